@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// AllParamsCmd returns the all cobra Command, printing the governance controlled parameters of every module
+// as JSON.
+func AllParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all",
+		Short: "Print the governance controlled parameters of every module as JSON",
+		Long: `Print the governance controlled parameters of every module, including the app-level parameters,
+sorted by module name. Use it to review the tunable parameters of the chain in one place before
+submitting a parameter change proposal.`,
+		Example: "injectived query params all --node tcp://localhost:26657",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := apptypes.NewQueryClient(clientCtx).AllParams(cmd.Context(), &apptypes.QueryAllParamsRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to query the params: %w", err)
+			}
+
+			// print the params of each module as a JSON object rather than as its encoded string
+			type moduleParams struct {
+				Module string          `json:"module"`
+				Params json.RawMessage `json:"params"`
+			}
+
+			allParams := make([]moduleParams, 0, len(res.Params))
+			for _, params := range res.Params {
+				allParams = append(allParams, moduleParams{Module: params.Module, Params: json.RawMessage(params.Params)})
+			}
+
+			bz, err := json.MarshalIndent(allParams, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
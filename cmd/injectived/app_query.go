@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// AppQueryCmd returns the app query cobra Command, grouping the app-level queries which aggregate the state of
+// several modules and thus don't belong to the query commands of a single module.
+func AppQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "app",
+		Short:                      "Querying commands for the app-level queries",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ProposalsByModuleCmd(),
+		GovParticipationCmd(),
+		ModuleStateRootCmd(),
+	)
+
+	return cmd
+}
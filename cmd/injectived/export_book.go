@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+const flagOutputFile = "output-file"
+
+// ExportBookCmd returns the export-book cobra Command, writing every resting limit order of a market in the last
+// committed state of the local node as CSV.
+func ExportBookCmd(loader AppLoader, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-book [market-id]",
+		Short: "Export the resting limit orders of a market of the local node as CSV",
+		Long: `Export every resting limit order of a spot, derivative or binary options market in the last committed
+state of the local node as CSV, with the order hash, subaccount, side, price, quantity, fillable quantity and
+fill status of each order. The buy orders come first, then the sell orders, each side sorted from the best price.`,
+		Example: "injectived query exchange export-book 0x0611780ba69656949525013d947713300f56c37b6175e02f26bffa495c3208fe --output-file book.csv --home ~/.injectived",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !exchangetypes.IsHexHash(args[0]) {
+				return fmt.Errorf("invalid market id %s", args[0])
+			}
+
+			return runWithOfflineApp(cmd, loader, func(injectiveApp *app.InjectiveApp) error {
+				w := cmd.OutOrStdout()
+				if outputPath, _ := cmd.Flags().GetString(flagOutputFile); outputPath != "" {
+					file, err := os.Create(outputPath)
+					if err != nil {
+						return err
+					}
+					defer file.Close()
+					w = file
+				}
+
+				if err := injectiveApp.ExportOrderbookCSV(common.HexToHash(args[0]), w); err != nil {
+					return fmt.Errorf("failed to export the orderbook: %w", err)
+				}
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flagOutputFile, "", "File to write the CSV to, instead of the standard output")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+)
+
+// ExportValidatorSetCmd returns the export-valset cobra Command, exporting the bonded validator set
+// of the local node's last committed state as JSON.
+func ExportValidatorSetCmd(loader AppLoader, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-valset",
+		Short: "Export the bonded validator set of the local node as JSON",
+		Long: `Export the operator address, consensus pubkey, tokens, power and status of every bonded
+validator from the last committed state of the local node, sorted by descending power. Only the
+staking store is read, so this is much cheaper than a full state export.`,
+		Example: "injectived query staking export-valset --home ~/.injectived",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runWithOfflineApp(cmd, loader, func(injectiveApp *app.InjectiveApp) error {
+				validators, err := injectiveApp.ExportValidatorSet()
+				if err != nil {
+					return fmt.Errorf("failed to export validator set: %w", err)
+				}
+
+				bz, err := json.MarshalIndent(validators, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
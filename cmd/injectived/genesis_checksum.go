@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+)
+
+// GenesisChecksumCmd returns the genesis-checksum cobra Command, printing the canonical checksum of the app state
+// of a genesis file.
+func GenesisChecksumCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-checksum [genesis-file]",
+		Short: "Print the canonical SHA-256 checksum of the app state of a genesis file",
+		Long: `Print the SHA-256 checksum of the canonical JSON encoding of the app state of a genesis file.
+Object keys are sorted and whitespace is ignored, so nodes with the same app state get the same checksum
+regardless of how their genesis file is formatted.`,
+		Example: "injectived debug genesis-checksum ~/.injectived/config/genesis.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			genesis, _, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file %s: %w", args[0], err)
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), app.GenesisChecksum(genesis))
+			return nil
+		},
+	}
+
+	return cmd
+}
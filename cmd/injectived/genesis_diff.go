@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+)
+
+// GenesisDiffCmd returns the genesis-diff cobra Command, reporting the semantic differences
+// between the app states of two genesis files.
+func GenesisDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-diff [genesis-file-a] [genesis-file-b]",
+		Short: "Report the semantic differences between two genesis files",
+		Long: `Decode the app state of both genesis files and report the module level differences,
+one path per line. List ordering is ignored and typed values are compared through the app codec,
+so only actual state differences are reported.`,
+		Example: "injectived debug genesis-diff exported-before.json exported-after.json",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genesisA, _, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file %s: %w", args[0], err)
+			}
+
+			genesisB, _, err := genutiltypes.GenesisStateFromGenFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file %s: %w", args[1], err)
+			}
+
+			diffs, err := app.DiffGenesis(clientCtx.Codec, genesisA, genesisB)
+			if err != nil {
+				return err
+			}
+
+			if len(diffs) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no differences")
+				return nil
+			}
+
+			for _, diff := range diffs {
+				fmt.Fprintln(cmd.OutOrStdout(), diff.String())
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
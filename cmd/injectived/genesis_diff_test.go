@@ -0,0 +1,71 @@
+package main_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	injectived "github.com/InjectiveLabs/injective-core/cmd/injectived"
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestGenesisDiffCmd(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dir := t.TempDir()
+
+	balances := []banktypes.Balance{
+		{Address: "inj1cml96vmptgw99syqrrz8az79xer2pcgp0a885r", Coins: sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(10)))},
+		{Address: "inj1jcltmuhplrdcwp7stlr4hlhlhgd4htqhe4c0cs", Coins: sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(20)))},
+	}
+
+	writeGenesis := func(name string, makerFeeRate sdk.Dec, balances []banktypes.Balance) string {
+		genesisState := app.NewDefaultGenesisState()
+
+		var bankGenesis banktypes.GenesisState
+		cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+		bankGenesis.Balances = balances
+		genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
+		var exchangeGenesis exchangetypes.GenesisState
+		cdc.MustUnmarshalJSON(genesisState[exchangetypes.ModuleName], &exchangeGenesis)
+		exchangeGenesis.Params.DefaultSpotMakerFeeRate = makerFeeRate
+		genesisState[exchangetypes.ModuleName] = cdc.MustMarshalJSON(&exchangeGenesis)
+
+		appState, err := json.Marshal(genesisState)
+		require.NoError(t, err)
+
+		genFile := filepath.Join(dir, name)
+		genDoc := tmtypes.GenesisDoc{ChainID: "injective-1", AppState: appState}
+		require.NoError(t, genDoc.SaveAs(genFile))
+		return genFile
+	}
+
+	// same balances in a different order, only the maker fee rate differs
+	genFileA := writeGenesis("a.json", sdk.NewDecWithPrec(1, 3), balances)
+	genFileB := writeGenesis("b.json", sdk.NewDecWithPrec(2, 3), []banktypes.Balance{balances[1], balances[0]})
+
+	rootCmd, _ := injectived.NewRootCmd()
+	out := new(bytes.Buffer)
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"debug", "genesis-diff", genFileA, genFileB})
+	require.NoError(t, injectived.Execute(rootCmd))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 1, out.String())
+	require.Equal(t, `exchange.params.default_spot_maker_fee_rate: "0.001000000000000000" => "0.002000000000000000"`, lines[0])
+
+	out.Reset()
+	rootCmd, _ = injectived.NewRootCmd()
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"debug", "genesis-diff", genFileA, genFileA})
+	require.NoError(t, injectived.Execute(rootCmd))
+	require.Equal(t, "no differences", strings.TrimSpace(out.String()))
+}
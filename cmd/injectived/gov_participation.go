@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// GovParticipationCmd returns the gov-participation cobra Command, printing the participation of an account in the
+// governance proposals in their voting period.
+func GovParticipationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-participation <address>",
+		Short: "Print the participation of an account in the governance proposals",
+		Long: `Print the participation of an account in the governance proposals in their voting period, ordered by
+proposal ID: either the vote cast by the account, or the votes it inherits from the bonded validators it
+delegates to when it did not vote. Proposals in which the account neither voted nor inherits a vote are
+skipped.`,
+		Example: "injectived query app gov-participation inj1... --node tcp://localhost:26657",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := apptypes.NewQueryClient(clientCtx).AccountGovParticipation(cmd.Context(), &apptypes.QueryAccountGovParticipationRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to query the governance participation of %s: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gov-participation")
+
+	return cmd
+}
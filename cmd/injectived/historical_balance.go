@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// HistoricalBalanceCmd returns the historical-balance cobra Command, printing the balance of an account in a
+// denom as of a past committed height.
+func HistoricalBalanceCmd() *cobra.Command {
+	cmd := cli.QueryCmd("historical-balance <address> <denom> <height>",
+		"Print the balance of an account in a denom as of a past height",
+		apptypes.NewQueryClient,
+		&apptypes.QueryHistoricalBalanceRequest{}, nil, nil,
+	)
+	cmd.Long = `Print the balance of an account in a denom as of the committed state at the given height, or at the
+height of the query when the height is 0. The query fails if the state at that height has been pruned
+by the node.`
+	cmd.Example = "injectived query bank historical-balance inj1... inj 1000000 --node tcp://localhost:26657"
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// ModuleStateRootCmd returns the module-state-root cobra Command, printing the commit hash of the store of a module.
+func ModuleStateRootCmd() *cobra.Command {
+	cmd := cli.QueryCmd("module-state-root <module-name>",
+		"Print the commit hash of the store of a module",
+		apptypes.NewQueryClient,
+		&apptypes.QueryModuleStateRootRequest{}, nil, nil,
+	)
+	cmd.Long = `Print the commit hash, i.e. the IAVL root, of the store of a module as included in the app hash, which lets
+the state of a single module be verified without the full app hash. If the height is not provided, the
+latest height is used.`
+	cmd.Example = "injectived query app module-state-root exchange --height 1000000 --node tcp://localhost:26657"
+
+	return cmd
+}
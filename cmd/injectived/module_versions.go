@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// ModuleVersionsCmd returns the module-versions cobra Command, printing the consensus version of every
+// module stored in the committed state of the node.
+func ModuleVersionsCmd() *cobra.Command {
+	cmd := cli.QueryCmd("module-versions",
+		"Print the stored consensus version of every module",
+		apptypes.NewQueryClient,
+		&apptypes.QueryModuleVersionsRequest{}, nil, nil,
+	)
+	cmd.Long = `Print the consensus version of every module as stored by the upgrade keeper in the committed state
+of the node, sorted by module name. Use it after an upgrade to confirm that the migrations of every
+module ran.`
+	cmd.Example = "injectived query upgrade module-versions --node tcp://localhost:26657"
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+)
+
+// AppLoader loads the application at the latest committed state of the given db.
+type AppLoader func(logger log.Logger, db dbm.DB, appOpts servertypes.AppOptions) (*app.InjectiveApp, error)
+
+// runWithOfflineApp opens the application database of the local node, loads the application from it and runs fn.
+func runWithOfflineApp(cmd *cobra.Command, loader AppLoader, fn func(injectiveApp *app.InjectiveApp) error) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	if homeDir == "" {
+		homeDir = serverCtx.Config.RootDir
+	}
+	serverCtx.Viper.Set(flags.FlagHome, homeDir)
+
+	db, err := openDB(homeDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	injectiveApp, err := loader(serverCtx.Logger, db, serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+
+	return fn(injectiveApp)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// ProposalsByModuleCmd returns the proposals-by-module cobra Command, printing the governance proposals with at
+// least one message targeting a module.
+func ProposalsByModuleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-by-module <module-name>",
+		Short: "Print the governance proposals targeting a module",
+		Long: `Print the governance proposals, ordered by proposal ID, with at least one message targeting the given
+module. A message targets the module registering the msg services of its proto package, a legacy parameter
+change targets the subspaces of its changes.`,
+		Example: "injectived query app proposals-by-module exchange --limit 10 --node tcp://localhost:26657",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := apptypes.NewQueryClient(clientCtx).ProposalsByModule(cmd.Context(), &apptypes.QueryProposalsByModuleRequest{
+				ModuleName: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to query the proposals of module %s: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals-by-module")
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+)
+
+// RegisteredInterfacesCmd returns the interfaces cobra Command, printing every interface of the interface
+// registry with the type URLs of its registered implementations as JSON.
+func RegisteredInterfacesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interfaces",
+		Short: "Print the registered interfaces and their implementations as JSON",
+		Long: `Print every interface of the app's interface registry with the type URLs of its registered
+implementations, sorted by name. A type missing from the implementations of its interface can't be
+decoded from an Any, so use it to catch missing registrations.`,
+		Example: "injectived debug interfaces",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := json.MarshalIndent(app.ListRegisteredInterfaces(clientCtx.InterfaceRegistry), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+
+	return cmd
+}
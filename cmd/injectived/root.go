@@ -40,12 +40,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	clicfg "github.com/InjectiveLabs/injective-core/cmd/injectived/config/cli"
 	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/app/ante"
 	injectiveclient "github.com/InjectiveLabs/injective-core/injective-chain/client"
 	"github.com/InjectiveLabs/injective-core/injective-chain/crypto/hd"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
 	"github.com/InjectiveLabs/injective-core/version"
 )
 
@@ -131,6 +137,12 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 
 	a := appCreator{encodingConfig}
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(GenesisDiffCmd())
+	debugCmd.AddCommand(GenesisChecksumCmd())
+	debugCmd.AddCommand(RegisteredInterfacesCmd())
+	debugCmd.AddCommand(StoreSizesCmd())
+
 	rootCmd.AddCommand(
 		injectiveclient.ValidateChainID(
 			genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
@@ -141,7 +153,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
-		debug.Cmd(),
+		debugCmd,
 		config.Cmd(),
 	)
 
@@ -174,7 +186,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 		flags.LineBreak,
 		rpc.StatusCommand(),
 		rosettaCmd.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler),
-		queryCommand(),
+		queryCommand(a.loadApp),
 		txCommand(),
 		flags.LineBreak,
 		version.NewVersionCommand(),
@@ -185,9 +197,21 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().Uint64(app.FlagEventBudget, 0, "Maximum number of events the messages of a block may emit, must be the same on all validators (0 = unlimited)")
+	startCmd.Flags().Uint64(app.FlagMaxTxBytes, 0, "Maximum size in bytes of txs accepted into the mempool (0 = consensus params limit only)")
+	startCmd.Flags().Uint64(ante.FlagMaxGasRefund, 0, "Maximum gas refundable within a single tx, must be the same on all validators (0 = no refunds)")
+	startCmd.Flags().Uint64(ante.FlagMaxMsgsPerTx, 0, "Maximum number of messages per tx, enforced in CheckTx and DeliverTx so it must be the same on every validator (0 = unlimited)")
+	startCmd.Flags().String(app.FlagUnsafeDisabledEndBlockers, "", "Comma separated modules whose EndBlockers are skipped, for profiling only: produces an INVALID state")
+	startCmd.Flags().Int(app.FlagMempoolPolicyMaxTxs, 0, "Number of txs at which the mempool is full for the message type policy, should match the CometBFT mempool size (0 = policy disabled)")
+	startCmd.Flags().Float64(app.FlagMempoolPolicyLowPriorityThreshold, app.DefaultMempoolPolicyLowPriorityThreshold, "Fraction of the mempool policy max txs from which txs with a low priority message are rejected")
+	startCmd.Flags().StringSlice(app.FlagMempoolPolicyLowPriorityMsgTypes, nil, "Type URLs of the low priority messages, rejected when the mempool is nearly full")
+	startCmd.Flags().StringSlice(app.FlagMempoolPolicyHighPriorityMsgTypes, nil, "Type URLs of the high priority messages, still accepted when the mempool is full")
+	startCmd.Flags().Bool(app.FlagQueryCache, false, "Serve the gRPC queries repeated at the same height from an in-memory cache, emptied on every commit")
+	startCmd.Flags().Int(app.FlagQueryCacheMaxEntries, app.DefaultQueryCacheMaxEntries, "Maximum number of query responses held by the query cache")
+	startCmd.Flags().Bool(app.FlagTelemetryExchangeTVL, false, "Report the total deposits per denom in the exchange to the exchange_tvl gauge (requires telemetry.enabled)")
 }
 
-func queryCommand() *cobra.Command {
+func queryCommand(loader AppLoader) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
 		Aliases:                    []string{"q"},
@@ -206,8 +230,26 @@ func queryCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
+	cmd.AddCommand(AppQueryCmd())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
+	for _, moduleCmd := range cmd.Commands() {
+		switch moduleCmd.Name() {
+		case distrtypes.ModuleName:
+			moduleCmd.AddCommand(ValidatorRewardSummaryCmd())
+		case upgradetypes.ModuleName:
+			moduleCmd.AddCommand(ModuleVersionsCmd())
+		case paramstypes.ModuleName:
+			moduleCmd.AddCommand(AllParamsCmd())
+		case banktypes.ModuleName:
+			moduleCmd.AddCommand(HistoricalBalanceCmd())
+		case stakingtypes.ModuleName:
+			moduleCmd.AddCommand(ExportValidatorSetCmd(loader, app.DefaultNodeHome))
+		case exchangetypes.ModuleName:
+			moduleCmd.AddCommand(ExportBookCmd(loader, app.DefaultNodeHome))
+		}
+	}
+
 	return cmd
 }
 
@@ -340,3 +382,14 @@ func (a appCreator) appExport(
 
 	return injectiveApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
+
+// loadApp loads the application at the latest committed state of the given db, for the commands reading the
+// database of the local node.
+func (a appCreator) loadApp(logger log.Logger, db dbm.DB, appOpts servertypes.AppOptions) (*app.InjectiveApp, error) {
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return nil, errors.New("application home not set")
+	}
+
+	return app.NewInjectiveApp(logger, db, nil, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts), nil
+}
@@ -290,6 +290,10 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 	sdkcfg.API = parsedConfig.API
 	if sdkcfg.API.Enable {
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+		if injApp, ok := app.(*injectivechain.InjectiveApp); ok && injApp.Telemetry != nil {
+			// serve the app telemetry, labelled with the chain-id and moniker, on the /metrics endpoint
+			apiSrv.SetTelemetry(injApp.Telemetry)
+		}
 		app.RegisterAPIRoutes(apiSrv, sdkcfg.API)
 		errCh := make(chan error)
 
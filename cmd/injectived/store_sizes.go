@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+const flagMaxKeysPerStore = "max-keys-per-store"
+
+// StoreSizesCmd returns the store-sizes cobra Command, printing the number of keys and the byte size of every store
+// of the committed state of the node as a table.
+func StoreSizesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-sizes",
+		Short: "Print the number of keys and the byte size of every store",
+		Long: `Print the number of keys and the total byte size of the keys and values of every store in the committed
+state of the node, sorted by decreasing size. Stores with more keys than --max-keys-per-store are only
+partly iterated and marked with a + as their sizes are lower bounds.`,
+		Example: "injectived debug store-sizes --node tcp://localhost:26657",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			maxKeysPerStore, err := cmd.Flags().GetUint64(flagMaxKeysPerStore)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := apptypes.NewQueryClient(clientCtx).StoreSizes(cmd.Context(), &apptypes.QueryStoreSizesRequest{
+				MaxKeysPerStore: maxKeysPerStore,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to query the store sizes: %w", err)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "STORE\tKEYS\tBYTES")
+			for _, size := range res.StoreSizes {
+				lowerBound := ""
+				if size.Truncated {
+					lowerBound = "+"
+				}
+				fmt.Fprintf(w, "%s\t%d%s\t%d%s\n", size.StoreKey, size.Keys, lowerBound, size.Bytes, lowerBound)
+			}
+			return w.Flush()
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagMaxKeysPerStore, 1000000, "Maximum number of keys iterated per store (0 = no limit)")
+
+	return cmd
+}
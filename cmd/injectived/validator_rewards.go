@@ -0,0 +1,21 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// ValidatorRewardSummaryCmd returns the validator-reward-summary cobra Command, printing the commission rates,
+// the accumulated commission and the outstanding rewards of a validator.
+func ValidatorRewardSummaryCmd() *cobra.Command {
+	cmd := cli.QueryCmd("validator-reward-summary <validator_address>",
+		"Print the commission and reward breakdown of a validator",
+		apptypes.NewQueryClient,
+		&apptypes.QueryValidatorRewardSummaryRequest{}, nil, nil,
+	)
+	cmd.Example = "injectived query distribution validator-reward-summary injvaloper1..."
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+	auctiontypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	autocompoundtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	ibcpacketlimittypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+	insurancetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
+	ocrtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/ocr/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+	permissionstypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+	tokenfactorytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/tokenfactory/types"
+	wasmxtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/wasmx/types"
+)
+
+// AllParams returns the parameters of every module with governance controlled parameters, sorted by module name.
+// The module parameters are read from their keeper and encoded as the JSON of their params query, the app-level
+// parameters are read from their subspace.
+func (app *InjectiveApp) AllParams(ctx sdk.Context) ([]apptypes.ModuleParams, error) {
+	getters := []struct {
+		module string
+		params func(ctx sdk.Context) proto.Message
+	}{
+		{auctiontypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.AuctionKeeper.GetParams(ctx); return &p }},
+		{authtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.AccountKeeper.GetParams(ctx); return &p }},
+		{autocompoundtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.AutoCompoundKeeper.GetParams(ctx); return &p }},
+		{banktypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.BankKeeper.GetParams(ctx); return &p }},
+		{distrtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.DistrKeeper.GetParams(ctx); return &p }},
+		{exchangetypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.ExchangeKeeper.GetParams(ctx); return &p }},
+		{govtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.GovKeeper.GetParams(ctx); return &p }},
+		{icahosttypes.SubModuleName, func(ctx sdk.Context) proto.Message { p := app.ICAHostKeeper.GetParams(ctx); return &p }},
+		{ibcpacketlimittypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.IBCPacketLimitKeeper.GetParams(ctx); return &p }},
+		{insurancetypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.InsuranceKeeper.GetParams(ctx); return &p }},
+		{minttypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.MintKeeper.GetParams(ctx); return &p }},
+		{ocrtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.OcrKeeper.GetParams(ctx); return &p }},
+		{oracletypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.OracleKeeper.GetParams(ctx); return &p }},
+		{peggytypes.ModuleName, func(ctx sdk.Context) proto.Message { return app.PeggyKeeper.GetParams(ctx) }},
+		{permissionstypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.PermissionsKeeper.GetParams(ctx); return &p }},
+		{slashingtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.SlashingKeeper.GetParams(ctx); return &p }},
+		{stakingtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.StakingKeeper.GetParams(ctx); return &p }},
+		{tokenfactorytypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.TokenFactoryKeeper.GetParams(ctx); return &p }},
+		{ibctransfertypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.TransferKeeper.GetParams(ctx); return &p }},
+		{wasmtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.WasmKeeper.GetParams(ctx); return &p }},
+		{wasmxtypes.ModuleName, func(ctx sdk.Context) proto.Message { p := app.WasmxKeeper.GetParams(ctx); return &p }},
+	}
+
+	allParams := make([]apptypes.ModuleParams, 0, len(getters)+2)
+	for _, getter := range getters {
+		bz, err := app.appCodec.MarshalJSON(getter.params(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the %s params: %w", getter.module, err)
+		}
+
+		allParams = append(allParams, apptypes.ModuleParams{Module: getter.module, Params: string(bz)})
+	}
+
+	var evidenceQueueParams EvidenceQueueParams
+	app.GetSubspace(EvidenceQueueParamsSubspace).GetParamSetIfExists(ctx, &evidenceQueueParams)
+
+	var rewardBatchingParams RewardBatchingParams
+	app.GetSubspace(RewardBatchingParamsSubspace).GetParamSetIfExists(ctx, &rewardBatchingParams)
+
+	for _, appParams := range []struct {
+		subspace string
+		params   interface{}
+	}{
+		{EvidenceQueueParamsSubspace, evidenceQueueParams},
+		{RewardBatchingParamsSubspace, rewardBatchingParams},
+	} {
+		bz, err := json.Marshal(appParams.params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the %s params: %w", appParams.subspace, err)
+		}
+
+		allParams = append(allParams, apptypes.ModuleParams{Module: appParams.subspace, Params: string(bz)})
+	}
+
+	sort.Slice(allParams, func(i, j int) bool {
+		return allParams[i].Module < allParams[j].Module
+	})
+
+	return allParams, nil
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestAllParams(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+
+	res, err := apptypes.NewQueryClient(queryHelper).AllParams(ctx, &apptypes.QueryAllParamsRequest{})
+	require.NoError(t, err)
+	allParams := res.Params
+
+	paramsByModule := make(map[string]string, len(allParams))
+	for i, moduleParams := range allParams {
+		if i > 0 {
+			require.Less(t, allParams[i-1].Module, moduleParams.Module)
+		}
+		paramsByModule[moduleParams.Module] = moduleParams.Params
+	}
+
+	require.Contains(t, paramsByModule, exchangetypes.ModuleName)
+	exchangeParams := app.ExchangeKeeper.GetParams(ctx)
+	require.JSONEq(t, string(app.AppCodec().MustMarshalJSON(&exchangeParams)), paramsByModule[exchangetypes.ModuleName])
+
+	require.Contains(t, paramsByModule, stakingtypes.ModuleName)
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	require.JSONEq(t, string(app.AppCodec().MustMarshalJSON(&stakingParams)), paramsByModule[stakingtypes.ModuleName])
+
+	require.Contains(t, paramsByModule, EvidenceQueueParamsSubspace)
+	require.JSONEq(t, `{"evidence_per_block":0}`, paramsByModule[EvidenceQueueParamsSubspace])
+}
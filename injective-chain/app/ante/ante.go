@@ -17,6 +17,7 @@ import (
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibcante "github.com/cosmos/ibc-go/v7/modules/core/ante"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -77,6 +78,9 @@ func NewAnteHandler(
 	txCounterStoreKey storetypes.StoreKey,
 	wasmConfig wasmTypes.WasmConfig,
 	ibcKeeper *ibckeeper.Keeper,
+	stakingKeeper StakingKeeper,
+	paramSpace paramtypes.Subspace,
+	maxMsgsPerTx uint64,
 ) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, sim bool,
@@ -96,16 +100,19 @@ func NewAnteHandler(
 					switch tx.(type) {
 					case sdk.Tx:
 						anteHandler = sdk.ChainAnteDecorators(
-							authante.NewSetUpContextDecorator(),                                      // outermost AnteDecorator. SetUpContext must be called first
+							NewMaxMsgsPerTxDecorator(maxMsgsPerTx),                                   // outermost AnteDecorator. Rejects txs with too many messages before any other work
+							authante.NewSetUpContextDecorator(),                                      // SetUpContext must be called before any gas consuming decorator
+							NewGasRefundDecorator(),                                                  // after setup context to record the gas refunds of the tx
 							wasmkeeper.NewLimitSimulationGasDecorator(wasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 							wasmkeeper.NewCountTXDecorator(txCounterStoreKey),
 							authante.NewValidateBasicDecorator(),
+							NewJailedDelegationDecorator(stakingKeeper, paramSpace),
 							authante.NewTxTimeoutHeightDecorator(),
 							authante.NewValidateMemoDecorator(ak),
 							authante.NewConsumeGasForTxSizeDecorator(ak),
 							authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 							authante.NewValidateSigCountDecorator(ak),
-							NewDeductFeeDecorator(ak, bankKeeper), // overidden for fee delegation
+							NewFeeBypassDecorator(paramSpace, NewDeductFeeDecorator(ak, bankKeeper)), // overidden for fee delegation
 							authante.NewSigGasConsumeDecorator(ak, DefaultSigVerificationGasConsumer),
 							NewEip712SigVerificationDecorator(ak, signModeHandler), // overidden for EIP712 Tx signatures
 							authante.NewIncrementSequenceDecorator(ak),             // innermost AnteDecorator
@@ -128,15 +135,18 @@ func NewAnteHandler(
 		switch tx.(type) {
 		case sdk.Tx:
 			anteHandler = sdk.ChainAnteDecorators(
-				authante.NewSetUpContextDecorator(),                                      // outermost AnteDecorator. SetUpContext must be called first
+				NewMaxMsgsPerTxDecorator(maxMsgsPerTx),                                   // outermost AnteDecorator. Rejects txs with too many messages before any other work
+				authante.NewSetUpContextDecorator(),                                      // SetUpContext must be called before any gas consuming decorator
+				NewGasRefundDecorator(),                                                  // after setup context to record the gas refunds of the tx
 				wasmkeeper.NewLimitSimulationGasDecorator(wasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 				wasmkeeper.NewCountTXDecorator(txCounterStoreKey),
 				authante.NewExtensionOptionsDecorator(nil),
 				authante.NewValidateBasicDecorator(),
+				NewJailedDelegationDecorator(stakingKeeper, paramSpace),
 				authante.NewTxTimeoutHeightDecorator(),
 				authante.NewValidateMemoDecorator(ak),
 				authante.NewConsumeGasForTxSizeDecorator(ak),
-				authante.NewDeductFeeDecorator(ak, bankKeeper, feegrantKeeper, nil),
+				NewFeeBypassDecorator(paramSpace, authante.NewDeductFeeDecorator(ak, bankKeeper, feegrantKeeper, nil)),
 				authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 				authante.NewValidateSigCountDecorator(ak),
 				authante.NewSigGasConsumeDecorator(ak, DefaultSigVerificationGasConsumer),
@@ -0,0 +1,79 @@
+package ante
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	EventTypeFeeBypass  = "fee_bypass"
+	AttributeKeySigners = "signers"
+)
+
+// FeeBypassDecorator skips the wrapped fee decorator (fee deduction and min gas price check)
+// when every signer of the tx is part of the governance controlled FeeBypassSigners allowlist.
+// Signature verification and sequence increments are performed by the other decorators of the
+// chain and are not affected. Bypassed txs are flagged with a fee_bypass event.
+type FeeBypassDecorator struct {
+	paramSpace   paramtypes.Subspace
+	feeDecorator sdk.AnteDecorator
+}
+
+func NewFeeBypassDecorator(paramSpace paramtypes.Subspace, feeDecorator sdk.AnteDecorator) FeeBypassDecorator {
+	return FeeBypassDecorator{
+		paramSpace:   paramSpace,
+		feeDecorator: feeDecorator,
+	}
+}
+
+func (fbd FeeBypassDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return fbd.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	signers := sigTx.GetSigners()
+	if !fbd.areAllowlisted(ctx, signers) {
+		return fbd.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	signerAddrs := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		signerAddrs = append(signerAddrs, signer.String())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeFeeBypass,
+		sdk.NewAttribute(AttributeKeySigners, strings.Join(signerAddrs, ",")),
+	))
+
+	return next(ctx, tx, simulate)
+}
+
+func (fbd FeeBypassDecorator) areAllowlisted(ctx sdk.Context, signers []sdk.AccAddress) bool {
+	if len(signers) == 0 || !fbd.paramSpace.HasKeyTable() {
+		return false
+	}
+
+	var allowlist []string
+	fbd.paramSpace.GetIfExists(ctx, KeyFeeBypassSigners, &allowlist)
+	if len(allowlist) == 0 {
+		return false
+	}
+
+	allowed := make(map[string]struct{}, len(allowlist))
+	for _, addr := range allowlist {
+		allowed[addr] = struct{}{}
+	}
+
+	for _, signer := range signers {
+		if _, ok := allowed[signer.String()]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,93 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/app/ante"
+)
+
+func TestFeeBypassDecorator(t *testing.T) {
+	testApp := app.Setup(false)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+	encodingConfig := app.MakeEncodingConfig()
+
+	relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	trader := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	initialBalance := sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1_000_000_000)))
+	fee := sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(100_000)))
+
+	for _, addr := range []sdk.AccAddress{relayer, trader} {
+		testApp.AccountKeeper.SetAccount(ctx, testApp.AccountKeeper.NewAccountWithAddress(ctx, addr))
+		require.NoError(t, banktestutil.FundAccount(testApp.BankKeeper, ctx, addr, initialBalance))
+	}
+
+	paramSpace := testApp.GetSubspace(ante.ParamsSubspace)
+	paramSpace.SetParamSet(ctx, &ante.Params{FeeBypassSigners: []string{relayer.String()}})
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewFeeBypassDecorator(paramSpace, authante.NewDeductFeeDecorator(testApp.AccountKeeper, testApp.BankKeeper, testApp.FeeGrantKeeper, nil)),
+	)
+
+	buildTx := func(signer sdk.AccAddress) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{
+			FromAddress: signer.String(),
+			ToAddress:   signer.String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1))),
+		}))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(200000)
+		return txBuilder.GetTx()
+	}
+
+	t.Run("allowlisted signer bypasses fees", func(t *testing.T) {
+		bypassCtx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := anteHandler(bypassCtx, buildTx(relayer), false)
+		require.NoError(t, err)
+
+		require.Equal(t, initialBalance, testApp.BankKeeper.GetAllBalances(ctx, relayer))
+
+		var bypassEvent *sdk.Event
+		for _, ev := range bypassCtx.EventManager().Events() {
+			if ev.Type == ante.EventTypeFeeBypass {
+				ev := ev
+				bypassEvent = &ev
+			}
+		}
+		require.NotNil(t, bypassEvent, "bypassed tx should emit a fee_bypass event")
+		require.Equal(t, ante.AttributeKeySigners, bypassEvent.Attributes[0].Key)
+		require.Equal(t, relayer.String(), bypassEvent.Attributes[0].Value)
+	})
+
+	t.Run("normal signer pays fees", func(t *testing.T) {
+		normalCtx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := anteHandler(normalCtx, buildTx(trader), false)
+		require.NoError(t, err)
+
+		require.Equal(t, initialBalance.Sub(fee...), testApp.BankKeeper.GetAllBalances(ctx, trader))
+
+		for _, ev := range normalCtx.EventManager().Events() {
+			require.NotEqual(t, ante.EventTypeFeeBypass, ev.Type)
+		}
+	})
+}
+
+func TestParamsValidation(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	require.NoError(t, ante.DefaultParams().Validate())
+	require.NoError(t, ante.Params{FeeBypassSigners: []string{addr}}.Validate())
+	require.Error(t, ante.Params{FeeBypassSigners: []string{"not-an-address"}}.Validate())
+	require.Error(t, ante.Params{FeeBypassSigners: []string{addr, addr}}.Validate())
+}
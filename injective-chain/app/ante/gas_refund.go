@@ -0,0 +1,117 @@
+package ante
+
+import (
+	"math"
+	"strconv"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FlagMaxGasRefund is the app option holding the maximum gas refundable within a single tx. Zero
+// means no gas is refunded.
+const FlagMaxGasRefund = "max-gas-refund"
+
+const (
+	EventTypeGasRefundClamped = "gas_refund_clamped"
+
+	AttributeKeyRequestedGasRefund = "requested"
+	AttributeKeyAppliedGasRefund   = "applied"
+	AttributeKeyMaxGasRefund       = "max_gas_refund"
+)
+
+// GasRefundMeter wraps the gas meter of a tx so that the gas refunded by the message handlers is
+// only recorded. The refund is applied once the messages succeeded by the GasRefundPostDecorator,
+// up to the cap.
+type GasRefundMeter struct {
+	storetypes.GasMeter
+
+	pendingRefund storetypes.Gas
+}
+
+func NewGasRefundMeter(meter storetypes.GasMeter) *GasRefundMeter {
+	return &GasRefundMeter{
+		GasMeter: meter,
+	}
+}
+
+// RefundGas records the refund, it's applied by the GasRefundPostDecorator.
+func (m *GasRefundMeter) RefundGas(amount storetypes.Gas, _ string) {
+	if amount > math.MaxUint64-m.pendingRefund {
+		m.pendingRefund = math.MaxUint64
+		return
+	}
+	m.pendingRefund += amount
+}
+
+// PendingRefund returns the gas refunded by the message handlers and not applied yet.
+func (m *GasRefundMeter) PendingRefund() storetypes.Gas {
+	return m.pendingRefund
+}
+
+// GasRefundDecorator wraps the gas meter of the tx in a GasRefundMeter, so it must be placed
+// after the SetUpContextDecorator.
+type GasRefundDecorator struct{}
+
+func NewGasRefundDecorator() GasRefundDecorator {
+	return GasRefundDecorator{}
+}
+
+func (GasRefundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	return next(ctx.WithGasMeter(NewGasRefundMeter(ctx.GasMeter())), tx, simulate)
+}
+
+// GasRefundPostDecorator applies the gas refunded by the message handlers of a tx, clamped to the
+// cap: a refund beyond the cap is silently reduced and a gas_refund_clamped event records it. The
+// cap changes the gas used by the txs, so it is consensus critical: every validator must run with
+// the same value.
+type GasRefundPostDecorator struct {
+	maxGasRefund uint64
+}
+
+func NewGasRefundPostDecorator(maxGasRefund uint64) GasRefundPostDecorator {
+	return GasRefundPostDecorator{
+		maxGasRefund: maxGasRefund,
+	}
+}
+
+func (grd GasRefundPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	meter, ok := ctx.GasMeter().(*GasRefundMeter)
+	if !ok || meter.pendingRefund == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+
+	requestedRefund := meter.pendingRefund
+	meter.pendingRefund = 0
+
+	refund := requestedRefund
+	if refund > grd.maxGasRefund {
+		refund = grd.maxGasRefund
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeGasRefundClamped,
+			sdk.NewAttribute(AttributeKeyRequestedGasRefund, strconv.FormatUint(requestedRefund, 10)),
+			sdk.NewAttribute(AttributeKeyAppliedGasRefund, strconv.FormatUint(refund, 10)),
+			sdk.NewAttribute(AttributeKeyMaxGasRefund, strconv.FormatUint(grd.maxGasRefund, 10)),
+		))
+	}
+
+	// the gas consumed can't become negative
+	if consumed := meter.GasMeter.GasConsumed(); refund > consumed {
+		refund = consumed
+	}
+
+	if refund > 0 {
+		meter.GasMeter.RefundGas(refund, "gas refund")
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// NewPostHandler returns the post handler applying the gas refunds of the txs, up to maxGasRefund
+// per tx.
+func NewPostHandler(maxGasRefund uint64) sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		NewGasRefundPostDecorator(maxGasRefund),
+	)
+}
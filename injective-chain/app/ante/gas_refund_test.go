@@ -0,0 +1,76 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/app/ante"
+)
+
+func TestGasRefundPostDecorator(t *testing.T) {
+	testApp := app.Setup(false)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+
+	const (
+		gasConsumed  = 50000
+		maxGasRefund = 20000
+	)
+
+	// runTx consumes gas and refunds the given amount from the message handlers, then runs the post handler
+	runTx := func(t *testing.T, refund, maxGasRefund uint64) sdk.Context {
+		t.Helper()
+
+		anteHandler := sdk.ChainAnteDecorators(ante.NewGasRefundDecorator())
+		msgCtx, err := anteHandler(ctx.WithGasMeter(storetypes.NewGasMeter(100000)).WithEventManager(sdk.NewEventManager()), nil, false)
+		require.NoError(t, err)
+
+		msgCtx.GasMeter().ConsumeGas(gasConsumed, "msg")
+		msgCtx.GasMeter().RefundGas(refund, "msg refund")
+		require.Equal(t, uint64(gasConsumed), msgCtx.GasMeter().GasConsumed(), "refunds are only applied by the post handler")
+
+		postCtx, err := ante.NewPostHandler(maxGasRefund)(msgCtx, nil, false, true)
+		require.NoError(t, err)
+		return postCtx
+	}
+
+	clampEvents := func(ctx sdk.Context) []sdk.Event {
+		events := make([]sdk.Event, 0)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == ante.EventTypeGasRefundClamped {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	t.Run("refund under the cap is applied", func(t *testing.T) {
+		postCtx := runTx(t, 10000, maxGasRefund)
+		require.Equal(t, uint64(gasConsumed-10000), postCtx.GasMeter().GasConsumed())
+		require.Empty(t, clampEvents(postCtx))
+	})
+
+	t.Run("refund over the cap is clamped", func(t *testing.T) {
+		postCtx := runTx(t, 30000, maxGasRefund)
+		require.Equal(t, uint64(gasConsumed-maxGasRefund), postCtx.GasMeter().GasConsumed())
+
+		events := clampEvents(postCtx)
+		require.Len(t, events, 1)
+		require.Equal(t, []string{"30000", "20000", "20000"}, []string{
+			events[0].Attributes[0].Value,
+			events[0].Attributes[1].Value,
+			events[0].Attributes[2].Value,
+		})
+	})
+
+	t.Run("zero cap allows no refund", func(t *testing.T) {
+		postCtx := runTx(t, 10000, 0)
+		require.Equal(t, uint64(gasConsumed), postCtx.GasMeter().GasConsumed())
+		require.Len(t, clampEvents(postCtx), 1)
+	})
+}
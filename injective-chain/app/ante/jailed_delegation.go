@@ -0,0 +1,82 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines an expected keeper interface for the staking module's Keeper
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+}
+
+// JailedDelegationDecorator rejects txs delegating or redelegating to a jailed validator, including
+// delegations wrapped in an authz MsgExec. Undelegations and redelegations away from a jailed validator
+// are not affected. The check is disabled when the governance controlled AllowJailedDelegations param is set.
+type JailedDelegationDecorator struct {
+	stakingKeeper StakingKeeper
+	paramSpace    paramtypes.Subspace
+}
+
+func NewJailedDelegationDecorator(stakingKeeper StakingKeeper, paramSpace paramtypes.Subspace) JailedDelegationDecorator {
+	return JailedDelegationDecorator{
+		stakingKeeper: stakingKeeper,
+		paramSpace:    paramSpace,
+	}
+}
+
+func (jdd JailedDelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if jdd.paramSpace.HasKeyTable() {
+		var allowJailedDelegations bool
+		jdd.paramSpace.GetIfExists(ctx, KeyAllowJailedDelegations, &allowJailedDelegations)
+		if allowJailedDelegations {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	if err := jdd.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (jdd JailedDelegationDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var validatorAddr string
+
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgDelegate:
+			validatorAddr = msg.ValidatorAddress
+		case *stakingtypes.MsgBeginRedelegate:
+			validatorAddr = msg.ValidatorDstAddress
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := jdd.checkMsgs(ctx, execMsgs); err != nil {
+				return err
+			}
+			continue
+		default:
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+		if err != nil {
+			return err
+		}
+
+		validator, found := jdd.stakingKeeper.GetValidator(ctx, valAddr)
+		if found && validator.IsJailed() {
+			return errors.Wrapf(stakingtypes.ErrValidatorJailed, "cannot delegate to jailed validator %s", validatorAddr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/app/ante"
+)
+
+func TestJailedDelegationDecorator(t *testing.T) {
+	testApp := app.Setup(false)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+	encodingConfig := app.MakeEncodingConfig()
+
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoin("inj", sdk.NewInt(1000))
+
+	bondedValidators := testApp.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NotEmpty(t, bondedValidators)
+	bondedValAddr := bondedValidators[0].GetOperator()
+
+	jailedValAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	jailedValidator, err := stakingtypes.NewValidator(jailedValAddr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	jailedValidator.Jailed = true
+	testApp.StakingKeeper.SetValidator(ctx, jailedValidator)
+
+	paramSpace := testApp.GetSubspace(ante.ParamsSubspace)
+	anteHandler := sdk.ChainAnteDecorators(ante.NewJailedDelegationDecorator(testApp.StakingKeeper, paramSpace))
+
+	buildTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		return txBuilder.GetTx()
+	}
+
+	delegate := func(valAddr sdk.ValAddress) sdk.Msg {
+		return stakingtypes.NewMsgDelegate(delegator, valAddr, amount)
+	}
+
+	redelegate := func(srcValAddr, dstValAddr sdk.ValAddress) sdk.Msg {
+		return stakingtypes.NewMsgBeginRedelegate(delegator, srcValAddr, dstValAddr, amount)
+	}
+
+	t.Run("delegation to a bonded validator is accepted", func(t *testing.T) {
+		_, err := anteHandler(ctx, buildTx(delegate(bondedValAddr)), false)
+		require.NoError(t, err)
+	})
+
+	t.Run("delegation to a jailed validator is rejected", func(t *testing.T) {
+		_, err := anteHandler(ctx, buildTx(delegate(jailedValAddr)), false)
+		require.ErrorIs(t, err, stakingtypes.ErrValidatorJailed)
+	})
+
+	t.Run("delegation to a jailed validator through authz is rejected", func(t *testing.T) {
+		exec := authz.NewMsgExec(delegator, []sdk.Msg{delegate(jailedValAddr)})
+		_, err := anteHandler(ctx, buildTx(&exec), false)
+		require.ErrorIs(t, err, stakingtypes.ErrValidatorJailed)
+	})
+
+	t.Run("redelegation away from a jailed validator is accepted", func(t *testing.T) {
+		_, err := anteHandler(ctx, buildTx(redelegate(jailedValAddr, bondedValAddr)), false)
+		require.NoError(t, err)
+	})
+
+	t.Run("redelegation to a jailed validator is rejected", func(t *testing.T) {
+		_, err := anteHandler(ctx, buildTx(redelegate(bondedValAddr, jailedValAddr)), false)
+		require.ErrorIs(t, err, stakingtypes.ErrValidatorJailed)
+	})
+
+	t.Run("delegation to a jailed validator is accepted when allowed by governance", func(t *testing.T) {
+		paramSpace.Set(ctx, ante.KeyAllowJailedDelegations, true)
+		defer paramSpace.Set(ctx, ante.KeyAllowJailedDelegations, false)
+
+		_, err := anteHandler(ctx, buildTx(delegate(jailedValAddr)), false)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FlagMaxMsgsPerTx is the app option holding the maximum number of messages in a tx. Zero means
+// there is no limit.
+const FlagMaxMsgsPerTx = "max-msgs-per-tx"
+
+// MaxMsgsPerTxDecorator rejects txs with more top level messages than the limit. Batch messages,
+// such as batch order creation or cancellation, count as a single message. Unlike the max tx size,
+// the limit is applied in both CheckTx and DeliverTx, so it is consensus critical: every validator
+// must run with the same value.
+type MaxMsgsPerTxDecorator struct {
+	maxMsgsPerTx uint64
+}
+
+func NewMaxMsgsPerTxDecorator(maxMsgsPerTx uint64) MaxMsgsPerTxDecorator {
+	return MaxMsgsPerTxDecorator{
+		maxMsgsPerTx: maxMsgsPerTx,
+	}
+}
+
+func (mmd MaxMsgsPerTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if mmd.maxMsgsPerTx == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if msgsCount := uint64(len(tx.GetMsgs())); msgsCount > mmd.maxMsgsPerTx {
+		return ctx, errors.Wrapf(sdkerrors.ErrInvalidRequest, "tx has %d messages, exceeding the limit of %d messages", msgsCount, mmd.maxMsgsPerTx)
+	}
+
+	return next(ctx, tx, simulate)
+}
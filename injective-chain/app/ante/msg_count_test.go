@@ -0,0 +1,80 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/app/ante"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestMaxMsgsPerTxDecorator(t *testing.T) {
+	testApp := app.Setup(false)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+	encodingConfig := app.MakeEncodingConfig()
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	buildTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetGasLimit(200000)
+		return txBuilder.GetTx()
+	}
+
+	buildSendTx := func(msgsCount int) sdk.Tx {
+		msgs := make([]sdk.Msg, 0, msgsCount)
+		for i := 0; i < msgsCount; i++ {
+			msgs = append(msgs, &banktypes.MsgSend{
+				FromAddress: sender.String(),
+				ToAddress:   sender.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1))),
+			})
+		}
+		return buildTx(msgs...)
+	}
+
+	const maxMsgsPerTx = 3
+	anteHandler := sdk.ChainAnteDecorators(ante.NewMaxMsgsPerTxDecorator(maxMsgsPerTx))
+
+	modes := map[string]sdk.Context{
+		"CheckTx":   ctx.WithIsCheckTx(true),
+		"DeliverTx": ctx.WithIsCheckTx(false),
+	}
+
+	for mode, modeCtx := range modes {
+		t.Run("tx at the limit is accepted in "+mode, func(t *testing.T) {
+			_, err := anteHandler(modeCtx, buildSendTx(maxMsgsPerTx), false)
+			require.NoError(t, err)
+		})
+
+		t.Run("tx over the limit is rejected in "+mode, func(t *testing.T) {
+			_, err := anteHandler(modeCtx, buildSendTx(maxMsgsPerTx+1), false)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		})
+	}
+
+	t.Run("batch message counts as a single message", func(t *testing.T) {
+		orders := make([]exchangetypes.SpotOrder, maxMsgsPerTx+1)
+		batchTx := buildTx(&exchangetypes.MsgBatchCreateSpotLimitOrders{
+			Sender: sender.String(),
+			Orders: orders,
+		})
+
+		_, err := anteHandler(ctx, batchTx, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("zero disables the limit", func(t *testing.T) {
+		_, err := sdk.ChainAnteDecorators(ante.NewMaxMsgsPerTxDecorator(0))(ctx, buildSendTx(maxMsgsPerTx+1), false)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,94 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamsSubspace is the name of the params subspace holding the governance
+// controlled settings of the ante handler. The values can be queried with
+// `injectived query params subspace ante <key>` and updated through a
+// parameter change proposal.
+const ParamsSubspace = "ante"
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter keys
+var (
+	KeyFeeBypassSigners       = []byte("FeeBypassSigners")
+	KeyAllowJailedDelegations = []byte("AllowJailedDelegations")
+)
+
+// Params defines the governance controlled ante handler settings.
+type Params struct {
+	// FeeBypassSigners are the accounts whose txs skip fee deduction and the
+	// min gas price check when they are the only signers of the tx
+	FeeBypassSigners []string `json:"fee_bypass_signers" yaml:"fee_bypass_signers"`
+	// AllowJailedDelegations disables the rejection of delegations and redelegations to jailed validators
+	AllowJailedDelegations bool `json:"allow_jailed_delegations" yaml:"allow_jailed_delegations"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		FeeBypassSigners:       []string{},
+		AllowJailedDelegations: false,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeBypassSigners, &p.FeeBypassSigners, validateFeeBypassSigners),
+		paramtypes.NewParamSetPair(KeyAllowJailedDelegations, &p.AllowJailedDelegations, validateBool),
+	}
+}
+
+// Validate performs basic validation on ante parameters.
+func (p Params) Validate() error {
+	if err := validateFeeBypassSigners(p.FeeBypassSigners); err != nil {
+		return fmt.Errorf("fee_bypass_signers is incorrect: %w", err)
+	}
+
+	if err := validateBool(p.AllowJailedDelegations); err != nil {
+		return fmt.Errorf("allow_jailed_delegations is incorrect: %w", err)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeBypassSigners(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, signer := range v {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid fee bypass signer %s: %w", signer, err)
+		}
+
+		if _, ok := seen[signer]; ok {
+			return fmt.Errorf("duplicate fee bypass signer %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+
+	return nil
+}
@@ -1,10 +1,12 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"cosmossdk.io/errors"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -15,6 +17,7 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cast"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -31,8 +34,15 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibctestingtypes "github.com/cosmos/ibc-go/v7/testing/types"
 
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction"
 	auctionkeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound"
+	autocompoundkeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs"
+	epochskeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit"
+	ibcpacketlimitkeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/keeper"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ocr"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle"
@@ -42,6 +52,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
@@ -149,9 +160,12 @@ import (
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/app/ante"
 	auctiontypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	autocompoundtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+	epochstypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange"
 	exchangekeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
 	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	ibcpacketlimittypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
 	insurancekeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/keeper"
 	insurancetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
 	ocrkeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/ocr/keeper"
@@ -223,6 +237,9 @@ var (
 		insurance.AppModuleBasic{},
 		exchange.AppModuleBasic{},
 		auction.AppModuleBasic{},
+		autocompound.AppModuleBasic{},
+		epochs.AppModuleBasic{},
+		ibcpacketlimit.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		peggy.AppModuleBasic{},
 		ocr.AppModuleBasic{},
@@ -230,12 +247,14 @@ var (
 		permissionsmodule.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 		wasmx.AppModuleBasic{},
+		RewardBatchingAppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
+		RewardBatchingModuleName:       nil,
 		icatypes.ModuleName:            nil,
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
@@ -278,6 +297,20 @@ type InjectiveApp struct {
 
 	invCheckPeriod uint
 
+	// db, txDecoder, anteHandler and postHandler are kept to replay historical blocks, loaded from the replay stores
+	db               dbm.DB
+	txDecoder        sdk.TxDecoder
+	anteHandler      sdk.AnteHandler
+	postHandler      sdk.PostHandler
+	replayBlockStore ReplayBlockStore
+	replayStateStore ReplayStateStore
+
+	// disabledEndBlockers holds the modules whose EndBlockers are skipped for profiling
+	disabledEndBlockers map[string]struct{}
+
+	// queryCache serves the gRPC queries repeated at the same height, nil when disabled
+	queryCache *queryCache
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -314,11 +347,14 @@ type InjectiveApp struct {
 	IBCHooksKeeper ibchookskeeper.Keeper
 
 	// injective keepers
-	AuctionKeeper      auctionkeeper.Keeper
-	ExchangeKeeper     exchangekeeper.Keeper
-	InsuranceKeeper    insurancekeeper.Keeper
-	TokenFactoryKeeper tokenfactorykeeper.Keeper
-	PermissionsKeeper  permissionskeeper.Keeper
+	AuctionKeeper        auctionkeeper.Keeper
+	AutoCompoundKeeper   autocompoundkeeper.Keeper
+	EpochsKeeper         epochskeeper.Keeper
+	IBCPacketLimitKeeper ibcpacketlimitkeeper.Keeper
+	ExchangeKeeper       exchangekeeper.Keeper
+	InsuranceKeeper      insurancekeeper.Keeper
+	TokenFactoryKeeper   tokenfactorykeeper.Keeper
+	PermissionsKeeper    permissionskeeper.Keeper
 
 	ScopedOracleKeeper capabilitykeeper.ScopedKeeper
 	OracleKeeper       oraclekeeper.Keeper
@@ -331,9 +367,27 @@ type InjectiveApp struct {
 
 	WasmxKeeper wasmxkeeper.Keeper
 
+	// tracks mints and burns for the supply conservation invariant
+	SupplyTracker *SupplyTracker
+
+	// max size of the txs accepted in CheckTx, zero for the consensus params limit only
+	maxTxBytes uint64
+
+	// fans out validator bonding events to app level subscribers
+	ValidatorHooks *ValidatorHooksRegistry
+
 	// the module manager
 	mm *module.Manager
 
+	// runs the blockers of the modules of mm with their module loggers, see newBlockerManager
+	blockerManager *module.Manager
+
+	// base logger module loggers are derived from, see SetBaseLogger
+	baseLogger log.Logger
+
+	// name of the module registering the msg services of each proto package, see registerServices
+	msgPackageModules map[string]string
+
 	// simulation manager
 	sm *module.SimulationManager
 
@@ -343,6 +397,9 @@ type InjectiveApp struct {
 	// stream server
 	ChainStreamServer *stream.StreamServer
 	EventPublisher    *stream.Publisher
+
+	// telemetry started from app.toml, nil when disabled
+	Telemetry *telemetry.Metrics
 }
 
 // NewInjectiveApp returns a reference to a new initialized Injective application.
@@ -376,6 +433,13 @@ func NewInjectiveApp(
 	bApp.SetName(version.Name)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 
+	// the mempool policy only tracks the txs of the CometBFT mempool, so proposals keep being built from it
+	if mempoolPolicy := newMsgTypePolicyMempoolFromAppOptions(appOpts, encodingConfig.TxConfig.TxEncoder()); mempoolPolicy != nil {
+		bApp.SetMempool(mempoolPolicy)
+		bApp.SetPrepareProposal(baseapp.NoOpPrepareProposal())
+		bApp.SetProcessProposal(baseapp.NoOpProcessProposal())
+	}
+
 	keys := sdk.NewKVStoreKeys(
 		// SDK keys
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
@@ -392,14 +456,20 @@ func NewInjectiveApp(
 		insurancetypes.StoreKey,
 		peggytypes.StoreKey,
 		auctiontypes.StoreKey,
+		autocompoundtypes.StoreKey,
+		ibcpacketlimittypes.StoreKey,
+		epochstypes.StoreKey,
 		ocrtypes.StoreKey,
 		tokenfactorytypes.StoreKey,
 		permissionsmodule.StoreKey,
 		wasmtypes.StoreKey,
 		wasmxtypes.StoreKey,
+		// app-level keys
+		RewardBatchingStoreKey,
+		EvidenceQueueStoreKey,
 	)
 
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, banktypes.TStoreKey, exchangetypes.TStoreKey, ocrtypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, banktypes.TStoreKey, exchangetypes.TStoreKey, ocrtypes.TStoreKey, SupplyTrackerTStoreKey, EventBudgetTStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &InjectiveApp{
@@ -408,11 +478,21 @@ func NewInjectiveApp(
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
+		db:                db,
+		txDecoder:         encodingConfig.TxConfig.TxDecoder(),
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		queryCache:        newQueryCacheFromAppOptions(appOpts),
 	}
 
+	// start the telemetry before any keeper can emit metrics, so every metric carries the chain identity labels
+	metrics, err := initTelemetry(appOpts, homePath)
+	if err != nil {
+		panic(fmt.Sprintf("failed to start telemetry: %s", err))
+	}
+	app.Telemetry = metrics
+
 	// init params keeper and subspaces
 	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 	// set the BaseApp's parameter store
@@ -435,7 +515,7 @@ func NewInjectiveApp(
 		chaintypes.InjectiveBech32Prefix,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankBaseKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		tkeys[banktypes.TStoreKey],
@@ -443,6 +523,9 @@ func NewInjectiveApp(
 		app.BlockedAddrs(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.SupplyTracker = NewSupplyTracker(tkeys[SupplyTrackerTStoreKey], bankBaseKeeper)
+	bankKeeper := NewSupplyTrackingBankKeeper(bankBaseKeeper, app.SupplyTracker)
+	app.BankKeeper = bankKeeper
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -472,7 +555,7 @@ func NewInjectiveApp(
 		appCodec,
 		legacyAmino,
 		keys[slashingtypes.StoreKey],
-		app.StakingKeeper,
+		newSlashingFractionsStakingKeeper(app.StakingKeeper, app.GetSubspace(SlashingFractionsParamsSubspace)),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
@@ -496,8 +579,12 @@ func NewInjectiveApp(
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	// NOTE: the reward batching hooks run first, crediting the pending rewards before the distribution hooks end the
+	// rewards period of the validator
+	// NOTE: the validator hooks registry runs last, after the standard modules have processed the event
+	app.ValidatorHooks = NewValidatorHooksRegistry()
 	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.PeggyKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.newRewardBatcher().Hooks(), app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.PeggyKeeper.Hooks(), app.ValidatorHooks.Hooks()),
 	)
 
 	// Create IBC Keeper
@@ -527,6 +614,9 @@ func NewInjectiveApp(
 		&app.OcrKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.OracleKeeper.SetMisbehaviorSlasher(newSlashingFractionsStakingKeeper(app.StakingKeeper, app.GetSubspace(SlashingFractionsParamsSubspace)))
+	app.OracleKeeper.SetStakingKeeper(app.StakingKeeper)
+	app.OracleKeeper.SetSlashingKeeper(app.SlashingKeeper)
 
 	app.OcrKeeper.SetHooks(ocrtypes.NewMultiOcrHooks(
 		app.OracleKeeper.Hooks(),
@@ -556,6 +646,22 @@ func NewInjectiveApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.AutoCompoundKeeper = autocompoundkeeper.NewKeeper(
+		appCodec,
+		keys[autocompoundtypes.StoreKey],
+		app.StakingKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.EpochsKeeper = epochskeeper.NewKeeper(
+		appCodec,
+		keys[epochstypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// modules subscribe to the epoch boundaries here
+	app.EpochsKeeper.SetHooks(epochstypes.NewMultiEpochHooks())
+
 	scopedOcrKeeper := app.CapabilityKeeper.ScopeToModule(ocrtypes.ModuleName)
 	app.ScopedOcrKeeper = scopedOcrKeeper
 
@@ -604,7 +710,7 @@ func NewInjectiveApp(
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		app.keys[tokenfactorytypes.StoreKey],
 		app.AccountKeeper,
-		app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
+		bankKeeper.WithTrackedMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
 		app.DistrKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -660,6 +766,7 @@ func NewInjectiveApp(
 	)
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
+	app.PeggyKeeper.SetTransferKeeper(app.TransferKeeper)
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	wasmDir := filepath.Join(homePath, "wasm")
@@ -676,7 +783,7 @@ func NewInjectiveApp(
 	wasmOpts := GetWasmOpts(appOpts)
 	wasmOpts = append(wasmOpts, wasmbinding.RegisterCustomPlugins(
 		&app.AuthzKeeper,
-		app.BankKeeper.(bankkeeper.BaseKeeper),
+		app.BankKeeper,
 		&app.ExchangeKeeper,
 		&app.FeeGrantKeeper,
 		&app.OracleKeeper,
@@ -731,6 +838,13 @@ func NewInjectiveApp(
 		app.MsgServiceRouter(),
 	)
 
+	app.IBCPacketLimitKeeper = ibcpacketlimitkeeper.NewKeeper(
+		appCodec,
+		keys[ibcpacketlimittypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Create Transfer Stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
@@ -743,6 +857,8 @@ func NewInjectiveApp(
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
+	// the packet size is checked first so that oversized packets are rejected before being processed
+	transferStack = ibcpacketlimit.NewIBCMiddleware(transferStack, app.IBCPacketLimitKeeper)
 
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
@@ -791,6 +907,7 @@ func NewInjectiveApp(
 
 	app.ExchangeKeeper.SetWasmKeepers(app.WasmKeeper, app.WasmxKeeper)
 	app.ExchangeKeeper.SetGovKeeper(govKeeper)
+	app.ExchangeKeeper.SetTVLTelemetryEnabled(app.Telemetry != nil && cast.ToBool(appOpts.Get(FlagTelemetryExchangeTVL)))
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter().
@@ -842,17 +959,29 @@ func NewInjectiveApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		// the bank module migrations require the BaseKeeper, its msg server doesn't mint or burn
+		bank.NewAppModule(appCodec, bankKeeper.BaseKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
-		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
-		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
+		newDowntimeGraceSlashingModule(
+			slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
+			app.UpgradeKeeper,
+			app.GetSubspace(DowntimeGraceParamsSubspace),
+		),
+		newRewardBatchingDistributionModule(
+			distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
+			app.newRewardBatcher(),
+		),
+		newRewardBatchingModule(app.newRewardBatcher()),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
 		upgrade.NewAppModule(app.UpgradeKeeper),
-		evidence.NewAppModule(app.EvidenceKeeper),
+		newEvidenceQueueModule(
+			evidence.NewAppModule(app.EvidenceKeeper),
+			app.newEvidenceQueue(),
+		),
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
@@ -874,6 +1003,9 @@ func NewInjectiveApp(
 			app.ExchangeKeeper,
 			app.GetSubspace(auctiontypes.ModuleName),
 		),
+		autocompound.NewAppModule(app.AutoCompoundKeeper),
+		epochs.NewAppModule(app.EpochsKeeper),
+		ibcpacketlimit.NewAppModule(app.IBCPacketLimitKeeper),
 		insurance.NewAppModule(
 			app.InsuranceKeeper,
 			app.AccountKeeper,
@@ -923,11 +1055,11 @@ func NewInjectiveApp(
 	// NOTE: upgrade module must go first to handle software upgrades.
 	// NOTE: staking module is required if HistoricalEntries param > 0.
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName,
-		genutiltypes.ModuleName, vestingtypes.ModuleName, govtypes.ModuleName, auctiontypes.ModuleName, peggytypes.ModuleName,
+		upgradetypes.ModuleName, epochstypes.ModuleName,
+		genutiltypes.ModuleName, vestingtypes.ModuleName, govtypes.ModuleName, auctiontypes.ModuleName, autocompoundtypes.ModuleName, ibcpacketlimittypes.ModuleName, peggytypes.ModuleName,
 		paramstypes.ModuleName, insurancetypes.ModuleName, authtypes.ModuleName, crisistypes.ModuleName,
 		feegrant.ModuleName, banktypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName, consensustypes.ModuleName,
-		capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
+		capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, RewardBatchingModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibcexported.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName,
 		ibchookstypes.ModuleName,
 		packetforwardtypes.ModuleName,
@@ -940,10 +1072,10 @@ func NewInjectiveApp(
 		paramstypes.ModuleName, authtypes.ModuleName,
 		feegrant.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName, consensustypes.ModuleName,
 		oracletypes.ModuleName, minttypes.ModuleName, slashingtypes.ModuleName, ibctransfertypes.ModuleName, evidencetypes.ModuleName,
-		capabilitytypes.ModuleName, distrtypes.ModuleName, ibcexported.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName,
+		capabilitytypes.ModuleName, distrtypes.ModuleName, RewardBatchingModuleName, ibcexported.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName,
 		upgradetypes.ModuleName,
-		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, peggytypes.ModuleName,
-		exchangetypes.ModuleName, auctiontypes.ModuleName, insurancetypes.ModuleName, ocrtypes.ModuleName,
+		crisistypes.ModuleName, govtypes.ModuleName, epochstypes.ModuleName, autocompoundtypes.ModuleName, stakingtypes.ModuleName, peggytypes.ModuleName,
+		exchangetypes.ModuleName, auctiontypes.ModuleName, insurancetypes.ModuleName, ocrtypes.ModuleName, ibcpacketlimittypes.ModuleName,
 		tokenfactorytypes.ModuleName, permissionsmodule.ModuleName, wasmtypes.ModuleName, ibchookstypes.ModuleName, packetforwardtypes.ModuleName,
 		wasmxtypes.ModuleName, banktypes.ModuleName,
 	)
@@ -955,13 +1087,16 @@ func NewInjectiveApp(
 	// can do so safely.
 	app.mm.SetOrderInitGenesis(
 		// SDK modules
-		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
+		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, RewardBatchingModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
 		ibcexported.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
 		paramstypes.ModuleName, authz.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, feegrant.ModuleName,
 		consensustypes.ModuleName, packetforwardtypes.ModuleName,
 		// Injective modules
 		auctiontypes.ModuleName,
+		autocompoundtypes.ModuleName,
+		epochstypes.ModuleName,
+		ibcpacketlimittypes.ModuleName,
 		oracletypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		permissionsmodule.ModuleName,
@@ -978,13 +1113,23 @@ func NewInjectiveApp(
 		crisistypes.ModuleName,
 	)
 
+	app.blockerManager = app.newBlockerManager(app.mm)
+
 	app.mm.RegisterInvariants(app.CrisisKeeper)
-	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	app.mm.RegisterServices(app.configurator)
+	app.CrisisKeeper.RegisterRoute(banktypes.ModuleName, SupplyConservationInvariantRoute, SupplyConservationInvariant(app.SupplyTracker))
+	// charge the events emitted by the msg handlers against the per block event budget
+	msgServer := newEventBudgetMsgServer(app.MsgServiceRouter(), tkeys[EventBudgetTStoreKey], cast.ToUint64(appOpts.Get(FlagEventBudget)))
+	app.configurator = module.NewConfigurator(app.appCodec, msgServer, app.GRPCQueryRouter())
+	app.registerServices(app.configurator)
 
 	// register upgrade handlers
 	app.registerUpgradeHandlers()
 
+	if disabledEndBlockers := parseDisabledEndBlockers(cast.ToString(appOpts.Get(FlagUnsafeDisabledEndBlockers))); len(disabledEndBlockers) > 0 {
+		app.SetDisabledEndBlockers(disabledEndBlockers...)
+	}
+
+	apptypes.RegisterQueryServer(app.GRPCQueryRouter(), newQueryServer(app))
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.mm.Modules))
 
 	reflectionSvc, err := runtimeservices.NewReflectionService()
@@ -1014,13 +1159,15 @@ func NewInjectiveApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 
 	// use Injective's custom AnteHandler
-	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper,
-			encodingConfig.TxConfig.SignModeHandler(), keys[wasmtypes.StoreKey],
-			wasmConfig, app.IBCKeeper,
-		),
+	app.anteHandler = ante.NewAnteHandler(
+		app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper,
+		encodingConfig.TxConfig.SignModeHandler(), keys[wasmtypes.StoreKey],
+		wasmConfig, app.IBCKeeper, app.StakingKeeper, app.GetSubspace(ante.ParamsSubspace),
+		cast.ToUint64(appOpts.Get(ante.FlagMaxMsgsPerTx)),
 	)
+	app.SetAnteHandler(app.anteHandler)
+	app.maxTxBytes = cast.ToUint64(appOpts.Get(FlagMaxTxBytes))
+	app.SetPostHandler(ante.NewPostHandler(cast.ToUint64(appOpts.Get(ante.FlagMaxGasRefund))))
 
 	app.SetEndBlocker(app.EndBlocker)
 
@@ -1062,14 +1209,24 @@ func (app *InjectiveApp) GetTxConfig() client.TxConfig { return MakeEncodingConf
 // Name returns the name of the App
 func (app *InjectiveApp) Name() string { return app.BaseApp.Name() }
 
-// BeginBlocker updates every begin block
+// BeginBlocker runs the begin blockers of all modules in the configured order, handing each
+// module a context whose logger carries the module field.
 func (app *InjectiveApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	return app.mm.BeginBlock(ctx, req)
+	// the supply is tracked in every block, so that the supply conservation invariant gives the same result on all
+	// nodes whatever their invariant check period, including when run by MsgVerifyInvariant
+	app.SupplyTracker.BeginBlock(ctx)
+
+	return app.blockerManager.BeginBlock(ctx, req)
 }
 
-// EndBlocker updates every end block
+// EndBlocker runs the end blockers of all modules in the configured order, handing each
+// module a context whose logger carries the module field.
 func (app *InjectiveApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	res := app.mm.EndBlock(ctx, req)
+	res := app.blockerManager.EndBlock(ctx, req)
+
+	// deposits may change in any EndBlocker, so the TVL gauge is only updated once all of them ran
+	app.ExchangeKeeper.UpdateTVLTelemetry(ctx)
+
 	return res
 }
 
@@ -1259,7 +1416,15 @@ func (app *InjectiveApp) registerUpgradeHandlers() {
 					},
 				}
 
-				for marketId, newOracleParams := range newMarketOracleParams {
+				// update the markets in market ID order, since the updates emit events
+				marketIds := make([]string, 0, len(newMarketOracleParams))
+				for marketId := range newMarketOracleParams {
+					marketIds = append(marketIds, marketId)
+				}
+				sort.Strings(marketIds)
+
+				for _, marketId := range marketIds {
+					newOracleParams := newMarketOracleParams[marketId]
 					market := app.ExchangeKeeper.GetDerivativeMarketByID(ctx, common.HexToHash(marketId))
 					if market == nil {
 						return nil, fmt.Errorf("can't find derivative market with ID: %s during upgrade", marketId)
@@ -1267,7 +1432,7 @@ func (app *InjectiveApp) registerUpgradeHandlers() {
 					// this will also update insurance fund oracle params
 					if err := app.ExchangeKeeper.UpdateDerivativeMarketParam(ctx, market.MarketID(),
 						&market.InitialMarginRatio, &market.MaintenanceMarginRatio, &market.MakerFeeRate, &market.TakerFeeRate, &market.RelayerFeeShareRate,
-						&market.MinPriceTickSize, &market.MinQuantityTickSize, nil, nil, market.Status, newOracleParams); err != nil {
+						&market.MinPriceTickSize, &market.MinQuantityTickSize, nil, nil, nil, nil, nil, nil, nil, market.Status, newOracleParams); err != nil {
 						return nil, err
 					}
 				}
@@ -1302,12 +1467,24 @@ func (app *InjectiveApp) registerUpgradeHandlers() {
 				return nil, err
 			}
 
-			exchangeParams := app.ExchangeKeeper.GetParams(ctx)
 			upgradeInfo, _ := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
-			exchangeParams.PostOnlyModeHeightThreshold = upgradeInfo.Height + 2000
-			feeAmount, _ := sdk.NewIntFromString("20000000000000000000")
-			exchangeParams.SpotMarketInstantListingFee = sdk.NewCoin(chaintypes.InjectiveCoin, feeAmount) // 20 INJ
-			app.ExchangeKeeper.SetParams(ctx, exchangeParams)
+			app.ExchangeKeeper.SetParams(ctx, upgradeExchangeParams(app.ExchangeKeeper.GetParams(ctx), upgradeInfo.Height))
+
+			// count the resting orders of every subaccount for the max open orders check
+			app.ExchangeKeeper.InitializeSubaccountOpenOrderCounts(ctx)
+			// count the conditional orders of every subaccount for the max conditional orders check
+			app.ExchangeKeeper.InitializeSubaccountConditionalOrderCounts(ctx)
+			// sum the deposits of every denom for the protocol stats
+			app.ExchangeKeeper.InitializeDenomTotalDeposits(ctx)
+			// count the pending withdrawals of every account for the max pending withdrawals check
+			app.PeggyKeeper.InitializePendingWithdrawalCounts(ctx)
+
+			// keep time based auction rounds without a reserve price, the current round keeps its terms
+			auctionParams := app.AuctionKeeper.GetParams(ctx)
+			auctionParams.AuctionDurationBlocks = auctiontypes.DefaultAuctionDurationBlocks
+			auctionParams.MinReservePrice = auctiontypes.DefaultMinReservePrice
+			app.AuctionKeeper.SetParams(ctx, auctionParams)
+			app.AuctionKeeper.SetReservePrice(ctx, sdk.ZeroInt())
 
 			// set DenomCreationFee to 0.1 INJ
 			tfParams := app.TokenFactoryKeeper.GetParams(ctx)
@@ -1357,6 +1534,11 @@ func (app *InjectiveApp) registerUpgradeHandlers() {
 				ibchookstypes.StoreKey,
 				packetforwardtypes.StoreKey,
 				permissionsmodule.StoreKey,
+				autocompoundtypes.StoreKey,
+				ibcpacketlimittypes.StoreKey,
+				epochstypes.StoreKey,
+				RewardBatchingStoreKey,
+				EvidenceQueueStoreKey,
 			},
 			Renamed: nil,
 			Deleted: nil,
@@ -1383,6 +1565,11 @@ func (app *InjectiveApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register grpc-gateway routes for the app-level queries.
+	if err := apptypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, apptypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -1433,6 +1620,12 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 	paramsKeeper.Subspace(permissionsmodule.ModuleName)
 	paramsKeeper.Subspace(wasmxtypes.ModuleName)
+	// app-level subspaces
+	paramsKeeper.Subspace(ante.ParamsSubspace).WithKeyTable(ante.ParamKeyTable())
+	paramsKeeper.Subspace(DowntimeGraceParamsSubspace).WithKeyTable(DowntimeGraceParamKeyTable())
+	paramsKeeper.Subspace(SlashingFractionsParamsSubspace).WithKeyTable(SlashingFractionsParamKeyTable())
+	paramsKeeper.Subspace(RewardBatchingParamsSubspace).WithKeyTable(RewardBatchingParamKeyTable())
+	paramsKeeper.Subspace(EvidenceQueueParamsSubspace).WithKeyTable(EvidenceQueueParamKeyTable())
 	return paramsKeeper
 }
 
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockRandomnessDomain separates the block randomness from any other hash of the same block data.
+const blockRandomnessDomain = "injective/block-randomness/v1"
+
+// BlockRandomness returns a 32 bytes randomness beacon for the current block, the SHA-256 of the hash of the previous
+// block and the current height. It's identical on every node for a given block, so modules can use it to shuffle or
+// sample deterministically.
+//
+// The beacon is not cryptographically unpredictable: the proposer of the previous block chooses its hash and can grind
+// it, and anyone can compute the beacon as soon as the previous block is committed. It must not be relied on where
+// biasing or predicting the outcome is worth an attack, e.g. lotteries with valuable prizes.
+func (app *InjectiveApp) BlockRandomness(ctx sdk.Context) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(blockRandomnessDomain))
+	hasher.Write(ctx.BlockHeader().LastBlockId.Hash)
+	hasher.Write(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	return hasher.Sum(nil)
+}
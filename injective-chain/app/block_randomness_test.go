@@ -0,0 +1,31 @@
+package app
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRandomness(t *testing.T) {
+	header := tmproto.Header{
+		Height:      100,
+		LastBlockId: tmproto.BlockID{Hash: []byte("previous block hash")},
+	}
+
+	// two runs of the same block on separate apps
+	firstApp := Setup(false)
+	randomness := firstApp.BlockRandomness(firstApp.BaseApp.NewContext(false, header))
+	require.Len(t, randomness, 32)
+
+	app := Setup(false)
+	require.Equal(t, randomness, app.BlockRandomness(app.BaseApp.NewContext(false, header)))
+
+	nextHeader := header
+	nextHeader.Height++
+	require.NotEqual(t, randomness, app.BlockRandomness(app.BaseApp.NewContext(false, nextHeader)))
+
+	forkHeader := header
+	forkHeader.LastBlockId = tmproto.BlockID{Hash: []byte("another block hash")}
+	require.NotEqual(t, randomness, app.BlockRandomness(app.BaseApp.NewContext(false, forkHeader)))
+}
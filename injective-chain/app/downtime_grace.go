@@ -0,0 +1,124 @@
+package app
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+// DowntimeGraceParamsSubspace is the name of the params subspace holding the number of blocks after an upgrade
+// during which validators do not accrue downtime. The value can be queried with
+// `injectived query params subspace downtime_grace DowntimeGraceBlocks` and updated through a parameter change proposal.
+const DowntimeGraceParamsSubspace = "downtime_grace"
+
+// MaxDowntimeGraceBlocks bounds the grace window so that liveness tracking cannot be disabled for long.
+const MaxDowntimeGraceBlocks = 10_000
+
+var _ paramtypes.ParamSet = &DowntimeGraceParams{}
+
+// Parameter keys
+var (
+	KeyDowntimeGraceBlocks = []byte("DowntimeGraceBlocks")
+)
+
+// DowntimeGraceParams defines the governance controlled downtime grace window.
+type DowntimeGraceParams struct {
+	// GraceBlocks is the number of blocks, starting at the height of the last applied upgrade, during which missed
+	// blocks are not recorded in the validators' signing info. Zero disables the grace window.
+	GraceBlocks uint64 `json:"grace_blocks" yaml:"grace_blocks"`
+}
+
+// DowntimeGraceParamKeyTable returns the parameter key table.
+func DowntimeGraceParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&DowntimeGraceParams{})
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *DowntimeGraceParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDowntimeGraceBlocks, &p.GraceBlocks, validateDowntimeGraceBlocks),
+	}
+}
+
+// Validate performs basic validation on the downtime grace parameters.
+func (p DowntimeGraceParams) Validate() error {
+	if err := validateDowntimeGraceBlocks(p.GraceBlocks); err != nil {
+		return fmt.Errorf("grace_blocks is incorrect: %w", err)
+	}
+
+	return nil
+}
+
+func validateDowntimeGraceBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxDowntimeGraceBlocks {
+		return fmt.Errorf("DowntimeGraceBlocks must be at most %d: %d", MaxDowntimeGraceBlocks, v)
+	}
+
+	return nil
+}
+
+// LastUpgradeGetter returns the name and height of the last applied upgrade.
+type LastUpgradeGetter interface {
+	GetLastCompletedUpgrade(ctx sdk.Context) (string, int64)
+}
+
+// downtimeGraceSlashingModule wraps the slashing module so that its BeginBlocker, which only tracks liveness,
+// sees every validator as having signed the last block while within the grace window after an upgrade.
+// Double-sign evidence is handled by the evidence module and is never suppressed.
+type downtimeGraceSlashingModule struct {
+	slashing.AppModule
+
+	upgradeKeeper LastUpgradeGetter
+	subspace      paramtypes.Subspace
+}
+
+func newDowntimeGraceSlashingModule(
+	slashingModule slashing.AppModule,
+	upgradeKeeper LastUpgradeGetter,
+	subspace paramtypes.Subspace,
+) downtimeGraceSlashingModule {
+	return downtimeGraceSlashingModule{
+		AppModule:     slashingModule,
+		upgradeKeeper: upgradeKeeper,
+		subspace:      subspace,
+	}
+}
+
+// BeginBlock implements the slashing BeginBlocker, suppressing missed blocks within the grace window.
+func (am downtimeGraceSlashingModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	if am.isWithinGraceWindow(ctx) {
+		votes := make([]abci.VoteInfo, len(req.LastCommitInfo.Votes))
+		for i, vote := range req.LastCommitInfo.Votes {
+			vote.SignedLastBlock = true
+			votes[i] = vote
+		}
+		req.LastCommitInfo.Votes = votes
+	}
+
+	am.AppModule.BeginBlock(ctx, req)
+}
+
+// isWithinGraceWindow returns true if the block is at most GraceBlocks - 1 blocks past the last applied upgrade.
+// It only depends on consensus state, so every node suppresses downtime on the same blocks.
+func (am downtimeGraceSlashingModule) isWithinGraceWindow(ctx sdk.Context) bool {
+	var graceBlocks uint64
+	am.subspace.GetIfExists(ctx, KeyDowntimeGraceBlocks, &graceBlocks)
+	if graceBlocks == 0 {
+		return false
+	}
+
+	_, upgradeHeight := am.upgradeKeeper.GetLastCompletedUpgrade(ctx)
+	if upgradeHeight == 0 || ctx.BlockHeight() < upgradeHeight {
+		return false
+	}
+
+	return uint64(ctx.BlockHeight()-upgradeHeight) < graceBlocks
+}
@@ -0,0 +1,94 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDowntimeGraceWindow(t *testing.T) {
+	app := Setup(false)
+	upgradeHeight := int64(10)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: upgradeHeight})
+
+	subspace := app.GetSubspace(DowntimeGraceParamsSubspace)
+	subspace.Set(ctx, KeyDowntimeGraceBlocks, uint64(5))
+
+	slashingModule := newDowntimeGraceSlashingModule(
+		slashing.NewAppModule(app.AppCodec(), app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
+		app.UpgradeKeeper,
+		subspace,
+	)
+
+	validators := app.StakingKeeper.GetLastValidators(ctx)
+	require.NotEmpty(t, validators)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	power := validators[0].ConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+
+	// the genesis validators of the test app are not bonded through the staking hooks, so they have no signing info
+	consPubKey, err := validators[0].ConsPubKey()
+	require.NoError(t, err)
+	require.NoError(t, app.SlashingKeeper.AddPubkey(ctx, consPubKey))
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+
+	missedBlocks := func() int64 {
+		info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		return info.MissedBlocksCounter
+	}
+
+	missBlock := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		slashingModule.BeginBlock(ctx, abci.RequestBeginBlock{
+			LastCommitInfo: abci.CommitInfo{
+				Votes: []abci.VoteInfo{{
+					Validator:       abci.Validator{Address: consAddr, Power: power},
+					SignedLastBlock: false,
+				}},
+			},
+		})
+	}
+
+	// no upgrade applied yet, downtime accrues
+	missBlock(upgradeHeight - 1)
+	require.Equal(t, int64(1), missedBlocks())
+
+	ctx = ctx.WithBlockHeight(upgradeHeight)
+	app.UpgradeKeeper.SetUpgradeHandler("downtime-grace-test", func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return fromVM, nil
+	})
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "downtime-grace-test", Height: upgradeHeight})
+
+	// within the grace window
+	for height := upgradeHeight; height < upgradeHeight+5; height++ {
+		missBlock(height)
+	}
+	require.Equal(t, int64(1), missedBlocks())
+
+	// outside of the grace window
+	missBlock(upgradeHeight + 5)
+	missBlock(upgradeHeight + 6)
+	require.Equal(t, int64(3), missedBlocks())
+
+	// a zero grace window disables the suppression
+	subspace.Set(ctx, KeyDowntimeGraceBlocks, uint64(0))
+	ctx = ctx.WithBlockHeight(upgradeHeight + 7)
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "downtime-grace-test", Height: upgradeHeight + 7})
+	missBlock(upgradeHeight + 7)
+	require.Equal(t, int64(4), missedBlocks())
+}
+
+func TestValidateDowntimeGraceBlocks(t *testing.T) {
+	require.NoError(t, DowntimeGraceParams{GraceBlocks: 0}.Validate())
+	require.NoError(t, DowntimeGraceParams{GraceBlocks: MaxDowntimeGraceBlocks}.Validate())
+	require.Error(t, DowntimeGraceParams{GraceBlocks: MaxDowntimeGraceBlocks + 1}.Validate())
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	// FlagEventBudget is the app option holding the maximum number of events the messages of a block
+	// may emit. Zero means unlimited. The budget is enforced during tx execution, so all validators
+	// must use the same value.
+	FlagEventBudget = "event-budget"
+
+	// EventBudgetTStoreKey is the transient store holding the number of events emitted in the current block.
+	EventBudgetTStoreKey = "transient_event_budget"
+
+	eventBudgetCodespace = "event_budget"
+)
+
+var (
+	ErrEventBudgetExceeded = errorsmod.Register(eventBudgetCodespace, 2, "event budget exceeded")
+
+	eventCountKey = []byte{0x01}
+)
+
+// eventBudgetMsgServer registers the msg services on the msg service router, wrapping every
+// handler so that the events it emits are charged against the per block event budget.
+type eventBudgetMsgServer struct {
+	router   gogogrpc.Server
+	storeKey storetypes.StoreKey
+	budget   uint64
+}
+
+func newEventBudgetMsgServer(router gogogrpc.Server, storeKey storetypes.StoreKey, budget uint64) gogogrpc.Server {
+	if budget == 0 {
+		return router
+	}
+
+	return &eventBudgetMsgServer{
+		router:   router,
+		storeKey: storeKey,
+		budget:   budget,
+	}
+}
+
+func (s *eventBudgetMsgServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	wrapped := *sd
+	wrapped.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				// the router invokes the handler without a server to resolve the request type
+				if srv == nil {
+					return handler(srv, ctx, dec, interceptor)
+				}
+
+				sdkCtx := sdk.UnwrapSDKContext(ctx)
+				startEvents := len(sdkCtx.EventManager().Events())
+				startCount := s.eventCount(sdkCtx)
+
+				res, err := handler(srv, ctx, dec, interceptor)
+				if err != nil {
+					return res, err
+				}
+
+				emitted := uint64(len(sdkCtx.EventManager().Events()) - startEvents)
+				if err := s.chargeEvents(sdkCtx, startCount, emitted); err != nil {
+					return nil, err
+				}
+
+				return res, nil
+			},
+		}
+	}
+
+	s.router.RegisterService(&wrapped, ss)
+}
+
+// chargeEvents adds the events emitted by the message to the events emitted in the block so far and
+// fails once the budget is exceeded. Nested messages, e.g. executed by authz or wasm, are charged by
+// their own handler and their events are emitted again by the outer message, so the outer message
+// is only charged for the events beyond those. The count lives in a transient store, so events of
+// failed txs are not charged and every node accounts the same events.
+func (s *eventBudgetMsgServer) chargeEvents(ctx sdk.Context, startCount, emitted uint64) error {
+	count := s.eventCount(ctx)
+	if startCount+emitted > count {
+		count = startCount + emitted
+	}
+
+	if count > s.budget {
+		return errorsmod.Wrapf(ErrEventBudgetExceeded, "block emitted %d events, the budget is %d", count, s.budget)
+	}
+
+	ctx.TransientStore(s.storeKey).Set(eventCountKey, sdk.Uint64ToBigEndian(count))
+	return nil
+}
+
+// eventCount returns the number of events charged in the block so far.
+func (s *eventBudgetMsgServer) eventCount(ctx sdk.Context) uint64 {
+	bz := ctx.TransientStore(s.storeKey).Get(eventCountKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
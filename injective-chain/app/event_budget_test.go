@@ -0,0 +1,74 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBudget(t *testing.T) {
+	newMultiSend := func(app *InjectiveApp, ctx sdk.Context, outputs int) *banktypes.MsgMultiSend {
+		sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		total := sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(int64(outputs))))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, total))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, total))
+
+		msg := &banktypes.MsgMultiSend{
+			Inputs: []banktypes.Input{banktypes.NewInput(sender, total)},
+		}
+		for i := 0; i < outputs; i++ {
+			recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			msg.Outputs = append(msg.Outputs, banktypes.NewOutput(recipient, sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1)))))
+		}
+		return msg
+	}
+
+	deliver := func(app *InjectiveApp, ctx sdk.Context, msg sdk.Msg) error {
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+
+	header := tmproto.Header{Height: 2, ChainID: "injective-777", Time: time.Now().UTC()}
+
+	t.Run("fails the message past the budget", func(t *testing.T) {
+		app := SetupWithAppOptions(false, TestAppOptions{Values: map[string]interface{}{FlagEventBudget: uint64(50)}})
+		ctx := app.BaseApp.NewContext(false, header)
+
+		require.NoError(t, deliver(app, ctx, newMultiSend(app, ctx, 10)))
+
+		err := deliver(app, ctx, newMultiSend(app, ctx, 20))
+		require.ErrorIs(t, err, ErrEventBudgetExceeded)
+	})
+
+	t.Run("charges the events of nested messages once", func(t *testing.T) {
+		eventCount := func(app *InjectiveApp, ctx sdk.Context) uint64 {
+			return sdk.BigEndianToUint64(ctx.TransientStore(app.GetTKey(EventBudgetTStoreKey)).Get(eventCountKey))
+		}
+
+		app := SetupWithAppOptions(false, TestAppOptions{Values: map[string]interface{}{FlagEventBudget: uint64(1000)}})
+		ctx := app.BaseApp.NewContext(false, header)
+		require.NoError(t, deliver(app, ctx, newMultiSend(app, ctx, 10)))
+		multiSendEvents := eventCount(app, ctx)
+
+		app = SetupWithAppOptions(false, TestAppOptions{Values: map[string]interface{}{FlagEventBudget: uint64(1000)}})
+		ctx = app.BaseApp.NewContext(false, header)
+		multiSend := newMultiSend(app, ctx, 10)
+		exec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(multiSend.Inputs[0].Address), []sdk.Msg{multiSend})
+		require.NoError(t, deliver(app, ctx, &exec))
+		require.Equal(t, multiSendEvents, eventCount(app, ctx))
+	})
+
+	t.Run("zero means unlimited", func(t *testing.T) {
+		app := Setup(false)
+		ctx := app.BaseApp.NewContext(false, header)
+
+		require.NoError(t, deliver(app, ctx, newMultiSend(app, ctx, 100)))
+	})
+}
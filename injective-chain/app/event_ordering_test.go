@@ -0,0 +1,109 @@
+package app
+
+import (
+	"math/rand"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// deliverMultiEventTx delivers, on a fresh app, a tx with a bank multi send and an exchange deposit, the
+// deposit emitting both bank and exchange events in a single message, and returns the tx events.
+func deliverMultiEventTx(t *testing.T) []abci.Event {
+	app := Setup(false)
+	encodingConfig := MakeEncodingConfig()
+
+	senderKey := secp256k1.GenPrivKeyFromSecret([]byte("event ordering sender"))
+	sender := sdk.AccAddress(senderKey.PubKey().Address())
+	recipients := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("event ordering recipient 1")).PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("event ordering recipient 2")).PubKey().Address()),
+	}
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	ctx := app.NewContext(false, header)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, sender))
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("inj", 1_000_000))))
+	senderAccount := app.AccountKeeper.GetAccount(ctx, sender)
+
+	coin := sdk.NewInt64Coin("inj", 1000)
+	msgs := []sdk.Msg{
+		&banktypes.MsgMultiSend{
+			Inputs: []banktypes.Input{banktypes.NewInput(sender, sdk.NewCoins(coin.Add(coin)))},
+			Outputs: []banktypes.Output{
+				banktypes.NewOutput(recipients[0], sdk.NewCoins(coin)),
+				banktypes.NewOutput(recipients[1], sdk.NewCoins(coin)),
+			},
+		},
+		&exchangetypes.MsgDeposit{
+			Sender:       sender.String(),
+			SubaccountId: exchangetypes.MustSdkAddressWithNonceToSubaccountID(sender, 1).Hex(),
+			Amount:       coin,
+		},
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		encodingConfig.TxConfig,
+		msgs,
+		sdk.NewCoins(sdk.NewInt64Coin("inj", 100)),
+		simtestutil.DefaultGenTxGas,
+		header.ChainID,
+		[]uint64{senderAccount.GetAccountNumber()},
+		[]uint64{senderAccount.GetSequence()},
+		senderKey,
+	)
+	require.NoError(t, err)
+
+	txBytes, err := encodingConfig.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	return res.Events
+}
+
+func TestTxEventOrderingIsDeterministic(t *testing.T) {
+	events := deliverMultiEventTx(t)
+	require.Equal(t, events, deliverMultiEventTx(t))
+
+	// events follow execution order: the ante handler events first, then for each message its message event
+	// followed by the events emitted while executing it
+	eventIndex := func(eventType, attributeKey, attributeValue string) int {
+		for i, event := range events {
+			if event.Type != eventType {
+				continue
+			}
+			if attributeKey == "" {
+				return i
+			}
+			for _, attribute := range event.Attributes {
+				if attribute.Key == attributeKey && attribute.Value == attributeValue {
+					return i
+				}
+			}
+		}
+		return -1
+	}
+
+	feeEvent := eventIndex(sdk.EventTypeTx, sdk.AttributeKeyFee, "100inj")
+	multiSendEvent := eventIndex(sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	depositMsgEvent := eventIndex(sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&exchangetypes.MsgDeposit{}))
+	depositEvent := eventIndex(proto.MessageName(&exchangetypes.EventSubaccountDeposit{}), "", "")
+
+	require.NotEqual(t, -1, feeEvent)
+	require.Less(t, feeEvent, multiSendEvent)
+	require.Less(t, multiSendEvent, depositMsgEvent)
+	require.Less(t, depositMsgEvent, depositEvent)
+}
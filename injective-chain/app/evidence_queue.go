@@ -0,0 +1,202 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// EvidenceQueueParamsSubspace is the name of the params subspace holding the max number of evidence handled per
+	// block. The value can be queried with `injectived query params subspace evidence_queue EvidencePerBlock` and
+	// updated through a parameter change proposal.
+	EvidenceQueueParamsSubspace = "evidence_queue"
+
+	// EvidenceQueueStoreKey is the store holding the evidence deferred to the next blocks. Store keys can't be prefixes
+	// of each other, so the key doesn't start with the one of the evidence module.
+	EvidenceQueueStoreKey = "deferredevidence"
+
+	// EventTypeEvidenceDeferred is the type of the event emitted when evidence is deferred to the next blocks.
+	EventTypeEvidenceDeferred = "evidence_deferred"
+
+	AttributeKeyHandledEvidence  = "handled"
+	AttributeKeyDeferredEvidence = "deferred"
+)
+
+var _ paramtypes.ParamSet = &EvidenceQueueParams{}
+
+// Parameter keys
+var (
+	KeyEvidencePerBlock = []byte("EvidencePerBlock")
+)
+
+// deferredEvidencePrefix prefixes the deferred evidence, keyed by the height at which it was received and its hash
+var deferredEvidencePrefix = []byte{0x01}
+
+// EvidenceQueueParams defines the governance controlled bound on the evidence handled per block.
+type EvidenceQueueParams struct {
+	// EvidencePerBlock is the max number of evidence handled in a block, the remaining evidence is deferred to the next
+	// blocks. Zero handles all the evidence of a block.
+	EvidencePerBlock uint64 `json:"evidence_per_block" yaml:"evidence_per_block"`
+}
+
+// EvidenceQueueParamKeyTable returns the parameter key table.
+func EvidenceQueueParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&EvidenceQueueParams{})
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *EvidenceQueueParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEvidencePerBlock, &p.EvidencePerBlock, validateEvidencePerBlock),
+	}
+}
+
+// Validate performs basic validation on the evidence queue parameters.
+func (p EvidenceQueueParams) Validate() error {
+	if err := validateEvidencePerBlock(p.EvidencePerBlock); err != nil {
+		return fmt.Errorf("evidence_per_block is incorrect: %w", err)
+	}
+
+	return nil
+}
+
+func validateEvidencePerBlock(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// evidenceHash returns the hash of the evidence as computed by the evidence module.
+func evidenceHash(misbehavior abci.Misbehavior) []byte {
+	return evidencetypes.FromABCIEvidence(misbehavior).Hash()
+}
+
+// sortEvidenceByHash sorts the evidence of a block by ascending hash, so that the order in which it is handled does not
+// depend on the order chosen by the proposer.
+func sortEvidenceByHash(misbehaviors []abci.Misbehavior) []abci.Misbehavior {
+	sorted := append([]abci.Misbehavior{}, misbehaviors...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(evidenceHash(sorted[i]), evidenceHash(sorted[j])) < 0
+	})
+	return sorted
+}
+
+// evidenceQueue bounds the evidence handled per block. The evidence of a block is handled in ascending hash order and,
+// beyond EvidencePerBlock, queued in state to be handled first in the next blocks, oldest block first and by hash within
+// a block, so every node handles the same evidence in the same order.
+type evidenceQueue struct {
+	storeKey storetypes.StoreKey
+	subspace paramtypes.Subspace
+}
+
+func (app *InjectiveApp) newEvidenceQueue() evidenceQueue {
+	return evidenceQueue{
+		storeKey: app.keys[EvidenceQueueStoreKey],
+		subspace: app.GetSubspace(EvidenceQueueParamsSubspace),
+	}
+}
+
+func (q evidenceQueue) evidencePerBlock(ctx sdk.Context) uint64 {
+	var evidencePerBlock uint64
+	q.subspace.GetIfExists(ctx, KeyEvidencePerBlock, &evidencePerBlock)
+	return evidencePerBlock
+}
+
+// nextEvidence returns the evidence to handle in the block, given the new evidence of the block, and queues the rest.
+// An event is emitted when evidence is left in the queue.
+func (q evidenceQueue) nextEvidence(ctx sdk.Context, misbehaviors []abci.Misbehavior) []abci.Misbehavior {
+	evidencePerBlock := q.evidencePerBlock(ctx)
+	queueStore := prefix.NewStore(ctx.KVStore(q.storeKey), deferredEvidencePrefix)
+	newEvidence := sortEvidenceByHash(misbehaviors)
+
+	if !hasQueuedEvidence(queueStore) && (evidencePerBlock == 0 || uint64(len(newEvidence)) <= evidencePerBlock) {
+		return newEvidence
+	}
+
+	// the new evidence is queued behind the evidence deferred in the previous blocks
+	heightKey := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
+	for _, misbehavior := range newEvidence {
+		bz, err := misbehavior.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		queueStore.Set(append(append([]byte{}, heightKey...), evidenceHash(misbehavior)...), bz)
+	}
+
+	handled := make([]abci.Misbehavior, 0)
+	handledKeys := make([][]byte, 0)
+	var deferred int
+
+	iterator := queueStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if evidencePerBlock > 0 && uint64(len(handled)) == evidencePerBlock {
+			deferred++
+			continue
+		}
+
+		var misbehavior abci.Misbehavior
+		if err := misbehavior.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		handled = append(handled, misbehavior)
+		handledKeys = append(handledKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range handledKeys {
+		queueStore.Delete(key)
+	}
+
+	if deferred > 0 {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeEvidenceDeferred,
+			sdk.NewAttribute(AttributeKeyHandledEvidence, strconv.Itoa(len(handled))),
+			sdk.NewAttribute(AttributeKeyDeferredEvidence, strconv.Itoa(deferred)),
+		))
+	}
+
+	return handled
+}
+
+func hasQueuedEvidence(queueStore prefix.Store) bool {
+	iterator := queueStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// evidenceQueueModule wraps the evidence module so that its BeginBlocker handles the evidence through the evidence
+// queue. Deferred evidence is still subject to the max evidence age of the consensus params when handled.
+type evidenceQueueModule struct {
+	evidence.AppModule
+
+	queue evidenceQueue
+}
+
+func newEvidenceQueueModule(
+	evidenceModule evidence.AppModule,
+	queue evidenceQueue,
+) evidenceQueueModule {
+	return evidenceQueueModule{
+		AppModule: evidenceModule,
+		queue:     queue,
+	}
+}
+
+// BeginBlock implements the evidence BeginBlocker, handling the queued and new evidence within the per block bound.
+func (am evidenceQueueModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	req.ByzantineValidators = am.queue.nextEvidence(ctx, req.ByzantineValidators)
+	am.AppModule.BeginBlock(ctx, req)
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvidenceQueue(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	queue := app.newEvidenceQueue()
+
+	newEvidence := func(count int) []abci.Misbehavior {
+		misbehaviors := make([]abci.Misbehavior, 0, count)
+		for i := 0; i < count; i++ {
+			misbehaviors = append(misbehaviors, abci.Misbehavior{
+				Type:             abci.MisbehaviorType_DUPLICATE_VOTE,
+				Validator:        abci.Validator{Address: ed25519.GenPrivKey().PubKey().Address(), Power: 10},
+				Height:           5,
+				Time:             time.Unix(1_700_000_000, 0).UTC(),
+				TotalVotingPower: 100,
+			})
+		}
+		return misbehaviors
+	}
+
+	hashes := func(misbehaviors []abci.Misbehavior) [][]byte {
+		hashes := make([][]byte, 0, len(misbehaviors))
+		for _, misbehavior := range misbehaviors {
+			hashes = append(hashes, evidenceHash(misbehavior))
+		}
+		return hashes
+	}
+
+	reversed := func(misbehaviors []abci.Misbehavior) []abci.Misbehavior {
+		result := make([]abci.Misbehavior, 0, len(misbehaviors))
+		for i := len(misbehaviors) - 1; i >= 0; i-- {
+			result = append(result, misbehaviors[i])
+		}
+		return result
+	}
+
+	// nextBlock returns the evidence handled in the next block and the number of deferred evidence reported by the event
+	nextBlock := func(misbehaviors []abci.Misbehavior) ([]abci.Misbehavior, string) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		handled := queue.nextEvidence(ctx, misbehaviors)
+
+		var deferred string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != EventTypeEvidenceDeferred {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == AttributeKeyDeferredEvidence {
+					deferred = attr.Value
+				}
+			}
+		}
+		return handled, deferred
+	}
+
+	t.Run("handles the evidence by hash regardless of the proposer order", func(t *testing.T) {
+		misbehaviors := newEvidence(5)
+
+		handled, deferred := nextBlock(misbehaviors)
+		require.Empty(t, deferred)
+		require.Len(t, handled, 5)
+		for i := 1; i < len(handled); i++ {
+			require.Negative(t, bytes.Compare(evidenceHash(handled[i-1]), evidenceHash(handled[i])))
+		}
+
+		handledReversed, _ := nextBlock(reversed(misbehaviors))
+		require.Equal(t, hashes(handled), hashes(handledReversed))
+	})
+
+	t.Run("defers the evidence beyond the per block cap", func(t *testing.T) {
+		app.GetSubspace(EvidenceQueueParamsSubspace).Set(ctx, KeyEvidencePerBlock, uint64(2))
+
+		firstEvidence := sortEvidenceByHash(newEvidence(5))
+		secondEvidence := newEvidence(1)
+
+		handled, deferred := nextBlock(reversed(firstEvidence))
+		require.Equal(t, hashes(firstEvidence[:2]), hashes(handled))
+		require.Equal(t, "3", deferred)
+
+		// the evidence deferred from the previous block is handled before the new evidence
+		handled, deferred = nextBlock(secondEvidence)
+		require.Equal(t, hashes(firstEvidence[2:4]), hashes(handled))
+		require.Equal(t, "2", deferred)
+
+		handled, deferred = nextBlock(nil)
+		require.Equal(t, hashes(append([]abci.Misbehavior{firstEvidence[4]}, secondEvidence...)), hashes(handled))
+		require.Empty(t, deferred)
+
+		handled, deferred = nextBlock(nil)
+		require.Empty(t, handled)
+		require.Empty(t, deferred)
+	})
+}
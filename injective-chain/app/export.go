@@ -83,6 +83,9 @@ func (app *InjectiveApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAd
 
 	/* Handle fee distribution state. */
 
+	// credit the rewards still pending from a batched cycle
+	app.newRewardBatcher().creditAllPendingRewards(ctx)
+
 	// withdraw all validator commission
 	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
 		_, _ = app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
@@ -170,7 +173,7 @@ func (app *InjectiveApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAd
 	counter := int16(0)
 
 	for ; iter.Valid(); iter.Next() {
-		addr := sdk.ValAddress(iter.Key()[1:])
+		addr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(iter.Key()))
 		validator, found := app.StakingKeeper.GetValidator(ctx, addr)
 		if !found {
 			return fmt.Errorf("expected validator %s not found", addr)
@@ -0,0 +1,15 @@
+package app
+
+import (
+	"io"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ExportOrderbookCSV writes the resting limit orders of an exchange market in the last committed state to w as
+// CSV, see the exchange keeper's ExportOrderbookCSV for the format.
+func (app *InjectiveApp) ExportOrderbookCSV(marketID common.Hash, w io.Writer) error {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	return app.ExchangeKeeper.ExportOrderbookCSV(ctx, marketID, w)
+}
@@ -0,0 +1,102 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZeroHeightExportIsByteIdentical(t *testing.T) {
+	db := dbm.NewMemDB()
+	newApp := func() *InjectiveApp {
+		return NewInjectiveApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), simtestutil.EmptyAppOptions{})
+	}
+	app := newApp()
+
+	validators := make([]*tmtypes.Validator, 0, 3)
+	for i := 0; i < 3; i++ {
+		pubKey, err := mock.NewPV().GetPubKey()
+		require.NoError(t, err)
+		validators = append(validators, tmtypes.NewValidator(pubKey, 1))
+	}
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), NewDefaultGenesisState(), tmtypes.NewValidatorSet(validators), []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+
+	// the helper only funds the bonded pool with the bonded tokens of a single validator
+	var bankGenesis banktypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	for i := range bankGenesis.Balances {
+		if bankGenesis.Balances[i].Address == bondedPool {
+			bankGenesis.Balances[i].Coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.DefaultPowerReduction.MulRaw(int64(len(validators)))))
+		}
+	}
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(&bankGenesis)
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	app.Commit()
+
+	// add unbonding delegations and redelegations to the state, which carry heights zeroed by the export
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	bondedValidators := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(t, bondedValidators, 3)
+
+	for i, validator := range bondedValidators {
+		_, err = app.StakingKeeper.Undelegate(ctx, acc.GetAddress(), validator.GetOperator(), sdk.NewDecWithPrec(1, 1))
+		require.NoError(t, err)
+
+		if i > 0 {
+			_, err = app.StakingKeeper.BeginRedelegation(ctx, acc.GetAddress(), bondedValidators[0].GetOperator(), validator.GetOperator(), sdk.NewDecWithPrec(2, 1))
+			require.NoError(t, err)
+		}
+	}
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// two nodes load the same committed state and export it for zero height
+	exported, err := newApp().ExportAppStateAndValidators(true, []string{}, []string{})
+	require.NoError(t, err)
+	exportedAgain, err := newApp().ExportAppStateAndValidators(true, []string{}, []string{})
+	require.NoError(t, err)
+
+	var appState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+	var stakingGenesis stakingtypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenesis)
+	require.Len(t, stakingGenesis.Validators, 3)
+	require.Len(t, stakingGenesis.UnbondingDelegations, 3)
+	require.Len(t, stakingGenesis.Redelegations, 2)
+
+	require.Equal(t, string(exported.AppState), string(exportedAgain.AppState))
+	require.Equal(t, exported.Validators, exportedAgain.Validators)
+	require.Equal(t, exported.ConsensusParams, exportedAgain.ConsensusParams)
+	require.Equal(t, exported.Height, exportedAgain.Height)
+}
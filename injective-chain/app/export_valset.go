@@ -0,0 +1,57 @@
+package app
+
+import (
+	"encoding/json"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// ValidatorInfo is the lightweight view of a bonded validator returned by ExportValidatorSet.
+type ValidatorInfo struct {
+	OperatorAddress string          `json:"operator_address"`
+	ConsensusPubkey json.RawMessage `json:"consensus_pubkey"`
+	Tokens          sdkmath.Int     `json:"tokens"`
+	Power           int64           `json:"power"`
+	Status          string          `json:"status"`
+}
+
+// ExportValidatorSet exports the bonded validator set from the last committed state, sorted by
+// descending power and then by operator address. Unlike ExportAppStateAndValidators it only reads
+// the staking store, so it is cheap enough to be used for monitoring.
+func (app *InjectiveApp) ExportValidatorSet() ([]ValidatorInfo, error) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	powerReduction := app.StakingKeeper.PowerReduction(ctx)
+
+	validators := app.StakingKeeper.GetLastValidators(ctx)
+	validatorSet := make([]ValidatorInfo, 0, len(validators))
+	for _, validator := range validators {
+		pubKey, err := validator.ConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		pubKeyJSON, err := app.appCodec.MarshalInterfaceJSON(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		validatorSet = append(validatorSet, ValidatorInfo{
+			OperatorAddress: validator.OperatorAddress,
+			ConsensusPubkey: pubKeyJSON,
+			Tokens:          validator.Tokens,
+			Power:           validator.ConsensusPower(powerReduction),
+			Status:          validator.Status.String(),
+		})
+	}
+
+	sort.SliceStable(validatorSet, func(i, j int) bool {
+		if validatorSet[i].Power != validatorSet[j].Power {
+			return validatorSet[i].Power > validatorSet[j].Power
+		}
+		return validatorSet[i].OperatorAddress < validatorSet[j].OperatorAddress
+	})
+
+	return validatorSet, nil
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportValidatorSet(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	validatorSet, err := app.ExportValidatorSet()
+	require.NoError(t, err)
+
+	lastValidators := app.StakingKeeper.GetLastValidators(ctx)
+	require.NotEmpty(t, lastValidators)
+	require.Len(t, validatorSet, len(lastValidators))
+
+	for i, info := range validatorSet {
+		if i > 0 {
+			prev := validatorSet[i-1]
+			require.True(t, prev.Power > info.Power || (prev.Power == info.Power && prev.OperatorAddress < info.OperatorAddress))
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(info.OperatorAddress)
+		require.NoError(t, err)
+
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		require.True(t, validator.Tokens.Equal(info.Tokens))
+		require.Equal(t, validator.Status.String(), info.Status)
+		require.Equal(t, app.StakingKeeper.GetLastValidatorPower(ctx, valAddr), info.Power)
+
+		var pubKey cryptotypes.PubKey
+		require.NoError(t, app.AppCodec().UnmarshalInterfaceJSON(info.ConsensusPubkey, &pubKey))
+
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		require.Equal(t, consAddr.Bytes(), pubKey.Address().Bytes())
+	}
+}
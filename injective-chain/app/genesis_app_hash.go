@@ -0,0 +1,52 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+// ComputeGenesisAppHash returns the app hash of the first commit of a chain started from the genesis state, i.e.
+// the state right after InitChain. The chain is initialized by a separate app backed by an in-memory DB and a
+// temporary home directory, which are both discarded, so the state of any other app is never modified.
+//
+// The chain is initialized with an empty chain ID, a zero genesis time and the default CometBFT consensus params, so
+// the hash is meant to compare genesis states with each other, e.g. to assert determinism across branches.
+func ComputeGenesisAppHash(genesis GenesisState) (appHash []byte, err error) {
+	stateBytes, err := json.Marshal(genesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode genesis state: %w", err)
+	}
+
+	homeDir, err := os.MkdirTemp("", "injective-genesis-app-hash")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(homeDir)
+
+	db := dbm.NewMemDB()
+	defer db.Close()
+
+	app := NewInjectiveApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, homeDir, 0, MakeEncodingConfig(), simtestutil.EmptyAppOptions{})
+
+	// invalid genesis states make the modules panic in InitGenesis
+	defer func() {
+		if r := recover(); r != nil {
+			appHash, err = nil, fmt.Errorf("failed to init chain: %v", r)
+		}
+	}()
+
+	consensusParams := tmtypes.DefaultConsensusParams().ToProto()
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	return app.Commit().Data, nil
+}
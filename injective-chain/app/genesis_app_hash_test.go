@@ -0,0 +1,48 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	auctiontypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+)
+
+func TestComputeGenesisAppHash(t *testing.T) {
+	cdc := MakeEncodingConfig().Marshaler
+
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	senderPubKey := secp256k1.GenPrivKey().PubKey()
+	acc := authtypes.NewBaseAccount(senderPubKey.Address().Bytes(), senderPubKey, 0, 0)
+
+	genesis, err := simtestutil.GenesisStateWithValSet(cdc, NewDefaultGenesisState(), valSet, []authtypes.GenesisAccount{acc})
+	require.NoError(t, err)
+
+	hash, err := ComputeGenesisAppHash(genesis)
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+
+	sameHash, err := ComputeGenesisAppHash(genesis)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash, "the same genesis should yield the same app hash")
+
+	var auctionGenesis auctiontypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[auctiontypes.ModuleName], &auctionGenesis)
+	auctionGenesis.Params.AuctionPeriod++
+
+	otherGenesis := make(GenesisState, len(genesis))
+	for name, state := range genesis {
+		otherGenesis[name] = state
+	}
+	otherGenesis[auctiontypes.ModuleName] = cdc.MustMarshalJSON(&auctionGenesis)
+
+	otherHash, err := ComputeGenesisAppHash(otherGenesis)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash, "a different genesis should yield a different app hash")
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// GenesisChecksum returns the hex encoded SHA-256 checksum of the genesis state. The state is hashed in its canonical
+// JSON encoding, i.e. compacted with the object keys sorted at every level and the numbers kept as written, so that
+// genesis files only differing in key ordering or whitespace have the same checksum. The ordering of lists is part of
+// the state and changes the checksum.
+func GenesisChecksum(genesis GenesisState) string {
+	// encoding the raw module states validates and compacts them
+	bz, err := json.Marshal(genesis)
+	if err != nil {
+		panic(err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var state interface{}
+	if err := decoder.Decode(&state); err != nil {
+		panic(err)
+	}
+
+	checksum := sha256.Sum256([]byte(canonicalJSON(state)))
+	return hex.EncodeToString(checksum[:])
+}
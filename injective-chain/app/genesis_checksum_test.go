@@ -0,0 +1,40 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisChecksum(t *testing.T) {
+	genesis := GenesisState{
+		"bank":     json.RawMessage(`{"params":{"default_send_enabled":true,"send_enabled":[]},"balances":[{"address":"inj1a","coins":[{"denom":"inj","amount":"10"}]}]}`),
+		"exchange": json.RawMessage(`{"params":{"spot_market_instant_listing_fee":{"denom":"inj","amount":"1000"},"default_spot_maker_fee_rate":"0.001000000000000000"}}`),
+	}
+
+	// the same state with the keys in another order and another formatting
+	reorderedGenesis := GenesisState{
+		"exchange": json.RawMessage(`{
+			"params": {
+				"default_spot_maker_fee_rate": "0.001000000000000000",
+				"spot_market_instant_listing_fee": {"amount": "1000", "denom": "inj"}
+			}
+		}`),
+		"bank": json.RawMessage(`{"balances":[{"coins":[{"amount":"10","denom":"inj"}],"address":"inj1a"}],"params":{"send_enabled":[],"default_send_enabled":true}}`),
+	}
+
+	checksum := GenesisChecksum(genesis)
+	require.Len(t, checksum, 64)
+	require.Equal(t, checksum, GenesisChecksum(reorderedGenesis))
+
+	defaultChecksum := GenesisChecksum(NewDefaultGenesisState())
+	require.Equal(t, defaultChecksum, GenesisChecksum(NewDefaultGenesisState()))
+	require.NotEqual(t, checksum, defaultChecksum)
+
+	changedGenesis := GenesisState{
+		"bank":     genesis["bank"],
+		"exchange": json.RawMessage(`{"params":{"spot_market_instant_listing_fee":{"denom":"inj","amount":"1001"},"default_spot_maker_fee_rate":"0.001000000000000000"}}`),
+	}
+	require.NotEqual(t, checksum, GenesisChecksum(changedGenesis))
+}
@@ -0,0 +1,264 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// genesisTypeRecorder is a JSON codec recording the type of the last message encoded through MustMarshalJSON,
+// which the modules encode their default genesis with.
+type genesisTypeRecorder struct {
+	codec.JSONCodec
+	messageType reflect.Type
+}
+
+func (r *genesisTypeRecorder) MustMarshalJSON(o proto.Message) []byte {
+	r.messageType = reflect.TypeOf(o)
+	return r.JSONCodec.MustMarshalJSON(o)
+}
+
+// jsonGenesisModuleBasic is implemented by the module basics encoding their genesis with encoding/json instead of
+// the codec.
+type jsonGenesisModuleBasic interface {
+	newJSONGenesisState() interface{}
+}
+
+// genesisTypes holds the typed genesis state of each module of ModuleBasics with one.
+type genesisTypes struct {
+	// types of the genesis states encoded with the codec
+	proto map[string]reflect.Type
+	// constructors of the genesis states encoded with encoding/json
+	json map[string]func() interface{}
+}
+
+// newGenesisTypes returns the typed genesis state of each module of ModuleBasics. The type of the genesis states
+// encoded with the codec is the type of the message the module encodes its default genesis from. The genesis of
+// the modules without a typed genesis state, such as upgrade or vesting, is a fixed placeholder.
+func newGenesisTypes(cdc codec.JSONCodec) genesisTypes {
+	types := genesisTypes{
+		proto: make(map[string]reflect.Type, len(ModuleBasics)),
+		json:  make(map[string]func() interface{}),
+	}
+
+	for name, basic := range ModuleBasics {
+		if jsonBasic, ok := basic.(jsonGenesisModuleBasic); ok {
+			types.json[name] = jsonBasic.newJSONGenesisState
+			continue
+		}
+
+		genesisBasic, ok := basic.(module.HasGenesisBasics)
+		if !ok {
+			continue
+		}
+
+		recorder := &genesisTypeRecorder{JSONCodec: cdc}
+		genesisBasic.DefaultGenesis(recorder)
+		if recorder.messageType != nil {
+			types.proto[name] = recorder.messageType
+		}
+	}
+
+	return types
+}
+
+// GenesisDifference is a single semantic difference between two genesis states.
+type GenesisDifference struct {
+	// Path is the dot separated path of the differing value, starting with the module name
+	Path string
+	// A is the JSON encoded value in the first genesis, empty when the value is missing
+	A string
+	// B is the JSON encoded value in the second genesis, empty when the value is missing
+	B string
+}
+
+func (d GenesisDifference) String() string {
+	valueOrMissing := func(v string) string {
+		if v == "" {
+			return "<missing>"
+		}
+		return v
+	}
+
+	return fmt.Sprintf("%s: %s => %s", d.Path, valueOrMissing(d.A), valueOrMissing(d.B))
+}
+
+// DiffGenesis reports the semantic differences between two genesis states. The genesis of each module
+// is decoded into its typed genesis state and re-encoded through the codec, so that equivalent
+// encodings of Any values, decimals or defaulted fields are not reported. Ordering of lists is ignored,
+// so only actual module state differences are reported, sorted by path.
+func DiffGenesis(cdc codec.JSONCodec, a, b GenesisState) ([]GenesisDifference, error) {
+	modules := make(map[string]struct{}, len(a))
+	for name := range a {
+		modules[name] = struct{}{}
+	}
+	for name := range b {
+		modules[name] = struct{}{}
+	}
+
+	types := newGenesisTypes(cdc)
+
+	diffs := make([]GenesisDifference, 0)
+	for name := range modules {
+		valueA, err := normalizeGenesisJSON(cdc, types, name, a[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s genesis of the first genesis: %w", name, err)
+		}
+
+		valueB, err := normalizeGenesisJSON(cdc, types, name, b[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s genesis of the second genesis: %w", name, err)
+		}
+
+		diffs = append(diffs, diffGenesisValues(name, valueA, valueB)...)
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+
+	return diffs, nil
+}
+
+// normalizeGenesisJSON decodes the genesis of the module into its typed genesis state, if any, and
+// returns its canonical encoding as a generic JSON value with sorted lists.
+func normalizeGenesisJSON(cdc codec.JSONCodec, types genesisTypes, moduleName string, bz json.RawMessage) (interface{}, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	if genesisType, ok := types.proto[moduleName]; ok {
+		genesis := reflect.New(genesisType.Elem()).Interface().(proto.Message)
+		if err := cdc.UnmarshalJSON(bz, genesis); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if bz, err = cdc.MarshalJSON(genesis); err != nil {
+			return nil, err
+		}
+	} else if newGenesis, ok := types.json[moduleName]; ok {
+		genesis := newGenesis()
+		if err := json.Unmarshal(bz, genesis); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if bz, err = json.Marshal(genesis); err != nil {
+			return nil, err
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	sortGenesisLists(value)
+	return value, nil
+}
+
+// sortGenesisLists sorts the lists of the value by the canonical encoding of their elements.
+func sortGenesisLists(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, field := range v {
+			sortGenesisLists(field)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			sortGenesisLists(elem)
+		}
+
+		sort.SliceStable(v, func(i, j int) bool {
+			return canonicalJSON(v[i]) < canonicalJSON(v[j])
+		})
+	}
+}
+
+func diffGenesisValues(path string, a, b interface{}) []GenesisDifference {
+	mapA, isMapA := a.(map[string]interface{})
+	mapB, isMapB := b.(map[string]interface{})
+	if isMapA && isMapB {
+		keys := make(map[string]struct{}, len(mapA))
+		for key := range mapA {
+			keys[key] = struct{}{}
+		}
+		for key := range mapB {
+			keys[key] = struct{}{}
+		}
+
+		diffs := make([]GenesisDifference, 0)
+		for key := range keys {
+			diffs = append(diffs, diffGenesisValues(path+"."+key, mapA[key], mapB[key])...)
+		}
+		return diffs
+	}
+
+	listA, isListA := a.([]interface{})
+	listB, isListB := b.([]interface{})
+	if isListA && isListB {
+		return diffGenesisLists(path, listA, listB)
+	}
+
+	if canonicalJSON(a) == canonicalJSON(b) {
+		return nil
+	}
+
+	return []GenesisDifference{{Path: path, A: encodeGenesisValue(a), B: encodeGenesisValue(b)}}
+}
+
+// diffGenesisLists compares lists as multisets, reporting elements only present in
+// the first list under path[-] and elements only present in the second under path[+].
+func diffGenesisLists(path string, a, b []interface{}) []GenesisDifference {
+	counts := make(map[string]int, len(a))
+	for _, elem := range a {
+		counts[canonicalJSON(elem)]++
+	}
+	for _, elem := range b {
+		counts[canonicalJSON(elem)]--
+	}
+
+	diffs := make([]GenesisDifference, 0)
+	for _, elem := range a {
+		key := canonicalJSON(elem)
+		if counts[key] > 0 {
+			counts[key]--
+			diffs = append(diffs, GenesisDifference{Path: path + "[-]", A: key})
+		}
+	}
+	for _, elem := range b {
+		key := canonicalJSON(elem)
+		if counts[key] < 0 {
+			counts[key]++
+			diffs = append(diffs, GenesisDifference{Path: path + "[+]", B: key})
+		}
+	}
+
+	return diffs
+}
+
+func encodeGenesisValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return canonicalJSON(value)
+}
+
+// canonicalJSON encodes the value with sorted object keys.
+func canonicalJSON(value interface{}) string {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
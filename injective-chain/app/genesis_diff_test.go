@@ -0,0 +1,73 @@
+package app
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestDiffGenesis(t *testing.T) {
+	cdc := MakeEncodingConfig().Marshaler
+
+	t.Run("every module genesis is typed or a placeholder", func(t *testing.T) {
+		types := newGenesisTypes(cdc)
+		for name, bz := range NewDefaultGenesisState() {
+			if _, ok := types.proto[name]; ok {
+				continue
+			}
+			if _, ok := types.json[name]; ok {
+				continue
+			}
+
+			var value map[string]interface{}
+			if len(bz) > 0 {
+				require.NoError(t, json.Unmarshal(bz, &value), name)
+			}
+			require.Empty(t, value, "module %s has a genesis state but no genesis type", name)
+		}
+	})
+
+	t.Run("derives the genesis types from the module basics", func(t *testing.T) {
+		types := newGenesisTypes(cdc)
+		require.Equal(t, reflect.TypeOf(&exchangetypes.GenesisState{}), types.proto[exchangetypes.ModuleName])
+		require.Equal(t, reflect.TypeOf(&govv1.GenesisState{}), types.proto[govtypes.ModuleName])
+		require.NotContains(t, types.proto, upgradetypes.ModuleName)
+		require.IsType(t, &RewardBatchingGenesisState{}, types.json[RewardBatchingModuleName]())
+	})
+
+	t.Run("ignores equivalent encodings", func(t *testing.T) {
+		a := NewDefaultGenesisState()
+		b := NewDefaultGenesisState()
+
+		// a decimal without its trailing zeros decodes into the same genesis state
+		var genesis map[string]interface{}
+		require.NoError(t, json.Unmarshal(b[exchangetypes.ModuleName], &genesis))
+		params := genesis["params"].(map[string]interface{})
+		params["default_spot_maker_fee_rate"] = strings.TrimRight(params["default_spot_maker_fee_rate"].(string), "0")
+
+		bz, err := json.Marshal(genesis)
+		require.NoError(t, err)
+		b[exchangetypes.ModuleName] = bz
+
+		diffs, err := DiffGenesis(cdc, a, b)
+		require.NoError(t, err)
+		require.Empty(t, diffs)
+	})
+
+	t.Run("rejects a genesis not matching the module genesis type", func(t *testing.T) {
+		a := NewDefaultGenesisState()
+		b := NewDefaultGenesisState()
+		b[exchangetypes.ModuleName] = json.RawMessage(`{"unknown_field": true}`)
+
+		_, err := DiffGenesis(cdc, a, b)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// InitGenesisParallel inits the genesis state of the modules in the init genesis order of the module manager, like
+// InitChain does, but inits the modules of each independent group concurrently. The modules of a group must be
+// contiguous in the init genesis order, so the order across groups is unchanged.
+//
+// Each module of a group runs on its own cached branch of the state, branched before the group starts. Once all the
+// modules of the group are done, the branches are written in the init genesis order, whatever order the modules
+// finished in, so the resulting state is deterministic. It only matches a serial import if the modules of a group
+// neither read nor write each other's state, e.g. by creating accounts.
+//
+// Validator updates returned by the modules are dropped. Modules without genesis data are skipped, and nothing is
+// written if any module fails.
+func (app *InjectiveApp) InitGenesisParallel(ctx sdk.Context, data map[string]json.RawMessage, independentGroups [][]string) error {
+	steps, err := genesisInitSteps(app.mm.OrderInitGenesis, independentGroups)
+	if err != nil {
+		return err
+	}
+
+	stateCtx, writeState := ctx.CacheContext()
+
+	for _, step := range steps {
+		moduleCtxs := make([]sdk.Context, len(step))
+		writeModuleStates := make([]func(), len(step))
+		errs := make([]error, len(step))
+
+		// the branches are created upfront, so the modules only ever read the parent state concurrently
+		for idx := range step {
+			moduleCtx, writeModuleState := stateCtx.CacheContext()
+			moduleCtxs[idx] = moduleCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			writeModuleStates[idx] = writeModuleState
+		}
+
+		var wg sync.WaitGroup
+		for idx, moduleName := range step {
+			wg.Add(1)
+			go func(idx int, moduleName string) {
+				defer wg.Done()
+				errs[idx] = app.initModuleGenesis(moduleCtxs[idx], moduleName, data[moduleName])
+			}(idx, moduleName)
+		}
+		wg.Wait()
+
+		for idx := range step {
+			if errs[idx] != nil {
+				return errs[idx]
+			}
+			writeModuleStates[idx]()
+		}
+	}
+
+	writeState()
+	return nil
+}
+
+func (app *InjectiveApp) initModuleGenesis(ctx sdk.Context, moduleName string, data json.RawMessage) (err error) {
+	if data == nil {
+		return nil
+	}
+
+	mod, ok := app.mm.Modules[moduleName].(module.HasGenesis)
+	if !ok {
+		return nil
+	}
+
+	// modules panic on invalid genesis states
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to init %s genesis: %v", moduleName, r)
+		}
+	}()
+
+	mod.InitGenesis(ctx, app.appCodec, data)
+	return nil
+}
+
+// genesisInitSteps splits the init genesis order into steps, each step being either a single module or an independent
+// group. The modules of a step are sorted in the init genesis order.
+func genesisInitSteps(order []string, independentGroups [][]string) ([][]string, error) {
+	positions := make(map[string]int, len(order))
+	for idx, moduleName := range order {
+		positions[moduleName] = idx
+	}
+
+	groupOf := make(map[string]int)
+	for groupIdx, group := range independentGroups {
+		first, last := len(order), -1
+		for _, moduleName := range group {
+			pos, ok := positions[moduleName]
+			if !ok {
+				return nil, fmt.Errorf("module %s of independent group %d is not in the init genesis order", moduleName, groupIdx)
+			}
+			if _, ok := groupOf[moduleName]; ok {
+				return nil, fmt.Errorf("module %s is listed more than once in the independent groups", moduleName)
+			}
+			groupOf[moduleName] = groupIdx
+
+			if pos < first {
+				first = pos
+			}
+			if pos > last {
+				last = pos
+			}
+		}
+
+		if len(group) > 0 && last-first+1 != len(group) {
+			return nil, fmt.Errorf("modules of independent group %d are not contiguous in the init genesis order: %v", groupIdx, group)
+		}
+	}
+
+	steps := make([][]string, 0, len(order))
+	for idx := 0; idx < len(order); {
+		groupIdx, ok := groupOf[order[idx]]
+		if !ok {
+			steps = append(steps, []string{order[idx]})
+			idx++
+			continue
+		}
+
+		size := len(independentGroups[groupIdx])
+		steps = append(steps, order[idx:idx+size])
+		idx += size
+	}
+
+	return steps, nil
+}
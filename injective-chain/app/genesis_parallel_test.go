@@ -0,0 +1,67 @@
+package app
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	auctiontypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	autocompoundtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+	epochstypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+	ibcpacketlimittypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+)
+
+func TestInitGenesisParallel(t *testing.T) {
+	cdc := MakeEncodingConfig().Marshaler
+
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	senderPubKey := secp256k1.GenPrivKey().PubKey()
+	acc := authtypes.NewBaseAccount(senderPubKey.Address().Bytes(), senderPubKey, 0, 0)
+
+	genesis, err := simtestutil.GenesisStateWithValSet(cdc, NewDefaultGenesisState(), valSet, []authtypes.GenesisAccount{acc})
+	require.NoError(t, err)
+
+	header := tmproto.Header{ChainID: "injective-777"}
+	newApp := func() *InjectiveApp {
+		return NewInjectiveApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), simtestutil.EmptyAppOptions{})
+	}
+
+	// the state is written through a cache as in InitChain, the IAVL hashes depending on the write order
+	serialApp := newApp()
+	serialCtx, writeSerialState := serialApp.NewUncachedContext(false, header).CacheContext()
+	serialApp.mm.InitGenesis(serialCtx, cdc, genesis)
+	writeSerialState()
+	serialHash := serialApp.CommitMultiStore().Commit().Hash
+
+	t.Run("produces the same state as a serial import", func(t *testing.T) {
+		parallelApp := newApp()
+		err := parallelApp.InitGenesisParallel(parallelApp.NewUncachedContext(false, header), genesis, [][]string{
+			{epochstypes.ModuleName, auctiontypes.ModuleName, autocompoundtypes.ModuleName},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, serialHash, parallelApp.CommitMultiStore().Commit().Hash)
+	})
+
+	t.Run("rejects groups which are not contiguous in the init genesis order", func(t *testing.T) {
+		parallelApp := newApp()
+		err := parallelApp.InitGenesisParallel(parallelApp.NewUncachedContext(false, header), genesis, [][]string{
+			{auctiontypes.ModuleName, ibcpacketlimittypes.ModuleName},
+		})
+		require.ErrorContains(t, err, "not contiguous")
+	})
+
+	t.Run("rejects modules listed more than once", func(t *testing.T) {
+		_, err := genesisInitSteps([]string{"a", "b", "c"}, [][]string{{"a", "b"}, {"b", "c"}})
+		require.ErrorContains(t, err, "more than once")
+	})
+}
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// GenesisSchemaError lists every problem found by ValidateGenesisSchema.
+type GenesisSchemaError struct {
+	Errors []error
+}
+
+func (e *GenesisSchemaError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+
+	return fmt.Sprintf("invalid genesis (%d errors): %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// ValidateGenesisSchema is a pre-flight check of a genesis, either a full genesis file or only its app_state, that
+// runs without any keeper. It checks that every module of the app has a genesis and no unknown module is present,
+// and that each module genesis decodes into the module's genesis type and passes the module's ValidateGenesis.
+// All problems are reported at once, as a *GenesisSchemaError. The genesis is not modified.
+func ValidateGenesisSchema(raw json.RawMessage) error {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return &GenesisSchemaError{Errors: []error{fmt.Errorf("genesis is not a JSON object: %w", err)}}
+	}
+
+	var genesis GenesisState
+	if appState, ok := doc["app_state"]; ok {
+		if err := json.Unmarshal(appState, &genesis); err != nil {
+			return &GenesisSchemaError{Errors: []error{fmt.Errorf("app_state is not a JSON object: %w", err)}}
+		}
+	} else {
+		genesis = doc
+	}
+
+	encCfg := MakeEncodingConfig()
+	errs := make([]error, 0)
+
+	genesisBasics := make(map[string]module.HasGenesisBasics, len(ModuleBasics))
+	moduleNames := make([]string, 0, len(ModuleBasics))
+	for name, basic := range ModuleBasics {
+		if genesisBasic, ok := basic.(module.HasGenesisBasics); ok {
+			genesisBasics[name] = genesisBasic
+			moduleNames = append(moduleNames, name)
+		}
+	}
+	sort.Strings(moduleNames)
+
+	for _, name := range moduleNames {
+		bz, ok := genesis[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: missing module genesis", name))
+			continue
+		}
+
+		if err := genesisBasics[name].ValidateGenesis(encCfg.Marshaler, encCfg.TxConfig, bz); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	unknownNames := make([]string, 0)
+	for name := range genesis {
+		if _, ok := genesisBasics[name]; !ok {
+			unknownNames = append(unknownNames, name)
+		}
+	}
+	sort.Strings(unknownNames)
+
+	for _, name := range unknownNames {
+		errs = append(errs, fmt.Errorf("%s: unknown module", name))
+	}
+
+	if len(errs) > 0 {
+		return &GenesisSchemaError{Errors: errs}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestValidateGenesisSchema(t *testing.T) {
+	marshal := func(genesis GenesisState) json.RawMessage {
+		bz, err := json.Marshal(genesis)
+		require.NoError(t, err)
+		return bz
+	}
+
+	t.Run("accepts the default genesis", func(t *testing.T) {
+		require.NoError(t, ValidateGenesisSchema(marshal(NewDefaultGenesisState())))
+	})
+
+	t.Run("accepts a full genesis file", func(t *testing.T) {
+		doc, err := json.Marshal(map[string]interface{}{
+			"chain_id":  "injective-777",
+			"app_state": NewDefaultGenesisState(),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, ValidateGenesisSchema(doc))
+	})
+
+	t.Run("reports all malformed modules at once", func(t *testing.T) {
+		genesis := NewDefaultGenesisState()
+		genesis[exchangetypes.ModuleName] = json.RawMessage(`{"params": "not an object"}`)
+		genesis[banktypes.ModuleName] = json.RawMessage(`{"balances": 42}`)
+
+		raw := marshal(genesis)
+		original := append(json.RawMessage{}, raw...)
+
+		err := ValidateGenesisSchema(raw)
+
+		var schemaErr *GenesisSchemaError
+		require.True(t, errors.As(err, &schemaErr))
+		require.Len(t, schemaErr.Errors, 2)
+		require.Contains(t, schemaErr.Errors[0].Error(), banktypes.ModuleName+":")
+		require.Contains(t, schemaErr.Errors[1].Error(), exchangetypes.ModuleName+":")
+
+		// the genesis is left untouched
+		require.True(t, bytes.Equal(original, raw))
+	})
+
+	t.Run("reports missing and unknown modules", func(t *testing.T) {
+		genesis := NewDefaultGenesisState()
+		delete(genesis, exchangetypes.ModuleName)
+		genesis["unknown"] = json.RawMessage(`{}`)
+
+		err := ValidateGenesisSchema(marshal(genesis))
+
+		var schemaErr *GenesisSchemaError
+		require.True(t, errors.As(err, &schemaErr))
+		require.Len(t, schemaErr.Errors, 2)
+		require.EqualError(t, schemaErr.Errors[0], exchangetypes.ModuleName+": missing module genesis")
+		require.EqualError(t, schemaErr.Errors[1], "unknown: unknown module")
+	})
+}
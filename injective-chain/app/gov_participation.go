@@ -0,0 +1,102 @@
+package app
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// AccountGovParticipation returns a page of the participation of the account in the governance proposals, ordered by
+// proposal ID. Proposals in which the account neither voted nor inherits a vote are skipped. As the votes of a
+// proposal are deleted once it is tallied, only proposals in their voting period are reported.
+//
+// The inherited votes follow the tally of the gov module: an account which did not vote inherits, for each of its
+// delegations to a bonded validator which voted, the vote of the validator with the voting power of the delegation.
+func (app *InjectiveApp) AccountGovParticipation(ctx sdk.Context, address sdk.AccAddress, pageReq *query.PageRequest) ([]apptypes.GovParticipation, *query.PageResponse, error) {
+	if address.Empty() {
+		return nil, nil, errors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	proposalStore := prefix.NewStore(ctx.KVStore(app.keys[govtypes.StoreKey]), govtypes.ProposalsKeyPrefix)
+
+	delegations := app.bondedDelegations(ctx, address)
+
+	participations := make([]apptypes.GovParticipation, 0)
+	pageRes, err := query.FilteredPaginate(proposalStore, pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		proposalID := govtypes.GetProposalIDFromBytes(key)
+		participation := apptypes.GovParticipation{ProposalId: proposalID}
+
+		if vote, found := app.GovKeeper.GetVote(ctx, proposalID, address); found {
+			participation.Vote = &vote
+		} else {
+			participation.InheritedVotes = app.inheritedGovVotes(ctx, proposalID, delegations)
+		}
+
+		if participation.Vote == nil && len(participation.InheritedVotes) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			participations = append(participations, participation)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return participations, pageRes, nil
+}
+
+// bondedDelegation is a delegation to a bonded validator along with its voting power.
+type bondedDelegation struct {
+	validator   sdk.ValAddress
+	votingPower sdk.Dec
+}
+
+// bondedDelegations returns the delegations of the account to bonded validators, with the voting power computed as in
+// the tally of the gov module.
+func (app *InjectiveApp) bondedDelegations(ctx sdk.Context, address sdk.AccAddress) []bondedDelegation {
+	delegations := make([]bondedDelegation, 0)
+
+	app.StakingKeeper.IterateDelegations(ctx, address, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		validator, found := app.StakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found || !validator.IsBonded() {
+			return false
+		}
+
+		delegations = append(delegations, bondedDelegation{
+			validator:   validator.GetOperator(),
+			votingPower: delegation.GetShares().MulInt(validator.GetBondedTokens()).Quo(validator.GetDelegatorShares()),
+		})
+		return false
+	})
+
+	return delegations
+}
+
+func (app *InjectiveApp) inheritedGovVotes(ctx sdk.Context, proposalID uint64, delegations []bondedDelegation) []apptypes.InheritedGovVote {
+	votes := make([]apptypes.InheritedGovVote, 0)
+
+	for _, delegation := range delegations {
+		// a validator votes with the account of its operator address
+		validatorVote, found := app.GovKeeper.GetVote(ctx, proposalID, sdk.AccAddress(delegation.validator))
+		if !found {
+			continue
+		}
+
+		votes = append(votes, apptypes.InheritedGovVote{
+			ValidatorAddress: delegation.validator.String(),
+			Options:          validatorVote.Options,
+			VotingPower:      delegation.votingPower,
+		})
+	}
+
+	return votes
+}
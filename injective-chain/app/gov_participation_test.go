@@ -0,0 +1,101 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+func TestAccountGovParticipation(t *testing.T) {
+	app := Setup(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	height := app.LastBlockHeight() + 1
+	ctx := app.NewContext(false, tmproto.Header{Height: height, Time: time.Unix(1618997040, 0)})
+
+	// the genesis account is the only delegator of the genesis validator
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	delegator := sdk.MustAccAddressFromBech32(app.StakingKeeper.GetAllDelegations(ctx)[0].DelegatorAddress)
+	validatorVoter := sdk.AccAddress(validator.GetOperator())
+
+	for proposalID := uint64(1); proposalID <= 3; proposalID++ {
+		msgs := []sdk.Msg{&banktypes.MsgUpdateParams{Authority: authority.String(), Params: banktypes.DefaultParams()}}
+		proposal, err := govv1.NewProposal(msgs, proposalID, ctx.BlockTime(), ctx.BlockTime(), "", "title", "summary", authority)
+		require.NoError(t, err)
+		app.GovKeeper.SetProposal(ctx, proposal)
+	}
+
+	yes := govv1.NewNonSplitVoteOption(govv1.OptionYes)
+	no := govv1.NewNonSplitVoteOption(govv1.OptionNo)
+
+	// the delegator overrides the vote of its validator in the first proposal and inherits it in the second one, while
+	// nobody votes in the third one
+	app.GovKeeper.SetVote(ctx, govv1.NewVote(1, validatorVoter, yes, ""))
+	app.GovKeeper.SetVote(ctx, govv1.NewVote(1, delegator, no, ""))
+	app.GovKeeper.SetVote(ctx, govv1.NewVote(2, validatorVoter, yes, ""))
+
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	ctx = app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+	queryClient := apptypes.NewQueryClient(queryHelper)
+
+	accountGovParticipation := func(address string, pageReq *query.PageRequest) (*apptypes.QueryAccountGovParticipationResponse, error) {
+		return queryClient.AccountGovParticipation(ctx, &apptypes.QueryAccountGovParticipationRequest{
+			Address:    address,
+			Pagination: pageReq,
+		})
+	}
+
+	res, err := accountGovParticipation(delegator.String(), nil)
+	require.NoError(t, err)
+	require.Len(t, res.Participations, 2)
+
+	direct := res.Participations[0]
+	require.Equal(t, uint64(1), direct.ProposalId)
+	require.NotNil(t, direct.Vote)
+	require.Equal(t, []*govv1.WeightedVoteOption(no), direct.Vote.Options)
+	require.Empty(t, direct.InheritedVotes)
+
+	inherited := res.Participations[1]
+	require.Equal(t, uint64(2), inherited.ProposalId)
+	require.Nil(t, inherited.Vote)
+	require.Len(t, inherited.InheritedVotes, 1)
+	require.Equal(t, validator.GetOperator().String(), inherited.InheritedVotes[0].ValidatorAddress)
+	require.Equal(t, []*govv1.WeightedVoteOption(yes), inherited.InheritedVotes[0].Options)
+	require.Equal(t, validator.GetBondedTokens().ToDec().String(), inherited.InheritedVotes[0].VotingPower.String())
+
+	// the pages are taken from the proposals the account participates in
+	res, err = accountGovParticipation(delegator.String(), &query.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Participations, 1)
+	require.Equal(t, uint64(1), res.Participations[0].ProposalId)
+
+	res, err = accountGovParticipation(delegator.String(), &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Participations, 1)
+	require.Equal(t, uint64(2), res.Participations[0].ProposalId)
+
+	// an account without votes or delegations doesn't participate
+	res, err = accountGovParticipation(authority.String(), nil)
+	require.NoError(t, err)
+	require.Empty(t, res.Participations)
+
+	_, err = accountGovParticipation("", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
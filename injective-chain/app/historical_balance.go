@@ -0,0 +1,29 @@
+package app
+
+import (
+	"cosmossdk.io/errors"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HistoricalBalance returns the balance of an account in the given denom as of the committed state at the given
+// height. The state is read from an immutable view of the multistore at that version, so the query is read-only
+// and only loads the balance key. An error is returned if the height is not committed yet or has been pruned.
+func (app *InjectiveApp) HistoricalBalance(address sdk.AccAddress, denom string, height int64) (sdk.Coin, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.Coin{}, errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if height <= 0 || height > app.LastBlockHeight() {
+		return sdk.Coin{}, errors.Wrapf(sdkerrors.ErrInvalidHeight, "cannot query the balance at height %d, the last committed block is %d", height, app.LastBlockHeight())
+	}
+
+	cacheStore, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Coin{}, errors.Wrapf(sdkerrors.ErrInvalidHeight, "state at height %d is not available, it may have been pruned: %s", height, err)
+	}
+
+	ctx := sdk.NewContext(cacheStore, tmproto.Header{Height: height}, true, app.Logger())
+	return app.BankKeeper.GetBalance(ctx, address, denom), nil
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+func TestHistoricalBalance(t *testing.T) {
+	app := Setup(false)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// fund the account in the block left open by Setup, then fund it again in the next block
+	fundAndCommit := func(amount int64) int64 {
+		height := app.LastBlockHeight() + 1
+		ctx := app.NewContext(false, tmproto.Header{Height: height})
+		require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, account, sdk.NewCoins(sdk.NewInt64Coin("inj", amount))))
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+		return height
+	}
+
+	firstHeight := fundAndCommit(1000)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		Height:  app.LastBlockHeight() + 1,
+		AppHash: app.LastCommitID().Hash,
+	}})
+	secondHeight := fundAndCommit(500)
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+	queryClient := apptypes.NewQueryClient(queryHelper)
+
+	historicalBalance := func(address, denom string, height int64) (*apptypes.QueryHistoricalBalanceResponse, error) {
+		return queryClient.HistoricalBalance(ctx, &apptypes.QueryHistoricalBalanceRequest{
+			Address: address,
+			Denom:   denom,
+			Height:  height,
+		})
+	}
+
+	res, err := historicalBalance(account.String(), "inj", firstHeight-1)
+	require.NoError(t, err)
+	require.True(t, res.Balance.IsZero())
+
+	res, err = historicalBalance(account.String(), "inj", firstHeight)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("inj", 1000), res.Balance)
+	require.Equal(t, firstHeight, res.Height)
+
+	res, err = historicalBalance(account.String(), "inj", secondHeight)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("inj", 1500), res.Balance)
+
+	// without a height the balance is read at the height of the query
+	res, err = historicalBalance(account.String(), "inj", 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("inj", 1500), res.Balance)
+	require.Equal(t, secondHeight, res.Height)
+
+	// heights that are not committed yet can't be queried
+	_, err = historicalBalance(account.String(), "inj", secondHeight+1)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	_, err = historicalBalance(account.String(), "inj", -1)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	_, err = historicalBalance("inj1invalid", "inj", secondHeight)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	_, err = historicalBalance(account.String(), "!", secondHeight)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+}
@@ -0,0 +1,213 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+// ModuleLogKey is the structured field carrying the name of the module that emitted a log entry.
+const ModuleLogKey = "module"
+
+var _ log.Logger = moduleLogger{}
+
+// moduleLogger wraps a base logger so that every entry carries the module field. Any module
+// field set by the module itself (e.g. through ctx.Logger().With("module", ...)) is dropped
+// so that all entries are attributed consistently, using the name registered in the module manager.
+type moduleLogger struct {
+	base   log.Logger
+	module string
+}
+
+// NewModuleLogger returns a logger that injects the module field into every entry
+// emitted by the base logger.
+func NewModuleLogger(base log.Logger, moduleName string) log.Logger {
+	if ml, ok := base.(moduleLogger); ok {
+		base = ml.base
+	}
+
+	return moduleLogger{
+		base:   base.With(ModuleLogKey, moduleName),
+		module: moduleName,
+	}
+}
+
+func (l moduleLogger) Debug(msg string, keyvals ...interface{}) {
+	l.base.Debug(msg, stripModuleField(keyvals)...)
+}
+
+func (l moduleLogger) Info(msg string, keyvals ...interface{}) {
+	l.base.Info(msg, stripModuleField(keyvals)...)
+}
+
+func (l moduleLogger) Error(msg string, keyvals ...interface{}) {
+	l.base.Error(msg, stripModuleField(keyvals)...)
+}
+
+func (l moduleLogger) With(keyvals ...interface{}) log.Logger {
+	return moduleLogger{
+		base:   l.base.With(stripModuleField(keyvals)...),
+		module: l.module,
+	}
+}
+
+func stripModuleField(keyvals []interface{}) []interface{} {
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if key, ok := keyvals[i].(string); ok && key == ModuleLogKey {
+			stripped := make([]interface{}, 0, len(keyvals))
+			stripped = append(stripped, keyvals[:i]...)
+			return append(stripped, stripModuleField(keyvals[i+2:])...)
+		}
+	}
+
+	return keyvals
+}
+
+// SetBaseLogger sets the logger implementation module loggers are derived from. When unset, module
+// loggers wrap the logger of the block context, i.e. the logger the app was constructed with.
+func (app *InjectiveApp) SetBaseLogger(logger log.Logger) {
+	app.baseLogger = logger
+}
+
+// ModuleLogger returns the logger for the given module, carrying the module field.
+func (app *InjectiveApp) ModuleLogger(ctx sdk.Context, moduleName string) log.Logger {
+	base := app.baseLogger
+	if base == nil {
+		base = ctx.Logger()
+	}
+
+	return NewModuleLogger(base, moduleName)
+}
+
+// moduleConfigurator is the configurator handed to a single module, registering its msg services
+// through msgServer.
+type moduleConfigurator struct {
+	module.Configurator
+	msgServer gogogrpc.Server
+}
+
+func (c moduleConfigurator) MsgServer() gogogrpc.Server {
+	return c.msgServer
+}
+
+// moduleLoggerMsgServer registers the msg services of a module on the msg service router, running
+// every handler with a logger carrying the module field.
+type moduleLoggerMsgServer struct {
+	router     gogogrpc.Server
+	app        *InjectiveApp
+	moduleName string
+}
+
+func (s *moduleLoggerMsgServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	wrapped := *sd
+	wrapped.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				// the router invokes the handler without a server nor an SDK context to resolve the request type
+				if srv == nil {
+					return handler(srv, ctx, dec, interceptor)
+				}
+
+				sdkCtx := sdk.UnwrapSDKContext(ctx)
+				return handler(srv, sdk.WrapSDKContext(sdkCtx.WithLogger(s.app.ModuleLogger(sdkCtx, s.moduleName))), dec, interceptor)
+			},
+		}
+	}
+
+	s.router.RegisterService(&wrapped, ss)
+	s.app.msgPackageModules[protoPackage(sd.ServiceName)] = s.moduleName
+}
+
+// protoPackage returns the proto package of a fully qualified service or message name.
+func protoPackage(fullName string) string {
+	if i := strings.LastIndex(fullName, "."); i >= 0 {
+		return fullName[:i]
+	}
+	return ""
+}
+
+// registerServices registers the services of all modules like the module manager does, except that
+// the msg handlers of each module log with its module field. It also records the module registering
+// the msg services of each proto package in msgPackageModules.
+func (app *InjectiveApp) registerServices(cfg module.Configurator) {
+	app.msgPackageModules = make(map[string]string)
+
+	for moduleName, mod := range app.mm.Modules {
+		mod, ok := mod.(module.HasServices)
+		if !ok {
+			continue
+		}
+
+		mod.RegisterServices(moduleConfigurator{
+			Configurator: cfg,
+			msgServer: &moduleLoggerMsgServer{
+				router:     cfg.MsgServer(),
+				app:        app,
+				moduleName: moduleName,
+			},
+		})
+	}
+}
+
+// moduleLoggerBlocker runs the begin and end blockers of a module with a logger carrying its module
+// field, and skips the end blocker of the modules disabled for profiling.
+type moduleLoggerBlocker struct {
+	module.AppModule
+	app        *InjectiveApp
+	moduleName string
+}
+
+func (b moduleLoggerBlocker) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	if mod, ok := b.AppModule.(module.BeginBlockAppModule); ok {
+		mod.BeginBlock(ctx.WithLogger(b.app.ModuleLogger(ctx, b.moduleName)), req)
+	}
+}
+
+func (b moduleLoggerBlocker) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	mod, ok := b.AppModule.(module.EndBlockAppModule)
+	if !ok {
+		return nil
+	}
+
+	if _, disabled := b.app.disabledEndBlockers[b.moduleName]; disabled {
+		return nil
+	}
+
+	return mod.EndBlock(ctx.WithLogger(b.app.ModuleLogger(ctx, b.moduleName)), req)
+}
+
+// newBlockerManager returns a module manager running the begin and end blockers of the modules of mm in the
+// same order, through moduleLoggerBlocker. Only its BeginBlock and EndBlock are meant to be called, so that the
+// blocks keep being run by the loops of the SDK module manager.
+func (app *InjectiveApp) newBlockerManager(mm *module.Manager) *module.Manager {
+	modules := make(map[string]interface{}, len(mm.Modules))
+	for moduleName, mod := range mm.Modules {
+		appModule, ok := mod.(module.AppModule)
+		if !ok {
+			panic(fmt.Sprintf("module %s doesn't implement module.AppModule", moduleName))
+		}
+
+		modules[moduleName] = moduleLoggerBlocker{
+			AppModule:  appModule,
+			app:        app,
+			moduleName: moduleName,
+		}
+	}
+
+	return &module.Manager{
+		Modules:            modules,
+		OrderBeginBlockers: mm.OrderBeginBlockers,
+		OrderEndBlockers:   mm.OrderEndBlockers,
+	}
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestModuleLoggerInjectsModuleField(t *testing.T) {
+	app := Setup(false)
+
+	var buf bytes.Buffer
+	app.SetBaseLogger(log.NewTMJSONLoggerNoTS(&buf))
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+
+	decodeEntry := func() map[string]interface{} {
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 1)
+		require.Equal(t, 1, strings.Count(lines[0], `"module"`), "module field must be set exactly once")
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+		buf.Reset()
+		return entry
+	}
+
+	// injective keepers already set the module field themselves
+	exchangeCtx := ctx.WithLogger(app.ModuleLogger(ctx, exchangetypes.ModuleName))
+	app.ExchangeKeeper.Logger(exchangeCtx).Info("exchange entry", "height", 1)
+
+	entry := decodeEntry()
+	require.Equal(t, exchangetypes.ModuleName, entry[ModuleLogKey])
+	require.Equal(t, "exchange entry", entry["_msg"])
+	require.EqualValues(t, 1, entry["height"])
+
+	// SDK keepers use x/<name>, which is replaced by the registered module name
+	stakingCtx := ctx.WithLogger(app.ModuleLogger(ctx, stakingtypes.ModuleName))
+	app.StakingKeeper.Logger(stakingCtx).Info("staking entry")
+
+	entry = decodeEntry()
+	require.Equal(t, stakingtypes.ModuleName, entry[ModuleLogKey])
+}
+
+// capturingMsgServer keeps the last service registered on it.
+type capturingMsgServer struct {
+	sd *grpc.ServiceDesc
+}
+
+func (s *capturingMsgServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.sd = sd
+}
+
+func TestModuleLoggerMsgServer(t *testing.T) {
+	app := Setup(false)
+
+	var buf bytes.Buffer
+	app.SetBaseLogger(log.NewTMJSONLoggerNoTS(&buf))
+
+	router := &capturingMsgServer{}
+	msgServer := &moduleLoggerMsgServer{router: router, app: app, moduleName: exchangetypes.ModuleName}
+	msgServer.RegisterService(&grpc.ServiceDesc{
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Log",
+			Handler: func(srv interface{}, ctx context.Context, _ func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				if srv != nil {
+					sdk.UnwrapSDKContext(ctx).Logger().Info("handler entry")
+				}
+				return nil, nil
+			},
+		}},
+	}, nil)
+
+	handler := router.sd.Methods[0].Handler
+
+	// resolving the request type doesn't pass an SDK context
+	_, err := handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, buf.String())
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+	_, err = handler(struct{}{}, sdk.WrapSDKContext(ctx), nil, nil)
+	require.NoError(t, err)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, exchangetypes.ModuleName, entry[ModuleLogKey])
+	require.Equal(t, "handler entry", entry["_msg"])
+}
+
+func TestBlockerManagerMatchesModuleManager(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1, ChainID: "injective-777", Time: time.Now().UTC()})
+
+	// a delegation changes the power of the validator, so that the staking EndBlocker returns a validator update
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+
+	delegator := sdk.AccAddress([]byte("delegator___________"))
+	amount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amount))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delegator, coins))
+	_, err := app.StakingKeeper.Delegate(ctx, delegator, amount, stakingtypes.Unbonded, validators[0], true)
+	require.NoError(t, err)
+
+	beginReq := abci.RequestBeginBlock{Header: ctx.BlockHeader()}
+	endReq := abci.RequestEndBlock{Height: ctx.BlockHeight()}
+
+	appCtx, _ := ctx.CacheContext()
+	appBeginRes := app.BeginBlocker(appCtx, beginReq)
+	appEndRes := app.EndBlocker(appCtx, endReq)
+
+	mmCtx, _ := ctx.CacheContext()
+	mmBeginRes := app.mm.BeginBlock(mmCtx, beginReq)
+	mmEndRes := app.mm.EndBlock(mmCtx, endReq)
+
+	require.NotEmpty(t, mmBeginRes.Events)
+	require.NotEmpty(t, mmEndRes.ValidatorUpdates)
+	require.Equal(t, mmBeginRes, appBeginRes)
+	require.Equal(t, mmEndRes, appEndRes)
+}
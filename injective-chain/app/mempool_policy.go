@@ -0,0 +1,255 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/errors"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagMempoolPolicyMaxTxs is the app option holding the number of txs at which the mempool is full for the
+	// mempool policy. It should match the CometBFT mempool size. Zero disables the policy.
+	FlagMempoolPolicyMaxTxs = "mempool-policy.max-txs"
+	// FlagMempoolPolicyLowPriorityThreshold is the app option holding the fraction of FlagMempoolPolicyMaxTxs from
+	// which txs with a low priority message are rejected.
+	FlagMempoolPolicyLowPriorityThreshold = "mempool-policy.low-priority-threshold"
+	// FlagMempoolPolicyLowPriorityMsgTypes is the app option holding the type URLs of the low priority messages.
+	FlagMempoolPolicyLowPriorityMsgTypes = "mempool-policy.low-priority-msg-types"
+	// FlagMempoolPolicyHighPriorityMsgTypes is the app option holding the type URLs of the high priority messages.
+	FlagMempoolPolicyHighPriorityMsgTypes = "mempool-policy.high-priority-msg-types"
+	// FlagMempoolPolicyTxTTLNumBlocks is the app option holding the number of blocks after which a tx is no longer
+	// tracked by the mempool policy.
+	FlagMempoolPolicyTxTTLNumBlocks = "mempool-policy.tx-ttl-num-blocks"
+
+	// DefaultMempoolPolicyLowPriorityThreshold rejects low priority txs once the mempool is 90% full.
+	DefaultMempoolPolicyLowPriorityThreshold = 0.9
+	// DefaultMempoolPolicyTxTTLNumBlocks stops tracking txs 20 blocks after they were checked.
+	DefaultMempoolPolicyTxTTLNumBlocks = 20
+)
+
+var _ mempool.Mempool = &MsgTypePolicyMempool{}
+
+// MsgTypePolicyMempool is an app-side mempool that rejects txs by message type as the mempool fills up, so that
+// order spam can't crowd out oracle updates:
+//   - from the low priority threshold, txs with a low priority message are rejected
+//   - from the maximum number of txs, only txs made of high priority messages are accepted
+//
+// Only CheckTx inserts txs, so the policy never affects the execution of blocks. It only tracks the txs of the
+// CometBFT mempool and holds no tx itself, and proposals are still built from the CometBFT mempool. Txs are removed
+// once delivered, but the app isn't told about the txs CometBFT drops on ReCheckTx failure or evicts, so a tx stops
+// being tracked txTTLNumBlocks blocks after it was checked.
+type MsgTypePolicyMempool struct {
+	mu sync.Mutex
+
+	txEncoder               sdk.TxEncoder
+	txs                     map[[sha256.Size]byte]int64 // tx hash to the height at which it was checked
+	prunedHeight            int64
+	txTTLNumBlocks          int64
+	maxTxs                  int
+	lowPriorityTxsThreshold int
+	lowPriorityMsgTypeURLs  map[string]struct{}
+	highPriorityMsgTypeURLs map[string]struct{}
+}
+
+// NewMsgTypePolicyMempool returns a MsgTypePolicyMempool which is full at maxTxs txs, rejects low priority txs
+// from lowPriorityThreshold, a fraction of maxTxs, and tracks txs for txTTLNumBlocks blocks.
+func NewMsgTypePolicyMempool(
+	txEncoder sdk.TxEncoder,
+	maxTxs int,
+	lowPriorityThreshold float64,
+	txTTLNumBlocks int64,
+	lowPriorityMsgTypeURLs []string,
+	highPriorityMsgTypeURLs []string,
+) *MsgTypePolicyMempool {
+	return &MsgTypePolicyMempool{
+		txEncoder:               txEncoder,
+		txs:                     make(map[[sha256.Size]byte]int64),
+		txTTLNumBlocks:          txTTLNumBlocks,
+		maxTxs:                  maxTxs,
+		lowPriorityTxsThreshold: int(float64(maxTxs) * lowPriorityThreshold),
+		lowPriorityMsgTypeURLs:  msgTypeURLSet(lowPriorityMsgTypeURLs),
+		highPriorityMsgTypeURLs: msgTypeURLSet(highPriorityMsgTypeURLs),
+	}
+}
+
+// newMsgTypePolicyMempoolFromAppOptions returns the MsgTypePolicyMempool configured by the app options, or nil if
+// the policy is disabled.
+func newMsgTypePolicyMempoolFromAppOptions(appOpts servertypes.AppOptions, txEncoder sdk.TxEncoder) *MsgTypePolicyMempool {
+	maxTxs := cast.ToInt(appOpts.Get(FlagMempoolPolicyMaxTxs))
+	if maxTxs <= 0 {
+		return nil
+	}
+
+	lowPriorityThreshold := DefaultMempoolPolicyLowPriorityThreshold
+	if v := appOpts.Get(FlagMempoolPolicyLowPriorityThreshold); v != nil {
+		lowPriorityThreshold = cast.ToFloat64(v)
+	}
+
+	txTTLNumBlocks := int64(DefaultMempoolPolicyTxTTLNumBlocks)
+	if v := cast.ToInt64(appOpts.Get(FlagMempoolPolicyTxTTLNumBlocks)); v > 0 {
+		txTTLNumBlocks = v
+	}
+
+	return NewMsgTypePolicyMempool(
+		txEncoder,
+		maxTxs,
+		lowPriorityThreshold,
+		txTTLNumBlocks,
+		parseMsgTypeURLs(appOpts.Get(FlagMempoolPolicyLowPriorityMsgTypes)),
+		parseMsgTypeURLs(appOpts.Get(FlagMempoolPolicyHighPriorityMsgTypes)),
+	)
+}
+
+// Insert tracks a tx accepted by CheckTx, unless the policy rejects it.
+func (mp *MsgTypePolicyMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	key, err := mp.txKey(tx)
+	if err != nil {
+		return err
+	}
+
+	height := blockHeight(ctx)
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	mp.pruneExpiredTxs(height)
+
+	if _, ok := mp.txs[key]; ok {
+		return nil
+	}
+
+	txsCount := len(mp.txs)
+	if txsCount >= mp.maxTxs && !mp.isHighPriority(tx) {
+		return errors.Wrapf(sdkerrors.ErrMempoolIsFull, "mempool is full with %d txs, only high priority txs are accepted", txsCount)
+	}
+	if txsCount >= mp.lowPriorityTxsThreshold && mp.isLowPriority(tx) {
+		return errors.Wrapf(sdkerrors.ErrMempoolIsFull, "mempool holds %d txs, low priority txs are rejected", txsCount)
+	}
+
+	mp.txs[key] = height
+	return nil
+}
+
+// pruneExpiredTxs stops tracking the txs checked txTTLNumBlocks or more blocks before height, once per height.
+func (mp *MsgTypePolicyMempool) pruneExpiredTxs(height int64) {
+	if height <= mp.prunedHeight {
+		return
+	}
+
+	for key, checkedHeight := range mp.txs {
+		if height-checkedHeight >= mp.txTTLNumBlocks {
+			delete(mp.txs, key)
+		}
+	}
+	mp.prunedHeight = height
+}
+
+// blockHeight returns the height of the sdk.Context wrapped in ctx, or zero if there is none.
+func blockHeight(ctx context.Context) int64 {
+	switch c := ctx.(type) {
+	case sdk.Context:
+		return c.BlockHeight()
+	default:
+		if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+			return sdkCtx.BlockHeight()
+		}
+		return 0
+	}
+}
+
+// Select returns no tx: proposals are built from the CometBFT mempool.
+func (*MsgTypePolicyMempool) Select(context.Context, [][]byte) mempool.Iterator {
+	return nil
+}
+
+// CountTx returns the number of tracked txs.
+func (mp *MsgTypePolicyMempool) CountTx() int {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	return len(mp.txs)
+}
+
+// Remove stops tracking a tx once it's delivered. Baseapp fails DeliverTx on any error other than
+// mempool.ErrTxNotFound, so a tx which cannot be encoded is reported as not found.
+func (mp *MsgTypePolicyMempool) Remove(tx sdk.Tx) error {
+	key, err := mp.txKey(tx)
+	if err != nil {
+		return mempool.ErrTxNotFound
+	}
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if _, ok := mp.txs[key]; !ok {
+		return mempool.ErrTxNotFound
+	}
+
+	delete(mp.txs, key)
+	return nil
+}
+
+func (mp *MsgTypePolicyMempool) txKey(tx sdk.Tx) ([sha256.Size]byte, error) {
+	bz, err := mp.txEncoder(tx)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(bz), nil
+}
+
+// isLowPriority returns whether any message of the tx is a low priority message.
+func (mp *MsgTypePolicyMempool) isLowPriority(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := mp.lowPriorityMsgTypeURLs[sdk.MsgTypeURL(msg)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isHighPriority returns whether all the messages of the tx are high priority messages.
+func (mp *MsgTypePolicyMempool) isHighPriority(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if _, ok := mp.highPriorityMsgTypeURLs[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// parseMsgTypeURLs parses a list of message type URLs, given as a string slice or as a comma separated string.
+func parseMsgTypeURLs(value interface{}) []string {
+	values := cast.ToStringSlice(value)
+	if s, ok := value.(string); ok {
+		values = strings.Split(s, ",")
+	}
+
+	typeURLs := make([]string, 0, len(values))
+	for _, typeURL := range values {
+		if typeURL = strings.TrimSpace(typeURL); typeURL != "" {
+			typeURLs = append(typeURLs, typeURL)
+		}
+	}
+	return typeURLs
+}
+
+func msgTypeURLSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
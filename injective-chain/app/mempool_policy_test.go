@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+func TestMsgTypePolicyMempool(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	txCount := 0
+	buildTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txCount++
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetMemo(fmt.Sprintf("tx %d", txCount))
+		return txBuilder.GetTx()
+	}
+
+	sendTx := func() sdk.Tx {
+		return buildTx(&banktypes.MsgSend{FromAddress: sender, ToAddress: sender, Amount: sdk.NewCoins(sdk.NewInt64Coin("inj", 1))})
+	}
+	orderTx := func() sdk.Tx {
+		return buildTx(&exchangetypes.MsgCreateSpotLimitOrder{Sender: sender})
+	}
+	oracleTx := func() sdk.Tx {
+		return buildTx(&oracletypes.MsgRelayPriceFeedPrice{Sender: sender})
+	}
+
+	ctx := context.Background()
+	mp := newMsgTypePolicyMempoolFromAppOptions(TestAppOptions{Values: map[string]interface{}{
+		FlagMempoolPolicyMaxTxs:               10,
+		FlagMempoolPolicyLowPriorityThreshold: 0.5,
+		FlagMempoolPolicyLowPriorityMsgTypes:  sdk.MsgTypeURL(&exchangetypes.MsgCreateSpotLimitOrder{}),
+		FlagMempoolPolicyHighPriorityMsgTypes: []string{sdk.MsgTypeURL(&oracletypes.MsgRelayPriceFeedPrice{})},
+	}}, encodingConfig.TxConfig.TxEncoder())
+	require.NotNil(t, mp)
+
+	// below the low priority threshold every tx is accepted
+	for i := 0; i < 4; i++ {
+		require.NoError(t, mp.Insert(ctx, sendTx()))
+	}
+	lastOrderTx := orderTx()
+	require.NoError(t, mp.Insert(ctx, lastOrderTx))
+	require.Equal(t, 5, mp.CountTx())
+
+	// from the low priority threshold low priority txs are dropped
+	require.ErrorIs(t, mp.Insert(ctx, orderTx()), sdkerrors.ErrMempoolIsFull)
+	require.NoError(t, mp.Insert(ctx, oracleTx()))
+	for i := 0; i < 4; i++ {
+		require.NoError(t, mp.Insert(ctx, sendTx()))
+	}
+	require.Equal(t, 10, mp.CountTx())
+
+	// once full only high priority txs are accepted
+	require.ErrorIs(t, mp.Insert(ctx, sendTx()), sdkerrors.ErrMempoolIsFull)
+	require.ErrorIs(t, mp.Insert(ctx, orderTx()), sdkerrors.ErrMempoolIsFull)
+	require.ErrorIs(t, mp.Insert(ctx, buildTx(
+		&oracletypes.MsgRelayPriceFeedPrice{Sender: sender},
+		&exchangetypes.MsgCreateSpotLimitOrder{Sender: sender},
+	)), sdkerrors.ErrMempoolIsFull)
+	require.NoError(t, mp.Insert(ctx, oracleTx()))
+	require.Equal(t, 11, mp.CountTx())
+
+	// delivered txs free their slot
+	require.NoError(t, mp.Remove(lastOrderTx))
+	require.ErrorIs(t, mp.Remove(lastOrderTx), mempool.ErrTxNotFound)
+	require.Equal(t, 10, mp.CountTx())
+}
+
+func TestMsgTypePolicyMempoolTxTTL(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	txCount := 0
+	sendTx := func() sdk.Tx {
+		txCount++
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{FromAddress: sender, ToAddress: sender, Amount: sdk.NewCoins(sdk.NewInt64Coin("inj", 1))}))
+		txBuilder.SetMemo(fmt.Sprintf("tx %d", txCount))
+		return txBuilder.GetTx()
+	}
+
+	mp := newMsgTypePolicyMempoolFromAppOptions(TestAppOptions{Values: map[string]interface{}{
+		FlagMempoolPolicyMaxTxs:         4,
+		FlagMempoolPolicyTxTTLNumBlocks: 5,
+	}}, encodingConfig.TxConfig.TxEncoder())
+	require.NotNil(t, mp)
+
+	ctxAtHeight := func(height int64) sdk.Context {
+		return sdk.Context{}.WithBlockHeight(height)
+	}
+
+	// txs which are never delivered, e.g. evicted by CometBFT, fill up the mempool
+	for i := 0; i < 2; i++ {
+		require.NoError(t, mp.Insert(ctxAtHeight(10), sendTx()))
+	}
+	for i := 0; i < 2; i++ {
+		require.NoError(t, mp.Insert(ctxAtHeight(12), sendTx()))
+	}
+	require.ErrorIs(t, mp.Insert(ctxAtHeight(14), sendTx()), sdkerrors.ErrMempoolIsFull)
+
+	// until they expire
+	require.NoError(t, mp.Insert(ctxAtHeight(15), sendTx()))
+	require.Equal(t, 3, mp.CountTx())
+
+	require.NoError(t, mp.Insert(ctxAtHeight(17), sendTx()))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestMsgTypePolicyMempoolDisabledByDefault(t *testing.T) {
+	require.Nil(t, newMsgTypePolicyMempoolFromAppOptions(TestAppOptions{}, MakeEncodingConfig().TxConfig.TxEncoder()))
+}
+
+func TestMsgTypePolicyMempoolRemoveUnencodableTx(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	mp := newMsgTypePolicyMempoolFromAppOptions(TestAppOptions{Values: map[string]interface{}{
+		FlagMempoolPolicyMaxTxs: 10,
+	}}, func(sdk.Tx) ([]byte, error) {
+		return nil, errors.New("cannot encode tx")
+	})
+	require.NotNil(t, mp)
+
+	// baseapp fails DeliverTx on any Remove error other than ErrTxNotFound
+	require.ErrorIs(t, mp.Remove(encodingConfig.TxConfig.NewTxBuilder().GetTx()), mempool.ErrTxNotFound)
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// commitInfoReader is implemented by the commit multistores which keep the commit info of past heights.
+type commitInfoReader interface {
+	GetCommitInfo(ver int64) (*storetypes.CommitInfo, error)
+}
+
+// ModuleStateRoot returns the commit hash of the store with the given name at the height of the context, i.e. the root
+// of its IAVL tree. It is read from the commit info the app hash of the height is computed from, so that the state of
+// a single module can be verified without the full app hash.
+func (app *InjectiveApp) ModuleStateRoot(ctx sdk.Context, storeName string) ([]byte, error) {
+	cms, ok := app.CommitMultiStore().(commitInfoReader)
+	if !ok {
+		return nil, errors.Wrap(sdkerrors.ErrNotSupported, "the commit multistore doesn't keep the commit info")
+	}
+
+	commitInfo, err := cms.GetCommitInfo(ctx.BlockHeight())
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidHeight, "no commit info at height %d: %s", ctx.BlockHeight(), err)
+	}
+
+	for _, storeInfo := range commitInfo.StoreInfos {
+		if storeInfo.Name == storeName {
+			return storeInfo.GetHash(), nil
+		}
+	}
+
+	return nil, errors.Wrapf(sdkerrors.ErrNotFound, "no store named %s at height %d", storeName, ctx.BlockHeight())
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestModuleStateRoot(t *testing.T) {
+	app := Setup(false)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// change the bank state in a block and commit it
+	height := app.LastBlockHeight() + 1
+	deliverCtx := app.NewContext(false, tmproto.Header{Height: height})
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, deliverCtx, account, sdk.NewCoins(sdk.NewInt64Coin("inj", 100))))
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+	queryClient := apptypes.NewQueryClient(queryHelper)
+
+	moduleStateRoot := func(moduleName string) (*apptypes.QueryModuleStateRootResponse, error) {
+		return queryClient.ModuleStateRoot(ctx, &apptypes.QueryModuleStateRootRequest{ModuleName: moduleName})
+	}
+
+	commitInfo, err := app.CommitMultiStore().(*rootmulti.Store).GetCommitInfo(app.LastBlockHeight())
+	require.NoError(t, err)
+	require.Equal(t, app.LastCommitID().Hash, commitInfo.Hash())
+
+	committedRoots := make(map[string][]byte)
+	for _, storeInfo := range commitInfo.StoreInfos {
+		committedRoots[storeInfo.Name] = storeInfo.GetHash()
+	}
+
+	// the root is the one the app hash is computed from
+	res, err := moduleStateRoot(banktypes.StoreKey)
+	require.NoError(t, err)
+	require.Equal(t, app.LastBlockHeight(), res.Height)
+	require.Equal(t, committedRoots[banktypes.StoreKey], res.Root)
+	require.Equal(t, app.CommitMultiStore().GetCommitKVStore(app.GetKey(banktypes.StoreKey)).LastCommitID().Hash, res.Root)
+
+	res, err = moduleStateRoot(exchangetypes.StoreKey)
+	require.NoError(t, err)
+	require.Equal(t, committedRoots[exchangetypes.StoreKey], res.Root)
+	require.NotEqual(t, committedRoots[banktypes.StoreKey], res.Root)
+
+	_, err = moduleStateRoot("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// the commit info of the next height doesn't exist yet
+	queryHelper.Ctx = ctx.WithBlockHeight(app.LastBlockHeight() + 1)
+	_, err = moduleStateRoot(banktypes.StoreKey)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// ModuleVersions returns the consensus version of every module stored in the upgrade keeper's module
+// version map, sorted by module name. After an upgrade the stored versions match the module manager's
+// versions only if all the migrations ran.
+func (app *InjectiveApp) ModuleVersions(ctx sdk.Context) []apptypes.ModuleVersion {
+	versionMap := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	versions := make([]apptypes.ModuleVersion, 0, len(versionMap))
+	for name, version := range versionMap {
+		versions = append(versions, apptypes.ModuleVersion{
+			Name:    name,
+			Version: version,
+		})
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Name < versions[j].Name
+	})
+
+	return versions
+}
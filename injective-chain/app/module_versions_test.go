@@ -0,0 +1,36 @@
+package app
+
+import (
+	"sort"
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+func TestModuleVersions(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+
+	res, err := apptypes.NewQueryClient(queryHelper).ModuleVersions(ctx, &apptypes.QueryModuleVersionsRequest{})
+	require.NoError(t, err)
+	versions := res.ModuleVersions
+
+	expected := app.mm.GetVersionMap()
+	require.Len(t, versions, len(expected))
+	require.True(t, sort.SliceIsSorted(versions, func(i, j int) bool {
+		return versions[i].Name < versions[j].Name
+	}))
+
+	for _, version := range versions {
+		expectedVersion, ok := expected[version.Name]
+		require.True(t, ok, "unexpected module %s", version.Name)
+		require.Equal(t, expectedVersion, version.Version, "module %s", version.Name)
+	}
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FlagUnsafeDisabledEndBlockers is the app option holding the comma separated names of the modules whose
+// EndBlockers are skipped. It is meant for profiling the block time only: skipping EndBlockers produces an
+// invalid state, so a node started with it diverges from the network.
+const FlagUnsafeDisabledEndBlockers = "unsafe-disabled-end-blockers"
+
+// SetDisabledEndBlockers skips the EndBlockers of the given modules while the EndBlockers of the other modules
+// keep running in their configured order. It is meant for profiling and testing only, as the resulting state is
+// invalid. It panics if a module is unknown.
+func (app *InjectiveApp) SetDisabledEndBlockers(moduleNames ...string) {
+	disabled := make(map[string]struct{}, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		if _, ok := app.mm.Modules[moduleName]; !ok {
+			panic(fmt.Sprintf("cannot disable the EndBlocker of unknown module %s", moduleName))
+		}
+		disabled[moduleName] = struct{}{}
+	}
+
+	if len(disabled) > 0 {
+		app.Logger().Error(
+			"⚠️ EndBlockers are DISABLED for profiling, this node produces an INVALID state and must never be used on a live network",
+			"modules", strings.Join(moduleNames, ","),
+		)
+	}
+
+	app.disabledEndBlockers = disabled
+}
+
+// parseDisabledEndBlockers parses the comma separated module names of the FlagUnsafeDisabledEndBlockers app option.
+func parseDisabledEndBlockers(value string) []string {
+	moduleNames := make([]string, 0)
+	for _, moduleName := range strings.Split(value, ",") {
+		if moduleName = strings.TrimSpace(moduleName); moduleName != "" {
+			moduleNames = append(moduleNames, moduleName)
+		}
+	}
+	return moduleNames
+}
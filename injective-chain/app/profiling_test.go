@@ -0,0 +1,38 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	auctiontypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+)
+
+func TestSetDisabledEndBlockers(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()})
+
+	// the auction EndBlocker settles the round once its ending timestamp is reached
+	endAuctionRound := func() {
+		app.AuctionKeeper.SetEndingTimeStamp(ctx, ctx.BlockTime().Unix()-1)
+		app.EndBlocker(ctx, abci.RequestEndBlock{Height: ctx.BlockHeight()})
+	}
+
+	app.SetDisabledEndBlockers(auctiontypes.ModuleName)
+	endAuctionRound()
+	require.Equal(t, uint64(0), app.AuctionKeeper.GetAuctionRound(ctx))
+
+	app.SetDisabledEndBlockers()
+	endAuctionRound()
+	require.Equal(t, uint64(1), app.AuctionKeeper.GetAuctionRound(ctx))
+
+	require.Panics(t, func() { app.SetDisabledEndBlockers("unknown") })
+}
+
+func TestParseDisabledEndBlockers(t *testing.T) {
+	require.Empty(t, parseDisabledEndBlockers(""))
+	require.Equal(t, []string{"exchange", "auction"}, parseDisabledEndBlockers(" exchange, ,auction"))
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// ProposalsByModule returns a page of the governance proposals, ordered by proposal ID, with at least one message
+// targeting the given module. See ProposalTargetModules for how the modules are derived.
+func (app *InjectiveApp) ProposalsByModule(ctx sdk.Context, moduleName string, pageReq *query.PageRequest) ([]*govv1.Proposal, *query.PageResponse, error) {
+	if moduleName == "" {
+		return nil, nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "module name cannot be empty")
+	}
+
+	proposalStore := prefix.NewStore(ctx.KVStore(app.keys[govtypes.StoreKey]), govtypes.ProposalsKeyPrefix)
+
+	proposals := make([]*govv1.Proposal, 0)
+	pageRes, err := query.FilteredPaginate(proposalStore, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+		var proposal govv1.Proposal
+		if err := app.GovKeeper.UnmarshalProposal(value, &proposal); err != nil {
+			return false, err
+		}
+
+		if !app.proposalTargetsModule(&proposal, moduleName) {
+			return false, nil
+		}
+
+		if accumulate {
+			proposals = append(proposals, &proposal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proposals, pageRes, nil
+}
+
+func (app *InjectiveApp) proposalTargetsModule(proposal *govv1.Proposal, moduleName string) bool {
+	for _, module := range app.ProposalTargetModules(proposal) {
+		if module == moduleName {
+			return true
+		}
+	}
+	return false
+}
+
+// ProposalTargetModules returns the modules targeted by the messages of a proposal, in the order of the messages and
+// without duplicates. A message targets the module registering the msg services of its proto package, e.g.
+// /injective.exchange.v1beta1.MsgUpdateParams targets exchange and /ibc.applications.fee.v1.MsgPayPacketFee targets
+// feeibc. A legacy content proposal targets the module of its content, except for a legacy parameter change which
+// targets the subspaces of its changes.
+func (app *InjectiveApp) ProposalTargetModules(proposal *govv1.Proposal) []string {
+	modules := make([]string, 0, len(proposal.Messages))
+	seen := make(map[string]struct{})
+
+	addModule := func(module string) {
+		if _, ok := seen[module]; ok || module == "" {
+			return
+		}
+		seen[module] = struct{}{}
+		modules = append(modules, module)
+	}
+
+	for _, msg := range proposal.Messages {
+		for _, module := range app.messageTargetModules(msg) {
+			addModule(module)
+		}
+	}
+
+	return modules
+}
+
+func (app *InjectiveApp) messageTargetModules(msg *codectypes.Any) []string {
+	legacyContentMsg, ok := msg.GetCachedValue().(*govv1.MsgExecLegacyContent)
+	if !ok || legacyContentMsg.Content == nil {
+		return []string{app.moduleFromTypeURL(msg.TypeUrl)}
+	}
+
+	paramChange, ok := legacyContentMsg.Content.GetCachedValue().(*paramproposal.ParameterChangeProposal)
+	if !ok {
+		return []string{app.moduleFromTypeURL(legacyContentMsg.Content.TypeUrl)}
+	}
+
+	modules := make([]string, 0, len(paramChange.Changes))
+	for _, change := range paramChange.Changes {
+		modules = append(modules, change.Subspace)
+	}
+	return modules
+}
+
+// moduleFromTypeURL returns the module registering the msg services of the proto package of the type URL, or an
+// empty string when no module registers msg services in that package.
+func (app *InjectiveApp) moduleFromTypeURL(typeURL string) string {
+	return app.msgPackageModules[protoPackage(strings.TrimPrefix(typeURL, "/"))]
+}
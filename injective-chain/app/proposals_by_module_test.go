@@ -0,0 +1,140 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestProposalsByModule(t *testing.T) {
+	app := Setup(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	exchangeParams := &exchangetypes.MsgUpdateParams{Authority: authority.String(), Params: exchangetypes.DefaultParams()}
+	bankParams := &banktypes.MsgUpdateParams{Authority: authority.String(), Params: banktypes.DefaultParams()}
+
+	stakingParamChange, err := govv1.NewLegacyContent(paramproposal.NewParameterChangeProposal("staking", "staking", []paramproposal.ParamChange{
+		{Subspace: "staking", Key: "MaxValidators", Value: "100"},
+	}), authority.String())
+	require.NoError(t, err)
+
+	marketLaunch, err := govv1.NewLegacyContent(&exchangetypes.SpotMarketLaunchProposal{
+		Title:       "launch",
+		Description: "launch",
+		Ticker:      "INJ/USDT",
+		BaseDenom:   "inj",
+		QuoteDenom:  "usdt",
+	}, authority.String())
+	require.NoError(t, err)
+
+	proposalMsgs := [][]sdk.Msg{
+		{exchangeParams},
+		{bankParams},
+		{stakingParamChange},
+		{bankParams, marketLaunch},
+		{exchangeParams, bankParams},
+	}
+
+	height := app.LastBlockHeight() + 1
+	ctx := app.NewContext(false, tmproto.Header{Height: height, Time: time.Unix(1618997040, 0)})
+	for i, msgs := range proposalMsgs {
+		proposal, err := govv1.NewProposal(msgs, uint64(i+1), ctx.BlockTime(), ctx.BlockTime(), "", "title", "summary", authority)
+		require.NoError(t, err)
+		app.GovKeeper.SetProposal(ctx, proposal)
+	}
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	ctx = app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+	queryClient := apptypes.NewQueryClient(queryHelper)
+
+	proposalsByModule := func(moduleName string, pageReq *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+		res, err := queryClient.ProposalsByModule(ctx, &apptypes.QueryProposalsByModuleRequest{
+			ModuleName: moduleName,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ids := make([]uint64, 0, len(res.Proposals))
+		for _, proposal := range res.Proposals {
+			ids = append(ids, proposal.Id)
+		}
+		return ids, res.Pagination, nil
+	}
+
+	proposalIDs, _, err := proposalsByModule(exchangetypes.ModuleName, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 4, 5}, proposalIDs)
+
+	proposalIDs, _, err = proposalsByModule(banktypes.ModuleName, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 5}, proposalIDs)
+
+	// a legacy parameter change targets the subspaces of its changes
+	proposalIDs, _, err = proposalsByModule("staking", nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, proposalIDs)
+
+	proposal, found := app.GovKeeper.GetProposal(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, []string{"staking"}, app.ProposalTargetModules(&proposal))
+
+	proposalIDs, _, err = proposalsByModule("oracle", nil)
+	require.NoError(t, err)
+	require.Empty(t, proposalIDs)
+
+	// the pages are taken from the filtered proposals
+	proposalIDs, pageRes, err := proposalsByModule(exchangetypes.ModuleName, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 4}, proposalIDs)
+
+	proposalIDs, _, err = proposalsByModule(exchangetypes.ModuleName, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, proposalIDs)
+
+	_, _, err = proposalsByModule("", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestModuleFromTypeURL(t *testing.T) {
+	app := Setup(false)
+
+	require.Equal(t, "exchange", app.moduleFromTypeURL(sdk.MsgTypeURL(&exchangetypes.MsgUpdateParams{})))
+	require.Equal(t, "bank", app.moduleFromTypeURL("/cosmos.bank.v1beta1.MsgSend"))
+	require.Equal(t, "wasm", app.moduleFromTypeURL("/cosmwasm.wasm.v1.MsgStoreCode"))
+	// the module name differs from the proto package
+	require.Equal(t, "chainlink", app.moduleFromTypeURL("/injective.ocr.v1beta1.MsgUpdateParams"))
+	// legacy contents belong to the module registering the msg services of their package
+	require.Equal(t, "exchange", app.moduleFromTypeURL("/injective.exchange.v1beta1.SpotMarketLaunchProposal"))
+	require.Equal(t, "ibc", app.moduleFromTypeURL("/ibc.core.client.v1.ClientUpdateProposal"))
+
+	// all the IBC core packages belong to the ibc module, the IBC applications to their own module
+	require.Equal(t, "ibc", app.moduleFromTypeURL("/ibc.core.client.v1.MsgUpdateClient"))
+	require.Equal(t, "ibc", app.moduleFromTypeURL("/ibc.core.connection.v1.MsgConnectionOpenInit"))
+	require.Equal(t, "ibc", app.moduleFromTypeURL("/ibc.core.channel.v1.MsgRecvPacket"))
+	require.Equal(t, "transfer", app.moduleFromTypeURL("/ibc.applications.transfer.v1.MsgTransfer"))
+	require.Equal(t, "feeibc", app.moduleFromTypeURL("/ibc.applications.fee.v1.MsgPayPacketFee"))
+	// the interchain accounts module runs as host only, without msg services
+	require.Equal(t, "", app.moduleFromTypeURL("/ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount"))
+
+	require.Equal(t, "", app.moduleFromTypeURL("/MsgSend"))
+}
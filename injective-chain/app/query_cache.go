@@ -0,0 +1,329 @@
+package app
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// FlagQueryCache is the app option enabling the query cache, which serves the gRPC queries repeated at the same
+	// height from memory instead of recomputing them.
+	FlagQueryCache = "query-cache"
+	// FlagQueryCacheMaxEntries is the app option holding the maximum number of responses held by the query cache.
+	FlagQueryCacheMaxEntries = "query-cache-max-entries"
+
+	// DefaultQueryCacheMaxEntries is the maximum number of responses held by the query cache unless configured.
+	DefaultQueryCacheMaxEntries = 10_000
+)
+
+// queryCacheServices are the gRPC services whose queries are served from the query cache. Only the module Query
+// services are listed: the other services registered on the gRPC query router, such as the tx service broadcasting
+// and simulating txs or the CometBFT and node services, don't read the committed state of a height.
+var queryCacheServices = map[string]struct{}{
+	"cosmos.auth.v1beta1.Query":                          {},
+	"cosmos.authz.v1beta1.Query":                         {},
+	"cosmos.bank.v1beta1.Query":                          {},
+	"cosmos.consensus.v1.Query":                          {},
+	"cosmos.distribution.v1beta1.Query":                  {},
+	"cosmos.evidence.v1beta1.Query":                      {},
+	"cosmos.feegrant.v1beta1.Query":                      {},
+	"cosmos.gov.v1.Query":                                {},
+	"cosmos.gov.v1beta1.Query":                           {},
+	"cosmos.mint.v1beta1.Query":                          {},
+	"cosmos.params.v1beta1.Query":                        {},
+	"cosmos.slashing.v1beta1.Query":                      {},
+	"cosmos.staking.v1beta1.Query":                       {},
+	"cosmos.upgrade.v1beta1.Query":                       {},
+	"cosmwasm.wasm.v1.Query":                             {},
+	"ibc.applications.fee.v1.Query":                      {},
+	"ibc.applications.interchain_accounts.host.v1.Query": {},
+	"ibc.applications.transfer.v1.Query":                 {},
+	"ibc.core.channel.v1.Query":                          {},
+	"ibc.core.client.v1.Query":                           {},
+	"ibc.core.connection.v1.Query":                       {},
+	"injective.app.v1beta1.Query":                        {},
+	"injective.auction.v1beta1.Query":                    {},
+	"injective.autocompound.v1beta1.Query":               {},
+	"injective.epochs.v1beta1.Query":                     {},
+	"injective.exchange.v1beta1.Query":                   {},
+	"injective.ibcpacketlimit.v1beta1.Query":             {},
+	"injective.insurance.v1beta1.Query":                  {},
+	"injective.ocr.v1beta1.Query":                        {},
+	"injective.oracle.v1beta1.Query":                     {},
+	"injective.peggy.v1.Query":                           {},
+	"injective.permissions.v1beta1.Query":                {},
+	"injective.tokenfactory.v1beta1.Query":               {},
+	"injective.wasmx.v1.Query":                           {},
+	"packetforward.v1.Query":                             {},
+}
+
+// isQueryCacheService returns whether the queries of the given gRPC service are served from the query cache.
+func isQueryCacheService(serviceName string) bool {
+	_, ok := queryCacheServices[serviceName]
+	return ok
+}
+
+// queryCacheServiceName returns the gRPC service of the given query path, /<service>/<method>.
+func queryCacheServiceName(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+// queryCache holds the responses of the gRPC queries by method, request and height. A response is only ever served
+// for the height it was computed at, and the cache is emptied on every commit, so it holds the responses of the
+// heights queried since the last block. Once it holds maxEntries responses, the other queries are computed without
+// being cached until the next commit.
+type queryCache struct {
+	mu sync.Mutex
+
+	entries    map[string]abci.ResponseQuery
+	maxEntries int
+	hits       uint64
+	misses     uint64
+}
+
+// newQueryCacheFromAppOptions returns the query cache if enabled by the app options, nil otherwise.
+func newQueryCacheFromAppOptions(appOpts servertypes.AppOptions) *queryCache {
+	if !cast.ToBool(appOpts.Get(FlagQueryCache)) {
+		return nil
+	}
+
+	maxEntries := cast.ToInt(appOpts.Get(FlagQueryCacheMaxEntries))
+	if maxEntries <= 0 {
+		maxEntries = DefaultQueryCacheMaxEntries
+	}
+
+	return &queryCache{
+		entries:    make(map[string]abci.ResponseQuery),
+		maxEntries: maxEntries,
+	}
+}
+
+// queryCacheKey returns the key of the query of the given method and request at the given height.
+func queryCacheKey(path string, data []byte, height int64) string {
+	key := make([]byte, 0, 8+4+len(path)+len(data))
+	key = binary.BigEndian.AppendUint64(key, uint64(height))
+	// the length prefix keeps the method and the request apart
+	key = binary.BigEndian.AppendUint32(key, uint32(len(path)))
+	key = append(key, path...)
+	key = append(key, data...)
+	return string(key)
+}
+
+func (c *queryCache) get(key string) (abci.ResponseQuery, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.entries[key]
+	if ok {
+		c.hits++
+	} else {
+		c.misses++
+	}
+	return res, ok
+}
+
+func (c *queryCache) set(key string, res abci.ResponseQuery) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= c.maxEntries {
+		return
+	}
+
+	c.entries[key] = res
+}
+
+func (c *queryCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]abci.ResponseQuery)
+}
+
+// stats returns the number of queries served from the cache and the number of queries computed.
+func (c *queryCache) stats() (hits, misses uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hits, c.misses
+}
+
+// Query implements the ABCI Query, serving the gRPC queries of the module Query services from the query cache when
+// enabled. Queries with proofs and the other queries, such as tx simulations, always reach the BaseApp, and only
+// successful responses are cached.
+func (app *InjectiveApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	if app.queryCache == nil || req.Prove || app.GRPCQueryRouter().Route(req.Path) == nil || !isQueryCacheService(queryCacheServiceName(req.Path)) {
+		return app.BaseApp.Query(req)
+	}
+
+	// a query without height is served at the last committed height, which is pinned so that a commit racing with the
+	// query can't cache the response of the next height under the current one
+	if req.Height == 0 {
+		req.Height = app.LastBlockHeight()
+	}
+
+	key := queryCacheKey(req.Path, req.Data, req.Height)
+	if res, ok := app.queryCache.get(key); ok {
+		return res
+	}
+
+	res := app.BaseApp.Query(req)
+	if res.IsOK() {
+		app.queryCache.set(key, res)
+	}
+
+	return res
+}
+
+// RegisterGRPCServer registers the gRPC query services of the BaseApp, serving their queries from the query cache when
+// enabled. The gRPC queries don't go through the ABCI Query, so the methods are wrapped to share its cache.
+func (app *InjectiveApp) RegisterGRPCServer(server gogogrpc.Server) {
+	if app.queryCache == nil {
+		app.BaseApp.RegisterGRPCServer(server)
+		return
+	}
+
+	app.BaseApp.RegisterGRPCServer(&queryCacheGRPCServer{Server: server, app: app})
+}
+
+// queryCacheGRPCServer wraps the gRPC server to serve the queries of the module Query services from the query cache.
+type queryCacheGRPCServer struct {
+	gogogrpc.Server
+
+	app *InjectiveApp
+}
+
+func (s *queryCacheGRPCServer) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	if !isQueryCacheService(desc.ServiceName) {
+		s.Server.RegisterService(desc, impl)
+		return
+	}
+
+	methods := make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    s.cachedHandler("/"+desc.ServiceName+"/"+method.MethodName, method.Handler),
+		}
+	}
+
+	cachedDesc := *desc
+	cachedDesc.Methods = methods
+	s.Server.RegisterService(&cachedDesc, impl)
+}
+
+// grpcMethodHandler is the handler of a unary gRPC method.
+type grpcMethodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// cachedHandler returns the handler of a gRPC query method serving its responses from the query cache. The cache is
+// keyed by the request bytes, and the responses are encoded as by the ABCI Query, so both share the cached responses.
+func (s *queryCacheGRPCServer) cachedHandler(path string, handler grpcMethodHandler) grpcMethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(srv, ctx, dec, interceptor)
+		}
+
+		var height int64
+		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+			var err error
+			if height, err = strconv.ParseInt(heightHeaders[0], 10, 64); err != nil {
+				// the BaseApp rejects the invalid heights
+				return handler(srv, ctx, dec, interceptor)
+			}
+		}
+
+		// as for the ABCI Query, a query without height is pinned to the last committed height
+		if height == 0 {
+			height = s.app.LastBlockHeight()
+			md = md.Copy()
+			md.Set(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+
+		var req rawQueryMessage
+		if err := dec(&req); err != nil {
+			return nil, err
+		}
+
+		key := queryCacheKey(path, req.bz, height)
+		if res, ok := s.app.queryCache.get(key); ok {
+			if err := grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))); err != nil {
+				s.app.Logger().Error("failed to set gRPC header", "err", err)
+			}
+			return &rawQueryMessage{bz: res.Value}, nil
+		}
+
+		// the request is decoded again into its type by the handler
+		res, err := handler(srv, ctx, dec, interceptor)
+		if err != nil {
+			return nil, err
+		}
+
+		if msg, ok := res.(codec.ProtoMarshaler); ok {
+			if bz, err := msg.Marshal(); err == nil {
+				s.app.queryCache.set(key, abci.ResponseQuery{Value: bz, Height: height})
+			}
+		}
+
+		return res, nil
+	}
+}
+
+var _ codec.ProtoMarshaler = &rawQueryMessage{}
+
+// rawQueryMessage holds an encoded gRPC query request or response, so that the requests are keyed and the cached
+// responses are served by their bytes.
+type rawQueryMessage struct {
+	bz []byte
+}
+
+func (m *rawQueryMessage) Reset()         { m.bz = nil }
+func (m *rawQueryMessage) String() string { return hex.EncodeToString(m.bz) }
+func (*rawQueryMessage) ProtoMessage()    {}
+func (m *rawQueryMessage) Size() int      { return len(m.bz) }
+
+func (m *rawQueryMessage) Marshal() ([]byte, error) {
+	return m.bz, nil
+}
+
+func (m *rawQueryMessage) MarshalTo(data []byte) (int, error) {
+	return copy(data, m.bz), nil
+}
+
+func (m *rawQueryMessage) MarshalToSizedBuffer(data []byte) (int, error) {
+	return copy(data[len(data)-len(m.bz):], m.bz), nil
+}
+
+func (m *rawQueryMessage) Unmarshal(data []byte) error {
+	m.bz = append([]byte(nil), data...)
+	return nil
+}
+
+// Commit implements the ABCI Commit, emptying the query cache once the new state is committed.
+func (app *InjectiveApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+
+	if app.queryCache != nil {
+		app.queryCache.reset()
+	}
+
+	return res
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	balanceQueryPath     = "/cosmos.bank.v1beta1.Query/Balance"
+	totalSupplyQueryPath = "/cosmos.bank.v1beta1.Query/TotalSupply"
+)
+
+func setupWithQueryCache() *InjectiveApp {
+	return SetupWithAppOptions(false, TestAppOptions{Values: map[string]interface{}{FlagQueryCache: true}})
+}
+
+func TestQueryCache(t *testing.T) {
+	app := setupWithQueryCache()
+	account := sdk.AccAddress("query_cache_account")
+
+	queryBalance := func() sdk.Coin {
+		req := banktypes.QueryBalanceRequest{Address: account.String(), Denom: "inj"}
+		res := app.Query(abci.RequestQuery{Path: balanceQueryPath, Data: app.AppCodec().MustMarshal(&req)})
+		require.True(t, res.IsOK(), res.Log)
+
+		var balance banktypes.QueryBalanceResponse
+		app.AppCodec().MustUnmarshal(res.Value, &balance)
+		return *balance.Balance
+	}
+
+	t.Run("is disabled by default", func(t *testing.T) {
+		require.Nil(t, Setup(false).queryCache)
+	})
+
+	t.Run("serves the repeated queries within a height", func(t *testing.T) {
+		require.Equal(t, "0inj", queryBalance().String())
+		require.Equal(t, "0inj", queryBalance().String())
+
+		hits, misses := app.queryCache.stats()
+		require.Equal(t, uint64(1), hits)
+		require.Equal(t, uint64(1), misses)
+	})
+
+	t.Run("never serves a query across a commit", func(t *testing.T) {
+		height := app.LastBlockHeight() + 1
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: height})
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin("inj", 100))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins))
+
+		// the state of the block isn't committed yet
+		require.Equal(t, "0inj", queryBalance().String())
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+		require.Empty(t, app.queryCache.entries)
+
+		require.Equal(t, "100inj", queryBalance().String())
+
+		hits, misses := app.queryCache.stats()
+		require.Equal(t, uint64(2), hits)
+		require.Equal(t, uint64(2), misses)
+	})
+
+	t.Run("computes the queries with proofs or errors", func(t *testing.T) {
+		// the balance query of the previous test is still cached at this height
+		cachedEntries := len(app.queryCache.entries)
+
+		req := banktypes.QueryBalanceRequest{Address: account.String(), Denom: "usdt"}
+		app.Query(abci.RequestQuery{Path: balanceQueryPath, Data: app.AppCodec().MustMarshal(&req), Prove: true})
+
+		invalidReq := banktypes.QueryBalanceRequest{Address: "invalid", Denom: "inj"}
+		res := app.Query(abci.RequestQuery{Path: balanceQueryPath, Data: app.AppCodec().MustMarshal(&invalidReq)})
+		require.False(t, res.IsOK())
+		require.Len(t, app.queryCache.entries, cachedEntries)
+	})
+
+	t.Run("computes the queries of the services other than the module Query services", func(t *testing.T) {
+		cachedEntries := len(app.queryCache.entries)
+
+		res := app.Query(abci.RequestQuery{Path: "/cosmos.base.reflection.v1beta1.ReflectionService/ListAllInterfaces"})
+		require.True(t, res.IsOK(), res.Log)
+		require.Len(t, app.queryCache.entries, cachedEntries)
+	})
+}
+
+// startGRPCServer serves the gRPC query services of the app in memory, as the gRPC server of the node does.
+func startGRPCServer(t *testing.T, app *InjectiveApp) banktypes.QueryClient {
+	t.Helper()
+
+	grpcCodec := codec.NewProtoCodec(app.InterfaceRegistry()).GRPCCodec()
+	server := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	app.RegisterGRPCServer(server)
+
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return banktypes.NewQueryClient(conn)
+}
+
+func TestQueryCacheGRPC(t *testing.T) {
+	app := setupWithQueryCache()
+	client := startGRPCServer(t, app)
+	account := sdk.AccAddress("query_cache_account")
+	req := &banktypes.QueryBalanceRequest{Address: account.String(), Denom: "inj"}
+
+	queryBalance := func() sdk.Coin {
+		res, err := client.Balance(context.Background(), req)
+		require.NoError(t, err)
+		return *res.Balance
+	}
+
+	require.Equal(t, "0inj", queryBalance().String())
+	require.Equal(t, "0inj", queryBalance().String())
+
+	hits, misses := app.queryCache.stats()
+	require.Equal(t, uint64(1), hits)
+	require.Equal(t, uint64(1), misses)
+
+	// the gRPC and ABCI queries share the cached responses
+	res := app.Query(abci.RequestQuery{Path: balanceQueryPath, Data: app.AppCodec().MustMarshal(req)})
+	require.True(t, res.IsOK(), res.Log)
+	hits, _ = app.queryCache.stats()
+	require.Equal(t, uint64(2), hits)
+
+	height := app.LastBlockHeight() + 1
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: height})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("inj", 100))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins))
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	require.Equal(t, "100inj", queryBalance().String())
+
+	// failed queries are not cached
+	_, err := client.Balance(context.Background(), &banktypes.QueryBalanceRequest{Address: "invalid", Denom: "inj"})
+	require.Error(t, err)
+	require.Len(t, app.queryCache.entries, 1)
+}
+
+func TestQueryCacheMaxEntries(t *testing.T) {
+	app := SetupWithAppOptions(false, TestAppOptions{Values: map[string]interface{}{
+		FlagQueryCache:           true,
+		FlagQueryCacheMaxEntries: 1,
+	}})
+
+	for _, denom := range []string{"inj", "usdt"} {
+		req := banktypes.QueryBalanceRequest{Address: sdk.AccAddress("query_cache_account").String(), Denom: denom}
+		res := app.Query(abci.RequestQuery{Path: balanceQueryPath, Data: app.AppCodec().MustMarshal(&req)})
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	require.Len(t, app.queryCache.entries, 1)
+}
+
+func BenchmarkQueryCache(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		app  *InjectiveApp
+	}{
+		{"disabled", Setup(false)},
+		{"enabled", setupWithQueryCache()},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			req := abci.RequestQuery{
+				Path: totalSupplyQueryPath,
+				Data: bc.app.AppCodec().MustMarshal(&banktypes.QueryTotalSupplyRequest{}),
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if res := bc.app.Query(req); !res.IsOK() {
+					b.Fatal(res.Log)
+				}
+			}
+			b.StopTimer()
+
+			if bc.app.queryCache != nil {
+				hits, _ := bc.app.queryCache.stats()
+				b.ReportMetric(float64(hits)/float64(b.N), "hits/op")
+			}
+		})
+	}
+}
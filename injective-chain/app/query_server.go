@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+var _ apptypes.QueryServer = queryServer{}
+
+// queryServer serves the app-level queries, which aggregate the state of several modules, from the
+// committed state of the queried height.
+type queryServer struct {
+	app *InjectiveApp
+}
+
+func newQueryServer(app *InjectiveApp) apptypes.QueryServer {
+	return queryServer{app: app}
+}
+
+func (q queryServer) ModuleVersions(c context.Context, _ *apptypes.QueryModuleVersionsRequest) (*apptypes.QueryModuleVersionsResponse, error) {
+	return &apptypes.QueryModuleVersionsResponse{ModuleVersions: q.app.ModuleVersions(sdk.UnwrapSDKContext(c))}, nil
+}
+
+func (q queryServer) ValidatorRewardSummary(c context.Context, req *apptypes.QueryValidatorRewardSummaryRequest) (*apptypes.QueryValidatorRewardSummaryResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	summary, err := q.app.ValidatorRewardSummary(sdk.UnwrapSDKContext(c), valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apptypes.QueryValidatorRewardSummaryResponse{Summary: *summary}, nil
+}
+
+func (q queryServer) StoreSizes(c context.Context, req *apptypes.QueryStoreSizesRequest) (*apptypes.QueryStoreSizesResponse, error) {
+	return &apptypes.QueryStoreSizesResponse{StoreSizes: q.app.StoreSizes(sdk.UnwrapSDKContext(c), req.MaxKeysPerStore)}, nil
+}
+
+func (q queryServer) AllParams(c context.Context, _ *apptypes.QueryAllParamsRequest) (*apptypes.QueryAllParamsResponse, error) {
+	params, err := q.app.AllParams(sdk.UnwrapSDKContext(c))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apptypes.QueryAllParamsResponse{Params: params}, nil
+}
+
+func (q queryServer) HistoricalBalance(c context.Context, req *apptypes.QueryHistoricalBalanceRequest) (*apptypes.QueryHistoricalBalanceResponse, error) {
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = sdk.UnwrapSDKContext(c).BlockHeight()
+	}
+
+	balance, err := q.app.HistoricalBalance(address, req.Denom, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apptypes.QueryHistoricalBalanceResponse{Balance: balance, Height: height}, nil
+}
+
+func (q queryServer) ProposalsByModule(c context.Context, req *apptypes.QueryProposalsByModuleRequest) (*apptypes.QueryProposalsByModuleResponse, error) {
+	proposals, pageRes, err := q.app.ProposalsByModule(sdk.UnwrapSDKContext(c), req.ModuleName, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apptypes.QueryProposalsByModuleResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+func (q queryServer) AccountGovParticipation(c context.Context, req *apptypes.QueryAccountGovParticipationRequest) (*apptypes.QueryAccountGovParticipationResponse, error) {
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	participations, pageRes, err := q.app.AccountGovParticipation(sdk.UnwrapSDKContext(c), address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apptypes.QueryAccountGovParticipationResponse{Participations: participations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ModuleStateRoot(c context.Context, req *apptypes.QueryModuleStateRootRequest) (*apptypes.QueryModuleStateRootResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	root, err := q.app.ModuleStateRoot(ctx, req.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apptypes.QueryModuleStateRootResponse{Height: ctx.BlockHeight(), Root: root}, nil
+}
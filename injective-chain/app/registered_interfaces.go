@@ -0,0 +1,40 @@
+package app
+
+import (
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// RegisteredInterface is an interface of the interface registry with the type URLs of its registered
+// implementations.
+type RegisteredInterface struct {
+	Name            string   `json:"name"`
+	Implementations []string `json:"implementations"`
+}
+
+// RegisteredInterfaces returns every interface registered in the app's interface registry with the type URLs
+// of its implementations, both sorted. An interface without implementations can't be decoded from an Any.
+func (app *InjectiveApp) RegisteredInterfaces() []RegisteredInterface {
+	return ListRegisteredInterfaces(app.interfaceRegistry)
+}
+
+// ListRegisteredInterfaces returns every interface of the interface registry with the type URLs of its
+// implementations, both sorted.
+func ListRegisteredInterfaces(registry codectypes.InterfaceRegistry) []RegisteredInterface {
+	names := registry.ListAllInterfaces()
+	sort.Strings(names)
+
+	interfaces := make([]RegisteredInterface, 0, len(names))
+	for _, name := range names {
+		implementations := registry.ListImplementations(name)
+		sort.Strings(implementations)
+
+		interfaces = append(interfaces, RegisteredInterface{
+			Name:            name,
+			Implementations: implementations,
+		})
+	}
+
+	return interfaces
+}
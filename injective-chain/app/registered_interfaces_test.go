@@ -0,0 +1,32 @@
+package app
+
+import (
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestRegisteredInterfaces(t *testing.T) {
+	app := Setup(false)
+
+	interfaces := app.RegisteredInterfaces()
+	require.NotEmpty(t, interfaces)
+	require.True(t, sort.SliceIsSorted(interfaces, func(i, j int) bool {
+		return interfaces[i].Name < interfaces[j].Name
+	}))
+
+	var msgImplementations []string
+	for _, iface := range interfaces {
+		require.True(t, sort.StringsAreSorted(iface.Implementations), "interface %s", iface.Name)
+
+		if iface.Name == sdk.MsgInterfaceProtoName {
+			msgImplementations = iface.Implementations
+		}
+	}
+
+	require.Contains(t, msgImplementations, sdk.MsgTypeURL(&exchangetypes.MsgCreateSpotLimitOrder{}))
+}
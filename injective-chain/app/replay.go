@@ -0,0 +1,411 @@
+package app
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	tmtypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/iavl"
+)
+
+// ReplayBlockStore provides the committed blocks to replay, like the CometBFT block store of the node.
+type ReplayBlockStore interface {
+	LoadBlock(height int64) *tmtypes.Block
+}
+
+// ReplayStateStore provides the validator sets of the committed blocks to replay, like the CometBFT state store of the
+// node.
+type ReplayStateStore interface {
+	LoadValidators(height int64) (*tmtypes.ValidatorSet, error)
+}
+
+var (
+	_ ReplayBlockStore = (*store.BlockStore)(nil)
+	_ ReplayStateStore = sm.Store(nil)
+)
+
+// SetReplayStores sets the stores from which ReplayBlock loads the blocks to replay.
+func (app *InjectiveApp) SetReplayStores(blockStore ReplayBlockStore, stateStore ReplayStateStore) {
+	app.replayBlockStore = blockStore
+	app.replayStateStore = stateStore
+}
+
+// ReplayBlock re-executes the committed block at the given height with the given txs on top of the committed state at
+// height-1 and returns the tx results and the resulting app hash. The header, the last commit votes and the
+// misbehaviors of the block are loaded from the stores set by SetReplayStores, so that the block is processed as in
+// the original one.
+//
+// Nothing is persisted: the state is loaded into a separate root multistore whose writes only reach an in-memory
+// overlay of the app DB, so the node's own state is never modified. The versions committed after the previous
+// height are dropped from the overlay, so replaying an old block takes as long as the state written since. An error
+// is returned if the state at the previous height has been pruned. The stores added by an upgrade at the replayed
+// height start empty, as they did in the original block.
+func (app *InjectiveApp) ReplayBlock(height int64, txs [][]byte) ([]abci.ResponseDeliverTx, []byte, error) {
+	if height <= 1 || height > app.LastBlockHeight() {
+		return nil, nil, fmt.Errorf("cannot replay block %d, the last committed block is %d", height, app.LastBlockHeight())
+	}
+
+	req, err := app.loadReplayBeginBlockRequest(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cms, err := app.loadReplayStore(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cacheStore := cms.CacheMultiStore()
+	ctx := sdk.NewContext(cacheStore, req.Header, false, app.Logger()).WithHeaderHash(req.Hash)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	app.BeginBlocker(ctx, req)
+
+	results := make([]abci.ResponseDeliverTx, 0, len(txs))
+	for _, txBytes := range txs {
+		results = append(results, app.replayTx(ctx, txBytes))
+	}
+
+	app.EndBlocker(ctx, abci.RequestEndBlock{Height: height})
+
+	cacheStore.Write()
+	return results, cms.Commit().Hash, nil
+}
+
+// loadReplayBeginBlockRequest builds the BeginBlock request of the committed block at the given height like CometBFT
+// does: the header and the misbehaviors come from the block, while the last commit votes are matched with the
+// validator set of the previous height.
+func (app *InjectiveApp) loadReplayBeginBlockRequest(height int64) (abci.RequestBeginBlock, error) {
+	if app.replayBlockStore == nil || app.replayStateStore == nil {
+		return abci.RequestBeginBlock{}, fmt.Errorf("cannot replay block %d, the block and state stores are not set", height)
+	}
+
+	block := app.replayBlockStore.LoadBlock(height)
+	if block == nil {
+		return abci.RequestBeginBlock{}, fmt.Errorf("block %d is not available in the block store", height)
+	}
+
+	var commitInfo abci.CommitInfo
+	if block.LastCommit.Size() > 0 {
+		lastValidators, err := app.replayStateStore.LoadValidators(height - 1)
+		if err != nil {
+			return abci.RequestBeginBlock{}, errors.Wrapf(err, "validator set of block %d is not available", height-1)
+		}
+		if len(lastValidators.Validators) != block.LastCommit.Size() {
+			return abci.RequestBeginBlock{}, fmt.Errorf("last commit of block %d has %d signatures for %d validators", height, block.LastCommit.Size(), len(lastValidators.Validators))
+		}
+
+		votes := make([]abci.VoteInfo, 0, len(lastValidators.Validators))
+		for i, validator := range lastValidators.Validators {
+			votes = append(votes, abci.VoteInfo{
+				Validator:       tmtypes.TM2PB.Validator(validator),
+				SignedLastBlock: block.LastCommit.Signatures[i].BlockIDFlag != tmtypes.BlockIDFlagAbsent,
+			})
+		}
+		commitInfo = abci.CommitInfo{Round: block.LastCommit.Round, Votes: votes}
+	}
+
+	// hashing the block fills the hashes of its header
+	hash := block.Hash()
+	return abci.RequestBeginBlock{
+		Hash:                hash,
+		Header:              *block.Header.ToProto(),
+		LastCommitInfo:      commitInfo,
+		ByzantineValidators: block.Evidence.Evidence.ToABCI(),
+	}, nil
+}
+
+// loadReplayStore loads the state at height-1 into a root multistore with the stores of the app, on top of an
+// overlay of the app DB so that committing it never writes to the app DB.
+func (app *InjectiveApp) loadReplayStore(height int64) (*rootmulti.Store, error) {
+	cms := rootmulti.NewStore(newOverlayDB(app.db), app.Logger())
+	cms.SetIAVLDisableFastNode(true)
+	cms.SetLazyLoading(true)
+
+	previousInfo, err := cms.GetCommitInfo(height - 1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "state at height %d is not available", height-1)
+	}
+	commitInfo, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "commit info of block %d is not available", height)
+	}
+
+	previousStores := make(map[string]struct{}, len(previousInfo.StoreInfos))
+	for _, storeInfo := range previousInfo.StoreInfos {
+		previousStores[storeInfo.Name] = struct{}{}
+	}
+
+	// the stores committed at height for the first time were added by an upgrade in the replayed block, they start
+	// empty at height like they did on the upgrade
+	upgrades := &storetypes.StoreUpgrades{}
+	committedStores := make(map[string]struct{}, len(commitInfo.StoreInfos))
+	for _, storeInfo := range commitInfo.StoreInfos {
+		committedStores[storeInfo.Name] = struct{}{}
+		if _, ok := previousStores[storeInfo.Name]; !ok {
+			upgrades.Added = append(upgrades.Added, storeInfo.Name)
+		}
+	}
+
+	for name, key := range app.keys {
+		if _, ok := committedStores[name]; !ok {
+			return nil, fmt.Errorf("store %s did not exist at height %d, the block must be replayed with the binary of that height", name, height)
+		}
+
+		if upgrades.IsAdded(name) {
+			cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, dbm.NewMemDB())
+		} else {
+			cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+	}
+	for _, key := range app.tkeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	for _, key := range app.memKeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
+	}
+
+	if err := cms.LoadVersionAndUpgrade(height-1, upgrades); err != nil {
+		if errors.IsOf(err, iavl.ErrVersionDoesNotExist) {
+			return nil, errors.Wrapf(err, "state at height %d has been pruned", height-1)
+		}
+		return nil, errors.Wrapf(err, "failed to load the state at height %d", height-1)
+	}
+
+	// the versions committed since height-1 are dropped from the overlay, so that the replayed block is committed
+	// as a new version rather than checked against the committed one
+	for name, key := range app.keys {
+		if upgrades.IsAdded(name) {
+			continue
+		}
+		if _, err := cms.GetCommitKVStore(key).(*iavlstore.Store).LoadVersionForOverwriting(height - 1); err != nil {
+			return nil, errors.Wrapf(err, "failed to load store %s at height %d", name, height-1)
+		}
+	}
+
+	return cms, nil
+}
+
+// SetPostHandler sets the post handler of the BaseApp, also kept to replay historical blocks.
+func (app *InjectiveApp) SetPostHandler(postHandler sdk.PostHandler) {
+	app.postHandler = postHandler
+	app.BaseApp.SetPostHandler(postHandler)
+}
+
+// replayTx runs the ante handler, the messages and the post handler of the tx like BaseApp does in DeliverTx mode:
+// the ante handler changes are kept when the messages fail, while the message and post handler changes are only
+// kept if all of them succeed.
+func (app *InjectiveApp) replayTx(ctx sdk.Context, txBytes []byte) (res abci.ResponseDeliverTx) {
+	ctx = ctx.WithTxBytes(txBytes).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			res = replayTxError(errors.Wrapf(sdkerrors.ErrPanic, "recovered: %v", r), ctx)
+		}
+	}()
+
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return replayTxError(err, ctx)
+	}
+
+	anteCtx, writeAnteCache := ctx.CacheContext()
+	anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+
+	newCtx, err := app.anteHandler(anteCtx, tx, false)
+	if err != nil {
+		return replayTxError(err, newCtx)
+	}
+	writeAnteCache()
+
+	ctx = ctx.WithGasMeter(newCtx.GasMeter())
+	events := newCtx.EventManager().ABCIEvents()
+
+	msgCtx, writeMsgCache := ctx.CacheContext()
+	msgResponses := make([]*codectypes.Any, 0, len(tx.GetMsgs()))
+
+	for i, msg := range tx.GetMsgs() {
+		handler := app.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			return replayTxError(errors.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg), msgCtx)
+		}
+
+		msgResult, err := handler(msgCtx.WithEventManager(sdk.NewEventManager()), msg)
+		if err != nil {
+			return replayTxError(errors.Wrapf(err, "failed to execute message; message index: %d", i), msgCtx)
+		}
+
+		events = append(events, msgResult.Events...)
+		msgResponses = append(msgResponses, msgResult.MsgResponses...)
+	}
+
+	// the post handler changes are discarded with the message changes when it fails
+	if app.postHandler != nil {
+		postCtx, err := app.postHandler(msgCtx.WithEventManager(sdk.NewEventManager()), tx, false, true)
+		if err != nil {
+			return replayTxError(err, msgCtx)
+		}
+		events = append(events, postCtx.EventManager().ABCIEvents()...)
+	}
+	writeMsgCache()
+
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
+	if err != nil {
+		return replayTxError(err, ctx)
+	}
+
+	return abci.ResponseDeliverTx{
+		GasWanted: int64(ctx.GasMeter().Limit()),
+		GasUsed:   int64(ctx.GasMeter().GasConsumed()),
+		Data:      data,
+		Events:    events,
+	}
+}
+
+func replayTxError(err error, ctx sdk.Context) abci.ResponseDeliverTx {
+	codespace, code, log := errors.ABCIInfo(err, false)
+
+	res := abci.ResponseDeliverTx{
+		Codespace: codespace,
+		Code:      code,
+		Log:       log,
+	}
+
+	if gasMeter := ctx.GasMeter(); gasMeter != nil {
+		res.GasWanted = int64(gasMeter.Limit())
+		res.GasUsed = int64(gasMeter.GasConsumed())
+	}
+
+	return res
+}
+
+// overlayDB is an in-memory overlay of a DB: reads see the writes made through the overlay on top of the DB, while
+// the DB itself is never written to.
+type overlayDB struct {
+	store *cachekv.Store
+}
+
+var _ dbm.DB = &overlayDB{}
+
+func newOverlayDB(db dbm.DB) *overlayDB {
+	return &overlayDB{store: cachekv.NewStore(dbadapter.Store{DB: db})}
+}
+
+func (db *overlayDB) Get(key []byte) ([]byte, error) {
+	return db.store.Get(key), nil
+}
+
+func (db *overlayDB) Has(key []byte) (bool, error) {
+	return db.store.Has(key), nil
+}
+
+func (db *overlayDB) Set(key, value []byte) error {
+	db.store.Set(key, value)
+	return nil
+}
+
+func (db *overlayDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+func (db *overlayDB) Delete(key []byte) error {
+	db.store.Delete(key)
+	return nil
+}
+
+func (db *overlayDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+func (db *overlayDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return overlayIterator{db.store.Iterator(start, end)}, nil
+}
+
+func (db *overlayDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return overlayIterator{db.store.ReverseIterator(start, end)}, nil
+}
+
+func (*overlayDB) Close() error {
+	return nil
+}
+
+func (db *overlayDB) NewBatch() dbm.Batch {
+	return &overlayBatch{db: db}
+}
+
+func (*overlayDB) Print() error {
+	return nil
+}
+
+func (*overlayDB) Stats() map[string]string {
+	return nil
+}
+
+// overlayIterator is an iterator of the overlay. The store iterators report an error once exhausted, while the DB
+// iterators don't.
+type overlayIterator struct {
+	dbm.Iterator
+}
+
+func (it overlayIterator) Error() error {
+	if !it.Valid() {
+		return nil
+	}
+	return it.Iterator.Error()
+}
+
+// overlayBatch holds the writes of a batch until it's written to the overlay.
+type overlayBatch struct {
+	db     *overlayDB
+	writes []overlayWrite
+}
+
+type overlayWrite struct {
+	key, value []byte
+	delete     bool
+}
+
+func (b *overlayBatch) Set(key, value []byte) error {
+	// the caller may reuse the buffers once the batch is written
+	b.writes = append(b.writes, overlayWrite{key: append([]byte{}, key...), value: append([]byte{}, value...)})
+	return nil
+}
+
+func (b *overlayBatch) Delete(key []byte) error {
+	b.writes = append(b.writes, overlayWrite{key: append([]byte{}, key...), delete: true})
+	return nil
+}
+
+func (b *overlayBatch) Write() error {
+	for _, write := range b.writes {
+		if write.delete {
+			b.db.store.Delete(write.key)
+		} else {
+			b.db.store.Set(write.key, write.value)
+		}
+	}
+	b.writes = nil
+	return nil
+}
+
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+func (b *overlayBatch) Close() error {
+	b.writes = nil
+	return nil
+}
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// replayStores keeps the blocks and the validator sets committed by the test, like the CometBFT stores of a node
+type replayStores struct {
+	blocks     map[int64]*tmtypes.Block
+	validators map[int64]*tmtypes.ValidatorSet
+}
+
+func (s replayStores) LoadBlock(height int64) *tmtypes.Block {
+	return s.blocks[height]
+}
+
+func (s replayStores) LoadValidators(height int64) (*tmtypes.ValidatorSet, error) {
+	validators, ok := s.validators[height]
+	if !ok {
+		return nil, fmt.Errorf("no validator set at height %d", height)
+	}
+	return validators, nil
+}
+
+func TestReplayBlock(t *testing.T) {
+	app := Setup(false)
+	encodingConfig := MakeEncodingConfig()
+	blockTime := time.Now().UTC()
+
+	stores := replayStores{
+		blocks:     make(map[int64]*tmtypes.Block),
+		validators: make(map[int64]*tmtypes.ValidatorSet),
+	}
+	app.SetReplayStores(stores, stores)
+
+	bondedValidators := app.StakingKeeper.GetLastValidators(app.NewContext(true, tmproto.Header{}))
+	require.Len(t, bondedValidators, 1)
+	consPubKey, err := bondedValidators[0].ConsPubKey()
+	require.NoError(t, err)
+	tmPubKey, err := cryptocodec.ToTmPubKeyInterface(consPubKey)
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(tmPubKey, bondedValidators[0].ConsensusPower(sdk.DefaultPowerReduction))
+	validators := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	finalizeBlock := func(height int64) []byte {
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+		return app.LastCommitID().Hash
+	}
+
+	// beginBlock begins a block signed by the validator, with the BeginBlock request CometBFT builds for it
+	beginBlock := func() tmproto.Header {
+		blockTime = blockTime.Add(time.Second)
+		height := app.LastBlockHeight() + 1
+		block := &tmtypes.Block{
+			Header: tmtypes.Header{
+				Height:             height,
+				Time:               blockTime,
+				AppHash:            app.LastCommitID().Hash,
+				ValidatorsHash:     validators.Hash(),
+				NextValidatorsHash: validators.Hash(),
+				ProposerAddress:    validator.Address,
+			},
+			LastCommit: &tmtypes.Commit{
+				Height: height - 1,
+				Signatures: []tmtypes.CommitSig{{
+					BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+					ValidatorAddress: validator.Address,
+					Timestamp:        blockTime,
+				}},
+			},
+		}
+		stores.blocks[height] = block
+		stores.validators[height-1] = validators
+
+		hash := block.Hash()
+		header := *block.Header.ToProto()
+		app.BeginBlock(abci.RequestBeginBlock{
+			Hash:   hash,
+			Header: header,
+			LastCommitInfo: abci.CommitInfo{Votes: []abci.VoteInfo{{
+				Validator:       abci.Validator{Address: validator.Address, Power: validator.VotingPower},
+				SignedLastBlock: true,
+			}}},
+		})
+		return header
+	}
+
+	// fund the sender and track the signatures of the validator in the block left open by Setup
+	senderKey := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(senderKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	consAddress := sdk.ConsAddress(validator.Address)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddress, slashingtypes.NewValidatorSigningInfo(consAddress, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, sender))
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("inj", 1_000_000))))
+	finalizeBlock(app.LastBlockHeight() + 1)
+
+	// commit a block with a bank send
+	header := beginBlock()
+	senderAccount := app.AccountKeeper.GetAccount(app.NewContext(false, header), sender)
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		encodingConfig.TxConfig,
+		[]sdk.Msg{banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("inj", 1000)))},
+		sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas,
+		header.ChainID,
+		[]uint64{senderAccount.GetAccountNumber()},
+		[]uint64{senderAccount.GetSequence()},
+		senderKey,
+	)
+	require.NoError(t, err)
+
+	txBytes, err := encodingConfig.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, deliverRes.IsOK(), deliverRes.Log)
+	committedHash := finalizeBlock(header.Height)
+
+	// replay the block on top of the previous state, with a post handler only emitting an event
+	app.postHandler = func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent("post_handler"))
+		return ctx, nil
+	}
+
+	results, appHash, err := app.ReplayBlock(header.Height, [][]byte{txBytes})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.True(t, results[0].IsOK(), results[0].Log)
+	require.Equal(t, "post_handler", results[0].Events[len(results[0].Events)-1].Type)
+	require.Equal(t, committedHash, appHash)
+
+	// replaying never modifies the committed state
+	require.Equal(t, header.Height, app.LastBlockHeight())
+	require.Equal(t, committedHash, app.LastCommitID().Hash)
+	require.Equal(t, int64(1000), app.BankKeeper.GetBalance(app.NewContext(true, tmproto.Header{}), recipient, "inj").Amount.Int64())
+
+	// replaying without the tx results in a different app hash
+	_, appHash, err = app.ReplayBlock(header.Height, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, committedHash, appHash)
+
+	// a block that has not been committed yet cannot be replayed
+	_, _, err = app.ReplayBlock(header.Height+1, nil)
+	require.Error(t, err)
+
+	// once the previous state is pruned, the block cannot be replayed
+	beginBlock()
+	finalizeBlock(header.Height + 1)
+	require.NoError(t, app.CommitMultiStore().(*rootmulti.Store).PruneStores(false, []int64{header.Height - 1}))
+
+	_, _, err = app.ReplayBlock(header.Height, [][]byte{txBytes})
+	require.ErrorContains(t, err, "pruned")
+}
@@ -0,0 +1,711 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// RewardBatchingParamsSubspace is the name of the params subspace holding the number of validators credited with
+	// their rewards per block. The value can be queried with
+	// `injectived query params subspace reward_batching RewardValidatorsPerBlock` and updated through a parameter
+	// change proposal.
+	RewardBatchingParamsSubspace = "reward_batching"
+
+	// RewardBatchingStoreKey is the store holding the reward index, the snapshots of the validators and the cursor of
+	// the current crediting cycle.
+	RewardBatchingStoreKey = "reward_batching"
+
+	// RewardBatchingModuleName is the name of the module exporting the batching state in genesis, and of the module
+	// account holding the fees of the validators until they are credited.
+	RewardBatchingModuleName = "reward_batching"
+)
+
+var _ paramtypes.ParamSet = &RewardBatchingParams{}
+
+// Parameter keys
+var (
+	KeyRewardValidatorsPerBlock = []byte("RewardValidatorsPerBlock")
+)
+
+var (
+	// rewardSnapshotsPrefix prefixes the reward index at which each validator of the vote set was last credited,
+	// keyed by consensus address
+	rewardSnapshotsPrefix = []byte{0x01}
+	// rewardCursorKey is the consensus address from which the current crediting cycle resumes
+	rewardCursorKey = []byte{0x02}
+	// rewardIndexKey is the cumulative reward accrued per unit of voting power while batching
+	rewardIndexKey = []byte{0x03}
+	// rewardVotesKey is the vote set the reward index was last accrued with
+	rewardVotesKey = []byte{0x04}
+)
+
+// RewardBatchingParams defines the governance controlled batching of the validator rewards.
+type RewardBatchingParams struct {
+	// ValidatorsPerBlock is the max number of validators credited with their commission and rewards in a block. Once
+	// more validators sign a block, the rewards are credited in batches over the next blocks. Zero disables the
+	// batching.
+	ValidatorsPerBlock uint64 `json:"validators_per_block" yaml:"validators_per_block"`
+}
+
+// RewardBatchingParamKeyTable returns the parameter key table.
+func RewardBatchingParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&RewardBatchingParams{})
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *RewardBatchingParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRewardValidatorsPerBlock, &p.ValidatorsPerBlock, validateRewardValidatorsPerBlock),
+	}
+}
+
+// Validate performs basic validation on the reward batching parameters.
+func (p RewardBatchingParams) Validate() error {
+	if err := validateRewardValidatorsPerBlock(p.ValidatorsPerBlock); err != nil {
+		return fmt.Errorf("validators_per_block is incorrect: %w", err)
+	}
+
+	return nil
+}
+
+func validateRewardValidatorsPerBlock(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// rewardBatcher accrues the fees of a block to the validators which signed it and credits them as commission and
+// rewards in bounded batches.
+//
+// While batching, the share of the validators of the fees is held by the reward_batching module account, out of reach
+// of community pool spends, and only accrued to a single reward index, the cumulative reward per unit of voting power.
+// The index at which each validator was last credited is kept in a snapshot, so the rewards pending for a validator
+// are the index increase since its snapshot times its voting power. Crediting them moves them from the module account
+// to the commission and the current rewards of the validator. The community tax is moved to the community pool right
+// away, while the rounding dust of the index stays in the module account until the batching ends.
+//
+// Validators are credited in ascending consensus address order, starting from a cursor kept in state, so that every
+// validator of the vote set is credited exactly once per cycle. A validator is also credited before its voting power
+// changes or it leaves the vote set, and through the staking hooks before its delegations, commission or stake
+// change, so that its pending rewards are always split with the delegations and commission they were accrued with.
+type rewardBatcher struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	subspace      paramtypes.Subspace
+	accountKeeper distrtypes.AccountKeeper
+	bankKeeper    distrtypes.BankKeeper
+	stakingKeeper distrtypes.StakingKeeper
+	distrKeeper   distrkeeper.Keeper
+}
+
+func (app *InjectiveApp) newRewardBatcher() rewardBatcher {
+	return rewardBatcher{
+		cdc:           app.appCodec,
+		storeKey:      app.keys[RewardBatchingStoreKey],
+		subspace:      app.GetSubspace(RewardBatchingParamsSubspace),
+		accountKeeper: app.AccountKeeper,
+		bankKeeper:    app.BankKeeper,
+		stakingKeeper: app.StakingKeeper,
+		distrKeeper:   app.DistrKeeper,
+	}
+}
+
+func (b rewardBatcher) validatorsPerBlock(ctx sdk.Context) uint64 {
+	var validatorsPerBlock uint64
+	b.subspace.GetIfExists(ctx, KeyRewardValidatorsPerBlock, &validatorsPerBlock)
+	return validatorsPerBlock
+}
+
+func (b rewardBatcher) isBatching(ctx sdk.Context) bool {
+	return ctx.KVStore(b.storeKey).Has(rewardVotesKey)
+}
+
+// updateVotes credits the validators whose voting power changed or which left the vote set with the rewards accrued
+// at their previous power, and starts tracking the validators which joined it. The vote set is compared in memory, so
+// only the validators whose vote changed are read from or written to the store. It returns the voting powers of the
+// vote set by consensus address and their total.
+func (b rewardBatcher) updateVotes(ctx sdk.Context, votes []abci.VoteInfo) (powers map[string]int64, totalPower int64) {
+	powers = make(map[string]int64, len(votes))
+	for _, vote := range votes {
+		powers[string(vote.Validator.Address)] = vote.Validator.Power
+		totalPower += vote.Validator.Power
+	}
+
+	prevVotes := b.getVotes(ctx)
+	prevPowers := make(map[string]int64, len(prevVotes))
+	changed := len(prevVotes) != len(votes)
+
+	for _, prevVote := range prevVotes {
+		consAddr := sdk.ConsAddress(prevVote.Validator.Address)
+		prevPowers[string(consAddr)] = prevVote.Validator.Power
+
+		power, found := powers[string(consAddr)]
+		if found && power == prevVote.Validator.Power {
+			continue
+		}
+
+		b.creditRewards(ctx, consAddr, prevVote.Validator.Power)
+		if !found {
+			prefix.NewStore(ctx.KVStore(b.storeKey), rewardSnapshotsPrefix).Delete(consAddr)
+		}
+		changed = true
+	}
+
+	var index sdk.DecCoins
+	for _, vote := range votes {
+		if _, found := prevPowers[string(vote.Validator.Address)]; found {
+			continue
+		}
+
+		if index == nil {
+			index = b.getRewardIndex(ctx)
+		}
+		b.setRewardSnapshot(ctx, sdk.ConsAddress(vote.Validator.Address), index)
+		changed = true
+	}
+
+	if changed {
+		b.setVotes(ctx, votes)
+	}
+
+	return powers, totalPower
+}
+
+// accrueRewards mirrors the token allocation of the distribution module, except that the share of the validators is
+// moved to the reward_batching module account and accrued to the reward index instead of being credited.
+func (b rewardBatcher) accrueRewards(ctx sdk.Context, totalPower int64) {
+	feeCollector := b.accountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
+	feesCollectedInt := b.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
+	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
+
+	if err := b.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, RewardBatchingModuleName, feesCollectedInt); err != nil {
+		panic(err)
+	}
+
+	validatorsShare := sdk.DecCoins{}
+	if totalPower > 0 {
+		feeMultiplier := feesCollected.MulDecTruncate(sdk.OneDec().Sub(b.distrKeeper.GetCommunityTax(ctx)))
+		indexIncrease := feeMultiplier.QuoDecTruncate(sdk.NewDec(totalPower))
+		b.setRewardIndex(ctx, b.getRewardIndex(ctx).Add(indexIncrease...))
+		validatorsShare = indexIncrease.MulDecTruncate(sdk.NewDec(totalPower))
+	}
+
+	// the rounding dust of the community tax stays in the module account along with that of the index
+	communityTax, _ := feesCollected.Sub(validatorsShare).TruncateDecimal()
+	b.fundCommunityPool(ctx, communityTax)
+}
+
+// creditPendingRewards credits the pending rewards of at most limit validators of the vote set with the given voting
+// powers, resuming from the cursor of the current cycle.
+func (b rewardBatcher) creditPendingRewards(ctx sdk.Context, limit uint64, powers map[string]int64) {
+	store := ctx.KVStore(b.storeKey)
+
+	consAddrs := make([]sdk.ConsAddress, 0)
+	var nextCursor []byte
+
+	iterator := prefix.NewStore(store, rewardSnapshotsPrefix).Iterator(store.Get(rewardCursorKey), nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(consAddrs)) == limit {
+			nextCursor = append([]byte{}, iterator.Key()...)
+			break
+		}
+
+		consAddrs = append(consAddrs, append(sdk.ConsAddress{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, consAddr := range consAddrs {
+		b.creditRewards(ctx, consAddr, powers[string(consAddr)])
+	}
+
+	if nextCursor == nil {
+		store.Delete(rewardCursorKey)
+	} else {
+		store.Set(rewardCursorKey, nextCursor)
+	}
+}
+
+// creditAllPendingRewards credits the pending rewards of every validator and clears the batching state. The rounding
+// dust left in the reward_batching module account is moved to the community pool.
+func (b rewardBatcher) creditAllPendingRewards(ctx sdk.Context) {
+	store := ctx.KVStore(b.storeKey)
+	snapshotsStore := prefix.NewStore(store, rewardSnapshotsPrefix)
+
+	for _, vote := range b.getVotes(ctx) {
+		consAddr := sdk.ConsAddress(vote.Validator.Address)
+		b.creditRewards(ctx, consAddr, vote.Validator.Power)
+		snapshotsStore.Delete(consAddr)
+	}
+
+	store.Delete(rewardCursorKey)
+	store.Delete(rewardIndexKey)
+	store.Delete(rewardVotesKey)
+
+	dust := b.bankKeeper.GetAllBalances(ctx, b.accountKeeper.GetModuleAddress(RewardBatchingModuleName))
+	b.fundCommunityPool(ctx, dust)
+}
+
+// creditRewards credits a validator with the rewards accrued at the given voting power since its snapshot, and moves
+// its snapshot to the current reward index. Only whole coins leave the reward_batching module account, so that the
+// distribution module account always holds its outstanding rewards and community pool. The rewards of a validator
+// which no longer exists are moved to the community pool, as the distribution module does for its remaining rewards.
+func (b rewardBatcher) creditRewards(ctx sdk.Context, consAddr sdk.ConsAddress, power int64) {
+	snapshot, found := b.getRewardSnapshot(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	index := b.getRewardIndex(ctx)
+	b.setRewardSnapshot(ctx, consAddr, index)
+
+	rewards, _ := index.Sub(snapshot).MulDecTruncate(sdk.NewDec(power)).TruncateDecimal()
+	if rewards.IsZero() {
+		return
+	}
+
+	validator := b.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		b.fundCommunityPool(ctx, rewards)
+		return
+	}
+
+	if err := b.bankKeeper.SendCoinsFromModuleToModule(ctx, RewardBatchingModuleName, distrtypes.ModuleName, rewards); err != nil {
+		panic(err)
+	}
+	b.distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+}
+
+// fundCommunityPool moves the coins from the reward_batching module account to the community pool.
+func (b rewardBatcher) fundCommunityPool(ctx sdk.Context, amount sdk.Coins) {
+	if amount.IsZero() {
+		return
+	}
+
+	if err := b.bankKeeper.SendCoinsFromModuleToModule(ctx, RewardBatchingModuleName, distrtypes.ModuleName, amount); err != nil {
+		panic(err)
+	}
+
+	feePool := b.distrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	b.distrKeeper.SetFeePool(ctx, feePool)
+}
+
+// flushRewards credits a validator of the vote set with its pending rewards.
+func (b rewardBatcher) flushRewards(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	if !b.isBatching(ctx) {
+		return nil
+	}
+
+	validator := b.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if power, found := b.getVotingPowers(ctx)[string(consAddr)]; found {
+		b.creditRewards(ctx, consAddr, power)
+	}
+	return nil
+}
+
+func (b rewardBatcher) getRewardIndex(ctx sdk.Context) sdk.DecCoins {
+	bz := ctx.KVStore(b.storeKey).Get(rewardIndexKey)
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var index distrtypes.ValidatorHistoricalRewards
+	b.cdc.MustUnmarshal(bz, &index)
+	return index.CumulativeRewardRatio
+}
+
+func (b rewardBatcher) setRewardIndex(ctx sdk.Context, index sdk.DecCoins) {
+	bz := b.cdc.MustMarshal(&distrtypes.ValidatorHistoricalRewards{CumulativeRewardRatio: index})
+	ctx.KVStore(b.storeKey).Set(rewardIndexKey, bz)
+}
+
+func (b rewardBatcher) getRewardSnapshot(ctx sdk.Context, consAddr sdk.ConsAddress) (sdk.DecCoins, bool) {
+	bz := prefix.NewStore(ctx.KVStore(b.storeKey), rewardSnapshotsPrefix).Get(consAddr)
+	if bz == nil {
+		return nil, false
+	}
+
+	var snapshot distrtypes.ValidatorHistoricalRewards
+	b.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot.CumulativeRewardRatio, true
+}
+
+func (b rewardBatcher) setRewardSnapshot(ctx sdk.Context, consAddr sdk.ConsAddress, index sdk.DecCoins) {
+	bz := b.cdc.MustMarshal(&distrtypes.ValidatorHistoricalRewards{CumulativeRewardRatio: index})
+	prefix.NewStore(ctx.KVStore(b.storeKey), rewardSnapshotsPrefix).Set(consAddr, bz)
+}
+
+func (b rewardBatcher) getVotes(ctx sdk.Context) []abci.VoteInfo {
+	bz := ctx.KVStore(b.storeKey).Get(rewardVotesKey)
+	if bz == nil {
+		return nil
+	}
+
+	var commitInfo abci.CommitInfo
+	b.cdc.MustUnmarshal(bz, &commitInfo)
+	return commitInfo.Votes
+}
+
+// setVotes stores the validators and voting powers of the vote set, without their signing status which doesn't
+// affect the rewards.
+func (b rewardBatcher) setVotes(ctx sdk.Context, votes []abci.VoteInfo) {
+	commitInfo := abci.CommitInfo{Votes: make([]abci.VoteInfo, 0, len(votes))}
+	for _, vote := range votes {
+		commitInfo.Votes = append(commitInfo.Votes, abci.VoteInfo{Validator: vote.Validator})
+	}
+
+	ctx.KVStore(b.storeKey).Set(rewardVotesKey, b.cdc.MustMarshal(&commitInfo))
+}
+
+func (b rewardBatcher) getVotingPowers(ctx sdk.Context) map[string]int64 {
+	votes := b.getVotes(ctx)
+	powers := make(map[string]int64, len(votes))
+	for _, vote := range votes {
+		powers[string(vote.Validator.Address)] = vote.Validator.Power
+	}
+	return powers
+}
+
+// Hooks returns the staking hooks crediting a validator with its pending rewards before its delegations, commission
+// or stake change. They must run before the distribution hooks, which end the current rewards period of the
+// validator.
+func (b rewardBatcher) Hooks() stakingtypes.StakingHooks {
+	return rewardBatchingHooks{batcher: b}
+}
+
+var _ stakingtypes.StakingHooks = rewardBatchingHooks{}
+
+type rewardBatchingHooks struct {
+	batcher rewardBatcher
+}
+
+func (h rewardBatchingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h rewardBatchingHooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return h.batcher.flushRewards(ctx, valAddr)
+}
+
+func (h rewardBatchingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h rewardBatchingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h rewardBatchingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h rewardBatchingHooks) BeforeDelegationCreated(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.batcher.flushRewards(ctx, valAddr)
+}
+
+func (h rewardBatchingHooks) BeforeDelegationSharesModified(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.batcher.flushRewards(ctx, valAddr)
+}
+
+func (h rewardBatchingHooks) BeforeDelegationRemoved(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.batcher.flushRewards(ctx, valAddr)
+}
+
+func (h rewardBatchingHooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h rewardBatchingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.Dec) error {
+	return h.batcher.flushRewards(ctx, valAddr)
+}
+
+func (h rewardBatchingHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+// rewardBatchingDistributionModule wraps the distribution module so that its BeginBlocker credits the validator
+// rewards in batches of RewardValidatorsPerBlock once more validators sign a block. Below the threshold, the pending
+// rewards are all credited and the distribution BeginBlocker runs unchanged.
+type rewardBatchingDistributionModule struct {
+	distr.AppModule
+
+	batcher rewardBatcher
+}
+
+func newRewardBatchingDistributionModule(
+	distrModule distr.AppModule,
+	batcher rewardBatcher,
+) rewardBatchingDistributionModule {
+	return rewardBatchingDistributionModule{
+		AppModule: distrModule,
+		batcher:   batcher,
+	}
+}
+
+// BeginBlock implements the distribution BeginBlocker, batching the crediting of the rewards above the threshold.
+func (am rewardBatchingDistributionModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	votes := req.LastCommitInfo.GetVotes()
+	validatorsPerBlock := am.batcher.validatorsPerBlock(ctx)
+
+	if validatorsPerBlock == 0 || uint64(len(votes)) <= validatorsPerBlock {
+		// leaving the batching mid cycle credits all the pending rewards at once
+		if am.batcher.isBatching(ctx) {
+			am.batcher.creditAllPendingRewards(ctx)
+		}
+
+		am.AppModule.BeginBlock(ctx, req)
+		return
+	}
+
+	powers, totalPower := am.batcher.updateVotes(ctx, votes)
+
+	// the fees of the first block are never allocated, as in the distribution BeginBlocker
+	if ctx.BlockHeight() > 1 {
+		am.batcher.accrueRewards(ctx, totalPower)
+	}
+
+	am.batcher.distrKeeper.SetPreviousProposerConsAddr(ctx, sdk.ConsAddress(req.Header.ProposerAddress))
+	am.batcher.creditPendingRewards(ctx, validatorsPerBlock, powers)
+}
+
+// RewardBatchingGenesisState is the batching state of the validator rewards, exported in genesis so that the rewards
+// pending from a batched cycle survive an export at any height. The fees backing them are exported as the balance of
+// the reward_batching module account by the bank module.
+type RewardBatchingGenesisState struct {
+	Params RewardBatchingParams `json:"params"`
+	// RewardIndex is the cumulative reward accrued per unit of voting power while batching
+	RewardIndex sdk.DecCoins `json:"reward_index"`
+	// Snapshots are the reward indexes at which the validators of the vote set were last credited
+	Snapshots []RewardBatchingSnapshot `json:"snapshots"`
+	// Votes is the vote set the reward index was last accrued with, empty unless batching
+	Votes []RewardBatchingVote `json:"votes"`
+	// Cursor is the consensus address from which the current crediting cycle resumes, empty at the start of a cycle
+	Cursor string `json:"cursor"`
+}
+
+// RewardBatchingSnapshot is the reward index at which a validator was last credited.
+type RewardBatchingSnapshot struct {
+	ConsAddress string       `json:"cons_address"`
+	RewardIndex sdk.DecCoins `json:"reward_index"`
+}
+
+// RewardBatchingVote is the voting power of a validator of the vote set.
+type RewardBatchingVote struct {
+	ConsAddress string `json:"cons_address"`
+	Power       int64  `json:"power"`
+}
+
+// DefaultRewardBatchingGenesisState returns the genesis state without batching, which is disabled by default.
+func DefaultRewardBatchingGenesisState() RewardBatchingGenesisState {
+	return RewardBatchingGenesisState{
+		RewardIndex: sdk.DecCoins{},
+		Snapshots:   []RewardBatchingSnapshot{},
+		Votes:       []RewardBatchingVote{},
+	}
+}
+
+// Validate performs basic validation of the reward batching genesis state.
+func (gs RewardBatchingGenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.RewardIndex.Validate(); err != nil {
+		return fmt.Errorf("invalid reward index: %w", err)
+	}
+
+	for _, snapshot := range gs.Snapshots {
+		if _, err := sdk.ConsAddressFromBech32(snapshot.ConsAddress); err != nil {
+			return fmt.Errorf("invalid snapshot address %s: %w", snapshot.ConsAddress, err)
+		}
+		if err := snapshot.RewardIndex.Validate(); err != nil {
+			return fmt.Errorf("invalid reward index of the snapshot of %s: %w", snapshot.ConsAddress, err)
+		}
+	}
+
+	for _, vote := range gs.Votes {
+		if _, err := sdk.ConsAddressFromBech32(vote.ConsAddress); err != nil {
+			return fmt.Errorf("invalid vote address %s: %w", vote.ConsAddress, err)
+		}
+		if vote.Power < 0 {
+			return fmt.Errorf("negative voting power %d of %s", vote.Power, vote.ConsAddress)
+		}
+	}
+
+	if gs.Cursor != "" {
+		if _, err := sdk.ConsAddressFromBech32(gs.Cursor); err != nil {
+			return fmt.Errorf("invalid cursor %s: %w", gs.Cursor, err)
+		}
+	}
+
+	return nil
+}
+
+func mustConsAddressFromBech32(address string) sdk.ConsAddress {
+	consAddr, err := sdk.ConsAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return consAddr
+}
+
+func (b rewardBatcher) initGenesis(ctx sdk.Context, gs RewardBatchingGenesisState) {
+	b.subspace.SetParamSet(ctx, &gs.Params)
+
+	store := ctx.KVStore(b.storeKey)
+	if len(gs.RewardIndex) > 0 {
+		b.setRewardIndex(ctx, gs.RewardIndex)
+	}
+
+	for _, snapshot := range gs.Snapshots {
+		b.setRewardSnapshot(ctx, mustConsAddressFromBech32(snapshot.ConsAddress), snapshot.RewardIndex)
+	}
+
+	if len(gs.Votes) > 0 {
+		votes := make([]abci.VoteInfo, 0, len(gs.Votes))
+		for _, vote := range gs.Votes {
+			votes = append(votes, abci.VoteInfo{
+				Validator: abci.Validator{Address: mustConsAddressFromBech32(vote.ConsAddress), Power: vote.Power},
+			})
+		}
+		b.setVotes(ctx, votes)
+	}
+
+	if gs.Cursor != "" {
+		store.Set(rewardCursorKey, mustConsAddressFromBech32(gs.Cursor))
+	}
+}
+
+func (b rewardBatcher) exportGenesis(ctx sdk.Context) RewardBatchingGenesisState {
+	gs := DefaultRewardBatchingGenesisState()
+	b.subspace.GetParamSetIfExists(ctx, &gs.Params)
+	gs.RewardIndex = b.getRewardIndex(ctx)
+
+	iterator := prefix.NewStore(ctx.KVStore(b.storeKey), rewardSnapshotsPrefix).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot distrtypes.ValidatorHistoricalRewards
+		b.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		gs.Snapshots = append(gs.Snapshots, RewardBatchingSnapshot{
+			ConsAddress: sdk.ConsAddress(iterator.Key()).String(),
+			RewardIndex: snapshot.CumulativeRewardRatio,
+		})
+	}
+	iterator.Close()
+
+	for _, vote := range b.getVotes(ctx) {
+		gs.Votes = append(gs.Votes, RewardBatchingVote{
+			ConsAddress: sdk.ConsAddress(vote.Validator.Address).String(),
+			Power:       vote.Validator.Power,
+		})
+	}
+
+	if cursor := ctx.KVStore(b.storeKey).Get(rewardCursorKey); cursor != nil {
+		gs.Cursor = sdk.ConsAddress(cursor).String()
+	}
+
+	return gs
+}
+
+// RewardBatchingAppModuleBasic is the basic module of the reward batching, which only has a genesis.
+type RewardBatchingAppModuleBasic struct{}
+
+func (RewardBatchingAppModuleBasic) Name() string {
+	return RewardBatchingModuleName
+}
+
+func (RewardBatchingAppModuleBasic) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
+
+func (RewardBatchingAppModuleBasic) RegisterInterfaces(codectypes.InterfaceRegistry) {}
+
+func (RewardBatchingAppModuleBasic) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {}
+
+func (RewardBatchingAppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+func (RewardBatchingAppModuleBasic) GetQueryCmd() *cobra.Command {
+	return nil
+}
+
+// DefaultGenesis returns the default genesis state of the reward batching.
+func (RewardBatchingAppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	bz, err := json.Marshal(DefaultRewardBatchingGenesisState())
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (RewardBatchingAppModuleBasic) newJSONGenesisState() interface{} {
+	return &RewardBatchingGenesisState{}
+}
+
+// ValidateGenesis performs genesis state validation for the reward batching.
+func (RewardBatchingAppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs RewardBatchingGenesisState
+	if err := json.Unmarshal(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", RewardBatchingModuleName, err)
+	}
+
+	return gs.Validate()
+}
+
+// rewardBatchingModule imports and exports the batching state of the validator rewards in genesis. The batching
+// itself runs in the BeginBlocker of rewardBatchingDistributionModule.
+type rewardBatchingModule struct {
+	RewardBatchingAppModuleBasic
+
+	batcher rewardBatcher
+}
+
+func newRewardBatchingModule(batcher rewardBatcher) rewardBatchingModule {
+	return rewardBatchingModule{batcher: batcher}
+}
+
+// InitGenesis imports the batching state of the validator rewards.
+func (am rewardBatchingModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+	var gs RewardBatchingGenesisState
+	if err := json.Unmarshal(bz, &gs); err != nil {
+		panic(err)
+	}
+
+	am.batcher.initGenesis(ctx, gs)
+	return nil
+}
+
+// ExportGenesis exports the batching state of the validator rewards.
+func (am rewardBatchingModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	bz, err := json.Marshal(am.batcher.exportGenesis(ctx))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
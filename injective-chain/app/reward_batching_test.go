@@ -0,0 +1,253 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRewardBatching creates five validators of equal power and enables the batching of their rewards by two
+func setupRewardBatching(t *testing.T) (*InjectiveApp, sdk.Context, rewardBatchingDistributionModule, []abci.VoteInfo) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	app.GetSubspace(RewardBatchingParamsSubspace).Set(ctx, KeyRewardValidatorsPerBlock, uint64(2))
+
+	distrModule := newRewardBatchingDistributionModule(
+		distr.NewAppModule(app.AppCodec(), app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
+		app.newRewardBatcher(),
+	)
+
+	votes := make([]abci.VoteInfo, 0)
+	for i := 0; i < 5; i++ {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		valAddr := sdk.ValAddress(pubKey.Address())
+		validator, err := stakingtypes.NewValidator(valAddr, pubKey, stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Tokens = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+		validator.DelegatorShares = sdk.NewDecFromInt(validator.Tokens)
+		app.StakingKeeper.SetValidator(ctx, validator)
+		require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		require.NoError(t, app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr))
+
+		votes = append(votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: pubKey.Address(), Power: 10},
+			SignedLastBlock: true,
+		})
+	}
+
+	return app, ctx, distrModule, votes
+}
+
+// beginRewardBatchingBlock collects fees and runs the BeginBlocker, returning the number of times each validator was
+// credited
+func beginRewardBatchingBlock(
+	t *testing.T,
+	app *InjectiveApp,
+	ctx sdk.Context,
+	distrModule rewardBatchingDistributionModule,
+	votes []abci.VoteInfo,
+) (sdk.Context, map[string]int) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("inj", 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	distrModule.BeginBlock(ctx, abci.RequestBeginBlock{LastCommitInfo: abci.CommitInfo{Votes: votes}})
+
+	_, broken := distrkeeper.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+
+	return ctx, countCreditedValidators(ctx)
+}
+
+func countCreditedValidators(ctx sdk.Context) map[string]int {
+	credited := make(map[string]int)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != distrtypes.EventTypeRewards {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == distrtypes.AttributeKeyValidator {
+				credited[attr.Value]++
+			}
+		}
+	}
+	return credited
+}
+
+func TestRewardBatching(t *testing.T) {
+	app, ctx, distrModule, votes := setupRewardBatching(t)
+	batcher := app.newRewardBatcher()
+
+	// the first cycle spans three blocks, crediting every validator exactly once
+	var credited map[string]int
+	cycle := make(map[string]int)
+	for _, expected := range []int{2, 2, 1} {
+		ctx, credited = beginRewardBatchingBlock(t, app, ctx, distrModule, votes)
+		require.Len(t, credited, expected)
+		for valAddr, count := range credited {
+			cycle[valAddr] += count
+		}
+	}
+	require.Len(t, cycle, 5)
+	for _, count := range cycle {
+		require.Equal(t, 1, count)
+	}
+
+	require.True(t, batcher.isBatching(ctx))
+	require.Nil(t, ctx.KVStore(batcher.storeKey).Get(rewardCursorKey))
+
+	ctx, credited = beginRewardBatchingBlock(t, app, ctx, distrModule, votes)
+	require.Len(t, credited, 2)
+	require.NotNil(t, ctx.KVStore(batcher.storeKey).Get(rewardCursorKey))
+
+	// disabling the batching credits all the pending rewards at once
+	app.GetSubspace(RewardBatchingParamsSubspace).Set(ctx, KeyRewardValidatorsPerBlock, uint64(0))
+	ctx, credited = beginRewardBatchingBlock(t, app, ctx, distrModule, votes)
+	require.Len(t, credited, 5)
+	require.False(t, batcher.isBatching(ctx))
+	require.Nil(t, ctx.KVStore(batcher.storeKey).Get(rewardCursorKey))
+	require.Nil(t, ctx.KVStore(batcher.storeKey).Get(rewardIndexKey))
+}
+
+func TestRewardBatchingCreditsBeforeDelegationChanges(t *testing.T) {
+	app, ctx, distrModule, votes := setupRewardBatching(t)
+	batcher := app.newRewardBatcher()
+
+	ctx, credited := beginRewardBatchingBlock(t, app, ctx, distrModule, votes)
+	require.Len(t, credited, 2)
+
+	// pick a validator with pending rewards
+	var validator stakingtypes.ValidatorI
+	for _, vote := range votes {
+		validator = app.StakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
+		if credited[validator.GetOperator().String()] == 0 {
+			break
+		}
+	}
+	valAddr := validator.GetOperator()
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	outstanding := app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards.AmountOf("inj")
+
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	stake := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, stake))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delAddr, stake))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	stakingValidator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, delAddr, stake[0].Amount, stakingtypes.Unbonded, stakingValidator, true)
+	require.NoError(t, err)
+
+	// the pending rewards were credited to the period ended by the delegation, so the new delegation doesn't share them
+	require.Equal(t, 1, countCreditedValidators(ctx)[valAddr.String()])
+	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards.AmountOf("inj").GT(outstanding))
+	require.True(t, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr).Rewards.IsZero())
+
+	snapshot, found := batcher.getRewardSnapshot(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, batcher.getRewardIndex(ctx), snapshot)
+
+	_, broken := distrkeeper.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestRewardBatchingBelowThreshold(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	app.GetSubspace(RewardBatchingParamsSubspace).Set(ctx, KeyRewardValidatorsPerBlock, uint64(2))
+	distrModule := newRewardBatchingDistributionModule(
+		distr.NewAppModule(app.AppCodec(), app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
+		app.newRewardBatcher(),
+	)
+
+	validators := app.StakingKeeper.GetLastValidators(ctx)
+	require.NotEmpty(t, validators)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	valAddr := validators[0].GetOperator()
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("inj", 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	outstanding := app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards.AmountOf("inj")
+	distrModule.BeginBlock(ctx, abci.RequestBeginBlock{
+		LastCommitInfo: abci.CommitInfo{
+			Votes: []abci.VoteInfo{{
+				Validator:       abci.Validator{Address: consAddr, Power: 10},
+				SignedLastBlock: true,
+			}},
+		},
+	})
+
+	// the validators are credited right away
+	require.False(t, app.newRewardBatcher().isBatching(ctx))
+	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards.AmountOf("inj").GT(outstanding))
+}
+
+func TestRewardBatchingHoldsPendingRewardsOutOfTheCommunityPool(t *testing.T) {
+	app, ctx, distrModule, votes := setupRewardBatching(t)
+	batcher := app.newRewardBatcher()
+
+	ctx, credited := beginRewardBatchingBlock(t, app, ctx, distrModule, votes)
+	require.Len(t, credited, 2)
+
+	rewardBatchingAddr := app.AccountKeeper.GetModuleAddress(RewardBatchingModuleName)
+	require.True(t, app.BankKeeper.GetBalance(ctx, rewardBatchingAddr, "inj").IsPositive())
+
+	// spending the whole community pool leaves the pending rewards untouched
+	communityPool, _ := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+	require.True(t, communityPool.AmountOf("inj").IsPositive())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, app.DistrKeeper.DistributeFromFeePool(ctx, communityPool, recipient))
+
+	app.GetSubspace(RewardBatchingParamsSubspace).Set(ctx, KeyRewardValidatorsPerBlock, uint64(0))
+	ctx, credited = beginRewardBatchingBlock(t, app, ctx, distrModule, votes)
+	require.Len(t, credited, 5)
+	require.False(t, batcher.isBatching(ctx))
+
+	// the rounding dust is moved to the community pool once the batching ends
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, rewardBatchingAddr).IsZero())
+}
+
+func TestRewardBatchingGenesis(t *testing.T) {
+	app, ctx, distrModule, votes := setupRewardBatching(t)
+	batcher := app.newRewardBatcher()
+
+	ctx, _ = beginRewardBatchingBlock(t, app, ctx, distrModule, votes)
+
+	exported := batcher.exportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, uint64(2), exported.Params.ValidatorsPerBlock)
+	require.Len(t, exported.Snapshots, 5)
+	require.Len(t, exported.Votes, 5)
+	require.NotEmpty(t, exported.Cursor)
+	require.False(t, exported.RewardIndex.IsZero())
+
+	bz := newRewardBatchingModule(batcher).ExportGenesis(ctx, app.AppCodec())
+	require.NoError(t, RewardBatchingAppModuleBasic{}.ValidateGenesis(app.AppCodec(), nil, bz))
+
+	// the batching state is imported as exported
+	importingApp := Setup(false)
+	importingCtx := importingApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	importingBatcher := importingApp.newRewardBatcher()
+	newRewardBatchingModule(importingBatcher).InitGenesis(importingCtx, importingApp.AppCodec(), bz)
+
+	require.True(t, importingBatcher.isBatching(importingCtx))
+	require.Equal(t, exported, importingBatcher.exportGenesis(importingCtx))
+}
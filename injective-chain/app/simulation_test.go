@@ -99,6 +99,44 @@ func TestFullAppSimulation(t *testing.T) {
 	}
 }
 
+func TestSupplyConservationSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = "simulation-app"
+
+	db, dir, logger, skip, err := sims.SetupSimulation(
+		config,
+		"leveldb-app-sim",
+		"Simulation",
+		simcli.FlagVerboseValue,
+		simcli.FlagEnabledValue,
+	)
+	if skip {
+		t.Skip("skipping supply conservation simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	// the crisis module checks the supply conservation invariant on every block
+	app := NewInjectiveApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, 1, MakeEncodingConfig(), sims.EmptyAppOptions{})
+
+	_, _, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		sims.AppStateFn(app.AppCodec(), app.SimulationManager(), NewDefaultGenesisState()),
+		simtypes.RandomAccounts,
+		sims.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.appCodec,
+	)
+	require.NoError(t, simErr)
+}
+
 func TestAppImportExport(t *testing.T) {
 	config := simcli.NewConfigFromFlags()
 	config.ChainID = "simulation-app"
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// SlashingFractionsParamsSubspace is the name of the params subspace holding the governance controlled slash fractions
+// per infraction. The values can be queried with `injectived query params subspace slashing_fractions <key>` and
+// updated through a parameter change proposal.
+const SlashingFractionsParamsSubspace = "slashing_fractions"
+
+var _ paramtypes.ParamSet = &SlashingFractionsParams{}
+
+// Parameter keys
+var (
+	KeyDoubleSignSlashFraction        = []byte("DoubleSignSlashFraction")
+	KeyDowntimeSlashFraction          = []byte("DowntimeSlashFraction")
+	KeyOracleMisbehaviorSlashFraction = []byte("OracleMisbehaviorSlashFraction")
+)
+
+// SlashingFractionsParams defines the governance controlled slash fractions per infraction.
+type SlashingFractionsParams struct {
+	// DoubleSignSlashFraction replaces the double sign slash fraction of the slashing params, zero defers to them
+	DoubleSignSlashFraction sdk.Dec `json:"double_sign_slash_fraction" yaml:"double_sign_slash_fraction"`
+	// DowntimeSlashFraction replaces the downtime slash fraction of the slashing params, zero defers to them
+	DowntimeSlashFraction sdk.Dec `json:"downtime_slash_fraction" yaml:"downtime_slash_fraction"`
+	// OracleMisbehaviorSlashFraction is the slash fraction of an oracle misbehavior, zero disables the slashing
+	OracleMisbehaviorSlashFraction sdk.Dec `json:"oracle_misbehavior_slash_fraction" yaml:"oracle_misbehavior_slash_fraction"`
+}
+
+// SlashingFractionsParamKeyTable returns the parameter key table.
+func SlashingFractionsParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&SlashingFractionsParams{})
+}
+
+// DefaultSlashingFractionsParams returns the default slash fractions, deferring to the slashing params.
+func DefaultSlashingFractionsParams() SlashingFractionsParams {
+	return SlashingFractionsParams{
+		DoubleSignSlashFraction:        sdk.ZeroDec(),
+		DowntimeSlashFraction:          sdk.ZeroDec(),
+		OracleMisbehaviorSlashFraction: sdk.ZeroDec(),
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *SlashingFractionsParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDoubleSignSlashFraction, &p.DoubleSignSlashFraction, validateSlashFraction),
+		paramtypes.NewParamSetPair(KeyDowntimeSlashFraction, &p.DowntimeSlashFraction, validateSlashFraction),
+		paramtypes.NewParamSetPair(KeyOracleMisbehaviorSlashFraction, &p.OracleMisbehaviorSlashFraction, validateSlashFraction),
+	}
+}
+
+// Validate performs basic validation on the slash fractions.
+func (p SlashingFractionsParams) Validate() error {
+	if err := validateSlashFraction(p.DoubleSignSlashFraction); err != nil {
+		return fmt.Errorf("double_sign_slash_fraction is incorrect: %w", err)
+	}
+
+	if err := validateSlashFraction(p.DowntimeSlashFraction); err != nil {
+		return fmt.Errorf("downtime_slash_fraction is incorrect: %w", err)
+	}
+
+	if err := validateSlashFraction(p.OracleMisbehaviorSlashFraction); err != nil {
+		return fmt.Errorf("oracle_misbehavior_slash_fraction is incorrect: %w", err)
+	}
+
+	return nil
+}
+
+func validateSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+// slashingFractionsStakingKeeper wraps the staking keeper of the slashing module, which also slashes the double sign
+// evidence of the evidence module, so that the governance set slash fraction of an infraction replaces the fraction
+// of the slashing params. The fraction is replaced rather than applied on top, so an infraction is only slashed once.
+// It also slashes the oracle misbehavior reported by the oracle keeper.
+type slashingFractionsStakingKeeper struct {
+	*stakingkeeper.Keeper
+
+	subspace paramtypes.Subspace
+}
+
+var _ oracletypes.MisbehaviorSlasher = slashingFractionsStakingKeeper{}
+
+func newSlashingFractionsStakingKeeper(stakingKeeper *stakingkeeper.Keeper, subspace paramtypes.Subspace) slashingFractionsStakingKeeper {
+	return slashingFractionsStakingKeeper{
+		Keeper:   stakingKeeper,
+		subspace: subspace,
+	}
+}
+
+// getSlashFraction returns the governance set slash fraction of the param, zero if unset.
+func (k slashingFractionsStakingKeeper) getSlashFraction(ctx sdk.Context, key []byte) sdk.Dec {
+	var fraction sdk.Dec
+	k.subspace.GetIfExists(ctx, key, &fraction)
+	if fraction.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return fraction
+}
+
+// SlashWithInfractionReason slashes the validator with the governance set slash fraction of the infraction, or the
+// slash fraction of the slashing params if none is set.
+func (k slashingFractionsStakingKeeper) SlashWithInfractionReason(
+	ctx sdk.Context,
+	consAddr sdk.ConsAddress,
+	infractionHeight, power int64,
+	slashFactor sdk.Dec,
+	infraction stakingtypes.Infraction,
+) math.Int {
+	var fraction sdk.Dec
+
+	switch infraction {
+	case stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN:
+		fraction = k.getSlashFraction(ctx, KeyDoubleSignSlashFraction)
+	case stakingtypes.Infraction_INFRACTION_DOWNTIME:
+		fraction = k.getSlashFraction(ctx, KeyDowntimeSlashFraction)
+	default:
+		fraction = sdk.ZeroDec()
+	}
+
+	if !fraction.IsPositive() {
+		fraction = slashFactor
+	}
+
+	return k.Keeper.SlashWithInfractionReason(ctx, consAddr, infractionHeight, power, fraction, infraction)
+}
+
+// SlashOracleMisbehavior slashes the validator for an oracle misbehavior at the infraction height by the governance
+// set oracle misbehavior slash fraction, based on its current power, and returns the fraction.
+func (k slashingFractionsStakingKeeper) SlashOracleMisbehavior(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64) (sdk.Dec, error) {
+	validator, found := k.Keeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, errors.Wrapf(stakingtypes.ErrNoValidatorFound, "validator %s", valAddr.String())
+	}
+
+	if validator.IsUnbonded() {
+		return sdk.Dec{}, errors.Wrapf(oracletypes.ErrInvalidOracleMisbehavior, "validator %s is unbonded", valAddr.String())
+	}
+
+	fraction := k.getSlashFraction(ctx, KeyOracleMisbehaviorSlashFraction)
+	if fraction.IsZero() {
+		return fraction, nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	power := validator.ConsensusPower(k.Keeper.PowerReduction(ctx))
+	k.Keeper.Slash(ctx, consAddr, infractionHeight, power, fraction)
+
+	return fraction, nil
+}
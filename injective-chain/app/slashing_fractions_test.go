@@ -0,0 +1,89 @@
+package app
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+func TestSlashingFractions(t *testing.T) {
+	sdkFraction := sdk.NewDecWithPrec(5, 2)
+	configuredFraction := sdk.NewDecWithPrec(1, 1)
+
+	setup := func(t *testing.T) (*InjectiveApp, sdk.Context, stakingtypes.Validator) {
+		t.Helper()
+
+		app := Setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+		subspace := app.GetSubspace(SlashingFractionsParamsSubspace)
+		subspace.Set(ctx, KeyDoubleSignSlashFraction, configuredFraction)
+		subspace.Set(ctx, KeyDowntimeSlashFraction, configuredFraction)
+		subspace.Set(ctx, KeyOracleMisbehaviorSlashFraction, configuredFraction)
+
+		validators := app.StakingKeeper.GetLastValidators(ctx)
+		require.NotEmpty(t, validators)
+		return app, ctx, validators[0]
+	}
+
+	// expectSlashed checks that the validator lost the fraction of the tokens of its consensus power
+	expectSlashed := func(t *testing.T, app *InjectiveApp, ctx sdk.Context, before stakingtypes.Validator, fraction sdk.Dec) {
+		t.Helper()
+
+		after, found := app.StakingKeeper.GetValidator(ctx, before.GetOperator())
+		require.True(t, found)
+
+		powerTokens := sdk.TokensFromConsensusPower(before.ConsensusPower(app.StakingKeeper.PowerReduction(ctx)), app.StakingKeeper.PowerReduction(ctx))
+		require.Equal(t, sdk.NewDecFromInt(powerTokens).Mul(fraction).TruncateInt(), before.Tokens.Sub(after.Tokens))
+	}
+
+	for _, infraction := range []stakingtypes.Infraction{
+		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	} {
+		infraction := infraction
+
+		t.Run(infraction.String()+" applies the configured fraction", func(t *testing.T) {
+			app, ctx, validator := setup(t)
+			consAddr, err := validator.GetConsAddr()
+			require.NoError(t, err)
+
+			power := validator.ConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+			app.SlashingKeeper.SlashWithInfractionReason(ctx, consAddr, sdkFraction, power, ctx.BlockHeight(), infraction)
+
+			expectSlashed(t, app, ctx, validator, configuredFraction)
+		})
+	}
+
+	t.Run("unset fraction defers to the slashing params", func(t *testing.T) {
+		app, ctx, validator := setup(t)
+		app.GetSubspace(SlashingFractionsParamsSubspace).Set(ctx, KeyDoubleSignSlashFraction, sdk.ZeroDec())
+
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(t, err)
+
+		power := validator.ConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+		app.SlashingKeeper.SlashWithInfractionReason(ctx, consAddr, sdkFraction, power, ctx.BlockHeight(), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+
+		expectSlashed(t, app, ctx, validator, sdkFraction)
+	})
+
+	t.Run("oracle misbehavior applies the configured fraction once", func(t *testing.T) {
+		app, ctx, validator := setup(t)
+
+		slashFraction, err := app.OracleKeeper.HandleOracleMisbehavior(ctx, validator.GetOperator(), ctx.BlockHeight())
+		require.NoError(t, err)
+		require.Equal(t, configuredFraction, slashFraction)
+		expectSlashed(t, app, ctx, validator, configuredFraction)
+
+		// the same evidence isn't slashed twice
+		_, err = app.OracleKeeper.HandleOracleMisbehavior(ctx, validator.GetOperator(), ctx.BlockHeight())
+		require.ErrorIs(t, err, oracletypes.ErrOracleMisbehaviorSlashed)
+		expectSlashed(t, app, ctx, validator, configuredFraction)
+	})
+}
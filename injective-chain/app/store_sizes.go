@@ -0,0 +1,44 @@
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// StoreSizes iterates every KV store of the app and returns its number of keys and total byte size, sorted by
+// decreasing byte size. At most maxKeysPerStore keys are iterated per store, zero meaning no limit, so that huge
+// stores only report lower bounds instead of taking minutes. Transient and memory stores are not persisted and are
+// skipped.
+func (app *InjectiveApp) StoreSizes(ctx sdk.Context, maxKeysPerStore uint64) []apptypes.StoreSize {
+	sizes := make([]apptypes.StoreSize, 0, len(app.keys))
+
+	for name, key := range app.keys {
+		size := apptypes.StoreSize{StoreKey: name}
+
+		iterator := ctx.KVStore(key).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			if maxKeysPerStore > 0 && size.Keys == maxKeysPerStore {
+				size.Truncated = true
+				break
+			}
+
+			size.Keys++
+			size.Bytes += uint64(len(iterator.Key()) + len(iterator.Value()))
+		}
+		iterator.Close()
+
+		sizes = append(sizes, size)
+	}
+
+	sort.Slice(sizes, func(i, j int) bool {
+		if sizes[i].Bytes != sizes[j].Bytes {
+			return sizes[i].Bytes > sizes[j].Bytes
+		}
+		return sizes[i].StoreKey < sizes[j].StoreKey
+	})
+
+	return sizes
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+func TestStoreSizes(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+	queryClient := apptypes.NewQueryClient(queryHelper)
+
+	storeSizes := func(maxKeysPerStore uint64) []apptypes.StoreSize {
+		res, err := queryClient.StoreSizes(ctx, &apptypes.QueryStoreSizesRequest{MaxKeysPerStore: maxKeysPerStore})
+		require.NoError(t, err)
+		return res.StoreSizes
+	}
+
+	bankSize := func(sizes []apptypes.StoreSize) apptypes.StoreSize {
+		for _, size := range sizes {
+			if size.StoreKey == banktypes.StoreKey {
+				return size
+			}
+		}
+		t.Fatal("bank store size not reported")
+		return apptypes.StoreSize{}
+	}
+
+	sizesBefore := storeSizes(0)
+	require.Len(t, sizesBefore, len(app.keys))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("inj", 1000), sdk.NewInt64Coin("usdt", 1000))
+	for i := 0; i < 3; i++ {
+		account := sdk.AccAddress([]byte{byte(i + 1)})
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins))
+	}
+
+	sizes := storeSizes(0)
+	bank := bankSize(sizes)
+	require.NotZero(t, bank.Keys)
+	require.NotZero(t, bank.Bytes)
+	require.Greater(t, bank.Keys, bankSize(sizesBefore).Keys)
+	require.False(t, bank.Truncated)
+
+	for i := 1; i < len(sizes); i++ {
+		require.GreaterOrEqual(t, sizes[i-1].Bytes, sizes[i].Bytes)
+	}
+
+	// with a limit, the bank store only reports a lower bound
+	truncatedBank := bankSize(storeSizes(2))
+	require.Equal(t, uint64(2), truncatedBank.Keys)
+	require.True(t, truncatedBank.Truncated)
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// SupplyTrackerTStoreKey is the transient store holding the per block supply snapshots and
+	// the amounts minted and burned since the snapshots were taken.
+	SupplyTrackerTStoreKey = "transient_supply_tracker"
+
+	// SupplyConservationInvariantRoute is the crisis route of the supply conservation invariant.
+	SupplyConservationInvariantRoute = "supply-conservation"
+)
+
+var (
+	supplyTrackingStartedKey = []byte{0x01}
+	supplySnapshotPrefix     = []byte{0x02}
+	mintedSupplyPrefix       = []byte{0x03}
+	burnedSupplyPrefix       = []byte{0x04}
+)
+
+// SupplyTracker records every mint and burn going through the bank keeper, along with the supply of
+// the denom before its first mint or burn in the block, so that the supply conservation invariant
+// can verify the supply of these denoms only changed by the net of the observed mints and burns.
+// Only the denoms minted or burned in the block are snapshotted, so that tracking doesn't iterate
+// the total supply in every block. The supply is tracked in every block, independently of the
+// invariant check period of the node, and nothing is recorded outside of a block. The tracker
+// reads and writes without consuming gas, so that tracking the supply doesn't change the gas used
+// by the txs.
+type SupplyTracker struct {
+	storeKey   storetypes.StoreKey
+	bankKeeper bankkeeper.BaseKeeper
+}
+
+func NewSupplyTracker(storeKey storetypes.StoreKey, bankKeeper bankkeeper.BaseKeeper) *SupplyTracker {
+	return &SupplyTracker{
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// BeginBlock discards the snapshots and amounts recorded so far and starts tracking the supply.
+func (t *SupplyTracker) BeginBlock(ctx sdk.Context) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store := ctx.TransientStore(t.storeKey)
+
+	iterator := store.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	store.Set(supplyTrackingStartedKey, []byte{1})
+}
+
+func (t *SupplyTracker) isTracking(ctx sdk.Context) bool {
+	return ctx.TransientStore(t.storeKey).Has(supplyTrackingStartedKey)
+}
+
+// snapshotSupply stores the current supply of the denoms not yet minted or burned in the block, it
+// must be called before they are minted or burned.
+func (t *SupplyTracker) snapshotSupply(ctx sdk.Context, amounts sdk.Coins) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if !t.isTracking(ctx) {
+		return
+	}
+
+	store := ctx.TransientStore(t.storeKey)
+	for _, coin := range amounts {
+		key := append(append([]byte{}, supplySnapshotPrefix...), coin.Denom...)
+		if !store.Has(key) {
+			setAmount(store, supplySnapshotPrefix, coin.Denom, t.bankKeeper.GetSupply(ctx, coin.Denom).Amount)
+		}
+	}
+}
+
+func (t *SupplyTracker) recordMint(ctx sdk.Context, amounts sdk.Coins) {
+	t.record(ctx, mintedSupplyPrefix, amounts)
+}
+
+func (t *SupplyTracker) recordBurn(ctx sdk.Context, amounts sdk.Coins) {
+	t.record(ctx, burnedSupplyPrefix, amounts)
+}
+
+func (t *SupplyTracker) record(ctx sdk.Context, prefix []byte, amounts sdk.Coins) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if !t.isTracking(ctx) {
+		return
+	}
+
+	store := ctx.TransientStore(t.storeKey)
+	for _, coin := range amounts {
+		setAmount(store, prefix, coin.Denom, getAmount(store, prefix, coin.Denom).Add(coin.Amount))
+	}
+}
+
+func getAmount(store sdk.KVStore, prefix []byte, denom string) sdkmath.Int {
+	bz := store.Get(append(append([]byte{}, prefix...), denom...))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func setAmount(store sdk.KVStore, prefix []byte, denom string, amount sdkmath.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(append(append([]byte{}, prefix...), denom...), bz)
+}
+
+// denoms returns the denoms minted or burned in the block, in the key order of their snapshots.
+func (t *SupplyTracker) denoms(ctx sdk.Context) []string {
+	denoms := make([]string, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.TransientStore(t.storeKey), supplySnapshotPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(supplySnapshotPrefix):]))
+	}
+
+	return denoms
+}
+
+// SupplyConservationInvariant checks that the total supply of every denom minted or burned in the
+// current block only changed by the amount minted minus the amount burned since its snapshot was
+// taken. Outside of a block there is nothing to compare against and the invariant holds. It runs
+// without consuming gas, so that MsgVerifyInvariant uses the same gas on every node.
+func SupplyConservationInvariant(t *SupplyTracker) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		if !t.isTracking(ctx) {
+			return sdk.FormatInvariant(banktypes.ModuleName, SupplyConservationInvariantRoute, "supply not tracked outside of a block, nothing to check\n"), false
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		store := ctx.TransientStore(t.storeKey)
+		for _, denom := range t.denoms(ctx) {
+			delta := t.bankKeeper.GetSupply(ctx, denom).Amount.Sub(getAmount(store, supplySnapshotPrefix, denom))
+			expected := getAmount(store, mintedSupplyPrefix, denom).Sub(getAmount(store, burnedSupplyPrefix, denom))
+
+			if !delta.Equal(expected) {
+				broken = true
+				msg += fmt.Sprintf("\tdenom %s: supply changed by %s while minted minus burned is %s, unexplained delta %s\n",
+					denom, delta, expected, delta.Sub(expected))
+			}
+		}
+
+		return sdk.FormatInvariant(banktypes.ModuleName, SupplyConservationInvariantRoute, fmt.Sprintf(
+			"supply changed outside of mint and burn operations:\n%s", msg)), broken
+	}
+}
+
+var _ bankkeeper.Keeper = SupplyTrackingBankKeeper{}
+
+// SupplyTrackingBankKeeper is the bank keeper handed to the modules, it records all mints and burns
+// in the SupplyTracker.
+type SupplyTrackingBankKeeper struct {
+	bankkeeper.BaseKeeper
+	tracker *SupplyTracker
+}
+
+func NewSupplyTrackingBankKeeper(bankKeeper bankkeeper.BaseKeeper, tracker *SupplyTracker) SupplyTrackingBankKeeper {
+	return SupplyTrackingBankKeeper{
+		BaseKeeper: bankKeeper,
+		tracker:    tracker,
+	}
+}
+
+// WithTrackedMintCoinsRestriction restricts the minting of coins while keeping the mints and burns tracked, unlike
+// WithMintCoinsRestriction which returns an untracked BaseKeeper.
+func (k SupplyTrackingBankKeeper) WithTrackedMintCoinsRestriction(check banktypes.MintingRestrictionFn) SupplyTrackingBankKeeper {
+	return NewSupplyTrackingBankKeeper(k.BaseKeeper.WithMintCoinsRestriction(check), k.tracker)
+}
+
+func (k SupplyTrackingBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	k.tracker.snapshotSupply(ctx, amounts)
+	if err := k.BaseKeeper.MintCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
+	k.tracker.recordMint(ctx, amounts)
+	return nil
+}
+
+func (k SupplyTrackingBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	k.tracker.snapshotSupply(ctx, amounts)
+	if err := k.BaseKeeper.BurnCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
+	k.tracker.recordBurn(ctx, amounts)
+	return nil
+}
@@ -0,0 +1,134 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupplyConservationInvariant(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+	invariant := SupplyConservationInvariant(app.SupplyTracker)
+
+	app.SupplyTracker.BeginBlock(ctx)
+
+	minted := sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1_000_000)), sdk.NewCoin("peggy0xdAC17F958D2ee523a2206206994597C13D831ec7", sdk.NewInt(500)))
+	burned := sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(400_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, minted))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, govtypes.ModuleName, burned))
+	require.NoError(t, app.BankKeeper.BurnCoins(ctx, govtypes.ModuleName, burned))
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	// fabricate a leak by increasing the supply without minting
+	leakDenom := "peggy0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	supply := app.BankKeeper.GetSupply(ctx, leakDenom).Amount.Add(sdkmath.NewInt(42))
+	bz, err := supply.Marshal()
+	require.NoError(t, err)
+	prefix.NewStore(ctx.KVStore(app.GetKey(banktypes.StoreKey)), banktypes.SupplyKey).Set([]byte(leakDenom), bz)
+
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "denom "+leakDenom)
+	require.Contains(t, msg, "unexplained delta 42")
+	require.NotContains(t, msg, "denom inj:")
+
+	// the next block starts from the leaked supply
+	app.SupplyTracker.BeginBlock(ctx)
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestSupplyConservationInvariantOutsideOfBlock(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1_000_000)))))
+
+	// the outcome must not depend on whether this node tracks the supply
+	msg, broken := SupplyConservationInvariant(app.SupplyTracker)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestSupplyConservationInvariantWithoutInvariantChecks(t *testing.T) {
+	leakDenom := "peggy0xdAC17F958D2ee523a2206206994597C13D831ec7"
+
+	// mints, checks the invariant, then fabricates a leak and checks it again
+	mintAndLeak := func(app *InjectiveApp) (string, bool) {
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1, ChainID: "injective-777", Time: time.Now().UTC()})
+		invariant := SupplyConservationInvariant(app.SupplyTracker)
+
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(leakDenom, sdk.NewInt(500)))))
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+
+		bz, err := app.BankKeeper.GetSupply(ctx, leakDenom).Amount.Add(sdkmath.NewInt(42)).Marshal()
+		require.NoError(t, err)
+		prefix.NewStore(ctx.KVStore(app.GetKey(banktypes.StoreKey)), banktypes.SupplyKey).Set([]byte(leakDenom), bz)
+
+		return invariant(ctx)
+	}
+
+	// a node which never asserts the invariants itself still tracks the supply, so that the invariant run by
+	// MsgVerifyInvariant gives the same result as on the nodes asserting them
+	msg, broken := mintAndLeak(setupWithInvCheckPeriod(false, TestAppOptions{}, 0))
+	require.True(t, broken)
+	require.Contains(t, msg, "denom "+leakDenom)
+	require.Contains(t, msg, "unexplained delta 42")
+
+	checkingMsg, checkingBroken := mintAndLeak(Setup(false))
+	require.Equal(t, checkingBroken, broken)
+	require.Equal(t, checkingMsg, msg)
+}
+
+func TestSupplyTrackerOnlySnapshotsMintedAndBurnedDenoms(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("peggy0xdAC17F958D2ee523a2206206994597C13D831ec7", sdk.NewInt(500)))))
+
+	app.SupplyTracker.BeginBlock(ctx)
+	require.Empty(t, app.SupplyTracker.denoms(ctx))
+
+	minted := sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, minted))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, minted))
+	require.Equal(t, []string{"inj"}, app.SupplyTracker.denoms(ctx))
+
+	msg, broken := SupplyConservationInvariant(app.SupplyTracker)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestSupplyTrackerGasOutsideOfBlock(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{Height: 1, ChainID: "injective-777", Time: time.Now().UTC()})
+	invariant := SupplyConservationInvariant(app.SupplyTracker)
+	minted := sdk.NewCoins(sdk.NewCoin("inj", sdk.NewInt(1_000_000)))
+
+	// mints and checks the invariant, returning the gas consumed
+	mintAndCheck := func() uint64 {
+		meteredCtx := ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+		require.NoError(t, app.BankKeeper.MintCoins(meteredCtx, minttypes.ModuleName, minted))
+
+		msg, broken := invariant(meteredCtx)
+		require.False(t, broken, msg)
+		return meteredCtx.GasMeter().GasConsumed()
+	}
+
+	// the first mint creates the balance of the module account
+	mintAndCheck()
+	gasWithoutTracking := mintAndCheck()
+
+	app.SupplyTracker.BeginBlock(ctx)
+	require.Equal(t, gasWithoutTracking, mintAndCheck(), "the gas used must not depend on whether the node tracks the supply")
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"path/filepath"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/spf13/cast"
+)
+
+const (
+	// TelemetryLabelChainID is the global telemetry label holding the chain-id of the node
+	TelemetryLabelChainID = "chain_id"
+	// TelemetryLabelMoniker is the global telemetry label holding the moniker of the node
+	TelemetryLabelMoniker = "moniker"
+
+	// flagMoniker is the node config (config.toml) key of the node moniker
+	flagMoniker = "moniker"
+
+	// FlagTelemetryExchangeTVL is the app option enabling the exchange_tvl gauge of the total deposits per denom in the
+	// exchange. It only takes effect when the telemetry is enabled.
+	FlagTelemetryExchangeTVL = "telemetry.exchange-tvl"
+)
+
+// initTelemetry starts the telemetry configured in app.toml, if enabled. The chain-id and moniker of the node are added
+// to the configured global labels, so metrics emitted by several chains or nodes into the same sink can be told apart.
+// Both labels are constant for the lifetime of the node, so metric names and per-node cardinality are unchanged.
+func initTelemetry(appOpts servertypes.AppOptions, homePath string) (*telemetry.Metrics, error) {
+	if !cast.ToBool(appOpts.Get("telemetry.enabled")) {
+		return nil, nil
+	}
+
+	globalLabels := withChainIdentityLabels(
+		parseTelemetryGlobalLabels(appOpts.Get("telemetry.global-labels")),
+		telemetryChainID(appOpts, homePath),
+		cast.ToString(appOpts.Get(flagMoniker)),
+	)
+
+	return telemetry.New(telemetry.Config{
+		ServiceName:             cast.ToString(appOpts.Get("telemetry.service-name")),
+		Enabled:                 true,
+		EnableHostname:          cast.ToBool(appOpts.Get("telemetry.enable-hostname")),
+		EnableHostnameLabel:     cast.ToBool(appOpts.Get("telemetry.enable-hostname-label")),
+		EnableServiceLabel:      cast.ToBool(appOpts.Get("telemetry.enable-service-label")),
+		PrometheusRetentionTime: cast.ToInt64(appOpts.Get("telemetry.prometheus-retention-time")),
+		GlobalLabels:            globalLabels,
+	})
+}
+
+// withChainIdentityLabels appends the chain-id and moniker labels to the global labels. Empty values and labels the
+// operator has already configured are left untouched.
+func withChainIdentityLabels(globalLabels [][]string, chainID, moniker string) [][]string {
+	configured := make(map[string]struct{}, len(globalLabels))
+	for _, label := range globalLabels {
+		configured[label[0]] = struct{}{}
+	}
+
+	for _, label := range [][]string{{TelemetryLabelChainID, chainID}, {TelemetryLabelMoniker, moniker}} {
+		if _, ok := configured[label[0]]; ok || label[1] == "" {
+			continue
+		}
+
+		globalLabels = append(globalLabels, label)
+	}
+
+	return globalLabels
+}
+
+// parseTelemetryGlobalLabels parses the telemetry.global-labels option, a list of [key, value] pairs
+func parseTelemetryGlobalLabels(raw interface{}) [][]string {
+	globalLabels := make([][]string, 0)
+
+	switch labels := raw.(type) {
+	case [][]string:
+		for _, label := range labels {
+			if len(label) == 2 {
+				globalLabels = append(globalLabels, label)
+			}
+		}
+	case []interface{}:
+		for _, label := range labels {
+			pair, ok := label.([]interface{})
+			if !ok || len(pair) != 2 {
+				continue
+			}
+
+			globalLabels = append(globalLabels, []string{cast.ToString(pair[0]), cast.ToString(pair[1])})
+		}
+	}
+
+	return globalLabels
+}
+
+// telemetryChainID returns the chain-id the node was started with, falling back to the chain-id of the genesis file
+func telemetryChainID(appOpts servertypes.AppOptions, homePath string) string {
+	if chainID := cast.ToString(appOpts.Get(flags.FlagChainID)); chainID != "" {
+		return chainID
+	}
+
+	genesis, err := tmtypes.GenesisDocFromFile(filepath.Join(homePath, "config", "genesis.json"))
+	if err != nil {
+		return ""
+	}
+
+	return genesis.ChainID
+}
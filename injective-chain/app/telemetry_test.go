@@ -0,0 +1,58 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelemetryChainIdentityLabels(t *testing.T) {
+	t.Run("labels emitted metrics with the chain-id and moniker", func(t *testing.T) {
+		app := SetupWithAppOptions(false, TestAppOptions{Values: map[string]interface{}{
+			"telemetry.enabled":       true,
+			"telemetry.service-name":  "injectived",
+			"telemetry.global-labels": []interface{}{[]interface{}{"env", "test"}},
+			flags.FlagChainID:         "injective-777",
+			flagMoniker:               "sentry-1",
+		}})
+		require.NotNil(t, app.Telemetry)
+
+		telemetry.IncrCounter(1, "telemetry_test", "counter")
+
+		res, err := app.Telemetry.Gather("")
+		require.NoError(t, err)
+
+		var summary struct {
+			Counters []struct {
+				Name   string
+				Labels map[string]string
+			}
+		}
+		require.NoError(t, json.Unmarshal(res.Metrics, &summary))
+
+		var labels map[string]string
+		for _, counter := range summary.Counters {
+			if counter.Name == "injectived.telemetry_test.counter" {
+				labels = counter.Labels
+			}
+		}
+		require.Equal(t, map[string]string{
+			"env":                 "test",
+			TelemetryLabelChainID: "injective-777",
+			TelemetryLabelMoniker: "sentry-1",
+		}, labels)
+	})
+
+	t.Run("is not started when disabled", func(t *testing.T) {
+		app := Setup(false)
+		require.Nil(t, app.Telemetry)
+	})
+
+	t.Run("keeps labels configured by the operator", func(t *testing.T) {
+		labels := withChainIdentityLabels([][]string{{TelemetryLabelChainID, "custom"}}, "injective-777", "")
+		require.Equal(t, [][]string{{TelemetryLabelChainID, "custom"}}, labels)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,10 +37,16 @@ var DefaultConsensusParams = &tmproto.ConsensusParams{
 }
 
 // TestAppOptions is a stub implementing AppOptions, with selected values possible to set
-type TestAppOptions struct{}
+type TestAppOptions struct {
+	Values map[string]interface{}
+}
 
 // Get implements AppOptions
 func (ao TestAppOptions) Get(o string) interface{} {
+	if v, ok := ao.Values[o]; ok {
+		return v
+	}
+
 	switch o {
 	case "trace":
 		return true
@@ -49,10 +56,21 @@ func (ao TestAppOptions) Get(o string) interface{} {
 
 // Setup initializes a new InjectiveApp. A Nop logger is set in InjectiveApp.
 func Setup(isCheckTx bool) *InjectiveApp {
+	return SetupWithAppOptions(isCheckTx, TestAppOptions{})
+}
+
+// SetupWithAppOptions initializes a new InjectiveApp with the given app options. A Nop logger is set in InjectiveApp.
+func SetupWithAppOptions(isCheckTx bool, appOpts servertypes.AppOptions) *InjectiveApp {
+	return setupWithInvCheckPeriod(isCheckTx, appOpts, 1)
+}
+
+// setupWithInvCheckPeriod initializes a new InjectiveApp asserting the invariants every invCheckPeriod blocks,
+// never when zero.
+func setupWithInvCheckPeriod(isCheckTx bool, appOpts servertypes.AppOptions, invCheckPeriod uint) *InjectiveApp {
 	sdk.DefaultBondDenom = "inj"
 
 	db := dbm.NewMemDB()
-	app := NewInjectiveApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 1, MakeEncodingConfig(), TestAppOptions{})
+	app := NewInjectiveApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, invCheckPeriod, MakeEncodingConfig(), appOpts)
 
 	if isCheckTx {
 		return app
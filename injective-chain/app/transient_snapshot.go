@@ -0,0 +1,60 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// TransientSnapshot holds a copy of the contents of the transient stores of the app, by store name.
+//
+// NOTE: This is solely to be used for testing purposes.
+type TransientSnapshot map[string][]kv.Pair
+
+// SnapshotTransientState copies the contents of all transient stores, so that tests can checkpoint the transient
+// writes of a block and roll them back with RestoreTransientState.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *InjectiveApp) SnapshotTransientState(ctx sdk.Context) TransientSnapshot {
+	snapshot := make(TransientSnapshot, len(app.tkeys))
+
+	for name, key := range app.tkeys {
+		pairs := make([]kv.Pair, 0)
+
+		iterator := ctx.TransientStore(key).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs = append(pairs, kv.Pair{
+				Key:   append([]byte{}, iterator.Key()...),
+				Value: append([]byte{}, iterator.Value()...),
+			})
+		}
+		iterator.Close()
+
+		snapshot[name] = pairs
+	}
+
+	return snapshot
+}
+
+// RestoreTransientState replaces the contents of all transient stores with the snapshot.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *InjectiveApp) RestoreTransientState(ctx sdk.Context, snapshot TransientSnapshot) {
+	for name, key := range app.tkeys {
+		store := ctx.TransientStore(key)
+
+		keys := make([][]byte, 0)
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, k := range keys {
+			store.Delete(k)
+		}
+
+		for _, pair := range snapshot[name] {
+			store.Set(pair.Key, pair.Value)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+func TestTransientSnapshot(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+	store := ctx.TransientStore(app.GetTKey(exchangetypes.TStoreKey))
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("2"))
+
+	snapshot := app.SnapshotTransientState(ctx)
+
+	store.Set([]byte("a"), []byte("3"))
+	store.Delete([]byte("b"))
+	store.Set([]byte("c"), []byte("4"))
+
+	app.RestoreTransientState(ctx, snapshot)
+
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("c")))
+
+	// the snapshot isn't affected by writes after it was taken
+	store.Set([]byte("a"), []byte("5"))
+	app.RestoreTransientState(ctx, snapshot)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+}
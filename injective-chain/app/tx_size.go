@@ -0,0 +1,23 @@
+package app
+
+import (
+	"cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FlagMaxTxBytes is the app option holding the maximum size in bytes of txs accepted into the mempool. Zero defers
+// to the consensus params only.
+const FlagMaxTxBytes = "max-tx-bytes"
+
+// CheckTx rejects txs whose raw size exceeds the node's local limit before they are decoded, then runs the CheckTx of
+// BaseApp. The limit keeps oversized txs out of the mempool and gossip without affecting the execution of blocks,
+// which must not depend on node local configuration.
+func (app *InjectiveApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	if txSize := uint64(len(req.Tx)); app.maxTxBytes > 0 && txSize > app.maxTxBytes {
+		err := errors.Wrapf(sdkerrors.ErrTxTooLarge, "tx size %d bytes exceeds the limit of %d bytes", txSize, app.maxTxBytes)
+		return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, nil, false)
+	}
+
+	return app.BaseApp.CheckTx(req)
+}
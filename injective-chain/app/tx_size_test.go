@@ -0,0 +1,36 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxTxBytes(t *testing.T) {
+	const maxTxBytes = 512
+
+	// the bytes are not a valid tx, so only a tx rejected before decoding fails with ErrTxTooLarge
+	checkTx := func(app *InjectiveApp, size int) abci.ResponseCheckTx {
+		return app.CheckTx(abci.RequestCheckTx{Tx: bytes.Repeat([]byte{0xff}, size), Type: abci.CheckTxType_New})
+	}
+	requireError := func(res abci.ResponseCheckTx, err *sdkerrors.Error) {
+		require.Equal(t, err.Codespace(), res.Codespace)
+		require.Equal(t, err.ABCICode(), res.Code)
+	}
+
+	t.Run("rejects an oversized tx before decoding it", func(t *testing.T) {
+		app := SetupWithAppOptions(false, TestAppOptions{Values: map[string]interface{}{FlagMaxTxBytes: uint64(maxTxBytes)}})
+
+		requireError(checkTx(app, maxTxBytes+1), sdkerrors.ErrTxTooLarge)
+		requireError(checkTx(app, maxTxBytes), sdkerrors.ErrTxDecode)
+	})
+
+	t.Run("zero defers to the consensus params", func(t *testing.T) {
+		app := Setup(false)
+
+		requireError(checkTx(app, maxTxBytes+1), sdkerrors.ErrTxDecode)
+	})
+}
@@ -0,0 +1,5467 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/app/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryModuleVersionsRequest is the request type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsRequest struct {
+}
+
+func (m *QueryModuleVersionsRequest) Reset()         { *m = QueryModuleVersionsRequest{} }
+func (m *QueryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsRequest) ProtoMessage()    {}
+func (*QueryModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{0}
+}
+func (m *QueryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsRequest proto.InternalMessageInfo
+
+// QueryModuleVersionsResponse is the response type for the
+// Query/ModuleVersions RPC method.
+type QueryModuleVersionsResponse struct {
+	// module versions sorted by module name
+	ModuleVersions []ModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions"`
+}
+
+func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersionsResponse{} }
+func (m *QueryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsResponse) ProtoMessage()    {}
+func (*QueryModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{1}
+}
+func (m *QueryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsResponse) GetModuleVersions() []ModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
+// ModuleVersion is the consensus version of a module as stored by the upgrade
+// keeper.
+type ModuleVersion struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{2}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
+func (m *ModuleVersion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModuleVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// QueryValidatorRewardSummaryRequest is the request type for the
+// Query/ValidatorRewardSummary RPC method.
+type QueryValidatorRewardSummaryRequest struct {
+	// operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorRewardSummaryRequest) Reset()         { *m = QueryValidatorRewardSummaryRequest{} }
+func (m *QueryValidatorRewardSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardSummaryRequest) ProtoMessage()    {}
+func (*QueryValidatorRewardSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{3}
+}
+func (m *QueryValidatorRewardSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardSummaryRequest.Merge(m, src)
+}
+func (m *QueryValidatorRewardSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardSummaryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorRewardSummaryResponse is the response type for the
+// Query/ValidatorRewardSummary RPC method.
+type QueryValidatorRewardSummaryResponse struct {
+	Summary ValidatorRewardBreakdown `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
+}
+
+func (m *QueryValidatorRewardSummaryResponse) Reset()         { *m = QueryValidatorRewardSummaryResponse{} }
+func (m *QueryValidatorRewardSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardSummaryResponse) ProtoMessage()    {}
+func (*QueryValidatorRewardSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{4}
+}
+func (m *QueryValidatorRewardSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardSummaryResponse.Merge(m, src)
+}
+func (m *QueryValidatorRewardSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardSummaryResponse) GetSummary() ValidatorRewardBreakdown {
+	if m != nil {
+		return m.Summary
+	}
+	return ValidatorRewardBreakdown{}
+}
+
+// ValidatorRewardBreakdown is the commission and reward breakdown of a
+// validator.
+type ValidatorRewardBreakdown struct {
+	OperatorAddress         string                                      `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	CommissionRate          github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	MaxCommissionRate       github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,3,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate"`
+	MaxCommissionChangeRate github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,4,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_change_rate"`
+	DelegatorShares         github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,5,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
+	AccumulatedCommission   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=accumulated_commission,json=accumulatedCommission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"accumulated_commission"`
+	OutstandingRewards      github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=outstanding_rewards,json=outstandingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"outstanding_rewards"`
+}
+
+func (m *ValidatorRewardBreakdown) Reset()         { *m = ValidatorRewardBreakdown{} }
+func (m *ValidatorRewardBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardBreakdown) ProtoMessage()    {}
+func (*ValidatorRewardBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{5}
+}
+func (m *ValidatorRewardBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardBreakdown.Merge(m, src)
+}
+func (m *ValidatorRewardBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardBreakdown proto.InternalMessageInfo
+
+func (m *ValidatorRewardBreakdown) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewardBreakdown) GetAccumulatedCommission() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AccumulatedCommission
+	}
+	return nil
+}
+
+func (m *ValidatorRewardBreakdown) GetOutstandingRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.OutstandingRewards
+	}
+	return nil
+}
+
+// QueryStoreSizesRequest is the request type for the Query/StoreSizes RPC
+// method.
+type QueryStoreSizesRequest struct {
+	// maximum number of keys iterated per store, zero meaning no limit
+	MaxKeysPerStore uint64 `protobuf:"varint,1,opt,name=max_keys_per_store,json=maxKeysPerStore,proto3" json:"max_keys_per_store,omitempty"`
+}
+
+func (m *QueryStoreSizesRequest) Reset()         { *m = QueryStoreSizesRequest{} }
+func (m *QueryStoreSizesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreSizesRequest) ProtoMessage()    {}
+func (*QueryStoreSizesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{6}
+}
+func (m *QueryStoreSizesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreSizesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreSizesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoreSizesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreSizesRequest.Merge(m, src)
+}
+func (m *QueryStoreSizesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreSizesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreSizesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStoreSizesRequest proto.InternalMessageInfo
+
+func (m *QueryStoreSizesRequest) GetMaxKeysPerStore() uint64 {
+	if m != nil {
+		return m.MaxKeysPerStore
+	}
+	return 0
+}
+
+// QueryStoreSizesResponse is the response type for the Query/StoreSizes RPC
+// method.
+type QueryStoreSizesResponse struct {
+	// store sizes sorted by decreasing byte size
+	StoreSizes []StoreSize `protobuf:"bytes,1,rep,name=store_sizes,json=storeSizes,proto3" json:"store_sizes"`
+}
+
+func (m *QueryStoreSizesResponse) Reset()         { *m = QueryStoreSizesResponse{} }
+func (m *QueryStoreSizesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreSizesResponse) ProtoMessage()    {}
+func (*QueryStoreSizesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{7}
+}
+func (m *QueryStoreSizesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreSizesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreSizesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoreSizesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreSizesResponse.Merge(m, src)
+}
+func (m *QueryStoreSizesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreSizesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreSizesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStoreSizesResponse proto.InternalMessageInfo
+
+func (m *QueryStoreSizesResponse) GetStoreSizes() []StoreSize {
+	if m != nil {
+		return m.StoreSizes
+	}
+	return nil
+}
+
+// StoreSize is the number of keys and the total size in bytes of the keys and
+// values of a store.
+type StoreSize struct {
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Keys     uint64 `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Bytes    uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// set when the store has more keys than the iteration limit, the keys and
+	// bytes being then lower bounds
+	Truncated bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *StoreSize) Reset()         { *m = StoreSize{} }
+func (m *StoreSize) String() string { return proto.CompactTextString(m) }
+func (*StoreSize) ProtoMessage()    {}
+func (*StoreSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{8}
+}
+func (m *StoreSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreSize.Merge(m, src)
+}
+func (m *StoreSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreSize proto.InternalMessageInfo
+
+func (m *StoreSize) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreSize) GetKeys() uint64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func (m *StoreSize) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *StoreSize) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// QueryAllParamsRequest is the request type for the Query/AllParams RPC method.
+type QueryAllParamsRequest struct {
+}
+
+func (m *QueryAllParamsRequest) Reset()         { *m = QueryAllParamsRequest{} }
+func (m *QueryAllParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParamsRequest) ProtoMessage()    {}
+func (*QueryAllParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{9}
+}
+func (m *QueryAllParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParamsRequest.Merge(m, src)
+}
+func (m *QueryAllParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllParamsRequest proto.InternalMessageInfo
+
+// QueryAllParamsResponse is the response type for the Query/AllParams RPC
+// method.
+type QueryAllParamsResponse struct {
+	// module params sorted by module name
+	Params []ModuleParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+}
+
+func (m *QueryAllParamsResponse) Reset()         { *m = QueryAllParamsResponse{} }
+func (m *QueryAllParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParamsResponse) ProtoMessage()    {}
+func (*QueryAllParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{10}
+}
+func (m *QueryAllParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParamsResponse.Merge(m, src)
+}
+func (m *QueryAllParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllParamsResponse proto.InternalMessageInfo
+
+func (m *QueryAllParamsResponse) GetParams() []ModuleParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// ModuleParams is the governance controlled parameter set of a module.
+type ModuleParams struct {
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// JSON encoded params, as returned by the params query of the module
+	Params string `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *ModuleParams) Reset()         { *m = ModuleParams{} }
+func (m *ModuleParams) String() string { return proto.CompactTextString(m) }
+func (*ModuleParams) ProtoMessage()    {}
+func (*ModuleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{11}
+}
+func (m *ModuleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleParams.Merge(m, src)
+}
+func (m *ModuleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleParams proto.InternalMessageInfo
+
+func (m *ModuleParams) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleParams) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+// QueryHistoricalBalanceRequest is the request type for the
+// Query/HistoricalBalance RPC method.
+type QueryHistoricalBalanceRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// committed height of the balance, zero meaning the height of the query
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryHistoricalBalanceRequest) Reset()         { *m = QueryHistoricalBalanceRequest{} }
+func (m *QueryHistoricalBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalBalanceRequest) ProtoMessage()    {}
+func (*QueryHistoricalBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{12}
+}
+func (m *QueryHistoricalBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalBalanceRequest.Merge(m, src)
+}
+func (m *QueryHistoricalBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryHistoricalBalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryHistoricalBalanceRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryHistoricalBalanceResponse is the response type for the
+// Query/HistoricalBalance RPC method.
+type QueryHistoricalBalanceResponse struct {
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// height the balance was read at
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryHistoricalBalanceResponse) Reset()         { *m = QueryHistoricalBalanceResponse{} }
+func (m *QueryHistoricalBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalBalanceResponse) ProtoMessage()    {}
+func (*QueryHistoricalBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{13}
+}
+func (m *QueryHistoricalBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalBalanceResponse.Merge(m, src)
+}
+func (m *QueryHistoricalBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalBalanceResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *QueryHistoricalBalanceResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryProposalsByModuleRequest is the request type for the
+// Query/ProposalsByModule RPC method.
+type QueryProposalsByModuleRequest struct {
+	// name of the module, as registered in the module manager
+	ModuleName string             `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByModuleRequest) Reset()         { *m = QueryProposalsByModuleRequest{} }
+func (m *QueryProposalsByModuleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByModuleRequest) ProtoMessage()    {}
+func (*QueryProposalsByModuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{14}
+}
+func (m *QueryProposalsByModuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByModuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByModuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByModuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByModuleRequest.Merge(m, src)
+}
+func (m *QueryProposalsByModuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByModuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByModuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByModuleRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByModuleRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *QueryProposalsByModuleRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsByModuleResponse is the response type for the
+// Query/ProposalsByModule RPC method.
+type QueryProposalsByModuleResponse struct {
+	// proposals ordered by proposal ID
+	Proposals  []*v1.Proposal      `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByModuleResponse) Reset()         { *m = QueryProposalsByModuleResponse{} }
+func (m *QueryProposalsByModuleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByModuleResponse) ProtoMessage()    {}
+func (*QueryProposalsByModuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{15}
+}
+func (m *QueryProposalsByModuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByModuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByModuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByModuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByModuleResponse.Merge(m, src)
+}
+func (m *QueryProposalsByModuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByModuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByModuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByModuleResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByModuleResponse) GetProposals() []*v1.Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsByModuleResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountGovParticipationRequest is the request type for the
+// Query/AccountGovParticipation RPC method.
+type QueryAccountGovParticipationRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountGovParticipationRequest) Reset()         { *m = QueryAccountGovParticipationRequest{} }
+func (m *QueryAccountGovParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountGovParticipationRequest) ProtoMessage()    {}
+func (*QueryAccountGovParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{16}
+}
+func (m *QueryAccountGovParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountGovParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountGovParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountGovParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountGovParticipationRequest.Merge(m, src)
+}
+func (m *QueryAccountGovParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountGovParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountGovParticipationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountGovParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryAccountGovParticipationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAccountGovParticipationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountGovParticipationResponse is the response type for the
+// Query/AccountGovParticipation RPC method.
+type QueryAccountGovParticipationResponse struct {
+	// participations ordered by proposal ID
+	Participations []GovParticipation  `protobuf:"bytes,1,rep,name=participations,proto3" json:"participations"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountGovParticipationResponse) Reset()         { *m = QueryAccountGovParticipationResponse{} }
+func (m *QueryAccountGovParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountGovParticipationResponse) ProtoMessage()    {}
+func (*QueryAccountGovParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{17}
+}
+func (m *QueryAccountGovParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountGovParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountGovParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountGovParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountGovParticipationResponse.Merge(m, src)
+}
+func (m *QueryAccountGovParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountGovParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountGovParticipationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountGovParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryAccountGovParticipationResponse) GetParticipations() []GovParticipation {
+	if m != nil {
+		return m.Participations
+	}
+	return nil
+}
+
+func (m *QueryAccountGovParticipationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GovParticipation is the participation of an account in a governance
+// proposal: either the vote cast by the account, or the votes it inherits from
+// the validators it delegates to.
+type GovParticipation struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// vote cast by the account, unset if the account did not vote
+	Vote *v1.Vote `protobuf:"bytes,2,opt,name=vote,proto3" json:"vote,omitempty"`
+	// votes the account inherits through its delegations, empty if the account
+	// voted
+	InheritedVotes []InheritedGovVote `protobuf:"bytes,3,rep,name=inherited_votes,json=inheritedVotes,proto3" json:"inherited_votes"`
+}
+
+func (m *GovParticipation) Reset()         { *m = GovParticipation{} }
+func (m *GovParticipation) String() string { return proto.CompactTextString(m) }
+func (*GovParticipation) ProtoMessage()    {}
+func (*GovParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{18}
+}
+func (m *GovParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovParticipation.Merge(m, src)
+}
+func (m *GovParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovParticipation proto.InternalMessageInfo
+
+func (m *GovParticipation) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *GovParticipation) GetVote() *v1.Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+func (m *GovParticipation) GetInheritedVotes() []InheritedGovVote {
+	if m != nil {
+		return m.InheritedVotes
+	}
+	return nil
+}
+
+// InheritedGovVote is the vote of a bonded validator applied to the voting
+// power delegated to it by an account which did not vote.
+type InheritedGovVote struct {
+	ValidatorAddress string                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Options          []*v1.WeightedVoteOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// voting power of the delegation, as counted by the tally
+	VotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power"`
+}
+
+func (m *InheritedGovVote) Reset()         { *m = InheritedGovVote{} }
+func (m *InheritedGovVote) String() string { return proto.CompactTextString(m) }
+func (*InheritedGovVote) ProtoMessage()    {}
+func (*InheritedGovVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{19}
+}
+func (m *InheritedGovVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InheritedGovVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InheritedGovVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InheritedGovVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InheritedGovVote.Merge(m, src)
+}
+func (m *InheritedGovVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *InheritedGovVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_InheritedGovVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InheritedGovVote proto.InternalMessageInfo
+
+func (m *InheritedGovVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *InheritedGovVote) GetOptions() []*v1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// QueryModuleStateRootRequest is the request type for the
+// Query/ModuleStateRoot RPC method.
+type QueryModuleStateRootRequest struct {
+	// name of the store key of the module
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryModuleStateRootRequest) Reset()         { *m = QueryModuleStateRootRequest{} }
+func (m *QueryModuleStateRootRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStateRootRequest) ProtoMessage()    {}
+func (*QueryModuleStateRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{20}
+}
+func (m *QueryModuleStateRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleStateRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleStateRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleStateRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStateRootRequest.Merge(m, src)
+}
+func (m *QueryModuleStateRootRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleStateRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStateRootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleStateRootRequest proto.InternalMessageInfo
+
+func (m *QueryModuleStateRootRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryModuleStateRootResponse is the response type for the
+// Query/ModuleStateRoot RPC method.
+type QueryModuleStateRootResponse struct {
+	// height of the commit the root belongs to
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// commit hash of the store of the module, i.e. the root of its IAVL tree
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *QueryModuleStateRootResponse) Reset()         { *m = QueryModuleStateRootResponse{} }
+func (m *QueryModuleStateRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStateRootResponse) ProtoMessage()    {}
+func (*QueryModuleStateRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62648ed48053a966, []int{21}
+}
+func (m *QueryModuleStateRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleStateRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleStateRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleStateRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStateRootResponse.Merge(m, src)
+}
+func (m *QueryModuleStateRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleStateRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStateRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleStateRootResponse proto.InternalMessageInfo
+
+func (m *QueryModuleStateRootResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryModuleStateRootResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "injective.app.v1beta1.QueryModuleVersionsRequest")
+	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "injective.app.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*ModuleVersion)(nil), "injective.app.v1beta1.ModuleVersion")
+	proto.RegisterType((*QueryValidatorRewardSummaryRequest)(nil), "injective.app.v1beta1.QueryValidatorRewardSummaryRequest")
+	proto.RegisterType((*QueryValidatorRewardSummaryResponse)(nil), "injective.app.v1beta1.QueryValidatorRewardSummaryResponse")
+	proto.RegisterType((*ValidatorRewardBreakdown)(nil), "injective.app.v1beta1.ValidatorRewardBreakdown")
+	proto.RegisterType((*QueryStoreSizesRequest)(nil), "injective.app.v1beta1.QueryStoreSizesRequest")
+	proto.RegisterType((*QueryStoreSizesResponse)(nil), "injective.app.v1beta1.QueryStoreSizesResponse")
+	proto.RegisterType((*StoreSize)(nil), "injective.app.v1beta1.StoreSize")
+	proto.RegisterType((*QueryAllParamsRequest)(nil), "injective.app.v1beta1.QueryAllParamsRequest")
+	proto.RegisterType((*QueryAllParamsResponse)(nil), "injective.app.v1beta1.QueryAllParamsResponse")
+	proto.RegisterType((*ModuleParams)(nil), "injective.app.v1beta1.ModuleParams")
+	proto.RegisterType((*QueryHistoricalBalanceRequest)(nil), "injective.app.v1beta1.QueryHistoricalBalanceRequest")
+	proto.RegisterType((*QueryHistoricalBalanceResponse)(nil), "injective.app.v1beta1.QueryHistoricalBalanceResponse")
+	proto.RegisterType((*QueryProposalsByModuleRequest)(nil), "injective.app.v1beta1.QueryProposalsByModuleRequest")
+	proto.RegisterType((*QueryProposalsByModuleResponse)(nil), "injective.app.v1beta1.QueryProposalsByModuleResponse")
+	proto.RegisterType((*QueryAccountGovParticipationRequest)(nil), "injective.app.v1beta1.QueryAccountGovParticipationRequest")
+	proto.RegisterType((*QueryAccountGovParticipationResponse)(nil), "injective.app.v1beta1.QueryAccountGovParticipationResponse")
+	proto.RegisterType((*GovParticipation)(nil), "injective.app.v1beta1.GovParticipation")
+	proto.RegisterType((*InheritedGovVote)(nil), "injective.app.v1beta1.InheritedGovVote")
+	proto.RegisterType((*QueryModuleStateRootRequest)(nil), "injective.app.v1beta1.QueryModuleStateRootRequest")
+	proto.RegisterType((*QueryModuleStateRootResponse)(nil), "injective.app.v1beta1.QueryModuleStateRootResponse")
+}
+
+func init() { proto.RegisterFile("injective/app/v1beta1/query.proto", fileDescriptor_62648ed48053a966) }
+
+var fileDescriptor_62648ed48053a966 = []byte{
+	// 1522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x14, 0xc7,
+	0x12, 0xf7, 0x18, 0x7f, 0xe0, 0x5a, 0x9e, 0x3f, 0x1a, 0xb0, 0xf7, 0x2d, 0x7e, 0x6b, 0x33, 0x20,
+	0x30, 0xf0, 0xbc, 0x2b, 0xdb, 0xf8, 0x89, 0x0f, 0x3d, 0x14, 0xdb, 0x10, 0x87, 0x90, 0x04, 0x33,
+	0x56, 0x8c, 0x12, 0xa4, 0x8c, 0x7a, 0x67, 0x5b, 0xbb, 0x13, 0xef, 0x4c, 0x0f, 0xd3, 0xb3, 0x03,
+	0x1b, 0xc4, 0x85, 0x4b, 0x38, 0x46, 0xe2, 0x90, 0x6b, 0x6e, 0x91, 0x72, 0xce, 0x29, 0x52, 0x2e,
+	0x39, 0x44, 0x9c, 0x12, 0x24, 0xa4, 0x28, 0x8a, 0x22, 0x12, 0x41, 0xfe, 0x90, 0xa8, 0x3f, 0x66,
+	0x76, 0x66, 0xbd, 0xb3, 0xd8, 0x16, 0x39, 0x79, 0xba, 0xbb, 0xea, 0x57, 0xbf, 0xaa, 0xae, 0xae,
+	0xaa, 0x35, 0x1c, 0xb7, 0xdd, 0x4f, 0x89, 0x15, 0xd8, 0x21, 0x29, 0x63, 0xcf, 0x2b, 0x87, 0x0b,
+	0x15, 0x12, 0xe0, 0x85, 0xf2, 0xdd, 0x26, 0xf1, 0x5b, 0x25, 0xcf, 0xa7, 0x01, 0x45, 0x47, 0x63,
+	0x91, 0x12, 0xf6, 0xbc, 0x92, 0x12, 0x29, 0x9c, 0xb5, 0x28, 0x73, 0x28, 0x2b, 0x57, 0x30, 0x23,
+	0x52, 0x3e, 0xd6, 0xf6, 0x70, 0xcd, 0x76, 0x71, 0x60, 0x53, 0x57, 0x42, 0x14, 0x8a, 0x49, 0xd9,
+	0x48, 0xca, 0xa2, 0x76, 0x74, 0x3e, 0xa5, 0xce, 0x6b, 0x34, 0x2c, 0x87, 0x0b, 0xfc, 0x8f, 0x3a,
+	0x38, 0x52, 0xa3, 0x35, 0x2a, 0x3e, 0xcb, 0xfc, 0x4b, 0xed, 0x4e, 0xd7, 0x28, 0xad, 0x35, 0x38,
+	0x63, 0xbb, 0x8c, 0x5d, 0x97, 0x06, 0xc2, 0x16, 0x93, 0xa7, 0xfa, 0x34, 0x14, 0x6e, 0x71, 0x3a,
+	0xef, 0xd3, 0x6a, 0xb3, 0x41, 0xb6, 0x88, 0xcf, 0xf8, 0xa1, 0x41, 0xee, 0x36, 0x09, 0x0b, 0x74,
+	0x1f, 0x8e, 0x75, 0x3d, 0x65, 0x1e, 0x75, 0x19, 0x41, 0x9b, 0x30, 0xe6, 0x88, 0x13, 0x33, 0x54,
+	0x47, 0x79, 0x6d, 0xf6, 0xc0, 0x5c, 0x6e, 0xf1, 0x64, 0xa9, 0x6b, 0x18, 0x4a, 0x29, 0x9c, 0xd5,
+	0x81, 0xa7, 0x2f, 0x66, 0xfa, 0x8c, 0x51, 0x27, 0x05, 0xae, 0xff, 0x1f, 0xfe, 0x95, 0x12, 0x43,
+	0x08, 0x06, 0x5c, 0xec, 0x90, 0xbc, 0x36, 0xab, 0xcd, 0x8d, 0x18, 0xe2, 0x1b, 0xe5, 0x61, 0x58,
+	0x99, 0xcc, 0xf7, 0xcf, 0x6a, 0x73, 0x03, 0x46, 0xb4, 0xd4, 0x6f, 0x81, 0x2e, 0x28, 0x6f, 0xe1,
+	0x86, 0x5d, 0xc5, 0x01, 0xf5, 0x0d, 0x72, 0x0f, 0xfb, 0xd5, 0xcd, 0xa6, 0xe3, 0x60, 0xbf, 0xa5,
+	0x1c, 0x43, 0xe7, 0x60, 0x22, 0x8c, 0x04, 0x4c, 0x5c, 0xad, 0xfa, 0x84, 0x31, 0x65, 0x60, 0x3c,
+	0x3e, 0x58, 0x91, 0xfb, 0x7a, 0x08, 0x27, 0x7a, 0x42, 0xaa, 0x68, 0xdc, 0x84, 0x61, 0x26, 0xb7,
+	0x04, 0x52, 0x6e, 0xb1, 0x9c, 0x11, 0x85, 0x0e, 0x9c, 0x55, 0x9f, 0xe0, 0xed, 0x2a, 0xbd, 0x17,
+	0x05, 0x24, 0x42, 0xd1, 0x7f, 0x19, 0x84, 0x7c, 0x96, 0x2c, 0x3a, 0x03, 0xe3, 0xd4, 0x23, 0x7e,
+	0x17, 0x07, 0xc6, 0xa2, 0x7d, 0xc5, 0x1f, 0xdd, 0x86, 0x31, 0x8b, 0x3a, 0x8e, 0xcd, 0x78, 0x80,
+	0x4c, 0x1f, 0x07, 0x44, 0x04, 0x6d, 0x64, 0xb5, 0xc4, 0xed, 0xfd, 0xf6, 0x62, 0xe6, 0x54, 0xcd,
+	0x0e, 0xea, 0xcd, 0x4a, 0xc9, 0xa2, 0x4e, 0x59, 0x25, 0x97, 0xfc, 0x33, 0xcf, 0xaa, 0xdb, 0xe5,
+	0xa0, 0xe5, 0x11, 0x56, 0xba, 0x4a, 0x2c, 0x63, 0xb4, 0x0d, 0x63, 0xe0, 0x80, 0xa0, 0x4f, 0xe0,
+	0xb0, 0x83, 0xef, 0x9b, 0x9d, 0xe0, 0x07, 0xf6, 0x05, 0x3e, 0xe1, 0xe0, 0xfb, 0x6b, 0x69, 0xfc,
+	0x6d, 0x28, 0x74, 0xe0, 0x5b, 0x75, 0xec, 0xd6, 0x88, 0x34, 0x33, 0xb0, 0x2f, 0x33, 0x53, 0x29,
+	0x33, 0x6b, 0x02, 0x4f, 0x18, 0xfb, 0x08, 0xc6, 0xab, 0xa4, 0x41, 0x6a, 0x22, 0xa2, 0xac, 0x8e,
+	0x7d, 0xc2, 0xf2, 0x83, 0xfb, 0x32, 0x31, 0x16, 0xe3, 0x6c, 0x0a, 0x18, 0xf4, 0x58, 0x83, 0x49,
+	0x6c, 0x59, 0x4d, 0xa7, 0xd9, 0xc0, 0x01, 0xa9, 0x26, 0x1c, 0xca, 0x0f, 0x89, 0xf7, 0x32, 0x5d,
+	0x92, 0x40, 0x25, 0xfe, 0xe6, 0xe3, 0x3c, 0xb9, 0x4a, 0xac, 0x35, 0x6a, 0xbb, 0xab, 0x4b, 0xdc,
+	0xfe, 0x37, 0x7f, 0xcc, 0x9c, 0xdb, 0x9d, 0x7d, 0xae, 0xc3, 0x8c, 0xa3, 0x09, 0x83, 0x6d, 0x7f,
+	0xd1, 0x23, 0x0d, 0x0e, 0xd3, 0x66, 0xc0, 0x02, 0xec, 0x56, 0x6d, 0xb7, 0x66, 0xfa, 0x22, 0xad,
+	0x58, 0x7e, 0xf8, 0x9f, 0xe2, 0x81, 0x12, 0xd6, 0x64, 0x0e, 0x33, 0xfd, 0x1a, 0x4c, 0x8a, 0x07,
+	0xb5, 0x19, 0x50, 0x9f, 0x6c, 0xda, 0x9f, 0x11, 0xd6, 0x7e, 0x97, 0x88, 0xdf, 0xf8, 0x36, 0x69,
+	0x31, 0xd3, 0x23, 0xbe, 0xc9, 0xb8, 0x84, 0xc8, 0xeb, 0x01, 0x63, 0xcc, 0xc1, 0xf7, 0x6f, 0x90,
+	0x16, 0xdb, 0x20, 0xbe, 0x50, 0xd4, 0x2b, 0x30, 0xb5, 0x03, 0x46, 0xbd, 0xc5, 0x75, 0xc8, 0x09,
+	0x55, 0x93, 0xf1, 0x6d, 0x55, 0x95, 0x66, 0x33, 0xde, 0x63, 0xac, 0xaf, 0x1e, 0x20, 0xb0, 0x18,
+	0x50, 0xf7, 0x60, 0x24, 0x3e, 0x46, 0xc7, 0x60, 0x44, 0xa2, 0x6e, 0x93, 0x96, 0x7a, 0x6c, 0x07,
+	0xc5, 0xc6, 0x0d, 0xd2, 0xe2, 0x65, 0x8a, 0xd3, 0x56, 0xf5, 0x48, 0x7c, 0xa3, 0x23, 0x30, 0x58,
+	0x69, 0x05, 0x84, 0x89, 0x27, 0x31, 0x60, 0xc8, 0x05, 0x9a, 0x86, 0x91, 0xc0, 0x6f, 0xba, 0x16,
+	0xbf, 0x1a, 0x91, 0xc5, 0x07, 0x8d, 0xf6, 0x86, 0x3e, 0x05, 0x47, 0x85, 0x57, 0x2b, 0x8d, 0xc6,
+	0x06, 0xf6, 0xb1, 0x13, 0x17, 0xe3, 0x3b, 0x30, 0xd9, 0x79, 0xa0, 0xbc, 0x5d, 0x81, 0x21, 0x4f,
+	0xec, 0x28, 0x47, 0x4f, 0xf4, 0x2c, 0xbf, 0x52, 0x59, 0xf9, 0xaa, 0x14, 0xf5, 0x2b, 0x70, 0x28,
+	0x79, 0x8a, 0x26, 0x61, 0x48, 0xd6, 0x65, 0xe5, 0xa7, 0x5a, 0xf1, 0x7d, 0x65, 0xaa, 0x5f, 0xee,
+	0x2b, 0xfd, 0x1a, 0xfc, 0x47, 0x90, 0x7b, 0xc7, 0xe6, 0x01, 0xb1, 0x2d, 0xdc, 0x58, 0xc5, 0x0d,
+	0xec, 0x5a, 0x24, 0xba, 0xd9, 0x3c, 0x0c, 0xa7, 0xcb, 0x54, 0xb4, 0xe4, 0x41, 0xaa, 0x12, 0x97,
+	0x3a, 0x0a, 0x51, 0x2e, 0xb8, 0xa1, 0x3a, 0xb1, 0x6b, 0xf5, 0x40, 0xc4, 0xee, 0x80, 0xa1, 0x56,
+	0x3a, 0x83, 0x62, 0x96, 0x21, 0x15, 0x8d, 0x8b, 0x30, 0x5c, 0x91, 0x5b, 0xaa, 0x0e, 0xff, 0xbb,
+	0x6b, 0x56, 0x8b, 0x94, 0x56, 0x15, 0x57, 0xc9, 0x27, 0x8c, 0xf6, 0xa7, 0x8c, 0x3e, 0xd6, 0x94,
+	0x7b, 0x1b, 0x3e, 0xf5, 0x28, 0xc3, 0x0d, 0xb6, 0xaa, 0x7a, 0x62, 0xe4, 0xde, 0x0c, 0xe4, 0x54,
+	0x2b, 0x4c, 0xf4, 0x2a, 0x90, 0x5b, 0x1f, 0xf0, 0x8e, 0xf5, 0x36, 0x40, 0xbb, 0xd3, 0x0b, 0xf8,
+	0xdc, 0xe2, 0xa9, 0x14, 0x31, 0x39, 0x46, 0x44, 0xf4, 0x36, 0x70, 0x2d, 0x02, 0x37, 0x12, 0x9a,
+	0xfa, 0x57, 0x1a, 0x14, 0xb3, 0xa8, 0xa8, 0x00, 0x2c, 0xc3, 0x88, 0x17, 0x1d, 0xaa, 0x8c, 0x98,
+	0x8a, 0x2c, 0xf1, 0x69, 0x21, 0x5c, 0x28, 0x45, 0xca, 0x46, 0x5b, 0x12, 0xad, 0x77, 0x61, 0x78,
+	0xfa, 0xb5, 0x0c, 0xa5, 0xcd, 0x14, 0xc5, 0xcf, 0x35, 0xd5, 0x30, 0x57, 0x2c, 0x8b, 0x36, 0xdd,
+	0x60, 0x9d, 0x86, 0x1b, 0xd8, 0x0f, 0x6c, 0xcb, 0xf6, 0x84, 0xc0, 0xeb, 0x53, 0xe2, 0x4d, 0x05,
+	0xeb, 0x47, 0x0d, 0x4e, 0xf6, 0x66, 0xa2, 0x42, 0xf6, 0x21, 0x8c, 0x7a, 0xc9, 0x83, 0x28, 0x6e,
+	0xa7, 0x33, 0x5e, 0x52, 0x27, 0x50, 0x34, 0xcb, 0xa4, 0x41, 0xde, 0x5c, 0x48, 0xbf, 0xd5, 0x60,
+	0xbc, 0xd3, 0x26, 0xcf, 0xb9, 0xe8, 0xf6, 0x4c, 0xbb, 0xaa, 0xaa, 0x24, 0x44, 0x5b, 0xd7, 0xab,
+	0xe8, 0x34, 0x0c, 0x84, 0x54, 0x75, 0xfb, 0xdc, 0xe2, 0xe1, 0x8e, 0x1c, 0xd8, 0xa2, 0x01, 0x31,
+	0x84, 0x00, 0xda, 0x82, 0x31, 0xdb, 0xad, 0x13, 0xdf, 0xe6, 0xdd, 0x29, 0xa4, 0xb2, 0x62, 0xf5,
+	0xf2, 0xff, 0x7a, 0x24, 0xbd, 0x4e, 0x43, 0x8e, 0x13, 0xf9, 0x1f, 0xa3, 0xf0, 0x4d, 0xa6, 0xff,
+	0xac, 0xc1, 0x78, 0xa7, 0xe8, 0x9e, 0x66, 0x2f, 0x74, 0x19, 0x86, 0xa9, 0x27, 0x6f, 0xa4, 0x5f,
+	0x30, 0x3a, 0xde, 0xe1, 0xc5, 0x6d, 0xf1, 0x42, 0xa5, 0xc1, 0x9b, 0x42, 0xd2, 0x88, 0x34, 0xd0,
+	0x2d, 0x38, 0x14, 0xd2, 0x80, 0xb7, 0x39, 0x8f, 0xde, 0x23, 0xfe, 0x3e, 0x07, 0x93, 0x9c, 0xc4,
+	0xd8, 0xe0, 0x10, 0xfa, 0x95, 0xd4, 0x44, 0xbc, 0x19, 0xe0, 0x80, 0x18, 0x94, 0x06, 0xbb, 0x2d,
+	0x03, 0xfa, 0xbb, 0x30, 0xdd, 0x5d, 0x5f, 0x25, 0x62, 0xbb, 0x02, 0x69, 0xc9, 0x0a, 0xc4, 0xbb,
+	0x8b, 0x4f, 0xa9, 0xac, 0x4b, 0x87, 0x0c, 0xf1, 0xbd, 0xf8, 0x3c, 0x07, 0x83, 0x02, 0x0c, 0x7d,
+	0xad, 0xc1, 0x68, 0x7a, 0x46, 0x47, 0x0b, 0x19, 0x37, 0x97, 0x3d, 0xed, 0x17, 0x16, 0xf7, 0xa2,
+	0x22, 0xf9, 0xea, 0xa5, 0x47, 0xcf, 0xff, 0x7a, 0xd2, 0x3f, 0x87, 0x4e, 0x95, 0xbb, 0xff, 0x36,
+	0xea, 0xf8, 0x7d, 0x80, 0x7e, 0xd7, 0x60, 0xb2, 0xfb, 0x1c, 0x8d, 0x2e, 0xf6, 0x32, 0xdf, 0x73,
+	0x9c, 0x2f, 0x5c, 0xda, 0x8f, 0xaa, 0xf2, 0xe0, 0x86, 0xf0, 0xe0, 0x1a, 0x5a, 0xcb, 0xf0, 0xa0,
+	0x9d, 0xab, 0x72, 0x56, 0x32, 0xd5, 0x78, 0x5e, 0x7e, 0xb0, 0x23, 0x8b, 0x1f, 0xa2, 0x2f, 0x35,
+	0x80, 0xf6, 0x38, 0x82, 0xe6, 0x7b, 0xf1, 0xda, 0x31, 0xfd, 0x14, 0x4a, 0xbb, 0x15, 0x57, 0xd4,
+	0xcf, 0x0a, 0xea, 0x27, 0x91, 0x9e, 0x41, 0x3d, 0x31, 0x02, 0xa1, 0x27, 0x1a, 0x8c, 0xc4, 0x93,
+	0x03, 0xfa, 0x6f, 0x2f, 0x4b, 0x9d, 0x93, 0x47, 0x61, 0x7e, 0x97, 0xd2, 0x8a, 0xd6, 0x19, 0x41,
+	0xeb, 0x04, 0x3a, 0x9e, 0x41, 0x0b, 0x37, 0x1a, 0xa6, 0x1c, 0x1b, 0xd0, 0xf7, 0x1a, 0x4c, 0xec,
+	0xe8, 0xe4, 0xe8, 0x7c, 0x2f, 0x7b, 0x59, 0x13, 0x46, 0x61, 0x79, 0x8f, 0x5a, 0x8a, 0xed, 0x65,
+	0xc1, 0x76, 0x19, 0x2d, 0x65, 0xb0, 0xad, 0xc7, 0x9a, 0xa6, 0x1a, 0x13, 0xca, 0x0f, 0xe2, 0xfb,
+	0xfe, 0x41, 0x83, 0x89, 0x1d, 0x8d, 0xb8, 0x37, 0xff, 0xac, 0x11, 0xa2, 0xb0, 0xbc, 0x47, 0x2d,
+	0xc5, 0xff, 0x2d, 0xc1, 0xff, 0x12, 0xba, 0x90, 0xc1, 0x3f, 0x6e, 0xf0, 0x66, 0xa5, 0x65, 0xca,
+	0xe7, 0x58, 0x7e, 0x90, 0x28, 0x52, 0x0f, 0xd1, 0x4f, 0x1a, 0x4c, 0x65, 0x34, 0x48, 0xd4, 0xf3,
+	0x65, 0xf5, 0xee, 0xef, 0x85, 0xcb, 0xfb, 0xd2, 0x55, 0x6e, 0x5d, 0x12, 0x6e, 0x9d, 0x47, 0x8b,
+	0x19, 0x6e, 0xd5, 0x68, 0x68, 0xa6, 0xba, 0x6d, 0xe2, 0x56, 0xbe, 0xd3, 0x60, 0xac, 0xa3, 0xc0,
+	0xa2, 0x5d, 0x14, 0xb7, 0xce, 0x6a, 0x5e, 0x58, 0xda, 0x93, 0x8e, 0x22, 0x7e, 0x45, 0x10, 0xbf,
+	0x80, 0xfe, 0xd7, 0xbb, 0x22, 0x32, 0xae, 0x68, 0xf2, 0x3a, 0x9e, 0xbe, 0x8d, 0xd5, 0x3b, 0x4f,
+	0x5f, 0x16, 0xb5, 0x67, 0x2f, 0x8b, 0xda, 0x9f, 0x2f, 0x8b, 0xda, 0x17, 0xaf, 0x8a, 0x7d, 0xcf,
+	0x5e, 0x15, 0xfb, 0x7e, 0x7d, 0x55, 0xec, 0xfb, 0x78, 0x25, 0xd1, 0xb0, 0xae, 0x47, 0xd8, 0xef,
+	0xe1, 0x0a, 0x6b, 0x5b, 0x9a, 0xb7, 0xa8, 0x4f, 0x92, 0xcb, 0x3a, 0xb6, 0x5d, 0x61, 0x5e, 0xf4,
+	0xb3, 0xca, 0x90, 0xf8, 0xaf, 0xcf, 0xd2, 0xdf, 0x03, 0x00, 0x7d, 0x0f, 0x10, 0x76, 0xca, 0x12,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ModuleVersions returns the consensus version of every module as stored by
+	// the upgrade keeper.
+	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// ValidatorRewardSummary returns the commission rates, the accumulated
+	// commission and the outstanding rewards of a validator.
+	ValidatorRewardSummary(ctx context.Context, in *QueryValidatorRewardSummaryRequest, opts ...grpc.CallOption) (*QueryValidatorRewardSummaryResponse, error)
+	// StoreSizes returns the number of keys and the byte size of every store.
+	StoreSizes(ctx context.Context, in *QueryStoreSizesRequest, opts ...grpc.CallOption) (*QueryStoreSizesResponse, error)
+	// AllParams returns the governance controlled parameters of every module.
+	AllParams(ctx context.Context, in *QueryAllParamsRequest, opts ...grpc.CallOption) (*QueryAllParamsResponse, error)
+	// HistoricalBalance returns the balance of an account in a denom as of a
+	// past committed height.
+	HistoricalBalance(ctx context.Context, in *QueryHistoricalBalanceRequest, opts ...grpc.CallOption) (*QueryHistoricalBalanceResponse, error)
+	// ProposalsByModule returns the governance proposals with at least one
+	// message targeting a module.
+	ProposalsByModule(ctx context.Context, in *QueryProposalsByModuleRequest, opts ...grpc.CallOption) (*QueryProposalsByModuleResponse, error)
+	// AccountGovParticipation returns the participation of an account in the
+	// governance proposals in their voting period.
+	AccountGovParticipation(ctx context.Context, in *QueryAccountGovParticipationRequest, opts ...grpc.CallOption) (*QueryAccountGovParticipationResponse, error)
+	// ModuleStateRoot returns the commit hash of the store of a module at the
+	// queried height, as included in the app hash.
+	ModuleStateRoot(ctx context.Context, in *QueryModuleStateRootRequest, opts ...grpc.CallOption) (*QueryModuleStateRootResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error) {
+	out := new(QueryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/ModuleVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRewardSummary(ctx context.Context, in *QueryValidatorRewardSummaryRequest, opts ...grpc.CallOption) (*QueryValidatorRewardSummaryResponse, error) {
+	out := new(QueryValidatorRewardSummaryResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/ValidatorRewardSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoreSizes(ctx context.Context, in *QueryStoreSizesRequest, opts ...grpc.CallOption) (*QueryStoreSizesResponse, error) {
+	out := new(QueryStoreSizesResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/StoreSizes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllParams(ctx context.Context, in *QueryAllParamsRequest, opts ...grpc.CallOption) (*QueryAllParamsResponse, error) {
+	out := new(QueryAllParamsResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/AllParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalBalance(ctx context.Context, in *QueryHistoricalBalanceRequest, opts ...grpc.CallOption) (*QueryHistoricalBalanceResponse, error) {
+	out := new(QueryHistoricalBalanceResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/HistoricalBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByModule(ctx context.Context, in *QueryProposalsByModuleRequest, opts ...grpc.CallOption) (*QueryProposalsByModuleResponse, error) {
+	out := new(QueryProposalsByModuleResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/ProposalsByModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountGovParticipation(ctx context.Context, in *QueryAccountGovParticipationRequest, opts ...grpc.CallOption) (*QueryAccountGovParticipationResponse, error) {
+	out := new(QueryAccountGovParticipationResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/AccountGovParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleStateRoot(ctx context.Context, in *QueryModuleStateRootRequest, opts ...grpc.CallOption) (*QueryModuleStateRootResponse, error) {
+	out := new(QueryModuleStateRootResponse)
+	err := c.cc.Invoke(ctx, "/injective.app.v1beta1.Query/ModuleStateRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ModuleVersions returns the consensus version of every module as stored by
+	// the upgrade keeper.
+	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// ValidatorRewardSummary returns the commission rates, the accumulated
+	// commission and the outstanding rewards of a validator.
+	ValidatorRewardSummary(context.Context, *QueryValidatorRewardSummaryRequest) (*QueryValidatorRewardSummaryResponse, error)
+	// StoreSizes returns the number of keys and the byte size of every store.
+	StoreSizes(context.Context, *QueryStoreSizesRequest) (*QueryStoreSizesResponse, error)
+	// AllParams returns the governance controlled parameters of every module.
+	AllParams(context.Context, *QueryAllParamsRequest) (*QueryAllParamsResponse, error)
+	// HistoricalBalance returns the balance of an account in a denom as of a
+	// past committed height.
+	HistoricalBalance(context.Context, *QueryHistoricalBalanceRequest) (*QueryHistoricalBalanceResponse, error)
+	// ProposalsByModule returns the governance proposals with at least one
+	// message targeting a module.
+	ProposalsByModule(context.Context, *QueryProposalsByModuleRequest) (*QueryProposalsByModuleResponse, error)
+	// AccountGovParticipation returns the participation of an account in the
+	// governance proposals in their voting period.
+	AccountGovParticipation(context.Context, *QueryAccountGovParticipationRequest) (*QueryAccountGovParticipationResponse, error)
+	// ModuleStateRoot returns the commit hash of the store of a module at the
+	// queried height, as included in the app hash.
+	ModuleStateRoot(context.Context, *QueryModuleStateRootRequest) (*QueryModuleStateRootResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRewardSummary(ctx context.Context, req *QueryValidatorRewardSummaryRequest) (*QueryValidatorRewardSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewardSummary not implemented")
+}
+func (*UnimplementedQueryServer) StoreSizes(ctx context.Context, req *QueryStoreSizesRequest) (*QueryStoreSizesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreSizes not implemented")
+}
+func (*UnimplementedQueryServer) AllParams(ctx context.Context, req *QueryAllParamsRequest) (*QueryAllParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllParams not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalBalance(ctx context.Context, req *QueryHistoricalBalanceRequest) (*QueryHistoricalBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalBalance not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByModule(ctx context.Context, req *QueryProposalsByModuleRequest) (*QueryProposalsByModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByModule not implemented")
+}
+func (*UnimplementedQueryServer) AccountGovParticipation(ctx context.Context, req *QueryAccountGovParticipationRequest) (*QueryAccountGovParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountGovParticipation not implemented")
+}
+func (*UnimplementedQueryServer) ModuleStateRoot(ctx context.Context, req *QueryModuleStateRootRequest) (*QueryModuleStateRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleStateRoot not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/ModuleVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleVersions(ctx, req.(*QueryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRewardSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewardSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/ValidatorRewardSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewardSummary(ctx, req.(*QueryValidatorRewardSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoreSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoreSizesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoreSizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/StoreSizes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoreSizes(ctx, req.(*QueryStoreSizesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/AllParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllParams(ctx, req.(*QueryAllParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/HistoricalBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalBalance(ctx, req.(*QueryHistoricalBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/ProposalsByModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByModule(ctx, req.(*QueryProposalsByModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountGovParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountGovParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountGovParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/AccountGovParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountGovParticipation(ctx, req.(*QueryAccountGovParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleStateRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleStateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.app.v1beta1.Query/ModuleStateRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleStateRoot(ctx, req.(*QueryModuleStateRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.app.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleVersions",
+			Handler:    _Query_ModuleVersions_Handler,
+		},
+		{
+			MethodName: "ValidatorRewardSummary",
+			Handler:    _Query_ValidatorRewardSummary_Handler,
+		},
+		{
+			MethodName: "StoreSizes",
+			Handler:    _Query_StoreSizes_Handler,
+		},
+		{
+			MethodName: "AllParams",
+			Handler:    _Query_AllParams_Handler,
+		},
+		{
+			MethodName: "HistoricalBalance",
+			Handler:    _Query_HistoricalBalance_Handler,
+		},
+		{
+			MethodName: "ProposalsByModule",
+			Handler:    _Query_ProposalsByModule_Handler,
+		},
+		{
+			MethodName: "AccountGovParticipation",
+			Handler:    _Query_AccountGovParticipation_Handler,
+		},
+		{
+			MethodName: "ModuleStateRoot",
+			Handler:    _Query_ModuleStateRoot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/app/v1beta1/query.proto",
+}
+
+func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutstandingRewards) > 0 {
+		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AccumulatedCommission) > 0 {
+		for iNdEx := len(m.AccumulatedCommission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatedCommission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoreSizesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreSizesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreSizesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxKeysPerStore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxKeysPerStore))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoreSizesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreSizesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreSizesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreSizes) > 0 {
+		for iNdEx := len(m.StoreSizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreSizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Keys != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Keys))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		i -= len(m.Params)
+		copy(dAtA[i:], m.Params)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Params)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByModuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByModuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByModuleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountGovParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountGovParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountGovParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountGovParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountGovParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountGovParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Participations) > 0 {
+		for iNdEx := len(m.Participations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InheritedVotes) > 0 {
+		for iNdEx := len(m.InheritedVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InheritedVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Vote != nil {
+		{
+			size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InheritedGovVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InheritedGovVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InheritedGovVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryModuleVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Summary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorRewardBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AccumulatedCommission) > 0 {
+		for _, e := range m.AccumulatedCommission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStoreSizesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxKeysPerStore != 0 {
+		n += 1 + sovQuery(uint64(m.MaxKeysPerStore))
+	}
+	return n
+}
+
+func (m *QueryStoreSizesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoreSizes) > 0 {
+		for _, e := range m.StoreSizes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Keys != 0 {
+		n += 1 + sovQuery(uint64(m.Keys))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovQuery(uint64(m.Bytes))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryHistoricalBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryProposalsByModuleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountGovParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountGovParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participations) > 0 {
+		for _, e := range m.Participations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GovParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Vote != nil {
+		l = m.Vote.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.InheritedVotes) > 0 {
+		for _, e := range m.InheritedVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InheritedGovVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleStateRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleStateRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRewardSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRewardSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedCommission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedCommission = append(m.AccumulatedCommission, types.DecCoin{})
+			if err := m.AccumulatedCommission[len(m.AccumulatedCommission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoreSizesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoreSizesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoreSizesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeysPerStore", wireType)
+			}
+			m.MaxKeysPerStore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeysPerStore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoreSizesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoreSizesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoreSizesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreSizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreSizes = append(m.StoreSizes, StoreSize{})
+			if err := m.StoreSizes[len(m.StoreSizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, ModuleParams{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByModuleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByModuleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByModuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByModuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByModuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &v1.Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountGovParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountGovParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountGovParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountGovParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountGovParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountGovParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participations = append(m.Participations, GovParticipation{})
+			if err := m.Participations[len(m.Participations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vote == nil {
+				m.Vote = &v1.Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InheritedVotes = append(m.InheritedVotes, InheritedGovVote{})
+			if err := m.InheritedVotes[len(m.InheritedVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InheritedGovVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InheritedGovVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InheritedGovVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &v1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
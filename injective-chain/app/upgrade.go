@@ -1,6 +1,39 @@
 package app
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
 // nolint:all
 const (
 	upgradeName = "v1.12.0"
 )
+
+// upgradeExchangeParams returns the exchange params of the upgrade. The params added by the upgrade are missing from
+// the stored params, so they are set to their default values.
+func upgradeExchangeParams(params exchangetypes.Params, upgradeHeight int64) exchangetypes.Params {
+	defaultParams := exchangetypes.DefaultParams()
+
+	params.PostOnlyModeHeightThreshold = upgradeHeight + 2000
+	feeAmount, _ := sdk.NewIntFromString("20000000000000000000")
+	params.SpotMarketInstantListingFee = sdk.NewCoin(chaintypes.InjectiveCoin, feeAmount) // 20 INJ
+	params.MaxOpenOrdersPerSubaccount = defaultParams.MaxOpenOrdersPerSubaccount
+	params.MaxExpiredOrdersPerBlock = defaultParams.MaxExpiredOrdersPerBlock
+	params.FundingRateHistorySize = defaultParams.FundingRateHistorySize
+	params.MarketCreationFee = defaultParams.MarketCreationFee
+	params.OrderPlacementSurcharge = defaultParams.OrderPlacementSurcharge
+	params.MakerRebateRate = defaultParams.MakerRebateRate
+	params.FeeSettlementOracleType = defaultParams.FeeSettlementOracleType
+	params.FeeSettlementMaxPriceAge = defaultParams.FeeSettlementMaxPriceAge
+	params.FeeSettlementSlippage = defaultParams.FeeSettlementSlippage
+	params.OrderHistoryRetentionBlocks = defaultParams.OrderHistoryRetentionBlocks
+	params.MaxConditionalOrdersPerSubaccount = defaultParams.MaxConditionalOrdersPerSubaccount
+	params.SubaccountFundingHistorySize = defaultParams.SubaccountFundingHistorySize
+	params.MaxMarketOrderSlippageRatio = defaultParams.MaxMarketOrderSlippageRatio
+	params.MakerRebateMaxVolumesPerBlock = defaultParams.MakerRebateMaxVolumesPerBlock
+
+	return params
+}
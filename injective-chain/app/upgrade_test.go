@@ -0,0 +1,59 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// preUpgradeExchangeParams are the default exchange params before the upgrade, without the params it adds.
+const preUpgradeExchangeParams = `{
+	"spot_market_instant_listing_fee": {"denom": "inj", "amount": "1000000000000000000000"},
+	"derivative_market_instant_listing_fee": {"denom": "inj", "amount": "1000000000000000000000"},
+	"default_spot_maker_fee_rate": "-0.000100000000000000",
+	"default_spot_taker_fee_rate": "0.001000000000000000",
+	"default_derivative_maker_fee_rate": "-0.000100000000000000",
+	"default_derivative_taker_fee_rate": "0.001000000000000000",
+	"default_initial_margin_ratio": "0.050000000000000000",
+	"default_maintenance_margin_ratio": "0.020000000000000000",
+	"default_funding_interval": "3600",
+	"funding_multiple": "3600",
+	"relayer_fee_share_rate": "0.400000000000000000",
+	"default_hourly_funding_rate_cap": "0.000625000000000000",
+	"default_hourly_interest_rate": "0.000004166660000000",
+	"max_derivative_order_side_count": 20,
+	"inj_reward_staked_requirement_threshold": "100000000000000000000",
+	"trading_rewards_vesting_duration": "604800",
+	"liquidator_reward_share_rate": "0.050000000000000000",
+	"binary_options_market_instant_listing_fee": {"denom": "inj", "amount": "100000000000000000000"},
+	"atomic_market_order_access_level": "SmartContractsOnly",
+	"spot_atomic_market_order_fee_multiplier": "2.500000000000000000",
+	"derivative_atomic_market_order_fee_multiplier": "2.500000000000000000",
+	"binary_options_atomic_market_order_fee_multiplier": "2.500000000000000000",
+	"minimal_protocol_fee_rate": "0.000050000000000000",
+	"is_instant_derivative_market_launch_enabled": false,
+	"post_only_mode_height_threshold": "0"
+}`
+
+func TestUpgradeExchangeParams(t *testing.T) {
+	cdc := MakeEncodingConfig().Marshaler
+
+	var params exchangetypes.Params
+	cdc.MustUnmarshalJSON([]byte(preUpgradeExchangeParams), &params)
+
+	// the stored params are re-encoded as the keeper stores them
+	var storedParams exchangetypes.Params
+	cdc.MustUnmarshal(cdc.MustMarshal(&params), &storedParams)
+	require.Error(t, storedParams.Validate())
+
+	upgradedParams := upgradeExchangeParams(storedParams, 100)
+	require.NoError(t, upgradedParams.Validate())
+	require.Equal(t, int64(2100), upgradedParams.PostOnlyModeHeightThreshold)
+
+	// the export of the upgraded params can be imported
+	var exportedParams exchangetypes.Params
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(&upgradedParams), &exportedParams)
+	require.NoError(t, exportedParams.Validate())
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ValidatorBondingCallback is invoked with the consensus and operator address of a validator whose
+// bonding status changed.
+type ValidatorBondingCallback func(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error
+
+// ValidatorHooksSubscriber holds the callbacks of a subscriber to validator bonding events. Either
+// callback may be nil.
+type ValidatorHooksSubscriber struct {
+	Name                         string
+	AfterValidatorBonded         ValidatorBondingCallback
+	AfterValidatorBeginUnbonding ValidatorBondingCallback
+}
+
+// ValidatorHooksRegistry fans out the staking AfterValidatorBonded and AfterValidatorBeginUnbonding
+// hooks to the registered subscribers. Subscribers are invoked in registration order, which is fixed
+// by the app wiring, so the fan-out is deterministic. The first callback error aborts the fan-out
+// and is returned to the staking module.
+type ValidatorHooksRegistry struct {
+	subscribers []ValidatorHooksSubscriber
+}
+
+func NewValidatorHooksRegistry() *ValidatorHooksRegistry {
+	return &ValidatorHooksRegistry{}
+}
+
+// Subscribe registers the subscriber. It panics if a subscriber with the same name is already registered.
+func (r *ValidatorHooksRegistry) Subscribe(subscriber ValidatorHooksSubscriber) {
+	for _, s := range r.subscribers {
+		if s.Name == subscriber.Name {
+			panic(fmt.Sprintf("validator hooks subscriber %s is already registered", subscriber.Name))
+		}
+	}
+
+	r.subscribers = append(r.subscribers, subscriber)
+}
+
+// Hooks returns the staking hooks invoking the subscribers of the registry.
+func (r *ValidatorHooksRegistry) Hooks() stakingtypes.StakingHooks {
+	return validatorRegistryHooks{registry: r}
+}
+
+var _ stakingtypes.StakingHooks = validatorRegistryHooks{}
+
+type validatorRegistryHooks struct {
+	registry *ValidatorHooksRegistry
+}
+
+func (h validatorRegistryHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	for _, subscriber := range h.registry.subscribers {
+		if subscriber.AfterValidatorBonded == nil {
+			continue
+		}
+
+		if err := subscriber.AfterValidatorBonded(ctx, consAddr, valAddr); err != nil {
+			return fmt.Errorf("%s AfterValidatorBonded: %w", subscriber.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h validatorRegistryHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	for _, subscriber := range h.registry.subscribers {
+		if subscriber.AfterValidatorBeginUnbonding == nil {
+			continue
+		}
+
+		if err := subscriber.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr); err != nil {
+			return fmt.Errorf("%s AfterValidatorBeginUnbonding: %w", subscriber.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h validatorRegistryHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h validatorRegistryHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorHooksRegistry(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2, ChainID: "injective-777", Time: time.Now().UTC()})
+
+	var observed []string
+	record := func(name string) ValidatorBondingCallback {
+		return func(_ sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+			observed = append(observed, name+":"+valAddr.String())
+			return nil
+		}
+	}
+
+	app.ValidatorHooks.Subscribe(ValidatorHooksSubscriber{Name: "first", AfterValidatorBonded: record("first")})
+	app.ValidatorHooks.Subscribe(ValidatorHooksSubscriber{Name: "second", AfterValidatorBonded: record("second")})
+	require.Panics(t, func() {
+		app.ValidatorHooks.Subscribe(ValidatorHooksSubscriber{Name: "first"})
+	})
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pubKey.Address())
+	consAddr := sdk.ConsAddress(pubKey.Address())
+
+	selfDelegation := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, sdk.AccAddress(valAddr), sdk.NewCoins(selfDelegation)))
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		pubKey,
+		selfDelegation,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	require.NoError(t, err)
+
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Empty(t, observed)
+
+	// the validator is bonded when the validator set is updated at the end of the block
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	require.Equal(t, []string{"first:" + valAddr.String(), "second:" + valAddr.String()}, observed)
+
+	// the standard hooks still fire alongside the registry
+	_, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+// ValidatorRewardSummary returns the current commission rates, the accumulated commission and the outstanding
+// rewards of the validator, in place of separate staking and distribution queries. A validator without delegations
+// has zero delegator shares and empty rewards.
+func (app *InjectiveApp) ValidatorRewardSummary(ctx sdk.Context, valAddr sdk.ValAddress) (*apptypes.ValidatorRewardBreakdown, error) {
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, errors.Wrapf(stakingtypes.ErrNoValidatorFound, "validator %s", valAddr.String())
+	}
+
+	commission := app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr)
+	outstanding := app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+
+	return &apptypes.ValidatorRewardBreakdown{
+		OperatorAddress:         validator.OperatorAddress,
+		CommissionRate:          validator.Commission.Rate,
+		MaxCommissionRate:       validator.Commission.MaxRate,
+		MaxCommissionChangeRate: validator.Commission.MaxChangeRate,
+		DelegatorShares:         validator.DelegatorShares,
+		AccumulatedCommission:   commission.Commission,
+		OutstandingRewards:      outstanding.Rewards,
+	}, nil
+}
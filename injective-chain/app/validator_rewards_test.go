@@ -0,0 +1,71 @@
+package app
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	apptypes "github.com/InjectiveLabs/injective-core/injective-chain/app/types"
+)
+
+func TestValidatorRewardSummary(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	apptypes.RegisterQueryServer(queryHelper, newQueryServer(app))
+	queryClient := apptypes.NewQueryClient(queryHelper)
+
+	rewardSummary := func(valAddr sdk.ValAddress) (apptypes.ValidatorRewardBreakdown, error) {
+		res, err := queryClient.ValidatorRewardSummary(ctx, &apptypes.QueryValidatorRewardSummaryRequest{ValidatorAddress: valAddr.String()})
+		if err != nil {
+			return apptypes.ValidatorRewardBreakdown{}, err
+		}
+		return res.Summary, nil
+	}
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.NotEmpty(t, validators)
+	valAddr := validators[0].GetOperator()
+
+	commission := sdk.NewDecCoins(sdk.NewDecCoinFromDec("inj", sdk.NewDecWithPrec(15, 1)))
+	rewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec("inj", sdk.NewDec(42)))
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, distrtypes.ValidatorAccumulatedCommission{Commission: commission})
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, distrtypes.ValidatorOutstandingRewards{Rewards: rewards})
+
+	summary, err := rewardSummary(valAddr)
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, validator.OperatorAddress, summary.OperatorAddress)
+	require.True(t, validator.Commission.Rate.Equal(summary.CommissionRate))
+	require.True(t, validator.Commission.MaxRate.Equal(summary.MaxCommissionRate))
+	require.True(t, validator.Commission.MaxChangeRate.Equal(summary.MaxCommissionChangeRate))
+	require.True(t, validator.DelegatorShares.Equal(summary.DelegatorShares))
+	require.Equal(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr).Commission, summary.AccumulatedCommission)
+	require.Equal(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards, summary.OutstandingRewards)
+	require.Equal(t, commission, summary.AccumulatedCommission)
+	require.Equal(t, rewards, summary.OutstandingRewards)
+
+	// a validator without any delegations
+	emptyValAddr := sdk.ValAddress("empty_validator_____")
+	emptyValidator, err := stakingtypes.NewValidator(emptyValAddr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	app.StakingKeeper.SetValidator(ctx, emptyValidator)
+
+	summary, err = rewardSummary(emptyValAddr)
+	require.NoError(t, err)
+	require.True(t, summary.DelegatorShares.IsZero())
+	require.True(t, summary.AccumulatedCommission.IsZero())
+	require.True(t, summary.OutstandingRewards.IsZero())
+
+	_, err = rewardSummary(sdk.ValAddress("unknown_validator___"))
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+}
@@ -16,14 +16,12 @@ func (am AppModule) EndBlocker(ctx sdk.Context, block abci.RequestEndBlock) {
 	defer doneFn()
 
 	// trigger auction settlement
-	endingTimeStamp := am.keeper.GetEndingTimeStamp(ctx)
-
-	if ctx.BlockTime().Unix() < endingTimeStamp {
+	if !am.keeper.IsAuctionRoundOver(ctx) {
 		return
 	}
 
 	logger := ctx.Logger().With("module", "auction", "EndBlocker", block.Height)
-	logger.Info("Settling auction round...", "blockTimestamp", ctx.BlockTime().Unix(), "endingTimeStamp", endingTimeStamp)
+	logger.Info("Settling auction round...", "blockTimestamp", ctx.BlockTime().Unix(), "endingTimeStamp", am.keeper.GetEndingTimeStamp(ctx), "endingHeight", am.keeper.GetEndingHeight(ctx))
 	auctionModuleAddress := am.accountKeeper.GetModuleAddress(auctiontypes.ModuleName)
 
 	// get and validate highest bid
@@ -76,6 +74,8 @@ func (am AppModule) EndBlocker(ctx sdk.Context, block abci.RequestEndBlock) {
 	// advance auctionRound, endingTimestamp
 	nextRound := am.keeper.AdvanceNextAuctionRound(ctx)
 	nextEndingTimestamp := am.keeper.AdvanceNextEndingTimeStamp(ctx)
+	// the duration and reserve price params apply from the round starting now
+	nextEndingHeight, reservePrice := am.keeper.InitRoundTerms(ctx)
 	// ping exchange module to flush fee for next round
 	balances := am.exchangeKeeper.WithdrawAllAuctionBalances(ctx)
 
@@ -86,6 +86,8 @@ func (am AppModule) EndBlocker(ctx sdk.Context, block abci.RequestEndBlock) {
 		Round:           nextRound,
 		EndingTimestamp: nextEndingTimestamp,
 		NewBasket:       newBasket,
+		EndingHeight:    nextEndingHeight,
+		ReservePrice:    reservePrice,
 	})
 
 	if len(balances) == 0 {
@@ -19,19 +19,30 @@ func InitGenesis(ctx sdk.Context, keeper auctionkeeper.Keeper, data types.Genesi
 	// load auction round
 	keeper.SetAuctionRound(ctx, data.AuctionRound)
 
-	// set ending time stamp for this round
+	// set ending time stamp, ending height and reserve price for this round
 	if data.AuctionEndingTimestamp == 0 {
 		keeper.InitEndingTimeStamp(ctx)
+		keeper.InitRoundTerms(ctx)
+		return
+	}
+
+	keeper.SetEndingTimeStamp(ctx, data.AuctionEndingTimestamp)
+	keeper.SetEndingHeight(ctx, data.AuctionEndingHeight)
+	if data.AuctionReservePrice != nil {
+		keeper.SetReservePrice(ctx, *data.AuctionReservePrice)
 	} else {
-		keeper.SetEndingTimeStamp(ctx, data.AuctionEndingTimestamp)
+		keeper.SetReservePrice(ctx, data.Params.MinReservePrice)
 	}
 }
 
 func ExportGenesis(ctx sdk.Context, k auctionkeeper.Keeper) *types.GenesisState {
+	reservePrice := k.GetReservePrice(ctx)
 	return &types.GenesisState{
 		Params:                 k.GetParams(ctx),
 		AuctionRound:           k.GetAuctionRound(ctx),
 		HighestBid:             k.GetHighestBid(ctx),
 		AuctionEndingTimestamp: k.GetEndingTimeStamp(ctx),
+		AuctionEndingHeight:    k.GetEndingHeight(ctx),
+		AuctionReservePrice:    &reservePrice,
 	}
 }
@@ -80,3 +80,85 @@ func (k *Keeper) AdvanceNextEndingTimeStamp(ctx sdk.Context) (nextTimestamp int6
 	store.Set(types.KeyEndingTimeStamp, sdk.Uint64ToBigEndian(uint64(nextTimestamp)))
 	return nextTimestamp
 }
+
+// GetEndingHeight gets the ending block height of the current auction round, zero when the round ends at the ending
+// timestamp.
+func (k *Keeper) GetEndingHeight(ctx sdk.Context) int64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyEndingHeight)
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+func (k *Keeper) SetEndingHeight(ctx sdk.Context, height int64) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyEndingHeight, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetReservePrice gets the minimum INJ bid of the current auction round
+func (k *Keeper) GetReservePrice(ctx sdk.Context) sdk.Int {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyReservePrice)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var reservePrice sdk.Int
+	if err := reservePrice.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return reservePrice
+}
+
+func (k *Keeper) SetReservePrice(ctx sdk.Context, reservePrice sdk.Int) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz, err := reservePrice.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyReservePrice, bz)
+}
+
+// InitRoundTerms fixes the ending height and reserve price of an auction round starting at the current block from the
+// current params, so param changes only apply to the rounds starting after them.
+func (k *Keeper) InitRoundTerms(ctx sdk.Context) (endingHeight int64, reservePrice sdk.Int) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+
+	if params.AuctionDurationBlocks > 0 {
+		endingHeight = ctx.BlockHeight() + int64(params.AuctionDurationBlocks)
+	}
+
+	reservePrice = sdk.ZeroInt()
+	if !params.MinReservePrice.IsNil() {
+		reservePrice = params.MinReservePrice
+	}
+
+	k.SetEndingHeight(ctx, endingHeight)
+	k.SetReservePrice(ctx, reservePrice)
+	return endingHeight, reservePrice
+}
+
+// IsAuctionRoundOver returns whether the current auction round has reached its ending height, or its ending timestamp
+// for rounds that are not limited by a number of blocks.
+func (k *Keeper) IsAuctionRoundOver(ctx sdk.Context) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if endingHeight := k.GetEndingHeight(ctx); endingHeight > 0 {
+		return ctx.BlockHeight() >= endingHeight
+	}
+
+	return ctx.BlockTime().Unix() >= k.GetEndingTimeStamp(ctx)
+}
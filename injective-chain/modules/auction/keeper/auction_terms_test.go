@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+var _ = Describe("Auction duration and reserve price", func() {
+	var (
+		app         *simapp.InjectiveApp
+		ctx         sdk.Context
+		msgServer   types.MsgServer
+		bidder      sdk.AccAddress
+		basket      = sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(79)))
+		reserve     = sdk.NewInt(1000)
+		startHeight = int64(10)
+	)
+
+	bid := func(amount int64) error {
+		_, err := msgServer.Bid(sdk.WrapSDKContext(ctx), &types.MsgBid{
+			Sender:    bidder.String(),
+			BidAmount: chaintypes.NewInjectiveCoin(sdk.NewInt(amount)),
+			Round:     app.AuctionKeeper.GetAuctionRound(ctx),
+		})
+		return err
+	}
+
+	auctionResults := func() []*types.EventAuctionResult {
+		results := make([]*types.EventAuctionResult, 0)
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			parsed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			if result, ok := parsed.(*types.EventAuctionResult); ok {
+				results = append(results, result)
+			}
+		}
+		return results
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: startHeight, Time: time.Now()})
+		msgServer = keeper.NewMsgServerImpl(app.AuctionKeeper)
+
+		bidder = sdk.AccAddress("auction_bidder______")
+		injCoins := sdk.NewCoins(chaintypes.NewInjectiveCoin(sdk.NewInt(100000)))
+		Expect(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, injCoins.Add(basket...))).To(Succeed())
+		Expect(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, bidder, injCoins)).To(Succeed())
+		Expect(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, basket)).To(Succeed())
+
+		params := types.DefaultParams()
+		params.AuctionDurationBlocks = 3
+		params.MinReservePrice = reserve
+		app.AuctionKeeper.SetParams(ctx, params)
+
+		// start a round with the new terms, ending at a timestamp far in the future
+		app.AuctionKeeper.InitEndingTimeStamp(ctx)
+		app.AuctionKeeper.InitRoundTerms(ctx)
+	})
+
+	It("fixes the terms of the round when it starts", func() {
+		Expect(app.AuctionKeeper.GetEndingHeight(ctx)).To(Equal(startHeight + 3))
+		Expect(app.AuctionKeeper.GetReservePrice(ctx).String()).To(Equal(reserve.String()))
+	})
+
+	It("sells the basket to a bid meeting the reserve price", func() {
+		Expect(bid(reserve.Int64())).To(Succeed())
+
+		ctx = EndBlockerAndCommit(app, ctx, 4)
+
+		Expect(app.BankKeeper.GetBalance(ctx, bidder, "bnb").Amount.Int64()).To(Equal(int64(79)))
+
+		results := auctionResults()
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Winner).To(Equal(bidder.String()))
+		Expect(results[0].Amount.Amount.String()).To(Equal(reserve.String()))
+		Expect(results[0].Round).To(Equal(uint64(0)))
+	})
+
+	It("rejects bids below the reserve price and keeps the basket", func() {
+		Expect(bid(reserve.Int64() - 1)).To(MatchError(types.ErrBidReserve))
+
+		ctx = EndBlockerAndCommit(app, ctx, 4)
+
+		Expect(auctionResults()).To(BeEmpty())
+		Expect(app.BankKeeper.GetBalance(ctx, bidder, "bnb").IsZero()).To(BeTrue())
+		Expect(app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName), "bnb").Amount.Int64()).To(Equal(int64(79)))
+		Expect(app.AuctionKeeper.GetAuctionRound(ctx)).To(Equal(uint64(1)))
+	})
+
+	It("settles the round after the configured number of blocks", func() {
+		// changing the params does not affect the round in flight
+		params := app.AuctionKeeper.GetParams(ctx)
+		params.AuctionDurationBlocks = 10
+		params.MinReservePrice = sdk.ZeroInt()
+		app.AuctionKeeper.SetParams(ctx, params)
+
+		ctx = EndBlockerAndCommit(app, ctx, 3)
+		Expect(ctx.BlockHeight()).To(Equal(startHeight + 3))
+		Expect(app.AuctionKeeper.GetAuctionRound(ctx)).To(Equal(uint64(0)))
+
+		ctx = EndBlockerAndCommit(app, ctx, 1)
+		Expect(app.AuctionKeeper.GetAuctionRound(ctx)).To(Equal(uint64(1)))
+
+		// the next round uses the new params
+		Expect(app.AuctionKeeper.GetEndingHeight(ctx)).To(Equal(startHeight + 3 + 10))
+		Expect(app.AuctionKeeper.GetReservePrice(ctx).IsZero()).To(BeTrue())
+	})
+})
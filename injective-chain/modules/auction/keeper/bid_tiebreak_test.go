@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+var _ = Describe("Auction bid tiebreak", func() {
+	var (
+		firstBidder  = sdk.AccAddress("auction_first_bidder")
+		secondBidder = sdk.AccAddress("auction_second_bid__")
+		bidAmount    = chaintypes.NewInjectiveCoin(sdk.NewInt(1000))
+	)
+
+	// settleEqualBids places two equal bids in the current round and settles it, returning the winner
+	settleEqualBids := func() (winner string, secondBidErr error) {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+		msgServer := keeper.NewMsgServerImpl(app.AuctionKeeper)
+
+		injCoins := sdk.NewCoins(bidAmount)
+		for _, bidder := range []sdk.AccAddress{firstBidder, secondBidder} {
+			Expect(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, injCoins)).To(Succeed())
+			Expect(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, bidder, injCoins)).To(Succeed())
+		}
+
+		round := app.AuctionKeeper.GetAuctionRound(ctx)
+		_, err := msgServer.Bid(sdk.WrapSDKContext(ctx), &types.MsgBid{Sender: firstBidder.String(), BidAmount: bidAmount, Round: round})
+		Expect(err).To(BeNil())
+		_, secondBidErr = msgServer.Bid(sdk.WrapSDKContext(ctx), &types.MsgBid{Sender: secondBidder.String(), BidAmount: bidAmount, Round: round})
+
+		// settle the round right away
+		app.AuctionKeeper.SetEndingTimeStamp(ctx, ctx.BlockTime().Unix())
+		ctx = EndBlockerAndCommit(app, ctx, 1)
+
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			parsed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			if result, ok := parsed.(*types.EventAuctionResult); ok {
+				winner = result.Winner
+			}
+		}
+
+		// the losing bid never leaves the bidder's account
+		Expect(app.BankKeeper.GetBalance(ctx, secondBidder, chaintypes.InjectiveCoin).Amount.String()).To(Equal(bidAmount.Amount.String()))
+		return winner, secondBidErr
+	}
+
+	It("awards the round to the earliest of two equal bids on every run", func() {
+		for run := 0; run < 3; run++ {
+			winner, secondBidErr := settleEqualBids()
+			Expect(secondBidErr).To(MatchError(sdkerrors.ErrInvalidRequest))
+			Expect(winner).To(Equal(firstBidder.String()))
+		}
+	})
+})
@@ -45,11 +45,13 @@ func (k *Keeper) CurrentAuctionBasket(c context.Context, _ *types.QueryCurrentAu
 
 	closingTime := k.GetEndingTimeStamp(ctx)
 	res := &types.QueryCurrentAuctionBasketResponse{
-		AuctionRound:       k.GetAuctionRound(ctx),
-		AuctionClosingTime: closingTime,
-		HighestBidAmount:   lastBid.Amount.Amount,
-		HighestBidder:      lastBid.Bidder,
-		Amount:             sdk.NewCoins(coinsWithoutINJ...),
+		AuctionRound:         k.GetAuctionRound(ctx),
+		AuctionClosingTime:   closingTime,
+		HighestBidAmount:     lastBid.Amount.Amount,
+		HighestBidder:        lastBid.Bidder,
+		Amount:               sdk.NewCoins(coinsWithoutINJ...),
+		AuctionClosingHeight: k.GetEndingHeight(ctx),
+		ReservePrice:         k.GetReservePrice(ctx),
 	}
 	return res, nil
 }
@@ -58,6 +60,7 @@ func (k *Keeper) AuctionModuleState(c context.Context, _ *types.QueryModuleState
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	ctx := sdk.UnwrapSDKContext(c)
+	reservePrice := k.GetReservePrice(ctx)
 
 	res := &types.QueryModuleStateResponse{
 		State: &types.GenesisState{
@@ -65,6 +68,8 @@ func (k *Keeper) AuctionModuleState(c context.Context, _ *types.QueryModuleState
 			AuctionRound:           k.GetAuctionRound(ctx),
 			HighestBid:             k.GetHighestBid(ctx),
 			AuctionEndingTimestamp: k.GetEndingTimeStamp(ctx),
+			AuctionEndingHeight:    k.GetEndingHeight(ctx),
+			AuctionReservePrice:    &reservePrice,
 		},
 	}
 	return res, nil
@@ -59,9 +59,15 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 		metrics.ReportFuncError(k.svcTags)
 		return nil, errors.Wrapf(types.ErrBidRound, "current round is %d but got bid for %d", round, msg.Round)
 	}
-	// check valid bid
+	if reservePrice := k.GetReservePrice(ctx); msg.BidAmount.Amount.LT(reservePrice) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrBidReserve, "bid %s is below the reserve price %s", msg.BidAmount.Amount, reservePrice)
+	}
+
+	// check valid bid. A bid must strictly exceed the current highest bid, so among equal bids the one included
+	// earliest in the chain (by block height, then by position in the block) always wins the round.
 	lastBid := k.GetHighestBid(ctx)
-	if msg.BidAmount.Amount.LT(lastBid.Amount.Amount) {
+	if lastBid.Amount.Amount.IsPositive() && msg.BidAmount.Amount.LTE(lastBid.Amount.Amount) {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "Bid must exceed current highest bid")
 	}
@@ -88,7 +94,8 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 		return nil, errors.Wrap(err, "deposit failed")
 	}
 
-	// check first bidder
+	// check first bidder. The outbid bidder is refunded within this transaction, so refunds follow the
+	// order in which the bids were included.
 	isFirstBidder := !lastBid.Amount.Amount.IsPositive()
 	if !isFirstBidder {
 		err := k.refundLastBidder(ctx)
@@ -37,6 +37,7 @@ var _ = Describe("MsgServer Tests", func() {
 		TestingAuctionParams = types.Params{
 			AuctionPeriod:           5,
 			MinNextBidIncrementRate: sdk.NewDecWithPrec(25, 4),
+			MinReservePrice:         sdk.ZeroInt(),
 		}
 	)
 
@@ -235,10 +236,12 @@ var _ = Describe("MsgServer Tests", func() {
 			It("Assert state at height = 7", func() {
 
 				genesis := auction.ExportGenesis(ctx, app.AuctionKeeper)
+				reservePrice := sdk.ZeroInt()
 				Expect(*genesis).To(BeEquivalentTo(types.GenesisState{
 					Params: types.Params{
 						AuctionPeriod:           5,
 						MinNextBidIncrementRate: sdk.NewDecWithPrec(25, 4),
+						MinReservePrice:         sdk.ZeroInt(),
 					},
 					AuctionRound: 1,
 					HighestBid: &types.Bid{
@@ -246,6 +249,7 @@ var _ = Describe("MsgServer Tests", func() {
 						Amount: chaintypes.NewInjectiveCoin(sdk.NewInt(1379)),
 					},
 					AuctionEndingTimestamp: app.AuctionKeeper.GetEndingTimeStamp(ctx),
+					AuctionReservePrice:    &reservePrice,
 				}))
 
 				// fast forward 5 blocks
@@ -353,10 +357,12 @@ var _ = Describe("MsgServer Tests", func() {
 				Context("Assert module state", func() {
 					It("Should pass", func() {
 						genesis := auction.ExportGenesis(ctx, app.AuctionKeeper)
+						reservePrice := sdk.ZeroInt()
 						Expect(*genesis).To(BeEquivalentTo(types.GenesisState{
 							Params: types.Params{
 								AuctionPeriod:           5,
 								MinNextBidIncrementRate: sdk.NewDecWithPrec(25, 4),
+								MinReservePrice:         sdk.ZeroInt(),
 							},
 							AuctionRound: 3,
 							HighestBid: &types.Bid{
@@ -364,6 +370,7 @@ var _ = Describe("MsgServer Tests", func() {
 								Amount: chaintypes.NewInjectiveCoin(sdk.NewInt(0)),
 							},
 							AuctionEndingTimestamp: app.AuctionKeeper.GetEndingTimeStamp(ctx),
+							AuctionReservePrice:    &reservePrice,
 						}))
 					})
 				})
@@ -30,6 +30,12 @@ type Params struct {
 	AuctionPeriod int64 `protobuf:"varint,1,opt,name=auction_period,json=auctionPeriod,proto3" json:"auction_period,omitempty"`
 	// min_next_bid_increment_rate defines the minimum increment rate for new bids
 	MinNextBidIncrementRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_next_bid_increment_rate,json=minNextBidIncrementRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_next_bid_increment_rate"`
+	// auction_duration_blocks defines the number of blocks an auction round
+	// lasts, zero means rounds last auction_period seconds
+	AuctionDurationBlocks uint64 `protobuf:"varint,3,opt,name=auction_duration_blocks,json=auctionDurationBlocks,proto3" json:"auction_duration_blocks,omitempty"`
+	// min_reserve_price defines the minimum INJ amount a bid must have to win
+	// the basket of an auction round
+	MinReservePrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_reserve_price,json=minReservePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_reserve_price"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,6 +78,13 @@ func (m *Params) GetAuctionPeriod() int64 {
 	return 0
 }
 
+func (m *Params) GetAuctionDurationBlocks() uint64 {
+	if m != nil {
+		return m.AuctionDurationBlocks
+	}
+	return 0
+}
+
 type Bid struct {
 	Bidder string                                  `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder" yaml:"bidder"`
 	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
@@ -237,6 +250,11 @@ type EventAuctionStart struct {
 	// new_basket describes auction module balance at the time of new auction
 	// start
 	NewBasket github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=new_basket,json=newBasket,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"new_basket"`
+	// ending_height describes auction end height, zero when the auction ends at
+	// ending_timestamp
+	EndingHeight int64 `protobuf:"varint,4,opt,name=ending_height,json=endingHeight,proto3" json:"ending_height,omitempty"`
+	// reserve_price describes the minimum INJ bid of the auction
+	ReservePrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=reserve_price,json=reservePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_price"`
 }
 
 func (m *EventAuctionStart) Reset()         { *m = EventAuctionStart{} }
@@ -293,6 +311,13 @@ func (m *EventAuctionStart) GetNewBasket() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
+func (m *EventAuctionStart) GetEndingHeight() int64 {
+	if m != nil {
+		return m.EndingHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.auction.v1beta1.Params")
 	proto.RegisterType((*Bid)(nil), "injective.auction.v1beta1.Bid")
@@ -306,40 +331,45 @@ func init() {
 }
 
 var fileDescriptor_49edfee5f1ef4b5a = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xb8, 0x44, 0xe4, 0x50, 0xf9, 0x73, 0xaa, 0x20, 0x6d, 0x25, 0x3b, 0xb2, 0x04,
-	0x0d, 0x43, 0x6d, 0x4a, 0xb7, 0x6e, 0x18, 0x10, 0xaa, 0x04, 0xa8, 0x32, 0x4c, 0x2c, 0xd6, 0xd9,
-	0xf7, 0x2a, 0xbd, 0x36, 0x77, 0x17, 0xf9, 0xce, 0x49, 0x3b, 0x22, 0x46, 0x16, 0x3e, 0x02, 0x12,
-	0x1b, 0x9f, 0x82, 0xb1, 0x63, 0x47, 0xc4, 0x10, 0x50, 0xb2, 0x20, 0x46, 0x3e, 0x01, 0x8a, 0x7d,
-	0x0e, 0x66, 0xeb, 0x00, 0x53, 0xf2, 0x3c, 0xf7, 0xdc, 0x73, 0xbf, 0x93, 0xef, 0xc5, 0x5b, 0x5c,
-	0x1e, 0x41, 0x66, 0xf8, 0x18, 0x42, 0x5a, 0x64, 0x86, 0x2b, 0x19, 0x8e, 0x77, 0x52, 0x30, 0x74,
-	0xa7, 0xd6, 0xc1, 0x28, 0x57, 0x46, 0x91, 0xf5, 0x65, 0x30, 0xa8, 0x17, 0x6c, 0x70, 0x63, 0x6d,
-	0xa0, 0x06, 0xaa, 0x4c, 0x85, 0x8b, 0x7f, 0xd5, 0x86, 0x0d, 0x37, 0x53, 0x5a, 0x28, 0x1d, 0xa6,
-	0x54, 0xc3, 0xb2, 0x33, 0x53, 0xdc, 0x16, 0xfa, 0x1f, 0x11, 0x6e, 0x1f, 0xd0, 0x9c, 0x0a, 0x4d,
-	0xee, 0xe0, 0x6b, 0xb6, 0x33, 0x19, 0x41, 0xce, 0x15, 0xeb, 0xa2, 0x1e, 0xea, 0x3b, 0xf1, 0xaa,
-	0x75, 0x0f, 0x4a, 0x93, 0x0c, 0xf1, 0xa6, 0xe0, 0x32, 0x91, 0x70, 0x62, 0x92, 0x94, 0xb3, 0x84,
-	0xcb, 0x2c, 0x07, 0x01, 0xd2, 0x24, 0x39, 0x35, 0xd0, 0xbd, 0xd4, 0x43, 0xfd, 0x4e, 0x14, 0x9c,
-	0x4d, 0xbd, 0xd6, 0xd7, 0xa9, 0x77, 0x77, 0xc0, 0xcd, 0x61, 0x91, 0x06, 0x99, 0x12, 0xa1, 0x25,
-	0xa9, 0x7e, 0xb6, 0x35, 0x3b, 0x0e, 0xcd, 0xe9, 0x08, 0x74, 0xf0, 0x18, 0xb2, 0xf8, 0xb6, 0xe0,
-	0xf2, 0x05, 0x9c, 0x98, 0x88, 0xb3, 0xfd, 0xba, 0x2f, 0xa6, 0x06, 0xf6, 0x56, 0x7e, 0x7c, 0xf0,
-	0x90, 0xff, 0x16, 0x61, 0x27, 0xe2, 0x8c, 0xec, 0xe2, 0x76, 0xca, 0x19, 0x83, 0xbc, 0x44, 0xeb,
-	0x44, 0x9b, 0x3f, 0xa7, 0x9e, 0x75, 0x7e, 0x4d, 0xbd, 0xd5, 0x53, 0x2a, 0x86, 0x7b, 0x7e, 0xa5,
-	0xfd, 0xd8, 0x2e, 0x90, 0xa7, 0xb8, 0x4d, 0x85, 0x2a, 0xa4, 0xb1, 0x6c, 0xa1, 0x65, 0xdb, 0xba,
-	0x00, 0xdb, 0x23, 0xc5, 0x65, 0x6c, 0xb7, 0xfb, 0x6f, 0x10, 0xbe, 0xf2, 0x64, 0x0c, 0x72, 0x41,
-	0x49, 0x6e, 0xfd, 0x8d, 0xf2, 0xcf, 0x4f, 0x23, 0x6b, 0xf8, 0x72, 0xae, 0x0a, 0xc9, 0xba, 0x4e,
-	0x0f, 0xf5, 0x57, 0xe2, 0x4a, 0xf8, 0xef, 0x10, 0x26, 0x25, 0xc3, 0xc3, 0xea, 0xa3, 0xc4, 0xa0,
-	0x8b, 0xa1, 0x59, 0xd0, 0x4c, 0xb8, 0x94, 0x7f, 0x68, 0x2a, 0xf5, 0xbf, 0x69, 0x3e, 0x23, 0x7c,
-	0xb3, 0x49, 0xf3, 0xd2, 0xd0, 0xbc, 0x91, 0x45, 0x8d, 0x2c, 0xb9, 0x87, 0x6f, 0x80, 0x64, 0x5c,
-	0x0e, 0x12, 0xc3, 0x05, 0x68, 0x43, 0xc5, 0xa8, 0x84, 0x72, 0xe2, 0xeb, 0x95, 0xff, 0xaa, 0xb6,
-	0xc9, 0x11, 0xc6, 0x12, 0x26, 0x49, 0x4a, 0xf5, 0x31, 0x98, 0xae, 0xd3, 0x73, 0xfa, 0x57, 0x1f,
-	0xac, 0x07, 0x15, 0x60, 0xb0, 0x78, 0xc9, 0xf5, 0xa3, 0x2f, 0x19, 0xa3, 0xfb, 0x8b, 0x4b, 0x7d,
-	0xfa, 0xe6, 0xf5, 0x2f, 0x78, 0x29, 0x1d, 0x77, 0x24, 0x4c, 0xa2, 0xb2, 0x3d, 0x1a, 0x9c, 0xcd,
-	0x5c, 0x74, 0x3e, 0x73, 0xd1, 0xf7, 0x99, 0x8b, 0xde, 0xcf, 0xdd, 0xd6, 0xf9, 0xdc, 0x6d, 0x7d,
-	0x99, 0xbb, 0xad, 0xd7, 0xcf, 0x1b, 0x75, 0xfb, 0xf5, 0xd8, 0x3d, 0xa3, 0xa9, 0x0e, 0x97, 0x43,
-	0xb8, 0x9d, 0xa9, 0x1c, 0x9a, 0xf2, 0x90, 0x72, 0x19, 0x0a, 0xc5, 0x8a, 0x21, 0xe8, 0xe5, 0x28,
-	0x97, 0x27, 0xa7, 0xed, 0x72, 0xe0, 0x76, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x6d, 0xcb,
-	0x8b, 0xec, 0x03, 0x00, 0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0xb7, 0x14, 0x36, 0x3f, 0xe6, 0xc7, 0x8a, 0x4c, 0x50, 0x16, 0x48, 0xba, 0x9b, 0x35,
+	0xca, 0x7a, 0xa0, 0x15, 0x49, 0x3c, 0x70, 0xb3, 0x62, 0x94, 0x44, 0x0d, 0x29, 0x9e, 0xb8, 0x34,
+	0xd3, 0xf6, 0xc9, 0xee, 0xc0, 0x76, 0x66, 0x33, 0x33, 0x5d, 0xe0, 0x68, 0x3c, 0x7a, 0xf1, 0x25,
+	0x78, 0xf6, 0x0d, 0x98, 0xf8, 0x0a, 0x38, 0x72, 0x34, 0x1e, 0x56, 0x03, 0x17, 0xe3, 0xd1, 0x57,
+	0x60, 0x3a, 0x9d, 0xae, 0xe5, 0x86, 0x46, 0x4f, 0x9d, 0xe7, 0xcf, 0x7c, 0xe7, 0x33, 0x4f, 0x9f,
+	0x79, 0xd0, 0x1a, 0x65, 0x07, 0x10, 0x2b, 0x3a, 0x02, 0x8f, 0x64, 0xb1, 0xa2, 0x9c, 0x79, 0xa3,
+	0x8d, 0x08, 0x14, 0xd9, 0x28, 0x6d, 0x77, 0x28, 0xb8, 0xe2, 0x78, 0x79, 0x92, 0xe8, 0x96, 0x01,
+	0x93, 0xb8, 0xb2, 0xd8, 0xe3, 0x3d, 0xae, 0xb3, 0xbc, 0x7c, 0x55, 0x6c, 0x58, 0x71, 0x62, 0x2e,
+	0x53, 0x2e, 0xbd, 0x88, 0x48, 0x98, 0x68, 0xc6, 0x9c, 0x1a, 0xc1, 0xce, 0x87, 0x29, 0x54, 0xdf,
+	0x25, 0x82, 0xa4, 0x12, 0xdf, 0x46, 0xd7, 0x8c, 0x66, 0x38, 0x04, 0x41, 0x79, 0xd2, 0xb4, 0xda,
+	0x56, 0xd7, 0x0e, 0x1a, 0xc6, 0xbb, 0xab, 0x9d, 0x78, 0x80, 0x56, 0x53, 0xca, 0x42, 0x06, 0xc7,
+	0x2a, 0x8c, 0x68, 0x12, 0x52, 0x16, 0x0b, 0x48, 0x81, 0xa9, 0x50, 0x10, 0x05, 0xcd, 0xa9, 0xb6,
+	0xd5, 0x9d, 0xf5, 0xdd, 0xd3, 0x71, 0xab, 0xf6, 0x79, 0xdc, 0xba, 0xd3, 0xa3, 0xaa, 0x9f, 0x45,
+	0x6e, 0xcc, 0x53, 0xcf, 0x90, 0x14, 0x9f, 0x75, 0x99, 0x1c, 0x7a, 0xea, 0x64, 0x08, 0xd2, 0xdd,
+	0x86, 0x38, 0x58, 0x4a, 0x29, 0x7b, 0x01, 0xc7, 0xca, 0xa7, 0xc9, 0x4e, 0xa9, 0x17, 0x10, 0x05,
+	0xf8, 0x01, 0x5a, 0x2a, 0xa1, 0x92, 0x4c, 0x10, 0xbd, 0x88, 0x06, 0x3c, 0x3e, 0x94, 0x4d, 0xbb,
+	0x6d, 0x75, 0xa7, 0x83, 0x1b, 0x26, 0xbc, 0x6d, 0xa2, 0xbe, 0x0e, 0xe2, 0x7d, 0xb4, 0x90, 0x53,
+	0x0a, 0x90, 0x20, 0x46, 0x10, 0x0e, 0x05, 0x8d, 0xa1, 0x39, 0xfd, 0xdb, 0x6c, 0x3b, 0x4c, 0x05,
+	0xf3, 0x29, 0x65, 0x41, 0xa1, 0xb3, 0x9b, 0xcb, 0x6c, 0x4d, 0x7f, 0x7b, 0xd7, 0xb2, 0x3a, 0xaf,
+	0x2d, 0x64, 0xfb, 0x34, 0xc1, 0x9b, 0xa8, 0x1e, 0xd1, 0x24, 0x01, 0xa1, 0xcb, 0x35, 0xeb, 0xaf,
+	0x7e, 0x1f, 0xb7, 0x8c, 0xe7, 0xc7, 0xb8, 0xd5, 0x38, 0x21, 0xe9, 0x60, 0xab, 0x53, 0xd8, 0x9d,
+	0xc0, 0x04, 0xf0, 0x13, 0x54, 0x27, 0x29, 0xcf, 0x98, 0x32, 0xf5, 0xf2, 0x0c, 0xd3, 0xda, 0x15,
+	0x98, 0x1e, 0x71, 0xca, 0x02, 0xb3, 0xbd, 0xf3, 0xca, 0x42, 0xff, 0x3d, 0x1e, 0x01, 0xcb, 0x2b,
+	0x87, 0x6f, 0x5e, 0x46, 0xf9, 0xeb, 0xa7, 0xe1, 0x45, 0x34, 0x23, 0x78, 0xc6, 0x12, 0x53, 0xfb,
+	0xc2, 0xe8, 0xbc, 0xb1, 0x10, 0xd6, 0x0c, 0x0f, 0x8b, 0x5f, 0x11, 0x80, 0xcc, 0x06, 0x2a, 0xa7,
+	0x39, 0xa2, 0x8c, 0xfd, 0xa2, 0x29, 0xac, 0x7f, 0x4d, 0xf3, 0x71, 0x0a, 0x2d, 0x54, 0x69, 0xf6,
+	0x14, 0x11, 0x95, 0x5c, 0xab, 0x92, 0x8b, 0xef, 0xa2, 0xeb, 0xc0, 0x12, 0xca, 0x7a, 0xa1, 0xa2,
+	0x29, 0x48, 0x45, 0xd2, 0xa1, 0x86, 0xb2, 0x83, 0xf9, 0xc2, 0xff, 0xb2, 0x74, 0xe3, 0x03, 0x84,
+	0x18, 0x1c, 0x85, 0x11, 0x91, 0x87, 0xa0, 0x9a, 0x76, 0xdb, 0xee, 0xfe, 0x7f, 0x7f, 0xd9, 0x2d,
+	0x00, 0xdd, 0xfc, 0x75, 0x95, 0x0f, 0x51, 0x33, 0xfa, 0xf7, 0xf2, 0x4b, 0xbd, 0xff, 0xd2, 0xea,
+	0x5e, 0xf1, 0x52, 0x32, 0x98, 0x65, 0x70, 0xe4, 0x6b, 0x75, 0x7c, 0x0b, 0x35, 0x0c, 0x56, 0x1f,
+	0x68, 0xaf, 0xaf, 0x74, 0xe3, 0xda, 0xc1, 0x5c, 0xe1, 0x7c, 0xaa, 0x7d, 0x78, 0x0f, 0x35, 0x2e,
+	0x77, 0xf7, 0xcc, 0x1f, 0x75, 0xf7, 0x9c, 0xa8, 0xb4, 0xb6, 0xdf, 0x3b, 0x3d, 0x77, 0xac, 0xb3,
+	0x73, 0xc7, 0xfa, 0x7a, 0xee, 0x58, 0x6f, 0x2f, 0x9c, 0xda, 0xd9, 0x85, 0x53, 0xfb, 0x74, 0xe1,
+	0xd4, 0xf6, 0x9f, 0x57, 0xf4, 0x76, 0xca, 0x21, 0xf4, 0x8c, 0x44, 0xd2, 0x9b, 0x8c, 0xa4, 0xf5,
+	0x98, 0x0b, 0xa8, 0x9a, 0x7d, 0x42, 0x99, 0x97, 0xf2, 0x24, 0x1b, 0x80, 0x9c, 0x0c, 0x36, 0x7d,
+	0x74, 0x54, 0xd7, 0xe3, 0x67, 0xf3, 0xe7, 0x00, 0xf2, 0xb7, 0xb3, 0x0a, 0xfa, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -367,6 +397,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinNextBidIncrementRate.Equal(that1.MinNextBidIncrementRate) {
 		return false
 	}
+	if this.AuctionDurationBlocks != that1.AuctionDurationBlocks {
+		return false
+	}
+	if !this.MinReservePrice.Equal(that1.MinReservePrice) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -389,6 +425,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinReservePrice.Size()
+		i -= size
+		if _, err := m.MinReservePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AuctionDurationBlocks != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionDurationBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.MinNextBidIncrementRate.Size()
 		i -= size
@@ -557,6 +608,21 @@ func (m *EventAuctionStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReservePrice.Size()
+		i -= size
+		if _, err := m.ReservePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.EndingHeight != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.EndingHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.NewBasket) > 0 {
 		for iNdEx := len(m.NewBasket) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -606,6 +672,11 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinNextBidIncrementRate.Size()
 	n += 1 + l + sovAuction(uint64(l))
+	if m.AuctionDurationBlocks != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionDurationBlocks))
+	}
+	l = m.MinReservePrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
@@ -678,6 +749,11 @@ func (m *EventAuctionStart) Size() (n int) {
 			n += 1 + l + sovAuction(uint64(l))
 		}
 	}
+	if m.EndingHeight != 0 {
+		n += 1 + sovAuction(uint64(m.EndingHeight))
+	}
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
@@ -769,6 +845,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDurationBlocks", wireType)
+			}
+			m.AuctionDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionDurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReservePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1277,6 +1406,59 @@ func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -5,4 +5,5 @@ import "cosmossdk.io/errors"
 var (
 	ErrBidInvalid = errors.Register(ModuleName, 1, "invalid bid denom")
 	ErrBidRound   = errors.Register(ModuleName, 2, "invalid bid round")
+	ErrBidReserve = errors.Register(ModuleName, 3, "bid is below the reserve price")
 )
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,6 +34,10 @@ type GenesisState struct {
 	HighestBid *Bid `protobuf:"bytes,3,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
 	// auction ending timestamp
 	AuctionEndingTimestamp int64 `protobuf:"varint,4,opt,name=auction_ending_timestamp,json=auctionEndingTimestamp,proto3" json:"auction_ending_timestamp,omitempty"`
+	// auction ending height, zero when the round ends at the ending timestamp
+	AuctionEndingHeight int64 `protobuf:"varint,5,opt,name=auction_ending_height,json=auctionEndingHeight,proto3" json:"auction_ending_height,omitempty"`
+	// minimum INJ bid of the current round
+	AuctionReservePrice *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=auction_reserve_price,json=auctionReservePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"auction_reserve_price,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +101,13 @@ func (m *GenesisState) GetAuctionEndingTimestamp() int64 {
 	return 0
 }
 
+func (m *GenesisState) GetAuctionEndingHeight() int64 {
+	if m != nil {
+		return m.AuctionEndingHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.auction.v1beta1.GenesisState")
 }
@@ -105,27 +117,31 @@ func init() {
 }
 
 var fileDescriptor_56f095f457353f49 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x5a, 0x75, 0x70, 0xcb, 0x12, 0x21, 0x14, 0x3a, 0x98, 0x02, 0x03, 0x5d, 0x88,
-	0x55, 0x58, 0xd8, 0x2a, 0x45, 0x42, 0x08, 0x09, 0x24, 0x14, 0x98, 0x58, 0x2a, 0x27, 0xb1, 0x9c,
-	0x43, 0xc4, 0x8e, 0x62, 0xa7, 0x12, 0x6f, 0xc1, 0x63, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xfb, 0x00,
-	0xbc, 0x02, 0xaa, 0xeb, 0x44, 0x2c, 0x74, 0x3b, 0xff, 0xff, 0x77, 0xf7, 0x9f, 0x7c, 0xf8, 0x1c,
-	0xe4, 0x2b, 0x4f, 0x0d, 0xcc, 0x39, 0x65, 0x75, 0x6a, 0x40, 0x49, 0x3a, 0x9f, 0x24, 0xdc, 0xb0,
-	0x09, 0x15, 0x5c, 0x72, 0x0d, 0x3a, 0x2c, 0x2b, 0x65, 0x94, 0x7f, 0xd4, 0x82, 0xa1, 0x03, 0x43,
-	0x07, 0x0e, 0x77, 0xcc, 0x68, 0x50, 0x3b, 0x63, 0x78, 0x20, 0x94, 0x50, 0xb6, 0xa4, 0x9b, 0x6a,
-	0xab, 0x9e, 0xfe, 0x20, 0x3c, 0xb8, 0xdd, 0x66, 0x3d, 0x19, 0x66, 0xb8, 0x3f, 0xc5, 0xbd, 0x92,
-	0x55, 0xac, 0xd0, 0x01, 0x1a, 0xa1, 0x71, 0xff, 0xf2, 0x24, 0xfc, 0x37, 0x3b, 0x7c, 0xb4, 0x60,
-	0xd4, 0x5d, 0x7c, 0x1d, 0x7b, 0xb1, 0x6b, 0xf3, 0xcf, 0xf0, 0xbe, 0xe3, 0x66, 0x95, 0xaa, 0x65,
-	0x16, 0xec, 0x8d, 0xd0, 0xb8, 0x1b, 0x0f, 0x9c, 0x18, 0x6f, 0x34, 0x7f, 0x8a, 0xfb, 0x39, 0x88,
-	0x9c, 0x6b, 0x33, 0x4b, 0x20, 0x0b, 0x3a, 0x36, 0x8a, 0xec, 0x88, 0x8a, 0x20, 0x8b, 0xb1, 0x6b,
-	0x89, 0x20, 0xf3, 0xaf, 0x71, 0xd0, 0xa4, 0x70, 0x99, 0x81, 0x14, 0x33, 0x03, 0x05, 0xd7, 0x86,
-	0x15, 0x65, 0xd0, 0x1d, 0xa1, 0x71, 0x27, 0x3e, 0x74, 0xfe, 0x8d, 0xb5, 0x9f, 0x1b, 0x37, 0x12,
-	0x8b, 0x15, 0x41, 0xcb, 0x15, 0x41, 0xdf, 0x2b, 0x82, 0x3e, 0xd6, 0xc4, 0x5b, 0xae, 0x89, 0xf7,
-	0xb9, 0x26, 0xde, 0xcb, 0x83, 0x00, 0x93, 0xd7, 0x49, 0x98, 0xaa, 0x82, 0xde, 0x35, 0x9b, 0xdc,
-	0xb3, 0x44, 0xd3, 0x76, 0xaf, 0x8b, 0x54, 0x55, 0xfc, 0xef, 0x33, 0x67, 0x20, 0x69, 0xa1, 0xb2,
-	0xfa, 0x8d, 0xeb, 0xf6, 0x00, 0xe6, 0xbd, 0xe4, 0x3a, 0xe9, 0xd9, 0x1f, 0xbe, 0xfa, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x2f, 0xc4, 0xb2, 0x63, 0xe6, 0x01, 0x00, 0x00,
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0xcb, 0xd3, 0x30,
+	0x18, 0x6f, 0xdc, 0x1c, 0x98, 0xf7, 0xf5, 0x52, 0xff, 0x50, 0xdf, 0x43, 0x57, 0x15, 0xb4, 0x97,
+	0x37, 0xe1, 0x9d, 0x17, 0x6f, 0x83, 0x82, 0xe8, 0x40, 0x61, 0x54, 0x4f, 0x5e, 0x4a, 0xda, 0x3e,
+	0xa4, 0x51, 0x9b, 0x94, 0x26, 0x1d, 0xf8, 0x09, 0xbc, 0xfa, 0xb1, 0x76, 0xdc, 0x51, 0x3c, 0x0c,
+	0xd9, 0xbe, 0x88, 0x2c, 0x4b, 0xeb, 0x14, 0xdc, 0xa9, 0x4f, 0x7f, 0x7f, 0xc3, 0x93, 0xe0, 0xe7,
+	0x42, 0x7e, 0x82, 0xc2, 0x88, 0x15, 0x50, 0xd6, 0x15, 0x46, 0x28, 0x49, 0x57, 0x37, 0x39, 0x18,
+	0x76, 0x43, 0x39, 0x48, 0xd0, 0x42, 0x93, 0xa6, 0x55, 0x46, 0xf9, 0x8f, 0x06, 0x21, 0x71, 0x42,
+	0xe2, 0x84, 0x57, 0x67, 0x32, 0x7a, 0xa9, 0xcd, 0xb8, 0xba, 0xcf, 0x15, 0x57, 0x76, 0xa4, 0x87,
+	0xe9, 0x88, 0x3e, 0xf9, 0x36, 0xc2, 0x97, 0xaf, 0x8f, 0x5d, 0xef, 0x0d, 0x33, 0xe0, 0xcf, 0xf1,
+	0xa4, 0x61, 0x2d, 0xab, 0x75, 0x80, 0x22, 0x14, 0x5f, 0xcc, 0x1e, 0x93, 0xff, 0x76, 0x93, 0xa5,
+	0x15, 0x26, 0xe3, 0xf5, 0x76, 0xea, 0xa5, 0xce, 0xe6, 0x3f, 0xc5, 0x77, 0x9d, 0x2e, 0x6b, 0x55,
+	0x27, 0xcb, 0xe0, 0x56, 0x84, 0xe2, 0x71, 0x7a, 0xe9, 0xc0, 0xf4, 0x80, 0xf9, 0x73, 0x7c, 0x51,
+	0x09, 0x5e, 0x81, 0x36, 0x59, 0x2e, 0xca, 0x60, 0x64, 0xab, 0xc2, 0x33, 0x55, 0x89, 0x28, 0x53,
+	0xec, 0x2c, 0x89, 0x28, 0xfd, 0x97, 0x38, 0xe8, 0x5b, 0x40, 0x96, 0x42, 0xf2, 0xcc, 0x88, 0x1a,
+	0xb4, 0x61, 0x75, 0x13, 0x8c, 0x23, 0x14, 0x8f, 0xd2, 0x87, 0x8e, 0x7f, 0x65, 0xe9, 0x0f, 0x3d,
+	0xeb, 0xcf, 0xf0, 0x83, 0x7f, 0x9c, 0x15, 0x08, 0x5e, 0x99, 0xe0, 0xb6, 0xb5, 0xdd, 0xfb, 0xcb,
+	0xf6, 0xc6, 0x52, 0x7e, 0xfe, 0xc7, 0xd3, 0x82, 0x86, 0x76, 0x05, 0x59, 0xd3, 0x8a, 0x02, 0x82,
+	0x49, 0x84, 0xe2, 0x3b, 0x09, 0x59, 0x6f, 0xa7, 0xe8, 0xe7, 0x76, 0xfa, 0x8c, 0x0b, 0x53, 0x75,
+	0x39, 0x29, 0x54, 0x4d, 0x0b, 0xa5, 0x6b, 0xa5, 0xdd, 0xe7, 0x5a, 0x97, 0x9f, 0xa9, 0xf9, 0xda,
+	0x80, 0x26, 0x0b, 0x69, 0x86, 0x8e, 0xf4, 0x98, 0xb5, 0x3c, 0x44, 0x25, 0x7c, 0xbd, 0x0b, 0xd1,
+	0x66, 0x17, 0xa2, 0x5f, 0xbb, 0x10, 0x7d, 0xdf, 0x87, 0xde, 0x66, 0x1f, 0x7a, 0x3f, 0xf6, 0xa1,
+	0xf7, 0xf1, 0xdd, 0x49, 0xec, 0xa2, 0xdf, 0xd0, 0x5b, 0x96, 0x6b, 0x3a, 0xec, 0xeb, 0xba, 0x50,
+	0x2d, 0x9c, 0xfe, 0x56, 0x4c, 0x48, 0x5a, 0xab, 0xb2, 0xfb, 0x02, 0x7a, 0x78, 0x18, 0xf6, 0x04,
+	0xf9, 0xc4, 0xde, 0xfc, 0x8b, 0xdf, 0x03, 0x00, 0x9c, 0x29, 0x10, 0x14, 0x7e, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -148,6 +164,23 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AuctionReservePrice != nil {
+		{
+			size := m.AuctionReservePrice.Size()
+			i -= size
+			if _, err := m.AuctionReservePrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AuctionEndingHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionEndingHeight))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.AuctionEndingTimestamp != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionEndingTimestamp))
 		i--
@@ -212,6 +245,13 @@ func (m *GenesisState) Size() (n int) {
 	if m.AuctionEndingTimestamp != 0 {
 		n += 1 + sovGenesis(uint64(m.AuctionEndingTimestamp))
 	}
+	if m.AuctionEndingHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionEndingHeight))
+	}
+	if m.AuctionReservePrice != nil {
+		l = m.AuctionReservePrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -357,6 +397,61 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionEndingHeight", wireType)
+			}
+			m.AuctionEndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionEndingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionReservePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.AuctionReservePrice = &v
+			if err := m.AuctionReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,5 +11,7 @@ var (
 	BidsKey            = []byte{0x01}
 	AuctionRoundKey    = []byte{0x03}
 	KeyEndingTimeStamp = []byte{0x04}
+	KeyEndingHeight    = []byte{0x05}
+	KeyReservePrice    = []byte{0x06}
 	ParamsKey          = []byte{0x10}
 )
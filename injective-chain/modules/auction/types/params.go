@@ -15,12 +15,18 @@ var (
 	DefaultAuctionPeriod int64 = 60 * 60 * 24 * 7
 	// DefaultMinNextBidIncrementRate represents default min increment rate 0.25%
 	DefaultMinNextBidIncrementRate = sdk.NewDecWithPrec(25, 4)
+	// DefaultAuctionDurationBlocks represents rounds lasting AuctionPeriod seconds instead of a number of blocks
+	DefaultAuctionDurationBlocks uint64 = 0
+	// DefaultMinReservePrice represents no reserve price
+	DefaultMinReservePrice = sdk.ZeroInt()
 )
 
 // Parameter keys
 var (
 	KeyAuctionPeriod           = []byte("AuctionPeriod")
 	KeyMinNextBidIncrementRate = []byte("MinNextBidIncrementRate")
+	KeyAuctionDurationBlocks   = []byte("AuctionDurationBlocks")
+	KeyMinReservePrice         = []byte("MinReservePrice")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -32,10 +38,14 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	auctionPeriod int64,
 	minNextBidIncrementRate sdk.Dec,
+	auctionDurationBlocks uint64,
+	minReservePrice sdk.Int,
 ) Params {
 	return Params{
 		AuctionPeriod:           auctionPeriod,
 		MinNextBidIncrementRate: minNextBidIncrementRate,
+		AuctionDurationBlocks:   auctionDurationBlocks,
+		MinReservePrice:         minReservePrice,
 	}
 }
 
@@ -44,6 +54,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAuctionPeriod, &p.AuctionPeriod, validateAuctionPeriodDuration),
 		paramtypes.NewParamSetPair(KeyMinNextBidIncrementRate, &p.MinNextBidIncrementRate, validateMinNextBidIncrementRate),
+		paramtypes.NewParamSetPair(KeyAuctionDurationBlocks, &p.AuctionDurationBlocks, validateAuctionDurationBlocks),
+		paramtypes.NewParamSetPair(KeyMinReservePrice, &p.MinReservePrice, validateMinReservePrice),
 	}
 }
 
@@ -52,6 +64,8 @@ func DefaultParams() Params {
 	return Params{
 		AuctionPeriod:           DefaultAuctionPeriod,
 		MinNextBidIncrementRate: DefaultMinNextBidIncrementRate,
+		AuctionDurationBlocks:   DefaultAuctionDurationBlocks,
+		MinReservePrice:         DefaultMinReservePrice,
 	}
 }
 
@@ -65,6 +79,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAuctionDurationBlocks(p.AuctionDurationBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMinReservePrice(p.MinReservePrice); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -91,7 +113,7 @@ func validateMinNextBidIncrementRate(i interface{}) error {
 		return fmt.Errorf("MinNextBidIncrementRate cannot be nil")
 	}
 
-	if v.Equal(sdk.ZeroDec()) {
+	if !v.IsPositive() {
 		return fmt.Errorf("MinNextBidIncrementRate must be positive: %s", v.String())
 	}
 
@@ -101,3 +123,29 @@ func validateMinNextBidIncrementRate(i interface{}) error {
 
 	return nil
 }
+
+func validateAuctionDurationBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinReservePrice(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("MinReservePrice cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("MinReservePrice cannot be negative: %s", v.String())
+	}
+
+	return nil
+}
@@ -166,6 +166,11 @@ type QueryCurrentAuctionBasketResponse struct {
 	HighestBidder string `protobuf:"bytes,4,opt,name=highestBidder,proto3" json:"highestBidder,omitempty"`
 	// highestBidAmount describes highest bid amount on current round
 	HighestBidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=highestBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"highestBidAmount"`
+	// auctionClosingHeight describes auction close height for the round, zero
+	// when the round closes at auctionClosingTime
+	AuctionClosingHeight int64 `protobuf:"varint,6,opt,name=auctionClosingHeight,proto3" json:"auctionClosingHeight,omitempty"`
+	// reservePrice describes the minimum INJ bid for the round
+	ReservePrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=reservePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reservePrice"`
 }
 
 func (m *QueryCurrentAuctionBasketResponse) Reset()         { *m = QueryCurrentAuctionBasketResponse{} }
@@ -229,6 +234,13 @@ func (m *QueryCurrentAuctionBasketResponse) GetHighestBidder() string {
 	return ""
 }
 
+func (m *QueryCurrentAuctionBasketResponse) GetAuctionClosingHeight() int64 {
+	if m != nil {
+		return m.AuctionClosingHeight
+	}
+	return 0
+}
+
 // QueryModuleStateRequest is the request type for the Query/AuctionModuleState
 // RPC method.
 type QueryModuleStateRequest struct {
@@ -327,45 +339,47 @@ func init() {
 }
 
 var fileDescriptor_2ae80edbdb9fffb7 = []byte{
-	// 607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0xd4, 0x40,
-	0x18, 0xde, 0x69, 0xb7, 0x05, 0xa7, 0x16, 0x64, 0x28, 0x98, 0x8d, 0x92, 0xa6, 0xf1, 0xa3, 0xe9,
-	0xa1, 0x89, 0xdd, 0xea, 0x49, 0x45, 0x9a, 0x1e, 0xa4, 0x60, 0x41, 0xa3, 0x17, 0x0b, 0x22, 0x93,
-	0xec, 0x90, 0x1d, 0xdb, 0xcc, 0x6c, 0x33, 0x93, 0x42, 0xaf, 0xfe, 0x02, 0xc1, 0x5f, 0xe0, 0x49,
-	0xf0, 0xe0, 0x2f, 0xf0, 0x07, 0xf4, 0x58, 0xf0, 0x22, 0x1e, 0xaa, 0xec, 0xfa, 0x43, 0x24, 0x99,
-	0xd9, 0xed, 0x2e, 0xfb, 0xe5, 0x7a, 0xda, 0xe4, 0x9d, 0xf7, 0x79, 0xde, 0xe7, 0x79, 0xe7, 0xc9,
-	0xc2, 0x3b, 0x94, 0xbd, 0x23, 0xb1, 0xa4, 0x27, 0xc4, 0xc7, 0x79, 0x2c, 0x29, 0x67, 0xfe, 0xc9,
-	0x56, 0x44, 0x24, 0xde, 0xf2, 0x8f, 0x73, 0x92, 0x9d, 0x7a, 0xad, 0x8c, 0x4b, 0x8e, 0x6a, 0xbd,
-	0x36, 0x4f, 0xb7, 0x79, 0xba, 0xcd, 0xbc, 0x99, 0x70, 0x9e, 0x1c, 0x11, 0x1f, 0xb7, 0xa8, 0x8f,
-	0x19, 0xe3, 0x12, 0x17, 0xc7, 0x42, 0x01, 0xcd, 0xf5, 0xf1, 0xfc, 0x5d, 0xa2, 0xa9, 0x8d, 0x09,
-	0x61, 0x44, 0xd0, 0x2e, 0xe3, 0x4a, 0xc2, 0x13, 0x5e, 0x3e, 0xfa, 0xc5, 0x93, 0xae, 0x5a, 0x31,
-	0x17, 0x29, 0x17, 0x7e, 0x84, 0x05, 0xe9, 0x01, 0x63, 0x4e, 0x35, 0xbd, 0x73, 0x03, 0xd6, 0x5e,
-	0x14, 0x7e, 0x76, 0x14, 0xf7, 0x73, 0x9c, 0xe1, 0x54, 0x84, 0xe4, 0x38, 0x27, 0x42, 0x3a, 0x6f,
-	0xa0, 0x39, 0xea, 0x50, 0xb4, 0x38, 0x13, 0x04, 0x3d, 0x81, 0x8b, 0xad, 0xb2, 0x62, 0x00, 0x1b,
-	0xb8, 0x4b, 0xf5, 0x35, 0x6f, 0xec, 0x32, 0x3c, 0x05, 0x0d, 0xaa, 0x67, 0x17, 0xab, 0x95, 0x50,
-	0xc3, 0x1c, 0x07, 0xda, 0x25, 0xfd, 0x6e, 0x9e, 0x65, 0x84, 0x49, 0x3d, 0x25, 0xc0, 0xe2, 0x90,
-	0xc8, 0xae, 0x84, 0x8b, 0x39, 0xb8, 0x36, 0xa1, 0x49, 0x4b, 0x89, 0xe1, 0x22, 0x4e, 0x79, 0xce,
-	0xa4, 0x01, 0xec, 0x79, 0x77, 0xa9, 0x5e, 0xf3, 0x94, 0x6d, 0xaf, 0xb0, 0xdd, 0x13, 0xb1, 0xcb,
-	0x29, 0x0b, 0xee, 0x15, 0x12, 0xbe, 0xfc, 0x5a, 0x75, 0x13, 0x2a, 0x9b, 0x79, 0xe4, 0xc5, 0x3c,
-	0xf5, 0xf5, 0x8e, 0xd4, 0xcf, 0xa6, 0x68, 0x1c, 0xfa, 0xf2, 0xb4, 0x45, 0x44, 0x09, 0x10, 0xa1,
-	0xa6, 0x46, 0x0e, 0xbc, 0xaa, 0x6d, 0x85, 0x3c, 0x67, 0x0d, 0x63, 0xce, 0x06, 0x6e, 0x35, 0x1c,
-	0xa8, 0x21, 0x0f, 0x22, 0xfd, 0xbe, 0x7b, 0xc4, 0x05, 0x65, 0xc9, 0x2b, 0x9a, 0x12, 0x63, 0xde,
-	0x06, 0xee, 0x7c, 0x38, 0xe2, 0x04, 0xdd, 0x86, 0xcb, 0x4d, 0x9a, 0x34, 0x89, 0x90, 0x01, 0x6d,
-	0x34, 0x48, 0x66, 0x54, 0x6d, 0xe0, 0x5e, 0x09, 0x07, 0x8b, 0xe8, 0x00, 0x5e, 0xbb, 0x2c, 0xec,
-	0x28, 0xa3, 0x0b, 0x45, 0x63, 0xe0, 0x15, 0x6e, 0x7e, 0x5e, 0xac, 0xde, 0xfd, 0x07, 0x37, 0x7b,
-	0x4c, 0x86, 0x43, 0x3c, 0x4e, 0x0d, 0x5e, 0x2f, 0xf7, 0xbb, 0xcf, 0x1b, 0xf9, 0x11, 0x79, 0x29,
-	0xb1, 0x24, 0xdd, 0xdd, 0xbf, 0x86, 0xc6, 0xf0, 0x91, 0xde, 0xf8, 0x63, 0xb8, 0x20, 0x8a, 0x82,
-	0xbe, 0xfb, 0xf5, 0x09, 0x77, 0xff, 0x54, 0xc5, 0x54, 0xe1, 0x15, 0xaa, 0xfe, 0xa9, 0x0a, 0x17,
-	0x4a, 0x6e, 0xf4, 0x19, 0xc0, 0xe5, 0x81, 0x7c, 0xa1, 0xfb, 0x13, 0xb8, 0xc6, 0x66, 0xd5, 0x7c,
-	0x30, 0x23, 0x4a, 0xf9, 0x70, 0x36, 0xde, 0x7f, 0xff, 0xf3, 0x71, 0xee, 0x16, 0x5a, 0xf3, 0xc7,
-	0x7f, 0x67, 0x2a, 0xae, 0xe8, 0x1b, 0x80, 0x2b, 0xa3, 0x52, 0x88, 0x1e, 0x4e, 0x1b, 0x3d, 0x21,
-	0xe0, 0xe6, 0xa3, 0xff, 0x03, 0xcf, 0x20, 0x3f, 0x52, 0x2a, 0xbf, 0x02, 0x88, 0x34, 0x49, 0xdf,
-	0x85, 0xa2, 0xfa, 0xb4, 0xf9, 0xc3, 0xc1, 0x30, 0xb7, 0x67, 0xc2, 0x68, 0xa9, 0x7e, 0x29, 0x75,
-	0x03, 0xad, 0x4f, 0x90, 0x9a, 0x96, 0xb8, 0xb7, 0x65, 0x46, 0x82, 0xe4, 0xac, 0x6d, 0x81, 0xf3,
-	0xb6, 0x05, 0x7e, 0xb7, 0x2d, 0xf0, 0xa1, 0x63, 0x55, 0xce, 0x3b, 0x56, 0xe5, 0x47, 0xc7, 0xaa,
-	0x1c, 0xec, 0xf7, 0xa5, 0x7d, 0xaf, 0x4b, 0xf6, 0x0c, 0x47, 0xe2, 0x92, 0x7a, 0x33, 0xe6, 0x19,
-	0xe9, 0x7f, 0x6d, 0x62, 0xca, 0x34, 0xbf, 0xe8, 0xcd, 0x2d, 0x3f, 0x8c, 0x68, 0xb1, 0xfc, 0x2b,
-	0xdc, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xd8, 0x05, 0x8e, 0xf4, 0x05, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xd0, 0x52, 0xe3, 0x00, 0x89, 0x99, 0x90, 0xb8, 0xad, 0x66, 0x29, 0xeb, 0x1f, 0xca,
+	0x81, 0x5d, 0x29, 0x7a, 0x52, 0x63, 0x28, 0x07, 0x25, 0x91, 0x04, 0x57, 0x2f, 0x92, 0x18, 0x33,
+	0xdd, 0xbe, 0x6c, 0x47, 0xe8, 0x4c, 0xd9, 0x99, 0x25, 0xe1, 0xea, 0x27, 0x30, 0xf1, 0x13, 0x78,
+	0x32, 0xf1, 0xe0, 0x27, 0xf0, 0x03, 0x70, 0x24, 0xf1, 0x62, 0x3c, 0xa0, 0x01, 0xe3, 0xe7, 0x30,
+	0x3b, 0x33, 0x2d, 0x34, 0xb4, 0xc5, 0x72, 0xea, 0xce, 0x7b, 0xef, 0xf7, 0x7b, 0xbf, 0xdf, 0xcc,
+	0x7b, 0xc5, 0x77, 0x18, 0x7f, 0x07, 0x91, 0x62, 0x7b, 0x10, 0xd0, 0x34, 0x52, 0x4c, 0xf0, 0x60,
+	0x6f, 0xb9, 0x01, 0x8a, 0x2e, 0x07, 0xbb, 0x29, 0x24, 0xfb, 0x7e, 0x27, 0x11, 0x4a, 0x90, 0x52,
+	0xaf, 0xcc, 0xb7, 0x65, 0xbe, 0x2d, 0x2b, 0xdf, 0x8c, 0x85, 0x88, 0x77, 0x20, 0xa0, 0x1d, 0x16,
+	0x50, 0xce, 0x85, 0xa2, 0x59, 0x5a, 0x1a, 0x60, 0x79, 0x61, 0x38, 0x7f, 0x97, 0xe8, 0xc2, 0xc2,
+	0x18, 0x38, 0x48, 0xd6, 0x65, 0x9c, 0x8d, 0x45, 0x2c, 0xf4, 0x67, 0x90, 0x7d, 0xd9, 0xa8, 0x1b,
+	0x09, 0xd9, 0x16, 0x32, 0x68, 0x50, 0x09, 0x3d, 0x60, 0x24, 0x98, 0xa5, 0xf7, 0x6e, 0xe0, 0xd2,
+	0x8b, 0xcc, 0xcf, 0xaa, 0xe1, 0xde, 0xa4, 0x09, 0x6d, 0xcb, 0x10, 0x76, 0x53, 0x90, 0xca, 0x7b,
+	0x83, 0xcb, 0x83, 0x92, 0xb2, 0x23, 0xb8, 0x04, 0xf2, 0x04, 0x17, 0x3b, 0x3a, 0xe2, 0xa0, 0x0a,
+	0xaa, 0x4e, 0xd5, 0xe6, 0xfd, 0xa1, 0x97, 0xe1, 0x1b, 0x68, 0xbd, 0x70, 0x70, 0x34, 0x97, 0x0b,
+	0x2d, 0xcc, 0xf3, 0x70, 0x45, 0xd3, 0xaf, 0xa5, 0x49, 0x02, 0x5c, 0xd9, 0x2e, 0x75, 0x2a, 0xb7,
+	0x41, 0x75, 0x25, 0xfc, 0xcd, 0xe3, 0xf9, 0x11, 0x45, 0x56, 0x4a, 0x84, 0x8b, 0xb4, 0x2d, 0x52,
+	0xae, 0x1c, 0x54, 0xc9, 0x57, 0xa7, 0x6a, 0x25, 0xdf, 0xd8, 0xf6, 0x33, 0xdb, 0x3d, 0x11, 0x6b,
+	0x82, 0xf1, 0xfa, 0xbd, 0x4c, 0xc2, 0x97, 0x5f, 0x73, 0xd5, 0x98, 0xa9, 0x56, 0xda, 0xf0, 0x23,
+	0xd1, 0x0e, 0xec, 0x1d, 0x99, 0x9f, 0x25, 0xd9, 0xdc, 0x0e, 0xd4, 0x7e, 0x07, 0xa4, 0x06, 0xc8,
+	0xd0, 0x52, 0x13, 0x0f, 0x4f, 0x5b, 0x5b, 0xa1, 0x48, 0x79, 0xd3, 0x99, 0xa8, 0xa0, 0x6a, 0x21,
+	0xec, 0x8b, 0x11, 0x1f, 0x13, 0x7b, 0x5e, 0xdb, 0x11, 0x92, 0xf1, 0xf8, 0x15, 0x6b, 0x83, 0x93,
+	0xaf, 0xa0, 0x6a, 0x3e, 0x1c, 0x90, 0x21, 0xb7, 0xf1, 0x4c, 0x8b, 0xc5, 0x2d, 0x90, 0xaa, 0xce,
+	0x9a, 0x4d, 0x48, 0x9c, 0x42, 0x05, 0x55, 0xaf, 0x86, 0xfd, 0x41, 0xb2, 0x85, 0xaf, 0x9d, 0x06,
+	0x56, 0x8d, 0xd1, 0xc9, 0xac, 0xb0, 0xee, 0x67, 0x6e, 0x7e, 0x1e, 0xcd, 0xdd, 0xfd, 0x0f, 0x37,
+	0xeb, 0x5c, 0x85, 0xe7, 0x78, 0x48, 0x0d, 0xcf, 0xf6, 0xeb, 0x7a, 0x06, 0x2c, 0x6e, 0x29, 0xa7,
+	0xa8, 0x35, 0x0f, 0xcc, 0x91, 0x10, 0x4f, 0x27, 0x20, 0x21, 0xd9, 0x83, 0xcd, 0x84, 0x45, 0xe0,
+	0x5c, 0xb9, 0x94, 0x96, 0x3e, 0x0e, 0xaf, 0x84, 0xaf, 0xeb, 0x77, 0xde, 0x10, 0xcd, 0x74, 0x07,
+	0x5e, 0x2a, 0xaa, 0xa0, 0x3b, 0x03, 0xaf, 0xb1, 0x73, 0x3e, 0x65, 0x5f, 0xfe, 0x31, 0x9e, 0x94,
+	0x59, 0xc0, 0xce, 0xe0, 0xc2, 0x88, 0x19, 0x7c, 0x6a, 0xd6, 0xc5, 0xe0, 0x0d, 0xaa, 0xf6, 0xa9,
+	0x80, 0x27, 0x35, 0x37, 0xf9, 0x8c, 0xf0, 0x4c, 0xdf, 0x9c, 0x93, 0xfb, 0x23, 0xb8, 0x86, 0xee,
+	0x4c, 0xf9, 0xc1, 0x98, 0x28, 0xe3, 0xc3, 0x5b, 0x7c, 0xff, 0xfd, 0xcf, 0xc7, 0x89, 0x5b, 0x64,
+	0x3e, 0x18, 0xbe, 0xef, 0x66, 0x6d, 0xc8, 0x37, 0x84, 0x67, 0x07, 0x6d, 0x03, 0x79, 0x78, 0x51,
+	0xeb, 0x11, 0x8b, 0x56, 0x7e, 0x74, 0x39, 0xf0, 0x18, 0xf2, 0x1b, 0x46, 0xe5, 0x57, 0x84, 0x89,
+	0x25, 0x39, 0xf3, 0xa0, 0xa4, 0x76, 0x51, 0xff, 0xf3, 0x83, 0x51, 0x5e, 0x19, 0x0b, 0x63, 0xa5,
+	0x06, 0x5a, 0xea, 0x22, 0x59, 0x18, 0x21, 0xb5, 0xad, 0x71, 0x6f, 0xf5, 0x8c, 0xd4, 0xe3, 0x83,
+	0x63, 0x17, 0x1d, 0x1e, 0xbb, 0xe8, 0xf7, 0xb1, 0x8b, 0x3e, 0x9c, 0xb8, 0xb9, 0xc3, 0x13, 0x37,
+	0xf7, 0xe3, 0xc4, 0xcd, 0x6d, 0x6d, 0x9c, 0x99, 0xf4, 0xf5, 0x2e, 0xd9, 0x73, 0xda, 0x90, 0xa7,
+	0xd4, 0x4b, 0x91, 0x48, 0xe0, 0xec, 0xb1, 0x45, 0x19, 0xb7, 0xfc, 0xb2, 0xd7, 0x57, 0x2f, 0x45,
+	0xa3, 0xa8, 0xff, 0x92, 0x57, 0xfe, 0x0d, 0x00, 0xdc, 0x9c, 0xac, 0x7a, 0x7c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -625,6 +639,21 @@ func (m *QueryCurrentAuctionBasketResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReservePrice.Size()
+		i -= size
+		if _, err := m.ReservePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.AuctionClosingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionClosingHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.HighestBidAmount.Size()
 		i -= size
@@ -791,6 +820,11 @@ func (m *QueryCurrentAuctionBasketResponse) Size() (n int) {
 	}
 	l = m.HighestBidAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.AuctionClosingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionClosingHeight))
+	}
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1172,6 +1206,59 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionClosingHeight", wireType)
+			}
+			m.AuctionClosingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionClosingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
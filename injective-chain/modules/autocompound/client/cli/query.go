@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+)
+
+// GetQueryCmd returns the parent command for all modules/autocompound CLi query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := cli.ModuleRootCommand(types.ModuleName, true)
+
+	cmd.AddCommand(
+		GetAutoCompoundParamsCmd(),
+		GetAutoCompoundDelegatorsCmd(),
+	)
+	return cmd
+}
+
+func GetAutoCompoundParamsCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"params",
+		"Gets autocompound params info",
+		types.NewQueryClient,
+		&types.QueryAutoCompoundParamsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+}
+
+func GetAutoCompoundDelegatorsCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"delegators",
+		"Gets the delegators opted in to auto-compounding",
+		types.NewQueryClient,
+		&types.QueryAutoCompoundDelegatorsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+}
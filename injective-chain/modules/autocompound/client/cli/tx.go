@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+)
+
+// NewTxCmd returns a root CLI command handler for certain modules/autocompound transaction commands.
+func NewTxCmd() *cobra.Command {
+	cmd := cli.ModuleRootCommand(types.ModuleName, false)
+
+	cmd.AddCommand(
+		NewSetAutoCompoundCmd(),
+	)
+	return cmd
+}
+
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := cli.TxCmd("set <enabled>",
+		"opt in or out of the auto-compounding of staking rewards",
+		&types.MsgSetAutoCompound{},
+		cli.FlagsMapping{},
+		cli.ArgsMapping{},
+	)
+	cmd.Example = `injectived tx autocompound set true --from=genesis --keyring-backend=file --yes`
+	return cmd
+}
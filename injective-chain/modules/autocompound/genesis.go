@@ -0,0 +1,23 @@
+package autocompound
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, delegator := range data.Delegators {
+		k.SetAutoCompound(ctx, sdk.MustAccAddressFromBech32(delegator), true)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		Delegators: k.GetAllAutoCompoundDelegators(ctx),
+	}
+}
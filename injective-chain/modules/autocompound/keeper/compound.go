@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+)
+
+// MaxDelegationsCompoundedPerDelegator bounds the number of delegations of a delegator compounded in a block, so that
+// a pass costs at most MaxDelegatorsPerBlock * MaxDelegationsCompoundedPerDelegator compoundings per block.
+const MaxDelegationsCompoundedPerDelegator = 10
+
+// CompoundRewards runs the compounding pass of the current epoch. A pass starts every EpochBlocks blocks and
+// compounds the rewards of at most MaxDelegatorsPerBlock opted-in delegators per block in address order, resuming
+// in the next block until all the opted-in delegators are compounded. A delegator with more delegations than
+// MaxDelegationsCompoundedPerDelegator ends the block, and its remaining delegations are compounded from the
+// delegation cursor in the next block.
+func (k *Keeper) CompoundRewards(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	if params.EpochBlocks == 0 || params.MaxDelegatorsPerBlock == 0 {
+		k.deleteCompoundingCursor(ctx)
+		k.deleteDelegationCursor(ctx)
+		return
+	}
+
+	start, isPassInProgress := k.getCompoundingCursor(ctx)
+	if !isPassInProgress && ctx.BlockHeight()%int64(params.EpochBlocks) != 0 {
+		return
+	}
+
+	startValidator := k.getDelegationCursor(ctx)
+
+	var (
+		compoundedCount int
+		nextDelegator   sdk.AccAddress
+		nextValidator   sdk.ValAddress
+	)
+
+	k.IterateAutoCompoundDelegators(ctx, start, func(delegator sdk.AccAddress) (stop bool) {
+		if compoundedCount == int(params.MaxDelegatorsPerBlock) {
+			nextDelegator = delegator
+			return true
+		}
+
+		var after sdk.ValAddress
+		if delegator.Equals(start) {
+			after = startValidator
+		}
+
+		lastValidator, hasMore := k.compoundDelegatorRewards(ctx, delegator, after)
+		compoundedCount++
+
+		if hasMore {
+			nextDelegator, nextValidator = delegator, lastValidator
+			return true
+		}
+		return false
+	})
+
+	if nextDelegator != nil {
+		k.setCompoundingCursor(ctx, nextDelegator)
+	} else {
+		k.deleteCompoundingCursor(ctx)
+	}
+
+	if nextValidator != nil {
+		k.setDelegationCursor(ctx, nextValidator)
+	} else {
+		k.deleteDelegationCursor(ctx)
+	}
+}
+
+// compoundDelegatorRewards withdraws the rewards of the delegations of a delegator to the validators after the given
+// one, at most MaxDelegationsCompoundedPerDelegator of them in validator address order, and delegates the bond denom
+// rewards to the same validator. It returns the last validator read and whether delegations are left after it.
+// Delegations to jailed validators or to validators whose tokens were entirely slashed are skipped, as are delegators
+// withdrawing their rewards to another address. A delegation which fails to compound is left untouched.
+func (k *Keeper) compoundDelegatorRewards(ctx sdk.Context, delegator sdk.AccAddress, after sdk.ValAddress) (lastValidator sdk.ValAddress, hasMore bool) {
+	if !k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegator).Equals(delegator) {
+		return nil, false
+	}
+
+	delegations := make([]stakingtypes.Delegation, 0, MaxDelegationsCompoundedPerDelegator)
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) (stop bool) {
+		if after != nil && bytes.Compare(delegation.GetValidatorAddr(), after) <= 0 {
+			return false
+		}
+
+		if len(delegations) == MaxDelegationsCompoundedPerDelegator {
+			hasMore = true
+			return true
+		}
+
+		delegations = append(delegations, delegation)
+		return false
+	})
+
+	if len(delegations) == 0 {
+		return nil, false
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	for _, delegation := range delegations {
+		validatorAddr := delegation.GetValidatorAddr()
+		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
+		if !found || validator.IsJailed() || validator.InvalidExRate() {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		rewards, err := k.distributionKeeper.WithdrawDelegationRewards(cacheCtx, delegator, validatorAddr)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			k.Logger(ctx).Error("failed to withdraw rewards to compound", "delegator", delegator.String(), "validator", validatorAddr.String(), "error", err)
+			continue
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if _, err := k.stakingKeeper.Delegate(cacheCtx, delegator, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			k.Logger(ctx).Error("failed to delegate compounded rewards", "delegator", delegator.String(), "validator", validatorAddr.String(), "error", err)
+			continue
+		}
+
+		writeCache()
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventRewardsCompounded{
+			Delegator: delegator.String(),
+			Validator: validatorAddr.String(),
+			Amount:    sdk.NewCoin(bondDenom, amount),
+		})
+	}
+
+	return delegations[len(delegations)-1].GetValidatorAddr(), hasMore
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+)
+
+func setupCompounding(t *testing.T) (*simapp.InjectiveApp, sdk.Context, stakingtypes.Validator, sdk.AccAddress) {
+	t.Helper()
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	})
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.NotEmpty(t, validators)
+	validator := validators[0]
+
+	delegations := app.StakingKeeper.GetValidatorDelegations(ctx, validator.GetOperator())
+	require.NotEmpty(t, delegations)
+	delegator := delegations[0].GetDelegatorAddr()
+
+	// allocate rewards to the delegators of the validator
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+	app.AutoCompoundKeeper.SetParams(ctx, types.NewParams(1, 10))
+	app.AutoCompoundKeeper.SetAutoCompound(ctx, delegator, true)
+
+	return app, ctx, validator, delegator
+}
+
+func TestCompoundRewards(t *testing.T) {
+	app, ctx, validator, delegator := setupCompounding(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delegationBefore, found := app.StakingKeeper.GetDelegation(ctx, delegator, validator.GetOperator())
+	require.True(t, found)
+	balanceBefore := app.BankKeeper.GetBalance(ctx, delegator, bondDenom)
+
+	app.AutoCompoundKeeper.CompoundRewards(ctx)
+
+	delegationAfter, found := app.StakingKeeper.GetDelegation(ctx, delegator, validator.GetOperator())
+	require.True(t, found)
+	require.True(t, delegationAfter.Shares.GT(delegationBefore.Shares), "rewards should be delegated")
+	require.Equal(t, balanceBefore, app.BankKeeper.GetBalance(ctx, delegator, bondDenom), "withdrawn rewards should be entirely delegated")
+
+	validatorAfter, found := app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	require.True(t, found)
+	require.True(t, validatorAfter.Tokens.GT(validator.Tokens))
+
+	// opting out stops the compounding
+	app.AutoCompoundKeeper.SetAutoCompound(ctx, delegator, false)
+	require.Empty(t, app.AutoCompoundKeeper.GetAllAutoCompoundDelegators(ctx))
+}
+
+func TestCompoundRewardsSkipsJailedValidator(t *testing.T) {
+	app, ctx, validator, delegator := setupCompounding(t)
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(ctx, consAddr)
+
+	delegationBefore, found := app.StakingKeeper.GetDelegation(ctx, delegator, validator.GetOperator())
+	require.True(t, found)
+	outstandingRewardsBefore := app.DistrKeeper.GetValidatorOutstandingRewards(ctx, validator.GetOperator())
+
+	app.AutoCompoundKeeper.CompoundRewards(ctx)
+
+	delegationAfter, found := app.StakingKeeper.GetDelegation(ctx, delegator, validator.GetOperator())
+	require.True(t, found)
+	require.True(t, delegationAfter.Shares.Equal(delegationBefore.Shares), "rewards shouldn't be delegated to a jailed validator")
+	require.Equal(t, outstandingRewardsBefore, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, validator.GetOperator()), "rewards shouldn't be withdrawn")
+}
+
+func TestCompoundRewardsResumesTheDelegationsOfADelegator(t *testing.T) {
+	app, ctx, _, _ := setupCompounding(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delegator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	funds := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delegator, funds))
+
+	// delegate in the previous block to more validators than the delegations compounded per delegator in a block
+	delegationCtx := ctx.WithBlockHeight(ctx.BlockHeight() - 1)
+	validators := make([]sdk.ValAddress, 0, keeper.MaxDelegationsCompoundedPerDelegator+2)
+	for i := 0; i < keeper.MaxDelegationsCompoundedPerDelegator+2; i++ {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+		require.NoError(t, err)
+		app.StakingKeeper.SetValidator(ctx, validator)
+		require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		require.NoError(t, app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, validator.GetOperator()))
+
+		_, err = app.StakingKeeper.Delegate(delegationCtx, delegator, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+		require.NoError(t, err)
+
+		validator, _ = app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+		rewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+		app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+		validators = append(validators, validator.GetOperator())
+	}
+
+	app.AutoCompoundKeeper.SetParams(ctx, types.NewParams(1, 10))
+	app.AutoCompoundKeeper.SetAutoCompound(ctx, delegator, true)
+
+	compoundedCount := func() int {
+		count := 0
+		for _, validator := range validators {
+			delegation, found := app.StakingKeeper.GetDelegation(ctx, delegator, validator)
+			require.True(t, found)
+			if delegation.Shares.GT(sdk.NewDec(1000)) {
+				count++
+			}
+		}
+		return count
+	}
+
+	app.AutoCompoundKeeper.CompoundRewards(ctx)
+	require.Equal(t, keeper.MaxDelegationsCompoundedPerDelegator, compoundedCount())
+
+	// the remaining delegations are compounded in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.AutoCompoundKeeper.CompoundRewards(ctx)
+	require.Equal(t, len(validators), compoundedCount())
+}
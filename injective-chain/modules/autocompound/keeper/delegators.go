@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+)
+
+// SetAutoCompound opts a delegator in or out of the auto-compounding of its staking rewards
+func (k *Keeper) SetAutoCompound(ctx sdk.Context, delegator sdk.AccAddress, enabled bool) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.GetStore(ctx)
+	if enabled {
+		store.Set(types.GetDelegatorKey(delegator), []byte{1})
+	} else {
+		store.Delete(types.GetDelegatorKey(delegator))
+	}
+}
+
+// IsAutoCompoundEnabled returns whether a delegator is opted in to the auto-compounding of its staking rewards
+func (k *Keeper) IsAutoCompoundEnabled(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.GetStore(ctx).Has(types.GetDelegatorKey(delegator))
+}
+
+// IterateAutoCompoundDelegators iterates over the opted-in delegators in address order, starting from the start
+// delegator when it's not nil.
+func (k *Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, start sdk.AccAddress, process func(delegator sdk.AccAddress) (stop bool)) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	delegatorsStore := prefix.NewStore(k.GetStore(ctx), types.DelegatorsKey)
+	iterator := delegatorsStore.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if process(sdk.AccAddress(iterator.Key())) {
+			return
+		}
+	}
+}
+
+// GetAllAutoCompoundDelegators returns the delegators opted in to auto-compounding
+func (k *Keeper) GetAllAutoCompoundDelegators(ctx sdk.Context) []string {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	delegators := make([]string, 0)
+	k.IterateAutoCompoundDelegators(ctx, nil, func(delegator sdk.AccAddress) (stop bool) {
+		delegators = append(delegators, delegator.String())
+		return false
+	})
+
+	return delegators
+}
+
+// getCompoundingCursor returns the next delegator to compound when a compounding pass is in progress
+func (k *Keeper) getCompoundingCursor(ctx sdk.Context) (sdk.AccAddress, bool) {
+	bz := k.GetStore(ctx).Get(types.CompoundingCursorKey)
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+func (k *Keeper) setCompoundingCursor(ctx sdk.Context, delegator sdk.AccAddress) {
+	k.GetStore(ctx).Set(types.CompoundingCursorKey, delegator)
+}
+
+func (k *Keeper) deleteCompoundingCursor(ctx sdk.Context) {
+	k.GetStore(ctx).Delete(types.CompoundingCursorKey)
+}
+
+// getDelegationCursor returns the validator after which the delegations of the next delegator to compound resume,
+// when only part of its delegations were compounded in the previous block
+func (k *Keeper) getDelegationCursor(ctx sdk.Context) sdk.ValAddress {
+	bz := k.GetStore(ctx).Get(types.DelegationCursorKey)
+	if bz == nil {
+		return nil
+	}
+	return sdk.ValAddress(bz)
+}
+
+func (k *Keeper) setDelegationCursor(ctx sdk.Context, validator sdk.ValAddress) {
+	k.GetStore(ctx).Set(types.DelegationCursorKey, validator)
+}
+
+func (k *Keeper) deleteDelegationCursor(ctx sdk.Context) {
+	k.GetStore(ctx).Delete(types.DelegationCursorKey)
+}
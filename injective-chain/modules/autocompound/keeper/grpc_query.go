@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+	"github.com/InjectiveLabs/metrics"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+func (k *Keeper) AutoCompoundParams(c context.Context, _ *types.QueryAutoCompoundParamsRequest) (*types.QueryAutoCompoundParamsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryAutoCompoundParamsResponse{
+		Params: k.GetParams(ctx),
+	}
+	return res, nil
+}
+
+func (k *Keeper) AutoCompoundDelegators(c context.Context, _ *types.QueryAutoCompoundDelegatorsRequest) (*types.QueryAutoCompoundDelegatorsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryAutoCompoundDelegatorsResponse{
+		Delegators: k.GetAllAutoCompoundDelegators(ctx),
+	}
+	return res, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/autocompound/types"
+)
+
+// Keeper of this module maintains the delegators opted in to auto-compounding.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	stakingKeeper      types.StakingKeeper
+	distributionKeeper types.DistributionKeeper
+
+	svcTags metrics.Tags
+
+	authority string
+}
+
+// NewKeeper creates new instances of the autocompound Keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	sk types.StakingKeeper,
+	dk types.DistributionKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:           storeKey,
+		cdc:                cdc,
+		stakingKeeper:      sk,
+		distributionKeeper: dk,
+		authority:          authority,
+		svcTags: metrics.Tags{
+			"svc": "autocompound_k",
+		},
+	}
+}
+
+func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+func (k *Keeper) GetStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.KVStore(k.storeKey)
+}
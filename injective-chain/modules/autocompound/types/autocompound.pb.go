@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/autocompound/v1beta1/autocompound.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Params struct {
+	// epoch_blocks defines the number of blocks between two compounding passes,
+	// zero disables auto-compounding
+	EpochBlocks uint64 `protobuf:"varint,1,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// max_delegators_per_block defines the maximum number of delegators whose
+	// rewards are compounded in a block, a pass continues in the next blocks
+	// until all the opted-in delegators are compounded
+	MaxDelegatorsPerBlock uint32 `protobuf:"varint,2,opt,name=max_delegators_per_block,json=maxDelegatorsPerBlock,proto3" json:"max_delegators_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfcd1b624ea3c208, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDelegatorsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxDelegatorsPerBlock
+	}
+	return 0
+}
+
+type EventRewardsCompounded struct {
+	Delegator string     `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Validator string     `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventRewardsCompounded) Reset()         { *m = EventRewardsCompounded{} }
+func (m *EventRewardsCompounded) String() string { return proto.CompactTextString(m) }
+func (*EventRewardsCompounded) ProtoMessage()    {}
+func (*EventRewardsCompounded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfcd1b624ea3c208, []int{1}
+}
+func (m *EventRewardsCompounded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardsCompounded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardsCompounded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardsCompounded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardsCompounded.Merge(m, src)
+}
+func (m *EventRewardsCompounded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardsCompounded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardsCompounded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardsCompounded proto.InternalMessageInfo
+
+func (m *EventRewardsCompounded) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventRewardsCompounded) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventRewardsCompounded) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "injective.autocompound.v1beta1.Params")
+	proto.RegisterType((*EventRewardsCompounded)(nil), "injective.autocompound.v1beta1.EventRewardsCompounded")
+}
+
+func init() {
+	proto.RegisterFile("injective/autocompound/v1beta1/autocompound.proto", fileDescriptor_bfcd1b624ea3c208)
+}
+
+var fileDescriptor_bfcd1b624ea3c208 = []byte{
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
+	0x14, 0x85, 0x3b, 0xfc, 0x84, 0x84, 0xf2, 0xbb, 0x69, 0xd4, 0x54, 0x62, 0x06, 0x64, 0xc5, 0xc6,
+	0x36, 0xe8, 0x82, 0xc4, 0x25, 0xe8, 0xc2, 0xc4, 0x05, 0xe9, 0xd2, 0x0d, 0x99, 0x4e, 0x6f, 0x4a,
+	0xb1, 0xd3, 0xdb, 0x74, 0xa6, 0x15, 0x1f, 0xc2, 0xc4, 0x47, 0xf0, 0x71, 0x58, 0xb2, 0x74, 0x65,
+	0x0c, 0x6c, 0x7c, 0x0c, 0xc3, 0x14, 0xaa, 0xec, 0xda, 0xf3, 0xdd, 0x73, 0x27, 0xe7, 0x5c, 0x73,
+	0x10, 0x25, 0x73, 0xe0, 0x2a, 0x2a, 0xc0, 0x65, 0xb9, 0x42, 0x8e, 0x22, 0xc5, 0x3c, 0x09, 0xdc,
+	0x62, 0xe0, 0x83, 0x62, 0x83, 0x03, 0xd1, 0x49, 0x33, 0x54, 0x68, 0xd1, 0xca, 0xe2, 0x1c, 0xd0,
+	0x9d, 0xa5, 0x7d, 0x1c, 0x62, 0x88, 0x7a, 0xd4, 0xdd, 0x7e, 0x95, 0xae, 0x36, 0xe5, 0x28, 0x05,
+	0x4a, 0xd7, 0x67, 0x12, 0xaa, 0xed, 0x1c, 0xa3, 0xa4, 0xe4, 0xbd, 0xb9, 0xd9, 0x98, 0xb0, 0x8c,
+	0x09, 0x69, 0x5d, 0x98, 0xff, 0x21, 0x45, 0x3e, 0x9b, 0xfa, 0x31, 0xf2, 0x27, 0x69, 0x93, 0x2e,
+	0xe9, 0xd7, 0xbd, 0x96, 0xd6, 0x46, 0x5a, 0xb2, 0x86, 0xa6, 0x2d, 0xd8, 0x62, 0x1a, 0x40, 0x0c,
+	0x21, 0x53, 0x98, 0xc9, 0x69, 0x0a, 0x59, 0x39, 0x6f, 0xd7, 0xba, 0xa4, 0x7f, 0xe4, 0x9d, 0x08,
+	0xb6, 0xb8, 0xad, 0xf0, 0x04, 0x32, 0xed, 0xbc, 0xa9, 0x7f, 0xbf, 0x77, 0x48, 0xef, 0x95, 0x98,
+	0xa7, 0x77, 0x05, 0x24, 0xca, 0x83, 0x67, 0x96, 0x05, 0x72, 0xbc, 0x8b, 0x00, 0x81, 0x75, 0x6e,
+	0x36, 0xab, 0xad, 0xfa, 0xe5, 0xa6, 0xf7, 0x2b, 0x6c, 0x69, 0xc1, 0xe2, 0x28, 0xd0, 0xb4, 0x56,
+	0xd2, 0x4a, 0xb0, 0x86, 0x66, 0x83, 0x09, 0xcc, 0x13, 0x65, 0xff, 0xeb, 0x92, 0x7e, 0xeb, 0xea,
+	0xcc, 0x29, 0x33, 0x3b, 0xdb, 0xcc, 0xfb, 0x7a, 0x9c, 0x31, 0x46, 0xc9, 0xa8, 0xbe, 0xfc, 0xec,
+	0x18, 0xde, 0x6e, 0x7c, 0x14, 0x2f, 0xd7, 0x94, 0xac, 0xd6, 0x94, 0x7c, 0xad, 0x29, 0x79, 0xdb,
+	0x50, 0x63, 0xb5, 0xa1, 0xc6, 0xc7, 0x86, 0x1a, 0x8f, 0x5e, 0x18, 0xa9, 0x59, 0xee, 0x3b, 0x1c,
+	0x85, 0x7b, 0xbf, 0xaf, 0xfd, 0x81, 0xf9, 0xd2, 0xad, 0x8e, 0x70, 0xc9, 0x31, 0x83, 0xbf, 0xbf,
+	0x33, 0x16, 0x25, 0xae, 0xc0, 0x20, 0x8f, 0x41, 0x1e, 0x1e, 0x55, 0xbd, 0xa4, 0x20, 0xfd, 0x86,
+	0x2e, 0xfc, 0xfa, 0x67, 0x00, 0x22, 0x18, 0x18, 0x79, 0xfb, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochBlocks != that1.EpochBlocks {
+		return false
+	}
+	if this.MaxDelegatorsPerBlock != that1.MaxDelegatorsPerBlock {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDelegatorsPerBlock != 0 {
+		i = encodeVarintAutocompound(dAtA, i, uint64(m.MaxDelegatorsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochBlocks != 0 {
+		i = encodeVarintAutocompound(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardsCompounded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardsCompounded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardsCompounded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAutocompound(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAutocompound(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintAutocompound(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAutocompound(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAutocompound(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochBlocks != 0 {
+		n += 1 + sovAutocompound(uint64(m.EpochBlocks))
+	}
+	if m.MaxDelegatorsPerBlock != 0 {
+		n += 1 + sovAutocompound(uint64(m.MaxDelegatorsPerBlock))
+	}
+	return n
+}
+
+func (m *EventRewardsCompounded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovAutocompound(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAutocompound(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAutocompound(uint64(l))
+	return n
+}
+
+func sovAutocompound(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAutocompound(x uint64) (n int) {
+	return sovAutocompound(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutocompound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutocompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatorsPerBlock", wireType)
+			}
+			m.MaxDelegatorsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutocompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatorsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutocompound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsCompounded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutocompound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsCompounded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsCompounded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutocompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutocompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutocompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutocompound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutocompound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAutocompound(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAutocompound
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutocompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutocompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAutocompound
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAutocompound
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAutocompound
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAutocompound        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAutocompound          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAutocompound = fmt.Errorf("proto: unexpected end of group")
+)
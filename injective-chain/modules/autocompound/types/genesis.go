@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenDelegators := make(map[string]struct{}, len(gs.Delegators))
+	for _, delegator := range gs.Delegators {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return fmt.Errorf("invalid delegator %s: %w", delegator, err)
+		}
+		if _, ok := seenDelegators[delegator]; ok {
+			return fmt.Errorf("duplicate delegator %s", delegator)
+		}
+		seenDelegators[delegator] = struct{}{}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:     DefaultParams(),
+		Delegators: []string{},
+	}
+}
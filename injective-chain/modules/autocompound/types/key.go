@@ -0,0 +1,19 @@
+package types
+
+const (
+	ModuleName = "autocompound"
+	StoreKey   = ModuleName
+)
+
+var (
+	// Keys for store prefixes
+	ParamsKey            = []byte{0x01}
+	DelegatorsKey        = []byte{0x02} // delegator address => opted in
+	CompoundingCursorKey = []byte{0x03} // next delegator to compound in the current pass
+	DelegationCursorKey  = []byte{0x04} // validator after which the delegations of the next delegator resume
+)
+
+// GetDelegatorKey returns the key of a delegator opted in to auto-compounding
+func GetDelegatorKey(delegator []byte) []byte {
+	return append(append([]byte{}, DelegatorsKey...), delegator...)
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Autocompound params default values
+var (
+	// DefaultEpochBlocks represents a compounding pass about once a day
+	DefaultEpochBlocks uint64 = 100000
+	// DefaultMaxDelegatorsPerBlock represents the default number of delegators compounded in a block
+	DefaultMaxDelegatorsPerBlock uint32 = 100
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	epochBlocks uint64,
+	maxDelegatorsPerBlock uint32,
+) Params {
+	return Params{
+		EpochBlocks:           epochBlocks,
+		MaxDelegatorsPerBlock: maxDelegatorsPerBlock,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		EpochBlocks:           DefaultEpochBlocks,
+		MaxDelegatorsPerBlock: DefaultMaxDelegatorsPerBlock,
+	}
+}
+
+// Validate performs basic validation on autocompound parameters.
+func (p Params) Validate() error {
+	if p.EpochBlocks > 0 && p.MaxDelegatorsPerBlock == 0 {
+		return fmt.Errorf("MaxDelegatorsPerBlock must be positive when auto-compounding is enabled")
+	}
+
+	return nil
+}
@@ -0,0 +1,880 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/autocompound/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAutoCompoundParamsRequest is the request type for the
+// Query/AutoCompoundParams RPC method.
+type QueryAutoCompoundParamsRequest struct {
+}
+
+func (m *QueryAutoCompoundParamsRequest) Reset()         { *m = QueryAutoCompoundParamsRequest{} }
+func (m *QueryAutoCompoundParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundParamsRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c28d87e3fdcee2c1, []int{0}
+}
+func (m *QueryAutoCompoundParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundParamsRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundParamsRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundParamsResponse is the response type for the
+// Query/AutoCompoundParams RPC method.
+type QueryAutoCompoundParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryAutoCompoundParamsResponse) Reset()         { *m = QueryAutoCompoundParamsResponse{} }
+func (m *QueryAutoCompoundParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundParamsResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c28d87e3fdcee2c1, []int{1}
+}
+func (m *QueryAutoCompoundParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundParamsResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundParamsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryAutoCompoundDelegatorsRequest is the request type for the
+// Query/AutoCompoundDelegators RPC method.
+type QueryAutoCompoundDelegatorsRequest struct {
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) Reset()         { *m = QueryAutoCompoundDelegatorsRequest{} }
+func (m *QueryAutoCompoundDelegatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegatorsRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c28d87e3fdcee2c1, []int{2}
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegatorsRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundDelegatorsResponse is the response type for the
+// Query/AutoCompoundDelegators RPC method.
+type QueryAutoCompoundDelegatorsResponse struct {
+	Delegators []string `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators,omitempty"`
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Reset()         { *m = QueryAutoCompoundDelegatorsResponse{} }
+func (m *QueryAutoCompoundDelegatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegatorsResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c28d87e3fdcee2c1, []int{3}
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundDelegatorsResponse) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAutoCompoundParamsRequest)(nil), "injective.autocompound.v1beta1.QueryAutoCompoundParamsRequest")
+	proto.RegisterType((*QueryAutoCompoundParamsResponse)(nil), "injective.autocompound.v1beta1.QueryAutoCompoundParamsResponse")
+	proto.RegisterType((*QueryAutoCompoundDelegatorsRequest)(nil), "injective.autocompound.v1beta1.QueryAutoCompoundDelegatorsRequest")
+	proto.RegisterType((*QueryAutoCompoundDelegatorsResponse)(nil), "injective.autocompound.v1beta1.QueryAutoCompoundDelegatorsResponse")
+}
+
+func init() {
+	proto.RegisterFile("injective/autocompound/v1beta1/query.proto", fileDescriptor_c28d87e3fdcee2c1)
+}
+
+var fileDescriptor_c28d87e3fdcee2c1 = []byte{
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x4b, 0xe3, 0x40,
+	0x18, 0xce, 0x6c, 0x77, 0x0b, 0x3b, 0x7b, 0x1b, 0x96, 0xa5, 0x84, 0x65, 0x5a, 0xb2, 0x4b, 0x29,
+	0x65, 0x37, 0x43, 0xbb, 0xf7, 0x15, 0xdb, 0x7a, 0x10, 0x3c, 0x68, 0x8e, 0xde, 0x26, 0xe9, 0x90,
+	0x46, 0x92, 0xbc, 0x69, 0x66, 0x52, 0xe8, 0xd5, 0x5f, 0x20, 0xf8, 0x23, 0xfc, 0x25, 0x42, 0x8f,
+	0x05, 0x11, 0x3c, 0x89, 0xb4, 0xfe, 0x10, 0x69, 0x12, 0xfb, 0x41, 0xb5, 0x41, 0xbd, 0x25, 0xef,
+	0x3c, 0xcf, 0xfb, 0x7c, 0x0c, 0x83, 0x9b, 0x5e, 0x78, 0x26, 0x1c, 0xe5, 0x8d, 0x04, 0xe3, 0x89,
+	0x02, 0x07, 0x82, 0x08, 0x92, 0xb0, 0xcf, 0x46, 0x2d, 0x5b, 0x28, 0xde, 0x62, 0xc3, 0x44, 0xc4,
+	0x63, 0x33, 0x8a, 0x41, 0x01, 0xa1, 0x4b, 0xac, 0xb9, 0x8e, 0x35, 0x73, 0xac, 0xfe, 0xdd, 0x05,
+	0x17, 0x52, 0x28, 0x5b, 0x7c, 0x65, 0x2c, 0xfd, 0xa7, 0x0b, 0xe0, 0xfa, 0x82, 0xf1, 0xc8, 0x63,
+	0x3c, 0x0c, 0x41, 0x71, 0xe5, 0x41, 0x28, 0xf3, 0xd3, 0x56, 0x81, 0xfe, 0x86, 0x50, 0x4a, 0x31,
+	0x6a, 0x98, 0x9e, 0x2c, 0x5c, 0xed, 0x27, 0x0a, 0xba, 0xf9, 0xd1, 0x31, 0x8f, 0x79, 0x20, 0x2d,
+	0x31, 0x4c, 0x84, 0x54, 0x86, 0x8b, 0xab, 0xaf, 0x22, 0x64, 0x04, 0xa1, 0x14, 0xa4, 0x87, 0xcb,
+	0x51, 0x3a, 0xa9, 0xa0, 0x1a, 0x6a, 0x7c, 0x6b, 0xd7, 0xcd, 0xdd, 0xe1, 0xcc, 0x8c, 0xdf, 0xf9,
+	0x3c, 0xb9, 0xaf, 0x6a, 0x56, 0xce, 0x35, 0x7e, 0x63, 0x63, 0x4b, 0xa8, 0x27, 0x7c, 0xe1, 0x72,
+	0x05, 0xf1, 0xd2, 0xce, 0x01, 0xfe, 0xb5, 0x13, 0x95, 0x5b, 0xa2, 0x18, 0xf7, 0x97, 0xd3, 0x0a,
+	0xaa, 0x95, 0x1a, 0x5f, 0xad, 0xb5, 0x49, 0xfb, 0xaa, 0x84, 0xbf, 0xa4, 0x7b, 0xc8, 0x35, 0xc2,
+	0x64, 0x3b, 0x1b, 0xf9, 0x5f, 0x94, 0x61, 0x77, 0x6d, 0xfa, 0xde, 0xbb, 0xf9, 0x59, 0x02, 0xc3,
+	0x3c, 0xbf, 0x79, 0xbc, 0xfc, 0xd4, 0x20, 0x75, 0x56, 0x70, 0xab, 0x59, 0x7d, 0xe4, 0x16, 0xe1,
+	0x1f, 0x2f, 0x97, 0x42, 0x3a, 0x6f, 0xf6, 0xb2, 0xd5, 0xbb, 0xde, 0xfd, 0xd0, 0x8e, 0x3c, 0x53,
+	0x3b, 0xcd, 0xf4, 0x87, 0x34, 0x8b, 0x32, 0xad, 0x6e, 0xaa, 0xe3, 0x4f, 0x66, 0x14, 0x4d, 0x67,
+	0x14, 0x3d, 0xcc, 0x28, 0xba, 0x98, 0x53, 0x6d, 0x3a, 0xa7, 0xda, 0xdd, 0x9c, 0x6a, 0xa7, 0x96,
+	0xeb, 0xa9, 0x41, 0x62, 0x9b, 0x0e, 0x04, 0xec, 0xf0, 0x79, 0xdf, 0x11, 0xb7, 0xe5, 0x6a, 0xfb,
+	0x5f, 0x07, 0x62, 0xb1, 0xfe, 0x3b, 0xe0, 0x5e, 0xc8, 0x02, 0xe8, 0x27, 0xbe, 0x90, 0x9b, 0xd2,
+	0x6a, 0x1c, 0x09, 0x69, 0x97, 0xd3, 0x67, 0xf1, 0xef, 0x69, 0x00, 0x77, 0x3a, 0x4f, 0xb1, 0xcb,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Retrieves autocompound params
+	AutoCompoundParams(ctx context.Context, in *QueryAutoCompoundParamsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundParamsResponse, error)
+	// Retrieves the delegators opted in to auto-compounding
+	AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AutoCompoundParams(ctx context.Context, in *QueryAutoCompoundParamsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundParamsResponse, error) {
+	out := new(QueryAutoCompoundParamsResponse)
+	err := c.cc.Invoke(ctx, "/injective.autocompound.v1beta1.Query/AutoCompoundParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error) {
+	out := new(QueryAutoCompoundDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/injective.autocompound.v1beta1.Query/AutoCompoundDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Retrieves autocompound params
+	AutoCompoundParams(context.Context, *QueryAutoCompoundParamsRequest) (*QueryAutoCompoundParamsResponse, error)
+	// Retrieves the delegators opted in to auto-compounding
+	AutoCompoundDelegators(context.Context, *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AutoCompoundParams(ctx context.Context, req *QueryAutoCompoundParamsRequest) (*QueryAutoCompoundParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundParams not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundDelegators(ctx context.Context, req *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundDelegators not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AutoCompoundParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.autocompound.v1beta1.Query/AutoCompoundParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundParams(ctx, req.(*QueryAutoCompoundParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.autocompound.v1beta1.Query/AutoCompoundDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, req.(*QueryAutoCompoundDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.autocompound.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AutoCompoundParams",
+			Handler:    _Query_AutoCompoundParams_Handler,
+		},
+		{
+			MethodName: "AutoCompoundDelegators",
+			Handler:    _Query_AutoCompoundDelegators_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/autocompound/v1beta1/query.proto",
+}
+
+func (m *QueryAutoCompoundParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAutoCompoundParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAutoCompoundParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAutoCompoundParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundDelegatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundDelegatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,24 @@
+package epochs
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+)
+
+func (am AppModule) BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	metrics.ReportFuncCall(am.svcTags)
+	doneFn := metrics.ReportFuncTiming(am.svcTags)
+	defer doneFn()
+
+	am.keeper.BeginEpochs(ctx)
+}
+
+func (am AppModule) EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock) {
+	metrics.ReportFuncCall(am.svcTags)
+	doneFn := metrics.ReportFuncTiming(am.svcTags)
+	defer doneFn()
+
+	am.keeper.EndEpochs(ctx)
+}
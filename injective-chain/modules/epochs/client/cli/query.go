@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+)
+
+// GetQueryCmd returns the parent command for all modules/epochs CLi query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := cli.ModuleRootCommand(types.ModuleName, true)
+
+	cmd.AddCommand(
+		GetEpochInfosCmd(),
+		GetCurrentEpochCmd(),
+	)
+	return cmd
+}
+
+func GetEpochInfosCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"epoch-infos",
+		"Gets the epochs and the progress of their current epoch",
+		types.NewQueryClient,
+		&types.QueryEpochInfosRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+}
+
+func GetCurrentEpochCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"current-epoch <identifier>",
+		"Gets the current epoch of an epoch identifier",
+		types.NewQueryClient,
+		&types.QueryCurrentEpochRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+}
@@ -0,0 +1,20 @@
+package epochs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for i := range data.Epochs {
+		k.SetEpochInfo(ctx, &data.Epochs[i])
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Epochs: k.GetAllEpochInfos(ctx),
+	}
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+)
+
+// GetEpochInfo returns the epoch info of an epoch identifier, nil if the epoch doesn't exist.
+func (k *Keeper) GetEpochInfo(ctx sdk.Context, identifier string) *types.EpochInfo {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.GetStore(ctx).Get(types.GetEpochInfoKey(identifier))
+	if bz == nil {
+		return nil
+	}
+
+	var info types.EpochInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return &info
+}
+
+// SetEpochInfo stores the epoch info.
+func (k *Keeper) SetEpochInfo(ctx sdk.Context, info *types.EpochInfo) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.GetStore(ctx).Set(types.GetEpochInfoKey(info.Definition.Identifier), k.cdc.MustMarshal(info))
+}
+
+// GetAllEpochInfos returns the infos of all the epochs, ordered by identifier.
+func (k *Keeper) GetAllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	epochStore := prefix.NewStore(k.GetStore(ctx), types.EpochInfoPrefix)
+
+	iterator := epochStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	infos := make([]types.EpochInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.EpochInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// SetEpochDefinition adds an epoch, updates the definition of an existing epoch or removes it if the definition has
+// neither a duration nor a number of blocks. The current epoch of an updated epoch continues, and ends once it lasted
+// the updated duration.
+func (k *Keeper) SetEpochDefinition(ctx sdk.Context, definition types.EpochDefinition) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if definition.IsRemoval() {
+		k.GetStore(ctx).Delete(types.GetEpochInfoKey(definition.Identifier))
+		return
+	}
+
+	info := k.GetEpochInfo(ctx, definition.Identifier)
+	if info == nil {
+		newInfo := types.NewEpochInfo(definition)
+		info = &newInfo
+	}
+
+	info.Definition = definition
+	k.SetEpochInfo(ctx, info)
+}
+
+// BeginEpochs starts the first epoch of the epochs whose start time is reached, and the next epoch of the epochs whose
+// current epoch ended with the previous block, in identifier order. The epochs based on block time start at the end
+// of the previous epoch rather than at the block time, so that they don't drift. After a long halt, they catch up
+// one epoch per block.
+func (k *Keeper) BeginEpochs(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime()
+
+	for _, info := range k.GetAllEpochInfos(ctx) {
+		info := info
+		definition := info.Definition
+
+		if info.HasStarted() && !info.CurrentEpochEnded {
+			continue
+		}
+
+		if !info.HasStarted() && blockTime.Before(definition.StartTime) {
+			continue
+		}
+
+		startTime := blockTime
+		if !definition.IsBlockBased() {
+			if info.HasStarted() {
+				startTime = info.CurrentEpochStartTime.Add(definition.Duration)
+			} else if !definition.StartTime.IsZero() {
+				startTime = definition.StartTime
+			}
+		}
+
+		info.CurrentEpoch++
+		info.CurrentEpochStartTime = startTime
+		info.CurrentEpochStartHeight = ctx.BlockHeight()
+		info.CurrentEpochEnded = false
+		k.SetEpochInfo(ctx, &info)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventEpochStart{
+			Identifier:  definition.Identifier,
+			EpochNumber: info.CurrentEpoch,
+			StartTime:   startTime,
+			StartHeight: info.CurrentEpochStartHeight,
+		})
+
+		k.callHooks(ctx, definition.Identifier, info.CurrentEpoch, func(hooks types.EpochHooks, cacheCtx sdk.Context) error {
+			return hooks.EpochStart(cacheCtx, definition.Identifier, info.CurrentEpoch)
+		})
+	}
+}
+
+// EndEpochs ends the current epoch of the epochs which lasted their duration with this block, in identifier order.
+func (k *Keeper) EndEpochs(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	for _, info := range k.GetAllEpochInfos(ctx) {
+		info := info
+
+		if !info.HasElapsed(ctx.BlockTime(), ctx.BlockHeight()) {
+			continue
+		}
+
+		info.CurrentEpochEnded = true
+		k.SetEpochInfo(ctx, &info)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventEpochEnd{
+			Identifier:  info.Definition.Identifier,
+			EpochNumber: info.CurrentEpoch,
+			EndHeight:   ctx.BlockHeight(),
+		})
+
+		k.callHooks(ctx, info.Definition.Identifier, info.CurrentEpoch, func(hooks types.EpochHooks, cacheCtx sdk.Context) error {
+			return hooks.EpochEnd(cacheCtx, info.Definition.Identifier, info.CurrentEpoch)
+		})
+	}
+}
+
+// callHooks calls the hooks of an epoch boundary in a cached context, which is only written if they succeed, so that a
+// failing subscriber can't halt the chain or leave a partial state.
+func (k *Keeper) callHooks(ctx sdk.Context, identifier string, epochNumber int64, call func(types.EpochHooks, sdk.Context) error) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("epoch hook panicked: %v", r)
+			}
+		}()
+		return call(k.hooks, cacheCtx)
+	}()
+
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("epoch hook failed", "identifier", identifier, "epoch", epochNumber, "err", err)
+		return
+	}
+
+	writeCache()
+}
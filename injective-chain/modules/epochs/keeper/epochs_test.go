@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+)
+
+var failedHookMarkerKey = []byte("failed_hook_marker")
+
+// recordingHooks records the hook calls, and fails the ones of failIdentifier after writing to the store.
+type recordingHooks struct {
+	storeKey       storetypes.StoreKey
+	calls          []string
+	failIdentifier string
+}
+
+func (h *recordingHooks) record(ctx sdk.Context, boundary, identifier string, epochNumber int64) error {
+	if identifier == h.failIdentifier {
+		ctx.KVStore(h.storeKey).Set(failedHookMarkerKey, []byte{1})
+		return errors.New("hook failed")
+	}
+
+	h.calls = append(h.calls, fmt.Sprintf("%s %s#%d@%d", boundary, identifier, epochNumber, ctx.BlockHeight()))
+	return nil
+}
+
+func (h *recordingHooks) EpochStart(ctx sdk.Context, identifier string, epochNumber int64) error {
+	return h.record(ctx, "start", identifier, epochNumber)
+}
+
+func (h *recordingHooks) EpochEnd(ctx sdk.Context, identifier string, epochNumber int64) error {
+	return h.record(ctx, "end", identifier, epochNumber)
+}
+
+func setupEpochs(t *testing.T, hooks *recordingHooks) (*simapp.InjectiveApp, keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	})
+
+	hooks.storeKey = app.GetKey(types.StoreKey)
+	k := keeper.NewKeeper(app.AppCodec(), hooks.storeKey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	k.SetHooks(hooks)
+
+	// remove the default epochs
+	for _, info := range k.GetAllEpochInfos(ctx) {
+		k.SetEpochDefinition(ctx, types.EpochDefinition{Identifier: info.Definition.Identifier})
+	}
+	require.Empty(t, k.GetAllEpochInfos(ctx))
+
+	return app, k, ctx
+}
+
+func processBlock(k keeper.Keeper, ctx sdk.Context, height int64, blockTime time.Time) sdk.Context {
+	ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+	k.BeginEpochs(ctx)
+	k.EndEpochs(ctx)
+	return ctx
+}
+
+func TestBlockBasedEpochBoundaries(t *testing.T) {
+	hooks := &recordingHooks{}
+	_, k, ctx := setupEpochs(t, hooks)
+	k.SetEpochDefinition(ctx, types.EpochDefinition{Identifier: "three_blocks", DurationBlocks: 3})
+
+	startHeight := ctx.BlockHeight()
+	for height := startHeight; height <= startHeight+6; height++ {
+		ctx = processBlock(k, ctx, height, ctx.BlockTime().Add(time.Second))
+	}
+
+	require.Equal(t, []string{
+		fmt.Sprintf("start three_blocks#1@%d", startHeight),
+		fmt.Sprintf("end three_blocks#1@%d", startHeight+2),
+		fmt.Sprintf("start three_blocks#2@%d", startHeight+3),
+		fmt.Sprintf("end three_blocks#2@%d", startHeight+5),
+		fmt.Sprintf("start three_blocks#3@%d", startHeight+6),
+	}, hooks.calls)
+
+	info := k.GetEpochInfo(ctx, "three_blocks")
+	require.Equal(t, int64(3), info.CurrentEpoch)
+	require.Equal(t, startHeight+6, info.CurrentEpochStartHeight)
+	require.False(t, info.CurrentEpochEnded)
+}
+
+func TestTimeBasedEpochBoundaries(t *testing.T) {
+	hooks := &recordingHooks{}
+	_, k, ctx := setupEpochs(t, hooks)
+
+	genesisTime := ctx.BlockTime()
+	startTime := genesisTime.Add(30 * time.Second)
+	k.SetEpochDefinition(ctx, types.EpochDefinition{Identifier: "minute", Duration: time.Minute, StartTime: startTime})
+
+	// a block every 20 seconds
+	startHeight := ctx.BlockHeight()
+	for i := int64(0); i <= 6; i++ {
+		ctx = processBlock(k, ctx, startHeight+i, genesisTime.Add(time.Duration(i)*20*time.Second))
+	}
+
+	require.Equal(t, []string{
+		fmt.Sprintf("start minute#1@%d", startHeight+2),
+		fmt.Sprintf("end minute#1@%d", startHeight+5),
+		fmt.Sprintf("start minute#2@%d", startHeight+6),
+	}, hooks.calls)
+
+	// the second epoch starts at the end of the first one rather than at the block time
+	info := k.GetEpochInfo(ctx, "minute")
+	require.Equal(t, int64(2), info.CurrentEpoch)
+	require.Equal(t, startTime.Add(time.Minute), info.CurrentEpochStartTime)
+
+	res, err := k.CurrentEpoch(sdk.WrapSDKContext(ctx), &types.QueryCurrentEpochRequest{Identifier: "minute"})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.CurrentEpoch)
+}
+
+func TestEpochHooksOrderAndFailures(t *testing.T) {
+	hooks := &recordingHooks{failIdentifier: "b"}
+	_, k, ctx := setupEpochs(t, hooks)
+
+	for _, identifier := range []string{"c", "b", "a"} {
+		k.SetEpochDefinition(ctx, types.EpochDefinition{Identifier: identifier, DurationBlocks: 1})
+	}
+
+	height := ctx.BlockHeight()
+	ctx = processBlock(k, ctx, height, ctx.BlockTime())
+
+	// the epochs are processed in identifier order and the failing hooks don't affect the others
+	require.Equal(t, []string{
+		fmt.Sprintf("start a#1@%d", height),
+		fmt.Sprintf("start c#1@%d", height),
+		fmt.Sprintf("end a#1@%d", height),
+		fmt.Sprintf("end c#1@%d", height),
+	}, hooks.calls)
+
+	// the changes of a failing hook are discarded, but its epoch still advances
+	require.False(t, k.GetStore(ctx).Has(failedHookMarkerKey))
+	require.True(t, k.GetEpochInfo(ctx, "b").CurrentEpochEnded)
+}
+
+func TestSetEpochsRequiresAuthority(t *testing.T) {
+	_, k, ctx := setupEpochs(t, &recordingHooks{})
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	msg := &types.MsgSetEpochs{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Epochs:    []types.EpochDefinition{{Identifier: "day", Duration: 24 * time.Hour}},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	_, err := msgServer.SetEpochs(sdk.WrapSDKContext(ctx), &types.MsgSetEpochs{
+		Authority: authtypes.NewModuleAddress("someone").String(),
+		Epochs:    msg.Epochs,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Nil(t, k.GetEpochInfo(ctx, "day"))
+
+	_, err = msgServer.SetEpochs(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, 24*time.Hour, k.GetEpochInfo(ctx, "day").Definition.Duration)
+
+	invalidMsg := &types.MsgSetEpochs{
+		Authority: msg.Authority,
+		Epochs:    []types.EpochDefinition{{Identifier: "both", Duration: time.Hour, DurationBlocks: 10}},
+	}
+	require.ErrorIs(t, invalidMsg.ValidateBasic(), types.ErrInvalidEpoch)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+	"github.com/InjectiveLabs/metrics"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+func (k *Keeper) EpochInfos(c context.Context, _ *types.QueryEpochInfosRequest) (*types.QueryEpochInfosResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryEpochInfosResponse{
+		Epochs: k.GetAllEpochInfos(ctx),
+	}
+	return res, nil
+}
+
+func (k *Keeper) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info := k.GetEpochInfo(ctx, req.Identifier)
+	if info == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrEpochNotFound.Wrapf("epoch %s", req.Identifier)
+	}
+
+	res := &types.QueryCurrentEpochResponse{
+		CurrentEpoch: info.CurrentEpoch,
+	}
+	return res, nil
+}
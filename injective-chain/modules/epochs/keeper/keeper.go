@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+)
+
+// Keeper of this module maintains the epochs and calls the epoch hooks at their boundaries.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	hooks    types.EpochHooks
+
+	svcTags metrics.Tags
+
+	authority string
+}
+
+// NewKeeper creates new instances of the epochs Keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		authority: authority,
+		svcTags: metrics.Tags{
+			"svc": "epochs_k",
+		},
+	}
+}
+
+// SetHooks sets the hooks called at the epoch boundaries
+func (k *Keeper) SetHooks(h types.EpochHooks) {
+	if k.hooks != nil {
+		panic("cannot set hooks twice")
+	}
+
+	k.hooks = h
+}
+
+func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+func (k *Keeper) GetStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.KVStore(k.storeKey)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"cosmossdk.io/errors"
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/epochs/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "epochs_h",
+		},
+	}
+}
+
+func (k msgServer) SetEpochs(c context.Context, msg *types.MsgSetEpochs) (*types.MsgSetEpochsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	for _, definition := range msg.Epochs {
+		k.SetEpochDefinition(ctx, definition)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventEpochsUpdated{
+		Epochs: msg.Epochs,
+	})
+
+	return &types.MsgSetEpochsResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxEpochIdentifierLength is the maximum length of an epoch identifier
+const MaxEpochIdentifierLength = 64
+
+// IsRemoval returns true if the definition has neither a duration nor a number of blocks, which removes the epoch.
+func (d EpochDefinition) IsRemoval() bool {
+	return d.Duration == 0 && d.DurationBlocks == 0
+}
+
+// IsBlockBased returns true if the epochs last a number of blocks rather than a duration of block time.
+func (d EpochDefinition) IsBlockBased() bool {
+	return d.DurationBlocks > 0
+}
+
+// ValidateBasic checks the definition, a removal is valid.
+func (d EpochDefinition) ValidateBasic() error {
+	if strings.TrimSpace(d.Identifier) == "" {
+		return ErrInvalidEpoch.Wrap("epoch identifier cannot be empty")
+	}
+
+	if len(d.Identifier) > MaxEpochIdentifierLength {
+		return ErrInvalidEpoch.Wrapf("epoch identifier %s exceeds %d characters", d.Identifier, MaxEpochIdentifierLength)
+	}
+
+	if d.Duration < 0 {
+		return ErrInvalidEpoch.Wrapf("epoch %s duration cannot be negative", d.Identifier)
+	}
+
+	if d.DurationBlocks < 0 {
+		return ErrInvalidEpoch.Wrapf("epoch %s duration blocks cannot be negative", d.Identifier)
+	}
+
+	if d.Duration > 0 && d.DurationBlocks > 0 {
+		return ErrInvalidEpoch.Wrapf("epoch %s cannot define both a duration and duration blocks", d.Identifier)
+	}
+
+	return nil
+}
+
+// HasStarted returns true once the first epoch started.
+func (e EpochInfo) HasStarted() bool {
+	return e.CurrentEpoch > 0
+}
+
+// HasElapsed returns true if the current epoch lasted its duration at the given block time and height. A block
+// based epoch started at height H with N blocks ends with the block H+N-1, a time based epoch ends with the first
+// block whose time is past its start time by its duration.
+func (e EpochInfo) HasElapsed(blockTime time.Time, height int64) bool {
+	if !e.HasStarted() || e.CurrentEpochEnded {
+		return false
+	}
+
+	if e.Definition.IsBlockBased() {
+		return height >= e.CurrentEpochStartHeight+e.Definition.DurationBlocks-1
+	}
+
+	return !blockTime.Before(e.CurrentEpochStartTime.Add(e.Definition.Duration))
+}
+
+// Validate checks the epoch info of the genesis state.
+func (e EpochInfo) Validate() error {
+	if err := e.Definition.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if e.Definition.IsRemoval() {
+		return ErrInvalidEpoch.Wrapf("epoch %s must define a duration or duration blocks", e.Definition.Identifier)
+	}
+
+	if e.CurrentEpoch < 0 {
+		return ErrInvalidEpoch.Wrapf("epoch %s current epoch cannot be negative", e.Definition.Identifier)
+	}
+
+	if e.CurrentEpochStartHeight < 0 {
+		return ErrInvalidEpoch.Wrapf("epoch %s current epoch start height cannot be negative", e.Definition.Identifier)
+	}
+
+	return nil
+}
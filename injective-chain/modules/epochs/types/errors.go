@@ -0,0 +1,11 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/epochs module sentinel errors
+var (
+	ErrInvalidEpoch  = errors.Register(ModuleName, 2, "invalid epoch")
+	ErrEpochNotFound = errors.Register(ModuleName, 3, "epoch not found")
+)
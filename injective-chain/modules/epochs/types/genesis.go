@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+func NewGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func (gs GenesisState) Validate() error {
+	seenIdentifiers := make(map[string]struct{}, len(gs.Epochs))
+	for _, epoch := range gs.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenIdentifiers[epoch.Definition.Identifier]; ok {
+			return fmt.Errorf("duplicate epoch %s", epoch.Definition.Identifier)
+		}
+		seenIdentifiers[epoch.Definition.Identifier] = struct{}{}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Epochs: []EpochInfo{
+			NewEpochInfo(EpochDefinition{Identifier: "day", Duration: 24 * time.Hour}),
+			NewEpochInfo(EpochDefinition{Identifier: "hour", Duration: time.Hour}),
+			NewEpochInfo(EpochDefinition{Identifier: "week", Duration: 7 * 24 * time.Hour}),
+		},
+	}
+}
+
+// NewEpochInfo returns the info of an epoch whose first epoch hasn't started yet.
+func NewEpochInfo(definition EpochDefinition) EpochInfo {
+	return EpochInfo{
+		Definition: definition,
+	}
+}
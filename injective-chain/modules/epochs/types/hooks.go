@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks are called by the epochs module at the boundaries of the epochs. EpochEnd is called at the EndBlock of
+// the last block of an epoch and EpochStart at the BeginBlock of the first block of the next epoch.
+type EpochHooks interface {
+	EpochStart(ctx sdk.Context, identifier string, epochNumber int64) error
+	EpochEnd(ctx sdk.Context, identifier string, epochNumber int64) error
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+type MultiEpochHooks []EpochHooks
+
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+func (h MultiEpochHooks) EpochStart(ctx sdk.Context, identifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].EpochStart(ctx, identifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiEpochHooks) EpochEnd(ctx sdk.Context, identifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].EpochEnd(ctx, identifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package types
+
+const (
+	ModuleName = "epochs"
+	StoreKey   = ModuleName
+)
+
+var (
+	// Keys for store prefixes
+	EpochInfoPrefix = []byte{0x01} // epoch identifier => epoch info
+)
+
+// GetEpochInfoKey returns the key of the epoch info of an epoch identifier
+func GetEpochInfoKey(identifier string) []byte {
+	return append(append([]byte{}, EpochInfoPrefix...), []byte(identifier)...)
+}
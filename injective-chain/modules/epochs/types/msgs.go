@@ -0,0 +1,60 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	RouterKey = ModuleName
+
+	TypeMsgSetEpochs = "setEpochs"
+)
+
+var (
+	_ sdk.Msg = &MsgSetEpochs{}
+)
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgSetEpochs) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgSetEpochs) Type() string { return TypeMsgSetEpochs }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgSetEpochs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if len(msg.Epochs) == 0 {
+		return ErrInvalidEpoch.Wrap("epochs cannot be empty")
+	}
+
+	seenIdentifiers := make(map[string]struct{}, len(msg.Epochs))
+	for _, epoch := range msg.Epochs {
+		if err := epoch.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := seenIdentifiers[epoch.Identifier]; ok {
+			return ErrInvalidEpoch.Wrapf("duplicate epoch %s", epoch.Identifier)
+		}
+		seenIdentifiers[epoch.Identifier] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgSetEpochs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgSetEpochs) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
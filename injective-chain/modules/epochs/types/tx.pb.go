@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/epochs/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgSetEpochs struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// epochs defines the epochs to add or update, an epoch without duration and
+	// duration_blocks is removed
+	Epochs []EpochDefinition `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *MsgSetEpochs) Reset()         { *m = MsgSetEpochs{} }
+func (m *MsgSetEpochs) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEpochs) ProtoMessage()    {}
+func (*MsgSetEpochs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce383fc3ed2def4d, []int{0}
+}
+func (m *MsgSetEpochs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEpochs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEpochs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEpochs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEpochs.Merge(m, src)
+}
+func (m *MsgSetEpochs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEpochs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEpochs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEpochs proto.InternalMessageInfo
+
+func (m *MsgSetEpochs) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetEpochs) GetEpochs() []EpochDefinition {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+type MsgSetEpochsResponse struct {
+}
+
+func (m *MsgSetEpochsResponse) Reset()         { *m = MsgSetEpochsResponse{} }
+func (m *MsgSetEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEpochsResponse) ProtoMessage()    {}
+func (*MsgSetEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce383fc3ed2def4d, []int{1}
+}
+func (m *MsgSetEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEpochsResponse.Merge(m, src)
+}
+func (m *MsgSetEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEpochsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetEpochs)(nil), "injective.epochs.v1beta1.MsgSetEpochs")
+	proto.RegisterType((*MsgSetEpochsResponse)(nil), "injective.epochs.v1beta1.MsgSetEpochsResponse")
+}
+
+func init() { proto.RegisterFile("injective/epochs/v1beta1/tx.proto", fileDescriptor_ce383fc3ed2def4d) }
+
+var fileDescriptor_ce383fc3ed2def4d = []byte{
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x13, 0x2b, 0x85, 0xae, 0xe2, 0x21, 0x14, 0x8d, 0x3d, 0xc4, 0x5a, 0x50, 0xaa, 0xd0,
+	0x5d, 0x5a, 0xc1, 0x83, 0x37, 0x8b, 0x22, 0x42, 0x7b, 0x69, 0x6f, 0x5e, 0x24, 0x49, 0xb7, 0x9b,
+	0x2d, 0x26, 0x13, 0x32, 0xdb, 0x62, 0xaf, 0x3e, 0x81, 0x6f, 0xe0, 0x2b, 0x78, 0xf0, 0x21, 0x7a,
+	0x2c, 0x9e, 0x3c, 0x89, 0xb4, 0x07, 0x5f, 0x43, 0x9a, 0xa4, 0x7f, 0x2e, 0x01, 0x4f, 0xbb, 0xb3,
+	0xf3, 0x9b, 0xef, 0xfb, 0x96, 0x21, 0xc7, 0x32, 0x18, 0x70, 0x57, 0xc9, 0x11, 0x67, 0x3c, 0x04,
+	0xd7, 0x43, 0x36, 0xaa, 0x3b, 0x5c, 0xd9, 0x75, 0xa6, 0x9e, 0x69, 0x18, 0x81, 0x02, 0xc3, 0x5c,
+	0x21, 0x34, 0x41, 0x68, 0x8a, 0x94, 0x8a, 0x02, 0x04, 0xc4, 0x10, 0x5b, 0xdc, 0x12, 0xbe, 0x74,
+	0xe0, 0x02, 0xfa, 0x80, 0xcc, 0x47, 0xc1, 0x46, 0xf5, 0xc5, 0x91, 0x36, 0x0e, 0x93, 0xc6, 0x63,
+	0x32, 0x91, 0x14, 0x69, 0xeb, 0x24, 0x33, 0x46, 0x6a, 0x19, 0x63, 0x95, 0x37, 0x9d, 0xec, 0xb6,
+	0x51, 0x74, 0xb9, 0xba, 0x8d, 0x9f, 0x8d, 0x4b, 0x52, 0xb0, 0x87, 0xca, 0x83, 0x48, 0xaa, 0xb1,
+	0xa9, 0x97, 0xf5, 0x6a, 0xa1, 0x69, 0x7e, 0x7e, 0xd4, 0x8a, 0xa9, 0xf8, 0x75, 0xaf, 0x17, 0x71,
+	0xc4, 0xae, 0x8a, 0x64, 0x20, 0x3a, 0x6b, 0xd4, 0xb8, 0x23, 0xf9, 0x44, 0xd8, 0xdc, 0x2a, 0xe7,
+	0xaa, 0x3b, 0x8d, 0x33, 0x9a, 0xf5, 0x49, 0x1a, 0x3b, 0xdd, 0xf0, 0xbe, 0x0c, 0xa4, 0x92, 0x10,
+	0x34, 0xb7, 0x27, 0xdf, 0x47, 0x5a, 0x27, 0x1d, 0xbf, 0xda, 0x7b, 0xf9, 0x7d, 0x3f, 0x5f, 0x0b,
+	0x57, 0xf6, 0x49, 0x71, 0x33, 0x60, 0x87, 0x63, 0x08, 0x01, 0xf2, 0xc6, 0x80, 0xe4, 0xda, 0x28,
+	0x0c, 0x97, 0x14, 0xd6, 0xe1, 0x4f, 0xb3, 0x4d, 0x37, 0x35, 0x4a, 0xf4, 0x7f, 0xdc, 0xd2, 0xab,
+	0xd9, 0x9f, 0xcc, 0x2c, 0x7d, 0x3a, 0xb3, 0xf4, 0x9f, 0x99, 0xa5, 0xbf, 0xce, 0x2d, 0x6d, 0x3a,
+	0xb7, 0xb4, 0xaf, 0xb9, 0xa5, 0x3d, 0xb4, 0x84, 0x54, 0xde, 0xd0, 0xa1, 0x2e, 0xf8, 0xec, 0x7e,
+	0xa9, 0xd9, 0xb2, 0x1d, 0x64, 0x2b, 0x87, 0x9a, 0x0b, 0x11, 0xdf, 0x2c, 0x3d, 0x5b, 0x06, 0xcc,
+	0x87, 0xde, 0xf0, 0x89, 0xe3, 0x72, 0x39, 0x6a, 0x1c, 0x72, 0x74, 0xf2, 0xf1, 0x52, 0x2e, 0xfe,
+	0x06, 0x00, 0x0f, 0x67, 0x84, 0xbe, 0x44, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetEpochs defines a governance operation for adding, updating or
+	// removing epochs
+	SetEpochs(ctx context.Context, in *MsgSetEpochs, opts ...grpc.CallOption) (*MsgSetEpochsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetEpochs(ctx context.Context, in *MsgSetEpochs, opts ...grpc.CallOption) (*MsgSetEpochsResponse, error) {
+	out := new(MsgSetEpochsResponse)
+	err := c.cc.Invoke(ctx, "/injective.epochs.v1beta1.Msg/SetEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetEpochs defines a governance operation for adding, updating or
+	// removing epochs
+	SetEpochs(context.Context, *MsgSetEpochs) (*MsgSetEpochsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetEpochs(ctx context.Context, req *MsgSetEpochs) (*MsgSetEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEpochs not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEpochs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.epochs.v1beta1.Msg/SetEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEpochs(ctx, req.(*MsgSetEpochs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetEpochs",
+			Handler:    _Msg_SetEpochs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/epochs/v1beta1/tx.proto",
+}
+
+func (m *MsgSetEpochs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEpochs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEpochs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetEpochs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetEpochs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEpochs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochDefinition{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
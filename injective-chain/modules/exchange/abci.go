@@ -42,6 +42,7 @@ func (h *BlockHandler) BeginBlocker(ctx sdk.Context) {
 	h.k.ProcessBinaryOptionsMarketsToExpireAndSettle(ctx)
 	h.k.ProcessTradingRewards(ctx)
 	h.k.ProcessFeeDiscountBuckets(ctx)
+	h.k.ProcessTradingScheduleClosures(ctx)
 
 	if ctx.BlockHeight()%100000 == 0 {
 		h.k.CleanupHistoricalTradeRecords(ctx)
@@ -57,6 +58,12 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 	ctx = ctx.WithGasMeter(chaintypes.NewThreadsafeInfiniteGasMeter()).
 		WithBlockGasMeter(chaintypes.NewThreadsafeInfiniteGasMeter())
 
+	// cancel expired resting limit orders before matching so they can no longer be filled
+	h.k.ProcessExpiredOrders(ctx)
+
+	// NOTE: the markets of every stage are processed and persisted in the ascending order of their market ID: the indicators
+	// are iterated from stores keyed by market ID and the results of the parallel matching are indexed by that order.
+
 	/** =========== Stage 1: Process all orders in parallel =========== */
 
 	// Process Conditional Market orders first
@@ -273,6 +280,8 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 	h.k.PersistPerpetualFundingInfo(ctx, derivativeVwapData)
 	h.k.PersistTradingRewardPoints(ctx, tradingRewards)
 	h.k.PersistFeeDiscountStakingInfoUpdates(ctx, stakingInfo)
+	h.k.PersistRollingTradeVolume(ctx)
+	h.k.ProcessMakerRebates(ctx)
 
 	/** =========== Stage 6: Process Spot Market Param Updates if any =========== */
 	h.k.IterateSpotMarketParamUpdates(ctx, func(p *types.SpotMarketParamUpdateProposal) (stop bool) {
@@ -307,7 +316,19 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 		return false
 	})
 
-	/** =========== Stage 10: Emit Deposit, Position and Orderbook Update Events =========== */
+	/** =========== Stage 10: Auto-deleverage underwater positions which couldn't be liquidated =========== */
+	h.k.ProcessAutoDeleveraging(ctx)
+
+	/** =========== Stage 11: Auto-delist the markets which stayed inactive =========== */
+	h.k.ProcessInactiveMarkets(ctx)
+
+	/** =========== Stage 12: Prune the order histories past their retention =========== */
+	h.k.PruneOrderHistories(ctx)
+
+	/** =========== Stage 13: Sweep the dust deposits =========== */
+	h.k.SweepDustDeposits(ctx)
+
+	/** =========== Stage 14: Emit Deposit, Position and Orderbook Update Events =========== */
 	h.k.EmitAllTransientDepositUpdates(ctx)
 	h.k.EmitAllTransientPositionUpdates(ctx)
 	h.k.IncrementSequenceAndEmitAllTransientOrderbookUpdates(ctx)
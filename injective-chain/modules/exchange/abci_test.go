@@ -0,0 +1,319 @@
+package exchange_test
+
+import (
+	"sort"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("EndBlocker ordering", func() {
+	const marketCount = 4
+
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+	)
+
+	buyer := testexchange.SampleSubaccountAddr1
+	seller := testexchange.SampleSubaccountAddr2
+
+	// runEndBlocker runs the EndBlocker on a branch of the state, returning its events and the resulting exchange store
+	runEndBlocker := func() ([]abci.Event, [][2][]byte) {
+		branchCtx, _ := ctx.CacheContext()
+		exchange.NewBlockHandler(app.ExchangeKeeper).EndBlocker(branchCtx)
+
+		store := branchCtx.KVStore(app.GetKey(types.StoreKey))
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+
+		entries := make([][2][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			entries = append(entries, [2][]byte{iterator.Key(), iterator.Value()})
+		}
+
+		return branchCtx.EventManager().ABCIEvents(), entries
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, marketCount, 0, 0)
+		msgServer := keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		for marketIndex := 0; marketIndex < marketCount; marketIndex++ {
+			spot := testInput.Spots[marketIndex]
+			_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, spot.Ticker, spot.BaseDenom, spot.QuoteDenom, spot.MinPriceTickSize, spot.MinQuantityTickSize)
+			testexchange.OrFail(err)
+		}
+		testInput.AddSpotDepositsForSubaccounts(app, ctx, marketCount, nil, []common.Hash{buyer, seller})
+
+		// crossing orders in every market, so that each market is matched in the same EndBlocker
+		for marketIndex := 0; marketIndex < marketCount; marketIndex++ {
+			msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(marketIndex,
+				testexchange.NewBareSpotLimitOrderFromString("10.1", "3", types.OrderType_BUY, buyer),
+				testexchange.NewBareSpotLimitOrderFromString("10", "2", types.OrderType_SELL, seller),
+				testexchange.NewBareSpotLimitOrderFromString("9.9", "2", types.OrderType_SELL, seller),
+			)
+			for _, msg := range msgs {
+				_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msg)
+				testexchange.OrFail(err)
+			}
+		}
+	})
+
+	It("produces identical events and state for identical multi-market state", func() {
+		events, entries := runEndBlocker()
+		Expect(events).ToNot(BeEmpty())
+
+		for i := 0; i < 5; i++ {
+			otherEvents, otherEntries := runEndBlocker()
+			Expect(otherEvents).To(Equal(events))
+			Expect(otherEntries).To(Equal(entries))
+		}
+	})
+})
+
+var _ = Describe("BeginBlocker ordering", func() {
+	const marketCount = 3
+
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+
+		perpetualMarketIDs     []string
+		expiryMarketIDs        []string
+		binaryOptionsMarketIDs []string
+	)
+
+	// sortedDescending returns the indexes of the market IDs, from the highest market ID to the lowest
+	sortedDescending := func(marketIDs []common.Hash) []int {
+		indexes := make([]int, len(marketIDs))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		sort.Slice(indexes, func(i, j int) bool {
+			return marketIDs[indexes[i]].Hex() > marketIDs[indexes[j]].Hex()
+		})
+		return indexes
+	}
+
+	sorted := func(marketIDs []string) []string {
+		sortedIDs := append([]string{}, marketIDs...)
+		sort.Strings(sortedIDs)
+		return sortedIDs
+	}
+
+	// advanceOraclePrices keeps the derivative oracle prices unchanged while accumulating them up to the block time
+	advanceOraclePrices := func() {
+		for _, market := range testInput.Perps {
+			priceState := app.OracleKeeper.GetPriceFeedPriceState(ctx, market.OracleBase, market.OracleQuote)
+			priceState.UpdatePrice(priceState.Price, ctx.BlockTime().Unix())
+			app.OracleKeeper.SetPriceFeedPriceState(ctx, market.OracleBase, market.OracleQuote, priceState)
+		}
+		for _, market := range testInput.ExpiryMarkets {
+			priceState := app.OracleKeeper.GetPriceFeedPriceState(ctx, market.OracleBase, market.OracleQuote)
+			priceState.UpdatePrice(priceState.Price, ctx.BlockTime().Unix())
+			app.OracleKeeper.SetPriceFeedPriceState(ctx, market.OracleBase, market.OracleQuote, priceState)
+		}
+	}
+
+	createInsuranceFund := func(ticker, quoteDenom, oracleBase, oracleQuote string, oracleType oracletypes.OracleType, expiry int64) {
+		sender := testexchange.SampleAccountAddr1
+		coin := sdk.NewCoin(quoteDenom, sdk.OneInt())
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, coin, ticker, quoteDenom, oracleBase, oracleQuote, oracleType, expiry))
+	}
+
+	storeEntries := func(ctx sdk.Context) [][2][]byte {
+		store := ctx.KVStore(app.GetKey(types.StoreKey))
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+
+		entries := make([][2][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			entries = append(entries, [2][]byte{iterator.Key(), iterator.Value()})
+		}
+		return entries
+	}
+
+	// runBeginBlocker runs the BeginBlocker on a branch of the state, returning the market IDs of its funding, expiry
+	// futures settlement and binary options expiry and settlement events in their order, and the branched context
+	runBeginBlocker := func() ([]string, sdk.Context) {
+		branchCtx, _ := ctx.CacheContext()
+		exchange.NewBlockHandler(app.ExchangeKeeper).BeginBlocker(branchCtx)
+
+		marketEvents := make([]string, 0)
+		for _, event := range branchCtx.EventManager().ABCIEvents() {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			switch e := typedEvent.(type) {
+			case *types.EventPerpetualMarketFundingUpdate:
+				marketEvents = append(marketEvents, "funding "+e.MarketId)
+			case *types.EventExpiryFuturesMarketUpdate:
+				marketEvents = append(marketEvents, e.Market.Status.String()+" "+e.Market.MarketId)
+			case *types.EventBinaryOptionsMarketUpdate:
+				marketEvents = append(marketEvents, e.Market.Status.String()+" "+e.Market.MarketId)
+			}
+		}
+
+		return marketEvents, branchCtx
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, marketCount, marketCount)
+
+		startTime := ctx.BlockTime().Unix()
+		twapStartTime := startTime + 2*60*60
+		settlementBlockTime := twapStartTime + 60*60
+
+		perpetualIDs := make([]common.Hash, marketCount)
+		for i, market := range testInput.Perps {
+			perpetualIDs[i] = market.MarketID
+		}
+
+		// the expiry futures markets are collected in the order of their expiry, which isn't the order of their market IDs
+		expiryIDs := make([]common.Hash, marketCount)
+		expiries := make([]int64, marketCount)
+		expiryOrder := make([]string, marketCount)
+		for i, market := range testInput.ExpiryMarkets {
+			expiries[i] = twapStartTime + testexchange.ThirtyMinutesInSeconds + int64(i)*60
+			expiryIDs[i] = types.NewExpiryFuturesMarketID(market.Ticker, market.QuoteDenom, market.OracleBase, market.OracleQuote, market.OracleType, expiries[i])
+			expiryOrder[i] = expiryIDs[i].Hex()
+		}
+		Expect(expiryOrder).ToNot(Equal(sorted(expiryOrder)))
+
+		binaryOptionsIDs := make([]common.Hash, marketCount)
+		for i, market := range testInput.BinaryMarkets {
+			binaryOptionsIDs[i] = market.MarketID
+		}
+
+		testexchange.OrFail(app.OracleKeeper.SetProviderInfo(ctx, &oracletypes.ProviderInfo{
+			Provider: testInput.BinaryMarkets[0].OracleProvider,
+			Relayers: []string{testexchange.DefaultAddress},
+		}))
+
+		// launch the markets from the highest market ID to the lowest
+		perpetualMarketIDs = make([]string, 0, marketCount)
+		for _, i := range sortedDescending(perpetualIDs) {
+			market := testInput.Perps[i]
+			app.OracleKeeper.SetPriceFeedPriceState(ctx, market.OracleBase, market.OracleQuote, oracletypes.NewPriceState(sdk.NewDec(int64(1000*(i+1))), startTime))
+			createInsuranceFund(market.Ticker, market.QuoteDenom, market.OracleBase, market.OracleQuote, market.OracleType, -1)
+
+			_, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(ctx, market.Ticker, market.QuoteDenom, market.OracleBase, market.OracleQuote, 0, market.OracleType,
+				market.InitialMarginRatio, market.MaintenanceMarginRatio, market.MakerFeeRate, market.TakerFeeRate, market.MinPriceTickSize, market.MinQuantityTickSize)
+			testexchange.OrFail(err)
+			perpetualMarketIDs = append(perpetualMarketIDs, market.MarketID.Hex())
+		}
+
+		expiryMarketIDs = make([]string, 0, marketCount)
+		for _, i := range sortedDescending(expiryIDs) {
+			market := testInput.ExpiryMarkets[i]
+			app.OracleKeeper.SetPriceFeedPriceState(ctx, market.OracleBase, market.OracleQuote, oracletypes.NewPriceState(sdk.NewDec(int64(100*(i+1))), startTime))
+			createInsuranceFund(market.Ticker, market.QuoteDenom, market.OracleBase, market.OracleQuote, market.OracleType, expiries[i])
+
+			_, _, err := app.ExchangeKeeper.ExpiryFuturesMarketLaunch(ctx, market.Ticker, market.QuoteDenom, market.OracleBase, market.OracleQuote, 0, market.OracleType, expiries[i],
+				market.InitialMarginRatio, market.MaintenanceMarginRatio, market.MakerFeeRate, market.TakerFeeRate, market.MinPriceTickSize, market.MinQuantityTickSize)
+			testexchange.OrFail(err)
+			expiryMarketIDs = append(expiryMarketIDs, expiryIDs[i].Hex())
+		}
+
+		// the binary options markets expire and settle from the highest market ID to the lowest
+		binaryOptionsMarketIDs = make([]string, 0, marketCount)
+		for rank, i := range sortedDescending(binaryOptionsIDs) {
+			market := testInput.BinaryMarkets[i]
+			expiration := twapStartTime + 10*60 + int64(rank)*60
+			app.OracleKeeper.SetProviderPriceState(ctx, market.OracleProvider, oracletypes.NewProviderPriceState(market.OracleSymbol, sdk.NewDecWithPrec(int64(i+1), 1), startTime))
+
+			_, err := app.ExchangeKeeper.BinaryOptionsMarketLaunch(ctx, market.Ticker, market.OracleSymbol, market.OracleProvider, oracletypes.OracleType_Provider, market.OracleScaleFactor,
+				market.MakerFeeRate, market.TakerFeeRate, expiration, expiration+30, "", market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+			testexchange.OrFail(err)
+			binaryOptionsMarketIDs = append(binaryOptionsMarketIDs, market.MarketID.Hex())
+		}
+
+		// start the TWAP of the expiry futures markets, then move to the block settling every market
+		ctx = ctx.WithBlockTime(time.Unix(expiries[marketCount-1]-testexchange.ThirtyMinutesInSeconds, 0))
+		advanceOraclePrices()
+		exchange.NewBlockHandler(app.ExchangeKeeper).BeginBlocker(ctx)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		ctx = ctx.WithBlockTime(time.Unix(settlementBlockTime, 0))
+		advanceOraclePrices()
+	})
+
+	It("processes the fundings, settlements and expiries in the order of the market IDs", func() {
+		marketEvents, branchCtx := runBeginBlocker()
+
+		expectedEvents := make([]string, 0)
+		for _, marketID := range sorted(perpetualMarketIDs) {
+			expectedEvents = append(expectedEvents, "funding "+marketID)
+		}
+		for _, marketID := range sorted(expiryMarketIDs) {
+			expectedEvents = append(expectedEvents, types.MarketStatus_Expired.String()+" "+marketID)
+		}
+		for _, marketID := range sorted(binaryOptionsMarketIDs) {
+			expectedEvents = append(expectedEvents, types.MarketStatus_Expired.String()+" "+marketID)
+		}
+		for _, marketID := range sorted(binaryOptionsMarketIDs) {
+			expectedEvents = append(expectedEvents, types.MarketStatus_Demolished.String()+" "+marketID)
+		}
+		Expect(marketEvents).To(Equal(expectedEvents))
+
+		for _, marketID := range perpetualMarketIDs {
+			marketInfo := app.ExchangeKeeper.GetPerpetualMarketInfo(ctx, common.HexToHash(marketID))
+			fundedMarketInfo := app.ExchangeKeeper.GetPerpetualMarketInfo(branchCtx, common.HexToHash(marketID))
+			Expect(fundedMarketInfo.NextFundingTimestamp).To(Equal(marketInfo.NextFundingTimestamp + marketInfo.FundingInterval))
+			Expect(app.ExchangeKeeper.GetPerpetualMarketFunding(branchCtx, common.HexToHash(marketID)).LastTimestamp).To(Equal(marketInfo.NextFundingTimestamp))
+		}
+
+		for _, marketID := range expiryMarketIDs {
+			Expect(app.ExchangeKeeper.GetDerivativeMarketByID(branchCtx, common.HexToHash(marketID)).Status).To(Equal(types.MarketStatus_Expired))
+			Expect(app.ExchangeKeeper.GetExpiryFuturesMarketInfo(branchCtx, common.HexToHash(marketID))).To(BeNil())
+		}
+
+		for _, marketID := range binaryOptionsMarketIDs {
+			market := app.ExchangeKeeper.GetBinaryOptionsMarketByID(branchCtx, common.HexToHash(marketID))
+			oraclePrice := app.OracleKeeper.GetProviderPrice(branchCtx, market.OracleProvider, market.OracleSymbol)
+			Expect(market.Status).To(Equal(types.MarketStatus_Demolished))
+			Expect(market.SettlementPrice.String()).To(Equal(types.GetScaledPrice(*oraclePrice, market.OracleScaleFactor).String()))
+		}
+	})
+
+	It("produces identical events and state for identical multi-market state", func() {
+		marketEvents, branchCtx := runBeginBlocker()
+		entries := storeEntries(branchCtx)
+
+		for i := 0; i < 5; i++ {
+			otherMarketEvents, otherBranchCtx := runBeginBlocker()
+			Expect(otherMarketEvents).To(Equal(marketEvents))
+			Expect(storeEntries(otherBranchCtx)).To(Equal(entries))
+		}
+	})
+})
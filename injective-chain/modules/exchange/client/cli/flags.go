@@ -28,6 +28,11 @@ const (
 	FlagRelayerFeeShareRate      = "relayer-fee-share-rate"
 	FlagHourlyInterestRate       = "hourly-interest-rate"
 	FlagHourlyFundingRateCap     = "hourly-funding-rate-cap"
+	FlagMaxOpenInterest          = "max-open-interest"
+	FlagMaxPositionSize          = "max-position-size"
+	FlagPriceBandRatio           = "price-band-ratio"
+	FlagMaxLeverage              = "max-leverage"
+	FlagMaxSlippageRatio         = "max-slippage-ratio"
 	FlagMinPriceTickSize         = "min-price-tick-size"
 	FlagMinQuantityTickSize      = "min-quantity-tick-size"
 	FlagMarketStatus             = "market-status"
@@ -51,4 +56,6 @@ const (
 	FlagSubscriptionMaxPenalty   = "max-penalty"
 	FlagSubscriptionMinIncentive = "min-incentive"
 	FlagFunds                    = "funds"
+	FlagCancelOrdersAtClose      = "cancel-orders-at-close"
+	FlagExempt                   = "exempt"
 )
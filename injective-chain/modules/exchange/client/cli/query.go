@@ -29,10 +29,19 @@ func GetQueryCmd() *cobra.Command {
 		GetExchangeParamsCmd(),
 		GetSubaccountDeposits(),
 		GetSubaccountDeposit(),
+		GetSubaccountBalancesCmd(),
 		GetEthAddressFromInjAddressCmd(),
 		GetInjAddressFromEthAddressCmd(),
 		GetSubaccountIDFromInjAddressCmd(),
 		GetAllBinaryOptionsMarketsCmd(),
+		GetFeeDiscountScheduleCmd(),
+		GetFeeDiscountAccountInfoCmd(),
+		GetMarketTradingScheduleCmd(),
+		GetProtocolStatsCmd(),
+		GetOrderHistoryCmd(),
+		GetSubaccountMarginModeCmd(),
+		GetSubaccountFundingHistoryCmd(),
+		GetLiquidationPriceCmd(),
 	)
 	return cmd
 }
@@ -335,3 +344,96 @@ func GetAllBinaryOptionsMarketsCmd() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetSubaccountBalancesCmd queries the deposits of several subaccounts
+func GetSubaccountBalancesCmd() *cobra.Command {
+	cmd := cli.QueryCmd("subaccount-balances <subaccount_ids>",
+		"Gets the deposits of several subaccounts",
+		types.NewQueryClient,
+		&types.QuerySubaccountBalancesRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the deposits of up to 100 comma-separated subaccounts in a single query. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
+
+// GetFeeDiscountScheduleCmd queries the fee discount schedule
+func GetFeeDiscountScheduleCmd() *cobra.Command {
+	cmd := cli.QueryCmd("fee-discount-schedule",
+		"Gets the fee discount schedule",
+		types.NewQueryClient,
+		&types.QueryFeeDiscountScheduleRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the fee discount schedule with all its tiers. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
+
+// GetFeeDiscountAccountInfoCmd queries the fee discount tier of an account
+func GetFeeDiscountAccountInfoCmd() *cobra.Command {
+	cmd := cli.QueryCmd("fee-discount-account-info <account>",
+		"Gets the fee discount tier of an account",
+		types.NewQueryClient,
+		&types.QueryFeeDiscountAccountInfoRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the current fee discount tier of an account along with its discount rates, staked amount and trading volume. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
+
+// GetMarketTradingScheduleCmd queries the trading hours of a market
+func GetMarketTradingScheduleCmd() *cobra.Command {
+	cmd := cli.QueryCmd("trading-schedule <market_id>",
+		"Gets the trading hours of a market",
+		types.NewQueryClient,
+		&types.QueryMarketTradingScheduleRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the trading hours schedule of a market and whether it accepts orders at the current block time. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
+
+// GetProtocolStatsCmd queries the chain-wide stats of the exchange
+func GetProtocolStatsCmd() *cobra.Command {
+	cmd := cli.QueryCmd("protocol-stats",
+		"Gets the chain-wide stats of the exchange",
+		types.NewQueryClient,
+		&types.QueryProtocolStatsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the number of markets and open orders, the total deposits per denom and the trade volume per quote denom of the last 24 hours. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
+
+// GetOrderHistoryCmd queries the lifecycle history of a limit order
+func GetOrderHistoryCmd() *cobra.Command {
+	cmd := cli.QueryCmd("order-history <order_hash>",
+		"Gets the lifecycle history of a limit order",
+		types.NewQueryClient,
+		&types.QueryOrderHistoryRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the placement, the fills and the terminal event of a limit order. The history is deleted some blocks after the order is filled, cancelled or expired, as set by the order_history_retention_blocks param. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
+
+// GetSubaccountMarginModeCmd queries the margin mode of a subaccount
+func GetSubaccountMarginModeCmd() *cobra.Command {
+	cmd := cli.QueryCmd("subaccount-margin-mode <subaccount_id>",
+		"Gets the margin mode of a subaccount",
+		types.NewQueryClient,
+		&types.QuerySubaccountMarginModeRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets whether the positions of a subaccount are margined in isolation or share the available balance of the subaccount as cross margin. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
+
+// GetSubaccountFundingHistoryCmd queries the funding payments of the positions of a subaccount
+func GetSubaccountFundingHistoryCmd() *cobra.Command {
+	cmd := cli.QueryCmd("subaccount-funding-history <subaccount_id>",
+		"Gets the funding payments of the positions of a subaccount",
+		types.NewQueryClient,
+		&types.QuerySubaccountFundingHistoryRequest{}, cli.FlagsMapping{
+			"MarketId": cli.Flag{Flag: FlagMarketID},
+		}, cli.ArgsMapping{})
+	cmd.Long = "Gets the funding payments of the positions of a subaccount, oldest first, with the funding rate and the amount received, negative when paid. Only the last payments are kept for each market, as set by the subaccount_funding_history_size param. If the height is not provided, it will use the latest height from context."
+	cmd.Flags().String(FlagMarketID, "", "filter by perpetual market ID")
+	return cmd
+}
+
+// GetLiquidationPriceCmd queries the liquidation price of the position of a subaccount in a derivative market
+func GetLiquidationPriceCmd() *cobra.Command {
+	cmd := cli.QueryCmd("liquidation-price <subaccount_id> <market_id>",
+		"Gets the liquidation price of the position of a subaccount in a derivative market",
+		types.NewQueryClient,
+		&types.QueryLiquidationPriceRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the mark price at which the position of a subaccount in a derivative market becomes liquidatable on its own margin, at or below it for a long position and at or above it for a short position. Long positions funded enough to never become liquidatable have no liquidation price. If the height is not provided, it will use the latest height from context."
+	return cmd
+}
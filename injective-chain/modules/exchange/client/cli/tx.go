@@ -63,12 +63,16 @@ func NewTxCmd() *cobra.Command {
 		FeeDiscountProposalTxCmd(),
 		BatchCommunityPoolSpendProposalTxCmd(),
 		NewAtomicMarketOrderFeeMultiplierScheduleProposalTxCmd(),
+		NewTradingScheduleProposalTxCmd(),
+		NewMarketDelistingExemptionProposalTxCmd(),
 		// account
 		NewDepositTxCmd(),
 		NewWithdrawTxCmd(),
 		NewSubaccountTransferTxCmd(),
 		NewExternalTransferTxCmd(),
 		NewRewardsOptOutTxCmd(),
+		NewSetMarginModeTxCmd(),
+		NewTransferPositionTxCmd(),
 		// mito
 		NewSubscribeToSpotVaultTxCmd(),
 		NewRedeemFromSpotVaultTxCmd(),
@@ -110,7 +114,10 @@ func NewCreateSpotLimitOrderTxCmd() *cobra.Command {
 		"create-spot-limit-order <order_type> <market_ticker> <quantity> <price>",
 		"Create Spot Limit Order",
 		&types.MsgCreateSpotLimitOrder{},
-		cli.FlagsMapping{"TriggerPrice": cli.SkipField}, // disable parsing of trigger price
+		cli.FlagsMapping{
+			"TriggerPrice":     cli.SkipField, // disable parsing of trigger price
+			"MaxSlippageRatio": cli.SkipField, // only used by market orders
+		},
 		cli.ArgsMapping{
 			"OrderType": cli.Arg{
 				Index: 0,
@@ -147,7 +154,10 @@ func NewCreateSpotMarketOrderTxCmd() *cobra.Command {
 		"create-spot-market-order <order_type> <market_ticker> <quantity> <worst_price>",
 		"Create Spot Market Order",
 		&types.MsgCreateSpotMarketOrder{},
-		cli.FlagsMapping{"TriggerPrice": cli.SkipField}, // disable parsing of trigger price
+		cli.FlagsMapping{
+			"TriggerPrice":     cli.SkipField, // disable parsing of trigger price
+			"MaxSlippageRatio": cli.Flag{Flag: FlagMaxSlippageRatio},
+		},
 		cli.ArgsMapping{
 			"OrderType": cli.Arg{
 				Index: 0,
@@ -170,6 +180,7 @@ func NewCreateSpotMarketOrderTxCmd() *cobra.Command {
 		},
 	)
 	cmd.Example = "injectived tx exchange create-spot-limit-order buy ETH/USDT 2.4 2000.1 --from=genesis --keyring-backend=file --yes"
+	cmd.Flags().String(FlagMaxSlippageRatio, "", "Max relative distance of the execution price from the top of the book, tightening the default of the market")
 	return cmd
 }
 
@@ -203,7 +214,8 @@ func NewCreateDerivativeLimitOrderTxCmd() *cobra.Command {
 		"Create Derivative Limit Order",
 		&types.MsgCreateDerivativeLimitOrder{},
 		cli.FlagsMapping{
-			"TriggerPrice": cli.SkipField, // disable parsing of trigger price
+			"TriggerPrice":     cli.SkipField, // disable parsing of trigger price
+			"MaxSlippageRatio": cli.SkipField, // only used by market orders
 			"OrderType": cli.Flag{
 				Flag: FlagOrderType,
 				Transform: func(orig string, ctx grpc.ClientConn) (any, error) {
@@ -261,7 +273,8 @@ func NewCreateDerivativeMarketOrderTxCmd() *cobra.Command {
 		"Create Derivative Market Order",
 		&types.MsgCreateDerivativeMarketOrder{},
 		cli.FlagsMapping{
-			"TriggerPrice": cli.SkipField, // disable parsing of trigger price
+			"TriggerPrice":     cli.SkipField, // disable parsing of trigger price
+			"MaxSlippageRatio": cli.Flag{Flag: FlagMaxSlippageRatio},
 			"OrderType": cli.Flag{
 				Flag: FlagOrderType,
 				Transform: func(orig string, ctx grpc.ClientConn) (any, error) {
@@ -301,6 +314,7 @@ func NewCreateDerivativeMarketOrderTxCmd() *cobra.Command {
 	cmd.Flags().String(FlagPrice, "", "Price of the order")
 	cmd.Flags().String(FlagQuantity, "", "Quantity of the order")
 	cmd.Flags().String(FlagMargin, "", "Margin for the order")
+	cmd.Flags().String(FlagMaxSlippageRatio, "", "Max relative distance of the execution price from the mark price, tightening the default of the market")
 	return cmd
 }
 
@@ -319,6 +333,7 @@ func NewSpotMarketUpdateParamsProposalTxCmd() *cobra.Command {
 			"RelayerFeeShareRate": cli.Flag{Flag: FlagRelayerFeeShareRate},
 			"MinPriceTickSize":    cli.Flag{Flag: FlagMinPriceTickSize},
 			"MinQuantityTickSize": cli.Flag{Flag: FlagMinQuantityTickSize},
+			"MaxSlippageRatio":    cli.Flag{Flag: FlagMaxSlippageRatio},
 			"Status": cli.Flag{
 				Flag: FlagMarketStatus,
 				Transform: func(origV string, ctx grpc.ClientConn) (tranformedV any, err error) {
@@ -345,6 +360,7 @@ func NewSpotMarketUpdateParamsProposalTxCmd() *cobra.Command {
 	cmd.Flags().String(FlagRelayerFeeShareRate, "", "relayer fee share rate")
 	cmd.Flags().String(FlagMinPriceTickSize, "", "min price tick size")
 	cmd.Flags().String(FlagMinQuantityTickSize, "", "min quantity tick size")
+	cmd.Flags().String(FlagMaxSlippageRatio, "", "default max relative distance of market order execution prices from the top of the book, 0 for no bound")
 	cmd.Flags().String(FlagMarketStatus, "", "market status")
 	cliflags.AddGovProposalFlags(cmd)
 
@@ -1110,6 +1126,11 @@ func NewCreateBinaryOptionsMarketOrderTxCmd() *cobra.Command {
 				return err
 			}
 
+			order.MaxSlippageRatio, err = optionalDecimalFromFlag(cmd, FlagMaxSlippageRatio)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCreateBinaryOptionsMarketOrder{
 				Sender: clientCtx.GetFromAddress().String(),
 				Order:  *order,
@@ -1124,6 +1145,7 @@ func NewCreateBinaryOptionsMarketOrderTxCmd() *cobra.Command {
 	}
 
 	defineDerivativeOrderFlags(cmd)
+	cmd.Flags().String(FlagMaxSlippageRatio, "", "Max relative distance of the execution price from the top of the book, tightening the default of the market")
 	return cmd
 }
 
@@ -1938,6 +1960,11 @@ func NewDerivativeMarketParamUpdateProposalTxCmd() *cobra.Command {
 			--relayer-fee-share-rate="0.01" \
 			--hourly-interest-rate="0.01" \
 			--hourly-funding-rate-cap="0.00625" \
+			--max-open-interest="1000000" \
+			--max-position-size="10000" \
+			--price-band-ratio="0.1" \
+			--max-leverage="10" \
+			--max-slippage-ratio="0.05" \
 			--market-status="Active" \
 			--title="INJ derivative market params update" \
 			--description="XX" \
@@ -1992,6 +2019,31 @@ func NewDerivativeMarketParamUpdateProposalTxCmd() *cobra.Command {
 				return err
 			}
 
+			maxOpenInterest, err := optionalDecimalFromFlag(cmd, FlagMaxOpenInterest)
+			if err != nil {
+				return err
+			}
+
+			maxPositionSize, err := optionalDecimalFromFlag(cmd, FlagMaxPositionSize)
+			if err != nil {
+				return err
+			}
+
+			priceBandRatio, err := optionalDecimalFromFlag(cmd, FlagPriceBandRatio)
+			if err != nil {
+				return err
+			}
+
+			maxLeverage, err := optionalDecimalFromFlag(cmd, FlagMaxLeverage)
+			if err != nil {
+				return err
+			}
+
+			maxSlippageRatio, err := optionalDecimalFromFlag(cmd, FlagMaxSlippageRatio)
+			if err != nil {
+				return err
+			}
+
 			minPriceTickSizeStr, err := cmd.Flags().GetString(FlagMinPriceTickSize)
 			if err != nil {
 				return err
@@ -2068,6 +2120,11 @@ func NewDerivativeMarketParamUpdateProposalTxCmd() *cobra.Command {
 				&minQuantityTickSize,
 				hourlyInterestRate,
 				hourlyFundingRateCap,
+				maxOpenInterest,
+				maxPositionSize,
+				priceBandRatio,
+				maxLeverage,
+				maxSlippageRatio,
 				oracleParams,
 				status,
 			)
@@ -2112,6 +2169,11 @@ func NewDerivativeMarketParamUpdateProposalTxCmd() *cobra.Command {
 	cmd.Flags().String(FlagMinQuantityTickSize, "0.01", "min quantity tick size")
 	cmd.Flags().String(FlagHourlyInterestRate, "", "hourly interest rate")
 	cmd.Flags().String(FlagHourlyFundingRateCap, "", "hourly funding rate cap")
+	cmd.Flags().String(FlagMaxOpenInterest, "", "max open interest of the market, 0 for no cap")
+	cmd.Flags().String(FlagMaxPositionSize, "", "max position size of a subaccount in the market, 0 for no cap")
+	cmd.Flags().String(FlagPriceBandRatio, "", "max relative distance of order prices from the mark price, 0 for no band")
+	cmd.Flags().String(FlagMaxLeverage, "", "max leverage of the positions opened or increased by orders, 0 for no limit")
+	cmd.Flags().String(FlagMaxSlippageRatio, "", "default max relative distance of market order execution prices from the mark price, 0 for no bound")
 	cmd.Flags().String(FlagOracleBase, "", "oracle base")
 	cmd.Flags().String(FlagOracleQuote, "", "oracle quote")
 	cmd.Flags().String(FlagOracleType, "", "oracle type")
@@ -2633,6 +2695,89 @@ func NewRewardsOptOutTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSetMarginModeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-margin-mode [subaccount_id] [isolated|cross] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Switch a subaccount between isolated and cross margin.",
+		Long: `Switch a subaccount between isolated and cross margin. The margin mode can only be switched while the subaccount has no open positions.
+
+		Example:
+		$ %s tx exchange set-margin-mode 1 cross --from=genesis --keyring-backend=file --yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var marginMode types.MarginMode
+			switch strings.ToLower(args[1]) {
+			case "isolated":
+				marginMode = types.MarginMode_Isolated
+			case "cross":
+				marginMode = types.MarginMode_Cross
+			default:
+				return fmt.Errorf("margin mode must be isolated or cross, got %s", args[1])
+			}
+
+			msg := &types.MsgSetMarginMode{
+				Sender:       clientCtx.GetFromAddress().String(),
+				SubaccountId: args[0],
+				MarginMode:   marginMode,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTransferPositionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-position [market_id] [source_subaccount_id] [destination_subaccount_id] [quantity] [flags]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Move a derivative position between two subaccounts of the sender.",
+		Long: `Move a quantity of a derivative position, together with the same share of its margin, between two subaccounts of the sender.
+
+		Example:
+		$ %s tx exchange transfer-position 0x7cc8b10d7deb61e744ef83bdec2bbcf4a056867e89b062c6a453020ca82bd4e4 1 2 0.5 --from=genesis --keyring-backend=file --yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			quantity, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferPosition{
+				Sender:                  clientCtx.GetFromAddress().String(),
+				MarketId:                args[0],
+				SourceSubaccountId:      args[1],
+				DestinationSubaccountId: args[2],
+				Quantity:                quantity,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewAtomicMarketOrderFeeMultiplierScheduleProposalTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "propose-atomic-fee-multiplier [marketId:multiplier] [flags]",
@@ -2723,6 +2868,188 @@ func NewAtomicMarketOrderFeeMultiplierScheduleProposalTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewTradingScheduleProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-trading-schedule [market_id] [start_second:end_second]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to set the trading hours of a market",
+		Long: `Submit a proposal to set the trading hours of a market. Each window is given in seconds since midnight UTC,
+		with an inclusive start and an exclusive end. A proposal without windows removes the trading hours of the market.
+
+		Example:
+		$ %s tx exchange propose-trading-schedule 0xfd30930cb70d176c37d0c405cde055e551c5b1116b7049a88bcf821766b62d61 48600:72000 \
+			--cancel-orders-at-close \
+			--title="Set Trading Hours" \
+			--description="Trade from 13:30 to 20:00 UTC" \
+			--from=genesis \
+			--keyring-backend=file \
+			--yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			windows := make([]types.TradingWindow, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				split := strings.Split(arg, ":")
+				if len(split) != 2 {
+					return types.ErrInvalidArgument.Wrapf(
+						"%v does not match a pattern start_second:end_second",
+						arg,
+					)
+				}
+				startSecond, err := strconv.ParseUint(split[0], 10, 32)
+				if err != nil {
+					return err
+				}
+				endSecond, err := strconv.ParseUint(split[1], 10, 32)
+				if err != nil {
+					return err
+				}
+				windows = append(windows, types.TradingWindow{
+					StartSecond: uint32(startSecond),
+					EndSecond:   uint32(endSecond),
+				})
+			}
+
+			cancelOrdersAtClose, err := cmd.Flags().GetBool(FlagCancelOrdersAtClose)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := &types.TradingScheduleProposal{
+				Title:       title,
+				Description: description,
+				Schedules: []*types.MarketTradingSchedule{{
+					MarketId:            args[0],
+					Windows:             windows,
+					CancelOrdersAtClose: cancelOrdersAtClose,
+				}},
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagCancelOrdersAtClose, false, "cancel the resting orders of the market while it is closed")
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewMarketDelistingExemptionProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-market-delisting-exemption [market_id]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to exempt markets from the auto-delisting on inactivity",
+		Long: `Submit a proposal to exempt markets from the auto-delisting on inactivity. Without the --exempt flag, the
+		exemption of the markets is removed.
+
+		Example:
+		$ %s tx exchange propose-market-delisting-exemption 0xfd30930cb70d176c37d0c405cde055e551c5b1116b7049a88bcf821766b62d61 \
+			--exempt \
+			--title="Exempt INJ/USDT market" \
+			--description="Keep the INJ/USDT market listed" \
+			--from=genesis \
+			--keyring-backend=file \
+			--yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			isExempt, err := cmd.Flags().GetBool(FlagExempt)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := &types.MarketDelistingExemptionProposal{
+				Title:       title,
+				Description: description,
+				MarketIds:   args,
+				IsExempt:    isExempt,
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExempt, false, "exempt the markets from the auto-delisting, or remove their exemption if not set")
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getSpotMarketIdFromTicker(ticker string, ctx grpc.ClientConn) (any, error) {
 	queryClient := types.NewQueryClient(ctx)
 	req := &types.QuerySpotMarketsRequest{
@@ -2806,6 +3133,7 @@ func derivativeMarketParamUpdateArgsToContent(
 	marketID string,
 	initialMarginRatio, maintenanceMarginRatio, makerFeeRate, takerFeeRate, relayerFeeShareRate, minPriceTickSize, minQuantityTickSize *sdk.Dec,
 	hourlyInterestRate, hourlyFundingRateCap *sdk.Dec,
+	maxOpenInterest, maxPositionSize, priceBandRatio, maxLeverage, maxSlippageRatio *sdk.Dec,
 	oracleParams *types.OracleParams,
 	status types.MarketStatus,
 ) (govtypes.Content, error) {
@@ -2835,6 +3163,11 @@ func derivativeMarketParamUpdateArgsToContent(
 		status,
 		oracleParams,
 	)
+	content.MaxOpenInterest = maxOpenInterest
+	content.MaxPositionSize = maxPositionSize
+	content.PriceBandRatio = priceBandRatio
+	content.MaxLeverage = maxLeverage
+	content.MaxSlippageRatio = maxSlippageRatio
 	return content, nil
 }
 
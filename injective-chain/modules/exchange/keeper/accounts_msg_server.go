@@ -37,7 +37,7 @@ func (k AccountsMsgServer) BatchUpdateOrders(
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
 
-	return k.ExecuteBatchUpdateOrders(
+	resp, err := k.ExecuteBatchUpdateOrders(
 		ctx,
 		sender,
 		msg.SubaccountId,
@@ -51,6 +51,17 @@ func (k AccountsMsgServer) BatchUpdateOrders(
 		msg.DerivativeOrdersToCreate,
 		msg.BinaryOptionsOrdersToCreate,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	createdOrdersCount := countCreatedOrders(resp.SpotOrderHashes, resp.DerivativeOrderHashes, resp.BinaryOptionsOrderHashes)
+	if err := k.ChargeOrderPlacementSurcharge(ctx, sender, createdOrdersCount); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (k AccountsMsgServer) Deposit(
@@ -94,25 +105,10 @@ func (k AccountsMsgServer) SubaccountTransfer(
 	srcSubaccountID := types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SourceSubaccountId)
 	dstSubaccountID := types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.DestinationSubaccountId)
 
-	denom := msg.Amount.Denom
-	amount := msg.Amount.Amount.ToDec()
-
-	if err := k.Keeper.DecrementDeposit(ctx, srcSubaccountID, denom, amount); err != nil {
-		metrics.ReportFuncError(k.svcTags)
-		return nil, err
-	}
-
-	if err := k.Keeper.IncrementDepositForNonDefaultSubaccount(ctx, dstSubaccountID, denom, amount); err != nil {
+	if err := k.Keeper.ExecuteSubaccountTransfer(ctx, sender, srcSubaccountID, dstSubaccountID, msg.Amount); err != nil {
 		return nil, err
 	}
 
-	// nolint:errcheck //ignored on purpose
-	ctx.EventManager().EmitTypedEvent(&types.EventSubaccountBalanceTransfer{
-		SrcSubaccountId: srcSubaccountID.Hex(),
-		DstSubaccountId: dstSubaccountID.Hex(),
-		Amount:          msg.Amount,
-	})
-
 	return &types.MsgSubaccountTransferResponse{}, nil
 }
 
@@ -131,6 +127,10 @@ func (k AccountsMsgServer) ExternalTransfer(
 	denom := msg.Amount.Denom
 	amount := msg.Amount.Amount.ToDec()
 
+	if err := k.Keeper.EnsureCrossMarginRequirement(ctx, srcSubaccountID, denom, amount); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.DecrementDeposit(ctx, srcSubaccountID, denom, amount); err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, err
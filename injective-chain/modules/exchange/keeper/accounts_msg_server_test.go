@@ -477,7 +477,7 @@ var _ = Describe("Account MsgServer Tests", func() { // TODO - Test events
 			app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount)
 			app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, amount)
 
-			subaccountIDFrom := "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000001"
+			subaccountIDFrom := "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000001"
 			amountToDeposit := sdk.NewCoin(baseDenom, sdk.NewInt(50))
 
 			deposit := &types.MsgDeposit{
@@ -502,8 +502,8 @@ var _ = Describe("Account MsgServer Tests", func() { // TODO - Test events
 
 		Context("With all valid fields", func() {
 			BeforeEach(func() {
-				subaccountIDFrom = "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000001"
-				subaccountIDTo = "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000002"
+				subaccountIDFrom = "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000001"
+				subaccountIDTo = "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000002"
 
 				zeroDeposit := &types.Deposit{
 					AvailableBalance: sdk.NewDec(0),
@@ -533,8 +533,8 @@ var _ = Describe("Account MsgServer Tests", func() { // TODO - Test events
 
 		Context("With more funds than sender owns", func() {
 			BeforeEach(func() {
-				subaccountIDFrom = "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000001"
-				subaccountIDTo = "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000002"
+				subaccountIDFrom = "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000001"
+				subaccountIDTo = "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000002"
 
 				zeroDeposit := &types.Deposit{
 					AvailableBalance: sdk.NewDec(0),
@@ -562,6 +562,30 @@ var _ = Describe("Account MsgServer Tests", func() { // TODO - Test events
 				Expect(depositTo.TotalBalance).To(Equal(sdk.NewDec(0)))
 			})
 		})
+
+		Context("With a destination subaccount of another owner", func() {
+			BeforeEach(func() {
+				subaccountIDFrom = "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000001"
+				subaccountIDTo = "dfa0e6f4d3c6fd4bd2a8e7b3a2c5c7d0f1e2a3b4000000000000000000000001"
+
+				amountToTransfer = sdk.NewCoin(baseDenom, sdk.NewInt(50))
+			})
+
+			It("Should be rejected", func() {
+				Expect(err).To(MatchError(types.ErrBadSubaccountID))
+			})
+
+			It("Should not have updated balances", func() {
+				depositFrom := app.ExchangeKeeper.GetDeposit(ctx, common.HexToHash(subaccountIDFrom), baseDenom)
+
+				Expect(depositFrom.AvailableBalance).To(Equal(sdk.NewDec(50)))
+				Expect(depositFrom.TotalBalance).To(Equal(sdk.NewDec(50)))
+
+				depositTo := app.ExchangeKeeper.GetDeposit(ctx, common.HexToHash(subaccountIDTo), baseDenom)
+
+				Expect(depositTo.IsEmpty()).To(BeTrue())
+			})
+		})
 	})
 
 	Describe("SubaccountTransfer with simplified ids", func() {
@@ -659,7 +683,7 @@ var _ = Describe("Account MsgServer Tests", func() { // TODO - Test events
 			app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount)
 			app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, amount)
 
-			subaccountIDFrom := "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000001"
+			subaccountIDFrom := "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000001"
 			amountToDeposit := sdk.NewCoin(baseDenom, sdk.NewInt(50))
 
 			deposit := &types.MsgDeposit{
@@ -684,8 +708,8 @@ var _ = Describe("Account MsgServer Tests", func() { // TODO - Test events
 
 		Context("With all valid fields", func() {
 			BeforeEach(func() {
-				subaccountIDFrom = "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000001"
-				subaccountIDTo = "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000002"
+				subaccountIDFrom = "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000001"
+				subaccountIDTo = "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000002"
 
 				zeroDeposit := &types.Deposit{
 					AvailableBalance: sdk.NewDec(0),
@@ -301,6 +301,9 @@ func (k *Keeper) IncrementMarketAggregateVolume(
 	oldVolume := k.GetMarketAggregateVolume(ctx, marketID)
 	newVolume := oldVolume.Add(volume)
 	k.SetMarketAggregateVolume(ctx, marketID, newVolume)
+
+	k.recordMarketBlockTradeVolume(ctx, marketID, volume)
+	k.resetMarketInactivity(ctx, marketID)
 }
 
 // GetAllMarketAggregateVolumes gets all the aggregate volumes for all markets
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+type autoDeleveragingQueueEntry struct {
+	marketID     common.Hash
+	subaccountID common.Hash
+}
+
+// EnqueueAutoDeleveraging schedules an underwater position for auto-deleveraging at the end of the block. The queue is
+// kept in the transient store and drained by the EndBlocker of the same block, so it's never part of the exported
+// genesis state.
+func (k *Keeper) EnqueueAutoDeleveraging(ctx sdk.Context, marketID, subaccountID common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.getTransientStore(ctx).Set(types.GetAutoDeleveragingQueueKey(marketID, subaccountID), []byte{types.TrueByte})
+}
+
+// popAutoDeleveragingQueue returns and deletes the positions scheduled for auto-deleveraging, ordered by market ID
+// and subaccount ID.
+func (k *Keeper) popAutoDeleveragingQueue(ctx sdk.Context) []autoDeleveragingQueueEntry {
+	store := prefix.NewStore(k.getTransientStore(ctx), types.AutoDeleveragingQueuePrefix)
+
+	entries := make([]autoDeleveragingQueueEntry, 0)
+	keys := make([][]byte, 0)
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		entries = append(entries, autoDeleveragingQueueEntry{
+			marketID:     common.BytesToHash(key[:common.HashLength]),
+			subaccountID: common.BytesToHash(key[common.HashLength:]),
+		})
+		keys = append(keys, key)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return entries
+}
+
+// ProcessAutoDeleveraging closes the underwater positions scheduled during the block against the opposing positions of
+// their market. Positions are processed in the order of their market ID and subaccount ID, each in its own cache
+// context so that a failing position doesn't revert the others. If the opposing positions can't absorb the whole
+// position, its auto-deleveraging is discarded and the market is paused and scheduled for settlement instead, as when
+// auto-deleveraging is disabled.
+func (k *Keeper) ProcessAutoDeleveraging(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	for _, entry := range k.popAutoDeleveragingQueue(ctx) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.autoDeleveragePosition(cacheCtx, entry.marketID, entry.subaccountID)
+		if err == nil {
+			writeCache()
+			continue
+		}
+
+		k.Logger(ctx).Info("auto-deleveraging failed, settling the market", "marketID", entry.marketID.Hex(), "subaccountID", entry.subaccountID.Hex(), "err", err)
+
+		market := k.GetDerivativeMarket(ctx, entry.marketID, true)
+		if market == nil {
+			// the market was already paused by a previous position of the queue
+			continue
+		}
+
+		if err := k.pauseMarketAndScheduleForSettlement(ctx, market); err != nil {
+			k.Logger(ctx).Error("failed to schedule the market for settlement", "marketID", entry.marketID.Hex(), "err", err)
+		}
+	}
+}
+
+// autoDeleveragePosition closes an underwater position at its bankruptcy price against the opposing positions ranked
+// by SortPositionsForAutoDeleveraging, so that its deficit is absorbed by the most profitable and most leveraged
+// traders instead of being socialized through market settlement. Every position is closed at the same price, hence
+// the total funds of the market are conserved. The bankruptcy price of a cross margin position accounts for the
+// available balance of its subaccount, which is charged accordingly. Opposing positions which would have a negative
+// payout at the bankruptcy price are skipped, since closing them would only move the deficit. A position which was
+// closed or is no longer liquidatable by the end of the block is left as is. It fails if the opposing positions can't
+// absorb the whole position, in which case the caller must discard the state changes and settle the market instead.
+func (k *Keeper) autoDeleveragePosition(ctx sdk.Context, marketID, subaccountID common.Hash) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	market, markPrice := k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrDerivativeMarketNotFound.Wrapf("active derivative market for marketID %s not found", marketID.Hex())
+	}
+
+	position := k.GetPosition(ctx, marketID, subaccountID)
+	if position == nil || position.Quantity.IsZero() {
+		return nil
+	}
+
+	var funding *types.PerpetualMarketFunding
+	if market.IsPerpetual {
+		funding = k.GetPerpetualMarketFunding(ctx, marketID)
+	}
+
+	if !k.IsSubaccountPositionLiquidatable(ctx, subaccountID, position, market, markPrice, funding) {
+		return nil
+	}
+
+	position.ApplyFunding(funding)
+
+	// the available balance of a cross margin subaccount backs its positions, as in IsSubaccountPositionLiquidatable,
+	// so it absorbs the deficit before the opposing positions do
+	addedMargin := sdk.ZeroDec()
+	if k.GetSubaccountMarginMode(ctx, subaccountID) == types.MarginMode_Cross {
+		addedMargin = sdk.MaxDec(sdk.ZeroDec(), k.GetSpendableFunds(ctx, subaccountID, market.QuoteDenom))
+	}
+	bankruptcyPrice := position.GetBankruptcyPriceWithAddedMargin(funding, addedMargin)
+
+	if !bankruptcyPrice.IsPositive() {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInvalidPrice.Wrapf("bankruptcy price %s of the position must be positive", bankruptcyPrice.String())
+	}
+
+	k.CancelAllTransientDerivativeLimitOrdersBySubaccountID(ctx, market, subaccountID)
+	k.CancelAllDerivativeMarketOrdersBySubaccountID(ctx, market, subaccountID, marketID)
+	if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountID, true, true); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+	k.CancelAllConditionalDerivativeOrdersBySubaccountIDAndMarket(ctx, market, subaccountID, true, true)
+
+	opposingPositions := make([]*types.DerivativePosition, 0)
+	for _, p := range k.GetAllPositionsByMarket(ctx, marketID) {
+		if p.Position.IsLong == position.IsLong || p.Position.Quantity.IsZero() {
+			continue
+		}
+
+		p.Position.ApplyFunding(funding)
+
+		// a position without positive equity at the bankruptcy price can't absorb any of the deficit
+		if !p.Position.Margin.Add(p.Position.GetPayoutFromPnl(bankruptcyPrice, p.Position.Quantity)).IsPositive() {
+			continue
+		}
+
+		opposingPositions = append(opposingPositions, p)
+	}
+
+	types.SortPositionsForAutoDeleveraging(opposingPositions, markPrice)
+
+	depositDeltas := types.NewDepositDeltas()
+	remainingQuantity := position.Quantity
+
+	for _, opposingPosition := range opposingPositions {
+		if !remainingQuantity.IsPositive() {
+			break
+		}
+
+		opposingSubaccountID := common.HexToHash(opposingPosition.SubaccountId)
+		quantity := sdk.MinDec(remainingQuantity, opposingPosition.Position.Quantity)
+		remainingQuantity = remainingQuantity.Sub(quantity)
+
+		payout := reducePositionAtPrice(opposingPosition.Position, quantity, bankruptcyPrice)
+		depositDeltas.ApplyUniformDelta(opposingSubaccountID, payout)
+
+		k.SetPosition(ctx, marketID, opposingSubaccountID, opposingPosition.Position)
+		k.checkAndResolveReduceOnlyConflicts(ctx, marketID, opposingSubaccountID, opposingPosition.Position, !opposingPosition.Position.IsLong)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventAutoDeleverage{
+			MarketId:               marketID.Hex(),
+			SubaccountId:           opposingSubaccountID.Hex(),
+			LiquidatedSubaccountId: subaccountID.Hex(),
+			IsLong:                 opposingPosition.Position.IsLong,
+			Quantity:               quantity,
+			Price:                  bankruptcyPrice,
+			Payout:                 payout,
+		})
+	}
+
+	if remainingQuantity.IsPositive() {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInsufficientPositionQuantity.Wrapf("the opposing positions can't absorb %s of the position", remainingQuantity.String())
+	}
+
+	closedQuantity := position.Quantity
+	payout := reducePositionAtPrice(position, closedQuantity, bankruptcyPrice)
+	depositDeltas.ApplyUniformDelta(subaccountID, payout)
+
+	k.SetPosition(ctx, marketID, subaccountID, position)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventAutoDeleverage{
+		MarketId:               marketID.Hex(),
+		SubaccountId:           subaccountID.Hex(),
+		LiquidatedSubaccountId: subaccountID.Hex(),
+		IsLong:                 position.IsLong,
+		Quantity:               closedQuantity,
+		Price:                  bankruptcyPrice,
+		Payout:                 payout,
+	})
+
+	for _, id := range depositDeltas.GetSortedSubaccountKeys() {
+		k.UpdateDepositWithDelta(ctx, id, market.QuoteDenom, depositDeltas[id])
+	}
+
+	return nil
+}
+
+// reducePositionAtPrice closes the quantity of the position at the price without fees and returns the payout of the
+// closed quantity.
+func reducePositionAtPrice(position *types.Position, quantity, price sdk.Dec) sdk.Dec {
+	payout, _, _ := position.ApplyPositionDelta(&types.PositionDelta{
+		IsLong:            !position.IsLong,
+		ExecutionQuantity: quantity,
+		ExecutionMargin:   sdk.ZeroDec(),
+		ExecutionPrice:    price,
+	}, sdk.ZeroDec())
+
+	return payout
+}
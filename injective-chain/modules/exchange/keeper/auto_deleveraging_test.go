@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Auto-deleveraging", func() {
+	var (
+		testInput      testexchange.TestInput
+		app            *simapp.InjectiveApp
+		ctx            sdk.Context
+		msgServer      types.MsgServer
+		marketID       common.Hash
+		quoteDenom     string
+		underwaterLong = testexchange.SampleNonDefaultSubaccountAddr1
+		otherLong      = testexchange.SampleNonDefaultSubaccountAddr2
+		safeShort      = testexchange.SampleNonDefaultSubaccountAddr3
+		riskyShort     = testexchange.SampleNonDefaultSubaccountAddr4
+		liquidator     = testexchange.SampleNonDefaultSubaccountAddr5
+		subaccountIDs  = []common.Hash{underwaterLong, otherLong, safeShort, riskyShort}
+		markPrice      = sdk.NewDec(1700)
+	)
+
+	setPosition := func(subaccountID common.Hash, isLong bool, quantity, entryPrice, margin sdk.Dec) {
+		app.ExchangeKeeper.SetPosition(ctx, marketID, subaccountID, &types.Position{
+			IsLong:                 isLong,
+			Quantity:               quantity,
+			EntryPrice:             entryPrice,
+			Margin:                 margin,
+			CumulativeFundingEntry: sdk.ZeroDec(),
+		})
+	}
+
+	// totalFunds returns the deposits of the subaccounts plus the value of their positions at the mark price
+	totalFunds := func() sdk.Dec {
+		total := sdk.ZeroDec()
+		for _, subaccountID := range subaccountIDs {
+			total = total.Add(app.ExchangeKeeper.GetDeposit(ctx, subaccountID, quoteDenom).TotalBalance)
+
+			position := app.ExchangeKeeper.GetPosition(ctx, marketID, subaccountID)
+			if position != nil {
+				total = total.Add(position.Margin).Add(position.GetPayoutFromPnl(markPrice, position.Quantity))
+			}
+		}
+		return total
+	}
+
+	getAutoDeleverageEvents := func(events []abci.Event) []*types.EventAutoDeleverage {
+		parsedEvents := make([]*types.EventAutoDeleverage, 0)
+		for _, event := range events {
+			parsed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			if e, ok := parsed.(*types.EventAutoDeleverage); ok {
+				parsedEvents = append(parsedEvents, e)
+			}
+		}
+		return parsedEvents
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		quoteDenom = testInput.Perps[0].QuoteDenom
+		oracleBase, oracleQuote, oracleType := testInput.Perps[0].OracleBase, testInput.Perps[0].OracleQuote, testInput.Perps[0].OracleType
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, oracleBase, oracleQuote, oracletypes.NewPriceState(sdk.NewDec(2000), ctx.BlockTime().Unix()))
+
+		// the insurance fund only holds a single unit, so it can't cover any liquidation deficit
+		sender := types.SubaccountIDToSdkAddress(liquidator)
+		coin := sdk.NewCoin(quoteDenom, sdk.OneInt())
+		app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin))
+		app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(coin))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, coin, testInput.Perps[0].Ticker, quoteDenom, oracleBase, oracleQuote, oracleType, -1))
+
+		market, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			testInput.Perps[0].Ticker,
+			quoteDenom,
+			oracleBase,
+			oracleQuote,
+			0,
+			oracleType,
+			testInput.Perps[0].InitialMarginRatio,
+			testInput.Perps[0].MaintenanceMarginRatio,
+			testInput.Perps[0].MakerFeeRate,
+			testInput.Perps[0].TakerFeeRate,
+			testInput.Perps[0].MinPriceTickSize,
+			testInput.Perps[0].MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+		marketID = market.MarketID()
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.IsAutoDeleveragingEnabled = true
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		depositAmount := sdk.NewCoins(sdk.NewCoin(quoteDenom, sdk.NewInt(10000)))
+		testexchange.MintAndDeposit(app, ctx, otherLong.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, safeShort.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, riskyShort.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, liquidator.String(), depositAmount)
+
+		// the underwater long has no deposits and goes bankrupt at 1800
+		setPosition(underwaterLong, true, sdk.NewDec(2), sdk.NewDec(2000), sdk.NewDec(400))
+		setPosition(otherLong, true, sdk.NewDec(1), sdk.NewDec(2000), sdk.NewDec(2000))
+		// score at 1700: (450 / 3000) * (2550 / 3450) ≈ 0.11
+		setPosition(safeShort, false, sdk.NewDecWithPrec(15, 1), sdk.NewDec(2000), sdk.NewDec(3000))
+		// score at 1700: (600 / 750) * (2550 / 1350) ≈ 1.51
+		setPosition(riskyShort, false, sdk.NewDecWithPrec(15, 1), sdk.NewDec(2100), sdk.NewDec(750))
+
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, oracleBase, oracleQuote, oracletypes.NewPriceState(markPrice, ctx.BlockTime().Unix()))
+	})
+
+	liquidate := func() error {
+		_, err := msgServer.LiquidatePosition(sdk.WrapSDKContext(ctx), &types.MsgLiquidatePosition{
+			Sender:       types.SubaccountIDToSdkAddress(liquidator).String(),
+			SubaccountId: underwaterLong.Hex(),
+			MarketId:     marketID.Hex(),
+			Order: &types.DerivativeOrder{
+				MarketId: marketID.Hex(),
+				OrderInfo: types.OrderInfo{
+					SubaccountId: liquidator.Hex(),
+					FeeRecipient: types.SubaccountIDToSdkAddress(liquidator).String(),
+					Price:        markPrice,
+					Quantity:     sdk.NewDec(2),
+				},
+				OrderType: types.OrderType_BUY,
+				Margin:    markPrice.MulInt64(2),
+			},
+		})
+		return err
+	}
+
+	It("deleverages the opposing positions by score when the insurance fund is depleted", func() {
+		insuranceFundBefore := app.InsuranceKeeper.GetInsuranceFund(ctx, marketID).Balance
+		totalFundsBefore := totalFunds()
+
+		testexchange.OrFail(liquidate())
+
+		// the liquidation is discarded and the position is auto-deleveraged at the end of the block
+		Expect(app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID).Status).To(Equal(types.MarketStatus_Active))
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, underwaterLong).Quantity.String()).To(Equal(sdk.NewDec(2).String()))
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.ExchangeKeeper.ProcessAutoDeleveraging(ctx)
+		Expect(app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID).Status).To(Equal(types.MarketStatus_Active))
+
+		events := getAutoDeleverageEvents(ctx.EventManager().ABCIEvents())
+		Expect(events).To(HaveLen(3))
+
+		bankruptcyPrice := sdk.NewDec(1800)
+		expectedEvents := []struct {
+			subaccountID common.Hash
+			quantity     sdk.Dec
+			payout       sdk.Dec
+		}{
+			// 1.5 * (2100 - 1800) + 750
+			{riskyShort, sdk.NewDecWithPrec(15, 1), sdk.NewDec(1200)},
+			// 0.5 * (2000 - 1800) + 3000 / 3
+			{safeShort, sdk.NewDecWithPrec(5, 1), sdk.NewDec(1100)},
+			{underwaterLong, sdk.NewDec(2), sdk.ZeroDec()},
+		}
+		for i, expected := range expectedEvents {
+			Expect(events[i].SubaccountId).To(Equal(expected.subaccountID.Hex()))
+			Expect(events[i].LiquidatedSubaccountId).To(Equal(underwaterLong.Hex()))
+			Expect(events[i].Quantity.String()).To(Equal(expected.quantity.String()))
+			Expect(events[i].Price.String()).To(Equal(bankruptcyPrice.String()))
+			Expect(events[i].Payout.String()).To(Equal(expected.payout.String()))
+		}
+
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, underwaterLong)).To(BeNil())
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, riskyShort)).To(BeNil())
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, safeShort).Quantity.String()).To(Equal(sdk.OneDec().String()))
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, otherLong).Quantity.String()).To(Equal(sdk.OneDec().String()))
+
+		Expect(app.ExchangeKeeper.GetOpenInterest(ctx, marketID).String()).To(Equal(sdk.OneDec().String()))
+		Expect(app.InsuranceKeeper.GetInsuranceFund(ctx, marketID).Balance.String()).To(Equal(insuranceFundBefore.String()))
+		Expect(totalFunds().String()).To(Equal(totalFundsBefore.String()))
+	})
+
+	It("settles the market when the opposing positions can't absorb the position", func() {
+		setPosition(safeShort, false, sdk.NewDecWithPrec(2, 1), sdk.NewDec(2000), sdk.NewDec(400))
+
+		testexchange.OrFail(liquidate())
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.ExchangeKeeper.ProcessAutoDeleveraging(ctx)
+
+		Expect(getAutoDeleverageEvents(ctx.EventManager().ABCIEvents())).To(BeEmpty())
+		Expect(app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID).Status).To(Equal(types.MarketStatus_Paused))
+		Expect(app.ExchangeKeeper.GetDerivativesMarketScheduledSettlementInfo(ctx, marketID)).ToNot(BeNil())
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, underwaterLong).Quantity.String()).To(Equal(sdk.NewDec(2).String()))
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, riskyShort).Quantity.String()).To(Equal(sdk.NewDecWithPrec(15, 1).String()))
+	})
+
+	It("skips the opposing positions which would have a negative payout at the bankruptcy price", func() {
+		// score at 1700: (75 / 50) * (2550 / 125) ≈ 30.6, but its equity at 1800 is 50 - 1.5 * 50 = -25
+		setPosition(riskyShort, false, sdk.NewDecWithPrec(15, 1), sdk.NewDec(1750), sdk.NewDec(50))
+		setPosition(safeShort, false, sdk.NewDec(2), sdk.NewDec(2000), sdk.NewDec(4000))
+		totalFundsBefore := totalFunds()
+
+		testexchange.OrFail(liquidate())
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.ExchangeKeeper.ProcessAutoDeleveraging(ctx)
+
+		events := getAutoDeleverageEvents(ctx.EventManager().ABCIEvents())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].SubaccountId).To(Equal(safeShort.Hex()))
+		Expect(events[0].Quantity.String()).To(Equal(sdk.NewDec(2).String()))
+		// 2 * (2000 - 1800) + 4000
+		Expect(events[0].Payout.String()).To(Equal(sdk.NewDec(4400).String()))
+		Expect(events[1].SubaccountId).To(Equal(underwaterLong.Hex()))
+
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, underwaterLong)).To(BeNil())
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, safeShort)).To(BeNil())
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, riskyShort).Quantity.String()).To(Equal(sdk.NewDecWithPrec(15, 1).String()))
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, riskyShort, quoteDenom).TotalBalance.String()).To(Equal(sdk.NewDec(10000).String()))
+		Expect(totalFunds().String()).To(Equal(totalFundsBefore.String()))
+	})
+
+	It("leaves a position which is no longer liquidatable by the end of the block", func() {
+		testexchange.OrFail(liquidate())
+
+		oracleBase, oracleQuote := testInput.Perps[0].OracleBase, testInput.Perps[0].OracleQuote
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, oracleBase, oracleQuote, oracletypes.NewPriceState(sdk.NewDec(2000), ctx.BlockTime().Unix()))
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.ExchangeKeeper.ProcessAutoDeleveraging(ctx)
+
+		Expect(getAutoDeleverageEvents(ctx.EventManager().ABCIEvents())).To(BeEmpty())
+		Expect(app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID).Status).To(Equal(types.MarketStatus_Active))
+		Expect(app.ExchangeKeeper.GetPosition(ctx, marketID, underwaterLong).Quantity.String()).To(Equal(sdk.NewDec(2).String()))
+	})
+})
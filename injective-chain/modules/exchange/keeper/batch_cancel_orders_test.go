@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Batch Cancel Orders", func() {
+	var (
+		testInput    testexchange.TestInput
+		app          *simapp.InjectiveApp
+		ctx          sdk.Context
+		msgServer    types.MsgServer
+		marketID     common.Hash
+		quoteDenom   string
+		sender       string
+		subaccountID = testexchange.SampleNonDefaultSubaccountAddr1
+		orderHashes  []string
+	)
+
+	getRestingOrders := func() []*types.SpotLimitOrder {
+		return app.ExchangeKeeper.GetAllSpotLimitOrdersByMarketDirection(ctx, marketID, true)
+	}
+
+	orderData := func(orderHash string) types.OrderData {
+		return types.OrderData{
+			MarketId:     marketID.Hex(),
+			SubaccountId: subaccountID.Hex(),
+			OrderHash:    orderHash,
+		}
+	}
+
+	batchCancel := func(data ...types.OrderData) *types.MsgBatchCancelOrdersResponse {
+		resp, err := msgServer.BatchCancelOrders(sdk.WrapSDKContext(ctx), &types.MsgBatchCancelOrders{
+			Sender: sender,
+			Data:   data,
+		})
+		testexchange.OrFail(err)
+		return resp
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		market := testInput.Spots[0]
+		marketID = market.MarketID
+		quoteDenom = market.QuoteDenom
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		testexchange.MintAndDeposit(app, ctx, subaccountID.String(), sdk.NewCoins(sdk.NewCoin(quoteDenom, sdk.NewInt(100000))))
+
+		orderHashes = make([]string, 0)
+		for _, price := range []string{"100", "101"} {
+			msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+				testexchange.NewBareSpotLimitOrderFromString(price, "1", types.OrderType_BUY_PO, subaccountID),
+			)
+			sender = msgs[0].Sender
+
+			resp, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+			testexchange.OrFail(err)
+			orderHashes = append(orderHashes, resp.OrderHash)
+		}
+
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		Expect(getRestingOrders()).To(HaveLen(2))
+	})
+
+	AfterEach(func() {
+		Expect(app.ExchangeKeeper.IsMetadataInvariantValid(ctx)).To(BeTrue())
+	})
+
+	It("cancels and refunds all orders", func() {
+		depositBefore := app.ExchangeKeeper.GetDeposit(ctx, subaccountID, quoteDenom)
+
+		resp := batchCancel(orderData(orderHashes[0]), orderData(orderHashes[1]))
+
+		Expect(resp.Success).To(Equal([]bool{true, true}))
+		Expect(resp.ErrorCodes).To(Equal([]uint32{0, 0}))
+		Expect(getRestingOrders()).To(BeEmpty())
+
+		depositAfter := app.ExchangeKeeper.GetDeposit(ctx, subaccountID, quoteDenom)
+		Expect(depositAfter.TotalBalance.String()).To(Equal(depositBefore.TotalBalance.String()))
+		Expect(depositAfter.AvailableBalance.String()).To(Equal(depositAfter.TotalBalance.String()))
+	})
+
+	It("reports failed cancellations without reverting successful ones", func() {
+		missingOrderHash := common.BytesToHash([]byte("missing order")).Hex()
+		missingMarket := orderData(orderHashes[1])
+		missingMarket.MarketId = common.BytesToHash([]byte("missing market")).Hex()
+
+		resp := batchCancel(orderData(orderHashes[0]), orderData(missingOrderHash), missingMarket)
+
+		Expect(resp.Success).To(Equal([]bool{true, false, false}))
+		Expect(resp.ErrorCodes[0]).To(BeZero())
+		Expect(resp.ErrorCodes[1]).To(Equal(types.ErrOrderDoesntExist.ABCICode()))
+		Expect(resp.ErrorCodes[2]).To(Equal(types.ErrDerivativeMarketNotFound.ABCICode()))
+
+		restingOrders := getRestingOrders()
+		Expect(restingOrders).To(HaveLen(1))
+		Expect(common.BytesToHash(restingOrders[0].OrderHash).Hex()).To(Equal(orderHashes[1]))
+	})
+
+	It("handles an empty batch", func() {
+		msg := &types.MsgBatchCancelOrders{Sender: sender}
+		Expect(msg.ValidateBasic()).To(MatchError(types.ErrOrderDoesntExist))
+
+		resp := batchCancel()
+		Expect(resp.Success).To(BeEmpty())
+		Expect(resp.ErrorCodes).To(BeEmpty())
+		Expect(getRestingOrders()).To(HaveLen(2))
+	})
+})
@@ -43,6 +43,11 @@ func (k *Keeper) BinaryOptionsMarketLaunch(
 		return nil, errors.Wrapf(types.ErrBinaryOptionsMarketExists, "ticker %s quoteDenom %s", ticker, quoteDenom)
 	}
 
+	if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	// Enforce that the provider exists, but not necessarily that the oracle price for the symbol exists
 	if k.OracleKeeper.GetProviderInfo(ctx, oracleProvider) == nil {
 		metrics.ReportFuncError(k.svcTags)
@@ -195,6 +200,8 @@ func (k *Keeper) ProcessBinaryOptionsMarketsToExpireAndSettle(ctx sdk.Context) {
 
 	// 1. Find all markets whose expiration time has just passed and cancel all orders
 	marketsToExpire := k.GetAllBinaryOptionsMarketsToExpire(ctx)
+	SortByMarketID(marketsToExpire, (*types.BinaryOptionsMarket).MarketID)
+
 	for _, market := range marketsToExpire {
 		// no need to cancel transient orders since SettleMarket only runs in the BeginBlocker
 		k.CancelAllRestingDerivativeLimitOrders(ctx, market)
@@ -211,7 +218,9 @@ func (k *Keeper) ProcessBinaryOptionsMarketsToExpireAndSettle(ctx sdk.Context) {
 	marketsToNaturallySettle := k.GetAllBinaryOptionsMarketsToNaturallySettle(ctx)
 	marketsToSettle = append(marketsToSettle, marketsToNaturallySettle...)
 
-	// 4. Settle all markets
+	// 4. Settle all markets, in the order of their market ID rather than of their settlement time
+	SortByMarketID(marketsToSettle, (*types.BinaryOptionsMarket).MarketID)
+
 	for _, market := range marketsToSettle {
 		if market.SettlementPrice != nil && !market.SettlementPrice.IsNil() && !market.SettlementPrice.Equal(types.BinaryOptionsMarketRefundFlagPrice) {
 			scaledSettlementPrice := types.GetScaledPrice(*market.SettlementPrice, market.OracleScaleFactor)
@@ -557,6 +566,9 @@ func (k *Keeper) ExecuteBinaryOptionsMarketParamUpdateProposal(ctx sdk.Context,
 	if p.SettlementPrice != nil {
 		market.SettlementPrice = p.SettlementPrice
 	}
+	if p.MaxSlippageRatio != nil {
+		market.MaxSlippageRatio = *p.MaxSlippageRatio
+	}
 
 	if p.Status == types.MarketStatus_Demolished {
 		k.scheduleBinaryOptionsMarketForSettlement(ctx, common.HexToHash(market.MarketId)) // settle in BeginBlocker of the next block
@@ -41,6 +41,12 @@ func (k BinaryOptionsMsgServer) InstantBinaryOptionsMarketLaunch(goCtx context.C
 		return nil, err
 	}
 
+	if err := k.ChargeMarketCreationFee(ctx, senderAddr); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("failed launching binary options market", err)
+		return nil, err
+	}
+
 	// check if the market launch proposal already exists
 	marketID := types.NewBinaryOptionsMarketID(msg.Ticker, msg.QuoteDenom, msg.OracleSymbol, msg.OracleProvider, msg.OracleType)
 	if k.checkIfMarketLaunchProposalExist(ctx, types.ProposalTypeBinaryOptionsMarketLaunch, marketID) {
@@ -85,6 +91,9 @@ func (k BinaryOptionsMsgServer) CreateBinaryOptionsLimitOrder(goCtx context.Cont
 	if market == nil {
 		k.Logger(ctx).Error("active binary options market doesn't exist", "marketId", msg.Order.MarketId)
 		metrics.ReportFuncError(k.svcTags)
+		if k.IsMarketPaused(ctx, msg.Order.MarketID()) {
+			return nil, types.ErrMarketPaused.Wrapf("binary options market %s is paused", msg.Order.MarketId)
+		}
 		return nil, errors.Wrapf(types.ErrBinaryOptionsMarketNotFound, "marketID %s", msg.Order.MarketId)
 	}
 
@@ -101,6 +110,11 @@ func (k BinaryOptionsMsgServer) CreateBinaryOptionsLimitOrder(goCtx context.Cont
 		return nil, err
 	}
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, account, 1); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	return &types.MsgCreateBinaryOptionsLimitOrderResponse{
 		OrderHash: orderHash.Hex(),
 	}, nil
@@ -117,6 +131,9 @@ func (k BinaryOptionsMsgServer) CreateBinaryOptionsMarketOrder(goCtx context.Con
 	if market == nil {
 		k.Logger(ctx).Error("active binary options market doesn't exist", "marketId", msg.Order.MarketId)
 		metrics.ReportFuncError(k.svcTags)
+		if k.IsMarketPaused(ctx, msg.Order.MarketID()) {
+			return nil, types.ErrMarketPaused.Wrapf("binary options market %s is paused", msg.Order.MarketId)
+		}
 		return nil, errors.Wrapf(types.ErrBinaryOptionsMarketNotFound, "marketID %s", msg.Order.MarketId)
 	}
 
@@ -132,6 +149,11 @@ func (k BinaryOptionsMsgServer) CreateBinaryOptionsMarketOrder(goCtx context.Con
 		return nil, err
 	}
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, account, 1); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	resp := &types.MsgCreateBinaryOptionsMarketOrderResponse{
 		OrderHash: orderHash.Hex(),
 	}
@@ -396,7 +396,7 @@ var _ = Describe("Binary Options MsgServer Tests", func() {
 			It("Should be invalid with invalid price error", func() {
 				errorMessage1 := "price " + message.Order.OrderInfo.Price.String()
 				errorMessage2 := " must be a multiple of the minimum price tick size " + market.MinPriceTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidPrice.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrPriceTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
@@ -409,7 +409,7 @@ var _ = Describe("Binary Options MsgServer Tests", func() {
 			It("Should be invalid with invalid quantity error", func() {
 				errorMessage1 := "quantity " + message.Order.OrderInfo.Quantity.String()
 				errorMessage2 := " must be a multiple of the minimum quantity tick size " + market.MinQuantityTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidQuantity.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrQuantityTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
@@ -1111,7 +1111,7 @@ var _ = Describe("Binary Options MsgServer Tests", func() {
 			It("Should be invalid with invalid price error", func() {
 				errorMessage1 := "price " + message.Order.OrderInfo.Price.String()
 				errorMessage2 := " must be a multiple of the minimum price tick size " + market.MinPriceTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidPrice.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrPriceTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
@@ -1124,7 +1124,7 @@ var _ = Describe("Binary Options MsgServer Tests", func() {
 			It("Should be invalid with invalid quantity error", func() {
 				errorMessage1 := "quantity " + message.Order.OrderInfo.Quantity.String()
 				errorMessage2 := " must be a multiple of the minimum quantity tick size " + market.MinQuantityTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidQuantity.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrQuantityTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
@@ -64,8 +64,8 @@ func (k *Keeper) getTradeDataAndIncrementVolumeContribution(
 func getOrderFillFeeInfo(orderFillNotional, tradeFeeRate, relayerFeeShareRate sdk.Dec) (
 	totalTradeFee, traderFee, feeRecipientReward, auctionFeeReward sdk.Dec,
 ) {
-	totalTradeFee = orderFillNotional.Mul(tradeFeeRate)
-	feeRecipientReward = relayerFeeShareRate.Mul(totalTradeFee).Abs()
+	totalTradeFee = types.CalculateFee(orderFillNotional, tradeFeeRate)
+	feeRecipientReward = types.CalculateFee(totalTradeFee, relayerFeeShareRate).Abs()
 
 	if totalTradeFee.IsNegative() {
 		// trader "pays" aka only receives the trading fee without the fee recipient reward component
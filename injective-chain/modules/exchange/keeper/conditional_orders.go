@@ -241,6 +241,7 @@ func (k *Keeper) SetConditionalDerivativeMarketOrderWithMetadata(
 	orderBz := k.cdc.MustMarshal(order)
 	ordersIndexStore.Set(subaccountIndexKey, triggerPrice.BigInt().Bytes())
 	ordersStore.Set(priceKey, orderBz)
+	k.incrementSubaccountConditionalOrderCount(ctx, subaccountID)
 
 	if metadata == nil {
 		metadata = k.GetSubaccountOrderbookMetadata(ctx, marketID, subaccountID, isTriggerPriceHigher)
@@ -290,6 +291,7 @@ func (k *Keeper) SetConditionalDerivativeLimitOrderWithMetadata(
 	orderBz := k.cdc.MustMarshal(order)
 	ordersIndexStore.Set(subaccountIndexKey, triggerPrice.BigInt().Bytes())
 	ordersStore.Set(priceKey, orderBz)
+	k.incrementSubaccountConditionalOrderCount(ctx, subaccountID)
 
 	k.setCid(ctx, false, subaccountID, order.OrderInfo.Cid, marketID, order.IsBuy(), orderHash)
 
@@ -348,6 +350,9 @@ func (k *Keeper) DeleteConditionalDerivativeOrder(
 
 	// delete from subaccount index key store
 	ordersIndexStore.Delete(subaccountIndexKey)
+
+	// free the conditional order slot of the subaccount
+	k.decrementSubaccountConditionalOrderCount(ctx, subaccountID)
 }
 
 // GetConditionalDerivativeLimitOrderBySubaccountIDAndHash returns the active conditional derivative limit order from hash and subaccountID.
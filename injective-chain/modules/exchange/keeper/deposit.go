@@ -105,6 +105,10 @@ func (k *Keeper) ExecuteWithdraw(ctx sdk.Context, msg *types.MsgWithdraw) error
 		return sdkerrors.ErrInvalidCoins
 	}
 
+	if err := k.EnsureCrossMarginRequirement(ctx, subaccountID, denom, amount); err != nil {
+		return errors.Wrap(err, "withdrawal failed")
+	}
+
 	if err := k.DecrementDeposit(ctx, subaccountID, denom, amount); err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return errors.Wrap(err, "withdrawal failed")
@@ -127,6 +131,61 @@ func (k *Keeper) ExecuteWithdraw(ctx sdk.Context, msg *types.MsgWithdraw) error
 	return nil
 }
 
+// ExecuteSubaccountTransfer moves the available balance of a denom between two non-default subaccounts owned by the
+// sender. The ownership is checked again here, so callers skipping MsgSubaccountTransfer.ValidateBasic can't move the
+// funds of another owner. The source is debited before the destination is credited, the caller must discard the state
+// changes on error, as a failed tx does.
+func (k *Keeper) ExecuteSubaccountTransfer(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	srcSubaccountID, dstSubaccountID common.Hash,
+	amount sdk.Coin,
+) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if !types.SubaccountIDToSdkAddress(srcSubaccountID).Equals(sender) {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrBadSubaccountID, "source subaccount %s is not owned by %s", srcSubaccountID.Hex(), sender.String())
+	}
+
+	if !types.SubaccountIDToSdkAddress(dstSubaccountID).Equals(sender) {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrBadSubaccountID, "destination subaccount %s is not owned by %s", dstSubaccountID.Hex(), sender.String())
+	}
+
+	if types.IsDefaultSubaccountID(srcSubaccountID) || types.IsDefaultSubaccountID(dstSubaccountID) {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrap(types.ErrBadSubaccountID, "cannot transfer from or to the default subaccount")
+	}
+
+	denom := amount.Denom
+	decAmount := amount.Amount.ToDec()
+
+	if err := k.EnsureCrossMarginRequirement(ctx, srcSubaccountID, denom, decAmount); err != nil {
+		return err
+	}
+
+	// only the available balance, i.e. the free margin, can be transferred
+	if err := k.DecrementDeposit(ctx, srcSubaccountID, denom, decAmount); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	if err := k.IncrementDepositForNonDefaultSubaccount(ctx, dstSubaccountID, denom, decAmount); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSubaccountBalanceTransfer{
+		SrcSubaccountId: srcSubaccountID.Hex(),
+		DstSubaccountId: dstSubaccountID.Hex(),
+		Amount:          amount,
+	})
+
+	return nil
+}
+
 // IsDenomValid checks if denom is a valid denomination in the bank module supply.
 func (k *Keeper) IsDenomValid(ctx sdk.Context, denom string) bool {
 	return k.bankKeeper.GetSupply(ctx, denom).Amount.IsPositive()
@@ -205,6 +264,15 @@ func (k *Keeper) SetDeposit(
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
+	// Reading the previous deposit for the protocol stats is free like the stats themselves, so that the gas charged
+	// for a deposit update stays the cost of its write.
+	oldDeposit := k.GetDeposit(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), subaccountID, denom)
+	k.updateDenomTotalDeposits(ctx, denom, oldDeposit, deposit)
+
+	if k.IsTVLTelemetryEnabled() {
+		k.recordTVLDelta(ctx, denom, oldDeposit, deposit)
+	}
+
 	store := k.getStore(ctx)
 	key := types.GetDepositKey(subaccountID, denom)
 	bz := k.cdc.MustMarshal(deposit)
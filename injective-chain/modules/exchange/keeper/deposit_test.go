@@ -43,8 +43,8 @@ var _ = Describe("Deposit", func() {
 	config := sdk.GetConfig()
 	chaintypes.SetBech32Prefixes(config)
 	sender := sdk.AccAddress(common.FromHex("90f8bf6a479f320ead074411a4b0e7944ea8c9c1")).String()
-	subaccountIDStr := "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000001"
-	subaccountID2Str := "727aee334987c52fa7b567b2662bdbb68614e48c000000000000000000000002"
+	subaccountIDStr := "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000001"
+	subaccountID2Str := "90f8bf6a479f320ead074411a4b0e7944ea8c9c1000000000000000000000002"
 	externalAccountStr := "0x71C7656EC7ab88b098defB751B7401B5f6d8976F000000000000000000000001"
 	subaccountId := common.HexToHash(subaccountIDStr)
 	subaccountId2 := common.HexToHash(subaccountID2Str)
@@ -189,6 +189,11 @@ func (k *Keeper) PersistDerivativeMatchingExecution(
 			ctx.EventManager().EmitTypedEvent(execution.NewOrdersEvent)
 		}
 
+		k.recordDerivativeOrderFills(ctx, execution.RestingLimitBuyOrderExecutionEvent)
+		k.recordDerivativeOrderFills(ctx, execution.RestingLimitSellOrderExecutionEvent)
+		k.recordDerivativeOrderFills(ctx, execution.TransientLimitBuyOrderExecutionEvent)
+		k.recordDerivativeOrderFills(ctx, execution.TransientLimitSellOrderExecutionEvent)
+
 		if execution.RestingLimitBuyOrderExecutionEvent != nil {
 			// nolint:errcheck //ignored on purpose
 			ctx.EventManager().EmitTypedEvent(execution.RestingLimitBuyOrderExecutionEvent)
@@ -210,6 +215,7 @@ func (k *Keeper) PersistDerivativeMatchingExecution(
 		}
 
 		for idx := range execution.CancelLimitOrderEvents {
+			k.recordCancelledDerivativeOrder(ctx, execution.CancelLimitOrderEvents[idx])
 			// nolint:errcheck //ignored on purpose
 			ctx.EventManager().EmitTypedEvent(execution.CancelLimitOrderEvents[idx])
 		}
@@ -91,6 +91,9 @@ func (k *Keeper) PersistSingleDerivativeMarketOrderExecution(
 		}
 	}
 
+	k.recordDerivativeOrderFills(ctx, execution.RestingLimitSellOrderExecutionEvent)
+	k.recordDerivativeOrderFills(ctx, execution.RestingLimitBuyOrderExecutionEvent)
+
 	if execution.MarketBuyOrderExecutionEvent != nil {
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(execution.MarketBuyOrderExecutionEvent)
@@ -106,6 +109,7 @@ func (k *Keeper) PersistSingleDerivativeMarketOrderExecution(
 	}
 
 	for idx := range execution.CancelLimitOrderEvents {
+		k.recordCancelledDerivativeOrder(ctx, execution.CancelLimitOrderEvents[idx])
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(execution.CancelLimitOrderEvents[idx])
 	}
@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Force settling a derivative market", func() {
+	var (
+		testInput  testexchange.TestInput
+		app        *simapp.InjectiveApp
+		ctx        sdk.Context
+		msgServer  types.MsgServer
+		marketID   common.Hash
+		quoteDenom string
+
+		exchangeAddress sdk.AccAddress
+		authority       = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		longs           = []common.Hash{testexchange.SampleNonDefaultSubaccountAddr1, testexchange.SampleNonDefaultSubaccountAddr2}
+		shorts          = []common.Hash{testexchange.SampleNonDefaultSubaccountAddr3, testexchange.SampleNonDefaultSubaccountAddr4}
+		bystander       = testexchange.SampleNonDefaultSubaccountAddr5
+		bystanderFunds  = sdk.NewDec(1000000)
+		entryPrice      = sdk.NewDec(2000)
+		quantity        = sdk.NewDec(2)
+		margin          = sdk.NewDec(1000)
+		depositsBefore  map[common.Hash]sdk.Dec
+	)
+
+	getTotalBalance := func(subaccountID common.Hash) sdk.Dec {
+		return testexchange.GetBankAndDepositFunds(app, ctx, subaccountID, quoteDenom).TotalBalance
+	}
+
+	forceSettle := func(settlementPrice sdk.Dec) error {
+		_, err := msgServer.ForceSettleMarket(sdk.WrapSDKContext(ctx), &types.MsgForceSettleMarket{
+			Authority:       authority,
+			MarketId:        marketID.Hex(),
+			SettlementPrice: settlementPrice,
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		market := testInput.Perps[0]
+		marketID = market.MarketID
+		quoteDenom = market.QuoteDenom
+		exchangeAddress = app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, market.OracleBase, market.OracleQuote, oracletypes.NewPriceState(entryPrice, ctx.BlockTime().Unix()))
+
+		sender := sdk.AccAddress(common.FromHex("90f8bf6a479f320ead074411a4b0e7944ea8c9c1"))
+		coin := sdk.NewCoin(quoteDenom, sdk.NewInt(100))
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, coin, market.Ticker, quoteDenom, market.OracleBase, market.OracleQuote, market.OracleType, -1))
+
+		_, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			market.Ticker,
+			quoteDenom,
+			market.OracleBase,
+			market.OracleQuote,
+			0,
+			market.OracleType,
+			market.InitialMarginRatio,
+			market.MaintenanceMarginRatio,
+			market.MakerFeeRate,
+			market.TakerFeeRate,
+			market.MinPriceTickSize,
+			market.MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+
+		for i := range longs {
+			testexchange.MintAndDeposit(app, ctx, longs[i].String(), sdk.NewCoins(sdk.NewCoin(quoteDenom, sdk.NewInt(10000))))
+			testexchange.MintAndDeposit(app, ctx, shorts[i].String(), sdk.NewCoins(sdk.NewCoin(quoteDenom, sdk.NewInt(10000))))
+
+			_, err = msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateDerivativeLimitOrder(entryPrice, quantity, margin, types.OrderType_BUY, longs[i]))
+			testexchange.OrFail(err)
+			_, err = msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateDerivativeLimitOrder(entryPrice, quantity, margin, types.OrderType_SELL, shorts[i]))
+			testexchange.OrFail(err)
+		}
+
+		// an unrelated depositor of the quote denom, whose funds are also held by the exchange module account
+		testexchange.MintAndDeposit(app, ctx, bystander.String(), sdk.NewCoins(sdk.NewCoin(quoteDenom, bystanderFunds.TruncateInt())))
+
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		exchange.NewBlockHandler(app.ExchangeKeeper).BeginBlocker(ctx)
+
+		Expect(app.ExchangeKeeper.GetAllPositionsByMarket(ctx, marketID)).To(HaveLen(4))
+
+		depositsBefore = make(map[common.Hash]sdk.Dec)
+		for _, subaccountID := range append(append([]common.Hash{}, longs...), shorts...) {
+			depositsBefore[subaccountID] = getTotalBalance(subaccountID)
+		}
+	})
+
+	AfterEach(func() {
+		Expect(app.ExchangeKeeper.IsMetadataInvariantValid(ctx)).To(BeTrue())
+	})
+
+	Context("with long and short positions", func() {
+		var exchangeBalanceBefore sdk.Coin
+
+		BeforeEach(func() {
+			exchangeBalanceBefore = app.BankKeeper.GetBalance(ctx, exchangeAddress, quoteDenom)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			testexchange.OrFail(forceSettle(sdk.NewDec(2100)))
+		})
+
+		It("closes all positions and demolishes the market", func() {
+			Expect(app.ExchangeKeeper.GetAllPositionsByMarket(ctx, marketID)).To(BeEmpty())
+			Expect(app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID).Status).To(Equal(types.MarketStatus_Demolished))
+		})
+
+		It("refunds margin and pnl while conserving funds", func() {
+			pnl := sdk.NewDec(2100).Sub(entryPrice).Mul(quantity)
+			totalDelta := sdk.ZeroDec()
+
+			for _, subaccountID := range longs {
+				delta := getTotalBalance(subaccountID).Sub(depositsBefore[subaccountID])
+				Expect(delta.String()).To(Equal(margin.Add(pnl).String()))
+				totalDelta = totalDelta.Add(delta)
+			}
+			for _, subaccountID := range shorts {
+				delta := getTotalBalance(subaccountID).Sub(depositsBefore[subaccountID])
+				Expect(delta.String()).To(Equal(margin.Sub(pnl).String()))
+				totalDelta = totalDelta.Add(delta)
+			}
+
+			Expect(totalDelta.String()).To(Equal(margin.MulInt64(int64(len(longs) + len(shorts))).String()))
+			Expect(app.BankKeeper.GetBalance(ctx, exchangeAddress, quoteDenom)).To(Equal(exchangeBalanceBefore))
+			Expect(getTotalBalance(bystander).String()).To(Equal(bystanderFunds.String()))
+		})
+
+		It("emits a settlement event per position", func() {
+			settledSubaccounts := make([]string, 0)
+			for _, event := range ctx.EventManager().ABCIEvents() {
+				parsed, err := sdk.ParseTypedEvent(event)
+				if err != nil {
+					continue
+				}
+
+				if settled, ok := parsed.(*types.EventForceSettledPosition); ok {
+					Expect(settled.MarketId).To(Equal(marketID.Hex()))
+					Expect(settled.Quantity.String()).To(Equal(quantity.String()))
+					Expect(settled.SettlementPrice.String()).To(Equal(sdk.NewDec(2100).String()))
+					settledSubaccounts = append(settledSubaccounts, settled.SubaccountId)
+				}
+			}
+
+			Expect(settledSubaccounts).To(ConsistOf(longs[0].Hex(), longs[1].Hex(), shorts[0].Hex(), shorts[1].Hex()))
+		})
+	})
+
+	It("rolls back a settlement crediting more than the module account received", func() {
+		// a long position without counterparty, whose profit is paid by no loss. The funds of the unrelated depositor
+		// held by the module account could cover it, but the settlement must still conserve funds.
+		unbacked := testexchange.SampleNonDefaultSubaccountAddr6
+		app.ExchangeKeeper.SetPosition(ctx, marketID, unbacked, &types.Position{
+			IsLong:                 true,
+			Quantity:               quantity,
+			EntryPrice:             entryPrice,
+			Margin:                 margin,
+			CumulativeFundingEntry: app.ExchangeKeeper.GetPerpetualMarketFunding(ctx, marketID).CumulativeFunding,
+		})
+
+		err := forceSettle(sdk.NewDec(2100))
+		Expect(err).To(MatchError(types.ErrExchangeBalanceMismatch))
+
+		Expect(app.ExchangeKeeper.GetAllPositionsByMarket(ctx, marketID)).To(HaveLen(5))
+		Expect(app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID).Status).To(Equal(types.MarketStatus_Active))
+		for subaccountID, deposit := range depositsBefore {
+			Expect(getTotalBalance(subaccountID).String()).To(Equal(deposit.String()))
+		}
+		Expect(getTotalBalance(unbacked).IsZero()).To(BeTrue())
+	})
+
+	It("cancels the orders placed in the current block", func() {
+		_, err := msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateDerivativeLimitOrder(sdk.NewDec(1900), quantity, margin, types.OrderType_BUY, longs[0]))
+		testexchange.OrFail(err)
+
+		testexchange.OrFail(forceSettle(sdk.NewDec(2100)))
+
+		Expect(app.ExchangeKeeper.GetAllTransientDerivativeLimitOrdersByMarketDirection(ctx, marketID, true)).To(BeEmpty())
+		deposit := app.ExchangeKeeper.GetDeposit(ctx, longs[0], quoteDenom)
+		Expect(deposit.AvailableBalance.String()).To(Equal(deposit.TotalBalance.String()))
+	})
+
+	It("rejects a non-governance signer", func() {
+		_, err := msgServer.ForceSettleMarket(sdk.WrapSDKContext(ctx), &types.MsgForceSettleMarket{
+			Authority:       testexchange.DefaultAddress,
+			MarketId:        marketID.Hex(),
+			SettlementPrice: sdk.NewDec(2100),
+		})
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+	})
+})
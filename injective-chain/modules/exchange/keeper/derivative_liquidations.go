@@ -126,7 +126,7 @@ func (k *Keeper) cancelAllOrdersFromTraderInCurrentMarket(
 // 1: From trader's available balance
 // 2: From trader's locked balance by cancelling his vanilla limit orders
 // 3: From the insurance fund
-// 4: Not enough funds available. Pause the market and socialize losses.
+// 4: Not enough funds available. Pause the market and socialize losses, or auto-deleverage the opposing positions if enabled.
 func (k DerivativesMsgServer) handleNegativeLiquidationPayout(
 	ctx sdk.Context,
 	market *types.DerivativeMarket,
@@ -231,11 +231,12 @@ func (k DerivativesMsgServer) liquidatePosition(goCtx context.Context, msg *type
 		funding = k.GetPerpetualMarketFunding(cacheCtx, marketID)
 	}
 
-	liquidationPrice := position.GetLiquidationPrice(market.MaintenanceMarginRatio, funding)
-	shouldLiquidate := (position.IsLong && markPrice.LTE(liquidationPrice)) || (position.IsShort() && markPrice.GTE(liquidationPrice))
-
-	if !shouldLiquidate {
+	if !k.IsSubaccountPositionLiquidatable(cacheCtx, positionSubaccountID, position, market, markPrice, funding) {
 		metrics.ReportFuncError(k.svcTags)
+		if k.GetSubaccountMarginMode(cacheCtx, positionSubaccountID) == types.MarginMode_Cross {
+			return nil, errors.Wrapf(types.ErrPositionNotLiquidable, "cross margin subaccount %s is above its maintenance margin requirement in %s", positionSubaccountID.Hex(), market.QuoteDenom)
+		}
+		liquidationPrice := position.GetLiquidationPrice(market.MaintenanceMarginRatio, funding)
 		return nil, errors.Wrapf(types.ErrPositionNotLiquidable, "%s position liquidation price is %s but mark price is %s", position.GetDirectionString(), liquidationPrice.String(), markPrice.String())
 	}
 
@@ -392,6 +393,13 @@ func (k DerivativesMsgServer) liquidatePosition(goCtx context.Context, msg *type
 		})
 	}
 
+	if shouldSettleMarket && !isEmergencySettlingMarket && k.GetIsAutoDeleveragingEnabled(ctx) {
+		// the liquidation is discarded by not writing its cache context, the position is closed against the opposing
+		// positions at the end of the block instead and the market is only settled if they can't absorb it
+		k.EnqueueAutoDeleveraging(ctx, marketID, positionSubaccountID)
+		return &types.MsgLiquidatePositionResponse{}, nil
+	}
+
 	if isEmergencySettlingMarket && !shouldSettleMarket {
 		return nil, types.ErrInvalidEmergencySettle
 	}
@@ -408,7 +416,9 @@ func (k DerivativesMsgServer) liquidatePosition(goCtx context.Context, msg *type
 	return &types.MsgLiquidatePositionResponse{}, nil
 }
 
-func (k DerivativesMsgServer) pauseMarketAndScheduleForSettlement(
+// pauseMarketAndScheduleForSettlement pauses the market and schedules its settlement at the oracle price in the next
+// BeginBlocker.
+func (k *Keeper) pauseMarketAndScheduleForSettlement(
 	ctx sdk.Context,
 	market *types.DerivativeMarket,
 ) error {
@@ -600,6 +600,13 @@ func (k *Keeper) ExecuteDerivativeMarketParamUpdateProposal(ctx sdk.Context, p *
 		return fmt.Errorf("market is not available, market_id %s", p.MarketId)
 	}
 
+	if !prevMarket.IsActive() && p.Status == types.MarketStatus_Active {
+		if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return err
+		}
+	}
+
 	// cancel resting orders in the market when it shuts down
 	switch p.Status {
 	case types.MarketStatus_Expired,
@@ -637,6 +644,11 @@ func (k *Keeper) ExecuteDerivativeMarketParamUpdateProposal(ctx sdk.Context, p *
 		p.MinQuantityTickSize,
 		p.HourlyInterestRate,
 		p.HourlyFundingRateCap,
+		p.MaxOpenInterest,
+		p.MaxPositionSize,
+		p.PriceBandRatio,
+		p.MaxLeverage,
+		p.MaxSlippageRatio,
 		p.Status,
 		p.OracleParams,
 	); err != nil {
@@ -651,6 +663,7 @@ func (k *Keeper) UpdateDerivativeMarketParam(
 	marketID common.Hash,
 	initialMarginRatio, maintenanceMarginRatio, makerFeeRate, takerFeeRate, relayerFeeShareRate, minPriceTickSize, minQuantityTickSize *sdk.Dec,
 	hourlyInterestRate, hourlyFundingRateCap *sdk.Dec,
+	maxOpenInterest, maxPositionSize, priceBandRatio, maxLeverage, maxSlippageRatio *sdk.Dec,
 	status types.MarketStatus,
 	oracleParams *types.OracleParams,
 ) error {
@@ -684,6 +697,22 @@ func (k *Keeper) UpdateDerivativeMarketParam(
 	market.MinQuantityTickSize = *minQuantityTickSize
 	market.Status = status
 
+	if maxOpenInterest != nil {
+		market.MaxOpenInterest = *maxOpenInterest
+	}
+	if maxPositionSize != nil {
+		market.MaxPositionSize = *maxPositionSize
+	}
+	if priceBandRatio != nil {
+		market.PriceBandRatio = *priceBandRatio
+	}
+	if maxLeverage != nil {
+		market.MaxLeverage = *maxLeverage
+	}
+	if maxSlippageRatio != nil {
+		market.MaxSlippageRatio = *maxSlippageRatio
+	}
+
 	if oracleParams != nil {
 		market.OracleBase = oracleParams.OracleBase
 		market.OracleQuote = oracleParams.OracleQuote
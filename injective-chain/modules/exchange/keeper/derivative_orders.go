@@ -77,7 +77,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrdersForSubaccountUpToBalance(
 			continue
 		} else {
 			notional := order.OrderInfo.Price.Mul(order.OrderInfo.Quantity)
-			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(notional.Mul(positiveFeePart))).Quo(order.OrderInfo.Quantity)
+			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(types.CalculateFee(notional, positiveFeePart))).Quo(order.OrderInfo.Quantity)
 			freedUpBalance = freedUpBalance.Add(marginHoldRefund)
 		}
 	}
@@ -95,7 +95,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrdersForSubaccountUpToBalance(
 			continue
 		} else {
 			notional := order.OrderInfo.Price.Mul(order.OrderInfo.Quantity)
-			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(notional.Mul(positiveFeePart))).Quo(order.OrderInfo.Quantity)
+			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(types.CalculateFee(notional, positiveFeePart))).Quo(order.OrderInfo.Quantity)
 			freedUpBalance = freedUpBalance.Add(marginHoldRefund)
 		}
 	}
@@ -219,6 +219,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrder(
 	k.DeleteDerivativeLimitOrder(ctx, marketID, order)
 
 	k.UpdateSubaccountOrderbookMetadataFromOrderCancel(ctx, marketID, subaccountID, order)
+	k.recordOrderTermination(ctx, orderHash, types.OrderHistoryEventType_OrderHistoryCancelled)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventCancelDerivativeOrder{
@@ -262,6 +263,9 @@ func (k *Keeper) SetNewDerivativeLimitOrderWithMetadata(
 	// Set cid => orderHash
 	k.setCid(ctx, false, subaccountID, order.OrderInfo.Cid, marketID, order.IsBuy(), orderHash)
 
+	// index the order by its expiration timestamp
+	k.setOrderExpiration(ctx, false, marketID, isBuy, subaccountID, orderHash, order.OrderInfo.ExpirationTimestamp)
+
 	if metadata == nil {
 		metadata = k.GetSubaccountOrderbookMetadata(ctx, marketID, subaccountID, isBuy)
 	}
@@ -279,6 +283,8 @@ func (k *Keeper) SetNewDerivativeLimitOrderWithMetadata(
 
 	// update the orderbook metadata
 	k.IncrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, false, price, order.GetFillable())
+
+	k.incrementSubaccountOpenOrderCount(ctx, false, subaccountID)
 }
 
 // UpdateDerivativeLimitOrdersFromFilledDeltas applies the filledDeltas to the derivative limit orders and stores the updated order (and order index) in the keeper.
@@ -341,6 +347,8 @@ func (k *Keeper) UpdateDerivativeLimitOrdersFromFilledDeltas(
 				ordersStore.Delete(priceKey)
 				ordersIndexStore.Delete(subaccountIndexKey)
 				k.deleteCid(ctx, false, subaccountID, filledDelta.Order.OrderInfo.Cid)
+				k.deleteOrderExpiration(ctx, marketID, isBuy, orderHash, filledDelta.Order.OrderInfo.ExpirationTimestamp)
+				k.decrementSubaccountOpenOrderCount(ctx, false, subaccountID)
 			}
 
 			store.Delete(subaccountOrderKey)
@@ -356,6 +364,8 @@ func (k *Keeper) UpdateDerivativeLimitOrdersFromFilledDeltas(
 			if !isResting {
 				ordersIndexStore.Set(subaccountIndexKey, priceKey)
 				k.setCid(ctx, false, subaccountID, cid, marketID, isBuy, orderHash)
+				k.setOrderExpiration(ctx, false, marketID, isBuy, subaccountID, orderHash, filledDelta.Order.OrderInfo.ExpirationTimestamp)
+				k.restSubaccountOpenOrder(ctx, subaccountID)
 			}
 			ordersStore.Set(priceKey, orderBz)
 			subaccountOrder := &types.SubaccountOrder{
@@ -453,6 +463,10 @@ func (k *Keeper) DeleteDerivativeLimitOrder(
 	subaccountIndexKey := types.GetLimitOrderIndexKey(marketID, isBuy, subaccountID, orderHash)
 	subaccountOrderKey := types.GetSubaccountOrderKey(marketID, subaccountID, isBuy, price, orderHash)
 
+	if ordersStore.Has(priceKey) {
+		k.decrementSubaccountOpenOrderCount(ctx, false, subaccountID)
+	}
+
 	// delete main spot order store
 	ordersStore.Delete(priceKey)
 
@@ -465,6 +479,9 @@ func (k *Keeper) DeleteDerivativeLimitOrder(
 	// delete cid
 	k.deleteCid(ctx, false, order.SubaccountID(), order.Cid())
 
+	// delete from expiration index
+	k.deleteOrderExpiration(ctx, marketID, isBuy, orderHash, order.OrderInfo.ExpirationTimestamp)
+
 	// update orderbook metadata
 	k.DecrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, false, order.GetPrice(), order.GetFillable())
 }
@@ -811,7 +828,7 @@ func GetDerivativeOrdersToCancelUpToAmount(
 		ordersToCancel = append(ordersToCancel, order)
 
 		notional := order.Fillable.Mul(order.Price)
-		fee := notional.Mul(positiveMakerFeePart)
+		fee := types.CalculateFee(notional, positiveMakerFeePart)
 		remainingMargin := order.Margin.Mul(order.Fillable).Quo(order.Quantity)
 		cumulativeQuoteAmount = cumulativeQuoteAmount.Add(remainingMargin).Add(fee)
 	}
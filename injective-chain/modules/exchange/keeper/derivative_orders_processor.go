@@ -524,17 +524,17 @@ func getDerivativeOrderFeesAndRefunds(
 	)
 
 	// the fee refund for the unfilled order quantity
-	unmatchedFeeRefund = unfilledQuantity.Mul(orderPrice).Mul(unmatchedFeeRefundRate)
+	unmatchedFeeRefund = types.CalculateFee(unfilledQuantity.Mul(orderPrice), unmatchedFeeRefundRate)
 
 	// for a buy order, priceDelta >= 0, so get a fee refund for the matching, since the margin assumed a higher price
 	// for a sell order, priceDelta <= 0, so pay extra trading fee
 
 	// matched fee refund or charge = FillQuantity * ΔPrice * Rate
 	// this is the fee refund or charge resulting from the order being executed at a better price
-	matchedFeePriceDeltaRefundOrCharge := fillQuantity.Mul(priceDelta).Mul(positiveDiscountedFeeRatePart)
+	matchedFeePriceDeltaRefundOrCharge := types.CalculateFee(fillQuantity.Mul(priceDelta), positiveDiscountedFeeRatePart)
 
 	feeRateDelta := positiveTradeFeeRatePart.Sub(positiveDiscountedFeeRatePart)
-	matchedFeeDiscountRefund := fillQuantity.Mul(orderPrice).Mul(feeRateDelta)
+	matchedFeeDiscountRefund := types.CalculateFee(fillQuantity.Mul(orderPrice), feeRateDelta)
 
 	matchedFeeRefundOrCharge = matchedFeePriceDeltaRefundOrCharge.Add(matchedFeeDiscountRefund)
 
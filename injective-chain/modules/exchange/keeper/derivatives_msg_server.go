@@ -60,6 +60,12 @@ func (k DerivativesMsgServer) InstantPerpetualMarketLaunch(goCtx context.Context
 		return nil, err
 	}
 
+	if err := k.ChargeMarketCreationFee(ctx, senderAddr); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("failed launching derivative market", err)
+		return nil, err
+	}
+
 	_, _, err = k.PerpetualMarketLaunch(
 		ctx, msg.Ticker, msg.QuoteDenom, msg.OracleBase, msg.OracleQuote, msg.OracleScaleFactor, msg.OracleType,
 		msg.InitialMarginRatio, msg.MaintenanceMarginRatio,
@@ -100,6 +106,12 @@ func (k DerivativesMsgServer) InstantExpiryFuturesMarketLaunch(goCtx context.Con
 		return nil, err
 	}
 
+	if err := k.ChargeMarketCreationFee(ctx, senderAddr); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("failed launching derivative market", err)
+		return nil, err
+	}
+
 	if _, _, err := k.ExpiryFuturesMarketLaunch(
 		ctx, msg.Ticker, msg.QuoteDenom,
 		msg.OracleBase, msg.OracleQuote, msg.OracleScaleFactor, msg.OracleType, msg.Expiry,
@@ -125,6 +137,9 @@ func (k DerivativesMsgServer) CreateDerivativeLimitOrder(goCtx context.Context,
 	if market == nil || markPrice.IsNil() {
 		k.Logger(ctx).Error("active derivative market with valid mark price doesn't exist", "marketId", msg.Order.MarketId, "mark price", markPrice.String())
 		metrics.ReportFuncError(k.svcTags)
+		if market == nil && k.IsMarketPaused(ctx, msg.Order.MarketID()) {
+			return nil, types.ErrMarketPaused.Wrapf("derivative market %s is paused", msg.Order.MarketId)
+		}
 		return nil, sdkerrors.Wrapf(types.ErrDerivativeMarketNotFound, "active derivative market for marketID %s not found", msg.Order.MarketId)
 	}
 
@@ -135,6 +150,11 @@ func (k DerivativesMsgServer) CreateDerivativeLimitOrder(goCtx context.Context,
 		return nil, err
 	}
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, account, 1); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	return &types.MsgCreateDerivativeLimitOrderResponse{
 		OrderHash: orderHash.Hex(),
 	}, nil
@@ -156,8 +176,24 @@ func (k *Keeper) createDerivativeLimitOrder(
 
 	marketID := order.MarketID()
 
+	if err := k.ensureMarketWithinTradingHours(ctx, marketID); err != nil {
+		return common.Hash{}, err
+	}
+
 	metadata := k.GetSubaccountOrderbookMetadata(ctx, marketID, subaccountID, order.IsBuy())
 
+	if err := k.ensurePositionCapsNotExceeded(ctx, market, order, metadata); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := k.ensureOrderWithinPriceBand(market, order, markPrice, false); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := k.ensureOrderWithinMaxLeverage(ctx, market, order); err != nil {
+		return common.Hash{}, err
+	}
+
 	isMaker := order.OrderType.IsPostOnly()
 
 	orderHash, err := k.ensureValidDerivativeOrder(ctx, order, market, metadata, markPrice, false, nil, isMaker)
@@ -175,6 +211,8 @@ func (k *Keeper) createDerivativeLimitOrder(
 		return orderHash, nil
 	}
 
+	k.recordOrderPlacement(ctx, marketID, subaccountID, orderHash, order.IsBuy(), order.OrderInfo.Price, order.OrderInfo.Quantity)
+
 	if order.OrderType.IsPostOnly() {
 		k.SetPostOnlyDerivativeLimitOrderWithMetadata(ctx, derivativeLimitOrder, metadata, marketID)
 		return orderHash, nil
@@ -235,6 +273,11 @@ func (k DerivativesMsgServer) BatchCreateDerivativeLimitOrders(goCtx context.Con
 		ctx.EventManager().EmitTypedEvent(&orderFailEvent)
 	}
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, sender, countCreatedOrders(orderHashes)); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	return &types.MsgBatchCreateDerivativeLimitOrdersResponse{
 		OrderHashes: orderHashes,
 	}, nil
@@ -249,8 +292,29 @@ func (k *Keeper) createDerivativeMarketOrder(ctx sdk.Context, sender sdk.AccAddr
 	// set the actual subaccountID value in the order, since it might be a nonce value
 	derivativeOrder.OrderInfo.SubaccountId = subaccountID.Hex()
 
+	if err := k.ensureMarketWithinTradingHours(ctx, marketID); err != nil {
+		return orderHash, nil, err
+	}
+
+	// bound the worst price first, the later checks apply to the bounded order
+	if err := k.applyMarketOrderSlippageBound(ctx, market, derivativeOrder, markPrice); err != nil {
+		return orderHash, nil, err
+	}
+
 	metadata := k.GetSubaccountOrderbookMetadata(ctx, marketID, subaccountID, derivativeOrder.IsBuy())
 
+	if err := k.ensurePositionCapsNotExceeded(ctx, market, derivativeOrder, metadata); err != nil {
+		return orderHash, nil, err
+	}
+
+	if err := k.ensureOrderWithinPriceBand(market, derivativeOrder, markPrice, true); err != nil {
+		return orderHash, nil, err
+	}
+
+	if err := k.ensureOrderWithinMaxLeverage(ctx, market, derivativeOrder); err != nil {
+		return orderHash, nil, err
+	}
+
 	var orderMarginHold sdk.Dec
 	orderHash, err = k.ensureValidDerivativeOrder(ctx, derivativeOrder, market, metadata, markPrice, true, &orderMarginHold, false)
 	if err != nil {
@@ -308,6 +372,9 @@ func (k DerivativesMsgServer) CreateDerivativeMarketOrder(goCtx context.Context,
 	if market == nil {
 		k.Logger(ctx).Error("active derivative market doesn't exist", "marketId", msg.Order.MarketId)
 		metrics.ReportFuncError(k.svcTags)
+		if k.IsMarketPaused(ctx, msg.Order.MarketID()) {
+			return nil, types.ErrMarketPaused.Wrapf("derivative market %s is paused", msg.Order.MarketId)
+		}
 		return nil, sdkerrors.Wrapf(types.ErrDerivativeMarketNotFound, "active derivative market for marketID %s not found", msg.Order.MarketId)
 	}
 
@@ -317,6 +384,11 @@ func (k DerivativesMsgServer) CreateDerivativeMarketOrder(goCtx context.Context,
 		return nil, err
 	}
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, account, 1); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	resp := &types.MsgCreateDerivativeMarketOrderResponse{
 		OrderHash: orderHash.Hex(),
 	}
@@ -511,6 +583,13 @@ func (k DerivativesMsgServer) IncreasePositionMargin(goCtx context.Context, msg
 		return nil, sdkerrors.Wrapf(types.ErrDerivativeMarketNotFound, "active derivative market for marketID %s not found", marketID.Hex())
 	}
 
+	// adding margin to a position of the same subaccount leaves its cross margin equity unchanged
+	if sourceSubaccountID != destinationSubaccountID {
+		if err := k.EnsureCrossMarginRequirement(ctx, sourceSubaccountID, market.QuoteDenom, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
 	marginIncrement, err := k.DecrementDepositOrChargeFromBank(ctx, sourceSubaccountID, market.QuoteDenom, msg.Amount)
 	if err != nil {
 		return nil, err
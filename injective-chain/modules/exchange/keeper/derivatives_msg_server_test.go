@@ -729,7 +729,7 @@ var _ = Describe("Derivatives MsgServer Tests", func() {
 			It("Should be invalid with invalid price error", func() {
 				errorMessage1 := "price " + message.Order.OrderInfo.Price.String()
 				errorMessage2 := " must be a multiple of the minimum price tick size " + derivativeMarket.MinPriceTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidPrice.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrPriceTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
@@ -742,7 +742,7 @@ var _ = Describe("Derivatives MsgServer Tests", func() {
 			It("Should be invalid with invalid quantity error", func() {
 				errorMessage1 := "quantity " + message.Order.OrderInfo.Quantity.String()
 				errorMessage2 := " must be a multiple of the minimum quantity tick size " + derivativeMarket.MinQuantityTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidQuantity.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrQuantityTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
@@ -1675,7 +1675,7 @@ var _ = Describe("Derivatives MsgServer Tests", func() {
 			It("Should be invalid with invalid price error", func() {
 				errorMessage1 := "price " + message.Order.OrderInfo.Price.String()
 				errorMessage2 := " must be a multiple of the minimum price tick size " + derivativeMarket.MinPriceTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidPrice.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrPriceTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
@@ -1688,7 +1688,7 @@ var _ = Describe("Derivatives MsgServer Tests", func() {
 			It("Should be invalid with invalid quantity error", func() {
 				errorMessage1 := "quantity " + message.Order.OrderInfo.Quantity.String()
 				errorMessage2 := " must be a multiple of the minimum quantity tick size " + derivativeMarket.MinQuantityTickSize.String() + ": "
-				expectedError := errorMessage1 + errorMessage2 + types.ErrInvalidQuantity.Error()
+				expectedError := errorMessage1 + errorMessage2 + types.ErrQuantityTickSizeMisaligned.Error()
 				Expect(err.Error()).To(Equal(expectedError))
 			})
 		})
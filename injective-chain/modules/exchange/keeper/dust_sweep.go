@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// SweepDustDeposits checks up to DustSweepMaxDepositsPerBlock deposits, resuming after the last deposit checked in the
+// previous block, and sweeps the idle deposits whose balance is below the dust threshold of their denom. The integer
+// part of the swept balances is sent to the community pool or burned, while the fractional remainders stay in the
+// module. A deposit at or above the threshold, or with balance locked in orders, is never swept.
+func (k *Keeper) SweepDustDeposits(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	if params.DustSweepMaxDepositsPerBlock == 0 || params.DustThresholds.Empty() {
+		return
+	}
+
+	store := k.getStore(ctx)
+	depositStore := prefix.NewStore(store, types.DepositsPrefix)
+
+	var start []byte
+	if cursor := store.Get(types.DustSweepCursorKey); cursor != nil {
+		// resume right after the last checked deposit
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+
+	iterator := depositStore.Iterator(start, nil)
+	sweptKeys := make([][]byte, 0)
+	var lastCheckedKey []byte
+
+	for checked := uint32(0); iterator.Valid() && checked < params.DustSweepMaxDepositsPerBlock; iterator.Next() {
+		checked++
+		lastCheckedKey = iterator.Key()
+
+		subaccountID, denom := types.ParseDepositStoreKey(lastCheckedKey)
+		threshold := params.DustThresholds.AmountOf(denom)
+		if !threshold.IsPositive() || subaccountID == types.AuctionSubaccountID {
+			continue
+		}
+
+		var deposit types.Deposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		if deposit.TotalBalance.IsNil() || deposit.AvailableBalance.IsNil() {
+			continue
+		}
+
+		isDust := deposit.TotalBalance.IsPositive() && deposit.TotalBalance.LT(threshold) && deposit.AvailableBalance.Equal(deposit.TotalBalance)
+		if isDust {
+			sweptKeys = append(sweptKeys, lastCheckedKey)
+		}
+	}
+
+	// a cycle ends when the last deposit was checked, the next sweep starts over
+	isCycleOver := !iterator.Valid()
+	iterator.Close()
+
+	if isCycleOver {
+		store.Delete(types.DustSweepCursorKey)
+	} else {
+		store.Set(types.DustSweepCursorKey, lastCheckedKey)
+	}
+
+	if len(sweptKeys) == 0 {
+		return
+	}
+
+	// sweep on a cached context so that the deposits are kept if the dust can't be sent
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	swept := sdk.NewDecCoins()
+	for _, key := range sweptKeys {
+		subaccountID, denom := types.ParseDepositStoreKey(key)
+		deposit := k.GetDeposit(cacheCtx, subaccountID, denom)
+		swept = swept.Add(sdk.NewDecCoinFromDec(denom, deposit.TotalBalance))
+		k.SetDeposit(cacheCtx, subaccountID, denom, types.NewDeposit())
+	}
+
+	amount, _ := swept.TruncateDecimal()
+	if err := k.sendSweptDust(cacheCtx, amount, params.DustSweepDestination); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("dust sweep failed", "amount", amount.String(), "error", err.Error())
+		return
+	}
+	writeCache()
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventDustSweep{
+		Destination:   params.DustSweepDestination,
+		SweptDeposits: uint32(len(sweptKeys)),
+		Swept:         swept,
+		Amount:        amount,
+	})
+}
+
+func (k *Keeper) sendSweptDust(ctx sdk.Context, amount sdk.Coins, destination types.SpamFeeDestination) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	if destination == types.SpamFeeDestination_Burn {
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount)
+	}
+
+	return k.DistributionKeeper.FundCommunityPool(ctx, amount, k.AccountKeeper.GetModuleAddress(types.ModuleName))
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Dust sweep", func() {
+	const denom = "usdt"
+
+	var (
+		app *simapp.InjectiveApp
+		ctx sdk.Context
+	)
+
+	subaccountID := func(account sdk.AccAddress) common.Hash {
+		id, err := types.SdkAddressWithNonceToSubaccountID(account, 1)
+		testexchange.OrFail(err)
+		return *id
+	}
+
+	dustSubaccount := subaccountID(testexchange.SampleAccountAddr1)
+	thresholdSubaccount := subaccountID(testexchange.SampleAccountAddr2)
+	largeSubaccount := subaccountID(testexchange.SampleAccountAddr3)
+	lockedSubaccount := subaccountID(testexchange.SampleAccountAddr4)
+
+	deposit := func(subaccountID common.Hash, amount int64) {
+		testexchange.MintAndDeposit(app, ctx, subaccountID.Hex(), sdk.NewCoins(sdk.NewInt64Coin(denom, amount)))
+	}
+
+	communityPool := func() sdk.Dec {
+		return app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom)
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.DustThresholds = sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 10))
+		params.DustSweepDestination = types.SpamFeeDestination_CommunityPool
+		params.DustSweepMaxDepositsPerBlock = 100
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		deposit(dustSubaccount, 3)
+		deposit(thresholdSubaccount, 10)
+		deposit(largeSubaccount, 100)
+
+		// a deposit partly locked in orders
+		deposit(lockedSubaccount, 5)
+		app.ExchangeKeeper.SetDeposit(ctx, lockedSubaccount, denom, &types.Deposit{
+			AvailableBalance: sdk.NewDec(2),
+			TotalBalance:     sdk.NewDec(5),
+		})
+	})
+
+	It("sweeps the idle deposits below the threshold to the community pool", func() {
+		poolBefore := communityPool()
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		app.ExchangeKeeper.SweepDustDeposits(ctx)
+
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, dustSubaccount, denom).IsEmpty()).To(BeTrue())
+		Expect(communityPool().String()).To(Equal(poolBefore.Add(sdk.NewDec(3)).String()))
+
+		sweepEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "injective.exchange.v1beta1.EventDustSweep" {
+				sweepEvents++
+			}
+		}
+		Expect(sweepEvents).To(Equal(1))
+	})
+
+	It("leaves the deposits at or above the threshold and the locked deposits untouched", func() {
+		app.ExchangeKeeper.SweepDustDeposits(ctx)
+
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, thresholdSubaccount, denom).TotalBalance.String()).To(Equal(sdk.NewDec(10).String()))
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, largeSubaccount, denom).TotalBalance.String()).To(Equal(sdk.NewDec(100).String()))
+
+		lockedDeposit := app.ExchangeKeeper.GetDeposit(ctx, lockedSubaccount, denom)
+		Expect(lockedDeposit.AvailableBalance.String()).To(Equal(sdk.NewDec(2).String()))
+		Expect(lockedDeposit.TotalBalance.String()).To(Equal(sdk.NewDec(5).String()))
+	})
+
+	It("burns the dust and keeps the fractional remainder in the module", func() {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.DustSweepDestination = types.SpamFeeDestination_Burn
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		fractionalSubaccount := subaccountID(testexchange.SampleAccountAddr5)
+		app.ExchangeKeeper.SetDeposit(ctx, fractionalSubaccount, denom, &types.Deposit{
+			AvailableBalance: sdk.MustNewDecFromStr("0.5"),
+			TotalBalance:     sdk.MustNewDecFromStr("0.5"),
+		})
+
+		supplyBefore := app.BankKeeper.GetSupply(ctx, denom).Amount
+		poolBefore := communityPool()
+
+		app.ExchangeKeeper.SweepDustDeposits(ctx)
+
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, dustSubaccount, denom).IsEmpty()).To(BeTrue())
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, fractionalSubaccount, denom).IsEmpty()).To(BeTrue())
+		Expect(app.BankKeeper.GetSupply(ctx, denom).Amount).To(Equal(supplyBefore.SubRaw(3)))
+		Expect(communityPool().String()).To(Equal(poolBefore.String()))
+	})
+
+	It("checks at most the capped number of deposits per block", func() {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.DustSweepMaxDepositsPerBlock = 1
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		otherDustSubaccount := subaccountID(testexchange.SampleAccountAddr5)
+		deposit(otherDustSubaccount, 4)
+		poolBefore := communityPool()
+
+		sweptDeposits := func() int {
+			count := 0
+			for _, subaccountID := range []common.Hash{dustSubaccount, otherDustSubaccount} {
+				if app.ExchangeKeeper.GetDeposit(ctx, subaccountID, denom).IsEmpty() {
+					count++
+				}
+			}
+			return count
+		}
+
+		// the five deposits are checked one per block, sweeping both dust deposits within the cycle
+		for i := 0; i < 5; i++ {
+			sweptBefore := sweptDeposits()
+			app.ExchangeKeeper.SweepDustDeposits(ctx)
+			Expect(sweptDeposits() - sweptBefore).To(BeNumerically("<=", 1))
+		}
+
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, dustSubaccount, denom).IsEmpty()).To(BeTrue())
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, otherDustSubaccount, denom).IsEmpty()).To(BeTrue())
+		Expect(communityPool().String()).To(Equal(poolBefore.Add(sdk.NewDec(7)).String()))
+	})
+
+	It("doesn't sweep with the dust sweep disabled", func() {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.DustSweepMaxDepositsPerBlock = 0
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		app.ExchangeKeeper.SweepDustDeposits(ctx)
+
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, dustSubaccount, denom).TotalBalance.String()).To(Equal(sdk.NewDec(3).String()))
+	})
+})
@@ -11,6 +11,10 @@ import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
 )
 
+// MaxDelegationsForStakedAmount bounds the number of delegations read from the staking keeper
+// when computing the staked amount of an account for fee discounts and trading rewards.
+const MaxDelegationsForStakedAmount uint16 = 10
+
 func (k *Keeper) PersistFeeDiscountStakingInfoUpdates(
 	ctx sdk.Context,
 	stakingInfo *FeeDiscountStakingInfo,
@@ -43,6 +47,10 @@ func (k *Keeper) PersistFeeDiscountStakingInfoUpdates(
 		contribution := marketVolumeContributions[idx]
 		k.IncrementMarketAggregateVolume(ctx, contribution.MarketID, contribution.Volume)
 	}
+
+	if k.GetMakerRebateEpochDuration(ctx) > 0 {
+		k.recordMakerRebateVolumes(ctx, subaccountVolumeContributions)
+	}
 }
 
 func (k *Keeper) FetchAndUpdateDiscountedTradingFeeRate(
@@ -227,8 +235,7 @@ func (k *Keeper) CalculateStakedAmountWithCache(
 ) sdkmath.Int {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
-	maxDelegations := uint16(10)
-	delegations := k.StakingKeeper.GetDelegatorDelegations(ctx, trader, maxDelegations)
+	delegations := k.StakingKeeper.GetDelegatorDelegations(ctx, trader, MaxDelegationsForStakedAmount)
 
 	totalStaked := sdk.ZeroInt()
 	for _, delegation := range delegations {
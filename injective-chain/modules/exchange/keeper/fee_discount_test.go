@@ -655,5 +655,63 @@ var _ = Describe("Fee Discount Tests", func() {
 				Expect(discountedFeeRate.String()).Should(Equal(tradingFeeRate.Mul(sdk.OneDec().Sub(tierInfos[2].MakerDiscountRate)).String()))
 			})
 		})
+
+		Describe("when querying the fee discount account info", func() {
+			var (
+				schedule    *exchangetypes.FeeDiscountSchedule
+				accountInfo *exchangetypes.QueryFeeDiscountAccountInfoResponse
+			)
+
+			BeforeEach(func() {
+				pastVolumeBuckets = []sdk.Dec{sdk.NewDec(3), sdk.NewDec(1), sdk.NewDec(2), sdk.NewDec(2), sdk.NewDec(1)}
+				stakedAmount = sdk.NewInt(100)
+			})
+
+			JustBeforeEach(func() {
+				scheduleRes, err := app.ExchangeKeeper.FeeDiscountSchedule(sdk.WrapSDKContext(ctx), &exchangetypes.QueryFeeDiscountScheduleRequest{})
+				testexchange.OrFail(err)
+				schedule = scheduleRes.FeeDiscountSchedule
+
+				accountInfo, err = app.ExchangeKeeper.FeeDiscountAccountInfo(sdk.WrapSDKContext(ctx), &exchangetypes.QueryFeeDiscountAccountInfoRequest{
+					Account: traderAddress.String(),
+				})
+				testexchange.OrFail(err)
+			})
+
+			Context("when the trader qualifies for a tier", func() {
+				BeforeEach(func() {
+					stakedAmount = sdk.NewInt(200)
+				})
+
+				It("should return the tier of the schedule", func() {
+					Expect(schedule.TierInfos).Should(HaveLen(len(tierInfos)))
+					Expect(accountInfo.TierLevel).Should(Equal(uint64(2)))
+
+					tierInfo := schedule.TierInfos[accountInfo.TierLevel-1]
+					Expect(accountInfo.AccountInfo.MakerDiscountRate.String()).Should(Equal(tierInfo.MakerDiscountRate.String()))
+					Expect(accountInfo.AccountInfo.TakerDiscountRate.String()).Should(Equal(tierInfo.TakerDiscountRate.String()))
+					Expect(accountInfo.AccountInfo.StakedAmount.String()).Should(Equal(stakedAmount.String()))
+					Expect(accountInfo.AccountInfo.StakedAmount.GTE(tierInfo.StakedAmount)).Should(BeTrue())
+				})
+			})
+
+			Context("when the trader doesn't qualify for any tier", func() {
+				BeforeEach(func() {
+					stakedAmount = sdk.NewInt(99)
+				})
+
+				It("should return the tier zero", func() {
+					Expect(accountInfo.TierLevel).Should(BeZero())
+					Expect(accountInfo.AccountInfo.StakedAmount.LT(schedule.TierInfos[0].StakedAmount)).Should(BeTrue())
+				})
+			})
+
+			It("should reject invalid addresses", func() {
+				_, err := app.ExchangeKeeper.FeeDiscountAccountInfo(sdk.WrapSDKContext(ctx), &exchangetypes.QueryFeeDiscountAccountInfoRequest{
+					Account: "invalid",
+				})
+				Expect(err).Should(MatchError(exchangetypes.ErrInvalidAddress))
+			})
+		})
 	})
 })
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// settleFee converts a fee paid by the payer into the fee settlement denom, before it's burned or sent to the
+// community pool. The fee settlement pool buys the fee at the oracle price of the fee denom in the settlement denom,
+// discounted by the fee settlement slippage, and the settled fee is then paid by the pool. The fee is left as is when
+// the conversion is disabled, when the price is missing or older than the max price age, or when the pool doesn't hold
+// enough of the settlement denom. It returns the account paying the fee and the fee to pay.
+func (k *Keeper) settleFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.AccAddress, sdk.Coin) {
+	params := k.GetParams(ctx)
+
+	settlementDenom := params.FeeSettlementDenom
+	if settlementDenom == "" || fee.Denom == settlementDenom || !fee.IsPositive() {
+		return payer, fee
+	}
+
+	price := k.getFeeSettlementPrice(ctx, fee.Denom, params)
+	if price == nil {
+		return payer, fee
+	}
+
+	conversionPrice := price.Mul(sdk.OneDec().Sub(params.FeeSettlementSlippage))
+	settledFee := sdk.NewCoin(settlementDenom, fee.Amount.ToDec().Mul(conversionPrice).TruncateInt())
+	if !settledFee.IsPositive() {
+		return payer, fee
+	}
+
+	if k.bankKeeper.GetBalance(ctx, types.FeeSettlementPoolAddress, settlementDenom).IsLT(settledFee) {
+		k.Logger(ctx).Debug("fee settlement pool balance too low, the fee isn't converted", "fee", fee.String(), "settled_fee", settledFee.String())
+		return payer, fee
+	}
+
+	// the payer keeps paying the fee itself when it can't pay the pool
+	if err := k.bankKeeper.SendCoins(ctx, payer, types.FeeSettlementPoolAddress, sdk.NewCoins(fee)); err != nil {
+		return payer, fee
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventFeeSettlement{
+		Payer:      payer.String(),
+		Fee:        fee,
+		SettledFee: settledFee,
+		Price:      conversionPrice,
+	})
+
+	return types.FeeSettlementPoolAddress, settledFee
+}
+
+// getFeeSettlementPrice returns the oracle price of the denom in the fee settlement denom, or nil if there is no
+// positive price updated within the max price age.
+func (k *Keeper) getFeeSettlementPrice(ctx sdk.Context, denom string, params types.Params) *sdk.Dec {
+	pricePairState := k.OracleKeeper.GetPricePairState(ctx, params.FeeSettlementOracleType, denom, params.FeeSettlementDenom)
+	if pricePairState == nil || pricePairState.PairPrice.IsNil() || !pricePairState.PairPrice.IsPositive() {
+		return nil
+	}
+
+	oldestTimestamp := pricePairState.BaseTimestamp
+	if pricePairState.QuoteTimestamp < oldestTimestamp {
+		oldestTimestamp = pricePairState.QuoteTimestamp
+	}
+
+	if ctx.BlockTime().Unix()-oldestTimestamp > params.FeeSettlementMaxPriceAge {
+		return nil
+	}
+
+	return &pricePairState.PairPrice
+}
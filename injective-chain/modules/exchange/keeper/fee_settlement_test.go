@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Fee settlement", func() {
+	const (
+		feeDenom        = "usdt"
+		settlementDenom = "inj"
+		maxPriceAge     = 600
+	)
+
+	var (
+		app *simapp.InjectiveApp
+		ctx sdk.Context
+	)
+	sender := testexchange.SampleAccountAddr1
+
+	mint := func(recipient sdk.AccAddress, coin sdk.Coin) {
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, recipient, sdk.NewCoins(coin)))
+	}
+
+	balanceOf := func(account sdk.AccAddress, denom string) math.Int {
+		return app.BankKeeper.GetBalance(ctx, account, denom).Amount
+	}
+
+	setPrice := func(price sdk.Dec, timestamp int64) {
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, feeDenom, settlementDenom, oracletypes.NewPriceState(price, timestamp))
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MarketCreationFee = sdk.NewInt64Coin(feeDenom, 100)
+		params.SpamFeeDestination = types.SpamFeeDestination_Burn
+		params.FeeSettlementDenom = settlementDenom
+		params.FeeSettlementOracleType = oracletypes.OracleType_PriceFeed
+		params.FeeSettlementMaxPriceAge = maxPriceAge
+		params.FeeSettlementSlippage = sdk.NewDecWithPrec(2, 2)
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		mint(sender, sdk.NewInt64Coin(feeDenom, 1000))
+		mint(types.FeeSettlementPoolAddress, sdk.NewInt64Coin(settlementDenom, 1000))
+	})
+
+	It("converts the fee at the oracle price with a fresh price", func() {
+		setPrice(sdk.NewDecWithPrec(5, 1), ctx.BlockTime().Unix()-maxPriceAge)
+		settlementSupply := app.BankKeeper.GetSupply(ctx, settlementDenom).Amount
+		feeSupply := app.BankKeeper.GetSupply(ctx, feeDenom).Amount
+
+		testexchange.OrFail(app.ExchangeKeeper.ChargeMarketCreationFee(ctx, sender))
+
+		// 100 usdt at 0.5 inj with a 2% slippage
+		settledFee := math.NewInt(49)
+		Expect(balanceOf(sender, feeDenom)).To(Equal(math.NewInt(900)))
+		Expect(balanceOf(types.FeeSettlementPoolAddress, feeDenom)).To(Equal(math.NewInt(100)))
+		Expect(balanceOf(types.FeeSettlementPoolAddress, settlementDenom)).To(Equal(math.NewInt(1000).Sub(settledFee)))
+		Expect(app.BankKeeper.GetSupply(ctx, settlementDenom).Amount).To(Equal(settlementSupply.Sub(settledFee)))
+		Expect(app.BankKeeper.GetSupply(ctx, feeDenom).Amount).To(Equal(feeSupply))
+	})
+
+	It("skips the conversion with a stale price", func() {
+		setPrice(sdk.NewDecWithPrec(5, 1), ctx.BlockTime().Unix()-maxPriceAge-1)
+		feeSupply := app.BankKeeper.GetSupply(ctx, feeDenom).Amount
+
+		testexchange.OrFail(app.ExchangeKeeper.ChargeMarketCreationFee(ctx, sender))
+
+		Expect(balanceOf(sender, feeDenom)).To(Equal(math.NewInt(900)))
+		Expect(balanceOf(types.FeeSettlementPoolAddress, feeDenom).IsZero()).To(BeTrue())
+		Expect(balanceOf(types.FeeSettlementPoolAddress, settlementDenom)).To(Equal(math.NewInt(1000)))
+		Expect(app.BankKeeper.GetSupply(ctx, feeDenom).Amount).To(Equal(feeSupply.SubRaw(100)))
+	})
+
+	It("skips the conversion when the pool can't pay the settled fee", func() {
+		setPrice(sdk.NewDec(20), ctx.BlockTime().Unix())
+
+		testexchange.OrFail(app.ExchangeKeeper.ChargeMarketCreationFee(ctx, sender))
+
+		Expect(balanceOf(sender, feeDenom)).To(Equal(math.NewInt(900)))
+		Expect(balanceOf(types.FeeSettlementPoolAddress, settlementDenom)).To(Equal(math.NewInt(1000)))
+	})
+})
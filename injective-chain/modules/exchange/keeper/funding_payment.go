@@ -25,6 +25,10 @@ func (k *Keeper) ProcessHourlyFundings(ctx sdk.Context) {
 	}
 
 	marketInfos := k.GetAllPerpetualMarketInfoStates(ctx)
+	SortByMarketID(marketInfos, func(marketInfo types.PerpetualMarketInfo) common.Hash {
+		return common.HexToHash(marketInfo.MarketId)
+	})
+
 	for _, marketInfo := range marketInfos {
 		currFundingTimestamp := marketInfo.NextFundingTimestamp
 		// skip market if funding timestamp hasn't been reached
@@ -70,6 +74,12 @@ func (k *Keeper) ProcessHourlyFundings(ctx sdk.Context) {
 		}
 
 		k.SetPerpetualMarketFunding(ctx, marketID, &newFunding)
+		k.AppendFundingRateRecord(ctx, marketID, &types.FundingRateRecord{
+			Timestamp:   currFundingTimestamp,
+			FundingRate: fundingRate,
+			MarkPrice:   markPrice,
+		})
+		k.AppendSubaccountFundingPayments(ctx, marketID, currFundingTimestamp, fundingRate, fundingRatePayment)
 
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(&types.EventPerpetualMarketFundingUpdate{
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// AppendFundingRateRecord records the funding rate applied to a perpetual market at a funding interval. The history
+// is a bounded ring buffer: records are keyed by an increasing sequence and the records older than the last
+// FundingRateHistorySize are pruned, including the excess left behind when the param is lowered.
+func (k *Keeper) AppendFundingRateRecord(ctx sdk.Context, marketID common.Hash, record *types.FundingRateRecord) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	historySize := uint64(k.GetParams(ctx).FundingRateHistorySize)
+	historyStore := prefix.NewStore(k.getStore(ctx), types.GetFundingRateHistoryPrefix(marketID))
+
+	appendBoundedRecord(historyStore, historySize, k.cdc.MustMarshal(record))
+}
+
+// appendBoundedRecord appends a record to a history keyed by an increasing sequence, and prunes every record which is
+// not among the last historySize records. Nothing is appended when historySize is zero, and the history is cleared.
+func appendBoundedRecord(historyStore prefix.Store, historySize uint64, bz []byte) {
+	sequence := uint64(0)
+	lastIterator := historyStore.ReverseIterator(nil, nil)
+	if lastIterator.Valid() {
+		sequence = sdk.BigEndianToUint64(lastIterator.Key()) + 1
+	}
+	lastIterator.Close()
+
+	if historySize > 0 {
+		historyStore.Set(sdk.Uint64ToBigEndian(sequence), bz)
+	}
+
+	// prune every record which is not among the last historySize records
+	recordCount := sequence + 1
+	if historySize >= recordCount {
+		return
+	}
+
+	pruneIterator := historyStore.Iterator(nil, sdk.Uint64ToBigEndian(recordCount-historySize))
+	keysToDelete := make([][]byte, 0)
+	for ; pruneIterator.Valid(); pruneIterator.Next() {
+		keysToDelete = append(keysToDelete, pruneIterator.Key())
+	}
+	pruneIterator.Close()
+
+	for _, key := range keysToDelete {
+		historyStore.Delete(key)
+	}
+}
+
+// GetFundingRateHistory returns a page of the funding rate history of a perpetual market, oldest record first unless
+// the page request is reversed.
+func (k *Keeper) GetFundingRateHistory(ctx sdk.Context, marketID common.Hash, pageReq *query.PageRequest) ([]types.FundingRateRecord, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	historyStore := prefix.NewStore(k.getStore(ctx), types.GetFundingRateHistoryPrefix(marketID))
+
+	records := make([]types.FundingRateRecord, 0)
+	pageRes, err := query.Paginate(historyStore, pageReq, func(_, value []byte) error {
+		var record types.FundingRateRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
+
+// GetAllFundingRateHistories returns the funding rate history of every perpetual market, ordered by market ID.
+func (k *Keeper) GetAllFundingRateHistories(ctx sdk.Context) []types.FundingRateHistory {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	historyStore := prefix.NewStore(k.getStore(ctx), types.PerpetualFundingRateHistoryPrefix)
+
+	iterator := historyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	histories := make([]types.FundingRateHistory, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		marketID := common.BytesToHash(iterator.Key()[:common.HashLength]).Hex()
+
+		var record types.FundingRateRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if len(histories) == 0 || histories[len(histories)-1].MarketId != marketID {
+			histories = append(histories, types.FundingRateHistory{MarketId: marketID})
+		}
+
+		history := &histories[len(histories)-1]
+		history.Records = append(history.Records, record)
+	}
+
+	return histories
+}
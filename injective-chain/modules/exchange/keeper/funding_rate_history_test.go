@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Funding Rate History", func() {
+	var (
+		app      *simapp.InjectiveApp
+		ctx      sdk.Context
+		marketID = common.HexToHash("0x01")
+		start    = int64(1587556800)
+	)
+
+	setHistorySize := func(size uint32) {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.FundingRateHistorySize = size
+		app.ExchangeKeeper.SetParams(ctx, params)
+	}
+
+	// appendIntervals records count hourly funding rates, the nth interval having a rate of n / 10000
+	appendIntervals := func(from, count int64) {
+		for i := from; i < from+count; i++ {
+			app.ExchangeKeeper.AppendFundingRateRecord(ctx, marketID, &types.FundingRateRecord{
+				Timestamp:   start + i*3600,
+				FundingRate: sdk.NewDecWithPrec(i, 4),
+				MarkPrice:   sdk.NewDec(2000),
+			})
+		}
+	}
+
+	queryHistory := func(pageReq *query.PageRequest) *types.QueryFundingRateHistoryResponse {
+		res, err := app.ExchangeKeeper.FundingRateHistory(sdk.WrapSDKContext(ctx), &types.QueryFundingRateHistoryRequest{
+			MarketId:   marketID.Hex(),
+			Pagination: pageReq,
+		})
+		Expect(err).To(BeNil())
+		return res
+	}
+
+	timestamps := func(records []types.FundingRateRecord) []int64 {
+		ts := make([]int64, 0, len(records))
+		for _, record := range records {
+			ts = append(ts, record.Timestamp)
+		}
+		return ts
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+
+		app.ExchangeKeeper.SetPerpetualMarketInfo(ctx, marketID, &types.PerpetualMarketInfo{
+			MarketId:             marketID.Hex(),
+			HourlyFundingRateCap: sdk.NewDecWithPrec(625, 6),
+			HourlyInterestRate:   sdk.NewDecWithPrec(416666, 11),
+			NextFundingTimestamp: start,
+			FundingInterval:      3600,
+		})
+	})
+
+	It("records every funding interval", func() {
+		appendIntervals(0, 3)
+
+		res := queryHistory(nil)
+		Expect(timestamps(res.Records)).To(Equal([]int64{start, start + 3600, start + 7200}))
+		Expect(res.Records[2].FundingRate.String()).To(Equal(sdk.NewDecWithPrec(2, 4).String()))
+		Expect(res.Records[2].MarkPrice.String()).To(Equal(sdk.NewDec(2000).String()))
+	})
+
+	It("keeps only the last funding_rate_history_size intervals", func() {
+		setHistorySize(3)
+		appendIntervals(0, 5)
+
+		Expect(timestamps(queryHistory(nil).Records)).To(Equal([]int64{start + 2*3600, start + 3*3600, start + 4*3600}))
+
+		By("lowering the history size")
+		setHistorySize(1)
+		appendIntervals(5, 1)
+
+		Expect(timestamps(queryHistory(nil).Records)).To(Equal([]int64{start + 5*3600}))
+
+		By("disabling the history")
+		setHistorySize(0)
+		appendIntervals(6, 1)
+
+		Expect(queryHistory(nil).Records).To(BeEmpty())
+	})
+
+	It("paginates the history", func() {
+		appendIntervals(0, 5)
+
+		res := queryHistory(&query.PageRequest{Limit: 2, CountTotal: true})
+		Expect(timestamps(res.Records)).To(Equal([]int64{start, start + 3600}))
+		Expect(res.Pagination.Total).To(Equal(uint64(5)))
+
+		res = queryHistory(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+		Expect(timestamps(res.Records)).To(Equal([]int64{start + 2*3600, start + 3*3600}))
+
+		res = queryHistory(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+		Expect(timestamps(res.Records)).To(Equal([]int64{start + 4*3600}))
+		Expect(res.Pagination.NextKey).To(BeEmpty())
+
+		res = queryHistory(&query.PageRequest{Limit: 1, Reverse: true})
+		Expect(timestamps(res.Records)).To(Equal([]int64{start + 4*3600}))
+	})
+
+	It("rejects a market which is not a perpetual market", func() {
+		_, err := app.ExchangeKeeper.FundingRateHistory(sdk.WrapSDKContext(ctx), &types.QueryFundingRateHistoryRequest{
+			MarketId: common.HexToHash("0x02").Hex(),
+		})
+		Expect(err).To(MatchError(types.ErrDerivativeMarketNotFound))
+	})
+
+	It("exports and imports the history in genesis", func() {
+		otherMarketID := common.HexToHash("0x02")
+		appendIntervals(0, 3)
+		app.ExchangeKeeper.AppendFundingRateRecord(ctx, otherMarketID, &types.FundingRateRecord{
+			Timestamp:   start,
+			FundingRate: sdk.NewDecWithPrec(-1, 4),
+			MarkPrice:   sdk.NewDec(10),
+		})
+
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		Expect(state.FundingRateHistories).To(HaveLen(2))
+		Expect(state.FundingRateHistories[0].MarketId).To(Equal(marketID.Hex()))
+		Expect(timestamps(state.FundingRateHistories[0].Records)).To(Equal([]int64{start, start + 3600, start + 7200}))
+		Expect(state.FundingRateHistories[1].MarketId).To(Equal(otherMarketID.Hex()))
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+		app.ExchangeKeeper.InitGenesis(ctx, *state)
+
+		Expect(app.ExchangeKeeper.GetAllFundingRateHistories(ctx)).To(Equal(state.FundingRateHistories))
+		Expect(timestamps(queryHistory(nil).Records)).To(Equal([]int64{start, start + 3600, start + 7200}))
+	})
+})
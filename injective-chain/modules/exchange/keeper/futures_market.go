@@ -49,6 +49,11 @@ func (k *Keeper) ExpiryFuturesMarketLaunch(
 		}
 	}
 
+	if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
 	if expiry <= ctx.BlockTime().Unix() {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, nil, errors.Wrapf(types.ErrExpiryFuturesMarketExpired, "ticker %s quoteDenom %s oracleBase %s oracleQuote %s expiry %d expired. Current blocktime %d", ticker, quoteDenom, oracleBase, oracleQuote, expiry, ctx.BlockTime().Unix())
@@ -30,6 +30,11 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 	}
 
+	// the margin modes are set before the positions, which index the markets of the cross margin subaccounts
+	for _, subaccountID := range data.CrossMarginSubaccountIds {
+		k.setSubaccountMarginMode(ctx, common.HexToHash(subaccountID), types.MarginMode_Cross)
+	}
+
 	for _, position := range data.Positions {
 		k.SetPosition(ctx, common.HexToHash(position.MarketId), common.HexToHash(position.SubaccountId), position.Position)
 	}
@@ -47,6 +52,13 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetDeposit(ctx, common.HexToHash(balance.SubaccountId), balance.Denom, balance.Deposits)
 	}
 
+	// the total deposits are maintained by SetDeposit, the genesis ones are only checked against the balances
+	if len(data.DenomTotalDeposits) > 0 {
+		if totalDeposits := k.GetAllDenomTotalDeposits(ctx); !isEqualDecCoins(data.DenomTotalDeposits, totalDeposits) {
+			panic(fmt.Errorf("genesis denom total deposits are incorrect, expected %v, got %v", data.DenomTotalDeposits, totalDeposits))
+		}
+	}
+
 	for _, subaccountTradeNonce := range data.SubaccountTradeNonces {
 		k.SetSubaccountTradeNonce(
 			ctx,
@@ -248,6 +260,83 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, record := range data.MarketVolumes {
 		k.SetMarketAggregateVolume(ctx, common.HexToHash(record.MarketId), record.Volume)
 	}
+
+	for _, history := range data.FundingRateHistories {
+		marketID := common.HexToHash(history.MarketId)
+		for idx := range history.Records {
+			k.AppendFundingRateRecord(ctx, marketID, &history.Records[idx])
+		}
+	}
+
+	k.SetMarketTradingSchedules(ctx, data.MarketTradingSchedules)
+
+	for idx := range data.BlockTradeVolumes {
+		record := data.BlockTradeVolumes[idx]
+		k.addBlockTradeVolume(ctx, record.BlockHeight, &record.Volume)
+	}
+
+	for _, marketID := range data.DelistingExemptMarketIds {
+		k.SetMarketDelistingExemption(ctx, common.HexToHash(marketID), true)
+	}
+
+	for _, marketHeight := range data.MarketInactiveSinceHeights {
+		k.setMarketHeight(ctx, types.MarketInactiveSinceHeightPrefix, common.HexToHash(marketHeight.MarketId), marketHeight.Height)
+	}
+
+	for _, marketHeight := range data.MarketAutoPausedHeights {
+		k.setMarketHeight(ctx, types.MarketAutoPausedHeightPrefix, common.HexToHash(marketHeight.MarketId), marketHeight.Height)
+	}
+
+	if data.MakerRebateEpochStartTimestamp != 0 {
+		k.setMakerRebateEpochStartTimestamp(ctx, data.MakerRebateEpochStartTimestamp)
+	}
+
+	for _, volume := range data.MakerRebateVolumes {
+		account, err := sdk.AccAddressFromBech32(volume.Account)
+		if err != nil {
+			panic("error in MakerRebateVolumes account " + volume.Account)
+		}
+		k.addMakerRebateVolume(ctx, data.MakerRebateEpochStartTimestamp, account, volume.Denom, volume.Volume)
+	}
+
+	if data.MakerRebateSettlement != nil {
+		k.setMakerRebateSettlement(ctx, data.MakerRebateSettlement)
+
+		for _, volume := range data.MakerRebateSettlementVolumes {
+			account, err := sdk.AccAddressFromBech32(volume.Account)
+			if err != nil {
+				panic("error in MakerRebateSettlementVolumes account " + volume.Account)
+			}
+			k.setMakerRebateVolume(ctx, data.MakerRebateSettlement.EpochStartTimestamp, account, volume.Denom, volume.Volume)
+		}
+	}
+
+	for idx := range data.OrderHistories {
+		k.importOrderHistory(ctx, &data.OrderHistories[idx])
+	}
+
+	subaccountFundingHistorySize := uint64(data.Params.SubaccountFundingHistorySize)
+	for _, history := range data.SubaccountFundingHistories {
+		subaccountID := common.HexToHash(history.SubaccountId)
+		for idx := range history.Payments {
+			payment := &history.Payments[idx]
+			k.appendSubaccountFundingPayment(ctx, subaccountID, common.HexToHash(payment.MarketId), subaccountFundingHistorySize, payment)
+		}
+	}
+}
+
+// isEqualDecCoins returns true if both coins have the same amounts in the same denoms, in the same order.
+func isEqualDecCoins(coinsA, coinsB sdk.DecCoins) bool {
+	if len(coinsA) != len(coinsB) {
+		return false
+	}
+
+	for i := range coinsA {
+		if coinsA[i].Denom != coinsB[i].Denom || !coinsA[i].Amount.Equal(coinsB[i].Amount) {
+			return false
+		}
+	}
+	return true
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -286,5 +375,19 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OrderbookSequences:                           k.GetAllOrderbookSequences(ctx),
 		SubaccountVolumes:                            k.GetAllSubaccountMarketAggregateVolumes(ctx),
 		MarketVolumes:                                k.GetAllMarketAggregateVolumes(ctx),
+		FundingRateHistories:                         k.GetAllFundingRateHistories(ctx),
+		MarketTradingSchedules:                       k.GetAllMarketTradingSchedules(ctx),
+		BlockTradeVolumes:                            k.GetAllBlockTradeVolumes(ctx),
+		DenomTotalDeposits:                           k.GetAllDenomTotalDeposits(ctx),
+		DelistingExemptMarketIds:                     k.GetAllDelistingExemptMarketIDs(ctx),
+		MarketInactiveSinceHeights:                   k.GetAllMarketInactiveSinceHeights(ctx),
+		MarketAutoPausedHeights:                      k.GetAllMarketAutoPausedHeights(ctx),
+		MakerRebateEpochStartTimestamp:               k.GetMakerRebateEpochStartTimestamp(ctx),
+		MakerRebateVolumes:                           k.GetAllMakerRebateAccountVolumes(ctx),
+		MakerRebateSettlement:                        k.GetMakerRebateSettlement(ctx),
+		MakerRebateSettlementVolumes:                 k.GetAllMakerRebateSettlementAccountVolumes(ctx),
+		OrderHistories:                               k.GetAllOrderHistories(ctx),
+		CrossMarginSubaccountIds:                     k.GetAllCrossMarginSubaccountIDs(ctx),
+		SubaccountFundingHistories:                   k.GetAllSubaccountFundingHistories(ctx),
 	}
 }
@@ -1,10 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/InjectiveLabs/metrics"
@@ -83,6 +88,38 @@ func (k *Keeper) SubaccountDeposits(c context.Context, req *types.QuerySubaccoun
 	return res, nil
 }
 
+// SubaccountBalances returns the deposits of each requested subaccount, in the order of the request. At most
+// types.MaxSubaccountBalancesBatchSize subaccounts can be queried at once.
+func (k *Keeper) SubaccountBalances(c context.Context, req *types.QuerySubaccountBalancesRequest) (*types.QuerySubaccountBalancesResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if len(req.SubaccountIds) > types.MaxSubaccountBalancesBatchSize {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrInvalidArgument.Wrapf("at most %d subaccounts can be queried at once, got %d", types.MaxSubaccountBalancesBatchSize, len(req.SubaccountIds))
+	}
+
+	balances := make([]*types.SubaccountBalances, 0, len(req.SubaccountIds))
+	for _, subaccountID := range req.SubaccountIds {
+		if !types.IsHexHash(subaccountID) {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, types.ErrBadSubaccountID.Wrap(subaccountID)
+		}
+
+		balances = append(balances, &types.SubaccountBalances{
+			SubaccountId: subaccountID,
+			Deposits:     k.GetDeposits(ctx, common.HexToHash(subaccountID)),
+		})
+	}
+
+	res := &types.QuerySubaccountBalancesResponse{
+		Balances: balances,
+	}
+
+	return res, nil
+}
+
 func (k *Keeper) ExchangeBalances(c context.Context, _ *types.QueryExchangeBalancesRequest) (*types.QueryExchangeBalancesResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -1018,11 +1055,13 @@ func (k *Keeper) FeeDiscountAccountInfo(c context.Context, req *types.QueryFeeDi
 	ctx := sdk.UnwrapSDKContext(c)
 	account, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
-		return nil, err
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrInvalidAddress.Wrap(err.Error())
 	}
 
 	schedule := k.GetFeeDiscountSchedule(ctx)
 	if schedule == nil {
+		metrics.ReportFuncError(k.svcTags)
 		return nil, types.ErrInvalidFeeDiscountSchedule
 	}
 
@@ -1412,3 +1451,468 @@ func (k *Keeper) MarketAtomicExecutionFeeMultiplier(c context.Context, req *type
 	}
 	return &response, nil
 }
+
+func (k *Keeper) Markets(c context.Context, req *types.QueryMarketsRequest) (*types.QueryMarketsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	filters := make([]MarketFilter, 0, 2)
+	if req.Status != "" {
+		status, ok := parseMarketStatus(req.Status)
+		if !ok {
+			return nil, types.ErrInvalidMarketStatus.Wrapf("unknown market status %s", req.Status)
+		}
+		filters = append(filters, StatusMarketFilter(status))
+	}
+
+	if req.MarketType != "" {
+		marketTypes, ok := parseMarketTypes(req.MarketType)
+		if !ok {
+			return nil, fmt.Errorf("unknown market type %s, must be one of spot, derivative or binary", req.MarketType)
+		}
+		filters = append(filters, MarketTypeMarketFilter(marketTypes...))
+	}
+
+	markets, pageRes, err := paginateMarkets(k.FindMarkets(ctx, ChainMarketFilter(filters...)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryMarketsResponse{
+		Markets:    make([]*types.MarketOfAnyType, 0, len(markets)),
+		Pagination: pageRes,
+	}
+
+	for _, market := range markets {
+		m := &types.MarketOfAnyType{MarketId: market.MarketID().Hex()}
+		switch market := market.(type) {
+		case *types.SpotMarket:
+			m.SpotMarket = market
+		case *types.DerivativeMarket:
+			m.DerivativeMarket = market
+		case *types.BinaryOptionsMarket:
+			m.BinaryOptionsMarket = market
+		}
+		res.Markets = append(res.Markets, m)
+	}
+
+	return res, nil
+}
+
+func parseMarketStatus(s string) (types.MarketStatus, bool) {
+	for value, name := range types.MarketStatus_name {
+		if value != int32(types.MarketStatus_Unspecified) && strings.EqualFold(name, s) {
+			return types.MarketStatus(value), true
+		}
+	}
+	return types.MarketStatus_Unspecified, false
+}
+
+func parseMarketTypes(s string) ([]types.MarketType, bool) {
+	switch strings.ToLower(s) {
+	case "spot":
+		return []types.MarketType{types.MarketType_Spot}, true
+	case "derivative":
+		return []types.MarketType{types.MarketType_Perpetual, types.MarketType_Expiry}, true
+	case "binary":
+		return []types.MarketType{types.MarketType_BinaryOption}, true
+	default:
+		return nil, false
+	}
+}
+
+// paginateMarkets returns the page of the markets, sorted by market ID, selected by the page request.
+// The next key is the ID of the first market of the next page.
+func paginateMarkets(markets []MarketI, pageReq *query.PageRequest) ([]MarketI, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]MarketI, len(markets))
+		for i, market := range markets {
+			reversed[len(markets)-1-i] = market
+		}
+		markets = reversed
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := uint64(0)
+	if len(pageReq.Key) > 0 {
+		start = uint64(sort.Search(len(markets), func(i int) bool {
+			cmp := bytes.Compare(markets[i].MarketID().Bytes(), pageReq.Key)
+			if pageReq.Reverse {
+				return cmp <= 0
+			}
+			return cmp >= 0
+		}))
+	} else {
+		start = pageReq.Offset
+	}
+
+	total := uint64(len(markets))
+	if start > total {
+		start = total
+	}
+
+	end := total
+	if limit < total-start {
+		end = start + limit
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = markets[end].MarketID().Bytes()
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return markets[start:end], pageRes, nil
+}
+
+// SimulateMarketOrder walks the resting orders on the opposite side of the committed orderbook and returns how much of
+// a market order of the given quantity would fill, at what average price and for what taker fee. At most
+// types.MaxSimulatedOrderbookLevels price levels are considered.
+func (k *Keeper) SimulateMarketOrder(c context.Context, req *types.QuerySimulateMarketOrderRequest) (*types.QuerySimulateMarketOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Quantity.IsNil() || !req.Quantity.IsPositive() {
+		return nil, types.ErrInvalidQuantity.Wrap("quantity must be positive")
+	}
+
+	var isBuy bool
+	switch req.OrderSide {
+	case types.OrderSide_Buy:
+		isBuy = true
+	case types.OrderSide_Sell:
+		isBuy = false
+	default:
+		return nil, types.ErrUnrecognizedOrderType.Wrap("order side must be either buy or sell")
+	}
+
+	marketID := common.HexToHash(req.MarketId)
+
+	var (
+		market MarketI
+		isSpot bool
+	)
+	if spotMarket := k.GetSpotMarketByID(ctx, marketID); spotMarket != nil {
+		market, isSpot = spotMarket, true
+	} else if derivativeMarket := k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil); derivativeMarket != nil {
+		market = derivativeMarket
+	} else {
+		return nil, types.ErrMarketInvalid.Wrapf("market %s not found", marketID.Hex())
+	}
+
+	// a buy order fills against the sell side of the book and vice versa
+	limit := types.MaxSimulatedOrderbookLevels
+	levels := k.GetOrderbookPriceLevels(ctx, isSpot, marketID, !isBuy, &limit, nil, &req.Quantity)
+
+	fillableQuantity, fillNotional := simulateFillAgainstLevels(levels, req.Quantity)
+
+	averagePrice := sdk.ZeroDec()
+	if fillableQuantity.IsPositive() {
+		averagePrice = fillNotional.Quo(fillableQuantity)
+	}
+
+	res := &types.QuerySimulateMarketOrderResponse{
+		FillableQuantity: fillableQuantity,
+		AveragePrice:     averagePrice,
+		ExpectedFee:      types.CalculateFee(fillNotional, market.GetTakerFeeRate()),
+	}
+
+	return res, nil
+}
+
+// OrderbookSnapshot returns up to req.Depth aggregated price levels on each side of the committed orderbook of a
+// market. Resting orders at the same price are merged into a single level, since the orderbook stores one total
+// quantity per price. The depth defaults to types.DefaultQueryOrderbookLimit and is capped at
+// types.MaxOrderbookSnapshotDepth.
+func (k *Keeper) OrderbookSnapshot(c context.Context, req *types.QueryOrderbookSnapshotRequest) (*types.QueryOrderbookSnapshotResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	marketID := common.HexToHash(req.MarketId)
+
+	var isSpot bool
+	if spotMarket := k.GetSpotMarketByID(ctx, marketID); spotMarket != nil {
+		isSpot = true
+	} else if k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil) == nil {
+		return nil, types.ErrMarketInvalid.Wrapf("market %s not found", marketID.Hex())
+	}
+
+	depth := req.Depth
+	if depth == 0 {
+		depth = types.DefaultQueryOrderbookLimit
+	}
+	if depth > types.MaxOrderbookSnapshotDepth {
+		depth = types.MaxOrderbookSnapshotDepth
+	}
+
+	res := &types.QueryOrderbookSnapshotResponse{
+		BuysPriceLevel:  k.GetOrderbookPriceLevels(ctx, isSpot, marketID, true, &depth, nil, nil),
+		SellsPriceLevel: k.GetOrderbookPriceLevels(ctx, isSpot, marketID, false, &depth, nil, nil),
+	}
+
+	return res, nil
+}
+
+// FundingRateHistory returns a page of the funding rates applied to a perpetual market, oldest first
+func (k *Keeper) FundingRateHistory(c context.Context, req *types.QueryFundingRateHistoryRequest) (*types.QueryFundingRateHistoryResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	marketID := common.HexToHash(req.MarketId)
+
+	if k.GetPerpetualMarketInfo(ctx, marketID) == nil {
+		return nil, types.ErrDerivativeMarketNotFound.Wrapf("perpetual market %s not found", marketID.Hex())
+	}
+
+	records, pageRes, err := k.GetFundingRateHistory(ctx, marketID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryFundingRateHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}
+
+	return res, nil
+}
+
+// OrderHistory returns the lifecycle history of a limit order
+func (k *Keeper) OrderHistory(c context.Context, req *types.QueryOrderHistoryRequest) (*types.QueryOrderHistoryResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !types.IsHexHash(req.OrderHash) {
+		return nil, types.ErrOrderHashInvalid.Wrapf("invalid order hash %s", req.OrderHash)
+	}
+
+	orderHash := common.HexToHash(req.OrderHash)
+	history := k.GetOrderHistory(ctx, orderHash)
+	if history == nil {
+		return nil, types.ErrOrderDoesntExist.Wrapf("no history for order %s", orderHash.Hex())
+	}
+
+	res := &types.QueryOrderHistoryResponse{
+		History: *history,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) SubaccountMarginMode(c context.Context, req *types.QuerySubaccountMarginModeRequest) (*types.QuerySubaccountMarginModeResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !types.IsHexHash(req.SubaccountId) {
+		return nil, types.ErrBadSubaccountID.Wrapf("invalid subaccount id %s", req.SubaccountId)
+	}
+
+	res := &types.QuerySubaccountMarginModeResponse{
+		MarginMode: k.GetSubaccountMarginMode(ctx, common.HexToHash(req.SubaccountId)),
+	}
+
+	return res, nil
+}
+
+// SubaccountFundingHistory returns a page of the funding payments of the positions of a subaccount, oldest first
+func (k *Keeper) SubaccountFundingHistory(c context.Context, req *types.QuerySubaccountFundingHistoryRequest) (*types.QuerySubaccountFundingHistoryResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !types.IsHexHash(req.SubaccountId) {
+		return nil, types.ErrBadSubaccountID.Wrapf("invalid subaccount id %s", req.SubaccountId)
+	}
+
+	var marketID *common.Hash
+	if req.MarketId != "" {
+		if !types.IsHexHash(req.MarketId) {
+			return nil, types.ErrMarketInvalid.Wrapf("invalid market id %s", req.MarketId)
+		}
+
+		id := common.HexToHash(req.MarketId)
+		marketID = &id
+	}
+
+	payments, pageRes, err := k.GetSubaccountFundingHistory(ctx, common.HexToHash(req.SubaccountId), marketID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QuerySubaccountFundingHistoryResponse{
+		Payments:   payments,
+		Pagination: pageRes,
+	}
+
+	return res, nil
+}
+
+// LiquidationPrice returns the mark price at which the position of a subaccount in a derivative market becomes
+// liquidatable on its own margin
+func (k *Keeper) LiquidationPrice(c context.Context, req *types.QueryLiquidationPriceRequest) (*types.QueryLiquidationPriceResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !types.IsHexHash(req.SubaccountId) {
+		return nil, types.ErrBadSubaccountID.Wrapf("invalid subaccount id %s", req.SubaccountId)
+	}
+
+	if !types.IsHexHash(req.MarketId) {
+		return nil, types.ErrMarketInvalid.Wrapf("invalid market id %s", req.MarketId)
+	}
+
+	marketID := common.HexToHash(req.MarketId)
+	subaccountID := common.HexToHash(req.SubaccountId)
+
+	market := k.GetDerivativeMarketByID(ctx, marketID)
+	if market == nil {
+		return nil, types.ErrDerivativeMarketNotFound.Wrapf("derivative market for marketID %s not found", marketID.Hex())
+	}
+
+	position := k.GetPosition(ctx, marketID, subaccountID)
+	if position == nil || !position.Quantity.IsPositive() {
+		return nil, types.ErrPositionNotFound.Wrapf("subaccountID %s marketID %s", subaccountID.Hex(), marketID.Hex())
+	}
+
+	liquidationPrice, hasLiquidationPrice := k.GetPositionLiquidationPrice(position, market, k.GetPerpetualMarketFunding(ctx, marketID))
+
+	res := &types.QueryLiquidationPriceResponse{
+		HasLiquidationPrice: hasLiquidationPrice,
+		LiquidationPrice:    liquidationPrice,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) DenomsWithUsage(c context.Context, req *types.QueryDenomsWithUsageRequest) (*types.QueryDenomsWithUsageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the limit is clamped on a copy, leaving the request of the caller untouched
+	pagination := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pagination = *req.Pagination
+	}
+	if pagination.Limit == 0 || pagination.Limit > types.MaxDenomsWithUsageLimit {
+		pagination.Limit = types.MaxDenomsWithUsageLimit
+	}
+
+	supply, pageRes, err := k.bankKeeper.GetPaginatedTotalSupply(ctx, pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	baseDenoms, quoteDenoms := k.GetMarketDenomUsage(ctx)
+
+	denoms := make([]types.DenomWithUsage, 0, len(supply))
+	for _, coin := range supply {
+		denom := types.DenomWithUsage{
+			Denom: coin.Denom,
+		}
+
+		if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom); found {
+			denom.Metadata = &metadata
+		}
+
+		_, denom.UsedAsBase = baseDenoms[coin.Denom]
+		_, denom.UsedAsQuote = quoteDenoms[coin.Denom]
+
+		denoms = append(denoms, denom)
+	}
+
+	res := &types.QueryDenomsWithUsageResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) ActiveMarketsCount(c context.Context, _ *types.QueryActiveMarketsCountRequest) (*types.QueryActiveMarketsCountResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryActiveMarketsCountResponse{
+		ActiveMarketsCount: k.GetActiveMarketsCount(ctx),
+		MaxActiveMarkets:   k.GetParams(ctx).MaxActiveMarkets,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) MarketTradingSchedule(c context.Context, req *types.QueryMarketTradingScheduleRequest) (*types.QueryMarketTradingScheduleResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	marketID := common.HexToHash(req.MarketId)
+
+	if !k.HasMarket(ctx, marketID) {
+		return nil, types.ErrMarketInvalid.Wrapf("market %s not found", req.MarketId)
+	}
+
+	schedule := k.GetMarketTradingSchedule(ctx, marketID)
+	res := &types.QueryMarketTradingScheduleResponse{
+		Schedule: schedule,
+		IsOpen:   schedule == nil || schedule.IsOpen(ctx.BlockTime()),
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) ProtocolStats(c context.Context, _ *types.QueryProtocolStatsRequest) (*types.QueryProtocolStatsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryProtocolStatsResponse{
+		TotalMarkets:             uint32(len(k.FindMarkets(ctx, AllMarketFilter))),
+		ActiveMarkets:            k.GetActiveMarketsCount(ctx),
+		TotalOpenOrders:          k.GetTotalOpenOrderCount(ctx),
+		TotalValueLocked:         k.GetAllDenomTotalDeposits(ctx),
+		TradeVolume:              k.GetRollingTradeVolumes(ctx),
+		TradeVolumeWindowSeconds: types.TradeVolumeWindowSeconds,
+	}
+
+	return res, nil
+}
+
+// simulateFillAgainstLevels fills the quantity against the price levels in order and returns the filled quantity
+// and its notional.
+func simulateFillAgainstLevels(levels []*types.Level, quantity sdk.Dec) (filledQuantity, filledNotional sdk.Dec) {
+	filledQuantity, filledNotional = sdk.ZeroDec(), sdk.ZeroDec()
+
+	for _, level := range levels {
+		remaining := quantity.Sub(filledQuantity)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		fillQuantity := sdk.MinDec(remaining, level.Q)
+		filledQuantity = filledQuantity.Add(fillQuantity)
+		filledNotional = filledNotional.Add(fillQuantity.Mul(level.P))
+	}
+
+	return filledQuantity, filledNotional
+}
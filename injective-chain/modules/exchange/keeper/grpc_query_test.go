@@ -1,11 +1,17 @@
 package keeper_test
 
 import (
+	"math"
+	"math/big"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -49,4 +55,258 @@ var _ = Describe("GRPC query test", func() {
 			Expect(res.SubaccountId).To(BeEquivalentTo(exchangetypes.SdkAddressToSubaccountID(exchangetypes.SubaccountIDToSdkAddress(testInput.Perps[0].MarketID)).String()))
 		})
 	})
+
+	Context("Markets query", func() {
+		marketID := func(i int) string {
+			return common.BigToHash(big.NewInt(int64(i))).Hex()
+		}
+
+		queryMarkets := func(req *exchangetypes.QueryMarketsRequest) []string {
+			res, err := app.ExchangeKeeper.Markets(sdk.WrapSDKContext(ctx), req)
+			Expect(err).To(BeNil())
+
+			ids := make([]string, 0, len(res.Markets))
+			for _, m := range res.Markets {
+				ids = append(ids, m.MarketId)
+			}
+			return ids
+		}
+
+		BeforeEach(func() {
+			app.ExchangeKeeper.SetSpotMarket(ctx, &exchangetypes.SpotMarket{MarketId: marketID(5), Status: exchangetypes.MarketStatus_Active})
+			app.ExchangeKeeper.SetSpotMarket(ctx, &exchangetypes.SpotMarket{MarketId: marketID(2), Status: exchangetypes.MarketStatus_Paused})
+			app.ExchangeKeeper.SetDerivativeMarket(ctx, &exchangetypes.DerivativeMarket{MarketId: marketID(3), Status: exchangetypes.MarketStatus_Active, IsPerpetual: true})
+			app.ExchangeKeeper.SetDerivativeMarket(ctx, &exchangetypes.DerivativeMarket{MarketId: marketID(1), Status: exchangetypes.MarketStatus_Paused})
+			app.ExchangeKeeper.SetBinaryOptionsMarket(ctx, &exchangetypes.BinaryOptionsMarket{MarketId: marketID(4), Status: exchangetypes.MarketStatus_Demolished})
+		})
+
+		It("returns all markets sorted by market ID", func() {
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{})).To(Equal([]string{marketID(1), marketID(2), marketID(3), marketID(4), marketID(5)}))
+		})
+
+		It("filters by status and market type", func() {
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{Status: "active"})).To(Equal([]string{marketID(3), marketID(5)}))
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{Status: "Paused"})).To(Equal([]string{marketID(1), marketID(2)}))
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{MarketType: "spot"})).To(Equal([]string{marketID(2), marketID(5)}))
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{MarketType: "derivative"})).To(Equal([]string{marketID(1), marketID(3)}))
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{MarketType: "binary"})).To(Equal([]string{marketID(4)}))
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{Status: "paused", MarketType: "derivative"})).To(Equal([]string{marketID(1)}))
+			Expect(queryMarkets(&exchangetypes.QueryMarketsRequest{Status: "demolished", MarketType: "spot"})).To(BeEmpty())
+		})
+
+		It("rejects unknown filters", func() {
+			_, err := app.ExchangeKeeper.Markets(sdk.WrapSDKContext(ctx), &exchangetypes.QueryMarketsRequest{Status: "closed"})
+			Expect(err).To(MatchError(exchangetypes.ErrInvalidMarketStatus))
+
+			_, err = app.ExchangeKeeper.Markets(sdk.WrapSDKContext(ctx), &exchangetypes.QueryMarketsRequest{MarketType: "option"})
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("pages through the markets with the next key", func() {
+			ids := make([]string, 0)
+			var nextKey []byte
+			pages := 0
+			for {
+				res, err := app.ExchangeKeeper.Markets(sdk.WrapSDKContext(ctx), &exchangetypes.QueryMarketsRequest{
+					Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+				})
+				Expect(err).To(BeNil())
+				Expect(len(res.Markets)).To(BeNumerically("<=", 2))
+
+				for _, m := range res.Markets {
+					ids = append(ids, m.MarketId)
+				}
+				pages++
+
+				nextKey = res.Pagination.NextKey
+				if len(nextKey) == 0 {
+					break
+				}
+			}
+
+			Expect(pages).To(Equal(3))
+			Expect(ids).To(Equal([]string{marketID(1), marketID(2), marketID(3), marketID(4), marketID(5)}))
+
+			res, err := app.ExchangeKeeper.Markets(sdk.WrapSDKContext(ctx), &exchangetypes.QueryMarketsRequest{
+				Status:     "active",
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			})
+			Expect(err).To(BeNil())
+			Expect(res.Markets).To(HaveLen(1))
+			Expect(res.Markets[0].SpotMarket).To(BeNil())
+			Expect(res.Markets[0].DerivativeMarket).ToNot(BeNil())
+			Expect(res.Pagination.Total).To(Equal(uint64(2)))
+			Expect(res.Pagination.NextKey).To(Equal(common.HexToHash(marketID(5)).Bytes()))
+		})
+
+		It("does not overflow with the maximum limit", func() {
+			res, err := app.ExchangeKeeper.Markets(sdk.WrapSDKContext(ctx), &exchangetypes.QueryMarketsRequest{
+				Pagination: &query.PageRequest{Offset: 1, Limit: math.MaxUint64},
+			})
+			Expect(err).To(BeNil())
+			Expect(res.Markets).To(HaveLen(4))
+			Expect(res.Pagination.NextKey).To(BeEmpty())
+		})
+	})
+
+	Context("SimulateMarketOrder query", func() {
+		var marketID common.Hash
+
+		simulate := func(side exchangetypes.OrderSide, quantity sdk.Dec) *exchangetypes.QuerySimulateMarketOrderResponse {
+			res, err := app.ExchangeKeeper.SimulateMarketOrder(sdk.WrapSDKContext(ctx), &exchangetypes.QuerySimulateMarketOrderRequest{
+				MarketId:  marketID.Hex(),
+				OrderSide: side,
+				Quantity:  quantity,
+			})
+			Expect(err).To(BeNil())
+			return res
+		}
+
+		BeforeEach(func() {
+			marketID = common.BigToHash(big.NewInt(7))
+			app.ExchangeKeeper.SetSpotMarket(ctx, &exchangetypes.SpotMarket{
+				MarketId:     marketID.Hex(),
+				Status:       exchangetypes.MarketStatus_Active,
+				MakerFeeRate: sdk.NewDecWithPrec(1, 4),
+				TakerFeeRate: sdk.NewDecWithPrec(1, 3),
+			})
+
+			app.ExchangeKeeper.SetOrderbookPriceLevelQuantity(ctx, marketID, false, true, sdk.NewDec(10), sdk.NewDec(10))
+			app.ExchangeKeeper.SetOrderbookPriceLevelQuantity(ctx, marketID, false, true, sdk.NewDec(11), sdk.NewDec(5))
+			app.ExchangeKeeper.SetOrderbookPriceLevelQuantity(ctx, marketID, false, true, sdk.NewDec(12), sdk.NewDec(20))
+		})
+
+		It("computes the average price and fee across the price levels", func() {
+			res := simulate(exchangetypes.OrderSide_Buy, sdk.NewDec(20))
+
+			// 10 * 10 + 5 * 11 + 5 * 12 = 215
+			Expect(res.FillableQuantity.String()).To(Equal(sdk.NewDec(20).String()))
+			Expect(res.AveragePrice.String()).To(Equal(sdk.MustNewDecFromStr("10.75").String()))
+			Expect(res.ExpectedFee.String()).To(Equal(sdk.MustNewDecFromStr("0.215").String()))
+		})
+
+		It("only fills what the book can take", func() {
+			res := simulate(exchangetypes.OrderSide_Buy, sdk.NewDec(100))
+
+			Expect(res.FillableQuantity.String()).To(Equal(sdk.NewDec(35).String()))
+			Expect(res.AveragePrice.String()).To(Equal(sdk.NewDec(395).Quo(sdk.NewDec(35)).String()))
+		})
+
+		It("returns zero for an empty book", func() {
+			res := simulate(exchangetypes.OrderSide_Sell, sdk.NewDec(10))
+
+			Expect(res.FillableQuantity.IsZero()).To(BeTrue())
+			Expect(res.AveragePrice.IsZero()).To(BeTrue())
+			Expect(res.ExpectedFee.IsZero()).To(BeTrue())
+		})
+
+		It("rejects an unknown market", func() {
+			_, err := app.ExchangeKeeper.SimulateMarketOrder(sdk.WrapSDKContext(ctx), &exchangetypes.QuerySimulateMarketOrderRequest{
+				MarketId:  common.BigToHash(big.NewInt(8)).Hex(),
+				OrderSide: exchangetypes.OrderSide_Buy,
+				Quantity:  sdk.NewDec(1),
+			})
+			Expect(err).To(MatchError(exchangetypes.ErrMarketInvalid))
+		})
+	})
+
+	Context("SubaccountBalances query", func() {
+		It("returns the deposits of each subaccount", func() {
+			quoteDenom := testInput.Perps[0].QuoteDenom
+			subaccountIDs := []common.Hash{testexchange.SampleNonDefaultSubaccountAddr1, testexchange.SampleNonDefaultSubaccountAddr2, testexchange.SampleNonDefaultSubaccountAddr3}
+			for i, subaccountID := range subaccountIDs {
+				testexchange.MintAndDeposit(app, ctx, subaccountID.String(), sdk.NewCoins(sdk.NewInt64Coin(quoteDenom, int64(100*(i+1)))))
+			}
+
+			res, err := app.ExchangeKeeper.SubaccountBalances(sdk.WrapSDKContext(ctx), &exchangetypes.QuerySubaccountBalancesRequest{
+				SubaccountIds: []string{subaccountIDs[2].Hex(), subaccountIDs[0].Hex(), subaccountIDs[1].Hex()},
+			})
+			Expect(err).To(BeNil())
+			Expect(res.Balances).To(HaveLen(3))
+
+			for i, expectedAmount := range []int64{300, 100, 200} {
+				Expect(res.Balances[i].Deposits).To(HaveLen(1))
+				Expect(res.Balances[i].Deposits[quoteDenom].AvailableBalance).To(Equal(sdk.NewDec(expectedAmount)))
+				Expect(res.Balances[i].Deposits[quoteDenom].TotalBalance).To(Equal(sdk.NewDec(expectedAmount)))
+			}
+			Expect(res.Balances[0].SubaccountId).To(Equal(subaccountIDs[2].Hex()))
+		})
+
+		It("rejects batches above the cap", func() {
+			subaccountIDs := make([]string, exchangetypes.MaxSubaccountBalancesBatchSize+1)
+			for i := range subaccountIDs {
+				subaccountIDs[i] = common.BigToHash(big.NewInt(int64(i))).Hex()
+			}
+
+			_, err := app.ExchangeKeeper.SubaccountBalances(sdk.WrapSDKContext(ctx), &exchangetypes.QuerySubaccountBalancesRequest{SubaccountIds: subaccountIDs})
+			Expect(err).To(MatchError(exchangetypes.ErrInvalidArgument))
+		})
+	})
+
+	Context("DenomsWithUsage query", func() {
+		BeforeEach(func() {
+			coins := sdk.NewCoins(sdk.NewInt64Coin("listedtoken", 100), sdk.NewInt64Coin("unlistedtoken", 100))
+			Expect(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins)).To(Succeed())
+
+			app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+				Base:    "listedtoken",
+				Display: "LISTED",
+				Symbol:  "LISTED",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "listedtoken", Exponent: 0},
+				},
+			})
+
+			app.ExchangeKeeper.SetSpotMarket(ctx, &exchangetypes.SpotMarket{
+				MarketId:   common.BigToHash(big.NewInt(1)).Hex(),
+				BaseDenom:  "listedtoken",
+				QuoteDenom: "inj",
+				Status:     exchangetypes.MarketStatus_Active,
+			})
+		})
+
+		It("flags the denoms used by the markets", func() {
+			res, err := app.ExchangeKeeper.DenomsWithUsage(sdk.WrapSDKContext(ctx), &exchangetypes.QueryDenomsWithUsageRequest{})
+			Expect(err).To(BeNil())
+
+			denoms := make(map[string]exchangetypes.DenomWithUsage)
+			for i, denom := range res.Denoms {
+				if i > 0 {
+					Expect(res.Denoms[i-1].Denom < denom.Denom).To(BeTrue())
+				}
+				denoms[denom.Denom] = denom
+			}
+
+			listed, found := denoms["listedtoken"]
+			Expect(found).To(BeTrue())
+			Expect(listed.UsedAsBase).To(BeTrue())
+			Expect(listed.UsedAsQuote).To(BeFalse())
+			Expect(listed.Metadata).ToNot(BeNil())
+			Expect(listed.Metadata.Symbol).To(Equal("LISTED"))
+
+			unlisted, found := denoms["unlistedtoken"]
+			Expect(found).To(BeTrue())
+			Expect(unlisted.UsedAsBase).To(BeFalse())
+			Expect(unlisted.UsedAsQuote).To(BeFalse())
+			Expect(unlisted.Metadata).To(BeNil())
+		})
+
+		It("caps the page size", func() {
+			res, err := app.ExchangeKeeper.DenomsWithUsage(sdk.WrapSDKContext(ctx), &exchangetypes.QueryDenomsWithUsageRequest{
+				Pagination: &query.PageRequest{Limit: 1},
+			})
+			Expect(err).To(BeNil())
+			Expect(res.Denoms).To(HaveLen(1))
+			Expect(res.Pagination.NextKey).ToNot(BeEmpty())
+		})
+
+		It("leaves the page request of the caller untouched", func() {
+			pagination := &query.PageRequest{Limit: exchangetypes.MaxDenomsWithUsageLimit + 1}
+			_, err := app.ExchangeKeeper.DenomsWithUsage(sdk.WrapSDKContext(ctx), &exchangetypes.QueryDenomsWithUsageRequest{
+				Pagination: pagination,
+			})
+			Expect(err).To(BeNil())
+			Expect(pagination.Limit).To(Equal(exchangetypes.MaxDenomsWithUsageLimit + 1))
+		})
+	})
 })
@@ -33,6 +33,8 @@ type Keeper struct {
 
 	svcTags   metrics.Tags
 	authority string
+
+	tvl *tvlTelemetry
 }
 
 // NewKeeper creates new instances of the exchange Keeper
@@ -64,6 +66,7 @@ func NewKeeper(
 		svcTags: metrics.Tags{
 			"svc": "exchange_k",
 		},
+		tvl: &tvlTelemetry{},
 	}
 }
 
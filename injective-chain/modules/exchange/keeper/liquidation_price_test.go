@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Liquidation price query", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		market    *types.DerivativeMarket
+		markPrice = sdk.NewDec(2000)
+	)
+
+	subaccountID := testexchange.SampleSubaccountAddr1
+
+	setPosition := func(isLong bool, quantity, entryPrice, margin int64) *types.Position {
+		position := &types.Position{
+			IsLong:                 isLong,
+			Quantity:               sdk.NewDec(quantity),
+			EntryPrice:             sdk.NewDec(entryPrice),
+			Margin:                 sdk.NewDec(margin),
+			CumulativeFundingEntry: sdk.ZeroDec(),
+		}
+		app.ExchangeKeeper.SetPosition(ctx, market.MarketID(), subaccountID, position)
+		return position
+	}
+
+	queryLiquidationPrice := func(subaccountID common.Hash) (*types.QueryLiquidationPriceResponse, error) {
+		return app.ExchangeKeeper.LiquidationPrice(sdk.WrapSDKContext(ctx), &types.QueryLiquidationPriceRequest{
+			SubaccountId: subaccountID.Hex(),
+			MarketId:     market.MarketID().Hex(),
+		})
+	}
+
+	isLiquidatableAt := func(position *types.Position, price sdk.Dec) bool {
+		return app.ExchangeKeeper.IsPositionLiquidatable(position, market, price, app.ExchangeKeeper.GetPerpetualMarketFunding(ctx, market.MarketID()))
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		perp := testInput.Perps[0]
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, perp.OracleBase, perp.OracleQuote, oracletypes.NewPriceState(markPrice, ctx.BlockTime().Unix()))
+
+		sender := types.SubaccountIDToSdkAddress(testexchange.SampleSubaccountAddr3)
+		coin := sdk.NewCoin(perp.QuoteDenom, sdk.OneInt())
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, coin, perp.Ticker, perp.QuoteDenom, perp.OracleBase, perp.OracleQuote, perp.OracleType, -1))
+
+		var err error
+		market, _, err = app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			perp.Ticker,
+			perp.QuoteDenom,
+			perp.OracleBase,
+			perp.OracleQuote,
+			0,
+			perp.OracleType,
+			perp.InitialMarginRatio,
+			perp.MaintenanceMarginRatio,
+			perp.MakerFeeRate,
+			perp.TakerFeeRate,
+			perp.MinPriceTickSize,
+			perp.MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+		Expect(market.MaintenanceMarginRatio.String()).To(Equal(sdk.NewDecWithPrec(2, 2).String()))
+	})
+
+	It("returns the liquidation price of a long position", func() {
+		// (entry price - unit margin) / (1 - maintenance margin ratio) = (2000 - 530) / 0.98
+		position := setPosition(true, 2, 2000, 1060)
+
+		res, err := queryLiquidationPrice(subaccountID)
+		Expect(err).To(BeNil())
+		Expect(res.HasLiquidationPrice).To(BeTrue())
+		Expect(res.LiquidationPrice.String()).To(Equal(sdk.NewDec(1500).String()))
+
+		Expect(isLiquidatableAt(position, res.LiquidationPrice)).To(BeTrue())
+		Expect(isLiquidatableAt(position, res.LiquidationPrice.Add(sdk.OneDec()))).To(BeFalse())
+	})
+
+	It("returns the liquidation price of a short position", func() {
+		// (entry price + unit margin) / (1 + maintenance margin ratio) = (2000 + 550) / 1.02
+		position := setPosition(false, 2, 2000, 1100)
+
+		res, err := queryLiquidationPrice(subaccountID)
+		Expect(err).To(BeNil())
+		Expect(res.HasLiquidationPrice).To(BeTrue())
+		Expect(res.LiquidationPrice.String()).To(Equal(sdk.NewDec(2500).String()))
+
+		Expect(isLiquidatableAt(position, res.LiquidationPrice)).To(BeTrue())
+		Expect(isLiquidatableAt(position, res.LiquidationPrice.Sub(sdk.OneDec()))).To(BeFalse())
+	})
+
+	It("returns no liquidation price for a fully funded long position", func() {
+		setPosition(true, 2, 2000, 4000)
+
+		res, err := queryLiquidationPrice(subaccountID)
+		Expect(err).To(BeNil())
+		Expect(res.HasLiquidationPrice).To(BeFalse())
+		Expect(res.LiquidationPrice.String()).To(Equal(sdk.ZeroDec().String()))
+	})
+
+	It("rejects a subaccount without a position", func() {
+		_, err := queryLiquidationPrice(testexchange.SampleSubaccountAddr2)
+		Expect(err).To(MatchError(ContainSubstring(types.ErrPositionNotFound.Error())))
+	})
+})
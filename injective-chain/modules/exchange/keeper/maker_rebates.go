@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// MakerRebateVolume is the maker volume of an account in a quote denom within the current maker rebate epoch.
+type MakerRebateVolume struct {
+	Account sdk.AccAddress
+	Denom   string
+	Volume  sdk.Dec
+}
+
+// GetMakerRebateEpochStartTimestamp returns the start timestamp of the current maker rebate epoch, or zero if no epoch
+// started yet.
+func (k *Keeper) GetMakerRebateEpochStartTimestamp(ctx sdk.Context) int64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.MakerRebateEpochStartKey)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+func (k *Keeper) setMakerRebateEpochStartTimestamp(ctx sdk.Context, timestamp int64) {
+	k.getStore(ctx).Set(types.MakerRebateEpochStartKey, sdk.Uint64ToBigEndian(uint64(timestamp)))
+}
+
+// GetAllMakerRebateVolumes returns the maker volumes of the current maker rebate epoch, ordered by account and denom.
+func (k *Keeper) GetAllMakerRebateVolumes(ctx sdk.Context) []*MakerRebateVolume {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getMakerRebateVolumes(ctx, k.GetMakerRebateEpochStartTimestamp(ctx))
+}
+
+func (k *Keeper) getMakerRebateVolumes(ctx sdk.Context, epochStart int64) []*MakerRebateVolume {
+	volumeStore := prefix.NewStore(k.getStore(ctx), types.GetMakerRebateEpochVolumesPrefix(epochStart))
+
+	iterator := volumeStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	volumes := make([]*MakerRebateVolume, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		volume, ok := parseMakerRebateVolume(iterator.Key(), iterator.Value())
+		if !ok {
+			continue
+		}
+
+		volumes = append(volumes, volume)
+	}
+
+	return volumes
+}
+
+func parseMakerRebateVolume(key, value []byte) (*MakerRebateVolume, bool) {
+	var volume sdk.Dec
+	if err := volume.Unmarshal(value); err != nil {
+		return nil, false
+	}
+
+	return &MakerRebateVolume{
+		Account: sdk.AccAddress(key[:common.AddressLength]),
+		Denom:   string(key[common.AddressLength:]),
+		Volume:  volume,
+	}, true
+}
+
+// GetAllMakerRebateAccountVolumes returns the maker volumes of the current maker rebate epoch for the genesis export,
+// ordered by account and denom.
+func (k *Keeper) GetAllMakerRebateAccountVolumes(ctx sdk.Context) []types.MakerRebateAccountVolume {
+	return k.getMakerRebateAccountVolumes(ctx, k.GetMakerRebateEpochStartTimestamp(ctx))
+}
+
+// GetAllMakerRebateSettlementAccountVolumes returns the maker volumes of the previous maker rebate epoch which are not
+// settled yet for the genesis export, ordered by account and denom.
+func (k *Keeper) GetAllMakerRebateSettlementAccountVolumes(ctx sdk.Context) []types.MakerRebateAccountVolume {
+	settlement := k.GetMakerRebateSettlement(ctx)
+	if settlement == nil {
+		return []types.MakerRebateAccountVolume{}
+	}
+
+	return k.getMakerRebateAccountVolumes(ctx, settlement.EpochStartTimestamp)
+}
+
+func (k *Keeper) getMakerRebateAccountVolumes(ctx sdk.Context, epochStart int64) []types.MakerRebateAccountVolume {
+	volumes := k.getMakerRebateVolumes(ctx, epochStart)
+
+	accountVolumes := make([]types.MakerRebateAccountVolume, 0, len(volumes))
+	for _, volume := range volumes {
+		accountVolumes = append(accountVolumes, types.MakerRebateAccountVolume{
+			Account: volume.Account.String(),
+			Denom:   volume.Denom,
+			Volume:  volume.Volume,
+		})
+	}
+
+	return accountVolumes
+}
+
+// GetMakerRebateSettlement returns the settlement in progress of the maker rebates of the previous maker rebate epoch,
+// or nil if the rebates of the previous epoch are settled.
+func (k *Keeper) GetMakerRebateSettlement(ctx sdk.Context) *types.MakerRebateSettlement {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.MakerRebateSettlementKey)
+	if bz == nil {
+		return nil
+	}
+
+	var settlement types.MakerRebateSettlement
+	k.cdc.MustUnmarshal(bz, &settlement)
+
+	return &settlement
+}
+
+func (k *Keeper) setMakerRebateSettlement(ctx sdk.Context, settlement *types.MakerRebateSettlement) {
+	k.getStore(ctx).Set(types.MakerRebateSettlementKey, k.cdc.MustMarshal(settlement))
+}
+
+func (k *Keeper) setMakerRebateVolume(ctx sdk.Context, epochStart int64, account sdk.AccAddress, quoteDenom string, volume sdk.Dec) {
+	setDecOrDelete(k.getStore(ctx), types.GetMakerRebateVolumeKey(epochStart, account, quoteDenom), volume)
+}
+
+// addMakerRebateVolume adds to the maker volume of an account in a quote denom in a maker rebate epoch, and to the
+// total maker volume in the denom.
+func (k *Keeper) addMakerRebateVolume(ctx sdk.Context, epochStart int64, account sdk.AccAddress, quoteDenom string, volume sdk.Dec) {
+	store := k.getStore(ctx)
+
+	key := types.GetMakerRebateVolumeKey(epochStart, account, quoteDenom)
+	setDecOrDelete(store, key, getDecOrZero(store, key).Add(volume))
+
+	totalKey := types.GetMakerRebateTotalVolumeKey(epochStart, quoteDenom)
+	setDecOrDelete(store, totalKey, getDecOrZero(store, totalKey).Add(volume))
+}
+
+// recordMakerRebateVolumes adds the maker volume of the subaccounts to the maker volume of their accounts in the quote
+// denom of the markets, within the current maker rebate epoch. The contributions are sorted, so that the store is
+// updated in a deterministic order.
+func (k *Keeper) recordMakerRebateVolumes(ctx sdk.Context, contributions []*SubaccountVolumeContribution) {
+	quoteDenoms := make(map[common.Hash]string)
+
+	epochStart := k.GetMakerRebateEpochStartTimestamp(ctx)
+	if epochStart == 0 {
+		epochStart = ctx.BlockTime().Unix()
+		k.setMakerRebateEpochStartTimestamp(ctx, epochStart)
+	}
+
+	for _, contribution := range contributions {
+		if !contribution.Volume.MakerVolume.IsPositive() {
+			continue
+		}
+
+		quoteDenom, ok := quoteDenoms[contribution.MarketID]
+		if !ok {
+			quoteDenom = k.getMarketQuoteDenom(ctx, contribution.MarketID)
+			quoteDenoms[contribution.MarketID] = quoteDenom
+		}
+
+		if quoteDenom == "" {
+			continue
+		}
+
+		account := types.SubaccountIDToSdkAddress(contribution.SubaccountID)
+		k.addMakerRebateVolume(ctx, epochStart, account, quoteDenom, contribution.Volume.MakerVolume)
+	}
+}
+
+func (k *Keeper) getMarketQuoteDenom(ctx sdk.Context, marketID common.Hash) string {
+	if spotMarket := k.GetSpotMarketByID(ctx, marketID); spotMarket != nil {
+		return spotMarket.QuoteDenom
+	}
+
+	if derivativeMarket := k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil); derivativeMarket != nil {
+		return derivativeMarket.GetQuoteDenom()
+	}
+
+	return ""
+}
+
+// ProcessMakerRebates ends the maker rebate epoch once its duration elapsed and settles the rebates of the ended epoch,
+// paying up to MakerRebateMaxVolumesPerBlock account maker volumes per block. An epoch only ends once the rebates of
+// the previous epoch are settled. When the maker rebates get disabled, the current epoch ends without any rebate.
+func (k *Keeper) ProcessMakerRebates(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	epochStart := k.GetMakerRebateEpochStartTimestamp(ctx)
+	blockTime := ctx.BlockTime().Unix()
+	settlement := k.GetMakerRebateSettlement(ctx)
+
+	switch {
+	case settlement != nil:
+		// the previous epoch is still being settled
+	case params.MakerRebateEpochDuration == 0:
+		if epochStart != 0 {
+			// the maker volumes are dropped through the settlement, without any rebate
+			settlement = k.endMakerRebateEpoch(ctx, epochStart, sdk.ZeroDec(), params.MakerRebatePoolSource)
+			k.getStore(ctx).Delete(types.MakerRebateEpochStartKey)
+		}
+	case epochStart == 0:
+		k.setMakerRebateEpochStartTimestamp(ctx, blockTime)
+	case blockTime >= epochStart+params.MakerRebateEpochDuration:
+		settlement = k.endMakerRebateEpoch(ctx, epochStart, params.MakerRebateRate, params.MakerRebatePoolSource)
+		k.setMakerRebateEpochStartTimestamp(ctx, blockTime)
+	}
+
+	if settlement != nil {
+		k.settleMakerRebates(ctx, settlement, params.MakerRebateMaxVolumesPerBlock)
+	}
+}
+
+// endMakerRebateEpoch starts the settlement of the rebates of an ended maker rebate epoch. Every account is paid the
+// rebate rate share of its maker volume per quote denom. When the pool doesn't hold enough funds for a denom, the rate
+// of that denom is reduced pro rata to the available funds. Only the total volumes per denom are read, so the work
+// doesn't depend on the number of makers. The rebates are paid from the same pool until the settlement is over, even
+// if the pool source param changes meanwhile.
+func (k *Keeper) endMakerRebateEpoch(ctx sdk.Context, epochStart int64, rebateRate sdk.Dec, poolSource types.MakerRebatePoolSource) *types.MakerRebateSettlement {
+	totalVolumeStore := prefix.NewStore(k.getStore(ctx), types.GetMakerRebateEpochTotalVolumesPrefix(epochStart))
+
+	iterator := totalVolumeStore.Iterator(nil, nil)
+	totalVolumeKeys := make([][]byte, 0)
+	rebateRates := sdk.NewDecCoins()
+
+	for ; iterator.Valid(); iterator.Next() {
+		totalVolumeKeys = append(totalVolumeKeys, iterator.Key())
+
+		var totalVolume sdk.Dec
+		if err := totalVolume.Unmarshal(iterator.Value()); err != nil || !rebateRate.IsPositive() {
+			continue
+		}
+
+		denom := string(iterator.Key())
+		requested := totalVolume.Mul(rebateRate)
+		available := k.getMakerRebatePoolBalance(ctx, denom, poolSource).ToDec()
+
+		if requested.IsPositive() && available.IsPositive() {
+			payoutRatio := sdk.MinDec(sdk.OneDec(), available.QuoTruncate(requested))
+			// the total volumes are iterated in the order of their denoms, so the rates stay sorted
+			rebateRates = append(rebateRates, sdk.NewDecCoinFromDec(denom, rebateRate.Mul(payoutRatio)))
+		}
+	}
+	iterator.Close()
+
+	for _, key := range totalVolumeKeys {
+		totalVolumeStore.Delete(key)
+	}
+
+	settlement := &types.MakerRebateSettlement{
+		EpochStartTimestamp: epochStart,
+		RebateRates:         rebateRates,
+		PoolSource:          poolSource,
+	}
+	k.setMakerRebateSettlement(ctx, settlement)
+
+	return settlement
+}
+
+// settleMakerRebates pays the rebates of up to maxVolumes account maker volumes of the settled epoch, in the order of
+// the accounts and from the pool of the settlement, and deletes the paid volumes so that the next block resumes after them. The settlement is over once
+// every volume is paid. The volumes of an account in several denoms are paid at once, unless the limit splits them
+// over two blocks.
+func (k *Keeper) settleMakerRebates(ctx sdk.Context, settlement *types.MakerRebateSettlement, maxVolumes uint32) {
+	store := k.getStore(ctx)
+	volumeStore := prefix.NewStore(store, types.GetMakerRebateEpochVolumesPrefix(settlement.EpochStartTimestamp))
+
+	iterator := volumeStore.Iterator(nil, nil)
+	volumeKeys := make([][]byte, 0)
+	volumes := make([]*MakerRebateVolume, 0)
+
+	for ; iterator.Valid() && uint32(len(volumeKeys)) < maxVolumes; iterator.Next() {
+		volumeKeys = append(volumeKeys, iterator.Key())
+
+		if volume, ok := parseMakerRebateVolume(iterator.Key(), iterator.Value()); ok {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	isSettled := !iterator.Valid()
+	iterator.Close()
+
+	var (
+		account sdk.AccAddress
+		rebates = sdk.NewCoins()
+	)
+
+	for _, volume := range volumes {
+		if !volume.Account.Equals(account) {
+			k.payMakerRebates(ctx, account, rebates, settlement.PoolSource)
+			account, rebates = volume.Account, sdk.NewCoins()
+		}
+
+		amount := volume.Volume.Mul(settlement.RebateRates.AmountOf(volume.Denom)).TruncateInt()
+		if amount.IsPositive() {
+			rebates = rebates.Add(sdk.NewCoin(volume.Denom, amount))
+		}
+	}
+
+	k.payMakerRebates(ctx, account, rebates, settlement.PoolSource)
+
+	for _, key := range volumeKeys {
+		volumeStore.Delete(key)
+	}
+
+	if isSettled {
+		store.Delete(types.MakerRebateSettlementKey)
+	}
+}
+
+func (k *Keeper) getMakerRebatePoolBalance(ctx sdk.Context, denom string, poolSource types.MakerRebatePoolSource) sdkmath.Int {
+	if poolSource == types.MakerRebatePoolSource_MakerRebateCommunityPool {
+		return k.DistributionKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom).TruncateInt()
+	}
+
+	return k.bankKeeper.GetBalance(ctx, types.MakerRebatePoolAddress, denom).Amount
+}
+
+func (k *Keeper) payMakerRebates(ctx sdk.Context, account sdk.AccAddress, rebates sdk.Coins, poolSource types.MakerRebatePoolSource) {
+	if account.Empty() || rebates.IsZero() {
+		return
+	}
+
+	var err error
+	if poolSource == types.MakerRebatePoolSource_MakerRebateCommunityPool {
+		err = k.DistributionKeeper.DistributeFromFeePool(ctx, rebates, account)
+	} else {
+		err = k.bankKeeper.SendCoins(ctx, types.MakerRebatePoolAddress, account, rebates)
+	}
+
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("maker rebate transfer failed", "account", account.String(), "rebates", rebates.String(), "err", err.Error())
+		return
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventMakerRebate{
+		Account: account.String(),
+		Rebates: rebates,
+	})
+}
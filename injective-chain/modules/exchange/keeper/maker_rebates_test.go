@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Maker rebates", func() {
+	const epochDuration = 3600
+
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		market    testexchange.SpotMarket
+		makerA    = testexchange.SampleSubaccountAddr1
+		makerB    = testexchange.SampleSubaccountAddr2
+		taker     = testexchange.SampleSubaccountAddr3
+	)
+
+	createLimitOrder := func(quantity string, orderType types.OrderType, subaccountID common.Hash) {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString("100", quantity, orderType, subaccountID),
+		)
+		_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		testexchange.OrFail(err)
+	}
+
+	fundRebatePool := func(amount int64) {
+		funds := sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(amount)))
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.MakerRebatePoolAddress, funds))
+	}
+
+	poolBalance := func() sdkmath.Int {
+		return app.BankKeeper.GetBalance(ctx, types.MakerRebatePoolAddress, market.QuoteDenom).Amount
+	}
+
+	bankBalanceOf := func(subaccountID common.Hash) sdkmath.Int {
+		return app.BankKeeper.GetBalance(ctx, types.SubaccountIDToSdkAddress(subaccountID), market.QuoteDenom).Amount
+	}
+
+	// processMakerRebates returns the rebates paid to the makers and to the taker at the block time
+	processMakerRebates := func(blockTime time.Time) (makerARebate, makerBRebate, takerRebate sdkmath.Int) {
+		makerABalance, makerBBalance, takerBalance := bankBalanceOf(makerA), bankBalanceOf(makerB), bankBalanceOf(taker)
+
+		ctx = ctx.WithBlockTime(blockTime)
+		app.ExchangeKeeper.ProcessMakerRebates(ctx)
+
+		return bankBalanceOf(makerA).Sub(makerABalance), bankBalanceOf(makerB).Sub(makerBBalance), bankBalanceOf(taker).Sub(takerBalance)
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+		market = testInput.Spots[0]
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MakerRebateEpochDuration = epochDuration
+		params.MakerRebateRate = sdk.NewDecWithPrec(1, 1)
+		params.MakerRebatePoolSource = types.MakerRebatePoolSource_MakerRebatePool
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testexchange.MintAndDeposit(app, ctx, makerA.String(), sdk.NewCoins(sdk.NewCoin(market.BaseDenom, sdk.NewInt(10))))
+		testexchange.MintAndDeposit(app, ctx, makerB.String(), sdk.NewCoins(sdk.NewCoin(market.BaseDenom, sdk.NewInt(10))))
+		testexchange.MintAndDeposit(app, ctx, taker.String(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000))))
+
+		// the first EndBlocker starts the epoch
+		createLimitOrder("1", types.OrderType_SELL, makerA)
+		createLimitOrder("3", types.OrderType_SELL, makerB)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		createLimitOrder("4", types.OrderType_BUY, taker)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+	})
+
+	It("pays the makers proportionally to their maker volume at the end of the epoch", func() {
+		fundRebatePool(1000)
+		epochEnd := ctx.BlockTime().Add(epochDuration * time.Second)
+
+		makerARebate, _, _ := processMakerRebates(epochEnd.Add(-time.Second))
+		Expect(makerARebate.IsZero()).To(BeTrue())
+
+		makerARebate, makerBRebate, takerRebate := processMakerRebates(epochEnd)
+		Expect(makerARebate).To(Equal(sdk.NewInt(10)))
+		Expect(makerBRebate).To(Equal(sdk.NewInt(30)))
+		Expect(takerRebate.IsZero()).To(BeTrue())
+		Expect(app.BankKeeper.GetBalance(ctx, types.MakerRebatePoolAddress, market.QuoteDenom).Amount).To(Equal(sdk.NewInt(960)))
+		Expect(app.ExchangeKeeper.GetAllMakerRebateVolumes(ctx)).To(BeEmpty())
+	})
+
+	It("clamps the rebates to the funds of the pool", func() {
+		fundRebatePool(20)
+
+		makerARebate, makerBRebate, _ := processMakerRebates(ctx.BlockTime().Add(epochDuration * time.Second))
+		Expect(makerARebate).To(Equal(sdk.NewInt(5)))
+		Expect(makerBRebate).To(Equal(sdk.NewInt(15)))
+		Expect(app.BankKeeper.GetBalance(ctx, types.MakerRebatePoolAddress, market.QuoteDenom).Amount.IsZero()).To(BeTrue())
+	})
+
+	It("exports and imports the maker volumes and the epoch start in genesis", func() {
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		Expect(state.MakerRebateEpochStartTimestamp).To(Equal(app.ExchangeKeeper.GetMakerRebateEpochStartTimestamp(ctx)))
+		Expect(state.MakerRebateVolumes).To(HaveLen(2))
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+		app.ExchangeKeeper.InitGenesis(ctx, *state)
+
+		Expect(app.ExchangeKeeper.GetMakerRebateEpochStartTimestamp(ctx)).To(Equal(state.MakerRebateEpochStartTimestamp))
+		Expect(app.ExchangeKeeper.GetAllMakerRebateAccountVolumes(ctx)).To(Equal(state.MakerRebateVolumes))
+
+		// the imported epoch ends at the same time and pays the same rebates
+		fundRebatePool(1000)
+		makerARebate, makerBRebate, _ := processMakerRebates(time.Unix(state.MakerRebateEpochStartTimestamp+epochDuration, 0))
+		Expect(makerARebate).To(Equal(sdk.NewInt(10)))
+		Expect(makerBRebate).To(Equal(sdk.NewInt(30)))
+	})
+
+	// importManyMakers adds 25 makers to the 2 makers of the epoch, ordered before them by address, settled 10 per
+	// block, and returns the end of the epoch
+	importManyMakers := func() time.Time {
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		for i := 0; i < 25; i++ {
+			maker := sdk.AccAddress(common.LeftPadBytes([]byte{byte(i + 1)}, common.AddressLength))
+			state.MakerRebateVolumes = append(state.MakerRebateVolumes, types.MakerRebateAccountVolume{
+				Account: maker.String(),
+				Denom:   market.QuoteDenom,
+				Volume:  sdk.NewDec(100),
+			})
+		}
+		state.Params.MakerRebateMaxVolumesPerBlock = 10
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+		app.ExchangeKeeper.InitGenesis(ctx, *state)
+
+		return time.Unix(state.MakerRebateEpochStartTimestamp+epochDuration, 0)
+	}
+
+	It("settles the rebates of many makers over several blocks", func() {
+		epochEnd := importManyMakers()
+		fundRebatePool(1000)
+
+		nextEpochEnd := epochEnd.Add(epochDuration * time.Second)
+
+		processMakerRebates(epochEnd)
+		Expect(poolBalance()).To(Equal(sdk.NewInt(900)))
+		Expect(app.ExchangeKeeper.GetMakerRebateSettlement(ctx)).ToNot(BeNil())
+		Expect(app.ExchangeKeeper.GetMakerRebateEpochStartTimestamp(ctx)).To(Equal(epochEnd.Unix()))
+
+		// the next epoch doesn't end while the previous one is being settled
+		processMakerRebates(nextEpochEnd)
+		Expect(poolBalance()).To(Equal(sdk.NewInt(800)))
+		Expect(app.ExchangeKeeper.GetMakerRebateEpochStartTimestamp(ctx)).To(Equal(epochEnd.Unix()))
+
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		Expect(state.MakerRebateSettlement.EpochStartTimestamp).To(Equal(epochEnd.Unix() - epochDuration))
+		Expect(state.MakerRebateSettlementVolumes).To(HaveLen(7))
+
+		makerARebate, makerBRebate, _ := processMakerRebates(nextEpochEnd.Add(time.Second))
+		Expect(makerARebate).To(Equal(sdk.NewInt(10)))
+		Expect(makerBRebate).To(Equal(sdk.NewInt(30)))
+		Expect(poolBalance()).To(Equal(sdk.NewInt(710)))
+		Expect(app.ExchangeKeeper.GetMakerRebateSettlement(ctx)).To(BeNil())
+
+		// once settled, the next epoch ends
+		processMakerRebates(nextEpochEnd.Add(2 * time.Second))
+		Expect(app.ExchangeKeeper.GetMakerRebateEpochStartTimestamp(ctx)).To(Equal(nextEpochEnd.Add(2 * time.Second).Unix()))
+	})
+
+	It("pays a pending settlement from the pool it was computed against", func() {
+		epochEnd := importManyMakers()
+		fundRebatePool(1000)
+
+		communityPoolFunds := sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(1000)))
+		funder := sdk.AccAddress(common.LeftPadBytes([]byte{0xff}, common.AddressLength))
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, types.ModuleName, communityPoolFunds))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, communityPoolFunds))
+		testexchange.OrFail(app.DistrKeeper.FundCommunityPool(ctx, communityPoolFunds, funder))
+		communityPoolBalance := func() sdk.Dec {
+			return app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf(market.QuoteDenom)
+		}
+
+		processMakerRebates(epochEnd)
+		Expect(poolBalance()).To(Equal(sdk.NewInt(900)))
+		Expect(app.ExchangeKeeper.GetMakerRebateSettlement(ctx).PoolSource).To(Equal(types.MakerRebatePoolSource_MakerRebatePool))
+
+		// governance switches the pool source while the settlement is pending
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MakerRebatePoolSource = types.MakerRebatePoolSource_MakerRebateCommunityPool
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		processMakerRebates(epochEnd.Add(time.Second))
+		makerARebate, makerBRebate, _ := processMakerRebates(epochEnd.Add(2 * time.Second))
+		Expect(makerARebate).To(Equal(sdk.NewInt(10)))
+		Expect(makerBRebate).To(Equal(sdk.NewInt(30)))
+		Expect(app.ExchangeKeeper.GetMakerRebateSettlement(ctx)).To(BeNil())
+
+		Expect(poolBalance()).To(Equal(sdk.NewInt(710)))
+		Expect(communityPoolBalance()).To(Equal(sdk.NewDec(1000)))
+	})
+})
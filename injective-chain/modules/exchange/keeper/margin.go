@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// The margin requirements of the positions of a derivative market are checked through the helpers below only, so that
+// position updates and liquidations agree on the margin of a position. Like an order at its mark price threshold, a
+// position exactly at the initial margin ratio meets the initial margin requirement. A position is above the
+// maintenance margin ratio only if its mark price is strictly on the safe side of its liquidation price, a position
+// exactly at the maintenance margin ratio is therefore liquidatable.
+
+// MarginRequirement defines against which margin ratio of a derivative market an order or a position is checked.
+type MarginRequirement uint8
+
+const (
+	// InitialMarginRequirement applies to orders and to positions opened or increased outside of the orderbook
+	InitialMarginRequirement MarginRequirement = iota
+	// MaintenanceMarginRequirement applies to positions kept open, below it a position is liquidated
+	MaintenanceMarginRequirement
+)
+
+// GetMarginRatio returns the margin ratio of the requirement set for the market.
+func (r MarginRequirement) GetMarginRatio(market *types.DerivativeMarket) sdk.Dec {
+	if r == MaintenanceMarginRequirement {
+		return market.MaintenanceMarginRatio
+	}
+
+	return market.InitialMarginRatio
+}
+
+func (r MarginRequirement) String() string {
+	if r == MaintenanceMarginRequirement {
+		return "maintenance"
+	}
+
+	return "initial"
+}
+
+// IsPositionAboveMarginRequirement returns true if the position meets the margin ratio of the requirement at the mark
+// price, after the pending funding if any. Empty positions have no margin requirement.
+func (k *Keeper) IsPositionAboveMarginRequirement(
+	position *types.Position,
+	market *types.DerivativeMarket,
+	requirement MarginRequirement,
+	markPrice sdk.Dec,
+	funding *types.PerpetualMarketFunding,
+) bool {
+	if !position.Quantity.IsPositive() {
+		return true
+	}
+
+	if requirement == InitialMarginRequirement {
+		// effectiveMargin ≥ initialMarginRatio * markPrice * quantity, which unlike the margin ratio holds at a zero mark price
+		requiredMargin := market.InitialMarginRatio.Mul(markPrice).Mul(position.Quantity)
+		return position.GetEffectiveMargin(funding, markPrice).GTE(requiredMargin)
+	}
+
+	liquidationPrice := position.GetLiquidationPrice(market.MaintenanceMarginRatio, funding)
+
+	if position.IsLong {
+		return markPrice.GT(liquidationPrice)
+	}
+
+	return markPrice.LT(liquidationPrice)
+}
+
+// ensurePositionAboveMarginRequirement returns ErrLowPositionMargin unless the position stays above the margin ratio
+// of the requirement at the mark price.
+func (k *Keeper) ensurePositionAboveMarginRequirement(
+	position *types.Position,
+	market *types.DerivativeMarket,
+	requirement MarginRequirement,
+	markPrice sdk.Dec,
+	funding *types.PerpetualMarketFunding,
+) error {
+	if k.IsPositionAboveMarginRequirement(position, market, requirement, markPrice, funding) {
+		return nil
+	}
+
+	return errors.Wrapf(
+		types.ErrLowPositionMargin,
+		"%s position must stay above the %s margin ratio %s at mark price %s, its margin ratio reaches it at price %s",
+		position.GetDirectionString(),
+		requirement.String(),
+		requirement.GetMarginRatio(market).String(),
+		markPrice.String(),
+		position.GetLiquidationPrice(requirement.GetMarginRatio(market), funding).String(),
+	)
+}
+
+// IsPositionLiquidatable returns true if the position is at or below the maintenance margin ratio of the market at the
+// mark price.
+func (k *Keeper) IsPositionLiquidatable(
+	position *types.Position,
+	market *types.DerivativeMarket,
+	markPrice sdk.Dec,
+	funding *types.PerpetualMarketFunding,
+) bool {
+	return !k.IsPositionAboveMarginRequirement(position, market, MaintenanceMarginRequirement, markPrice, funding)
+}
+
+// GetPositionLiquidationPrice returns the mark price at which the position reaches the maintenance margin ratio of the
+// market on its own margin, after the pending funding if any. A long position is liquidatable at or below this price
+// and a short position at or above it. Long positions funded enough to stay above the maintenance margin ratio down to
+// a zero mark price have no liquidation price, in which case false is returned along with a zero price.
+func (k *Keeper) GetPositionLiquidationPrice(
+	position *types.Position,
+	market *types.DerivativeMarket,
+	funding *types.PerpetualMarketFunding,
+) (liquidationPrice sdk.Dec, hasLiquidationPrice bool) {
+	liquidationPrice = position.GetLiquidationPrice(market.MaintenanceMarginRatio, funding)
+
+	if position.IsLong && !liquidationPrice.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+
+	return liquidationPrice, true
+}
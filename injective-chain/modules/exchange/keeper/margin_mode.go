@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetSubaccountMarginMode returns the margin mode of the subaccount, isolated unless cross margin was enabled.
+func (k *Keeper) GetSubaccountMarginMode(ctx sdk.Context, subaccountID common.Hash) types.MarginMode {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	crossMarginStore := prefix.NewStore(k.getStore(ctx), types.CrossMarginSubaccountPrefix)
+	if crossMarginStore.Has(subaccountID.Bytes()) {
+		return types.MarginMode_Cross
+	}
+
+	return types.MarginMode_Isolated
+}
+
+// SetSubaccountMarginMode switches the margin mode of the subaccount. The switch is rejected while the subaccount has
+// a position in any derivative or binary options market, so that no position ever changes its margin mode. The default
+// subaccount can't use cross margin, since its bank balance can be moved without the check of
+// EnsureCrossMarginRequirement.
+func (k *Keeper) SetSubaccountMarginMode(ctx sdk.Context, subaccountID common.Hash, marginMode types.MarginMode) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if k.GetSubaccountMarginMode(ctx, subaccountID) == marginMode {
+		return nil
+	}
+
+	if marginMode == types.MarginMode_Cross && types.IsDefaultSubaccountID(subaccountID) {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInvalidMarginMode.Wrap("the default subaccount can't use cross margin")
+	}
+
+	for _, market := range k.GetAllDerivativeAndBinaryOptionsMarkets(ctx) {
+		if k.HasPosition(ctx, market.MarketID(), subaccountID) {
+			metrics.ReportFuncError(k.svcTags)
+			return types.ErrMarginModeSwitchWithPositions.Wrapf("subaccount %s has a position in market %s", subaccountID.Hex(), market.MarketID().Hex())
+		}
+	}
+
+	k.setSubaccountMarginMode(ctx, subaccountID, marginMode)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSubaccountMarginModeUpdate{
+		SubaccountId: subaccountID.Hex(),
+		MarginMode:   marginMode,
+	})
+
+	return nil
+}
+
+func (k *Keeper) setSubaccountMarginMode(ctx sdk.Context, subaccountID common.Hash, marginMode types.MarginMode) {
+	crossMarginStore := prefix.NewStore(k.getStore(ctx), types.CrossMarginSubaccountPrefix)
+
+	if marginMode != types.MarginMode_Cross {
+		crossMarginStore.Delete(subaccountID.Bytes())
+		return
+	}
+
+	crossMarginStore.Set(subaccountID.Bytes(), []byte{})
+}
+
+// setCrossMarginPositionMarket indexes the market in which the cross margin subaccount has a position. Since the margin
+// mode of a subaccount can't be switched while it has positions, the index always covers every position of a cross
+// margin subaccount.
+func (k *Keeper) setCrossMarginPositionMarket(ctx sdk.Context, subaccountID, marketID common.Hash) {
+	marketStore := prefix.NewStore(k.getStore(ctx), types.GetCrossMarginPositionMarketPrefix(subaccountID))
+	marketStore.Set(marketID.Bytes(), []byte{})
+}
+
+func (k *Keeper) deleteCrossMarginPositionMarket(ctx sdk.Context, subaccountID, marketID common.Hash) {
+	marketStore := prefix.NewStore(k.getStore(ctx), types.GetCrossMarginPositionMarketPrefix(subaccountID))
+	marketStore.Delete(marketID.Bytes())
+}
+
+// getCrossMarginPositionMarketIDs returns the markets in which the cross margin subaccount has a position, ordered by
+// market ID.
+func (k *Keeper) getCrossMarginPositionMarketIDs(ctx sdk.Context, subaccountID common.Hash) []common.Hash {
+	marketStore := prefix.NewStore(k.getStore(ctx), types.GetCrossMarginPositionMarketPrefix(subaccountID))
+
+	iterator := marketStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	marketIDs := make([]common.Hash, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		marketIDs = append(marketIDs, common.BytesToHash(iterator.Key()))
+	}
+
+	return marketIDs
+}
+
+// GetAllCrossMarginSubaccountIDs returns the subaccounts in cross margin mode.
+func (k *Keeper) GetAllCrossMarginSubaccountIDs(ctx sdk.Context) []string {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	crossMarginStore := prefix.NewStore(k.getStore(ctx), types.CrossMarginSubaccountPrefix)
+
+	iterator := crossMarginStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	subaccountIDs := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		subaccountIDs = append(subaccountIDs, common.BytesToHash(iterator.Key()).Hex())
+	}
+
+	return subaccountIDs
+}
+
+// IsSubaccountPositionLiquidatable returns true if the position of the subaccount can be liquidated. A position of an
+// isolated margin subaccount is liquidatable at or below the maintenance margin ratio of its market, see
+// IsPositionLiquidatable. The positions of a cross margin subaccount are liquidatable once its cross margin equity is at
+// or below its cross margin maintenance requirement in the quote denom of the market, see GetCrossMarginState.
+func (k *Keeper) IsSubaccountPositionLiquidatable(
+	ctx sdk.Context,
+	subaccountID common.Hash,
+	position *types.Position,
+	market *types.DerivativeMarket,
+	markPrice sdk.Dec,
+	funding *types.PerpetualMarketFunding,
+) bool {
+	if k.GetSubaccountMarginMode(ctx, subaccountID) != types.MarginMode_Cross {
+		return k.IsPositionLiquidatable(position, market, markPrice, funding)
+	}
+
+	equity, requirement, err := k.GetCrossMarginState(ctx, subaccountID, market.QuoteDenom)
+	if err != nil {
+		// without the mark price of every market, fall back to the check of the position on its own margin
+		k.Logger(ctx).Error("cross margin state unavailable", "subaccountID", subaccountID.Hex(), "error", err.Error())
+		return k.IsPositionLiquidatable(position, market, markPrice, funding)
+	}
+
+	return equity.LTE(requirement)
+}
+
+// GetCrossMarginState returns the cross margin equity and maintenance requirement of the subaccount in the quote denom.
+// The equity is the spendable balance of the subaccount plus the effective margin of its positions in the active
+// derivative markets of the quote denom, while the requirement is the sum of the maintenance margin of these positions
+// at the mark price. For a single position and no spendable balance, the requirement is reached exactly at the
+// liquidation price of the position, so that cross margin only differs from isolated margin by the shared balance.
+// Only the markets in which the subaccount has a position are read.
+func (k *Keeper) GetCrossMarginState(ctx sdk.Context, subaccountID common.Hash, quoteDenom string) (equity, requirement sdk.Dec, err error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	equity = k.GetSpendableFunds(ctx, subaccountID, quoteDenom)
+	requirement = sdk.ZeroDec()
+
+	for _, marketID := range k.getCrossMarginPositionMarketIDs(ctx, subaccountID) {
+		market := k.GetDerivativeMarket(ctx, marketID, true)
+		if market == nil || market.QuoteDenom != quoteDenom {
+			continue
+		}
+
+		position := k.GetPosition(ctx, marketID, subaccountID)
+		if position == nil || !position.Quantity.IsPositive() {
+			continue
+		}
+
+		markPrice, err := k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+
+		var funding *types.PerpetualMarketFunding
+		if market.IsPerpetual {
+			funding = k.GetPerpetualMarketFunding(ctx, marketID)
+		}
+
+		equity = equity.Add(position.GetEffectiveMargin(funding, *markPrice))
+		requirement = requirement.Add(market.MaintenanceMarginRatio.Mul(position.Quantity).Mul(*markPrice))
+	}
+
+	return equity, requirement, nil
+}
+
+// EnsureCrossMarginRequirement rejects spending the amount from the spendable funds of a cross margin subaccount when
+// its cross margin equity would be left at or below its maintenance requirement in the denom, see GetCrossMarginState.
+// Since cross margin counts the spendable funds towards the margin of the positions, withdrawals, transfers and order
+// margin holds must not leave them liquidatable. Isolated margin subaccounts, whose positions only rely on their own
+// margin, and subaccounts without positions in the denom are not checked.
+func (k *Keeper) EnsureCrossMarginRequirement(ctx sdk.Context, subaccountID common.Hash, denom string, amount sdk.Dec) error {
+	if k.GetSubaccountMarginMode(ctx, subaccountID) != types.MarginMode_Cross {
+		return nil
+	}
+
+	equity, requirement, err := k.GetCrossMarginState(ctx, subaccountID, denom)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	if requirement.IsPositive() && equity.Sub(amount).LTE(requirement) {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrCrossMarginRequirement.Wrapf("spending %s %s leaves a cross margin equity of %s for a maintenance requirement of %s", amount.String(), denom, equity.Sub(amount).String(), requirement.String())
+	}
+
+	return nil
+}
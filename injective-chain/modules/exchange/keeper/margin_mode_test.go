@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Cross margin mode", func() {
+	var (
+		testInput     testexchange.TestInput
+		app           *simapp.InjectiveApp
+		ctx           sdk.Context
+		msgServer     types.MsgServer
+		markets       []*types.DerivativeMarket
+		quoteDenom    string
+		startingPrice = sdk.NewDec(2000)
+	)
+
+	subaccountID := func(account sdk.AccAddress) common.Hash {
+		id, err := types.SdkAddressWithNonceToSubaccountID(account, 1)
+		testexchange.OrFail(err)
+		return *id
+	}
+
+	isolatedSubaccount := subaccountID(testexchange.SampleAccountAddr1)
+	crossSubaccount := subaccountID(testexchange.SampleAccountAddr2)
+
+	setMarkPrice := func(marketIndex int, price sdk.Dec) {
+		perp := testInput.Perps[marketIndex]
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, perp.OracleBase, perp.OracleQuote, oracletypes.NewPriceState(price, ctx.BlockTime().Unix()))
+	}
+
+	// launchMarket launches the perpetual market of the test input with the quote denom of the first market, so that
+	// the positions in all the markets share the same cross margin collateral
+	launchMarket := func(marketIndex int) *types.DerivativeMarket {
+		perp := testInput.Perps[marketIndex]
+		setMarkPrice(marketIndex, startingPrice)
+
+		sender := types.SubaccountIDToSdkAddress(testexchange.SampleSubaccountAddr3)
+		coin := sdk.NewCoin(quoteDenom, sdk.OneInt())
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, coin, perp.Ticker, quoteDenom, perp.OracleBase, perp.OracleQuote, perp.OracleType, -1))
+
+		market, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			perp.Ticker,
+			quoteDenom,
+			perp.OracleBase,
+			perp.OracleQuote,
+			0,
+			perp.OracleType,
+			perp.InitialMarginRatio,
+			perp.MaintenanceMarginRatio,
+			perp.MakerFeeRate,
+			perp.TakerFeeRate,
+			perp.MinPriceTickSize,
+			perp.MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+		return market
+	}
+
+	// openLongPosition opens a long position of 1 contract at the starting price with the given margin
+	openLongPosition := func(marketIndex int, subaccountID common.Hash, margin int64) *types.Position {
+		position := &types.Position{
+			IsLong:                 true,
+			Quantity:               sdk.OneDec(),
+			EntryPrice:             startingPrice,
+			Margin:                 sdk.NewDec(margin),
+			CumulativeFundingEntry: sdk.ZeroDec(),
+		}
+		app.ExchangeKeeper.SetPosition(ctx, markets[marketIndex].MarketID(), subaccountID, position)
+		return position
+	}
+
+	isLiquidatable := func(marketIndex int, subaccountID common.Hash) bool {
+		market := markets[marketIndex]
+		position := app.ExchangeKeeper.GetPosition(ctx, market.MarketID(), subaccountID)
+		markPrice := app.OracleKeeper.GetPriceFeedPrice(ctx, testInput.Perps[marketIndex].OracleBase, testInput.Perps[marketIndex].OracleQuote)
+		funding := app.ExchangeKeeper.GetPerpetualMarketFunding(ctx, market.MarketID())
+		return app.ExchangeKeeper.IsSubaccountPositionLiquidatable(ctx, subaccountID, position, market, *markPrice, funding)
+	}
+
+	setMarginMode := func(subaccountID common.Hash, marginMode types.MarginMode) error {
+		_, err := msgServer.SetMarginMode(sdk.WrapSDKContext(ctx), &types.MsgSetMarginMode{
+			Sender:       types.SubaccountIDToSdkAddress(subaccountID).String(),
+			SubaccountId: subaccountID.Hex(),
+			MarginMode:   marginMode,
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 2, 0)
+		quoteDenom = testInput.Perps[0].QuoteDenom
+		markets = []*types.DerivativeMarket{launchMarket(0), launchMarket(1)}
+
+		testexchange.OrFail(setMarginMode(crossSubaccount, types.MarginMode_Cross))
+	})
+
+	It("keeps the subaccounts in isolated margin by default", func() {
+		Expect(app.ExchangeKeeper.GetSubaccountMarginMode(ctx, isolatedSubaccount)).To(Equal(types.MarginMode_Isolated))
+		Expect(app.ExchangeKeeper.GetSubaccountMarginMode(ctx, crossSubaccount)).To(Equal(types.MarginMode_Cross))
+		Expect(app.ExchangeKeeper.GetAllCrossMarginSubaccountIDs(ctx)).To(Equal([]string{crossSubaccount.Hex()}))
+	})
+
+	It("liquidates a single position without available balance at the isolated liquidation price", func() {
+		// the liquidation price of both positions is (2000 - 100) / (1 - 0.02) ≈ 1938.78
+		openLongPosition(0, isolatedSubaccount, 100)
+		openLongPosition(0, crossSubaccount, 100)
+
+		setMarkPrice(0, sdk.NewDec(1939))
+		Expect(isLiquidatable(0, isolatedSubaccount)).To(BeFalse())
+		Expect(isLiquidatable(0, crossSubaccount)).To(BeFalse())
+
+		setMarkPrice(0, sdk.NewDec(1938))
+		Expect(isLiquidatable(0, isolatedSubaccount)).To(BeTrue())
+		Expect(isLiquidatable(0, crossSubaccount)).To(BeTrue())
+	})
+
+	It("backs the position with the available balance of a cross margin subaccount", func() {
+		deposit := sdk.NewCoins(sdk.NewInt64Coin(quoteDenom, 100))
+		testexchange.MintAndDeposit(app, ctx, isolatedSubaccount.Hex(), deposit)
+		testexchange.MintAndDeposit(app, ctx, crossSubaccount.Hex(), deposit)
+
+		openLongPosition(0, isolatedSubaccount, 100)
+		openLongPosition(0, crossSubaccount, 100)
+
+		// the equity of the cross margin subaccount is 100 + 100 - 100 = 100 against a requirement of 0.02 * 1900 = 38
+		setMarkPrice(0, sdk.NewDec(1900))
+		Expect(isLiquidatable(0, isolatedSubaccount)).To(BeTrue())
+		Expect(isLiquidatable(0, crossSubaccount)).To(BeFalse())
+
+		// the equity is 100 + 100 - 200 = 0 against a requirement of 0.02 * 1800 = 36
+		setMarkPrice(0, sdk.NewDec(1800))
+		Expect(isLiquidatable(0, crossSubaccount)).To(BeTrue())
+
+		setMarkPrice(0, sdk.NewDec(1900))
+		_, err := msgServer.LiquidatePosition(sdk.WrapSDKContext(ctx), &types.MsgLiquidatePosition{
+			Sender:       types.SubaccountIDToSdkAddress(testexchange.SampleSubaccountAddr3).String(),
+			SubaccountId: crossSubaccount.Hex(),
+			MarketId:     markets[0].MarketID().Hex(),
+		})
+		Expect(err).To(MatchError(types.ErrPositionNotLiquidable))
+	})
+
+	It("makes a healthy position liquidatable through an underwater position of a cross margin subaccount", func() {
+		for _, subaccountID := range []common.Hash{isolatedSubaccount, crossSubaccount} {
+			openLongPosition(0, subaccountID, 1000)
+			openLongPosition(1, subaccountID, 100)
+		}
+
+		// the equity is 1000 + 100 - 1050 = 50 against a requirement of 0.02 * 2000 + 0.02 * 950 = 59
+		setMarkPrice(1, sdk.NewDec(950))
+
+		Expect(isLiquidatable(0, isolatedSubaccount)).To(BeFalse())
+		Expect(isLiquidatable(1, isolatedSubaccount)).To(BeTrue())
+		Expect(isLiquidatable(0, crossSubaccount)).To(BeTrue())
+		Expect(isLiquidatable(1, crossSubaccount)).To(BeTrue())
+
+		// the equity is 1000 + 100 - 1000 = 100 against a requirement of 0.02 * 2000 + 0.02 * 1000 = 60
+		setMarkPrice(1, sdk.NewDec(1000))
+
+		Expect(isLiquidatable(0, crossSubaccount)).To(BeFalse())
+		Expect(isLiquidatable(1, crossSubaccount)).To(BeFalse())
+		Expect(isLiquidatable(1, isolatedSubaccount)).To(BeTrue())
+	})
+
+	It("rejects switching the margin mode with an open position", func() {
+		openLongPosition(0, crossSubaccount, 100)
+		Expect(setMarginMode(crossSubaccount, types.MarginMode_Isolated)).To(MatchError(types.ErrMarginModeSwitchWithPositions))
+		Expect(app.ExchangeKeeper.GetSubaccountMarginMode(ctx, crossSubaccount)).To(Equal(types.MarginMode_Cross))
+
+		app.ExchangeKeeper.DeletePosition(ctx, markets[0].MarketID(), crossSubaccount)
+		Expect(setMarginMode(crossSubaccount, types.MarginMode_Isolated)).To(BeNil())
+
+		res, err := app.ExchangeKeeper.SubaccountMarginMode(sdk.WrapSDKContext(ctx), &types.QuerySubaccountMarginModeRequest{
+			SubaccountId: crossSubaccount.Hex(),
+		})
+		Expect(err).To(BeNil())
+		Expect(res.MarginMode).To(Equal(types.MarginMode_Isolated))
+	})
+
+	It("rejects cross margin for the default subaccount", func() {
+		defaultSubaccount := types.MustSdkAddressWithNonceToSubaccountID(testexchange.SampleAccountAddr2, 0)
+		Expect(setMarginMode(defaultSubaccount, types.MarginMode_Cross)).To(MatchError(types.ErrInvalidMarginMode))
+		Expect(app.ExchangeKeeper.GetSubaccountMarginMode(ctx, defaultSubaccount)).To(Equal(types.MarginMode_Isolated))
+	})
+
+	Context("with the available balance backing a position", func() {
+		owner := types.SubaccountIDToSdkAddress(crossSubaccount).String()
+		coin := func(amount int64) sdk.Coin {
+			return sdk.NewInt64Coin(quoteDenom, amount)
+		}
+
+		BeforeEach(func() {
+			testexchange.MintAndDeposit(app, ctx, crossSubaccount.Hex(), sdk.NewCoins(coin(100)))
+			openLongPosition(0, crossSubaccount, 100)
+
+			// the equity is 100 + 100 - 100 = 100 against a requirement of 0.02 * 1900 = 38
+			setMarkPrice(0, sdk.NewDec(1900))
+		})
+
+		It("rejects a withdrawal leaving the subaccount at its maintenance requirement", func() {
+			withdraw := func(amount int64) error {
+				_, err := msgServer.Withdraw(sdk.WrapSDKContext(ctx), &types.MsgWithdraw{
+					Sender:       owner,
+					SubaccountId: crossSubaccount.Hex(),
+					Amount:       coin(amount),
+				})
+				return err
+			}
+
+			Expect(withdraw(70)).To(MatchError(types.ErrCrossMarginRequirement))
+			Expect(app.ExchangeKeeper.GetDeposit(ctx, crossSubaccount, quoteDenom).AvailableBalance.String()).To(Equal(sdk.NewDec(100).String()))
+
+			Expect(withdraw(50)).To(BeNil())
+			Expect(app.ExchangeKeeper.GetDeposit(ctx, crossSubaccount, quoteDenom).AvailableBalance.String()).To(Equal(sdk.NewDec(50).String()))
+		})
+
+		It("rejects transfers leaving the subaccount at its maintenance requirement", func() {
+			_, err := msgServer.SubaccountTransfer(sdk.WrapSDKContext(ctx), &types.MsgSubaccountTransfer{
+				Sender:                  owner,
+				SourceSubaccountId:      crossSubaccount.Hex(),
+				DestinationSubaccountId: types.MustSdkAddressWithNonceToSubaccountID(testexchange.SampleAccountAddr2, 2).Hex(),
+				Amount:                  coin(70),
+			})
+			Expect(err).To(MatchError(types.ErrCrossMarginRequirement))
+
+			_, err = msgServer.ExternalTransfer(sdk.WrapSDKContext(ctx), &types.MsgExternalTransfer{
+				Sender:                  owner,
+				SourceSubaccountId:      crossSubaccount.Hex(),
+				DestinationSubaccountId: isolatedSubaccount.Hex(),
+				Amount:                  coin(70),
+			})
+			Expect(err).To(MatchError(types.ErrCrossMarginRequirement))
+
+			Expect(app.ExchangeKeeper.GetDeposit(ctx, crossSubaccount, quoteDenom).AvailableBalance.String()).To(Equal(sdk.NewDec(100).String()))
+		})
+
+		It("rejects adding margin to another position leaving the subaccount at its maintenance requirement", func() {
+			openLongPosition(0, isolatedSubaccount, 1000)
+
+			increaseMargin := func(destinationSubaccountID common.Hash, amount int64) error {
+				_, err := msgServer.IncreasePositionMargin(sdk.WrapSDKContext(ctx), &types.MsgIncreasePositionMargin{
+					Sender:                  owner,
+					SourceSubaccountId:      crossSubaccount.Hex(),
+					DestinationSubaccountId: destinationSubaccountID.Hex(),
+					MarketId:                markets[0].MarketId,
+					Amount:                  sdk.NewDec(amount),
+				})
+				return err
+			}
+
+			Expect(increaseMargin(isolatedSubaccount, 70)).To(MatchError(types.ErrCrossMarginRequirement))
+			Expect(app.ExchangeKeeper.GetDeposit(ctx, crossSubaccount, quoteDenom).AvailableBalance.String()).To(Equal(sdk.NewDec(100).String()))
+			Expect(app.ExchangeKeeper.GetPosition(ctx, markets[0].MarketID(), isolatedSubaccount).Margin.String()).To(Equal(sdk.NewDec(1000).String()))
+
+			// the margin added to its own position still backs the positions of the subaccount
+			Expect(increaseMargin(crossSubaccount, 70)).To(BeNil())
+			Expect(app.ExchangeKeeper.GetPosition(ctx, markets[0].MarketID(), crossSubaccount).Margin.String()).To(Equal(sdk.NewDec(170).String()))
+		})
+
+		It("rejects an order whose margin hold leaves the subaccount at its maintenance requirement", func() {
+			createOrder := func(margin int64) error {
+				msg := testInput.NewMsgCreateDerivativeLimitOrder(sdk.NewDec(1900), sdk.MustNewDecFromStr("0.01"), sdk.NewDec(margin), types.OrderType_BUY, crossSubaccount)
+				_, err := msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), msg)
+				return err
+			}
+
+			Expect(createOrder(70)).To(MatchError(types.ErrCrossMarginRequirement))
+			Expect(app.ExchangeKeeper.GetDeposit(ctx, crossSubaccount, quoteDenom).AvailableBalance.String()).To(Equal(sdk.NewDec(100).String()))
+
+			Expect(createOrder(14)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Margin requirements", func() {
+	var (
+		app    *simapp.InjectiveApp
+		market *types.DerivativeMarket
+		// an order of 2 contracts at price 100 with a margin of 10 reaches the initial margin ratio at mark price 100
+		price    = sdk.NewDec(100)
+		quantity = sdk.NewDec(2)
+		margin   = sdk.NewDec(10)
+	)
+
+	newOrder := func(orderType types.OrderType) *types.DerivativeOrder {
+		return &types.DerivativeOrder{
+			OrderInfo: types.OrderInfo{
+				SubaccountId: testexchange.SampleSubaccountAddr1.Hex(),
+				Price:        price,
+				Quantity:     quantity,
+			},
+			OrderType: orderType,
+			Margin:    margin,
+		}
+	}
+
+	newPosition := func(isLong bool) *types.Position {
+		return &types.Position{
+			IsLong:     isLong,
+			Quantity:   quantity,
+			EntryPrice: price,
+			Margin:     margin,
+		}
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		market = &types.DerivativeMarket{
+			InitialMarginRatio:     sdk.NewDecWithPrec(5, 2),
+			MaintenanceMarginRatio: sdk.NewDecWithPrec(2, 2),
+			IsPerpetual:            true,
+		}
+	})
+
+	for _, isLong := range []bool{true, false} {
+		isLong := isLong
+		orderType, direction := types.OrderType_BUY, "long"
+		// the side on which the mark price is safe for the position
+		safeSide := sdk.SmallestDec()
+		if !isLong {
+			orderType, direction = types.OrderType_SELL, "short"
+			safeSide = safeSide.Neg()
+		}
+
+		It("checks orders and "+direction+" positions against the same initial margin threshold", func() {
+			order := newOrder(orderType)
+			position := newPosition(isLong)
+
+			orderThreshold := order.ComputeInitialMarginRequirementMarkPriceThreshold(market.InitialMarginRatio)
+			Expect(orderThreshold).To(Equal(sdk.NewDec(100)))
+
+			// exactly at the initial margin ratio both the order and the position meet the requirement
+			Expect(position.GetEffectiveMarginRatio(orderThreshold, sdk.ZeroDec())).To(Equal(market.InitialMarginRatio))
+			_, err := order.CheckMarginAndGetMarginHold(market.InitialMarginRatio, orderThreshold, sdk.ZeroDec(), types.MarketType_Perpetual, 0)
+			Expect(err).To(BeNil())
+			Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(position, market, keeper.InitialMarginRequirement, orderThreshold, nil)).To(BeTrue())
+
+			// an offset above the precision lost in the margin ratio
+			offset := safeSide.MulInt64(1_000_000_000)
+			safeMarkPrice, unsafeMarkPrice := orderThreshold.Add(offset), orderThreshold.Sub(offset)
+
+			_, err = order.CheckMarginAndGetMarginHold(market.InitialMarginRatio, safeMarkPrice, sdk.ZeroDec(), types.MarketType_Perpetual, 0)
+			Expect(err).To(BeNil())
+			Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(position, market, keeper.InitialMarginRequirement, safeMarkPrice, nil)).To(BeTrue())
+
+			_, err = order.CheckMarginAndGetMarginHold(market.InitialMarginRatio, unsafeMarkPrice, sdk.ZeroDec(), types.MarketType_Perpetual, 0)
+			Expect(err).To(MatchError(ContainSubstring(types.ErrInsufficientOrderMargin.Error())))
+			Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(position, market, keeper.InitialMarginRequirement, unsafeMarkPrice, nil)).To(BeFalse())
+		})
+
+		It("liquidates a "+direction+" position exactly when it fails the maintenance margin requirement", func() {
+			position := newPosition(isLong)
+			liquidationPrice := position.GetLiquidationPrice(market.MaintenanceMarginRatio, nil)
+
+			Expect(app.ExchangeKeeper.IsPositionLiquidatable(position, market, liquidationPrice, nil)).To(BeTrue())
+			Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(position, market, keeper.MaintenanceMarginRequirement, liquidationPrice, nil)).To(BeFalse())
+
+			safeMarkPrice := liquidationPrice.Add(safeSide)
+			Expect(app.ExchangeKeeper.IsPositionLiquidatable(position, market, safeMarkPrice, nil)).To(BeFalse())
+			Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(position, market, keeper.MaintenanceMarginRequirement, safeMarkPrice, nil)).To(BeTrue())
+
+			// the maintenance margin requirement is looser than the initial one
+			Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(position, market, keeper.InitialMarginRequirement, safeMarkPrice, nil)).To(BeFalse())
+		})
+	}
+
+	It("checks the initial margin requirement at a zero mark price", func() {
+		long, short := newPosition(true), newPosition(false)
+
+		// at a zero mark price a long position has lost more than its margin and a short position needs no margin
+		Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(long, market, keeper.InitialMarginRequirement, sdk.ZeroDec(), nil)).To(BeFalse())
+		Expect(app.ExchangeKeeper.IsPositionAboveMarginRequirement(short, market, keeper.InitialMarginRequirement, sdk.ZeroDec(), nil)).To(BeTrue())
+	})
+
+	It("uses the margin ratios of the market", func() {
+		position := newPosition(true)
+		markPrice := sdk.MustNewDecFromStr("97")
+
+		Expect(app.ExchangeKeeper.IsPositionLiquidatable(position, market, markPrice, nil)).To(BeFalse())
+
+		// a market param update raising the maintenance margin ratio applies to the open positions
+		market.MaintenanceMarginRatio = sdk.NewDecWithPrec(4, 2)
+		Expect(app.ExchangeKeeper.IsPositionLiquidatable(position, market, markPrice, nil)).To(BeTrue())
+	})
+})
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// IsMarketDelistingExempt returns true if the market is exempted from the auto-delisting on inactivity.
+func (k *Keeper) IsMarketDelistingExempt(ctx sdk.Context, marketID common.Hash) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	exemptionStore := prefix.NewStore(k.getStore(ctx), types.MarketDelistingExemptionPrefix)
+	return exemptionStore.Has(marketID.Bytes())
+}
+
+// SetMarketDelistingExemption exempts the market from the auto-delisting on inactivity, or removes its exemption.
+func (k *Keeper) SetMarketDelistingExemption(ctx sdk.Context, marketID common.Hash, isExempt bool) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	exemptionStore := prefix.NewStore(k.getStore(ctx), types.MarketDelistingExemptionPrefix)
+
+	if !isExempt {
+		exemptionStore.Delete(marketID.Bytes())
+		return
+	}
+
+	exemptionStore.Set(marketID.Bytes(), []byte{})
+}
+
+// GetAllDelistingExemptMarketIDs returns the markets exempted from the auto-delisting on inactivity.
+func (k *Keeper) GetAllDelistingExemptMarketIDs(ctx sdk.Context) []string {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	exemptionStore := prefix.NewStore(k.getStore(ctx), types.MarketDelistingExemptionPrefix)
+
+	iterator := exemptionStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	marketIDs := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		marketIDs = append(marketIDs, common.BytesToHash(iterator.Key()).Hex())
+	}
+
+	return marketIDs
+}
+
+func (k *Keeper) getMarketHeight(ctx sdk.Context, keyPrefix []byte, marketID common.Hash) (int64, bool) {
+	bz := prefix.NewStore(k.getStore(ctx), keyPrefix).Get(marketID.Bytes())
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+func (k *Keeper) setMarketHeight(ctx sdk.Context, keyPrefix []byte, marketID common.Hash, height int64) {
+	prefix.NewStore(k.getStore(ctx), keyPrefix).Set(marketID.Bytes(), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+func (k *Keeper) deleteMarketHeight(ctx sdk.Context, keyPrefix []byte, marketID common.Hash) {
+	heightStore := prefix.NewStore(k.getStore(ctx), keyPrefix)
+	if heightStore.Has(marketID.Bytes()) {
+		heightStore.Delete(marketID.Bytes())
+	}
+}
+
+// GetAllMarketInactiveSinceHeights returns the heights since which the active markets are idle, ordered by market ID.
+func (k *Keeper) GetAllMarketInactiveSinceHeights(ctx sdk.Context) []types.MarketHeight {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getAllMarketHeights(ctx, types.MarketInactiveSinceHeightPrefix)
+}
+
+// GetAllMarketAutoPausedHeights returns the heights at which the idle markets were paused, ordered by market ID.
+func (k *Keeper) GetAllMarketAutoPausedHeights(ctx sdk.Context) []types.MarketHeight {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getAllMarketHeights(ctx, types.MarketAutoPausedHeightPrefix)
+}
+
+func (k *Keeper) getAllMarketHeights(ctx sdk.Context, keyPrefix []byte) []types.MarketHeight {
+	iterator := prefix.NewStore(k.getStore(ctx), keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	marketHeights := make([]types.MarketHeight, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		marketHeights = append(marketHeights, types.MarketHeight{
+			MarketId: common.BytesToHash(iterator.Key()).Hex(),
+			Height:   int64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+
+	return marketHeights
+}
+
+// resetMarketInactivity restarts the inactivity window of a market which traded. It doesn't consume gas, so that it
+// doesn't change the gas used by the txs executing atomic market orders.
+func (k *Keeper) resetMarketInactivity(ctx sdk.Context, marketID common.Hash) {
+	k.deleteMarketHeight(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.MarketInactiveSinceHeightPrefix, marketID)
+}
+
+// hasMarketOpenInterest returns true if the market has resting limit orders, conditional orders or open positions.
+func (k *Keeper) hasMarketOpenInterest(ctx sdk.Context, market MarketI) bool {
+	marketID := market.MarketID()
+	hasOpenInterest := false
+
+	if market.GetMarketType() == types.MarketType_Spot {
+		for _, isBuy := range []bool{true, false} {
+			k.IterateSpotLimitOrdersByMarketDirection(ctx, marketID, isBuy, func(*types.SpotLimitOrder) (stop bool) {
+				hasOpenInterest = true
+				return true
+			})
+		}
+
+		return hasOpenInterest
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		k.IterateDerivativeLimitOrdersByMarketDirection(ctx, marketID, isBuy, func(*types.DerivativeLimitOrder) (stop bool) {
+			hasOpenInterest = true
+			return true
+		})
+	}
+
+	for _, isMarketOrders := range []bool{false, true} {
+		for _, isTriggerPriceHigher := range []bool{true, false} {
+			k.IterateConditionalDerivativeOrders(ctx, marketID, isTriggerPriceHigher, isMarketOrders, nil, func([]byte) (stop bool) {
+				hasOpenInterest = true
+				return true
+			})
+		}
+	}
+
+	k.IteratePositionsByMarket(ctx, marketID, func(*types.Position, []byte) (stop bool) {
+		hasOpenInterest = true
+		return true
+	})
+
+	return hasOpenInterest
+}
+
+// setDelistedMarketStatus sets the status of an auto-delisted spot or perpetual market.
+func (k *Keeper) setDelistedMarketStatus(ctx sdk.Context, marketID common.Hash, status types.MarketStatus) error {
+	if k.GetSpotMarketByID(ctx, marketID) != nil {
+		_, err := k.SetSpotMarketStatus(ctx, marketID, status)
+		return err
+	}
+
+	market := k.GetDerivativeMarketByID(ctx, marketID)
+	if market == nil {
+		return types.ErrMarketInvalid.Wrapf("market %s not found", marketID.Hex())
+	}
+
+	market.Status = status
+	k.SetDerivativeMarketWithInfo(ctx, market, nil, nil, nil)
+	return nil
+}
+
+// ProcessInactiveMarkets auto-delists the spot and perpetual markets without trades and without open orders, conditional
+// orders or positions for market_inactivity_delist_blocks. Such markets are paused first, so that governance can still
+// exempt and reactivate them, and are demolished once paused for market_inactivity_delist_blocks. Expiry futures and
+// binary options markets are skipped since they're demolished at their settlement anyway.
+//
+// The markets are processed in a deterministic order. An idle market holds no funds of its traders, any order left in a paused
+// market is cancelled and refunded before it's demolished.
+func (k *Keeper) ProcessInactiveMarkets(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	inactivityBlocks := k.GetMarketInactivityDelistBlocks(ctx)
+	if inactivityBlocks == 0 {
+		return
+	}
+
+	height := ctx.BlockHeight()
+
+	k.demolishAutoPausedMarkets(ctx, height, inactivityBlocks)
+
+	for _, market := range k.FindMarkets(ctx, StatusMarketFilter(types.MarketStatus_Active)) {
+		marketType := market.GetMarketType()
+		if marketType != types.MarketType_Spot && marketType != types.MarketType_Perpetual {
+			continue
+		}
+
+		marketID := market.MarketID()
+
+		if k.IsMarketDelistingExempt(ctx, marketID) || k.hasMarketOpenInterest(ctx, market) {
+			k.deleteMarketHeight(ctx, types.MarketInactiveSinceHeightPrefix, marketID)
+			continue
+		}
+
+		inactiveSince, found := k.getMarketHeight(ctx, types.MarketInactiveSinceHeightPrefix, marketID)
+		if !found {
+			k.setMarketHeight(ctx, types.MarketInactiveSinceHeightPrefix, marketID, height)
+			continue
+		}
+
+		if height-inactiveSince < inactivityBlocks {
+			continue
+		}
+
+		if err := k.setDelistedMarketStatus(ctx, marketID, types.MarketStatus_Paused); err != nil {
+			k.Logger(ctx).Error("failed to pause the inactive market", "marketID", marketID.Hex(), "err", err)
+			continue
+		}
+
+		k.deleteMarketHeight(ctx, types.MarketInactiveSinceHeightPrefix, marketID)
+		k.setMarketHeight(ctx, types.MarketAutoPausedHeightPrefix, marketID, height)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventMarketAutoDelisted{
+			MarketId:       marketID.Hex(),
+			Status:         types.MarketStatus_Paused,
+			InactiveBlocks: height - inactiveSince,
+		})
+	}
+}
+
+// demolishAutoPausedMarkets demolishes the markets which stayed paused for market_inactivity_delist_blocks since they
+// were paused for inactivity. Markets reactivated, demolished or exempted in the meantime are no longer tracked.
+func (k *Keeper) demolishAutoPausedMarkets(ctx sdk.Context, height, inactivityBlocks int64) {
+	pausedStore := prefix.NewStore(k.getStore(ctx), types.MarketAutoPausedHeightPrefix)
+
+	marketIDs := make([]common.Hash, 0)
+	pausedHeights := make([]int64, 0)
+
+	iterator := pausedStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		marketIDs = append(marketIDs, common.BytesToHash(iterator.Key()))
+		pausedHeights = append(pausedHeights, int64(sdk.BigEndianToUint64(iterator.Value())))
+	}
+	iterator.Close()
+
+	for i, marketID := range marketIDs {
+		var market MarketI
+		if spotMarket := k.GetSpotMarketByID(ctx, marketID); spotMarket != nil {
+			market = spotMarket
+		} else if derivativeMarket := k.GetDerivativeMarketByID(ctx, marketID); derivativeMarket != nil {
+			market = derivativeMarket
+		}
+
+		if market == nil || market.GetMarketStatus() != types.MarketStatus_Paused || k.IsMarketDelistingExempt(ctx, marketID) {
+			pausedStore.Delete(marketID.Bytes())
+			continue
+		}
+
+		if height-pausedHeights[i] < inactivityBlocks {
+			continue
+		}
+
+		// defensive programming: a paused market can't get new orders, but any residual order is cancelled and its
+		// balance hold refunded before the market is demolished, over the next blocks for deep books
+		if k.hasMarketOpenInterest(ctx, market) {
+			if _, hasMoreOrders, err := k.CancelAllOrdersInMarket(ctx, marketID); err != nil || hasMoreOrders || k.hasMarketOpenInterest(ctx, market) {
+				continue
+			}
+		}
+
+		if err := k.setDelistedMarketStatus(ctx, marketID, types.MarketStatus_Demolished); err != nil {
+			k.Logger(ctx).Error("failed to demolish the inactive market", "marketID", marketID.Hex(), "err", err)
+			continue
+		}
+
+		pausedStore.Delete(marketID.Bytes())
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventMarketAutoDelisted{
+			MarketId:       marketID.Hex(),
+			Status:         types.MarketStatus_Demolished,
+			InactiveBlocks: height - pausedHeights[i],
+		})
+	}
+}
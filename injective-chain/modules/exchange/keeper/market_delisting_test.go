@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Market auto-delisting", func() {
+	const inactivityBlocks = 10
+
+	var (
+		testInput    testexchange.TestInput
+		app          *simapp.InjectiveApp
+		ctx          sdk.Context
+		startHeight  int64
+		idleMarket   *types.SpotMarket
+		exemptMarket *types.SpotMarket
+	)
+
+	processAtHeight := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		app.ExchangeKeeper.ProcessInactiveMarkets(ctx)
+	}
+
+	marketStatus := func(market *types.SpotMarket) types.MarketStatus {
+		return app.ExchangeKeeper.GetSpotMarketByID(ctx, market.MarketID()).Status
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Unix(1618997040, 0),
+		})
+		startHeight = ctx.BlockHeight()
+		testInput, ctx = testexchange.SetupTest(app, ctx, 2, 0, 0)
+
+		var err error
+		idleMarket, err = app.ExchangeKeeper.SpotMarketLaunch(ctx, testInput.Spots[0].Ticker, testInput.Spots[0].BaseDenom, testInput.Spots[0].QuoteDenom, testInput.Spots[0].MinPriceTickSize, testInput.Spots[0].MinQuantityTickSize)
+		testexchange.OrFail(err)
+		exemptMarket, err = app.ExchangeKeeper.SpotMarketLaunch(ctx, testInput.Spots[1].Ticker, testInput.Spots[1].BaseDenom, testInput.Spots[1].QuoteDenom, testInput.Spots[1].MinPriceTickSize, testInput.Spots[1].MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MarketInactivityDelistBlocks = inactivityBlocks
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		app.ExchangeKeeper.SetMarketDelistingExemption(ctx, exemptMarket.MarketID(), true)
+	})
+
+	It("pauses and then demolishes a market after inactivity", func() {
+		processAtHeight(startHeight)
+		processAtHeight(startHeight + inactivityBlocks - 1)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Active))
+
+		processAtHeight(startHeight + inactivityBlocks)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Paused))
+
+		processAtHeight(startHeight + 2*inactivityBlocks - 1)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Paused))
+
+		processAtHeight(startHeight + 2*inactivityBlocks)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Demolished))
+	})
+
+	It("exports and imports the inactivity heights in genesis", func() {
+		processAtHeight(startHeight)
+		processAtHeight(startHeight + inactivityBlocks)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Paused))
+
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		Expect(state.MarketAutoPausedHeights).To(Equal([]types.MarketHeight{{
+			MarketId: idleMarket.MarketId,
+			Height:   startHeight + inactivityBlocks,
+		}}))
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+		app.ExchangeKeeper.InitGenesis(ctx, *state)
+
+		Expect(app.ExchangeKeeper.GetAllMarketInactiveSinceHeights(ctx)).To(Equal(state.MarketInactiveSinceHeights))
+		Expect(app.ExchangeKeeper.GetAllMarketAutoPausedHeights(ctx)).To(Equal(state.MarketAutoPausedHeights))
+
+		// the paused market is still demolished after the same number of blocks
+		processAtHeight(startHeight + 2*inactivityBlocks - 1)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Paused))
+		processAtHeight(startHeight + 2*inactivityBlocks)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Demolished))
+	})
+
+	It("retains an exempt market", func() {
+		for height := startHeight; height <= startHeight+2*inactivityBlocks; height++ {
+			processAtHeight(height)
+		}
+
+		Expect(marketStatus(exemptMarket)).To(Equal(types.MarketStatus_Active))
+		Expect(app.ExchangeKeeper.GetAllDelistingExemptMarketIDs(ctx)).To(Equal([]string{exemptMarket.MarketId}))
+	})
+
+	It("retains a market with open orders", func() {
+		trader := testexchange.SampleSubaccountAddr1
+		testexchange.MintAndDeposit(app, ctx, trader.String(), sdk.NewCoins(sdk.NewCoin(idleMarket.QuoteDenom, sdk.NewInt(100000))))
+
+		processAtHeight(startHeight)
+
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString("100", "1", types.OrderType_BUY, trader),
+		)
+		_, err := keeper.NewMsgServerImpl(app.ExchangeKeeper).CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		testexchange.OrFail(err)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		processAtHeight(startHeight + inactivityBlocks)
+		Expect(marketStatus(idleMarket)).To(Equal(types.MarketStatus_Active))
+	})
+})
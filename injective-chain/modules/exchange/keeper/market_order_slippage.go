@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// applyMarketOrderSlippageBound lowers the worst price of a buy market order, or raises it for a sell, to the max
+// slippage ratio away from the mark price, so that the order is never filled beyond it. Binary options markets have no
+// mark price, so their market orders are bounded from the top of the book instead. A market order whose bounded worst
+// price doesn't cross the top of the book is then rejected as any other market order. Conditional orders are bounded
+// once triggered, by the max slippage ratio of the market.
+func (k *Keeper) applyMarketOrderSlippageBound(
+	ctx sdk.Context,
+	market DerivativeMarketI,
+	order *types.DerivativeOrder,
+	markPrice sdk.Dec,
+) error {
+	if order.IsConditional() {
+		return nil
+	}
+
+	maxSlippageRatio, err := k.getMarketOrderMaxSlippageRatio(ctx, market.GetMaxSlippageRatio(), order.MaxSlippageRatio)
+	if err != nil {
+		return err
+	}
+
+	if !market.GetMarketType().IsBinaryOptions() {
+		order.OrderInfo.Price = boundMarketOrderWorstPrice(order.OrderInfo.Price, order.IsBuy(), markPrice, maxSlippageRatio, market.GetMinPriceTickSize())
+		return nil
+	}
+
+	bestPrice := k.GetBestDerivativeLimitOrderPrice(ctx, market.MarketID(), !order.IsBuy())
+	if bestPrice == nil {
+		return nil
+	}
+
+	order.OrderInfo.Price = boundMarketOrderWorstPrice(order.OrderInfo.Price, order.IsBuy(), *bestPrice, maxSlippageRatio, market.GetMinPriceTickSize())
+
+	// the margin of binary options orders must match the bounded price exactly
+	if requiredMargin := order.GetRequiredBinaryOptionsMargin(market.GetOracleScaleFactor()); order.Margin.GT(requiredMargin) {
+		order.Margin = requiredMargin
+	}
+	return nil
+}
+
+// applySpotMarketOrderSlippageBound lowers the worst price of a buy spot market order, or raises it for a sell, to the
+// max slippage ratio away from the best price of the opposite side of the book.
+func (k *Keeper) applySpotMarketOrderSlippageBound(
+	ctx sdk.Context,
+	market *types.SpotMarket,
+	order *types.SpotOrder,
+	bestPrice sdk.Dec,
+) error {
+	maxSlippageRatio, err := k.getMarketOrderMaxSlippageRatio(ctx, market.GetMaxSlippageRatio(), order.MaxSlippageRatio)
+	if err != nil {
+		return err
+	}
+
+	order.OrderInfo.Price = boundMarketOrderWorstPrice(order.OrderInfo.Price, order.IsBuy(), bestPrice, maxSlippageRatio, market.GetMinPriceTickSize())
+	return nil
+}
+
+// getMarketOrderMaxSlippageRatio returns the max slippage ratio bounding a market order, zero meaning no bound. The
+// ratio of the market is capped by the max market order slippage ratio param. The order may only tighten it, so an
+// order ratio above the one of the market, or above the param, is rejected.
+func (k *Keeper) getMarketOrderMaxSlippageRatio(ctx sdk.Context, marketMaxSlippageRatio sdk.Dec, orderMaxSlippageRatio *sdk.Dec) (sdk.Dec, error) {
+	maxMarketOrderSlippageRatio := k.GetMaxMarketOrderSlippageRatio(ctx)
+
+	maxSlippageRatio := marketMaxSlippageRatio
+	if maxMarketOrderSlippageRatio.IsPositive() && maxSlippageRatio.GT(maxMarketOrderSlippageRatio) {
+		maxSlippageRatio = maxMarketOrderSlippageRatio
+	}
+
+	if orderMaxSlippageRatio == nil {
+		return maxSlippageRatio, nil
+	}
+
+	// without a bound of the market, the order is only limited by the param
+	allowedMaxSlippageRatio := maxSlippageRatio
+	if allowedMaxSlippageRatio.IsZero() {
+		allowedMaxSlippageRatio = maxMarketOrderSlippageRatio
+	}
+
+	if allowedMaxSlippageRatio.IsPositive() && orderMaxSlippageRatio.GT(allowedMaxSlippageRatio) {
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, types.ErrMaxSlippageRatioExceeded.Wrapf("max slippage ratio %s must not exceed %s", orderMaxSlippageRatio.String(), allowedMaxSlippageRatio.String())
+	}
+	return *orderMaxSlippageRatio, nil
+}
+
+// boundMarketOrderWorstPrice returns the worst price of a market order bounded to the max slippage ratio away from the
+// reference price, rounded to the price tick size towards the reference price.
+func boundMarketOrderWorstPrice(worstPrice sdk.Dec, isBuy bool, referencePrice, maxSlippageRatio, minPriceTickSize sdk.Dec) sdk.Dec {
+	if maxSlippageRatio.IsZero() || referencePrice.IsNil() || !referencePrice.IsPositive() {
+		return worstPrice
+	}
+
+	if isBuy {
+		upperBound := referencePrice.Mul(sdk.OneDec().Add(maxSlippageRatio)).Quo(minPriceTickSize).TruncateDec().Mul(minPriceTickSize)
+		if worstPrice.GT(upperBound) && upperBound.IsPositive() {
+			return upperBound
+		}
+		return worstPrice
+	}
+
+	lowerBound := referencePrice.Mul(sdk.OneDec().Sub(maxSlippageRatio)).Quo(minPriceTickSize).Ceil().Mul(minPriceTickSize)
+	if worstPrice.LT(lowerBound) {
+		return lowerBound
+	}
+	return worstPrice
+}
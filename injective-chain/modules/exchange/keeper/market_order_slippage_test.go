@@ -0,0 +1,380 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Derivative market order slippage", func() {
+	var (
+		testInput          testexchange.TestInput
+		app                *simapp.InjectiveApp
+		ctx                sdk.Context
+		msgServer          types.MsgServer
+		marketID           common.Hash
+		subaccountIdBuyer  = testexchange.SampleSubaccountAddr1
+		subaccountIdSeller = testexchange.SampleSubaccountAddr2
+		senderBuyer        = types.SubaccountIDToSdkAddress(subaccountIdBuyer)
+		startingPrice      = sdk.NewDec(2000)
+	)
+
+	newOrder := func(subaccountID common.Hash, price, quantity int64, orderType types.OrderType) types.DerivativeOrder {
+		return types.DerivativeOrder{
+			MarketId: marketID.Hex(),
+			OrderInfo: types.OrderInfo{
+				SubaccountId: subaccountID.Hex(),
+				FeeRecipient: "inj1dzqd00lfd4y4qy2pxa0dsdwzfnmsu27hgttswz",
+				Price:        sdk.NewDec(price),
+				Quantity:     sdk.NewDec(quantity),
+			},
+			OrderType: orderType,
+			// enough margin for any price the order may be bounded to
+			Margin: sdk.NewDec(3000 * quantity),
+		}
+	}
+
+	createLimitOrder := func(subaccountID common.Hash, price, quantity int64, orderType types.OrderType) {
+		_, err := msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCreateDerivativeLimitOrder{
+			Sender: types.SubaccountIDToSdkAddress(subaccountID).String(),
+			Order:  newOrder(subaccountID, price, quantity, orderType),
+		})
+		testexchange.OrFail(err)
+	}
+
+	createMarketOrder := func(subaccountID common.Hash, worstPrice, quantity int64, orderType types.OrderType, maxSlippageRatio *sdk.Dec) error {
+		msg := &types.MsgCreateDerivativeMarketOrder{
+			Sender: types.SubaccountIDToSdkAddress(subaccountID).String(),
+			Order:  newOrder(subaccountID, worstPrice, quantity, orderType),
+		}
+		msg.Order.MaxSlippageRatio = maxSlippageRatio
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := msgServer.CreateDerivativeMarketOrder(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	getPosition := func(subaccountID common.Hash) *types.Position {
+		return app.ExchangeKeeper.GetPosition(ctx, marketID, subaccountID)
+	}
+
+	ratio := func(percent int64) *sdk.Dec {
+		r := sdk.NewDecWithPrec(percent, 2)
+		return &r
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		oracleBase, oracleQuote, oracleType := testInput.Perps[0].OracleBase, testInput.Perps[0].OracleQuote, testInput.Perps[0].OracleType
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, oracleBase, oracleQuote, oracletypes.NewPriceState(startingPrice, ctx.BlockTime().Unix()))
+		coin := sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.OneInt())
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderBuyer, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, senderBuyer, coin, testInput.Perps[0].Ticker, testInput.Perps[0].QuoteDenom, oracleBase, oracleQuote, oracleType, -1))
+
+		market, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			testInput.Perps[0].Ticker,
+			testInput.Perps[0].QuoteDenom,
+			oracleBase,
+			oracleQuote,
+			0,
+			oracleType,
+			testInput.Perps[0].InitialMarginRatio,
+			testInput.Perps[0].MaintenanceMarginRatio,
+			testInput.Perps[0].MakerFeeRate,
+			testInput.Perps[0].TakerFeeRate,
+			testInput.Perps[0].MinPriceTickSize,
+			testInput.Perps[0].MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+		marketID = market.MarketID()
+
+		// market orders are filled within [1900, 2100] unless they set their own bound
+		market.MaxSlippageRatio = *ratio(5)
+		app.ExchangeKeeper.SetDerivativeMarket(ctx, market)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MaxMarketOrderSlippageRatio = *ratio(10)
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		depositAmount := sdk.NewCoins(sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.NewInt(100000)))
+		testexchange.MintAndDeposit(app, ctx, subaccountIdBuyer.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, subaccountIdSeller.String(), depositAmount)
+
+		createLimitOrder(subaccountIdSeller, 2050, 1, types.OrderType_SELL)
+		createLimitOrder(subaccountIdSeller, 2150, 1, types.OrderType_SELL)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+	})
+
+	It("fills a market order without a bound up to the default bound of the market", func() {
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 3000, 2, types.OrderType_BUY, nil))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		// the order at 2150 is beyond the bound of 2100
+		position := getPosition(subaccountIdBuyer)
+		Expect(position.Quantity.String()).To(Equal(sdk.OneDec().String()))
+		Expect(position.EntryPrice.String()).To(Equal(sdk.NewDec(2050).String()))
+	})
+
+	It("rejects a market order which can only be filled beyond the default bound", func() {
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 3000, 1, types.OrderType_BUY, nil))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		// the resting sell within the bound is taken, the remaining one is beyond it
+		Expect(createMarketOrder(subaccountIdBuyer, 3000, 1, types.OrderType_BUY, nil)).To(MatchError(types.ErrSlippageExceedsWorstPrice))
+
+		// sells are bounded to 1900 below the mark price
+		createLimitOrder(subaccountIdBuyer, 1850, 1, types.OrderType_BUY)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		Expect(createMarketOrder(subaccountIdSeller, 1, 1, types.OrderType_SELL, nil)).To(MatchError(types.ErrSlippageExceedsWorstPrice))
+	})
+
+	It("lets a market order set a tighter bound", func() {
+		Expect(createMarketOrder(subaccountIdBuyer, 3000, 1, types.OrderType_BUY, ratio(1))).To(MatchError(types.ErrSlippageExceedsWorstPrice))
+	})
+
+	It("rejects a market order setting a looser bound than the market", func() {
+		Expect(createMarketOrder(subaccountIdBuyer, 3000, 2, types.OrderType_BUY, ratio(6))).To(MatchError(types.ErrMaxSlippageRatioExceeded))
+		Expect(createMarketOrder(subaccountIdBuyer, 3000, 2, types.OrderType_BUY, ratio(11))).To(MatchError(types.ErrMaxSlippageRatioExceeded))
+
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 3000, 2, types.OrderType_BUY, ratio(5)))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		position := getPosition(subaccountIdBuyer)
+		Expect(position.Quantity.String()).To(Equal(sdk.OneDec().String()))
+	})
+
+	It("lets a market order set a bound up to the max market order slippage ratio in a market without bound", func() {
+		market := app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID)
+		market.MaxSlippageRatio = sdk.ZeroDec()
+		app.ExchangeKeeper.SetDerivativeMarket(ctx, market)
+
+		Expect(createMarketOrder(subaccountIdBuyer, 3000, 2, types.OrderType_BUY, ratio(11))).To(MatchError(types.ErrMaxSlippageRatioExceeded))
+
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 3000, 2, types.OrderType_BUY, ratio(10)))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		position := getPosition(subaccountIdBuyer)
+		Expect(position.Quantity.String()).To(Equal(sdk.NewDec(2).String()))
+		Expect(position.EntryPrice.String()).To(Equal(sdk.NewDec(2100).String()))
+	})
+})
+
+var _ = Describe("Spot market order slippage", func() {
+	var (
+		testInput          testexchange.TestInput
+		app                *simapp.InjectiveApp
+		ctx                sdk.Context
+		msgServer          types.MsgServer
+		market             *types.SpotMarket
+		subaccountIdBuyer  = testexchange.SampleSubaccountAddr1
+		subaccountIdSeller = testexchange.SampleSubaccountAddr2
+	)
+
+	createMarketOrder := func(subaccountID common.Hash, worstPrice, quantity int64, orderType types.OrderType, maxSlippageRatio *sdk.Dec) error {
+		msg := testInput.NewMsgCreateSpotMarketOrder(sdk.NewDec(quantity), sdk.NewDec(worstPrice), orderType, subaccountID)
+		msg.Order.MaxSlippageRatio = maxSlippageRatio
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := msgServer.CreateSpotMarketOrder(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	getRestingOrderPrices := func(isBuy bool) []string {
+		prices := make([]string, 0)
+		for _, order := range app.ExchangeKeeper.GetAllSpotLimitOrdersByMarketDirection(ctx, market.MarketID(), isBuy) {
+			prices = append(prices, order.OrderInfo.Price.String())
+		}
+		return prices
+	}
+
+	ratio := func(percent int64) *sdk.Dec {
+		r := sdk.NewDecWithPrec(percent, 2)
+		return &r
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		var err error
+		market, err = app.ExchangeKeeper.SpotMarketLaunch(ctx, testInput.Spots[0].Ticker, testInput.Spots[0].BaseDenom, testInput.Spots[0].QuoteDenom, testInput.Spots[0].MinPriceTickSize, testInput.Spots[0].MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		// market orders are filled within 5% of the top of the book unless they set a tighter bound
+		market.MaxSlippageRatio = *ratio(5)
+		app.ExchangeKeeper.SetSpotMarket(ctx, market)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MaxMarketOrderSlippageRatio = *ratio(10)
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		testInput.AddSpotDepositsForSubaccounts(app, ctx, 1, nil, []common.Hash{subaccountIdBuyer, subaccountIdSeller})
+
+		for _, price := range []int64{100, 110} {
+			_, err = msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateSpotLimitOrder(sdk.NewDec(price), sdk.OneDec(), types.OrderType_SELL, subaccountIdSeller))
+			testexchange.OrFail(err)
+		}
+		for _, price := range []int64{90, 80} {
+			_, err = msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateSpotLimitOrder(sdk.NewDec(price), sdk.OneDec(), types.OrderType_BUY, subaccountIdBuyer))
+			testexchange.OrFail(err)
+		}
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+	})
+
+	It("fills a market order up to the default bound of the market", func() {
+		// buys are bounded to 105, sells to 85.5
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 200, 2, types.OrderType_BUY, nil))
+		testexchange.OrFail(createMarketOrder(subaccountIdSeller, 1, 2, types.OrderType_SELL, nil))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		Expect(getRestingOrderPrices(false)).To(Equal([]string{sdk.NewDec(110).String()}))
+		Expect(getRestingOrderPrices(true)).To(Equal([]string{sdk.NewDec(80).String()}))
+	})
+
+	It("lets a market order set a tighter bound", func() {
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 200, 2, types.OrderType_BUY, ratio(1)))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		Expect(getRestingOrderPrices(false)).To(Equal([]string{sdk.NewDec(110).String()}))
+	})
+
+	It("rejects a market order setting a looser bound than the market", func() {
+		Expect(createMarketOrder(subaccountIdBuyer, 200, 2, types.OrderType_BUY, ratio(10))).To(MatchError(types.ErrMaxSlippageRatioExceeded))
+		Expect(getRestingOrderPrices(false)).To(HaveLen(2))
+	})
+
+	It("caps the bound of the market by the max market order slippage ratio", func() {
+		market.MaxSlippageRatio = *ratio(20)
+		app.ExchangeKeeper.SetSpotMarket(ctx, market)
+
+		_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateSpotLimitOrder(sdk.NewDec(115), sdk.OneDec(), types.OrderType_SELL, subaccountIdSeller))
+		testexchange.OrFail(err)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 200, 3, types.OrderType_BUY, nil))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		// buys are bounded to 110 by the param instead of 120 by the market
+		Expect(getRestingOrderPrices(false)).To(Equal([]string{sdk.NewDec(115).String()}))
+	})
+})
+
+var _ = Describe("Binary options market order slippage", func() {
+	var (
+		testInput          testexchange.TestInput
+		app                *simapp.InjectiveApp
+		ctx                sdk.Context
+		msgServer          types.MsgServer
+		market             *types.BinaryOptionsMarket
+		subaccountIdBuyer  = testexchange.SampleSubaccountAddr1
+		subaccountIdSeller = testexchange.SampleSubaccountAddr2
+	)
+
+	createMarketOrder := func(subaccountID common.Hash, worstPrice, quantity int64, orderType types.OrderType, maxSlippageRatio *sdk.Dec) error {
+		msg := testInput.NewMsgCreateBinaryOptionsMarketOrderForMarketIndex(testexchange.DefaultFeeRecipientAddress, sdk.NewDec(worstPrice), sdk.NewDec(quantity), orderType, subaccountID, 0, false)
+		msg.Order.MaxSlippageRatio = maxSlippageRatio
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := msgServer.CreateBinaryOptionsMarketOrder(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	ratio := func(percent int64) *sdk.Dec {
+		r := sdk.NewDecWithPrec(percent, 2)
+		return &r
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 0, 1)
+		app.OracleKeeper.SetProviderInfo(ctx, &oracletypes.ProviderInfo{
+			Provider: testInput.BinaryMarkets[0].OracleProvider,
+			Relayers: []string{testInput.BinaryMarkets[0].Admin},
+		})
+		adminAccount, _ := sdk.AccAddressFromBech32(testInput.BinaryMarkets[0].Admin)
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, adminAccount))
+
+		var err error
+		market, err = app.ExchangeKeeper.BinaryOptionsMarketLaunch(
+			ctx,
+			testInput.BinaryMarkets[0].Ticker,
+			testInput.BinaryMarkets[0].OracleSymbol,
+			testInput.BinaryMarkets[0].OracleProvider,
+			oracletypes.OracleType_Provider,
+			testInput.BinaryMarkets[0].OracleScaleFactor,
+			testInput.BinaryMarkets[0].MakerFeeRate,
+			testInput.BinaryMarkets[0].TakerFeeRate,
+			testInput.BinaryMarkets[0].ExpirationTimestamp,
+			testInput.BinaryMarkets[0].SettlementTimestamp,
+			testInput.BinaryMarkets[0].Admin,
+			testInput.BinaryMarkets[0].QuoteDenom,
+			testInput.BinaryMarkets[0].MinPriceTickSize,
+			testInput.BinaryMarkets[0].MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+
+		// market orders are filled within 5% of the top of the book unless they set a tighter bound
+		market.MaxSlippageRatio = *ratio(5)
+		app.ExchangeKeeper.SetBinaryOptionsMarket(ctx, market)
+
+		depositAmount := sdk.NewCoins(sdk.NewCoin(testInput.BinaryMarkets[0].QuoteDenom, sdk.NewInt(10000000)))
+		testexchange.MintAndDeposit(app, ctx, subaccountIdBuyer.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, subaccountIdSeller.String(), depositAmount)
+
+		// prices are scaled by 10^6, the sells rest at 0.5 and 0.6
+		for _, price := range []int64{500000, 600000} {
+			_, err = msgServer.CreateBinaryOptionsLimitOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateBinaryOptionsLimitOrderForMarketIndex(testexchange.DefaultFeeRecipientAddress, sdk.NewDec(price), sdk.OneDec(), types.OrderType_SELL, subaccountIdSeller, 0, false))
+			testexchange.OrFail(err)
+		}
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+	})
+
+	It("fills a market order up to the default bound of the market", func() {
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 900000, 2, types.OrderType_BUY, nil))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		// the order at 0.6 is beyond the bound of 0.525
+		position := app.ExchangeKeeper.GetPosition(ctx, market.MarketID(), subaccountIdBuyer)
+		Expect(position.Quantity.String()).To(Equal(sdk.OneDec().String()))
+		Expect(position.EntryPrice.String()).To(Equal(sdk.NewDec(500000).String()))
+	})
+
+	It("rejects a market order setting a looser bound than the market", func() {
+		Expect(createMarketOrder(subaccountIdBuyer, 900000, 2, types.OrderType_BUY, ratio(25))).To(MatchError(types.ErrMaxSlippageRatioExceeded))
+	})
+})
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// CancelAllOrdersInMarket cancels the resting orders of a spot, derivative or binary options market and refunds
+// their balance holds. At most MaxOrdersCancelledInMarketPerCall orders are cancelled per call, so that clearing a
+// deep book can't exceed the block gas limit. It returns the number of cancelled orders and whether orders are left,
+// in which case it must be called again to clear the book.
+//
+// The orders are cancelled in a deterministic order: the buy limit orders from the best price, then the sell limit
+// orders from the best price, then the limit and market orders placed in the current block, which are still in the
+// transient store, in the same direction and price order and, for derivative markets, the conditional limit orders
+// followed by the conditional market orders, each in trigger price order. An EventMarketOrdersCancelled with the number of cancelled orders is
+// emitted.
+func (k *Keeper) CancelAllOrdersInMarket(ctx sdk.Context, marketID common.Hash) (cancelledCount uint32, hasMoreOrders bool, err error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	// collect one more cancellation than the bound to know whether orders are left
+	maxCancellations := int(types.MaxOrdersCancelledInMarketPerCall) + 1
+
+	var cancellations []func() error
+	if spotMarket := k.GetSpotMarketByID(ctx, marketID); spotMarket != nil {
+		cancellations = k.getSpotMarketOrderCancellations(ctx, spotMarket, maxCancellations)
+	} else if derivativeMarket := k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil); derivativeMarket != nil {
+		cancellations = k.getDerivativeMarketOrderCancellations(ctx, derivativeMarket, maxCancellations)
+	} else {
+		metrics.ReportFuncError(k.svcTags)
+		return 0, false, types.ErrMarketInvalid.Wrapf("market %s not found", marketID.Hex())
+	}
+
+	if len(cancellations) == maxCancellations {
+		hasMoreOrders = true
+		cancellations = cancellations[:types.MaxOrdersCancelledInMarketPerCall]
+	}
+
+	for _, cancel := range cancellations {
+		if err := cancel(); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return 0, false, err
+		}
+	}
+
+	cancelledCount = uint32(len(cancellations))
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventMarketOrdersCancelled{
+		MarketId:             marketID.Hex(),
+		CancelledOrdersCount: cancelledCount,
+		HasMoreOrders:        hasMoreOrders,
+	})
+
+	return cancelledCount, hasMoreOrders, nil
+}
+
+func (k *Keeper) getSpotMarketOrderCancellations(ctx sdk.Context, market *types.SpotMarket, maxCancellations int) []func() error {
+	marketID := market.MarketID()
+	cancellations := make([]func() error, 0)
+
+	appendCancellation := func(order *types.SpotLimitOrder) (stop bool) {
+		cancellations = append(cancellations, func() error {
+			return k.cancelSpotLimitOrderByOrderHash(ctx, order.SubaccountID(), order.Hash(), market, marketID)
+		})
+		return len(cancellations) == maxCancellations
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		if len(cancellations) == maxCancellations {
+			break
+		}
+		k.IterateSpotLimitOrdersByMarketDirection(ctx, marketID, isBuy, appendCancellation)
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		for _, order := range k.GetAllTransientSpotLimitOrdersByMarketDirection(ctx, marketID, isBuy) {
+			if len(cancellations) == maxCancellations {
+				return cancellations
+			}
+
+			order := order
+			cancellations = append(cancellations, func() error {
+				k.CancelTransientSpotLimitOrder(ctx, market, marketID, order.SubaccountID(), order)
+				return nil
+			})
+		}
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		if len(cancellations) == maxCancellations {
+			break
+		}
+
+		k.IterateSpotMarketOrders(ctx, marketID, isBuy, func(order *types.SpotMarketOrder) (stop bool) {
+			cancellations = append(cancellations, func() error {
+				k.CancelTransientSpotMarketOrder(ctx, market, order)
+				return nil
+			})
+			return len(cancellations) == maxCancellations
+		})
+	}
+
+	return cancellations
+}
+
+func (k *Keeper) getDerivativeMarketOrderCancellations(ctx sdk.Context, market DerivativeMarketI, maxCancellations int) []func() error {
+	marketID := market.MarketID()
+	cancellations := make([]func() error, 0)
+	store := k.getStore(ctx)
+
+	for _, isBuy := range []bool{true, false} {
+		isBuy := isBuy
+		if len(cancellations) == maxCancellations {
+			return cancellations
+		}
+
+		k.IterateDerivativeLimitOrdersByMarketDirection(ctx, marketID, isBuy, func(order *types.DerivativeLimitOrder) (stop bool) {
+			cancellations = append(cancellations, func() error {
+				return k.CancelRestingDerivativeLimitOrder(ctx, market, order.SubaccountID(), &isBuy, order.Hash(), true, true)
+			})
+			return len(cancellations) == maxCancellations
+		})
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		for _, order := range k.GetAllTransientDerivativeLimitOrdersByMarketDirection(ctx, marketID, isBuy) {
+			if len(cancellations) == maxCancellations {
+				return cancellations
+			}
+
+			order := order
+			cancellations = append(cancellations, func() error {
+				return k.CancelTransientDerivativeLimitOrder(ctx, market, order)
+			})
+		}
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		if len(cancellations) == maxCancellations {
+			return cancellations
+		}
+
+		k.IterateDerivativeMarketOrders(ctx, marketID, isBuy, func(order *types.DerivativeMarketOrder) (stop bool) {
+			cancellations = append(cancellations, func() error {
+				k.CancelDerivativeMarketOrder(ctx, market, order)
+				return nil
+			})
+			return len(cancellations) == maxCancellations
+		})
+	}
+
+	for _, isMarketOrders := range []bool{false, true} {
+		for _, isTriggerPriceHigher := range []bool{true, false} {
+			isMarketOrders := isMarketOrders
+			if len(cancellations) == maxCancellations {
+				return cancellations
+			}
+
+			k.IterateConditionalDerivativeOrders(ctx, marketID, isTriggerPriceHigher, isMarketOrders, nil, func(orderKey []byte) (stop bool) {
+				bz := store.Get(orderKey)
+
+				if isMarketOrders {
+					var order types.DerivativeMarketOrder
+					k.cdc.MustUnmarshal(bz, &order)
+					cancellations = append(cancellations, func() error {
+						return k.CancelConditionalDerivativeMarketOrder(ctx, market, order.SubaccountID(), nil, order.Hash())
+					})
+				} else {
+					var order types.DerivativeLimitOrder
+					k.cdc.MustUnmarshal(bz, &order)
+					cancellations = append(cancellations, func() error {
+						return k.CancelConditionalDerivativeLimitOrder(ctx, market, order.SubaccountID(), nil, order.Hash())
+					})
+				}
+
+				return len(cancellations) == maxCancellations
+			})
+		}
+	}
+
+	return cancellations
+}
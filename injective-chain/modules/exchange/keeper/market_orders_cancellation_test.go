@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Cancel all orders in market", func() {
+	var (
+		testInput    testexchange.TestInput
+		app          *simapp.InjectiveApp
+		ctx          sdk.Context
+		msgServer    types.MsgServer
+		market       testexchange.SpotMarket
+		buyer        common.Hash
+		seller       common.Hash
+		initialFunds sdk.Coins
+	)
+
+	createOrder := func(price string, orderType types.OrderType, subaccountID common.Hash) string {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, "1", orderType, subaccountID),
+		)
+		resp, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		testexchange.OrFail(err)
+		return resp.OrderHash
+	}
+
+	getCancelledOrderHashes := func(events []abci.Event) []string {
+		hashes := make([]string, 0)
+		for _, event := range events {
+			parsed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			if e, ok := parsed.(*types.EventCancelSpotOrder); ok {
+				hashes = append(hashes, common.BytesToHash(e.Order.OrderHash).Hex())
+			}
+		}
+		return hashes
+	}
+
+	expectFullRefund := func(subaccountID common.Hash) {
+		for _, coin := range initialFunds {
+			deposit := app.ExchangeKeeper.GetDeposit(ctx, subaccountID, coin.Denom)
+			Expect(deposit.AvailableBalance.String()).To(Equal(deposit.TotalBalance.String()), coin.Denom)
+			Expect(deposit.TotalBalance.String()).To(Equal(coin.Amount.ToDec().String()), coin.Denom)
+		}
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+		market = testInput.Spots[0]
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		buyer = testexchange.SampleNonDefaultSubaccountAddr1
+		seller = testexchange.SampleNonDefaultSubaccountAddr2
+
+		initialFunds = sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000)), sdk.NewCoin(market.BaseDenom, sdk.NewInt(100)))
+		testexchange.MintAndDeposit(app, ctx, buyer.String(), initialFunds)
+		testexchange.MintAndDeposit(app, ctx, seller.String(), initialFunds)
+	})
+
+	AfterEach(func() {
+		Expect(app.ExchangeKeeper.IsMetadataInvariantValid(ctx)).To(BeTrue())
+	})
+
+	Context("with a populated book", func() {
+		var expectedOrder []string
+
+		BeforeEach(func() {
+			buy100 := createOrder("100", types.OrderType_BUY, buyer)
+			buy101 := createOrder("101", types.OrderType_BUY, buyer)
+			sell111 := createOrder("111", types.OrderType_SELL, seller)
+			sell110 := createOrder("110", types.OrderType_SELL, seller)
+			ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+			// buys from the best price, then sells from the best price
+			expectedOrder = []string{buy101, buy100, sell110, sell111}
+		})
+
+		It("cancels every order in book order and refunds the balance holds", func() {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			cancelledCount, hasMoreOrders, err := app.ExchangeKeeper.CancelAllOrdersInMarket(ctx, market.MarketID)
+			Expect(err).To(BeNil())
+			Expect(cancelledCount).To(Equal(uint32(4)))
+			Expect(hasMoreOrders).To(BeFalse())
+
+			Expect(app.ExchangeKeeper.GetAllSpotLimitOrdersByMarketDirection(ctx, market.MarketID, true)).To(BeEmpty())
+			Expect(app.ExchangeKeeper.GetAllSpotLimitOrdersByMarketDirection(ctx, market.MarketID, false)).To(BeEmpty())
+
+			events := ctx.EventManager().ABCIEvents()
+			Expect(getCancelledOrderHashes(events)).To(Equal(expectedOrder))
+
+			expectFullRefund(buyer)
+			expectFullRefund(seller)
+
+			var summary *types.EventMarketOrdersCancelled
+			for _, event := range events {
+				if parsed, err := sdk.ParseTypedEvent(event); err == nil {
+					if e, ok := parsed.(*types.EventMarketOrdersCancelled); ok {
+						summary = e
+					}
+				}
+			}
+			Expect(summary).ToNot(BeNil())
+			Expect(summary.MarketId).To(Equal(market.MarketID.Hex()))
+			Expect(summary.CancelledOrdersCount).To(Equal(uint32(4)))
+			Expect(summary.HasMoreOrders).To(BeFalse())
+		})
+
+		It("cancels the orders of a paused market through governance", func() {
+			_, err := app.ExchangeKeeper.SetSpotMarketStatus(ctx, market.MarketID, types.MarketStatus_Paused)
+			testexchange.OrFail(err)
+
+			resp, err := msgServer.CancelAllOrdersInMarket(sdk.WrapSDKContext(ctx), &types.MsgCancelAllOrdersInMarket{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketId:  market.MarketID.Hex(),
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.CancelledOrdersCount).To(Equal(uint32(4)))
+			Expect(resp.HasMoreOrders).To(BeFalse())
+
+			expectFullRefund(buyer)
+			expectFullRefund(seller)
+		})
+
+		It("cancels the orders placed in the current block and refunds their balance holds", func() {
+			transientBuy := createOrder("102", types.OrderType_BUY, buyer)
+			_, err := msgServer.CreateSpotMarketOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateSpotMarketOrder(sdk.OneDec(), sdk.NewDec(120), types.OrderType_BUY, buyer))
+			testexchange.OrFail(err)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			cancelledCount, hasMoreOrders, err := app.ExchangeKeeper.CancelAllOrdersInMarket(ctx, market.MarketID)
+			Expect(err).To(BeNil())
+			Expect(cancelledCount).To(Equal(uint32(6)))
+			Expect(hasMoreOrders).To(BeFalse())
+
+			Expect(getCancelledOrderHashes(ctx.EventManager().ABCIEvents())).To(Equal(append(expectedOrder, transientBuy)))
+			Expect(app.ExchangeKeeper.GetAllTransientSpotLimitOrdersByMarketDirection(ctx, market.MarketID, true)).To(BeEmpty())
+			Expect(app.ExchangeKeeper.GetAllTransientSpotMarketOrders(ctx, market.MarketID, true)).To(BeEmpty())
+
+			ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+			expectFullRefund(buyer)
+			expectFullRefund(seller)
+		})
+
+		It("rejects a message not sent by the governance authority", func() {
+			_, err := msgServer.CancelAllOrdersInMarket(sdk.WrapSDKContext(ctx), &types.MsgCancelAllOrdersInMarket{
+				Authority: testexchange.SampleAccountAddr1.String(),
+				MarketId:  market.MarketID.Hex(),
+			})
+			Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+			Expect(app.ExchangeKeeper.GetAllSpotLimitOrdersByMarketDirection(ctx, market.MarketID, true)).To(HaveLen(2))
+		})
+	})
+
+	It("succeeds with an empty book", func() {
+		cancelledCount, hasMoreOrders, err := app.ExchangeKeeper.CancelAllOrdersInMarket(ctx, market.MarketID)
+		Expect(err).To(BeNil())
+		Expect(cancelledCount).To(BeZero())
+		Expect(hasMoreOrders).To(BeFalse())
+	})
+
+	It("rejects an unknown market", func() {
+		_, _, err := app.ExchangeKeeper.CancelAllOrdersInMarket(ctx, common.HexToHash("0x1"))
+		Expect(err).To(MatchError(types.ErrMarketInvalid))
+	})
+})
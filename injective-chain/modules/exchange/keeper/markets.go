@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
 	"github.com/InjectiveLabs/metrics"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -27,6 +30,7 @@ type DerivativeMarketI interface {
 	GetIsPerpetual() bool
 	GetInitialMarginRatio() sdk.Dec
 	GetOracleScaleFactor() uint32
+	GetMaxSlippageRatio() sdk.Dec
 }
 
 type MarketIDQuoteDenomMakerFee struct {
@@ -67,6 +71,18 @@ func MarketIDMarketFilter(marketIDs ...string) MarketFilter {
 	}
 }
 
+// MarketTypeMarketFilter filters the markets by their type
+func MarketTypeMarketFilter(marketTypes ...types.MarketType) MarketFilter {
+	m := make(map[types.MarketType]struct{}, len(marketTypes))
+	for _, t := range marketTypes {
+		m[t] = struct{}{}
+	}
+	return func(market MarketI) bool {
+		_, found := m[market.GetMarketType()]
+		return found
+	}
+}
+
 // ChainMarketFilter can be used to chain multiple market filters
 func ChainMarketFilter(filters ...MarketFilter) MarketFilter {
 	return func(market MarketI) bool {
@@ -128,6 +144,25 @@ func ConvertBinaryOptionsMarkets(markets []*types.BinaryOptionsMarket) []MarketI
 	return converted
 }
 
+// FindMarkets returns the spot, derivative and binary options markets passing the filter, sorted by market ID.
+func (k *Keeper) FindMarkets(ctx sdk.Context, filter MarketFilter) []MarketI {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	spotMarkets := k.FindSpotMarkets(ctx, func(market *types.SpotMarket) bool {
+		return filter(market)
+	})
+
+	markets := ConvertSpotMarkets(spotMarkets)
+	markets = append(markets, ConvertDerivativeMarkets(k.FindDerivativeMarkets(ctx, filter))...)
+	markets = append(markets, ConvertBinaryOptionsMarkets(k.FindBinaryOptionsMarkets(ctx, filter))...)
+
+	sort.SliceStable(markets, func(i, j int) bool {
+		return bytes.Compare(markets[i].MarketID().Bytes(), markets[j].MarketID().Bytes()) < 0
+	})
+
+	return markets
+}
+
 func (k *Keeper) FindDerivativeAndBinaryOptionsMarkets(ctx sdk.Context, filter MarketFilter) []DerivativeMarketI {
 	derivativeMarkets := k.FindDerivativeMarkets(ctx, filter)
 	binaryOptionsMarkets := k.FindBinaryOptionsMarkets(ctx, filter)
@@ -253,6 +288,71 @@ func (k *Keeper) GetAllMarketIDsWithQuoteDenoms(ctx sdk.Context) []*MarketIDQuot
 	return marketIDQuoteDenoms
 }
 
+// GetMarketDenomUsage returns the set of denoms used as base denom and the set of denoms used as quote denom by
+// the markets of any type and status.
+func (k *Keeper) GetMarketDenomUsage(ctx sdk.Context) (baseDenoms, quoteDenoms map[string]struct{}) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	baseDenoms = make(map[string]struct{})
+	quoteDenoms = make(map[string]struct{})
+
+	for _, m := range k.GetAllSpotMarkets(ctx) {
+		baseDenoms[m.BaseDenom] = struct{}{}
+		quoteDenoms[m.QuoteDenom] = struct{}{}
+	}
+
+	for _, m := range k.GetAllDerivativeAndBinaryOptionsMarkets(ctx) {
+		quoteDenoms[m.GetQuoteDenom()] = struct{}{}
+	}
+
+	return baseDenoms, quoteDenoms
+}
+
+// GetActiveMarketsCount returns the number of active spot, derivative and binary options markets.
+func (k *Keeper) GetActiveMarketsCount(ctx sdk.Context) uint32 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return uint32(len(k.FindMarkets(ctx, StatusMarketFilter(types.MarketStatus_Active))))
+}
+
+// CheckMaxActiveMarkets returns an error if no market can be launched or reactivated because the number of active
+// markets has reached the max_active_markets param. Demolished markets are not active and don't count towards the cap.
+func (k *Keeper) CheckMaxActiveMarkets(ctx sdk.Context) error {
+	maxActiveMarkets := k.GetParams(ctx).MaxActiveMarkets
+	if maxActiveMarkets == 0 {
+		return nil
+	}
+
+	if activeMarketsCount := k.GetActiveMarketsCount(ctx); activeMarketsCount >= maxActiveMarkets {
+		return types.ErrMaxActiveMarketsReached.Wrapf("%d markets are active, max_active_markets is %d", activeMarketsCount, maxActiveMarkets)
+	}
+
+	return nil
+}
+
+// IsMarketPaused returns true if a market of any type exists for the given marketID and is paused. It is used to
+// reject orders in paused markets with ErrMarketPaused instead of the generic market not found errors.
+func (k *Keeper) IsMarketPaused(ctx sdk.Context, marketID common.Hash) bool {
+	if market := k.GetSpotMarketByID(ctx, marketID); market != nil {
+		return market.Status == types.MarketStatus_Paused
+	}
+
+	if market := k.GetDerivativeMarketByID(ctx, marketID); market != nil {
+		return market.Status == types.MarketStatus_Paused
+	}
+
+	if market := k.GetBinaryOptionsMarketByID(ctx, marketID); market != nil {
+		return market.Status == types.MarketStatus_Paused
+	}
+
+	return false
+}
+
+// HasMarket returns true if a spot, derivative or binary options market of any status exists for the given marketID.
+func (k *Keeper) HasMarket(ctx sdk.Context, marketID common.Hash) bool {
+	return k.GetSpotMarketByID(ctx, marketID) != nil || k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil) != nil
+}
+
 func (k *Keeper) GetMarketAtomicExecutionFeeMultiplier(ctx sdk.Context, marketId common.Hash, marketType types.MarketType) sdk.Dec {
 	metrics.ReportFuncCall(k.svcTags)
 	defer metrics.ReportFuncTiming(k.svcTags)()
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -19,6 +21,9 @@ func (k *Keeper) ProcessMarketsScheduledToSettle(ctx sdk.Context) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	marketSettlementInfos := k.GetAllScheduledSettlementDerivativeMarkets(ctx)
+	SortByMarketID(marketSettlementInfos, func(info types.DerivativeMarketSettlementInfo) common.Hash {
+		return common.HexToHash(info.MarketId)
+	})
 
 	for _, marketSettlementInfo := range marketSettlementInfos {
 		zeroClosingFeeRateWhenForciblyClosing := sdk.ZeroDec()
@@ -137,6 +142,10 @@ func (k *Keeper) ProcessMatureExpiryFutureMarkets(ctx sdk.Context) {
 		}
 	}
 
+	// the market infos are collected in the order of their expiry
+	SortByMarketID(maturingMarketInfos, expiryFuturesMarketInfoID)
+	SortByMarketID(maturedMarketInfos, expiryFuturesMarketInfoID)
+
 	for _, marketInfo := range maturingMarketInfos {
 		marketID := common.HexToHash(marketInfo.MarketId)
 		prevStartTimestamp := marketInfo.TwapStartTimestamp
@@ -161,6 +170,10 @@ func (k *Keeper) ProcessMatureExpiryFutureMarkets(ctx sdk.Context) {
 	}
 }
 
+func expiryFuturesMarketInfoID(marketInfo *types.ExpiryFuturesMarketInfo) common.Hash {
+	return common.HexToHash(marketInfo.MarketId)
+}
+
 func getPositionFundsStatus(position *types.Position, settlementPrice, closingFeeRate sdk.Dec) (isProfitable bool, profitAmount, deficitAmountAbs, payout sdk.Dec) {
 	profitAmount, deficitAmountAbs = sdk.ZeroDec(), sdk.ZeroDec()
 
@@ -414,7 +427,7 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 	closingFeeRate sdk.Dec,
 	marketFunding *types.PerpetualMarketFunding,
 	deficitPositions []DeficitPositions,
-) {
+) []*types.DerivativeTradeLog {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	depositDeltas := types.NewDepositDeltas()
@@ -422,13 +435,14 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 
 	buyTrades := make([]*types.DerivativeTradeLog, 0)
 	sellTrades := make([]*types.DerivativeTradeLog, 0)
+	closingTrades := make([]*types.DerivativeTradeLog, 0, len(positions))
 
 	for _, position := range positions {
 		// should always be positive or zero
 		// settlementPrice can be -1 for binary options
 		if closingFeeRate.IsPositive() && settlementPrice.IsPositive() {
 			orderFillNotional := settlementPrice.Mul(position.Position.Quantity)
-			auctionFeeReward := orderFillNotional.Mul(closingFeeRate)
+			auctionFeeReward := types.CalculateFee(orderFillNotional, closingFeeRate)
 			depositDeltas.ApplyUniformDelta(types.AuctionSubaccountID, auctionFeeReward)
 		}
 
@@ -461,6 +475,7 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 		} else {
 			buyTrades = append(buyTrades, &tradeLog)
 		}
+		closingTrades = append(closingTrades, &tradeLog)
 
 		k.SetPosition(ctx, marketID, subaccountID, position.Position)
 	}
@@ -508,25 +523,131 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 	for _, subaccountID := range depositDeltas.GetSortedSubaccountKeys() {
 		k.UpdateDepositWithDelta(ctx, subaccountID, market.GetQuoteDenom(), depositDeltas[subaccountID])
 	}
+
+	return closingTrades
 }
 
-// SettleMarket settles derivative & binary options markets
+// SettleMarket settles derivative & binary options markets and returns the trades closing the positions,
+// in the order the positions were closed.
 func (k *Keeper) SettleMarket(
 	ctx sdk.Context,
 	market DerivativeMarketI,
 	closingFeeRate sdk.Dec,
 	settlementPrice *sdk.Dec,
-) {
+) []*types.DerivativeTradeLog {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	marketID := market.MarketID()
 	derivativePositions := k.GetAllPositionsByMarket(ctx, marketID)
 	marketFunding := k.GetPerpetualMarketFunding(ctx, marketID)
 
+	// positions are closed and their trades are emitted in the deterministic liquidation order
+	types.SortPositionsForLiquidation(derivativePositions, *settlementPrice, marketFunding)
+
 	// no need to cancel transient orders since SettleMarket only runs in the BeginBlocker
 	k.CancelAllRestingDerivativeLimitOrders(ctx, market)
 	k.CancelAllConditionalDerivativeOrders(ctx, market)
 
 	deficitPositions := k.executeSocializedLoss(ctx, market, marketFunding, derivativePositions, *settlementPrice, closingFeeRate)
-	k.closeAllPositionsWithSettlePrice(ctx, market, derivativePositions, *settlementPrice, closingFeeRate, marketFunding, deficitPositions)
+	return k.closeAllPositionsWithSettlePrice(ctx, market, derivativePositions, *settlementPrice, closingFeeRate, marketFunding, deficitPositions)
+}
+
+// ForceSettleDerivativeMarket settles the derivative market at the given price, closing all its positions without
+// closing fees and refunding the remaining margin, and demolishes the market. The settlement is atomic: it is only
+// committed if it conserves funds, see reconcileExchangeBalances.
+func (k *Keeper) ForceSettleDerivativeMarket(ctx sdk.Context, marketID common.Hash, settlementPrice sdk.Dec) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	market := k.GetDerivativeMarketByID(ctx, marketID)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrDerivativeMarketNotFound, "market %s", marketID.Hex())
+	}
+
+	if market.Status == types.MarketStatus_Demolished {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrInvalidMarketStatus, "market %s is already demolished", marketID.Hex())
+	}
+
+	if !settlementPrice.IsPositive() {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrap(types.ErrInvalidSettlement, "settlement price must be positive")
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// unlike the settlements in the BeginBlocker, the market can have orders placed in the current block
+	k.CancelAllTransientDerivativeLimitOrders(cacheCtx, market)
+	k.CancelAllDerivativeMarketOrders(cacheCtx, market)
+
+	positions := k.GetAllPositionsByMarket(cacheCtx, marketID)
+	moduleBalanceBefore, depositsBefore, margins := k.getSettlementFunds(cacheCtx, market.QuoteDenom, positions)
+
+	closingTrades := k.SettleMarket(cacheCtx, market, sdk.ZeroDec(), &settlementPrice)
+
+	if market.IsTimeExpiry() {
+		marketInfo := k.GetExpiryFuturesMarketInfo(cacheCtx, marketID)
+		k.DeleteExpiryFuturesMarketInfoByTimestamp(cacheCtx, marketID, marketInfo.TwapStartTimestamp)
+		k.DeleteExpiryFuturesMarketInfoByTimestamp(cacheCtx, marketID, marketInfo.ExpirationTimestamp)
+		k.DeleteExpiryFuturesMarketInfo(cacheCtx, marketID)
+	}
+
+	market.Status = types.MarketStatus_Demolished
+	k.DeleteDerivativesMarketScheduledSettlementInfo(cacheCtx, marketID)
+	k.SetDerivativeMarketWithInfo(cacheCtx, market, nil, nil, nil)
+
+	moduleBalanceAfter, depositsAfter, _ := k.getSettlementFunds(cacheCtx, market.QuoteDenom, positions)
+	if err := reconcileExchangeBalances(market.QuoteDenom, depositsAfter.Sub(depositsBefore), margins, moduleBalanceAfter.Sub(moduleBalanceBefore)); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	for _, trade := range closingTrades {
+		// nolint:errcheck //ignored on purpose
+		cacheCtx.EventManager().EmitTypedEvent(&types.EventForceSettledPosition{
+			MarketId:        marketID.Hex(),
+			SubaccountId:    common.BytesToHash(trade.SubaccountId).Hex(),
+			IsLong:          !trade.PositionDelta.IsLong,
+			Quantity:        trade.PositionDelta.ExecutionQuantity,
+			SettlementPrice: settlementPrice,
+			// deficits of bankrupt positions are covered, so they get nothing back
+			Payout: sdk.MaxDec(trade.Payout, sdk.ZeroDec()),
+		})
+	}
+
+	writeCache()
+	return nil
+}
+
+// getSettlementFunds returns the balance of the exchange module account in the quote denom of a market, as well as
+// the total deposits in the denom and the total margin of the subaccounts with the given positions in the market. Only
+// these subaccounts are read so that the settlement stays bounded by the number of positions of the market rather
+// than by all the deposits of the denom.
+func (k *Keeper) getSettlementFunds(
+	ctx sdk.Context,
+	denom string,
+	positions []*types.DerivativePosition,
+) (moduleBalance sdkmath.Int, deposits, margins sdk.Dec) {
+	deposits, margins = sdk.ZeroDec(), sdk.ZeroDec()
+
+	for _, position := range positions {
+		deposits = deposits.Add(k.GetDeposit(ctx, common.HexToHash(position.SubaccountId), denom).TotalBalance)
+		margins = margins.Add(position.Position.Margin)
+	}
+
+	moduleBalance = k.bankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName), denom).Amount
+	return moduleBalance, deposits, margins
+}
+
+// reconcileExchangeBalances checks that a market settlement conserves funds: the deposits it credited to the settled
+// subaccounts must be backed by the margins of their closed positions and by the funds the exchange module account
+// received, e.g. from the insurance fund to cover deficits. Rounding dust of less than one unit is tolerated, and
+// crediting less than backed leaves the difference in the module account.
+func reconcileExchangeBalances(denom string, depositsDelta, margins sdk.Dec, moduleBalanceDelta sdkmath.Int) error {
+	credited := depositsDelta.Sub(margins)
+	if credited.TruncateInt().GT(moduleBalanceDelta) {
+		return errors.Wrapf(types.ErrExchangeBalanceMismatch, "settlement credited %s%s beyond the closed margins, module account received %s%s", credited.String(), denom, moduleBalanceDelta.String(), denom)
+	}
+
+	return nil
 }
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Max active markets", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+	)
+
+	launchSpotMarket := func(index int) (*types.SpotMarket, error) {
+		market := testInput.Spots[index]
+		return app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+	}
+
+	queryActiveMarketsCount := func() *types.QueryActiveMarketsCountResponse {
+		res, err := app.ExchangeKeeper.ActiveMarketsCount(sdk.WrapSDKContext(ctx), &types.QueryActiveMarketsCountRequest{})
+		testexchange.OrFail(err)
+		return res
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 3, 0, 0)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MaxActiveMarkets = 2
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		_, err := launchSpotMarket(0)
+		testexchange.OrFail(err)
+		_, err = launchSpotMarket(1)
+		testexchange.OrFail(err)
+	})
+
+	It("reports the active markets count and the cap", func() {
+		res := queryActiveMarketsCount()
+		Expect(res.ActiveMarketsCount).To(Equal(uint32(2)))
+		Expect(res.MaxActiveMarkets).To(Equal(uint32(2)))
+	})
+
+	It("rejects a launch at the cap", func() {
+		_, err := launchSpotMarket(2)
+		Expect(err).To(MatchError(types.ErrMaxActiveMarketsReached))
+		Expect(app.ExchangeKeeper.GetSpotMarketByID(ctx, testInput.Spots[2].MarketID)).To(BeNil())
+	})
+
+	It("accepts a launch after a market is demolished", func() {
+		_, err := app.ExchangeKeeper.SetSpotMarketStatus(ctx, testInput.Spots[0].MarketID, types.MarketStatus_Demolished)
+		testexchange.OrFail(err)
+		Expect(queryActiveMarketsCount().ActiveMarketsCount).To(Equal(uint32(1)))
+
+		_, err = launchSpotMarket(2)
+		Expect(err).To(BeNil())
+		Expect(queryActiveMarketsCount().ActiveMarketsCount).To(Equal(uint32(2)))
+	})
+
+	It("rejects reactivating a market at the cap", func() {
+		market, err := app.ExchangeKeeper.SetSpotMarketStatus(ctx, testInput.Spots[0].MarketID, types.MarketStatus_Paused)
+		testexchange.OrFail(err)
+		_, err = launchSpotMarket(2)
+		testexchange.OrFail(err)
+
+		err = app.ExchangeKeeper.ExecuteSpotMarketParamUpdateProposal(ctx, &types.SpotMarketParamUpdateProposal{
+			MarketId:            market.MarketId,
+			MakerFeeRate:        &market.MakerFeeRate,
+			TakerFeeRate:        &market.TakerFeeRate,
+			RelayerFeeShareRate: &market.RelayerFeeShareRate,
+			MinPriceTickSize:    &market.MinPriceTickSize,
+			MinQuantityTickSize: &market.MinQuantityTickSize,
+			Status:              types.MarketStatus_Active,
+		})
+		Expect(err).To(MatchError(types.ErrMaxActiveMarketsReached))
+		Expect(app.ExchangeKeeper.GetSpotMarketByID(ctx, testInput.Spots[0].MarketID).Status).To(Equal(types.MarketStatus_Paused))
+		Expect(queryActiveMarketsCount().ActiveMarketsCount).To(Equal(uint32(2)))
+	})
+
+	It("doesn't limit the markets when the cap is zero", func() {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MaxActiveMarkets = 0
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		_, err := launchSpotMarket(2)
+		Expect(err).To(BeNil())
+	})
+})
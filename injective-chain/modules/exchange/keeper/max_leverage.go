@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// ensureOrderWithinMaxLeverage rejects an order which, if fully filled, would leave the position of the subaccount with
+// a leverage, i.e. notional over margin, above the max leverage of the market. Orders which don't increase the
+// leverage of the position are always accepted, so that positions opened before the max leverage was lowered can still
+// be deleveraged. Conditional orders are checked once triggered.
+func (k *Keeper) ensureOrderWithinMaxLeverage(
+	ctx sdk.Context,
+	market DerivativeMarketI,
+	order *types.DerivativeOrder,
+) error {
+	derivativeMarket, ok := market.(*types.DerivativeMarket)
+	if !ok || order.IsConditional() || order.IsReduceOnly() {
+		return nil
+	}
+
+	maxLeverage := derivativeMarket.GetMaxLeverage()
+	if maxLeverage.IsZero() {
+		return nil
+	}
+
+	notional := order.Price().Mul(order.GetQuantity())
+	margin := order.GetMargin()
+
+	position := k.GetPosition(ctx, derivativeMarket.MarketID(), order.SubaccountID())
+	if position != nil && position.Quantity.IsPositive() {
+		if position.IsLong != order.IsBuy() {
+			// the order closes the position first, the rest opens a position backed by its margin pro rata, so the
+			// leverage of the new position is the leverage of the order
+			if order.GetQuantity().LTE(position.Quantity) {
+				return nil
+			}
+		} else {
+			positionNotional := position.EntryPrice.Mul(position.Quantity)
+
+			// the order doesn't increase the leverage of the position if its own leverage isn't higher
+			if position.Margin.IsPositive() && notional.Mul(position.Margin).LTE(positionNotional.Mul(margin)) {
+				return nil
+			}
+
+			notional = notional.Add(positionNotional)
+			margin = margin.Add(position.Margin)
+		}
+	}
+
+	if notional.LTE(maxLeverage.Mul(margin)) {
+		return nil
+	}
+
+	metrics.ReportFuncError(k.svcTags)
+	if !margin.IsPositive() {
+		return types.ErrMaxLeverageExceeded.Wrapf("position without margin would exceed the max leverage of %s", maxLeverage.String())
+	}
+	return types.ErrMaxLeverageExceeded.Wrapf("leverage of %s would exceed the max leverage of %s", notional.Quo(margin).String(), maxLeverage.String())
+}
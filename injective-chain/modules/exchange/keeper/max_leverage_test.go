@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Derivative market max leverage", func() {
+	var (
+		testInput          testexchange.TestInput
+		app                *simapp.InjectiveApp
+		ctx                sdk.Context
+		msgServer          types.MsgServer
+		marketID           common.Hash
+		subaccountIdBuyer  = testexchange.SampleSubaccountAddr1
+		subaccountIdSeller = testexchange.SampleSubaccountAddr2
+		senderBuyer        = types.SubaccountIDToSdkAddress(subaccountIdBuyer)
+		startingPrice      = sdk.NewDec(2000)
+	)
+
+	setMaxLeverage := func(maxLeverage int64) {
+		market := app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID)
+		market.MaxLeverage = sdk.NewDec(maxLeverage)
+		app.ExchangeKeeper.SetDerivativeMarket(ctx, market)
+	}
+
+	// openLongPosition opens a long position of 2 contracts at the starting price with the given leverage
+	openLongPosition := func(subaccountID common.Hash, leverage int64) {
+		notional := startingPrice.MulInt64(2)
+		app.ExchangeKeeper.SetPosition(ctx, marketID, subaccountID, &types.Position{
+			IsLong:                 true,
+			Quantity:               sdk.NewDec(2),
+			EntryPrice:             startingPrice,
+			Margin:                 notional.QuoInt64(leverage),
+			CumulativeFundingEntry: sdk.ZeroDec(),
+		})
+	}
+
+	createOrder := func(subaccountID common.Hash, margin int64, orderType types.OrderType) error {
+		_, err := msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCreateDerivativeLimitOrder{
+			Sender: types.SubaccountIDToSdkAddress(subaccountID).String(),
+			Order: types.DerivativeOrder{
+				MarketId: marketID.Hex(),
+				OrderInfo: types.OrderInfo{
+					SubaccountId: subaccountID.Hex(),
+					FeeRecipient: "inj1dzqd00lfd4y4qy2pxa0dsdwzfnmsu27hgttswz",
+					Price:        startingPrice,
+					Quantity:     sdk.OneDec(),
+				},
+				OrderType: orderType,
+				Margin:    sdk.NewDec(margin),
+			},
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		oracleBase, oracleQuote, oracleType := testInput.Perps[0].OracleBase, testInput.Perps[0].OracleQuote, testInput.Perps[0].OracleType
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, oracleBase, oracleQuote, oracletypes.NewPriceState(startingPrice, ctx.BlockTime().Unix()))
+		coin := sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.OneInt())
+		app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin))
+		app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderBuyer, sdk.NewCoins(coin))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, senderBuyer, coin, testInput.Perps[0].Ticker, testInput.Perps[0].QuoteDenom, oracleBase, oracleQuote, oracleType, -1))
+
+		market, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			testInput.Perps[0].Ticker,
+			testInput.Perps[0].QuoteDenom,
+			oracleBase,
+			oracleQuote,
+			0,
+			oracleType,
+			testInput.Perps[0].InitialMarginRatio,
+			testInput.Perps[0].MaintenanceMarginRatio,
+			testInput.Perps[0].MakerFeeRate,
+			testInput.Perps[0].TakerFeeRate,
+			testInput.Perps[0].MinPriceTickSize,
+			testInput.Perps[0].MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+		marketID = market.MarketID()
+
+		depositAmount := sdk.NewCoins(sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.NewInt(100000)))
+		testexchange.MintAndDeposit(app, ctx, subaccountIdBuyer.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, subaccountIdSeller.String(), depositAmount)
+	})
+
+	It("rejects orders exceeding the max leverage", func() {
+		setMaxLeverage(5)
+
+		// leverage of 2000 / 250 = 8
+		Expect(createOrder(subaccountIdBuyer, 250, types.OrderType_BUY)).To(MatchError(types.ErrMaxLeverageExceeded))
+		Expect(createOrder(subaccountIdSeller, 250, types.OrderType_SELL)).To(MatchError(types.ErrMaxLeverageExceeded))
+
+		// leverage of 2000 / 400 = 5
+		testexchange.OrFail(createOrder(subaccountIdBuyer, 400, types.OrderType_BUY))
+	})
+
+	It("rejects orders increasing the leverage of a position beyond the max leverage", func() {
+		setMaxLeverage(5)
+		openLongPosition(subaccountIdBuyer, 4)
+
+		// the resulting leverage is 6000 / (1000 + 200) = 5
+		testexchange.OrFail(createOrder(subaccountIdBuyer, 200, types.OrderType_BUY))
+
+		// the resulting leverage is 6000 / (1000 + 160) > 5
+		Expect(createOrder(subaccountIdBuyer, 160, types.OrderType_BUY)).To(MatchError(types.ErrMaxLeverageExceeded))
+	})
+
+	It("accepts deleveraging orders when over the max leverage", func() {
+		openLongPosition(subaccountIdBuyer, 10)
+		setMaxLeverage(5)
+
+		// the resulting leverage is 6000 / (400 + 500) > 5, but lower than the leverage of 10 of the position
+		testexchange.OrFail(createOrder(subaccountIdBuyer, 500, types.OrderType_BUY))
+
+		// an order with a leverage of 2000 / 160 > 10 increases the leverage of the position
+		Expect(createOrder(subaccountIdBuyer, 160, types.OrderType_BUY)).To(MatchError(types.ErrMaxLeverageExceeded))
+
+		// an order closing a part of the position reduces its exposure
+		testexchange.OrFail(createOrder(subaccountIdBuyer, 200, types.OrderType_SELL))
+	})
+
+	It("doesn't limit the leverage without a max leverage", func() {
+		testexchange.OrFail(createOrder(subaccountIdBuyer, 250, types.OrderType_BUY))
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"github.com/InjectiveLabs/metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
 )
@@ -54,3 +55,143 @@ func (m MsgServer) UpdateParams(c context.Context, msg *types.MsgUpdateParams) (
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (m MsgServer) ForceSettleMarket(c context.Context, msg *types.MsgForceSettleMarket) (*types.MsgForceSettleMarketResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(m.svcTags)()
+
+	if msg.Authority != m.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := m.ForceSettleDerivativeMarket(sdk.UnwrapSDKContext(c), common.HexToHash(msg.MarketId), msg.SettlementPrice); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceSettleMarketResponse{}, nil
+}
+
+// BatchCancelOrders cancels orders in spot, derivative and binary options markets. Each cancellation runs in its own
+// cached context, so a failed cancellation never leaves a partial refund behind and never aborts the rest of the batch.
+func (m MsgServer) BatchCancelOrders(c context.Context, msg *types.MsgBatchCancelOrders) (*types.MsgBatchCancelOrdersResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(m.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	successes := make([]bool, len(msg.Data))
+	errorCodes := make([]uint32, len(msg.Data))
+
+	for idx := range msg.Data {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		if err := m.cancelOrder(cacheCtx, sender, &msg.Data[idx]); err != nil {
+			metrics.ReportFuncError(m.svcTags)
+			_, errorCodes[idx], _ = errors.ABCIInfo(err, false)
+			continue
+		}
+
+		writeCache()
+		successes[idx] = true
+	}
+
+	return &types.MsgBatchCancelOrdersResponse{
+		Success:    successes,
+		ErrorCodes: errorCodes,
+	}, nil
+}
+
+// ReplaceOrder atomically cancels a resting limit order and places a new limit order in the same market and subaccount,
+// charging the order placement surcharge for the new order.
+func (m MsgServer) ReplaceOrder(c context.Context, msg *types.MsgReplaceOrder) (*types.MsgReplaceOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(m.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	orderHash, err := m.Keeper.ReplaceOrder(ctx, sender, &msg.OldOrder, msg.SpotOrder, msg.DerivativeOrder)
+	if err != nil {
+		metrics.ReportFuncError(m.svcTags)
+		return nil, err
+	}
+
+	if err := m.ChargeOrderPlacementSurcharge(ctx, sender, 1); err != nil {
+		metrics.ReportFuncError(m.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgReplaceOrderResponse{
+		OrderHash: orderHash.Hex(),
+	}, nil
+}
+
+// SetMarginMode switches a subaccount of the sender between isolated and cross margin.
+func (m MsgServer) SetMarginMode(c context.Context, msg *types.MsgSetMarginMode) (*types.MsgSetMarginModeResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(m.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	subaccountID := types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+
+	if err := m.SetSubaccountMarginMode(ctx, subaccountID, msg.MarginMode); err != nil {
+		metrics.ReportFuncError(m.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgSetMarginModeResponse{}, nil
+}
+
+// TransferPosition moves a quantity of a derivative position, together with the same share of its margin, between two
+// subaccounts of the sender.
+func (m MsgServer) TransferPosition(c context.Context, msg *types.MsgTransferPosition) (*types.MsgTransferPositionResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(m.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		sender                  = sdk.MustAccAddressFromBech32(msg.Sender)
+		sourceSubaccountID      = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SourceSubaccountId)
+		destinationSubaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.DestinationSubaccountId)
+		marketID                = common.HexToHash(msg.MarketId)
+	)
+
+	if err := m.Keeper.TransferPosition(ctx, marketID, sourceSubaccountID, destinationSubaccountID, msg.Quantity); err != nil {
+		metrics.ReportFuncError(m.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgTransferPositionResponse{}, nil
+}
+
+// cancelOrder cancels a single order in a market of any type
+func (m MsgServer) cancelOrder(ctx sdk.Context, sender sdk.AccAddress, data *types.OrderData) error {
+	var (
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, data.SubaccountId)
+		marketID     = common.HexToHash(data.MarketId)
+		identifier   = types.GetOrderIdentifier(data.OrderHash, data.Cid)
+	)
+
+	if market := m.GetSpotMarketByID(ctx, marketID); market != nil {
+		return m.cancelSpotLimitOrder(ctx, subaccountID, identifier, market, marketID)
+	}
+
+	market := m.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil)
+	return m.cancelDerivativeOrder(ctx, subaccountID, identifier, market, marketID, data.OrderMask)
+}
+
+func (m MsgServer) CancelAllOrdersInMarket(c context.Context, msg *types.MsgCancelAllOrdersInMarket) (*types.MsgCancelAllOrdersInMarketResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(m.svcTags)()
+
+	if msg.Authority != m.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	cancelledCount, hasMoreOrders, err := m.Keeper.CancelAllOrdersInMarket(sdk.UnwrapSDKContext(c), common.HexToHash(msg.MarketId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAllOrdersInMarketResponse{
+		CancelledOrdersCount: cancelledCount,
+		HasMoreOrders:        hasMoreOrders,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// ensureValidOrderExpiration returns an error if the order has an expiration timestamp which is not after the current block time.
+func (k *Keeper) ensureValidOrderExpiration(ctx sdk.Context, orderInfo *types.OrderInfo) error {
+	if orderInfo.ExpirationTimestamp == 0 {
+		return nil
+	}
+
+	if blockTime := ctx.BlockTime().Unix(); orderInfo.ExpirationTimestamp <= blockTime {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInvalidExpirationTimestamp.Wrapf("expiration timestamp %d must be after the block time %d", orderInfo.ExpirationTimestamp, blockTime)
+	}
+
+	return nil
+}
+
+// setOrderExpiration indexes the resting limit order by its expiration timestamp, if any.
+func (k *Keeper) setOrderExpiration(ctx sdk.Context, isSpot bool, marketID common.Hash, isBuy bool, subaccountID, orderHash common.Hash, expirationTimestamp int64) {
+	if expirationTimestamp == 0 {
+		return
+	}
+
+	expirationStore := prefix.NewStore(k.getStore(ctx), types.OrderExpirationIndexPrefix)
+	expirationStore.Set(types.GetOrderExpirationKey(expirationTimestamp, marketID, isBuy, orderHash), types.GetOrderExpirationValue(isSpot, subaccountID))
+}
+
+// deleteOrderExpiration removes the resting limit order from the expiration index, if present.
+func (k *Keeper) deleteOrderExpiration(ctx sdk.Context, marketID common.Hash, isBuy bool, orderHash common.Hash, expirationTimestamp int64) {
+	if expirationTimestamp == 0 {
+		return
+	}
+
+	expirationStore := prefix.NewStore(k.getStore(ctx), types.OrderExpirationIndexPrefix)
+	expirationStore.Delete(types.GetOrderExpirationKey(expirationTimestamp, marketID, isBuy, orderHash))
+}
+
+type expiredOrder struct {
+	expirationTimestamp int64
+	marketID            common.Hash
+	isBuy               bool
+	orderHash           common.Hash
+	isSpot              bool
+	subaccountID        common.Hash
+}
+
+// getExpiredOrders returns up to limit resting limit orders whose expiration timestamp is not after the given time,
+// ordered by expiration timestamp, marketID, direction and order hash.
+func (k *Keeper) getExpiredOrders(ctx sdk.Context, blockTime int64, limit uint32) []expiredOrder {
+	expirationStore := prefix.NewStore(k.getStore(ctx), types.OrderExpirationIndexPrefix)
+
+	// iterate over all timestamps up to and including the block time
+	iterator := expirationStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(blockTime+1)))
+	defer iterator.Close()
+
+	orders := make([]expiredOrder, 0)
+	for ; iterator.Valid() && uint32(len(orders)) < limit; iterator.Next() {
+		expirationTimestamp, marketID, isBuy, orderHash := types.ParseOrderExpirationKey(iterator.Key())
+		isSpot, subaccountID := types.ParseOrderExpirationValue(iterator.Value())
+
+		orders = append(orders, expiredOrder{
+			expirationTimestamp: expirationTimestamp,
+			marketID:            marketID,
+			isBuy:               isBuy,
+			orderHash:           orderHash,
+			isSpot:              isSpot,
+			subaccountID:        subaccountID,
+		})
+	}
+
+	return orders
+}
+
+// ProcessExpiredOrders cancels the resting limit orders whose expiration timestamp is not after the block time, in
+// order of expiration timestamp and up to MaxExpiredOrdersPerBlock orders. Remaining expired orders are cancelled in
+// the following blocks.
+func (k *Keeper) ProcessExpiredOrders(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	expiredOrders := k.getExpiredOrders(ctx, ctx.BlockTime().Unix(), k.GetParams(ctx).MaxExpiredOrdersPerBlock)
+
+	for _, o := range expiredOrders {
+		var (
+			cid       string
+			cancelled bool
+		)
+		if o.isSpot {
+			cid, cancelled = k.cancelExpiredSpotLimitOrder(ctx, o)
+		} else {
+			cid, cancelled = k.cancelExpiredDerivativeLimitOrder(ctx, o)
+		}
+
+		// the index entry is normally removed together with the order, delete it anyways so a stale entry cannot stall the sweep
+		k.deleteOrderExpiration(ctx, o.marketID, o.isBuy, o.orderHash, o.expirationTimestamp)
+
+		if !cancelled {
+			continue
+		}
+
+		k.recordOrderTermination(ctx, o.orderHash, types.OrderHistoryEventType_OrderHistoryExpired)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventOrderExpired{
+			MarketId:            o.marketID.Hex(),
+			SubaccountId:        o.subaccountID.Hex(),
+			OrderHash:           o.orderHash.Hex(),
+			Cid:                 cid,
+			ExpirationTimestamp: o.expirationTimestamp,
+		})
+	}
+}
+
+func (k *Keeper) cancelExpiredSpotLimitOrder(ctx sdk.Context, o expiredOrder) (cid string, cancelled bool) {
+	market := k.GetSpotMarketByID(ctx, o.marketID)
+	order := k.GetSpotLimitOrderBySubaccountID(ctx, o.marketID, &o.isBuy, o.subaccountID, o.orderHash)
+	if market == nil || order == nil {
+		k.Logger(ctx).Error("expired spot limit order not found", "marketId", o.marketID.Hex(), "orderHash", o.orderHash.Hex())
+		return "", false
+	}
+
+	k.CancelSpotLimitOrder(ctx, market, o.marketID, o.subaccountID, o.isBuy, order)
+	return order.Cid(), true
+}
+
+func (k *Keeper) cancelExpiredDerivativeLimitOrder(ctx sdk.Context, o expiredOrder) (cid string, cancelled bool) {
+	market := k.GetDerivativeOrBinaryOptionsMarket(ctx, o.marketID, nil)
+	order := k.GetDerivativeLimitOrderBySubaccountIDAndHash(ctx, o.marketID, &o.isBuy, o.subaccountID, o.orderHash)
+	if market == nil || order == nil {
+		k.Logger(ctx).Error("expired derivative limit order not found", "marketId", o.marketID.Hex(), "orderHash", o.orderHash.Hex())
+		return "", false
+	}
+
+	if err := k.CancelRestingDerivativeLimitOrder(ctx, market, o.subaccountID, &o.isBuy, o.orderHash, true, true); err != nil {
+		k.Logger(ctx).Error("failed to cancel expired derivative limit order", "marketId", o.marketID.Hex(), "orderHash", o.orderHash.Hex(), "err", err)
+		return "", false
+	}
+
+	return order.Cid(), true
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Order Expiration", func() {
+	var (
+		testInput           testexchange.TestInput
+		app                 *simapp.InjectiveApp
+		ctx                 sdk.Context
+		msgServer           types.MsgServer
+		marketID            common.Hash
+		quoteDenom          string
+		subaccountID        common.Hash
+		expirationTimestamp int64
+	)
+
+	createOrder := func(price string, expiration int64) (*types.MsgCreateSpotLimitOrderResponse, error) {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, "1", types.OrderType_BUY_PO, subaccountID),
+		)
+		msgs[0].Order.OrderInfo.ExpirationTimestamp = expiration
+		return msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+	}
+
+	getRestingOrders := func() []*types.SpotLimitOrder {
+		return app.ExchangeKeeper.GetAllSpotLimitOrdersByMarketDirection(ctx, marketID, true)
+	}
+
+	getExpiredEvents := func(events []abci.Event) []*types.EventOrderExpired {
+		expired := make([]*types.EventOrderExpired, 0)
+		for _, event := range events {
+			parsed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			if e, ok := parsed.(*types.EventOrderExpired); ok {
+				expired = append(expired, e)
+			}
+		}
+		return expired
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		market := testInput.Spots[0]
+		marketID = market.MarketID
+		quoteDenom = market.QuoteDenom
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		subaccountID = testexchange.SampleNonDefaultSubaccountAddr1
+		expirationTimestamp = ctx.BlockTime().Add(time.Minute).Unix()
+
+		testexchange.MintAndDeposit(app, ctx, subaccountID.String(), sdk.NewCoins(sdk.NewCoin(quoteDenom, sdk.NewInt(100000))))
+	})
+
+	AfterEach(func() {
+		Expect(app.ExchangeKeeper.IsMetadataInvariantValid(ctx)).To(BeTrue())
+	})
+
+	It("rejects orders which are already expired", func() {
+		_, err := createOrder("100", ctx.BlockTime().Unix())
+		Expect(err).To(MatchError(types.ErrInvalidExpirationTimestamp))
+	})
+
+	Context("with resting orders that expire", func() {
+		var orderHashes []string
+
+		BeforeEach(func() {
+			orderHashes = make([]string, 0)
+			for _, price := range []string{"100", "101", "102"} {
+				resp, err := createOrder(price, expirationTimestamp)
+				testexchange.OrFail(err)
+				orderHashes = append(orderHashes, resp.OrderHash)
+			}
+
+			// an order without expiration is never cancelled
+			_, err := createOrder("99", 0)
+			testexchange.OrFail(err)
+
+			ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+			Expect(getRestingOrders()).To(HaveLen(4))
+		})
+
+		It("keeps the orders before the expiration timestamp", func() {
+			ctx = ctx.WithBlockTime(time.Unix(expirationTimestamp-1, 0))
+			ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+			Expect(getRestingOrders()).To(HaveLen(4))
+		})
+
+		It("cancels and refunds the orders expiring exactly at the block time", func() {
+			depositBefore := app.ExchangeKeeper.GetDeposit(ctx, subaccountID, quoteDenom)
+			ctx = ctx.WithBlockTime(time.Unix(expirationTimestamp, 0))
+
+			var events []abci.Event
+			ctx, events = testexchange.EndBlockerAndCommit(app, ctx)
+
+			Expect(getRestingOrders()).To(HaveLen(1))
+
+			// the margin hold of the three expired orders is released
+			depositAfter := app.ExchangeKeeper.GetDeposit(ctx, subaccountID, quoteDenom)
+			Expect(depositAfter.TotalBalance.String()).To(Equal(depositBefore.TotalBalance.String()))
+			Expect(depositAfter.AvailableBalance.Sub(depositBefore.AvailableBalance).GTE(sdk.NewDec(100 + 101 + 102))).To(BeTrue())
+
+			expiredHashes := make([]string, 0)
+			for _, e := range getExpiredEvents(events) {
+				Expect(e.MarketId).To(Equal(marketID.Hex()))
+				Expect(e.SubaccountId).To(Equal(subaccountID.Hex()))
+				Expect(e.ExpirationTimestamp).To(Equal(expirationTimestamp))
+				expiredHashes = append(expiredHashes, e.OrderHash)
+			}
+			Expect(expiredHashes).To(ConsistOf(orderHashes))
+		})
+
+		It("cancels at most the configured number of orders per block", func() {
+			params := app.ExchangeKeeper.GetParams(ctx)
+			params.MaxExpiredOrdersPerBlock = 2
+			app.ExchangeKeeper.SetParams(ctx, params)
+
+			ctx = ctx.WithBlockTime(time.Unix(expirationTimestamp, 0))
+
+			var events []abci.Event
+			ctx, events = testexchange.EndBlockerAndCommit(app, ctx)
+
+			Expect(getExpiredEvents(events)).To(HaveLen(2))
+			Expect(getRestingOrders()).To(HaveLen(2))
+
+			ctx = ctx.WithBlockTime(time.Unix(expirationTimestamp+1, 0))
+			ctx, events = testexchange.EndBlockerAndCommit(app, ctx)
+
+			Expect(getExpiredEvents(events)).To(HaveLen(1))
+			Expect(getRestingOrders()).To(HaveLen(1))
+		})
+	})
+})
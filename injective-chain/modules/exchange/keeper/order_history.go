@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetOrderHistory returns the lifecycle history of a limit order, or nil if the order has no history.
+func (k *Keeper) GetOrderHistory(ctx sdk.Context, orderHash common.Hash) *types.OrderHistory {
+	bz := k.getStore(ctx).Get(types.GetOrderHistoryKey(orderHash))
+	if bz == nil {
+		return nil
+	}
+
+	var history types.OrderHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return &history
+}
+
+// GetAllOrderHistories returns the lifecycle histories of all limit orders, ordered by order hash.
+func (k *Keeper) GetAllOrderHistories(ctx sdk.Context) []types.OrderHistory {
+	iterator := prefix.NewStore(k.getStore(ctx), types.OrderHistoryPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	histories := make([]types.OrderHistory, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.OrderHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// importOrderHistory stores the history of a limit order from genesis, indexing a terminated history for pruning.
+func (k *Keeper) importOrderHistory(ctx sdk.Context, history *types.OrderHistory) {
+	orderHash := common.HexToHash(history.OrderHash)
+	k.setOrderHistory(ctx, orderHash, history)
+
+	if history.TerminatedHeight > 0 {
+		k.getStore(ctx).Set(types.GetOrderHistoryPruningIndexKey(history.TerminatedHeight, orderHash), []byte{})
+	}
+}
+
+func (k *Keeper) setOrderHistory(ctx sdk.Context, orderHash common.Hash, history *types.OrderHistory) {
+	k.getStore(ctx).Set(types.GetOrderHistoryKey(orderHash), k.cdc.MustMarshal(history))
+}
+
+func newOrderHistoryEvent(ctx sdk.Context, eventType types.OrderHistoryEventType, price, quantity sdk.Dec) types.OrderHistoryEvent {
+	return types.OrderHistoryEvent{
+		Type:        eventType,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime().Unix(),
+		Price:       price,
+		Quantity:    quantity,
+	}
+}
+
+// recordOrderPlacement starts the history of a newly placed limit order, unless the order history is disabled.
+func (k *Keeper) recordOrderPlacement(ctx sdk.Context, marketID, subaccountID, orderHash common.Hash, isBuy bool, price, quantity sdk.Dec) {
+	if k.GetParams(ctx).OrderHistoryRetentionBlocks == 0 {
+		return
+	}
+
+	k.setOrderHistory(ctx, orderHash, &types.OrderHistory{
+		OrderHash:      orderHash.Hex(),
+		MarketId:       marketID.Hex(),
+		SubaccountId:   subaccountID.Hex(),
+		IsBuy:          isBuy,
+		Quantity:       quantity,
+		FilledQuantity: sdk.ZeroDec(),
+		Events:         []types.OrderHistoryEvent{newOrderHistoryEvent(ctx, types.OrderHistoryEventType_OrderHistoryPlaced, price, quantity)},
+	})
+}
+
+// recordOrderFill appends a fill to the history of a limit order, terminating the history once the order is fully
+// filled. Only the first MaxOrderHistoryFills partial fills are recorded individually, the next ones are counted.
+func (k *Keeper) recordOrderFill(ctx sdk.Context, orderHash common.Hash, price, quantity sdk.Dec) {
+	history := k.GetOrderHistory(ctx, orderHash)
+	if history == nil || history.TerminatedHeight > 0 {
+		return
+	}
+
+	history.FilledQuantity = history.FilledQuantity.Add(quantity)
+
+	if history.FilledQuantity.GTE(history.Quantity) {
+		history.Events = append(history.Events, newOrderHistoryEvent(ctx, types.OrderHistoryEventType_OrderHistoryFilled, price, quantity))
+		k.terminateOrderHistory(ctx, orderHash, history)
+		return
+	}
+
+	// the events of an open order are its placement and its fills
+	if len(history.Events)-1 < types.MaxOrderHistoryFills {
+		history.Events = append(history.Events, newOrderHistoryEvent(ctx, types.OrderHistoryEventType_OrderHistoryPartialFill, price, quantity))
+	} else {
+		history.OmittedFills++
+	}
+
+	k.setOrderHistory(ctx, orderHash, history)
+}
+
+// recordSpotOrderFills records the fills of a spot execution batch in the histories of the filled orders.
+func (k *Keeper) recordSpotOrderFills(ctx sdk.Context, batch *types.EventBatchSpotExecution) {
+	if batch == nil {
+		return
+	}
+
+	for _, trade := range batch.Trades {
+		k.recordOrderFill(ctx, common.BytesToHash(trade.OrderHash), trade.Price, trade.Quantity)
+	}
+}
+
+// recordDerivativeOrderFills records the fills of a derivative execution batch in the histories of the filled orders.
+func (k *Keeper) recordDerivativeOrderFills(ctx sdk.Context, batch *types.EventBatchDerivativeExecution) {
+	if batch == nil {
+		return
+	}
+
+	for _, trade := range batch.Trades {
+		if trade.PositionDelta == nil {
+			continue
+		}
+		k.recordOrderFill(ctx, common.BytesToHash(trade.OrderHash), trade.PositionDelta.ExecutionPrice, trade.PositionDelta.ExecutionQuantity)
+	}
+}
+
+// recordOrderTermination terminates the history of a cancelled or expired limit order with its unfilled quantity. As
+// an expiry cancels the order, it replaces the cancellation recorded in the same block.
+func (k *Keeper) recordOrderTermination(ctx sdk.Context, orderHash common.Hash, eventType types.OrderHistoryEventType) {
+	history := k.GetOrderHistory(ctx, orderHash)
+	if history == nil {
+		return
+	}
+
+	if history.TerminatedHeight > 0 {
+		lastEvent := &history.Events[len(history.Events)-1]
+		if eventType == types.OrderHistoryEventType_OrderHistoryExpired &&
+			lastEvent.Type == types.OrderHistoryEventType_OrderHistoryCancelled &&
+			history.TerminatedHeight == ctx.BlockHeight() {
+			lastEvent.Type = eventType
+			k.setOrderHistory(ctx, orderHash, history)
+		}
+		return
+	}
+
+	unfilledQuantity := history.Quantity.Sub(history.FilledQuantity)
+	history.Events = append(history.Events, newOrderHistoryEvent(ctx, eventType, sdk.ZeroDec(), unfilledQuantity))
+	k.terminateOrderHistory(ctx, orderHash, history)
+}
+
+// recordCancelledDerivativeOrder terminates the history of the limit order of a derivative cancellation event.
+func (k *Keeper) recordCancelledDerivativeOrder(ctx sdk.Context, event *types.EventCancelDerivativeOrder) {
+	if event.LimitOrder == nil {
+		return
+	}
+
+	k.recordOrderTermination(ctx, event.LimitOrder.Hash(), types.OrderHistoryEventType_OrderHistoryCancelled)
+}
+
+func (k *Keeper) terminateOrderHistory(ctx sdk.Context, orderHash common.Hash, history *types.OrderHistory) {
+	history.TerminatedHeight = ctx.BlockHeight()
+	k.setOrderHistory(ctx, orderHash, history)
+	k.getStore(ctx).Set(types.GetOrderHistoryPruningIndexKey(history.TerminatedHeight, orderHash), []byte{})
+}
+
+// PruneOrderHistories deletes the histories of the limit orders which terminated at least OrderHistoryRetentionBlocks
+// blocks ago. With the order history disabled, the histories are deleted as soon as the orders terminate.
+func (k *Keeper) PruneOrderHistories(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	lastPrunedHeight := ctx.BlockHeight() - k.GetParams(ctx).OrderHistoryRetentionBlocks
+	if lastPrunedHeight < 0 {
+		return
+	}
+
+	store := k.getStore(ctx)
+	indexStore := prefix.NewStore(store, types.OrderHistoryPruningIndexPrefix)
+	iterator := indexStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(lastPrunedHeight+1)))
+
+	keysToDelete := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keysToDelete {
+		orderHash := common.BytesToHash(key[8:])
+		store.Delete(types.GetOrderHistoryKey(orderHash))
+		indexStore.Delete(key)
+	}
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Order History", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		marketID  common.Hash
+		buyer     common.Hash
+		seller    common.Hash
+	)
+
+	createOrder := func(price, quantity string, orderType types.OrderType, subaccountID common.Hash, expiration int64) common.Hash {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, quantity, orderType, subaccountID),
+		)
+		msgs[0].Order.OrderInfo.ExpirationTimestamp = expiration
+		resp, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		testexchange.OrFail(err)
+		return common.HexToHash(resp.OrderHash)
+	}
+
+	queryHistory := func(orderHash common.Hash) (*types.OrderHistory, error) {
+		res, err := app.ExchangeKeeper.OrderHistory(sdk.WrapSDKContext(ctx), &types.QueryOrderHistoryRequest{
+			OrderHash: orderHash.Hex(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &res.History, nil
+	}
+
+	eventTypes := func(history *types.OrderHistory) []types.OrderHistoryEventType {
+		eventTypes := make([]types.OrderHistoryEventType, 0, len(history.Events))
+		for _, event := range history.Events {
+			eventTypes = append(eventTypes, event.Type)
+		}
+		return eventTypes
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		market := testInput.Spots[0]
+		marketID = market.MarketID
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.OrderHistoryRetentionBlocks = 5
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		buyer = testexchange.SampleSubaccountAddr1
+		seller = testexchange.SampleSubaccountAddr2
+
+		testexchange.MintAndDeposit(app, ctx, buyer.String(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000))))
+		testexchange.MintAndDeposit(app, ctx, seller.String(), sdk.NewCoins(sdk.NewCoin(market.BaseDenom, sdk.NewInt(100000))))
+	})
+
+	It("records the placement, the partial fills and the cancellation of an order", func() {
+		placementHeight := ctx.BlockHeight()
+		buyOrderHash := createOrder("100", "3", types.OrderType_BUY, buyer, 0)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		fillHeight := ctx.BlockHeight()
+		sellOrderHash := createOrder("100", "1", types.OrderType_SELL, seller, 0)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		history, err := queryHistory(buyOrderHash)
+		Expect(err).To(BeNil())
+		Expect(history.MarketId).To(Equal(marketID.Hex()))
+		Expect(history.SubaccountId).To(Equal(buyer.Hex()))
+		Expect(history.IsBuy).To(BeTrue())
+		Expect(history.FilledQuantity.String()).To(Equal(sdk.NewDec(1).String()))
+		Expect(history.TerminatedHeight).To(BeZero())
+		Expect(eventTypes(history)).To(Equal([]types.OrderHistoryEventType{
+			types.OrderHistoryEventType_OrderHistoryPlaced,
+			types.OrderHistoryEventType_OrderHistoryPartialFill,
+		}))
+		Expect(history.Events[0].BlockHeight).To(Equal(placementHeight))
+		Expect(history.Events[0].Quantity.String()).To(Equal(sdk.NewDec(3).String()))
+		Expect(history.Events[1].BlockHeight).To(Equal(fillHeight))
+		Expect(history.Events[1].Price.String()).To(Equal(sdk.NewDec(100).String()))
+		Expect(history.Events[1].Quantity.String()).To(Equal(sdk.NewDec(1).String()))
+
+		// the sell order was fully filled on placement
+		sellHistory, err := queryHistory(sellOrderHash)
+		Expect(err).To(BeNil())
+		Expect(eventTypes(sellHistory)).To(Equal([]types.OrderHistoryEventType{
+			types.OrderHistoryEventType_OrderHistoryPlaced,
+			types.OrderHistoryEventType_OrderHistoryFilled,
+		}))
+		Expect(sellHistory.TerminatedHeight).To(Equal(fillHeight))
+
+		cancelHeight := ctx.BlockHeight()
+		_, err = msgServer.CancelSpotOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelSpotOrder{
+			Sender:       types.SubaccountIDToSdkAddress(buyer).String(),
+			MarketId:     marketID.Hex(),
+			SubaccountId: buyer.Hex(),
+			OrderHash:    buyOrderHash.Hex(),
+		})
+		Expect(err).To(BeNil())
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		history, err = queryHistory(buyOrderHash)
+		Expect(err).To(BeNil())
+		Expect(eventTypes(history)).To(Equal([]types.OrderHistoryEventType{
+			types.OrderHistoryEventType_OrderHistoryPlaced,
+			types.OrderHistoryEventType_OrderHistoryPartialFill,
+			types.OrderHistoryEventType_OrderHistoryCancelled,
+		}))
+		Expect(history.TerminatedHeight).To(Equal(cancelHeight))
+		Expect(history.Events[2].Quantity.String()).To(Equal(sdk.NewDec(2).String()))
+	})
+
+	It("records the expiration of an order", func() {
+		expiration := ctx.BlockTime().Add(time.Minute).Unix()
+		orderHash := createOrder("100", "1", types.OrderType_BUY_PO, buyer, expiration)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		ctx = ctx.WithBlockTime(time.Unix(expiration, 0))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		history, err := queryHistory(orderHash)
+		Expect(err).To(BeNil())
+		Expect(eventTypes(history)).To(Equal([]types.OrderHistoryEventType{
+			types.OrderHistoryEventType_OrderHistoryPlaced,
+			types.OrderHistoryEventType_OrderHistoryExpired,
+		}))
+	})
+
+	It("deletes the history once past the retention", func() {
+		orderHash := createOrder("100", "1", types.OrderType_BUY_PO, buyer, 0)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		_, err := msgServer.CancelSpotOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelSpotOrder{
+			Sender:       types.SubaccountIDToSdkAddress(buyer).String(),
+			MarketId:     marketID.Hex(),
+			SubaccountId: buyer.Hex(),
+			OrderHash:    orderHash.Hex(),
+		})
+		Expect(err).To(BeNil())
+
+		for i := 0; i < 5; i++ {
+			ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+			_, err = queryHistory(orderHash)
+			Expect(err).To(BeNil())
+		}
+
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		_, err = queryHistory(orderHash)
+		Expect(err).To(MatchError(types.ErrOrderDoesntExist))
+	})
+
+	It("exports and imports the histories in genesis", func() {
+		buyOrderHash := createOrder("100", "3", types.OrderType_BUY, buyer, 0)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		fillHeight := ctx.BlockHeight()
+		sellOrderHash := createOrder("100", "1", types.OrderType_SELL, seller, 0)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		Expect(state.OrderHistories).To(HaveLen(2))
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+		app.ExchangeKeeper.InitGenesis(ctx, *state)
+
+		Expect(app.ExchangeKeeper.GetAllOrderHistories(ctx)).To(Equal(state.OrderHistories))
+
+		// the imported history of the filled order is still pruned after the retention
+		ctx = ctx.WithBlockHeight(fillHeight + 5)
+		app.ExchangeKeeper.PruneOrderHistories(ctx)
+		Expect(app.ExchangeKeeper.GetOrderHistory(ctx, sellOrderHash)).To(BeNil())
+		Expect(app.ExchangeKeeper.GetOrderHistory(ctx, buyOrderHash)).ToNot(BeNil())
+	})
+
+	It("doesn't record the orders with the order history disabled", func() {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.OrderHistoryRetentionBlocks = 0
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		orderHash := createOrder("100", "1", types.OrderType_BUY_PO, buyer, 0)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		_, err := queryHistory(orderHash)
+		Expect(err).To(MatchError(types.ErrOrderDoesntExist))
+	})
+})
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Order rejection error codes", func() {
+	var (
+		testInput    testexchange.TestInput
+		app          *simapp.InjectiveApp
+		ctx          sdk.Context
+		msgServer    types.MsgServer
+		marketID     common.Hash
+		subaccountID common.Hash
+	)
+
+	createOrder := func(price, quantity string) error {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, quantity, types.OrderType_BUY, subaccountID),
+		)
+		_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		return err
+	}
+
+	expectRejection := func(err error, expectedErr *errors.Error, expectedCode uint32) {
+		Expect(err).To(HaveOccurred())
+		Expect(errors.IsOf(err, expectedErr)).To(BeTrue(), "unexpected error: %v", err)
+
+		codespace, code, _ := errors.ABCIInfo(err, false)
+		Expect(codespace).To(Equal(types.ModuleName))
+		Expect(code).To(Equal(expectedCode))
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		market := testInput.Spots[0]
+		marketID = market.MarketID
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		subaccountID = testexchange.SampleNonDefaultSubaccountAddr1
+
+		testexchange.MintAndDeposit(app, ctx, subaccountID.String(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000))))
+	})
+
+	It("accepts a valid order", func() {
+		Expect(createOrder("10", "1")).To(Succeed())
+	})
+
+	It("rejects a price that is not aligned to the price tick size", func() {
+		expectRejection(createOrder("10.00001", "1"), types.ErrPriceTickSizeMisaligned, 103)
+	})
+
+	It("rejects a quantity that is not aligned to the quantity tick size", func() {
+		expectRejection(createOrder("10", "1.00001"), types.ErrQuantityTickSizeMisaligned, 104)
+	})
+
+	It("rejects an order in a paused market", func() {
+		_, err := app.ExchangeKeeper.SetSpotMarketStatus(ctx, marketID, types.MarketStatus_Paused)
+		testexchange.OrFail(err)
+
+		expectRejection(createOrder("10", "1"), types.ErrMarketPaused, 106)
+	})
+
+	It("rejects an order in a market that doesn't exist", func() {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString("10", "1", types.OrderType_BUY, subaccountID),
+		)
+		msgs[0].Order.MarketId = common.HexToHash("0xdeadbeef").Hex()
+
+		_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		expectRejection(err, types.ErrSpotMarketNotFound, 2)
+	})
+
+	It("rejects an order exceeding the available deposits", func() {
+		expectRejection(createOrder("100000", "10"), types.ErrInsufficientDeposit, 6)
+	})
+})
@@ -40,6 +40,13 @@ func (k *Keeper) ensureValidDerivativeOrder(
 		return orderHash, types.ErrClientOrderIdAlreadyExists
 	}
 
+	// reject limit orders which would already be expired
+	if !isMarketOrder {
+		if err := k.ensureValidOrderExpiration(ctx, &derivativeOrder.OrderInfo); err != nil {
+			return orderHash, err
+		}
+	}
+
 	doesOrderCrossTopOfBook := k.DerivativeOrderCrossesTopOfBook(ctx, derivativeOrder)
 
 	isPostOnlyMode := k.IsPostOnlyMode(ctx)
@@ -94,6 +101,20 @@ func (k *Keeper) ensureValidDerivativeOrder(
 		return orderHash, types.ErrExceedsOrderSideCount
 	}
 
+	// limit the number of open limit orders of the subaccount across all markets
+	if !derivativeOrder.IsConditional() && !isMarketOrder {
+		if err := k.EnsureSubaccountOpenOrderCapacity(ctx, subaccountID); err != nil {
+			return orderHash, err
+		}
+	}
+
+	// limit the number of conditional orders of the subaccount across all markets
+	if derivativeOrder.IsConditional() {
+		if err := k.EnsureSubaccountConditionalOrderCapacity(ctx, subaccountID); err != nil {
+			return orderHash, err
+		}
+	}
+
 	// also limit conditional market orders: 1 per subaccount per market per side
 	if derivativeOrder.IsConditional() && isMarketOrder {
 		isHigher := derivativeOrder.TriggerPrice.GT(markPrice)
@@ -172,6 +193,10 @@ func (k *Keeper) ensureValidDerivativeOrder(
 			return orderHash, err
 		}
 
+		if err := k.EnsureCrossMarginRequirement(ctx, subaccountID, market.GetQuoteDenom(), marginHold); err != nil {
+			return orderHash, err
+		}
+
 		// Decrement the available balance by the funds amount needed to fund the order
 		if err := k.chargeAccount(ctx, subaccountID, market.GetQuoteDenom(), marginHold); err != nil {
 			return orderHash, err
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// OrderbookCSVHeader is the header row written by ExportOrderbookCSV.
+var OrderbookCSVHeader = []string{"order_hash", "subaccount_id", "side", "price", "quantity", "fillable", "fill_status"}
+
+const (
+	orderFillStatusUnfilled        = "unfilled"
+	orderFillStatusPartiallyFilled = "partially_filled"
+)
+
+// ExportOrderbookCSV writes every resting limit order of a spot, derivative or binary options market as a CSV row
+// to w, after the OrderbookCSVHeader row. The buy orders come first, then the sell orders, each side sorted from the
+// best price with ties broken by order hash, as they are stored. The rows are streamed to w while iterating over the
+// store, so the book is never held in memory.
+func (k *Keeper) ExportOrderbookCSV(ctx sdk.Context, marketID common.Hash, w io.Writer) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	isSpot := k.GetSpotMarketByID(ctx, marketID) != nil
+	if !isSpot && k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil) == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrMarketInvalid.Wrapf("market %s not found", marketID.Hex())
+	}
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(OrderbookCSVHeader); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	var err error
+	writeOrder := func(orderHash, subaccountID common.Hash, isBuy bool, price, quantity, fillable sdk.Dec) (stop bool) {
+		err = csvWriter.Write(orderbookCSVRow(orderHash, subaccountID, isBuy, price, quantity, fillable))
+		return err != nil
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		if isSpot {
+			k.IterateSpotLimitOrdersByMarketDirection(ctx, marketID, isBuy, func(order *types.SpotLimitOrder) (stop bool) {
+				return writeOrder(order.Hash(), order.SubaccountID(), order.IsBuy(), order.OrderInfo.Price, order.OrderInfo.Quantity, order.Fillable)
+			})
+		} else {
+			k.IterateDerivativeLimitOrdersByMarketDirection(ctx, marketID, isBuy, func(order *types.DerivativeLimitOrder) (stop bool) {
+				return writeOrder(order.Hash(), order.SubaccountID(), order.IsBuy(), order.OrderInfo.Price, order.OrderInfo.Quantity, order.Fillable)
+			})
+		}
+
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	return nil
+}
+
+func orderbookCSVRow(orderHash, subaccountID common.Hash, isBuy bool, price, quantity, fillable sdk.Dec) []string {
+	side := "sell"
+	if isBuy {
+		side = "buy"
+	}
+
+	fillStatus := orderFillStatusPartiallyFilled
+	if fillable.Equal(quantity) {
+		fillStatus = orderFillStatusUnfilled
+	}
+
+	return []string{
+		orderHash.Hex(),
+		subaccountID.Hex(),
+		side,
+		price.String(),
+		quantity.String(),
+		fillable.String(),
+		fillStatus,
+	}
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Orderbook CSV export", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		market    testexchange.SpotMarket
+		buyer     common.Hash
+		seller    common.Hash
+	)
+
+	createOrder := func(price, quantity string, orderType types.OrderType, subaccountID common.Hash) string {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, quantity, orderType, subaccountID),
+		)
+		resp, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		testexchange.OrFail(err)
+		return resp.OrderHash
+	}
+
+	row := func(orderHash string, subaccountID common.Hash, side, price, quantity, fillable, fillStatus string) []string {
+		return []string{
+			orderHash,
+			subaccountID.Hex(),
+			side,
+			sdk.MustNewDecFromStr(price).String(),
+			sdk.MustNewDecFromStr(quantity).String(),
+			sdk.MustNewDecFromStr(fillable).String(),
+			fillStatus,
+		}
+	}
+
+	exportRows := func(marketID common.Hash) ([][]string, error) {
+		var buf bytes.Buffer
+		if err := app.ExchangeKeeper.ExportOrderbookCSV(ctx, marketID, &buf); err != nil {
+			return nil, err
+		}
+		return csv.NewReader(&buf).ReadAll()
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+		market = testInput.Spots[0]
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		buyer = testexchange.SampleSubaccountAddr1
+		seller = testexchange.SampleSubaccountAddr2
+
+		testexchange.MintAndDeposit(app, ctx, buyer.String(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000))))
+		testexchange.MintAndDeposit(app, ctx, seller.String(), sdk.NewCoins(sdk.NewCoin(market.BaseDenom, sdk.NewInt(100))))
+	})
+
+	It("writes the resting orders by side then price", func() {
+		buy100 := createOrder("100", "1", types.OrderType_BUY, buyer)
+		buy101 := createOrder("101", "2", types.OrderType_BUY, buyer)
+		sell111 := createOrder("111", "1", types.OrderType_SELL, seller)
+		sell110 := createOrder("110", "1", types.OrderType_SELL, seller)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		// partially fill the best buy order
+		createOrder("101", "1", types.OrderType_SELL, seller)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		rows, err := exportRows(market.MarketID)
+		Expect(err).To(BeNil())
+		Expect(rows).To(Equal([][]string{
+			keeper.OrderbookCSVHeader,
+			row(buy101, buyer, "buy", "101", "2", "1", "partially_filled"),
+			row(buy100, buyer, "buy", "100", "1", "1", "unfilled"),
+			row(sell110, seller, "sell", "110", "1", "1", "unfilled"),
+			row(sell111, seller, "sell", "111", "1", "1", "unfilled"),
+		}))
+	})
+
+	It("writes only the header for an empty book", func() {
+		rows, err := exportRows(market.MarketID)
+		Expect(err).To(BeNil())
+		Expect(rows).To(Equal([][]string{keeper.OrderbookCSVHeader}))
+	})
+
+	It("rejects an unknown market", func() {
+		_, err := exportRows(common.HexToHash("0x1"))
+		Expect(err).To(MatchError(types.ErrMarketInvalid))
+	})
+})
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Orderbook Snapshot", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		marketID  common.Hash
+		buyer     = testexchange.SampleSubaccountAddr1
+		seller    = testexchange.SampleSubaccountAddr2
+	)
+
+	createOrder := func(price, quantity string, orderType types.OrderType, subaccountID common.Hash) {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, quantity, orderType, subaccountID),
+		)
+		_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		testexchange.OrFail(err)
+	}
+
+	snapshot := func(depth uint64) *types.QueryOrderbookSnapshotResponse {
+		res, err := app.ExchangeKeeper.OrderbookSnapshot(sdk.WrapSDKContext(ctx), &types.QueryOrderbookSnapshotRequest{
+			MarketId: marketID.Hex(),
+			Depth:    depth,
+		})
+		testexchange.OrFail(err)
+		return res
+	}
+
+	expectLevels := func(levels []*types.Level, expected ...string) {
+		actual := make([]string, 0, len(levels))
+		for _, level := range levels {
+			actual = append(actual, level.P.String()+"@"+level.Q.String())
+		}
+
+		expectedLevels := make([]string, 0, len(expected)/2)
+		for i := 0; i < len(expected); i += 2 {
+			expectedLevels = append(expectedLevels, sdk.MustNewDecFromStr(expected[i]).String()+"@"+sdk.MustNewDecFromStr(expected[i+1]).String())
+		}
+
+		Expect(actual).To(Equal(expectedLevels))
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		market := testInput.Spots[0]
+		marketID = market.MarketID
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		testexchange.MintAndDeposit(app, ctx, buyer.String(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000))))
+		testexchange.MintAndDeposit(app, ctx, seller.String(), sdk.NewCoins(sdk.NewCoin(market.BaseDenom, sdk.NewInt(100000))))
+
+		createOrder("100", "1", types.OrderType_BUY_PO, buyer)
+		createOrder("100", "2", types.OrderType_BUY_PO, buyer)
+		createOrder("101", "1", types.OrderType_BUY_PO, buyer)
+		createOrder("99", "4", types.OrderType_BUY_PO, buyer)
+		createOrder("105", "1", types.OrderType_SELL_PO, seller)
+		createOrder("105", "1.5", types.OrderType_SELL_PO, seller)
+		createOrder("106", "3", types.OrderType_SELL_PO, seller)
+
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+	})
+
+	It("merges orders at the same price into one level", func() {
+		res := snapshot(0)
+
+		expectLevels(res.BuysPriceLevel, "101", "1", "100", "3", "99", "4")
+		expectLevels(res.SellsPriceLevel, "105", "2.5", "106", "3")
+	})
+
+	It("truncates each side to the requested depth", func() {
+		res := snapshot(2)
+
+		expectLevels(res.BuysPriceLevel, "101", "1", "100", "3")
+		expectLevels(res.SellsPriceLevel, "105", "2.5", "106", "3")
+
+		res = snapshot(1)
+
+		expectLevels(res.BuysPriceLevel, "101", "1")
+		expectLevels(res.SellsPriceLevel, "105", "2.5")
+	})
+
+	It("clamps a depth above the maximum instead of failing", func() {
+		res := snapshot(types.MaxOrderbookSnapshotDepth + 1)
+
+		expectLevels(res.BuysPriceLevel, "101", "1", "100", "3", "99", "4")
+	})
+
+	It("rejects an unknown market", func() {
+		_, err := app.ExchangeKeeper.OrderbookSnapshot(sdk.WrapSDKContext(ctx), &types.QueryOrderbookSnapshotRequest{
+			MarketId: common.HexToHash("0x1").Hex(),
+		})
+		Expect(err).To(MatchError(types.ErrMarketInvalid))
+	})
+})
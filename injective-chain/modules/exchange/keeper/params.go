@@ -160,6 +160,39 @@ func (k *Keeper) GetIsInstantDerivativeMarketLaunchEnabled(ctx sdk.Context) bool
 	return k.GetParams(ctx).IsInstantDerivativeMarketLaunchEnabled
 }
 
+// GetIsAutoDeleveragingEnabled returns if auto-deleveraging of underwater positions is enabled
+func (k *Keeper) GetIsAutoDeleveragingEnabled(ctx sdk.Context) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.GetParams(ctx).IsAutoDeleveragingEnabled
+}
+
+// GetMarketInactivityDelistBlocks returns the number of idle blocks after which markets are auto-delisted
+func (k *Keeper) GetMarketInactivityDelistBlocks(ctx sdk.Context) int64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.GetParams(ctx).MarketInactivityDelistBlocks
+}
+
+// GetMakerRebateEpochDuration returns the duration in seconds of the maker rebate epochs
+func (k *Keeper) GetMakerRebateEpochDuration(ctx sdk.Context) int64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.GetParams(ctx).MakerRebateEpochDuration
+}
+
+// GetMaxMarketOrderSlippageRatio returns the maximum slippage ratio a derivative market order may set for itself, zero
+// when there is no maximum
+func (k *Keeper) GetMaxMarketOrderSlippageRatio(ctx sdk.Context) sdk.Dec {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	maxSlippageRatio := k.GetParams(ctx).MaxMarketOrderSlippageRatio
+	if maxSlippageRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return maxSlippageRatio
+}
+
 // GetParams returns the total set of exchange parameters.
 func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
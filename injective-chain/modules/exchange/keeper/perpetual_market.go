@@ -47,6 +47,11 @@ func (k *Keeper) PerpetualMarketLaunch(
 		}
 	}
 
+	if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
 	_, err := k.GetDerivativeMarketPrice(ctx, oracleBase, oracleQuote, oracleScaleFactor, oracleType)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetOpenInterest returns the open interest of a derivative market, i.e. the total quantity of its long positions.
+// Markets whose open interest isn't stored yet have it computed from their positions.
+func (k *Keeper) GetOpenInterest(ctx sdk.Context, marketID common.Hash) sdk.Dec {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetMarketOpenInterestKey(marketID))
+	if bz == nil {
+		return k.computeOpenInterest(ctx, marketID)
+	}
+
+	return types.DecBytesToDec(bz)
+}
+
+func (k *Keeper) setOpenInterest(ctx sdk.Context, marketID common.Hash, openInterest sdk.Dec) {
+	k.getStore(ctx).Set(types.GetMarketOpenInterestKey(marketID), types.DecToDecBytes(openInterest))
+}
+
+func (k *Keeper) computeOpenInterest(ctx sdk.Context, marketID common.Hash) sdk.Dec {
+	openInterest := sdk.ZeroDec()
+	k.IteratePositionsByMarket(ctx, marketID, func(position *types.Position, _ []byte) (stop bool) {
+		openInterest = openInterest.Add(longQuantity(position))
+		return false
+	})
+
+	return openInterest
+}
+
+// updateOpenInterest updates the open interest of a market with the change of a position, before the position is
+// written to the store.
+func (k *Keeper) updateOpenInterest(ctx sdk.Context, marketID, subaccountID common.Hash, position *types.Position) {
+	previousPosition := k.GetPosition(ctx, marketID, subaccountID)
+
+	delta := longQuantity(position).Sub(longQuantity(previousPosition))
+	if delta.IsZero() {
+		return
+	}
+
+	openInterest := k.GetOpenInterest(ctx, marketID).Add(delta)
+	if openInterest.IsNegative() {
+		openInterest = sdk.ZeroDec()
+	}
+
+	k.setOpenInterest(ctx, marketID, openInterest)
+}
+
+func longQuantity(position *types.Position) sdk.Dec {
+	if position == nil || !position.IsLong || position.Quantity.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return position.Quantity
+}
+
+// ensurePositionCapsNotExceeded rejects an order which, if fully filled, would push the open interest of the market
+// or the position of the subaccount beyond the caps of the market. The resting vanilla orders of the subaccount on the
+// same side are assumed to be filled too. Orders which only reduce the position are always accepted, even when the
+// position is beyond a cap that was lowered after it was opened. Conditional orders are checked once triggered.
+func (k *Keeper) ensurePositionCapsNotExceeded(
+	ctx sdk.Context,
+	market DerivativeMarketI,
+	order *types.DerivativeOrder,
+	metadata *types.SubaccountOrderbookMetadata,
+) error {
+	derivativeMarket, ok := market.(*types.DerivativeMarket)
+	if !ok || order.IsConditional() {
+		return nil
+	}
+
+	maxOpenInterest := derivativeMarket.GetMaxOpenInterest()
+	maxPositionSize := derivativeMarket.GetMaxPositionSize()
+	if maxOpenInterest.IsZero() && maxPositionSize.IsZero() {
+		return nil
+	}
+
+	position := k.GetPosition(ctx, derivativeMarket.MarketID(), order.SubaccountID())
+
+	quantity := order.GetQuantity()
+	increasedQuantity := quantity
+
+	isReducingPosition := position != nil && position.Quantity.IsPositive() && position.IsLong != order.IsBuy()
+	if isReducingPosition {
+		increasedQuantity = sdk.MaxDec(quantity.Sub(position.Quantity), sdk.ZeroDec())
+	}
+
+	if order.IsReduceOnly() || increasedQuantity.IsZero() {
+		return nil
+	}
+
+	if maxPositionSize.IsPositive() {
+		positionSize := quantity
+		if metadata != nil && !metadata.AggregateVanillaQuantity.IsNil() {
+			positionSize = positionSize.Add(metadata.AggregateVanillaQuantity)
+		}
+
+		if position != nil && position.Quantity.IsPositive() {
+			if isReducingPosition {
+				positionSize = positionSize.Sub(position.Quantity)
+			} else {
+				positionSize = positionSize.Add(position.Quantity)
+			}
+		}
+
+		if positionSize.GT(maxPositionSize) {
+			metrics.ReportFuncError(k.svcTags)
+			return types.ErrPositionSizeCapExceeded.Wrapf("position of %s would exceed the cap of %s", positionSize.String(), maxPositionSize.String())
+		}
+	}
+
+	if maxOpenInterest.IsPositive() {
+		openInterest := k.GetOpenInterest(ctx, derivativeMarket.MarketID()).Add(increasedQuantity)
+		if openInterest.GT(maxOpenInterest) {
+			metrics.ReportFuncError(k.svcTags)
+			return types.ErrOpenInterestCapExceeded.Wrapf("open interest of %s would exceed the cap of %s", openInterest.String(), maxOpenInterest.String())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Derivative market position caps", func() {
+	var (
+		testInput          testexchange.TestInput
+		app                *simapp.InjectiveApp
+		ctx                sdk.Context
+		msgServer          types.MsgServer
+		marketID           common.Hash
+		subaccountIdBuyer  = testexchange.SampleSubaccountAddr1
+		subaccountIdSeller = testexchange.SampleSubaccountAddr2
+		senderBuyer        = types.SubaccountIDToSdkAddress(subaccountIdBuyer)
+		startingPrice      = sdk.NewDec(2000)
+	)
+
+	setCaps := func(maxOpenInterest, maxPositionSize int64) {
+		market := app.ExchangeKeeper.GetDerivativeMarketByID(ctx, marketID)
+		market.MaxOpenInterest = sdk.NewDec(maxOpenInterest)
+		market.MaxPositionSize = sdk.NewDec(maxPositionSize)
+		app.ExchangeKeeper.SetDerivativeMarket(ctx, market)
+	}
+
+	openLongPosition := func(subaccountID common.Hash, quantity int64) {
+		app.ExchangeKeeper.SetPosition(ctx, marketID, subaccountID, &types.Position{
+			IsLong:                 true,
+			Quantity:               sdk.NewDec(quantity),
+			EntryPrice:             startingPrice,
+			Margin:                 startingPrice.MulInt64(quantity),
+			CumulativeFundingEntry: sdk.ZeroDec(),
+		})
+	}
+
+	createOrder := func(subaccountID common.Hash, price sdk.Dec, quantity int64, orderType types.OrderType) error {
+		_, err := msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCreateDerivativeLimitOrder{
+			Sender: types.SubaccountIDToSdkAddress(subaccountID).String(),
+			Order: types.DerivativeOrder{
+				MarketId: marketID.Hex(),
+				OrderInfo: types.OrderInfo{
+					SubaccountId: subaccountID.Hex(),
+					FeeRecipient: "inj1dzqd00lfd4y4qy2pxa0dsdwzfnmsu27hgttswz",
+					Price:        price,
+					Quantity:     sdk.NewDec(quantity),
+				},
+				OrderType: orderType,
+				Margin:    price.MulInt64(quantity),
+			},
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		oracleBase, oracleQuote, oracleType := testInput.Perps[0].OracleBase, testInput.Perps[0].OracleQuote, testInput.Perps[0].OracleType
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, oracleBase, oracleQuote, oracletypes.NewPriceState(startingPrice, ctx.BlockTime().Unix()))
+		coin := sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.OneInt())
+		app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin))
+		app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderBuyer, sdk.NewCoins(coin))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, senderBuyer, coin, testInput.Perps[0].Ticker, testInput.Perps[0].QuoteDenom, oracleBase, oracleQuote, oracleType, -1))
+
+		market, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			testInput.Perps[0].Ticker,
+			testInput.Perps[0].QuoteDenom,
+			oracleBase,
+			oracleQuote,
+			0,
+			oracleType,
+			testInput.Perps[0].InitialMarginRatio,
+			testInput.Perps[0].MaintenanceMarginRatio,
+			testInput.Perps[0].MakerFeeRate,
+			testInput.Perps[0].TakerFeeRate,
+			testInput.Perps[0].MinPriceTickSize,
+			testInput.Perps[0].MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+		marketID = market.MarketID()
+
+		depositAmount := sdk.NewCoins(sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.NewInt(100000)))
+		testexchange.MintAndDeposit(app, ctx, subaccountIdBuyer.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, subaccountIdSeller.String(), depositAmount)
+	})
+
+	It("tracks the open interest of the market", func() {
+		openLongPosition(subaccountIdBuyer, 3)
+		openLongPosition(subaccountIdSeller, 4)
+		Expect(app.ExchangeKeeper.GetOpenInterest(ctx, marketID).String()).To(Equal(sdk.NewDec(7).String()))
+
+		openLongPosition(subaccountIdSeller, 1)
+		Expect(app.ExchangeKeeper.GetOpenInterest(ctx, marketID).String()).To(Equal(sdk.NewDec(4).String()))
+	})
+
+	It("rejects orders exceeding the position size cap", func() {
+		setCaps(0, 5)
+		openLongPosition(subaccountIdBuyer, 3)
+
+		err := createOrder(subaccountIdBuyer, sdk.NewDec(1900), 3, types.OrderType_BUY)
+		Expect(err).To(MatchError(types.ErrPositionSizeCapExceeded))
+
+		testexchange.OrFail(createOrder(subaccountIdBuyer, sdk.NewDec(1900), 2, types.OrderType_BUY))
+
+		// the resting order counts towards the position size
+		err = createOrder(subaccountIdBuyer, sdk.NewDec(1900), 1, types.OrderType_BUY)
+		Expect(err).To(MatchError(types.ErrPositionSizeCapExceeded))
+	})
+
+	It("rejects orders exceeding the open interest cap", func() {
+		setCaps(10, 0)
+		openLongPosition(subaccountIdBuyer, 8)
+
+		err := createOrder(subaccountIdSeller, sdk.NewDec(1900), 3, types.OrderType_BUY)
+		Expect(err).To(MatchError(types.ErrOpenInterestCapExceeded))
+
+		testexchange.OrFail(createOrder(subaccountIdSeller, sdk.NewDec(1900), 2, types.OrderType_BUY))
+	})
+
+	It("accepts position reducing orders when over the caps", func() {
+		openLongPosition(subaccountIdSeller, 5)
+		setCaps(2, 2)
+
+		testexchange.OrFail(createOrder(subaccountIdSeller, sdk.NewDec(2100), 3, types.OrderType_SELL))
+
+		err := createOrder(subaccountIdSeller, sdk.NewDec(1900), 1, types.OrderType_BUY)
+		Expect(err).To(MatchError(types.ErrPositionSizeCapExceeded))
+	})
+})
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// TransferPosition moves the quantity of the position of the source subaccount to the destination subaccount, together
+// with the same share of its margin, so that the total quantity and margin of the two positions are unchanged. Both
+// positions are first settled for funding. The transferred quantity is merged into a destination position in the same
+// direction at the weighted average entry price, while a destination position in the opposite direction is rejected,
+// since netting it would realize a PnL. The transfer is also rejected when it leaves less position than the resting
+// reduce-only orders of the source subaccount, exceeds the position size cap of the market, or leaves either position
+// liquidatable. Nothing is written when the transfer fails.
+func (k *Keeper) TransferPosition(ctx sdk.Context, marketID, sourceSubaccountID, destinationSubaccountID common.Hash, quantity sdk.Dec) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	margin, err := k.transferPosition(cacheCtx, marketID, sourceSubaccountID, destinationSubaccountID, quantity)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	writeCache()
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventPositionTransfer{
+		MarketId:        marketID.Hex(),
+		SrcSubaccountId: sourceSubaccountID.Hex(),
+		DstSubaccountId: destinationSubaccountID.Hex(),
+		Quantity:        quantity,
+		Margin:          margin,
+	})
+
+	return nil
+}
+
+func (k *Keeper) transferPosition(ctx sdk.Context, marketID, sourceSubaccountID, destinationSubaccountID common.Hash, quantity sdk.Dec) (sdk.Dec, error) {
+	market, markPrice := k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true)
+	if market == nil || markPrice.IsNil() {
+		return sdk.Dec{}, types.ErrDerivativeMarketNotFound.Wrapf("active derivative market for marketID %s not found", marketID.Hex())
+	}
+
+	sourcePosition := k.GetPosition(ctx, marketID, sourceSubaccountID)
+	if sourcePosition == nil || !sourcePosition.Quantity.IsPositive() {
+		return sdk.Dec{}, types.ErrPositionNotFound.Wrapf("subaccountID %s marketID %s", sourceSubaccountID.Hex(), marketID.Hex())
+	}
+
+	if quantity.GT(sourcePosition.Quantity) {
+		return sdk.Dec{}, types.ErrInvalidQuantity.Wrapf("quantity %s exceeds the position quantity %s", quantity.String(), sourcePosition.Quantity.String())
+	}
+
+	destinationPosition := k.GetPosition(ctx, marketID, destinationSubaccountID)
+	if destinationPosition != nil && destinationPosition.Quantity.IsPositive() && destinationPosition.IsLong != sourcePosition.IsLong {
+		return sdk.Dec{}, types.ErrInvalidPositionTransfer.Wrap("the destination subaccount has a position in the opposite direction")
+	}
+
+	remainingQuantity := sourcePosition.Quantity.Sub(quantity)
+	metadata := k.GetSubaccountOrderbookMetadata(ctx, marketID, sourceSubaccountID, !sourcePosition.IsLong)
+	if metadata.AggregateReduceOnlyQuantity.GT(remainingQuantity) {
+		return sdk.Dec{}, types.ErrInvalidPositionTransfer.Wrapf("the resting reduce-only orders of %s exceed the remaining position of %s", metadata.AggregateReduceOnlyQuantity.String(), remainingQuantity.String())
+	}
+
+	var funding *types.PerpetualMarketFunding
+	if market.IsPerpetual {
+		funding = k.GetPerpetualMarketFunding(ctx, marketID)
+	}
+
+	sourcePosition.ApplyFunding(funding)
+	if destinationPosition == nil || destinationPosition.Quantity.IsZero() {
+		destinationPosition = types.NewPosition(sourcePosition.IsLong, sourcePosition.CumulativeFundingEntry)
+	}
+	destinationPosition.ApplyFunding(funding)
+
+	maxPositionSize := market.GetMaxPositionSize()
+	if maxPositionSize.IsPositive() && destinationPosition.Quantity.Add(quantity).GT(maxPositionSize) {
+		return sdk.Dec{}, types.ErrPositionSizeCapExceeded.Wrapf("position of %s would exceed the cap of %s", destinationPosition.Quantity.Add(quantity).String(), maxPositionSize.String())
+	}
+
+	// the whole margin moves with the whole position, so that no rounding dust is left behind
+	margin := sourcePosition.Margin
+	if remainingQuantity.IsPositive() {
+		margin = sourcePosition.Margin.Mul(quantity).Quo(sourcePosition.Quantity)
+	}
+
+	destinationPosition.EntryPrice = destinationPosition.GetAverageWeightedEntryPrice(quantity, sourcePosition.EntryPrice)
+	destinationPosition.Quantity = destinationPosition.Quantity.Add(quantity)
+	destinationPosition.Margin = destinationPosition.Margin.Add(margin)
+
+	sourcePosition.Quantity = remainingQuantity
+	sourcePosition.Margin = sourcePosition.Margin.Sub(margin)
+
+	k.SetPosition(ctx, marketID, sourceSubaccountID, sourcePosition)
+	k.SetPosition(ctx, marketID, destinationSubaccountID, destinationPosition)
+
+	if sourcePosition.Quantity.IsPositive() && k.IsSubaccountPositionLiquidatable(ctx, sourceSubaccountID, sourcePosition, market, markPrice, funding) {
+		return sdk.Dec{}, types.ErrInvalidPositionTransfer.Wrapf("the position of subaccount %s would be liquidatable", sourceSubaccountID.Hex())
+	}
+
+	if k.IsSubaccountPositionLiquidatable(ctx, destinationSubaccountID, destinationPosition, market, markPrice, funding) {
+		return sdk.Dec{}, types.ErrInvalidPositionTransfer.Wrapf("the position of subaccount %s would be liquidatable", destinationSubaccountID.Hex())
+	}
+
+	return margin, nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Position transfer", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		market    *types.DerivativeMarket
+		markPrice = sdk.NewDec(2000)
+	)
+
+	subaccountID := func(account sdk.AccAddress, nonce uint32) common.Hash {
+		id, err := types.SdkAddressWithNonceToSubaccountID(account, nonce)
+		testexchange.OrFail(err)
+		return *id
+	}
+
+	sourceSubaccount := subaccountID(testexchange.SampleAccountAddr1, 1)
+	destinationSubaccount := subaccountID(testexchange.SampleAccountAddr1, 2)
+
+	setPosition := func(subaccountID common.Hash, isLong bool, quantity, entryPrice, margin int64) {
+		app.ExchangeKeeper.SetPosition(ctx, market.MarketID(), subaccountID, &types.Position{
+			IsLong:                 isLong,
+			Quantity:               sdk.NewDec(quantity),
+			EntryPrice:             sdk.NewDec(entryPrice),
+			Margin:                 sdk.NewDec(margin),
+			CumulativeFundingEntry: sdk.ZeroDec(),
+		})
+	}
+
+	transferPosition := func(sourceSubaccountID, destinationSubaccountID common.Hash, quantity int64) error {
+		msg := &types.MsgTransferPosition{
+			Sender:                  testexchange.SampleAccountAddr1.String(),
+			MarketId:                market.MarketID().Hex(),
+			SourceSubaccountId:      sourceSubaccountID.Hex(),
+			DestinationSubaccountId: destinationSubaccountID.Hex(),
+			Quantity:                sdk.NewDec(quantity),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := msgServer.TransferPosition(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	getPosition := func(subaccountID common.Hash) *types.Position {
+		return app.ExchangeKeeper.GetPosition(ctx, market.MarketID(), subaccountID)
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		perp := testInput.Perps[0]
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, perp.OracleBase, perp.OracleQuote, oracletypes.NewPriceState(markPrice, ctx.BlockTime().Unix()))
+
+		sender := types.SubaccountIDToSdkAddress(testexchange.SampleSubaccountAddr3)
+		coin := sdk.NewCoin(perp.QuoteDenom, sdk.OneInt())
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, coin, perp.Ticker, perp.QuoteDenom, perp.OracleBase, perp.OracleQuote, perp.OracleType, -1))
+
+		var err error
+		market, _, err = app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			perp.Ticker,
+			perp.QuoteDenom,
+			perp.OracleBase,
+			perp.OracleQuote,
+			0,
+			perp.OracleType,
+			perp.InitialMarginRatio,
+			perp.MaintenanceMarginRatio,
+			perp.MakerFeeRate,
+			perp.TakerFeeRate,
+			perp.MinPriceTickSize,
+			perp.MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+
+		setPosition(sourceSubaccount, true, 4, 2000, 2000)
+	})
+
+	It("moves part of the position with its share of the margin", func() {
+		Expect(transferPosition(sourceSubaccount, destinationSubaccount, 1)).To(BeNil())
+
+		source := getPosition(sourceSubaccount)
+		Expect(source.Quantity.String()).To(Equal(sdk.NewDec(3).String()))
+		Expect(source.Margin.String()).To(Equal(sdk.NewDec(1500).String()))
+		Expect(source.EntryPrice.String()).To(Equal(sdk.NewDec(2000).String()))
+
+		destination := getPosition(destinationSubaccount)
+		Expect(destination.IsLong).To(BeTrue())
+		Expect(destination.Quantity.String()).To(Equal(sdk.OneDec().String()))
+		Expect(destination.Margin.String()).To(Equal(sdk.NewDec(500).String()))
+		Expect(destination.EntryPrice.String()).To(Equal(sdk.NewDec(2000).String()))
+
+		Expect(app.ExchangeKeeper.GetOpenInterest(ctx, market.MarketID()).String()).To(Equal(sdk.NewDec(4).String()))
+
+		var transferEvent *types.EventPositionTransfer
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			parsed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+
+			if e, ok := parsed.(*types.EventPositionTransfer); ok {
+				transferEvent = e
+			}
+		}
+		Expect(transferEvent).ToNot(BeNil())
+		Expect(transferEvent.SrcSubaccountId).To(Equal(sourceSubaccount.Hex()))
+		Expect(transferEvent.DstSubaccountId).To(Equal(destinationSubaccount.Hex()))
+		Expect(transferEvent.Margin.String()).To(Equal(sdk.NewDec(500).String()))
+	})
+
+	It("merges the whole position into a position in the same direction", func() {
+		setPosition(destinationSubaccount, true, 2, 2300, 1000)
+
+		Expect(transferPosition(sourceSubaccount, destinationSubaccount, 4)).To(BeNil())
+
+		Expect(getPosition(sourceSubaccount)).To(BeNil())
+
+		// the entry price is (2 * 2300 + 4 * 2000) / 6 = 2100
+		destination := getPosition(destinationSubaccount)
+		Expect(destination.Quantity.String()).To(Equal(sdk.NewDec(6).String()))
+		Expect(destination.Margin.String()).To(Equal(sdk.NewDec(3000).String()))
+		Expect(destination.EntryPrice.String()).To(Equal(sdk.NewDec(2100).String()))
+
+		Expect(app.ExchangeKeeper.GetOpenInterest(ctx, market.MarketID()).String()).To(Equal(sdk.NewDec(6).String()))
+	})
+
+	It("rejects a transfer into a position in the opposite direction", func() {
+		setPosition(destinationSubaccount, false, 1, 2000, 1000)
+
+		Expect(transferPosition(sourceSubaccount, destinationSubaccount, 1)).To(MatchError(ContainSubstring(types.ErrInvalidPositionTransfer.Error())))
+		Expect(getPosition(sourceSubaccount).Quantity.String()).To(Equal(sdk.NewDec(4).String()))
+		Expect(getPosition(destinationSubaccount).Quantity.String()).To(Equal(sdk.OneDec().String()))
+	})
+
+	It("rejects a transfer beyond the position quantity", func() {
+		Expect(transferPosition(sourceSubaccount, destinationSubaccount, 5)).To(MatchError(ContainSubstring(types.ErrInvalidQuantity.Error())))
+		Expect(getPosition(destinationSubaccount)).To(BeNil())
+	})
+
+	It("rejects a transfer to a subaccount of another owner", func() {
+		otherSubaccount := subaccountID(testexchange.SampleAccountAddr2, 1)
+		Expect(transferPosition(sourceSubaccount, otherSubaccount, 1)).To(MatchError(ContainSubstring(types.ErrBadSubaccountID.Error())))
+	})
+})
@@ -17,6 +17,7 @@ func (k *Keeper) SetPosition(
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
+	k.updateOpenInterest(ctx, marketID, subaccountID, position)
 	k.SetTransientPosition(ctx, marketID, subaccountID, position)
 
 	store := k.getStore(ctx)
@@ -30,6 +31,10 @@ func (k *Keeper) SetPosition(
 	key := types.MarketSubaccountInfix(marketID, subaccountID)
 	bz := k.cdc.MustMarshal(position)
 	positionStore.Set(key, bz)
+
+	if k.GetSubaccountMarginMode(ctx, subaccountID) == types.MarginMode_Cross {
+		k.setCrossMarginPositionMarket(ctx, subaccountID, marketID)
+	}
 }
 
 func (k *Keeper) GetPosition(
@@ -80,6 +85,8 @@ func (k *Keeper) DeletePosition(
 	positionStore := prefix.NewStore(store, types.DerivativePositionsPrefix)
 	key := types.MarketSubaccountInfix(marketID, subaccountID)
 	positionStore.Delete(key)
+
+	k.deleteCrossMarginPositionMarket(ctx, subaccountID, marketID)
 }
 
 // GetAllPositionsByMarket returns all positions in a given derivative market
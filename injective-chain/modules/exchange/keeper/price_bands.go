@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// ensureOrderWithinPriceBand rejects an order priced too far from the mark price, as defined by the price band ratio of
+// the market. Limit orders must be priced within [markPrice * (1 - band), markPrice * (1 + band)]. For market orders
+// the price is the worst accepted execution price, so only the slippage is bounded: buys may not exceed the upper bound
+// and sells may not fall below the lower bound. Conditional orders are checked once triggered.
+func (k *Keeper) ensureOrderWithinPriceBand(
+	market DerivativeMarketI,
+	order *types.DerivativeOrder,
+	markPrice sdk.Dec,
+	isMarketOrder bool,
+) error {
+	derivativeMarket, ok := market.(*types.DerivativeMarket)
+	if !ok || order.IsConditional() {
+		return nil
+	}
+
+	priceBandRatio := derivativeMarket.GetPriceBandRatio()
+	if priceBandRatio.IsZero() || markPrice.IsNil() || !markPrice.IsPositive() {
+		return nil
+	}
+
+	lowerBound := markPrice.Mul(sdk.OneDec().Sub(priceBandRatio))
+	upperBound := markPrice.Mul(sdk.OneDec().Add(priceBandRatio))
+
+	price := order.Price()
+	isAboveBand := price.GT(upperBound) && (!isMarketOrder || order.IsBuy())
+	isBelowBand := price.LT(lowerBound) && (!isMarketOrder || !order.IsBuy())
+
+	if isAboveBand || isBelowBand {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrOrderOutsidePriceBand.Wrapf("price %s must be within [%s, %s]", price.String(), lowerBound.String(), upperBound.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Derivative market price bands", func() {
+	var (
+		testInput          testexchange.TestInput
+		app                *simapp.InjectiveApp
+		ctx                sdk.Context
+		msgServer          types.MsgServer
+		marketID           common.Hash
+		subaccountIdBuyer  = testexchange.SampleSubaccountAddr1
+		subaccountIdSeller = testexchange.SampleSubaccountAddr2
+		senderBuyer        = types.SubaccountIDToSdkAddress(subaccountIdBuyer)
+		startingPrice      = sdk.NewDec(2000)
+	)
+
+	newOrder := func(subaccountID common.Hash, price sdk.Dec, orderType types.OrderType) types.DerivativeOrder {
+		return types.DerivativeOrder{
+			MarketId: marketID.Hex(),
+			OrderInfo: types.OrderInfo{
+				SubaccountId: subaccountID.Hex(),
+				FeeRecipient: "inj1dzqd00lfd4y4qy2pxa0dsdwzfnmsu27hgttswz",
+				Price:        price,
+				Quantity:     sdk.OneDec(),
+			},
+			OrderType: orderType,
+			Margin:    price,
+		}
+	}
+
+	createLimitOrder := func(subaccountID common.Hash, price int64, orderType types.OrderType) error {
+		_, err := msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCreateDerivativeLimitOrder{
+			Sender: types.SubaccountIDToSdkAddress(subaccountID).String(),
+			Order:  newOrder(subaccountID, sdk.NewDec(price), orderType),
+		})
+		return err
+	}
+
+	createMarketOrder := func(subaccountID common.Hash, worstPrice int64, orderType types.OrderType) error {
+		_, err := msgServer.CreateDerivativeMarketOrder(sdk.WrapSDKContext(ctx), &types.MsgCreateDerivativeMarketOrder{
+			Sender: types.SubaccountIDToSdkAddress(subaccountID).String(),
+			Order:  newOrder(subaccountID, sdk.NewDec(worstPrice), orderType),
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		oracleBase, oracleQuote, oracleType := testInput.Perps[0].OracleBase, testInput.Perps[0].OracleQuote, testInput.Perps[0].OracleType
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, oracleBase, oracleQuote, oracletypes.NewPriceState(startingPrice, ctx.BlockTime().Unix()))
+		coin := sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.OneInt())
+		app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin))
+		app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderBuyer, sdk.NewCoins(coin))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, senderBuyer, coin, testInput.Perps[0].Ticker, testInput.Perps[0].QuoteDenom, oracleBase, oracleQuote, oracleType, -1))
+
+		market, _, err := app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			testInput.Perps[0].Ticker,
+			testInput.Perps[0].QuoteDenom,
+			oracleBase,
+			oracleQuote,
+			0,
+			oracleType,
+			testInput.Perps[0].InitialMarginRatio,
+			testInput.Perps[0].MaintenanceMarginRatio,
+			testInput.Perps[0].MakerFeeRate,
+			testInput.Perps[0].TakerFeeRate,
+			testInput.Perps[0].MinPriceTickSize,
+			testInput.Perps[0].MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+		marketID = market.MarketID()
+
+		// orders must be priced within [1800, 2200]
+		market.PriceBandRatio = sdk.NewDecWithPrec(1, 1)
+		app.ExchangeKeeper.SetDerivativeMarket(ctx, market)
+
+		depositAmount := sdk.NewCoins(sdk.NewCoin(testInput.Perps[0].QuoteDenom, sdk.NewInt(100000)))
+		testexchange.MintAndDeposit(app, ctx, subaccountIdBuyer.String(), depositAmount)
+		testexchange.MintAndDeposit(app, ctx, subaccountIdSeller.String(), depositAmount)
+	})
+
+	It("accepts limit orders within the price band", func() {
+		testexchange.OrFail(createLimitOrder(subaccountIdBuyer, 1800, types.OrderType_BUY))
+		testexchange.OrFail(createLimitOrder(subaccountIdBuyer, 1950, types.OrderType_BUY))
+		testexchange.OrFail(createLimitOrder(subaccountIdSeller, 2200, types.OrderType_SELL))
+	})
+
+	It("rejects limit orders outside of the price band", func() {
+		Expect(createLimitOrder(subaccountIdBuyer, 2201, types.OrderType_BUY)).To(MatchError(types.ErrOrderOutsidePriceBand))
+		Expect(createLimitOrder(subaccountIdBuyer, 1799, types.OrderType_BUY)).To(MatchError(types.ErrOrderOutsidePriceBand))
+		Expect(createLimitOrder(subaccountIdSeller, 1799, types.OrderType_SELL)).To(MatchError(types.ErrOrderOutsidePriceBand))
+		Expect(createLimitOrder(subaccountIdSeller, 2201, types.OrderType_SELL)).To(MatchError(types.ErrOrderOutsidePriceBand))
+	})
+
+	It("caps the slippage of market orders", func() {
+		Expect(createMarketOrder(subaccountIdBuyer, 2300, types.OrderType_BUY)).To(MatchError(types.ErrOrderOutsidePriceBand))
+		Expect(createMarketOrder(subaccountIdSeller, 1700, types.OrderType_SELL)).To(MatchError(types.ErrOrderOutsidePriceBand))
+
+		testexchange.OrFail(createLimitOrder(subaccountIdSeller, 2100, types.OrderType_SELL))
+		testexchange.OrFail(createLimitOrder(subaccountIdBuyer, 1900, types.OrderType_BUY))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		// a worst price within the band is filled against the resting orders
+		testexchange.OrFail(createMarketOrder(subaccountIdBuyer, 2200, types.OrderType_BUY))
+		testexchange.OrFail(createMarketOrder(subaccountIdSeller, 1800, types.OrderType_SELL))
+	})
+})
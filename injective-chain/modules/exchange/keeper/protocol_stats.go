@@ -0,0 +1,315 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// The counters of the protocol stats are maintained without consuming gas, so that they don't change the gas used by
+// the txs which update them.
+
+// GetTotalOpenOrderCount returns the number of resting spot and derivative limit orders of all subaccounts.
+func (k *Keeper) GetTotalOpenOrderCount(ctx sdk.Context) uint64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.TotalOpenOrderCountKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k *Keeper) setTotalOpenOrderCount(ctx sdk.Context, count uint64) {
+	k.getStore(ctx).Set(types.TotalOpenOrderCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+func (k *Keeper) incrementTotalOpenOrderCount(ctx sdk.Context) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.setTotalOpenOrderCount(ctx, k.GetTotalOpenOrderCount(ctx)+1)
+}
+
+func (k *Keeper) decrementTotalOpenOrderCount(ctx sdk.Context) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	count := k.GetTotalOpenOrderCount(ctx)
+	if count == 0 {
+		return
+	}
+
+	k.setTotalOpenOrderCount(ctx, count-1)
+}
+
+// GetDenomTotalDeposits returns the total deposits of all subaccounts in a denom.
+func (k *Keeper) GetDenomTotalDeposits(ctx sdk.Context, denom string) sdk.Dec {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return getDecOrZero(k.getStore(ctx), types.GetDenomTotalDepositsKey(denom))
+}
+
+// GetAllDenomTotalDeposits returns the total deposits of all subaccounts per denom, ordered by denom.
+func (k *Keeper) GetAllDenomTotalDeposits(ctx sdk.Context) sdk.DecCoins {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return getAllDecsByDenom(prefix.NewStore(k.getStore(ctx), types.DenomTotalDepositsPrefix))
+}
+
+// updateDenomTotalDeposits applies the change of the total balance of a deposit to the total deposits of its denom.
+func (k *Keeper) updateDenomTotalDeposits(ctx sdk.Context, denom string, oldDeposit, newDeposit *types.Deposit) {
+	newTotal := newDeposit.TotalBalance
+	if newTotal.IsNil() {
+		newTotal = sdk.ZeroDec()
+	}
+
+	delta := newTotal.Sub(oldDeposit.TotalBalance)
+	if delta.IsZero() {
+		return
+	}
+
+	store := k.getStore(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	key := types.GetDenomTotalDepositsKey(denom)
+	setDecOrDelete(store, key, getDecOrZero(store, key).Add(delta))
+}
+
+// InitializeDenomTotalDeposits recomputes the total deposits per denom from the deposits of all subaccounts.
+func (k *Keeper) InitializeDenomTotalDeposits(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	totalsStore := prefix.NewStore(store, types.DenomTotalDepositsPrefix)
+
+	iterator := totalsStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		totalsStore.Delete(key)
+	}
+
+	totals := make(map[string]sdk.Dec)
+	denoms := make([]string, 0)
+
+	for _, balance := range k.GetAllExchangeBalances(ctx) {
+		if balance.Deposits == nil || balance.Deposits.TotalBalance.IsNil() {
+			continue
+		}
+
+		total, ok := totals[balance.Denom]
+		if !ok {
+			total = sdk.ZeroDec()
+			denoms = append(denoms, balance.Denom)
+		}
+		totals[balance.Denom] = total.Add(balance.Deposits.TotalBalance)
+	}
+
+	for _, denom := range denoms {
+		setDecOrDelete(store, types.GetDenomTotalDepositsKey(denom), totals[denom])
+	}
+}
+
+// recordMarketBlockTradeVolume accumulates the volume of a market in the transient store, so that it's added to the
+// rolling trade volume at the end of the block.
+func (k *Keeper) recordMarketBlockTradeVolume(ctx sdk.Context, marketID common.Hash, volume types.VolumeRecord) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store := k.getTransientStore(ctx)
+	key := types.GetMarketBlockTradeVolumeKey(marketID)
+
+	if bz := store.Get(key); bz != nil {
+		var previousVolume types.VolumeRecord
+		k.cdc.MustUnmarshal(bz, &previousVolume)
+		volume = previousVolume.Add(volume)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&volume))
+}
+
+// popMarketBlockTradeVolumes returns and deletes the volumes of the markets recorded in the block, ordered by marketID.
+func (k *Keeper) popMarketBlockTradeVolumes(ctx sdk.Context) []*MarketVolumeContribution {
+	store := prefix.NewStore(k.getTransientStore(ctx), types.MarketBlockTradeVolumePrefix)
+
+	volumes := make([]*MarketVolumeContribution, 0)
+	keys := make([][]byte, 0)
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var volume types.VolumeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &volume)
+
+		volumes = append(volumes, &MarketVolumeContribution{
+			MarketID: common.BytesToHash(iterator.Key()),
+			Volume:   volume,
+		})
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return volumes
+}
+
+// PersistRollingTradeVolume adds the trade volume of the block to the rolling trade volume per quote denom and removes
+// the volume of the blocks which left the window of TradeVolumeWindowSeconds. Every trade counts towards the volume of
+// both of its sides, so the trade volume of a market is half of its aggregate volume.
+func (k *Keeper) PersistRollingTradeVolume(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockVolumes := sdk.NewDecCoins()
+
+	for _, marketVolume := range k.popMarketBlockTradeVolumes(ctx) {
+		var market MarketI
+		if spotMarket := k.GetSpotMarketByID(ctx, marketVolume.MarketID); spotMarket != nil {
+			market = spotMarket
+		} else if derivativeMarket := k.GetDerivativeOrBinaryOptionsMarket(ctx, marketVolume.MarketID, nil); derivativeMarket != nil {
+			market = derivativeMarket
+		} else {
+			continue
+		}
+
+		tradeVolume := marketVolume.Volume.Total().QuoInt64(2)
+		if !tradeVolume.IsPositive() {
+			continue
+		}
+
+		blockVolumes = blockVolumes.Add(sdk.NewDecCoinFromDec(market.GetQuoteDenom(), tradeVolume))
+	}
+
+	if !blockVolumes.IsZero() {
+		k.addBlockTradeVolume(ctx, ctx.BlockHeight(), &types.BlockTradeVolume{
+			Timestamp: ctx.BlockTime().Unix(),
+			Volumes:   blockVolumes,
+		})
+	}
+
+	k.pruneBlockTradeVolumes(ctx)
+}
+
+// addBlockTradeVolume stores the trade volume of a block and adds it to the rolling trade volume.
+func (k *Keeper) addBlockTradeVolume(ctx sdk.Context, blockHeight int64, record *types.BlockTradeVolume) {
+	store := k.getStore(ctx)
+	store.Set(types.GetBlockTradeVolumeKey(blockHeight), k.cdc.MustMarshal(record))
+
+	for _, volume := range record.Volumes {
+		key := types.GetRollingTradeVolumeKey(volume.Denom)
+		setDecOrDelete(store, key, getDecOrZero(store, key).Add(volume.Amount))
+	}
+}
+
+// GetAllBlockTradeVolumes returns the trade volume of the blocks within the rolling window, ordered by block height.
+func (k *Keeper) GetAllBlockTradeVolumes(ctx sdk.Context) []types.BlockTradeVolumeRecord {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockVolumesStore := prefix.NewStore(k.getStore(ctx), types.BlockTradeVolumePrefix)
+
+	iterator := blockVolumesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := make([]types.BlockTradeVolumeRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var volume types.BlockTradeVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &volume)
+
+		records = append(records, types.BlockTradeVolumeRecord{
+			BlockHeight: int64(sdk.BigEndianToUint64(iterator.Key())),
+			Volume:      volume,
+		})
+	}
+
+	return records
+}
+
+// pruneBlockTradeVolumes removes the volume of the blocks older than TradeVolumeWindowSeconds from the rolling trade
+// volume. At most MaxBlockTradeVolumesPrunedPerBlock blocks are pruned at once, the remaining ones are pruned with
+// the next blocks.
+func (k *Keeper) pruneBlockTradeVolumes(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	blockVolumesStore := prefix.NewStore(store, types.BlockTradeVolumePrefix)
+	windowStart := ctx.BlockTime().Unix() - types.TradeVolumeWindowSeconds
+
+	expiredKeys := make([][]byte, 0)
+	expiredVolumes := sdk.NewDecCoins()
+
+	iterator := blockVolumesStore.Iterator(nil, nil)
+	for ; iterator.Valid() && len(expiredKeys) < types.MaxBlockTradeVolumesPrunedPerBlock; iterator.Next() {
+		var record types.BlockTradeVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if record.Timestamp > windowStart {
+			break
+		}
+
+		expiredKeys = append(expiredKeys, iterator.Key())
+		expiredVolumes = expiredVolumes.Add(record.Volumes...)
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		blockVolumesStore.Delete(key)
+	}
+
+	for _, volume := range expiredVolumes {
+		key := types.GetRollingTradeVolumeKey(volume.Denom)
+		setDecOrDelete(store, key, getDecOrZero(store, key).Sub(volume.Amount))
+	}
+}
+
+// GetRollingTradeVolumes returns the trade volume per quote denom of the blocks within the last
+// TradeVolumeWindowSeconds, ordered by denom.
+func (k *Keeper) GetRollingTradeVolumes(ctx sdk.Context) sdk.DecCoins {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return getAllDecsByDenom(prefix.NewStore(k.getStore(ctx), types.RollingTradeVolumePrefix))
+}
+
+func getDecOrZero(store sdk.KVStore, key []byte) sdk.Dec {
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var value sdk.Dec
+	if err := value.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func setDecOrDelete(store sdk.KVStore, key []byte, value sdk.Dec) {
+	if value.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := value.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// getAllDecsByDenom returns the values of a store keyed by denom. The amounts are set directly, since the total
+// deposits of a denom can be negative.
+func getAllDecsByDenom(store sdk.KVStore) sdk.DecCoins {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	coins := make(sdk.DecCoins, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var value sdk.Dec
+		if err := value.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		coins = append(coins, sdk.DecCoin{Denom: string(iterator.Key()), Amount: value})
+	}
+
+	return coins
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Protocol stats", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		market    testexchange.SpotMarket
+		buyer     = testexchange.SampleNonDefaultSubaccountAddr1
+		seller    = testexchange.SampleNonDefaultSubaccountAddr2
+	)
+
+	createLimitOrder := func(price string, orderType types.OrderType, subaccountID common.Hash) {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, "1", orderType, subaccountID),
+		)
+		_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		testexchange.OrFail(err)
+	}
+
+	queryStats := func() *types.QueryProtocolStatsResponse {
+		res, err := app.ExchangeKeeper.ProtocolStats(sdk.WrapSDKContext(ctx), &types.QueryProtocolStatsRequest{})
+		testexchange.OrFail(err)
+		return res
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+		market = testInput.Spots[0]
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testexchange.MintAndDeposit(app, ctx, buyer.String(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000))))
+		testexchange.MintAndDeposit(app, ctx, seller.String(), sdk.NewCoins(sdk.NewCoin(market.BaseDenom, sdk.NewInt(100))))
+
+		createLimitOrder("90", types.OrderType_BUY, buyer)
+		createLimitOrder("110", types.OrderType_SELL, seller)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+	})
+
+	It("reflects the markets, open orders and deposits", func() {
+		stats := queryStats()
+
+		Expect(stats.TotalMarkets).To(Equal(uint32(1)))
+		Expect(stats.ActiveMarkets).To(Equal(uint32(1)))
+		Expect(stats.TotalOpenOrders).To(Equal(uint64(2)))
+		Expect(stats.TotalValueLocked.AmountOf(market.BaseDenom)).To(Equal(sdk.NewDec(100)))
+		Expect(stats.TotalValueLocked.AmountOf(market.QuoteDenom)).To(Equal(sdk.NewDec(100000)))
+		Expect(stats.TradeVolume).To(BeEmpty())
+		Expect(stats.TradeVolumeWindowSeconds).To(Equal(types.TradeVolumeWindowSeconds))
+	})
+
+	It("reflects a recent trade until it leaves the window", func() {
+		createLimitOrder("100", types.OrderType_SELL, seller)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		Expect(queryStats().TotalOpenOrders).To(Equal(uint64(3)))
+
+		createLimitOrder("100", types.OrderType_BUY, buyer)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		stats := queryStats()
+		Expect(stats.TotalOpenOrders).To(Equal(uint64(2)))
+		Expect(stats.TradeVolume.AmountOf(market.QuoteDenom)).To(Equal(sdk.NewDec(100)))
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+		app.ExchangeKeeper.PersistRollingTradeVolume(ctx)
+		Expect(queryStats().TradeVolume).To(BeEmpty())
+	})
+
+	It("exports and imports the trade volume and total deposits in genesis", func() {
+		createLimitOrder("100", types.OrderType_SELL, seller)
+		createLimitOrder("100", types.OrderType_BUY, buyer)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		Expect(state.BlockTradeVolumes).To(HaveLen(1))
+		Expect(state.DenomTotalDeposits.AmountOf(market.BaseDenom)).To(Equal(sdk.NewDec(100)))
+
+		exportedStats := queryStats()
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+		app.ExchangeKeeper.InitGenesis(ctx, *state)
+
+		Expect(app.ExchangeKeeper.GetAllBlockTradeVolumes(ctx)).To(Equal(state.BlockTradeVolumes))
+		Expect(queryStats()).To(Equal(exportedStats))
+	})
+
+	It("rejects genesis total deposits not matching the balances", func() {
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		state.DenomTotalDeposits[0].Amount = state.DenomTotalDeposits[0].Amount.Add(sdk.OneDec())
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+		Expect(func() { app.ExchangeKeeper.InitGenesis(ctx, *state) }).To(Panic())
+	})
+})
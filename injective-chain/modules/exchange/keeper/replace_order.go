@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// ReplaceOrder atomically cancels a resting limit order of the sender and places a new limit order in the same market
+// and subaccount, with exactly one of spotOrder and derivativeOrder set. The refund of the cancelled order and the charge
+// of the new order are applied together, so only their net difference needs to be available. Nothing is written when
+// either the cancellation or the placement fails.
+func (k *Keeper) ReplaceOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	oldOrder *types.OrderData,
+	spotOrder *types.SpotOrder,
+	derivativeOrder *types.DerivativeOrder,
+) (common.Hash, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	var (
+		orderHash common.Hash
+		err       error
+	)
+
+	if spotOrder != nil {
+		orderHash, err = k.replaceSpotOrder(cacheCtx, sender, oldOrder, spotOrder)
+	} else {
+		orderHash, err = k.replaceDerivativeOrder(cacheCtx, sender, oldOrder, derivativeOrder)
+	}
+
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return common.Hash{}, err
+	}
+
+	writeCache()
+	return orderHash, nil
+}
+
+func (k *Keeper) replaceSpotOrder(ctx sdk.Context, sender sdk.AccAddress, oldOrder *types.OrderData, order *types.SpotOrder) (common.Hash, error) {
+	subaccountID, err := getReplacedOrderSubaccountID(sender, oldOrder, order.OrderInfo.SubaccountId)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	marketID := common.HexToHash(oldOrder.MarketId)
+	market := k.GetSpotMarketByID(ctx, marketID)
+	if market == nil {
+		return common.Hash{}, types.ErrSpotMarketNotFound.Wrapf("spot market %s not found", oldOrder.MarketId)
+	}
+
+	if err := k.cancelSpotLimitOrder(ctx, subaccountID, oldOrder.GetIdentifier(), market, marketID); err != nil {
+		return common.Hash{}, err
+	}
+
+	return k.createSpotLimitOrder(ctx, sender, order, nil)
+}
+
+func (k *Keeper) replaceDerivativeOrder(ctx sdk.Context, sender sdk.AccAddress, oldOrder *types.OrderData, order *types.DerivativeOrder) (common.Hash, error) {
+	subaccountID, err := getReplacedOrderSubaccountID(sender, oldOrder, order.OrderInfo.SubaccountId)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	marketID := common.HexToHash(oldOrder.MarketId)
+	market, markPrice := k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true)
+	if market == nil || markPrice.IsNil() {
+		if market == nil && k.IsMarketPaused(ctx, marketID) {
+			return common.Hash{}, types.ErrMarketPaused.Wrapf("derivative market %s is paused", oldOrder.MarketId)
+		}
+		return common.Hash{}, types.ErrDerivativeMarketNotFound.Wrapf("active derivative market for marketID %s not found", oldOrder.MarketId)
+	}
+
+	if err := k.cancelDerivativeOrder(ctx, subaccountID, oldOrder.GetIdentifier(), market, marketID, oldOrder.OrderMask); err != nil {
+		return common.Hash{}, err
+	}
+
+	return k.createDerivativeLimitOrder(ctx, sender, order, market, markPrice)
+}
+
+// getReplacedOrderSubaccountID returns the subaccount of the replaced order, which must also be the subaccount of the
+// new order.
+func getReplacedOrderSubaccountID(sender sdk.AccAddress, oldOrder *types.OrderData, newOrderSubaccountID string) (common.Hash, error) {
+	subaccountID := types.MustGetSubaccountIDOrDeriveFromNonce(sender, oldOrder.SubaccountId)
+	if types.MustGetSubaccountIDOrDeriveFromNonce(sender, newOrderSubaccountID) != subaccountID {
+		return common.Hash{}, types.ErrBadSubaccountID.Wrapf("new order subaccount %s differs from the replaced order subaccount %s", newOrderSubaccountID, subaccountID.Hex())
+	}
+	return subaccountID, nil
+}
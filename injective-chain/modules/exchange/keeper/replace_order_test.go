@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Replace order", func() {
+	var (
+		testInput      testexchange.TestInput
+		app            *simapp.InjectiveApp
+		ctx            sdk.Context
+		msgServer      types.MsgServer
+		market         testexchange.SpotMarket
+		buyer          common.Hash
+		initialBalance sdk.Dec
+	)
+
+	newOrder := func(price, quantity string) *types.SpotOrder {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, quantity, types.OrderType_BUY, buyer),
+		)
+		return &msgs[0].Order
+	}
+
+	placeOrder := func(price, quantity string) common.Hash {
+		resp, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCreateSpotLimitOrder{
+			Sender: types.SubaccountIDToSdkAddress(buyer).String(),
+			Order:  *newOrder(price, quantity),
+		})
+		testexchange.OrFail(err)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		return common.HexToHash(resp.OrderHash)
+	}
+
+	replaceOrder := func(oldOrderHash common.Hash, price, quantity string) (*types.MsgReplaceOrderResponse, error) {
+		return msgServer.ReplaceOrder(sdk.WrapSDKContext(ctx), &types.MsgReplaceOrder{
+			Sender: types.SubaccountIDToSdkAddress(buyer).String(),
+			OldOrder: types.OrderData{
+				MarketId:     market.MarketID.Hex(),
+				SubaccountId: buyer.Hex(),
+				OrderHash:    oldOrderHash.Hex(),
+			},
+			SpotOrder: newOrder(price, quantity),
+		})
+	}
+
+	getOrder := func(orderHash common.Hash) *types.SpotLimitOrder {
+		isBuy := true
+		return app.ExchangeKeeper.GetSpotLimitOrderBySubaccountID(ctx, market.MarketID, &isBuy, buyer, orderHash)
+	}
+
+	availableBalance := func() sdk.Dec {
+		return app.ExchangeKeeper.GetDeposit(ctx, buyer, market.QuoteDenom).AvailableBalance
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		market = testInput.Spots[0]
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		subaccountID, err := types.SdkAddressWithNonceToSubaccountID(testexchange.SampleAccountAddr1, 1)
+		testexchange.OrFail(err)
+		buyer = *subaccountID
+
+		initialBalance = sdk.NewDec(1000)
+		testexchange.MintAndDeposit(app, ctx, buyer.Hex(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, initialBalance.TruncateInt())))
+	})
+
+	It("cancels the old order and places the new one", func() {
+		oldOrderHash := placeOrder("100", "3")
+		Expect(getOrder(oldOrderHash)).ToNot(BeNil())
+
+		resp, err := replaceOrder(oldOrderHash, "90", "3")
+		Expect(err).To(BeNil())
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		Expect(getOrder(oldOrderHash)).To(BeNil())
+		newOrder := getOrder(common.HexToHash(resp.OrderHash))
+		Expect(newOrder).ToNot(BeNil())
+		Expect(newOrder.OrderInfo.Price.String()).To(Equal(sdk.NewDec(90).String()))
+		Expect(newOrder.OrderInfo.Quantity.String()).To(Equal(sdk.NewDec(3).String()))
+	})
+
+	It("keeps the old order when the new order can't be placed", func() {
+		oldOrderHash := placeOrder("100", "3")
+		balanceBefore := availableBalance()
+
+		_, err := replaceOrder(oldOrderHash, "100", "20")
+		Expect(err).ToNot(BeNil())
+
+		Expect(getOrder(oldOrderHash)).ToNot(BeNil())
+		Expect(availableBalance().String()).To(Equal(balanceBefore.String()))
+
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		Expect(getOrder(oldOrderHash)).ToNot(BeNil())
+	})
+
+	It("charges only the net margin difference", func() {
+		// the new order needs more than the available balance, but less than the balance refunded by the cancellation
+		oldOrderHash := placeOrder("100", "9")
+		oldOrderHold := initialBalance.Sub(availableBalance())
+
+		_, err := replaceOrder(oldOrderHash, "105", "9")
+		Expect(err).To(BeNil())
+
+		// the new order is held at the taker fee until it rests in the book
+		newOrderHold := sdk.NewDec(105 * 9).Mul(sdk.OneDec().Add(market.TakerFeeRate))
+		Expect(newOrderHold.GT(initialBalance.Sub(oldOrderHold))).To(BeTrue())
+		Expect(availableBalance().String()).To(Equal(initialBalance.Sub(newOrderHold).String()))
+		Expect(app.ExchangeKeeper.GetDeposit(ctx, buyer, market.QuoteDenom).TotalBalance.String()).To(Equal(initialBalance.String()))
+	})
+})
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// ChargeMarketCreationFee charges the market creation fee to the creator of a market launched by message and sends
+// it, converted into the fee settlement denom if enabled, to the spam fee destination. It's a no-op when the fee is
+// zero.
+func (k *Keeper) ChargeMarketCreationFee(ctx sdk.Context, sender sdk.AccAddress) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	return k.chargeSpamFee(ctx, sender, params.MarketCreationFee, 1, params.SpamFeeDestination)
+}
+
+// ChargeOrderPlacementSurcharge charges the order placement surcharge to the sender once for each of the created
+// orders and sends it, converted into the fee settlement denom if enabled, to the spam fee destination. It's a no-op
+// when the surcharge is zero.
+func (k *Keeper) ChargeOrderPlacementSurcharge(ctx sdk.Context, sender sdk.AccAddress, createdOrdersCount int) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	return k.chargeSpamFee(ctx, sender, params.OrderPlacementSurcharge, createdOrdersCount, params.SpamFeeDestination)
+}
+
+func (k *Keeper) chargeSpamFee(ctx sdk.Context, sender sdk.AccAddress, fee sdk.Coin, multiplier int, destination types.SpamFeeDestination) error {
+	if fee.IsZero() || multiplier == 0 {
+		return nil
+	}
+
+	payer, settledFee := k.settleFee(ctx, sender, sdk.NewCoin(fee.Denom, fee.Amount.MulRaw(int64(multiplier))))
+	amount := sdk.NewCoins(settledFee)
+
+	if destination == types.SpamFeeDestination_Burn {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, amount); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return err
+		}
+		return nil
+	}
+
+	if err := k.DistributionKeeper.FundCommunityPool(ctx, amount, payer); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+	return nil
+}
+
+// countCreatedOrders returns the number of created orders in batch responses, where failed orders hold the error code
+// instead of the order hash.
+func countCreatedOrders(orderHashes ...[]string) int {
+	count := 0
+	for _, hashes := range orderHashes {
+		for _, hash := range hashes {
+			if strings.HasPrefix(hash, "0x") {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Spam fees", func() {
+	var (
+		testInput    testexchange.TestInput
+		app          *simapp.InjectiveApp
+		ctx          sdk.Context
+		msgServer    types.MsgServer
+		listingFee   math.Int
+		creationFee  math.Int
+		surcharge    math.Int
+		amountMinted math.Int
+	)
+	sender := testexchange.SampleAccountAddr1
+
+	communityPoolINJ := func() math.Int {
+		return app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf("inj").TruncateInt()
+	}
+
+	setSpamFees := func(marketCreationFee, orderPlacementSurcharge math.Int, destination types.SpamFeeDestination) {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MarketCreationFee = sdk.NewCoin("inj", marketCreationFee)
+		params.OrderPlacementSurcharge = sdk.NewCoin("inj", orderPlacementSurcharge)
+		params.SpamFeeDestination = destination
+		app.ExchangeKeeper.SetParams(ctx, params)
+	}
+
+	launchMarket := func() error {
+		_, err := msgServer.InstantSpotMarketLaunch(sdk.WrapSDKContext(ctx), &types.MsgInstantSpotMarketLaunch{
+			Sender:              sender.String(),
+			Ticker:              testInput.Spots[1].Ticker,
+			BaseDenom:           testInput.Spots[1].BaseDenom,
+			QuoteDenom:          testInput.Spots[1].QuoteDenom,
+			MinPriceTickSize:    testInput.Spots[1].MinPriceTickSize,
+			MinQuantityTickSize: testInput.Spots[1].MinQuantityTickSize,
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 2, 0, 0)
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, testInput.Spots[0].Ticker, testInput.Spots[0].BaseDenom, testInput.Spots[0].QuoteDenom, testInput.Spots[0].MinPriceTickSize, testInput.Spots[0].MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		listingFee = app.ExchangeKeeper.GetParams(ctx).SpotMarketInstantListingFee.Amount
+		creationFee = math.Int(sdk.NewDec(100))
+		surcharge = math.Int(sdk.NewDec(1))
+		amountMinted = math.Int(sdk.NewDec(10000))
+
+		amount := sdk.NewCoins(sdk.NewCoin("inj", amountMinted))
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, amount))
+	})
+
+	Describe("market creation fee", func() {
+		It("is not charged when it is zero", func() {
+			poolBefore := communityPoolINJ()
+
+			Expect(launchMarket()).To(BeNil())
+
+			Expect(app.BankKeeper.GetBalance(ctx, sender, "inj").Amount).To(Equal(amountMinted.Sub(listingFee)))
+			Expect(communityPoolINJ()).To(Equal(poolBefore.Add(listingFee)))
+		})
+
+		It("is sent to the community pool", func() {
+			setSpamFees(creationFee, math.ZeroInt(), types.SpamFeeDestination_CommunityPool)
+			poolBefore := communityPoolINJ()
+
+			Expect(launchMarket()).To(BeNil())
+
+			Expect(app.BankKeeper.GetBalance(ctx, sender, "inj").Amount).To(Equal(amountMinted.Sub(listingFee).Sub(creationFee)))
+			Expect(communityPoolINJ()).To(Equal(poolBefore.Add(listingFee).Add(creationFee)))
+		})
+
+		It("is burned", func() {
+			setSpamFees(creationFee, math.ZeroInt(), types.SpamFeeDestination_Burn)
+			poolBefore := communityPoolINJ()
+			supplyBefore := app.BankKeeper.GetSupply(ctx, "inj").Amount
+
+			Expect(launchMarket()).To(BeNil())
+
+			Expect(app.BankKeeper.GetBalance(ctx, sender, "inj").Amount).To(Equal(amountMinted.Sub(listingFee).Sub(creationFee)))
+			Expect(communityPoolINJ()).To(Equal(poolBefore.Add(listingFee)))
+			Expect(app.BankKeeper.GetSupply(ctx, "inj").Amount).To(Equal(supplyBefore.Sub(creationFee)))
+		})
+
+		It("fails the launch when the creator can't pay it", func() {
+			setSpamFees(amountMinted, math.ZeroInt(), types.SpamFeeDestination_CommunityPool)
+
+			Expect(launchMarket()).ToNot(BeNil())
+		})
+	})
+
+	Describe("order placement surcharge", func() {
+		var msgs []*types.MsgCreateSpotLimitOrder
+
+		BeforeEach(func() {
+			market := testInput.Spots[0]
+			testexchange.MintAndDeposit(app, ctx, testexchange.SampleSubaccountAddr1.String(), sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000))))
+
+			msgs = testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+				testexchange.NewBareSpotLimitOrderFromString("10", "1", types.OrderType_BUY, testexchange.SampleSubaccountAddr1),
+				testexchange.NewBareSpotLimitOrderFromString("11", "1", types.OrderType_BUY, testexchange.SampleSubaccountAddr1),
+			)
+		})
+
+		It("is charged for each created order of a batch", func() {
+			setSpamFees(math.ZeroInt(), surcharge, types.SpamFeeDestination_CommunityPool)
+			poolBefore := communityPoolINJ()
+
+			_, err := msgServer.BatchCreateSpotLimitOrders(sdk.WrapSDKContext(ctx), &types.MsgBatchCreateSpotLimitOrders{
+				Sender: sender.String(),
+				Orders: []types.SpotOrder{msgs[0].Order, msgs[1].Order},
+			})
+			Expect(err).To(BeNil())
+
+			Expect(app.BankKeeper.GetBalance(ctx, sender, "inj").Amount).To(Equal(amountMinted.Sub(surcharge.MulRaw(2))))
+			Expect(communityPoolINJ()).To(Equal(poolBefore.Add(surcharge.MulRaw(2))))
+		})
+
+		It("is burned", func() {
+			setSpamFees(math.ZeroInt(), surcharge, types.SpamFeeDestination_Burn)
+			poolBefore := communityPoolINJ()
+			supplyBefore := app.BankKeeper.GetSupply(ctx, "inj").Amount
+
+			_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+			Expect(err).To(BeNil())
+
+			Expect(app.BankKeeper.GetBalance(ctx, sender, "inj").Amount).To(Equal(amountMinted.Sub(surcharge)))
+			Expect(communityPoolINJ()).To(Equal(poolBefore))
+			Expect(app.BankKeeper.GetSupply(ctx, "inj").Amount).To(Equal(supplyBefore.Sub(surcharge)))
+		})
+
+		It("is not charged when it is zero", func() {
+			_, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+			Expect(err).To(BeNil())
+
+			Expect(app.BankKeeper.GetBalance(ctx, sender, "inj").Amount).To(Equal(amountMinted))
+		})
+	})
+})
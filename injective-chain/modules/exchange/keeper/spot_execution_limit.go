@@ -149,12 +149,14 @@ func (k *Keeper) PersistSpotMatchingExecution(ctx sdk.Context, batchSpotMatching
 		}
 
 		if execution.NewOrdersEvent != nil {
+			// the new resting orders were placed in the current block, so they leave the transient open order count
 			for idx := range execution.NewOrdersEvent.BuyOrders {
 				k.SetNewSpotLimitOrder(ctx,
 					execution.NewOrdersEvent.BuyOrders[idx],
 					marketID, true,
 					execution.NewOrdersEvent.BuyOrders[idx].Hash(),
 				)
+				k.decrementSubaccountOpenOrderCount(ctx, true, execution.NewOrdersEvent.BuyOrders[idx].SubaccountID())
 			}
 
 			for idx := range execution.NewOrdersEvent.SellOrders {
@@ -163,6 +165,7 @@ func (k *Keeper) PersistSpotMatchingExecution(ctx sdk.Context, batchSpotMatching
 					marketID, false,
 					execution.NewOrdersEvent.SellOrders[idx].Hash(),
 				)
+				k.decrementSubaccountOpenOrderCount(ctx, true, execution.NewOrdersEvent.SellOrders[idx].SubaccountID())
 			}
 
 			// nolint:errcheck //ignored on purpose
@@ -176,6 +179,7 @@ func (k *Keeper) PersistSpotMatchingExecution(ctx sdk.Context, batchSpotMatching
 		for idx := range execution.LimitOrderExecutionEvent {
 			if execution.LimitOrderExecutionEvent[idx] != nil {
 				tradeEvent := execution.LimitOrderExecutionEvent[idx]
+				k.recordSpotOrderFills(ctx, tradeEvent)
 				// nolint:errcheck //ignored on purpose
 				ctx.EventManager().EmitTypedEvent(tradeEvent)
 			}
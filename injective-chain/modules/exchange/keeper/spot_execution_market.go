@@ -151,6 +151,7 @@ func (k *Keeper) PersistSingleSpotMarketOrderExecution(
 		ctx.EventManager().EmitTypedEvent(execution.MarketOrderExecutionEvent)
 	}
 	if len(execution.LimitOrderExecutionEvent) > 0 {
+		k.recordSpotOrderFills(ctx, execution.LimitOrderExecutionEvent[0])
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(execution.LimitOrderExecutionEvent[0])
 	}
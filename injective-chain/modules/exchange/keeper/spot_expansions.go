@@ -242,7 +242,7 @@ func (k *Keeper) getRestingSpotLimitBuyStateExpansion(
 
 		// nolint:all
 		// matchedFeeRefund = max(discountedMakerFeeRate, 0) * fillQuantity * priceDelta
-		matchedFeeRefund := positiveDiscountedFeeRatePart.Mul(fillQuantity.Mul(priceDelta))
+		matchedFeeRefund := types.CalculateFee(fillQuantity.Mul(priceDelta), positiveDiscountedFeeRatePart)
 
 		// nolint:all
 		// quoteRefund += (1 + max(makerFeeRate, 0)) * fillQuantity * priceDelta
@@ -252,7 +252,7 @@ func (k *Keeper) getRestingSpotLimitBuyStateExpansion(
 	if feeData.totalTradeFee.IsPositive() {
 		positiveMakerFeeRatePart := sdk.MaxDec(makerFeeRate, sdk.ZeroDec())
 		makerFeeRateDelta := positiveMakerFeeRatePart.Sub(feeData.discountedTradeFeeRate)
-		matchedFeeDiscountRefund := fillQuantity.Mul(order.OrderInfo.Price).Mul(makerFeeRateDelta)
+		matchedFeeDiscountRefund := types.CalculateFee(fillQuantity.Mul(order.OrderInfo.Price), makerFeeRateDelta)
 		quoteRefund = quoteRefund.Add(matchedFeeDiscountRefund)
 	}
 
@@ -312,7 +312,7 @@ func (k *Keeper) getTransientSpotLimitBuyStateExpansion(
 		// Clearing Refund = FillQuantity * (Price - ClearingPrice)
 		clearingChargeOrRefund = fillQuantity.Mul(priceDelta)
 		// Matched Fee Refund = FillQuantity * TakerFeeRate * (Price - ClearingPrice)
-		matchedFeeRefund = fillQuantity.Mul(feeData.discountedTradeFeeRate).Mul(priceDelta)
+		matchedFeeRefund = types.CalculateFee(fillQuantity, feeData.discountedTradeFeeRate).Mul(priceDelta)
 	}
 
 	// limit buys are credited with the order fill quantity in base denom
@@ -323,7 +323,7 @@ func (k *Keeper) getTransientSpotLimitBuyStateExpansion(
 	positiveMakerFeePart := sdk.MaxDec(sdk.ZeroDec(), makerFeeRate)
 
 	unfilledQuantity := order.OrderInfo.Quantity.Sub(fillQuantity)
-	unmatchedFeeRefund := unfilledQuantity.Mul(order.OrderInfo.Price).Mul(takerFeeRate.Sub(positiveMakerFeePart))
+	unmatchedFeeRefund := types.CalculateFee(unfilledQuantity.Mul(order.OrderInfo.Price), takerFeeRate.Sub(positiveMakerFeePart))
 	// Fee Refund = Matched Fee Refund + Unmatched Fee Refund
 	feeRefund := matchedFeeRefund.Add(unmatchedFeeRefund)
 	// refund amount = clearing charge or refund + matched fee refund + unmatched fee refund
@@ -331,7 +331,7 @@ func (k *Keeper) getTransientSpotLimitBuyStateExpansion(
 	order.Fillable = order.Fillable.Sub(fillQuantity)
 
 	takerFeeRateDelta := takerFeeRate.Sub(feeData.discountedTradeFeeRate)
-	matchedFeeDiscountRefund := fillQuantity.Mul(order.OrderInfo.Price).Mul(takerFeeRateDelta)
+	matchedFeeDiscountRefund := types.CalculateFee(fillQuantity.Mul(order.OrderInfo.Price), takerFeeRateDelta)
 	quoteRefundAmount = quoteRefundAmount.Add(matchedFeeDiscountRefund)
 
 	stateExpansion := spotOrderStateExpansion{
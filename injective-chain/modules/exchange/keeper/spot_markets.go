@@ -255,6 +255,13 @@ func (k *Keeper) ExecuteSpotMarketParamUpdateProposal(ctx sdk.Context, p *types.
 		return errors.Wrapf(types.ErrMarketInvalid, "market is not available, market_id %s", p.MarketId)
 	}
 
+	if !prevMarket.IsActive() && p.Status == types.MarketStatus_Active {
+		if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return err
+		}
+	}
+
 	if p.Status == types.MarketStatus_Demolished {
 		k.CancelAllRestingLimitOrdersFromSpotMarket(ctx, prevMarket, prevMarket.MarketID())
 	}
@@ -275,6 +282,7 @@ func (k *Keeper) ExecuteSpotMarketParamUpdateProposal(ctx sdk.Context, p *types.
 		p.RelayerFeeShareRate,
 		p.MinPriceTickSize,
 		p.MinQuantityTickSize,
+		p.MaxSlippageRatio,
 		p.Status,
 	)
 
@@ -295,7 +303,7 @@ func (k *Keeper) GetSpotMarketByID(ctx sdk.Context, marketID common.Hash) *types
 func (k *Keeper) UpdateSpotMarketParam(
 	ctx sdk.Context,
 	marketID common.Hash,
-	makerFeeRate, takerFeeRate, relayerFeeShareRate, minPriceTickSize, minQuantityTickSize *sdk.Dec,
+	makerFeeRate, takerFeeRate, relayerFeeShareRate, minPriceTickSize, minQuantityTickSize, maxSlippageRatio *sdk.Dec,
 	status types.MarketStatus,
 ) *types.SpotMarket {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
@@ -316,6 +324,9 @@ func (k *Keeper) UpdateSpotMarketParam(
 	market.RelayerFeeShareRate = *relayerFeeShareRate
 	market.MinPriceTickSize = *minPriceTickSize
 	market.MinQuantityTickSize = *minQuantityTickSize
+	if maxSlippageRatio != nil {
+		market.MaxSlippageRatio = *maxSlippageRatio
+	}
 	market.Status = status
 	k.SetSpotMarket(ctx, market)
 
@@ -358,6 +369,11 @@ func (k *Keeper) SpotMarketLaunchWithCustomFees(
 		return nil, errors.Wrapf(types.ErrSpotMarketExists, "ticker %s baseDenom %s quoteDenom %s", ticker, baseDenom, quoteDenom)
 	}
 
+	if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	market := types.SpotMarket{
 		Ticker:              ticker,
 		BaseDenom:           baseDenom,
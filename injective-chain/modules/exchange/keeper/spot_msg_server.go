@@ -57,6 +57,12 @@ func (k SpotMsgServer) InstantSpotMarketLaunch(goCtx context.Context, msg *types
 		return nil, err
 	}
 
+	if err := k.ChargeMarketCreationFee(ctx, senderAddr); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("failed launching spot market", err)
+		return nil, err
+	}
+
 	return &types.MsgInstantSpotMarketLaunchResponse{}, nil
 }
 
@@ -72,6 +78,11 @@ func (k SpotMsgServer) CreateSpotLimitOrder(goCtx context.Context, msg *types.Ms
 		return nil, err
 	}
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, account, 1); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	return &types.MsgCreateSpotLimitOrderResponse{
 		OrderHash: orderHash.Hex(),
 	}, nil
@@ -106,6 +117,9 @@ func (k *Keeper) createSpotLimitOrder(
 		if market == nil {
 			k.Logger(ctx).Error("active spot market doesn't exist", "marketId", order.MarketId)
 			metrics.ReportFuncError(k.svcTags)
+			if k.IsMarketPaused(ctx, marketID) {
+				return orderHash, types.ErrMarketPaused.Wrapf("spot market %s is paused", order.MarketId)
+			}
 			return orderHash, sdkerrors.Wrapf(types.ErrSpotMarketNotFound, "active spot market doesn't exist %s", order.MarketId)
 		}
 	}
@@ -116,6 +130,16 @@ func (k *Keeper) createSpotLimitOrder(
 		return orderHash, err
 	}
 
+	// 3a. Reject if the order would already be expired
+	if err := k.ensureValidOrderExpiration(ctx, &order.OrderInfo); err != nil {
+		return orderHash, err
+	}
+
+	// 3b. Reject if the market is outside of its trading hours
+	if err := k.ensureMarketWithinTradingHours(ctx, marketID); err != nil {
+		return orderHash, err
+	}
+
 	// 4. Check for post-only orders (or if in post-only mode) if order crosses tob
 	isPostOnlyMode := k.IsPostOnlyMode(ctx)
 	if (order.OrderType.IsPostOnly() || isPostOnlyMode) && k.SpotOrderCrossesTopOfBook(ctx, order) {
@@ -131,6 +155,17 @@ func (k *Keeper) createSpotLimitOrder(
 		return orderHash, types.ErrClientOrderIdAlreadyExists
 	}
 
+	// 6a. Reject non-conditional order if the subaccount already has the maximum number of open orders
+	if !order.IsConditional() {
+		if err := k.EnsureSubaccountOpenOrderCapacity(ctx, subaccountID); err != nil {
+			return orderHash, err
+		}
+	}
+
+	if err := k.EnsureCrossMarginRequirement(ctx, subaccountID, marginDenom, balanceHoldIncrement); err != nil {
+		return orderHash, err
+	}
+
 	// 7. Decrement the available balance or bank by the funds amount needed to fund the order
 	if err := k.chargeAccount(ctx, subaccountID, marginDenom, balanceHoldIncrement); err != nil {
 		return orderHash, err
@@ -150,6 +185,8 @@ func (k *Keeper) createSpotLimitOrder(
 		return orderHash, nil
 	}
 
+	k.recordOrderPlacement(ctx, marketID, subaccountID, orderHash, order.IsBuy(), order.OrderInfo.Price, order.OrderInfo.Quantity)
+
 	// 9b. store the order in the spot limit order store or transient spot limit order store
 	if order.OrderType.IsPostOnly() {
 		k.SetNewSpotLimitOrder(ctx, spotLimitOrder, marketID, spotLimitOrder.IsBuy(), spotLimitOrder.Hash())
@@ -201,6 +238,9 @@ func (k SpotMsgServer) CreateSpotMarketOrder(goCtx context.Context, msg *types.M
 	if market == nil {
 		k.Logger(ctx).Error("active spot market doesn't exist", "marketId", msg.Order.MarketId)
 		metrics.ReportFuncError(k.svcTags)
+		if k.IsMarketPaused(ctx, marketID) {
+			return nil, types.ErrMarketPaused.Wrapf("spot market %s is paused", msg.Order.MarketId)
+		}
 		return nil, sdkerrors.Wrapf(types.ErrSpotMarketNotFound, "active spot market doesn't exist %s", msg.Order.MarketId)
 	}
 
@@ -209,6 +249,10 @@ func (k SpotMsgServer) CreateSpotMarketOrder(goCtx context.Context, msg *types.M
 		return nil, err
 	}
 
+	if err := k.ensureMarketWithinTradingHours(ctx, marketID); err != nil {
+		return nil, err
+	}
+
 	// 1b. Reject order if client order id is already used
 	if k.existsCid(ctx, subaccountID, msg.Order.OrderInfo.Cid) {
 		return nil, types.ErrClientOrderIdAlreadyExists
@@ -248,6 +292,11 @@ func (k SpotMsgServer) CreateSpotMarketOrder(goCtx context.Context, msg *types.M
 		return nil, types.ErrSlippageExceedsWorstPrice
 	}
 
+	// bound the worst price before computing the balance hold, so that the order is never filled beyond it
+	if err := k.applySpotMarketOrderSlippageBound(ctx, market, &msg.Order, *bestPrice); err != nil {
+		return nil, err
+	}
+
 	// 4. Check available balance to fund the market order factoring in fee discounts, based on the worst acceptable price for the market order
 	feeRate := market.TakerFeeRate
 	if msg.Order.OrderType.IsAtomic() {
@@ -256,6 +305,10 @@ func (k SpotMsgServer) CreateSpotMarketOrder(goCtx context.Context, msg *types.M
 
 	balanceHold := msg.Order.GetMarketOrderBalanceHold(feeRate, *bestPrice)
 
+	if err := k.EnsureCrossMarginRequirement(ctx, subaccountID, marginDenom, balanceHold); err != nil {
+		return nil, err
+	}
+
 	// 5. Decrement deposit's AvailableBalance by the balance hold
 	if err := k.chargeAccount(ctx, subaccountID, marginDenom, balanceHold); err != nil {
 		return nil, err
@@ -273,6 +326,11 @@ func (k SpotMsgServer) CreateSpotMarketOrder(goCtx context.Context, msg *types.M
 
 	k.CheckAndSetFeeDiscountAccountActivityIndicator(ctx, marketID, sender)
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, sender, 1); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	response := &types.MsgCreateSpotMarketOrderResponse{
 		OrderHash: orderHash.Hex(),
 	}
@@ -315,6 +373,11 @@ func (k SpotMsgServer) BatchCreateSpotLimitOrders(goCtx context.Context, msg *ty
 		ctx.EventManager().EmitTypedEvent(&orderFailEvent)
 	}
 
+	if err := k.ChargeOrderPlacementSurcharge(ctx, sender, countCreatedOrders(orderHashes)); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	return &types.MsgBatchCreateSpotLimitOrdersResponse{
 		OrderHashes: orderHashes,
 	}, nil
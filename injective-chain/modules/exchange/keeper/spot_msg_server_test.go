@@ -456,7 +456,49 @@ var _ = Describe("Spot MsgServer Tests", func() {
 			})
 		})
 
-		// TODO - Somehow add more decimals that allowed
+		Context("With price and quantity aligned to the tick sizes", func() {
+			BeforeEach(func() {
+				message.Order.OrderInfo.Price = sdk.NewDec(2).Add(spotMarket.MinPriceTickSize)
+				message.Order.OrderInfo.Quantity = sdk.NewDec(24).Add(spotMarket.MinQuantityTickSize)
+			})
+
+			It("Should be valid", func() {
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Context("When price tick size is wrong", func() {
+			BeforeEach(func() {
+				message.Order.OrderInfo.Price = sdk.NewDecWithPrec(200001, 5)
+			})
+
+			It("Should be invalid with invalid price error", func() {
+				errorMessage1 := "price " + message.Order.OrderInfo.Price.String()
+				errorMessage2 := " must be a multiple of the minimum price tick size " + spotMarket.MinPriceTickSize.String() + ": "
+				expectedError := errorMessage1 + errorMessage2 + types.ErrPriceTickSizeMisaligned.Error()
+				Expect(err.Error()).To(Equal(expectedError))
+			})
+
+			It("Should have not updated balances", func() {
+				depositAfter := testexchange.GetBankAndDepositFunds(app, ctx, common.HexToHash(subaccountID), quoteDenom)
+
+				Expect(depositAfter.AvailableBalance).To(Equal(deposit.AvailableBalance))
+				Expect(depositAfter.TotalBalance).To(Equal(deposit.TotalBalance))
+			})
+		})
+
+		Context("When quantity tick size is wrong", func() {
+			BeforeEach(func() {
+				message.Order.OrderInfo.Quantity = sdk.NewDecWithPrec(2400001, 5)
+			})
+
+			It("Should be invalid with invalid quantity error", func() {
+				errorMessage1 := "quantity " + message.Order.OrderInfo.Quantity.String()
+				errorMessage2 := " must be a multiple of the minimum quantity tick size " + spotMarket.MinQuantityTickSize.String() + ": "
+				expectedError := errorMessage1 + errorMessage2 + types.ErrQuantityTickSizeMisaligned.Error()
+				Expect(err.Error()).To(Equal(expectedError))
+			})
+		})
 	})
 
 	Describe("CreateSpotLimitOrder buy orders with PostOnly mode", func() {
@@ -42,6 +42,11 @@ func (k *Keeper) SetNewSpotLimitOrder(
 
 	// set the cid
 	k.setCid(ctx, false, order.SubaccountID(), order.Cid(), marketID, isBuy, orderHash)
+
+	// index the order by its expiration timestamp
+	k.setOrderExpiration(ctx, true, marketID, isBuy, order.SubaccountID(), orderHash, order.OrderInfo.ExpirationTimestamp)
+
+	k.incrementSubaccountOpenOrderCount(ctx, false, order.SubaccountID())
 }
 
 // SetConditionalSpotMarketOrder stores conditional order in a store
@@ -279,7 +284,7 @@ func GetSpotOrdersToCancelUpToAmount(
 		}
 
 		notional := order.Fillable.Mul(order.Price)
-		fee := notional.Mul(positiveMakerFeePart)
+		fee := types.CalculateFee(notional, positiveMakerFeePart)
 		cumulativeQuoteAmount = cumulativeQuoteAmount.Add(notional).Add(fee)
 	}
 
@@ -362,6 +367,7 @@ func (k *Keeper) CancelSpotLimitOrder(
 
 	// 3. Delete the order state from ordersStore and ordersIndexStore
 	k.DeleteSpotLimitOrder(ctx, marketID, isBuy, order)
+	k.recordOrderTermination(ctx, order.Hash(), types.OrderHistoryEventType_OrderHistoryCancelled)
 
 	// nolint:errcheck // ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventCancelSpotOrder{
@@ -480,6 +486,9 @@ func (k *Keeper) DeleteSpotLimitOrder(
 	subaccountKey := types.GetLimitOrderIndexKey(marketID, isBuy, order.SubaccountID(), common.BytesToHash(order.OrderHash))
 
 	priceKey := ordersIndexStore.Get(subaccountKey)
+	if priceKey != nil {
+		k.decrementSubaccountOpenOrderCount(ctx, false, order.SubaccountID())
+	}
 
 	// delete main spot order store
 	ordersStore := prefix.NewStore(store, types.SpotLimitOrdersPrefix)
@@ -491,6 +500,9 @@ func (k *Keeper) DeleteSpotLimitOrder(
 	// delete cid
 	k.deleteCid(ctx, false, order.SubaccountID(), order.Cid())
 
+	// delete from expiration index
+	k.deleteOrderExpiration(ctx, marketID, isBuy, common.BytesToHash(order.OrderHash), order.OrderInfo.ExpirationTimestamp)
+
 	// update orderbook metadata
 	k.DecrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, true, order.GetPrice(), order.GetFillable())
 }
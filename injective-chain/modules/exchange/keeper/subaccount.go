@@ -510,6 +510,7 @@ func (k *Keeper) CancelReduceOnlySubaccountOrders(
 
 		cumulativeReduceOnlyQuantityToCancel = cumulativeReduceOnlyQuantityToCancel.Add(order.Fillable)
 		orders = append(orders, order)
+		k.recordOrderTermination(ctx, order.Hash(), types.OrderHistoryEventType_OrderHistoryCancelled)
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(&types.EventCancelDerivativeOrder{
 			MarketId:      marketID.Hex(),
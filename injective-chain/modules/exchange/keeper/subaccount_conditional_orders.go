@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetSubaccountConditionalOrderCount returns the number of untriggered conditional orders (market and limit) of the
+// subaccount across all markets.
+func (k *Keeper) GetSubaccountConditionalOrderCount(ctx sdk.Context, subaccountID common.Hash) uint32 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetSubaccountConditionalOrderCountKey(subaccountID))
+	if bz == nil {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// EnsureSubaccountConditionalOrderCapacity returns an error if the subaccount already has the maximum number of conditional orders.
+func (k *Keeper) EnsureSubaccountConditionalOrderCapacity(ctx sdk.Context, subaccountID common.Hash) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	maxConditionalOrders := k.GetParams(ctx).MaxConditionalOrdersPerSubaccount
+	if maxConditionalOrders == 0 {
+		return nil
+	}
+
+	if conditionalOrders := k.GetSubaccountConditionalOrderCount(ctx, subaccountID); conditionalOrders >= maxConditionalOrders {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrExceedsMaxConditionalOrders.Wrapf("subaccount %s has %d conditional orders, the maximum is %d", subaccountID.Hex(), conditionalOrders, maxConditionalOrders)
+	}
+
+	return nil
+}
+
+// InitializeSubaccountConditionalOrderCounts recomputes the conditional order counts of all subaccounts from the resting conditional derivative orders.
+func (k *Keeper) InitializeSubaccountConditionalOrderCounts(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	countStore := prefix.NewStore(store, types.SubaccountConditionalOrderCountPrefix)
+
+	iterator := countStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		countStore.Delete(key)
+	}
+
+	counts := make(map[common.Hash]uint32)
+	subaccountIDs := make([]common.Hash, 0)
+
+	for _, indexPrefix := range [][]byte{types.DerivativeConditionalMarketOrdersIndexPrefix, types.DerivativeConditionalLimitOrdersIndexPrefix} {
+		indexStore := prefix.NewStore(store, indexPrefix)
+		indexIterator := indexStore.Iterator(nil, nil)
+
+		for ; indexIterator.Valid(); indexIterator.Next() {
+			// key is marketID + isTriggerPriceHigher + subaccountID + orderHash
+			subaccountID := common.BytesToHash(indexIterator.Key()[common.HashLength+1 : 2*common.HashLength+1])
+			if _, ok := counts[subaccountID]; !ok {
+				subaccountIDs = append(subaccountIDs, subaccountID)
+			}
+			counts[subaccountID]++
+		}
+		indexIterator.Close()
+	}
+
+	for _, subaccountID := range subaccountIDs {
+		k.setSubaccountConditionalOrderCount(ctx, subaccountID, counts[subaccountID])
+	}
+}
+
+func (k *Keeper) incrementSubaccountConditionalOrderCount(ctx sdk.Context, subaccountID common.Hash) {
+	k.setSubaccountConditionalOrderCount(ctx, subaccountID, k.GetSubaccountConditionalOrderCount(ctx, subaccountID)+1)
+}
+
+func (k *Keeper) decrementSubaccountConditionalOrderCount(ctx sdk.Context, subaccountID common.Hash) {
+	count := k.GetSubaccountConditionalOrderCount(ctx, subaccountID)
+	if count == 0 {
+		return
+	}
+
+	k.setSubaccountConditionalOrderCount(ctx, subaccountID, count-1)
+}
+
+func (k *Keeper) setSubaccountConditionalOrderCount(ctx sdk.Context, subaccountID common.Hash, count uint32) {
+	store := k.getStore(ctx)
+	key := types.GetSubaccountConditionalOrderCountKey(subaccountID)
+
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(count)))
+}
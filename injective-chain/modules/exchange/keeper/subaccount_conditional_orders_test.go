@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Max Conditional Orders Per Subaccount", func() {
+	var (
+		testInput     testexchange.TestInput
+		app           *simapp.InjectiveApp
+		ctx           sdk.Context
+		msgServer     types.MsgServer
+		market        *types.DerivativeMarket
+		subaccountID  = testexchange.SampleSubaccountAddr1
+		startingPrice = sdk.NewDec(2000)
+		orderHashes   []common.Hash
+	)
+
+	setMarkPrice := func(price sdk.Dec) {
+		perp := testInput.Perps[0]
+		app.OracleKeeper.SetPriceFeedPriceState(ctx, perp.OracleBase, perp.OracleQuote, oracletypes.NewPriceState(price, ctx.BlockTime().Unix()))
+	}
+
+	// createStopBuyOrder places a vanilla conditional limit order buying 1 contract once the mark price reaches the trigger price
+	createStopBuyOrder := func(triggerPrice sdk.Dec) (common.Hash, error) {
+		resp, err := msgServer.CreateDerivativeLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCreateDerivativeLimitOrder{
+			Sender: types.SubaccountIDToSdkAddress(subaccountID).String(),
+			Order: types.DerivativeOrder{
+				MarketId: market.MarketId,
+				OrderInfo: types.OrderInfo{
+					SubaccountId: subaccountID.Hex(),
+					FeeRecipient: types.SubaccountIDToSdkAddress(subaccountID).String(),
+					Price:        triggerPrice,
+					Quantity:     sdk.OneDec(),
+				},
+				OrderType:    types.OrderType_STOP_BUY,
+				Margin:       sdk.NewDec(1000),
+				TriggerPrice: &triggerPrice,
+			},
+		})
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return common.HexToHash(resp.OrderHash), nil
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		testInput, ctx = testexchange.SetupTest(app, ctx, 0, 1, 0)
+		perp := testInput.Perps[0]
+		setMarkPrice(startingPrice)
+
+		sender := types.SubaccountIDToSdkAddress(testexchange.SampleSubaccountAddr3)
+		coin := sdk.NewCoin(perp.QuoteDenom, sdk.OneInt())
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(coin)))
+		testexchange.OrFail(app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, coin, perp.Ticker, perp.QuoteDenom, perp.OracleBase, perp.OracleQuote, perp.OracleType, -1))
+
+		var err error
+		market, _, err = app.ExchangeKeeper.PerpetualMarketLaunch(
+			ctx,
+			perp.Ticker,
+			perp.QuoteDenom,
+			perp.OracleBase,
+			perp.OracleQuote,
+			0,
+			perp.OracleType,
+			perp.InitialMarginRatio,
+			perp.MaintenanceMarginRatio,
+			perp.MakerFeeRate,
+			perp.TakerFeeRate,
+			perp.MinPriceTickSize,
+			perp.MinQuantityTickSize,
+		)
+		testexchange.OrFail(err)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MaxConditionalOrdersPerSubaccount = 2
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		testexchange.MintAndDeposit(app, ctx, subaccountID.Hex(), sdk.NewCoins(sdk.NewInt64Coin(perp.QuoteDenom, 100000)))
+
+		orderHashes = make([]common.Hash, 0)
+		for _, triggerPrice := range []int64{2100, 2200} {
+			orderHash, err := createStopBuyOrder(sdk.NewDec(triggerPrice))
+			Expect(err).To(BeNil())
+			orderHashes = append(orderHashes, orderHash)
+		}
+	})
+
+	It("counts the conditional orders of the subaccount", func() {
+		Expect(app.ExchangeKeeper.GetSubaccountConditionalOrderCount(ctx, subaccountID)).To(Equal(uint32(2)))
+		Expect(app.ExchangeKeeper.GetSubaccountOpenOrderCount(ctx, subaccountID)).To(Equal(uint32(0)))
+	})
+
+	It("rejects new conditional orders once the cap is reached", func() {
+		_, err := createStopBuyOrder(sdk.NewDec(2300))
+		Expect(err).To(MatchError(types.ErrExceedsMaxConditionalOrders))
+		Expect(app.ExchangeKeeper.GetSubaccountConditionalOrderCount(ctx, subaccountID)).To(Equal(uint32(2)))
+	})
+
+	It("frees a slot when a conditional order is triggered", func() {
+		setMarkPrice(sdk.NewDec(2150))
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		// the triggered order rests on the book as a regular limit order
+		Expect(app.ExchangeKeeper.GetSubaccountConditionalOrderCount(ctx, subaccountID)).To(Equal(uint32(1)))
+		Expect(app.ExchangeKeeper.GetSubaccountOpenOrderCount(ctx, subaccountID)).To(Equal(uint32(1)))
+
+		_, err := createStopBuyOrder(sdk.NewDec(2300))
+		Expect(err).To(BeNil())
+
+		_, err = createStopBuyOrder(sdk.NewDec(2400))
+		Expect(err).To(MatchError(types.ErrExceedsMaxConditionalOrders))
+	})
+
+	It("frees a slot when a conditional order is cancelled", func() {
+		testexchange.OrFail(app.ExchangeKeeper.CancelConditionalDerivativeLimitOrder(ctx, market, subaccountID, nil, orderHashes[1]))
+		Expect(app.ExchangeKeeper.GetSubaccountConditionalOrderCount(ctx, subaccountID)).To(Equal(uint32(1)))
+
+		_, err := createStopBuyOrder(sdk.NewDec(2300))
+		Expect(err).To(BeNil())
+		Expect(app.ExchangeKeeper.GetSubaccountConditionalOrderCount(ctx, subaccountID)).To(Equal(uint32(2)))
+	})
+})
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// AppendSubaccountFundingPayments records the funding payment of every position of a perpetual market at a funding
+// interval, given the funding paid per unit of a long position. Longs pay the funding and shorts receive it, the other
+// way around when the funding is negative. As the funding rate history, the history of each subaccount and market only
+// keeps the last SubaccountFundingHistorySize payments.
+func (k *Keeper) AppendSubaccountFundingPayments(ctx sdk.Context, marketID common.Hash, timestamp int64, fundingRate, fundingRatePayment sdk.Dec) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	historySize := uint64(k.GetParams(ctx).SubaccountFundingHistorySize)
+
+	k.IteratePositionsByMarket(ctx, marketID, func(position *types.Position, key []byte) (stop bool) {
+		if position.Quantity.IsZero() {
+			return false
+		}
+
+		amount := position.Quantity.Mul(fundingRatePayment)
+		if position.IsLong {
+			amount = amount.Neg()
+		}
+
+		payment := &types.SubaccountFundingPayment{
+			MarketId:    marketID.Hex(),
+			Timestamp:   timestamp,
+			FundingRate: fundingRate,
+			Amount:      amount,
+		}
+
+		k.appendSubaccountFundingPayment(ctx, types.GetSubaccountIDFromPositionKey(key), marketID, historySize, payment)
+		return false
+	})
+}
+
+func (k *Keeper) appendSubaccountFundingPayment(
+	ctx sdk.Context,
+	subaccountID, marketID common.Hash,
+	historySize uint64,
+	payment *types.SubaccountFundingPayment,
+) {
+	historyStore := prefix.NewStore(k.getStore(ctx), types.GetSubaccountMarketFundingHistoryPrefix(subaccountID, marketID))
+	appendBoundedRecord(historyStore, historySize, k.cdc.MustMarshal(payment))
+}
+
+// GetAllSubaccountFundingHistories returns the funding payments of every subaccount, ordered by subaccount ID and
+// by market ID, oldest payment first.
+func (k *Keeper) GetAllSubaccountFundingHistories(ctx sdk.Context) []types.SubaccountFundingHistory {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	historyStore := prefix.NewStore(k.getStore(ctx), types.SubaccountFundingHistoryPrefix)
+
+	iterator := historyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	histories := make([]types.SubaccountFundingHistory, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		subaccountID := common.BytesToHash(iterator.Key()[:common.HashLength]).Hex()
+
+		var payment types.SubaccountFundingPayment
+		k.cdc.MustUnmarshal(iterator.Value(), &payment)
+
+		if len(histories) == 0 || histories[len(histories)-1].SubaccountId != subaccountID {
+			histories = append(histories, types.SubaccountFundingHistory{SubaccountId: subaccountID})
+		}
+
+		history := &histories[len(histories)-1]
+		history.Payments = append(history.Payments, payment)
+	}
+
+	return histories
+}
+
+// GetSubaccountFundingHistory returns a page of the funding payments of a subaccount in a perpetual market, or in
+// every perpetual market by market ID if marketID is nil, oldest payment first unless the page request is reversed.
+func (k *Keeper) GetSubaccountFundingHistory(
+	ctx sdk.Context,
+	subaccountID common.Hash,
+	marketID *common.Hash,
+	pageReq *query.PageRequest,
+) ([]types.SubaccountFundingPayment, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	keyPrefix := types.GetSubaccountFundingHistoryPrefix(subaccountID)
+	if marketID != nil {
+		keyPrefix = types.GetSubaccountMarketFundingHistoryPrefix(subaccountID, *marketID)
+	}
+
+	historyStore := prefix.NewStore(k.getStore(ctx), keyPrefix)
+
+	payments := make([]types.SubaccountFundingPayment, 0)
+	pageRes, err := query.Paginate(historyStore, pageReq, func(_, value []byte) error {
+		var payment types.SubaccountFundingPayment
+		if err := k.cdc.Unmarshal(value, &payment); err != nil {
+			return err
+		}
+
+		payments = append(payments, payment)
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
+	return payments, pageRes, nil
+}
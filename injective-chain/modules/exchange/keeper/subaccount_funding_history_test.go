@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Subaccount Funding History", func() {
+	var (
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		marketID  = common.HexToHash("0x01")
+		marketID2 = common.HexToHash("0x02")
+		long      = testexchange.SampleNonDefaultSubaccountAddr1
+		short     = testexchange.SampleNonDefaultSubaccountAddr2
+		start     = int64(1587556800)
+	)
+
+	setPosition := func(marketID, subaccountID common.Hash, isLong bool, quantity sdk.Dec) {
+		app.ExchangeKeeper.SetPosition(ctx, marketID, subaccountID, &types.Position{
+			IsLong:                 isLong,
+			Quantity:               quantity,
+			EntryPrice:             sdk.NewDec(2000),
+			Margin:                 sdk.NewDec(1000),
+			CumulativeFundingEntry: sdk.ZeroDec(),
+		})
+	}
+
+	// fundIntervals records count hourly funding payments of the market, the nth interval paying n per unit of a long
+	fundIntervals := func(marketID common.Hash, from, count int64) {
+		for i := from; i < from+count; i++ {
+			app.ExchangeKeeper.AppendSubaccountFundingPayments(ctx, marketID, start+i*3600, sdk.NewDecWithPrec(i, 4), sdk.NewDec(i))
+		}
+	}
+
+	queryHistory := func(subaccountID common.Hash, marketID string, pageReq *query.PageRequest) *types.QuerySubaccountFundingHistoryResponse {
+		res, err := app.ExchangeKeeper.SubaccountFundingHistory(sdk.WrapSDKContext(ctx), &types.QuerySubaccountFundingHistoryRequest{
+			SubaccountId: subaccountID.Hex(),
+			MarketId:     marketID,
+			Pagination:   pageReq,
+		})
+		Expect(err).To(BeNil())
+		return res
+	}
+
+	timestamps := func(payments []types.SubaccountFundingPayment) []int64 {
+		ts := make([]int64, 0, len(payments))
+		for _, payment := range payments {
+			ts = append(ts, payment.Timestamp)
+		}
+		return ts
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+
+		setPosition(marketID, long, true, sdk.NewDec(2))
+		setPosition(marketID, short, false, sdk.NewDec(2))
+	})
+
+	It("records the funding paid by the longs to the shorts", func() {
+		fundIntervals(marketID, 1, 2)
+
+		payments := queryHistory(long, marketID.Hex(), nil).Payments
+		Expect(timestamps(payments)).To(Equal([]int64{start + 3600, start + 7200}))
+		Expect(payments[1].MarketId).To(Equal(marketID.Hex()))
+		Expect(payments[1].FundingRate.String()).To(Equal(sdk.NewDecWithPrec(2, 4).String()))
+		Expect(payments[1].Amount.String()).To(Equal(sdk.NewDec(-4).String()))
+
+		payments = queryHistory(short, marketID.Hex(), nil).Payments
+		Expect(payments[1].Amount.String()).To(Equal(sdk.NewDec(4).String()))
+	})
+
+	It("returns the payments of every market without a market filter", func() {
+		setPosition(marketID2, long, false, sdk.NewDec(1))
+		fundIntervals(marketID, 1, 1)
+		fundIntervals(marketID2, 2, 1)
+
+		payments := queryHistory(long, "", nil).Payments
+		Expect(payments).To(HaveLen(2))
+		Expect(payments[0].MarketId).To(Equal(marketID.Hex()))
+		Expect(payments[1].MarketId).To(Equal(marketID2.Hex()))
+		Expect(payments[1].Amount.String()).To(Equal(sdk.NewDec(2).String()))
+
+		Expect(queryHistory(long, marketID2.Hex(), nil).Payments).To(HaveLen(1))
+	})
+
+	It("keeps only the last subaccount_funding_history_size payments", func() {
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.SubaccountFundingHistorySize = 2
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		fundIntervals(marketID, 0, 4)
+
+		Expect(timestamps(queryHistory(long, marketID.Hex(), nil).Payments)).To(Equal([]int64{start + 2*3600, start + 3*3600}))
+	})
+
+	It("exports and imports the histories in genesis", func() {
+		setPosition(marketID2, long, false, sdk.NewDec(1))
+		fundIntervals(marketID, 1, 2)
+		fundIntervals(marketID2, 3, 1)
+
+		state := app.ExchangeKeeper.ExportGenesis(ctx)
+		Expect(state.SubaccountFundingHistories).To(HaveLen(2))
+
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+		app.ExchangeKeeper.InitGenesis(ctx, *state)
+
+		Expect(app.ExchangeKeeper.GetAllSubaccountFundingHistories(ctx)).To(Equal(state.SubaccountFundingHistories))
+
+		// the next payment is appended after the imported ones
+		fundIntervals(marketID, 4, 1)
+		Expect(timestamps(queryHistory(long, marketID.Hex(), nil).Payments)).To(Equal([]int64{start + 3600, start + 7200, start + 4*3600}))
+	})
+
+	It("paginates the history", func() {
+		fundIntervals(marketID, 0, 5)
+
+		res := queryHistory(long, marketID.Hex(), &query.PageRequest{Limit: 2, CountTotal: true})
+		Expect(timestamps(res.Payments)).To(Equal([]int64{start, start + 3600}))
+		Expect(res.Pagination.Total).To(Equal(uint64(5)))
+
+		res = queryHistory(long, marketID.Hex(), &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+		Expect(timestamps(res.Payments)).To(Equal([]int64{start + 2*3600, start + 3*3600}))
+
+		res = queryHistory(long, marketID.Hex(), &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+		Expect(timestamps(res.Payments)).To(Equal([]int64{start + 4*3600}))
+		Expect(res.Pagination.NextKey).To(BeEmpty())
+	})
+
+	It("rejects an invalid subaccount id", func() {
+		_, err := app.ExchangeKeeper.SubaccountFundingHistory(sdk.WrapSDKContext(ctx), &types.QuerySubaccountFundingHistoryRequest{
+			SubaccountId: "0x01",
+		})
+		Expect(err).To(MatchError(types.ErrBadSubaccountID))
+	})
+})
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetSubaccountOpenOrderCount returns the number of open limit orders of the subaccount, which includes
+// the resting limit orders as well as the limit orders placed in the current block.
+func (k *Keeper) GetSubaccountOpenOrderCount(ctx sdk.Context, subaccountID common.Hash) uint32 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return getOpenOrderCount(k.getStore(ctx), subaccountID) + getOpenOrderCount(k.getTransientStore(ctx), subaccountID)
+}
+
+// EnsureSubaccountOpenOrderCapacity returns an error if the subaccount already has the maximum number of open orders.
+// A zero maximum doesn't cap the open orders.
+func (k *Keeper) EnsureSubaccountOpenOrderCapacity(ctx sdk.Context, subaccountID common.Hash) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	maxOpenOrders := k.GetParams(ctx).MaxOpenOrdersPerSubaccount
+	if maxOpenOrders == 0 {
+		return nil
+	}
+
+	if openOrders := k.GetSubaccountOpenOrderCount(ctx, subaccountID); openOrders >= maxOpenOrders {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrExceedsMaxOpenOrders.Wrapf("subaccount %s has %d open orders, the maximum is %d", subaccountID.Hex(), openOrders, maxOpenOrders)
+	}
+
+	return nil
+}
+
+// InitializeSubaccountOpenOrderCounts recomputes the open order counts of all subaccounts, as well as their total, from the resting spot and derivative limit orders.
+func (k *Keeper) InitializeSubaccountOpenOrderCounts(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	countStore := prefix.NewStore(store, types.SubaccountOpenOrderCountPrefix)
+
+	iterator := countStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		countStore.Delete(key)
+	}
+
+	counts := make(map[common.Hash]uint32)
+	subaccountIDs := make([]common.Hash, 0)
+
+	for _, indexPrefix := range [][]byte{types.SpotLimitOrdersIndexPrefix, types.DerivativeLimitOrdersIndexPrefix} {
+		indexStore := prefix.NewStore(store, indexPrefix)
+		indexIterator := indexStore.Iterator(nil, nil)
+
+		for ; indexIterator.Valid(); indexIterator.Next() {
+			// key is marketID + isBuy + subaccountID + orderHash
+			subaccountID := common.BytesToHash(indexIterator.Key()[common.HashLength+1 : 2*common.HashLength+1])
+			if _, ok := counts[subaccountID]; !ok {
+				subaccountIDs = append(subaccountIDs, subaccountID)
+			}
+			counts[subaccountID]++
+		}
+		indexIterator.Close()
+	}
+
+	totalCount := uint64(0)
+	for _, subaccountID := range subaccountIDs {
+		setOpenOrderCount(store, subaccountID, counts[subaccountID])
+		totalCount += uint64(counts[subaccountID])
+	}
+
+	k.setTotalOpenOrderCount(ctx, totalCount)
+}
+
+func (k *Keeper) incrementSubaccountOpenOrderCount(ctx sdk.Context, isTransient bool, subaccountID common.Hash) {
+	store := k.openOrderCountStore(ctx, isTransient)
+	setOpenOrderCount(store, subaccountID, getOpenOrderCount(store, subaccountID)+1)
+
+	if !isTransient {
+		k.incrementTotalOpenOrderCount(ctx)
+	}
+}
+
+// restSubaccountOpenOrder moves an order placed in the current block from the transient open order count of the
+// subaccount to its resting count once the order rests in the book, so that the order is only counted once.
+func (k *Keeper) restSubaccountOpenOrder(ctx sdk.Context, subaccountID common.Hash) {
+	k.decrementSubaccountOpenOrderCount(ctx, true, subaccountID)
+	k.incrementSubaccountOpenOrderCount(ctx, false, subaccountID)
+}
+
+func (k *Keeper) decrementSubaccountOpenOrderCount(ctx sdk.Context, isTransient bool, subaccountID common.Hash) {
+	store := k.openOrderCountStore(ctx, isTransient)
+
+	count := getOpenOrderCount(store, subaccountID)
+	if count == 0 {
+		return
+	}
+
+	setOpenOrderCount(store, subaccountID, count-1)
+
+	if !isTransient {
+		k.decrementTotalOpenOrderCount(ctx)
+	}
+}
+
+func (k *Keeper) openOrderCountStore(ctx sdk.Context, isTransient bool) sdk.KVStore {
+	if isTransient {
+		return k.getTransientStore(ctx)
+	}
+	return k.getStore(ctx)
+}
+
+func getOpenOrderCount(store sdk.KVStore, subaccountID common.Hash) uint32 {
+	bz := store.Get(types.GetSubaccountOpenOrderCountKey(subaccountID))
+	if bz == nil {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+func setOpenOrderCount(store sdk.KVStore, subaccountID common.Hash, count uint32) {
+	key := types.GetSubaccountOpenOrderCountKey(subaccountID)
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(count)))
+}
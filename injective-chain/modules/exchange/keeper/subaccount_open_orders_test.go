@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Max Open Orders Per Subaccount", func() {
+	var (
+		testInput    testexchange.TestInput
+		app          *simapp.InjectiveApp
+		ctx          sdk.Context
+		msgServer    types.MsgServer
+		subaccountID common.Hash
+		orderHashes  []string
+	)
+
+	createOrder := func(price string, orderType types.OrderType) (*types.MsgCreateSpotLimitOrderResponse, error) {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, "1", orderType, subaccountID),
+		)
+		return msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, testInput.Spots[0].Ticker, testInput.Spots[0].BaseDenom, testInput.Spots[0].QuoteDenom, testInput.Spots[0].MinPriceTickSize, testInput.Spots[0].MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		params := app.ExchangeKeeper.GetParams(ctx)
+		params.MaxOpenOrdersPerSubaccount = 3
+		app.ExchangeKeeper.SetParams(ctx, params)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+		subaccountID = testexchange.SampleSubaccountAddr1
+
+		testexchange.MintAndDeposit(app, ctx, subaccountID.String(), sdk.NewCoins(sdk.NewCoin(testInput.Spots[0].QuoteDenom, sdk.NewInt(100000))))
+
+		orderHashes = make([]string, 0)
+		for _, price := range []string{"100", "101", "102"} {
+			resp, err := createOrder(price, types.OrderType_BUY_PO)
+			Expect(err).To(BeNil())
+			orderHashes = append(orderHashes, resp.OrderHash)
+		}
+	})
+
+	It("counts the open orders of the subaccount", func() {
+		Expect(app.ExchangeKeeper.GetSubaccountOpenOrderCount(ctx, subaccountID)).To(Equal(uint32(3)))
+	})
+
+	It("rejects new orders once the cap is reached", func() {
+		_, err := createOrder("103", types.OrderType_BUY)
+		Expect(err).To(MatchError(types.ErrExceedsMaxOpenOrders))
+
+		_, err = createOrder("104", types.OrderType_BUY_PO)
+		Expect(err).To(MatchError(types.ErrExceedsMaxOpenOrders))
+	})
+
+	It("counts an order placed in the current block once after it rests in the book", func() {
+		_, err := msgServer.CancelSpotOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelSpotOrder{
+			Sender:       testexchange.SampleAccountAddr1.String(),
+			MarketId:     testInput.Spots[0].MarketID.Hex(),
+			SubaccountId: subaccountID.Hex(),
+			OrderHash:    orderHashes[0],
+		})
+		Expect(err).To(BeNil())
+
+		_, err = createOrder("103", types.OrderType_BUY)
+		Expect(err).To(BeNil())
+
+		// the transient store is only cleared on commit, after the order already rests in the book
+		app.EndBlocker(ctx, abci.RequestEndBlock{Height: ctx.BlockHeight()})
+		Expect(app.ExchangeKeeper.GetAllSpotLimitOrdersByMarketDirection(ctx, testInput.Spots[0].MarketID, true)).To(HaveLen(3))
+		Expect(app.ExchangeKeeper.GetSubaccountOpenOrderCount(ctx, subaccountID)).To(Equal(uint32(3)))
+	})
+
+	It("allows a new order after an order is cancelled", func() {
+		_, err := msgServer.CancelSpotOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelSpotOrder{
+			Sender:       testexchange.SampleAccountAddr1.String(),
+			MarketId:     testInput.Spots[0].MarketID.Hex(),
+			SubaccountId: subaccountID.Hex(),
+			OrderHash:    orderHashes[0],
+		})
+		Expect(err).To(BeNil())
+		Expect(app.ExchangeKeeper.GetSubaccountOpenOrderCount(ctx, subaccountID)).To(Equal(uint32(2)))
+
+		_, err = createOrder("103", types.OrderType_BUY)
+		Expect(err).To(BeNil())
+		Expect(app.ExchangeKeeper.GetSubaccountOpenOrderCount(ctx, subaccountID)).To(Equal(uint32(3)))
+
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		Expect(app.ExchangeKeeper.GetSubaccountOpenOrderCount(ctx, subaccountID)).To(Equal(uint32(3)))
+
+		_, err = createOrder("104", types.OrderType_BUY)
+		Expect(err).To(MatchError(types.ErrExceedsMaxOpenOrders))
+	})
+})
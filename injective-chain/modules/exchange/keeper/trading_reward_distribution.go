@@ -31,8 +31,7 @@ func (k *Keeper) distributeTradingRewardsForAccount(
 		accountRewardAmount := accountPoints.Points.Mul(availableRewardForDenom.ToDec()).Quo(totalPoints).TruncateInt()
 
 		if coin.Denom == chaintypes.InjectiveCoin && accountRewardAmount.GT(injRewardStakedRequirementThreshold) {
-			maxDelegations := uint16(10)
-			stakedINJ := k.CalculateStakedAmountWithoutCache(ctx, accountPoints.Account, maxDelegations)
+			stakedINJ := k.CalculateStakedAmountWithoutCache(ctx, accountPoints.Account, MaxDelegationsForStakedAmount)
 			minRewardAboveThreshold := injRewardStakedRequirementThreshold
 
 			// at least X amount of INJ (e.g. 100 INJ), but otherwise not more than the staked amount
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetMarketTradingSchedule returns the trading hours of a market, or nil if the market accepts orders at any time.
+func (k *Keeper) GetMarketTradingSchedule(ctx sdk.Context, marketID common.Hash) *types.MarketTradingSchedule {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	scheduleStore := prefix.NewStore(k.getStore(ctx), types.MarketTradingSchedulePrefix)
+
+	bz := scheduleStore.Get(marketID.Bytes())
+	if bz == nil {
+		return nil
+	}
+
+	var schedule types.MarketTradingSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return &schedule
+}
+
+// GetAllMarketTradingSchedules returns the trading hours of all markets with a schedule.
+func (k *Keeper) GetAllMarketTradingSchedules(ctx sdk.Context) []*types.MarketTradingSchedule {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	scheduleStore := prefix.NewStore(k.getStore(ctx), types.MarketTradingSchedulePrefix)
+
+	iterator := scheduleStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	schedules := make([]*types.MarketTradingSchedule, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.MarketTradingSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, &schedule)
+	}
+
+	return schedules
+}
+
+// SetMarketTradingSchedules sets the trading hours of the markets. Schedules without windows remove the trading hours
+// of their market.
+func (k *Keeper) SetMarketTradingSchedules(ctx sdk.Context, schedules []*types.MarketTradingSchedule) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	scheduleStore := prefix.NewStore(k.getStore(ctx), types.MarketTradingSchedulePrefix)
+
+	for _, schedule := range schedules {
+		marketID := common.HexToHash(schedule.MarketId)
+
+		if len(schedule.Windows) == 0 {
+			scheduleStore.Delete(marketID.Bytes())
+			continue
+		}
+
+		scheduleStore.Set(marketID.Bytes(), k.cdc.MustMarshal(schedule))
+	}
+}
+
+// IsMarketWithinTradingHours returns true if the market has no schedule or if the block time falls within one of the
+// windows of its schedule. The block time of the header is used so that all nodes agree on the result.
+func (k *Keeper) IsMarketWithinTradingHours(ctx sdk.Context, marketID common.Hash) bool {
+	schedule := k.GetMarketTradingSchedule(ctx, marketID)
+	return schedule == nil || schedule.IsOpen(ctx.BlockTime())
+}
+
+// ensureMarketWithinTradingHours rejects orders placed in a market outside of its trading hours.
+func (k *Keeper) ensureMarketWithinTradingHours(ctx sdk.Context, marketID common.Hash) error {
+	if !k.IsMarketWithinTradingHours(ctx, marketID) {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrOutsideTradingHours.Wrapf("market %s is closed at block time %s", marketID.Hex(), ctx.BlockTime().UTC().String())
+	}
+
+	return nil
+}
+
+// ProcessTradingScheduleClosures cancels the resting orders of the closed markets whose schedule has
+// cancel_orders_at_close set. The cancellations are bounded per market and block, so markets with more orders are
+// emptied over the next blocks while they stay closed.
+func (k *Keeper) ProcessTradingScheduleClosures(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	for _, schedule := range k.GetAllMarketTradingSchedules(ctx) {
+		if !schedule.CancelOrdersAtClose || schedule.IsOpen(ctx.BlockTime()) {
+			continue
+		}
+
+		marketID := common.HexToHash(schedule.MarketId)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		cancelledCount, _, err := k.CancelAllOrdersInMarket(cacheCtx, marketID)
+		if err != nil {
+			k.Logger(ctx).Error("failed to cancel the orders of the closed market", "marketID", marketID.Hex(), "err", err)
+			continue
+		}
+
+		// markets which are already empty don't emit any event
+		if cancelledCount > 0 {
+			writeCache()
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Trading schedule", func() {
+	var (
+		testInput testexchange.TestInput
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		market    testexchange.SpotMarket
+		trader    = testexchange.SampleSubaccountAddr1
+		openTime  = time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC)
+		// the market closes at 16:00 UTC
+		closeTime = time.Date(2020, time.April, 22, 16, 0, 0, 0, time.UTC)
+	)
+
+	createLimitOrder := func(price string, orderType types.OrderType) (string, error) {
+		msgs := testInput.NewListOfMsgCreateSpotLimitOrderForMarketIndex(0,
+			testexchange.NewBareSpotLimitOrderFromString(price, "1", orderType, trader),
+		)
+		resp, err := msgServer.CreateSpotLimitOrder(sdk.WrapSDKContext(ctx), msgs[0])
+		if err != nil {
+			return "", err
+		}
+		return resp.OrderHash, nil
+	}
+
+	setSchedule := func(cancelOrdersAtClose bool) {
+		app.ExchangeKeeper.SetMarketTradingSchedules(ctx, []*types.MarketTradingSchedule{{
+			MarketId: market.MarketID.Hex(),
+			// 10:00 to 16:00 UTC
+			Windows:             []types.TradingWindow{{StartSecond: 36000, EndSecond: 57600}},
+			CancelOrdersAtClose: cancelOrdersAtClose,
+		}})
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   openTime,
+		})
+		testInput, ctx = testexchange.SetupTest(app, ctx, 1, 0, 0)
+		market = testInput.Spots[0]
+
+		_, err := app.ExchangeKeeper.SpotMarketLaunch(ctx, market.Ticker, market.BaseDenom, market.QuoteDenom, market.MinPriceTickSize, market.MinQuantityTickSize)
+		testexchange.OrFail(err)
+
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+
+		initialFunds := sdk.NewCoins(sdk.NewCoin(market.QuoteDenom, sdk.NewInt(100000)), sdk.NewCoin(market.BaseDenom, sdk.NewInt(100)))
+		testexchange.MintAndDeposit(app, ctx, trader.String(), initialFunds)
+	})
+
+	It("accepts orders within the trading hours", func() {
+		setSchedule(false)
+
+		_, err := createLimitOrder("100", types.OrderType_BUY)
+		Expect(err).To(BeNil())
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+
+		_, err = msgServer.CreateSpotMarketOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateSpotMarketOrder(sdk.OneDec(), sdk.NewDec(90), types.OrderType_SELL, trader))
+		Expect(err).To(BeNil())
+	})
+
+	It("rejects orders outside of the trading hours", func() {
+		setSchedule(false)
+		ctx = ctx.WithBlockTime(closeTime)
+
+		_, err := createLimitOrder("100", types.OrderType_BUY)
+		Expect(err).To(MatchError(types.ErrOutsideTradingHours))
+
+		_, err = msgServer.CreateSpotMarketOrder(sdk.WrapSDKContext(ctx), testInput.NewMsgCreateSpotMarketOrder(sdk.OneDec(), sdk.NewDec(90), types.OrderType_SELL, trader))
+		Expect(err).To(MatchError(types.ErrOutsideTradingHours))
+	})
+
+	It("accepts orders at any time once the schedule is removed", func() {
+		setSchedule(false)
+		app.ExchangeKeeper.SetMarketTradingSchedules(ctx, []*types.MarketTradingSchedule{{MarketId: market.MarketID.Hex()}})
+		ctx = ctx.WithBlockTime(closeTime)
+
+		_, err := createLimitOrder("100", types.OrderType_BUY)
+		Expect(err).To(BeNil())
+		Expect(app.ExchangeKeeper.GetAllMarketTradingSchedules(ctx)).To(BeEmpty())
+	})
+
+	It("cancels the resting orders at close only if requested", func() {
+		orderHash, err := createLimitOrder("100", types.OrderType_BUY)
+		testexchange.OrFail(err)
+		ctx, _ = testexchange.EndBlockerAndCommit(app, ctx)
+		isBuy := true
+
+		setSchedule(false)
+		ctx = ctx.WithBlockTime(closeTime)
+		app.ExchangeKeeper.ProcessTradingScheduleClosures(ctx)
+		Expect(app.ExchangeKeeper.GetSpotLimitOrderBySubaccountID(ctx, market.MarketID, &isBuy, trader, common.HexToHash(orderHash))).ToNot(BeNil())
+
+		setSchedule(true)
+		app.ExchangeKeeper.ProcessTradingScheduleClosures(ctx)
+		Expect(app.ExchangeKeeper.GetSpotLimitOrderBySubaccountID(ctx, market.MarketID, &isBuy, trader, common.HexToHash(orderHash))).To(BeNil())
+	})
+
+	It("returns the schedule and whether the market is open", func() {
+		setSchedule(true)
+
+		res, err := app.ExchangeKeeper.MarketTradingSchedule(sdk.WrapSDKContext(ctx), &types.QueryMarketTradingScheduleRequest{MarketId: market.MarketID.Hex()})
+		Expect(err).To(BeNil())
+		Expect(res.IsOpen).To(BeTrue())
+		Expect(res.Schedule.CancelOrdersAtClose).To(BeTrue())
+
+		ctx = ctx.WithBlockTime(closeTime)
+		res, err = app.ExchangeKeeper.MarketTradingSchedule(sdk.WrapSDKContext(ctx), &types.QueryMarketTradingScheduleRequest{MarketId: market.MarketID.Hex()})
+		Expect(err).To(BeNil())
+		Expect(res.IsOpen).To(BeFalse())
+	})
+})
@@ -60,6 +60,7 @@ func (k *Keeper) SetNewTransientDerivativeLimitOrderWithMetadata(
 	k.SetTransientDerivativeLimitOrderIndicator(ctx, marketID, isBuy)
 	k.SetSubaccountOrderbookMetadata(ctx, marketID, subaccountID, order.IsBuy(), metadata)
 	k.SetSubaccountOrder(ctx, marketID, subaccountID, order.IsBuy(), order.Hash(), types.NewSubaccountOrder(order))
+	k.incrementSubaccountOpenOrderCount(ctx, true, subaccountID)
 }
 
 func (k *Keeper) SetTransientDerivativeLimitOrderIndicator(
@@ -320,7 +321,7 @@ func (k *Keeper) CancelTransientDerivativeLimitOrdersForSubaccountUpToBalance(
 			continue
 		} else {
 			notional := order.OrderInfo.Price.Mul(order.OrderInfo.Quantity)
-			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(notional.Mul(market.TakerFeeRate))).Quo(order.OrderInfo.Quantity)
+			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(types.CalculateFee(notional, market.TakerFeeRate))).Quo(order.OrderInfo.Quantity)
 			freedUpBalance = freedUpBalance.Add(marginHoldRefund)
 		}
 	}
@@ -336,7 +337,7 @@ func (k *Keeper) CancelTransientDerivativeLimitOrdersForSubaccountUpToBalance(
 			continue
 		} else {
 			notional := order.OrderInfo.Price.Mul(order.OrderInfo.Quantity)
-			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(notional.Mul(market.TakerFeeRate))).Quo(order.OrderInfo.Quantity)
+			marginHoldRefund := order.Fillable.Mul(order.Margin.Add(types.CalculateFee(notional, market.TakerFeeRate))).Quo(order.OrderInfo.Quantity)
 			freedUpBalance = freedUpBalance.Add(marginHoldRefund)
 		}
 	}
@@ -499,6 +500,7 @@ func (k *Keeper) CancelTransientDerivativeLimitOrder(
 
 	// 2. Delete the order state from ordersStore and ordersIndexStore
 	k.DeleteTransientDerivativeLimitOrder(ctx, marketID, order)
+	k.recordOrderTermination(ctx, order.Hash(), types.OrderHistoryEventType_OrderHistoryCancelled)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventCancelDerivativeOrder{
@@ -552,6 +554,9 @@ func (k *Keeper) DeleteTransientDerivativeLimitOrder(
 	// marketID common.Hash, isBuy bool, price sdk.Dec, orderHash
 	orderHash := common.BytesToHash(order.OrderHash)
 	key := types.GetLimitOrderByPriceKeyPrefix(marketID, order.IsBuy(), order.OrderInfo.Price, orderHash)
+	if ordersStore.Has(key) {
+		k.decrementSubaccountOpenOrderCount(ctx, true, order.SubaccountID())
+	}
 	ordersStore.Delete(key)
 
 	ordersIndexStore := prefix.NewStore(tStore, types.DerivativeLimitOrdersIndexPrefix)
@@ -646,7 +651,7 @@ func (k *Keeper) CancelAllDerivativeMarketOrders(ctx sdk.Context, market *types.
 
 func (k *Keeper) CancelDerivativeMarketOrder(
 	ctx sdk.Context,
-	market *types.DerivativeMarket,
+	market DerivativeMarketI,
 	order *types.DerivativeMarketOrder,
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
@@ -655,7 +660,7 @@ func (k *Keeper) CancelDerivativeMarketOrder(
 	subaccountID := order.SubaccountID()
 	refundAmount := order.GetCancelRefundAmount()
 
-	k.incrementAvailableBalanceOrBank(ctx, subaccountID, market.QuoteDenom, refundAmount)
+	k.incrementAvailableBalanceOrBank(ctx, subaccountID, market.GetQuoteDenom(), refundAmount)
 	k.DeleteDerivativeMarketOrder(ctx, order, marketID)
 
 	// nolint:errcheck //ignored on purpose
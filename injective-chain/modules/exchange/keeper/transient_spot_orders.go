@@ -45,6 +45,8 @@ func (k *Keeper) SetTransientSpotLimitOrder(
 	}
 
 	k.setCid(ctx, true, order.SubaccountID(), order.Cid(), marketID, isBuy, orderHash)
+
+	k.incrementSubaccountOpenOrderCount(ctx, true, order.SubaccountID())
 }
 
 // GetAllTransientTraderSpotLimitOrders gets all the trimmed transient spot limit orders for a given subaccountID and marketID
@@ -181,6 +183,7 @@ func (k *Keeper) CancelTransientSpotLimitOrder(
 
 	// 3. Delete the order state from ordersStore and ordersIndexStore
 	k.DeleteTransientSpotLimitOrder(ctx, marketID, order)
+	k.recordOrderTermination(ctx, order.Hash(), types.OrderHistoryEventType_OrderHistoryCancelled)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventCancelSpotOrder{
@@ -189,6 +192,29 @@ func (k *Keeper) CancelTransientSpotLimitOrder(
 	})
 }
 
+// CancelTransientSpotMarketOrder cancels the spot market order placed in the current block and refunds its balance hold.
+func (k *Keeper) CancelTransientSpotMarketOrder(
+	ctx sdk.Context,
+	market *types.SpotMarket,
+	order *types.SpotMarketOrder,
+) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketID := market.MarketID()
+	subaccountID := order.SubaccountID()
+
+	balanceHoldDenom := market.BaseDenom
+	if order.IsBuy() {
+		balanceHoldDenom = market.QuoteDenom
+	}
+
+	k.incrementAvailableBalanceOrBank(ctx, subaccountID, balanceHoldDenom, order.BalanceHold)
+
+	ordersStore := prefix.NewStore(k.getTransientStore(ctx), types.SpotMarketOrdersPrefix)
+	ordersStore.Delete(types.GetOrderByPriceKeyPrefix(marketID, order.IsBuy(), order.OrderInfo.Price, order.Hash()))
+	k.deleteCid(ctx, true, subaccountID, order.Cid())
+}
+
 // DeleteTransientSpotLimitOrder deletes the SpotLimitOrder from the transient store.
 func (k *Keeper) DeleteTransientSpotLimitOrder(
 	ctx sdk.Context,
@@ -202,6 +228,9 @@ func (k *Keeper) DeleteTransientSpotLimitOrder(
 	ordersIndexStore := prefix.NewStore(store, types.SpotLimitOrdersIndexPrefix)
 
 	priceKey := types.GetLimitOrderByPriceKeyPrefix(marketID, order.IsBuy(), order.OrderInfo.Price, order.Hash())
+	if ordersStore.Has(priceKey) {
+		k.decrementSubaccountOpenOrderCount(ctx, true, order.SubaccountID())
+	}
 
 	// delete from main spot order store
 	ordersStore.Delete(priceKey)
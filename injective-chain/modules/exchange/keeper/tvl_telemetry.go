@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/InjectiveLabs/metrics"
+	gometrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// TVLGaugeName is the name of the telemetry gauge holding the total deposits of a denom in the exchange.
+const TVLGaugeName = "exchange_tvl"
+
+// tvlTelemetry holds the in-memory total deposits per denom reported by the TVL gauge. It's shared by all the copies
+// of the keeper and never touches the state, so enabling it can't change the outcome of a block.
+type tvlTelemetry struct {
+	mu      sync.Mutex
+	enabled bool
+	// totals is nil until it's loaded from the deposits of the store
+	totals map[string]sdk.Dec
+}
+
+// SetTVLTelemetryEnabled enables or disables the exchange_tvl gauge.
+func (k *Keeper) SetTVLTelemetryEnabled(enabled bool) {
+	k.tvl.mu.Lock()
+	defer k.tvl.mu.Unlock()
+
+	k.tvl.enabled = enabled
+	k.tvl.totals = nil
+}
+
+// IsTVLTelemetryEnabled returns whether the exchange_tvl gauge is enabled.
+func (k *Keeper) IsTVLTelemetryEnabled() bool {
+	k.tvl.mu.Lock()
+	defer k.tvl.mu.Unlock()
+
+	return k.tvl.enabled
+}
+
+// recordTVLDelta accumulates the change of the total balance of a deposit in the transient store, so that the changes
+// of failed txs are discarded with them. It doesn't consume gas, so the gas used doesn't depend on the node config.
+func (k *Keeper) recordTVLDelta(ctx sdk.Context, denom string, oldDeposit, newDeposit *types.Deposit) {
+	newTotal := newDeposit.TotalBalance
+	if newTotal.IsNil() {
+		newTotal = sdk.ZeroDec()
+	}
+
+	delta := newTotal.Sub(oldDeposit.TotalBalance)
+	if delta.IsZero() {
+		return
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store := k.getTransientStore(ctx)
+	key := types.GetTVLDeltaKey(denom)
+
+	if bz := store.Get(key); bz != nil {
+		var previousDelta sdk.Dec
+		if err := previousDelta.Unmarshal(bz); err == nil {
+			delta = delta.Add(previousDelta)
+		}
+	}
+
+	bz, err := delta.Marshal()
+	if err != nil {
+		return
+	}
+	store.Set(key, bz)
+}
+
+// UpdateTVLTelemetry applies the deposit changes of the block to the total deposits per denom and reports them to the
+// exchange_tvl gauge. It must run once all the deposits of the block are settled, after the module EndBlockers. The
+// totals are loaded from the store on the first call.
+func (k *Keeper) UpdateTVLTelemetry(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if !k.IsTVLTelemetryEnabled() {
+		return
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	deltas := k.popTVLDeltas(ctx)
+
+	k.tvl.mu.Lock()
+	defer k.tvl.mu.Unlock()
+
+	if k.tvl.totals == nil {
+		// the deposits of the store already include the changes of this block
+		k.tvl.totals = make(map[string]sdk.Dec)
+		for _, balance := range k.GetAllExchangeBalances(ctx) {
+			total, ok := k.tvl.totals[balance.Denom]
+			if !ok {
+				total = sdk.ZeroDec()
+			}
+			if !balance.Deposits.TotalBalance.IsNil() {
+				total = total.Add(balance.Deposits.TotalBalance)
+			}
+			k.tvl.totals[balance.Denom] = total
+		}
+
+		for denom, total := range k.tvl.totals {
+			setTVLGauge(denom, total)
+		}
+		return
+	}
+
+	for denom, delta := range deltas {
+		total, ok := k.tvl.totals[denom]
+		if !ok {
+			total = sdk.ZeroDec()
+		}
+		total = total.Add(delta)
+		k.tvl.totals[denom] = total
+
+		setTVLGauge(denom, total)
+	}
+}
+
+// popTVLDeltas returns and deletes the deposit changes per denom recorded in the transient store.
+func (k *Keeper) popTVLDeltas(ctx sdk.Context) map[string]sdk.Dec {
+	store := prefix.NewStore(k.getTransientStore(ctx), types.TVLDeltaPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	deltas := make(map[string]sdk.Dec)
+	keys := make([][]byte, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		var delta sdk.Dec
+		if err := delta.Unmarshal(iterator.Value()); err != nil {
+			continue
+		}
+		deltas[string(iterator.Key())] = delta
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return deltas
+}
+
+func setTVLGauge(denom string, total sdk.Dec) {
+	value, err := total.Float64()
+	if err != nil {
+		return
+	}
+
+	telemetry.SetGaugeWithLabels([]string{TVLGaugeName}, float32(value), []gometrics.Label{telemetry.NewLabel("denom", denom)})
+}
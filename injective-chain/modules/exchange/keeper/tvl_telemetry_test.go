@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("TVL telemetry", func() {
+	var (
+		app               *simapp.InjectiveApp
+		ctx               sdk.Context
+		msgServer         types.MsgServer
+		defaultRegisterer prometheus.Registerer
+	)
+	const denom = "usdt"
+	subaccountID := testexchange.SampleNonDefaultSubaccountAddr1
+
+	tvlGauge := func() (float32, bool) {
+		res, err := app.Telemetry.Gather("")
+		testexchange.OrFail(err)
+
+		var summary struct {
+			Gauges []struct {
+				Name   string
+				Value  float32
+				Labels map[string]string
+			}
+		}
+		testexchange.OrFail(json.Unmarshal(res.Metrics, &summary))
+
+		for _, gauge := range summary.Gauges {
+			if gauge.Name == keeper.TVLGaugeName && gauge.Labels["denom"] == denom {
+				return gauge.Value, true
+			}
+		}
+		return 0, false
+	}
+
+	deposit := func(ctx sdk.Context, amount int64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+		testexchange.OrFail(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		testexchange.OrFail(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testexchange.SampleAccountAddr1, coins))
+
+		_, err := msgServer.Deposit(sdk.WrapSDKContext(ctx), &types.MsgDeposit{
+			Sender:       testexchange.SampleAccountAddr1.String(),
+			SubaccountId: subaccountID.Hex(),
+			Amount:       sdk.NewInt64Coin(denom, amount),
+		})
+		testexchange.OrFail(err)
+	}
+
+	withdraw := func(amount int64) {
+		_, err := msgServer.Withdraw(sdk.WrapSDKContext(ctx), &types.MsgWithdraw{
+			Sender:       testexchange.SampleAccountAddr1.String(),
+			SubaccountId: subaccountID.Hex(),
+			Amount:       sdk.NewInt64Coin(denom, amount),
+		})
+		testexchange.OrFail(err)
+	}
+
+	BeforeEach(func() {
+		// the wasm VM metrics of every app with telemetry are registered with the default prometheus registerer
+		defaultRegisterer = prometheus.DefaultRegisterer
+		prometheus.DefaultRegisterer = prometheus.NewRegistry()
+
+		app = simapp.SetupWithAppOptions(false, simapp.TestAppOptions{Values: map[string]interface{}{
+			"telemetry.enabled":             true,
+			simapp.FlagTelemetryExchangeTVL: true,
+		}})
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{
+			Height: 1234567,
+			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+		})
+		msgServer = keeper.NewMsgServerImpl(app.ExchangeKeeper)
+	})
+
+	AfterEach(func() {
+		prometheus.DefaultRegisterer = defaultRegisterer
+	})
+
+	It("is enabled by the telemetry flag", func() {
+		Expect(app.ExchangeKeeper.IsTVLTelemetryEnabled()).To(BeTrue())
+		Expect(simapp.Setup(false).ExchangeKeeper.IsTVLTelemetryEnabled()).To(BeFalse())
+	})
+
+	It("reflects deposits and withdrawals", func() {
+		deposit(ctx, 1000)
+		app.ExchangeKeeper.UpdateTVLTelemetry(ctx)
+
+		value, ok := tvlGauge()
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(float32(1000)))
+
+		deposit(ctx, 500)
+		withdraw(300)
+		app.ExchangeKeeper.UpdateTVLTelemetry(ctx)
+
+		value, _ = tvlGauge()
+		Expect(value).To(Equal(float32(1200)))
+
+		withdraw(1200)
+		app.ExchangeKeeper.UpdateTVLTelemetry(ctx)
+
+		value, _ = tvlGauge()
+		Expect(value).To(Equal(float32(0)))
+	})
+
+	It("ignores the deposit changes of failed txs", func() {
+		deposit(ctx, 1000)
+		app.ExchangeKeeper.UpdateTVLTelemetry(ctx)
+
+		cacheCtx, _ := ctx.CacheContext()
+		deposit(cacheCtx, 500)
+		app.ExchangeKeeper.UpdateTVLTelemetry(ctx)
+
+		value, _ := tvlGauge()
+		Expect(value).To(Equal(float32(1000)))
+	})
+})
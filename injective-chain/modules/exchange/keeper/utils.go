@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -185,6 +187,16 @@ func FilterNotNull[T any](slice []*T) []*T {
 	return filteredSlice
 }
 
+// SortByMarketID sorts the slice in place by the market ID of its elements, keeping the relative order of the elements
+// of the same market. The block processing of several markets goes through their market IDs in this order, so that
+// the cross-market effects of one market on another, such as on the shared deposits of a subaccount, never depend on
+// the order in which the markets were collected.
+func SortByMarketID[T any](slice []T, marketID func(T) common.Hash) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return bytes.Compare(marketID(slice[i]).Bytes(), marketID(slice[j]).Bytes()) < 0
+	})
+}
+
 func SingleElementSlice[T any](element T) []T {
 	slice := make([]T, 1)
 	slice[0] = element
@@ -56,24 +56,6 @@ func (k *Keeper) ensurePositionAboveBankruptcyForClosing(
 	return nil
 }
 
-func (k *Keeper) ensurePositionAboveInitialMarginRatio(
-	position *types.Position,
-	market *types.DerivativeMarket,
-	markPrice sdk.Dec,
-) error {
-	if !position.Quantity.IsPositive() {
-		return nil
-	}
-
-	positionMarginRatio := position.GetEffectiveMarginRatio(markPrice, sdk.ZeroDec())
-
-	if positionMarginRatio.LT(market.InitialMarginRatio) {
-		return errors.Wrapf(types.ErrLowPositionMargin, "position margin ratio %s ≥ %s must hold", positionMarginRatio.String(), market.InitialMarginRatio.String())
-	}
-
-	return nil
-}
-
 func (k *Keeper) handleSyntheticTradeAction(
 	ctx sdk.Context,
 	contractAddress sdk.AccAddress,
@@ -145,7 +127,7 @@ func (k *Keeper) handleSyntheticTradeAction(
 			})
 		}
 
-		tradingFee := trade.Quantity.Mul(markPrice).Mul(market.TakerFeeRate)
+		tradingFee := types.CalculateFee(trade.Quantity.Mul(markPrice), market.TakerFeeRate)
 
 		isClosingPosition := trade.IsBuy != position.IsLong && !position.Quantity.IsZero()
 		if isClosingPosition {
@@ -162,7 +144,7 @@ func (k *Keeper) handleSyntheticTradeAction(
 			return types.ErrNegativePositionQuantity
 		}
 
-		if err := k.ensurePositionAboveInitialMarginRatio(position, market, markPrice); err != nil {
+		if err := k.ensurePositionAboveMarginRequirement(position, market, InitialMarginRequirement, markPrice, nil); err != nil {
 			return err
 		}
 
@@ -281,18 +263,12 @@ func (k *Keeper) handlePositionTransferAction(
 		sourcePosition.ApplyFunding(funding)
 	}
 
-	// Enforce each position's effectiveMargin / (markPrice * quantity) ≥ maintenanceMarginRatio
-	if sourcePosition.Quantity.IsPositive() {
-		positionMarginRatio := sourcePosition.GetEffectiveMarginRatio(markPrice, sdk.ZeroDec())
-		if positionMarginRatio.LT(market.MaintenanceMarginRatio) {
-			return errors.Wrapf(types.ErrLowPositionMargin, "position margin ratio %s ≥ %s must hold", positionMarginRatio.String(), market.MaintenanceMarginRatio.String())
-		}
+	// Enforce that neither position is liquidatable
+	if err := k.ensurePositionAboveMarginRequirement(sourcePosition, market, MaintenanceMarginRequirement, markPrice, nil); err != nil {
+		return err
 	}
-	if destinationPosition.Quantity.IsPositive() {
-		positionMarginRatio := destinationPosition.GetEffectiveMarginRatio(markPrice, sdk.ZeroDec())
-		if positionMarginRatio.LT(market.MaintenanceMarginRatio) {
-			return errors.Wrapf(types.ErrLowPositionMargin, "position margin ratio %s ≥ %s must hold", positionMarginRatio.String(), market.MaintenanceMarginRatio.String())
-		}
+	if err := k.ensurePositionAboveMarginRequirement(destinationPosition, market, MaintenanceMarginRequirement, markPrice, nil); err != nil {
+		return err
 	}
 
 	executionPrice := sourcePosition.EntryPrice
@@ -319,7 +295,7 @@ func (k *Keeper) handlePositionTransferAction(
 		},
 		sdk.ZeroDec(),
 	)
-	receiverTradingFee := markPrice.Mul(action.Quantity).Mul(market.TakerFeeRate)
+	receiverTradingFee := types.CalculateFee(markPrice.Mul(action.Quantity), market.TakerFeeRate)
 
 	k.SetPosition(ctx, action.MarketID, action.SourceSubaccountID, sourcePosition)
 	k.SetPosition(ctx, action.MarketID, action.DestinationSubaccountID, destinationPosition)
@@ -50,6 +50,10 @@ func NewExchangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleBatchCommunityPoolSpendProposal(ctx, k, c)
 		case *types.AtomicMarketOrderFeeMultiplierScheduleProposal:
 			return handleAtomicMarketOrderFeeMultiplierScheduleProposal(ctx, k, c)
+		case *types.TradingScheduleProposal:
+			return handleTradingScheduleProposal(ctx, k, c)
+		case *types.MarketDelistingExemptionProposal:
+			return handleMarketDelistingExemptionProposal(ctx, k, c)
 		default:
 			return errors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized exchange proposal content type: %T", c)
 		}
@@ -168,6 +172,10 @@ func handleSpotMarketParamUpdateProposal(ctx sdk.Context, k keeper.Keeper, p *ty
 	if p.MinQuantityTickSize == nil {
 		p.MinQuantityTickSize = &market.MinQuantityTickSize
 	}
+	if p.MaxSlippageRatio == nil {
+		maxSlippageRatio := market.GetMaxSlippageRatio()
+		p.MaxSlippageRatio = &maxSlippageRatio
+	}
 
 	minimalProtocolFeeRate := k.GetMinimalProtocolFeeRate(ctx)
 	discountSchedule := k.GetFeeDiscountSchedule(ctx)
@@ -179,6 +187,13 @@ func handleSpotMarketParamUpdateProposal(ctx sdk.Context, k keeper.Keeper, p *ty
 		p.Status = market.Status
 	}
 
+	// reactivating a market takes a slot like launching one
+	if !market.IsActive() && p.Status == types.MarketStatus_Active {
+		if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+			return err
+		}
+	}
+
 	// schedule market param change in transient store
 	if err := k.ScheduleSpotMarketParamUpdate(ctx, p); err != nil {
 		return err
@@ -345,6 +360,26 @@ func handleDerivativeMarketParamUpdateProposal(ctx sdk.Context, k keeper.Keeper,
 	if p.MinQuantityTickSize == nil {
 		p.MinQuantityTickSize = &market.MinQuantityTickSize
 	}
+	if p.MaxOpenInterest == nil {
+		maxOpenInterest := market.GetMaxOpenInterest()
+		p.MaxOpenInterest = &maxOpenInterest
+	}
+	if p.MaxPositionSize == nil {
+		maxPositionSize := market.GetMaxPositionSize()
+		p.MaxPositionSize = &maxPositionSize
+	}
+	if p.PriceBandRatio == nil {
+		priceBandRatio := market.GetPriceBandRatio()
+		p.PriceBandRatio = &priceBandRatio
+	}
+	if p.MaxLeverage == nil {
+		maxLeverage := market.GetMaxLeverage()
+		p.MaxLeverage = &maxLeverage
+	}
+	if p.MaxSlippageRatio == nil {
+		maxSlippageRatio := market.GetMaxSlippageRatio()
+		p.MaxSlippageRatio = &maxSlippageRatio
+	}
 	if p.InitialMarginRatio.LT(*p.MaintenanceMarginRatio) {
 		return types.ErrMarginsRelation
 	}
@@ -382,6 +417,13 @@ func handleDerivativeMarketParamUpdateProposal(ctx sdk.Context, k keeper.Keeper,
 		p.Status = market.Status
 	}
 
+	// reactivating a market takes a slot like launching one
+	if !market.IsActive() && p.Status == types.MarketStatus_Active {
+		if err := k.CheckMaxActiveMarkets(ctx); err != nil {
+			return err
+		}
+	}
+
 	// only perpetual markets should have changes to HourlyInterestRate or HourlyFundingRateCap
 	isValidFundingUpdate := market.IsPerpetual || (p.HourlyInterestRate == nil && p.HourlyFundingRateCap == nil)
 
@@ -775,3 +817,45 @@ func handleAtomicMarketOrderFeeMultiplierScheduleProposal(ctx sdk.Context, k kee
 	})
 	return nil
 }
+
+func handleTradingScheduleProposal(ctx sdk.Context, k keeper.Keeper, p *types.TradingScheduleProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, schedule := range p.Schedules {
+		marketID := common.HexToHash(schedule.MarketId)
+		if !k.HasMarket(ctx, marketID) {
+			return types.ErrMarketInvalid.Wrapf("market %s not found", schedule.MarketId)
+		}
+	}
+
+	k.SetMarketTradingSchedules(ctx, p.Schedules)
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventTradingSchedulesUpdated{
+		Schedules: p.Schedules,
+	})
+	return nil
+}
+
+func handleMarketDelistingExemptionProposal(ctx sdk.Context, k keeper.Keeper, p *types.MarketDelistingExemptionProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, marketID := range p.MarketIds {
+		marketHash := common.HexToHash(marketID)
+		if !k.HasMarket(ctx, marketHash) {
+			return types.ErrMarketInvalid.Wrapf("market %s not found", marketID)
+		}
+
+		k.SetMarketDelistingExemption(ctx, marketHash, p.IsExempt)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventMarketDelistingExemptionsUpdated{
+		MarketIds: p.MarketIds,
+		IsExempt:  p.IsExempt,
+	})
+	return nil
+}
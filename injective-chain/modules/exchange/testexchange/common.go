@@ -178,6 +178,8 @@ func GetDefaultTestingExchangeParams() exchangetypes.Params {
 		DerivativeAtomicMarketOrderFeeMultiplier:    sdk.NewDecWithPrec(25, 1),
 		BinaryOptionsAtomicMarketOrderFeeMultiplier: sdk.NewDecWithPrec(25, 1),
 		MinimalProtocolFeeRate:                      sdk.MustNewDecFromStr("0.00005"),
+		MaxExpiredOrdersPerBlock:                    exchangetypes.DefaultMaxExpiredOrdersPerBlock,
+		MakerRebateMaxVolumesPerBlock:               exchangetypes.DefaultMakerRebateMaxVolumesPerBlock,
 	}
 }
 
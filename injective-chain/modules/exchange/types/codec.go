@@ -47,6 +47,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAdminUpdateBinaryOptionsMarket{}, "exchange/MsgAdminUpdateBinaryOptionsMarket", nil)
 	cdc.RegisterConcrete(&MsgReclaimLockedFunds{}, "exchange/MsgReclaimLockedFunds", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "exchange/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgForceSettleMarket{}, "exchange/MsgForceSettleMarket", nil)
+	cdc.RegisterConcrete(&MsgCancelAllOrdersInMarket{}, "exchange/MsgCancelAllOrdersInMarket", nil)
+	cdc.RegisterConcrete(&MsgBatchCancelOrders{}, "exchange/MsgBatchCancelOrders", nil)
+	cdc.RegisterConcrete(&MsgReplaceOrder{}, "exchange/MsgReplaceOrder", nil)
+	cdc.RegisterConcrete(&MsgSetMarginMode{}, "exchange/MsgSetMarginMode", nil)
+	cdc.RegisterConcrete(&MsgTransferPosition{}, "exchange/MsgTransferPosition", nil)
 
 	cdc.RegisterConcrete(&ExchangeEnableProposal{}, "exchange/ExchangeEnableProposal", nil)
 	cdc.RegisterConcrete(&BatchExchangeModificationProposal{}, "exchange/BatchExchangeModificationProposal", nil)
@@ -65,6 +71,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BinaryOptionsMarketParamUpdateProposal{}, "exchange/BinaryOptionsMarketParamUpdateProposal", nil)
 	cdc.RegisterConcrete(&BinaryOptionsMarketLaunchProposal{}, "exchange/BinaryOptionsMarketLaunchProposal", nil)
 	cdc.RegisterConcrete(&AtomicMarketOrderFeeMultiplierScheduleProposal{}, "exchange/AtomicMarketOrderFeeMultiplierScheduleProposal", nil)
+	cdc.RegisterConcrete(&TradingScheduleProposal{}, "exchange/TradingScheduleProposal", nil)
+	cdc.RegisterConcrete(&MarketDelistingExemptionProposal{}, "exchange/MarketDelistingExemptionProposal", nil)
 
 	cdc.RegisterConcrete(&CreateSpotLimitOrderAuthz{}, "exchange/CreateSpotLimitOrderAuthz", nil)
 	cdc.RegisterConcrete(&CreateSpotMarketOrderAuthz{}, "exchange/CreateSpotMarketOrderAuthz", nil)
@@ -111,6 +119,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAdminUpdateBinaryOptionsMarket{},
 		&MsgReclaimLockedFunds{},
 		&MsgUpdateParams{},
+		&MsgForceSettleMarket{},
+		&MsgCancelAllOrdersInMarket{},
+		&MsgBatchCancelOrders{},
+		&MsgReplaceOrder{},
+		&MsgSetMarginMode{},
+		&MsgTransferPosition{},
 	)
 
 	registry.RegisterImplementations(
@@ -145,6 +159,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BinaryOptionsMarketParamUpdateProposal{},
 		&BinaryOptionsMarketLaunchProposal{},
 		&AtomicMarketOrderFeeMultiplierScheduleProposal{},
+		&TradingScheduleProposal{},
+		&MarketDelistingExemptionProposal{},
 	)
 
 	registry.RegisterImplementations(
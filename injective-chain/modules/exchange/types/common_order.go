@@ -73,7 +73,7 @@ func (m *OrderInfo) GetNotional() sdk.Dec {
 }
 
 func (m *OrderInfo) GetFeeAmount(fee sdk.Dec) sdk.Dec {
-	return m.GetNotional().Mul(fee)
+	return CalculateFee(m.GetNotional(), fee)
 }
 
 func (m *OrderInfo) IsFromDefaultSubaccount() bool {
@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -88,6 +89,16 @@ var TempRewardsSenderAddress = sdk.AccAddress(common.HexToAddress(ZeroSubaccount
 // inj1qqq3zyg3zyg3zyg3zyg3zyg3zyg3zyg3c9gg96
 var AuctionFeesAddress = sdk.AccAddress(common.HexToAddress(AuctionSubaccountID.Hex()).Bytes())
 
+// MakerRebatePoolAddress is the account funding the maker rebates when they're paid from the dedicated pool, anyone can
+// fund it with a bank send.
+// inj1txnqt4ku9elq2wjd3yw8v5qrukwk2y0xfxeqh4
+var MakerRebatePoolAddress = authtypes.NewModuleAddress(ModuleName + "_maker_rebate_pool")
+
+// FeeSettlementPoolAddress is the account converting the fees into the fee settlement denom, it buys the fees at the
+// oracle price with the settlement denom it holds. Anyone can fund it with a bank send.
+// inj105jtxsqz5s9qp4vnlncz39547elrym8zn5unvn
+var FeeSettlementPoolAddress = authtypes.NewModuleAddress(ModuleName + "_fee_settlement_pool")
+
 func StringInSlice(a string, list *[]string) bool {
 	for _, b := range *list {
 		if b == a {
@@ -220,21 +220,21 @@ func (o *DerivativeLimitOrder) GetCancelRefundAmount(feeRate sdk.Dec) sdk.Dec {
 		//nolint:all
 		// Refund = (FillableQuantity / Quantity) * (Margin + Price * Quantity * feeRate)
 		notional := o.OrderInfo.Price.Mul(o.OrderInfo.Quantity)
-		marginHoldRefund = o.Fillable.Mul(o.Margin.Add(notional.Mul(positiveFeePart))).Quo(o.OrderInfo.Quantity)
+		marginHoldRefund = o.Fillable.Mul(o.Margin.Add(CalculateFee(notional, positiveFeePart))).Quo(o.OrderInfo.Quantity)
 	}
 	return marginHoldRefund
 }
 
 func (o *DerivativeOrder) CheckTickSize(minPriceTickSize, minQuantityTickSize sdk.Dec) error {
 	if BreachesMinimumTickSize(o.OrderInfo.Price, minPriceTickSize) {
-		return errors.Wrapf(ErrInvalidPrice, "price %s must be a multiple of the minimum price tick size %s", o.OrderInfo.Price.String(), minPriceTickSize.String())
+		return errors.Wrapf(ErrPriceTickSizeMisaligned, "price %s must be a multiple of the minimum price tick size %s", o.OrderInfo.Price.String(), minPriceTickSize.String())
 	}
 	if BreachesMinimumTickSize(o.OrderInfo.Quantity, minQuantityTickSize) {
-		return errors.Wrapf(ErrInvalidQuantity, "quantity %s must be a multiple of the minimum quantity tick size %s", o.OrderInfo.Quantity.String(), minQuantityTickSize.String())
+		return errors.Wrapf(ErrQuantityTickSizeMisaligned, "quantity %s must be a multiple of the minimum quantity tick size %s", o.OrderInfo.Quantity.String(), minQuantityTickSize.String())
 	}
 	if !o.Margin.IsZero() {
 		if BreachesMinimumTickSize(o.Margin, minQuantityTickSize) {
-			return errors.Wrapf(ErrInvalidMargin, "margin %s must be a multiple of the minimum quantity tick size %s", o.Margin.String(), minQuantityTickSize.String())
+			return errors.Wrapf(ErrMarginTickSizeMisaligned, "margin %s must be a multiple of the minimum quantity tick size %s", o.Margin.String(), minQuantityTickSize.String())
 		}
 	}
 	return nil
@@ -257,7 +257,7 @@ func (o *DerivativeOrder) GetRequiredBinaryOptionsMargin(oracleScaleFactor uint3
 func (o *DerivativeOrder) CheckMarginAndGetMarginHold(initialMarginRatio, executionMarkPrice, feeRate sdk.Dec, marketType MarketType, oracleScaleFactor uint32) (marginHold sdk.Dec, err error) {
 	notional := o.OrderInfo.Price.Mul(o.OrderInfo.Quantity)
 	positiveFeeRatePart := sdk.MaxDec(feeRate, sdk.ZeroDec())
-	feeAmount := notional.Mul(positiveFeeRatePart)
+	feeAmount := CalculateFee(notional, positiveFeeRatePart)
 
 	marginHold = o.Margin.Add(feeAmount)
 	if marketType == MarketType_BinaryOption {
@@ -4,6 +4,8 @@ import (
 	"cosmossdk.io/errors"
 )
 
+// Error codes are part of the module's public API: clients branch on them to tell rejection reasons apart,
+// so a registered code must never be changed or reused for a different reason.
 var (
 	ErrOrderInvalid                             = errors.Register(ModuleName, 1, "failed to validate order")
 	ErrSpotMarketNotFound                       = errors.Register(ModuleName, 2, "spot market not found")
@@ -104,4 +106,28 @@ var (
 	ErrClientOrderIdAlreadyExists               = errors.Register(ModuleName, 97, "client order id already exists")
 	ErrInvalidCid                               = errors.Register(ModuleName, 98, "client order id is invalid. Max length is 36 chars")
 	ErrInvalidEmergencySettle                   = errors.Register(ModuleName, 99, "market cannot be settled in emergency mode")
+	ErrExceedsMaxOpenOrders                     = errors.Register(ModuleName, 100, "subaccount has reached the maximum number of open orders")
+	ErrExchangeBalanceMismatch                  = errors.Register(ModuleName, 101, "exchange balances exceed the exchange module account balance")
+	ErrInvalidExpirationTimestamp               = errors.Register(ModuleName, 102, "invalid order expiration timestamp")
+	ErrPriceTickSizeMisaligned                  = errors.Register(ModuleName, 103, "price is not a multiple of the minimum price tick size")
+	ErrQuantityTickSizeMisaligned               = errors.Register(ModuleName, 104, "quantity is not a multiple of the minimum quantity tick size")
+	ErrMarginTickSizeMisaligned                 = errors.Register(ModuleName, 105, "margin is not a multiple of the minimum quantity tick size")
+	ErrMarketPaused                             = errors.Register(ModuleName, 106, "market is paused")
+	ErrMaxActiveMarketsReached                  = errors.Register(ModuleName, 107, "maximum number of active markets reached")
+	ErrOpenInterestCapExceeded                  = errors.Register(ModuleName, 108, "order would exceed the open interest cap of the market")
+	ErrPositionSizeCapExceeded                  = errors.Register(ModuleName, 109, "order would exceed the position size cap of the market")
+	ErrInvalidPositionCap                       = errors.Register(ModuleName, 110, "invalid position cap")
+	ErrOrderOutsidePriceBand                    = errors.Register(ModuleName, 111, "order price is outside of the price band of the market")
+	ErrInvalidPriceBandRatio                    = errors.Register(ModuleName, 112, "invalid price band ratio")
+	ErrOutsideTradingHours                      = errors.Register(ModuleName, 113, "market is outside of its trading hours")
+	ErrInvalidTradingSchedule                   = errors.Register(ModuleName, 114, "invalid trading schedule")
+	ErrMaxLeverageExceeded                      = errors.Register(ModuleName, 115, "order would exceed the max leverage of the market")
+	ErrInvalidMaxLeverage                       = errors.Register(ModuleName, 116, "invalid max leverage")
+	ErrMarginModeSwitchWithPositions            = errors.Register(ModuleName, 117, "margin mode can't be switched with open positions")
+	ErrInvalidMarginMode                        = errors.Register(ModuleName, 118, "invalid margin mode")
+	ErrCrossMarginRequirement                   = errors.Register(ModuleName, 119, "cross margin subaccount would be at or below its maintenance margin requirement")
+	ErrExceedsMaxConditionalOrders              = errors.Register(ModuleName, 120, "subaccount exceeds the max number of conditional orders")
+	ErrInvalidPositionTransfer                  = errors.Register(ModuleName, 121, "invalid position transfer")
+	ErrInvalidMaxSlippageRatio                  = errors.Register(ModuleName, 122, "invalid max slippage ratio")
+	ErrMaxSlippageRatioExceeded                 = errors.Register(ModuleName, 123, "max slippage ratio of the market order exceeds the one of the market")
 )
@@ -346,24 +346,30 @@ func (m *EventDerivativeMarketPaused) GetMissingFundsRate() string {
 	return ""
 }
 
-type EventMarketBeyondBankruptcy struct {
-	MarketId           string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	SettlePrice        string `protobuf:"bytes,2,opt,name=settle_price,json=settlePrice,proto3" json:"settle_price,omitempty"`
-	MissingMarketFunds string `protobuf:"bytes,3,opt,name=missing_market_funds,json=missingMarketFunds,proto3" json:"missing_market_funds,omitempty"`
-}
-
-func (m *EventMarketBeyondBankruptcy) Reset()         { *m = EventMarketBeyondBankruptcy{} }
-func (m *EventMarketBeyondBankruptcy) String() string { return proto.CompactTextString(m) }
-func (*EventMarketBeyondBankruptcy) ProtoMessage()    {}
-func (*EventMarketBeyondBankruptcy) Descriptor() ([]byte, []int) {
+// EventForceSettledPosition is emitted for every position closed when a market
+// is force settled
+type EventForceSettledPosition struct {
+	MarketId        string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId    string                                 `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	IsLong          bool                                   `protobuf:"varint,3,opt,name=is_long,json=isLong,proto3" json:"is_long,omitempty"`
+	Quantity        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	// margin and pnl refunded to the subaccount
+	Payout github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout"`
+}
+
+func (m *EventForceSettledPosition) Reset()         { *m = EventForceSettledPosition{} }
+func (m *EventForceSettledPosition) String() string { return proto.CompactTextString(m) }
+func (*EventForceSettledPosition) ProtoMessage()    {}
+func (*EventForceSettledPosition) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{5}
 }
-func (m *EventMarketBeyondBankruptcy) XXX_Unmarshal(b []byte) error {
+func (m *EventForceSettledPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketBeyondBankruptcy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventForceSettledPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketBeyondBankruptcy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventForceSettledPosition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -373,57 +379,67 @@ func (m *EventMarketBeyondBankruptcy) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventMarketBeyondBankruptcy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketBeyondBankruptcy.Merge(m, src)
+func (m *EventForceSettledPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForceSettledPosition.Merge(m, src)
 }
-func (m *EventMarketBeyondBankruptcy) XXX_Size() int {
+func (m *EventForceSettledPosition) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketBeyondBankruptcy) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketBeyondBankruptcy.DiscardUnknown(m)
+func (m *EventForceSettledPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForceSettledPosition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketBeyondBankruptcy proto.InternalMessageInfo
+var xxx_messageInfo_EventForceSettledPosition proto.InternalMessageInfo
 
-func (m *EventMarketBeyondBankruptcy) GetMarketId() string {
+func (m *EventForceSettledPosition) GetMarketId() string {
 	if m != nil {
 		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventMarketBeyondBankruptcy) GetSettlePrice() string {
+func (m *EventForceSettledPosition) GetSubaccountId() string {
 	if m != nil {
-		return m.SettlePrice
+		return m.SubaccountId
 	}
 	return ""
 }
 
-func (m *EventMarketBeyondBankruptcy) GetMissingMarketFunds() string {
+func (m *EventForceSettledPosition) GetIsLong() bool {
 	if m != nil {
-		return m.MissingMarketFunds
+		return m.IsLong
 	}
-	return ""
-}
-
-type EventAllPositionsHaircut struct {
-	MarketId         string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	SettlePrice      string `protobuf:"bytes,2,opt,name=settle_price,json=settlePrice,proto3" json:"settle_price,omitempty"`
-	MissingFundsRate string `protobuf:"bytes,3,opt,name=missing_funds_rate,json=missingFundsRate,proto3" json:"missing_funds_rate,omitempty"`
+	return false
 }
 
-func (m *EventAllPositionsHaircut) Reset()         { *m = EventAllPositionsHaircut{} }
-func (m *EventAllPositionsHaircut) String() string { return proto.CompactTextString(m) }
-func (*EventAllPositionsHaircut) ProtoMessage()    {}
-func (*EventAllPositionsHaircut) Descriptor() ([]byte, []int) {
+// EventAutoDeleverage is emitted for every position reduced by auto-deleveraging,
+// including the underwater position which triggered it
+type EventAutoDeleverage struct {
+	MarketId     string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	// the subaccount of the underwater position which triggered the
+	// auto-deleveraging
+	LiquidatedSubaccountId string                                 `protobuf:"bytes,3,opt,name=liquidated_subaccount_id,json=liquidatedSubaccountId,proto3" json:"liquidated_subaccount_id,omitempty"`
+	IsLong                 bool                                   `protobuf:"varint,4,opt,name=is_long,json=isLong,proto3" json:"is_long,omitempty"`
+	Quantity               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	// bankruptcy price of the underwater position
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// margin and pnl refunded to the subaccount
+	Payout github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout"`
+}
+
+func (m *EventAutoDeleverage) Reset()         { *m = EventAutoDeleverage{} }
+func (m *EventAutoDeleverage) String() string { return proto.CompactTextString(m) }
+func (*EventAutoDeleverage) ProtoMessage()    {}
+func (*EventAutoDeleverage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{6}
 }
-func (m *EventAllPositionsHaircut) XXX_Unmarshal(b []byte) error {
+func (m *EventAutoDeleverage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAllPositionsHaircut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventAutoDeleverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAllPositionsHaircut.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventAutoDeleverage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -433,55 +449,67 @@ func (m *EventAllPositionsHaircut) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventAllPositionsHaircut) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAllPositionsHaircut.Merge(m, src)
+func (m *EventAutoDeleverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoDeleverage.Merge(m, src)
 }
-func (m *EventAllPositionsHaircut) XXX_Size() int {
+func (m *EventAutoDeleverage) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAllPositionsHaircut) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAllPositionsHaircut.DiscardUnknown(m)
+func (m *EventAutoDeleverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoDeleverage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAllPositionsHaircut proto.InternalMessageInfo
+var xxx_messageInfo_EventAutoDeleverage proto.InternalMessageInfo
 
-func (m *EventAllPositionsHaircut) GetMarketId() string {
+func (m *EventAutoDeleverage) GetMarketId() string {
 	if m != nil {
 		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventAllPositionsHaircut) GetSettlePrice() string {
+func (m *EventAutoDeleverage) GetSubaccountId() string {
 	if m != nil {
-		return m.SettlePrice
+		return m.SubaccountId
 	}
 	return ""
 }
 
-func (m *EventAllPositionsHaircut) GetMissingFundsRate() string {
+func (m *EventAutoDeleverage) GetLiquidatedSubaccountId() string {
 	if m != nil {
-		return m.MissingFundsRate
+		return m.LiquidatedSubaccountId
 	}
 	return ""
 }
 
-type EventBinaryOptionsMarketUpdate struct {
-	Market BinaryOptionsMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+func (m *EventAutoDeleverage) GetIsLong() bool {
+	if m != nil {
+		return m.IsLong
+	}
+	return false
 }
 
-func (m *EventBinaryOptionsMarketUpdate) Reset()         { *m = EventBinaryOptionsMarketUpdate{} }
-func (m *EventBinaryOptionsMarketUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventBinaryOptionsMarketUpdate) ProtoMessage()    {}
-func (*EventBinaryOptionsMarketUpdate) Descriptor() ([]byte, []int) {
+// EventMarketOrdersCancelled is emitted when the orders of a market are
+// cancelled with CancelAllOrdersInMarket
+type EventMarketOrdersCancelled struct {
+	MarketId             string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	CancelledOrdersCount uint32 `protobuf:"varint,2,opt,name=cancelled_orders_count,json=cancelledOrdersCount,proto3" json:"cancelled_orders_count,omitempty"`
+	// true if orders are left because of the per call bound
+	HasMoreOrders bool `protobuf:"varint,3,opt,name=has_more_orders,json=hasMoreOrders,proto3" json:"has_more_orders,omitempty"`
+}
+
+func (m *EventMarketOrdersCancelled) Reset()         { *m = EventMarketOrdersCancelled{} }
+func (m *EventMarketOrdersCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketOrdersCancelled) ProtoMessage()    {}
+func (*EventMarketOrdersCancelled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{7}
 }
-func (m *EventBinaryOptionsMarketUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketOrdersCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventBinaryOptionsMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketOrdersCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventBinaryOptionsMarketUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketOrdersCancelled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -491,43 +519,59 @@ func (m *EventBinaryOptionsMarketUpdate) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventBinaryOptionsMarketUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventBinaryOptionsMarketUpdate.Merge(m, src)
+func (m *EventMarketOrdersCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketOrdersCancelled.Merge(m, src)
 }
-func (m *EventBinaryOptionsMarketUpdate) XXX_Size() int {
+func (m *EventMarketOrdersCancelled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventBinaryOptionsMarketUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventBinaryOptionsMarketUpdate.DiscardUnknown(m)
+func (m *EventMarketOrdersCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketOrdersCancelled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventBinaryOptionsMarketUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketOrdersCancelled proto.InternalMessageInfo
 
-func (m *EventBinaryOptionsMarketUpdate) GetMarket() BinaryOptionsMarket {
+func (m *EventMarketOrdersCancelled) GetMarketId() string {
 	if m != nil {
-		return m.Market
+		return m.MarketId
 	}
-	return BinaryOptionsMarket{}
+	return ""
 }
 
-type EventNewSpotOrders struct {
-	MarketId   string            `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BuyOrders  []*SpotLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
-	SellOrders []*SpotLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
+func (m *EventMarketOrdersCancelled) GetCancelledOrdersCount() uint32 {
+	if m != nil {
+		return m.CancelledOrdersCount
+	}
+	return 0
 }
 
-func (m *EventNewSpotOrders) Reset()         { *m = EventNewSpotOrders{} }
-func (m *EventNewSpotOrders) String() string { return proto.CompactTextString(m) }
-func (*EventNewSpotOrders) ProtoMessage()    {}
-func (*EventNewSpotOrders) Descriptor() ([]byte, []int) {
+func (m *EventMarketOrdersCancelled) GetHasMoreOrders() bool {
+	if m != nil {
+		return m.HasMoreOrders
+	}
+	return false
+}
+
+// EventMarketAutoDelisted is emitted when a market is paused or demolished
+// after market_inactivity_delist_blocks without trades and open orders
+type EventMarketAutoDelisted struct {
+	MarketId       string       `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Status         MarketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+	InactiveBlocks int64        `protobuf:"varint,3,opt,name=inactive_blocks,json=inactiveBlocks,proto3" json:"inactive_blocks,omitempty"`
+}
+
+func (m *EventMarketAutoDelisted) Reset()         { *m = EventMarketAutoDelisted{} }
+func (m *EventMarketAutoDelisted) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAutoDelisted) ProtoMessage()    {}
+func (*EventMarketAutoDelisted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{8}
 }
-func (m *EventNewSpotOrders) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketAutoDelisted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNewSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketAutoDelisted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNewSpotOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketAutoDelisted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -537,57 +581,58 @@ func (m *EventNewSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventNewSpotOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNewSpotOrders.Merge(m, src)
+func (m *EventMarketAutoDelisted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAutoDelisted.Merge(m, src)
 }
-func (m *EventNewSpotOrders) XXX_Size() int {
+func (m *EventMarketAutoDelisted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNewSpotOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNewSpotOrders.DiscardUnknown(m)
+func (m *EventMarketAutoDelisted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAutoDelisted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNewSpotOrders proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketAutoDelisted proto.InternalMessageInfo
 
-func (m *EventNewSpotOrders) GetMarketId() string {
+func (m *EventMarketAutoDelisted) GetMarketId() string {
 	if m != nil {
 		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventNewSpotOrders) GetBuyOrders() []*SpotLimitOrder {
+func (m *EventMarketAutoDelisted) GetStatus() MarketStatus {
 	if m != nil {
-		return m.BuyOrders
+		return m.Status
 	}
-	return nil
+	return MarketStatus_Unspecified
 }
 
-func (m *EventNewSpotOrders) GetSellOrders() []*SpotLimitOrder {
+func (m *EventMarketAutoDelisted) GetInactiveBlocks() int64 {
 	if m != nil {
-		return m.SellOrders
+		return m.InactiveBlocks
 	}
-	return nil
+	return 0
 }
 
-type EventNewDerivativeOrders struct {
-	MarketId   string                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BuyOrders  []*DerivativeLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
-	SellOrders []*DerivativeLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
+// EventMakerRebate is emitted for every account paid maker rebates at the end
+// of a maker rebate epoch
+type EventMakerRebate struct {
+	Account string                                   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Rebates github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rebates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rebates"`
 }
 
-func (m *EventNewDerivativeOrders) Reset()         { *m = EventNewDerivativeOrders{} }
-func (m *EventNewDerivativeOrders) String() string { return proto.CompactTextString(m) }
-func (*EventNewDerivativeOrders) ProtoMessage()    {}
-func (*EventNewDerivativeOrders) Descriptor() ([]byte, []int) {
+func (m *EventMakerRebate) Reset()         { *m = EventMakerRebate{} }
+func (m *EventMakerRebate) String() string { return proto.CompactTextString(m) }
+func (*EventMakerRebate) ProtoMessage()    {}
+func (*EventMakerRebate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{9}
 }
-func (m *EventNewDerivativeOrders) XXX_Unmarshal(b []byte) error {
+func (m *EventMakerRebate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNewDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMakerRebate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNewDerivativeOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMakerRebate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,56 +642,53 @@ func (m *EventNewDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventNewDerivativeOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNewDerivativeOrders.Merge(m, src)
+func (m *EventMakerRebate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMakerRebate.Merge(m, src)
 }
-func (m *EventNewDerivativeOrders) XXX_Size() int {
+func (m *EventMakerRebate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNewDerivativeOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNewDerivativeOrders.DiscardUnknown(m)
+func (m *EventMakerRebate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMakerRebate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNewDerivativeOrders proto.InternalMessageInfo
+var xxx_messageInfo_EventMakerRebate proto.InternalMessageInfo
 
-func (m *EventNewDerivativeOrders) GetMarketId() string {
+func (m *EventMakerRebate) GetAccount() string {
 	if m != nil {
-		return m.MarketId
+		return m.Account
 	}
 	return ""
 }
 
-func (m *EventNewDerivativeOrders) GetBuyOrders() []*DerivativeLimitOrder {
-	if m != nil {
-		return m.BuyOrders
-	}
-	return nil
-}
-
-func (m *EventNewDerivativeOrders) GetSellOrders() []*DerivativeLimitOrder {
+func (m *EventMakerRebate) GetRebates() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.SellOrders
+		return m.Rebates
 	}
 	return nil
 }
 
-type EventCancelSpotOrder struct {
-	MarketId string         `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Order    SpotLimitOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+// EventFeeSettlement is emitted for every fee converted into the fee
+// settlement denom
+type EventFeeSettlement struct {
+	Payer      string                                 `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Fee        types.Coin                             `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+	SettledFee types.Coin                             `protobuf:"bytes,3,opt,name=settled_fee,json=settledFee,proto3" json:"settled_fee"`
+	Price      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 }
 
-func (m *EventCancelSpotOrder) Reset()         { *m = EventCancelSpotOrder{} }
-func (m *EventCancelSpotOrder) String() string { return proto.CompactTextString(m) }
-func (*EventCancelSpotOrder) ProtoMessage()    {}
-func (*EventCancelSpotOrder) Descriptor() ([]byte, []int) {
+func (m *EventFeeSettlement) Reset()         { *m = EventFeeSettlement{} }
+func (m *EventFeeSettlement) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSettlement) ProtoMessage()    {}
+func (*EventFeeSettlement) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{10}
 }
-func (m *EventCancelSpotOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventFeeSettlement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventFeeSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCancelSpotOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventFeeSettlement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -656,48 +698,63 @@ func (m *EventCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventCancelSpotOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCancelSpotOrder.Merge(m, src)
+func (m *EventFeeSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSettlement.Merge(m, src)
 }
-func (m *EventCancelSpotOrder) XXX_Size() int {
+func (m *EventFeeSettlement) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCancelSpotOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCancelSpotOrder.DiscardUnknown(m)
+func (m *EventFeeSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSettlement.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventCancelSpotOrder proto.InternalMessageInfo
+var xxx_messageInfo_EventFeeSettlement proto.InternalMessageInfo
 
-func (m *EventCancelSpotOrder) GetMarketId() string {
+func (m *EventFeeSettlement) GetPayer() string {
 	if m != nil {
-		return m.MarketId
+		return m.Payer
 	}
 	return ""
 }
 
-func (m *EventCancelSpotOrder) GetOrder() SpotLimitOrder {
+func (m *EventFeeSettlement) GetFee() types.Coin {
 	if m != nil {
-		return m.Order
+		return m.Fee
 	}
-	return SpotLimitOrder{}
+	return types.Coin{}
 }
 
-type EventSpotMarketUpdate struct {
-	Market SpotMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+func (m *EventFeeSettlement) GetSettledFee() types.Coin {
+	if m != nil {
+		return m.SettledFee
+	}
+	return types.Coin{}
 }
 
-func (m *EventSpotMarketUpdate) Reset()         { *m = EventSpotMarketUpdate{} }
-func (m *EventSpotMarketUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventSpotMarketUpdate) ProtoMessage()    {}
-func (*EventSpotMarketUpdate) Descriptor() ([]byte, []int) {
+// EventDustSweep is emitted at the end of every block in which dust deposits
+// were swept
+type EventDustSweep struct {
+	Destination   SpamFeeDestination `protobuf:"varint,1,opt,name=destination,proto3,enum=injective.exchange.v1beta1.SpamFeeDestination" json:"destination,omitempty"`
+	SweptDeposits uint32             `protobuf:"varint,2,opt,name=swept_deposits,json=sweptDeposits,proto3" json:"swept_deposits,omitempty"`
+	// swept defines the total balances of the swept deposits
+	Swept github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=swept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"swept"`
+	// amount defines the coins sent to the community pool or burned, the
+	// fractional remainders of the swept balances stay in the module
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventDustSweep) Reset()         { *m = EventDustSweep{} }
+func (m *EventDustSweep) String() string { return proto.CompactTextString(m) }
+func (*EventDustSweep) ProtoMessage()    {}
+func (*EventDustSweep) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{11}
 }
-func (m *EventSpotMarketUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventDustSweep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSpotMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDustSweep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSpotMarketUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDustSweep.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -707,43 +764,63 @@ func (m *EventSpotMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventSpotMarketUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSpotMarketUpdate.Merge(m, src)
+func (m *EventDustSweep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDustSweep.Merge(m, src)
 }
-func (m *EventSpotMarketUpdate) XXX_Size() int {
+func (m *EventDustSweep) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSpotMarketUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSpotMarketUpdate.DiscardUnknown(m)
+func (m *EventDustSweep) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDustSweep.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSpotMarketUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventDustSweep proto.InternalMessageInfo
 
-func (m *EventSpotMarketUpdate) GetMarket() SpotMarket {
+func (m *EventDustSweep) GetDestination() SpamFeeDestination {
 	if m != nil {
-		return m.Market
+		return m.Destination
 	}
-	return SpotMarket{}
+	return SpamFeeDestination_CommunityPool
 }
 
-type EventPerpetualMarketUpdate struct {
-	Market              DerivativeMarket        `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
-	PerpetualMarketInfo *PerpetualMarketInfo    `protobuf:"bytes,2,opt,name=perpetual_market_info,json=perpetualMarketInfo,proto3" json:"perpetual_market_info,omitempty"`
-	Funding             *PerpetualMarketFunding `protobuf:"bytes,3,opt,name=funding,proto3" json:"funding,omitempty"`
+func (m *EventDustSweep) GetSweptDeposits() uint32 {
+	if m != nil {
+		return m.SweptDeposits
+	}
+	return 0
 }
 
-func (m *EventPerpetualMarketUpdate) Reset()         { *m = EventPerpetualMarketUpdate{} }
-func (m *EventPerpetualMarketUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventPerpetualMarketUpdate) ProtoMessage()    {}
-func (*EventPerpetualMarketUpdate) Descriptor() ([]byte, []int) {
+func (m *EventDustSweep) GetSwept() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Swept
+	}
+	return nil
+}
+
+func (m *EventDustSweep) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type EventSubaccountMarginModeUpdate struct {
+	SubaccountId string     `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	MarginMode   MarginMode `protobuf:"varint,2,opt,name=margin_mode,json=marginMode,proto3,enum=injective.exchange.v1beta1.MarginMode" json:"margin_mode,omitempty"`
+}
+
+func (m *EventSubaccountMarginModeUpdate) Reset()         { *m = EventSubaccountMarginModeUpdate{} }
+func (m *EventSubaccountMarginModeUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventSubaccountMarginModeUpdate) ProtoMessage()    {}
+func (*EventSubaccountMarginModeUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{12}
 }
-func (m *EventPerpetualMarketUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventSubaccountMarginModeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPerpetualMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSubaccountMarginModeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPerpetualMarketUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSubaccountMarginModeUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -753,56 +830,52 @@ func (m *EventPerpetualMarketUpdate) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *EventPerpetualMarketUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPerpetualMarketUpdate.Merge(m, src)
+func (m *EventSubaccountMarginModeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubaccountMarginModeUpdate.Merge(m, src)
 }
-func (m *EventPerpetualMarketUpdate) XXX_Size() int {
+func (m *EventSubaccountMarginModeUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPerpetualMarketUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPerpetualMarketUpdate.DiscardUnknown(m)
+func (m *EventSubaccountMarginModeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubaccountMarginModeUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPerpetualMarketUpdate proto.InternalMessageInfo
-
-func (m *EventPerpetualMarketUpdate) GetMarket() DerivativeMarket {
-	if m != nil {
-		return m.Market
-	}
-	return DerivativeMarket{}
-}
+var xxx_messageInfo_EventSubaccountMarginModeUpdate proto.InternalMessageInfo
 
-func (m *EventPerpetualMarketUpdate) GetPerpetualMarketInfo() *PerpetualMarketInfo {
+func (m *EventSubaccountMarginModeUpdate) GetSubaccountId() string {
 	if m != nil {
-		return m.PerpetualMarketInfo
+		return m.SubaccountId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventPerpetualMarketUpdate) GetFunding() *PerpetualMarketFunding {
+func (m *EventSubaccountMarginModeUpdate) GetMarginMode() MarginMode {
 	if m != nil {
-		return m.Funding
+		return m.MarginMode
 	}
-	return nil
+	return MarginMode_Isolated
 }
 
-type EventExpiryFuturesMarketUpdate struct {
-	Market                  DerivativeMarket         `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
-	ExpiryFuturesMarketInfo *ExpiryFuturesMarketInfo `protobuf:"bytes,3,opt,name=expiry_futures_market_info,json=expiryFuturesMarketInfo,proto3" json:"expiry_futures_market_info,omitempty"`
+type EventPositionTransfer struct {
+	MarketId        string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SrcSubaccountId string                                 `protobuf:"bytes,2,opt,name=src_subaccount_id,json=srcSubaccountId,proto3" json:"src_subaccount_id,omitempty"`
+	DstSubaccountId string                                 `protobuf:"bytes,3,opt,name=dst_subaccount_id,json=dstSubaccountId,proto3" json:"dst_subaccount_id,omitempty"`
+	Quantity        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Margin          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
 }
 
-func (m *EventExpiryFuturesMarketUpdate) Reset()         { *m = EventExpiryFuturesMarketUpdate{} }
-func (m *EventExpiryFuturesMarketUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventExpiryFuturesMarketUpdate) ProtoMessage()    {}
-func (*EventExpiryFuturesMarketUpdate) Descriptor() ([]byte, []int) {
+func (m *EventPositionTransfer) Reset()         { *m = EventPositionTransfer{} }
+func (m *EventPositionTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventPositionTransfer) ProtoMessage()    {}
+func (*EventPositionTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{13}
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventPositionTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPositionTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventExpiryFuturesMarketUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPositionTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -812,52 +885,57 @@ func (m *EventExpiryFuturesMarketUpdate) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventExpiryFuturesMarketUpdate.Merge(m, src)
+func (m *EventPositionTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPositionTransfer.Merge(m, src)
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Size() int {
+func (m *EventPositionTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventExpiryFuturesMarketUpdate.DiscardUnknown(m)
+func (m *EventPositionTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPositionTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventExpiryFuturesMarketUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventPositionTransfer proto.InternalMessageInfo
 
-func (m *EventExpiryFuturesMarketUpdate) GetMarket() DerivativeMarket {
+func (m *EventPositionTransfer) GetMarketId() string {
 	if m != nil {
-		return m.Market
+		return m.MarketId
 	}
-	return DerivativeMarket{}
+	return ""
 }
 
-func (m *EventExpiryFuturesMarketUpdate) GetExpiryFuturesMarketInfo() *ExpiryFuturesMarketInfo {
+func (m *EventPositionTransfer) GetSrcSubaccountId() string {
 	if m != nil {
-		return m.ExpiryFuturesMarketInfo
+		return m.SrcSubaccountId
 	}
-	return nil
+	return ""
 }
 
-type EventPerpetualMarketFundingUpdate struct {
-	MarketId        string                                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Funding         PerpetualMarketFunding                  `protobuf:"bytes,2,opt,name=funding,proto3" json:"funding"`
-	IsHourlyFunding bool                                    `protobuf:"varint,3,opt,name=is_hourly_funding,json=isHourlyFunding,proto3" json:"is_hourly_funding,omitempty"`
-	FundingRate     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate,omitempty"`
-	MarkPrice       *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price,omitempty"`
+func (m *EventPositionTransfer) GetDstSubaccountId() string {
+	if m != nil {
+		return m.DstSubaccountId
+	}
+	return ""
 }
 
-func (m *EventPerpetualMarketFundingUpdate) Reset()         { *m = EventPerpetualMarketFundingUpdate{} }
-func (m *EventPerpetualMarketFundingUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventPerpetualMarketFundingUpdate) ProtoMessage()    {}
-func (*EventPerpetualMarketFundingUpdate) Descriptor() ([]byte, []int) {
+type EventMarketBeyondBankruptcy struct {
+	MarketId           string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SettlePrice        string `protobuf:"bytes,2,opt,name=settle_price,json=settlePrice,proto3" json:"settle_price,omitempty"`
+	MissingMarketFunds string `protobuf:"bytes,3,opt,name=missing_market_funds,json=missingMarketFunds,proto3" json:"missing_market_funds,omitempty"`
+}
+
+func (m *EventMarketBeyondBankruptcy) Reset()         { *m = EventMarketBeyondBankruptcy{} }
+func (m *EventMarketBeyondBankruptcy) String() string { return proto.CompactTextString(m) }
+func (*EventMarketBeyondBankruptcy) ProtoMessage()    {}
+func (*EventMarketBeyondBankruptcy) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{14}
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketBeyondBankruptcy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketBeyondBankruptcy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPerpetualMarketFundingUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketBeyondBankruptcy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -867,57 +945,57 @@ func (m *EventPerpetualMarketFundingUpdate) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPerpetualMarketFundingUpdate.Merge(m, src)
+func (m *EventMarketBeyondBankruptcy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketBeyondBankruptcy.Merge(m, src)
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Size() int {
+func (m *EventMarketBeyondBankruptcy) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPerpetualMarketFundingUpdate.DiscardUnknown(m)
+func (m *EventMarketBeyondBankruptcy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketBeyondBankruptcy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPerpetualMarketFundingUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketBeyondBankruptcy proto.InternalMessageInfo
 
-func (m *EventPerpetualMarketFundingUpdate) GetMarketId() string {
+func (m *EventMarketBeyondBankruptcy) GetMarketId() string {
 	if m != nil {
 		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventPerpetualMarketFundingUpdate) GetFunding() PerpetualMarketFunding {
+func (m *EventMarketBeyondBankruptcy) GetSettlePrice() string {
 	if m != nil {
-		return m.Funding
+		return m.SettlePrice
 	}
-	return PerpetualMarketFunding{}
+	return ""
 }
 
-func (m *EventPerpetualMarketFundingUpdate) GetIsHourlyFunding() bool {
+func (m *EventMarketBeyondBankruptcy) GetMissingMarketFunds() string {
 	if m != nil {
-		return m.IsHourlyFunding
+		return m.MissingMarketFunds
 	}
-	return false
+	return ""
 }
 
-type EventSubaccountDeposit struct {
-	SrcAddress   string     `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
-	SubaccountId []byte     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+type EventAllPositionsHaircut struct {
+	MarketId         string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SettlePrice      string `protobuf:"bytes,2,opt,name=settle_price,json=settlePrice,proto3" json:"settle_price,omitempty"`
+	MissingFundsRate string `protobuf:"bytes,3,opt,name=missing_funds_rate,json=missingFundsRate,proto3" json:"missing_funds_rate,omitempty"`
 }
 
-func (m *EventSubaccountDeposit) Reset()         { *m = EventSubaccountDeposit{} }
-func (m *EventSubaccountDeposit) String() string { return proto.CompactTextString(m) }
-func (*EventSubaccountDeposit) ProtoMessage()    {}
-func (*EventSubaccountDeposit) Descriptor() ([]byte, []int) {
+func (m *EventAllPositionsHaircut) Reset()         { *m = EventAllPositionsHaircut{} }
+func (m *EventAllPositionsHaircut) String() string { return proto.CompactTextString(m) }
+func (*EventAllPositionsHaircut) ProtoMessage()    {}
+func (*EventAllPositionsHaircut) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{15}
 }
-func (m *EventSubaccountDeposit) XXX_Unmarshal(b []byte) error {
+func (m *EventAllPositionsHaircut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSubaccountDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventAllPositionsHaircut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSubaccountDeposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventAllPositionsHaircut.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -927,57 +1005,55 @@ func (m *EventSubaccountDeposit) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventSubaccountDeposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSubaccountDeposit.Merge(m, src)
+func (m *EventAllPositionsHaircut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAllPositionsHaircut.Merge(m, src)
 }
-func (m *EventSubaccountDeposit) XXX_Size() int {
+func (m *EventAllPositionsHaircut) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSubaccountDeposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSubaccountDeposit.DiscardUnknown(m)
+func (m *EventAllPositionsHaircut) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAllPositionsHaircut.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSubaccountDeposit proto.InternalMessageInfo
+var xxx_messageInfo_EventAllPositionsHaircut proto.InternalMessageInfo
 
-func (m *EventSubaccountDeposit) GetSrcAddress() string {
+func (m *EventAllPositionsHaircut) GetMarketId() string {
 	if m != nil {
-		return m.SrcAddress
+		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventSubaccountDeposit) GetSubaccountId() []byte {
+func (m *EventAllPositionsHaircut) GetSettlePrice() string {
 	if m != nil {
-		return m.SubaccountId
+		return m.SettlePrice
 	}
-	return nil
+	return ""
 }
 
-func (m *EventSubaccountDeposit) GetAmount() types.Coin {
+func (m *EventAllPositionsHaircut) GetMissingFundsRate() string {
 	if m != nil {
-		return m.Amount
+		return m.MissingFundsRate
 	}
-	return types.Coin{}
+	return ""
 }
 
-type EventSubaccountWithdraw struct {
-	SubaccountId []byte     `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	DstAddress   string     `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
-	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+type EventBinaryOptionsMarketUpdate struct {
+	Market BinaryOptionsMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
 }
 
-func (m *EventSubaccountWithdraw) Reset()         { *m = EventSubaccountWithdraw{} }
-func (m *EventSubaccountWithdraw) String() string { return proto.CompactTextString(m) }
-func (*EventSubaccountWithdraw) ProtoMessage()    {}
-func (*EventSubaccountWithdraw) Descriptor() ([]byte, []int) {
+func (m *EventBinaryOptionsMarketUpdate) Reset()         { *m = EventBinaryOptionsMarketUpdate{} }
+func (m *EventBinaryOptionsMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventBinaryOptionsMarketUpdate) ProtoMessage()    {}
+func (*EventBinaryOptionsMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{16}
 }
-func (m *EventSubaccountWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *EventBinaryOptionsMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSubaccountWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventBinaryOptionsMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSubaccountWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventBinaryOptionsMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -987,57 +1063,43 @@ func (m *EventSubaccountWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventSubaccountWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSubaccountWithdraw.Merge(m, src)
+func (m *EventBinaryOptionsMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBinaryOptionsMarketUpdate.Merge(m, src)
 }
-func (m *EventSubaccountWithdraw) XXX_Size() int {
+func (m *EventBinaryOptionsMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSubaccountWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSubaccountWithdraw.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventSubaccountWithdraw proto.InternalMessageInfo
-
-func (m *EventSubaccountWithdraw) GetSubaccountId() []byte {
-	if m != nil {
-		return m.SubaccountId
-	}
-	return nil
+func (m *EventBinaryOptionsMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBinaryOptionsMarketUpdate.DiscardUnknown(m)
 }
 
-func (m *EventSubaccountWithdraw) GetDstAddress() string {
-	if m != nil {
-		return m.DstAddress
-	}
-	return ""
-}
+var xxx_messageInfo_EventBinaryOptionsMarketUpdate proto.InternalMessageInfo
 
-func (m *EventSubaccountWithdraw) GetAmount() types.Coin {
+func (m *EventBinaryOptionsMarketUpdate) GetMarket() BinaryOptionsMarket {
 	if m != nil {
-		return m.Amount
+		return m.Market
 	}
-	return types.Coin{}
+	return BinaryOptionsMarket{}
 }
 
-type EventSubaccountBalanceTransfer struct {
-	SrcSubaccountId string     `protobuf:"bytes,1,opt,name=src_subaccount_id,json=srcSubaccountId,proto3" json:"src_subaccount_id,omitempty"`
-	DstSubaccountId string     `protobuf:"bytes,2,opt,name=dst_subaccount_id,json=dstSubaccountId,proto3" json:"dst_subaccount_id,omitempty"`
-	Amount          types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+type EventNewSpotOrders struct {
+	MarketId   string            `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BuyOrders  []*SpotLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
+	SellOrders []*SpotLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
 }
 
-func (m *EventSubaccountBalanceTransfer) Reset()         { *m = EventSubaccountBalanceTransfer{} }
-func (m *EventSubaccountBalanceTransfer) String() string { return proto.CompactTextString(m) }
-func (*EventSubaccountBalanceTransfer) ProtoMessage()    {}
-func (*EventSubaccountBalanceTransfer) Descriptor() ([]byte, []int) {
+func (m *EventNewSpotOrders) Reset()         { *m = EventNewSpotOrders{} }
+func (m *EventNewSpotOrders) String() string { return proto.CompactTextString(m) }
+func (*EventNewSpotOrders) ProtoMessage()    {}
+func (*EventNewSpotOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{17}
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Unmarshal(b []byte) error {
+func (m *EventNewSpotOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventNewSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSubaccountBalanceTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventNewSpotOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1047,55 +1109,57 @@ func (m *EventSubaccountBalanceTransfer) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSubaccountBalanceTransfer.Merge(m, src)
+func (m *EventNewSpotOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewSpotOrders.Merge(m, src)
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Size() int {
+func (m *EventNewSpotOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSubaccountBalanceTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSubaccountBalanceTransfer.DiscardUnknown(m)
+func (m *EventNewSpotOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewSpotOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSubaccountBalanceTransfer proto.InternalMessageInfo
+var xxx_messageInfo_EventNewSpotOrders proto.InternalMessageInfo
 
-func (m *EventSubaccountBalanceTransfer) GetSrcSubaccountId() string {
+func (m *EventNewSpotOrders) GetMarketId() string {
 	if m != nil {
-		return m.SrcSubaccountId
+		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventSubaccountBalanceTransfer) GetDstSubaccountId() string {
+func (m *EventNewSpotOrders) GetBuyOrders() []*SpotLimitOrder {
 	if m != nil {
-		return m.DstSubaccountId
+		return m.BuyOrders
 	}
-	return ""
+	return nil
 }
 
-func (m *EventSubaccountBalanceTransfer) GetAmount() types.Coin {
+func (m *EventNewSpotOrders) GetSellOrders() []*SpotLimitOrder {
 	if m != nil {
-		return m.Amount
+		return m.SellOrders
 	}
-	return types.Coin{}
+	return nil
 }
 
-type EventBatchDepositUpdate struct {
-	DepositUpdates []*DepositUpdate `protobuf:"bytes,1,rep,name=deposit_updates,json=depositUpdates,proto3" json:"deposit_updates,omitempty"`
+type EventNewDerivativeOrders struct {
+	MarketId   string                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BuyOrders  []*DerivativeLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
+	SellOrders []*DerivativeLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
 }
 
-func (m *EventBatchDepositUpdate) Reset()         { *m = EventBatchDepositUpdate{} }
-func (m *EventBatchDepositUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventBatchDepositUpdate) ProtoMessage()    {}
-func (*EventBatchDepositUpdate) Descriptor() ([]byte, []int) {
+func (m *EventNewDerivativeOrders) Reset()         { *m = EventNewDerivativeOrders{} }
+func (m *EventNewDerivativeOrders) String() string { return proto.CompactTextString(m) }
+func (*EventNewDerivativeOrders) ProtoMessage()    {}
+func (*EventNewDerivativeOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{18}
 }
-func (m *EventBatchDepositUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventNewDerivativeOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventBatchDepositUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventNewDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventBatchDepositUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventNewDerivativeOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1105,42 +1169,56 @@ func (m *EventBatchDepositUpdate) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventBatchDepositUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventBatchDepositUpdate.Merge(m, src)
+func (m *EventNewDerivativeOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewDerivativeOrders.Merge(m, src)
 }
-func (m *EventBatchDepositUpdate) XXX_Size() int {
+func (m *EventNewDerivativeOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventBatchDepositUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventBatchDepositUpdate.DiscardUnknown(m)
+func (m *EventNewDerivativeOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewDerivativeOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventBatchDepositUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventNewDerivativeOrders proto.InternalMessageInfo
 
-func (m *EventBatchDepositUpdate) GetDepositUpdates() []*DepositUpdate {
+func (m *EventNewDerivativeOrders) GetMarketId() string {
 	if m != nil {
-		return m.DepositUpdates
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *EventNewDerivativeOrders) GetBuyOrders() []*DerivativeLimitOrder {
+	if m != nil {
+		return m.BuyOrders
 	}
 	return nil
 }
 
-type DerivativeMarketOrderCancel struct {
-	MarketOrder    *DerivativeMarketOrder                 `protobuf:"bytes,1,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
-	CancelQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=cancel_quantity,json=cancelQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cancel_quantity"`
+func (m *EventNewDerivativeOrders) GetSellOrders() []*DerivativeLimitOrder {
+	if m != nil {
+		return m.SellOrders
+	}
+	return nil
 }
 
-func (m *DerivativeMarketOrderCancel) Reset()         { *m = DerivativeMarketOrderCancel{} }
-func (m *DerivativeMarketOrderCancel) String() string { return proto.CompactTextString(m) }
-func (*DerivativeMarketOrderCancel) ProtoMessage()    {}
-func (*DerivativeMarketOrderCancel) Descriptor() ([]byte, []int) {
+type EventCancelSpotOrder struct {
+	MarketId string         `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Order    SpotLimitOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+}
+
+func (m *EventCancelSpotOrder) Reset()         { *m = EventCancelSpotOrder{} }
+func (m *EventCancelSpotOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelSpotOrder) ProtoMessage()    {}
+func (*EventCancelSpotOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{19}
 }
-func (m *DerivativeMarketOrderCancel) XXX_Unmarshal(b []byte) error {
+func (m *EventCancelSpotOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DerivativeMarketOrderCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DerivativeMarketOrderCancel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCancelSpotOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1150,44 +1228,52 @@ func (m *DerivativeMarketOrderCancel) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *DerivativeMarketOrderCancel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DerivativeMarketOrderCancel.Merge(m, src)
+func (m *EventCancelSpotOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelSpotOrder.Merge(m, src)
 }
-func (m *DerivativeMarketOrderCancel) XXX_Size() int {
+func (m *EventCancelSpotOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *DerivativeMarketOrderCancel) XXX_DiscardUnknown() {
-	xxx_messageInfo_DerivativeMarketOrderCancel.DiscardUnknown(m)
+func (m *EventCancelSpotOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelSpotOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DerivativeMarketOrderCancel proto.InternalMessageInfo
+var xxx_messageInfo_EventCancelSpotOrder proto.InternalMessageInfo
 
-func (m *DerivativeMarketOrderCancel) GetMarketOrder() *DerivativeMarketOrder {
+func (m *EventCancelSpotOrder) GetMarketId() string {
 	if m != nil {
-		return m.MarketOrder
+		return m.MarketId
 	}
-	return nil
+	return ""
 }
 
-type EventCancelDerivativeOrder struct {
-	MarketId          string                       `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	IsLimitCancel     bool                         `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
-	LimitOrder        *DerivativeLimitOrder        `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
-	MarketOrderCancel *DerivativeMarketOrderCancel `protobuf:"bytes,4,opt,name=market_order_cancel,json=marketOrderCancel,proto3" json:"market_order_cancel,omitempty"`
+func (m *EventCancelSpotOrder) GetOrder() SpotLimitOrder {
+	if m != nil {
+		return m.Order
+	}
+	return SpotLimitOrder{}
 }
 
-func (m *EventCancelDerivativeOrder) Reset()         { *m = EventCancelDerivativeOrder{} }
-func (m *EventCancelDerivativeOrder) String() string { return proto.CompactTextString(m) }
-func (*EventCancelDerivativeOrder) ProtoMessage()    {}
-func (*EventCancelDerivativeOrder) Descriptor() ([]byte, []int) {
+type EventOrderExpired struct {
+	MarketId            string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId        string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	OrderHash           string `protobuf:"bytes,3,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	Cid                 string `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
+	ExpirationTimestamp int64  `protobuf:"varint,5,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+}
+
+func (m *EventOrderExpired) Reset()         { *m = EventOrderExpired{} }
+func (m *EventOrderExpired) String() string { return proto.CompactTextString(m) }
+func (*EventOrderExpired) ProtoMessage()    {}
+func (*EventOrderExpired) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{20}
 }
-func (m *EventCancelDerivativeOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventOrderExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventOrderExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCancelDerivativeOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventOrderExpired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1197,62 +1283,69 @@ func (m *EventCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *EventCancelDerivativeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCancelDerivativeOrder.Merge(m, src)
+func (m *EventOrderExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderExpired.Merge(m, src)
 }
-func (m *EventCancelDerivativeOrder) XXX_Size() int {
+func (m *EventOrderExpired) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCancelDerivativeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCancelDerivativeOrder.DiscardUnknown(m)
+func (m *EventOrderExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderExpired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventCancelDerivativeOrder proto.InternalMessageInfo
+var xxx_messageInfo_EventOrderExpired proto.InternalMessageInfo
 
-func (m *EventCancelDerivativeOrder) GetMarketId() string {
+func (m *EventOrderExpired) GetMarketId() string {
 	if m != nil {
 		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventCancelDerivativeOrder) GetIsLimitCancel() bool {
+func (m *EventOrderExpired) GetSubaccountId() string {
 	if m != nil {
-		return m.IsLimitCancel
+		return m.SubaccountId
 	}
-	return false
+	return ""
 }
 
-func (m *EventCancelDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
+func (m *EventOrderExpired) GetOrderHash() string {
 	if m != nil {
-		return m.LimitOrder
+		return m.OrderHash
 	}
-	return nil
+	return ""
 }
 
-func (m *EventCancelDerivativeOrder) GetMarketOrderCancel() *DerivativeMarketOrderCancel {
+func (m *EventOrderExpired) GetCid() string {
 	if m != nil {
-		return m.MarketOrderCancel
+		return m.Cid
 	}
-	return nil
+	return ""
 }
 
-type EventFeeDiscountSchedule struct {
-	Schedule *FeeDiscountSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+func (m *EventOrderExpired) GetExpirationTimestamp() int64 {
+	if m != nil {
+		return m.ExpirationTimestamp
+	}
+	return 0
 }
 
-func (m *EventFeeDiscountSchedule) Reset()         { *m = EventFeeDiscountSchedule{} }
-func (m *EventFeeDiscountSchedule) String() string { return proto.CompactTextString(m) }
-func (*EventFeeDiscountSchedule) ProtoMessage()    {}
-func (*EventFeeDiscountSchedule) Descriptor() ([]byte, []int) {
+type EventSpotMarketUpdate struct {
+	Market SpotMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+}
+
+func (m *EventSpotMarketUpdate) Reset()         { *m = EventSpotMarketUpdate{} }
+func (m *EventSpotMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventSpotMarketUpdate) ProtoMessage()    {}
+func (*EventSpotMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{21}
 }
-func (m *EventFeeDiscountSchedule) XXX_Unmarshal(b []byte) error {
+func (m *EventSpotMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventFeeDiscountSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSpotMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventFeeDiscountSchedule.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSpotMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1262,42 +1355,43 @@ func (m *EventFeeDiscountSchedule) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventFeeDiscountSchedule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventFeeDiscountSchedule.Merge(m, src)
+func (m *EventSpotMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpotMarketUpdate.Merge(m, src)
 }
-func (m *EventFeeDiscountSchedule) XXX_Size() int {
+func (m *EventSpotMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventFeeDiscountSchedule) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventFeeDiscountSchedule.DiscardUnknown(m)
+func (m *EventSpotMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpotMarketUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventFeeDiscountSchedule proto.InternalMessageInfo
+var xxx_messageInfo_EventSpotMarketUpdate proto.InternalMessageInfo
 
-func (m *EventFeeDiscountSchedule) GetSchedule() *FeeDiscountSchedule {
+func (m *EventSpotMarketUpdate) GetMarket() SpotMarket {
 	if m != nil {
-		return m.Schedule
+		return m.Market
 	}
-	return nil
+	return SpotMarket{}
 }
 
-type EventTradingRewardCampaignUpdate struct {
-	CampaignInfo        *TradingRewardCampaignInfo `protobuf:"bytes,1,opt,name=campaign_info,json=campaignInfo,proto3" json:"campaign_info,omitempty"`
-	CampaignRewardPools []*CampaignRewardPool      `protobuf:"bytes,2,rep,name=campaign_reward_pools,json=campaignRewardPools,proto3" json:"campaign_reward_pools,omitempty"`
+type EventPerpetualMarketUpdate struct {
+	Market              DerivativeMarket        `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+	PerpetualMarketInfo *PerpetualMarketInfo    `protobuf:"bytes,2,opt,name=perpetual_market_info,json=perpetualMarketInfo,proto3" json:"perpetual_market_info,omitempty"`
+	Funding             *PerpetualMarketFunding `protobuf:"bytes,3,opt,name=funding,proto3" json:"funding,omitempty"`
 }
 
-func (m *EventTradingRewardCampaignUpdate) Reset()         { *m = EventTradingRewardCampaignUpdate{} }
-func (m *EventTradingRewardCampaignUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventTradingRewardCampaignUpdate) ProtoMessage()    {}
-func (*EventTradingRewardCampaignUpdate) Descriptor() ([]byte, []int) {
+func (m *EventPerpetualMarketUpdate) Reset()         { *m = EventPerpetualMarketUpdate{} }
+func (m *EventPerpetualMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPerpetualMarketUpdate) ProtoMessage()    {}
+func (*EventPerpetualMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{22}
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventPerpetualMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPerpetualMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventTradingRewardCampaignUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPerpetualMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1307,48 +1401,56 @@ func (m *EventTradingRewardCampaignUpdate) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventTradingRewardCampaignUpdate.Merge(m, src)
+func (m *EventPerpetualMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPerpetualMarketUpdate.Merge(m, src)
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Size() int {
+func (m *EventPerpetualMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventTradingRewardCampaignUpdate.DiscardUnknown(m)
+func (m *EventPerpetualMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPerpetualMarketUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventTradingRewardCampaignUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventPerpetualMarketUpdate proto.InternalMessageInfo
 
-func (m *EventTradingRewardCampaignUpdate) GetCampaignInfo() *TradingRewardCampaignInfo {
+func (m *EventPerpetualMarketUpdate) GetMarket() DerivativeMarket {
 	if m != nil {
-		return m.CampaignInfo
+		return m.Market
+	}
+	return DerivativeMarket{}
+}
+
+func (m *EventPerpetualMarketUpdate) GetPerpetualMarketInfo() *PerpetualMarketInfo {
+	if m != nil {
+		return m.PerpetualMarketInfo
 	}
 	return nil
 }
 
-func (m *EventTradingRewardCampaignUpdate) GetCampaignRewardPools() []*CampaignRewardPool {
+func (m *EventPerpetualMarketUpdate) GetFunding() *PerpetualMarketFunding {
 	if m != nil {
-		return m.CampaignRewardPools
+		return m.Funding
 	}
 	return nil
 }
 
-type EventTradingRewardDistribution struct {
-	AccountRewards []*AccountRewards `protobuf:"bytes,1,rep,name=account_rewards,json=accountRewards,proto3" json:"account_rewards,omitempty"`
+type EventExpiryFuturesMarketUpdate struct {
+	Market                  DerivativeMarket         `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+	ExpiryFuturesMarketInfo *ExpiryFuturesMarketInfo `protobuf:"bytes,3,opt,name=expiry_futures_market_info,json=expiryFuturesMarketInfo,proto3" json:"expiry_futures_market_info,omitempty"`
 }
 
-func (m *EventTradingRewardDistribution) Reset()         { *m = EventTradingRewardDistribution{} }
-func (m *EventTradingRewardDistribution) String() string { return proto.CompactTextString(m) }
-func (*EventTradingRewardDistribution) ProtoMessage()    {}
-func (*EventTradingRewardDistribution) Descriptor() ([]byte, []int) {
+func (m *EventExpiryFuturesMarketUpdate) Reset()         { *m = EventExpiryFuturesMarketUpdate{} }
+func (m *EventExpiryFuturesMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventExpiryFuturesMarketUpdate) ProtoMessage()    {}
+func (*EventExpiryFuturesMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{23}
 }
-func (m *EventTradingRewardDistribution) XXX_Unmarshal(b []byte) error {
+func (m *EventExpiryFuturesMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventTradingRewardDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExpiryFuturesMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventTradingRewardDistribution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExpiryFuturesMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1358,44 +1460,52 @@ func (m *EventTradingRewardDistribution) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventTradingRewardDistribution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventTradingRewardDistribution.Merge(m, src)
+func (m *EventExpiryFuturesMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpiryFuturesMarketUpdate.Merge(m, src)
 }
-func (m *EventTradingRewardDistribution) XXX_Size() int {
+func (m *EventExpiryFuturesMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventTradingRewardDistribution) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventTradingRewardDistribution.DiscardUnknown(m)
+func (m *EventExpiryFuturesMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpiryFuturesMarketUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventTradingRewardDistribution proto.InternalMessageInfo
+var xxx_messageInfo_EventExpiryFuturesMarketUpdate proto.InternalMessageInfo
 
-func (m *EventTradingRewardDistribution) GetAccountRewards() []*AccountRewards {
+func (m *EventExpiryFuturesMarketUpdate) GetMarket() DerivativeMarket {
 	if m != nil {
-		return m.AccountRewards
+		return m.Market
+	}
+	return DerivativeMarket{}
+}
+
+func (m *EventExpiryFuturesMarketUpdate) GetExpiryFuturesMarketInfo() *ExpiryFuturesMarketInfo {
+	if m != nil {
+		return m.ExpiryFuturesMarketInfo
 	}
 	return nil
 }
 
-type EventNewConditionalDerivativeOrder struct {
-	MarketId string           `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Order    *DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
-	Hash     []byte           `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	IsMarket bool             `protobuf:"varint,4,opt,name=is_market,json=isMarket,proto3" json:"is_market,omitempty"`
+type EventPerpetualMarketFundingUpdate struct {
+	MarketId        string                                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Funding         PerpetualMarketFunding                  `protobuf:"bytes,2,opt,name=funding,proto3" json:"funding"`
+	IsHourlyFunding bool                                    `protobuf:"varint,3,opt,name=is_hourly_funding,json=isHourlyFunding,proto3" json:"is_hourly_funding,omitempty"`
+	FundingRate     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate,omitempty"`
+	MarkPrice       *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price,omitempty"`
 }
 
-func (m *EventNewConditionalDerivativeOrder) Reset()         { *m = EventNewConditionalDerivativeOrder{} }
-func (m *EventNewConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
-func (*EventNewConditionalDerivativeOrder) ProtoMessage()    {}
-func (*EventNewConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+func (m *EventPerpetualMarketFundingUpdate) Reset()         { *m = EventPerpetualMarketFundingUpdate{} }
+func (m *EventPerpetualMarketFundingUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPerpetualMarketFundingUpdate) ProtoMessage()    {}
+func (*EventPerpetualMarketFundingUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{24}
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventPerpetualMarketFundingUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPerpetualMarketFundingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNewConditionalDerivativeOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPerpetualMarketFundingUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1405,65 +1515,57 @@ func (m *EventNewConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNewConditionalDerivativeOrder.Merge(m, src)
+func (m *EventPerpetualMarketFundingUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPerpetualMarketFundingUpdate.Merge(m, src)
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Size() int {
+func (m *EventPerpetualMarketFundingUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNewConditionalDerivativeOrder.DiscardUnknown(m)
+func (m *EventPerpetualMarketFundingUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPerpetualMarketFundingUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNewConditionalDerivativeOrder proto.InternalMessageInfo
+var xxx_messageInfo_EventPerpetualMarketFundingUpdate proto.InternalMessageInfo
 
-func (m *EventNewConditionalDerivativeOrder) GetMarketId() string {
+func (m *EventPerpetualMarketFundingUpdate) GetMarketId() string {
 	if m != nil {
 		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventNewConditionalDerivativeOrder) GetOrder() *DerivativeOrder {
+func (m *EventPerpetualMarketFundingUpdate) GetFunding() PerpetualMarketFunding {
 	if m != nil {
-		return m.Order
-	}
-	return nil
-}
-
-func (m *EventNewConditionalDerivativeOrder) GetHash() []byte {
-	if m != nil {
-		return m.Hash
+		return m.Funding
 	}
-	return nil
+	return PerpetualMarketFunding{}
 }
 
-func (m *EventNewConditionalDerivativeOrder) GetIsMarket() bool {
+func (m *EventPerpetualMarketFundingUpdate) GetIsHourlyFunding() bool {
 	if m != nil {
-		return m.IsMarket
+		return m.IsHourlyFunding
 	}
 	return false
 }
 
-type EventCancelConditionalDerivativeOrder struct {
-	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	IsLimitCancel bool                   `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
-	LimitOrder    *DerivativeLimitOrder  `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
-	MarketOrder   *DerivativeMarketOrder `protobuf:"bytes,4,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
+type EventSubaccountDeposit struct {
+	SrcAddress   string     `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	SubaccountId []byte     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *EventCancelConditionalDerivativeOrder) Reset()         { *m = EventCancelConditionalDerivativeOrder{} }
-func (m *EventCancelConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
-func (*EventCancelConditionalDerivativeOrder) ProtoMessage()    {}
-func (*EventCancelConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+func (m *EventSubaccountDeposit) Reset()         { *m = EventSubaccountDeposit{} }
+func (m *EventSubaccountDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventSubaccountDeposit) ProtoMessage()    {}
+func (*EventSubaccountDeposit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{25}
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventSubaccountDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSubaccountDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCancelConditionalDerivativeOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSubaccountDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1473,67 +1575,57 @@ func (m *EventCancelConditionalDerivativeOrder) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCancelConditionalDerivativeOrder.Merge(m, src)
+func (m *EventSubaccountDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubaccountDeposit.Merge(m, src)
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Size() int {
+func (m *EventSubaccountDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCancelConditionalDerivativeOrder.DiscardUnknown(m)
+func (m *EventSubaccountDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubaccountDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventCancelConditionalDerivativeOrder proto.InternalMessageInfo
+var xxx_messageInfo_EventSubaccountDeposit proto.InternalMessageInfo
 
-func (m *EventCancelConditionalDerivativeOrder) GetMarketId() string {
+func (m *EventSubaccountDeposit) GetSrcAddress() string {
 	if m != nil {
-		return m.MarketId
+		return m.SrcAddress
 	}
 	return ""
 }
 
-func (m *EventCancelConditionalDerivativeOrder) GetIsLimitCancel() bool {
-	if m != nil {
-		return m.IsLimitCancel
-	}
-	return false
-}
-
-func (m *EventCancelConditionalDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
+func (m *EventSubaccountDeposit) GetSubaccountId() []byte {
 	if m != nil {
-		return m.LimitOrder
+		return m.SubaccountId
 	}
 	return nil
 }
 
-func (m *EventCancelConditionalDerivativeOrder) GetMarketOrder() *DerivativeMarketOrder {
+func (m *EventSubaccountDeposit) GetAmount() types.Coin {
 	if m != nil {
-		return m.MarketOrder
+		return m.Amount
 	}
-	return nil
+	return types.Coin{}
 }
 
-type EventConditionalDerivativeOrderTrigger struct {
-	MarketId           []byte `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	IsLimitTrigger     bool   `protobuf:"varint,2,opt,name=isLimitTrigger,proto3" json:"isLimitTrigger,omitempty"`
-	TriggeredOrderHash []byte `protobuf:"bytes,3,opt,name=triggered_order_hash,json=triggeredOrderHash,proto3" json:"triggered_order_hash,omitempty"`
-	PlacedOrderHash    []byte `protobuf:"bytes,4,opt,name=placed_order_hash,json=placedOrderHash,proto3" json:"placed_order_hash,omitempty"`
+type EventSubaccountWithdraw struct {
+	SubaccountId []byte     `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	DstAddress   string     `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) Reset() {
-	*m = EventConditionalDerivativeOrderTrigger{}
-}
-func (m *EventConditionalDerivativeOrderTrigger) String() string { return proto.CompactTextString(m) }
-func (*EventConditionalDerivativeOrderTrigger) ProtoMessage()    {}
-func (*EventConditionalDerivativeOrderTrigger) Descriptor() ([]byte, []int) {
+func (m *EventSubaccountWithdraw) Reset()         { *m = EventSubaccountWithdraw{} }
+func (m *EventSubaccountWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventSubaccountWithdraw) ProtoMessage()    {}
+func (*EventSubaccountWithdraw) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{26}
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Unmarshal(b []byte) error {
+func (m *EventSubaccountWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSubaccountWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSubaccountWithdraw.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1543,64 +1635,57 @@ func (m *EventConditionalDerivativeOrderTrigger) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Merge(m, src)
+func (m *EventSubaccountWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubaccountWithdraw.Merge(m, src)
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Size() int {
+func (m *EventSubaccountWithdraw) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.DiscardUnknown(m)
+func (m *EventSubaccountWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubaccountWithdraw.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventConditionalDerivativeOrderTrigger proto.InternalMessageInfo
+var xxx_messageInfo_EventSubaccountWithdraw proto.InternalMessageInfo
 
-func (m *EventConditionalDerivativeOrderTrigger) GetMarketId() []byte {
+func (m *EventSubaccountWithdraw) GetSubaccountId() []byte {
 	if m != nil {
-		return m.MarketId
+		return m.SubaccountId
 	}
 	return nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) GetIsLimitTrigger() bool {
-	if m != nil {
-		return m.IsLimitTrigger
-	}
-	return false
-}
-
-func (m *EventConditionalDerivativeOrderTrigger) GetTriggeredOrderHash() []byte {
+func (m *EventSubaccountWithdraw) GetDstAddress() string {
 	if m != nil {
-		return m.TriggeredOrderHash
+		return m.DstAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) GetPlacedOrderHash() []byte {
+func (m *EventSubaccountWithdraw) GetAmount() types.Coin {
 	if m != nil {
-		return m.PlacedOrderHash
+		return m.Amount
 	}
-	return nil
+	return types.Coin{}
 }
 
-type EventOrderFail struct {
-	Account []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Hashes  [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
-	Flags   []uint32 `protobuf:"varint,3,rep,packed,name=flags,proto3" json:"flags,omitempty"`
+type EventSubaccountBalanceTransfer struct {
+	SrcSubaccountId string     `protobuf:"bytes,1,opt,name=src_subaccount_id,json=srcSubaccountId,proto3" json:"src_subaccount_id,omitempty"`
+	DstSubaccountId string     `protobuf:"bytes,2,opt,name=dst_subaccount_id,json=dstSubaccountId,proto3" json:"dst_subaccount_id,omitempty"`
+	Amount          types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *EventOrderFail) Reset()         { *m = EventOrderFail{} }
-func (m *EventOrderFail) String() string { return proto.CompactTextString(m) }
-func (*EventOrderFail) ProtoMessage()    {}
-func (*EventOrderFail) Descriptor() ([]byte, []int) {
+func (m *EventSubaccountBalanceTransfer) Reset()         { *m = EventSubaccountBalanceTransfer{} }
+func (m *EventSubaccountBalanceTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventSubaccountBalanceTransfer) ProtoMessage()    {}
+func (*EventSubaccountBalanceTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{27}
 }
-func (m *EventOrderFail) XXX_Unmarshal(b []byte) error {
+func (m *EventSubaccountBalanceTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOrderFail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSubaccountBalanceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOrderFail.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSubaccountBalanceTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1610,59 +1695,55 @@ func (m *EventOrderFail) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventOrderFail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOrderFail.Merge(m, src)
+func (m *EventSubaccountBalanceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubaccountBalanceTransfer.Merge(m, src)
 }
-func (m *EventOrderFail) XXX_Size() int {
+func (m *EventSubaccountBalanceTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOrderFail) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOrderFail.DiscardUnknown(m)
+func (m *EventSubaccountBalanceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubaccountBalanceTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOrderFail proto.InternalMessageInfo
+var xxx_messageInfo_EventSubaccountBalanceTransfer proto.InternalMessageInfo
 
-func (m *EventOrderFail) GetAccount() []byte {
+func (m *EventSubaccountBalanceTransfer) GetSrcSubaccountId() string {
 	if m != nil {
-		return m.Account
+		return m.SrcSubaccountId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventOrderFail) GetHashes() [][]byte {
+func (m *EventSubaccountBalanceTransfer) GetDstSubaccountId() string {
 	if m != nil {
-		return m.Hashes
+		return m.DstSubaccountId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventOrderFail) GetFlags() []uint32 {
+func (m *EventSubaccountBalanceTransfer) GetAmount() types.Coin {
 	if m != nil {
-		return m.Flags
+		return m.Amount
 	}
-	return nil
+	return types.Coin{}
 }
 
-type EventAtomicMarketOrderFeeMultipliersUpdated struct {
-	MarketFeeMultipliers []*MarketFeeMultiplier `protobuf:"bytes,1,rep,name=market_fee_multipliers,json=marketFeeMultipliers,proto3" json:"market_fee_multipliers,omitempty"`
+type EventBatchDepositUpdate struct {
+	DepositUpdates []*DepositUpdate `protobuf:"bytes,1,rep,name=deposit_updates,json=depositUpdates,proto3" json:"deposit_updates,omitempty"`
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Reset() {
-	*m = EventAtomicMarketOrderFeeMultipliersUpdated{}
-}
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) String() string {
-	return proto.CompactTextString(m)
-}
-func (*EventAtomicMarketOrderFeeMultipliersUpdated) ProtoMessage() {}
-func (*EventAtomicMarketOrderFeeMultipliersUpdated) Descriptor() ([]byte, []int) {
+func (m *EventBatchDepositUpdate) Reset()         { *m = EventBatchDepositUpdate{} }
+func (m *EventBatchDepositUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventBatchDepositUpdate) ProtoMessage()    {}
+func (*EventBatchDepositUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{28}
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventBatchDepositUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventBatchDepositUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventBatchDepositUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1672,42 +1753,42 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Merge(m, src)
+func (m *EventBatchDepositUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchDepositUpdate.Merge(m, src)
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Size() int {
+func (m *EventBatchDepositUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.DiscardUnknown(m)
+func (m *EventBatchDepositUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchDepositUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventBatchDepositUpdate proto.InternalMessageInfo
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) GetMarketFeeMultipliers() []*MarketFeeMultiplier {
+func (m *EventBatchDepositUpdate) GetDepositUpdates() []*DepositUpdate {
 	if m != nil {
-		return m.MarketFeeMultipliers
+		return m.DepositUpdates
 	}
 	return nil
 }
 
-type EventOrderbookUpdate struct {
-	SpotUpdates       []*OrderbookUpdate `protobuf:"bytes,1,rep,name=spot_updates,json=spotUpdates,proto3" json:"spot_updates,omitempty"`
-	DerivativeUpdates []*OrderbookUpdate `protobuf:"bytes,2,rep,name=derivative_updates,json=derivativeUpdates,proto3" json:"derivative_updates,omitempty"`
+type DerivativeMarketOrderCancel struct {
+	MarketOrder    *DerivativeMarketOrder                 `protobuf:"bytes,1,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
+	CancelQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=cancel_quantity,json=cancelQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cancel_quantity"`
 }
 
-func (m *EventOrderbookUpdate) Reset()         { *m = EventOrderbookUpdate{} }
-func (m *EventOrderbookUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventOrderbookUpdate) ProtoMessage()    {}
-func (*EventOrderbookUpdate) Descriptor() ([]byte, []int) {
+func (m *DerivativeMarketOrderCancel) Reset()         { *m = DerivativeMarketOrderCancel{} }
+func (m *DerivativeMarketOrderCancel) String() string { return proto.CompactTextString(m) }
+func (*DerivativeMarketOrderCancel) ProtoMessage()    {}
+func (*DerivativeMarketOrderCancel) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{29}
 }
-func (m *EventOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+func (m *DerivativeMarketOrderCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DerivativeMarketOrderCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOrderbookUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DerivativeMarketOrderCancel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1717,49 +1798,44 @@ func (m *EventOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventOrderbookUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOrderbookUpdate.Merge(m, src)
+func (m *DerivativeMarketOrderCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivativeMarketOrderCancel.Merge(m, src)
 }
-func (m *EventOrderbookUpdate) XXX_Size() int {
+func (m *DerivativeMarketOrderCancel) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOrderbookUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOrderbookUpdate.DiscardUnknown(m)
+func (m *DerivativeMarketOrderCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivativeMarketOrderCancel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOrderbookUpdate proto.InternalMessageInfo
+var xxx_messageInfo_DerivativeMarketOrderCancel proto.InternalMessageInfo
 
-func (m *EventOrderbookUpdate) GetSpotUpdates() []*OrderbookUpdate {
+func (m *DerivativeMarketOrderCancel) GetMarketOrder() *DerivativeMarketOrder {
 	if m != nil {
-		return m.SpotUpdates
+		return m.MarketOrder
 	}
 	return nil
 }
 
-func (m *EventOrderbookUpdate) GetDerivativeUpdates() []*OrderbookUpdate {
-	if m != nil {
-		return m.DerivativeUpdates
-	}
-	return nil
-}
-
-type OrderbookUpdate struct {
-	Seq       uint64     `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
-	Orderbook *Orderbook `protobuf:"bytes,2,opt,name=orderbook,proto3" json:"orderbook,omitempty"`
+type EventCancelDerivativeOrder struct {
+	MarketId          string                       `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsLimitCancel     bool                         `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
+	LimitOrder        *DerivativeLimitOrder        `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
+	MarketOrderCancel *DerivativeMarketOrderCancel `protobuf:"bytes,4,opt,name=market_order_cancel,json=marketOrderCancel,proto3" json:"market_order_cancel,omitempty"`
 }
 
-func (m *OrderbookUpdate) Reset()         { *m = OrderbookUpdate{} }
-func (m *OrderbookUpdate) String() string { return proto.CompactTextString(m) }
-func (*OrderbookUpdate) ProtoMessage()    {}
-func (*OrderbookUpdate) Descriptor() ([]byte, []int) {
+func (m *EventCancelDerivativeOrder) Reset()         { *m = EventCancelDerivativeOrder{} }
+func (m *EventCancelDerivativeOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelDerivativeOrder) ProtoMessage()    {}
+func (*EventCancelDerivativeOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{30}
 }
-func (m *OrderbookUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventCancelDerivativeOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OrderbookUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCancelDerivativeOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1769,50 +1845,62 @@ func (m *OrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *OrderbookUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderbookUpdate.Merge(m, src)
+func (m *EventCancelDerivativeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelDerivativeOrder.Merge(m, src)
 }
-func (m *OrderbookUpdate) XXX_Size() int {
+func (m *EventCancelDerivativeOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *OrderbookUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderbookUpdate.DiscardUnknown(m)
+func (m *EventCancelDerivativeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelDerivativeOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderbookUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventCancelDerivativeOrder proto.InternalMessageInfo
 
-func (m *OrderbookUpdate) GetSeq() uint64 {
+func (m *EventCancelDerivativeOrder) GetMarketId() string {
 	if m != nil {
-		return m.Seq
+		return m.MarketId
 	}
-	return 0
+	return ""
 }
 
-func (m *OrderbookUpdate) GetOrderbook() *Orderbook {
+func (m *EventCancelDerivativeOrder) GetIsLimitCancel() bool {
 	if m != nil {
-		return m.Orderbook
+		return m.IsLimitCancel
+	}
+	return false
+}
+
+func (m *EventCancelDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
+	if m != nil {
+		return m.LimitOrder
 	}
 	return nil
 }
 
-type Orderbook struct {
-	MarketId   []byte   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BuyLevels  []*Level `protobuf:"bytes,2,rep,name=buy_levels,json=buyLevels,proto3" json:"buy_levels,omitempty"`
-	SellLevels []*Level `protobuf:"bytes,3,rep,name=sell_levels,json=sellLevels,proto3" json:"sell_levels,omitempty"`
+func (m *EventCancelDerivativeOrder) GetMarketOrderCancel() *DerivativeMarketOrderCancel {
+	if m != nil {
+		return m.MarketOrderCancel
+	}
+	return nil
 }
 
-func (m *Orderbook) Reset()         { *m = Orderbook{} }
-func (m *Orderbook) String() string { return proto.CompactTextString(m) }
-func (*Orderbook) ProtoMessage()    {}
-func (*Orderbook) Descriptor() ([]byte, []int) {
+type EventFeeDiscountSchedule struct {
+	Schedule *FeeDiscountSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (m *EventFeeDiscountSchedule) Reset()         { *m = EventFeeDiscountSchedule{} }
+func (m *EventFeeDiscountSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventFeeDiscountSchedule) ProtoMessage()    {}
+func (*EventFeeDiscountSchedule) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{31}
 }
-func (m *Orderbook) XXX_Unmarshal(b []byte) error {
+func (m *EventFeeDiscountSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Orderbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventFeeDiscountSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Orderbook.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventFeeDiscountSchedule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1822,862 +1910,912 @@ func (m *Orderbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Orderbook) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Orderbook.Merge(m, src)
+func (m *EventFeeDiscountSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeDiscountSchedule.Merge(m, src)
 }
-func (m *Orderbook) XXX_Size() int {
+func (m *EventFeeDiscountSchedule) XXX_Size() int {
 	return m.Size()
 }
-func (m *Orderbook) XXX_DiscardUnknown() {
-	xxx_messageInfo_Orderbook.DiscardUnknown(m)
+func (m *EventFeeDiscountSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeDiscountSchedule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Orderbook proto.InternalMessageInfo
+var xxx_messageInfo_EventFeeDiscountSchedule proto.InternalMessageInfo
 
-func (m *Orderbook) GetMarketId() []byte {
+func (m *EventFeeDiscountSchedule) GetSchedule() *FeeDiscountSchedule {
 	if m != nil {
-		return m.MarketId
+		return m.Schedule
 	}
 	return nil
 }
 
-func (m *Orderbook) GetBuyLevels() []*Level {
+type EventTradingRewardCampaignUpdate struct {
+	CampaignInfo        *TradingRewardCampaignInfo `protobuf:"bytes,1,opt,name=campaign_info,json=campaignInfo,proto3" json:"campaign_info,omitempty"`
+	CampaignRewardPools []*CampaignRewardPool      `protobuf:"bytes,2,rep,name=campaign_reward_pools,json=campaignRewardPools,proto3" json:"campaign_reward_pools,omitempty"`
+}
+
+func (m *EventTradingRewardCampaignUpdate) Reset()         { *m = EventTradingRewardCampaignUpdate{} }
+func (m *EventTradingRewardCampaignUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventTradingRewardCampaignUpdate) ProtoMessage()    {}
+func (*EventTradingRewardCampaignUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{32}
+}
+func (m *EventTradingRewardCampaignUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradingRewardCampaignUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradingRewardCampaignUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradingRewardCampaignUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradingRewardCampaignUpdate.Merge(m, src)
+}
+func (m *EventTradingRewardCampaignUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradingRewardCampaignUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradingRewardCampaignUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradingRewardCampaignUpdate proto.InternalMessageInfo
+
+func (m *EventTradingRewardCampaignUpdate) GetCampaignInfo() *TradingRewardCampaignInfo {
 	if m != nil {
-		return m.BuyLevels
+		return m.CampaignInfo
 	}
 	return nil
 }
 
-func (m *Orderbook) GetSellLevels() []*Level {
+func (m *EventTradingRewardCampaignUpdate) GetCampaignRewardPools() []*CampaignRewardPool {
 	if m != nil {
-		return m.SellLevels
+		return m.CampaignRewardPools
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EventBatchSpotExecution)(nil), "injective.exchange.v1beta1.EventBatchSpotExecution")
-	proto.RegisterType((*EventBatchDerivativeExecution)(nil), "injective.exchange.v1beta1.EventBatchDerivativeExecution")
-	proto.RegisterType((*EventLostFundsFromLiquidation)(nil), "injective.exchange.v1beta1.EventLostFundsFromLiquidation")
-	proto.RegisterType((*EventBatchDerivativePosition)(nil), "injective.exchange.v1beta1.EventBatchDerivativePosition")
-	proto.RegisterType((*EventDerivativeMarketPaused)(nil), "injective.exchange.v1beta1.EventDerivativeMarketPaused")
-	proto.RegisterType((*EventMarketBeyondBankruptcy)(nil), "injective.exchange.v1beta1.EventMarketBeyondBankruptcy")
-	proto.RegisterType((*EventAllPositionsHaircut)(nil), "injective.exchange.v1beta1.EventAllPositionsHaircut")
-	proto.RegisterType((*EventBinaryOptionsMarketUpdate)(nil), "injective.exchange.v1beta1.EventBinaryOptionsMarketUpdate")
-	proto.RegisterType((*EventNewSpotOrders)(nil), "injective.exchange.v1beta1.EventNewSpotOrders")
-	proto.RegisterType((*EventNewDerivativeOrders)(nil), "injective.exchange.v1beta1.EventNewDerivativeOrders")
-	proto.RegisterType((*EventCancelSpotOrder)(nil), "injective.exchange.v1beta1.EventCancelSpotOrder")
-	proto.RegisterType((*EventSpotMarketUpdate)(nil), "injective.exchange.v1beta1.EventSpotMarketUpdate")
-	proto.RegisterType((*EventPerpetualMarketUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketUpdate")
-	proto.RegisterType((*EventExpiryFuturesMarketUpdate)(nil), "injective.exchange.v1beta1.EventExpiryFuturesMarketUpdate")
-	proto.RegisterType((*EventPerpetualMarketFundingUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketFundingUpdate")
-	proto.RegisterType((*EventSubaccountDeposit)(nil), "injective.exchange.v1beta1.EventSubaccountDeposit")
-	proto.RegisterType((*EventSubaccountWithdraw)(nil), "injective.exchange.v1beta1.EventSubaccountWithdraw")
-	proto.RegisterType((*EventSubaccountBalanceTransfer)(nil), "injective.exchange.v1beta1.EventSubaccountBalanceTransfer")
-	proto.RegisterType((*EventBatchDepositUpdate)(nil), "injective.exchange.v1beta1.EventBatchDepositUpdate")
-	proto.RegisterType((*DerivativeMarketOrderCancel)(nil), "injective.exchange.v1beta1.DerivativeMarketOrderCancel")
-	proto.RegisterType((*EventCancelDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelDerivativeOrder")
-	proto.RegisterType((*EventFeeDiscountSchedule)(nil), "injective.exchange.v1beta1.EventFeeDiscountSchedule")
-	proto.RegisterType((*EventTradingRewardCampaignUpdate)(nil), "injective.exchange.v1beta1.EventTradingRewardCampaignUpdate")
-	proto.RegisterType((*EventTradingRewardDistribution)(nil), "injective.exchange.v1beta1.EventTradingRewardDistribution")
-	proto.RegisterType((*EventNewConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventNewConditionalDerivativeOrder")
-	proto.RegisterType((*EventCancelConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelConditionalDerivativeOrder")
-	proto.RegisterType((*EventConditionalDerivativeOrderTrigger)(nil), "injective.exchange.v1beta1.EventConditionalDerivativeOrderTrigger")
-	proto.RegisterType((*EventOrderFail)(nil), "injective.exchange.v1beta1.EventOrderFail")
-	proto.RegisterType((*EventAtomicMarketOrderFeeMultipliersUpdated)(nil), "injective.exchange.v1beta1.EventAtomicMarketOrderFeeMultipliersUpdated")
-	proto.RegisterType((*EventOrderbookUpdate)(nil), "injective.exchange.v1beta1.EventOrderbookUpdate")
-	proto.RegisterType((*OrderbookUpdate)(nil), "injective.exchange.v1beta1.OrderbookUpdate")
-	proto.RegisterType((*Orderbook)(nil), "injective.exchange.v1beta1.Orderbook")
+type EventTradingRewardDistribution struct {
+	AccountRewards []*AccountRewards `protobuf:"bytes,1,rep,name=account_rewards,json=accountRewards,proto3" json:"account_rewards,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("injective/exchange/v1beta1/events.proto", fileDescriptor_20dda602b6b13fd3)
+func (m *EventTradingRewardDistribution) Reset()         { *m = EventTradingRewardDistribution{} }
+func (m *EventTradingRewardDistribution) String() string { return proto.CompactTextString(m) }
+func (*EventTradingRewardDistribution) ProtoMessage()    {}
+func (*EventTradingRewardDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{33}
 }
-
-var fileDescriptor_20dda602b6b13fd3 = []byte{
-	// 1909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xf1, 0xe6, 0xf0, 0x65, 0xb2, 0x76, 0x49, 0x9a, 0x23, 0x4a, 0xa6, 0xa9, 0x9f, 0x29, 0x6a, 0x7e,
-	0x96, 0x2c, 0xc9, 0xf6, 0xae, 0x45, 0x23, 0xf0, 0x25, 0x87, 0x70, 0x49, 0x11, 0x62, 0x4c, 0x49,
-	0x54, 0x53, 0x81, 0x02, 0x01, 0xc6, 0xa0, 0x77, 0xa6, 0xb9, 0xdb, 0xd1, 0xcc, 0xf4, 0x68, 0xba,
-	0x87, 0xd2, 0x22, 0xc7, 0x5c, 0x92, 0x53, 0x72, 0x08, 0x90, 0xdc, 0x72, 0xcc, 0x2d, 0x40, 0x0e,
-	0x39, 0xe5, 0x96, 0x93, 0x83, 0x5c, 0x8c, 0x9c, 0xf2, 0x82, 0x11, 0x50, 0xf9, 0x0b, 0xf2, 0x17,
-	0x04, 0xfd, 0x98, 0xc7, 0x3e, 0x34, 0xda, 0x15, 0x1d, 0xe4, 0xc4, 0x99, 0x9e, 0xea, 0xaf, 0xbe,
-	0xfe, 0xba, 0xba, 0xba, 0x6a, 0x09, 0x1f, 0xd0, 0xe8, 0x07, 0xc4, 0x13, 0xf4, 0x94, 0x34, 0xc9,
-	0x0b, 0xaf, 0x8b, 0xa3, 0x0e, 0x69, 0x9e, 0xde, 0x6e, 0x13, 0x81, 0x6f, 0x37, 0xc9, 0x29, 0x89,
-	0x04, 0x6f, 0xc4, 0x09, 0x13, 0xcc, 0xde, 0xc8, 0x0d, 0x1b, 0x99, 0x61, 0xc3, 0x18, 0x6e, 0xac,
-	0x75, 0x58, 0x87, 0x29, 0xb3, 0xa6, 0x7c, 0xd2, 0x33, 0x36, 0x36, 0x3d, 0xc6, 0x43, 0xc6, 0x9b,
-	0x6d, 0xcc, 0x0b, 0x4c, 0x8f, 0xd1, 0xc8, 0x7c, 0xbf, 0x56, 0xb8, 0x66, 0x09, 0xf6, 0x82, 0xc2,
-	0x48, 0xbf, 0x1a, 0xb3, 0x9b, 0x55, 0x0c, 0x33, 0x26, 0xca, 0xd4, 0xf9, 0x87, 0x05, 0xef, 0xdc,
-	0x91, 0xa4, 0x5b, 0x58, 0x78, 0xdd, 0xe3, 0x98, 0x89, 0x3b, 0x2f, 0x88, 0x97, 0x0a, 0xca, 0x22,
-	0xfb, 0x32, 0x2c, 0x86, 0x38, 0x79, 0x4a, 0x84, 0x4b, 0xfd, 0x75, 0x6b, 0xcb, 0xba, 0xb1, 0x88,
-	0x16, 0xf4, 0xc0, 0x81, 0x6f, 0x5f, 0x84, 0x79, 0xca, 0xdd, 0x76, 0xda, 0x5b, 0x9f, 0xde, 0xb2,
-	0x6e, 0x2c, 0xa0, 0x39, 0xca, 0x5b, 0x69, 0xcf, 0x7e, 0x00, 0x4b, 0x24, 0x03, 0x78, 0xd4, 0x8b,
-	0xc9, 0xfa, 0xcc, 0x96, 0x75, 0x63, 0x79, 0xfb, 0x66, 0xe3, 0xd5, 0x5a, 0x34, 0xee, 0x94, 0x27,
-	0xa0, 0xfe, 0xf9, 0xf6, 0xb7, 0x61, 0x5e, 0x24, 0xd8, 0x27, 0x7c, 0x7d, 0x76, 0x6b, 0xe6, 0x46,
-	0x6d, 0xfb, 0xfd, 0x2a, 0xa4, 0x47, 0xd2, 0xf2, 0x90, 0x75, 0x90, 0x99, 0xe3, 0xfc, 0x7b, 0x1a,
-	0xde, 0x2b, 0x96, 0xb7, 0x47, 0x12, 0x7a, 0x8a, 0xe5, 0xd4, 0xf3, 0x2d, 0xf2, 0x1a, 0x2c, 0x53,
-	0xee, 0x06, 0xf4, 0x59, 0x4a, 0x7d, 0x2c, 0x51, 0xd4, 0x2a, 0x17, 0xd0, 0x12, 0xe5, 0x87, 0xc5,
-	0xa0, 0xfd, 0x05, 0xd8, 0x5e, 0x1a, 0xa6, 0x81, 0xf2, 0xe8, 0x9e, 0xa4, 0x91, 0x4f, 0xa3, 0xce,
-	0xfa, 0xac, 0xf4, 0xd1, 0x6a, 0x7c, 0xf9, 0xf5, 0x15, 0xeb, 0x6f, 0x5f, 0x5f, 0xb9, 0xde, 0xa1,
-	0xa2, 0x9b, 0xb6, 0x1b, 0x1e, 0x0b, 0x9b, 0x66, 0xf3, 0xf5, 0x9f, 0x8f, 0xb9, 0xff, 0xb4, 0x29,
-	0x7a, 0x31, 0xe1, 0x8d, 0x3d, 0xe2, 0xa1, 0xd5, 0x02, 0x69, 0x5f, 0x03, 0x0d, 0x4b, 0x3d, 0x77,
-	0x4e, 0xa9, 0xf7, 0x73, 0xa9, 0xe7, 0x95, 0xd4, 0x8d, 0x2a, 0xa4, 0x42, 0xcb, 0x21, 0xd1, 0xff,
-	0x9a, 0x89, 0x7e, 0xc8, 0xb8, 0x90, 0x6c, 0xf9, 0x7e, 0xc2, 0xc2, 0xb2, 0x32, 0x95, 0xa2, 0xff,
-	0x3f, 0x2c, 0xf1, 0xb4, 0x8d, 0x3d, 0x8f, 0xa5, 0x91, 0x32, 0x90, 0xda, 0xd7, 0x51, 0xbd, 0x18,
-	0x3c, 0xf0, 0xed, 0x1f, 0x59, 0xf0, 0x41, 0xc0, 0xb8, 0x50, 0xb2, 0x72, 0xf7, 0x24, 0x61, 0xa1,
-	0x8b, 0x4f, 0x31, 0x0d, 0x70, 0x3b, 0x20, 0xae, 0x9f, 0x26, 0x34, 0xea, 0xb8, 0x31, 0xee, 0xb1,
-	0x54, 0xa8, 0xcd, 0xd1, 0x8a, 0x4f, 0x4d, 0xa0, 0xb8, 0x13, 0x94, 0xd9, 0xef, 0x64, 0xd8, 0x7b,
-	0x0a, 0xfa, 0x48, 0x21, 0xdb, 0x31, 0xbc, 0x37, 0x48, 0x82, 0x25, 0x3e, 0x49, 0x5c, 0x0f, 0x47,
-	0x1e, 0x09, 0x78, 0x69, 0xb3, 0x27, 0x71, 0xfd, 0x6e, 0x9f, 0xeb, 0x07, 0x12, 0x71, 0x57, 0x03,
-	0x3a, 0x3f, 0xb1, 0xe0, 0xff, 0x46, 0x05, 0xf4, 0x11, 0xe3, 0xf4, 0xf5, 0xd2, 0x1e, 0xc2, 0x62,
-	0x6c, 0x0c, 0xf9, 0xfa, 0xf4, 0xeb, 0x37, 0xf9, 0x38, 0x97, 0x3c, 0xc3, 0x47, 0x05, 0x80, 0xf3,
-	0x7b, 0x0b, 0x2e, 0x2b, 0x2e, 0x05, 0x8d, 0x7b, 0xca, 0xd3, 0x11, 0x4e, 0x39, 0xf1, 0xab, 0xa9,
-	0x5c, 0x85, 0x3a, 0x27, 0x42, 0x04, 0xc4, 0x8d, 0x13, 0xea, 0x11, 0xb5, 0xc9, 0x8b, 0xa8, 0xa6,
-	0xc7, 0x8e, 0xe4, 0x90, 0xdd, 0x80, 0x0b, 0x82, 0x09, 0x1c, 0xb8, 0x21, 0xe5, 0x5c, 0xee, 0xa7,
-	0x92, 0x59, 0x6f, 0x27, 0x5a, 0x55, 0x9f, 0xee, 0xe9, 0x2f, 0x4a, 0x2b, 0xfb, 0x23, 0xb0, 0xfb,
-	0x2c, 0xdd, 0x04, 0x0b, 0xa2, 0xb7, 0x00, 0xbd, 0x1d, 0x96, 0x2c, 0x11, 0x16, 0xc4, 0xf9, 0x69,
-	0xc6, 0x5e, 0x73, 0x6e, 0x91, 0x1e, 0x8b, 0xfc, 0x16, 0x8e, 0x9e, 0x26, 0x69, 0x2c, 0xbc, 0xde,
-	0xb9, 0xd9, 0x7f, 0x02, 0x6b, 0x19, 0x1b, 0x83, 0x53, 0xa6, 0x9f, 0x31, 0xd5, 0xce, 0x15, 0x2b,
-	0xe7, 0xc7, 0x16, 0xac, 0x2b, 0x46, 0x3b, 0x41, 0x90, 0xe9, 0xcd, 0xef, 0x62, 0x9a, 0x78, 0xa9,
-	0x38, 0x37, 0x9d, 0xd1, 0xe2, 0xcc, 0xbc, 0x42, 0x1c, 0x06, 0x9b, 0x3a, 0xca, 0x68, 0x84, 0x93,
-	0xde, 0x83, 0x58, 0x51, 0xd1, 0x5c, 0xbf, 0x17, 0xfb, 0x58, 0x10, 0xfb, 0x1e, 0xcc, 0x6b, 0xf7,
-	0x8a, 0x4c, 0x6d, 0xbb, 0x59, 0x15, 0x47, 0x23, 0x60, 0x5a, 0xb3, 0xf2, 0x50, 0x20, 0x03, 0xe2,
-	0xfc, 0xd1, 0x02, 0x5b, 0x79, 0xbc, 0x4f, 0x9e, 0xcb, 0x5b, 0x48, 0x05, 0x3d, 0xaf, 0x5e, 0xf5,
-	0x01, 0x40, 0x3b, 0xed, 0xe9, 0x13, 0x97, 0x85, 0xf3, 0xad, 0xca, 0x70, 0x8e, 0x99, 0x38, 0xa4,
-	0x21, 0xd5, 0xe8, 0x68, 0xb1, 0x9d, 0xf6, 0x8c, 0x9f, 0xcf, 0xa1, 0xc6, 0x49, 0x10, 0x64, 0x58,
-	0x33, 0x13, 0x63, 0x81, 0x9c, 0xae, 0xc1, 0x9c, 0xbf, 0x67, 0xfb, 0x78, 0x9f, 0x3c, 0x2f, 0x8e,
-	0xc6, 0x38, 0x2b, 0x7a, 0x30, 0x62, 0x45, 0x9f, 0x8c, 0x97, 0x85, 0x47, 0xaf, 0xeb, 0xe1, 0xa8,
-	0x75, 0x4d, 0x8e, 0x58, 0x5e, 0xdd, 0x0f, 0x61, 0x4d, 0x2d, 0x4e, 0x67, 0xa4, 0x7c, 0xaf, 0xaa,
-	0x17, 0xb6, 0x0f, 0x73, 0x8a, 0x82, 0x8a, 0xcc, 0x89, 0x94, 0x35, 0x71, 0xa2, 0xa7, 0x3b, 0x5f,
-	0xc0, 0x45, 0xe5, 0x5c, 0xda, 0xf4, 0x85, 0xe3, 0xde, 0x40, 0x38, 0x5e, 0x7f, 0x9d, 0x87, 0x91,
-	0x51, 0xf8, 0xeb, 0x69, 0xd8, 0x50, 0xf8, 0x47, 0x24, 0x89, 0x89, 0x48, 0x71, 0xd0, 0xe7, 0xe4,
-	0xbb, 0x03, 0x4e, 0x3e, 0x1a, 0x4f, 0xc8, 0x51, 0xae, 0x6c, 0x0a, 0x17, 0xe3, 0xcc, 0x49, 0x96,
-	0x20, 0x68, 0x74, 0xc2, 0x8c, 0x42, 0x95, 0xc7, 0x69, 0x80, 0xdd, 0x41, 0x74, 0xc2, 0x14, 0xba,
-	0x85, 0x2e, 0xc4, 0xc3, 0x9f, 0x6c, 0x04, 0x6f, 0x65, 0xc5, 0xc7, 0x8c, 0x02, 0xdf, 0x9e, 0x00,
-	0xdc, 0x54, 0x1b, 0x06, 0x3f, 0x03, 0x72, 0xfe, 0x65, 0x99, 0x0c, 0x71, 0xe7, 0x45, 0x4c, 0x93,
-	0xde, 0x7e, 0x2a, 0xd2, 0x84, 0xf0, 0xff, 0x9a, 0x5a, 0xa7, 0xb0, 0x41, 0x94, 0x23, 0xf7, 0x44,
-	0x7b, 0xea, 0x93, 0x4c, 0xaf, 0xea, 0xd3, 0xea, 0xc2, 0x67, 0x88, 0x66, 0x49, 0xb6, 0x77, 0xc8,
-	0xe8, 0xcf, 0xce, 0xd9, 0x34, 0x5c, 0x1d, 0x15, 0x10, 0x46, 0x15, 0xb3, 0xd2, 0xca, 0xd0, 0x2f,
-	0xa9, 0x3f, 0x7d, 0x2e, 0xf5, 0xa7, 0x72, 0xf5, 0xed, 0x5b, 0xb0, 0x4a, 0xb9, 0xdb, 0x65, 0x69,
-	0x12, 0xf4, 0xdc, 0xf2, 0xde, 0x2e, 0xa0, 0x15, 0xca, 0xef, 0xaa, 0xf1, 0xac, 0x4c, 0x7c, 0x08,
-	0x75, 0x63, 0x51, 0xba, 0x0f, 0x27, 0xae, 0x3f, 0x6b, 0x06, 0x03, 0xe9, 0xdc, 0x0f, 0x72, 0x79,
-	0xe6, 0xb2, 0x99, 0x7b, 0x23, 0x40, 0xa5, 0x98, 0xba, 0x9a, 0x9c, 0x5f, 0x58, 0x70, 0x49, 0x9f,
-	0xea, 0xbc, 0xdc, 0xd8, 0x23, 0xaa, 0xcc, 0xb0, 0xaf, 0x40, 0x8d, 0x27, 0x9e, 0x8b, 0x7d, 0x3f,
-	0x21, 0x9c, 0x1b, 0x6d, 0x81, 0x27, 0xde, 0x8e, 0x1e, 0x19, 0xaf, 0x58, 0xfc, 0x0c, 0xe6, 0x71,
-	0x28, 0x9f, 0x4d, 0xa4, 0xbc, 0xdb, 0xd0, 0x94, 0x1a, 0xb2, 0xcf, 0xca, 0xa5, 0xdf, 0x65, 0x34,
-	0xca, 0xc2, 0x4e, 0x9b, 0x3b, 0xbf, 0xcc, 0xba, 0xa3, 0x82, 0xd9, 0x63, 0x2a, 0xba, 0x7e, 0x82,
-	0x9f, 0x0f, 0x7b, 0xb6, 0x46, 0x78, 0xbe, 0x02, 0x35, 0x9f, 0x8b, 0x9c, 0xbf, 0xbe, 0x97, 0xc1,
-	0xe7, 0x22, 0xe3, 0xff, 0xc6, 0xd4, 0x7e, 0x9b, 0x1d, 0xc0, 0x82, 0x5a, 0x0b, 0x07, 0x32, 0x27,
-	0x3f, 0x4a, 0x70, 0xc4, 0x4f, 0x48, 0x22, 0xa3, 0x44, 0x8a, 0x37, 0xcc, 0x72, 0x11, 0xad, 0xf0,
-	0xc4, 0x3b, 0x2e, 0x13, 0xbd, 0x05, 0xab, 0x92, 0xe8, 0xb0, 0x96, 0x8b, 0x68, 0xc5, 0xe7, 0xe2,
-	0xf8, 0x1b, 0x91, 0x33, 0x2c, 0xf7, 0x9a, 0x66, 0x8b, 0xcd, 0x11, 0x42, 0xb0, 0xe2, 0xeb, 0x01,
-	0x37, 0x55, 0x23, 0x72, 0xb3, 0xe5, 0x65, 0x75, 0xb3, 0x3a, 0x6b, 0x94, 0x30, 0xd0, 0xb2, 0x5f,
-	0x7e, 0xe5, 0xce, 0x9f, 0x2d, 0xb8, 0x3c, 0x98, 0x57, 0x4a, 0xc5, 0xb4, 0xfd, 0x04, 0xea, 0xe6,
-	0xd8, 0xea, 0xbb, 0x49, 0xa7, 0xa9, 0xdb, 0x93, 0xa4, 0xa9, 0xe2, 0x8a, 0xb2, 0x50, 0x2d, 0x2c,
-	0x86, 0xec, 0xc7, 0xb0, 0xa2, 0x7b, 0x00, 0xf7, 0x59, 0x8a, 0x23, 0x41, 0x85, 0x6e, 0x21, 0x27,
-	0xef, 0x05, 0x96, 0x35, 0xcc, 0x43, 0x83, 0x52, 0x5c, 0x51, 0x7a, 0x11, 0x03, 0xf5, 0x45, 0x75,
-	0x2a, 0x7a, 0x1f, 0x54, 0x87, 0x1a, 0x52, 0x33, 0xd9, 0x74, 0xb5, 0xfd, 0x83, 0xf6, 0x63, 0xa8,
-	0x05, 0xf2, 0xd5, 0xa8, 0xa2, 0xf7, 0x78, 0xe2, 0x9a, 0xc1, 0x88, 0x02, 0x41, 0x3e, 0x62, 0x87,
-	0x70, 0xa1, 0xac, 0xb7, 0x69, 0x92, 0x54, 0x42, 0xaa, 0x6d, 0x7f, 0x36, 0xb1, 0xec, 0x9a, 0xae,
-	0xf1, 0xb3, 0x1a, 0x0e, 0x7e, 0x70, 0x3a, 0xa6, 0x0a, 0xdb, 0x27, 0x64, 0x8f, 0x72, 0x15, 0xbc,
-	0xc7, 0x5e, 0x97, 0xf8, 0x69, 0x40, 0xec, 0xcf, 0x61, 0x81, 0x9b, 0xe7, 0x71, 0xea, 0xd7, 0x11,
-	0x10, 0x28, 0x07, 0x70, 0xce, 0x2c, 0xd8, 0x52, 0x9e, 0x64, 0x27, 0x2c, 0x73, 0x24, 0x79, 0x8e,
-	0x13, 0x7f, 0x17, 0x87, 0x31, 0xa6, 0x9d, 0xc8, 0x04, 0xf8, 0x13, 0x58, 0xf2, 0xcc, 0x88, 0xbe,
-	0xb4, 0xb4, 0xdb, 0x6f, 0xbd, 0xee, 0xe7, 0x8c, 0x21, 0x3c, 0x79, 0x2f, 0xa1, 0xba, 0x57, 0x7a,
-	0xb3, 0xdb, 0x70, 0x31, 0xc7, 0x4e, 0x94, 0xb1, 0x1b, 0x33, 0x16, 0x8c, 0xd5, 0xe2, 0x65, 0xb0,
-	0xda, 0xc9, 0x11, 0x63, 0x01, 0xba, 0xe0, 0x0d, 0x8d, 0x71, 0x27, 0x35, 0xe9, 0xa6, 0x8f, 0xd3,
-	0x1e, 0xe5, 0x22, 0xa1, 0x6d, 0xfd, 0x4b, 0xca, 0x31, 0xac, 0x64, 0xb9, 0x43, 0x93, 0xc8, 0x8e,
-	0x70, 0x65, 0xb5, 0xb7, 0xa3, 0xa7, 0x68, 0x3c, 0x8e, 0x96, 0x71, 0xdf, 0xbb, 0xf3, 0x3b, 0x0b,
-	0x9c, 0xac, 0x96, 0xde, 0x65, 0x91, 0xaf, 0x9a, 0x22, 0x3c, 0x59, 0xd8, 0xef, 0xf4, 0x17, 0x9f,
-	0x1f, 0x8e, 0x17, 0x69, 0xba, 0xf2, 0xd5, 0x33, 0x6d, 0x1b, 0x66, 0xbb, 0x98, 0x77, 0xd5, 0x61,
-	0xa8, 0x23, 0xf5, 0x2c, 0x7d, 0xd2, 0xac, 0x0e, 0x51, 0x41, 0xbc, 0x80, 0x16, 0xa8, 0x29, 0x1e,
-	0x9c, 0x5f, 0x4d, 0xc3, 0xb5, 0xd2, 0x31, 0x7d, 0x53, 0xea, 0xff, 0xe3, 0x13, 0x3b, 0x98, 0x21,
-	0x67, 0xbf, 0xb9, 0x0c, 0xe9, 0xfc, 0xc9, 0x82, 0xeb, 0x5a, 0xa1, 0x57, 0x6a, 0xf3, 0x28, 0xa1,
-	0x9d, 0xce, 0x28, 0x89, 0xea, 0x25, 0x89, 0xae, 0xc3, 0xb2, 0x51, 0xc3, 0x98, 0x1b, 0x8d, 0x06,
-	0x46, 0x65, 0x3f, 0x2e, 0xf4, 0x23, 0xf1, 0x4d, 0x02, 0x2a, 0x6d, 0xa9, 0x9d, 0x7f, 0x53, 0x9e,
-	0xef, 0xca, 0x0d, 0xbe, 0x05, 0xab, 0x71, 0x80, 0xbd, 0x7e, 0xf3, 0x59, 0x65, 0xbe, 0xa2, 0x3f,
-	0xe4, 0xb6, 0xce, 0xf7, 0x61, 0x59, 0x2d, 0x46, 0x8d, 0xec, 0x63, 0x1a, 0xd8, 0xeb, 0xf0, 0x96,
-	0x89, 0x65, 0x43, 0x39, 0x7b, 0xb5, 0x2f, 0xc1, 0xbc, 0x84, 0x22, 0xfa, 0x7c, 0xd6, 0x91, 0x79,
-	0xb3, 0xd7, 0x60, 0xee, 0x24, 0xc0, 0x1d, 0xdd, 0xa6, 0x2d, 0x21, 0xfd, 0xe2, 0xfc, 0xdc, 0x82,
-	0x0f, 0xf5, 0xaf, 0x02, 0x82, 0x85, 0xd4, 0x2b, 0xa9, 0xba, 0x4f, 0xc8, 0xbd, 0x34, 0x10, 0x34,
-	0x0e, 0x28, 0x49, 0xb8, 0xce, 0x33, 0xbe, 0x4d, 0xe0, 0x52, 0xf6, 0x7b, 0x03, 0x21, 0x6e, 0x58,
-	0x18, 0x98, 0xd3, 0x58, 0x99, 0xe8, 0x4c, 0xd5, 0x59, 0x06, 0x46, 0x6b, 0xe1, 0xf0, 0x20, 0x77,
-	0xfe, 0x60, 0x99, 0x3e, 0x50, 0x51, 0x69, 0x33, 0xf6, 0xd4, 0x24, 0xba, 0xfb, 0x50, 0xe7, 0x31,
-	0x1b, 0xbc, 0xc6, 0x2b, 0x0f, 0xdd, 0x00, 0x04, 0xaa, 0x49, 0x00, 0x73, 0x8b, 0xdb, 0x4f, 0xc0,
-	0xf6, 0xf3, 0xb0, 0xc8, 0x51, 0xa7, 0x27, 0x47, 0x5d, 0x2d, 0x60, 0xb2, 0x0a, 0xa1, 0x0b, 0x2b,
-	0x83, 0xf4, 0xdf, 0x86, 0x19, 0x4e, 0x9e, 0xa9, 0x2d, 0x9b, 0x45, 0xf2, 0xd1, 0xde, 0x85, 0x45,
-	0x96, 0x19, 0x99, 0x14, 0x72, 0x6d, 0x2c, 0xbf, 0xa8, 0x98, 0xe7, 0xfc, 0xc6, 0x82, 0xc5, 0xfc,
-	0x43, 0x75, 0x40, 0x7f, 0x47, 0xff, 0x08, 0x10, 0x90, 0x53, 0x92, 0xa7, 0xf0, 0xab, 0x55, 0x0e,
-	0x0f, 0xa5, 0xa5, 0xea, 0xfa, 0xd5, 0x13, 0xb7, 0x5b, 0xa6, 0xeb, 0x37, 0x10, 0x33, 0xe3, 0x42,
-	0xa8, 0x36, 0x5f, 0x63, 0xb4, 0xba, 0x5f, 0x9e, 0x6d, 0x5a, 0x5f, 0x9d, 0x6d, 0x5a, 0xff, 0x3c,
-	0xdb, 0xb4, 0x7e, 0xf6, 0x72, 0x73, 0xea, 0xab, 0x97, 0x9b, 0x53, 0x7f, 0x79, 0xb9, 0x39, 0xf5,
-	0xe4, 0x7e, 0xa9, 0x72, 0x39, 0xc8, 0x20, 0x0f, 0x71, 0x9b, 0x37, 0x73, 0x07, 0x1f, 0x7b, 0x2c,
-	0x21, 0xe5, 0xd7, 0x2e, 0xa6, 0x51, 0x33, 0x64, 0xf2, 0xba, 0xe4, 0xc5, 0xff, 0x24, 0x54, 0x95,
-	0xd3, 0x9e, 0x57, 0xff, 0x89, 0xf8, 0xf4, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xc0, 0xc6,
-	0x87, 0x58, 0x19, 0x00, 0x00,
+func (m *EventTradingRewardDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradingRewardDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradingRewardDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradingRewardDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradingRewardDistribution.Merge(m, src)
+}
+func (m *EventTradingRewardDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradingRewardDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradingRewardDistribution.DiscardUnknown(m)
 }
 
-func (m *EventBatchSpotExecution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventTradingRewardDistribution proto.InternalMessageInfo
+
+func (m *EventTradingRewardDistribution) GetAccountRewards() []*AccountRewards {
+	if m != nil {
+		return m.AccountRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBatchSpotExecution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventNewConditionalDerivativeOrder struct {
+	MarketId string           `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Order    *DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Hash     []byte           `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	IsMarket bool             `protobuf:"varint,4,opt,name=is_market,json=isMarket,proto3" json:"is_market,omitempty"`
 }
 
-func (m *EventBatchSpotExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trades) > 0 {
-		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ExecutionType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IsBuy {
-		i--
-		if m.IsBuy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNewConditionalDerivativeOrder) Reset()         { *m = EventNewConditionalDerivativeOrder{} }
+func (m *EventNewConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
+func (*EventNewConditionalDerivativeOrder) ProtoMessage()    {}
+func (*EventNewConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{34}
+}
+func (m *EventNewConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewConditionalDerivativeOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventNewConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewConditionalDerivativeOrder.Merge(m, src)
+}
+func (m *EventNewConditionalDerivativeOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewConditionalDerivativeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewConditionalDerivativeOrder.DiscardUnknown(m)
 }
 
-func (m *EventBatchDerivativeExecution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventNewConditionalDerivativeOrder proto.InternalMessageInfo
+
+func (m *EventNewConditionalDerivativeOrder) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventBatchDerivativeExecution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNewConditionalDerivativeOrder) GetOrder() *DerivativeOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
 }
 
-func (m *EventBatchDerivativeExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trades) > 0 {
-		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.ExecutionType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.CumulativeFunding != nil {
-		{
-			size := m.CumulativeFunding.Size()
-			i -= size
-			if _, err := m.CumulativeFunding.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IsLiquidation {
-		i--
-		if m.IsLiquidation {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IsBuy {
-		i--
-		if m.IsBuy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventNewConditionalDerivativeOrder) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventLostFundsFromLiquidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNewConditionalDerivativeOrder) GetIsMarket() bool {
+	if m != nil {
+		return m.IsMarket
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventLostFundsFromLiquidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventCancelConditionalDerivativeOrder struct {
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsLimitCancel bool                   `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
+	LimitOrder    *DerivativeLimitOrder  `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
+	MarketOrder   *DerivativeMarketOrder `protobuf:"bytes,4,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
 }
 
-func (m *EventLostFundsFromLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LostFundsFromOrderCancels.Size()
-		i -= size
-		if _, err := m.LostFundsFromOrderCancels.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventCancelConditionalDerivativeOrder) Reset()         { *m = EventCancelConditionalDerivativeOrder{} }
+func (m *EventCancelConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelConditionalDerivativeOrder) ProtoMessage()    {}
+func (*EventCancelConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{35}
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelConditionalDerivativeOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.LostFundsFromAvailableDuringPayout.Size()
-		i -= size
-		if _, err := m.LostFundsFromAvailableDuringPayout.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelConditionalDerivativeOrder.Merge(m, src)
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelConditionalDerivativeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelConditionalDerivativeOrder proto.InternalMessageInfo
+
+func (m *EventCancelConditionalDerivativeOrder) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventCancelConditionalDerivativeOrder) GetIsLimitCancel() bool {
+	if m != nil {
+		return m.IsLimitCancel
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *EventCancelConditionalDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
+	if m != nil {
+		return m.LimitOrder
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventBatchDerivativePosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCancelConditionalDerivativeOrder) GetMarketOrder() *DerivativeMarketOrder {
+	if m != nil {
+		return m.MarketOrder
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBatchDerivativePosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventConditionalDerivativeOrderTrigger struct {
+	MarketId           []byte `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsLimitTrigger     bool   `protobuf:"varint,2,opt,name=isLimitTrigger,proto3" json:"isLimitTrigger,omitempty"`
+	TriggeredOrderHash []byte `protobuf:"bytes,3,opt,name=triggered_order_hash,json=triggeredOrderHash,proto3" json:"triggered_order_hash,omitempty"`
+	PlacedOrderHash    []byte `protobuf:"bytes,4,opt,name=placed_order_hash,json=placedOrderHash,proto3" json:"placed_order_hash,omitempty"`
 }
 
-func (m *EventBatchDerivativePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *EventConditionalDerivativeOrderTrigger) Reset() {
+	*m = EventConditionalDerivativeOrderTrigger{}
+}
+func (m *EventConditionalDerivativeOrderTrigger) String() string { return proto.CompactTextString(m) }
+func (*EventConditionalDerivativeOrderTrigger) ProtoMessage()    {}
+func (*EventConditionalDerivativeOrderTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{36}
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Merge(m, src)
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.DiscardUnknown(m)
 }
 
-func (m *EventDerivativeMarketPaused) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventConditionalDerivativeOrderTrigger proto.InternalMessageInfo
+
+func (m *EventConditionalDerivativeOrderTrigger) GetMarketId() []byte {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventDerivativeMarketPaused) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventConditionalDerivativeOrderTrigger) GetIsLimitTrigger() bool {
+	if m != nil {
+		return m.IsLimitTrigger
+	}
+	return false
 }
 
-func (m *EventDerivativeMarketPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MissingFundsRate) > 0 {
-		i -= len(m.MissingFundsRate)
-		copy(dAtA[i:], m.MissingFundsRate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TotalMissingFunds) > 0 {
-		i -= len(m.TotalMissingFunds)
-		copy(dAtA[i:], m.TotalMissingFunds)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalMissingFunds)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SettlePrice) > 0 {
-		i -= len(m.SettlePrice)
-		copy(dAtA[i:], m.SettlePrice)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventConditionalDerivativeOrderTrigger) GetTriggeredOrderHash() []byte {
+	if m != nil {
+		return m.TriggeredOrderHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventMarketBeyondBankruptcy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventConditionalDerivativeOrderTrigger) GetPlacedOrderHash() []byte {
+	if m != nil {
+		return m.PlacedOrderHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventMarketBeyondBankruptcy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventOrderFail struct {
+	Account []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Hashes  [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Flags   []uint32 `protobuf:"varint,3,rep,packed,name=flags,proto3" json:"flags,omitempty"`
 }
 
-func (m *EventMarketBeyondBankruptcy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MissingMarketFunds) > 0 {
-		i -= len(m.MissingMarketFunds)
-		copy(dAtA[i:], m.MissingMarketFunds)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingMarketFunds)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SettlePrice) > 0 {
-		i -= len(m.SettlePrice)
-		copy(dAtA[i:], m.SettlePrice)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventOrderFail) Reset()         { *m = EventOrderFail{} }
+func (m *EventOrderFail) String() string { return proto.CompactTextString(m) }
+func (*EventOrderFail) ProtoMessage()    {}
+func (*EventOrderFail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{37}
 }
-
-func (m *EventAllPositionsHaircut) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOrderFail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderFail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderFail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventAllPositionsHaircut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOrderFail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderFail.Merge(m, src)
+}
+func (m *EventOrderFail) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderFail) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderFail.DiscardUnknown(m)
 }
 
-func (m *EventAllPositionsHaircut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MissingFundsRate) > 0 {
-		i -= len(m.MissingFundsRate)
-		copy(dAtA[i:], m.MissingFundsRate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SettlePrice) > 0 {
-		i -= len(m.SettlePrice)
-		copy(dAtA[i:], m.SettlePrice)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventOrderFail proto.InternalMessageInfo
+
+func (m *EventOrderFail) GetAccount() []byte {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventBinaryOptionsMarketUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOrderFail) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBinaryOptionsMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOrderFail) GetFlags() []uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
 }
 
-func (m *EventBinaryOptionsMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type EventAtomicMarketOrderFeeMultipliersUpdated struct {
+	MarketFeeMultipliers []*MarketFeeMultiplier `protobuf:"bytes,1,rep,name=market_fee_multipliers,json=marketFeeMultipliers,proto3" json:"market_fee_multipliers,omitempty"`
 }
 
-func (m *EventNewSpotOrders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Reset() {
+	*m = EventAtomicMarketOrderFeeMultipliersUpdated{}
 }
-
-func (m *EventNewSpotOrders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *EventNewSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SellOrders) > 0 {
-		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.BuyOrders) > 0 {
-		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (*EventAtomicMarketOrderFeeMultipliersUpdated) ProtoMessage() {}
+func (*EventAtomicMarketOrderFeeMultipliersUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{38}
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Merge(m, src)
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.DiscardUnknown(m)
 }
 
-func (m *EventNewDerivativeOrders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated proto.InternalMessageInfo
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) GetMarketFeeMultipliers() []*MarketFeeMultiplier {
+	if m != nil {
+		return m.MarketFeeMultipliers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventNewDerivativeOrders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTradingSchedulesUpdated struct {
+	Schedules []*MarketTradingSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 }
 
-func (m *EventNewDerivativeOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SellOrders) > 0 {
-		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.BuyOrders) > 0 {
-		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *EventTradingSchedulesUpdated) Reset()         { *m = EventTradingSchedulesUpdated{} }
+func (m *EventTradingSchedulesUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventTradingSchedulesUpdated) ProtoMessage()    {}
+func (*EventTradingSchedulesUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{39}
+}
+func (m *EventTradingSchedulesUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradingSchedulesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradingSchedulesUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventTradingSchedulesUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradingSchedulesUpdated.Merge(m, src)
+}
+func (m *EventTradingSchedulesUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradingSchedulesUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradingSchedulesUpdated.DiscardUnknown(m)
 }
 
-func (m *EventCancelSpotOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventTradingSchedulesUpdated proto.InternalMessageInfo
+
+func (m *EventTradingSchedulesUpdated) GetSchedules() []*MarketTradingSchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventMarketDelistingExemptionsUpdated struct {
+	MarketIds []string `protobuf:"bytes,1,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	IsExempt  bool     `protobuf:"varint,2,opt,name=is_exempt,json=isExempt,proto3" json:"is_exempt,omitempty"`
 }
 
-func (m *EventCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventMarketDelistingExemptionsUpdated) Reset()         { *m = EventMarketDelistingExemptionsUpdated{} }
+func (m *EventMarketDelistingExemptionsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketDelistingExemptionsUpdated) ProtoMessage()    {}
+func (*EventMarketDelistingExemptionsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{40}
+}
+func (m *EventMarketDelistingExemptionsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketDelistingExemptionsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketDelistingExemptionsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarketDelistingExemptionsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketDelistingExemptionsUpdated.Merge(m, src)
+}
+func (m *EventMarketDelistingExemptionsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketDelistingExemptionsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketDelistingExemptionsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketDelistingExemptionsUpdated proto.InternalMessageInfo
+
+func (m *EventMarketDelistingExemptionsUpdated) GetMarketIds() []string {
+	if m != nil {
+		return m.MarketIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventSpotMarketUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketDelistingExemptionsUpdated) GetIsExempt() bool {
+	if m != nil {
+		return m.IsExempt
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventSpotMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventOrderbookUpdate struct {
+	SpotUpdates       []*OrderbookUpdate `protobuf:"bytes,1,rep,name=spot_updates,json=spotUpdates,proto3" json:"spot_updates,omitempty"`
+	DerivativeUpdates []*OrderbookUpdate `protobuf:"bytes,2,rep,name=derivative_updates,json=derivativeUpdates,proto3" json:"derivative_updates,omitempty"`
 }
 
-func (m *EventSpotMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventOrderbookUpdate) Reset()         { *m = EventOrderbookUpdate{} }
+func (m *EventOrderbookUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventOrderbookUpdate) ProtoMessage()    {}
+func (*EventOrderbookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{41}
+}
+func (m *EventOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderbookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *EventPerpetualMarketUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventOrderbookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderbookUpdate.Merge(m, src)
 }
-
-func (m *EventPerpetualMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOrderbookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderbookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderbookUpdate.DiscardUnknown(m)
 }
 
-func (m *EventPerpetualMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Funding != nil {
-		{
-			size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_EventOrderbookUpdate proto.InternalMessageInfo
+
+func (m *EventOrderbookUpdate) GetSpotUpdates() []*OrderbookUpdate {
+	if m != nil {
+		return m.SpotUpdates
 	}
-	if m.PerpetualMarketInfo != nil {
-		{
-			size, err := m.PerpetualMarketInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *EventOrderbookUpdate) GetDerivativeUpdates() []*OrderbookUpdate {
+	if m != nil {
+		return m.DerivativeUpdates
+	}
+	return nil
+}
+
+type OrderbookUpdate struct {
+	Seq       uint64     `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Orderbook *Orderbook `protobuf:"bytes,2,opt,name=orderbook,proto3" json:"orderbook,omitempty"`
+}
+
+func (m *OrderbookUpdate) Reset()         { *m = OrderbookUpdate{} }
+func (m *OrderbookUpdate) String() string { return proto.CompactTextString(m) }
+func (*OrderbookUpdate) ProtoMessage()    {}
+func (*OrderbookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{42}
+}
+func (m *OrderbookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderbookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *OrderbookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookUpdate.Merge(m, src)
+}
+func (m *OrderbookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderbookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderbookUpdate proto.InternalMessageInfo
+
+func (m *OrderbookUpdate) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *OrderbookUpdate) GetOrderbook() *Orderbook {
+	if m != nil {
+		return m.Orderbook
+	}
+	return nil
+}
+
+type Orderbook struct {
+	MarketId   []byte   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BuyLevels  []*Level `protobuf:"bytes,2,rep,name=buy_levels,json=buyLevels,proto3" json:"buy_levels,omitempty"`
+	SellLevels []*Level `protobuf:"bytes,3,rep,name=sell_levels,json=sellLevels,proto3" json:"sell_levels,omitempty"`
+}
+
+func (m *Orderbook) Reset()         { *m = Orderbook{} }
+func (m *Orderbook) String() string { return proto.CompactTextString(m) }
+func (*Orderbook) ProtoMessage()    {}
+func (*Orderbook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{43}
+}
+func (m *Orderbook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Orderbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Orderbook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Orderbook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Orderbook.Merge(m, src)
+}
+func (m *Orderbook) XXX_Size() int {
+	return m.Size()
+}
+func (m *Orderbook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Orderbook.DiscardUnknown(m)
 }
 
-func (m *EventExpiryFuturesMarketUpdate) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_Orderbook proto.InternalMessageInfo
+
+func (m *Orderbook) GetMarketId() []byte {
+	if m != nil {
+		return m.MarketId
+	}
+	return nil
+}
+
+func (m *Orderbook) GetBuyLevels() []*Level {
+	if m != nil {
+		return m.BuyLevels
+	}
+	return nil
+}
+
+func (m *Orderbook) GetSellLevels() []*Level {
+	if m != nil {
+		return m.SellLevels
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventBatchSpotExecution)(nil), "injective.exchange.v1beta1.EventBatchSpotExecution")
+	proto.RegisterType((*EventBatchDerivativeExecution)(nil), "injective.exchange.v1beta1.EventBatchDerivativeExecution")
+	proto.RegisterType((*EventLostFundsFromLiquidation)(nil), "injective.exchange.v1beta1.EventLostFundsFromLiquidation")
+	proto.RegisterType((*EventBatchDerivativePosition)(nil), "injective.exchange.v1beta1.EventBatchDerivativePosition")
+	proto.RegisterType((*EventDerivativeMarketPaused)(nil), "injective.exchange.v1beta1.EventDerivativeMarketPaused")
+	proto.RegisterType((*EventForceSettledPosition)(nil), "injective.exchange.v1beta1.EventForceSettledPosition")
+	proto.RegisterType((*EventAutoDeleverage)(nil), "injective.exchange.v1beta1.EventAutoDeleverage")
+	proto.RegisterType((*EventMarketOrdersCancelled)(nil), "injective.exchange.v1beta1.EventMarketOrdersCancelled")
+	proto.RegisterType((*EventMarketAutoDelisted)(nil), "injective.exchange.v1beta1.EventMarketAutoDelisted")
+	proto.RegisterType((*EventMakerRebate)(nil), "injective.exchange.v1beta1.EventMakerRebate")
+	proto.RegisterType((*EventFeeSettlement)(nil), "injective.exchange.v1beta1.EventFeeSettlement")
+	proto.RegisterType((*EventDustSweep)(nil), "injective.exchange.v1beta1.EventDustSweep")
+	proto.RegisterType((*EventSubaccountMarginModeUpdate)(nil), "injective.exchange.v1beta1.EventSubaccountMarginModeUpdate")
+	proto.RegisterType((*EventPositionTransfer)(nil), "injective.exchange.v1beta1.EventPositionTransfer")
+	proto.RegisterType((*EventMarketBeyondBankruptcy)(nil), "injective.exchange.v1beta1.EventMarketBeyondBankruptcy")
+	proto.RegisterType((*EventAllPositionsHaircut)(nil), "injective.exchange.v1beta1.EventAllPositionsHaircut")
+	proto.RegisterType((*EventBinaryOptionsMarketUpdate)(nil), "injective.exchange.v1beta1.EventBinaryOptionsMarketUpdate")
+	proto.RegisterType((*EventNewSpotOrders)(nil), "injective.exchange.v1beta1.EventNewSpotOrders")
+	proto.RegisterType((*EventNewDerivativeOrders)(nil), "injective.exchange.v1beta1.EventNewDerivativeOrders")
+	proto.RegisterType((*EventCancelSpotOrder)(nil), "injective.exchange.v1beta1.EventCancelSpotOrder")
+	proto.RegisterType((*EventOrderExpired)(nil), "injective.exchange.v1beta1.EventOrderExpired")
+	proto.RegisterType((*EventSpotMarketUpdate)(nil), "injective.exchange.v1beta1.EventSpotMarketUpdate")
+	proto.RegisterType((*EventPerpetualMarketUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketUpdate")
+	proto.RegisterType((*EventExpiryFuturesMarketUpdate)(nil), "injective.exchange.v1beta1.EventExpiryFuturesMarketUpdate")
+	proto.RegisterType((*EventPerpetualMarketFundingUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketFundingUpdate")
+	proto.RegisterType((*EventSubaccountDeposit)(nil), "injective.exchange.v1beta1.EventSubaccountDeposit")
+	proto.RegisterType((*EventSubaccountWithdraw)(nil), "injective.exchange.v1beta1.EventSubaccountWithdraw")
+	proto.RegisterType((*EventSubaccountBalanceTransfer)(nil), "injective.exchange.v1beta1.EventSubaccountBalanceTransfer")
+	proto.RegisterType((*EventBatchDepositUpdate)(nil), "injective.exchange.v1beta1.EventBatchDepositUpdate")
+	proto.RegisterType((*DerivativeMarketOrderCancel)(nil), "injective.exchange.v1beta1.DerivativeMarketOrderCancel")
+	proto.RegisterType((*EventCancelDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelDerivativeOrder")
+	proto.RegisterType((*EventFeeDiscountSchedule)(nil), "injective.exchange.v1beta1.EventFeeDiscountSchedule")
+	proto.RegisterType((*EventTradingRewardCampaignUpdate)(nil), "injective.exchange.v1beta1.EventTradingRewardCampaignUpdate")
+	proto.RegisterType((*EventTradingRewardDistribution)(nil), "injective.exchange.v1beta1.EventTradingRewardDistribution")
+	proto.RegisterType((*EventNewConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventNewConditionalDerivativeOrder")
+	proto.RegisterType((*EventCancelConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelConditionalDerivativeOrder")
+	proto.RegisterType((*EventConditionalDerivativeOrderTrigger)(nil), "injective.exchange.v1beta1.EventConditionalDerivativeOrderTrigger")
+	proto.RegisterType((*EventOrderFail)(nil), "injective.exchange.v1beta1.EventOrderFail")
+	proto.RegisterType((*EventAtomicMarketOrderFeeMultipliersUpdated)(nil), "injective.exchange.v1beta1.EventAtomicMarketOrderFeeMultipliersUpdated")
+	proto.RegisterType((*EventTradingSchedulesUpdated)(nil), "injective.exchange.v1beta1.EventTradingSchedulesUpdated")
+	proto.RegisterType((*EventMarketDelistingExemptionsUpdated)(nil), "injective.exchange.v1beta1.EventMarketDelistingExemptionsUpdated")
+	proto.RegisterType((*EventOrderbookUpdate)(nil), "injective.exchange.v1beta1.EventOrderbookUpdate")
+	proto.RegisterType((*OrderbookUpdate)(nil), "injective.exchange.v1beta1.OrderbookUpdate")
+	proto.RegisterType((*Orderbook)(nil), "injective.exchange.v1beta1.Orderbook")
+}
+
+func init() {
+	proto.RegisterFile("injective/exchange/v1beta1/events.proto", fileDescriptor_20dda602b6b13fd3)
+}
+
+var fileDescriptor_20dda602b6b13fd3 = []byte{
+	// 2561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcf, 0x6f, 0xdc, 0xd6,
+	0xf1, 0x37, 0x77, 0x25, 0x59, 0x3b, 0xab, 0x1f, 0x16, 0x25, 0x3b, 0x1b, 0x27, 0x96, 0x1c, 0x7e,
+	0x63, 0x45, 0x71, 0x92, 0x55, 0x24, 0x7f, 0x8b, 0xf4, 0xd0, 0x83, 0x2d, 0xcb, 0x5b, 0x3b, 0x91,
+	0x2c, 0x99, 0x72, 0xe1, 0xd6, 0x40, 0x40, 0xbc, 0x25, 0x9f, 0x76, 0x5f, 0x45, 0xf2, 0xd1, 0x7c,
+	0xa4, 0xe4, 0x45, 0x8f, 0xed, 0xa1, 0x3d, 0xa5, 0x40, 0x0b, 0xb4, 0xb7, 0xb6, 0xa7, 0x02, 0x3d,
+	0x14, 0xe8, 0xa1, 0x87, 0xa2, 0xb7, 0x9e, 0x52, 0xf4, 0x12, 0xf4, 0xd2, 0x9f, 0x48, 0x0b, 0xbb,
+	0xf9, 0x07, 0xfa, 0x17, 0x14, 0xef, 0x17, 0xc9, 0x5d, 0xad, 0x57, 0xbb, 0x92, 0x8b, 0x9e, 0x44,
+	0x3e, 0xce, 0x7c, 0x66, 0xde, 0xcc, 0xbc, 0x99, 0x79, 0xb3, 0x82, 0xb7, 0x48, 0xf8, 0x4d, 0xec,
+	0x26, 0xe4, 0x10, 0xaf, 0xe2, 0xa7, 0x6e, 0x1b, 0x85, 0x2d, 0xbc, 0x7a, 0xb8, 0xd6, 0xc4, 0x09,
+	0x5a, 0x5b, 0xc5, 0x87, 0x38, 0x4c, 0x58, 0x3d, 0x8a, 0x69, 0x42, 0xcd, 0xcb, 0x19, 0x61, 0x5d,
+	0x13, 0xd6, 0x15, 0xe1, 0xe5, 0x85, 0x16, 0x6d, 0x51, 0x41, 0xb6, 0xca, 0x9f, 0x24, 0xc7, 0xe5,
+	0x45, 0x97, 0xb2, 0x80, 0xb2, 0xd5, 0x26, 0x62, 0x39, 0xa6, 0x4b, 0x49, 0xa8, 0xbe, 0x5f, 0xcb,
+	0x45, 0xd3, 0x18, 0xb9, 0x7e, 0x4e, 0x24, 0x5f, 0x15, 0xd9, 0xdb, 0x83, 0x34, 0xd4, 0x9a, 0x08,
+	0x52, 0xeb, 0xef, 0x06, 0xbc, 0x72, 0x87, 0x2b, 0xbd, 0x81, 0x12, 0xb7, 0xbd, 0x17, 0xd1, 0xe4,
+	0xce, 0x53, 0xec, 0xa6, 0x09, 0xa1, 0xa1, 0xf9, 0x1a, 0x54, 0x02, 0x14, 0x1f, 0xe0, 0xc4, 0x21,
+	0x5e, 0xcd, 0xb8, 0x6a, 0xac, 0x54, 0xec, 0x49, 0xb9, 0x70, 0xcf, 0x33, 0x2f, 0xc2, 0x04, 0x61,
+	0x4e, 0x33, 0xed, 0xd4, 0x4a, 0x57, 0x8d, 0x95, 0x49, 0x7b, 0x9c, 0xb0, 0x8d, 0xb4, 0x63, 0xee,
+	0xc0, 0x34, 0xd6, 0x00, 0x0f, 0x3b, 0x11, 0xae, 0x95, 0xaf, 0x1a, 0x2b, 0x33, 0xeb, 0x6f, 0xd7,
+	0x5f, 0x6c, 0x8b, 0xfa, 0x9d, 0x22, 0x83, 0xdd, 0xcd, 0x6f, 0x7e, 0x05, 0x26, 0x92, 0x18, 0x79,
+	0x98, 0xd5, 0xc6, 0xae, 0x96, 0x57, 0xaa, 0xeb, 0x6f, 0x0e, 0x42, 0x7a, 0xc8, 0x29, 0xb7, 0x68,
+	0xcb, 0x56, 0x3c, 0xd6, 0xbf, 0x4b, 0x70, 0x25, 0xdf, 0xde, 0x26, 0x8e, 0xc9, 0x21, 0xe2, 0xac,
+	0x67, 0xdb, 0xe4, 0x35, 0x98, 0x21, 0xcc, 0xf1, 0xc9, 0x93, 0x94, 0x78, 0x88, 0xa3, 0x88, 0x5d,
+	0x4e, 0xda, 0xd3, 0x84, 0x6d, 0xe5, 0x8b, 0xe6, 0xc7, 0x60, 0xba, 0x69, 0x90, 0xfa, 0x42, 0xa2,
+	0xb3, 0x9f, 0x86, 0x1e, 0x09, 0x5b, 0xb5, 0x31, 0x2e, 0x63, 0xa3, 0xfe, 0xe9, 0xe7, 0x4b, 0xc6,
+	0x5f, 0x3f, 0x5f, 0x5a, 0x6e, 0x91, 0xa4, 0x9d, 0x36, 0xeb, 0x2e, 0x0d, 0x56, 0x95, 0xf3, 0xe5,
+	0x9f, 0xf7, 0x98, 0x77, 0xb0, 0x9a, 0x74, 0x22, 0xcc, 0xea, 0x9b, 0xd8, 0xb5, 0xe7, 0x72, 0xa4,
+	0x86, 0x04, 0x3a, 0x6e, 0xea, 0xf1, 0x33, 0x9a, 0xba, 0x91, 0x99, 0x7a, 0x42, 0x98, 0xba, 0x3e,
+	0x08, 0x29, 0xb7, 0xe5, 0x31, 0xa3, 0xff, 0x45, 0x1b, 0x7d, 0x8b, 0xb2, 0x84, 0x6b, 0xcb, 0x1a,
+	0x31, 0x0d, 0x8a, 0x96, 0x19, 0x68, 0xf4, 0xff, 0x83, 0x69, 0x96, 0x36, 0x91, 0xeb, 0xd2, 0x34,
+	0x14, 0x04, 0xdc, 0xf6, 0x53, 0xf6, 0x54, 0xbe, 0x78, 0xcf, 0x33, 0xbf, 0x6d, 0xc0, 0x5b, 0x3e,
+	0x65, 0x89, 0x30, 0x2b, 0x73, 0xf6, 0x63, 0x1a, 0x38, 0xe8, 0x10, 0x11, 0x1f, 0x35, 0x7d, 0xec,
+	0x78, 0x69, 0x4c, 0xc2, 0x96, 0x13, 0xa1, 0x0e, 0x4d, 0x93, 0x5a, 0x39, 0xb3, 0xf8, 0xb9, 0x11,
+	0x2c, 0x6e, 0xf9, 0x45, 0xed, 0x6f, 0x69, 0xec, 0x4d, 0x01, 0xbd, 0x2b, 0x90, 0xcd, 0x08, 0xae,
+	0xf4, 0x2a, 0x41, 0x63, 0x0f, 0xc7, 0x8e, 0x8b, 0x42, 0x17, 0xfb, 0xac, 0x36, 0x76, 0x2a, 0xd1,
+	0xaf, 0x76, 0x89, 0xde, 0xe1, 0x88, 0xb7, 0x25, 0xa0, 0xf5, 0x3d, 0x03, 0x5e, 0xef, 0x17, 0xd0,
+	0xbb, 0x94, 0x91, 0x93, 0x4d, 0xbb, 0x05, 0x95, 0x48, 0x11, 0xb2, 0x5a, 0xe9, 0x64, 0x27, 0xef,
+	0x65, 0x26, 0xd7, 0xf8, 0x76, 0x0e, 0x60, 0xfd, 0xd6, 0x80, 0xd7, 0x84, 0x2e, 0xb9, 0x1a, 0xdb,
+	0x42, 0xd2, 0x2e, 0x4a, 0x19, 0xf6, 0x06, 0xab, 0xf2, 0x06, 0x4c, 0x31, 0x9c, 0x24, 0x3e, 0x76,
+	0xa2, 0x98, 0xb8, 0x58, 0x38, 0xb9, 0x62, 0x57, 0xe5, 0xda, 0x2e, 0x5f, 0x32, 0xeb, 0x30, 0x9f,
+	0xd0, 0x04, 0xf9, 0x4e, 0x40, 0x18, 0xe3, 0xfe, 0x14, 0x66, 0x96, 0xee, 0xb4, 0xe7, 0xc4, 0xa7,
+	0x6d, 0xf9, 0x45, 0xd8, 0xca, 0x7c, 0x17, 0xcc, 0x2e, 0x4a, 0x27, 0x46, 0x09, 0x96, 0x2e, 0xb0,
+	0x2f, 0x04, 0x05, 0x4a, 0x1b, 0x25, 0xd8, 0xfa, 0xa2, 0x04, 0xaf, 0x0a, 0xed, 0x1b, 0x34, 0x76,
+	0xf1, 0x9e, 0x90, 0xeb, 0x0d, 0x67, 0xc6, 0xbe, 0x11, 0x5a, 0xe9, 0x89, 0xd0, 0x57, 0xe0, 0x3c,
+	0x4f, 0x12, 0x34, 0x6c, 0xa9, 0xec, 0x30, 0x41, 0xd8, 0x16, 0x0d, 0x5b, 0xe6, 0x87, 0x30, 0xf9,
+	0x24, 0x45, 0x61, 0x42, 0x92, 0xce, 0x29, 0xe3, 0x23, 0xe3, 0x37, 0xbf, 0x01, 0x17, 0xa4, 0xc5,
+	0x02, 0x1c, 0x26, 0xca, 0x92, 0xe3, 0xa7, 0xc2, 0x9c, 0xcd, 0x71, 0xa4, 0xf5, 0x1b, 0x30, 0xa1,
+	0xce, 0xcf, 0xc4, 0xa9, 0x00, 0x15, 0xb7, 0xf5, 0x9d, 0x32, 0xcc, 0x0b, 0x3b, 0xdf, 0x4a, 0x13,
+	0xba, 0x89, 0x7d, 0x7c, 0x88, 0x63, 0xd4, 0xc2, 0x2f, 0xc1, 0xc2, 0x5f, 0x86, 0x9a, 0xce, 0xc1,
+	0xd8, 0x73, 0xba, 0xe9, 0x65, 0x90, 0x5c, 0xca, 0xbf, 0xef, 0xbd, 0xc0, 0x37, 0x63, 0x2f, 0xf4,
+	0xcd, 0xf8, 0x19, 0x7d, 0xb3, 0x09, 0xe3, 0xd2, 0x21, 0xa7, 0xb3, 0xdf, 0x78, 0xd4, 0xe3, 0x86,
+	0xf3, 0x67, 0x72, 0xc3, 0x8f, 0x0c, 0xb8, 0x2c, 0xdc, 0x20, 0x8f, 0xa8, 0x48, 0x2a, 0x4c, 0x66,
+	0x15, 0xff, 0xa4, 0xb3, 0xfa, 0xff, 0x70, 0xc9, 0xd5, 0x94, 0x32, 0xc1, 0x31, 0x47, 0x98, 0x52,
+	0xb8, 0x65, 0xda, 0x5e, 0xc8, 0xbe, 0x2a, 0x58, 0xfe, 0xcd, 0x5c, 0x86, 0xd9, 0x36, 0x62, 0x4e,
+	0x40, 0x63, 0xac, 0x98, 0x74, 0x99, 0x6c, 0x23, 0xb6, 0x4d, 0x63, 0x2c, 0x89, 0xad, 0x9f, 0xe9,
+	0x16, 0x44, 0x6a, 0xa6, 0xc2, 0x84, 0xb0, 0xe4, 0x24, 0xb5, 0x6e, 0xc2, 0x04, 0x4b, 0x50, 0x92,
+	0x32, 0xa1, 0xc6, 0xcc, 0xfa, 0xca, 0xa0, 0x54, 0x26, 0xc1, 0xf7, 0x04, 0xbd, 0xad, 0xf8, 0xcc,
+	0xb7, 0x60, 0x96, 0x84, 0x48, 0x70, 0x38, 0x4d, 0x9f, 0xba, 0x07, 0x52, 0xc5, 0xb2, 0x3d, 0xa3,
+	0x97, 0x37, 0xc4, 0xaa, 0xf5, 0x03, 0x03, 0x2e, 0x28, 0x1d, 0x0f, 0x70, 0x6c, 0xe3, 0x26, 0x4a,
+	0xb0, 0x59, 0x83, 0xf3, 0x2a, 0xa4, 0x94, 0x6a, 0xfa, 0xd5, 0xc4, 0x70, 0x3e, 0x16, 0x34, 0x3a,
+	0xcb, 0xbe, 0x5a, 0x97, 0xce, 0xa9, 0xf3, 0xce, 0x2e, 0xd3, 0xe9, 0x36, 0x25, 0xe1, 0xc6, 0xfb,
+	0xdc, 0xa1, 0xbf, 0xf8, 0xc7, 0xd2, 0xca, 0x10, 0x0e, 0xe5, 0x0c, 0xcc, 0xd6, 0xd8, 0xd6, 0x17,
+	0x06, 0x98, 0x32, 0x85, 0x61, 0xbc, 0x97, 0x1d, 0x5f, 0x73, 0x01, 0xc6, 0x23, 0xd4, 0xc1, 0xb1,
+	0xd2, 0x4a, 0xbe, 0x98, 0x6b, 0x50, 0xde, 0xc7, 0x32, 0xcf, 0x0e, 0xd4, 0x67, 0x8c, 0xeb, 0x63,
+	0x73, 0x5a, 0xf3, 0x26, 0xa8, 0x7c, 0xec, 0x39, 0x9c, 0xb5, 0x3c, 0x1c, 0x2b, 0x28, 0x9e, 0x06,
+	0xc6, 0xf9, 0x19, 0x18, 0x3b, 0xc3, 0x19, 0xb0, 0xfe, 0x54, 0x82, 0x19, 0x59, 0x68, 0x52, 0x96,
+	0xec, 0x1d, 0x61, 0x1c, 0x99, 0xbb, 0x50, 0xf5, 0x30, 0x4b, 0x48, 0x28, 0xfb, 0x2f, 0x43, 0x04,
+	0xc0, 0xe0, 0x5a, 0x16, 0xa1, 0xa0, 0x81, 0xf1, 0x66, 0xce, 0x65, 0x17, 0x21, 0x78, 0x53, 0xc7,
+	0x8e, 0x70, 0x94, 0x38, 0x1e, 0x16, 0x25, 0x8e, 0xa9, 0xe0, 0x9e, 0x16, 0xab, 0x9b, 0x6a, 0xd1,
+	0x6c, 0xc1, 0xb8, 0x58, 0xa8, 0x95, 0x85, 0x63, 0x5f, 0xef, 0x6b, 0x8d, 0x4d, 0xec, 0x0a, 0x83,
+	0xdc, 0x50, 0xbe, 0x7d, 0x67, 0xb8, 0xfd, 0x4a, 0xf7, 0x4a, 0x7c, 0xd3, 0x85, 0x09, 0x14, 0x88,
+	0xe0, 0x1a, 0x7b, 0xf9, 0x21, 0xa4, 0xa0, 0xad, 0x4f, 0x0c, 0x58, 0x12, 0x96, 0xcd, 0xd3, 0xe3,
+	0x36, 0x8a, 0x5b, 0x24, 0xdc, 0xa6, 0x1e, 0xfe, 0x5a, 0xe4, 0xf1, 0x30, 0x3f, 0x96, 0x8b, 0x8d,
+	0x3e, 0xb9, 0xf8, 0xab, 0x50, 0x0d, 0x04, 0xa3, 0x13, 0x50, 0x0f, 0xab, 0x03, 0xb9, 0x7c, 0xc2,
+	0x81, 0x54, 0x72, 0x6c, 0x08, 0xb2, 0x67, 0xeb, 0xa7, 0x25, 0xb8, 0x28, 0x34, 0xd2, 0xa5, 0xf8,
+	0x61, 0x8c, 0x42, 0xb6, 0x8f, 0xe3, 0xc1, 0xb9, 0xe0, 0x3a, 0xcc, 0xb1, 0xd8, 0x75, 0xfa, 0x15,
+	0x8d, 0x59, 0x16, 0xbb, 0x5d, 0xd9, 0xff, 0x3a, 0xcc, 0x79, 0x2c, 0xe9, 0x5b, 0x30, 0x66, 0x3d,
+	0x96, 0x74, 0xd1, 0xbe, 0xcc, 0x62, 0xdd, 0x80, 0x09, 0xb9, 0xd1, 0x53, 0x96, 0x16, 0xc5, 0x6d,
+	0x7d, 0xa2, 0xfb, 0x2e, 0x99, 0xd3, 0x36, 0x70, 0x87, 0x86, 0xde, 0x06, 0x0a, 0x0f, 0xe2, 0x34,
+	0x4a, 0xdc, 0xce, 0x99, 0xfb, 0xae, 0xf7, 0x61, 0x41, 0xf7, 0x51, 0x0a, 0xa7, 0xd8, 0x78, 0xe9,
+	0x1e, 0x4b, 0x0a, 0x17, 0xfd, 0x94, 0xf5, 0x5d, 0x03, 0x6a, 0xb2, 0xc6, 0xfb, 0xbe, 0xf6, 0x1b,
+	0xbb, 0x8b, 0x48, 0xec, 0xa6, 0xc9, 0x99, 0xd5, 0xe9, 0xdf, 0xd6, 0x95, 0x5f, 0xd0, 0xd6, 0x51,
+	0x58, 0x94, 0xfd, 0x31, 0x09, 0x51, 0xdc, 0xd9, 0x89, 0x84, 0x2a, 0x52, 0x57, 0x15, 0xcf, 0xdb,
+	0xc2, 0x0d, 0x07, 0x58, 0x66, 0xed, 0xea, 0xfa, 0xea, 0xa0, 0x28, 0xed, 0x03, 0xa3, 0xd2, 0x9c,
+	0x02, 0xb1, 0x7e, 0xaf, 0x93, 0xf0, 0x7d, 0x7c, 0xc4, 0xef, 0xcf, 0xb2, 0xaa, 0x0d, 0xde, 0xf5,
+	0x3d, 0x80, 0x66, 0xda, 0xd1, 0x55, 0x51, 0x96, 0x88, 0xeb, 0x83, 0x93, 0x17, 0x4d, 0xb6, 0x48,
+	0x40, 0x24, 0xba, 0x5d, 0x69, 0xa6, 0x1d, 0x25, 0xe7, 0x23, 0x9e, 0xa3, 0x7d, 0x3f, 0xaf, 0xb0,
+	0xa3, 0x62, 0x01, 0x67, 0x57, 0xa5, 0xf8, 0x6f, 0xda, 0x8f, 0xf7, 0xf1, 0x51, 0xde, 0xd4, 0x0f,
+	0xb3, 0xa3, 0x9d, 0x3e, 0x3b, 0x7a, 0x7f, 0xb8, 0xfb, 0x63, 0xff, 0x7d, 0x3d, 0xe8, 0xb7, 0xaf,
+	0xd1, 0x11, 0x8b, 0xbb, 0xfb, 0x16, 0x2c, 0x88, 0xcd, 0xc9, 0xae, 0x27, 0xf3, 0xd5, 0xe0, 0x8d,
+	0x35, 0x60, 0x5c, 0xa8, 0xa0, 0x0a, 0xe7, 0x08, 0x96, 0x55, 0x71, 0x22, 0xd9, 0xad, 0xdf, 0x18,
+	0x30, 0x27, 0xa4, 0x8b, 0x6f, 0x77, 0x9e, 0x46, 0x24, 0x3e, 0xa9, 0xbf, 0x19, 0xaa, 0x09, 0xbe,
+	0x02, 0x20, 0xaf, 0x9c, 0x6d, 0xc4, 0xda, 0xea, 0x54, 0x54, 0xc4, 0xca, 0x5d, 0xc4, 0xda, 0xe6,
+	0x05, 0x28, 0xbb, 0xc4, 0x53, 0x97, 0x20, 0xfe, 0x68, 0xae, 0xc1, 0x02, 0xe6, 0xd2, 0x45, 0xd5,
+	0x73, 0x12, 0x12, 0x60, 0x96, 0xa0, 0x20, 0x12, 0x39, 0xa9, 0x6c, 0xcf, 0xe7, 0xdf, 0x1e, 0xea,
+	0x4f, 0xd6, 0xc7, 0x2a, 0x25, 0xf3, 0xfd, 0x75, 0x1d, 0xa5, 0xcd, 0x9e, 0xa3, 0xb4, 0x7c, 0x92,
+	0x75, 0xfa, 0x9e, 0xa0, 0x9f, 0x97, 0x54, 0x6b, 0xba, 0x8b, 0xe3, 0x08, 0x27, 0x29, 0xf2, 0xbb,
+	0x84, 0x7c, 0xd8, 0x23, 0xe4, 0xdd, 0xe1, 0x82, 0xa0, 0x9f, 0x28, 0x93, 0xc0, 0xc5, 0x48, 0x0b,
+	0xd1, 0xc9, 0x8d, 0x84, 0xfb, 0xb4, 0x56, 0x3a, 0x39, 0x15, 0xf4, 0x68, 0x77, 0x2f, 0xdc, 0xa7,
+	0x02, 0xdd, 0xb0, 0xe7, 0xa3, 0xe3, 0x9f, 0x4c, 0x1b, 0xce, 0xeb, 0x91, 0x8f, 0x6c, 0x9c, 0xd6,
+	0x47, 0x00, 0x57, 0x33, 0x1e, 0x85, 0xaf, 0x81, 0xac, 0x7f, 0x19, 0x2a, 0xbb, 0x89, 0xf8, 0xe9,
+	0x34, 0xd2, 0x24, 0x8d, 0x31, 0xfb, 0xaf, 0x59, 0xeb, 0x10, 0x2e, 0x8b, 0x70, 0xe8, 0x38, 0xfb,
+	0x52, 0x52, 0x97, 0xc9, 0xe4, 0xae, 0x6e, 0x0c, 0x1e, 0x37, 0x1d, 0x53, 0xb3, 0x60, 0xb6, 0x57,
+	0x70, 0xff, 0xcf, 0xd6, 0xb3, 0x12, 0xbc, 0xd1, 0x2f, 0x20, 0x94, 0x55, 0xd4, 0x4e, 0x07, 0x9e,
+	0x9d, 0x82, 0xf5, 0x4b, 0x67, 0xb2, 0xfe, 0xb9, 0xcc, 0xfa, 0xbc, 0x6f, 0x20, 0xcc, 0x69, 0xd3,
+	0x34, 0xf6, 0x3b, 0x4e, 0xd1, 0xb7, 0x93, 0xf6, 0x2c, 0x61, 0x77, 0xc5, 0xba, 0x62, 0x35, 0x1f,
+	0xc0, 0x94, 0xa2, 0x28, 0x4c, 0x21, 0x46, 0x9e, 0xfa, 0x55, 0x15, 0x86, 0x2d, 0xeb, 0x16, 0xef,
+	0x93, 0x0e, 0x8e, 0xdd, 0xf2, 0x47, 0x01, 0x14, 0x16, 0x13, 0x65, 0x95, 0x5f, 0x08, 0x2f, 0xf5,
+	0xb4, 0x7e, 0xaa, 0xc9, 0x35, 0x97, 0xa0, 0xca, 0x9b, 0x29, 0xe4, 0x79, 0x31, 0x66, 0x4c, 0xd9,
+	0x16, 0x58, 0xec, 0xde, 0x92, 0x2b, 0xc3, 0x8d, 0xe8, 0x3e, 0xc8, 0x1a, 0xd8, 0x21, 0x2f, 0x0e,
+	0xba, 0x29, 0xfd, 0xb1, 0xbe, 0x10, 0xe6, 0x9a, 0x3d, 0x22, 0x49, 0xdb, 0x8b, 0xd1, 0x51, 0xff,
+	0x66, 0xb4, 0x57, 0xf2, 0x12, 0x54, 0x79, 0x83, 0xa7, 0xf5, 0x97, 0x69, 0x13, 0x3c, 0x96, 0x68,
+	0xfd, 0x4f, 0xad, 0xda, 0xaf, 0xf4, 0x01, 0xcc, 0x55, 0xdb, 0x40, 0x3e, 0xaf, 0x27, 0x59, 0x9b,
+	0xda, 0xb7, 0x13, 0x35, 0x46, 0xe8, 0x44, 0x4b, 0xfd, 0x3b, 0xd1, 0x53, 0xeb, 0x1c, 0x14, 0x27,
+	0xfc, 0xca, 0xc5, 0xea, 0x08, 0xd9, 0x30, 0xab, 0x6e, 0x3b, 0x4e, 0x2a, 0x56, 0xb8, 0xb3, 0x79,
+	0xa1, 0x7d, 0x7b, 0x70, 0xd6, 0x28, 0x60, 0xd8, 0x33, 0x5e, 0xf1, 0x95, 0x59, 0x7f, 0x34, 0xe0,
+	0xb5, 0xde, 0xbc, 0x52, 0x18, 0x61, 0x9a, 0x8f, 0x61, 0x4a, 0x1d, 0x5b, 0x59, 0x57, 0x65, 0x9a,
+	0x5a, 0x1b, 0x25, 0x4d, 0xe5, 0xe5, 0xd5, 0xb0, 0xab, 0x41, 0xbe, 0x64, 0x3e, 0x82, 0x59, 0x39,
+	0x8a, 0x70, 0xb2, 0xa6, 0xbd, 0x74, 0xaa, 0x56, 0x7b, 0x46, 0xc2, 0x3c, 0x50, 0x28, 0x79, 0x89,
+	0x92, 0x9b, 0xe8, 0xe9, 0x8d, 0x06, 0xa7, 0xa2, 0x37, 0x41, 0xfc, 0x2e, 0x10, 0x10, 0xc5, 0xac,
+	0x7e, 0x4b, 0xe8, 0x5e, 0x34, 0x1f, 0x41, 0xd5, 0xe7, 0xaf, 0xca, 0x2a, 0xd2, 0xc7, 0x23, 0xf7,
+	0x3b, 0xca, 0x28, 0xe0, 0x67, 0x2b, 0x66, 0x00, 0xf3, 0x45, 0x7b, 0xab, 0xd1, 0xb4, 0x48, 0x48,
+	0xd5, 0xf5, 0x0f, 0x46, 0x36, 0xbb, 0x54, 0x57, 0xc9, 0x99, 0x0b, 0x7a, 0x3f, 0x58, 0x2d, 0xd5,
+	0x41, 0xf2, 0xab, 0x36, 0x61, 0x22, 0x78, 0xf7, 0xdc, 0x36, 0xf6, 0x52, 0x1f, 0x9b, 0x1f, 0xc1,
+	0x24, 0x53, 0xcf, 0xc3, 0xf4, 0xde, 0x7d, 0x20, 0xec, 0x0c, 0xc0, 0x7a, 0x66, 0xc0, 0x55, 0x21,
+	0x89, 0xff, 0xfe, 0xc0, 0x73, 0x24, 0x3e, 0x42, 0xb1, 0x77, 0x1b, 0x05, 0x11, 0x22, 0xad, 0x50,
+	0x05, 0xf8, 0x63, 0x98, 0x76, 0xd5, 0x8a, 0x2c, 0x5a, 0x52, 0xec, 0x97, 0x4e, 0xfa, 0x11, 0xe9,
+	0x18, 0x1e, 0xaf, 0x4b, 0xf6, 0x94, 0x5b, 0x78, 0x33, 0x9b, 0x70, 0x31, 0xc3, 0x8e, 0x05, 0xb1,
+	0x13, 0x51, 0xea, 0x0f, 0x35, 0x58, 0xd7, 0xb0, 0x52, 0xc8, 0x2e, 0xa5, 0xbe, 0x3d, 0xef, 0x1e,
+	0x5b, 0x63, 0x56, 0xaa, 0xd2, 0x4d, 0x97, 0x4e, 0x9b, 0x84, 0x25, 0x31, 0x69, 0xca, 0xdf, 0xaf,
+	0xf6, 0x60, 0x56, 0xe7, 0x0e, 0xa9, 0x84, 0x3e, 0xc2, 0x03, 0x3b, 0xd5, 0x5b, 0x92, 0x45, 0xe2,
+	0x31, 0x7b, 0x06, 0x75, 0xbd, 0x5b, 0xbf, 0x36, 0xc0, 0xd2, 0xf7, 0x80, 0xdb, 0x34, 0xf4, 0xc4,
+	0x85, 0x0e, 0x8d, 0x16, 0xf6, 0xb7, 0xba, 0x1b, 0xe7, 0x77, 0x86, 0x8b, 0x34, 0xd9, 0xb5, 0x4b,
+	0x4e, 0xd3, 0x84, 0xb1, 0xac, 0xab, 0x9d, 0xb2, 0xc5, 0x33, 0x97, 0x49, 0x74, 0x1f, 0xa2, 0x86,
+	0xb7, 0x93, 0x44, 0x35, 0x0f, 0xd6, 0x4f, 0x4a, 0x70, 0xad, 0x70, 0x4c, 0x4f, 0xab, 0xfa, 0xff,
+	0xf8, 0xc4, 0xf6, 0x66, 0xc8, 0xb1, 0x97, 0x97, 0x21, 0xad, 0x3f, 0x18, 0xb0, 0x2c, 0x2d, 0xf4,
+	0x42, 0xdb, 0x3c, 0x8c, 0x49, 0xab, 0xd5, 0xcf, 0x44, 0x53, 0x05, 0x13, 0x2d, 0xf3, 0x9f, 0x40,
+	0xc5, 0x2e, 0x14, 0xb9, 0xb2, 0x51, 0xcf, 0x2a, 0x9f, 0x25, 0x24, 0xf2, 0x51, 0x8f, 0x8e, 0x9d,
+	0x82, 0x4b, 0xcd, 0xec, 0xdb, 0x4e, 0x76, 0x63, 0xb9, 0x0e, 0x73, 0x91, 0x8f, 0xdc, 0x6e, 0xf2,
+	0x31, 0x41, 0x3e, 0x2b, 0x3f, 0x64, 0xb4, 0xd6, 0xd7, 0xd5, 0x5c, 0x50, 0xac, 0x34, 0x10, 0xf1,
+	0x7b, 0x67, 0xb2, 0x53, 0xf9, 0x4c, 0xf6, 0x12, 0x4c, 0x70, 0x28, 0x35, 0x92, 0x9d, 0xb2, 0xd5,
+	0x1b, 0x9f, 0x96, 0xee, 0xfb, 0xa8, 0x25, 0xaf, 0x98, 0xd3, 0xb6, 0x7c, 0xb1, 0x7e, 0x68, 0xc0,
+	0x3b, 0x72, 0xa2, 0x91, 0xd0, 0x80, 0xb8, 0x05, 0xab, 0x36, 0x30, 0xde, 0x4e, 0xfd, 0x84, 0x44,
+	0x3e, 0xc1, 0x31, 0x93, 0x79, 0xc6, 0x33, 0x31, 0x5c, 0xd2, 0xb3, 0x12, 0x8c, 0x9d, 0x20, 0x27,
+	0x50, 0xa7, 0x71, 0xf5, 0xe4, 0xd9, 0x74, 0x17, 0xb0, 0xbd, 0x10, 0x1c, 0x5f, 0x64, 0x16, 0x85,
+	0xd7, 0x8b, 0xf9, 0x40, 0xa7, 0xc5, 0x4c, 0x8d, 0x1d, 0xa8, 0xe8, 0x04, 0xa9, 0x25, 0xaf, 0x9d,
+	0x2c, 0xb9, 0x07, 0xcd, 0xce, 0x31, 0x2c, 0x57, 0x1d, 0x28, 0x49, 0x28, 0xe7, 0xf2, 0x24, 0x6c,
+	0xdd, 0x79, 0x8a, 0x03, 0x39, 0x13, 0xd1, 0x92, 0xaf, 0x00, 0x64, 0xd1, 0x22, 0x45, 0x57, 0xec,
+	0x8a, 0x0e, 0x17, 0xa6, 0x8e, 0x2d, 0x16, 0x6c, 0x2a, 0x54, 0x26, 0x09, 0x93, 0x30, 0xd6, 0xef,
+	0x0c, 0x75, 0x33, 0x17, 0x06, 0x6e, 0x52, 0x7a, 0xa0, 0xd2, 0xf7, 0x7d, 0x98, 0x62, 0x11, 0xed,
+	0x6d, 0x4e, 0x06, 0xa6, 0x92, 0x1e, 0x08, 0xbb, 0xca, 0x01, 0xe4, 0x33, 0x33, 0x1f, 0x83, 0xe9,
+	0x65, 0xc1, 0x9e, 0xa1, 0x96, 0x46, 0x47, 0x9d, 0xcb, 0x61, 0x74, 0xdf, 0xd3, 0x86, 0xd9, 0x5e,
+	0xf5, 0x2f, 0x40, 0x99, 0xe1, 0x27, 0x22, 0x10, 0xc7, 0x6c, 0xfe, 0x68, 0xde, 0x86, 0x0a, 0xd5,
+	0x44, 0x2a, 0x31, 0x5e, 0x1b, 0x4a, 0xae, 0x9d, 0xf3, 0x59, 0xbf, 0x34, 0xa0, 0x92, 0x7d, 0x18,
+	0x7c, 0x4c, 0x6f, 0xca, 0xb1, 0x8c, 0x8f, 0x0f, 0x71, 0x56, 0x98, 0xde, 0x18, 0x24, 0x70, 0x8b,
+	0x53, 0x8a, 0x39, 0x8c, 0x78, 0x62, 0xe6, 0x86, 0x9a, 0xc3, 0x28, 0x88, 0xf2, 0xb0, 0x10, 0x62,
+	0xf0, 0x22, 0x31, 0x36, 0xda, 0x9f, 0x3e, 0x5b, 0x34, 0x3e, 0x7b, 0xb6, 0x68, 0xfc, 0xf3, 0xd9,
+	0xa2, 0xf1, 0xfd, 0xe7, 0x8b, 0xe7, 0x3e, 0x7b, 0xbe, 0x78, 0xee, 0xcf, 0xcf, 0x17, 0xcf, 0x3d,
+	0xbe, 0x5f, 0xe8, 0xc7, 0xee, 0x69, 0xc8, 0x2d, 0xd4, 0x64, 0xab, 0x99, 0x80, 0xf7, 0x5c, 0x1a,
+	0xe3, 0xe2, 0x6b, 0x1b, 0x91, 0x70, 0x35, 0xa0, 0x22, 0x3e, 0xf3, 0xff, 0x6f, 0x11, 0xbd, 0x5b,
+	0x73, 0x42, 0xfc, 0x57, 0xcb, 0x8d, 0xff, 0x0c, 0x00, 0x51, 0x25, 0xa3, 0x4b, 0xa4, 0x23, 0x00,
+	0x00,
+}
+
+func (m *EventBatchSpotExecution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2687,42 +2825,56 @@ func (m *EventExpiryFuturesMarketUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventExpiryFuturesMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBatchSpotExecution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventExpiryFuturesMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBatchSpotExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExpiryFuturesMarketInfo != nil {
-		{
-			size, err := m.ExpiryFuturesMarketInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if m.ExecutionType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPerpetualMarketFundingUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventBatchDerivativeExecution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2732,33 +2884,40 @@ func (m *EventPerpetualMarketFundingUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPerpetualMarketFundingUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativeExecution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPerpetualMarketFundingUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativeExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarkPrice != nil {
-		{
-			size := m.MarkPrice.Size()
-			i -= size
-			if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
 		}
+	}
+	if m.ExecutionType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if m.FundingRate != nil {
+	if m.CumulativeFunding != nil {
 		{
-			size := m.FundingRate.Size()
+			size := m.CumulativeFunding.Size()
 			i -= size
-			if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.CumulativeFunding.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 			i = encodeVarintEvents(dAtA, i, uint64(size))
@@ -2766,9 +2925,9 @@ func (m *EventPerpetualMarketFundingUpdate) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.IsHourlyFunding {
+	if m.IsLiquidation {
 		i--
-		if m.IsHourlyFunding {
+		if m.IsLiquidation {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -2776,16 +2935,16 @@ func (m *EventPerpetualMarketFundingUpdate) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
 		copy(dAtA[i:], m.MarketId)
@@ -2796,7 +2955,7 @@ func (m *EventPerpetualMarketFundingUpdate) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSubaccountDeposit) Marshal() (dAtA []byte, err error) {
+func (m *EventLostFundsFromLiquidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2806,22 +2965,32 @@ func (m *EventSubaccountDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSubaccountDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventLostFundsFromLiquidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventLostFundsFromLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.LostFundsFromOrderCancels.Size()
+		i -= size
+		if _, err := m.LostFundsFromOrderCancels.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LostFundsFromAvailableDuringPayout.Size()
 		i -= size
+		if _, err := m.LostFundsFromAvailableDuringPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
@@ -2833,17 +3002,17 @@ func (m *EventSubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SrcAddress) > 0 {
-		i -= len(m.SrcAddress)
-		copy(dAtA[i:], m.SrcAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcAddress)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSubaccountWithdraw) Marshal() (dAtA []byte, err error) {
+func (m *EventBatchDerivativePosition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2853,44 +3022,41 @@ func (m *EventSubaccountWithdraw) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSubaccountWithdraw) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativePosition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubaccountWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.DstAddress) > 0 {
-		i -= len(m.DstAddress)
-		copy(dAtA[i:], m.DstAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstAddress)))
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSubaccountBalanceTransfer) Marshal() (dAtA []byte, err error) {
+func (m *EventDerivativeMarketPaused) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2900,44 +3066,48 @@ func (m *EventSubaccountBalanceTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSubaccountBalanceTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDerivativeMarketPaused) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubaccountBalanceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDerivativeMarketPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	if len(m.MissingFundsRate) > 0 {
+		i -= len(m.MissingFundsRate)
+		copy(dAtA[i:], m.MissingFundsRate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.DstSubaccountId) > 0 {
-		i -= len(m.DstSubaccountId)
-		copy(dAtA[i:], m.DstSubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstSubaccountId)))
+	if len(m.TotalMissingFunds) > 0 {
+		i -= len(m.TotalMissingFunds)
+		copy(dAtA[i:], m.TotalMissingFunds)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalMissingFunds)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SettlePrice) > 0 {
+		i -= len(m.SettlePrice)
+		copy(dAtA[i:], m.SettlePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SrcSubaccountId) > 0 {
-		i -= len(m.SrcSubaccountId)
-		copy(dAtA[i:], m.SrcSubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcSubaccountId)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBatchDepositUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventForceSettledPosition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2947,79 +3117,74 @@ func (m *EventBatchDepositUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBatchDepositUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventForceSettledPosition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBatchDepositUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventForceSettledPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DepositUpdates) > 0 {
-		for iNdEx := len(m.DepositUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DepositUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DerivativeMarketOrderCancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DerivativeMarketOrderCancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DerivativeMarketOrderCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x2a
 	{
-		size := m.CancelQuantity.Size()
+		size := m.Quantity.Size()
 		i -= size
-		if _, err := m.CancelQuantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.MarketOrder != nil {
-		{
-			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	dAtA[i] = 0x22
+	if m.IsLong {
+		i--
+		if m.IsLong {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
+func (m *EventAutoDeleverage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3029,49 +3194,69 @@ func (m *EventCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAutoDeleverage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAutoDeleverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketOrderCancel != nil {
-		{
-			size, err := m.MarketOrderCancel.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.LimitOrder != nil {
-		{
-			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.IsLimitCancel {
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.IsLong {
 		i--
-		if m.IsLimitCancel {
+		if m.IsLong {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
+	}
+	if len(m.LiquidatedSubaccountId) > 0 {
+		i -= len(m.LiquidatedSubaccountId)
+		copy(dAtA[i:], m.LiquidatedSubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LiquidatedSubaccountId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
@@ -3083,7 +3268,7 @@ func (m *EventCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *EventFeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketOrdersCancelled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3093,32 +3278,42 @@ func (m *EventFeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventFeeDiscountSchedule) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketOrdersCancelled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventFeeDiscountSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketOrdersCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Schedule != nil {
-		{
-			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.HasMoreOrders {
+		i--
+		if m.HasMoreOrders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x18
+	}
+	if m.CancelledOrdersCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CancelledOrdersCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTradingRewardCampaignUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketAutoDelisted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3128,46 +3323,37 @@ func (m *EventTradingRewardCampaignUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTradingRewardCampaignUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketAutoDelisted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTradingRewardCampaignUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketAutoDelisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CampaignRewardPools) > 0 {
-		for iNdEx := len(m.CampaignRewardPools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CampaignRewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.InactiveBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InactiveBlocks))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CampaignInfo != nil {
-		{
-			size, err := m.CampaignInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTradingRewardDistribution) Marshal() (dAtA []byte, err error) {
+func (m *EventMakerRebate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3177,20 +3363,20 @@ func (m *EventTradingRewardDistribution) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTradingRewardDistribution) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMakerRebate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTradingRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMakerRebate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AccountRewards) > 0 {
-		for iNdEx := len(m.AccountRewards) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Rebates) > 0 {
+		for iNdEx := len(m.Rebates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AccountRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Rebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3198,13 +3384,20 @@ func (m *EventTradingRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNewConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+func (m *EventFeeSettlement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3214,56 +3407,57 @@ func (m *EventNewConditionalDerivativeOrder) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EventNewConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFeeSettlement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNewConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFeeSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsMarket {
-		i--
-		if m.IsMarket {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x20
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.SettledFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.Order != nil {
-		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+func (m *EventDustSweep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3273,61 +3467,58 @@ func (m *EventCancelConditionalDerivativeOrder) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDustSweep) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDustSweep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketOrder != nil {
-		{
-			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.LimitOrder != nil {
-		{
-			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Swept) > 0 {
+		for iNdEx := len(m.Swept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.IsLimitCancel {
-		i--
-		if m.IsLimitCancel {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.SweptDeposits != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SweptDeposits))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+	if m.Destination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) Marshal() (dAtA []byte, err error) {
+func (m *EventSubaccountMarginModeUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3337,51 +3528,32 @@ func (m *EventConditionalDerivativeOrderTrigger) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSubaccountMarginModeUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSubaccountMarginModeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlacedOrderHash) > 0 {
-		i -= len(m.PlacedOrderHash)
-		copy(dAtA[i:], m.PlacedOrderHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlacedOrderHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TriggeredOrderHash) > 0 {
-		i -= len(m.TriggeredOrderHash)
-		copy(dAtA[i:], m.TriggeredOrderHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TriggeredOrderHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.IsLimitTrigger {
-		i--
-		if m.IsLimitTrigger {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.MarginMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarginMode))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderFail) Marshal() (dAtA []byte, err error) {
+func (m *EventPositionTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3391,54 +3563,61 @@ func (m *EventOrderFail) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderFail) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPositionTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderFail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPositionTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Flags) > 0 {
-		dAtA22 := make([]byte, len(m.Flags)*10)
-		var j21 int
-		for _, num := range m.Flags {
-			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA22[j21] = uint8(num)
-			j21++
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintEvents(dAtA, i, uint64(j21))
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstSubaccountId) > 0 {
+		i -= len(m.DstSubaccountId)
+		copy(dAtA[i:], m.DstSubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstSubaccountId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Hashes) > 0 {
-		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Hashes[iNdEx])
-			copy(dAtA[i:], m.Hashes[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Hashes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.SrcSubaccountId) > 0 {
+		i -= len(m.SrcSubaccountId)
+		copy(dAtA[i:], m.SrcSubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcSubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketBeyondBankruptcy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3448,34 +3627,41 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketBeyondBankruptcy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketBeyondBankruptcy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MarketFeeMultipliers) > 0 {
-		for iNdEx := len(m.MarketFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MarketFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.MissingMarketFunds) > 0 {
+		i -= len(m.MissingMarketFunds)
+		copy(dAtA[i:], m.MissingMarketFunds)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingMarketFunds)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SettlePrice) > 0 {
+		i -= len(m.SettlePrice)
+		copy(dAtA[i:], m.SettlePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderbookUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventAllPositionsHaircut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3485,48 +3671,41 @@ func (m *EventOrderbookUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAllPositionsHaircut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAllPositionsHaircut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DerivativeUpdates) > 0 {
-		for iNdEx := len(m.DerivativeUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DerivativeUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.MissingFundsRate) > 0 {
+		i -= len(m.MissingFundsRate)
+		copy(dAtA[i:], m.MissingFundsRate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.SpotUpdates) > 0 {
-		for iNdEx := len(m.SpotUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpotUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.SettlePrice) > 0 {
+		i -= len(m.SettlePrice)
+		copy(dAtA[i:], m.SettlePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderbookUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventBinaryOptionsMarketUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3536,37 +3715,30 @@ func (m *OrderbookUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBinaryOptionsMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBinaryOptionsMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Orderbook != nil {
-		{
-			size, err := m.Orderbook.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Seq != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Orderbook) Marshal() (dAtA []byte, err error) {
+func (m *EventNewSpotOrders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3576,20 +3748,20 @@ func (m *Orderbook) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Orderbook) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventNewSpotOrders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Orderbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventNewSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SellLevels) > 0 {
-		for iNdEx := len(m.SellLevels) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SellLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3600,10 +3772,10 @@ func (m *Orderbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.BuyLevels) > 0 {
-		for iNdEx := len(m.BuyLevels) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BuyOrders) > 0 {
+		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BuyLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3624,668 +3796,3788 @@ func (m *Orderbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventNewDerivativeOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventBatchSpotExecution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventNewDerivativeOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewDerivativeOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsBuy {
-		n += 2
-	}
-	if m.ExecutionType != 0 {
-		n += 1 + sovEvents(uint64(m.ExecutionType))
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Trades) > 0 {
-		for _, e := range m.Trades {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.BuyOrders) > 0 {
+		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBatchDerivativeExecution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsBuy {
-		n += 2
-	}
-	if m.IsLiquidation {
-		n += 2
-	}
-	if m.CumulativeFunding != nil {
-		l = m.CumulativeFunding.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ExecutionType != 0 {
-		n += 1 + sovEvents(uint64(m.ExecutionType))
-	}
-	if len(m.Trades) > 0 {
-		for _, e := range m.Trades {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+func (m *EventCancelSpotOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventLostFundsFromLiquidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.LostFundsFromAvailableDuringPayout.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.LostFundsFromOrderCancels.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+func (m *EventCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBatchDerivativePosition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDerivativeMarketPaused) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOrderExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.SettlePrice)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.TotalMissingFunds)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.MissingFundsRate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketBeyondBankruptcy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SettlePrice)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MissingMarketFunds)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSpotMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventAllPositionsHaircut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSpotMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpotMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SettlePrice)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MissingFundsRate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBinaryOptionsMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPerpetualMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventNewSpotOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventPerpetualMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPerpetualMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.BuyOrders) > 0 {
-		for _, e := range m.BuyOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.Funding != nil {
+		{
+			size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.SellOrders) > 0 {
-		for _, e := range m.SellOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *EventNewDerivativeOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.BuyOrders) > 0 {
-		for _, e := range m.BuyOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.PerpetualMarketInfo != nil {
+		{
+			size, err := m.PerpetualMarketInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.SellOrders) > 0 {
-		for _, e := range m.SellOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelSpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventExpiryFuturesMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Order.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSpotMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+func (m *EventExpiryFuturesMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPerpetualMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventExpiryFuturesMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.PerpetualMarketInfo != nil {
-		l = m.PerpetualMarketInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ExpiryFuturesMarketInfo != nil {
+		{
+			size, err := m.ExpiryFuturesMarketInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Funding != nil {
-		l = m.Funding.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventExpiryFuturesMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.ExpiryFuturesMarketInfo != nil {
-		l = m.ExpiryFuturesMarketInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventPerpetualMarketFundingUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventPerpetualMarketFundingUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventPerpetualMarketFundingUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPerpetualMarketFundingUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarkPrice != nil {
+		{
+			size := m.MarkPrice.Size()
+			i -= size
+			if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FundingRate != nil {
+		{
+			size := m.FundingRate.Size()
+			i -= size
+			if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Funding.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	if m.IsHourlyFunding {
-		n += 2
+		i--
+		if m.IsHourlyFunding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.FundingRate != nil {
-		l = m.FundingRate.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.MarkPrice != nil {
-		l = m.MarkPrice.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSubaccountDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SrcAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSubaccountDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSubaccountWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.DstAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+func (m *EventSubaccountDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubaccountBalanceTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SrcSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.DstSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	if len(m.SrcAddress) > 0 {
+		i -= len(m.SrcAddress)
+		copy(dAtA[i:], m.SrcAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBatchDepositUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSubaccountWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubaccountWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubaccountWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DepositUpdates) > 0 {
-		for _, e := range m.DepositUpdates {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *DerivativeMarketOrderCancel) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DstAddress) > 0 {
+		i -= len(m.DstAddress)
+		copy(dAtA[i:], m.DstAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.MarketOrder != nil {
-		l = m.MarketOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.CancelQuantity.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelDerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSubaccountBalanceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubaccountBalanceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubaccountBalanceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsLimitCancel {
-		n += 2
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.LimitOrder != nil {
-		l = m.LimitOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DstSubaccountId) > 0 {
+		i -= len(m.DstSubaccountId)
+		copy(dAtA[i:], m.DstSubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstSubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MarketOrderCancel != nil {
-		l = m.MarketOrderCancel.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SrcSubaccountId) > 0 {
+		i -= len(m.SrcSubaccountId)
+		copy(dAtA[i:], m.SrcSubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcSubaccountId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventFeeDiscountSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Schedule != nil {
-		l = m.Schedule.Size()
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventBatchDepositUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventTradingRewardCampaignUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventBatchDepositUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBatchDepositUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CampaignInfo != nil {
-		l = m.CampaignInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.CampaignRewardPools) > 0 {
-		for _, e := range m.CampaignRewardPools {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.DepositUpdates) > 0 {
+		for iNdEx := len(m.DepositUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventTradingRewardDistribution) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DerivativeMarketOrderCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativeMarketOrderCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativeMarketOrderCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AccountRewards) > 0 {
-		for _, e := range m.AccountRewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.CancelQuantity.Size()
+		i -= size
+		if _, err := m.CancelQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.MarketOrder != nil {
+		{
+			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNewConditionalDerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsMarket {
-		n += 2
-	}
-	return n
-}
-
-func (m *EventCancelConditionalDerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MarketOrderCancel != nil {
+		{
+			size, err := m.MarketOrderCancel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.LimitOrder != nil {
+		{
+			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.IsLimitCancel {
-		n += 2
-	}
-	if m.LimitOrder != nil {
-		l = m.LimitOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		i--
+		if m.IsLimitCancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MarketOrder != nil {
-		l = m.MarketOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeDiscountSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeDiscountSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsLimitTrigger {
-		n += 2
-	}
-	l = len(m.TriggeredOrderHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PlacedOrderHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderFail) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTradingRewardCampaignUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradingRewardCampaignUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradingRewardCampaignUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Hashes) > 0 {
-		for _, b := range m.Hashes {
-			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.CampaignRewardPools) > 0 {
+		for iNdEx := len(m.CampaignRewardPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignRewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Flags) > 0 {
-		l = 0
-		for _, e := range m.Flags {
-			l += sovEvents(uint64(e))
+	if m.CampaignInfo != nil {
+		{
+			size, err := m.CampaignInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-		n += 1 + sovEvents(uint64(l)) + l
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTradingRewardDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradingRewardDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradingRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MarketFeeMultipliers) > 0 {
-		for _, e := range m.MarketFeeMultipliers {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.AccountRewards) > 0 {
+		for iNdEx := len(m.AccountRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNewConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpotUpdates) > 0 {
-		for _, e := range m.SpotUpdates {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.IsMarket {
+		i--
+		if m.IsMarket {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.DerivativeUpdates) > 0 {
-		for _, e := range m.DerivativeUpdates {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *OrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovEvents(uint64(m.Seq))
+	if m.MarketOrder != nil {
+		{
+			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Orderbook != nil {
-		l = m.Orderbook.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.LimitOrder != nil {
+		{
+			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.IsLimitCancel {
+		i--
+		if m.IsLimitCancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Orderbook) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventConditionalDerivativeOrderTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PlacedOrderHash) > 0 {
+		i -= len(m.PlacedOrderHash)
+		copy(dAtA[i:], m.PlacedOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlacedOrderHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.BuyLevels) > 0 {
-		for _, e := range m.BuyLevels {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.TriggeredOrderHash) > 0 {
+		i -= len(m.TriggeredOrderHash)
+		copy(dAtA[i:], m.TriggeredOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TriggeredOrderHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsLimitTrigger {
+		i--
+		if m.IsLimitTrigger {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.SellLevels) > 0 {
-		for _, e := range m.SellLevels {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderFail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderFail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderFail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		dAtA24 := make([]byte, len(m.Flags)*10)
+		var j23 int
+		for _, num := range m.Flags {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintEvents(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hashes[iNdEx])
+			copy(dAtA[i:], m.Hashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Hashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketFeeMultipliers) > 0 {
+		for iNdEx := len(m.MarketFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradingSchedulesUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradingSchedulesUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradingSchedulesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketDelistingExemptionsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketDelistingExemptionsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketDelistingExemptionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsExempt {
+		i--
+		if m.IsExempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketIds) > 0 {
+		for iNdEx := len(m.MarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MarketIds[iNdEx])
+			copy(dAtA[i:], m.MarketIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderbookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivativeUpdates) > 0 {
+		for iNdEx := len(m.DerivativeUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpotUpdates) > 0 {
+		for iNdEx := len(m.SpotUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderbookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Orderbook != nil {
+		{
+			size, err := m.Orderbook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Orderbook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Orderbook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Orderbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SellLevels) > 0 {
+		for iNdEx := len(m.SellLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BuyLevels) > 0 {
+		for iNdEx := len(m.BuyLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBatchSpotExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	if m.ExecutionType != 0 {
+		n += 1 + sovEvents(uint64(m.ExecutionType))
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventBatchDerivativeExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	if m.IsLiquidation {
+		n += 2
+	}
+	if m.CumulativeFunding != nil {
+		l = m.CumulativeFunding.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExecutionType != 0 {
+		n += 1 + sovEvents(uint64(m.ExecutionType))
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventLostFundsFromLiquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.LostFundsFromAvailableDuringPayout.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.LostFundsFromOrderCancels.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBatchDerivativePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDerivativeMarketPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SettlePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TotalMissingFunds)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MissingFundsRate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventForceSettledPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLong {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Payout.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventAutoDeleverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.LiquidatedSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLong {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Payout.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventMarketOrdersCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CancelledOrdersCount != 0 {
+		n += 1 + sovEvents(uint64(m.CancelledOrdersCount))
+	}
+	if m.HasMoreOrders {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMarketAutoDelisted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.InactiveBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.InactiveBlocks))
+	}
+	return n
+}
+
+func (m *EventMakerRebate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rebates) > 0 {
+		for _, e := range m.Rebates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventFeeSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SettledFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDustSweep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovEvents(uint64(m.Destination))
+	}
+	if m.SweptDeposits != 0 {
+		n += 1 + sovEvents(uint64(m.SweptDeposits))
+	}
+	if len(m.Swept) > 0 {
+		for _, e := range m.Swept {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSubaccountMarginModeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarginMode != 0 {
+		n += 1 + sovEvents(uint64(m.MarginMode))
+	}
+	return n
+}
+
+func (m *EventPositionTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SrcSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventMarketBeyondBankruptcy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SettlePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MissingMarketFunds)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAllPositionsHaircut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SettlePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MissingFundsRate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBinaryOptionsMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventNewSpotOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.BuyOrders) > 0 {
+		for _, e := range m.BuyOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNewDerivativeOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.BuyOrders) > 0 {
+		for _, e := range m.BuyOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCancelSpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventOrderExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.ExpirationTimestamp))
+	}
+	return n
+}
+
+func (m *EventSpotMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPerpetualMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PerpetualMarketInfo != nil {
+		l = m.PerpetualMarketInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Funding != nil {
+		l = m.Funding.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventExpiryFuturesMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ExpiryFuturesMarketInfo != nil {
+		l = m.ExpiryFuturesMarketInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPerpetualMarketFundingUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Funding.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.IsHourlyFunding {
+		n += 2
+	}
+	if m.FundingRate != nil {
+		l = m.FundingRate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarkPrice != nil {
+		l = m.MarkPrice.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSubaccountDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubaccountWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubaccountBalanceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBatchDepositUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DepositUpdates) > 0 {
+		for _, e := range m.DepositUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DerivativeMarketOrderCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketOrder != nil {
+		l = m.MarketOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.CancelQuantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCancelDerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimitCancel {
+		n += 2
+	}
+	if m.LimitOrder != nil {
+		l = m.LimitOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketOrderCancel != nil {
+		l = m.MarketOrderCancel.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeDiscountSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradingRewardCampaignUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignInfo != nil {
+		l = m.CampaignInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.CampaignRewardPools) > 0 {
+		for _, e := range m.CampaignRewardPools {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTradingRewardDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountRewards) > 0 {
+		for _, e := range m.AccountRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNewConditionalDerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsMarket {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCancelConditionalDerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimitCancel {
+		n += 2
+	}
+	if m.LimitOrder != nil {
+		l = m.LimitOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketOrder != nil {
+		l = m.MarketOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimitTrigger {
+		n += 2
+	}
+	l = len(m.TriggeredOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlacedOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderFail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Hashes) > 0 {
+		for _, b := range m.Hashes {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Flags) > 0 {
+		l = 0
+		for _, e := range m.Flags {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketFeeMultipliers) > 0 {
+		for _, e := range m.MarketFeeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTradingSchedulesUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarketDelistingExemptionsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketIds) > 0 {
+		for _, s := range m.MarketIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.IsExempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventOrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpotUpdates) > 0 {
+		for _, e := range m.SpotUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.DerivativeUpdates) > 0 {
+		for _, e := range m.DerivativeUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvents(uint64(m.Seq))
+	}
+	if m.Orderbook != nil {
+		l = m.Orderbook.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *Orderbook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.BuyLevels) > 0 {
+		for _, e := range m.BuyLevels {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.SellLevels) > 0 {
+		for _, e := range m.SellLevels {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchSpotExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchSpotExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
+			}
+			m.ExecutionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionType |= ExecutionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &TradeLog{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchDerivativeExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchDerivativeExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiquidation = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CumulativeFunding = &v
+			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
+			}
+			m.ExecutionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionType |= ExecutionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &DerivativeTradeLog{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLostFundsFromLiquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLostFundsFromLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromAvailableDuringPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LostFundsFromAvailableDuringPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromOrderCancels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LostFundsFromOrderCancels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchDerivativePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchDerivativePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &SubaccountPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDerivativeMarketPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDerivativeMarketPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissingFunds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalMissingFunds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFundsRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingFundsRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForceSettledPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForceSettledPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForceSettledPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLong = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutoDeleverage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoDeleverage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoDeleverage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatedSubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatedSubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLong = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketOrdersCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketOrdersCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketOrdersCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrdersCount", wireType)
+			}
+			m.CancelledOrdersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledOrdersCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMoreOrders", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMoreOrders = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketAutoDelisted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketAutoDelisted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketAutoDelisted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveBlocks", wireType)
+			}
+			m.InactiveBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
+func (m *EventMakerRebate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,15 +7600,15 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchSpotExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMakerRebate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchSpotExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMakerRebate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4344,50 +7636,11 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsBuy = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
-			}
-			m.ExecutionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutionType |= ExecutionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4414,8 +7667,8 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trades = append(m.Trades, &TradeLog{})
-			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rebates = append(m.Rebates, types.Coin{})
+			if err := m.Rebates[len(m.Rebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4440,7 +7693,7 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
+func (m *EventFeeSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4463,15 +7716,15 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchDerivativeExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeeSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchDerivativeExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeeSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4499,13 +7752,13 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4515,37 +7768,30 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidation", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.IsLiquidation = bool(v != 0)
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4555,52 +7801,30 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CumulativeFunding = &v
-			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettledFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
-			}
-			m.ExecutionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutionType |= ExecutionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4610,23 +7834,23 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trades = append(m.Trades, &DerivativeTradeLog{})
-			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4651,7 +7875,7 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
+func (m *EventDustSweep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4674,17 +7898,17 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLostFundsFromLiquidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDustSweep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLostFundsFromLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDustSweep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			m.Destination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4694,29 +7918,16 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Destination |= SpamFeeDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptDeposits", wireType)
 			}
-			var byteLen int
+			m.SweptDeposits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4726,31 +7937,16 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SweptDeposits |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromAvailableDuringPayout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4760,31 +7956,31 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LostFundsFromAvailableDuringPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Swept = append(m.Swept, types.DecCoin{})
+			if err := m.Swept[len(m.Swept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromOrderCancels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4794,23 +7990,23 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LostFundsFromOrderCancels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4835,7 +8031,7 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
+func (m *EventSubaccountMarginModeUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4858,15 +8054,15 @@ func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchDerivativePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubaccountMarginModeUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchDerivativePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubaccountMarginModeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4894,13 +8090,13 @@ func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginMode", wireType)
 			}
-			var msglen int
+			m.MarginMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4910,26 +8106,11 @@ func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Positions = append(m.Positions, &SubaccountPosition{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.MarginMode |= MarginMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4951,7 +8132,7 @@ func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
+func (m *EventPositionTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4974,10 +8155,10 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDerivativeMarketPaused: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPositionTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDerivativeMarketPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPositionTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5014,7 +8195,7 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcSubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5042,11 +8223,11 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			m.SrcSubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissingFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstSubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5074,11 +8255,11 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalMissingFunds = string(dAtA[iNdEx:postIndex])
+			m.DstSubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingFundsRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5106,7 +8287,43 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingFundsRate = string(dAtA[iNdEx:postIndex])
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5789,53 +9006,232 @@ func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelSpotOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelSpotOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5863,13 +9259,13 @@ func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.OrderHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5879,25 +9275,43 @@ func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8390,6 +11804,192 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *EventTradingSchedulesUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradingSchedulesUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradingSchedulesUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, &MarketTradingSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketDelistingExemptionsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketDelistingExemptionsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketDelistingExemptionsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketIds = append(m.MarketIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventOrderbookUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -235,3 +235,23 @@ func (al AtomicMarketOrderAccessLevel) IsValid() bool {
 		return false
 	}
 }
+
+func (d SpamFeeDestination) IsValid() bool {
+	switch d {
+	case SpamFeeDestination_CommunityPool,
+		SpamFeeDestination_Burn:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s MakerRebatePoolSource) IsValid() bool {
+	switch s {
+	case MakerRebatePoolSource_MakerRebatePool,
+		MakerRebatePoolSource_MakerRebateCommunityPool:
+		return true
+	default:
+		return false
+	}
+}
@@ -58,6 +58,90 @@ func (AtomicMarketOrderAccessLevel) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2116e2804e9c53f9, []int{0}
 }
 
+// SpamFeeDestination defines where the market creation fees and order
+// placement surcharges are sent
+type SpamFeeDestination int32
+
+const (
+	SpamFeeDestination_CommunityPool SpamFeeDestination = 0
+	SpamFeeDestination_Burn          SpamFeeDestination = 1
+)
+
+var SpamFeeDestination_name = map[int32]string{
+	0: "CommunityPool",
+	1: "Burn",
+}
+
+var SpamFeeDestination_value = map[string]int32{
+	"CommunityPool": 0,
+	"Burn":          1,
+}
+
+func (x SpamFeeDestination) String() string {
+	return proto.EnumName(SpamFeeDestination_name, int32(x))
+}
+
+func (SpamFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{1}
+}
+
+// MakerRebatePoolSource defines the pool from which the maker rebates are paid
+type MakerRebatePoolSource int32
+
+const (
+	// the dedicated maker rebate pool account, funded by bank sends
+	MakerRebatePoolSource_MakerRebatePool MakerRebatePoolSource = 0
+	// the community pool
+	MakerRebatePoolSource_MakerRebateCommunityPool MakerRebatePoolSource = 1
+)
+
+var MakerRebatePoolSource_name = map[int32]string{
+	0: "MakerRebatePool",
+	1: "MakerRebateCommunityPool",
+}
+
+var MakerRebatePoolSource_value = map[string]int32{
+	"MakerRebatePool":          0,
+	"MakerRebateCommunityPool": 1,
+}
+
+func (x MakerRebatePoolSource) String() string {
+	return proto.EnumName(MakerRebatePoolSource_name, int32(x))
+}
+
+func (MakerRebatePoolSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{2}
+}
+
+// MarginMode defines how the positions of a subaccount are margined
+type MarginMode int32
+
+const (
+	// each position is backed only by its own margin
+	MarginMode_Isolated MarginMode = 0
+	// the positions of the subaccount in markets with the same quote denom share
+	// the available balance of the subaccount as collateral
+	MarginMode_Cross MarginMode = 1
+)
+
+var MarginMode_name = map[int32]string{
+	0: "Isolated",
+	1: "Cross",
+}
+
+var MarginMode_value = map[string]int32{
+	"Isolated": 0,
+	"Cross":    1,
+}
+
+func (x MarginMode) String() string {
+	return proto.EnumName(MarginMode_name, int32(x))
+}
+
+func (MarginMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{3}
+}
+
 type MarketStatus int32
 
 const (
@@ -89,7 +173,47 @@ func (x MarketStatus) String() string {
 }
 
 func (MarketStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{1}
+	return fileDescriptor_2116e2804e9c53f9, []int{4}
+}
+
+// OrderHistoryEventType defines the events of the lifecycle of a limit order
+type OrderHistoryEventType int32
+
+const (
+	// the order was placed
+	OrderHistoryEventType_OrderHistoryPlaced OrderHistoryEventType = 0
+	// the order was partially filled
+	OrderHistoryEventType_OrderHistoryPartialFill OrderHistoryEventType = 1
+	// the order was fully filled
+	OrderHistoryEventType_OrderHistoryFilled OrderHistoryEventType = 2
+	// the order was cancelled
+	OrderHistoryEventType_OrderHistoryCancelled OrderHistoryEventType = 3
+	// the order was cancelled at its expiration
+	OrderHistoryEventType_OrderHistoryExpired OrderHistoryEventType = 4
+)
+
+var OrderHistoryEventType_name = map[int32]string{
+	0: "OrderHistoryPlaced",
+	1: "OrderHistoryPartialFill",
+	2: "OrderHistoryFilled",
+	3: "OrderHistoryCancelled",
+	4: "OrderHistoryExpired",
+}
+
+var OrderHistoryEventType_value = map[string]int32{
+	"OrderHistoryPlaced":      0,
+	"OrderHistoryPartialFill": 1,
+	"OrderHistoryFilled":      2,
+	"OrderHistoryCancelled":   3,
+	"OrderHistoryExpired":     4,
+}
+
+func (x OrderHistoryEventType) String() string {
+	return proto.EnumName(OrderHistoryEventType_name, int32(x))
+}
+
+func (OrderHistoryEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{5}
 }
 
 type OrderType int32
@@ -141,7 +265,7 @@ func (x OrderType) String() string {
 }
 
 func (OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{2}
+	return fileDescriptor_2116e2804e9c53f9, []int{6}
 }
 
 type ExecutionType int32
@@ -181,7 +305,7 @@ func (x ExecutionType) String() string {
 }
 
 func (ExecutionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{3}
+	return fileDescriptor_2116e2804e9c53f9, []int{7}
 }
 
 type OrderMask int32
@@ -224,7 +348,7 @@ func (x OrderMask) String() string {
 }
 
 func (OrderMask) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{4}
+	return fileDescriptor_2116e2804e9c53f9, []int{8}
 }
 
 type Params struct {
@@ -299,6 +423,88 @@ type Params struct {
 	// derivative market launch is enabled
 	IsInstantDerivativeMarketLaunchEnabled bool  `protobuf:"varint,24,opt,name=is_instant_derivative_market_launch_enabled,json=isInstantDerivativeMarketLaunchEnabled,proto3" json:"is_instant_derivative_market_launch_enabled,omitempty"`
 	PostOnlyModeHeightThreshold            int64 `protobuf:"varint,25,opt,name=post_only_mode_height_threshold,json=postOnlyModeHeightThreshold,proto3" json:"post_only_mode_height_threshold,omitempty"`
+	// max_open_orders_per_subaccount defines the maximum number of resting limit
+	// orders a subaccount can have open across all markets, zero meaning no cap
+	MaxOpenOrdersPerSubaccount uint32 `protobuf:"varint,26,opt,name=max_open_orders_per_subaccount,json=maxOpenOrdersPerSubaccount,proto3" json:"max_open_orders_per_subaccount,omitempty"`
+	// max_expired_orders_per_block defines the maximum number of expired limit
+	// orders cancelled in a single block
+	MaxExpiredOrdersPerBlock uint32 `protobuf:"varint,27,opt,name=max_expired_orders_per_block,json=maxExpiredOrdersPerBlock,proto3" json:"max_expired_orders_per_block,omitempty"`
+	// funding_rate_history_size defines the number of past hourly funding rates
+	// kept for each perpetual market, zero disables the history
+	FundingRateHistorySize uint32 `protobuf:"varint,28,opt,name=funding_rate_history_size,json=fundingRateHistorySize,proto3" json:"funding_rate_history_size,omitempty"`
+	// max_active_markets defines the maximum number of active spot, derivative
+	// and binary options markets, new markets can't be launched once it is
+	// reached. Zero means there is no limit
+	MaxActiveMarkets uint32 `protobuf:"varint,29,opt,name=max_active_markets,json=maxActiveMarkets,proto3" json:"max_active_markets,omitempty"`
+	// market_creation_fee defines the fee charged to the creator of a market
+	// launched by message, on top of the instant listing fee. A zero amount
+	// disables the fee
+	MarketCreationFee types.Coin `protobuf:"bytes,30,opt,name=market_creation_fee,json=marketCreationFee,proto3" json:"market_creation_fee"`
+	// order_placement_surcharge defines the fee charged to the sender for each
+	// order created by message. A zero amount disables the surcharge
+	OrderPlacementSurcharge types.Coin `protobuf:"bytes,31,opt,name=order_placement_surcharge,json=orderPlacementSurcharge,proto3" json:"order_placement_surcharge"`
+	// spam_fee_destination defines whether the market creation fees and order
+	// placement surcharges are sent to the community pool or burned
+	SpamFeeDestination SpamFeeDestination `protobuf:"varint,32,opt,name=spam_fee_destination,json=spamFeeDestination,proto3,enum=injective.exchange.v1beta1.SpamFeeDestination" json:"spam_fee_destination,omitempty"`
+	// is_auto_deleveraging_enabled defines whether underwater positions whose
+	// deficit can't be covered by the insurance fund are closed against the
+	// opposing positions instead of pausing the market for settlement
+	IsAutoDeleveragingEnabled bool `protobuf:"varint,33,opt,name=is_auto_deleveraging_enabled,json=isAutoDeleveragingEnabled,proto3" json:"is_auto_deleveraging_enabled,omitempty"`
+	// market_inactivity_delist_blocks defines the number of blocks without
+	// trades and without open orders after which a market is paused, and after
+	// which a paused market is demolished. Zero disables the auto-delisting
+	MarketInactivityDelistBlocks int64 `protobuf:"varint,34,opt,name=market_inactivity_delist_blocks,json=marketInactivityDelistBlocks,proto3" json:"market_inactivity_delist_blocks,omitempty"`
+	// maker_rebate_epoch_duration defines the duration in seconds of the epochs
+	// at the end of which the maker rebates are distributed. Zero disables the
+	// maker rebates
+	MakerRebateEpochDuration int64 `protobuf:"varint,35,opt,name=maker_rebate_epoch_duration,json=makerRebateEpochDuration,proto3" json:"maker_rebate_epoch_duration,omitempty"`
+	// maker_rebate_rate defines the share of the maker volume of an account,
+	// in the quote denom of the markets, paid to the account as rebate
+	MakerRebateRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,36,opt,name=maker_rebate_rate,json=makerRebateRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_rebate_rate"`
+	// maker_rebate_pool_source defines the pool from which the maker rebates
+	// are paid
+	MakerRebatePoolSource MakerRebatePoolSource `protobuf:"varint,37,opt,name=maker_rebate_pool_source,json=makerRebatePoolSource,proto3,enum=injective.exchange.v1beta1.MakerRebatePoolSource" json:"maker_rebate_pool_source,omitempty"`
+	// fee_settlement_denom defines the denom into which the market creation
+	// fees and order placement surcharges are converted before being burned or
+	// sent to the community pool. Empty disables the conversion
+	FeeSettlementDenom string `protobuf:"bytes,38,opt,name=fee_settlement_denom,json=feeSettlementDenom,proto3" json:"fee_settlement_denom,omitempty"`
+	// fee_settlement_oracle_type defines the oracle pricing the fee denoms in
+	// the settlement denom, with the denoms as base and quote symbols
+	FeeSettlementOracleType types1.OracleType `protobuf:"varint,39,opt,name=fee_settlement_oracle_type,json=feeSettlementOracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"fee_settlement_oracle_type,omitempty"`
+	// fee_settlement_max_price_age defines the maximum age in seconds of the
+	// oracle price used for the conversion, the conversion is skipped with an
+	// older price
+	FeeSettlementMaxPriceAge int64 `protobuf:"varint,40,opt,name=fee_settlement_max_price_age,json=feeSettlementMaxPriceAge,proto3" json:"fee_settlement_max_price_age,omitempty"`
+	// fee_settlement_slippage defines the discount applied to the oracle price
+	// when converting the fees, which protects the fee settlement pool from
+	// oracle price deviations
+	FeeSettlementSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,41,opt,name=fee_settlement_slippage,json=feeSettlementSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_settlement_slippage"`
+	// order_history_retention_blocks defines the number of blocks the history
+	// of a limit order is kept after the order is filled, cancelled or expired,
+	// zero disables the order history
+	OrderHistoryRetentionBlocks int64 `protobuf:"varint,42,opt,name=order_history_retention_blocks,json=orderHistoryRetentionBlocks,proto3" json:"order_history_retention_blocks,omitempty"`
+	// dust_thresholds defines per denom the deposit balance under which an idle
+	// subaccount deposit is swept, denoms without a threshold are never swept
+	DustThresholds github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,43,rep,name=dust_thresholds,json=dustThresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"dust_thresholds"`
+	// dust_sweep_destination defines whether the swept dust is sent to the
+	// community pool or burned
+	DustSweepDestination SpamFeeDestination `protobuf:"varint,44,opt,name=dust_sweep_destination,json=dustSweepDestination,proto3,enum=injective.exchange.v1beta1.SpamFeeDestination" json:"dust_sweep_destination,omitempty"`
+	// dust_sweep_max_deposits_per_block defines the number of deposits checked
+	// for dust in a single block, zero disables the dust sweep
+	DustSweepMaxDepositsPerBlock uint32 `protobuf:"varint,45,opt,name=dust_sweep_max_deposits_per_block,json=dustSweepMaxDepositsPerBlock,proto3" json:"dust_sweep_max_deposits_per_block,omitempty"`
+	// max_conditional_orders_per_subaccount defines the maximum number of
+	// untriggered conditional orders a subaccount can have across all markets
+	MaxConditionalOrdersPerSubaccount uint32 `protobuf:"varint,46,opt,name=max_conditional_orders_per_subaccount,json=maxConditionalOrdersPerSubaccount,proto3" json:"max_conditional_orders_per_subaccount,omitempty"`
+	// subaccount_funding_history_size defines the number of past funding
+	// payments kept for each subaccount in each perpetual market, zero disables
+	// the history
+	SubaccountFundingHistorySize uint32 `protobuf:"varint,47,opt,name=subaccount_funding_history_size,json=subaccountFundingHistorySize,proto3" json:"subaccount_funding_history_size,omitempty"`
+	// max_market_order_slippage_ratio caps the max slippage ratio of markets and
+	// of market orders, zero means no maximum
+	MaxMarketOrderSlippageRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,48,opt,name=max_market_order_slippage_ratio,json=maxMarketOrderSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_market_order_slippage_ratio"`
+	// maker_rebate_max_volumes_per_block defines the number of account maker
+	// volumes of an ended maker rebate epoch settled in a single block
+	MakerRebateMaxVolumesPerBlock uint32 `protobuf:"varint,49,opt,name=maker_rebate_max_volumes_per_block,json=makerRebateMaxVolumesPerBlock,proto3" json:"maker_rebate_max_volumes_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -404,6 +610,308 @@ func (m *Params) GetPostOnlyModeHeightThreshold() int64 {
 	return 0
 }
 
+func (m *Params) GetMaxOpenOrdersPerSubaccount() uint32 {
+	if m != nil {
+		return m.MaxOpenOrdersPerSubaccount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExpiredOrdersPerBlock() uint32 {
+	if m != nil {
+		return m.MaxExpiredOrdersPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFundingRateHistorySize() uint32 {
+	if m != nil {
+		return m.FundingRateHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActiveMarkets() uint32 {
+	if m != nil {
+		return m.MaxActiveMarkets
+	}
+	return 0
+}
+
+func (m *Params) GetMarketCreationFee() types.Coin {
+	if m != nil {
+		return m.MarketCreationFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetOrderPlacementSurcharge() types.Coin {
+	if m != nil {
+		return m.OrderPlacementSurcharge
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetSpamFeeDestination() SpamFeeDestination {
+	if m != nil {
+		return m.SpamFeeDestination
+	}
+	return SpamFeeDestination_CommunityPool
+}
+
+func (m *Params) GetIsAutoDeleveragingEnabled() bool {
+	if m != nil {
+		return m.IsAutoDeleveragingEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMarketInactivityDelistBlocks() int64 {
+	if m != nil {
+		return m.MarketInactivityDelistBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMakerRebateEpochDuration() int64 {
+	if m != nil {
+		return m.MakerRebateEpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMakerRebatePoolSource() MakerRebatePoolSource {
+	if m != nil {
+		return m.MakerRebatePoolSource
+	}
+	return MakerRebatePoolSource_MakerRebatePool
+}
+
+func (m *Params) GetFeeSettlementDenom() string {
+	if m != nil {
+		return m.FeeSettlementDenom
+	}
+	return ""
+}
+
+func (m *Params) GetFeeSettlementOracleType() types1.OracleType {
+	if m != nil {
+		return m.FeeSettlementOracleType
+	}
+	return types1.OracleType_Unspecified
+}
+
+func (m *Params) GetFeeSettlementMaxPriceAge() int64 {
+	if m != nil {
+		return m.FeeSettlementMaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetOrderHistoryRetentionBlocks() int64 {
+	if m != nil {
+		return m.OrderHistoryRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDustThresholds() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DustThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetDustSweepDestination() SpamFeeDestination {
+	if m != nil {
+		return m.DustSweepDestination
+	}
+	return SpamFeeDestination_CommunityPool
+}
+
+func (m *Params) GetDustSweepMaxDepositsPerBlock() uint32 {
+	if m != nil {
+		return m.DustSweepMaxDepositsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConditionalOrdersPerSubaccount() uint32 {
+	if m != nil {
+		return m.MaxConditionalOrdersPerSubaccount
+	}
+	return 0
+}
+
+func (m *Params) GetSubaccountFundingHistorySize() uint32 {
+	if m != nil {
+		return m.SubaccountFundingHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetMakerRebateMaxVolumesPerBlock() uint32 {
+	if m != nil {
+		return m.MakerRebateMaxVolumesPerBlock
+	}
+	return 0
+}
+
+// TradingWindow defines a daily window of block time during which a market
+// accepts orders, in seconds since midnight UTC. The start is inclusive and
+// the end is exclusive.
+type TradingWindow struct {
+	StartSecond uint32 `protobuf:"varint,1,opt,name=start_second,json=startSecond,proto3" json:"start_second,omitempty"`
+	EndSecond   uint32 `protobuf:"varint,2,opt,name=end_second,json=endSecond,proto3" json:"end_second,omitempty"`
+}
+
+func (m *TradingWindow) Reset()         { *m = TradingWindow{} }
+func (m *TradingWindow) String() string { return proto.CompactTextString(m) }
+func (*TradingWindow) ProtoMessage()    {}
+func (*TradingWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{1}
+}
+func (m *TradingWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingWindow.Merge(m, src)
+}
+func (m *TradingWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingWindow proto.InternalMessageInfo
+
+func (m *TradingWindow) GetStartSecond() uint32 {
+	if m != nil {
+		return m.StartSecond
+	}
+	return 0
+}
+
+func (m *TradingWindow) GetEndSecond() uint32 {
+	if m != nil {
+		return m.EndSecond
+	}
+	return 0
+}
+
+// MarketTradingSchedule defines the trading hours of a market. A market
+// without schedule accepts orders at any time.
+type MarketTradingSchedule struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// windows during which the market accepts orders, an empty list removes
+	// the schedule of the market
+	Windows []TradingWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows"`
+	// cancel_orders_at_close defines whether the resting orders of the market
+	// are cancelled while the market is closed
+	CancelOrdersAtClose bool `protobuf:"varint,3,opt,name=cancel_orders_at_close,json=cancelOrdersAtClose,proto3" json:"cancel_orders_at_close,omitempty"`
+}
+
+func (m *MarketTradingSchedule) Reset()         { *m = MarketTradingSchedule{} }
+func (m *MarketTradingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MarketTradingSchedule) ProtoMessage()    {}
+func (*MarketTradingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{2}
+}
+func (m *MarketTradingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketTradingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketTradingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketTradingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketTradingSchedule.Merge(m, src)
+}
+func (m *MarketTradingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketTradingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketTradingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketTradingSchedule proto.InternalMessageInfo
+
+// BlockTradeVolume defines the trade volume per quote denom of a block, kept
+// for the rolling trade volume of the protocol stats
+type BlockTradeVolume struct {
+	// block time in unix seconds
+	Timestamp int64                                       `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Volumes   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=volumes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"volumes"`
+}
+
+func (m *BlockTradeVolume) Reset()         { *m = BlockTradeVolume{} }
+func (m *BlockTradeVolume) String() string { return proto.CompactTextString(m) }
+func (*BlockTradeVolume) ProtoMessage()    {}
+func (*BlockTradeVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{3}
+}
+func (m *BlockTradeVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTradeVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTradeVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTradeVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTradeVolume.Merge(m, src)
+}
+func (m *BlockTradeVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTradeVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTradeVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTradeVolume proto.InternalMessageInfo
+
+func (m *BlockTradeVolume) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockTradeVolume) GetVolumes() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
 type MarketFeeMultiplier struct {
 	MarketId      string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	FeeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_multiplier,json=feeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_multiplier"`
@@ -413,7 +921,7 @@ func (m *MarketFeeMultiplier) Reset()         { *m = MarketFeeMultiplier{} }
 func (m *MarketFeeMultiplier) String() string { return proto.CompactTextString(m) }
 func (*MarketFeeMultiplier) ProtoMessage()    {}
 func (*MarketFeeMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{1}
+	return fileDescriptor_2116e2804e9c53f9, []int{4}
 }
 func (m *MarketFeeMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,13 +990,29 @@ type DerivativeMarket struct {
 	// min_quantity_tick_size defines the minimum tick size of the quantity
 	// required for orders in the market
 	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+	// max_open_interest defines the maximum open interest of the market, i.e. the
+	// total quantity of its long positions. Zero means no cap.
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// max_position_size defines the maximum quantity of the position of a
+	// subaccount in the market. Zero means no cap.
+	MaxPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=max_position_size,json=maxPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_size"`
+	// price_band_ratio defines the maximum relative distance of order prices
+	// from the mark price. Zero means no band.
+	PriceBandRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=price_band_ratio,json=priceBandRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_band_ratio"`
+	// max_leverage defines the maximum leverage, i.e. notional over margin, of
+	// the positions opened or increased by orders. Zero means no limit.
+	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// max_slippage_ratio defines the default maximum relative distance of the
+	// execution prices of market orders from the mark price, for the market
+	// orders which don't set their own. Zero means no bound.
+	MaxSlippageRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio"`
 }
 
 func (m *DerivativeMarket) Reset()         { *m = DerivativeMarket{} }
 func (m *DerivativeMarket) String() string { return proto.CompactTextString(m) }
 func (*DerivativeMarket) ProtoMessage()    {}
 func (*DerivativeMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{2}
+	return fileDescriptor_2116e2804e9c53f9, []int{5}
 }
 func (m *DerivativeMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,13 +1079,17 @@ type BinaryOptionsMarket struct {
 	// required for orders in the market
 	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,16,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
 	SettlementPrice     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price,omitempty"`
+	// max_slippage_ratio defines the default maximum relative distance of the
+	// execution prices of market orders from the top of the book, for the market
+	// orders which don't set their own. Zero means no bound.
+	MaxSlippageRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio"`
 }
 
 func (m *BinaryOptionsMarket) Reset()         { *m = BinaryOptionsMarket{} }
 func (m *BinaryOptionsMarket) String() string { return proto.CompactTextString(m) }
 func (*BinaryOptionsMarket) ProtoMessage()    {}
 func (*BinaryOptionsMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{3}
+	return fileDescriptor_2116e2804e9c53f9, []int{6}
 }
 func (m *BinaryOptionsMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +1139,7 @@ func (m *ExpiryFuturesMarketInfo) Reset()         { *m = ExpiryFuturesMarketInfo
 func (m *ExpiryFuturesMarketInfo) String() string { return proto.CompactTextString(m) }
 func (*ExpiryFuturesMarketInfo) ProtoMessage()    {}
 func (*ExpiryFuturesMarketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{4}
+	return fileDescriptor_2116e2804e9c53f9, []int{7}
 }
 func (m *ExpiryFuturesMarketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -681,7 +1209,7 @@ func (m *PerpetualMarketInfo) Reset()         { *m = PerpetualMarketInfo{} }
 func (m *PerpetualMarketInfo) String() string { return proto.CompactTextString(m) }
 func (*PerpetualMarketInfo) ProtoMessage()    {}
 func (*PerpetualMarketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{5}
+	return fileDescriptor_2116e2804e9c53f9, []int{8}
 }
 func (m *PerpetualMarketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +1272,7 @@ func (m *PerpetualMarketFunding) Reset()         { *m = PerpetualMarketFunding{}
 func (m *PerpetualMarketFunding) String() string { return proto.CompactTextString(m) }
 func (*PerpetualMarketFunding) ProtoMessage()    {}
 func (*PerpetualMarketFunding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{6}
+	return fileDescriptor_2116e2804e9c53f9, []int{9}
 }
 func (m *PerpetualMarketFunding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,6 +1308,354 @@ func (m *PerpetualMarketFunding) GetLastTimestamp() int64 {
 	return 0
 }
 
+// FundingRateRecord is a funding rate applied to a perpetual market at a
+// funding interval
+type FundingRateRecord struct {
+	// timestamp of the funding interval
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// funding_rate defines the capped hourly funding rate
+	FundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate"`
+	// mark_price defines the mark price the funding was paid at
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+}
+
+func (m *FundingRateRecord) Reset()         { *m = FundingRateRecord{} }
+func (m *FundingRateRecord) String() string { return proto.CompactTextString(m) }
+func (*FundingRateRecord) ProtoMessage()    {}
+func (*FundingRateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{10}
+}
+func (m *FundingRateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingRateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingRateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingRateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRateRecord.Merge(m, src)
+}
+func (m *FundingRateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingRateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingRateRecord proto.InternalMessageInfo
+
+func (m *FundingRateRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// SubaccountFundingPayment is a funding payment of a position of a subaccount
+// in a perpetual market at a funding interval
+type SubaccountFundingPayment struct {
+	// the perpetual market of the position
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// timestamp of the funding interval
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// funding_rate defines the capped hourly funding rate of the interval
+	FundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate"`
+	// amount defines the funding received by the position, negative when the
+	// funding is paid
+	Amount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount"`
+}
+
+func (m *SubaccountFundingPayment) Reset()         { *m = SubaccountFundingPayment{} }
+func (m *SubaccountFundingPayment) String() string { return proto.CompactTextString(m) }
+func (*SubaccountFundingPayment) ProtoMessage()    {}
+func (*SubaccountFundingPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{11}
+}
+func (m *SubaccountFundingPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubaccountFundingPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubaccountFundingPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubaccountFundingPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubaccountFundingPayment.Merge(m, src)
+}
+func (m *SubaccountFundingPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubaccountFundingPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubaccountFundingPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubaccountFundingPayment proto.InternalMessageInfo
+
+func (m *SubaccountFundingPayment) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *SubaccountFundingPayment) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// OrderHistoryEvent is an event of the lifecycle of a limit order
+type OrderHistoryEvent struct {
+	Type        OrderHistoryEventType `protobuf:"varint,1,opt,name=type,proto3,enum=injective.exchange.v1beta1.OrderHistoryEventType" json:"type,omitempty"`
+	BlockHeight int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime   int64                 `protobuf:"varint,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// price defines the order price on placement and the execution price of a
+	// fill, it is zero on cancellation and expiration
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// quantity defines the order quantity on placement, the filled quantity of
+	// a fill and the unfilled quantity on cancellation and expiration
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+}
+
+func (m *OrderHistoryEvent) Reset()         { *m = OrderHistoryEvent{} }
+func (m *OrderHistoryEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderHistoryEvent) ProtoMessage()    {}
+func (*OrderHistoryEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{12}
+}
+func (m *OrderHistoryEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderHistoryEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderHistoryEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderHistoryEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderHistoryEvent.Merge(m, src)
+}
+func (m *OrderHistoryEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderHistoryEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderHistoryEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderHistoryEvent proto.InternalMessageInfo
+
+func (m *OrderHistoryEvent) GetType() OrderHistoryEventType {
+	if m != nil {
+		return m.Type
+	}
+	return OrderHistoryEventType_OrderHistoryPlaced
+}
+
+func (m *OrderHistoryEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *OrderHistoryEvent) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+// OrderHistory is the lifecycle of a limit order, from its placement to its
+// terminal event
+type OrderHistory struct {
+	OrderHash    string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	IsBuy        bool   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	// quantity defines the quantity of the order on placement
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	// filled_quantity defines the quantity filled so far
+	FilledQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=filled_quantity,json=filledQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"filled_quantity"`
+	// events of the order, oldest first. Past MaxOrderHistoryFills fills, the
+	// fills are only counted in omitted_fills
+	Events       []OrderHistoryEvent `protobuf:"bytes,7,rep,name=events,proto3" json:"events"`
+	OmittedFills uint32              `protobuf:"varint,8,opt,name=omitted_fills,json=omittedFills,proto3" json:"omitted_fills,omitempty"`
+	// terminated_height defines the height of the terminal event, zero while
+	// the order is open
+	TerminatedHeight int64 `protobuf:"varint,9,opt,name=terminated_height,json=terminatedHeight,proto3" json:"terminated_height,omitempty"`
+}
+
+func (m *OrderHistory) Reset()         { *m = OrderHistory{} }
+func (m *OrderHistory) String() string { return proto.CompactTextString(m) }
+func (*OrderHistory) ProtoMessage()    {}
+func (*OrderHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{13}
+}
+func (m *OrderHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderHistory.Merge(m, src)
+}
+func (m *OrderHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderHistory proto.InternalMessageInfo
+
+func (m *OrderHistory) GetOrderHash() string {
+	if m != nil {
+		return m.OrderHash
+	}
+	return ""
+}
+
+func (m *OrderHistory) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *OrderHistory) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return ""
+}
+
+func (m *OrderHistory) GetIsBuy() bool {
+	if m != nil {
+		return m.IsBuy
+	}
+	return false
+}
+
+func (m *OrderHistory) GetEvents() []OrderHistoryEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *OrderHistory) GetOmittedFills() uint32 {
+	if m != nil {
+		return m.OmittedFills
+	}
+	return 0
+}
+
+func (m *OrderHistory) GetTerminatedHeight() int64 {
+	if m != nil {
+		return m.TerminatedHeight
+	}
+	return 0
+}
+
+// MakerRebateSettlement is the settlement in progress of the maker rebates of
+// an ended maker rebate epoch
+type MakerRebateSettlement struct {
+	// epoch_start_timestamp defines the start timestamp of the ended epoch
+	EpochStartTimestamp int64 `protobuf:"varint,1,opt,name=epoch_start_timestamp,json=epochStartTimestamp,proto3" json:"epoch_start_timestamp,omitempty"`
+	// rebate_rates defines per quote denom the share of the maker volume paid as
+	// rebate, reduced to the funds of the pool at the end of the epoch
+	RebateRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rebate_rates,json=rebateRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rebate_rates"`
+	// pool_source defines the pool the rebate rates were reduced to, which pays
+	// the rebates until the settlement is over
+	PoolSource MakerRebatePoolSource `protobuf:"varint,3,opt,name=pool_source,json=poolSource,proto3,enum=injective.exchange.v1beta1.MakerRebatePoolSource" json:"pool_source,omitempty"`
+}
+
+func (m *MakerRebateSettlement) Reset()         { *m = MakerRebateSettlement{} }
+func (m *MakerRebateSettlement) String() string { return proto.CompactTextString(m) }
+func (*MakerRebateSettlement) ProtoMessage()    {}
+func (*MakerRebateSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{14}
+}
+func (m *MakerRebateSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MakerRebateSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MakerRebateSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MakerRebateSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakerRebateSettlement.Merge(m, src)
+}
+func (m *MakerRebateSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MakerRebateSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakerRebateSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakerRebateSettlement proto.InternalMessageInfo
+
+func (m *MakerRebateSettlement) GetEpochStartTimestamp() int64 {
+	if m != nil {
+		return m.EpochStartTimestamp
+	}
+	return 0
+}
+
+func (m *MakerRebateSettlement) GetRebateRates() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RebateRates
+	}
+	return nil
+}
+
+func (m *MakerRebateSettlement) GetPoolSource() MakerRebatePoolSource {
+	if m != nil {
+		return m.PoolSource
+	}
+	return MakerRebatePoolSource_MakerRebatePool
+}
+
 type DerivativeMarketSettlementInfo struct {
 	// market ID.
 	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -791,7 +1667,7 @@ func (m *DerivativeMarketSettlementInfo) Reset()         { *m = DerivativeMarket
 func (m *DerivativeMarketSettlementInfo) String() string { return proto.CompactTextString(m) }
 func (*DerivativeMarketSettlementInfo) ProtoMessage()    {}
 func (*DerivativeMarketSettlementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{7}
+	return fileDescriptor_2116e2804e9c53f9, []int{15}
 }
 func (m *DerivativeMarketSettlementInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1711,7 @@ func (m *NextFundingTimestamp) Reset()         { *m = NextFundingTimestamp{} }
 func (m *NextFundingTimestamp) String() string { return proto.CompactTextString(m) }
 func (*NextFundingTimestamp) ProtoMessage()    {}
 func (*NextFundingTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{8}
+	return fileDescriptor_2116e2804e9c53f9, []int{16}
 }
 func (m *NextFundingTimestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1760,7 @@ func (m *MidPriceAndTOB) Reset()         { *m = MidPriceAndTOB{} }
 func (m *MidPriceAndTOB) String() string { return proto.CompactTextString(m) }
 func (*MidPriceAndTOB) ProtoMessage()    {}
 func (*MidPriceAndTOB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{9}
+	return fileDescriptor_2116e2804e9c53f9, []int{17}
 }
 func (m *MidPriceAndTOB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,13 +1815,17 @@ type SpotMarket struct {
 	// min_quantity_tick_size defines the minimum tick size of the quantity
 	// required for orders in the market
 	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+	// max_slippage_ratio defines the default maximum relative distance of the
+	// execution prices of market orders from the top of the book, for the market
+	// orders which don't set their own. Zero means no bound.
+	MaxSlippageRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio"`
 }
 
 func (m *SpotMarket) Reset()         { *m = SpotMarket{} }
 func (m *SpotMarket) String() string { return proto.CompactTextString(m) }
 func (*SpotMarket) ProtoMessage()    {}
 func (*SpotMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{10}
+	return fileDescriptor_2116e2804e9c53f9, []int{18}
 }
 func (m *SpotMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1899,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{11}
+	return fileDescriptor_2116e2804e9c53f9, []int{19}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1936,7 @@ func (m *SubaccountTradeNonce) Reset()         { *m = SubaccountTradeNonce{} }
 func (m *SubaccountTradeNonce) String() string { return proto.CompactTextString(m) }
 func (*SubaccountTradeNonce) ProtoMessage()    {}
 func (*SubaccountTradeNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{12}
+	return fileDescriptor_2116e2804e9c53f9, []int{20}
 }
 func (m *SubaccountTradeNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1102,13 +1982,16 @@ type OrderInfo struct {
 	// quantity of the order
 	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
 	Cid      string                                 `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`
+	// unix timestamp in seconds after which a resting limit order is cancelled,
+	// zero if the order never expires
+	ExpirationTimestamp int64 `protobuf:"varint,6,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
 }
 
 func (m *OrderInfo) Reset()         { *m = OrderInfo{} }
 func (m *OrderInfo) String() string { return proto.CompactTextString(m) }
 func (*OrderInfo) ProtoMessage()    {}
 func (*OrderInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{13}
+	return fileDescriptor_2116e2804e9c53f9, []int{21}
 }
 func (m *OrderInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,6 +2041,13 @@ func (m *OrderInfo) GetCid() string {
 	return ""
 }
 
+func (m *OrderInfo) GetExpirationTimestamp() int64 {
+	if m != nil {
+		return m.ExpirationTimestamp
+	}
+	return 0
+}
+
 type SpotOrder struct {
 	// market_id represents the unique ID of the market
 	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -1167,13 +2057,17 @@ type SpotOrder struct {
 	OrderType OrderType `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=injective.exchange.v1beta1.OrderType" json:"order_type,omitempty"`
 	// trigger_price is the trigger price used by stop/take orders
 	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
+	// max_slippage_ratio optionally tightens the max slippage ratio of the
+	// market for a market order, up to the max_market_order_slippage_ratio
+	// param. Ignored by limit orders.
+	MaxSlippageRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio,omitempty"`
 }
 
 func (m *SpotOrder) Reset()         { *m = SpotOrder{} }
 func (m *SpotOrder) String() string { return proto.CompactTextString(m) }
 func (*SpotOrder) ProtoMessage()    {}
 func (*SpotOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{14}
+	return fileDescriptor_2116e2804e9c53f9, []int{22}
 }
 func (m *SpotOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1240,7 +2134,7 @@ func (m *SpotLimitOrder) Reset()         { *m = SpotLimitOrder{} }
 func (m *SpotLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*SpotLimitOrder) ProtoMessage()    {}
 func (*SpotLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{15}
+	return fileDescriptor_2116e2804e9c53f9, []int{23}
 }
 func (m *SpotLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1306,7 +2200,7 @@ func (m *SpotMarketOrder) Reset()         { *m = SpotMarketOrder{} }
 func (m *SpotMarketOrder) String() string { return proto.CompactTextString(m) }
 func (*SpotMarketOrder) ProtoMessage()    {}
 func (*SpotMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{16}
+	return fileDescriptor_2116e2804e9c53f9, []int{24}
 }
 func (m *SpotMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,13 +2261,17 @@ type DerivativeOrder struct {
 	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
 	// trigger_price is the trigger price used by stop/take orders
 	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
+	// max_slippage_ratio optionally tightens the max slippage ratio of the
+	// market for a market order, up to the max_market_order_slippage_ratio
+	// param. Ignored by limit orders.
+	MaxSlippageRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio,omitempty"`
 }
 
 func (m *DerivativeOrder) Reset()         { *m = DerivativeOrder{} }
 func (m *DerivativeOrder) String() string { return proto.CompactTextString(m) }
 func (*DerivativeOrder) ProtoMessage()    {}
 func (*DerivativeOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{17}
+	return fileDescriptor_2116e2804e9c53f9, []int{25}
 }
 func (m *DerivativeOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +2338,7 @@ func (m *SubaccountOrderbookMetadata) Reset()         { *m = SubaccountOrderbook
 func (m *SubaccountOrderbookMetadata) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrderbookMetadata) ProtoMessage()    {}
 func (*SubaccountOrderbookMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{18}
+	return fileDescriptor_2116e2804e9c53f9, []int{26}
 }
 func (m *SubaccountOrderbookMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1509,7 +2407,7 @@ func (m *SubaccountOrder) Reset()         { *m = SubaccountOrder{} }
 func (m *SubaccountOrder) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrder) ProtoMessage()    {}
 func (*SubaccountOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{19}
+	return fileDescriptor_2116e2804e9c53f9, []int{27}
 }
 func (m *SubaccountOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1554,7 +2452,7 @@ func (m *SubaccountOrderData) Reset()         { *m = SubaccountOrderData{} }
 func (m *SubaccountOrderData) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrderData) ProtoMessage()    {}
 func (*SubaccountOrderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{20}
+	return fileDescriptor_2116e2804e9c53f9, []int{28}
 }
 func (m *SubaccountOrderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1616,7 +2514,7 @@ func (m *DerivativeLimitOrder) Reset()         { *m = DerivativeLimitOrder{} }
 func (m *DerivativeLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*DerivativeLimitOrder) ProtoMessage()    {}
 func (*DerivativeLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{21}
+	return fileDescriptor_2116e2804e9c53f9, []int{29}
 }
 func (m *DerivativeLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1683,7 +2581,7 @@ func (m *DerivativeMarketOrder) Reset()         { *m = DerivativeMarketOrder{} }
 func (m *DerivativeMarketOrder) String() string { return proto.CompactTextString(m) }
 func (*DerivativeMarketOrder) ProtoMessage()    {}
 func (*DerivativeMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{22}
+	return fileDescriptor_2116e2804e9c53f9, []int{30}
 }
 func (m *DerivativeMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1745,7 +2643,7 @@ func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{23}
+	return fileDescriptor_2116e2804e9c53f9, []int{31}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1791,7 +2689,7 @@ func (m *MarketOrderIndicator) Reset()         { *m = MarketOrderIndicator{} }
 func (m *MarketOrderIndicator) String() string { return proto.CompactTextString(m) }
 func (*MarketOrderIndicator) ProtoMessage()    {}
 func (*MarketOrderIndicator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{24}
+	return fileDescriptor_2116e2804e9c53f9, []int{32}
 }
 func (m *MarketOrderIndicator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1849,7 +2747,7 @@ func (m *TradeLog) Reset()         { *m = TradeLog{} }
 func (m *TradeLog) String() string { return proto.CompactTextString(m) }
 func (*TradeLog) ProtoMessage()    {}
 func (*TradeLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{25}
+	return fileDescriptor_2116e2804e9c53f9, []int{33}
 }
 func (m *TradeLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1917,7 +2815,7 @@ func (m *PositionDelta) Reset()         { *m = PositionDelta{} }
 func (m *PositionDelta) String() string { return proto.CompactTextString(m) }
 func (*PositionDelta) ProtoMessage()    {}
 func (*PositionDelta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{26}
+	return fileDescriptor_2116e2804e9c53f9, []int{34}
 }
 func (m *PositionDelta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1967,7 +2865,7 @@ func (m *DerivativeTradeLog) Reset()         { *m = DerivativeTradeLog{} }
 func (m *DerivativeTradeLog) String() string { return proto.CompactTextString(m) }
 func (*DerivativeTradeLog) ProtoMessage()    {}
 func (*DerivativeTradeLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{27}
+	return fileDescriptor_2116e2804e9c53f9, []int{35}
 }
 func (m *DerivativeTradeLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2040,7 +2938,7 @@ func (m *SubaccountPosition) Reset()         { *m = SubaccountPosition{} }
 func (m *SubaccountPosition) String() string { return proto.CompactTextString(m) }
 func (*SubaccountPosition) ProtoMessage()    {}
 func (*SubaccountPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{28}
+	return fileDescriptor_2116e2804e9c53f9, []int{36}
 }
 func (m *SubaccountPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2092,7 +2990,7 @@ func (m *SubaccountDeposit) Reset()         { *m = SubaccountDeposit{} }
 func (m *SubaccountDeposit) String() string { return proto.CompactTextString(m) }
 func (*SubaccountDeposit) ProtoMessage()    {}
 func (*SubaccountDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{29}
+	return fileDescriptor_2116e2804e9c53f9, []int{37}
 }
 func (m *SubaccountDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +3042,7 @@ func (m *DepositUpdate) Reset()         { *m = DepositUpdate{} }
 func (m *DepositUpdate) String() string { return proto.CompactTextString(m) }
 func (*DepositUpdate) ProtoMessage()    {}
 func (*DepositUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{30}
+	return fileDescriptor_2116e2804e9c53f9, []int{38}
 }
 func (m *DepositUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2196,7 +3094,7 @@ func (m *PointsMultiplier) Reset()         { *m = PointsMultiplier{} }
 func (m *PointsMultiplier) String() string { return proto.CompactTextString(m) }
 func (*PointsMultiplier) ProtoMessage()    {}
 func (*PointsMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{31}
+	return fileDescriptor_2116e2804e9c53f9, []int{39}
 }
 func (m *PointsMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2236,7 +3134,7 @@ func (m *TradingRewardCampaignBoostInfo) Reset()         { *m = TradingRewardCam
 func (m *TradingRewardCampaignBoostInfo) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignBoostInfo) ProtoMessage()    {}
 func (*TradingRewardCampaignBoostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{32}
+	return fileDescriptor_2116e2804e9c53f9, []int{40}
 }
 func (m *TradingRewardCampaignBoostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2304,7 +3202,7 @@ func (m *CampaignRewardPool) Reset()         { *m = CampaignRewardPool{} }
 func (m *CampaignRewardPool) String() string { return proto.CompactTextString(m) }
 func (*CampaignRewardPool) ProtoMessage()    {}
 func (*CampaignRewardPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{33}
+	return fileDescriptor_2116e2804e9c53f9, []int{41}
 }
 func (m *CampaignRewardPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2362,7 +3260,7 @@ func (m *TradingRewardCampaignInfo) Reset()         { *m = TradingRewardCampaign
 func (m *TradingRewardCampaignInfo) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignInfo) ProtoMessage()    {}
 func (*TradingRewardCampaignInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{34}
+	return fileDescriptor_2116e2804e9c53f9, []int{42}
 }
 func (m *TradingRewardCampaignInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2430,7 +3328,7 @@ func (m *FeeDiscountTierInfo) Reset()         { *m = FeeDiscountTierInfo{} }
 func (m *FeeDiscountTierInfo) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountTierInfo) ProtoMessage()    {}
 func (*FeeDiscountTierInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{35}
+	return fileDescriptor_2116e2804e9c53f9, []int{43}
 }
 func (m *FeeDiscountTierInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2476,7 +3374,7 @@ func (m *FeeDiscountSchedule) Reset()         { *m = FeeDiscountSchedule{} }
 func (m *FeeDiscountSchedule) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountSchedule) ProtoMessage()    {}
 func (*FeeDiscountSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{36}
+	return fileDescriptor_2116e2804e9c53f9, []int{44}
 }
 func (m *FeeDiscountSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2549,7 +3447,7 @@ func (m *FeeDiscountTierTTL) Reset()         { *m = FeeDiscountTierTTL{} }
 func (m *FeeDiscountTierTTL) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountTierTTL) ProtoMessage()    {}
 func (*FeeDiscountTierTTL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{37}
+	return fileDescriptor_2116e2804e9c53f9, []int{45}
 }
 func (m *FeeDiscountTierTTL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2601,7 +3499,7 @@ func (m *VolumeRecord) Reset()         { *m = VolumeRecord{} }
 func (m *VolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*VolumeRecord) ProtoMessage()    {}
 func (*VolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{38}
+	return fileDescriptor_2116e2804e9c53f9, []int{46}
 }
 func (m *VolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2639,7 +3537,7 @@ func (m *AccountRewards) Reset()         { *m = AccountRewards{} }
 func (m *AccountRewards) String() string { return proto.CompactTextString(m) }
 func (*AccountRewards) ProtoMessage()    {}
 func (*AccountRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{39}
+	return fileDescriptor_2116e2804e9c53f9, []int{47}
 }
 func (m *AccountRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2691,7 +3589,7 @@ func (m *TradeRecords) Reset()         { *m = TradeRecords{} }
 func (m *TradeRecords) String() string { return proto.CompactTextString(m) }
 func (*TradeRecords) ProtoMessage()    {}
 func (*TradeRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{40}
+	return fileDescriptor_2116e2804e9c53f9, []int{48}
 }
 func (m *TradeRecords) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2742,7 +3640,7 @@ func (m *SubaccountIDs) Reset()         { *m = SubaccountIDs{} }
 func (m *SubaccountIDs) String() string { return proto.CompactTextString(m) }
 func (*SubaccountIDs) ProtoMessage()    {}
 func (*SubaccountIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{41}
+	return fileDescriptor_2116e2804e9c53f9, []int{49}
 }
 func (m *SubaccountIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +3686,7 @@ func (m *TradeRecord) Reset()         { *m = TradeRecord{} }
 func (m *TradeRecord) String() string { return proto.CompactTextString(m) }
 func (*TradeRecord) ProtoMessage()    {}
 func (*TradeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{42}
+	return fileDescriptor_2116e2804e9c53f9, []int{50}
 }
 func (m *TradeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2835,7 +3733,7 @@ func (m *Level) Reset()         { *m = Level{} }
 func (m *Level) String() string { return proto.CompactTextString(m) }
 func (*Level) ProtoMessage()    {}
 func (*Level) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{43}
+	return fileDescriptor_2116e2804e9c53f9, []int{51}
 }
 func (m *Level) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2873,7 +3771,7 @@ func (m *AggregateSubaccountVolumeRecord) Reset()         { *m = AggregateSubacc
 func (m *AggregateSubaccountVolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*AggregateSubaccountVolumeRecord) ProtoMessage()    {}
 func (*AggregateSubaccountVolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{44}
+	return fileDescriptor_2116e2804e9c53f9, []int{52}
 }
 func (m *AggregateSubaccountVolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2925,7 +3823,7 @@ func (m *AggregateAccountVolumeRecord) Reset()         { *m = AggregateAccountVo
 func (m *AggregateAccountVolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*AggregateAccountVolumeRecord) ProtoMessage()    {}
 func (*AggregateAccountVolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{45}
+	return fileDescriptor_2116e2804e9c53f9, []int{53}
 }
 func (m *AggregateAccountVolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2977,7 +3875,7 @@ func (m *MarketVolume) Reset()         { *m = MarketVolume{} }
 func (m *MarketVolume) String() string { return proto.CompactTextString(m) }
 func (*MarketVolume) ProtoMessage()    {}
 func (*MarketVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{46}
+	return fileDescriptor_2116e2804e9c53f9, []int{54}
 }
 func (m *MarketVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3029,7 +3927,7 @@ func (m *DenomDecimals) Reset()         { *m = DenomDecimals{} }
 func (m *DenomDecimals) String() string { return proto.CompactTextString(m) }
 func (*DenomDecimals) ProtoMessage()    {}
 func (*DenomDecimals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{47}
+	return fileDescriptor_2116e2804e9c53f9, []int{55}
 }
 func (m *DenomDecimals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3074,17 +3972,29 @@ func (m *DenomDecimals) GetDecimals() uint64 {
 
 func init() {
 	proto.RegisterEnum("injective.exchange.v1beta1.AtomicMarketOrderAccessLevel", AtomicMarketOrderAccessLevel_name, AtomicMarketOrderAccessLevel_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.SpamFeeDestination", SpamFeeDestination_name, SpamFeeDestination_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.MakerRebatePoolSource", MakerRebatePoolSource_name, MakerRebatePoolSource_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.MarginMode", MarginMode_name, MarginMode_value)
 	proto.RegisterEnum("injective.exchange.v1beta1.MarketStatus", MarketStatus_name, MarketStatus_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.OrderHistoryEventType", OrderHistoryEventType_name, OrderHistoryEventType_value)
 	proto.RegisterEnum("injective.exchange.v1beta1.OrderType", OrderType_name, OrderType_value)
 	proto.RegisterEnum("injective.exchange.v1beta1.ExecutionType", ExecutionType_name, ExecutionType_value)
 	proto.RegisterEnum("injective.exchange.v1beta1.OrderMask", OrderMask_name, OrderMask_value)
 	proto.RegisterType((*Params)(nil), "injective.exchange.v1beta1.Params")
+	proto.RegisterType((*TradingWindow)(nil), "injective.exchange.v1beta1.TradingWindow")
+	proto.RegisterType((*MarketTradingSchedule)(nil), "injective.exchange.v1beta1.MarketTradingSchedule")
+	proto.RegisterType((*BlockTradeVolume)(nil), "injective.exchange.v1beta1.BlockTradeVolume")
 	proto.RegisterType((*MarketFeeMultiplier)(nil), "injective.exchange.v1beta1.MarketFeeMultiplier")
 	proto.RegisterType((*DerivativeMarket)(nil), "injective.exchange.v1beta1.DerivativeMarket")
 	proto.RegisterType((*BinaryOptionsMarket)(nil), "injective.exchange.v1beta1.BinaryOptionsMarket")
 	proto.RegisterType((*ExpiryFuturesMarketInfo)(nil), "injective.exchange.v1beta1.ExpiryFuturesMarketInfo")
 	proto.RegisterType((*PerpetualMarketInfo)(nil), "injective.exchange.v1beta1.PerpetualMarketInfo")
 	proto.RegisterType((*PerpetualMarketFunding)(nil), "injective.exchange.v1beta1.PerpetualMarketFunding")
+	proto.RegisterType((*FundingRateRecord)(nil), "injective.exchange.v1beta1.FundingRateRecord")
+	proto.RegisterType((*SubaccountFundingPayment)(nil), "injective.exchange.v1beta1.SubaccountFundingPayment")
+	proto.RegisterType((*OrderHistoryEvent)(nil), "injective.exchange.v1beta1.OrderHistoryEvent")
+	proto.RegisterType((*OrderHistory)(nil), "injective.exchange.v1beta1.OrderHistory")
+	proto.RegisterType((*MakerRebateSettlement)(nil), "injective.exchange.v1beta1.MakerRebateSettlement")
 	proto.RegisterType((*DerivativeMarketSettlementInfo)(nil), "injective.exchange.v1beta1.DerivativeMarketSettlementInfo")
 	proto.RegisterType((*NextFundingTimestamp)(nil), "injective.exchange.v1beta1.NextFundingTimestamp")
 	proto.RegisterType((*MidPriceAndTOB)(nil), "injective.exchange.v1beta1.MidPriceAndTOB")
@@ -3133,266 +4043,349 @@ func init() {
 }
 
 var fileDescriptor_2116e2804e9c53f9 = []byte{
-	// 4144 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x23, 0x59,
-	0x5e, 0xef, 0xb2, 0x9d, 0xc4, 0xfe, 0xc7, 0x76, 0xdc, 0x95, 0x74, 0xe2, 0xa4, 0xbb, 0x13, 0x8f,
-	0x67, 0x7a, 0x3a, 0xd3, 0xb3, 0x93, 0xde, 0x69, 0x60, 0x35, 0x8c, 0x58, 0xa9, 0x9d, 0xaf, 0x69,
-	0xcf, 0xe4, 0x6b, 0xca, 0xee, 0x59, 0x35, 0xab, 0xd9, 0xda, 0x97, 0xaa, 0x97, 0xf8, 0x4d, 0x97,
-	0xab, 0xdc, 0xf5, 0x9e, 0xd3, 0xc9, 0x22, 0xa4, 0x15, 0x8b, 0x10, 0x1b, 0x90, 0x06, 0x38, 0x2c,
-	0x7b, 0x89, 0xb4, 0x07, 0x2e, 0x70, 0x00, 0x0e, 0x88, 0xcb, 0xc0, 0x99, 0x3d, 0xee, 0x11, 0x21,
-	0x58, 0x50, 0xcf, 0x05, 0x71, 0x40, 0x82, 0x1b, 0x42, 0x42, 0xe8, 0x7d, 0xd4, 0x87, 0xed, 0xc4,
-	0x49, 0x57, 0xd2, 0x5a, 0x16, 0xed, 0x29, 0x7e, 0x5f, 0xbf, 0xff, 0x7b, 0xff, 0xef, 0xf7, 0x51,
-	0x81, 0xb7, 0x88, 0xfb, 0x19, 0xb6, 0x18, 0x39, 0xc0, 0xf7, 0xf1, 0xa1, 0xd5, 0x42, 0xee, 0x3e,
-	0xbe, 0x7f, 0xf0, 0xee, 0x2e, 0x66, 0xe8, 0xdd, 0xb0, 0x62, 0xa9, 0xe3, 0x7b, 0xcc, 0xd3, 0xe7,
-	0xc2, 0xae, 0x4b, 0x61, 0x8b, 0xea, 0x3a, 0x37, 0xb5, 0xef, 0xed, 0x7b, 0xa2, 0xdb, 0x7d, 0xfe,
-	0x4b, 0x8e, 0x98, 0x9b, 0xb7, 0x3c, 0xda, 0xf6, 0xe8, 0xfd, 0x5d, 0x44, 0x23, 0x54, 0xcb, 0x23,
-	0xae, 0x6a, 0xbf, 0x13, 0x11, 0xf7, 0x7c, 0x64, 0x39, 0x51, 0x27, 0x59, 0x94, 0xdd, 0xaa, 0x3f,
-	0xb8, 0x01, 0xa3, 0x3b, 0xc8, 0x47, 0x6d, 0xaa, 0x63, 0x58, 0xa0, 0x1d, 0x8f, 0x99, 0x6d, 0xe4,
-	0x3f, 0xc5, 0xcc, 0x24, 0x2e, 0x65, 0xc8, 0x65, 0xa6, 0x43, 0x28, 0x23, 0xee, 0xbe, 0xb9, 0x87,
-	0x71, 0x59, 0xab, 0x68, 0x8b, 0xe3, 0x0f, 0x66, 0x97, 0x24, 0xed, 0x25, 0x4e, 0x3b, 0x98, 0xe6,
-	0xd2, 0x8a, 0x47, 0xdc, 0xe5, 0xcc, 0x8f, 0x7f, 0xba, 0x70, 0xcd, 0xb8, 0xc9, 0x71, 0x36, 0x05,
-	0x4c, 0x5d, 0xa2, 0x6c, 0x48, 0x90, 0x75, 0x8c, 0xf5, 0x67, 0x70, 0xc7, 0xc6, 0x3e, 0x39, 0x40,
-	0x7c, 0x6e, 0xc3, 0x88, 0xa5, 0x2e, 0x46, 0xec, 0xb5, 0x08, 0xed, 0x2c, 0x92, 0x0e, 0xdc, 0xb4,
-	0xf1, 0x1e, 0xea, 0x3a, 0xcc, 0x54, 0x2b, 0x7c, 0x8a, 0x7d, 0x4e, 0xc3, 0xf4, 0x11, 0xc3, 0xe5,
-	0x74, 0x45, 0x5b, 0xcc, 0x2d, 0x2f, 0x71, 0xb4, 0x7f, 0xf8, 0xe9, 0xc2, 0x9b, 0xfb, 0x84, 0xb5,
-	0xba, 0xbb, 0x4b, 0x96, 0xd7, 0xbe, 0xaf, 0x78, 0x2c, 0xff, 0xbc, 0x43, 0xed, 0xa7, 0xf7, 0xd9,
-	0x51, 0x07, 0xd3, 0xa5, 0x55, 0x6c, 0x19, 0x33, 0x0a, 0xb2, 0x21, 0xd6, 0xfa, 0x14, 0xfb, 0xeb,
-	0x18, 0x1b, 0x88, 0x0d, 0x52, 0x63, 0xbd, 0xd4, 0x32, 0x97, 0xa6, 0xd6, 0x8c, 0x53, 0x3b, 0x84,
-	0xd7, 0x02, 0x6a, 0x3d, 0x6c, 0xed, 0xa1, 0x39, 0x92, 0x88, 0xe6, 0x6d, 0x05, 0xbc, 0x1a, 0x63,
-	0xf0, 0xb9, 0x94, 0xfb, 0x56, 0x3b, 0x7a, 0x45, 0x94, 0x7b, 0xd6, 0xec, 0xc1, 0xad, 0x80, 0x32,
-	0x71, 0x09, 0x23, 0xc8, 0xe1, 0x7a, 0xb4, 0x4f, 0x5c, 0x4e, 0x93, 0x78, 0xe5, 0xb1, 0x44, 0x44,
-	0x67, 0x15, 0x66, 0x5d, 0x42, 0x6e, 0x0a, 0x44, 0x83, 0x03, 0xea, 0xcf, 0xa1, 0x12, 0x10, 0x6c,
-	0x23, 0xe2, 0x32, 0xec, 0x22, 0xd7, 0xc2, 0xbd, 0x44, 0xb3, 0x97, 0x5a, 0xe9, 0x66, 0x04, 0x1b,
-	0x27, 0xfc, 0x1e, 0x94, 0x03, 0xc2, 0x7b, 0x5d, 0xd7, 0xe6, 0xa6, 0xc1, 0xfb, 0xf9, 0x07, 0xc8,
-	0x29, 0xe7, 0x2a, 0xda, 0x62, 0xda, 0x98, 0x56, 0xed, 0xeb, 0xb2, 0xb9, 0xae, 0x5a, 0xf5, 0xb7,
-	0xa0, 0x14, 0x8c, 0x68, 0x77, 0x1d, 0x46, 0x3a, 0x0e, 0x2e, 0x83, 0x18, 0x31, 0xa1, 0xea, 0x37,
-	0x55, 0xb5, 0x6e, 0xc1, 0xb4, 0x8f, 0x1d, 0x74, 0xa4, 0xe4, 0x46, 0x5b, 0xc8, 0x57, 0xd2, 0x1b,
-	0x4f, 0xb4, 0xa6, 0x49, 0x85, 0xb6, 0x8e, 0x71, 0x83, 0x63, 0x09, 0x99, 0x31, 0x58, 0x08, 0x56,
-	0xd2, 0xf2, 0xba, 0xbe, 0x73, 0x14, 0x2e, 0x88, 0x53, 0x32, 0x2d, 0xd4, 0x29, 0xe7, 0x13, 0x51,
-	0x0b, 0x8c, 0xed, 0x91, 0x40, 0x55, 0x6c, 0xe0, 0x24, 0x57, 0x50, 0x27, 0xae, 0x29, 0x8a, 0xaa,
-	0x60, 0x1f, 0xa6, 0x4c, 0x2e, 0xb0, 0x70, 0x29, 0x4d, 0x91, 0x24, 0xeb, 0x0a, 0x51, 0x2c, 0x73,
-	0x15, 0x16, 0xda, 0xe8, 0x30, 0x6e, 0x10, 0x9e, 0x6f, 0x63, 0xdf, 0xa4, 0xc4, 0xc6, 0xa6, 0xe5,
-	0x75, 0x5d, 0x56, 0x2e, 0x56, 0xb4, 0xc5, 0x82, 0x71, 0xb3, 0x8d, 0x0e, 0x23, 0xf5, 0xde, 0xe6,
-	0x9d, 0x1a, 0xc4, 0xc6, 0x2b, 0xbc, 0x8b, 0xfe, 0xdb, 0x1a, 0xdc, 0x25, 0xee, 0x67, 0xa6, 0x8f,
-	0x9f, 0x23, 0xdf, 0x36, 0x29, 0x37, 0x2a, 0xdb, 0xf4, 0xf1, 0xb3, 0x2e, 0xf1, 0x71, 0x1b, 0xbb,
-	0xcc, 0x64, 0x2d, 0x1f, 0xd3, 0x96, 0xe7, 0xd8, 0xe5, 0x89, 0x97, 0x5e, 0x42, 0xdd, 0x65, 0xc6,
-	0xeb, 0xc4, 0xfd, 0xcc, 0x10, 0xe8, 0x0d, 0x01, 0x6e, 0x44, 0xd8, 0xcd, 0x00, 0x5a, 0xff, 0x00,
-	0x2a, 0xcc, 0x47, 0x52, 0x48, 0xa2, 0x2f, 0x35, 0x0f, 0xb0, 0x74, 0xd0, 0x76, 0x57, 0x68, 0xbd,
-	0x5b, 0x2e, 0x09, 0x9d, 0xba, 0xad, 0xfa, 0x49, 0x48, 0xfa, 0x89, 0xec, 0xb5, 0xaa, 0x3a, 0x71,
-	0x31, 0x38, 0xe4, 0x59, 0x97, 0xd8, 0x88, 0x79, 0x7e, 0xb8, 0xaa, 0x48, 0xcf, 0xae, 0x27, 0x13,
-	0x43, 0x84, 0xa9, 0x96, 0x12, 0x6a, 0xdb, 0x21, 0xbc, 0xb5, 0x4b, 0x5c, 0xe4, 0x1f, 0x99, 0x5e,
-	0x87, 0xcf, 0x80, 0x0e, 0x0b, 0x34, 0xfa, 0xc5, 0x02, 0xcd, 0x1b, 0x12, 0x71, 0x5b, 0x02, 0x9e,
-	0x15, 0x6b, 0xbe, 0xab, 0x41, 0x05, 0x31, 0xaf, 0x4d, 0xac, 0x80, 0xa4, 0x54, 0x00, 0x64, 0x59,
-	0x98, 0x52, 0xd3, 0xc1, 0x07, 0xd8, 0x29, 0x4f, 0x56, 0xb4, 0xc5, 0xe2, 0x83, 0xf7, 0x96, 0xce,
-	0x8e, 0xfa, 0x4b, 0x35, 0x81, 0x21, 0xa9, 0x08, 0xed, 0xa8, 0x09, 0x80, 0x0d, 0x3e, 0xde, 0xb8,
-	0x85, 0x86, 0xb4, 0xea, 0xdf, 0xd3, 0xe0, 0xae, 0x88, 0x3c, 0xa7, 0xcd, 0x83, 0x5b, 0xb8, 0x72,
-	0x08, 0x04, 0xfb, 0xe5, 0xa9, 0x44, 0x9c, 0xaf, 0x72, 0xf8, 0x81, 0x19, 0xae, 0x63, 0xbc, 0x19,
-	0x22, 0xeb, 0x9f, 0x6b, 0xf0, 0x4e, 0xcc, 0x0c, 0x2e, 0x30, 0x97, 0x1b, 0x89, 0xe6, 0xb2, 0x18,
-	0x11, 0x39, 0x67, 0x46, 0x3f, 0xd0, 0xe0, 0xdd, 0x3e, 0xad, 0xb8, 0xc0, 0xac, 0xa6, 0x13, 0xcd,
-	0xea, 0xed, 0x1e, 0x65, 0x39, 0x67, 0x62, 0x04, 0x66, 0xdb, 0xc4, 0x25, 0x6d, 0xe4, 0x98, 0x22,
-	0x2b, 0xb3, 0x3c, 0x27, 0x8a, 0xa0, 0x33, 0x89, 0xe8, 0x4f, 0x2b, 0xc0, 0x1d, 0x85, 0x17, 0x84,
-	0xce, 0x6f, 0xc2, 0xdb, 0x84, 0x86, 0x56, 0x30, 0x98, 0x88, 0x39, 0xa8, 0xeb, 0x5a, 0x2d, 0x13,
-	0xbb, 0x68, 0xd7, 0xc1, 0x76, 0xb9, 0x5c, 0xd1, 0x16, 0xb3, 0xc6, 0x9b, 0x84, 0x2a, 0x45, 0x5f,
-	0xed, 0xcb, 0xb5, 0x36, 0x44, 0xf7, 0x35, 0xd9, 0x9b, 0x3b, 0xbf, 0x8e, 0x47, 0x99, 0xe9, 0xb9,
-	0xce, 0x91, 0xd9, 0xf6, 0x6c, 0x6c, 0xb6, 0x30, 0xd9, 0x6f, 0xc5, 0xbd, 0xd5, 0xac, 0x70, 0x17,
-	0x37, 0x79, 0xb7, 0x6d, 0xd7, 0x39, 0xda, 0xf4, 0x6c, 0xfc, 0x48, 0xf4, 0x09, 0xbd, 0xce, 0xfb,
-	0x99, 0x7f, 0xfd, 0xd1, 0x82, 0x56, 0xfd, 0x5c, 0x83, 0x49, 0x49, 0xa3, 0x97, 0x57, 0x37, 0x21,
-	0x17, 0x98, 0xb2, 0x2d, 0xf2, 0xd1, 0x9c, 0x91, 0x95, 0x15, 0x75, 0x5b, 0x7f, 0x0c, 0xc5, 0x3e,
-	0xe9, 0xa5, 0x12, 0x71, 0xaf, 0xb0, 0x17, 0xa7, 0xf9, 0x7e, 0xe6, 0x77, 0x7f, 0xb4, 0x70, 0xad,
-	0xfa, 0xe7, 0x59, 0x28, 0xf5, 0xaf, 0x5f, 0x9f, 0x86, 0x51, 0x46, 0xac, 0xa7, 0xd8, 0x57, 0x73,
-	0x51, 0x25, 0x7d, 0x01, 0xc6, 0x65, 0x9e, 0x6d, 0x72, 0x77, 0x22, 0xa7, 0x61, 0x80, 0xac, 0x5a,
-	0x46, 0x14, 0xeb, 0xaf, 0x41, 0x5e, 0x75, 0x78, 0xd6, 0xf5, 0x82, 0x24, 0xd4, 0x50, 0x83, 0x3e,
-	0xe6, 0x55, 0xfa, 0x5a, 0x88, 0xc1, 0x67, 0x26, 0x12, 0xc7, 0xe2, 0x83, 0x37, 0x62, 0x4e, 0x43,
-	0x65, 0xf2, 0x81, 0xcb, 0xd8, 0x16, 0xc5, 0xe6, 0x51, 0x07, 0x07, 0x94, 0xf8, 0x6f, 0x7d, 0x09,
-	0x26, 0x15, 0x0c, 0xb5, 0x90, 0x83, 0xcd, 0x3d, 0x64, 0x31, 0xcf, 0x17, 0x39, 0x61, 0xc1, 0xb8,
-	0x2e, 0x9b, 0x1a, 0xbc, 0x65, 0x5d, 0x34, 0xf0, 0xa9, 0x8b, 0x29, 0x99, 0x36, 0x76, 0xbd, 0xb6,
-	0xcc, 0xe0, 0x0c, 0x10, 0x55, 0xab, 0xbc, 0xa6, 0x57, 0x04, 0x63, 0x7d, 0x22, 0xf8, 0x36, 0x4c,
-	0x9d, 0x9a, 0x93, 0x25, 0x4b, 0x8f, 0x74, 0x32, 0x98, 0x8c, 0xb5, 0xa0, 0x7c, 0x66, 0x12, 0x96,
-	0x4b, 0x68, 0x2c, 0xa7, 0x67, 0x5f, 0x4d, 0x28, 0xf6, 0x25, 0xd2, 0x90, 0x08, 0x3f, 0xdf, 0x8e,
-	0x67, 0xaf, 0x4d, 0x28, 0xf6, 0x25, 0xc9, 0xc9, 0xd2, 0xac, 0x3c, 0x8b, 0xa3, 0x9e, 0x9d, 0xc4,
-	0xe5, 0xaf, 0x2e, 0x89, 0xab, 0xc0, 0x38, 0xa1, 0x3b, 0xd8, 0xef, 0x60, 0xd6, 0x45, 0x8e, 0xc8,
-	0x9e, 0xb2, 0x46, 0xbc, 0x4a, 0x7f, 0x08, 0xa3, 0x94, 0x21, 0xd6, 0xa5, 0x22, 0xcd, 0x29, 0x3e,
-	0x58, 0x1c, 0x16, 0xe3, 0xa4, 0x0d, 0x35, 0x44, 0x7f, 0x43, 0x8d, 0xd3, 0x3f, 0x85, 0xc9, 0x36,
-	0x71, 0xcd, 0x8e, 0x4f, 0x2c, 0x6c, 0x72, 0x6b, 0x32, 0x29, 0xf9, 0x0e, 0x4e, 0x90, 0xe6, 0xf0,
-	0x55, 0x94, 0xda, 0xc4, 0xdd, 0xe1, 0x48, 0x4d, 0x62, 0x3d, 0x6d, 0x90, 0xef, 0x08, 0x3e, 0x71,
-	0xf8, 0x67, 0x5d, 0xe4, 0x32, 0xc2, 0x8e, 0x62, 0x14, 0x4a, 0xc9, 0xf8, 0xd4, 0x26, 0xee, 0xc7,
-	0x0a, 0x2c, 0x20, 0xa2, 0x1c, 0xc6, 0x9f, 0x64, 0x61, 0x72, 0x79, 0x30, 0x67, 0x38, 0xd3, 0x67,
-	0xbc, 0x0e, 0x85, 0xc0, 0x50, 0x8f, 0xda, 0xbb, 0x9e, 0xa3, 0xbc, 0x86, 0xf2, 0x13, 0x0d, 0x51,
-	0xa7, 0xdf, 0x85, 0x09, 0xd5, 0xa9, 0xe3, 0x7b, 0x07, 0xc4, 0xc6, 0xbe, 0x72, 0x1d, 0x45, 0x59,
-	0xbd, 0xa3, 0x6a, 0x7f, 0x56, 0xde, 0xe3, 0x5d, 0x98, 0xc2, 0x87, 0x1d, 0x22, 0x13, 0x3f, 0x93,
-	0x91, 0x36, 0xa6, 0x0c, 0xb5, 0x3b, 0xc2, 0x8d, 0xa4, 0x8d, 0xc9, 0xa8, 0xad, 0x19, 0x34, 0xf1,
-	0x21, 0x14, 0x33, 0xe6, 0xa8, 0xcc, 0x36, 0x1c, 0x32, 0x26, 0x87, 0x44, 0x6d, 0xd1, 0x90, 0x29,
-	0x18, 0x41, 0x76, 0x9b, 0xb8, 0xd2, 0xad, 0x18, 0xb2, 0xd0, 0xef, 0xb9, 0x72, 0xc3, 0x3d, 0x17,
-	0xf4, 0x79, 0xae, 0x41, 0x6b, 0x1f, 0x7f, 0x25, 0xd6, 0x9e, 0x7f, 0xa5, 0xd6, 0x5e, 0xb8, 0x3a,
-	0x6b, 0xff, 0x85, 0x2d, 0x73, 0x22, 0x4f, 0xa0, 0x14, 0xd3, 0x4e, 0xb1, 0x94, 0xd8, 0x7e, 0x45,
-	0x7b, 0x09, 0xf8, 0x89, 0x08, 0x47, 0xac, 0x43, 0xb9, 0x89, 0xff, 0x4e, 0xc1, 0xcc, 0x1a, 0x37,
-	0x8b, 0xa3, 0xf5, 0x2e, 0xeb, 0xfa, 0x38, 0xdc, 0x5a, 0xec, 0x79, 0xc3, 0xb3, 0x9d, 0xb3, 0x4c,
-	0x2d, 0x75, 0xb6, 0xa9, 0x7d, 0x15, 0xa6, 0xd8, 0x73, 0xd4, 0xe1, 0x3b, 0x4a, 0x3f, 0x6e, 0x6a,
-	0x69, 0x31, 0x44, 0xe7, 0x6d, 0x0d, 0xde, 0x14, 0x8d, 0xf8, 0x2d, 0x0d, 0xde, 0x8c, 0x53, 0x89,
-	0x46, 0x4b, 0xa9, 0x5a, 0xdd, 0x76, 0xd7, 0x11, 0x19, 0x51, 0xc2, 0x93, 0xad, 0x6a, 0x6c, 0x9e,
-	0x01, 0x79, 0xc1, 0x9e, 0x95, 0x10, 0xf9, 0x54, 0x19, 0x24, 0x3b, 0xd3, 0xea, 0x97, 0x41, 0xf5,
-	0x1f, 0x53, 0x30, 0x19, 0x86, 0xaf, 0x8b, 0x72, 0x1e, 0xc3, 0xcc, 0x59, 0x87, 0x18, 0xc9, 0x12,
-	0xce, 0xa9, 0xd6, 0x69, 0xa7, 0x17, 0xdf, 0x86, 0xa9, 0x53, 0x4f, 0x2d, 0x92, 0x1d, 0x58, 0xea,
-	0xad, 0xc1, 0xe3, 0x8a, 0x5f, 0x86, 0x69, 0x17, 0x1f, 0x46, 0x87, 0x4b, 0x91, 0x46, 0x64, 0x84,
-	0x46, 0x4c, 0xf1, 0x56, 0x35, 0xab, 0x48, 0x27, 0x62, 0x67, 0x4b, 0xe1, 0x69, 0xd4, 0x48, 0xcf,
-	0xd9, 0x52, 0x70, 0x0c, 0x55, 0xfd, 0x2f, 0x0d, 0xa6, 0xfb, 0xd8, 0xab, 0xe0, 0xf4, 0x4f, 0x41,
-	0x8f, 0x94, 0x27, 0x98, 0x81, 0x64, 0xf5, 0x4b, 0xaf, 0xed, 0x7a, 0x84, 0x14, 0xc0, 0x3f, 0x81,
-	0x52, 0x0c, 0x5e, 0xea, 0x4c, 0x32, 0xe1, 0x4c, 0x44, 0x38, 0x42, 0x67, 0xf4, 0x3b, 0x50, 0x74,
-	0x10, 0x1d, 0xb4, 0x9f, 0x02, 0xaf, 0x0d, 0xd9, 0x54, 0xfd, 0xa1, 0x06, 0xf3, 0xfd, 0x1b, 0x86,
-	0x46, 0xa8, 0x7e, 0xe7, 0x6b, 0xd9, 0x69, 0x5a, 0x9f, 0xba, 0x1a, 0xad, 0xff, 0x3a, 0x4c, 0x6d,
-	0x9d, 0x26, 0xd9, 0x3b, 0x50, 0x14, 0xfa, 0x10, 0xad, 0x4c, 0x93, 0x2b, 0xe3, 0xb5, 0xd1, 0xca,
-	0x7e, 0x2f, 0x05, 0xc5, 0x4d, 0x62, 0x0b, 0xac, 0x9a, 0x6b, 0x37, 0xb7, 0x97, 0xf5, 0x8f, 0x20,
-	0xd7, 0x26, 0xb6, 0x9a, 0xa5, 0x96, 0xc8, 0x3f, 0x66, 0xdb, 0x0a, 0x92, 0x07, 0xcd, 0x5d, 0xae,
-	0xed, 0xbb, 0xdd, 0xa3, 0x81, 0x75, 0xbf, 0x0c, 0x62, 0x9e, 0xa3, 0x2c, 0x77, 0x8f, 0x24, 0xea,
-	0x27, 0x30, 0x21, 0x50, 0x29, 0x76, 0x1c, 0x05, 0x9b, 0x4e, 0x04, 0x5b, 0xe0, 0x30, 0x0d, 0xec,
-	0x38, 0x92, 0x99, 0x3f, 0x1c, 0x01, 0x68, 0x84, 0x37, 0x1e, 0x67, 0xa6, 0x77, 0xb7, 0x01, 0xf8,
-	0x5e, 0x50, 0x25, 0x27, 0x32, 0xb7, 0xcb, 0xf1, 0x1a, 0x99, 0x9b, 0xf4, 0x25, 0x2f, 0xe9, 0x81,
-	0xe4, 0x65, 0x30, 0x3f, 0xc9, 0xbc, 0x92, 0xfc, 0x64, 0xe4, 0x95, 0xe6, 0x27, 0xa3, 0x57, 0x97,
-	0x9f, 0x0c, 0xdd, 0x87, 0x46, 0xc9, 0x4b, 0xf6, 0x6a, 0x93, 0x97, 0xdc, 0x2b, 0x4f, 0x5e, 0xe0,
-	0xca, 0x92, 0x97, 0xea, 0x17, 0x1a, 0x8c, 0xad, 0xe2, 0x8e, 0x47, 0x09, 0xd3, 0xbf, 0x09, 0xd7,
-	0xd1, 0x01, 0x22, 0x0e, 0xda, 0x15, 0xa7, 0x12, 0x0e, 0xdf, 0xed, 0x26, 0x74, 0xb7, 0xa5, 0x10,
-	0x68, 0x59, 0xe2, 0xe8, 0x0d, 0x28, 0x30, 0x8f, 0x21, 0x27, 0x04, 0x4e, 0x25, 0xd4, 0x22, 0x0e,
-	0xa2, 0x40, 0xab, 0x5f, 0x81, 0xa9, 0x46, 0x77, 0x17, 0x59, 0xe2, 0xdc, 0xbc, 0xe9, 0x23, 0x1b,
-	0x6f, 0x79, 0x9c, 0xd8, 0x14, 0x8c, 0xb8, 0x5e, 0x30, 0xfb, 0x82, 0x21, 0x0b, 0x3c, 0xd4, 0xe4,
-	0xc4, 0xe1, 0x9a, 0xf0, 0xac, 0xaf, 0x43, 0x81, 0x86, 0x63, 0x23, 0xef, 0x9a, 0x8f, 0x2a, 0xeb,
-	0x36, 0xef, 0x24, 0xd4, 0x1e, 0x5b, 0xa4, 0x43, 0xb0, 0xcb, 0x82, 0x1d, 0xd7, 0x1e, 0xc6, 0x46,
-	0x50, 0xa7, 0xaf, 0xc2, 0x48, 0xbf, 0xb3, 0x78, 0x99, 0x25, 0xc9, 0xc1, 0xfa, 0x87, 0x90, 0x0d,
-	0x44, 0x9d, 0xd0, 0x6e, 0xc3, 0xf1, 0x7a, 0x09, 0xd2, 0x16, 0xb1, 0xa5, 0xa1, 0x1a, 0xfc, 0x67,
-	0xf5, 0xf3, 0x14, 0xe4, 0xb8, 0x0b, 0x12, 0xeb, 0x1f, 0x1e, 0x55, 0x3e, 0x04, 0x90, 0xe7, 0x9c,
-	0xc4, 0xdd, 0xf3, 0xd4, 0x25, 0xeb, 0x9d, 0x61, 0xc6, 0x11, 0xf2, 0x54, 0x9d, 0x83, 0xe7, 0xbc,
-	0x90, 0xc9, 0xab, 0x01, 0x96, 0xd8, 0x62, 0xa6, 0x85, 0xa1, 0x9d, 0x8f, 0x25, 0xf6, 0x98, 0x12,
-	0x45, 0x6c, 0x31, 0xb9, 0xee, 0xf8, 0x64, 0x7f, 0x1f, 0xfb, 0xca, 0x2b, 0x67, 0x92, 0x39, 0x7b,
-	0x05, 0x22, 0x9d, 0xf2, 0x8b, 0x14, 0x14, 0x39, 0x47, 0x36, 0x48, 0x9b, 0x28, 0xb6, 0xf4, 0xae,
-	0x5c, 0xbb, 0xc2, 0x95, 0xa7, 0x12, 0xae, 0xfc, 0x43, 0xc8, 0xee, 0x11, 0x47, 0x18, 0x52, 0x42,
-	0xed, 0x0a, 0xc7, 0xbf, 0x12, 0x2e, 0xf2, 0x98, 0x25, 0x97, 0xd9, 0x42, 0xb4, 0x25, 0x14, 0x2e,
-	0xaf, 0xe6, 0xff, 0x08, 0xd1, 0x56, 0xf5, 0xdf, 0x52, 0x30, 0x11, 0x45, 0xbe, 0xab, 0xe7, 0xf2,
-	0xc7, 0x90, 0x57, 0xfe, 0xc4, 0x14, 0xa7, 0xc7, 0xc9, 0x9c, 0xca, 0xb8, 0xc2, 0x78, 0xe4, 0x39,
-	0x76, 0xdf, 0x8a, 0xd2, 0x7d, 0x2b, 0xea, 0x93, 0x6b, 0xe6, 0xaa, 0x34, 0x7a, 0xe4, 0x0a, 0x34,
-	0xfa, 0x9f, 0x52, 0x30, 0xd1, 0x77, 0x63, 0xf8, 0xf3, 0x66, 0xe9, 0xeb, 0x30, 0x2a, 0x8f, 0x6b,
-	0x13, 0xba, 0x40, 0x35, 0xfa, 0xd5, 0xf0, 0xf7, 0x8f, 0x32, 0x70, 0x33, 0x0a, 0x37, 0x62, 0xfe,
-	0xbb, 0x9e, 0xf7, 0x74, 0x13, 0x33, 0x64, 0x23, 0x86, 0xf4, 0x5f, 0x85, 0xd9, 0x03, 0xe4, 0x72,
-	0x73, 0x33, 0x1d, 0xee, 0x54, 0xd4, 0x75, 0x91, 0xbc, 0xd4, 0x95, 0x91, 0x68, 0x5a, 0x75, 0x88,
-	0x9c, 0x8e, 0xbc, 0xcf, 0x7d, 0x08, 0xb7, 0x7d, 0x6c, 0x77, 0x2d, 0x2c, 0xaf, 0x46, 0x06, 0x87,
-	0xa7, 0xc4, 0xf0, 0x59, 0xd9, 0x69, 0xdb, 0x75, 0x8e, 0xfa, 0x11, 0x28, 0xcc, 0xa3, 0xfd, 0x7d,
-	0x1f, 0xef, 0xf3, 0x7d, 0x66, 0x1c, 0x2b, 0x0c, 0x2a, 0xc9, 0xfc, 0xc7, 0xcd, 0x10, 0xd5, 0x08,
-	0x69, 0x07, 0x59, 0x84, 0xee, 0xc0, 0x5c, 0x44, 0x34, 0x58, 0xfb, 0x25, 0xa3, 0x58, 0x39, 0x44,
-	0xfc, 0x44, 0x02, 0x86, 0xd4, 0xd6, 0x60, 0x21, 0xa0, 0x61, 0x79, 0xae, 0x4d, 0x18, 0xf1, 0x5c,
-	0xe4, 0xf4, 0xb0, 0x49, 0x9e, 0x3a, 0xde, 0x52, 0xdd, 0x56, 0xa2, 0x5e, 0x31, 0x4e, 0x6d, 0xc0,
-	0xeb, 0x71, 0xfe, 0x9c, 0x05, 0x35, 0x2a, 0xa0, 0x16, 0x22, 0x8e, 0x9f, 0x8a, 0x56, 0xfd, 0x3b,
-	0x0d, 0x26, 0xfa, 0x94, 0x22, 0x4a, 0x08, 0xb4, 0xab, 0x4a, 0x08, 0x52, 0x97, 0x4c, 0x08, 0xaa,
-	0x90, 0x27, 0x34, 0x12, 0xa0, 0xd0, 0x85, 0xac, 0xd1, 0x53, 0x57, 0x7d, 0x0e, 0x93, 0x7d, 0x0b,
-	0x59, 0xe5, 0x5a, 0x5d, 0x83, 0x11, 0xc1, 0x16, 0xe5, 0xa9, 0xdf, 0x1e, 0x66, 0xd3, 0x7d, 0xe3,
-	0x0d, 0x39, 0xb2, 0xcf, 0xa5, 0xa6, 0xfa, 0x83, 0xc4, 0x5f, 0xa6, 0x61, 0x2a, 0xf2, 0x5b, 0xff,
-	0xa7, 0xe3, 0x71, 0xe4, 0x9f, 0xd2, 0x97, 0xf2, 0x4f, 0xf1, 0xb8, 0x9e, 0xb9, 0xea, 0xb8, 0x3e,
-	0x72, 0xe5, 0x71, 0x7d, 0xb4, 0x5f, 0x64, 0x7f, 0x9d, 0x86, 0x1b, 0xfd, 0x27, 0x17, 0xff, 0xdf,
-	0x65, 0xb6, 0x0d, 0xe3, 0xea, 0x2a, 0x51, 0xa4, 0x1a, 0xc9, 0xc4, 0x06, 0x12, 0x42, 0x64, 0x1a,
-	0x3f, 0x0b, 0xc1, 0xfd, 0x47, 0x0a, 0xb2, 0x3b, 0x7c, 0xb7, 0x47, 0x3c, 0x57, 0x9f, 0x86, 0x51,
-	0x42, 0x37, 0x3c, 0x75, 0xa8, 0x96, 0x35, 0x54, 0xe9, 0x4a, 0x3d, 0xcf, 0x36, 0x8c, 0x63, 0x97,
-	0xf9, 0x47, 0xe6, 0x65, 0xb6, 0x48, 0x20, 0x20, 0xe4, 0x02, 0xaf, 0x2a, 0x45, 0x68, 0x41, 0x79,
-	0xf0, 0x74, 0xd1, 0x14, 0x84, 0x12, 0x9e, 0x70, 0x4c, 0x0f, 0x9c, 0x31, 0xae, 0x71, 0xb4, 0x6a,
-	0x1d, 0xa6, 0x62, 0x16, 0x52, 0x77, 0x6d, 0x62, 0x21, 0xe6, 0x9d, 0x93, 0x9b, 0x4d, 0xc1, 0x08,
-	0xa1, 0xcb, 0x5d, 0x29, 0x80, 0xac, 0x21, 0x0b, 0xd5, 0x7f, 0x4f, 0x41, 0x56, 0xec, 0x73, 0x37,
-	0xbc, 0x5e, 0x31, 0x69, 0x97, 0x14, 0x53, 0x18, 0xb2, 0x52, 0x97, 0x09, 0x59, 0x03, 0x7b, 0x6a,
-	0x99, 0x3e, 0xf7, 0xee, 0xa9, 0x1f, 0x42, 0x7a, 0x0f, 0x27, 0x75, 0x7b, 0x7c, 0xe8, 0x39, 0x9b,
-	0x0e, 0xfd, 0x3d, 0xb8, 0xd1, 0xb3, 0x69, 0x37, 0x91, 0x6d, 0xfb, 0x98, 0x52, 0x69, 0x0d, 0xc2,
-	0xcd, 0x68, 0xc6, 0x64, 0x7c, 0x0b, 0x5f, 0x93, 0x1d, 0x82, 0x7d, 0xf3, 0x58, 0xb4, 0x6f, 0xfe,
-	0x22, 0x05, 0x85, 0xc0, 0x5e, 0x56, 0xb1, 0xc3, 0x90, 0x3e, 0x03, 0x63, 0x84, 0x9a, 0xce, 0xa0,
-	0xd5, 0x7c, 0x0a, 0x3a, 0x3e, 0xc4, 0x56, 0x57, 0x5c, 0x83, 0x5c, 0xd2, 0x7e, 0xae, 0x87, 0x48,
-	0x61, 0xf6, 0xf3, 0x04, 0x4a, 0x11, 0xfc, 0xa5, 0x1c, 0xda, 0x44, 0x88, 0x23, 0xdf, 0x32, 0xe8,
-	0xdf, 0x80, 0xa8, 0x6a, 0x60, 0x6f, 0xf8, 0x32, 0xc8, 0xc5, 0x10, 0x46, 0x66, 0xcc, 0xdf, 0x4d,
-	0x83, 0x1e, 0x7b, 0xa2, 0x1b, 0x28, 0xee, 0xa9, 0x47, 0x2f, 0xfd, 0x6a, 0xb2, 0x03, 0xc5, 0x8e,
-	0x62, 0xbc, 0x69, 0x73, 0xce, 0xab, 0x0d, 0xca, 0x5b, 0xc3, 0x02, 0x40, 0x8f, 0xa8, 0x8c, 0x42,
-	0xa7, 0x47, 0x72, 0xeb, 0x30, 0xda, 0x41, 0x47, 0x5e, 0x97, 0x25, 0x0d, 0x04, 0x72, 0xf4, 0xcf,
-	0x97, 0x02, 0xff, 0x06, 0xe8, 0x51, 0x56, 0x16, 0x7a, 0xfe, 0x87, 0x90, 0x0d, 0x78, 0xa3, 0x62,
-	0xf4, 0x1b, 0x17, 0x61, 0xab, 0x11, 0x8e, 0x1a, 0x94, 0x61, 0x6a, 0x50, 0x86, 0xd5, 0xe7, 0x70,
-	0x3d, 0x22, 0x1e, 0x1c, 0x33, 0x5e, 0x48, 0xfa, 0x5f, 0x87, 0x31, 0x5b, 0xf6, 0x57, 0x62, 0x7f,
-	0x7d, 0xd8, 0xfc, 0x14, 0xb4, 0x11, 0x8c, 0xa9, 0x76, 0xa0, 0xa0, 0xea, 0x1e, 0x77, 0x6c, 0xc4,
-	0xc4, 0x89, 0xa0, 0x3c, 0x36, 0x97, 0x7e, 0x56, 0x16, 0xf4, 0x3a, 0x64, 0xd5, 0x08, 0x5a, 0x4e,
-	0x55, 0xd2, 0x8b, 0xe3, 0x0f, 0xde, 0xb9, 0x58, 0x7a, 0x1b, 0x10, 0x0c, 0x87, 0x57, 0x5f, 0x68,
-	0x50, 0xda, 0xf1, 0x88, 0xcb, 0x68, 0xec, 0x2d, 0xda, 0x1e, 0xcc, 0xc8, 0x13, 0xf9, 0x8e, 0x68,
-	0x89, 0xbf, 0x3b, 0x4b, 0xe6, 0xb0, 0x6f, 0x08, 0xb8, 0xd3, 0xe8, 0xb0, 0x33, 0xe8, 0x24, 0xf3,
-	0x3f, 0x37, 0xd8, 0x69, 0x74, 0xaa, 0xff, 0x93, 0x82, 0xf9, 0x66, 0xfc, 0x21, 0xef, 0x0a, 0x6a,
-	0x77, 0x10, 0xd9, 0x77, 0x97, 0x3d, 0x8f, 0xca, 0x0b, 0xab, 0x5f, 0x81, 0x99, 0x5d, 0x5e, 0xc0,
-	0xb6, 0xd9, 0xf3, 0xb1, 0x88, 0x4d, 0xcb, 0x5a, 0x25, 0xbd, 0x98, 0x33, 0xa6, 0x54, 0x73, 0x74,
-	0x2c, 0x54, 0xb7, 0xa9, 0xfe, 0x19, 0xcc, 0xc4, 0xbb, 0x47, 0x0b, 0x08, 0x04, 0xf3, 0x95, 0xe1,
-	0xfa, 0xd9, 0x3b, 0x51, 0x95, 0x4a, 0xde, 0x88, 0x3e, 0x33, 0x89, 0xda, 0xa8, 0x5e, 0x83, 0xdb,
-	0xc1, 0x14, 0x4f, 0xf9, 0xd0, 0xc4, 0xa6, 0xe5, 0xb4, 0x98, 0xe8, 0x9c, 0xea, 0xd4, 0x9f, 0xe7,
-	0xf2, 0xe9, 0x1e, 0xc0, 0xed, 0xc1, 0xa1, 0xf1, 0x49, 0x67, 0x12, 0x4f, 0xfa, 0x66, 0xff, 0xe7,
-	0x2a, 0xb1, 0xa9, 0x57, 0xff, 0x46, 0x03, 0x3d, 0xe0, 0xb9, 0x94, 0xc0, 0x8e, 0x27, 0xdf, 0xfc,
-	0xf4, 0x5f, 0xd8, 0xcb, 0x6b, 0xb9, 0x22, 0xed, 0xbd, 0xac, 0xff, 0x4d, 0x98, 0x6a, 0xa3, 0x43,
-	0xd3, 0x52, 0x10, 0xc1, 0xab, 0x6d, 0xc5, 0xe3, 0x21, 0x2f, 0x9c, 0xbf, 0xca, 0xe7, 0xf6, 0x67,
-	0xff, 0xbc, 0xb0, 0x78, 0x01, 0x05, 0xe2, 0x03, 0xa8, 0xa1, 0xb7, 0xd1, 0x61, 0xef, 0x54, 0x69,
-	0xf5, 0x4f, 0x53, 0x30, 0x7b, 0xaa, 0xfe, 0x08, 0xd5, 0x79, 0x1f, 0x66, 0xc3, 0x89, 0x05, 0xcf,
-	0xc7, 0x4d, 0x8a, 0xf9, 0x06, 0x9d, 0xaa, 0xf5, 0xcc, 0x04, 0x1d, 0x82, 0x97, 0xe3, 0x0d, 0xd9,
-	0xac, 0xbf, 0x06, 0xf9, 0xd8, 0xe5, 0x98, 0x5c, 0x50, 0xce, 0x18, 0x8f, 0x6e, 0xc7, 0xa8, 0xde,
-	0x85, 0xd9, 0xde, 0xc7, 0xea, 0xa6, 0x10, 0xb0, 0xdc, 0xa8, 0xa4, 0x85, 0x93, 0x79, 0x7f, 0x98,
-	0xbc, 0x86, 0x2b, 0xbe, 0x31, 0xdd, 0xf3, 0xc2, 0x3d, 0x32, 0x88, 0xaf, 0xc1, 0x8c, 0x4d, 0xe8,
-	0xb3, 0x2e, 0x72, 0xc8, 0x1e, 0xc1, 0x76, 0x5c, 0xcf, 0x32, 0x62, 0x92, 0x37, 0xe2, 0xcd, 0xa1,
-	0x8a, 0x55, 0xff, 0x33, 0x05, 0x93, 0xeb, 0x18, 0xaf, 0x12, 0x2a, 0x6f, 0x37, 0x88, 0xda, 0x14,
-	0x7d, 0x0b, 0x26, 0xa5, 0x4f, 0xb1, 0x55, 0x8b, 0xbc, 0x36, 0x4b, 0x78, 0x2d, 0x2e, 0xa0, 0x02,
-	0x1a, 0xe2, 0xd2, 0xec, 0x5b, 0x30, 0xc9, 0x4e, 0xc1, 0x4f, 0x98, 0xc7, 0xb0, 0x01, 0xfc, 0x06,
-	0x14, 0xd4, 0xe7, 0x0a, 0xa8, 0x2d, 0x0e, 0x5a, 0xd2, 0x89, 0xbe, 0x4f, 0xc8, 0x4b, 0x90, 0x9a,
-	0xc0, 0xe0, 0xa1, 0xfd, 0xc0, 0x73, 0xba, 0xed, 0xa4, 0x51, 0x59, 0x8d, 0xae, 0xfe, 0x7e, 0x2f,
-	0xd3, 0x1b, 0x56, 0x0b, 0xdb, 0x5d, 0x47, 0x3c, 0xc6, 0xdd, 0xed, 0x5a, 0x5c, 0x6e, 0xd1, 0x69,
-	0x5e, 0xc6, 0x18, 0x97, 0x75, 0xf2, 0x58, 0xe9, 0x2e, 0x4c, 0xa8, 0x2e, 0xe1, 0xa7, 0x0f, 0xf2,
-	0x9d, 0x4d, 0x51, 0x56, 0x87, 0xdf, 0x3a, 0xf4, 0xab, 0x6a, 0x7a, 0x50, 0x55, 0xb7, 0x00, 0x18,
-	0x51, 0x7b, 0xe8, 0xc0, 0x97, 0xdc, 0x1f, 0xa6, 0x9b, 0xa7, 0x28, 0x8a, 0x91, 0x63, 0xea, 0x17,
-	0x1d, 0xa6, 0x83, 0x23, 0xc3, 0x74, 0x70, 0x13, 0xf4, 0x3e, 0xe4, 0x66, 0x73, 0x43, 0xd7, 0x21,
-	0xc3, 0x82, 0x10, 0x96, 0x31, 0xc4, 0x6f, 0x1e, 0xd4, 0x19, 0x73, 0x06, 0xde, 0x18, 0xe5, 0x19,
-	0x73, 0xa2, 0x57, 0x01, 0x7f, 0xa5, 0x41, 0xfe, 0x13, 0xc1, 0x68, 0x03, 0x5b, 0x9e, 0x6f, 0xeb,
-	0x1f, 0x83, 0xbc, 0x6b, 0x36, 0x95, 0xf0, 0x92, 0x29, 0xf1, 0xb8, 0xc0, 0x90, 0xc0, 0x1c, 0x92,
-	0xc5, 0x21, 0x13, 0xde, 0x08, 0xb0, 0x08, 0xb2, 0xfa, 0x87, 0x1a, 0x14, 0x6b, 0x32, 0xee, 0x2b,
-	0x47, 0xa6, 0x97, 0x61, 0x4c, 0x65, 0x02, 0x2a, 0xa1, 0x08, 0x8a, 0x3a, 0x86, 0xb1, 0x57, 0xe8,
-	0x54, 0x03, 0xec, 0xea, 0xef, 0x68, 0x90, 0x17, 0xf9, 0xb4, 0xe4, 0x24, 0x3d, 0xef, 0xa1, 0xc8,
-	0x94, 0x83, 0x18, 0xa6, 0xcc, 0xe4, 0x4e, 0x4a, 0x64, 0x96, 0x5e, 0x34, 0xc3, 0xbb, 0xe7, 0x79,
-	0x3d, 0x45, 0xc4, 0xd0, 0x25, 0x48, 0x9c, 0x6e, 0xf5, 0x6b, 0x50, 0x88, 0xd2, 0xa2, 0xfa, 0x2a,
-	0xd5, 0xef, 0x40, 0xb1, 0x27, 0xbd, 0x93, 0x71, 0x3f, 0x6f, 0x14, 0xe2, 0xf9, 0x1d, 0xad, 0xfe,
-	0xad, 0x06, 0xe3, 0x31, 0x20, 0xfd, 0x16, 0xe4, 0xfa, 0x83, 0x57, 0x54, 0x71, 0x45, 0xdb, 0xd3,
-	0xf8, 0x86, 0x39, 0x7d, 0xb9, 0x0d, 0x73, 0xf5, 0x7b, 0x1a, 0x8c, 0xc8, 0xaf, 0x69, 0x7e, 0x0d,
-	0xb4, 0x4e, 0x42, 0xcd, 0xd5, 0x3a, 0x7c, 0xf4, 0xb3, 0x84, 0xab, 0xd2, 0x9e, 0x55, 0xff, 0x58,
-	0x83, 0x85, 0x5a, 0x70, 0x5e, 0x1e, 0xc9, 0xa1, 0xc7, 0xc8, 0x2e, 0x74, 0xd1, 0xbd, 0x0d, 0x45,
-	0xa5, 0x3e, 0xd2, 0x6e, 0x02, 0xdd, 0xb8, 0xc0, 0xab, 0x08, 0x45, 0xac, 0xd0, 0x8e, 0x95, 0x68,
-	0xf5, 0xfb, 0x1a, 0xdc, 0x0a, 0x67, 0x56, 0x3b, 0x65, 0x5a, 0x67, 0x9b, 0xd0, 0x95, 0xcf, 0x85,
-	0x42, 0x3e, 0xde, 0x3c, 0xdc, 0x56, 0xa2, 0x50, 0x22, 0x37, 0x1e, 0x43, 0xa9, 0xc6, 0x57, 0xa4,
-	0xf2, 0xb7, 0x20, 0x94, 0xd4, 0xf8, 0x16, 0xc4, 0xf5, 0xda, 0xab, 0xd8, 0x22, 0x6d, 0xe4, 0xd0,
-	0x33, 0xb6, 0x20, 0x73, 0x7c, 0x0b, 0x22, 0x7b, 0x08, 0x82, 0x19, 0x23, 0x2c, 0xdf, 0x63, 0x70,
-	0x6b, 0xd8, 0x57, 0x5e, 0x3a, 0xc0, 0xe8, 0x96, 0xb7, 0xeb, 0xd9, 0x47, 0xa5, 0x6b, 0x7a, 0x15,
-	0xe6, 0x97, 0xf1, 0x3e, 0x71, 0x97, 0x1d, 0xcf, 0x7a, 0x8a, 0xfd, 0x46, 0x1b, 0xf9, 0x6c, 0xc5,
-	0x73, 0x99, 0x8f, 0x2c, 0x46, 0xb7, 0x5d, 0xe7, 0xa8, 0xa4, 0xe9, 0xd3, 0xa0, 0x9f, 0x52, 0x9f,
-	0xd2, 0xf3, 0x90, 0x5d, 0x3b, 0xc0, 0xfe, 0x91, 0xe7, 0xe2, 0x52, 0xfa, 0x5e, 0x33, 0xe0, 0x96,
-	0x7c, 0xee, 0xa2, 0x4f, 0xc0, 0xf8, 0x63, 0x97, 0x76, 0xb0, 0x25, 0x82, 0x43, 0xe9, 0x1a, 0x27,
-	0x5b, 0x13, 0xfc, 0x28, 0x69, 0xfc, 0xf7, 0x0e, 0xea, 0x52, 0x6c, 0x97, 0x52, 0x7a, 0x11, 0x60,
-	0x15, 0xb7, 0x3d, 0x87, 0xd0, 0x16, 0xb6, 0x4b, 0x69, 0x7d, 0x1c, 0xc6, 0xc4, 0xb3, 0x55, 0x6c,
-	0x97, 0x32, 0xf7, 0xbe, 0x48, 0xa9, 0xc7, 0x17, 0xe2, 0x4c, 0xb6, 0x02, 0xe3, 0x8f, 0xb7, 0x1a,
-	0x3b, 0x6b, 0x2b, 0xf5, 0xf5, 0xfa, 0xda, 0x6a, 0xe9, 0xda, 0xdc, 0xc4, 0xf1, 0x49, 0x25, 0x5e,
-	0xc5, 0x77, 0xb2, 0xcb, 0x8f, 0x9f, 0x94, 0xb4, 0xb9, 0xb1, 0xe3, 0x93, 0x0a, 0xff, 0xc9, 0xc3,
-	0x4e, 0x63, 0x6d, 0x63, 0xa3, 0x94, 0x9a, 0xcb, 0x1e, 0x9f, 0x54, 0xc4, 0x6f, 0xce, 0xbd, 0x46,
-	0x73, 0x7b, 0xc7, 0xe4, 0x5d, 0xd3, 0x73, 0xf9, 0xe3, 0x93, 0x4a, 0x58, 0xe6, 0x1e, 0x45, 0xfc,
-	0x16, 0x83, 0x32, 0x73, 0x85, 0xe3, 0x93, 0x4a, 0x54, 0xc1, 0x47, 0x36, 0x6b, 0x1f, 0xad, 0x89,
-	0x91, 0x23, 0x72, 0x64, 0x50, 0xe6, 0x23, 0xc5, 0x6f, 0x31, 0x72, 0x54, 0x8e, 0x0c, 0x2b, 0xf4,
-	0x69, 0x18, 0x5d, 0x7e, 0xfc, 0xc4, 0xdc, 0xd9, 0x2e, 0x8d, 0xcd, 0xc1, 0xf1, 0x49, 0x45, 0x95,
-	0xb8, 0x42, 0xf3, 0x76, 0xde, 0x90, 0x9d, 0x1b, 0x3f, 0x3e, 0xa9, 0x04, 0x45, 0x7d, 0x1e, 0x80,
-	0xf7, 0xa9, 0x35, 0xb7, 0x37, 0xeb, 0x2b, 0xa5, 0xdc, 0x5c, 0xf1, 0xf8, 0xa4, 0x12, 0xab, 0xe1,
-	0xdc, 0x10, 0x5d, 0x55, 0x07, 0x90, 0xdc, 0x88, 0x55, 0xdd, 0xfb, 0x0b, 0x0d, 0x0a, 0x6b, 0xc1,
-	0xd9, 0x8a, 0xe0, 0xe0, 0x2d, 0x28, 0xc7, 0xa4, 0xd2, 0xd3, 0x26, 0x45, 0x24, 0x65, 0x58, 0xd2,
-	0xf4, 0x02, 0xe4, 0xc4, 0x9d, 0xca, 0x3a, 0x71, 0x9c, 0x52, 0x4a, 0x9f, 0x83, 0x69, 0x51, 0xdc,
-	0x44, 0xcc, 0x6a, 0x19, 0xf2, 0x3b, 0x4c, 0x21, 0x98, 0x52, 0x9a, 0x2b, 0x48, 0xd4, 0xb6, 0x85,
-	0x9f, 0xcb, 0xfa, 0x8c, 0x7e, 0x03, 0xae, 0xab, 0xcf, 0xb9, 0xd4, 0x07, 0x95, 0xc4, 0x73, 0x4b,
-	0x23, 0x1c, 0x4a, 0xbe, 0x4b, 0xee, 0x7f, 0xba, 0x58, 0x1a, 0xbd, 0xf7, 0xfd, 0x40, 0xde, 0x9b,
-	0x88, 0x3e, 0xe5, 0x3c, 0x7b, 0xbc, 0xf5, 0xb8, 0x21, 0x44, 0x2d, 0x78, 0x26, 0x4b, 0x5c, 0xca,
-	0xb5, 0xad, 0x50, 0xca, 0xb5, 0xad, 0x27, 0x9c, 0x8b, 0xc6, 0xda, 0x07, 0x8f, 0x37, 0x6a, 0x46,
-	0x29, 0x25, 0xb9, 0xa8, 0x8a, 0x9c, 0x4b, 0x2b, 0xdb, 0x5b, 0xab, 0xf5, 0x66, 0x7d, 0x7b, 0xab,
-	0xc6, 0x25, 0x2a, 0xb8, 0x14, 0xab, 0xd2, 0x97, 0x60, 0x66, 0xb5, 0x6e, 0xac, 0xad, 0xf0, 0x22,
-	0x17, 0xa4, 0xb9, 0x6d, 0x98, 0x8f, 0xea, 0x1f, 0x3c, 0x5a, 0x33, 0x4a, 0xd9, 0xb9, 0xeb, 0xc7,
-	0x27, 0x95, 0x42, 0x4f, 0x65, 0x6f, 0x7f, 0xc1, 0xee, 0x6d, 0xc3, 0xdc, 0xd8, 0xfe, 0xc6, 0x9a,
-	0x51, 0x2a, 0xc9, 0xfe, 0x3d, 0x95, 0xfa, 0x4d, 0x18, 0x6f, 0x3e, 0xd9, 0x59, 0x33, 0x37, 0x6b,
-	0xc6, 0x47, 0x6b, 0xcd, 0x52, 0x45, 0x2e, 0x45, 0x96, 0xf4, 0x59, 0x00, 0xd1, 0xb8, 0x51, 0xdf,
-	0xac, 0x37, 0x4b, 0x0f, 0xe7, 0x72, 0xc7, 0x27, 0x95, 0x11, 0x51, 0x58, 0x6e, 0xfd, 0xf8, 0xc5,
-	0xbc, 0xf6, 0x93, 0x17, 0xf3, 0xda, 0xbf, 0xbc, 0x98, 0xd7, 0xfe, 0xe0, 0xcb, 0xf9, 0x6b, 0x3f,
-	0xf9, 0x72, 0xfe, 0xda, 0xdf, 0x7f, 0x39, 0x7f, 0xed, 0xd7, 0xb7, 0x62, 0xae, 0xbe, 0x1e, 0xb8,
-	0x99, 0x0d, 0xb4, 0x4b, 0xef, 0x87, 0x4e, 0xe7, 0x1d, 0xcb, 0xf3, 0x71, 0xbc, 0xd8, 0x42, 0xc4,
-	0xbd, 0xdf, 0xf6, 0x78, 0x5e, 0x4a, 0xa3, 0xff, 0x1b, 0x21, 0xc2, 0xc2, 0xee, 0xa8, 0xf8, 0x3c,
-	0xf0, 0x97, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x09, 0xcb, 0x9d, 0x5a, 0x42, 0x00, 0x00,
+	// 5464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x5b, 0x6c, 0x24, 0x49,
+	0x56, 0x76, 0x67, 0x95, 0x6f, 0x75, 0xaa, 0xca, 0x2e, 0x87, 0x6f, 0xe5, 0x4b, 0xdb, 0xd5, 0xd5,
+	0xd3, 0xd3, 0x9e, 0xee, 0x69, 0xf7, 0xf4, 0xec, 0xff, 0xaf, 0x96, 0x11, 0x0b, 0xe3, 0xeb, 0x74,
+	0xcd, 0xf8, 0xd6, 0x59, 0xee, 0x19, 0x7a, 0x87, 0xd9, 0x9c, 0x70, 0x66, 0xd8, 0x95, 0xd3, 0x79,
+	0xa9, 0xce, 0xc8, 0x72, 0xdb, 0x83, 0x90, 0x56, 0xbb, 0x08, 0xb1, 0x0d, 0xd2, 0x02, 0x12, 0xb0,
+	0x2f, 0x2d, 0xed, 0xeb, 0x22, 0x04, 0x3c, 0x20, 0x1e, 0x18, 0x78, 0x66, 0xc5, 0xd3, 0x4a, 0xbc,
+	0x20, 0x84, 0x16, 0x34, 0xf3, 0x82, 0x90, 0x40, 0x2c, 0x0f, 0x48, 0x08, 0x09, 0xa1, 0xb8, 0xe4,
+	0xad, 0xaa, 0x5c, 0x76, 0xa7, 0xdd, 0x5a, 0x16, 0xf1, 0x54, 0x95, 0x71, 0xf9, 0x4e, 0xc4, 0x39,
+	0x27, 0xce, 0x39, 0x71, 0x22, 0x32, 0xe1, 0x35, 0xd3, 0xf9, 0x84, 0xe8, 0xbe, 0x79, 0x44, 0xee,
+	0x92, 0x63, 0xbd, 0x81, 0x9d, 0x43, 0x72, 0xf7, 0xe8, 0xde, 0x3e, 0xf1, 0xf1, 0xbd, 0xb0, 0x60,
+	0xa9, 0xe9, 0xb9, 0xbe, 0x8b, 0x66, 0xc2, 0xa6, 0x4b, 0x61, 0x8d, 0x6c, 0x3a, 0x33, 0x7e, 0xe8,
+	0x1e, 0xba, 0xbc, 0xd9, 0x5d, 0xf6, 0x4f, 0xf4, 0x98, 0x99, 0xd7, 0x5d, 0x6a, 0xbb, 0xf4, 0xee,
+	0x3e, 0xa6, 0x11, 0xaa, 0xee, 0x9a, 0x8e, 0xac, 0xbf, 0x11, 0x11, 0x77, 0x3d, 0xac, 0x5b, 0x51,
+	0x23, 0xf1, 0x28, 0x9a, 0x55, 0xbf, 0xff, 0x0a, 0x0c, 0xec, 0x62, 0x0f, 0xdb, 0x14, 0x11, 0x58,
+	0xa0, 0x4d, 0xd7, 0xd7, 0x6c, 0xec, 0x3d, 0x26, 0xbe, 0x66, 0x3a, 0xd4, 0xc7, 0x8e, 0xaf, 0x59,
+	0x26, 0xf5, 0x4d, 0xe7, 0x50, 0x3b, 0x20, 0xa4, 0xac, 0x54, 0x94, 0xc5, 0xfc, 0x9b, 0xd3, 0x4b,
+	0x82, 0xf6, 0x12, 0xa3, 0x1d, 0x0c, 0x73, 0x69, 0xd5, 0x35, 0x9d, 0x95, 0xbe, 0x1f, 0xfc, 0x68,
+	0xe1, 0x8a, 0x3a, 0xcb, 0x70, 0xb6, 0x38, 0x4c, 0x4d, 0xa0, 0x6c, 0x0a, 0x90, 0x0d, 0x42, 0xd0,
+	0x13, 0xb8, 0x61, 0x10, 0xcf, 0x3c, 0xc2, 0x6c, 0x6c, 0xbd, 0x88, 0x65, 0xce, 0x47, 0xec, 0x5a,
+	0x84, 0x76, 0x1a, 0x49, 0x0b, 0x66, 0x0d, 0x72, 0x80, 0x5b, 0x96, 0xaf, 0xc9, 0x19, 0x3e, 0x26,
+	0x1e, 0xa3, 0xa1, 0x79, 0xd8, 0x27, 0xe5, 0x6c, 0x45, 0x59, 0xcc, 0xad, 0x2c, 0x31, 0xb4, 0xbf,
+	0xfd, 0xd1, 0xc2, 0xab, 0x87, 0xa6, 0xdf, 0x68, 0xed, 0x2f, 0xe9, 0xae, 0x7d, 0x57, 0xf2, 0x58,
+	0xfc, 0xdc, 0xa1, 0xc6, 0xe3, 0xbb, 0xfe, 0x49, 0x93, 0xd0, 0xa5, 0x35, 0xa2, 0xab, 0x53, 0x12,
+	0xb2, 0xce, 0xe7, 0xfa, 0x98, 0x78, 0x1b, 0x84, 0xa8, 0xd8, 0xef, 0xa4, 0xe6, 0x27, 0xa9, 0xf5,
+	0x5d, 0x98, 0xda, 0x5e, 0x9c, 0xda, 0x31, 0x5c, 0x0b, 0xa8, 0x25, 0xd8, 0x9a, 0xa0, 0xd9, 0x9f,
+	0x8a, 0xe6, 0x55, 0x09, 0xbc, 0x16, 0x63, 0xf0, 0x99, 0x94, 0xdb, 0x66, 0x3b, 0x70, 0x49, 0x94,
+	0x13, 0x73, 0x76, 0x61, 0x2e, 0xa0, 0x6c, 0x3a, 0xa6, 0x6f, 0x62, 0x8b, 0xe9, 0xd1, 0xa1, 0xe9,
+	0x30, 0x9a, 0xa6, 0x5b, 0x1e, 0x4c, 0x45, 0x74, 0x5a, 0x62, 0xd6, 0x04, 0xe4, 0x16, 0x47, 0x54,
+	0x19, 0x20, 0x7a, 0x0a, 0x95, 0x80, 0xa0, 0x8d, 0x4d, 0xc7, 0x27, 0x0e, 0x76, 0x74, 0x92, 0x24,
+	0x3a, 0x74, 0xa1, 0x99, 0x6e, 0x45, 0xb0, 0x71, 0xc2, 0x5f, 0x81, 0x72, 0x40, 0xf8, 0xa0, 0xe5,
+	0x18, 0x6c, 0x69, 0xb0, 0x76, 0xde, 0x11, 0xb6, 0xca, 0xb9, 0x8a, 0xb2, 0x98, 0x55, 0x27, 0x65,
+	0xfd, 0x86, 0xa8, 0xae, 0xc9, 0x5a, 0xf4, 0x1a, 0x94, 0x82, 0x1e, 0x76, 0xcb, 0xf2, 0xcd, 0xa6,
+	0x45, 0xca, 0xc0, 0x7b, 0x8c, 0xc8, 0xf2, 0x2d, 0x59, 0x8c, 0x74, 0x98, 0xf4, 0x88, 0x85, 0x4f,
+	0xa4, 0xdc, 0x68, 0x03, 0x7b, 0x52, 0x7a, 0xf9, 0x54, 0x73, 0x1a, 0x93, 0x68, 0x1b, 0x84, 0xd4,
+	0x19, 0x16, 0x97, 0x99, 0x0f, 0x0b, 0xc1, 0x4c, 0x1a, 0x6e, 0xcb, 0xb3, 0x4e, 0xc2, 0x09, 0x31,
+	0x4a, 0x9a, 0x8e, 0x9b, 0xe5, 0x42, 0x2a, 0x6a, 0xc1, 0x62, 0xbb, 0xcf, 0x51, 0x25, 0x1b, 0x18,
+	0xc9, 0x55, 0xdc, 0x8c, 0x6b, 0x8a, 0xa4, 0xca, 0xd9, 0x47, 0xa8, 0x2f, 0x26, 0x58, 0xbc, 0x90,
+	0xa6, 0x08, 0x92, 0x35, 0x89, 0xc8, 0xa7, 0xb9, 0x06, 0x0b, 0x36, 0x3e, 0x8e, 0x2f, 0x08, 0xd7,
+	0x33, 0x88, 0xa7, 0x51, 0xd3, 0x20, 0x9a, 0xee, 0xb6, 0x1c, 0xbf, 0x3c, 0x5c, 0x51, 0x16, 0x8b,
+	0xea, 0xac, 0x8d, 0x8f, 0x23, 0xf5, 0xde, 0x61, 0x8d, 0xea, 0xa6, 0x41, 0x56, 0x59, 0x13, 0xf4,
+	0x2b, 0x0a, 0xdc, 0x34, 0x9d, 0x4f, 0x34, 0x8f, 0x3c, 0xc5, 0x9e, 0xa1, 0x51, 0xb6, 0xa8, 0x0c,
+	0xcd, 0x23, 0x4f, 0x5a, 0xa6, 0x47, 0x6c, 0xe2, 0xf8, 0x9a, 0xdf, 0xf0, 0x08, 0x6d, 0xb8, 0x96,
+	0x51, 0x1e, 0x79, 0xe1, 0x29, 0xd4, 0x1c, 0x5f, 0xbd, 0x6e, 0x3a, 0x9f, 0xa8, 0x1c, 0xbd, 0xce,
+	0xc1, 0xd5, 0x08, 0x7b, 0x2f, 0x80, 0x46, 0xef, 0x40, 0xc5, 0xf7, 0xb0, 0x10, 0x12, 0x6f, 0x4b,
+	0xb5, 0x23, 0x22, 0x0c, 0xb4, 0xd1, 0xe2, 0x5a, 0xef, 0x94, 0x4b, 0x5c, 0xa7, 0xae, 0xca, 0x76,
+	0x02, 0x92, 0xbe, 0x2f, 0x5a, 0xad, 0xc9, 0x46, 0x4c, 0x0c, 0x96, 0xf9, 0xa4, 0x65, 0x1a, 0xd8,
+	0x77, 0xbd, 0x70, 0x56, 0x91, 0x9e, 0x8d, 0xa6, 0x13, 0x43, 0x84, 0x29, 0xa7, 0x12, 0x6a, 0xdb,
+	0x31, 0xbc, 0xb6, 0x6f, 0x3a, 0xd8, 0x3b, 0xd1, 0xdc, 0x26, 0x1b, 0x01, 0xed, 0xe5, 0x68, 0xd0,
+	0xf9, 0x1c, 0xcd, 0x2b, 0x02, 0x71, 0x47, 0x00, 0x9e, 0xe6, 0x6b, 0xbe, 0xa1, 0x40, 0x05, 0xfb,
+	0xae, 0x6d, 0xea, 0x01, 0x49, 0xa1, 0x00, 0x58, 0xd7, 0x09, 0xa5, 0x9a, 0x45, 0x8e, 0x88, 0x55,
+	0x1e, 0xab, 0x28, 0x8b, 0xc3, 0x6f, 0x7e, 0x65, 0xe9, 0x74, 0xaf, 0xbf, 0xb4, 0xcc, 0x31, 0x04,
+	0x15, 0xae, 0x1d, 0xcb, 0x1c, 0x60, 0x93, 0xf5, 0x57, 0xe7, 0x70, 0x8f, 0x5a, 0xf4, 0x2d, 0x05,
+	0x6e, 0x72, 0xcf, 0xd3, 0x6d, 0x1c, 0x6c, 0x85, 0x4b, 0x83, 0x60, 0x12, 0xaf, 0x3c, 0x9e, 0x8a,
+	0xf3, 0x55, 0x06, 0xdf, 0x31, 0xc2, 0x0d, 0x42, 0xb6, 0x42, 0x64, 0xf4, 0x1d, 0x05, 0xee, 0xc4,
+	0x96, 0xc1, 0x39, 0xc6, 0x32, 0x91, 0x6a, 0x2c, 0x8b, 0x11, 0x91, 0x33, 0x46, 0xf4, 0xbb, 0x0a,
+	0xdc, 0x6b, 0xd3, 0x8a, 0x73, 0x8c, 0x6a, 0x32, 0xd5, 0xa8, 0x6e, 0x27, 0x94, 0xe5, 0x8c, 0x81,
+	0x99, 0x30, 0x6d, 0x9b, 0x8e, 0x69, 0x63, 0x4b, 0xe3, 0x51, 0x99, 0xee, 0x5a, 0x91, 0x07, 0x9d,
+	0x4a, 0x45, 0x7f, 0x52, 0x02, 0xee, 0x4a, 0xbc, 0xc0, 0x75, 0x7e, 0x08, 0xb7, 0x4d, 0x1a, 0xae,
+	0x82, 0xce, 0x40, 0xcc, 0xc2, 0x2d, 0x47, 0x6f, 0x68, 0xc4, 0xc1, 0xfb, 0x16, 0x31, 0xca, 0xe5,
+	0x8a, 0xb2, 0x38, 0xa4, 0xbe, 0x6a, 0x52, 0xa9, 0xe8, 0x6b, 0x6d, 0xb1, 0xd6, 0x26, 0x6f, 0xbe,
+	0x2e, 0x5a, 0x33, 0xe3, 0xd7, 0x74, 0xa9, 0xaf, 0xb9, 0x8e, 0x75, 0xa2, 0xd9, 0xae, 0x41, 0xb4,
+	0x06, 0x31, 0x0f, 0x1b, 0x71, 0x6b, 0x35, 0xcd, 0xcd, 0xc5, 0x2c, 0x6b, 0xb6, 0xe3, 0x58, 0x27,
+	0x5b, 0xae, 0x41, 0xee, 0xf3, 0x36, 0x91, 0xd5, 0x59, 0x81, 0x79, 0x66, 0x42, 0xdd, 0x26, 0x71,
+	0x84, 0x44, 0xa8, 0xd6, 0x64, 0x16, 0xb4, 0xb5, 0x8f, 0x75, 0x61, 0x41, 0x67, 0xb8, 0x05, 0x9d,
+	0xb1, 0xf1, 0xf1, 0x4e, 0x93, 0x38, 0x9c, 0xa1, 0x74, 0x97, 0x78, 0xf5, 0xb0, 0x05, 0xfa, 0x39,
+	0x98, 0x63, 0x18, 0xe4, 0xb8, 0x69, 0x7a, 0xc4, 0x88, 0xc3, 0xec, 0x5b, 0xae, 0xfe, 0xb8, 0x3c,
+	0xcb, 0x11, 0xca, 0x36, 0x3e, 0x5e, 0x17, 0x4d, 0x42, 0x90, 0x15, 0x56, 0x8f, 0x7e, 0x06, 0xa6,
+	0x13, 0xee, 0xa9, 0x61, 0x52, 0xdf, 0xf5, 0x4e, 0x34, 0x6a, 0x7e, 0x4a, 0xca, 0x73, 0xbc, 0xf3,
+	0xe4, 0x41, 0xe4, 0x6a, 0xee, 0x8b, 0xea, 0xba, 0xf9, 0x29, 0x41, 0xaf, 0x03, 0x62, 0xa4, 0xb1,
+	0x1e, 0x63, 0x2b, 0x2d, 0x5f, 0xe5, 0x7d, 0x4a, 0x36, 0x3e, 0x5e, 0xd6, 0x23, 0xf6, 0x51, 0xb4,
+	0x03, 0x63, 0x92, 0xf3, 0xba, 0x47, 0xb8, 0xb1, 0xe4, 0x26, 0x69, 0xfe, 0x7c, 0x26, 0x69, 0x54,
+	0xf4, 0x5d, 0x95, 0x5d, 0x99, 0xfd, 0xf9, 0x10, 0xa6, 0x85, 0x1a, 0x37, 0x2d, 0xac, 0x0b, 0x5f,
+	0x41, 0x5b, 0x9e, 0xde, 0xc0, 0xde, 0x21, 0x29, 0x2f, 0x9c, 0x0f, 0x76, 0x8a, 0x23, 0xec, 0x06,
+	0x00, 0xf5, 0xa0, 0x3f, 0xfa, 0x18, 0xc6, 0x69, 0x13, 0xdb, 0x5c, 0x39, 0x0d, 0x6e, 0xe3, 0x85,
+	0x13, 0xa8, 0x70, 0x7b, 0xb6, 0xd4, 0xcb, 0x9e, 0xd5, 0x9b, 0xd8, 0xde, 0x20, 0x64, 0x2d, 0xea,
+	0xa5, 0x22, 0xda, 0x51, 0x86, 0x7e, 0x1e, 0xe6, 0x4c, 0xaa, 0xe1, 0x96, 0xef, 0x6a, 0x06, 0x61,
+	0xc6, 0xd2, 0xc3, 0x87, 0x4c, 0x0a, 0x81, 0x42, 0x5e, 0xe3, 0x0a, 0x39, 0x6d, 0xd2, 0xe5, 0x96,
+	0xef, 0xae, 0xc5, 0x5a, 0x04, 0x3a, 0xb8, 0x0e, 0x0b, 0x82, 0x29, 0x9a, 0xe9, 0x70, 0x19, 0x98,
+	0xfe, 0x09, 0x83, 0x32, 0xa9, 0x2f, 0x64, 0x4f, 0xcb, 0x55, 0xae, 0x83, 0x73, 0xb6, 0xb4, 0xe0,
+	0x41, 0xab, 0x35, 0xde, 0x88, 0xcb, 0x9f, 0xa2, 0xaf, 0xc2, 0xac, 0x88, 0xa1, 0x3d, 0xb2, 0xcf,
+	0x14, 0x80, 0x34, 0x5d, 0xbd, 0x11, 0x79, 0xbd, 0xeb, 0x1c, 0xa2, 0xcc, 0x9b, 0xa8, 0xbc, 0xc5,
+	0x3a, 0x6b, 0x10, 0x3a, 0xbc, 0xaf, 0xc1, 0x68, 0xa2, 0x3b, 0x5f, 0xc9, 0xaf, 0xa4, 0x5a, 0xc9,
+	0x23, 0x31, 0x22, 0x7c, 0x09, 0x7f, 0x02, 0xe5, 0x04, 0x76, 0xd3, 0x75, 0x2d, 0x8d, 0xba, 0x2d,
+	0x4f, 0x27, 0xe5, 0x1b, 0x5c, 0x10, 0xf7, 0x7a, 0x09, 0x62, 0x2b, 0x82, 0xdb, 0x75, 0x5d, 0xab,
+	0xce, 0x3b, 0xaa, 0x13, 0x76, 0xb7, 0x62, 0xf4, 0x06, 0x8c, 0xf3, 0x90, 0x90, 0xf8, 0xbe, 0x25,
+	0x94, 0xc9, 0x20, 0x8e, 0x6b, 0x97, 0x5f, 0x65, 0x53, 0x51, 0xd1, 0x01, 0x21, 0xf5, 0xb0, 0x6a,
+	0x8d, 0xd5, 0x20, 0x0c, 0x33, 0x6d, 0x3d, 0xc4, 0x7e, 0x53, 0x63, 0x33, 0x2a, 0xdf, 0xe4, 0xe3,
+	0x7b, 0x25, 0x36, 0x3e, 0x51, 0x1b, 0x8e, 0x6e, 0x87, 0x3f, 0xee, 0x9d, 0x34, 0x89, 0x3a, 0x95,
+	0x40, 0x8f, 0x2a, 0xd8, 0xe2, 0x6e, 0x23, 0xc1, 0x16, 0x5c, 0xd3, 0x33, 0x75, 0xa2, 0xe1, 0x43,
+	0x52, 0x5e, 0x14, 0xc2, 0x49, 0x74, 0xdf, 0xc2, 0xc7, 0xbb, 0xac, 0xc1, 0xf2, 0x21, 0x41, 0x07,
+	0x30, 0xd5, 0xd6, 0x9f, 0x5a, 0x66, 0xb3, 0xc9, 0xba, 0xbe, 0x96, 0x4a, 0x44, 0x13, 0x09, 0x52,
+	0x75, 0x09, 0x86, 0x56, 0x61, 0x5e, 0x2c, 0xc5, 0xc0, 0x7a, 0x78, 0xc4, 0x27, 0x0e, 0x5f, 0xe3,
+	0x52, 0x13, 0x6f, 0x09, 0x6b, 0xc8, 0x5b, 0x49, 0x1b, 0xa2, 0x06, 0x6d, 0xa4, 0x22, 0x7e, 0x0a,
+	0x23, 0x46, 0x8b, 0xc6, 0x4c, 0x28, 0x2d, 0xdf, 0xae, 0x64, 0x17, 0xf3, 0x6f, 0xce, 0x75, 0x5d,
+	0xc5, 0x6b, 0x44, 0xe7, 0x0b, 0xf9, 0x4b, 0x6c, 0x0a, 0xbf, 0xff, 0xf7, 0x0b, 0xb7, 0xcf, 0x37,
+	0x05, 0xd6, 0x87, 0xaa, 0xc3, 0x8c, 0x52, 0x68, 0x88, 0x29, 0x32, 0x60, 0x92, 0xd3, 0xa6, 0x4f,
+	0x09, 0x69, 0x26, 0x16, 0xfc, 0xeb, 0xa9, 0x16, 0xfc, 0x38, 0x43, 0xab, 0x33, 0xb0, 0xf8, 0x92,
+	0x7f, 0x07, 0xae, 0xc5, 0xa8, 0x88, 0xe8, 0xb9, 0xe9, 0x52, 0xd3, 0x8f, 0x1b, 0xec, 0x3b, 0xdc,
+	0x7e, 0xce, 0x85, 0x00, 0x5b, 0x2c, 0x7a, 0x16, 0xad, 0x42, 0xa3, 0xbd, 0x0b, 0x37, 0x58, 0x6f,
+	0xdd, 0x75, 0x0c, 0x93, 0x21, 0x63, 0xeb, 0x14, 0xff, 0xb1, 0xc4, 0xc1, 0xae, 0xd9, 0xf8, 0x78,
+	0x35, 0x6a, 0xdb, 0xcd, 0x8d, 0xac, 0xc3, 0x42, 0xd4, 0x2d, 0xdc, 0xb0, 0x24, 0x9c, 0xc1, 0x5d,
+	0x31, 0xb0, 0xa8, 0x99, 0xdc, 0x81, 0xc4, 0x5d, 0x82, 0x2f, 0x36, 0x05, 0x89, 0x28, 0x23, 0x50,
+	0x39, 0xb9, 0x7b, 0x7c, 0x23, 0xdd, 0xde, 0xc7, 0xc6, 0xc7, 0xb1, 0xa8, 0x22, 0xd0, 0x3c, 0xb1,
+	0x77, 0xac, 0x41, 0x35, 0x61, 0x27, 0xd8, 0x10, 0x8e, 0x5c, 0xab, 0x65, 0x93, 0x38, 0x63, 0xef,
+	0xf1, 0xf1, 0x5f, 0x8d, 0x2d, 0xff, 0x2d, 0x7c, 0xfc, 0xbe, 0x68, 0x16, 0x70, 0xf6, 0xad, 0xbe,
+	0x7f, 0xfc, 0xde, 0x82, 0x52, 0x7d, 0x00, 0xc5, 0x3d, 0x11, 0xe6, 0x7f, 0x60, 0x3a, 0x86, 0xfb,
+	0x14, 0x5d, 0x83, 0x02, 0xf5, 0xb1, 0xe7, 0x6b, 0x94, 0x30, 0xa6, 0xf3, 0xf4, 0x50, 0x51, 0xcd,
+	0xf3, 0xb2, 0x3a, 0x2f, 0x42, 0x57, 0x01, 0x88, 0x63, 0x04, 0x0d, 0x32, 0xbc, 0x41, 0x8e, 0x38,
+	0x86, 0xa8, 0xae, 0xfe, 0x99, 0x02, 0x13, 0x62, 0x02, 0x12, 0xb9, 0xae, 0x37, 0x88, 0xd1, 0xb2,
+	0x08, 0x9a, 0x85, 0x5c, 0x60, 0xc7, 0x05, 0x70, 0x4e, 0x1d, 0x92, 0x16, 0xdb, 0x40, 0x35, 0x18,
+	0x7c, 0xca, 0x87, 0x40, 0xcb, 0x19, 0xbe, 0x18, 0x5e, 0xeb, 0xa5, 0x89, 0x89, 0x41, 0x4b, 0x17,
+	0x17, 0xf4, 0x47, 0x5f, 0x82, 0x49, 0x9d, 0xed, 0xba, 0x43, 0x5d, 0xc1, 0xbe, 0xa6, 0x5b, 0x2e,
+	0x15, 0x69, 0xa1, 0x21, 0x75, 0x4c, 0xd4, 0x0a, 0xed, 0x58, 0xf6, 0x57, 0x59, 0xd5, 0x5b, 0x7d,
+	0xbf, 0xf6, 0xbd, 0x85, 0x2b, 0xd5, 0xe7, 0x0a, 0x94, 0x38, 0x7f, 0x18, 0x01, 0x22, 0x78, 0x86,
+	0xe6, 0x20, 0xe7, 0x9b, 0x36, 0xa1, 0x3e, 0xb6, 0x9b, 0x7c, 0xdc, 0x59, 0x35, 0x2a, 0x40, 0x8f,
+	0x61, 0x50, 0x8a, 0xa0, 0x9c, 0x79, 0x59, 0xab, 0x38, 0xa0, 0x50, 0xfd, 0x8e, 0x02, 0x63, 0x82,
+	0xb9, 0xc9, 0x70, 0xb3, 0x27, 0x6b, 0x1f, 0xc2, 0x70, 0x5b, 0x00, 0x9c, 0x49, 0xa5, 0x9a, 0xc5,
+	0x83, 0x38, 0x4d, 0xc9, 0xb1, 0x1f, 0xe7, 0xa1, 0xd4, 0x1e, 0x42, 0xa2, 0x49, 0x18, 0xf0, 0x4d,
+	0xfd, 0x31, 0xf1, 0xe4, 0x58, 0xe4, 0x13, 0x5a, 0x80, 0xbc, 0x74, 0x1d, 0x8c, 0x37, 0x62, 0x18,
+	0x2a, 0x88, 0xa2, 0x15, 0x4c, 0x09, 0x53, 0x3f, 0xd9, 0xe0, 0x49, 0xcb, 0x0d, 0xf2, 0x78, 0xaa,
+	0xec, 0xf4, 0x80, 0x15, 0xa1, 0xf5, 0x10, 0x83, 0xbb, 0x9f, 0xbe, 0x17, 0x70, 0x3f, 0xe0, 0x86,
+	0xff, 0xd1, 0x12, 0x8c, 0x49, 0x18, 0xaa, 0x63, 0x8b, 0x68, 0x07, 0x58, 0xf7, 0x5d, 0x8f, 0xa7,
+	0xd5, 0x8a, 0xea, 0xa8, 0xa8, 0xaa, 0xb3, 0x9a, 0x0d, 0x5e, 0xc1, 0x86, 0xce, 0x87, 0x24, 0xbd,
+	0xe5, 0x80, 0x18, 0x3a, 0x2f, 0x12, 0x5e, 0x32, 0x21, 0x82, 0xc1, 0x36, 0x11, 0x7c, 0x0c, 0xe3,
+	0x5d, 0xd3, 0x5a, 0xe9, 0x32, 0x4c, 0xc8, 0xec, 0xcc, 0x67, 0x35, 0x58, 0x08, 0x71, 0x4a, 0x1e,
+	0x2b, 0x97, 0x72, 0xbf, 0xd1, 0x3d, 0x81, 0xb5, 0x07, 0xc3, 0x6d, 0xb9, 0x48, 0x48, 0x85, 0x5f,
+	0xb0, 0xe3, 0x09, 0xc0, 0x3d, 0x18, 0x6e, 0xcb, 0x33, 0xa6, 0xcb, 0x54, 0x15, 0xfc, 0x38, 0xea,
+	0xe9, 0x79, 0xb0, 0xc2, 0xe5, 0xe5, 0xc1, 0x2a, 0x90, 0x37, 0x99, 0x61, 0x6d, 0x12, 0xbf, 0x85,
+	0x2d, 0x9e, 0x80, 0x1a, 0x52, 0xe3, 0x45, 0xe8, 0x6d, 0x18, 0xa0, 0x3e, 0xf6, 0x5b, 0x94, 0x67,
+	0x8a, 0x86, 0xdf, 0x5c, 0xec, 0x1d, 0xcd, 0x31, 0xa5, 0xa9, 0xf3, 0xf6, 0xaa, 0xec, 0x87, 0x3e,
+	0x82, 0x31, 0xdb, 0x74, 0x64, 0x44, 0xc4, 0x56, 0x93, 0x70, 0x55, 0x23, 0xa9, 0x66, 0x51, 0xb2,
+	0x4d, 0x87, 0x87, 0x4e, 0x7b, 0xa6, 0xfe, 0x98, 0xbb, 0x33, 0x1d, 0xd8, 0xee, 0x52, 0x7b, 0xd2,
+	0xc2, 0x8e, 0xcf, 0xa2, 0xeb, 0x88, 0x42, 0x29, 0x1d, 0x9f, 0x6c, 0xd3, 0x79, 0x20, 0xc1, 0x42,
+	0x22, 0x3c, 0x82, 0x96, 0xbb, 0xc0, 0x20, 0x67, 0x97, 0x32, 0x4f, 0x34, 0x22, 0x37, 0x8a, 0x41,
+	0xa2, 0x2e, 0xc0, 0xe6, 0xe1, 0x03, 0x8b, 0xc6, 0xf8, 0xd8, 0x51, 0x6a, 0xec, 0x5d, 0x89, 0xc3,
+	0xc7, 0xfd, 0x0b, 0x50, 0x12, 0x7c, 0xdf, 0xc7, 0x8e, 0x21, 0x97, 0xd4, 0x58, 0x2a, 0xe8, 0x61,
+	0x8e, 0xb3, 0x82, 0x1d, 0x43, 0x2c, 0xa5, 0x07, 0x50, 0x60, 0xa3, 0x96, 0x5b, 0x1e, 0x92, 0x32,
+	0x75, 0x93, 0xb7, 0xf1, 0xf1, 0xa6, 0x84, 0x40, 0xbf, 0x28, 0xf6, 0xaa, 0x6d, 0xb1, 0xc8, 0x44,
+	0x4a, 0x3d, 0xc1, 0xc7, 0x89, 0x00, 0x44, 0xda, 0xfc, 0x6f, 0xe6, 0x60, 0x6c, 0xa5, 0x33, 0x73,
+	0x76, 0xaa, 0xd9, 0xbf, 0x0e, 0xc5, 0xc0, 0xd6, 0x9e, 0xd8, 0xfb, 0xae, 0x25, 0x0d, 0xbf, 0x34,
+	0xf5, 0x75, 0x5e, 0x86, 0x6e, 0xc2, 0x88, 0x6c, 0xd4, 0xf4, 0xdc, 0x23, 0xd3, 0x20, 0x9e, 0xb4,
+	0xfe, 0xc3, 0xa2, 0x78, 0x57, 0x96, 0xfe, 0xa4, 0x1c, 0xc0, 0x3d, 0x18, 0xe7, 0xb9, 0x07, 0xb1,
+	0xa3, 0x8f, 0x02, 0x82, 0x01, 0x1e, 0x10, 0x8c, 0x45, 0x75, 0x7b, 0x41, 0x15, 0xeb, 0x12, 0xdb,
+	0x91, 0x44, 0x5d, 0x06, 0x45, 0x97, 0xa8, 0x2e, 0xea, 0x32, 0x0e, 0xfd, 0xd8, 0xb0, 0x4d, 0x47,
+	0x78, 0x06, 0x55, 0x3c, 0xb4, 0x3b, 0x9f, 0x5c, 0x6f, 0xe7, 0x03, 0x6d, 0xce, 0xa7, 0xd3, 0x60,
+	0xe7, 0x5f, 0x8a, 0xc1, 0x2e, 0xbc, 0x54, 0x83, 0x5d, 0xbc, 0x3c, 0x83, 0xfd, 0x7f, 0xe6, 0x98,
+	0x11, 0x79, 0x04, 0xa5, 0x98, 0x76, 0xf2, 0xa9, 0xc4, 0xac, 0xb1, 0xf2, 0x22, 0x16, 0x33, 0xc2,
+	0xe1, 0xf3, 0x38, 0xc5, 0x08, 0xa1, 0x4b, 0x35, 0x42, 0xff, 0x99, 0x81, 0x29, 0x9e, 0xe9, 0x3b,
+	0xd9, 0x68, 0xf9, 0x2d, 0x8f, 0x84, 0xe9, 0xfb, 0x03, 0xb7, 0x77, 0x38, 0x7c, 0xda, 0x42, 0xce,
+	0x9c, 0xbe, 0x90, 0xdf, 0x80, 0x71, 0xff, 0x29, 0x6e, 0x6a, 0x62, 0x6b, 0x14, 0x75, 0xc9, 0xf2,
+	0x2e, 0x88, 0xd5, 0xd5, 0x59, 0x55, 0xd4, 0xe3, 0x9b, 0x0a, 0xbc, 0x1a, 0xa7, 0x12, 0xf5, 0x16,
+	0x3a, 0xa3, 0xb7, 0xec, 0x96, 0xc5, 0x43, 0xe6, 0x94, 0xa7, 0xc7, 0xd5, 0xd8, 0x38, 0x03, 0xf2,
+	0x9c, 0xf9, 0xab, 0x21, 0x72, 0x57, 0x09, 0xa7, 0x3b, 0x37, 0x6e, 0x97, 0x70, 0xf5, 0xef, 0x32,
+	0x30, 0x16, 0xc6, 0x37, 0xe7, 0xe5, 0x3c, 0x81, 0xa9, 0xd3, 0x0e, 0x0a, 0xd3, 0xed, 0x48, 0xc6,
+	0x1b, 0xdd, 0x4e, 0x08, 0x3f, 0x86, 0xf1, 0xae, 0x27, 0x83, 0xe9, 0x2e, 0x05, 0xa0, 0x46, 0xe7,
+	0x91, 0xe0, 0xff, 0x83, 0x49, 0x87, 0x1c, 0x47, 0xe9, 0x83, 0x48, 0x23, 0xfa, 0xb8, 0x46, 0x8c,
+	0xb3, 0x5a, 0x39, 0xaa, 0x48, 0x27, 0x62, 0xe7, 0xb7, 0xe1, 0x89, 0x6f, 0x7f, 0xe2, 0xfc, 0x36,
+	0x38, 0xea, 0xad, 0xfe, 0x87, 0x02, 0x93, 0x6d, 0xec, 0x95, 0x70, 0xe8, 0x23, 0x40, 0x91, 0xf2,
+	0x04, 0x23, 0x28, 0x2b, 0xa9, 0xe6, 0x36, 0x1a, 0x21, 0x05, 0xf0, 0x8f, 0xa0, 0x14, 0x83, 0x17,
+	0x3a, 0x93, 0x4e, 0x38, 0x23, 0x11, 0x8e, 0xb0, 0x0a, 0x37, 0x60, 0xd8, 0xc2, 0xb4, 0x73, 0xfd,
+	0x14, 0x59, 0x69, 0xc8, 0xa6, 0xea, 0x5f, 0x2b, 0x30, 0x1a, 0x93, 0xa8, 0x4a, 0x74, 0xd7, 0x33,
+	0xce, 0xd8, 0x84, 0x3f, 0x80, 0x42, 0x5c, 0xa5, 0x52, 0x8e, 0x38, 0x1f, 0xcb, 0xff, 0xa3, 0x2d,
+	0x00, 0xa6, 0xb8, 0x92, 0x05, 0xe9, 0x74, 0x87, 0xaf, 0x05, 0xb1, 0x60, 0xfe, 0x55, 0x81, 0x72,
+	0xbd, 0x3d, 0xa3, 0xb4, 0x8b, 0x4f, 0xd8, 0x92, 0xea, 0xbd, 0x6a, 0x12, 0x33, 0xcf, 0x9c, 0x35,
+	0xf3, 0xec, 0xc5, 0x67, 0xbe, 0x01, 0x03, 0xd8, 0xe6, 0x59, 0xb5, 0x74, 0xa6, 0x49, 0xf6, 0xae,
+	0xfe, 0x61, 0x06, 0x46, 0x77, 0x62, 0x79, 0xd0, 0xf5, 0x23, 0xc2, 0x13, 0x70, 0x7d, 0xac, 0x69,
+	0x59, 0x39, 0x3b, 0xaf, 0xdd, 0xd1, 0x99, 0x07, 0x71, 0xbc, 0x3b, 0xcb, 0x14, 0xf0, 0x6c, 0x97,
+	0x3c, 0x8f, 0x92, 0x8c, 0xc9, 0xf3, 0x32, 0x71, 0xfc, 0xc4, 0x12, 0x55, 0xa2, 0x09, 0xe3, 0x96,
+	0xd4, 0xb5, 0x1c, 0x2f, 0x61, 0xca, 0x86, 0xd6, 0xa0, 0x5f, 0xc8, 0x36, 0xdd, 0x2c, 0x45, 0x67,
+	0xf4, 0x2e, 0x0c, 0x05, 0x6e, 0x3a, 0xa5, 0x6d, 0x0d, 0xfb, 0x57, 0xff, 0x2a, 0x0b, 0x85, 0xf8,
+	0x9c, 0xd9, 0x0c, 0x64, 0xba, 0x19, 0xd3, 0x86, 0x54, 0x8c, 0x9c, 0x48, 0x2d, 0x63, 0xda, 0x48,
+	0xaa, 0x4d, 0xa6, 0x4d, 0x6d, 0xae, 0x43, 0x31, 0x96, 0xe8, 0x34, 0x0d, 0x19, 0x4d, 0x17, 0xa2,
+	0xc2, 0x9a, 0x81, 0x26, 0x60, 0xc0, 0xa4, 0xda, 0x7e, 0xeb, 0x84, 0x33, 0x61, 0x48, 0xed, 0x37,
+	0xe9, 0x4a, 0xeb, 0xe4, 0x32, 0x27, 0x85, 0x3e, 0x80, 0x91, 0x03, 0xd3, 0xb2, 0x88, 0x11, 0x86,
+	0x33, 0x29, 0x6f, 0x10, 0x0d, 0x0b, 0x98, 0x20, 0x8e, 0x41, 0xef, 0xc1, 0x00, 0x61, 0x4a, 0x41,
+	0xcb, 0x83, 0x3c, 0xef, 0x76, 0xe7, 0x85, 0x54, 0x49, 0x26, 0x0d, 0x25, 0x04, 0xdf, 0xa2, 0xd8,
+	0xa6, 0xef, 0x13, 0x43, 0x63, 0x64, 0x28, 0x8f, 0xbf, 0x8b, 0x6a, 0x41, 0x16, 0x6e, 0xb0, 0x32,
+	0x74, 0x1b, 0x46, 0x7d, 0xe2, 0xd9, 0xa6, 0x83, 0x59, 0x3b, 0xa9, 0x78, 0xe2, 0xce, 0x4e, 0x29,
+	0xaa, 0x10, 0xda, 0x57, 0xfd, 0x9d, 0x0c, 0xcb, 0x83, 0x86, 0x29, 0xd8, 0xe8, 0x30, 0x01, 0xbd,
+	0x09, 0x13, 0xe2, 0xec, 0xa9, 0x3d, 0x9c, 0x50, 0x64, 0x04, 0xc2, 0x2a, 0xdb, 0xe2, 0x09, 0x1f,
+	0x0a, 0xb1, 0x73, 0xa7, 0x97, 0x98, 0x6a, 0xcc, 0x7b, 0xe1, 0xb1, 0x14, 0x45, 0x2a, 0xe4, 0xe3,
+	0x47, 0x51, 0xd9, 0xb4, 0x47, 0x51, 0xd0, 0x0c, 0xff, 0x57, 0xbf, 0xab, 0xc0, 0x7c, 0x7b, 0xc2,
+	0x30, 0x62, 0xce, 0xd9, 0x41, 0x44, 0xb7, 0xa0, 0x26, 0x73, 0x39, 0x41, 0xcd, 0x57, 0x61, 0x7c,
+	0xbb, 0x9b, 0xe3, 0xbe, 0x01, 0xc3, 0xdc, 0xdd, 0xb7, 0x4b, 0xaa, 0xc8, 0x4a, 0x23, 0xc7, 0xf5,
+	0xeb, 0x19, 0x18, 0xde, 0x32, 0x0d, 0x71, 0x28, 0xe5, 0x18, 0x7b, 0x3b, 0x2b, 0xe8, 0x3d, 0xc8,
+	0xd9, 0xa6, 0x21, 0x47, 0xa9, 0xa4, 0x0a, 0xae, 0x87, 0x6c, 0x09, 0xc9, 0x76, 0x5c, 0xfb, 0x2c,
+	0x98, 0xd9, 0x6f, 0x9d, 0x74, 0xcc, 0xfb, 0x45, 0x10, 0x0b, 0x0c, 0x65, 0xa5, 0x75, 0x22, 0x50,
+	0xdf, 0x87, 0x11, 0x8e, 0x4a, 0x89, 0x65, 0x75, 0x38, 0xbb, 0x17, 0x81, 0x2d, 0x32, 0x98, 0x3a,
+	0xb1, 0x2c, 0xc1, 0xcc, 0x7f, 0xef, 0x07, 0xa8, 0x87, 0x97, 0x46, 0x4f, 0xcd, 0x0d, 0x30, 0x23,
+	0x8d, 0x69, 0xb0, 0xb3, 0x15, 0x46, 0x2c, 0xc7, 0x4a, 0xc4, 0xc6, 0xb6, 0x6d, 0xe7, 0x9b, 0xed,
+	0xd8, 0xf9, 0x76, 0x6e, 0x6e, 0xfb, 0x5e, 0xca, 0xe6, 0xb6, 0xff, 0xa5, 0x6e, 0x6e, 0x07, 0x2e,
+	0x6f, 0x73, 0xdb, 0x33, 0x0f, 0x1d, 0xed, 0x7c, 0x87, 0x2e, 0x77, 0xe7, 0x9b, 0x7b, 0xe9, 0x3b,
+	0x5f, 0xb8, 0xbc, 0x9d, 0x6f, 0xf7, 0xed, 0x69, 0xfe, 0x72, 0xb6, 0xa7, 0xd5, 0xcf, 0x14, 0x18,
+	0x94, 0x07, 0x99, 0xe8, 0x43, 0x18, 0xc5, 0x47, 0xd8, 0xb4, 0xd8, 0x45, 0x06, 0x6d, 0x1f, 0x5b,
+	0x2c, 0x97, 0x9e, 0x32, 0x56, 0x2f, 0x85, 0x40, 0x2b, 0x02, 0x07, 0xd5, 0xa1, 0xe8, 0xbb, 0x3e,
+	0xb6, 0x42, 0xe0, 0x4c, 0x4a, 0x1d, 0x65, 0x20, 0x12, 0xb4, 0xfa, 0x3a, 0x8c, 0x47, 0x61, 0x2a,
+	0x3f, 0x05, 0xdb, 0x76, 0x19, 0xb1, 0x71, 0xe8, 0x77, 0xdc, 0x60, 0xf4, 0x45, 0x55, 0x3c, 0x54,
+	0xff, 0x20, 0x03, 0x39, 0xee, 0x5a, 0xb9, 0xdd, 0xee, 0x08, 0x39, 0x94, 0x2e, 0x21, 0xc7, 0x75,
+	0x28, 0xf2, 0x45, 0x45, 0x74, 0xb3, 0x69, 0x12, 0xc7, 0x0f, 0x92, 0x81, 0x07, 0x84, 0xa8, 0x41,
+	0x59, 0x14, 0x9b, 0x65, 0x2f, 0x2b, 0x36, 0xeb, 0xbb, 0x60, 0x18, 0x53, 0x82, 0xac, 0x6e, 0x1a,
+	0xc2, 0x0c, 0xa8, 0xec, 0x6f, 0x8a, 0x84, 0x60, 0xf5, 0x9f, 0x33, 0x90, 0x63, 0x36, 0x91, 0xb3,
+	0xac, 0xb7, 0x9b, 0x7b, 0x37, 0x08, 0xfd, 0x4c, 0xe7, 0xc0, 0x95, 0x17, 0xe7, 0x6f, 0x9c, 0x19,
+	0xe1, 0x30, 0x31, 0xc8, 0xc8, 0x26, 0xe7, 0x06, 0x05, 0x68, 0x2d, 0xc0, 0xe2, 0x81, 0xb7, 0xf0,
+	0xe2, 0x67, 0x63, 0xf1, 0x60, 0x3b, 0xe7, 0x06, 0x7f, 0xb9, 0xba, 0x79, 0xe6, 0xe1, 0x21, 0xbb,
+	0x88, 0xd4, 0x16, 0x37, 0xbf, 0x90, 0xf7, 0x91, 0x20, 0xbd, 0x32, 0x45, 0xfd, 0xa9, 0x90, 0x3b,
+	0x97, 0xe2, 0xe7, 0x19, 0x18, 0x66, 0xfc, 0xde, 0x34, 0x6d, 0x53, 0x32, 0x3d, 0xc9, 0x57, 0xe5,
+	0x12, 0xf9, 0x9a, 0x49, 0xc9, 0xd7, 0x77, 0x61, 0x88, 0x85, 0x9c, 0x6c, 0x65, 0xa7, 0x54, 0xf7,
+	0xb0, 0xff, 0xcb, 0x91, 0x51, 0x72, 0x17, 0xc2, 0x64, 0x53, 0x88, 0xed, 0x42, 0xaa, 0xff, 0x94,
+	0x81, 0x91, 0xc8, 0xd1, 0x5f, 0x3e, 0x97, 0x1f, 0x40, 0x41, 0x1a, 0x38, 0x8d, 0xdf, 0x37, 0x4c,
+	0xb9, 0xb7, 0x97, 0x18, 0xf7, 0xd9, 0x7d, 0xc4, 0xe4, 0x8c, 0xb2, 0x6d, 0x33, 0x6a, 0x93, 0x6b,
+	0xdf, 0x65, 0xad, 0x97, 0xfe, 0x8b, 0xcb, 0xa2, 0xfa, 0x59, 0x16, 0x46, 0xda, 0xee, 0x98, 0xff,
+	0xb4, 0xd9, 0x91, 0x0d, 0x18, 0x10, 0xa7, 0xd3, 0x69, 0xd3, 0x0b, 0xa2, 0xf7, 0x4b, 0xe1, 0xef,
+	0x29, 0xf6, 0x68, 0xe0, 0x92, 0xec, 0xd1, 0x6f, 0xf7, 0xc1, 0x6c, 0xe4, 0x5d, 0x39, 0x77, 0xf6,
+	0x5d, 0xf7, 0xf1, 0x16, 0xf1, 0xb1, 0x81, 0x7d, 0xcc, 0xee, 0xa8, 0x1e, 0x61, 0x87, 0x2d, 0x66,
+	0xcd, 0x62, 0x26, 0x4b, 0x5e, 0x2c, 0xe2, 0xad, 0xa5, 0xe3, 0x9d, 0x94, 0x0d, 0x22, 0x93, 0x26,
+	0xde, 0x2f, 0x78, 0x1b, 0xae, 0x7a, 0xc4, 0x68, 0xe9, 0x44, 0x5c, 0xd5, 0xed, 0xec, 0x2e, 0x2e,
+	0xea, 0x4c, 0x8b, 0x46, 0xec, 0xa2, 0x6e, 0x3b, 0x02, 0x85, 0x79, 0x7c, 0x78, 0xe8, 0x91, 0x43,
+	0xbe, 0xcb, 0x8c, 0x61, 0x85, 0x3e, 0x34, 0x9d, 0x75, 0x9a, 0x0d, 0x51, 0xd5, 0x90, 0x76, 0xb8,
+	0x89, 0xb7, 0x60, 0x26, 0x22, 0x1a, 0xcc, 0xfd, 0x82, 0x4e, 0xbb, 0x1c, 0x22, 0xbe, 0x2f, 0x00,
+	0x43, 0x6a, 0xeb, 0xb0, 0x10, 0xd0, 0xe8, 0xb8, 0x52, 0x26, 0xd9, 0x24, 0xce, 0xff, 0xe6, 0x64,
+	0xb3, 0xf6, 0xcb, 0x64, 0x82, 0x53, 0x9b, 0x70, 0x3d, 0xce, 0x9f, 0xd3, 0xa0, 0x06, 0x38, 0xd4,
+	0x42, 0xc4, 0xf1, 0xae, 0x68, 0xd5, 0xbf, 0x54, 0x60, 0xa4, 0x4d, 0x29, 0xa2, 0xf8, 0x47, 0xb9,
+	0xac, 0xf8, 0x27, 0x73, 0xc1, 0xf8, 0xa7, 0x0a, 0x05, 0x93, 0x46, 0x02, 0x94, 0x57, 0xa9, 0x12,
+	0x65, 0xd5, 0xa7, 0x30, 0xd6, 0x36, 0x91, 0x35, 0xa6, 0xd5, 0xcb, 0xd0, 0xcf, 0xd9, 0x22, 0xfd,
+	0xc0, 0xed, 0x9e, 0x57, 0x0c, 0x93, 0xfd, 0x55, 0xd1, 0xb3, 0xcd, 0x60, 0x67, 0xda, 0x5d, 0xd0,
+	0x1f, 0x67, 0x61, 0x3c, 0xb2, 0x8a, 0xff, 0xa3, 0xbd, 0x7d, 0x64, 0xfd, 0xb2, 0x17, 0xb2, 0x7e,
+	0xf1, 0xa8, 0xa1, 0xef, 0xb2, 0xa3, 0x86, 0xfe, 0x4b, 0x8f, 0x1a, 0x06, 0xda, 0x45, 0xf6, 0xa7,
+	0x59, 0x98, 0x68, 0x4f, 0x03, 0xfd, 0x6f, 0x97, 0xd9, 0x0e, 0xe4, 0xc5, 0x3f, 0x11, 0xc8, 0xa4,
+	0x13, 0x1b, 0x08, 0x08, 0x1e, 0xc7, 0xfc, 0x24, 0x04, 0xf7, 0xe3, 0x0c, 0x0c, 0x05, 0xd7, 0x63,
+	0x58, 0x56, 0xc7, 0xa4, 0x9b, 0xae, 0x3c, 0x80, 0x1a, 0x52, 0xe5, 0xd3, 0xa5, 0x5a, 0x9e, 0x1d,
+	0xc8, 0x13, 0xc7, 0xf7, 0x4e, 0x2e, 0x74, 0x12, 0x03, 0x1c, 0x42, 0x4c, 0xf0, 0xb2, 0x02, 0x90,
+	0x06, 0x94, 0x3b, 0x4f, 0xe2, 0x34, 0x4e, 0x28, 0x65, 0xba, 0x68, 0xb2, 0xe3, 0x3c, 0x6e, 0x9d,
+	0xa1, 0x55, 0x6b, 0x30, 0x1e, 0x5b, 0x21, 0x35, 0xc7, 0x30, 0x75, 0xec, 0xbb, 0x67, 0x44, 0x7e,
+	0xe3, 0x20, 0xb2, 0xf9, 0xe5, 0x4c, 0x2c, 0xb5, 0x5f, 0xfd, 0x97, 0x0c, 0x0c, 0xf1, 0x6d, 0xfd,
+	0xa6, 0x9b, 0x14, 0x93, 0x72, 0x41, 0x31, 0x85, 0x2e, 0x2b, 0x73, 0x11, 0x97, 0xd5, 0xf5, 0xd4,
+	0xa2, 0xd0, 0x96, 0x42, 0x78, 0x1b, 0xb2, 0xec, 0x8d, 0x9a, 0x74, 0xd2, 0x63, 0x5d, 0xcf, 0xd8,
+	0xd2, 0xa0, 0xaf, 0xc0, 0x44, 0x22, 0x47, 0xa1, 0x61, 0xc3, 0xf0, 0x08, 0xa5, 0x62, 0x35, 0x70,
+	0x33, 0xa3, 0xa8, 0x63, 0xf1, 0x8c, 0xc5, 0xb2, 0x68, 0x10, 0xa4, 0x09, 0x06, 0xc3, 0x34, 0x41,
+	0xf5, 0xb3, 0x0c, 0x14, 0x83, 0xf5, 0xb2, 0x46, 0x2c, 0x1f, 0xa3, 0x29, 0x18, 0x34, 0xa9, 0x66,
+	0x75, 0xae, 0x9a, 0x8f, 0x00, 0x91, 0x63, 0xa2, 0xb7, 0x58, 0x53, 0xed, 0x82, 0xeb, 0x67, 0x34,
+	0x44, 0x0a, 0xa3, 0x9f, 0x47, 0x50, 0x8a, 0xe0, 0x2f, 0x64, 0xd0, 0x46, 0x42, 0x1c, 0x71, 0x31,
+	0x94, 0x1d, 0xf2, 0x44, 0xd0, 0x17, 0x39, 0x55, 0x1b, 0x0e, 0x61, 0xc4, 0x7e, 0xe7, 0x1b, 0x59,
+	0x40, 0xb1, 0x57, 0xc6, 0x03, 0xc5, 0xed, 0x9a, 0x69, 0x6a, 0x57, 0x93, 0x5d, 0x18, 0x0e, 0xef,
+	0x03, 0x1a, 0x8c, 0xf3, 0x72, 0xfb, 0xd3, 0xf3, 0x66, 0x79, 0x42, 0x54, 0x6a, 0xb1, 0x99, 0x90,
+	0xdc, 0x06, 0x0c, 0x34, 0xf1, 0x89, 0xdb, 0xf2, 0xd3, 0x3a, 0x02, 0xd1, 0xfb, 0xa7, 0x4b, 0x81,
+	0x7f, 0x09, 0x50, 0x14, 0x95, 0x85, 0x96, 0xff, 0x6d, 0x18, 0x0a, 0x78, 0x23, 0x7d, 0xf4, 0x2b,
+	0xe7, 0x61, 0xab, 0x1a, 0xf6, 0xea, 0x94, 0x61, 0xa6, 0x53, 0x86, 0xd5, 0xa7, 0x30, 0x1a, 0x11,
+	0x0f, 0xb2, 0xaa, 0xe7, 0x92, 0xfe, 0x57, 0x61, 0x50, 0xbe, 0x74, 0x22, 0xc5, 0x7e, 0xbd, 0xd7,
+	0xf8, 0x24, 0xb4, 0x1a, 0xf4, 0xa9, 0x36, 0xa1, 0x28, 0xcb, 0x1e, 0x36, 0x0d, 0x96, 0x57, 0x1f,
+	0x87, 0x7e, 0x71, 0x06, 0x21, 0xec, 0xac, 0x78, 0x40, 0x35, 0x18, 0x92, 0x3d, 0x82, 0x33, 0xb9,
+	0x3b, 0xe7, 0x0b, 0x6f, 0x03, 0x82, 0x61, 0xf7, 0xea, 0xe7, 0x0a, 0x94, 0x76, 0x5d, 0xd3, 0xf1,
+	0x69, 0xec, 0x62, 0xff, 0x01, 0x4c, 0x89, 0xe3, 0x8d, 0x26, 0xaf, 0x89, 0x5f, 0xe2, 0x4f, 0x67,
+	0xb0, 0xc5, 0x5b, 0x61, 0xdd, 0xe8, 0xf8, 0xa7, 0xd0, 0x49, 0x67, 0x7f, 0x26, 0xfc, 0x6e, 0x74,
+	0xaa, 0xff, 0x95, 0x81, 0xf9, 0xbd, 0xf8, 0x8b, 0xe5, 0xab, 0xd8, 0x6e, 0x62, 0xf3, 0xd0, 0x59,
+	0x71, 0x5d, 0x2a, 0x4e, 0xff, 0xfe, 0x3f, 0x4c, 0xed, 0xb3, 0x07, 0x62, 0x68, 0x89, 0x8f, 0x97,
+	0x18, 0xb4, 0xac, 0x54, 0xb2, 0x8b, 0x39, 0x75, 0x5c, 0x56, 0x47, 0x49, 0xa7, 0x9a, 0x41, 0xd1,
+	0x27, 0x30, 0x15, 0x6f, 0x1e, 0x4d, 0x20, 0x10, 0xcc, 0xeb, 0xbd, 0xf5, 0x33, 0x39, 0x50, 0x19,
+	0x4a, 0x4e, 0x44, 0x9f, 0x3d, 0x89, 0xea, 0x28, 0x5a, 0x86, 0xab, 0xc1, 0x10, 0xbb, 0x7c, 0xf8,
+	0xc4, 0xa0, 0xe5, 0x2c, 0x1f, 0xe8, 0x8c, 0x6c, 0xd4, 0x1e, 0xe7, 0xb2, 0xe1, 0x1e, 0xc1, 0xd5,
+	0xce, 0xae, 0xf1, 0x41, 0xf7, 0xa5, 0x1e, 0xf4, 0x6c, 0xfb, 0xe7, 0x53, 0x62, 0x43, 0xaf, 0xfe,
+	0xb9, 0x02, 0x28, 0xe0, 0xb9, 0x90, 0xc0, 0xae, 0x2b, 0x6e, 0xdf, 0x76, 0x3f, 0x8d, 0x1e, 0xa6,
+	0xc9, 0x83, 0xe8, 0x5f, 0x86, 0x71, 0xfe, 0x46, 0x96, 0x84, 0x08, 0xbe, 0x22, 0x20, 0x79, 0xdc,
+	0xe3, 0x3d, 0xd4, 0x37, 0xe4, 0x69, 0xf4, 0xe2, 0x39, 0x14, 0x48, 0x1c, 0x45, 0xb3, 0x4c, 0x4c,
+	0x72, 0xa8, 0xb4, 0xfa, 0xfd, 0x0c, 0x4c, 0x77, 0xd5, 0x1f, 0xae, 0x3a, 0x6f, 0xc1, 0x74, 0x38,
+	0xb0, 0xe0, 0xc5, 0x4e, 0xf9, 0xa2, 0x12, 0x95, 0xf3, 0x99, 0x0a, 0x1a, 0x04, 0x2f, 0x76, 0x8a,
+	0xd7, 0x96, 0x28, 0xbb, 0x50, 0x12, 0x3b, 0x69, 0x14, 0x13, 0xca, 0xa9, 0xf9, 0xe8, 0xa8, 0x91,
+	0xa2, 0x16, 0x4c, 0x27, 0x3f, 0x9e, 0xa0, 0x71, 0x01, 0x8b, 0x8d, 0x4a, 0x96, 0x1b, 0x99, 0xb7,
+	0xce, 0xf1, 0xd6, 0xd2, 0x29, 0x8a, 0xaf, 0x4e, 0x26, 0xbe, 0xb8, 0x10, 0x2d, 0x88, 0x2f, 0xc3,
+	0x94, 0x61, 0xd2, 0x27, 0x2d, 0x6c, 0x99, 0x07, 0x26, 0x31, 0xe2, 0x7a, 0xd6, 0xc7, 0x07, 0x39,
+	0x11, 0xaf, 0x0e, 0x55, 0xac, 0xfa, 0x6f, 0x19, 0x18, 0x63, 0xaf, 0xeb, 0x99, 0x54, 0x1c, 0xe6,
+	0x98, 0x72, 0x53, 0xf4, 0x75, 0xf6, 0x82, 0x32, 0x5b, 0xeb, 0x86, 0xac, 0x11, 0x67, 0x90, 0x29,
+	0xaf, 0x90, 0x71, 0xa8, 0x80, 0x06, 0x3f, 0x81, 0xfc, 0x3a, 0x8c, 0xf9, 0x5d, 0xf0, 0x53, 0xc6,
+	0x31, 0x7e, 0x07, 0x7e, 0x1d, 0x8a, 0xf2, 0xf3, 0x19, 0xf2, 0x9a, 0x52, 0x36, 0xd5, 0xf7, 0x32,
+	0x0a, 0x02, 0x64, 0x99, 0x63, 0x30, 0xd7, 0x2e, 0x5e, 0xb2, 0x4a, 0xbb, 0x29, 0x10, 0xbd, 0xab,
+	0xbf, 0x91, 0x64, 0x7a, 0xf8, 0xf2, 0x1b, 0xbb, 0xaf, 0xd4, 0xd2, 0x99, 0xdc, 0xa2, 0x6c, 0x5e,
+	0x9f, 0x9a, 0x17, 0x65, 0x22, 0xad, 0x74, 0x13, 0x46, 0x64, 0x93, 0xf0, 0xa5, 0x64, 0x71, 0xab,
+	0x69, 0x58, 0x14, 0x87, 0xaf, 0x22, 0xb7, 0xab, 0x6a, 0xb6, 0x53, 0x55, 0xb7, 0x01, 0x7c, 0x53,
+	0xee, 0xa1, 0x03, 0x5b, 0x72, 0xb7, 0x97, 0x6e, 0x76, 0x51, 0x14, 0x76, 0xcd, 0x4c, 0xfc, 0xa3,
+	0xbd, 0x74, 0xb0, 0xbf, 0x97, 0x0e, 0x6e, 0x01, 0x6a, 0x43, 0xde, 0xdb, 0xdb, 0x44, 0x08, 0xfa,
+	0xfc, 0xc0, 0x85, 0xf5, 0xa9, 0xfc, 0x3f, 0x73, 0xea, 0xbe, 0x6f, 0x75, 0xdc, 0xc7, 0x2d, 0xf8,
+	0xbe, 0x15, 0x1d, 0xa0, 0xfd, 0x89, 0x02, 0x05, 0xf1, 0x56, 0x9e, 0xbc, 0x16, 0xc8, 0xdf, 0xa0,
+	0x60, 0xba, 0x26, 0x85, 0xa7, 0xa4, 0x7d, 0x83, 0xe2, 0x31, 0xf1, 0x04, 0x30, 0x83, 0xf4, 0xe3,
+	0x90, 0x29, 0xcf, 0x1b, 0xfc, 0x08, 0xb2, 0xfa, 0x5b, 0x0a, 0x0c, 0x2f, 0x0b, 0xbf, 0x2f, 0x0d,
+	0x19, 0x2a, 0xc3, 0x60, 0xf0, 0xee, 0xaa, 0x08, 0x28, 0x82, 0x47, 0x44, 0x60, 0xf0, 0x25, 0x1a,
+	0xd5, 0x00, 0xbb, 0xfa, 0xab, 0x0a, 0x14, 0x78, 0x3c, 0x2d, 0x38, 0x49, 0xcf, 0xba, 0x75, 0x33,
+	0x6e, 0x61, 0x9f, 0x50, 0x5f, 0x63, 0x46, 0x8a, 0x47, 0x96, 0x6e, 0x34, 0xc2, 0x9b, 0x67, 0x59,
+	0x3d, 0x49, 0x44, 0x45, 0x02, 0x24, 0x4e, 0xb7, 0xfa, 0x65, 0x28, 0x46, 0x61, 0x51, 0x6d, 0x8d,
+	0xb2, 0xeb, 0x36, 0x89, 0xf0, 0x4e, 0xf8, 0xfd, 0x82, 0x5a, 0x8c, 0xc7, 0x77, 0xb4, 0xfa, 0x17,
+	0x0a, 0xe4, 0x63, 0x40, 0x67, 0xdc, 0x10, 0xbd, 0x9c, 0xed, 0x69, 0x7c, 0xc3, 0x9c, 0xbd, 0xe0,
+	0x6d, 0xbf, 0x6f, 0x29, 0xd0, 0x2f, 0xbe, 0xee, 0xf2, 0xb3, 0xa0, 0x34, 0x53, 0x6a, 0xae, 0xd2,
+	0x64, 0xbd, 0x9f, 0xa4, 0x9c, 0x95, 0xf2, 0xa4, 0xfa, 0x7b, 0x0a, 0x2c, 0x2c, 0x07, 0xf9, 0xf2,
+	0x48, 0x0e, 0x89, 0x45, 0x76, 0xae, 0x73, 0xfd, 0x1d, 0x18, 0x16, 0xda, 0xa2, 0x25, 0x5f, 0x87,
+	0x3d, 0xc7, 0x15, 0x13, 0x49, 0xac, 0x68, 0xc7, 0x9e, 0x68, 0xf5, 0xdb, 0x0a, 0xcc, 0x85, 0x23,
+	0x5b, 0xee, 0x32, 0xac, 0xd3, 0x97, 0xd0, 0xa5, 0x8f, 0x85, 0x42, 0x21, 0x5e, 0xdd, 0x7b, 0xad,
+	0x44, 0xae, 0x44, 0x6c, 0x3c, 0x7a, 0x52, 0x8d, 0xcf, 0x28, 0xb8, 0x93, 0x28, 0x5d, 0xc9, 0x32,
+	0xdb, 0x82, 0x38, 0xae, 0xbd, 0x46, 0x74, 0xd3, 0xc6, 0x16, 0x3d, 0x65, 0x0b, 0x32, 0xc3, 0xb6,
+	0x20, 0xa2, 0x05, 0x27, 0xd8, 0xa7, 0x86, 0xcf, 0xb7, 0x7c, 0x98, 0xeb, 0xf5, 0xd5, 0x21, 0x04,
+	0x30, 0xb0, 0xed, 0xee, 0xbb, 0xc6, 0x49, 0xe9, 0x0a, 0xaa, 0xc2, 0xfc, 0x0a, 0x39, 0x34, 0xc5,
+	0x57, 0x0a, 0x88, 0x57, 0xb7, 0xb1, 0xe7, 0xaf, 0xba, 0x8e, 0xef, 0x61, 0xdd, 0xa7, 0x2c, 0xbf,
+	0x5f, 0x52, 0xd0, 0x24, 0xa0, 0x2e, 0xe5, 0x19, 0x54, 0x80, 0xa1, 0xf5, 0x23, 0xe2, 0x9d, 0xb8,
+	0x0e, 0x29, 0x65, 0x6f, 0xdd, 0x03, 0xd4, 0xf9, 0xa9, 0x00, 0x34, 0x0a, 0xc5, 0x55, 0xd7, 0xb6,
+	0x5b, 0x8e, 0xe9, 0x9f, 0xb0, 0x98, 0xb3, 0x74, 0x05, 0x0d, 0x41, 0xdf, 0x4a, 0xcb, 0x73, 0x4a,
+	0xca, 0xad, 0x77, 0x13, 0x97, 0x25, 0x63, 0x9f, 0xab, 0x18, 0x83, 0x91, 0xb6, 0x8a, 0xd2, 0x15,
+	0x34, 0x07, 0xe5, 0x58, 0x61, 0x12, 0x55, 0xb9, 0x75, 0x03, 0x40, 0xa4, 0x25, 0xd8, 0xa7, 0x68,
+	0xd8, 0xd0, 0x6a, 0xd4, 0x65, 0x76, 0xc7, 0x28, 0x5d, 0x41, 0x39, 0xe8, 0x5f, 0xf5, 0x5c, 0x4a,
+	0x4b, 0xca, 0xad, 0x3d, 0x28, 0xc4, 0x6f, 0x38, 0xa1, 0x11, 0xc8, 0x3f, 0x74, 0x68, 0x93, 0xe8,
+	0xdc, 0x85, 0x95, 0xae, 0x30, 0xe6, 0x88, 0x2f, 0xbb, 0x94, 0x14, 0xf6, 0x7f, 0x17, 0xb7, 0x28,
+	0x31, 0x4a, 0x19, 0x34, 0x0c, 0xb0, 0x46, 0x6c, 0xd7, 0x32, 0x69, 0x83, 0x18, 0xa5, 0x2c, 0xca,
+	0xc3, 0xa0, 0xfc, 0xe4, 0x4c, 0xa9, 0xef, 0xd6, 0x77, 0x15, 0x98, 0xe8, 0x7a, 0x6f, 0x99, 0xf1,
+	0x2e, 0x5e, 0xc1, 0xbf, 0xc5, 0xc2, 0xc8, 0xcc, 0xc2, 0x54, 0xa2, 0x1c, 0x7b, 0xbe, 0x89, 0x2d,
+	0x76, 0xe3, 0x54, 0x30, 0x3c, 0x5e, 0xb9, 0xc1, 0xaf, 0xc0, 0x96, 0x32, 0x68, 0x3a, 0x49, 0x65,
+	0x95, 0xbf, 0xd1, 0x6e, 0xf1, 0xe1, 0x4c, 0xc1, 0x58, 0x62, 0x00, 0xe1, 0xd0, 0x3e, 0x0b, 0x2e,
+	0xeb, 0xf0, 0xe1, 0x54, 0x20, 0xff, 0x70, 0xbb, 0xbe, 0xbb, 0xbe, 0x5a, 0xdb, 0xa8, 0xad, 0xaf,
+	0x95, 0xae, 0xcc, 0x8c, 0x3c, 0x7b, 0x5e, 0x89, 0x17, 0xb1, 0x54, 0xc0, 0xca, 0xc3, 0x47, 0x25,
+	0x65, 0x66, 0xf0, 0xd9, 0xf3, 0x0a, 0xfb, 0xcb, 0xfc, 0x76, 0x7d, 0x7d, 0x73, 0xb3, 0x94, 0x99,
+	0x19, 0x7a, 0xf6, 0xbc, 0xc2, 0xff, 0x33, 0xf5, 0xab, 0xef, 0xed, 0xec, 0x6a, 0xac, 0x69, 0x76,
+	0xa6, 0xf0, 0xec, 0x79, 0x25, 0x7c, 0x66, 0x26, 0x99, 0xff, 0xe7, 0x9d, 0xfa, 0x66, 0x8a, 0xcf,
+	0x9e, 0x57, 0xa2, 0x02, 0xd6, 0x73, 0x6f, 0xf9, 0xbd, 0x75, 0xde, 0xb3, 0x5f, 0xf4, 0x0c, 0x9e,
+	0x59, 0x4f, 0xfe, 0x9f, 0xf7, 0x1c, 0x10, 0x3d, 0xc3, 0x02, 0x96, 0x76, 0x5e, 0x79, 0xf8, 0x48,
+	0xdb, 0xdd, 0x29, 0x0d, 0xce, 0xc0, 0xb3, 0xe7, 0x15, 0xf9, 0xc4, 0x2c, 0x02, 0xab, 0x67, 0x15,
+	0x43, 0x33, 0xf9, 0x67, 0xcf, 0x2b, 0xc1, 0x23, 0x9a, 0x07, 0x60, 0x6d, 0x96, 0xf7, 0x76, 0xb6,
+	0x6a, 0xab, 0xa5, 0xdc, 0xcc, 0xf0, 0xb3, 0xe7, 0x95, 0x58, 0x09, 0xe3, 0x06, 0x6f, 0x2a, 0x1b,
+	0x80, 0xe0, 0x46, 0xac, 0xe8, 0xd6, 0x1f, 0x29, 0x50, 0x5c, 0x0f, 0x92, 0x53, 0x9c, 0x83, 0x73,
+	0x50, 0x8e, 0x29, 0x4c, 0xa2, 0x4e, 0x68, 0x8f, 0x50, 0xaf, 0x92, 0x82, 0x8a, 0x90, 0xe3, 0x87,
+	0x52, 0x5c, 0xa8, 0x19, 0x34, 0x03, 0x93, 0xfc, 0x71, 0x0b, 0xfb, 0x7a, 0x43, 0x15, 0x1f, 0x56,
+	0xe3, 0x82, 0x29, 0x65, 0x99, 0xc0, 0xa3, 0xba, 0x6d, 0xf2, 0x54, 0x94, 0xf7, 0xa1, 0x09, 0x18,
+	0x95, 0xdf, 0x67, 0x92, 0x5f, 0x48, 0x33, 0x5d, 0xa7, 0xd4, 0xcf, 0xa0, 0xc4, 0x4b, 0x50, 0xed,
+	0x17, 0x69, 0x4b, 0x03, 0xb7, 0xbe, 0x1d, 0xc8, 0x7b, 0x0b, 0xd3, 0xc7, 0x8c, 0x67, 0x0f, 0xb7,
+	0x1f, 0xd6, 0xb9, 0xa8, 0x39, 0xcf, 0xc4, 0x13, 0x93, 0xf2, 0xf2, 0x76, 0x28, 0xe5, 0xe5, 0xed,
+	0x47, 0x8c, 0x8b, 0xea, 0xfa, 0x3b, 0x0f, 0x37, 0x97, 0xd5, 0x52, 0x46, 0x70, 0x51, 0x3e, 0x32,
+	0x2e, 0xad, 0xee, 0x6c, 0xaf, 0xd5, 0xf6, 0x6a, 0x3b, 0xdb, 0xcb, 0x4c, 0xa2, 0x9c, 0x4b, 0xb1,
+	0x22, 0xb4, 0x04, 0x53, 0x6b, 0x35, 0x75, 0x7d, 0x95, 0x3d, 0x32, 0x41, 0x6a, 0x3b, 0xaa, 0x76,
+	0xbf, 0xf6, 0xce, 0xfd, 0x75, 0xb5, 0x34, 0x34, 0x33, 0xfa, 0xec, 0x79, 0xa5, 0x98, 0x28, 0x4c,
+	0xb6, 0xe7, 0xec, 0xde, 0x51, 0xb5, 0xcd, 0x9d, 0x0f, 0xd6, 0xd5, 0x52, 0x49, 0xb4, 0x4f, 0x14,
+	0xa2, 0x59, 0xc8, 0xef, 0x3d, 0xda, 0x5d, 0xd7, 0xb6, 0x96, 0xd5, 0xf7, 0xd6, 0xf7, 0x4a, 0x15,
+	0x31, 0x15, 0xf1, 0x84, 0xa6, 0x01, 0x78, 0xe5, 0x66, 0x6d, 0xab, 0xb6, 0x57, 0x7a, 0x7b, 0x26,
+	0xf7, 0xec, 0x79, 0xa5, 0x9f, 0x3f, 0xac, 0x34, 0x7e, 0xf0, 0xf9, 0xbc, 0xf2, 0xc3, 0xcf, 0xe7,
+	0x95, 0x7f, 0xf8, 0x7c, 0x5e, 0xf9, 0xcd, 0x2f, 0xe6, 0xaf, 0xfc, 0xf0, 0x8b, 0xf9, 0x2b, 0x7f,
+	0xf3, 0xc5, 0xfc, 0x95, 0xaf, 0x6d, 0xc7, 0x7c, 0x65, 0x2d, 0xb0, 0xd3, 0x9b, 0x78, 0x9f, 0xde,
+	0x0d, 0xad, 0xf6, 0x1d, 0xdd, 0xf5, 0x48, 0xfc, 0xb1, 0x81, 0x4d, 0xe7, 0xae, 0xed, 0xb2, 0xc0,
+	0x9e, 0x46, 0x1f, 0x82, 0xe5, 0x7e, 0x75, 0x7f, 0x80, 0x7f, 0xef, 0xeb, 0x4b, 0xff, 0x3d, 0x00,
+	0x5e, 0x04, 0x68, 0x7e, 0x2b, 0x56, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -3489,6 +4482,83 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.PostOnlyModeHeightThreshold != that1.PostOnlyModeHeightThreshold {
 		return false
 	}
+	if this.MaxOpenOrdersPerSubaccount != that1.MaxOpenOrdersPerSubaccount {
+		return false
+	}
+	if this.MaxExpiredOrdersPerBlock != that1.MaxExpiredOrdersPerBlock {
+		return false
+	}
+	if this.FundingRateHistorySize != that1.FundingRateHistorySize {
+		return false
+	}
+	if this.MaxActiveMarkets != that1.MaxActiveMarkets {
+		return false
+	}
+	if !this.MarketCreationFee.Equal(&that1.MarketCreationFee) {
+		return false
+	}
+	if !this.OrderPlacementSurcharge.Equal(&that1.OrderPlacementSurcharge) {
+		return false
+	}
+	if this.SpamFeeDestination != that1.SpamFeeDestination {
+		return false
+	}
+	if this.IsAutoDeleveragingEnabled != that1.IsAutoDeleveragingEnabled {
+		return false
+	}
+	if this.MarketInactivityDelistBlocks != that1.MarketInactivityDelistBlocks {
+		return false
+	}
+	if this.MakerRebateEpochDuration != that1.MakerRebateEpochDuration {
+		return false
+	}
+	if !this.MakerRebateRate.Equal(that1.MakerRebateRate) {
+		return false
+	}
+	if this.MakerRebatePoolSource != that1.MakerRebatePoolSource {
+		return false
+	}
+	if this.FeeSettlementDenom != that1.FeeSettlementDenom {
+		return false
+	}
+	if this.FeeSettlementOracleType != that1.FeeSettlementOracleType {
+		return false
+	}
+	if this.FeeSettlementMaxPriceAge != that1.FeeSettlementMaxPriceAge {
+		return false
+	}
+	if !this.FeeSettlementSlippage.Equal(that1.FeeSettlementSlippage) {
+		return false
+	}
+	if this.OrderHistoryRetentionBlocks != that1.OrderHistoryRetentionBlocks {
+		return false
+	}
+	if len(this.DustThresholds) != len(that1.DustThresholds) {
+		return false
+	}
+	for i := range this.DustThresholds {
+		if !this.DustThresholds[i].Equal(&that1.DustThresholds[i]) {
+			return false
+		}
+	}
+	if this.DustSweepDestination != that1.DustSweepDestination {
+		return false
+	}
+	if this.DustSweepMaxDepositsPerBlock != that1.DustSweepMaxDepositsPerBlock {
+		return false
+	}
+	if this.MaxConditionalOrdersPerSubaccount != that1.MaxConditionalOrdersPerSubaccount {
+		return false
+	}
+	if this.SubaccountFundingHistorySize != that1.SubaccountFundingHistorySize {
+		return false
+	}
+	if !this.MaxMarketOrderSlippageRatio.Equal(that1.MaxMarketOrderSlippageRatio) {
+		return false
+	}
+	if this.MakerRebateMaxVolumesPerBlock != that1.MakerRebateMaxVolumesPerBlock {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -3511,6 +4581,215 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MakerRebateMaxVolumesPerBlock != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MakerRebateMaxVolumesPerBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.MaxMarketOrderSlippageRatio.Size()
+		i -= size
+		if _, err := m.MaxMarketOrderSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x82
+	if m.SubaccountFundingHistorySize != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.SubaccountFundingHistorySize))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxConditionalOrdersPerSubaccount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MaxConditionalOrdersPerSubaccount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.DustSweepMaxDepositsPerBlock != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.DustSweepMaxDepositsPerBlock))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.DustSweepDestination != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.DustSweepDestination))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.DustThresholds) > 0 {
+		for iNdEx := len(m.DustThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.OrderHistoryRetentionBlocks != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderHistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	{
+		size := m.FeeSettlementSlippage.Size()
+		i -= size
+		if _, err := m.FeeSettlementSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xca
+	if m.FeeSettlementMaxPriceAge != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.FeeSettlementMaxPriceAge))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.FeeSettlementOracleType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.FeeSettlementOracleType))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.FeeSettlementDenom) > 0 {
+		i -= len(m.FeeSettlementDenom)
+		copy(dAtA[i:], m.FeeSettlementDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeSettlementDenom)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MakerRebatePoolSource != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MakerRebatePoolSource))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.MakerRebateRate.Size()
+		i -= size
+		if _, err := m.MakerRebateRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xa2
+	if m.MakerRebateEpochDuration != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MakerRebateEpochDuration))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MarketInactivityDelistBlocks != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MarketInactivityDelistBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IsAutoDeleveragingEnabled {
+		i--
+		if m.IsAutoDeleveragingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SpamFeeDestination != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.SpamFeeDestination))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.OrderPlacementSurcharge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	{
+		size, err := m.MarketCreationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if m.MaxActiveMarkets != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MaxActiveMarkets))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.FundingRateHistorySize != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.FundingRateHistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxExpiredOrdersPerBlock != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MaxExpiredOrdersPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxOpenOrdersPerSubaccount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MaxOpenOrdersPerSubaccount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
 	if m.PostOnlyModeHeightThreshold != 0 {
 		i = encodeVarintExchange(dAtA, i, uint64(m.PostOnlyModeHeightThreshold))
 		i--
@@ -3754,7 +5033,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MarketFeeMultiplier) Marshal() (dAtA []byte, err error) {
+func (m *TradingWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3764,37 +5043,30 @@ func (m *MarketFeeMultiplier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketFeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+func (m *TradingWindow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketFeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TradingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.FeeMultiplier.Size()
-		i -= size
-		if _, err := m.FeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	if m.EndSecond != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.EndSecond))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	if m.StartSecond != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.StartSecond))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DerivativeMarket) Marshal() (dAtA []byte, err error) {
+func (m *MarketTradingSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3804,60 +5076,256 @@ func (m *DerivativeMarket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DerivativeMarket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MarketTradingSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DerivativeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MarketTradingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MinQuantityTickSize.Size()
-		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.MinPriceTickSize.Size()
-		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	if m.Status != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.IsPerpetual {
+	if m.CancelOrdersAtClose {
 		i--
-		if m.IsPerpetual {
+		if m.CancelOrdersAtClose {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x68
-	}
-	{
-		size := m.RelayerFeeShareRate.Size()
-		i -= size
-		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		dAtA[i] = 0x18
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTradeVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTradeVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTradeVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketFeeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketFeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketFeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeMultiplier.Size()
+		i -= size
+		if _, err := m.FeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivativeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativeMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippageRatio.Size()
+		i -= size
+		if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.PriceBandRatio.Size()
+		i -= size
+		if _, err := m.PriceBandRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.MaxPositionSize.Size()
+		i -= size
+		if _, err := m.MaxPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MinQuantityTickSize.Size()
+		i -= size
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.IsPerpetual {
+		i--
+		if m.IsPerpetual {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.RelayerFeeShareRate.Size()
+		i -= size
+		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x62
@@ -3969,6 +5437,18 @@ func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSlippageRatio.Size()
+		i -= size
+		if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	if m.SettlementPrice != nil {
 		{
 			size := m.SettlementPrice.Size()
@@ -4273,7 +5753,7 @@ func (m *PerpetualMarketFunding) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *DerivativeMarketSettlementInfo) Marshal() (dAtA []byte, err error) {
+func (m *FundingRateRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4283,37 +5763,45 @@ func (m *DerivativeMarketSettlementInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DerivativeMarketSettlementInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *FundingRateRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DerivativeMarketSettlementInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FundingRateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.SettlementPrice.Size()
+		size := m.MarkPrice.Size()
 		i -= size
-		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	if m.Timestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NextFundingTimestamp) Marshal() (dAtA []byte, err error) {
+func (m *SubaccountFundingPayment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4323,25 +5811,52 @@ func (m *NextFundingTimestamp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NextFundingTimestamp) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubaccountFundingPayment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NextFundingTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubaccountFundingPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NextTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.NextTimestamp))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Timestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *MidPriceAndTOB) Marshal() (dAtA []byte, err error) {
+func (m *OrderHistoryEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4351,56 +5866,55 @@ func (m *MidPriceAndTOB) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MidPriceAndTOB) MarshalTo(dAtA []byte) (int, error) {
+func (m *OrderHistoryEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MidPriceAndTOB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OrderHistoryEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BestSellPrice != nil {
-		{
-			size := m.BestSellPrice.Size()
-			i -= size
-			if _, err := m.BestSellPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if m.BestBuyPrice != nil {
-		{
-			size := m.BestBuyPrice.Size()
-			i -= size
-			if _, err := m.BestBuyPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BlockTime != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.BlockTime))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.MidPrice != nil {
-		{
-			size := m.MidPrice.Size()
-			i -= size
-			if _, err := m.MidPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SpotMarket) Marshal() (dAtA []byte, err error) {
+func (m *OrderHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4410,52 +5924,44 @@ func (m *SpotMarket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpotMarket) MarshalTo(dAtA []byte) (int, error) {
+func (m *OrderHistory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OrderHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MinQuantityTickSize.Size()
-		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.MinPriceTickSize.Size()
-		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	if m.TerminatedHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.TerminatedHeight))
+		i--
+		dAtA[i] = 0x48
 	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Status != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
+	if m.OmittedFills != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OmittedFills))
 		i--
 		dAtA[i] = 0x40
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0x3a
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
 	{
-		size := m.RelayerFeeShareRate.Size()
+		size := m.FilledQuantity.Size()
 		i -= size
-		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.FilledQuantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -4463,50 +5969,50 @@ func (m *SpotMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x32
 	{
-		size := m.TakerFeeRate.Size()
+		size := m.Quantity.Size()
 		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x2a
-	{
-		size := m.MakerFeeRate.Size()
-		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x20
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.BaseDenom)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Ticker) > 0 {
-		i -= len(m.Ticker)
-		copy(dAtA[i:], m.Ticker)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Ticker)))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *MakerRebateSettlement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4516,40 +6022,84 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MakerRebateSettlement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MakerRebateSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TotalBalance.Size()
-		i -= size
-		if _, err := m.TotalBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.PoolSource != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.PoolSource))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RebateRates) > 0 {
+		for iNdEx := len(m.RebateRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RebateRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
+	if m.EpochStartTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.EpochStartTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivativeMarketSettlementInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativeMarketSettlementInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativeMarketSettlementInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.AvailableBalance.Size()
+		size := m.SettlementPrice.Size()
 		i -= size
-		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountTradeNonce) Marshal() (dAtA []byte, err error) {
+func (m *NextFundingTimestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4559,25 +6109,25 @@ func (m *SubaccountTradeNonce) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubaccountTradeNonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *NextFundingTimestamp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubaccountTradeNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NextFundingTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Nonce))
+	if m.NextTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NextTimestamp))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderInfo) Marshal() (dAtA []byte, err error) {
+func (m *MidPriceAndTOB) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4587,20 +6137,271 @@ func (m *OrderInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *MidPriceAndTOB) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MidPriceAndTOB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Cid)))
+	if m.BestSellPrice != nil {
+		{
+			size := m.BestSellPrice.Size()
+			i -= size
+			if _, err := m.BestSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BestBuyPrice != nil {
+		{
+			size := m.BestBuyPrice.Size()
+			i -= size
+			if _, err := m.BestBuyPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MidPrice != nil {
+		{
+			size := m.MidPrice.Size()
+			i -= size
+			if _, err := m.MidPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippageRatio.Size()
+		i -= size
+		if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinQuantityTickSize.Size()
+		i -= size
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.RelayerFeeShareRate.Size()
+		i -= size
+		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBalance.Size()
+		i -= size
+		if _, err := m.TotalBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.AvailableBalance.Size()
+		i -= size
+		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SubaccountTradeNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountTradeNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountTradeNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Cid)))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4661,6 +6462,18 @@ func (m *SpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSlippageRatio != nil {
+		{
+			size := m.MaxSlippageRatio.Size()
+			i -= size
+			if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.TriggerPrice != nil {
 		{
 			size := m.TriggerPrice.Size()
@@ -4852,11 +6665,23 @@ func (m *DerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.TriggerPrice != nil {
+	if m.MaxSlippageRatio != nil {
 		{
-			size := m.TriggerPrice.Size()
+			size := m.MaxSlippageRatio.Size()
 			i -= size
-			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TriggerPrice != nil {
+		{
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 			i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -6543,6 +8368,132 @@ func (m *Params) Size() (n int) {
 	if m.PostOnlyModeHeightThreshold != 0 {
 		n += 2 + sovExchange(uint64(m.PostOnlyModeHeightThreshold))
 	}
+	if m.MaxOpenOrdersPerSubaccount != 0 {
+		n += 2 + sovExchange(uint64(m.MaxOpenOrdersPerSubaccount))
+	}
+	if m.MaxExpiredOrdersPerBlock != 0 {
+		n += 2 + sovExchange(uint64(m.MaxExpiredOrdersPerBlock))
+	}
+	if m.FundingRateHistorySize != 0 {
+		n += 2 + sovExchange(uint64(m.FundingRateHistorySize))
+	}
+	if m.MaxActiveMarkets != 0 {
+		n += 2 + sovExchange(uint64(m.MaxActiveMarkets))
+	}
+	l = m.MarketCreationFee.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.OrderPlacementSurcharge.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.SpamFeeDestination != 0 {
+		n += 2 + sovExchange(uint64(m.SpamFeeDestination))
+	}
+	if m.IsAutoDeleveragingEnabled {
+		n += 3
+	}
+	if m.MarketInactivityDelistBlocks != 0 {
+		n += 2 + sovExchange(uint64(m.MarketInactivityDelistBlocks))
+	}
+	if m.MakerRebateEpochDuration != 0 {
+		n += 2 + sovExchange(uint64(m.MakerRebateEpochDuration))
+	}
+	l = m.MakerRebateRate.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.MakerRebatePoolSource != 0 {
+		n += 2 + sovExchange(uint64(m.MakerRebatePoolSource))
+	}
+	l = len(m.FeeSettlementDenom)
+	if l > 0 {
+		n += 2 + l + sovExchange(uint64(l))
+	}
+	if m.FeeSettlementOracleType != 0 {
+		n += 2 + sovExchange(uint64(m.FeeSettlementOracleType))
+	}
+	if m.FeeSettlementMaxPriceAge != 0 {
+		n += 2 + sovExchange(uint64(m.FeeSettlementMaxPriceAge))
+	}
+	l = m.FeeSettlementSlippage.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.OrderHistoryRetentionBlocks != 0 {
+		n += 2 + sovExchange(uint64(m.OrderHistoryRetentionBlocks))
+	}
+	if len(m.DustThresholds) > 0 {
+		for _, e := range m.DustThresholds {
+			l = e.Size()
+			n += 2 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.DustSweepDestination != 0 {
+		n += 2 + sovExchange(uint64(m.DustSweepDestination))
+	}
+	if m.DustSweepMaxDepositsPerBlock != 0 {
+		n += 2 + sovExchange(uint64(m.DustSweepMaxDepositsPerBlock))
+	}
+	if m.MaxConditionalOrdersPerSubaccount != 0 {
+		n += 2 + sovExchange(uint64(m.MaxConditionalOrdersPerSubaccount))
+	}
+	if m.SubaccountFundingHistorySize != 0 {
+		n += 2 + sovExchange(uint64(m.SubaccountFundingHistorySize))
+	}
+	l = m.MaxMarketOrderSlippageRatio.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.MakerRebateMaxVolumesPerBlock != 0 {
+		n += 2 + sovExchange(uint64(m.MakerRebateMaxVolumesPerBlock))
+	}
+	return n
+}
+
+func (m *TradingWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSecond != 0 {
+		n += 1 + sovExchange(uint64(m.StartSecond))
+	}
+	if m.EndSecond != 0 {
+		n += 1 + sovExchange(uint64(m.EndSecond))
+	}
+	return n
+}
+
+func (m *MarketTradingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.CancelOrdersAtClose {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockTradeVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovExchange(uint64(m.Timestamp))
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6613,6 +8564,16 @@ func (m *DerivativeMarket) Size() (n int) {
 	n += 1 + l + sovExchange(uint64(l))
 	l = m.MinQuantityTickSize.Size()
 	n += 2 + l + sovExchange(uint64(l))
+	l = m.MaxOpenInterest.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.MaxPositionSize.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.PriceBandRatio.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.MaxSlippageRatio.Size()
+	n += 2 + l + sovExchange(uint64(l))
 	return n
 }
 
@@ -6675,6 +8636,8 @@ func (m *BinaryOptionsMarket) Size() (n int) {
 		l = m.SettlementPrice.Size()
 		n += 2 + l + sovExchange(uint64(l))
 	}
+	l = m.MaxSlippageRatio.Size()
+	n += 2 + l + sovExchange(uint64(l))
 	return n
 }
 
@@ -6740,6 +8703,125 @@ func (m *PerpetualMarketFunding) Size() (n int) {
 	return n
 }
 
+func (m *FundingRateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovExchange(uint64(m.Timestamp))
+	}
+	l = m.FundingRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *SubaccountFundingPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovExchange(uint64(m.Timestamp))
+	}
+	l = m.FundingRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *OrderHistoryEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovExchange(uint64(m.Type))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovExchange(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovExchange(uint64(m.BlockTime))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *OrderHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.FilledQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.OmittedFills != 0 {
+		n += 1 + sovExchange(uint64(m.OmittedFills))
+	}
+	if m.TerminatedHeight != 0 {
+		n += 1 + sovExchange(uint64(m.TerminatedHeight))
+	}
+	return n
+}
+
+func (m *MakerRebateSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStartTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.EpochStartTimestamp))
+	}
+	if len(m.RebateRates) > 0 {
+		for _, e := range m.RebateRates {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.PoolSource != 0 {
+		n += 1 + sovExchange(uint64(m.PoolSource))
+	}
+	return n
+}
+
 func (m *DerivativeMarketSettlementInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6823,6 +8905,8 @@ func (m *SpotMarket) Size() (n int) {
 	n += 1 + l + sovExchange(uint64(l))
 	l = m.MinQuantityTickSize.Size()
 	n += 1 + l + sovExchange(uint64(l))
+	l = m.MaxSlippageRatio.Size()
+	n += 1 + l + sovExchange(uint64(l))
 	return n
 }
 
@@ -6873,6 +8957,9 @@ func (m *OrderInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovExchange(uint64(l))
 	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.ExpirationTimestamp))
+	}
 	return n
 }
 
@@ -6895,6 +8982,10 @@ func (m *SpotOrder) Size() (n int) {
 		l = m.TriggerPrice.Size()
 		n += 1 + l + sovExchange(uint64(l))
 	}
+	if m.MaxSlippageRatio != nil {
+		l = m.MaxSlippageRatio.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
 	return n
 }
 
@@ -6967,6 +9058,10 @@ func (m *DerivativeOrder) Size() (n int) {
 		l = m.TriggerPrice.Size()
 		n += 1 + l + sovExchange(uint64(l))
 	}
+	if m.MaxSlippageRatio != nil {
+		l = m.MaxSlippageRatio.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
 	return n
 }
 
@@ -7569,16 +9664,1455 @@ func (m *DenomDecimals) Size() (n int) {
 	if m.Decimals != 0 {
 		n += 1 + sovExchange(uint64(m.Decimals))
 	}
-	return n
-}
+	return n
+}
+
+func sovExchange(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExchange(x uint64) (n int) {
+	return sovExchange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketInstantListingFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketInstantListingFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DerivativeMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotMakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultSpotMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotTakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultSpotTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeMakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultDerivativeMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeTakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultDerivativeTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInitialMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultInitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFundingInterval", wireType)
+			}
+			m.DefaultFundingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultFundingInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingMultiple", wireType)
+			}
+			m.FundingMultiple = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingMultiple |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyFundingRateCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultHourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultHourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDerivativeOrderSideCount", wireType)
+			}
+			m.MaxDerivativeOrderSideCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDerivativeOrderSideCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjRewardStakedRequirementThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InjRewardStakedRequirementThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardsVestingDuration", wireType)
+			}
+			m.TradingRewardsVestingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradingRewardsVestingDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorRewardShareRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidatorRewardShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarketInstantListingFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BinaryOptionsMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicMarketOrderAccessLevel", wireType)
+			}
+			m.AtomicMarketOrderAccessLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtomicMarketOrderAccessLevel |= AtomicMarketOrderAccessLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotAtomicMarketOrderFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeAtomicMarketOrderFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DerivativeAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsAtomicMarketOrderFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalProtocolFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimalProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInstantDerivativeMarketLaunchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInstantDerivativeMarketLaunchEnabled = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnlyModeHeightThreshold", wireType)
+			}
+			m.PostOnlyModeHeightThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostOnlyModeHeightThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrdersPerSubaccount", wireType)
+			}
+			m.MaxOpenOrdersPerSubaccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrdersPerSubaccount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpiredOrdersPerBlock", wireType)
+			}
+			m.MaxExpiredOrdersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpiredOrdersPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateHistorySize", wireType)
+			}
+			m.FundingRateHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRateHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveMarkets", wireType)
+			}
+			m.MaxActiveMarkets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveMarkets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPlacementSurcharge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPlacementSurcharge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpamFeeDestination", wireType)
+			}
+			m.SpamFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpamFeeDestination |= SpamFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoDeleveragingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAutoDeleveragingEnabled = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketInactivityDelistBlocks", wireType)
+			}
+			m.MarketInactivityDelistBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketInactivityDelistBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateEpochDuration", wireType)
+			}
+			m.MakerRebateEpochDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerRebateEpochDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerRebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebatePoolSource", wireType)
+			}
+			m.MakerRebatePoolSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerRebatePoolSource |= MakerRebatePoolSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSettlementDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSettlementDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSettlementOracleType", wireType)
+			}
+			m.FeeSettlementOracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeSettlementOracleType |= types1.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSettlementMaxPriceAge", wireType)
+			}
+			m.FeeSettlementMaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeSettlementMaxPriceAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSettlementSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSettlementSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHistoryRetentionBlocks", wireType)
+			}
+			m.OrderHistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderHistoryRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThresholds = append(m.DustThresholds, types.DecCoin{})
+			if err := m.DustThresholds[len(m.DustThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepDestination", wireType)
+			}
+			m.DustSweepDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustSweepDestination |= SpamFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepMaxDepositsPerBlock", wireType)
+			}
+			m.DustSweepMaxDepositsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustSweepMaxDepositsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConditionalOrdersPerSubaccount", wireType)
+			}
+			m.MaxConditionalOrdersPerSubaccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConditionalOrdersPerSubaccount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountFundingHistorySize", wireType)
+			}
+			m.SubaccountFundingHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountFundingHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMarketOrderSlippageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMarketOrderSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateMaxVolumesPerBlock", wireType)
+			}
+			m.MakerRebateMaxVolumesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerRebateMaxVolumesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSecond", wireType)
+			}
+			m.StartSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSecond |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSecond", wireType)
+			}
+			m.EndSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSecond |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovExchange(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozExchange(x uint64) (n int) {
-	return sovExchange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MarketTradingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7601,17 +11135,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketTradingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketTradingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketInstantListingFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7621,28 +11155,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketInstantListingFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7669,15 +11202,105 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DerivativeMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, TradingWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelOrdersAtClose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CancelOrdersAtClose = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTradeVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTradeVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTradeVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotMakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7687,29 +11310,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultSpotMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volumes = append(m.Volumes, types.DecCoin{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketFeeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketFeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotTakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7737,13 +11410,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultSpotTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeMakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7771,13 +11442,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultDerivativeMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivativeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivativeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeTakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7805,13 +11526,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultDerivativeTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInitialMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7839,13 +11558,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultInitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OracleBase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7873,15 +11590,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultMaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OracleQuote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFundingInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
 			}
-			m.DefaultFundingInterval = 0
+			m.OracleType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7891,16 +11606,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DefaultFundingInterval |= int64(b&0x7F) << shift
+				m.OracleType |= types1.OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingMultiple", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
 			}
-			m.FundingMultiple = 0
+			m.OracleScaleFactor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7910,14 +11625,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FundingMultiple |= int64(b&0x7F) << shift
+				m.OracleScaleFactor |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7945,13 +11660,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyFundingRateCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7979,13 +11692,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultHourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8013,15 +11724,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultHourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDerivativeOrderSideCount", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
 			}
-			m.MaxDerivativeOrderSideCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8031,14 +11742,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDerivativeOrderSideCount |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InjRewardStakedRequirementThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8066,15 +11792,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InjRewardStakedRequirementThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardsVestingDuration", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
 			}
-			m.TradingRewardsVestingDuration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8084,14 +11810,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TradingRewardsVestingDuration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorRewardShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8119,15 +11860,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidatorRewardShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarketInstantListingFee", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8137,30 +11878,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BinaryOptionsMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 19:
+			m.IsPerpetual = bool(v != 0)
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicMarketOrderAccessLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.AtomicMarketOrderAccessLevel = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8170,14 +11898,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtomicMarketOrderAccessLevel |= AtomicMarketOrderAccessLevel(b&0x7F) << shift
+				m.Status |= MarketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotAtomicMarketOrderFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8205,13 +11933,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeAtomicMarketOrderFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8239,13 +11967,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DerivativeAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsAtomicMarketOrderFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8273,13 +12001,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimalProtocolFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8307,35 +12035,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimalProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInstantDerivativeMarketLaunchEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsInstantDerivativeMarketLaunchEnabled = bool(v != 0)
-		case 25:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostOnlyModeHeightThreshold", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBandRatio", wireType)
 			}
-			m.PostOnlyModeHeightThreshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8345,64 +12053,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PostOnlyModeHeightThreshold |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PriceBandRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketFeeMultiplier: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketFeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8430,11 +12103,13 @@ func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8462,7 +12137,7 @@ func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8487,7 +12162,7 @@ func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
+func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8510,10 +12185,10 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: BinaryOptionsMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BinaryOptionsMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8550,7 +12225,7 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSymbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8578,11 +12253,11 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			m.OracleSymbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleProvider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8610,7 +12285,7 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			m.OracleProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
@@ -8651,6 +12326,76 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTimestamp", wireType)
+			}
+			m.SettlementTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
@@ -8682,7 +12427,7 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			}
 			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -8714,9 +12459,9 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			}
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8744,13 +12489,13 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8778,13 +12523,13 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8812,13 +12557,136 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SettlementPrice = &v
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8843,16 +12711,66 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8880,15 +12798,13 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
 			}
-			var v int
+			m.ExpirationTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8898,17 +12814,16 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPerpetual = bool(v != 0)
-		case 14:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapStartTimestamp", wireType)
 			}
-			m.Status = 0
+			m.TwapStartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8918,14 +12833,14 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarketStatus(b&0x7F) << shift
+				m.TwapStartTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTwapStartPriceCumulative", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8953,13 +12868,13 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpirationTwapStartPriceCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8987,7 +12902,7 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9012,7 +12927,7 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
+func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9035,15 +12950,15 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BinaryOptionsMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerpetualMarketInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BinaryOptionsMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerpetualMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9071,11 +12986,11 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleSymbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyFundingRateCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9103,11 +13018,13 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleSymbol = string(dAtA[iNdEx:postIndex])
+			if err := m.HourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyInterestRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9135,13 +13052,15 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleProvider = string(dAtA[iNdEx:postIndex])
+			if err := m.HourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingTimestamp", wireType)
 			}
-			m.OracleType = 0
+			m.NextFundingTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9151,16 +13070,16 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				m.NextFundingTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingInterval", wireType)
 			}
-			m.OracleScaleFactor = 0
+			m.FundingInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9170,35 +13089,66 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				m.FundingInterval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ExpirationTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpirationTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTimestamp", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SettlementTimestamp = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualMarketFunding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualMarketFunding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9208,14 +13158,29 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9243,13 +13208,15 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.LastTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9259,29 +13226,66 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9291,27 +13295,14 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9339,13 +13330,13 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9373,13 +13364,63 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubaccountFundingPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubaccountFundingPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubaccountFundingPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9407,34 +13448,13 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarketStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9444,29 +13464,14 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9494,13 +13499,13 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9528,9 +13533,7 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.SettlementPrice = &v
-			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9555,7 +13558,7 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
+func (m *OrderHistoryEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9578,17 +13581,17 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderHistoryEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderHistoryEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9598,29 +13601,16 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= OrderHistoryEventType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.ExpirationTimestamp = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9630,16 +13620,16 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapStartTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			m.TwapStartTimestamp = 0
+			m.BlockTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9649,14 +13639,14 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TwapStartTimestamp |= int64(b&0x7F) << shift
+				m.BlockTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTwapStartPriceCumulative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9684,13 +13674,13 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExpirationTwapStartPriceCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9718,7 +13708,7 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9743,7 +13733,7 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
+func (m *OrderHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9753,28 +13743,92 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowExchange
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualMarketInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9802,11 +13856,31 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HourlyFundingRateCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9834,13 +13908,13 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HourlyInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9868,15 +13942,49 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FilledQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, OrderHistoryEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OmittedFills", wireType)
 			}
-			m.NextFundingTimestamp = 0
+			m.OmittedFills = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9886,16 +13994,16 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextFundingTimestamp |= int64(b&0x7F) << shift
+				m.OmittedFills |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedHeight", wireType)
 			}
-			m.FundingInterval = 0
+			m.TerminatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9905,7 +14013,7 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FundingInterval |= int64(b&0x7F) << shift
+				m.TerminatedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9931,7 +14039,7 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
+func (m *MakerRebateSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9954,17 +14062,17 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualMarketFunding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MakerRebateSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualMarketFunding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MakerRebateSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.EpochStartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9974,31 +14082,16 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochStartTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateRates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10008,31 +14101,31 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RebateRates = append(m.RebateRates, types.DecCoin{})
+			if err := m.RebateRates[len(m.RebateRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSource", wireType)
 			}
-			m.LastTimestamp = 0
+			m.PoolSource = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10042,7 +14135,7 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastTimestamp |= int64(b&0x7F) << shift
+				m.PoolSource |= MakerRebatePoolSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10757,6 +14850,40 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -11158,6 +15285,25 @@ func (m *OrderInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -11328,6 +15474,42 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSlippageRatio = &v
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -11944,6 +16126,42 @@ func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSlippageRatio = &v
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
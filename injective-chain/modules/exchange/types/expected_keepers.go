@@ -28,6 +28,7 @@ type BankKeeper interface {
 // OracleKeeper defines the expected oracle keeper methods.
 type OracleKeeper interface {
 	GetPrice(ctx sdk.Context, oracletype oracletypes.OracleType, base string, quote string) *sdk.Dec
+	GetPricePairState(ctx sdk.Context, oracletype oracletypes.OracleType, base, quote string) *oracletypes.PricePairState
 	GetCumulativePrice(ctx sdk.Context, oracleType oracletypes.OracleType, base string, quote string) *sdk.Dec
 	GetHistoricalPriceRecords(ctx sdk.Context, oracleType oracletypes.OracleType, symbol string, from int64) (entry *oracletypes.PriceRecords, omitted bool)
 	GetMixedHistoricalPriceRecords(ctx sdk.Context, baseOracleType, quoteOracleType oracletypes.OracleType, baseSymbol, quoteSymbol string, from int64) (mixed *oracletypes.PriceRecords, ok bool)
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxFeeBitLen is the maximum bit length of an sdk.Dec, beyond which sdk.Dec.Mul panics.
+const maxFeeBitLen = sdkmath.MaxBitLen + sdkmath.LegacyDecimalPrecisionBits - 1
+
+// feeRoundingDivisor scales the product of two sdk.Dec values back to sdk.Dec precision.
+var feeRoundingDivisor = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+
+// CalculateFee returns amount * feeRate, which is the single rounding point for all exchange fee math.
+//
+// The exact product is rounded to sdk.Dec precision (18 decimals) with round half to even (banker's
+// rounding): remainders below one half are dropped, remainders above one half round away from zero and
+// exact half-way values round to the nearest even last digit. The rounding is symmetric around zero, so
+// negative fees (maker rebates) round to the negation of the corresponding positive fee. This matches
+// sdk.Dec.Mul but does not depend on its internals, so the fee paid on every code path stays identical
+// across nodes and releases. As sdk.Dec.Mul, it panics when the fee overflows an sdk.Dec.
+//
+// The product of more than two values is rounded after every multiplication, so callers keep the order in which the
+// values are multiplied.
+func CalculateFee(amount, feeRate sdk.Dec) sdk.Dec {
+	product := new(big.Int).Mul(amount.BigInt(), feeRate.BigInt())
+
+	fee := roundHalfToEven(product, feeRoundingDivisor)
+	if fee.BitLen() > maxFeeBitLen {
+		panic("Int overflow")
+	}
+
+	return sdk.NewDecFromBigIntWithPrec(fee, sdk.Precision)
+}
+
+// roundHalfToEven returns value / divisor rounded half to even, with the sign applied after rounding the absolute value.
+func roundHalfToEven(value, divisor *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(new(big.Int).Abs(value), divisor, new(big.Int))
+
+	switch new(big.Int).Lsh(rem, 1).Cmp(divisor) {
+	case 1:
+		quo.Add(quo, big.NewInt(1))
+	case 0:
+		if quo.Bit(0) == 1 {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	if value.Sign() < 0 {
+		quo.Neg(quo)
+	}
+	return quo
+}
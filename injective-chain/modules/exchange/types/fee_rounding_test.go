@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Fee rounding", func() {
+	smallest := sdk.SmallestDec()
+	half := sdk.NewDecWithPrec(5, 1)
+
+	expectFee := func(amount, feeRate sdk.Dec, expected string) {
+		Expect(types.CalculateFee(amount, feeRate).String()).To(Equal(sdk.MustNewDecFromStr(expected).String()))
+	}
+
+	It("computes fees of regular amounts exactly", func() {
+		expectFee(sdk.NewDec(1000), sdk.NewDecWithPrec(1, 3), "1")
+		expectFee(sdk.MustNewDecFromStr("1234.5678"), sdk.NewDecWithPrec(25, 4), "3.0864195")
+		expectFee(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 3), "0")
+		expectFee(sdk.NewDec(1000), sdk.ZeroDec(), "0")
+	})
+
+	It("rounds half-way values to the nearest even digit", func() {
+		expectFee(smallest, half, "0")
+		expectFee(smallest.MulInt64(3), half, "0.000000000000000002")
+		expectFee(smallest.MulInt64(5), half, "0.000000000000000002")
+		expectFee(smallest.MulInt64(7), half, "0.000000000000000004")
+	})
+
+	It("rounds values off the half-way point to the nearest digit", func() {
+		expectFee(smallest, sdk.MustNewDecFromStr("0.499999999999999999"), "0")
+		expectFee(smallest, sdk.MustNewDecFromStr("0.500000000000000001"), "0.000000000000000001")
+		expectFee(smallest.MulInt64(2), sdk.MustNewDecFromStr("0.7"), "0.000000000000000001")
+	})
+
+	It("rounds very small amounts to zero", func() {
+		expectFee(smallest, smallest, "0")
+		expectFee(sdk.MustNewDecFromStr("0.000000001"), sdk.MustNewDecFromStr("0.0000000001"), "0")
+	})
+
+	It("rounds negative values symmetrically to positive values", func() {
+		expectFee(smallest.Neg(), half, "0")
+		expectFee(smallest.MulInt64(-3), half, "-0.000000000000000002")
+		expectFee(smallest.MulInt64(5), half.Neg(), "-0.000000000000000002")
+		expectFee(sdk.NewDec(1000), sdk.NewDecWithPrec(-1, 4), "-0.1")
+		expectFee(smallest.MulInt64(-2), sdk.MustNewDecFromStr("-0.7"), "0.000000000000000001")
+	})
+
+	It("matches sdk.Dec multiplication", func() {
+		values := []sdk.Dec{
+			sdk.MustNewDecFromStr("98765.432109876543210"),
+			sdk.MustNewDecFromStr("-0.000123456789012345"),
+			sdk.MustNewDecFromStr("0.000000000000000015"),
+			sdk.MustNewDecFromStr("0.0025"),
+			sdk.MustNewDecFromStr("-0.0001"),
+		}
+
+		for _, amount := range values {
+			for _, feeRate := range values {
+				Expect(types.CalculateFee(amount, feeRate).String()).To(Equal(amount.Mul(feeRate).String()))
+			}
+		}
+	})
+
+	It("panics on overflow as sdk.Dec multiplication", func() {
+		large := sdk.NewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200))
+
+		Expect(func() { large.Mul(large) }).To(PanicWith("Int overflow"))
+		Expect(func() { types.CalculateFee(large, large) }).To(PanicWith("Int overflow"))
+		Expect(func() { types.CalculateFee(large, sdk.NewDecWithPrec(1, 3)) }).ToNot(Panic())
+	})
+})
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -101,6 +102,46 @@ type GenesisState struct {
 	OrderbookSequences   []*OrderbookSequence               `protobuf:"bytes,32,rep,name=orderbook_sequences,json=orderbookSequences,proto3" json:"orderbook_sequences,omitempty"`
 	SubaccountVolumes    []*AggregateSubaccountVolumeRecord `protobuf:"bytes,33,rep,name=subaccount_volumes,json=subaccountVolumes,proto3" json:"subaccount_volumes,omitempty"`
 	MarketVolumes        []*MarketVolume                    `protobuf:"bytes,34,rep,name=market_volumes,json=marketVolumes,proto3" json:"market_volumes,omitempty"`
+	// funding_rate_histories contains the funding rate history of the perpetual
+	// markets
+	FundingRateHistories []FundingRateHistory `protobuf:"bytes,35,rep,name=funding_rate_histories,json=fundingRateHistories,proto3" json:"funding_rate_histories"`
+	// market_trading_schedules contains the trading hours of the markets with a
+	// schedule
+	MarketTradingSchedules []*MarketTradingSchedule `protobuf:"bytes,36,rep,name=market_trading_schedules,json=marketTradingSchedules,proto3" json:"market_trading_schedules,omitempty"`
+	// block_trade_volumes contains the trade volume of the blocks within the
+	// rolling trade volume window
+	BlockTradeVolumes []BlockTradeVolumeRecord `protobuf:"bytes,37,rep,name=block_trade_volumes,json=blockTradeVolumes,proto3" json:"block_trade_volumes"`
+	// denom_total_deposits contains the total deposits per denom, which must
+	// match the balances when set
+	DenomTotalDeposits github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,38,rep,name=denom_total_deposits,json=denomTotalDeposits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"denom_total_deposits"`
+	// delisting_exempt_market_ids contains the markets exempted from the
+	// auto-delisting on inactivity
+	DelistingExemptMarketIds []string `protobuf:"bytes,39,rep,name=delisting_exempt_market_ids,json=delistingExemptMarketIds,proto3" json:"delisting_exempt_market_ids,omitempty"`
+	// market_inactive_since_heights contains the heights since which the active
+	// markets are idle
+	MarketInactiveSinceHeights []MarketHeight `protobuf:"bytes,40,rep,name=market_inactive_since_heights,json=marketInactiveSinceHeights,proto3" json:"market_inactive_since_heights"`
+	// market_auto_paused_heights contains the heights at which the idle markets
+	// were paused
+	MarketAutoPausedHeights []MarketHeight `protobuf:"bytes,41,rep,name=market_auto_paused_heights,json=marketAutoPausedHeights,proto3" json:"market_auto_paused_heights"`
+	// maker_rebate_epoch_start_timestamp is the start timestamp of the current
+	// maker rebate epoch
+	MakerRebateEpochStartTimestamp int64 `protobuf:"varint,42,opt,name=maker_rebate_epoch_start_timestamp,json=makerRebateEpochStartTimestamp,proto3" json:"maker_rebate_epoch_start_timestamp,omitempty"`
+	// maker_rebate_volumes contains the maker volumes of the accounts in the
+	// current maker rebate epoch
+	MakerRebateVolumes []MakerRebateAccountVolume `protobuf:"bytes,43,rep,name=maker_rebate_volumes,json=makerRebateVolumes,proto3" json:"maker_rebate_volumes"`
+	// order_histories contains the lifecycle histories of the limit orders
+	OrderHistories []OrderHistory `protobuf:"bytes,44,rep,name=order_histories,json=orderHistories,proto3" json:"order_histories"`
+	// cross_margin_subaccount_ids contains the subaccounts in cross margin mode
+	CrossMarginSubaccountIds []string `protobuf:"bytes,45,rep,name=cross_margin_subaccount_ids,json=crossMarginSubaccountIds,proto3" json:"cross_margin_subaccount_ids,omitempty"`
+	// subaccount_funding_histories contains the funding payments of the
+	// subaccounts
+	SubaccountFundingHistories []SubaccountFundingHistory `protobuf:"bytes,46,rep,name=subaccount_funding_histories,json=subaccountFundingHistories,proto3" json:"subaccount_funding_histories"`
+	// maker_rebate_settlement contains the settlement in progress of the maker
+	// rebates of the previous maker rebate epoch
+	MakerRebateSettlement *MakerRebateSettlement `protobuf:"bytes,47,opt,name=maker_rebate_settlement,json=makerRebateSettlement,proto3" json:"maker_rebate_settlement,omitempty"`
+	// maker_rebate_settlement_volumes contains the maker volumes of the accounts
+	// in the previous maker rebate epoch which are not settled yet
+	MakerRebateSettlementVolumes []MakerRebateAccountVolume `protobuf:"bytes,48,rep,name=maker_rebate_settlement_volumes,json=makerRebateSettlementVolumes,proto3" json:"maker_rebate_settlement_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -374,6 +415,104 @@ func (m *GenesisState) GetMarketVolumes() []*MarketVolume {
 	return nil
 }
 
+func (m *GenesisState) GetFundingRateHistories() []FundingRateHistory {
+	if m != nil {
+		return m.FundingRateHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketTradingSchedules() []*MarketTradingSchedule {
+	if m != nil {
+		return m.MarketTradingSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockTradeVolumes() []BlockTradeVolumeRecord {
+	if m != nil {
+		return m.BlockTradeVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTotalDeposits() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DenomTotalDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelistingExemptMarketIds() []string {
+	if m != nil {
+		return m.DelistingExemptMarketIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketInactiveSinceHeights() []MarketHeight {
+	if m != nil {
+		return m.MarketInactiveSinceHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketAutoPausedHeights() []MarketHeight {
+	if m != nil {
+		return m.MarketAutoPausedHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMakerRebateEpochStartTimestamp() int64 {
+	if m != nil {
+		return m.MakerRebateEpochStartTimestamp
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMakerRebateVolumes() []MakerRebateAccountVolume {
+	if m != nil {
+		return m.MakerRebateVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderHistories() []OrderHistory {
+	if m != nil {
+		return m.OrderHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCrossMarginSubaccountIds() []string {
+	if m != nil {
+		return m.CrossMarginSubaccountIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubaccountFundingHistories() []SubaccountFundingHistory {
+	if m != nil {
+		return m.SubaccountFundingHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMakerRebateSettlement() *MakerRebateSettlement {
+	if m != nil {
+		return m.MakerRebateSettlement
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMakerRebateSettlementVolumes() []MakerRebateAccountVolume {
+	if m != nil {
+		return m.MakerRebateSettlementVolumes
+	}
+	return nil
+}
+
 type OrderbookSequence struct {
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -1018,6 +1157,268 @@ func (m *PerpetualMarketFundingState) GetFunding() *PerpetualMarketFunding {
 	return nil
 }
 
+type FundingRateHistory struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// records defines the funding rate records of the market, oldest first
+	Records []FundingRateRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *FundingRateHistory) Reset()         { *m = FundingRateHistory{} }
+func (m *FundingRateHistory) String() string { return proto.CompactTextString(m) }
+func (*FundingRateHistory) ProtoMessage()    {}
+func (*FundingRateHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c47ec6b98758ed05, []int{15}
+}
+func (m *FundingRateHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingRateHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingRateHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingRateHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRateHistory.Merge(m, src)
+}
+func (m *FundingRateHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingRateHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRateHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingRateHistory proto.InternalMessageInfo
+
+func (m *FundingRateHistory) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *FundingRateHistory) GetRecords() []FundingRateRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type BlockTradeVolumeRecord struct {
+	BlockHeight int64            `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Volume      BlockTradeVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume"`
+}
+
+func (m *BlockTradeVolumeRecord) Reset()         { *m = BlockTradeVolumeRecord{} }
+func (m *BlockTradeVolumeRecord) String() string { return proto.CompactTextString(m) }
+func (*BlockTradeVolumeRecord) ProtoMessage()    {}
+func (*BlockTradeVolumeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c47ec6b98758ed05, []int{16}
+}
+func (m *BlockTradeVolumeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTradeVolumeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTradeVolumeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTradeVolumeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTradeVolumeRecord.Merge(m, src)
+}
+func (m *BlockTradeVolumeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTradeVolumeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTradeVolumeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTradeVolumeRecord proto.InternalMessageInfo
+
+func (m *BlockTradeVolumeRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockTradeVolumeRecord) GetVolume() BlockTradeVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return BlockTradeVolume{}
+}
+
+type MarketHeight struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Height   int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MarketHeight) Reset()         { *m = MarketHeight{} }
+func (m *MarketHeight) String() string { return proto.CompactTextString(m) }
+func (*MarketHeight) ProtoMessage()    {}
+func (*MarketHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c47ec6b98758ed05, []int{17}
+}
+func (m *MarketHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHeight.Merge(m, src)
+}
+func (m *MarketHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketHeight proto.InternalMessageInfo
+
+func (m *MarketHeight) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *MarketHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type MakerRebateAccountVolume struct {
+	Account string                                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Volume  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+}
+
+func (m *MakerRebateAccountVolume) Reset()         { *m = MakerRebateAccountVolume{} }
+func (m *MakerRebateAccountVolume) String() string { return proto.CompactTextString(m) }
+func (*MakerRebateAccountVolume) ProtoMessage()    {}
+func (*MakerRebateAccountVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c47ec6b98758ed05, []int{18}
+}
+func (m *MakerRebateAccountVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MakerRebateAccountVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MakerRebateAccountVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MakerRebateAccountVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakerRebateAccountVolume.Merge(m, src)
+}
+func (m *MakerRebateAccountVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *MakerRebateAccountVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakerRebateAccountVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakerRebateAccountVolume proto.InternalMessageInfo
+
+func (m *MakerRebateAccountVolume) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MakerRebateAccountVolume) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type SubaccountFundingHistory struct {
+	SubaccountId string                     `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Payments     []SubaccountFundingPayment `protobuf:"bytes,2,rep,name=payments,proto3" json:"payments"`
+}
+
+func (m *SubaccountFundingHistory) Reset()         { *m = SubaccountFundingHistory{} }
+func (m *SubaccountFundingHistory) String() string { return proto.CompactTextString(m) }
+func (*SubaccountFundingHistory) ProtoMessage()    {}
+func (*SubaccountFundingHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c47ec6b98758ed05, []int{19}
+}
+func (m *SubaccountFundingHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubaccountFundingHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubaccountFundingHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubaccountFundingHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubaccountFundingHistory.Merge(m, src)
+}
+func (m *SubaccountFundingHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubaccountFundingHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubaccountFundingHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubaccountFundingHistory proto.InternalMessageInfo
+
+func (m *SubaccountFundingHistory) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return ""
+}
+
+func (m *SubaccountFundingHistory) GetPayments() []SubaccountFundingPayment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.exchange.v1beta1.GenesisState")
 	proto.RegisterType((*OrderbookSequence)(nil), "injective.exchange.v1beta1.OrderbookSequence")
@@ -1034,6 +1435,11 @@ func init() {
 	proto.RegisterType((*SubaccountNonce)(nil), "injective.exchange.v1beta1.SubaccountNonce")
 	proto.RegisterType((*ExpiryFuturesMarketInfoState)(nil), "injective.exchange.v1beta1.ExpiryFuturesMarketInfoState")
 	proto.RegisterType((*PerpetualMarketFundingState)(nil), "injective.exchange.v1beta1.PerpetualMarketFundingState")
+	proto.RegisterType((*FundingRateHistory)(nil), "injective.exchange.v1beta1.FundingRateHistory")
+	proto.RegisterType((*BlockTradeVolumeRecord)(nil), "injective.exchange.v1beta1.BlockTradeVolumeRecord")
+	proto.RegisterType((*MarketHeight)(nil), "injective.exchange.v1beta1.MarketHeight")
+	proto.RegisterType((*MakerRebateAccountVolume)(nil), "injective.exchange.v1beta1.MakerRebateAccountVolume")
+	proto.RegisterType((*SubaccountFundingHistory)(nil), "injective.exchange.v1beta1.SubaccountFundingHistory")
 }
 
 func init() {
@@ -1041,123 +1447,158 @@ func init() {
 }
 
 var fileDescriptor_c47ec6b98758ed05 = []byte{
-	// 1851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0xe3, 0xc6,
-	0x19, 0x37, 0xd7, 0x1b, 0x5b, 0xfe, 0xbc, 0xf6, 0xae, 0xc7, 0x8f, 0xa5, 0x1f, 0x91, 0xbc, 0x72,
-	0xbb, 0xd0, 0xb6, 0x59, 0x29, 0xbb, 0x69, 0x90, 0x36, 0x7d, 0x65, 0xb5, 0xb6, 0x5a, 0x03, 0x4e,
-	0x6c, 0xd0, 0x42, 0x0e, 0xe9, 0x83, 0xa0, 0xc8, 0x91, 0x34, 0x59, 0x92, 0xc3, 0x70, 0x86, 0xce,
-	0xfa, 0x16, 0xf4, 0x10, 0xa4, 0xa7, 0xb4, 0x05, 0x0a, 0xf4, 0x18, 0x14, 0x3d, 0xb4, 0x97, 0xfe,
-	0x0f, 0xbd, 0xe5, 0x98, 0xde, 0x8a, 0x1e, 0x82, 0x62, 0xf7, 0xd2, 0x6b, 0xff, 0x83, 0x82, 0x33,
-	0xc3, 0x87, 0x5e, 0x94, 0xec, 0xf6, 0x24, 0x71, 0xe6, 0xfb, 0x7e, 0xbf, 0xdf, 0x3c, 0x3e, 0x7e,
-	0x1f, 0x3f, 0xa8, 0x11, 0xff, 0x43, 0x6c, 0x73, 0x72, 0x81, 0x1b, 0xf8, 0xb9, 0xdd, 0xb7, 0xfc,
-	0x1e, 0x6e, 0x5c, 0x3c, 0xea, 0x60, 0x6e, 0x3d, 0x6a, 0xf4, 0xb0, 0x8f, 0x19, 0x61, 0xf5, 0x20,
-	0xa4, 0x9c, 0xa2, 0x9d, 0xd4, 0xb2, 0x9e, 0x58, 0xd6, 0x95, 0xe5, 0xce, 0x83, 0x02, 0x94, 0xd4,
-	0x58, 0xc0, 0xec, 0x1c, 0x14, 0x98, 0xf2, 0xe7, 0xca, 0x68, 0xa3, 0x47, 0x7b, 0x54, 0xfc, 0x6d,
-	0xc4, 0xff, 0xe4, 0x68, 0xf5, 0x3f, 0xbb, 0x70, 0xeb, 0x27, 0x52, 0xd3, 0x39, 0xb7, 0x38, 0x46,
-	0xef, 0xc0, 0x42, 0x60, 0x85, 0x96, 0xc7, 0x74, 0x6d, 0x5f, 0xab, 0x2d, 0x3f, 0xae, 0xd6, 0x27,
-	0x6b, 0xac, 0x9f, 0x09, 0xcb, 0xe6, 0xcd, 0x2f, 0xbf, 0xae, 0xcc, 0x19, 0xca, 0x0f, 0x1d, 0xc3,
-	0x2d, 0x16, 0x50, 0x6e, 0x7a, 0x56, 0xf8, 0x0c, 0x73, 0xa6, 0xdf, 0xd8, 0x9f, 0xaf, 0x2d, 0x3f,
-	0xbe, 0x5f, 0x84, 0x73, 0x1e, 0x50, 0xfe, 0xae, 0x30, 0x37, 0x96, 0x59, 0xfa, 0x9f, 0xa1, 0x9f,
-	0x01, 0x72, 0x70, 0x48, 0x2e, 0xac, 0xd8, 0x2d, 0x05, 0x9c, 0x17, 0x80, 0xaf, 0x15, 0x01, 0x1e,
-	0xa6, 0x5e, 0x0a, 0x76, 0xcd, 0x19, 0x1a, 0x61, 0xe8, 0x7d, 0x58, 0x15, 0x3a, 0x69, 0xe8, 0xe0,
-	0xb0, 0x43, 0xe9, 0x33, 0xfd, 0xa6, 0x00, 0x7e, 0x30, 0x4d, 0xe9, 0x69, 0xec, 0xd0, 0xa4, 0xf4,
-	0x99, 0x5a, 0xf8, 0x0a, 0x4b, 0x06, 0x63, 0x14, 0xd4, 0x87, 0x8d, 0x9c, 0xe8, 0x0c, 0xfd, 0x15,
-	0x81, 0xde, 0x98, 0x4d, 0xf6, 0x30, 0xc7, 0xba, 0x33, 0x38, 0x25, 0x98, 0x8e, 0xa0, 0xd4, 0xb1,
-	0x5c, 0xcb, 0xb7, 0x31, 0xd3, 0x17, 0x04, 0xfa, 0x41, 0x11, 0x7a, 0x53, 0xda, 0x2a, 0xc4, 0xd4,
-	0x15, 0x19, 0xb0, 0x14, 0x50, 0x46, 0x38, 0xa1, 0x3e, 0xd3, 0x17, 0x05, 0x4e, 0x7d, 0x36, 0x95,
-	0x67, 0xca, 0x4d, 0x41, 0x66, 0x30, 0x88, 0xc0, 0x5d, 0x16, 0x75, 0x2c, 0xdb, 0xa6, 0x91, 0xcf,
-	0x4d, 0x1e, 0x5a, 0x0e, 0x36, 0x7d, 0x2a, 0x94, 0x96, 0x04, 0xc3, 0xb7, 0x0b, 0x77, 0x39, 0x75,
-	0x7d, 0x8f, 0x66, 0x8a, 0x37, 0x33, 0xc4, 0x76, 0x0c, 0x28, 0xe6, 0x18, 0xfa, 0x54, 0x83, 0x7d,
-	0xfc, 0x3c, 0x20, 0xe1, 0xa5, 0xd9, 0x8d, 0x78, 0x14, 0x62, 0xa6, 0x6e, 0x8a, 0x49, 0xfc, 0x2e,
-	0x35, 0x59, 0x7c, 0xad, 0xf5, 0x25, 0x41, 0xfa, 0xdd, 0x22, 0xd2, 0x23, 0x81, 0xd1, 0x92, 0x10,
-	0xf2, 0x92, 0x1c, 0xfb, 0x5d, 0x2a, 0xc2, 0x42, 0x29, 0xd8, 0xc3, 0x05, 0x36, 0x88, 0xc0, 0x66,
-	0x80, 0xc3, 0x00, 0xf3, 0xc8, 0x72, 0xf3, 0x12, 0x74, 0x98, 0x7e, 0xf2, 0x67, 0x89, 0x63, 0x06,
-	0x9a, 0x9c, 0x7c, 0x30, 0x3a, 0x85, 0x7e, 0xa5, 0x41, 0x79, 0x84, 0xab, 0x1b, 0xf9, 0x0e, 0xf1,
-	0x7b, 0x6a, 0xc5, 0xcb, 0x82, 0xf4, 0xad, 0x2b, 0x90, 0xb6, 0xa4, 0x7f, 0x7e, 0xc1, 0xbb, 0xc1,
-	0x64, 0x13, 0xf4, 0x7b, 0x0d, 0xee, 0x8f, 0x84, 0xa7, 0xc9, 0x30, 0xe7, 0x2e, 0xf6, 0xb0, 0xcf,
-	0x4d, 0x66, 0xf7, 0xb1, 0x13, 0xb9, 0xd8, 0xd1, 0x6f, 0x09, 0x31, 0x6f, 0x5f, 0x25, 0x64, 0xcf,
-	0x53, 0x9c, 0xdc, 0x66, 0x1c, 0x38, 0x13, 0xad, 0xce, 0x13, 0x32, 0xf4, 0x16, 0xe8, 0x84, 0x99,
-	0x22, 0xb6, 0x13, 0x16, 0x13, 0xfb, 0x56, 0x27, 0x16, 0xb2, 0xb2, 0xaf, 0xd5, 0x4a, 0xc6, 0x26,
-	0x61, 0x71, 0x20, 0x1f, 0xa9, 0xd9, 0x23, 0x39, 0x89, 0x8e, 0xa0, 0x42, 0x98, 0x99, 0x51, 0xb0,
-	0x51, 0xff, 0x55, 0xe1, 0xbf, 0x47, 0x58, 0x26, 0x97, 0x0d, 0xc3, 0x5c, 0xc0, 0x5e, 0x7c, 0xe1,
-	0xe3, 0xa3, 0x08, 0xf1, 0xc7, 0x56, 0xe8, 0x98, 0xb6, 0xe5, 0x05, 0x16, 0xe9, 0xf9, 0xf2, 0x3a,
-	0xdc, 0x16, 0x2f, 0xd6, 0x37, 0x8b, 0x36, 0xa3, 0x2d, 0xfd, 0x0d, 0xe1, 0xfe, 0x54, 0x79, 0xc7,
-	0xfb, 0x60, 0x6c, 0xf3, 0x49, 0x53, 0xe8, 0x13, 0x0d, 0xbe, 0x39, 0x44, 0x1c, 0x50, 0xea, 0x66,
-	0xec, 0xc9, 0x79, 0xe8, 0x77, 0xa6, 0x07, 0x79, 0x82, 0x2c, 0x79, 0xce, 0x28, 0x75, 0x8d, 0x7b,
-	0x03, 0xd4, 0xf1, 0x50, 0x62, 0x94, 0xec, 0x3d, 0xfa, 0x9d, 0x06, 0xf7, 0x27, 0xad, 0x3d, 0x79,
-	0x19, 0x04, 0x94, 0xf8, 0x9c, 0xe9, 0x6b, 0x42, 0xc3, 0x8f, 0xae, 0xbc, 0x0b, 0x4f, 0x24, 0xcc,
-	0x99, 0x40, 0x31, 0xaa, 0x7c, 0xaa, 0x0d, 0xb2, 0x61, 0xb3, 0x8b, 0xb1, 0xe9, 0x10, 0x26, 0x05,
-	0xa4, 0xdb, 0x80, 0xc4, 0x41, 0x14, 0xc6, 0x65, 0x0b, 0xe3, 0x43, 0xe5, 0x97, 0x2c, 0xd2, 0x58,
-	0xef, 0x8e, 0x0e, 0xa2, 0x8f, 0xe1, 0xd5, 0x01, 0x92, 0xf4, 0xd5, 0x47, 0x70, 0x68, 0x72, 0xee,
-	0xea, 0xeb, 0x62, 0xbd, 0x6f, 0xce, 0x48, 0xa6, 0x56, 0xd0, 0x26, 0x38, 0x6c, 0xb7, 0x4f, 0x8c,
-	0xed, 0xee, 0xf8, 0x29, 0xee, 0xa2, 0x5f, 0x6b, 0x70, 0x30, 0xc0, 0xdc, 0x89, 0xec, 0x38, 0x0e,
-	0x2f, 0xa8, 0x1b, 0x79, 0x38, 0xd1, 0xc1, 0xf4, 0x0d, 0xc1, 0xff, 0xfd, 0x19, 0xf9, 0x9b, 0x02,
-	0xe4, 0x7d, 0x81, 0xa1, 0x08, 0x99, 0x51, 0xe9, 0x16, 0x1b, 0xa0, 0x1f, 0xc0, 0x2e, 0x61, 0x66,
-	0x97, 0x84, 0x8c, 0x9b, 0xb1, 0x26, 0xfb, 0xd2, 0x76, 0xb1, 0xd9, 0x25, 0x3e, 0x61, 0x7d, 0xec,
-	0xe8, 0x9b, 0x22, 0x78, 0xee, 0x12, 0xd6, 0x8a, 0x2d, 0x5a, 0x18, 0x3f, 0x8d, 0xe7, 0x5b, 0x6a,
-	0x1a, 0x7d, 0xae, 0xc1, 0xc3, 0x00, 0xcb, 0x77, 0xd8, 0x6c, 0xf7, 0x78, 0xeb, 0x5a, 0xf7, 0xb8,
-	0xa6, 0x48, 0xda, 0x53, 0xaf, 0xf3, 0x9f, 0x35, 0xa8, 0x4f, 0x50, 0x34, 0xe9, 0x5a, 0xdf, 0x15,
-	0x92, 0x8e, 0xae, 0x7d, 0xad, 0x25, 0x9b, 0xba, 0xdd, 0x0f, 0xc6, 0x29, 0x1d, 0x7f, 0xc9, 0xbf,
-	0x07, 0xdb, 0x52, 0x19, 0x33, 0x69, 0xc0, 0x4d, 0x1a, 0x71, 0xd3, 0x72, 0x9c, 0x10, 0x33, 0x86,
-	0x99, 0xae, 0xef, 0xcf, 0xd7, 0x96, 0x8c, 0x2d, 0x65, 0x70, 0x1a, 0xf0, 0xd3, 0x88, 0x3f, 0x49,
-	0x66, 0x51, 0x07, 0xf4, 0x3e, 0x61, 0x9c, 0x86, 0xc4, 0xb6, 0x5c, 0x95, 0xab, 0x43, 0x6c, 0xd3,
-	0xd0, 0x61, 0xfa, 0xb6, 0x58, 0x4e, 0x6d, 0xda, 0x72, 0xb0, 0x21, 0xed, 0x8d, 0xad, 0x0c, 0x29,
-	0x3f, 0x8e, 0x30, 0x6c, 0x75, 0x88, 0x6f, 0x85, 0x97, 0xb1, 0xba, 0xb8, 0x42, 0x48, 0xab, 0xb9,
-	0x9d, 0xe9, 0xc9, 0xb1, 0x29, 0x3c, 0x4f, 0xa5, 0xa3, 0x2a, 0xe8, 0x36, 0x3a, 0xa3, 0x83, 0x0c,
-	0xf5, 0xe1, 0xf1, 0x58, 0x1a, 0x93, 0x38, 0x2c, 0x4b, 0x47, 0x66, 0x97, 0x86, 0xb9, 0x3c, 0xa5,
-	0xef, 0x8a, 0xed, 0x79, 0x6d, 0x0c, 0xe2, 0xb1, 0xc3, 0xd2, 0xbc, 0xd2, 0xa2, 0x61, 0x96, 0x6d,
-	0x50, 0x1b, 0x6a, 0xb9, 0x2a, 0x77, 0x08, 0x9f, 0xd3, 0x98, 0xc2, 0xc6, 0xa6, 0xed, 0x52, 0x86,
-	0xf5, 0x3d, 0x81, 0x5f, 0xcd, 0x2a, 0xdb, 0x3c, 0x6c, 0x9b, 0xb6, 0x62, 0xd3, 0xa7, 0xb1, 0x65,
-	0x5c, 0x93, 0x3a, 0xd8, 0xa7, 0x9e, 0xe9, 0x60, 0x9b, 0x78, 0x96, 0xcb, 0xf4, 0x57, 0xa7, 0xd7,
-	0xa4, 0x87, 0xb1, 0xc7, 0xa1, 0x72, 0x48, 0x6a, 0x52, 0x27, 0x3f, 0x18, 0xd7, 0x48, 0xf7, 0x6c,
-	0xea, 0x3b, 0xa2, 0x3a, 0xb3, 0x5c, 0x73, 0x5c, 0x81, 0xca, 0xf4, 0xf2, 0xf4, 0x2c, 0xfd, 0x34,
-	0x03, 0x19, 0x53, 0xac, 0x1a, 0x15, 0x7b, 0xe2, 0xbc, 0xa0, 0x88, 0xef, 0x41, 0x52, 0xad, 0x60,
-	0x6c, 0x7a, 0x91, 0xcb, 0x49, 0xe0, 0x12, 0x1c, 0x32, 0xbd, 0x32, 0xfd, 0x1e, 0xa8, 0x1a, 0x04,
-	0xe3, 0x77, 0x53, 0x3f, 0x63, 0xc3, 0x1b, 0x1d, 0x64, 0xe8, 0x97, 0xb0, 0x9e, 0xae, 0xcb, 0x64,
-	0xf8, 0xa3, 0x08, 0x8b, 0xd2, 0x73, 0x5f, 0x70, 0x3c, 0x2c, 0xe2, 0x48, 0xb5, 0x9e, 0x2b, 0x2f,
-	0x03, 0xd1, 0xe1, 0x21, 0x86, 0x3e, 0x04, 0x94, 0x2b, 0x6f, 0xe5, 0xab, 0x96, 0xe9, 0xf7, 0xa6,
-	0xbf, 0x62, 0x9f, 0xf4, 0x7a, 0x21, 0xee, 0x59, 0x1c, 0x67, 0x25, 0xae, 0x7c, 0x87, 0xca, 0x40,
-	0x31, 0xd6, 0xd8, 0xd0, 0x38, 0x43, 0xa7, 0xb0, 0xaa, 0xb6, 0x2c, 0xe1, 0xa9, 0x4e, 0x0f, 0x4a,
-	0xb9, 0x55, 0x0a, 0x7a, 0xc5, 0xcb, 0x3d, 0xb1, 0xea, 0x09, 0xac, 0x8d, 0xac, 0x12, 0xed, 0x40,
-	0x29, 0xd9, 0x27, 0xf1, 0xe5, 0x77, 0xd3, 0x48, 0x9f, 0xd1, 0x2e, 0x2c, 0xa5, 0xd7, 0x5c, 0xbf,
-	0xb1, 0xaf, 0xd5, 0x96, 0x8c, 0x92, 0xa7, 0x2e, 0x72, 0xf5, 0x13, 0x0d, 0xb6, 0x27, 0x26, 0x2e,
-	0xa4, 0xc3, 0xa2, 0x5a, 0x8e, 0x40, 0x5d, 0x32, 0x92, 0x47, 0x74, 0x0c, 0xa5, 0x34, 0x37, 0xde,
-	0x10, 0x89, 0xb8, 0x3e, 0x63, 0x6e, 0x4a, 0x92, 0xe2, 0x22, 0x97, 0x29, 0xb0, 0xfa, 0x17, 0x0d,
-	0x2a, 0x53, 0x72, 0x17, 0xfa, 0x0e, 0x6c, 0xa9, 0xc4, 0xc8, 0xb8, 0x15, 0xc6, 0x79, 0xd9, 0xc3,
-	0x8c, 0x5b, 0x5e, 0x20, 0x74, 0xcd, 0x1b, 0x1b, 0x72, 0xf6, 0x3c, 0x9e, 0x6c, 0x27, 0x73, 0xe8,
-	0x0c, 0x56, 0x07, 0x0f, 0x59, 0x7d, 0xcd, 0x16, 0xc6, 0xe3, 0x93, 0x81, 0x73, 0x5d, 0x19, 0x38,
-	0xce, 0xea, 0x47, 0xb0, 0x32, 0x30, 0x5f, 0xb0, 0x43, 0x2d, 0x58, 0x48, 0x49, 0xb5, 0xda, 0x52,
-	0xb3, 0x1e, 0x47, 0xf6, 0x3f, 0xbf, 0xae, 0xdc, 0xef, 0x11, 0xde, 0x8f, 0x3a, 0x75, 0x9b, 0x7a,
-	0x0d, 0x9b, 0x32, 0x8f, 0x32, 0xf5, 0xf3, 0x90, 0x39, 0xcf, 0x1a, 0xfc, 0x32, 0xc0, 0xac, 0x7e,
-	0x88, 0x6d, 0x43, 0x79, 0x57, 0x3f, 0xd5, 0xa0, 0x3a, 0x43, 0x06, 0x29, 0x14, 0xa2, 0xb2, 0xdb,
-	0x35, 0x85, 0x48, 0xef, 0xea, 0xdf, 0x35, 0x78, 0x30, 0x73, 0xf2, 0x43, 0x3f, 0x84, 0xdd, 0x7c,
-	0xf6, 0x1f, 0x7f, 0x6c, 0x7a, 0x98, 0x66, 0xef, 0xa1, 0xa3, 0xc3, 0xd9, 0xd1, 0xa5, 0xe2, 0xff,
-	0x1f, 0x15, 0x67, 0x72, 0x9e, 0xf2, 0xb1, 0xfa, 0x07, 0x0d, 0x56, 0x06, 0x9a, 0x02, 0x83, 0xd1,
-	0xa2, 0x0d, 0x46, 0x0b, 0xda, 0x83, 0x25, 0xc2, 0x9a, 0xd1, 0xe5, 0x39, 0x71, 0xe4, 0xb1, 0x96,
-	0x8c, 0x6c, 0x00, 0x35, 0x61, 0x41, 0xbc, 0x6c, 0x92, 0x1e, 0xc7, 0xb7, 0xa6, 0xb5, 0x22, 0x4e,
-	0x88, 0x47, 0x24, 0xb5, 0xa1, 0x3c, 0xdf, 0x2e, 0x7d, 0xf6, 0x45, 0x65, 0xee, 0xdf, 0x5f, 0x54,
-	0xe6, 0xaa, 0x7f, 0xd2, 0x60, 0x7d, 0xcc, 0x4b, 0xfa, 0x7f, 0x11, 0xf8, 0xd3, 0x21, 0x81, 0xaf,
-	0xcf, 0xf6, 0x45, 0x57, 0x28, 0xf3, 0x6f, 0xf3, 0x50, 0x2e, 0x4e, 0x2b, 0xc5, 0x8a, 0x3f, 0x80,
-	0x3b, 0x6e, 0x8c, 0x6f, 0x76, 0xa2, 0x4b, 0x53, 0xa9, 0xbb, 0x71, 0x4d, 0x75, 0xab, 0x02, 0xa9,
-	0x19, 0x5d, 0x8a, 0x47, 0x86, 0x7e, 0x01, 0x6b, 0x8a, 0x38, 0x07, 0x2e, 0x97, 0xfe, 0xe8, 0x2a,
-	0x1f, 0xb3, 0x12, 0xfd, 0xb6, 0xc4, 0xca, 0xe0, 0x7f, 0x0e, 0x6b, 0x52, 0x3a, 0xc3, 0xae, 0x9b,
-	0xc0, 0xdf, 0xbc, 0xa6, 0xf6, 0xdb, 0x02, 0xea, 0x1c, 0xbb, 0xae, 0x42, 0x37, 0x01, 0xa5, 0xdf,
-	0xe4, 0x19, 0xfc, 0x2b, 0xd7, 0x55, 0x7f, 0xc7, 0x53, 0x5f, 0xdc, 0x09, 0x41, 0xee, 0x0c, 0x3f,
-	0xd7, 0x60, 0x51, 0xb5, 0x97, 0xd0, 0x01, 0xac, 0xe4, 0x72, 0x63, 0x7a, 0x60, 0xb7, 0xb2, 0xc1,
-	0x63, 0x07, 0x6d, 0xc0, 0x2b, 0xa2, 0x42, 0x51, 0xe9, 0x44, 0x3e, 0xa0, 0x1f, 0x43, 0xc9, 0xc1,
-	0xa2, 0x89, 0x14, 0xef, 0xb2, 0x36, 0xad, 0xa1, 0x75, 0x28, 0x6d, 0x8d, 0xd4, 0x29, 0xa7, 0xe8,
-	0x8f, 0x1a, 0xa0, 0xd1, 0x46, 0xd5, 0x6c, 0xe2, 0x8a, 0xf2, 0x1d, 0x7a, 0x07, 0x4a, 0x49, 0x9b,
-	0x4b, 0x69, 0xfc, 0x46, 0x61, 0x8f, 0x45, 0xd9, 0x1a, 0xa9, 0x57, 0x4e, 0xe4, 0x5f, 0x35, 0xb8,
-	0x3d, 0xd4, 0xeb, 0x9a, 0x4d, 0xa1, 0x0b, 0x5b, 0xe3, 0xdb, 0x6b, 0x2a, 0x95, 0xbe, 0x3e, 0x5b,
-	0x77, 0x2d, 0x6b, 0xa3, 0xa9, 0xb2, 0x71, 0x63, 0x5c, 0x8b, 0x2d, 0x27, 0xf8, 0xb7, 0x1a, 0xec,
-	0x15, 0xf5, 0xc9, 0x8a, 0x23, 0xb5, 0x0d, 0xcb, 0xf9, 0xb6, 0x98, 0x94, 0xfa, 0xc6, 0x35, 0x7a,
-	0x72, 0x06, 0x78, 0xe9, 0xff, 0xea, 0x67, 0x1a, 0xec, 0x16, 0x74, 0xb2, 0x8a, 0x25, 0x9d, 0xc0,
-	0xa2, 0x6a, 0x9b, 0x29, 0x39, 0x8f, 0xaf, 0xde, 0x30, 0x33, 0x12, 0x88, 0x66, 0xff, 0xcb, 0x17,
-	0x65, 0xed, 0xab, 0x17, 0x65, 0xed, 0x5f, 0x2f, 0xca, 0xda, 0x6f, 0x5e, 0x96, 0xe7, 0xbe, 0x7a,
-	0x59, 0x9e, 0xfb, 0xc7, 0xcb, 0xf2, 0xdc, 0x07, 0xef, 0xe5, 0x52, 0xe5, 0x71, 0x42, 0x70, 0x62,
-	0x75, 0x58, 0x23, 0xa5, 0x7b, 0x68, 0xd3, 0x10, 0xe7, 0x1f, 0xfb, 0x16, 0xf1, 0x1b, 0x1e, 0x8d,
-	0xbf, 0x12, 0x58, 0xd6, 0xd8, 0x17, 0x69, 0xb5, 0xb3, 0x20, 0xda, 0xf7, 0x6f, 0xfc, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0x40, 0x0d, 0xb7, 0x6c, 0x18, 0x00, 0x00,
+	// 2404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x17, 0x25, 0x47, 0x1f, 0x4f, 0x1f, 0xb6, 0x46, 0x1f, 0xa6, 0x25, 0x59, 0x5a, 0xaf, 0x12,
+	0x67, 0x1d, 0xdb, 0x2b, 0x7f, 0xa4, 0x48, 0x9b, 0x36, 0x6d, 0x2c, 0x59, 0xaa, 0x15, 0xd8, 0xb1,
+	0x40, 0x2d, 0x5c, 0x20, 0xfd, 0x20, 0xb8, 0xe4, 0xec, 0xee, 0x44, 0x5c, 0x0e, 0xcd, 0x99, 0x75,
+	0x2c, 0xa0, 0x87, 0xb4, 0x05, 0xd2, 0xf4, 0x94, 0x36, 0x40, 0xd1, 0x1c, 0x83, 0xa2, 0x87, 0xb6,
+	0x87, 0xfe, 0x0f, 0xbd, 0xe5, 0x98, 0xde, 0x8a, 0x1e, 0xd2, 0xc2, 0xbe, 0xf4, 0xcf, 0x28, 0x66,
+	0x38, 0x43, 0x72, 0x57, 0xbb, 0xe4, 0x5a, 0xee, 0x49, 0xcb, 0x99, 0xf7, 0x7e, 0xef, 0x37, 0x1f,
+	0xef, 0xcd, 0x7b, 0x33, 0x82, 0x0a, 0x09, 0x3e, 0xc4, 0x2e, 0x27, 0x4f, 0xf0, 0x16, 0x7e, 0xea,
+	0xb6, 0x9c, 0xa0, 0x89, 0xb7, 0x9e, 0xdc, 0xac, 0x63, 0xee, 0xdc, 0xdc, 0x6a, 0xe2, 0x00, 0x33,
+	0xc2, 0xaa, 0x61, 0x44, 0x39, 0x45, 0x2b, 0x89, 0x64, 0x55, 0x4b, 0x56, 0x95, 0xe4, 0xca, 0x95,
+	0x1c, 0x94, 0x44, 0x58, 0xc2, 0xac, 0x6c, 0xe6, 0x88, 0xf2, 0xa7, 0x4a, 0x68, 0xb1, 0x49, 0x9b,
+	0x54, 0xfe, 0xdc, 0x12, 0xbf, 0x54, 0xeb, 0xba, 0x4b, 0x59, 0x9b, 0xb2, 0xad, 0xba, 0xc3, 0x52,
+	0x1d, 0x97, 0x92, 0x20, 0xee, 0x2f, 0x7f, 0xf1, 0x3a, 0xcc, 0xfc, 0x30, 0xe6, 0x7c, 0xc8, 0x1d,
+	0x8e, 0xd1, 0xbb, 0x30, 0x1e, 0x3a, 0x91, 0xd3, 0x66, 0xa6, 0x51, 0x32, 0x2a, 0xd3, 0xb7, 0xca,
+	0xd5, 0xc1, 0x63, 0xa8, 0x1e, 0x48, 0xc9, 0xed, 0x33, 0x5f, 0x7d, 0xb3, 0x31, 0x62, 0x29, 0x3d,
+	0xb4, 0x0f, 0x33, 0x2c, 0xa4, 0xdc, 0x6e, 0x3b, 0xd1, 0x11, 0xe6, 0xcc, 0x1c, 0x2d, 0x8d, 0x55,
+	0xa6, 0x6f, 0x5d, 0xce, 0xc3, 0x39, 0x0c, 0x29, 0x7f, 0x20, 0xc5, 0xad, 0x69, 0x96, 0xfc, 0x66,
+	0xe8, 0xc7, 0x80, 0x3c, 0x1c, 0x91, 0x27, 0x8e, 0x50, 0x4b, 0x00, 0xc7, 0x24, 0xe0, 0xb5, 0x3c,
+	0xc0, 0xbb, 0x89, 0x96, 0x82, 0x9d, 0xf7, 0x7a, 0x5a, 0x18, 0x7a, 0x04, 0x73, 0x92, 0x27, 0x8d,
+	0x3c, 0x1c, 0xd5, 0x29, 0x3d, 0x32, 0xcf, 0x48, 0xe0, 0x2b, 0x45, 0x4c, 0x1f, 0x0a, 0x85, 0x6d,
+	0x4a, 0x8f, 0xd4, 0xc0, 0x67, 0x99, 0x6e, 0x14, 0x28, 0xa8, 0x05, 0x8b, 0x19, 0xd2, 0x29, 0xfa,
+	0x2b, 0x12, 0x7d, 0x6b, 0x38, 0xda, 0xbd, 0x36, 0x16, 0xbc, 0xee, 0x2e, 0x69, 0x69, 0x17, 0x26,
+	0xeb, 0x8e, 0xef, 0x04, 0x2e, 0x66, 0xe6, 0xb8, 0x44, 0xdf, 0xcc, 0x43, 0xdf, 0x8e, 0x65, 0x15,
+	0x62, 0xa2, 0x8a, 0x2c, 0x98, 0x0a, 0x29, 0x23, 0x9c, 0xd0, 0x80, 0x99, 0x13, 0x12, 0xa7, 0x3a,
+	0x1c, 0xcb, 0x03, 0xa5, 0xa6, 0x20, 0x53, 0x18, 0x44, 0xe0, 0x3c, 0xeb, 0xd4, 0x1d, 0xd7, 0xa5,
+	0x9d, 0x80, 0xdb, 0x3c, 0x72, 0x3c, 0x6c, 0x07, 0x54, 0x32, 0x9d, 0x94, 0x16, 0xae, 0xe6, 0xce,
+	0x72, 0xa2, 0xfa, 0x3e, 0x4d, 0x19, 0x2f, 0xa5, 0x88, 0x35, 0x01, 0x28, 0xfb, 0x18, 0xfa, 0xc4,
+	0x80, 0x12, 0x7e, 0x1a, 0x92, 0xe8, 0xd8, 0x6e, 0x74, 0x78, 0x27, 0xc2, 0x4c, 0xed, 0x14, 0x9b,
+	0x04, 0x0d, 0x6a, 0x33, 0xee, 0x70, 0x6c, 0x4e, 0x49, 0xa3, 0xdf, 0xce, 0x33, 0xba, 0x2b, 0x31,
+	0xf6, 0x62, 0x88, 0x78, 0x93, 0xec, 0x07, 0x0d, 0x2a, 0xdd, 0x42, 0x31, 0x58, 0xc3, 0x39, 0x32,
+	0x88, 0xc0, 0x52, 0x88, 0xa3, 0x10, 0xf3, 0x8e, 0xe3, 0x67, 0x29, 0x98, 0x50, 0xbc, 0xf2, 0x07,
+	0x5a, 0x31, 0x05, 0xd5, 0x2b, 0x1f, 0x9e, 0xec, 0x42, 0xbf, 0x34, 0x60, 0xfd, 0x84, 0xad, 0x46,
+	0x27, 0xf0, 0x48, 0xd0, 0x54, 0x23, 0x9e, 0x96, 0x46, 0xdf, 0x7a, 0x01, 0xa3, 0x7b, 0xb1, 0x7e,
+	0x76, 0xc0, 0xab, 0xe1, 0x60, 0x11, 0xf4, 0x7b, 0x03, 0x2e, 0x9f, 0x70, 0x4f, 0x9b, 0x61, 0xce,
+	0x7d, 0xdc, 0xc6, 0x01, 0xb7, 0x99, 0xdb, 0xc2, 0x5e, 0xc7, 0xc7, 0x9e, 0x39, 0x23, 0xc9, 0xbc,
+	0xfd, 0x22, 0x2e, 0x7b, 0x98, 0xe0, 0x64, 0x26, 0x63, 0xd3, 0x1b, 0x28, 0x75, 0xa8, 0x8d, 0xa1,
+	0xb7, 0xc0, 0x24, 0xcc, 0x96, 0xbe, 0xad, 0xad, 0xd8, 0x38, 0x70, 0xea, 0x82, 0xc8, 0x6c, 0xc9,
+	0xa8, 0x4c, 0x5a, 0x4b, 0x84, 0x09, 0x47, 0xde, 0x55, 0xbd, 0xbb, 0x71, 0x27, 0xda, 0x85, 0x0d,
+	0xc2, 0xec, 0xd4, 0x04, 0x3b, 0xa9, 0x3f, 0x27, 0xf5, 0xd7, 0x08, 0x4b, 0xe9, 0xb2, 0x5e, 0x98,
+	0x27, 0xb0, 0x26, 0x36, 0xbc, 0x58, 0x8a, 0x08, 0x7f, 0xe4, 0x44, 0x9e, 0xed, 0x3a, 0xed, 0xd0,
+	0x21, 0xcd, 0x20, 0xde, 0x0e, 0x67, 0x65, 0x60, 0xfd, 0x56, 0xde, 0x64, 0xd4, 0x62, 0x7d, 0x4b,
+	0xaa, 0xef, 0x28, 0x6d, 0x31, 0x0f, 0xd6, 0x05, 0x3e, 0xa8, 0x0b, 0x7d, 0x6c, 0xc0, 0x6b, 0x3d,
+	0x86, 0x43, 0x4a, 0xfd, 0xd4, 0xba, 0x5e, 0x0f, 0xf3, 0x5c, 0xb1, 0x93, 0x6b, 0xe4, 0xd8, 0xce,
+	0x01, 0xa5, 0xbe, 0x75, 0xa9, 0xcb, 0xb4, 0x68, 0xd2, 0x42, 0x7a, 0xee, 0xd1, 0xe7, 0x06, 0x5c,
+	0x1e, 0x34, 0x76, 0x1d, 0x0c, 0x42, 0x4a, 0x02, 0xce, 0xcc, 0x79, 0xc9, 0xe1, 0xfb, 0x2f, 0x3c,
+	0x0b, 0x77, 0x62, 0x98, 0x03, 0x89, 0x62, 0x95, 0x79, 0xa1, 0x0c, 0x72, 0x61, 0xa9, 0x81, 0xb1,
+	0xed, 0x11, 0x16, 0x13, 0x48, 0xa6, 0x01, 0x95, 0x8c, 0x22, 0xbf, 0xdc, 0xc3, 0xf8, 0xae, 0xd2,
+	0xd3, 0x83, 0xb4, 0x16, 0x1a, 0x27, 0x1b, 0xd1, 0x47, 0x70, 0xb1, 0xcb, 0x48, 0x12, 0xfa, 0x08,
+	0x8e, 0x6c, 0xce, 0x7d, 0x73, 0xa1, 0x34, 0x56, 0xb4, 0xea, 0x19, 0x63, 0x6a, 0x04, 0x35, 0x82,
+	0xa3, 0x5a, 0xed, 0xbe, 0x75, 0xa1, 0xd1, 0xbf, 0x8b, 0xfb, 0xe8, 0x37, 0x06, 0x6c, 0x76, 0x59,
+	0xae, 0x77, 0x5c, 0xe1, 0x87, 0x4f, 0xa8, 0xdf, 0x69, 0x63, 0xcd, 0x83, 0x99, 0x8b, 0xd2, 0xfe,
+	0x77, 0x87, 0xb4, 0xbf, 0x2d, 0x41, 0x1e, 0x49, 0x0c, 0x65, 0x90, 0x59, 0x1b, 0x8d, 0x7c, 0x01,
+	0xf4, 0x3d, 0x58, 0x25, 0xcc, 0x6e, 0x90, 0x88, 0x71, 0x5b, 0x70, 0x72, 0x8f, 0x5d, 0x1f, 0xdb,
+	0x0d, 0x12, 0x10, 0xd6, 0xc2, 0x9e, 0xb9, 0x24, 0x9d, 0xe7, 0x3c, 0x61, 0x7b, 0x42, 0x62, 0x0f,
+	0xe3, 0x1d, 0xd1, 0xbf, 0xa7, 0xba, 0xd1, 0x67, 0x06, 0x5c, 0x0f, 0x71, 0x1c, 0xc3, 0x86, 0xdb,
+	0xc7, 0xcb, 0xa7, 0xda, 0xc7, 0x15, 0x65, 0xa4, 0x56, 0xb8, 0x9d, 0xff, 0x6c, 0x40, 0x75, 0x00,
+	0xa3, 0x41, 0xdb, 0xfa, 0xbc, 0xa4, 0xb4, 0x7b, 0xea, 0x6d, 0x1d, 0x5b, 0x53, 0xbb, 0xfb, 0x4a,
+	0x3f, 0xa6, 0xfd, 0x37, 0xf9, 0x77, 0xe0, 0x42, 0xcc, 0x8c, 0xd9, 0x34, 0xe4, 0x36, 0xed, 0x70,
+	0xdb, 0xf1, 0xbc, 0x08, 0x33, 0x86, 0x99, 0x69, 0x96, 0xc6, 0x2a, 0x53, 0xd6, 0xb2, 0x12, 0x78,
+	0x18, 0xf2, 0x87, 0x1d, 0x7e, 0x47, 0xf7, 0xa2, 0x3a, 0x98, 0x2d, 0xc2, 0x38, 0x8d, 0x88, 0xeb,
+	0xf8, 0xea, 0xac, 0x8e, 0xb0, 0x4b, 0x23, 0x8f, 0x99, 0x17, 0xe4, 0x70, 0x2a, 0x45, 0xc3, 0xc1,
+	0x56, 0x2c, 0x6f, 0x2d, 0xa7, 0x48, 0xd9, 0x76, 0x84, 0x61, 0xb9, 0x4e, 0x02, 0x27, 0x3a, 0x16,
+	0xec, 0x44, 0x86, 0x90, 0x64, 0x73, 0x2b, 0xc5, 0x87, 0xe3, 0xb6, 0xd4, 0x7c, 0x18, 0x2b, 0xaa,
+	0x84, 0x6e, 0xb1, 0x7e, 0xb2, 0x91, 0xa1, 0x16, 0xdc, 0xea, 0x6b, 0xc6, 0x26, 0x1e, 0x4b, 0x8f,
+	0x23, 0xbb, 0x41, 0xa3, 0xcc, 0x39, 0x65, 0xae, 0xca, 0xe9, 0xb9, 0xd6, 0x07, 0x71, 0xdf, 0x63,
+	0xc9, 0xb9, 0xb2, 0x47, 0xa3, 0xf4, 0xb4, 0x41, 0x35, 0xa8, 0x64, 0xb2, 0xdc, 0x1e, 0x7c, 0x4e,
+	0x85, 0x09, 0x17, 0xdb, 0xae, 0x4f, 0x19, 0x36, 0xd7, 0x24, 0x7e, 0x39, 0xcd, 0x6c, 0xb3, 0xb0,
+	0x35, 0xba, 0x27, 0x44, 0x77, 0x84, 0xa4, 0xc8, 0x49, 0x3d, 0x1c, 0xd0, 0xb6, 0xed, 0x61, 0x97,
+	0xb4, 0x1d, 0x9f, 0x99, 0x17, 0x8b, 0x73, 0xd2, 0xbb, 0x42, 0xe3, 0xae, 0x52, 0xd0, 0x39, 0xa9,
+	0x97, 0x6d, 0x14, 0x39, 0xd2, 0x25, 0x97, 0x06, 0x9e, 0xcc, 0xce, 0x1c, 0xdf, 0xee, 0x97, 0xa0,
+	0x32, 0x73, 0xbd, 0xf8, 0x94, 0xde, 0x49, 0x41, 0xfa, 0x24, 0xab, 0xd6, 0x86, 0x3b, 0xb0, 0x5f,
+	0x9a, 0x10, 0xfb, 0x40, 0x67, 0x2b, 0x18, 0xdb, 0xed, 0x8e, 0xcf, 0x49, 0xe8, 0x13, 0x1c, 0x31,
+	0x73, 0xa3, 0x78, 0x1f, 0xa8, 0x1c, 0x04, 0xe3, 0x07, 0x89, 0x9e, 0xb5, 0xd8, 0x3e, 0xd9, 0xc8,
+	0xd0, 0xcf, 0x60, 0x21, 0x19, 0x97, 0xcd, 0xf0, 0xe3, 0x0e, 0x96, 0xa9, 0x67, 0x49, 0xda, 0xb8,
+	0x9e, 0x67, 0x23, 0xe1, 0x7a, 0xa8, 0xb4, 0x2c, 0x44, 0x7b, 0x9b, 0x18, 0xfa, 0x10, 0x50, 0x26,
+	0xbd, 0x8d, 0x43, 0x2d, 0x33, 0x2f, 0x15, 0x87, 0xd8, 0x3b, 0xcd, 0x66, 0x84, 0x9b, 0x0e, 0xc7,
+	0x69, 0x8a, 0x1b, 0xc7, 0xd0, 0xd8, 0x51, 0xac, 0x79, 0xd6, 0xd3, 0xce, 0xd0, 0x43, 0x98, 0x53,
+	0x53, 0xa6, 0xed, 0x94, 0x8b, 0x9d, 0x32, 0x9e, 0x2a, 0x05, 0x3d, 0xdb, 0xce, 0x7c, 0x09, 0xf2,
+	0xcb, 0x3a, 0x55, 0x8c, 0x1c, 0x8e, 0x6d, 0xe5, 0xb2, 0x98, 0x99, 0x9b, 0xc5, 0xf1, 0x54, 0x65,
+	0x80, 0x96, 0xc3, 0xf1, 0x3d, 0xa9, 0x77, 0xac, 0x76, 0xdc, 0x62, 0xa3, 0xb7, 0x87, 0x60, 0x86,
+	0x8e, 0xc0, 0x54, 0xe4, 0x75, 0xfc, 0xd4, 0x5e, 0xc2, 0xcc, 0x57, 0xa5, 0xb5, 0x9b, 0xc5, 0xc3,
+	0x50, 0xe1, 0x2f, 0x39, 0x80, 0x97, 0xdb, 0xfd, 0x9a, 0x85, 0xf7, 0x2f, 0xd4, 0x7d, 0xea, 0x1e,
+	0xa9, 0x18, 0xa6, 0xa7, 0xeb, 0x35, 0x69, 0xe7, 0x56, 0x6e, 0x84, 0x11, 0x6a, 0x02, 0x0f, 0x67,
+	0x57, 0x43, 0x8d, 0x6c, 0xbe, 0xde, 0xd3, 0xcb, 0xd0, 0xaf, 0x0c, 0x58, 0x8c, 0x1d, 0x95, 0x53,
+	0x2e, 0xfd, 0x49, 0x96, 0x3e, 0xcc, 0xbc, 0x2c, 0x6d, 0xad, 0x55, 0xe3, 0xb2, 0xbb, 0x2a, 0xca,
+	0xee, 0x8c, 0x9f, 0xba, 0x3b, 0x94, 0x04, 0xdb, 0xb7, 0x05, 0xea, 0x5f, 0xff, 0xbd, 0x71, 0xb5,
+	0x49, 0x78, 0xab, 0x53, 0xaf, 0xba, 0xb4, 0xbd, 0xa5, 0xca, 0xf4, 0xf8, 0xcf, 0x75, 0xe6, 0x1d,
+	0x6d, 0xf1, 0xe3, 0x10, 0x33, 0xad, 0xc3, 0x2c, 0x24, 0xcd, 0xd5, 0x84, 0xb5, 0xbb, 0xca, 0x18,
+	0x7a, 0x07, 0x56, 0x3d, 0xec, 0x13, 0xc6, 0xc5, 0xbc, 0xe2, 0xa7, 0xb8, 0x1d, 0x66, 0xe3, 0x91,
+	0xf9, 0xba, 0x0c, 0x3b, 0x66, 0x22, 0xb2, 0x2b, 0x25, 0x92, 0x08, 0x84, 0x1e, 0xc3, 0x45, 0x2d,
+	0x1d, 0x38, 0x72, 0x62, 0x6c, 0x46, 0x02, 0x17, 0xdb, 0x2d, 0x4c, 0x9a, 0x2d, 0xce, 0xcc, 0xca,
+	0xb0, 0xfb, 0xec, 0x9e, 0x54, 0x50, 0xd3, 0xb5, 0x12, 0x83, 0xee, 0x2b, 0xcc, 0x43, 0x01, 0x19,
+	0x0b, 0x88, 0xed, 0xa0, 0x7a, 0x6d, 0xa7, 0xc3, 0xa9, 0x1d, 0x3a, 0x1d, 0x86, 0xbd, 0xc4, 0xde,
+	0x95, 0x53, 0xd9, 0x3b, 0x1f, 0x23, 0xde, 0xe9, 0x70, 0x7a, 0x20, 0xf1, 0xb4, 0xb1, 0xf7, 0xa0,
+	0xdc, 0x76, 0x8e, 0x70, 0x64, 0x47, 0xb8, 0x2e, 0xf6, 0x39, 0x0e, 0xa9, 0xdb, 0x12, 0xd5, 0x51,
+	0x24, 0xd2, 0xb2, 0x36, 0x66, 0xdc, 0x69, 0x87, 0xe6, 0x1b, 0x25, 0xa3, 0x32, 0x66, 0xad, 0x4b,
+	0x49, 0x4b, 0x0a, 0xee, 0x0a, 0xb9, 0x43, 0x21, 0x56, 0xd3, 0x52, 0xc8, 0x87, 0xc5, 0x2e, 0x2c,
+	0xbd, 0xb7, 0xae, 0x4a, 0xca, 0x6f, 0xe6, 0x53, 0x4e, 0x90, 0xef, 0x64, 0x3d, 0x5b, 0xd1, 0x47,
+	0x19, 0xcb, 0x7a, 0x7b, 0xfd, 0x08, 0xce, 0xca, 0xa0, 0x93, 0x71, 0xcd, 0x6b, 0xc5, 0x73, 0x23,
+	0x43, 0x57, 0xb7, 0x53, 0xce, 0xd1, 0xb4, 0x4d, 0xb8, 0xe3, 0x3b, 0xb0, 0xea, 0x46, 0x94, 0xc9,
+	0x63, 0xb1, 0x49, 0x02, 0x3b, 0x13, 0xc4, 0xc4, 0x8e, 0xb9, 0x1e, 0xef, 0x18, 0x29, 0xf2, 0x40,
+	0x4a, 0xa4, 0x61, 0x4a, 0xec, 0x98, 0x9f, 0xc3, 0x5a, 0x46, 0x43, 0x07, 0x91, 0x94, 0x64, 0xb5,
+	0x78, 0x36, 0x52, 0x40, 0x15, 0x49, 0xba, 0x09, 0xaf, 0xb0, 0xfe, 0xfd, 0x82, 0x3c, 0x81, 0xf3,
+	0x5d, 0x6b, 0x90, 0x39, 0xc1, 0xb7, 0x4a, 0x46, 0x71, 0x28, 0x49, 0xa6, 0x39, 0x3d, 0xc6, 0xad,
+	0xa5, 0x76, 0xbf, 0x66, 0xf4, 0x0b, 0x03, 0x36, 0x06, 0xd8, 0x4a, 0x96, 0xfe, 0xc6, 0x4b, 0x2f,
+	0xfd, 0x5a, 0x5f, 0xe3, 0x6a, 0x13, 0x94, 0xef, 0xc3, 0xfc, 0x89, 0xc3, 0x08, 0xad, 0xc0, 0xa4,
+	0x3e, 0xce, 0xe4, 0x05, 0xdd, 0x19, 0x2b, 0xf9, 0x46, 0xab, 0x30, 0x95, 0x78, 0xbf, 0x39, 0x5a,
+	0x32, 0x2a, 0x53, 0xd6, 0xa4, 0xf2, 0x45, 0xaf, 0xfc, 0xb1, 0x01, 0x17, 0x06, 0xd6, 0x17, 0xc8,
+	0x84, 0x09, 0x35, 0xeb, 0x12, 0x75, 0xca, 0xd2, 0x9f, 0x68, 0x1f, 0x26, 0x93, 0x12, 0x66, 0xb4,
+	0x64, 0x14, 0x1e, 0x0f, 0xa9, 0x09, 0x5d, 0xbb, 0x4c, 0xf0, 0xb8, 0x52, 0x29, 0xff, 0xc5, 0x80,
+	0x8d, 0x82, 0x12, 0x03, 0xbd, 0x09, 0xcb, 0xaa, 0x7e, 0xe9, 0xf5, 0x53, 0x43, 0xfa, 0xe9, 0x62,
+	0xdc, 0xdb, 0xe3, 0x9d, 0x07, 0x30, 0xd7, 0x7d, 0x16, 0x9b, 0xa3, 0xc5, 0x69, 0x53, 0xd7, 0x8a,
+	0x58, 0xb3, 0x5d, 0xa7, 0x6e, 0xf9, 0x31, 0xcc, 0x76, 0xf5, 0xe7, 0xcc, 0xd0, 0x1e, 0x8c, 0x27,
+	0x46, 0x8d, 0xca, 0xd4, 0x76, 0x55, 0xac, 0xed, 0xbf, 0xbe, 0xd9, 0xb8, 0x3c, 0x5c, 0x78, 0xb7,
+	0x94, 0x76, 0xf9, 0x13, 0x03, 0xca, 0x43, 0x24, 0xfa, 0xb9, 0x44, 0x54, 0x11, 0x72, 0x4a, 0x22,
+	0xb1, 0x76, 0xf9, 0x1f, 0x06, 0x5c, 0x19, 0xba, 0x46, 0x11, 0x21, 0x25, 0x5b, 0xa4, 0xf5, 0x5f,
+	0x36, 0x33, 0x4a, 0x8a, 0xac, 0x9e, 0xa5, 0xc3, 0xe9, 0xd2, 0x25, 0xe4, 0xff, 0x1f, 0x17, 0x03,
+	0xb3, 0x4e, 0xf6, 0xb3, 0xfc, 0x85, 0x01, 0xb3, 0x5d, 0x77, 0xb7, 0xdd, 0xde, 0x62, 0x74, 0x7b,
+	0x0b, 0x5a, 0x83, 0x29, 0xc2, 0xb6, 0x3b, 0xc7, 0x87, 0xc4, 0x8b, 0x97, 0x75, 0xd2, 0x4a, 0x1b,
+	0xd0, 0x36, 0x8c, 0xcb, 0xb8, 0xaa, 0xaf, 0xa2, 0xdf, 0x28, 0xba, 0x31, 0xbe, 0x4f, 0xda, 0x24,
+	0x36, 0x6d, 0x29, 0xcd, 0xb7, 0x27, 0x3f, 0xfd, 0x72, 0x63, 0xe4, 0xbf, 0x5f, 0x6e, 0x8c, 0x94,
+	0xff, 0x64, 0xc0, 0x42, 0x9f, 0x5c, 0xfa, 0x65, 0x08, 0xde, 0xeb, 0x21, 0x78, 0x63, 0xb8, 0x8b,
+	0xb7, 0x5c, 0x9a, 0x7f, 0x1f, 0x83, 0xf5, 0xfc, 0xec, 0x3f, 0x9f, 0xf1, 0x07, 0x70, 0xce, 0x17,
+	0xf8, 0x76, 0xbd, 0x73, 0x6c, 0x2b, 0x76, 0xa3, 0xa7, 0x64, 0x37, 0x27, 0x91, 0xb6, 0x3b, 0xc7,
+	0xf2, 0x93, 0xa1, 0x9f, 0xc2, 0xbc, 0x32, 0x9c, 0x01, 0x1f, 0x2b, 0x4e, 0x2f, 0x7b, 0xef, 0x1c,
+	0x63, 0xf4, 0xb3, 0x31, 0x56, 0x0a, 0xff, 0x13, 0x98, 0x8f, 0xa9, 0x33, 0xec, 0xfb, 0x1a, 0xfe,
+	0xcc, 0x29, 0xb9, 0x9f, 0x95, 0x50, 0x87, 0xd8, 0xf7, 0x15, 0xba, 0x0d, 0x28, 0xb9, 0x3a, 0x4d,
+	0xe1, 0x5f, 0x39, 0x2d, 0xfb, 0x73, 0x6d, 0x75, 0x31, 0xaa, 0x0d, 0x64, 0xd6, 0xf0, 0x33, 0x03,
+	0x26, 0xd4, 0x2b, 0x00, 0xda, 0x84, 0xd9, 0xae, 0xd3, 0x5f, 0x2d, 0xd8, 0x0c, 0xcb, 0x9c, 0xf8,
+	0x68, 0x11, 0x5e, 0x91, 0x79, 0xa7, 0x3a, 0x4e, 0xe2, 0x0f, 0xf4, 0x03, 0x98, 0x4c, 0x12, 0xde,
+	0xb1, 0x92, 0x51, 0xf4, 0xee, 0xa0, 0xf2, 0x55, 0x2b, 0x51, 0xca, 0x30, 0xfa, 0xa3, 0x01, 0xe8,
+	0xe4, 0x7b, 0xc2, 0x70, 0xe4, 0xf2, 0xce, 0x3b, 0xf4, 0x2e, 0x4c, 0xea, 0xd7, 0x08, 0xc5, 0xf1,
+	0xd5, 0xdc, 0xab, 0x70, 0x25, 0x6b, 0x25, 0x5a, 0x19, 0x92, 0x7f, 0x33, 0xe0, 0x6c, 0xcf, 0x93,
+	0xc4, 0x70, 0x0c, 0x7d, 0x58, 0xee, 0xff, 0x0a, 0xa2, 0x8e, 0xd2, 0x1b, 0xc3, 0x65, 0x4a, 0xe9,
+	0x6b, 0x87, 0xae, 0xb5, 0xfa, 0xbd, 0x84, 0x64, 0x08, 0xff, 0xce, 0x80, 0xb5, 0xbc, 0xe7, 0x8c,
+	0x7c, 0x4f, 0xad, 0xc1, 0x74, 0xf6, 0xf5, 0x22, 0xa6, 0x7a, 0xfb, 0x14, 0x4f, 0x27, 0x16, 0xb4,
+	0x93, 0xdf, 0xe5, 0x4f, 0x0d, 0x58, 0xcd, 0x79, 0x70, 0xc8, 0xa7, 0x74, 0x1f, 0x26, 0x54, 0xb6,
+	0xa9, 0xe8, 0xdc, 0x7a, 0xf1, 0x77, 0x0d, 0x4b, 0x43, 0x88, 0x5c, 0x08, 0x9d, 0xac, 0x63, 0xf3,
+	0x19, 0x3c, 0x80, 0x09, 0x7d, 0x27, 0x36, 0x5a, 0x7c, 0x8b, 0x90, 0x41, 0xef, 0x2a, 0x25, 0x35,
+	0x46, 0xf9, 0xd7, 0x06, 0x2c, 0xf7, 0x2f, 0x3a, 0xd1, 0x25, 0x98, 0x89, 0xab, 0xd8, 0xb8, 0x2c,
+	0x52, 0x27, 0xe8, 0xb4, 0x6c, 0x8b, 0x4b, 0x1b, 0xf4, 0x5e, 0x57, 0xca, 0x51, 0xf0, 0x16, 0xda,
+	0x6b, 0x46, 0x3f, 0xd7, 0xaa, 0xb4, 0x63, 0x07, 0x66, 0xb2, 0x45, 0x55, 0xfe, 0x2c, 0x2c, 0xc3,
+	0xb8, 0x62, 0x35, 0x2a, 0x59, 0xa9, 0xaf, 0xf2, 0xe7, 0x06, 0x98, 0x83, 0x92, 0xdd, 0x9c, 0x8c,
+	0xa5, 0x7f, 0x78, 0x49, 0x13, 0xaa, 0xb1, 0x97, 0x4a, 0xa8, 0xfe, 0x60, 0x80, 0x39, 0xa8, 0xdc,
+	0x18, 0xce, 0x7f, 0x1f, 0xc1, 0x64, 0xe8, 0x1c, 0x8b, 0xa4, 0x5c, 0xaf, 0xfa, 0x8b, 0xd5, 0x36,
+	0x07, 0xb1, 0xb2, 0x7e, 0x71, 0xd5, 0x58, 0xdb, 0xad, 0xaf, 0x9e, 0xad, 0x1b, 0x5f, 0x3f, 0x5b,
+	0x37, 0xfe, 0xf3, 0x6c, 0xdd, 0xf8, 0xed, 0xf3, 0xf5, 0x91, 0xaf, 0x9f, 0xaf, 0x8f, 0xfc, 0xf3,
+	0xf9, 0xfa, 0xc8, 0x07, 0xef, 0x67, 0xc6, 0xb8, 0xaf, 0x2d, 0xdd, 0x77, 0xea, 0x6c, 0x2b, 0xb1,
+	0x7b, 0xdd, 0xa5, 0x11, 0xce, 0x7e, 0xb6, 0x1c, 0x12, 0x6c, 0xb5, 0xa9, 0xbc, 0x03, 0x49, 0xff,
+	0x41, 0x40, 0xce, 0x47, 0x7d, 0x5c, 0x3e, 0xf3, 0xdf, 0xfe, 0xdf, 0x00, 0xd4, 0xd8, 0x3d, 0xc8,
+	0xb4, 0x20, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -1180,10 +1621,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.MarketVolumes) > 0 {
-		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MakerRebateSettlementVolumes) > 0 {
+		for iNdEx := len(m.MakerRebateSettlementVolumes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MakerRebateSettlementVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1191,15 +1632,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.SubaccountVolumes) > 0 {
-		for iNdEx := len(m.SubaccountVolumes) - 1; iNdEx >= 0; iNdEx-- {
+	if m.MakerRebateSettlement != nil {
+		{
+			size, err := m.MakerRebateSettlement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.SubaccountFundingHistories) > 0 {
+		for iNdEx := len(m.SubaccountFundingHistories) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SubaccountVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SubaccountFundingHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1209,15 +1664,204 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i--
 			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.OrderbookSequences) > 0 {
-		for iNdEx := len(m.OrderbookSequences) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OrderbookSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if len(m.CrossMarginSubaccountIds) > 0 {
+		for iNdEx := len(m.CrossMarginSubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CrossMarginSubaccountIds[iNdEx])
+			copy(dAtA[i:], m.CrossMarginSubaccountIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CrossMarginSubaccountIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.OrderHistories) > 0 {
+		for iNdEx := len(m.OrderHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.MakerRebateVolumes) > 0 {
+		for iNdEx := len(m.MakerRebateVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MakerRebateVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.MakerRebateEpochStartTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MakerRebateEpochStartTimestamp))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.MarketAutoPausedHeights) > 0 {
+		for iNdEx := len(m.MarketAutoPausedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketAutoPausedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MarketInactiveSinceHeights) > 0 {
+		for iNdEx := len(m.MarketInactiveSinceHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketInactiveSinceHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.DelistingExemptMarketIds) > 0 {
+		for iNdEx := len(m.DelistingExemptMarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DelistingExemptMarketIds[iNdEx])
+			copy(dAtA[i:], m.DelistingExemptMarketIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelistingExemptMarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.DenomTotalDeposits) > 0 {
+		for iNdEx := len(m.DenomTotalDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTotalDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.BlockTradeVolumes) > 0 {
+		for iNdEx := len(m.BlockTradeVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockTradeVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.MarketTradingSchedules) > 0 {
+		for iNdEx := len(m.MarketTradingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketTradingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.FundingRateHistories) > 0 {
+		for iNdEx := len(m.FundingRateHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingRateHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.MarketVolumes) > 0 {
+		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SubaccountVolumes) > 0 {
+		for iNdEx := len(m.SubaccountVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OrderbookSequences) > 0 {
+		for iNdEx := len(m.OrderbookSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderbookSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
@@ -2319,60 +2963,268 @@ func (m *PerpetualMarketFundingState) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FundingRateHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FundingRateHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.SpotMarkets) > 0 {
-		for _, e := range m.SpotMarkets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.DerivativeMarkets) > 0 {
-		for _, e := range m.DerivativeMarkets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SpotOrderbook) > 0 {
-		for _, e := range m.SpotOrderbook {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.DerivativeOrderbook) > 0 {
-		for _, e := range m.DerivativeOrderbook {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTradeVolumeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BlockTradeVolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTradeVolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MakerRebateAccountVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MakerRebateAccountVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MakerRebateAccountVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubaccountFundingHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountFundingHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountFundingHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SpotMarkets) > 0 {
+		for _, e := range m.SpotMarkets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DerivativeMarkets) > 0 {
+		for _, e := range m.DerivativeMarkets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpotOrderbook) > 0 {
+		for _, e := range m.SpotOrderbook {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DerivativeOrderbook) > 0 {
+		for _, e := range m.DerivativeOrderbook {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	if len(m.SubaccountTradeNonces) > 0 {
 		for _, e := range m.SubaccountTradeNonces {
@@ -2523,6 +3375,85 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FundingRateHistories) > 0 {
+		for _, e := range m.FundingRateHistories {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketTradingSchedules) > 0 {
+		for _, e := range m.MarketTradingSchedules {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockTradeVolumes) > 0 {
+		for _, e := range m.BlockTradeVolumes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTotalDeposits) > 0 {
+		for _, e := range m.DenomTotalDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelistingExemptMarketIds) > 0 {
+		for _, s := range m.DelistingExemptMarketIds {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketInactiveSinceHeights) > 0 {
+		for _, e := range m.MarketInactiveSinceHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketAutoPausedHeights) > 0 {
+		for _, e := range m.MarketAutoPausedHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MakerRebateEpochStartTimestamp != 0 {
+		n += 2 + sovGenesis(uint64(m.MakerRebateEpochStartTimestamp))
+	}
+	if len(m.MakerRebateVolumes) > 0 {
+		for _, e := range m.MakerRebateVolumes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderHistories) > 0 {
+		for _, e := range m.OrderHistories {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CrossMarginSubaccountIds) > 0 {
+		for _, s := range m.CrossMarginSubaccountIds {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubaccountFundingHistories) > 0 {
+		for _, e := range m.SubaccountFundingHistories {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MakerRebateSettlement != nil {
+		l = m.MakerRebateSettlement.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MakerRebateSettlementVolumes) > 0 {
+		for _, e := range m.MakerRebateSettlementVolumes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2797,20 +3728,107 @@ func (m *PerpetualMarketFundingState) Size() (n int) {
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *FundingRateHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BlockTradeVolumeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *MarketHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MakerRebateAccountVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SubaccountFundingHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
 			if iNdEx >= l {
@@ -3943,61 +4961,45 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateHistories", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrderbookSequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FundingRateHistories = append(m.FundingRateHistories, FundingRateHistory{})
+			if err := m.FundingRateHistories[len(m.FundingRateHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrderbookSequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderbookSequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketTradingSchedules", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4007,16 +5009,31 @@ func (m *OrderbookSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketTradingSchedules = append(m.MarketTradingSchedules, &MarketTradingSchedule{})
+			if err := m.MarketTradingSchedules[len(m.MarketTradingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTradeVolumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4026,77 +5043,63 @@ func (m *OrderbookSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+			m.BlockTradeVolumes = append(m.BlockTradeVolumes, BlockTradeVolumeRecord{})
+			if err := m.BlockTradeVolumes[len(m.BlockTradeVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTotalDeposits", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeeDiscountAccountTierTTL) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DenomTotalDeposits = append(m.DenomTotalDeposits, types.DecCoin{})
+			if err := m.DenomTotalDeposits[len(m.DenomTotalDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeeDiscountAccountTierTTL: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeDiscountAccountTierTTL: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelistingExemptMarketIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4124,11 +5127,11 @@ func (m *FeeDiscountAccountTierTTL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.DelistingExemptMarketIds = append(m.DelistingExemptMarketIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TierTtl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketInactiveSinceHeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4155,35 +5158,844 @@ func (m *FeeDiscountAccountTierTTL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TierTtl == nil {
-				m.TierTtl = &FeeDiscountTierTTL{}
-			}
-			if err := m.TierTtl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketInactiveSinceHeights = append(m.MarketInactiveSinceHeights, MarketHeight{})
+			if err := m.MarketInactiveSinceHeights[len(m.MarketInactiveSinceHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketAutoPausedHeights", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketAutoPausedHeights = append(m.MarketAutoPausedHeights, MarketHeight{})
+			if err := m.MarketAutoPausedHeights[len(m.MarketAutoPausedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateEpochStartTimestamp", wireType)
+			}
+			m.MakerRebateEpochStartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerRebateEpochStartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerRebateVolumes = append(m.MakerRebateVolumes, MakerRebateAccountVolume{})
+			if err := m.MakerRebateVolumes[len(m.MakerRebateVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHistories = append(m.OrderHistories, OrderHistory{})
+			if err := m.OrderHistories[len(m.OrderHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossMarginSubaccountIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossMarginSubaccountIds = append(m.CrossMarginSubaccountIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountFundingHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountFundingHistories = append(m.SubaccountFundingHistories, SubaccountFundingHistory{})
+			if err := m.SubaccountFundingHistories[len(m.SubaccountFundingHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MakerRebateSettlement == nil {
+				m.MakerRebateSettlement = &MakerRebateSettlement{}
+			}
+			if err := m.MakerRebateSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebateSettlementVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MakerRebateSettlementVolumes = append(m.MakerRebateSettlementVolumes, MakerRebateAccountVolume{})
+			if err := m.MakerRebateSettlementVolumes[len(m.MakerRebateSettlementVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderbookSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderbookSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderbookSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDiscountAccountTierTTL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDiscountAccountTierTTL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDiscountAccountTierTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierTtl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TierTtl == nil {
+				m.TierTtl = &FeeDiscountTierTTL{}
+			}
+			if err := m.TierTtl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDiscountBucketVolumeAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDiscountBucketVolumeAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDiscountBucketVolumeAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStartTimestamp", wireType)
+			}
+			m.BucketStartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketStartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountVolume = append(m.AccountVolume, &AccountVolume{})
+			if err := m.AccountVolume[len(m.AccountVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingRewardCampaignAccountPoints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingRewardCampaignAccountPoints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingRewardCampaignAccountPoints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Points.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *FeeDiscountBucketVolumeAccounts) Unmarshal(dAtA []byte) error {
+func (m *TradingRewardCampaignAccountPendingPoints) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4206,17 +6018,17 @@ func (m *FeeDiscountBucketVolumeAccounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeDiscountBucketVolumeAccounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingRewardCampaignAccountPendingPoints: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeDiscountBucketVolumeAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingRewardCampaignAccountPendingPoints: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketStartTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolStartTimestamp", wireType)
 			}
-			m.BucketStartTimestamp = 0
+			m.RewardPoolStartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4226,14 +6038,14 @@ func (m *FeeDiscountBucketVolumeAccounts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketStartTimestamp |= int64(b&0x7F) << shift
+				m.RewardPoolStartTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountVolume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4260,8 +6072,8 @@ func (m *FeeDiscountBucketVolumeAccounts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountVolume = append(m.AccountVolume, &AccountVolume{})
-			if err := m.AccountVolume[len(m.AccountVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccountPoints = append(m.AccountPoints, &TradingRewardCampaignAccountPoints{})
+			if err := m.AccountPoints[len(m.AccountPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4286,7 +6098,7 @@ func (m *FeeDiscountBucketVolumeAccounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountVolume) Unmarshal(dAtA []byte) error {
+func (m *SpotOrderBook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4309,15 +6121,15 @@ func (m *AccountVolume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountVolume: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotOrderBook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4345,13 +6157,33 @@ func (m *AccountVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuySide", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuySide = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4361,23 +6193,23 @@ func (m *AccountVolume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, &SpotLimitOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4402,7 +6234,7 @@ func (m *AccountVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingRewardCampaignAccountPoints) Unmarshal(dAtA []byte) error {
+func (m *DerivativeOrderBook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4425,15 +6257,15 @@ func (m *TradingRewardCampaignAccountPoints) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingRewardCampaignAccountPoints: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivativeOrderBook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingRewardCampaignAccountPoints: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivativeOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4461,11 +6293,115 @@ func (m *TradingRewardCampaignAccountPoints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuySide", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuySide = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &DerivativeLimitOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalDerivativeOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalDerivativeOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4493,65 +6429,81 @@ func (m *TradingRewardCampaignAccountPoints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Points.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitBuyOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitBuyOrders = append(m.LimitBuyOrders, &DerivativeLimitOrder{})
+			if err := m.LimitBuyOrders[len(m.LimitBuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketBuyOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketBuyOrders = append(m.MarketBuyOrders, &DerivativeMarketOrder{})
+			if err := m.MarketBuyOrders[len(m.MarketBuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TradingRewardCampaignAccountPendingPoints) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TradingRewardCampaignAccountPendingPoints: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingRewardCampaignAccountPendingPoints: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolStartTimestamp", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellOrders", wireType)
 			}
-			m.RewardPoolStartTimestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4561,14 +6513,29 @@ func (m *TradingRewardCampaignAccountPendingPoints) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardPoolStartTimestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitSellOrders = append(m.LimitSellOrders, &DerivativeLimitOrder{})
+			if err := m.LimitSellOrders[len(m.LimitSellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketSellOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4595,8 +6562,8 @@ func (m *TradingRewardCampaignAccountPendingPoints) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPoints = append(m.AccountPoints, &TradingRewardCampaignAccountPoints{})
-			if err := m.AccountPoints[len(m.AccountPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketSellOrders = append(m.MarketSellOrders, &DerivativeMarketOrder{})
+			if err := m.MarketSellOrders[len(m.MarketSellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4621,7 +6588,7 @@ func (m *TradingRewardCampaignAccountPendingPoints) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SpotOrderBook) Unmarshal(dAtA []byte) error {
+func (m *Balance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4644,15 +6611,15 @@ func (m *SpotOrderBook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotOrderBook: wiretype end group for non-group")
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4680,13 +6647,13 @@ func (m *SpotOrderBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuySide", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4696,15 +6663,27 @@ func (m *SpotOrderBook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuySide = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4731,8 +6710,10 @@ func (m *SpotOrderBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &SpotLimitOrder{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Deposits == nil {
+				m.Deposits = &Deposit{}
+			}
+			if err := m.Deposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4757,7 +6738,7 @@ func (m *SpotOrderBook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeOrderBook) Unmarshal(dAtA []byte) error {
+func (m *DerivativePosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4780,15 +6761,15 @@ func (m *DerivativeOrderBook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeOrderBook: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivativePosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivativePosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4816,13 +6797,13 @@ func (m *DerivativeOrderBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuySide", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4832,15 +6813,27 @@ func (m *DerivativeOrderBook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuySide = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4867,8 +6860,10 @@ func (m *DerivativeOrderBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &DerivativeLimitOrder{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4893,7 +6888,7 @@ func (m *DerivativeOrderBook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
+func (m *SubaccountNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4916,15 +6911,15 @@ func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConditionalDerivativeOrderBook: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConditionalDerivativeOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4952,11 +6947,11 @@ func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitBuyOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountTradeNonce", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4983,50 +6978,65 @@ func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitBuyOrders = append(m.LimitBuyOrders, &DerivativeLimitOrder{})
-			if err := m.LimitBuyOrders[len(m.LimitBuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountTradeNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketBuyOrders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiryFuturesMarketInfoState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketBuyOrders = append(m.MarketBuyOrders, &DerivativeMarketOrder{})
-			if err := m.MarketBuyOrders[len(m.MarketBuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketInfoState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketInfoState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5036,29 +7046,27 @@ func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitSellOrders = append(m.LimitSellOrders, &DerivativeLimitOrder{})
-			if err := m.LimitSellOrders[len(m.LimitSellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketSellOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5085,8 +7093,10 @@ func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketSellOrders = append(m.MarketSellOrders, &DerivativeMarketOrder{})
-			if err := m.MarketSellOrders[len(m.MarketSellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MarketInfo == nil {
+				m.MarketInfo = &ExpiryFuturesMarketInfo{}
+			}
+			if err := m.MarketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5111,7 +7121,7 @@ func (m *ConditionalDerivativeOrderBook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *PerpetualMarketFundingState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5134,15 +7144,15 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerpetualMarketFundingState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerpetualMarketFundingState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5170,43 +7180,11 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5233,10 +7211,10 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deposits == nil {
-				m.Deposits = &Deposit{}
+			if m.Funding == nil {
+				m.Funding = &PerpetualMarketFunding{}
 			}
-			if err := m.Deposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Funding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5261,7 +7239,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativePosition) Unmarshal(dAtA []byte) error {
+func (m *FundingRateHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5284,15 +7262,15 @@ func (m *DerivativePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundingRateHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundingRateHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5320,13 +7298,13 @@ func (m *DerivativePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5336,27 +7314,98 @@ func (m *DerivativePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, FundingRateRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTradeVolumeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTradeVolumeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTradeVolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5383,10 +7432,7 @@ func (m *DerivativePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5411,7 +7457,7 @@ func (m *DerivativePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountNonce) Unmarshal(dAtA []byte) error {
+func (m *MarketHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5434,15 +7480,15 @@ func (m *SubaccountNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5470,13 +7516,13 @@ func (m *SubaccountNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountTradeNonce", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5486,25 +7532,11 @@ func (m *SubaccountNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SubaccountTradeNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5526,7 +7558,7 @@ func (m *SubaccountNonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpiryFuturesMarketInfoState) Unmarshal(dAtA []byte) error {
+func (m *MakerRebateAccountVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5549,15 +7581,15 @@ func (m *ExpiryFuturesMarketInfoState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpiryFuturesMarketInfoState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MakerRebateAccountVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpiryFuturesMarketInfoState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MakerRebateAccountVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5585,13 +7617,13 @@ func (m *ExpiryFuturesMarketInfoState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5601,25 +7633,55 @@ func (m *ExpiryFuturesMarketInfoState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MarketInfo == nil {
-				m.MarketInfo = &ExpiryFuturesMarketInfo{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			if err := m.MarketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5644,7 +7706,7 @@ func (m *ExpiryFuturesMarketInfoState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualMarketFundingState) Unmarshal(dAtA []byte) error {
+func (m *SubaccountFundingHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5667,15 +7729,15 @@ func (m *PerpetualMarketFundingState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualMarketFundingState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountFundingHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualMarketFundingState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountFundingHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5703,11 +7765,11 @@ func (m *PerpetualMarketFundingState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5734,10 +7796,8 @@ func (m *PerpetualMarketFundingState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Funding == nil {
-				m.Funding = &PerpetualMarketFunding{}
-			}
-			if err := m.Funding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payments = append(m.Payments, SubaccountFundingPayment{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21,6 +21,13 @@ const (
 )
 const PriceDecimalPlaces = 18
 const DefaultQueryOrderbookLimit uint64 = 20
+const MaxSimulatedOrderbookLevels uint64 = 100        // bounds the price levels walked when simulating a market order
+const MaxOrderbookSnapshotDepth uint64 = 100          // bounds the price levels returned per side by the orderbook snapshot query
+const MaxDenomsWithUsageLimit uint64 = 100            // bounds the denoms returned per page by the denoms with usage query
+const MaxOrdersCancelledInMarketPerCall uint32 = 1000 // bounds the orders cancelled by a single CancelAllOrdersInMarket call
+const TradeVolumeWindowSeconds int64 = 24 * 60 * 60   // length of the rolling window of the trade volume of the protocol stats
+const MaxBlockTradeVolumesPrunedPerBlock = 100        // bounds the block trade volumes leaving the rolling window in a block
+const MaxSubaccountBalancesBatchSize = 100            // bounds the subaccounts queried at once by the subaccount balances query
 const Uint64BytesLen = 8
 
 var (
@@ -39,6 +46,7 @@ var (
 	MarketVolumePrefix                   = []byte{0x0c} // prefix for each key to the aggregate volume for a market
 	ParamsKey                            = []byte{0x0d} // prefix for module params
 	SubaccountCidPrefix                  = []byte{0x0e} // prefix for each
+	SubaccountOpenOrderCountPrefix       = []byte{0x0f} // prefix for each key to a Subaccount's number of open limit orders
 
 	DenomDecimalsPrefix              = []byte{0x10} // prefix for denom decimals
 	SpotMarketsPrefix                = []byte{0x11} // prefix for each key to a spot market by (isEnabled, marketID)
@@ -66,6 +74,7 @@ var (
 	PerpetualMarketInfoPrefix                = []byte{0x32} // prefix for each key to a perpetual market's market info
 	ExpiryFuturesMarketInfoPrefix            = []byte{0x33} // prefix for each key to a expiry futures market's market info
 	ExpiryFuturesMarketInfoByTimestampPrefix = []byte{0x34} // prefix for each index key to a expiry futures market's market info
+	PerpetualFundingRateHistoryPrefix        = []byte{0x35} // prefix for each key to a perpetual market's funding rate history: marketID + sequence ⇒ fundingRateRecord
 
 	IsFirstFeeCycleFinishedKey = []byte{0x3c} // key to the fee discount is first cycle finished
 
@@ -110,8 +119,80 @@ var (
 	ConditionalOrderInvalidationFlagPrefix       = []byte{0x78} // prefix for a key to save flags to invalidate conditional orders
 
 	AtomicMarketOrderTakerFeeMultiplierKey = []byte{0x79} // key to store individual market atomic take fee multiplier
+	OrderExpirationIndexPrefix             = []byte{0x7a} // prefix for a key to save resting limit orders by expiration: expirationTimestamp + marketID + direction + orderHash ⇒ isSpot + subaccountID
+	TVLDeltaPrefix                         = []byte{0x7b} // transient prefix for a key to save the change of the total deposits of a denom in the block: denom ⇒ delta
+	MarketOpenInterestPrefix               = []byte{0x7c} // prefix for a key to save the open interest of a derivative market: marketID ⇒ openInterest
+	AutoDeleveragingQueuePrefix            = []byte{0x7d} // transient prefix for a key to save the underwater positions to auto-deleverage in the block: marketID + subaccountID ⇒ []byte{}
+	MarketTradingSchedulePrefix            = []byte{0x7e} // prefix for a key to save the trading hours of a market: marketID ⇒ marketTradingSchedule
+	DenomTotalDepositsPrefix               = []byte{0x7f} // prefix for a key to save the total deposits of a denom: denom ⇒ totalDeposits
+	TotalOpenOrderCountKey                 = []byte{0x80} // key to save the number of resting limit orders of all subaccounts
+	BlockTradeVolumePrefix                 = []byte{0x81} // prefix for a key to save the trade volume of a block within the rolling window: blockHeight ⇒ blockTradeVolume
+	RollingTradeVolumePrefix               = []byte{0x82} // prefix for a key to save the trade volume of a quote denom within the rolling window: denom ⇒ volume
+	MarketBlockTradeVolumePrefix           = []byte{0x83} // transient prefix for a key to save the volume of a market in the block: marketID ⇒ volumeRecord
+	MarketDelistingExemptionPrefix         = []byte{0x84} // prefix for a key to save the markets exempted from the auto-delisting: marketID ⇒ []byte{}
+	MarketInactiveSinceHeightPrefix        = []byte{0x85} // prefix for a key to save the height since which an active market is idle: marketID ⇒ height
+	MarketAutoPausedHeightPrefix           = []byte{0x86} // prefix for a key to save the height at which an idle market was paused: marketID ⇒ height
+	MakerRebateEpochStartKey               = []byte{0x87} // key to save the start timestamp of the current maker rebate epoch
+	MakerRebateVolumePrefix                = []byte{0x88} // prefix for a key to save the maker volume of an account in a maker rebate epoch: epochStart + account + quoteDenom ⇒ volume
+	OrderHistoryPrefix                     = []byte{0x89} // prefix for a key to save the lifecycle history of a limit order: orderHash ⇒ orderHistory
+	OrderHistoryPruningIndexPrefix         = []byte{0x8a} // prefix for a key to save the terminated order histories by terminal height: height + orderHash ⇒ []byte{}
+	DustSweepCursorKey                     = []byte{0x8b} // key to save the deposit key after which the next dust sweep resumes
+	CrossMarginSubaccountPrefix            = []byte{0x8c} // prefix for a key to save the subaccounts in cross margin mode: subaccountID ⇒ []byte{}
+	CrossMarginPositionMarketPrefix        = []byte{0x8d} // prefix for a key to save the markets in which a cross margin subaccount has a position: subaccountID + marketID ⇒ []byte{}
+	SubaccountConditionalOrderCountPrefix  = []byte{0x8e} // prefix for a key to save the number of untriggered conditional orders of a subaccount: subaccountID ⇒ count
+	SubaccountFundingHistoryPrefix         = []byte{0x8f} // prefix for a key to save the funding payments of a subaccount: subaccountID + marketID + sequence ⇒ subaccountFundingPayment
+	MakerRebateTotalVolumePrefix           = []byte{0x90} // prefix for a key to save the maker volume of all accounts in a maker rebate epoch: epochStart + quoteDenom ⇒ volume
+	MakerRebateSettlementKey               = []byte{0x91} // key to save the settlement in progress of the maker rebates of the previous maker rebate epoch
 )
 
+// GetFundingRateHistoryKey provides the key of the funding rate record with the given sequence of a perpetual market
+func GetFundingRateHistoryKey(marketID common.Hash, sequence uint64) []byte {
+	return append(GetFundingRateHistoryPrefix(marketID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetFundingRateHistoryPrefix provides the prefix of the funding rate records of a perpetual market
+func GetFundingRateHistoryPrefix(marketID common.Hash) []byte {
+	return append(PerpetualFundingRateHistoryPrefix, marketID.Bytes()...)
+}
+
+// GetCrossMarginPositionMarketPrefix provides the prefix of the markets in which a cross margin subaccount has a position
+func GetCrossMarginPositionMarketPrefix(subaccountID common.Hash) []byte {
+	return append(CrossMarginPositionMarketPrefix, subaccountID.Bytes()...)
+}
+
+// GetSubaccountFundingHistoryPrefix provides the prefix of the funding payments of a subaccount in every perpetual market
+func GetSubaccountFundingHistoryPrefix(subaccountID common.Hash) []byte {
+	return append(SubaccountFundingHistoryPrefix, subaccountID.Bytes()...)
+}
+
+// GetSubaccountMarketFundingHistoryPrefix provides the prefix of the funding payments of a subaccount in a perpetual market
+func GetSubaccountMarketFundingHistoryPrefix(subaccountID, marketID common.Hash) []byte {
+	return append(GetSubaccountFundingHistoryPrefix(subaccountID), marketID.Bytes()...)
+}
+
+// GetOrderHistoryKey provides the key of the history of a limit order
+func GetOrderHistoryKey(orderHash common.Hash) []byte {
+	return append(OrderHistoryPrefix, orderHash.Bytes()...)
+}
+
+// GetOrderHistoryPruningIndexKey provides the key indexing the history of a limit order terminated at the given height
+func GetOrderHistoryPruningIndexKey(height int64, orderHash common.Hash) []byte {
+	return append(GetOrderHistoryPruningIndexPrefix(height), orderHash.Bytes()...)
+}
+
+// GetOrderHistoryPruningIndexPrefix provides the prefix of the order histories terminated at the given height
+func GetOrderHistoryPruningIndexPrefix(height int64) []byte {
+	return append(OrderHistoryPruningIndexPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetSubaccountOpenOrderCountKey(subaccountID common.Hash) []byte {
+	return append(SubaccountOpenOrderCountPrefix, subaccountID.Bytes()...)
+}
+
+func GetSubaccountConditionalOrderCountKey(subaccountID common.Hash) []byte {
+	return append(SubaccountConditionalOrderCountPrefix, subaccountID.Bytes()...)
+}
+
 func GetSubaccountCidKey(subaccountID common.Hash, cid string) []byte {
 	return append(SubaccountCidPrefix, append(subaccountID.Bytes(), cid...)...)
 }
@@ -506,6 +587,82 @@ func GetBinaryOptionsMarketSettlementTimestampKey(timestamp int64, marketID comm
 	return append(BinaryOptionsMarketSettlementTimestampPrefix, append(sdk.Uint64ToBigEndian(uint64(timestamp)), marketID.Bytes()...)...)
 }
 
+func GetOrderExpirationKey(expirationTimestamp int64, marketID common.Hash, isBuy bool, orderHash common.Hash) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expirationTimestamp)), append(MarketDirectionPrefix(marketID, isBuy), orderHash.Bytes()...)...)
+}
+
+// ParseOrderExpirationKey parses the expiration timestamp, marketID, direction and order hash from an order expiration index key.
+func ParseOrderExpirationKey(key []byte) (expirationTimestamp int64, marketID common.Hash, isBuy bool, orderHash common.Hash) {
+	expirationTimestamp = int64(sdk.BigEndianToUint64(key[:8]))
+	marketID, isBuy = GetMarketIdDirectionFromTransientKey(key[8:])
+	orderHash = common.BytesToHash(key[8+common.HashLength+1:])
+	return expirationTimestamp, marketID, isBuy, orderHash
+}
+
+func GetOrderExpirationValue(isSpot bool, subaccountID common.Hash) []byte {
+	return append(getBoolPrefix(isSpot), subaccountID.Bytes()...)
+}
+
+// ParseOrderExpirationValue parses the market type and subaccountID from an order expiration index value.
+func ParseOrderExpirationValue(value []byte) (isSpot bool, subaccountID common.Hash) {
+	return value[0] == TrueByte, common.BytesToHash(value[1:])
+}
+
+// GetMarketOpenInterestKey provides the key of the open interest of a derivative market
+func GetMarketOpenInterestKey(marketID common.Hash) []byte {
+	return append(MarketOpenInterestPrefix, marketID.Bytes()...)
+}
+
+// GetTVLDeltaKey provides the transient key of the change of the total deposits of a denom in the block
+func GetTVLDeltaKey(denom string) []byte {
+	return append(TVLDeltaPrefix, []byte(denom)...)
+}
+
+// GetAutoDeleveragingQueueKey provides the transient key of an underwater position to auto-deleverage in the block
+func GetAutoDeleveragingQueueKey(marketID, subaccountID common.Hash) []byte {
+	return append(AutoDeleveragingQueuePrefix, MarketSubaccountInfix(marketID, subaccountID)...)
+}
+
+// GetDenomTotalDepositsKey provides the key of the total deposits of a denom
+func GetDenomTotalDepositsKey(denom string) []byte {
+	return append(DenomTotalDepositsPrefix, []byte(denom)...)
+}
+
+// GetBlockTradeVolumeKey provides the key of the trade volume of a block within the rolling window
+func GetBlockTradeVolumeKey(blockHeight int64) []byte {
+	return append(BlockTradeVolumePrefix, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetRollingTradeVolumeKey provides the key of the trade volume of a quote denom within the rolling window
+func GetRollingTradeVolumeKey(denom string) []byte {
+	return append(RollingTradeVolumePrefix, []byte(denom)...)
+}
+
+// GetMakerRebateEpochVolumesPrefix provides the prefix of the maker volumes of the accounts in a maker rebate epoch
+func GetMakerRebateEpochVolumesPrefix(epochStart int64) []byte {
+	return append(MakerRebateVolumePrefix, sdk.Uint64ToBigEndian(uint64(epochStart))...)
+}
+
+// GetMakerRebateVolumeKey provides the key of the maker volume of an account in a quote denom in a maker rebate epoch
+func GetMakerRebateVolumeKey(epochStart int64, account sdk.AccAddress, quoteDenom string) []byte {
+	return append(append(GetMakerRebateEpochVolumesPrefix(epochStart), account.Bytes()...), []byte(quoteDenom)...)
+}
+
+// GetMakerRebateEpochTotalVolumesPrefix provides the prefix of the total maker volumes per quote denom in a maker rebate epoch
+func GetMakerRebateEpochTotalVolumesPrefix(epochStart int64) []byte {
+	return append(MakerRebateTotalVolumePrefix, sdk.Uint64ToBigEndian(uint64(epochStart))...)
+}
+
+// GetMakerRebateTotalVolumeKey provides the key of the total maker volume in a quote denom in a maker rebate epoch
+func GetMakerRebateTotalVolumeKey(epochStart int64, quoteDenom string) []byte {
+	return append(GetMakerRebateEpochTotalVolumesPrefix(epochStart), []byte(quoteDenom)...)
+}
+
+// GetMarketBlockTradeVolumeKey provides the transient key of the volume of a market in the block
+func GetMarketBlockTradeVolumeKey(marketID common.Hash) []byte {
+	return append(MarketBlockTradeVolumePrefix, marketID.Bytes()...)
+}
+
 func GetMarketHistoricalTradeRecordsKey(marketID common.Hash) []byte {
 	return append(MarketHistoricalTradeRecordsPrefix, marketID.Bytes()...)
 }
@@ -114,6 +114,15 @@ func (m *SpotMarket) GetMarketStatus() MarketStatus {
 	return m.Status
 }
 
+// GetMaxSlippageRatio returns the default maximum relative distance of the execution prices of market orders from the
+// top of the book, zero when there is no bound.
+func (m *SpotMarket) GetMaxSlippageRatio() sdk.Dec {
+	if m.MaxSlippageRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.MaxSlippageRatio
+}
+
 func (m *ExpiryFuturesMarketInfo) IsPremature(currBlockTime int64) bool {
 	return currBlockTime < m.TwapStartTimestamp
 }
@@ -227,6 +236,49 @@ func (m *DerivativeMarket) GetMarketStatus() MarketStatus {
 	return m.Status
 }
 
+// GetMaxOpenInterest returns the cap on the open interest of the market, zero when there is no cap.
+func (m *DerivativeMarket) GetMaxOpenInterest() sdk.Dec {
+	if m.MaxOpenInterest.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.MaxOpenInterest
+}
+
+// GetMaxPositionSize returns the cap on the position quantity of a subaccount in the market, zero when there is no cap.
+func (m *DerivativeMarket) GetMaxPositionSize() sdk.Dec {
+	if m.MaxPositionSize.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.MaxPositionSize
+}
+
+// GetPriceBandRatio returns the maximum relative distance of order prices from the mark price, zero when there is no
+// band.
+func (m *DerivativeMarket) GetPriceBandRatio() sdk.Dec {
+	if m.PriceBandRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.PriceBandRatio
+}
+
+// GetMaxLeverage returns the maximum leverage of the positions opened or increased by orders, zero when there is no
+// limit.
+func (m *DerivativeMarket) GetMaxLeverage() sdk.Dec {
+	if m.MaxLeverage.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.MaxLeverage
+}
+
+// GetMaxSlippageRatio returns the default maximum relative distance of the execution prices of market orders from the
+// mark price, zero when there is no bound.
+func (m *DerivativeMarket) GetMaxSlippageRatio() sdk.Dec {
+	if m.MaxSlippageRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.MaxSlippageRatio
+}
+
 /// Binary Options Markets
 //
 
@@ -295,3 +347,12 @@ func (m *BinaryOptionsMarket) GetOracleScaleFactor() uint32 {
 func (m *BinaryOptionsMarket) GetMarketStatus() MarketStatus {
 	return m.Status
 }
+
+// GetMaxSlippageRatio returns the default maximum relative distance of the execution prices of market orders from the
+// top of the book, zero when there is no bound.
+func (m *BinaryOptionsMarket) GetMaxSlippageRatio() sdk.Dec {
+	if m.MaxSlippageRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.MaxSlippageRatio
+}
@@ -52,6 +52,12 @@ var (
 	_ sdk.Msg = &MsgBatchCancelBinaryOptionsOrders{}
 	_ sdk.Msg = &MsgReclaimLockedFunds{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgForceSettleMarket{}
+	_ sdk.Msg = &MsgCancelAllOrdersInMarket{}
+	_ sdk.Msg = &MsgBatchCancelOrders{}
+	_ sdk.Msg = &MsgReplaceOrder{}
+	_ sdk.Msg = &MsgSetMarginMode{}
+	_ sdk.Msg = &MsgTransferPosition{}
 )
 
 // exchange message types
@@ -87,6 +93,12 @@ const (
 	TypeMsgBatchCancelBinaryOptionsOrders   = "batchCancelBinaryOptionsOrders"
 	TypeMsgReclaimLockedFunds               = "reclaimLockedFunds"
 	TypeMsgUpdateParams                     = "updateParams"
+	TypeMsgForceSettleMarket                = "forceSettleMarket"
+	TypeMsgCancelAllOrdersInMarket          = "cancelAllOrdersInMarket"
+	TypeMsgBatchCancelOrders                = "batchCancelOrders"
+	TypeMsgReplaceOrder                     = "replaceOrder"
+	TypeMsgSetMarginMode                    = "setMarginMode"
+	TypeMsgTransferPosition                 = "transferPosition"
 )
 
 func (msg MsgUpdateParams) Route() string { return RouterKey }
@@ -114,6 +126,60 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (msg MsgForceSettleMarket) Route() string { return RouterKey }
+
+func (msg MsgForceSettleMarket) Type() string { return TypeMsgForceSettleMarket }
+
+func (msg MsgForceSettleMarket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return errors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if !SafeIsPositiveDec(msg.SettlementPrice) {
+		return errors.Wrap(ErrInvalidSettlement, "settlement price must be positive")
+	}
+
+	return nil
+}
+
+func (msg *MsgForceSettleMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgForceSettleMarket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelAllOrdersInMarket) Route() string { return RouterKey }
+
+func (msg MsgCancelAllOrdersInMarket) Type() string { return TypeMsgCancelAllOrdersInMarket }
+
+func (msg MsgCancelAllOrdersInMarket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return errors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	return nil
+}
+
+func (msg *MsgCancelAllOrdersInMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgCancelAllOrdersInMarket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
 func (o *SpotOrder) ValidateBasic(senderAddr sdk.AccAddress) error {
 	if !IsHexHash(o.MarketId) {
 		return errors.Wrap(ErrMarketInvalid, o.MarketId)
@@ -130,6 +196,10 @@ func (o *SpotOrder) ValidateBasic(senderAddr sdk.AccAddress) error {
 		return ErrInvalidTriggerPrice
 	}
 
+	if err := validateOrderMaxSlippageRatio(o.MaxSlippageRatio); err != nil {
+		return err
+	}
+
 	if o.OrderInfo.FeeRecipient != "" {
 		_, err := sdk.AccAddressFromBech32(o.OrderInfo.FeeRecipient)
 		if err != nil {
@@ -139,6 +209,21 @@ func (o *SpotOrder) ValidateBasic(senderAddr sdk.AccAddress) error {
 	return o.OrderInfo.ValidateBasic(senderAddr, false, false)
 }
 
+// validateOrderMaxSlippageRatio validates the optional max slippage ratio of an order, which can't disable the bound
+// of the market so must be positive when set.
+func validateOrderMaxSlippageRatio(maxSlippageRatio *sdk.Dec) error {
+	if maxSlippageRatio == nil {
+		return nil
+	}
+	if err := ValidateMaxSlippageRatio(*maxSlippageRatio); err != nil {
+		return errors.Wrap(ErrInvalidMaxSlippageRatio, err.Error())
+	}
+	if maxSlippageRatio.IsZero() {
+		return errors.Wrap(ErrInvalidMaxSlippageRatio, "max slippage ratio of an order must be positive")
+	}
+	return nil
+}
+
 func (o *OrderInfo) ValidateBasic(senderAddr sdk.AccAddress, hasBinaryPriceBand, isDerivative bool) error {
 	if err := CheckValidSubaccountIDOrNonce(senderAddr, o.SubaccountId); err != nil {
 		return err
@@ -148,6 +233,10 @@ func (o *OrderInfo) ValidateBasic(senderAddr sdk.AccAddress, hasBinaryPriceBand,
 		return errors.Wrap(ErrInvalidCid, o.Cid)
 	}
 
+	if o.ExpirationTimestamp < 0 {
+		return errors.Wrapf(ErrInvalidExpirationTimestamp, "expiration timestamp %d must not be negative", o.ExpirationTimestamp)
+	}
+
 	if o.Quantity.IsNil() || o.Quantity.LTE(sdk.ZeroDec()) || o.Quantity.GT(MaxOrderQuantity) {
 		return errors.Wrap(ErrInvalidQuantity, o.Quantity.String())
 	}
@@ -202,6 +291,10 @@ func (o *DerivativeOrder) ValidateBasic(senderAddr sdk.AccAddress, hasBinaryPric
 		return errors.Wrapf(ErrInvalidTriggerPrice, "Mismatch between triggerPrice: %v and orderType: %v, or triggerPrice is incorrect", o.TriggerPrice, o.OrderType)
 	}
 
+	if err := validateOrderMaxSlippageRatio(o.MaxSlippageRatio); err != nil {
+		return err
+	}
+
 	if o.OrderInfo.FeeRecipient != "" {
 		_, err := sdk.AccAddressFromBech32(o.OrderInfo.FeeRecipient)
 		if err != nil {
@@ -790,6 +883,204 @@ func (msg *MsgBatchCancelSpotOrders) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgBatchCancelOrders) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgBatchCancelOrders) Type() string { return TypeMsgBatchCancelOrders }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgBatchCancelOrders) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if len(msg.Data) == 0 {
+		return errors.Wrap(ErrOrderDoesntExist, "must cancel at least 1 order")
+	}
+
+	for idx := range msg.Data {
+		if err := msg.Data[idx].ValidateBasic(senderAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgBatchCancelOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgBatchCancelOrders) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgReplaceOrder) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgReplaceOrder) Type() string { return TypeMsgReplaceOrder }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgReplaceOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if err := msg.OldOrder.ValidateBasic(senderAddr); err != nil {
+		return err
+	}
+
+	var newOrderMarketID string
+	switch {
+	case msg.SpotOrder != nil && msg.DerivativeOrder != nil:
+		return errors.Wrap(ErrOrderInvalid, "must set either a new spot order or a new derivative order")
+	case msg.SpotOrder != nil:
+		if err := msg.SpotOrder.ValidateBasic(senderAddr); err != nil {
+			return err
+		}
+		newOrderMarketID = msg.SpotOrder.MarketId
+	case msg.DerivativeOrder != nil:
+		if err := msg.DerivativeOrder.ValidateBasic(senderAddr, false); err != nil {
+			return err
+		}
+		newOrderMarketID = msg.DerivativeOrder.MarketId
+	default:
+		return errors.Wrap(ErrOrderInvalid, "must set a new spot order or a new derivative order")
+	}
+
+	if common.HexToHash(newOrderMarketID) != common.HexToHash(msg.OldOrder.MarketId) {
+		return errors.Wrapf(ErrMarketInvalid, "new order market %s differs from the replaced order market %s", newOrderMarketID, msg.OldOrder.MarketId)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgReplaceOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgReplaceOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgSetMarginMode) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgSetMarginMode) Type() string { return TypeMsgSetMarginMode }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgSetMarginMode) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+
+	if _, ok := MarginMode_name[int32(msg.MarginMode)]; !ok {
+		return errors.Wrapf(ErrInvalidMarginMode, "unknown margin mode %d", msg.MarginMode)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgSetMarginMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgSetMarginMode) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgTransferPosition) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgTransferPosition) Type() string { return TypeMsgTransferPosition }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgTransferPosition) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return errors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if msg.Quantity.IsNil() || !msg.Quantity.IsPositive() || msg.Quantity.GT(MaxOrderQuantity) {
+		return errors.Wrap(ErrInvalidQuantity, msg.Quantity.String())
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SourceSubaccountId); err != nil {
+		return err
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.DestinationSubaccountId); err != nil {
+		return err
+	}
+
+	sourceSubaccount, err := GetSubaccountIDOrDeriveFromNonce(senderAddr, msg.SourceSubaccountId)
+	if err != nil {
+		return errors.Wrap(ErrBadSubaccountID, msg.SourceSubaccountId)
+	}
+
+	destinationSubaccount, err := GetSubaccountIDOrDeriveFromNonce(senderAddr, msg.DestinationSubaccountId)
+	if err != nil {
+		return errors.Wrap(ErrBadSubaccountID, msg.DestinationSubaccountId)
+	}
+
+	if sourceSubaccount == destinationSubaccount {
+		return errors.Wrap(ErrBadSubaccountID, "source and destination subaccounts must differ")
+	}
+
+	if !bytes.Equal(SubaccountIDToSdkAddress(sourceSubaccount).Bytes(), SubaccountIDToSdkAddress(destinationSubaccount).Bytes()) {
+		return errors.Wrap(ErrBadSubaccountID, msg.DestinationSubaccountId)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgTransferPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgTransferPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 // Route should return the name of the module
 func (msg MsgCreateDerivativeLimitOrder) Route() string { return RouterKey }
 
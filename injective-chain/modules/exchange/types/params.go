@@ -6,6 +6,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -33,6 +35,48 @@ const (
 	// MaxDerivativeOrderSideCount is 20
 	MaxDerivativeOrderSideCount uint32 = 20
 
+	// DefaultMaxOpenOrdersPerSubaccount is 1000. This is the number of resting limit orders a subaccount can have open across all markets, zero meaning no cap.
+	DefaultMaxOpenOrdersPerSubaccount uint32 = 1000
+
+	// DefaultMaxConditionalOrdersPerSubaccount is 100. This is the number of untriggered conditional orders a subaccount can have across all markets.
+	DefaultMaxConditionalOrdersPerSubaccount uint32 = 100
+
+	// DefaultMaxExpiredOrdersPerBlock is 100. This is the number of expired limit orders cancelled in a single block.
+	DefaultMaxExpiredOrdersPerBlock uint32 = 100
+
+	// DefaultFundingRateHistorySize is 720. This is the number of past hourly funding rates kept per perpetual market (30 days).
+	DefaultFundingRateHistorySize uint32 = 720
+
+	// MaxFundingRateHistorySize is 8760. This caps the funding rate history at a year of hourly fundings per perpetual market.
+	MaxFundingRateHistorySize uint32 = 8760
+
+	// DefaultSubaccountFundingHistorySize is 720. This is the number of past funding payments kept per subaccount and perpetual market (30 days of hourly fundings).
+	DefaultSubaccountFundingHistorySize uint32 = 720
+
+	// MaxSubaccountFundingHistorySize is 8760. This caps the funding payment history at a year of hourly fundings per subaccount and perpetual market.
+	MaxSubaccountFundingHistorySize uint32 = 8760
+
+	// DefaultOrderHistoryRetentionBlocks is 86400. This is the number of blocks the history of a limit order is kept after it terminates (about a day).
+	DefaultOrderHistoryRetentionBlocks int64 = 86400
+
+	// MaxOrderHistoryRetentionBlocks is 2592000. This caps the retention of the order histories at about a month of blocks.
+	MaxOrderHistoryRetentionBlocks int64 = 2592000
+
+	// MaxOrderHistoryFills is 100. This is the number of fills recorded individually in the history of a limit order.
+	MaxOrderHistoryFills = 100
+
+	// DefaultMakerRebateMaxVolumesPerBlock is 1000. This is the number of account maker volumes of an ended maker rebate epoch settled in a single block.
+	DefaultMakerRebateMaxVolumesPerBlock uint32 = 1000
+
+	// MaxMakerRebateMaxVolumesPerBlock is 10000. This caps the number of account maker volumes settled in a single block.
+	MaxMakerRebateMaxVolumesPerBlock uint32 = 10000
+
+	// MaxDustSweepDepositsPerBlock is 10000. This caps the number of deposits checked for dust in a single block.
+	MaxDustSweepDepositsPerBlock uint32 = 10000
+
+	// DefaultMaxActiveMarkets is 0, which means the number of active markets is not limited.
+	DefaultMaxActiveMarkets uint32 = 0
+
 	MaxOracleScaleFactor uint32 = 18
 
 	MaxTickerLength int = 40
@@ -87,6 +131,30 @@ var (
 	KeyMinimalProtocolFeeRate                      = []byte("MinimalProtocolFeeRate")
 	KeyIsInstantDerivativeMarketLaunchEnabled      = []byte("IsInstantDerivativeMarketLaunchEnabled")
 	KeyPostOnlyModeHeightThreshold                 = []byte("PostOnlyModeHeightThreshold")
+	KeyMaxOpenOrdersPerSubaccount                  = []byte("MaxOpenOrdersPerSubaccount")
+	KeyMaxExpiredOrdersPerBlock                    = []byte("MaxExpiredOrdersPerBlock")
+	KeyFundingRateHistorySize                      = []byte("FundingRateHistorySize")
+	KeyMaxActiveMarkets                            = []byte("MaxActiveMarkets")
+	KeyMarketCreationFee                           = []byte("MarketCreationFee")
+	KeyOrderPlacementSurcharge                     = []byte("OrderPlacementSurcharge")
+	KeySpamFeeDestination                          = []byte("SpamFeeDestination")
+	KeyIsAutoDeleveragingEnabled                   = []byte("IsAutoDeleveragingEnabled")
+	KeyMarketInactivityDelistBlocks                = []byte("MarketInactivityDelistBlocks")
+	KeyMakerRebateEpochDuration                    = []byte("MakerRebateEpochDuration")
+	KeyMakerRebateRate                             = []byte("MakerRebateRate")
+	KeyMakerRebatePoolSource                       = []byte("MakerRebatePoolSource")
+	KeyFeeSettlementDenom                          = []byte("FeeSettlementDenom")
+	KeyFeeSettlementOracleType                     = []byte("FeeSettlementOracleType")
+	KeyFeeSettlementMaxPriceAge                    = []byte("FeeSettlementMaxPriceAge")
+	KeyFeeSettlementSlippage                       = []byte("FeeSettlementSlippage")
+	KeyOrderHistoryRetentionBlocks                 = []byte("OrderHistoryRetentionBlocks")
+	KeyDustThresholds                              = []byte("DustThresholds")
+	KeyDustSweepDestination                        = []byte("DustSweepDestination")
+	KeyDustSweepMaxDepositsPerBlock                = []byte("DustSweepMaxDepositsPerBlock")
+	KeyMaxConditionalOrdersPerSubaccount           = []byte("MaxConditionalOrdersPerSubaccount")
+	KeySubaccountFundingHistorySize                = []byte("SubaccountFundingHistorySize")
+	KeyMaxMarketOrderSlippageRatio                 = []byte("MaxMarketOrderSlippageRatio")
+	KeyMakerRebateMaxVolumesPerBlock               = []byte("MakerRebateMaxVolumesPerBlock")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -178,6 +246,30 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMinimalProtocolFeeRate, &p.MinimalProtocolFeeRate, ValidateFee),
 		paramtypes.NewParamSetPair(KeyIsInstantDerivativeMarketLaunchEnabled, &p.IsInstantDerivativeMarketLaunchEnabled, validateBool),
 		paramtypes.NewParamSetPair(KeyPostOnlyModeHeightThreshold, &p.PostOnlyModeHeightThreshold, validatePostOnlyModeHeightThreshold),
+		paramtypes.NewParamSetPair(KeyMaxOpenOrdersPerSubaccount, &p.MaxOpenOrdersPerSubaccount, validateMaxOpenOrdersPerSubaccount),
+		paramtypes.NewParamSetPair(KeyMaxExpiredOrdersPerBlock, &p.MaxExpiredOrdersPerBlock, validateMaxExpiredOrdersPerBlock),
+		paramtypes.NewParamSetPair(KeyFundingRateHistorySize, &p.FundingRateHistorySize, validateFundingRateHistorySize),
+		paramtypes.NewParamSetPair(KeyMaxActiveMarkets, &p.MaxActiveMarkets, validateMaxActiveMarkets),
+		paramtypes.NewParamSetPair(KeyMarketCreationFee, &p.MarketCreationFee, validateSpamFee),
+		paramtypes.NewParamSetPair(KeyOrderPlacementSurcharge, &p.OrderPlacementSurcharge, validateSpamFee),
+		paramtypes.NewParamSetPair(KeySpamFeeDestination, &p.SpamFeeDestination, validateSpamFeeDestination),
+		paramtypes.NewParamSetPair(KeyIsAutoDeleveragingEnabled, &p.IsAutoDeleveragingEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyMarketInactivityDelistBlocks, &p.MarketInactivityDelistBlocks, validateMarketInactivityDelistBlocks),
+		paramtypes.NewParamSetPair(KeyMakerRebateEpochDuration, &p.MakerRebateEpochDuration, validateMakerRebateEpochDuration),
+		paramtypes.NewParamSetPair(KeyMakerRebateRate, &p.MakerRebateRate, ValidateFee),
+		paramtypes.NewParamSetPair(KeyMakerRebatePoolSource, &p.MakerRebatePoolSource, validateMakerRebatePoolSource),
+		paramtypes.NewParamSetPair(KeyFeeSettlementDenom, &p.FeeSettlementDenom, validateFeeSettlementDenom),
+		paramtypes.NewParamSetPair(KeyFeeSettlementOracleType, &p.FeeSettlementOracleType, validateFeeSettlementOracleType),
+		paramtypes.NewParamSetPair(KeyFeeSettlementMaxPriceAge, &p.FeeSettlementMaxPriceAge, validateFeeSettlementMaxPriceAge),
+		paramtypes.NewParamSetPair(KeyFeeSettlementSlippage, &p.FeeSettlementSlippage, ValidateFee),
+		paramtypes.NewParamSetPair(KeyOrderHistoryRetentionBlocks, &p.OrderHistoryRetentionBlocks, validateOrderHistoryRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyDustThresholds, &p.DustThresholds, validateDustThresholds),
+		paramtypes.NewParamSetPair(KeyDustSweepDestination, &p.DustSweepDestination, validateSpamFeeDestination),
+		paramtypes.NewParamSetPair(KeyDustSweepMaxDepositsPerBlock, &p.DustSweepMaxDepositsPerBlock, validateDustSweepMaxDepositsPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxConditionalOrdersPerSubaccount, &p.MaxConditionalOrdersPerSubaccount, validateMaxConditionalOrdersPerSubaccount),
+		paramtypes.NewParamSetPair(KeySubaccountFundingHistorySize, &p.SubaccountFundingHistorySize, validateSubaccountFundingHistorySize),
+		paramtypes.NewParamSetPair(KeyMaxMarketOrderSlippageRatio, &p.MaxMarketOrderSlippageRatio, ValidateMaxSlippageRatio),
+		paramtypes.NewParamSetPair(KeyMakerRebateMaxVolumesPerBlock, &p.MakerRebateMaxVolumesPerBlock, validateMakerRebateMaxVolumesPerBlock),
 	}
 }
 
@@ -209,6 +301,30 @@ func DefaultParams() Params {
 		MinimalProtocolFeeRate:                      sdk.MustNewDecFromStr("0.00005"), // default 0.005% minimal fee rate
 		IsInstantDerivativeMarketLaunchEnabled:      false,
 		PostOnlyModeHeightThreshold:                 0,
+		MaxOpenOrdersPerSubaccount:                  DefaultMaxOpenOrdersPerSubaccount,
+		MaxExpiredOrdersPerBlock:                    DefaultMaxExpiredOrdersPerBlock,
+		FundingRateHistorySize:                      DefaultFundingRateHistorySize,
+		MaxActiveMarkets:                            DefaultMaxActiveMarkets,
+		MarketCreationFee:                           sdk.NewCoin("inj", sdk.ZeroInt()),
+		OrderPlacementSurcharge:                     sdk.NewCoin("inj", sdk.ZeroInt()),
+		SpamFeeDestination:                          SpamFeeDestination_CommunityPool,
+		IsAutoDeleveragingEnabled:                   false,
+		MarketInactivityDelistBlocks:                0,
+		MakerRebateEpochDuration:                    0,
+		MakerRebateRate:                             sdk.ZeroDec(),
+		MakerRebatePoolSource:                       MakerRebatePoolSource_MakerRebatePool,
+		FeeSettlementDenom:                          "",
+		FeeSettlementOracleType:                     oracletypes.OracleType_PriceFeed,
+		FeeSettlementMaxPriceAge:                    600,                      // 10 minutes
+		FeeSettlementSlippage:                       sdk.NewDecWithPrec(1, 2), // default 1% discount
+		OrderHistoryRetentionBlocks:                 DefaultOrderHistoryRetentionBlocks,
+		DustThresholds:                              sdk.DecCoins{},
+		DustSweepDestination:                        SpamFeeDestination_CommunityPool,
+		DustSweepMaxDepositsPerBlock:                0, // disabled by default
+		MaxConditionalOrdersPerSubaccount:           DefaultMaxConditionalOrdersPerSubaccount,
+		SubaccountFundingHistorySize:                DefaultSubaccountFundingHistorySize,
+		MaxMarketOrderSlippageRatio:                 sdk.ZeroDec(), // no maximum by default
+		MakerRebateMaxVolumesPerBlock:               DefaultMakerRebateMaxVolumesPerBlock,
 	}
 }
 
@@ -283,6 +399,75 @@ func (p Params) Validate() error {
 	if err := validatePostOnlyModeHeightThreshold(p.PostOnlyModeHeightThreshold); err != nil {
 		return fmt.Errorf("post_only_mode_height_threshold is incorrect: %w", err)
 	}
+	if err := validateMaxOpenOrdersPerSubaccount(p.MaxOpenOrdersPerSubaccount); err != nil {
+		return fmt.Errorf("max_open_orders_per_subaccount is incorrect: %w", err)
+	}
+	if err := validateMaxExpiredOrdersPerBlock(p.MaxExpiredOrdersPerBlock); err != nil {
+		return fmt.Errorf("max_expired_orders_per_block is incorrect: %w", err)
+	}
+	if err := validateFundingRateHistorySize(p.FundingRateHistorySize); err != nil {
+		return fmt.Errorf("funding_rate_history_size is incorrect: %w", err)
+	}
+	if err := validateMaxActiveMarkets(p.MaxActiveMarkets); err != nil {
+		return fmt.Errorf("max_active_markets is incorrect: %w", err)
+	}
+	if err := validateSpamFee(p.MarketCreationFee); err != nil {
+		return fmt.Errorf("market_creation_fee is incorrect: %w", err)
+	}
+	if err := validateSpamFee(p.OrderPlacementSurcharge); err != nil {
+		return fmt.Errorf("order_placement_surcharge is incorrect: %w", err)
+	}
+	if err := validateSpamFeeDestination(p.SpamFeeDestination); err != nil {
+		return fmt.Errorf("spam_fee_destination is incorrect: %w", err)
+	}
+	if err := validateMarketInactivityDelistBlocks(p.MarketInactivityDelistBlocks); err != nil {
+		return fmt.Errorf("market_inactivity_delist_blocks is incorrect: %w", err)
+	}
+	if err := validateMakerRebateEpochDuration(p.MakerRebateEpochDuration); err != nil {
+		return fmt.Errorf("maker_rebate_epoch_duration is incorrect: %w", err)
+	}
+	if err := ValidateFee(p.MakerRebateRate); err != nil {
+		return fmt.Errorf("maker_rebate_rate is incorrect: %w", err)
+	}
+	if err := validateMakerRebatePoolSource(p.MakerRebatePoolSource); err != nil {
+		return fmt.Errorf("maker_rebate_pool_source is incorrect: %w", err)
+	}
+	if err := validateFeeSettlementDenom(p.FeeSettlementDenom); err != nil {
+		return fmt.Errorf("fee_settlement_denom is incorrect: %w", err)
+	}
+	if err := validateFeeSettlementOracleType(p.FeeSettlementOracleType); err != nil {
+		return fmt.Errorf("fee_settlement_oracle_type is incorrect: %w", err)
+	}
+	if err := validateFeeSettlementMaxPriceAge(p.FeeSettlementMaxPriceAge); err != nil {
+		return fmt.Errorf("fee_settlement_max_price_age is incorrect: %w", err)
+	}
+	if err := ValidateFee(p.FeeSettlementSlippage); err != nil {
+		return fmt.Errorf("fee_settlement_slippage is incorrect: %w", err)
+	}
+	if err := validateOrderHistoryRetentionBlocks(p.OrderHistoryRetentionBlocks); err != nil {
+		return fmt.Errorf("order_history_retention_blocks is incorrect: %w", err)
+	}
+	if err := validateDustThresholds(p.DustThresholds); err != nil {
+		return fmt.Errorf("dust_thresholds is incorrect: %w", err)
+	}
+	if err := validateSpamFeeDestination(p.DustSweepDestination); err != nil {
+		return fmt.Errorf("dust_sweep_destination is incorrect: %w", err)
+	}
+	if err := validateDustSweepMaxDepositsPerBlock(p.DustSweepMaxDepositsPerBlock); err != nil {
+		return fmt.Errorf("dust_sweep_max_deposits_per_block is incorrect: %w", err)
+	}
+	if err := validateMaxConditionalOrdersPerSubaccount(p.MaxConditionalOrdersPerSubaccount); err != nil {
+		return fmt.Errorf("max_conditional_orders_per_subaccount is incorrect: %w", err)
+	}
+	if err := validateSubaccountFundingHistorySize(p.SubaccountFundingHistorySize); err != nil {
+		return fmt.Errorf("subaccount_funding_history_size is incorrect: %w", err)
+	}
+	if err := ValidateMaxSlippageRatio(p.MaxMarketOrderSlippageRatio); err != nil {
+		return fmt.Errorf("max_market_order_slippage_ratio is incorrect: %w", err)
+	}
+	if err := validateMakerRebateMaxVolumesPerBlock(p.MakerRebateMaxVolumesPerBlock); err != nil {
+		return fmt.Errorf("maker_rebate_max_volumes_per_block is incorrect: %w", err)
+	}
 	return nil
 }
 
@@ -366,6 +551,94 @@ func ValidateMakerFee(i interface{}) error {
 	return nil
 }
 
+// ValidatePositionCap validates the open interest or position size cap of a derivative market, zero meaning no cap
+func ValidatePositionCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("position cap cannot be nil: %s", v)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("position cap cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// ValidatePriceBandRatio validates the price band ratio of a derivative market, zero meaning no band
+func ValidatePriceBandRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("price band ratio cannot be nil: %s", v)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("price band ratio cannot be negative: %s", v)
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("price band ratio must be less than 1: %s", v)
+	}
+
+	return nil
+}
+
+// ValidateMaxLeverage validates the max leverage of a derivative market, zero meaning no limit
+func ValidateMaxLeverage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max leverage cannot be nil: %s", v)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max leverage cannot be negative: %s", v)
+	}
+
+	if v.IsPositive() && v.LT(sdk.OneDec()) {
+		return fmt.Errorf("max leverage must be zero or at least 1: %s", v)
+	}
+
+	return nil
+}
+
+// ValidateMaxSlippageRatio validates a max slippage ratio of market orders, zero meaning no bound
+func ValidateMaxSlippageRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max slippage ratio cannot be nil: %s", v)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max slippage ratio cannot be negative: %s", v)
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max slippage ratio must be less than 1: %s", v)
+	}
+
+	return nil
+}
+
 func ValidateHourlyFundingRateCap(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
@@ -501,6 +774,49 @@ func validatePostOnlyModeHeightThreshold(i interface{}) error {
 	return nil
 }
 
+func validateMarketInactivityDelistBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("MarketInactivityDelistBlocks must be non-negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMakerRebateEpochDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("MakerRebateEpochDuration must be non-negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMakerRebateMaxVolumesPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("MakerRebateMaxVolumesPerBlock must be positive: %d", v)
+	}
+
+	if v > MaxMakerRebateMaxVolumesPerBlock {
+		return fmt.Errorf("MakerRebateMaxVolumesPerBlock must not exceed %d: %d", MaxMakerRebateMaxVolumesPerBlock, v)
+	}
+
+	return nil
+}
+
 func validateFundingMultiple(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -527,6 +843,195 @@ func validateDerivativeOrderSideCount(i interface{}) error {
 	return nil
 }
 
+func validateMaxOpenOrdersPerSubaccount(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxConditionalOrdersPerSubaccount(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("MaxConditionalOrdersPerSubaccount must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxExpiredOrdersPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("MaxExpiredOrdersPerBlock must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateFundingRateHistorySize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxFundingRateHistorySize {
+		return fmt.Errorf("FundingRateHistorySize must not exceed %d: %d", MaxFundingRateHistorySize, v)
+	}
+
+	return nil
+}
+
+func validateSubaccountFundingHistorySize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxSubaccountFundingHistorySize {
+		return fmt.Errorf("SubaccountFundingHistorySize must not exceed %d: %d", MaxSubaccountFundingHistorySize, v)
+	}
+
+	return nil
+}
+
+func validateMaxActiveMarkets(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateSpamFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero amount is valid and disables the fee
+	if !v.IsValid() {
+		return fmt.Errorf("invalid fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeSettlementDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an empty denom disables the conversion
+	if v == "" {
+		return nil
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateFeeSettlementOracleType(i interface{}) error {
+	v, ok := i.(oracletypes.OracleType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// provider prices are keyed by provider and can't price a denom pair
+	if _, ok := oracletypes.OracleType_name[int32(v)]; !ok || v == oracletypes.OracleType_Unspecified || v == oracletypes.OracleType_Provider {
+		return fmt.Errorf("invalid FeeSettlementOracleType value: %v", v)
+	}
+
+	return nil
+}
+
+func validateFeeSettlementMaxPriceAge(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("FeeSettlementMaxPriceAge must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateOrderHistoryRetentionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("OrderHistoryRetentionBlocks cannot be negative: %d", v)
+	}
+
+	if v > MaxOrderHistoryRetentionBlocks {
+		return fmt.Errorf("OrderHistoryRetentionBlocks must not exceed %d: %d", MaxOrderHistoryRetentionBlocks, v)
+	}
+
+	return nil
+}
+
+func validateDustThresholds(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("DustThresholds must be sorted positive coins with unique denoms: %w", err)
+	}
+
+	return nil
+}
+
+func validateDustSweepMaxDepositsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxDustSweepDepositsPerBlock {
+		return fmt.Errorf("DustSweepMaxDepositsPerBlock must not exceed %d: %d", MaxDustSweepDepositsPerBlock, v)
+	}
+
+	return nil
+}
+
+func validateSpamFeeDestination(i interface{}) error {
+	v, ok := i.(SpamFeeDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return fmt.Errorf("invalid SpamFeeDestination value: %v", v)
+	}
+	return nil
+}
+
+func validateMakerRebatePoolSource(i interface{}) error {
+	v, ok := i.(MakerRebatePoolSource)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return fmt.Errorf("invalid MakerRebatePoolSource value: %v", v)
+	}
+	return nil
+}
+
 func validateInjRewardStakedRequirementThreshold(i interface{}) error {
 	v, ok := i.(sdkmath.Int)
 	if !ok {
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"bytes"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type positionPayout struct {
@@ -55,6 +59,63 @@ func (p *Position) GetEffectiveMarginRatio(closingPrice, closingFee sdk.Dec) (ma
 	return effectiveMargin.Quo(closingPrice.Mul(p.Quantity))
 }
 
+// SortPositionsForLiquidation sorts the positions into the order in which they are liquidated when closed
+// together: by ascending effective margin ratio at the closing price, so the most undercollateralized positions
+// go first, and then by ascending subaccount ID. The order is part of consensus since it determines the order
+// of the resulting state changes and events. Without a positive closing price all margin ratios are considered
+// equal and the positions are only sorted by subaccount ID.
+func SortPositionsForLiquidation(positions []*DerivativePosition, closingPrice sdk.Dec, funding *PerpetualMarketFunding) {
+	marginRatios := make(map[*DerivativePosition]sdk.Dec, len(positions))
+	for _, position := range positions {
+		marginRatio := sdk.ZeroDec()
+		notional := sdk.ZeroDec()
+		if !closingPrice.IsNil() && closingPrice.IsPositive() {
+			notional = closingPrice.Mul(position.Position.Quantity)
+		}
+		if notional.IsPositive() {
+			marginRatio = position.Position.GetEffectiveMargin(funding, closingPrice).Quo(notional)
+		}
+		marginRatios[position] = marginRatio
+	}
+
+	sort.SliceStable(positions, func(i, j int) bool {
+		ratioI, ratioJ := marginRatios[positions[i]], marginRatios[positions[j]]
+		if !ratioI.Equal(ratioJ) {
+			return ratioI.LT(ratioJ)
+		}
+		return bytes.Compare(common.HexToHash(positions[i].SubaccountId).Bytes(), common.HexToHash(positions[j].SubaccountId).Bytes()) < 0
+	})
+}
+
+// SortPositionsForAutoDeleveraging sorts the positions into the order in which they are reduced by auto-deleveraging:
+// by descending score at the mark price and then by ascending subaccount ID. The score ranks positions by profit and
+// leverage, score = (pnl / margin) * (notional / (margin + pnl)), so that the most profitable and most leveraged
+// positions are reduced first. Positions without positive margin or equity have a zero score. The order is part of
+// consensus since it determines which positions are reduced.
+// CONTRACT: positions must already be funding-adjusted (if perpetual).
+func SortPositionsForAutoDeleveraging(positions []*DerivativePosition, markPrice sdk.Dec) {
+	scores := make(map[*DerivativePosition]sdk.Dec, len(positions))
+	for _, position := range positions {
+		score := sdk.ZeroDec()
+		margin := position.Position.Margin
+		pnl := position.Position.GetPayoutFromPnl(markPrice, position.Position.Quantity)
+		equity := margin.Add(pnl)
+		if margin.IsPositive() && equity.IsPositive() {
+			notional := markPrice.Mul(position.Position.Quantity)
+			score = pnl.Mul(notional).Quo(margin.Mul(equity))
+		}
+		scores[position] = score
+	}
+
+	sort.SliceStable(positions, func(i, j int) bool {
+		scoreI, scoreJ := scores[positions[i]], scores[positions[j]]
+		if !scoreI.Equal(scoreJ) {
+			return scoreI.GT(scoreJ)
+		}
+		return bytes.Compare(common.HexToHash(positions[i].SubaccountId).Bytes(), common.HexToHash(positions[j].SubaccountId).Bytes()) < 0
+	})
+}
+
 // ApplyProfitHaircutForDerivatives results in reducing the payout (pnl * quantity) by the given rate (e.g. 0.1=10%) by modifying the entry price.
 // Formula for adjustment:
 // newPayoutFromPnl = oldPayoutFromPnl * (1 - missingFundsRate)
@@ -106,7 +167,7 @@ func (p *Position) ClosePositionWithSettlePrice(settlementPrice, closingFeeRate
 	closingDirection := !p.IsLong
 	fullyClosingQuantity := p.Quantity
 
-	closeTradingFee = settlementPrice.Mul(fullyClosingQuantity).Mul(closingFeeRate)
+	closeTradingFee = CalculateFee(settlementPrice.Mul(fullyClosingQuantity), closingFeeRate)
 	positionDelta = &PositionDelta{
 		IsLong:            closingDirection,
 		ExecutionQuantity: fullyClosingQuantity,
@@ -294,7 +355,7 @@ func (p *Position) GetPayoutIfFullyClosing(closingPrice, closingFeeRate sdk.Dec)
 	fullyClosingQuantity := p.Quantity
 	positionMargin := p.Margin
 
-	closeTradingFee := closingPrice.Mul(fullyClosingQuantity).Mul(closingFeeRate)
+	closeTradingFee := CalculateFee(closingPrice.Mul(fullyClosingQuantity), closingFeeRate)
 	payoutFromPnl := p.GetPayoutFromPnl(closingPrice, fullyClosingQuantity)
 	pnlNotional := payoutFromPnl.Sub(closeTradingFee)
 	payout := pnlNotional.Add(positionMargin)
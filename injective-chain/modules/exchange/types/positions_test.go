@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ = Describe("Liquidation ordering", func() {
+	newPosition := func(subaccountID string, isLong bool, quantity, entryPrice, margin int64) *types.DerivativePosition {
+		return &types.DerivativePosition{
+			SubaccountId: common.HexToHash(subaccountID).Hex(),
+			MarketId:     common.HexToHash("0x01").Hex(),
+			Position: &types.Position{
+				IsLong:                 isLong,
+				Quantity:               sdk.NewDec(quantity),
+				EntryPrice:             sdk.NewDec(entryPrice),
+				Margin:                 sdk.NewDec(margin),
+				CumulativeFundingEntry: sdk.ZeroDec(),
+			},
+		}
+	}
+
+	subaccountIDs := func(positions []*types.DerivativePosition) []string {
+		ids := make([]string, 0, len(positions))
+		for _, position := range positions {
+			ids = append(ids, position.SubaccountId)
+		}
+		return ids
+	}
+
+	var positions []*types.DerivativePosition
+
+	BeforeEach(func() {
+		// at a closing price of 100 the margin ratios are 0.5, -0.1, 0.05, -0.1 and 0.05
+		positions = []*types.DerivativePosition{
+			newPosition("0x05", true, 1, 100, 50),
+			newPosition("0x04", true, 1, 120, 10),
+			newPosition("0x03", false, 2, 100, 10),
+			newPosition("0x02", false, 1, 80, 10),
+			newPosition("0x01", true, 2, 110, 30),
+		}
+	})
+
+	It("sorts by ascending margin ratio and then by subaccount ID", func() {
+		types.SortPositionsForLiquidation(positions, sdk.NewDec(100), nil)
+
+		Expect(subaccountIDs(positions)).To(Equal([]string{
+			common.HexToHash("0x02").Hex(),
+			common.HexToHash("0x04").Hex(),
+			common.HexToHash("0x01").Hex(),
+			common.HexToHash("0x03").Hex(),
+			common.HexToHash("0x05").Hex(),
+		}))
+	})
+
+	It("yields the same sequence regardless of the input order", func() {
+		types.SortPositionsForLiquidation(positions, sdk.NewDec(100), nil)
+		expected := subaccountIDs(positions)
+
+		reversed := make([]*types.DerivativePosition, 0, len(positions))
+		for i := len(positions) - 1; i >= 0; i-- {
+			reversed = append(reversed, positions[i])
+		}
+		types.SortPositionsForLiquidation(reversed, sdk.NewDec(100), nil)
+
+		Expect(subaccountIDs(reversed)).To(Equal(expected))
+	})
+
+	It("sorts only by subaccount ID without a positive closing price", func() {
+		types.SortPositionsForLiquidation(positions, types.BinaryOptionsMarketRefundFlagPrice, nil)
+
+		Expect(subaccountIDs(positions)).To(Equal([]string{
+			common.HexToHash("0x01").Hex(),
+			common.HexToHash("0x02").Hex(),
+			common.HexToHash("0x03").Hex(),
+			common.HexToHash("0x04").Hex(),
+			common.HexToHash("0x05").Hex(),
+		}))
+	})
+})
@@ -34,6 +34,8 @@ const (
 	ProposalTypeBinaryOptionsMarketLaunch          string = "ProposalTypeBinaryOptionsMarketLaunch"
 	ProposalTypeBinaryOptionsMarketParamUpdate     string = "ProposalTypeBinaryOptionsMarketParamUpdate"
 	ProposalAtomicMarketOrderFeeMultiplierSchedule string = "ProposalAtomicMarketOrderFeeMultiplierSchedule"
+	ProposalTypeTradingSchedule                    string = "ProposalTypeTradingSchedule"
+	ProposalTypeMarketDelistingExemption           string = "ProposalTypeMarketDelistingExemption"
 )
 
 func init() {
@@ -54,6 +56,8 @@ func init() {
 	govtypes.RegisterProposalType(ProposalTypeBinaryOptionsMarketLaunch)
 	govtypes.RegisterProposalType(ProposalTypeBinaryOptionsMarketParamUpdate)
 	govtypes.RegisterProposalType(ProposalAtomicMarketOrderFeeMultiplierSchedule)
+	govtypes.RegisterProposalType(ProposalTypeTradingSchedule)
+	govtypes.RegisterProposalType(ProposalTypeMarketDelistingExemption)
 }
 
 func SafeIsPositiveInt(v sdkmath.Int) bool {
@@ -213,7 +217,7 @@ func (p *BatchExchangeModificationProposal) ValidateBasic() error {
 }
 
 // NewSpotMarketParamUpdateProposal returns new instance of SpotMarketParamUpdateProposal
-func NewSpotMarketParamUpdateProposal(title, description string, marketID common.Hash, makerFeeRate, takerFeeRate, relayerFeeShareRate, minPriceTickSize, minQuantityTickSize *sdk.Dec, status MarketStatus) *SpotMarketParamUpdateProposal {
+func NewSpotMarketParamUpdateProposal(title, description string, marketID common.Hash, makerFeeRate, takerFeeRate, relayerFeeShareRate, minPriceTickSize, minQuantityTickSize, maxSlippageRatio *sdk.Dec, status MarketStatus) *SpotMarketParamUpdateProposal {
 
 	return &SpotMarketParamUpdateProposal{
 		title,
@@ -225,6 +229,7 @@ func NewSpotMarketParamUpdateProposal(title, description string, marketID common
 		minPriceTickSize,
 		minQuantityTickSize,
 		status,
+		maxSlippageRatio,
 	}
 }
 
@@ -254,7 +259,7 @@ func (p *SpotMarketParamUpdateProposal) ValidateBasic() error {
 	if !IsHexHash(p.MarketId) {
 		return errors.Wrap(ErrMarketInvalid, p.MarketId)
 	}
-	if p.MakerFeeRate == nil && p.TakerFeeRate == nil && p.RelayerFeeShareRate == nil && p.MinPriceTickSize == nil && p.MinQuantityTickSize == nil && p.Status == MarketStatus_Unspecified {
+	if p.MakerFeeRate == nil && p.TakerFeeRate == nil && p.RelayerFeeShareRate == nil && p.MinPriceTickSize == nil && p.MinQuantityTickSize == nil && p.MaxSlippageRatio == nil && p.Status == MarketStatus_Unspecified {
 		return errors.Wrap(gov.ErrInvalidProposalContent, "At least one field should not be nil")
 	}
 
@@ -284,6 +289,11 @@ func (p *SpotMarketParamUpdateProposal) ValidateBasic() error {
 			return errors.Wrap(ErrInvalidQuantityTickSize, err.Error())
 		}
 	}
+	if p.MaxSlippageRatio != nil {
+		if err := ValidateMaxSlippageRatio(*p.MaxSlippageRatio); err != nil {
+			return errors.Wrap(ErrInvalidMaxSlippageRatio, err.Error())
+		}
+	}
 
 	switch p.Status {
 	case
@@ -454,6 +464,11 @@ func (p *DerivativeMarketParamUpdateProposal) ValidateBasic() error {
 		p.MaintenanceMarginRatio == nil &&
 		p.HourlyInterestRate == nil &&
 		p.HourlyFundingRateCap == nil &&
+		p.MaxOpenInterest == nil &&
+		p.MaxPositionSize == nil &&
+		p.PriceBandRatio == nil &&
+		p.MaxLeverage == nil &&
+		p.MaxSlippageRatio == nil &&
 		p.Status == MarketStatus_Unspecified &&
 		p.OracleParams == nil {
 		return errors.Wrap(gov.ErrInvalidProposalContent, "At least one field should not be nil")
@@ -510,6 +525,32 @@ func (p *DerivativeMarketParamUpdateProposal) ValidateBasic() error {
 		}
 	}
 
+	if p.MaxOpenInterest != nil {
+		if err := ValidatePositionCap(*p.MaxOpenInterest); err != nil {
+			return errors.Wrap(ErrInvalidPositionCap, err.Error())
+		}
+	}
+	if p.MaxPositionSize != nil {
+		if err := ValidatePositionCap(*p.MaxPositionSize); err != nil {
+			return errors.Wrap(ErrInvalidPositionCap, err.Error())
+		}
+	}
+	if p.PriceBandRatio != nil {
+		if err := ValidatePriceBandRatio(*p.PriceBandRatio); err != nil {
+			return errors.Wrap(ErrInvalidPriceBandRatio, err.Error())
+		}
+	}
+	if p.MaxLeverage != nil {
+		if err := ValidateMaxLeverage(*p.MaxLeverage); err != nil {
+			return errors.Wrap(ErrInvalidMaxLeverage, err.Error())
+		}
+	}
+	if p.MaxSlippageRatio != nil {
+		if err := ValidateMaxSlippageRatio(*p.MaxSlippageRatio); err != nil {
+			return errors.Wrap(ErrInvalidMaxSlippageRatio, err.Error())
+		}
+	}
+
 	switch p.Status {
 	case
 		MarketStatus_Unspecified,
@@ -1519,7 +1560,8 @@ func (p *BinaryOptionsMarketParamUpdateProposal) ValidateBasic() error {
 		p.SettlementTimestamp == 0 &&
 		p.SettlementPrice == nil &&
 		p.Admin == "" &&
-		p.OracleParams == nil {
+		p.OracleParams == nil &&
+		p.MaxSlippageRatio == nil {
 		return errors.Wrap(gov.ErrInvalidProposalContent, "At least one field should not be nil")
 	}
 
@@ -1552,6 +1594,12 @@ func (p *BinaryOptionsMarketParamUpdateProposal) ValidateBasic() error {
 		}
 	}
 
+	if p.MaxSlippageRatio != nil {
+		if err := ValidateMaxSlippageRatio(*p.MaxSlippageRatio); err != nil {
+			return errors.Wrap(ErrInvalidMaxSlippageRatio, err.Error())
+		}
+	}
+
 	if p.ExpirationTimestamp != 0 && p.SettlementTimestamp != 0 {
 		if p.ExpirationTimestamp >= p.SettlementTimestamp || p.ExpirationTimestamp < 0 || p.SettlementTimestamp < 0 {
 			return ErrInvalidExpiry
@@ -1644,3 +1692,91 @@ func (p *AtomicMarketOrderFeeMultiplierScheduleProposal) ValidateBasic() error {
 	}
 	return govtypes.ValidateAbstract(p)
 }
+
+// Implements Proposal Interface
+var _ govtypes.Content = &TradingScheduleProposal{}
+
+// GetTitle returns the title of this proposal
+func (p *TradingScheduleProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal
+func (p *TradingScheduleProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *TradingScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *TradingScheduleProposal) ProposalType() string {
+	return ProposalTypeTradingSchedule
+}
+
+func (p *TradingScheduleProposal) ValidateBasic() error {
+	if len(p.Schedules) == 0 {
+		return errors.Wrap(gov.ErrInvalidProposalContent, "At least one trading schedule should be provided")
+	}
+
+	marketIDs := make(map[common.Hash]struct{})
+	for _, schedule := range p.Schedules {
+		if schedule == nil {
+			return errors.Wrap(gov.ErrInvalidProposalContent, "trading schedule cannot be nil")
+		}
+
+		if err := schedule.ValidateBasic(); err != nil {
+			return err
+		}
+
+		marketID := common.HexToHash(schedule.MarketId)
+		if _, ok := marketIDs[marketID]; ok {
+			return ErrInvalidTradingSchedule.Wrapf("duplicate trading schedule for market %s", schedule.MarketId)
+		}
+		marketIDs[marketID] = struct{}{}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// Implements Proposal Interface
+var _ govtypes.Content = &MarketDelistingExemptionProposal{}
+
+// GetTitle returns the title of this proposal
+func (p *MarketDelistingExemptionProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal
+func (p *MarketDelistingExemptionProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *MarketDelistingExemptionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *MarketDelistingExemptionProposal) ProposalType() string {
+	return ProposalTypeMarketDelistingExemption
+}
+
+func (p *MarketDelistingExemptionProposal) ValidateBasic() error {
+	if len(p.MarketIds) == 0 {
+		return errors.Wrap(gov.ErrInvalidProposalContent, "At least one market id should be provided")
+	}
+
+	marketIDs := make(map[common.Hash]struct{})
+	for _, marketID := range p.MarketIds {
+		if !IsHexHash(marketID) {
+			return errors.Wrap(ErrMarketInvalid, marketID)
+		}
+
+		marketHash := common.HexToHash(marketID)
+		if _, ok := marketIDs[marketHash]; ok {
+			return errors.Wrapf(gov.ErrInvalidProposalContent, "duplicate market id %s", marketID)
+		}
+		marketIDs[marketHash] = struct{}{}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
@@ -75,6 +75,10 @@ type SpotMarketParamUpdateProposal struct {
 	// quantity
 	MinQuantityTickSize *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size,omitempty"`
 	Status              MarketStatus                            `protobuf:"varint,9,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+	// max_slippage_ratio defines the default maximum relative distance of the
+	// execution prices of market orders from the top of the book, zero means
+	// no bound
+	MaxSlippageRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio,omitempty"`
 }
 
 func (m *SpotMarketParamUpdateProposal) Reset()         { *m = SpotMarketParamUpdateProposal{} }
@@ -493,6 +497,21 @@ type DerivativeMarketParamUpdateProposal struct {
 	HourlyFundingRateCap *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=HourlyFundingRateCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"HourlyFundingRateCap,omitempty"`
 	Status               MarketStatus                            `protobuf:"varint,13,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
 	OracleParams         *OracleParams                           `protobuf:"bytes,14,opt,name=oracle_params,json=oracleParams,proto3" json:"oracle_params,omitempty"`
+	// max_open_interest defines the maximum open interest of the market, zero
+	// means no cap
+	MaxOpenInterest *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest,omitempty"`
+	// max_position_size defines the maximum position quantity of a subaccount in
+	// the market, zero means no cap
+	MaxPositionSize *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=max_position_size,json=maxPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_size,omitempty"`
+	// price_band_ratio defines the maximum relative distance of order prices
+	// from the mark price, zero means no band
+	PriceBandRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=price_band_ratio,json=priceBandRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_band_ratio,omitempty"`
+	// max_leverage defines the maximum leverage of the positions opened or
+	// increased by orders, zero means no limit
+	MaxLeverage *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage,omitempty"`
+	// max_slippage_ratio defines the default maximum relative distance of the
+	// execution prices of market orders from the mark price, zero means no bound
+	MaxSlippageRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio,omitempty"`
 }
 
 func (m *DerivativeMarketParamUpdateProposal) Reset()         { *m = DerivativeMarketParamUpdateProposal{} }
@@ -636,6 +655,10 @@ type BinaryOptionsMarketParamUpdateProposal struct {
 	Admin        string                `protobuf:"bytes,12,opt,name=admin,proto3" json:"admin,omitempty"`
 	Status       MarketStatus          `protobuf:"varint,13,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
 	OracleParams *ProviderOracleParams `protobuf:"bytes,14,opt,name=oracle_params,json=oracleParams,proto3" json:"oracle_params,omitempty"`
+	// max_slippage_ratio defines the default maximum relative distance of the
+	// execution prices of market orders from the top of the book, zero means
+	// no bound
+	MaxSlippageRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_slippage_ratio,json=maxSlippageRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage_ratio,omitempty"`
 }
 
 func (m *BinaryOptionsMarketParamUpdateProposal) Reset() {
@@ -1109,6 +1132,90 @@ func (m *AtomicMarketOrderFeeMultiplierScheduleProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AtomicMarketOrderFeeMultiplierScheduleProposal proto.InternalMessageInfo
 
+// TradingScheduleProposal defines a SDK message for proposing new trading
+// hours for specified markets
+type TradingScheduleProposal struct {
+	Title       string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Schedules   []*MarketTradingSchedule `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (m *TradingScheduleProposal) Reset()         { *m = TradingScheduleProposal{} }
+func (m *TradingScheduleProposal) String() string { return proto.CompactTextString(m) }
+func (*TradingScheduleProposal) ProtoMessage()    {}
+func (*TradingScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e9ec9b6b22477c, []int{20}
+}
+func (m *TradingScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingScheduleProposal.Merge(m, src)
+}
+func (m *TradingScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingScheduleProposal proto.InternalMessageInfo
+
+// MarketDelistingExemptionProposal defines a SDK message for proposing to
+// exempt markets from, or subject them again to, the auto-delisting on
+// inactivity
+type MarketDelistingExemptionProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MarketIds   []string `protobuf:"bytes,3,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	IsExempt    bool     `protobuf:"varint,4,opt,name=is_exempt,json=isExempt,proto3" json:"is_exempt,omitempty"`
+}
+
+func (m *MarketDelistingExemptionProposal) Reset()         { *m = MarketDelistingExemptionProposal{} }
+func (m *MarketDelistingExemptionProposal) String() string { return proto.CompactTextString(m) }
+func (*MarketDelistingExemptionProposal) ProtoMessage()    {}
+func (*MarketDelistingExemptionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e9ec9b6b22477c, []int{21}
+}
+func (m *MarketDelistingExemptionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketDelistingExemptionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketDelistingExemptionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketDelistingExemptionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDelistingExemptionProposal.Merge(m, src)
+}
+func (m *MarketDelistingExemptionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketDelistingExemptionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDelistingExemptionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketDelistingExemptionProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("injective.exchange.v1beta1.ExchangeType", ExchangeType_name, ExchangeType_value)
 	proto.RegisterType((*SpotMarketParamUpdateProposal)(nil), "injective.exchange.v1beta1.SpotMarketParamUpdateProposal")
@@ -1131,6 +1238,8 @@ func init() {
 	proto.RegisterType((*FeeDiscountProposal)(nil), "injective.exchange.v1beta1.FeeDiscountProposal")
 	proto.RegisterType((*BatchCommunityPoolSpendProposal)(nil), "injective.exchange.v1beta1.BatchCommunityPoolSpendProposal")
 	proto.RegisterType((*AtomicMarketOrderFeeMultiplierScheduleProposal)(nil), "injective.exchange.v1beta1.AtomicMarketOrderFeeMultiplierScheduleProposal")
+	proto.RegisterType((*TradingScheduleProposal)(nil), "injective.exchange.v1beta1.TradingScheduleProposal")
+	proto.RegisterType((*MarketDelistingExemptionProposal)(nil), "injective.exchange.v1beta1.MarketDelistingExemptionProposal")
 }
 
 func init() {
@@ -1138,142 +1247,154 @@ func init() {
 }
 
 var fileDescriptor_32e9ec9b6b22477c = []byte{
-	// 2150 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x24, 0x47,
-	0x15, 0x77, 0xaf, 0x3f, 0xe7, 0xcd, 0xf8, 0x23, 0xed, 0x89, 0x99, 0x78, 0xb3, 0xe3, 0x8f, 0x4d,
-	0x76, 0x1d, 0xa4, 0x9d, 0x61, 0x4d, 0x50, 0xc4, 0x4a, 0x08, 0xfc, 0x31, 0x26, 0x16, 0xeb, 0xdd,
-	0x49, 0x8f, 0x37, 0x82, 0x48, 0xd0, 0xd4, 0x74, 0x97, 0xed, 0xc2, 0xd3, 0x5d, 0xbd, 0x5d, 0x35,
-	0xde, 0x75, 0xc4, 0x11, 0x04, 0x5a, 0x2e, 0x20, 0x81, 0xe0, 0xb2, 0x52, 0xb8, 0x71, 0xe3, 0x00,
-	0xff, 0x00, 0x48, 0x48, 0x81, 0x1c, 0xc8, 0x31, 0xe2, 0x10, 0xa1, 0xdd, 0x03, 0x08, 0x89, 0x33,
-	0x1c, 0x51, 0x57, 0x55, 0xf7, 0xf4, 0x7c, 0xf7, 0x8c, 0x3d, 0x11, 0x87, 0x3d, 0xcd, 0xd4, 0xab,
-	0x57, 0xbf, 0xf7, 0xea, 0xd5, 0x7b, 0xaf, 0x5e, 0x55, 0x35, 0xbc, 0x41, 0xdc, 0xef, 0x61, 0x8b,
-	0x93, 0x33, 0x5c, 0xc4, 0x8f, 0xad, 0x13, 0xe4, 0x1e, 0xe3, 0xe2, 0xd9, 0xed, 0x2a, 0xe6, 0xe8,
-	0x76, 0xd1, 0xf3, 0xa9, 0x47, 0x19, 0xaa, 0x15, 0x3c, 0x9f, 0x72, 0xaa, 0x2f, 0x47, 0xac, 0x85,
-	0x90, 0xb5, 0xa0, 0x58, 0x97, 0xf3, 0x16, 0x65, 0x0e, 0x65, 0xc5, 0x2a, 0x62, 0x8d, 0xf1, 0x16,
-	0x25, 0xae, 0x1c, 0xbb, 0x5c, 0x50, 0xfd, 0x36, 0x61, 0xdc, 0x27, 0xd5, 0x3a, 0x27, 0xd4, 0x8d,
-	0xf8, 0xe2, 0x44, 0xc5, 0xff, 0x39, 0xc5, 0xef, 0xb0, 0xe3, 0xe2, 0xd9, 0xed, 0xe0, 0x47, 0x75,
-	0xbc, 0x22, 0x3b, 0x4c, 0xd1, 0x2a, 0xca, 0x86, 0xea, 0xca, 0x1e, 0xd3, 0x63, 0x2a, 0xe9, 0xc1,
-	0x3f, 0x45, 0xed, 0x35, 0xc1, 0x68, 0x1a, 0x92, 0xf5, 0xf5, 0x06, 0x2b, 0xf5, 0x91, 0x55, 0x6b,
-	0x30, 0xca, 0xa6, 0x64, 0x5b, 0xff, 0xdd, 0x24, 0x5c, 0xab, 0x78, 0x94, 0x1f, 0x20, 0xff, 0x14,
-	0xf3, 0x32, 0xf2, 0x91, 0xf3, 0xc0, 0xb3, 0x11, 0xc7, 0x65, 0x65, 0x2f, 0x3d, 0x0b, 0x93, 0x9c,
-	0xf0, 0x1a, 0xce, 0x69, 0xab, 0xda, 0x46, 0xca, 0x90, 0x0d, 0x7d, 0x15, 0xd2, 0x36, 0x66, 0x96,
-	0x4f, 0xbc, 0x60, 0xa2, 0xb9, 0x2b, 0xa2, 0x2f, 0x4e, 0xd2, 0xaf, 0x42, 0xca, 0x11, 0xa0, 0x26,
-	0xb1, 0x73, 0xe3, 0xa2, 0x7f, 0x46, 0x12, 0xf6, 0x6d, 0xfd, 0x10, 0xe6, 0x1c, 0x74, 0x8a, 0x7d,
-	0xf3, 0x08, 0x63, 0xd3, 0x47, 0x1c, 0xe7, 0x26, 0x02, 0x8e, 0xed, 0xc2, 0x87, 0x9f, 0xae, 0x68,
-	0x7f, 0xfb, 0x74, 0xe5, 0xc6, 0x31, 0xe1, 0x27, 0xf5, 0x6a, 0xc1, 0xa2, 0x8e, 0xb2, 0x8b, 0xfa,
-	0xb9, 0xc5, 0xec, 0xd3, 0x22, 0x3f, 0xf7, 0x30, 0x2b, 0xec, 0x62, 0xcb, 0xc8, 0x08, 0x94, 0x3d,
-	0x8c, 0x0d, 0xc4, 0x71, 0x80, 0xca, 0x9b, 0x51, 0x27, 0x87, 0x43, 0xe5, 0x71, 0x54, 0x0b, 0x96,
-	0x7c, 0x5c, 0x43, 0xe7, 0x0a, 0x97, 0x9d, 0x20, 0x5f, 0xa1, 0x4f, 0x0d, 0x85, 0xbe, 0xa8, 0xd0,
-	0xf6, 0x30, 0xae, 0x04, 0x58, 0x42, 0xc8, 0xb7, 0x61, 0xd1, 0x21, 0xae, 0xe9, 0xf9, 0xc4, 0xc2,
-	0x26, 0x27, 0xd6, 0xa9, 0xc9, 0xc8, 0xfb, 0x38, 0x37, 0x3d, 0x94, 0x84, 0x05, 0x87, 0xb8, 0xe5,
-	0x00, 0xe9, 0x90, 0x58, 0xa7, 0x15, 0xf2, 0xbe, 0x98, 0x43, 0x00, 0xff, 0xb0, 0x8e, 0x5c, 0x4e,
-	0xf8, 0x79, 0x4c, 0xc2, 0xcc, 0x70, 0x73, 0x70, 0x88, 0xfb, 0x8e, 0x02, 0x8b, 0x84, 0x7c, 0x0d,
-	0xa6, 0x18, 0x47, 0xbc, 0xce, 0x72, 0xa9, 0x55, 0x6d, 0x63, 0x6e, 0x73, 0xa3, 0xd0, 0x3d, 0xc8,
-	0x0a, 0xd2, 0xe1, 0x2a, 0x82, 0xdf, 0x50, 0xe3, 0xee, 0xdc, 0xf8, 0xf1, 0x07, 0x2b, 0x63, 0xff,
-	0xfc, 0x60, 0x65, 0xec, 0x2f, 0xbf, 0xbf, 0xb5, 0xac, 0xe2, 0xe1, 0x98, 0x9e, 0x45, 0x83, 0x76,
-	0xa8, 0xcb, 0xb1, 0xcb, 0xd7, 0x7f, 0xa3, 0xc1, 0x52, 0x49, 0x21, 0x96, 0x5c, 0x54, 0xad, 0x5d,
-	0xdc, 0x5d, 0xef, 0x42, 0x26, 0xd4, 0xf1, 0xf0, 0xdc, 0xc3, 0xc2, 0x63, 0xfb, 0x4c, 0xa1, 0x14,
-	0xe3, 0x37, 0x9a, 0x46, 0xdf, 0x99, 0x09, 0x27, 0xb2, 0xfe, 0x8f, 0x0c, 0xac, 0x6d, 0x23, 0x6e,
-	0x9d, 0x84, 0xdc, 0x07, 0xd4, 0x26, 0x47, 0xc4, 0x42, 0x81, 0xd4, 0x0b, 0x6b, 0xfd, 0x43, 0x0d,
-	0xd6, 0x99, 0x47, 0xb9, 0xa9, 0x42, 0xcd, 0x0b, 0x02, 0xd8, 0xac, 0x8b, 0x08, 0x36, 0xc3, 0x94,
-	0xc7, 0x72, 0xe3, 0xab, 0xe3, 0x1b, 0xe9, 0xcd, 0x2f, 0xf7, 0x9a, 0x4c, 0xcf, 0x24, 0x60, 0xe4,
-	0x59, 0xaf, 0x6e, 0xa6, 0xff, 0x52, 0x83, 0x0d, 0x1b, 0xfb, 0xe4, 0x0c, 0x05, 0xe8, 0x7d, 0xb4,
-	0x99, 0x10, 0xda, 0x7c, 0xb5, 0x97, 0x36, 0xbb, 0x11, 0x56, 0x77, 0x9d, 0x5e, 0xb3, 0xfb, 0x33,
-	0x31, 0xbd, 0x0e, 0xaf, 0xc6, 0x0d, 0x54, 0x43, 0x75, 0xd7, 0x3a, 0x89, 0x29, 0x33, 0x29, 0x94,
-	0x79, 0x33, 0x99, 0x69, 0xee, 0x8a, 0xd1, 0x91, 0x06, 0xaf, 0xb0, 0x2e, 0x3d, 0x4c, 0xff, 0x81,
-	0x06, 0x6b, 0x1e, 0xf6, 0x3d, 0xcc, 0xeb, 0xa8, 0xd6, 0x55, 0xf8, 0x54, 0xff, 0x75, 0x29, 0x87,
-	0x20, 0x1d, 0x35, 0xc8, 0x7b, 0xbd, 0xba, 0x99, 0xfe, 0x33, 0x0d, 0x6e, 0xe0, 0xc7, 0x1e, 0xf1,
-	0xcf, 0xcd, 0xa3, 0x3a, 0xaf, 0xfb, 0x98, 0x75, 0xd5, 0x65, 0x5a, 0xe8, 0xf2, 0x95, 0xde, 0x0e,
-	0x1f, 0x20, 0xed, 0x49, 0xa0, 0x8e, 0xfa, 0xac, 0xe3, 0x7e, 0x2c, 0x4c, 0xff, 0x85, 0x06, 0x37,
-	0xb9, 0x8f, 0x6c, 0xe2, 0x1e, 0x9b, 0x3e, 0x7e, 0x84, 0x7c, 0xdb, 0xb4, 0x90, 0xe3, 0x21, 0x72,
-	0xec, 0xb6, 0xfa, 0x8a, 0xc8, 0x4e, 0x7d, 0x5c, 0xe5, 0x50, 0x42, 0x19, 0x02, 0x69, 0x47, 0x01,
-	0xb5, 0xb8, 0xca, 0x75, 0xde, 0x9f, 0x49, 0xd8, 0xaa, 0x4a, 0x5c, 0xe4, 0x9f, 0x9b, 0x54, 0x44,
-	0x57, 0x77, 0x5b, 0xa5, 0xfa, 0xdb, 0x6a, 0x5b, 0x20, 0xdd, 0x97, 0x40, 0x9d, 0x6d, 0x55, 0xed,
-	0xc7, 0xc2, 0xf4, 0x9f, 0x6b, 0xf0, 0x7a, 0x8b, 0x4e, 0x5d, 0x82, 0x0a, 0x84, 0x4a, 0xdb, 0x03,
-	0xaa, 0xd4, 0x29, 0xae, 0xd6, 0x9a, 0xf4, 0xea, 0x18, 0x54, 0xdf, 0x87, 0xbc, 0x8d, 0x5d, 0xea,
-	0x98, 0x36, 0xb6, 0x88, 0x83, 0x6a, 0xac, 0x6d, 0xe1, 0xd2, 0x62, 0xe1, 0xde, 0xea, 0xa5, 0x8e,
-	0x04, 0xdd, 0x0d, 0x70, 0x76, 0x15, 0x4c, 0xa4, 0xc3, 0x55, 0x3b, 0x4e, 0x6e, 0x59, 0x28, 0x0b,
-	0x5e, 0x0e, 0x36, 0x62, 0x9b, 0x30, 0x8b, 0xd6, 0x5d, 0xde, 0x10, 0x9a, 0x11, 0x42, 0x8b, 0xbd,
-	0x84, 0xee, 0x61, 0xbc, 0xab, 0xc6, 0x45, 0xc2, 0x16, 0x8f, 0xda, 0x89, 0xfa, 0x8f, 0x34, 0x58,
-	0x57, 0xcb, 0x7f, 0x44, 0x7d, 0x0b, 0xdb, 0x26, 0xc3, 0x9c, 0xd7, 0xb0, 0x83, 0x63, 0x12, 0x59,
-	0x6e, 0x56, 0x98, 0xfd, 0x4e, 0xff, 0x9d, 0x6e, 0x4f, 0x80, 0x54, 0x22, 0x8c, 0x48, 0xfa, 0x8a,
-	0xd3, 0xb3, 0x3f, 0xf9, 0xa6, 0xf8, 0xc7, 0x09, 0xc8, 0x75, 0x4b, 0x55, 0x43, 0x6f, 0x30, 0x4b,
-	0x30, 0x15, 0xd4, 0x0a, 0xd8, 0x57, 0x25, 0x9c, 0x6a, 0xe9, 0xd7, 0x00, 0x82, 0xf2, 0xd8, 0x14,
-	0xeb, 0x24, 0x8b, 0x37, 0x23, 0x15, 0x50, 0xc4, 0x7a, 0xea, 0x2b, 0x90, 0x7e, 0x58, 0xa7, 0x3c,
-	0xec, 0x17, 0x65, 0x98, 0x01, 0x82, 0x24, 0x19, 0xba, 0xd4, 0x3b, 0x8d, 0x8a, 0x6a, 0x6c, 0x44,
-	0xf5, 0xce, 0xf4, 0x50, 0x12, 0x3a, 0xd6, 0x3b, 0xed, 0x45, 0xec, 0xcc, 0x48, 0x8a, 0xd8, 0xd4,
-	0xc5, 0x8b, 0xd8, 0xc4, 0x4e, 0xf4, 0xdb, 0x69, 0xb8, 0xd6, 0x73, 0xcb, 0xb9, 0x74, 0x4f, 0x6a,
-	0x71, 0x95, 0x89, 0x36, 0x57, 0x59, 0x81, 0xb4, 0x3c, 0xb2, 0x98, 0x81, 0x7f, 0x85, 0xbe, 0x24,
-	0x49, 0xdb, 0x88, 0x61, 0x7d, 0x0d, 0x32, 0x8a, 0x41, 0x8c, 0x92, 0x4e, 0x64, 0xa8, 0x41, 0xef,
-	0x04, 0x24, 0xbd, 0x00, 0x8b, 0x8a, 0x85, 0x59, 0xa8, 0x86, 0xcd, 0x23, 0x64, 0x71, 0xea, 0x0b,
-	0x67, 0x98, 0x35, 0x5e, 0x92, 0x5d, 0x95, 0xa0, 0x67, 0x4f, 0x74, 0xe8, 0xa5, 0x48, 0x66, 0x60,
-	0x50, 0xb1, 0xae, 0x73, 0x9b, 0xaf, 0xc5, 0xa2, 0x5c, 0x1d, 0xa2, 0x42, 0xf3, 0xdd, 0x17, 0x4d,
-	0x51, 0x08, 0x2a, 0xcd, 0x82, 0xff, 0xfa, 0x77, 0x21, 0x4b, 0x5c, 0xc2, 0x89, 0x2c, 0x01, 0x8e,
-	0x89, 0x1b, 0x2c, 0x28, 0xa1, 0xb1, 0x15, 0x1d, 0xc4, 0x09, 0x75, 0x85, 0x75, 0x20, 0xa0, 0x8c,
-	0x00, 0x49, 0x3f, 0x81, 0x9c, 0x83, 0x48, 0xb0, 0x76, 0xc8, 0xb5, 0x70, 0xb3, 0x14, 0x18, 0x4a,
-	0xca, 0x52, 0x0c, 0x2f, 0x2e, 0xa9, 0xdd, 0xdb, 0xd3, 0x43, 0xe1, 0xf7, 0xf3, 0xf6, 0xcc, 0x70,
-	0xa8, 0x4d, 0x47, 0xb6, 0x2e, 0xd9, 0x65, 0x76, 0xe4, 0xd9, 0x65, 0xee, 0xd2, 0xb2, 0x4b, 0xe2,
-	0x88, 0xfd, 0xf7, 0x14, 0xac, 0xf5, 0x2d, 0x36, 0x2e, 0x3d, 0x6a, 0xaf, 0xc3, 0x6c, 0x18, 0x50,
-	0xe7, 0x4e, 0x95, 0xd6, 0x54, 0xdc, 0xaa, 0x40, 0xac, 0x08, 0x9a, 0x7e, 0x13, 0xe6, 0x15, 0x93,
-	0xe7, 0xd3, 0x33, 0x62, 0x63, 0x5f, 0x45, 0xef, 0x9c, 0x24, 0x97, 0x15, 0xb5, 0x35, 0xdc, 0xa6,
-	0x86, 0x0c, 0xb7, 0x41, 0xa3, 0xfc, 0x36, 0x64, 0x45, 0xbd, 0x2a, 0xce, 0x62, 0x26, 0x27, 0x0e,
-	0x66, 0x1c, 0x39, 0x9e, 0x08, 0xf7, 0x71, 0x63, 0xb1, 0xd1, 0x77, 0x18, 0x76, 0x05, 0x43, 0x62,
-	0x75, 0x40, 0x63, 0x48, 0x4a, 0x0e, 0x69, 0xf4, 0x35, 0x86, 0x64, 0x61, 0x12, 0xd9, 0x0e, 0x71,
-	0x65, 0x3c, 0x1a, 0xb2, 0xd1, 0x9a, 0xf6, 0xd2, 0x6d, 0x69, 0xaf, 0x3d, 0xde, 0x32, 0x23, 0x89,
-	0xb7, 0xd9, 0xd1, 0xc5, 0xdb, 0xdc, 0xc8, 0xe3, 0x6d, 0xfe, 0xb3, 0x8f, 0xb7, 0x8f, 0xa6, 0x61,
-	0xad, 0xef, 0x41, 0xe8, 0xc5, 0x2e, 0x39, 0x40, 0xd8, 0x2e, 0xc1, 0x94, 0x3c, 0x36, 0xaa, 0x28,
-	0x52, 0xad, 0xae, 0xbb, 0x27, 0x7c, 0x26, 0xbb, 0x67, 0x7a, 0xc4, 0xbb, 0xe7, 0x8b, 0x68, 0xfe,
-	0x7f, 0x88, 0xe6, 0x5f, 0xa5, 0xe0, 0x7a, 0x82, 0xcb, 0xa6, 0xd1, 0xdc, 0x82, 0x77, 0x73, 0xf0,
-	0xe1, 0xee, 0xc2, 0x07, 0x75, 0xf0, 0xe1, 0xee, 0xc6, 0x93, 0x3b, 0xf8, 0xd4, 0x48, 0x0e, 0x43,
-	0xd3, 0x23, 0xbd, 0xd1, 0x9f, 0x19, 0xf9, 0x8d, 0x7e, 0x6a, 0xe4, 0x37, 0xfa, 0x70, 0x79, 0x37,
-	0xfa, 0xdf, 0x01, 0xfd, 0x6d, 0x5a, 0xf7, 0x6b, 0xe7, 0xfb, 0x2e, 0xc7, 0x3e, 0x66, 0xdc, 0x68,
-	0xae, 0xfb, 0x07, 0x72, 0xcf, 0x76, 0x24, 0xbd, 0x0a, 0x59, 0x49, 0xdd, 0xab, 0xbb, 0xe2, 0x7e,
-	0x0e, 0x71, 0xbc, 0x83, 0xbc, 0x58, 0x6e, 0x1c, 0x44, 0x42, 0x47, 0xac, 0xd8, 0xab, 0xc4, 0xec,
-	0x70, 0xaf, 0x12, 0xfa, 0x41, 0x54, 0xeb, 0x8a, 0xbb, 0x37, 0x26, 0x32, 0x61, 0xba, 0x37, 0x90,
-	0xdc, 0xea, 0x44, 0x26, 0x61, 0x61, 0x55, 0x2c, 0x5b, 0x89, 0x53, 0xd3, 0x7f, 0x35, 0xc8, 0xf7,
-	0xbe, 0x3b, 0x1a, 0x4d, 0x56, 0xfa, 0x16, 0x2c, 0x34, 0x5d, 0x75, 0x11, 0x6b, 0xd8, 0xd7, 0xb9,
-	0x79, 0x16, 0x53, 0x99, 0x58, 0xc9, 0xb3, 0xf2, 0x5f, 0x35, 0xb8, 0xda, 0xe3, 0x7a, 0x70, 0xe8,
-	0x79, 0x97, 0x61, 0xae, 0xf9, 0xde, 0x52, 0xbd, 0x8c, 0xbc, 0xd1, 0xfb, 0x2d, 0x22, 0xa6, 0x82,
-	0x31, 0xdb, 0x74, 0x33, 0x99, 0x78, 0x46, 0xff, 0x9a, 0x86, 0x1b, 0xc9, 0xee, 0x5f, 0x5f, 0x3c,
-	0xb8, 0xbe, 0x78, 0x70, 0x4d, 0x98, 0x9e, 0xbb, 0x9d, 0x5f, 0x53, 0x83, 0x9f, 0x5f, 0xa1, 0xfb,
-	0xf9, 0xb5, 0x53, 0x3e, 0x48, 0x5f, 0x4a, 0x3e, 0x68, 0x1c, 0x8d, 0x33, 0xf1, 0xa3, 0xf1, 0xc5,
-	0x33, 0xf6, 0x83, 0xce, 0x19, 0xfb, 0x0b, 0x3d, 0x1f, 0xda, 0xd4, 0x65, 0xc4, 0x25, 0x64, 0xee,
-	0x3f, 0x68, 0x90, 0xed, 0x04, 0x17, 0x9c, 0x74, 0xd4, 0x75, 0x89, 0x8c, 0x6d, 0xd5, 0xd2, 0x97,
-	0x61, 0x26, 0xba, 0x21, 0x91, 0x91, 0x1d, 0xb5, 0xbb, 0x1d, 0xca, 0xc6, 0x13, 0x1e, 0xca, 0x26,
-	0x86, 0x3b, 0x94, 0xad, 0xff, 0x59, 0x83, 0x4c, 0x93, 0xee, 0x2d, 0x07, 0x4c, 0xad, 0xef, 0x01,
-	0xf3, 0x4a, 0xe2, 0x03, 0xe6, 0xa8, 0xe7, 0xf2, 0xa7, 0x2b, 0x70, 0xbd, 0xe3, 0x33, 0xe1, 0x25,
-	0x1d, 0xda, 0xdf, 0x83, 0xd9, 0xe8, 0x05, 0x93, 0xb8, 0x47, 0x54, 0x4c, 0x28, 0xbd, 0xf9, 0xa5,
-	0x81, 0x9f, 0x2d, 0xf7, 0xdd, 0x23, 0x6a, 0x64, 0xac, 0x58, 0x4b, 0xaf, 0xc2, 0xcb, 0x11, 0xb6,
-	0x7a, 0x2d, 0xf5, 0x28, 0x8d, 0x5e, 0xd1, 0x0b, 0xbd, 0x64, 0x84, 0xb0, 0x52, 0x48, 0x99, 0xd2,
-	0x9a, 0xb1, 0x68, 0xb5, 0xd1, 0x92, 0xfb, 0xf5, 0x47, 0xe3, 0x5d, 0xec, 0x78, 0x49, 0x3b, 0xd8,
-	0x28, 0xed, 0x58, 0x87, 0x95, 0x8e, 0x76, 0x34, 0x91, 0x6d, 0x13, 0xb1, 0x23, 0x0f, 0x69, 0xd1,
-	0x57, 0x3b, 0x58, 0x74, 0x2b, 0xc4, 0xd4, 0x1f, 0xc2, 0xb5, 0xce, 0x62, 0xe5, 0x83, 0x69, 0xf8,
-	0xfd, 0xc1, 0xa0, 0x42, 0x97, 0x3b, 0x08, 0x95, 0x8b, 0x90, 0x7c, 0x35, 0x7f, 0xa2, 0xc1, 0x4b,
-	0xe1, 0x70, 0xe2, 0x72, 0x39, 0x5c, 0xbf, 0x09, 0xf3, 0xc8, 0x92, 0xef, 0xaa, 0xc8, 0xb6, 0x7d,
-	0xcc, 0x98, 0x5a, 0xc5, 0x39, 0x45, 0xde, 0x92, 0x54, 0xfd, 0x00, 0xc0, 0xc5, 0x8f, 0x4c, 0x2f,
-	0x18, 0xcb, 0x86, 0xbc, 0xcd, 0x48, 0xb9, 0xf8, 0x91, 0x10, 0xce, 0xd6, 0x7f, 0x7d, 0x05, 0x36,
-	0x9a, 0xd6, 0xb2, 0x8c, 0x45, 0x19, 0x2f, 0xbb, 0x2f, 0xc9, 0xc1, 0xde, 0x84, 0x25, 0x4f, 0xc2,
-	0x8a, 0x55, 0x88, 0xed, 0x7f, 0xe3, 0x62, 0xff, 0xcb, 0x7a, 0xa1, 0x50, 0x5a, 0x6b, 0x6c, 0x80,
-	0x26, 0x64, 0xa3, 0xa5, 0x23, 0x2e, 0x8f, 0x96, 0x4e, 0xfa, 0xcb, 0xad, 0x5e, 0x4b, 0xd7, 0x66,
-	0x5f, 0x43, 0xf7, 0x5b, 0x49, 0x03, 0xbc, 0xf0, 0x6a, 0xb0, 0xd8, 0xe1, 0x01, 0x7b, 0x68, 0x73,
-	0x7c, 0x03, 0x66, 0x98, 0x75, 0x82, 0xed, 0x7a, 0x0d, 0xab, 0x50, 0x4b, 0xfa, 0x76, 0x5e, 0x51,
-	0xc3, 0x8c, 0x08, 0x20, 0xf1, 0x24, 0x3e, 0xd1, 0x60, 0x45, 0x7c, 0x10, 0xb5, 0x43, 0x1d, 0xa7,
-	0xee, 0x12, 0x7e, 0x1e, 0x58, 0xbb, 0x12, 0x58, 0xfe, 0xc2, 0x13, 0x7a, 0x00, 0xa9, 0xd6, 0x8f,
-	0x9e, 0xde, 0x52, 0x5f, 0x6b, 0x16, 0x9a, 0x3e, 0xcc, 0x6c, 0x28, 0xd5, 0x4d, 0x07, 0xa3, 0x81,
-	0x94, 0x78, 0x6a, 0xff, 0xd1, 0xa0, 0xb0, 0xc5, 0xa9, 0x43, 0x2c, 0x59, 0x95, 0xdc, 0xf7, 0x6d,
-	0x51, 0x75, 0x1e, 0xd4, 0x6b, 0x9c, 0x78, 0x35, 0x82, 0xfd, 0xd0, 0x6e, 0x17, 0x9e, 0x29, 0x86,
-	0xa5, 0xf0, 0xeb, 0x04, 0x8c, 0x4d, 0x27, 0x12, 0x10, 0x4e, 0xbb, 0x98, 0xe0, 0x8b, 0x84, 0xb8,
-	0x62, 0x46, 0xd6, 0x69, 0x27, 0x26, 0x9e, 0xf9, 0xe7, 0x1f, 0x43, 0x26, 0xfe, 0x35, 0x9c, 0xbe,
-	0x09, 0xd9, 0xd2, 0x37, 0x77, 0xde, 0xde, 0xba, 0xf7, 0xf5, 0x92, 0xf9, 0xe0, 0x5e, 0xa5, 0x5c,
-	0xda, 0xd9, 0xdf, 0xdb, 0x2f, 0xed, 0x2e, 0x8c, 0x2d, 0xe7, 0x9e, 0x3c, 0x5d, 0xed, 0xd8, 0xa7,
-	0xeb, 0x30, 0x51, 0x29, 0xdf, 0x3f, 0x5c, 0xd0, 0x96, 0x67, 0x9e, 0x3c, 0x5d, 0x15, 0xff, 0x03,
-	0x43, 0xec, 0x96, 0x8c, 0xfd, 0x77, 0xb7, 0x0e, 0xf7, 0xdf, 0x2d, 0x55, 0x16, 0xae, 0x2c, 0xcf,
-	0x3f, 0x79, 0xba, 0x1a, 0x27, 0x6d, 0x9f, 0x7c, 0xf8, 0x2c, 0xaf, 0x7d, 0xfc, 0x2c, 0xaf, 0xfd,
-	0xfd, 0x59, 0x5e, 0xfb, 0xe9, 0xf3, 0xfc, 0xd8, 0xc7, 0xcf, 0xf3, 0x63, 0x9f, 0x3c, 0xcf, 0x8f,
-	0xbd, 0x77, 0x2f, 0x96, 0x84, 0xf6, 0x43, 0x63, 0xdc, 0x45, 0x55, 0x56, 0x8c, 0x4c, 0x73, 0xcb,
-	0xa2, 0x3e, 0x8e, 0x37, 0x4f, 0x10, 0x71, 0x8b, 0x0e, 0x0d, 0x96, 0x88, 0x35, 0x3e, 0xb1, 0x15,
-	0x09, 0xab, 0x3a, 0x25, 0xbe, 0x98, 0xfd, 0xe2, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x8e,
-	0xe4, 0xae, 0x66, 0x2c, 0x00, 0x00,
+	// 2339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
+	0x15, 0x76, 0xc7, 0x8e, 0x3d, 0xf3, 0x66, 0xfc, 0x93, 0xf6, 0xac, 0x77, 0xd6, 0xd9, 0x8c, 0x7f,
+	0xb2, 0x9b, 0xf5, 0x22, 0x65, 0x86, 0x84, 0x45, 0x2b, 0x22, 0x21, 0x88, 0xed, 0x31, 0x6b, 0x11,
+	0xc7, 0x93, 0x1e, 0x67, 0xb5, 0x44, 0x40, 0x53, 0xd3, 0x5d, 0x1e, 0x17, 0x9e, 0xfe, 0x49, 0x57,
+	0x8d, 0x63, 0xaf, 0x38, 0x82, 0x40, 0xe1, 0xc2, 0x4a, 0x20, 0x4e, 0x91, 0x96, 0x1b, 0x37, 0x2e,
+	0x9c, 0x91, 0x40, 0x42, 0x0a, 0xbb, 0x07, 0xf6, 0xb8, 0x02, 0x69, 0x85, 0x92, 0x03, 0x5c, 0x38,
+	0xc3, 0x11, 0x75, 0x55, 0x75, 0x4f, 0xcf, 0x7f, 0xbb, 0xed, 0x89, 0x38, 0xe4, 0x34, 0xd3, 0xf5,
+	0xf3, 0xbd, 0x9f, 0x7a, 0xaf, 0xde, 0xab, 0x57, 0x05, 0x6f, 0x13, 0xfb, 0x87, 0xd8, 0x60, 0xe4,
+	0x08, 0x97, 0xf0, 0xb1, 0x71, 0x80, 0xec, 0x3a, 0x2e, 0x1d, 0xdd, 0xa8, 0x61, 0x86, 0x6e, 0x94,
+	0x5c, 0xcf, 0x71, 0x1d, 0x8a, 0x1a, 0x45, 0xd7, 0x73, 0x98, 0xa3, 0x2e, 0x86, 0x43, 0x8b, 0xc1,
+	0xd0, 0xa2, 0x1c, 0xba, 0x58, 0x30, 0x1c, 0x6a, 0x39, 0xb4, 0x54, 0x43, 0xb4, 0x35, 0xdf, 0x70,
+	0x88, 0x2d, 0xe6, 0x2e, 0x16, 0x65, 0xbf, 0x49, 0x28, 0xf3, 0x48, 0xad, 0xc9, 0x88, 0x63, 0x87,
+	0xe3, 0xa2, 0x8d, 0x72, 0xfc, 0xab, 0x72, 0xbc, 0x45, 0xeb, 0xa5, 0xa3, 0x1b, 0xfe, 0x8f, 0xec,
+	0x78, 0x4d, 0x74, 0xe8, 0xfc, 0xab, 0x24, 0x3e, 0x64, 0x57, 0xae, 0xee, 0xd4, 0x1d, 0xd1, 0xee,
+	0xff, 0x93, 0xad, 0x83, 0x04, 0x0c, 0xc5, 0x10, 0x43, 0xdf, 0x6c, 0x0d, 0x75, 0x3c, 0x64, 0x34,
+	0x5a, 0x03, 0xc5, 0xa7, 0x18, 0xb6, 0xfa, 0xd1, 0x24, 0x5c, 0xa9, 0xba, 0x0e, 0xdb, 0x41, 0xde,
+	0x21, 0x66, 0x15, 0xe4, 0x21, 0xeb, 0xbe, 0x6b, 0x22, 0x86, 0x2b, 0x52, 0x5f, 0x6a, 0x0e, 0x2e,
+	0x32, 0xc2, 0x1a, 0x38, 0xaf, 0x2c, 0x2b, 0x6b, 0x69, 0x4d, 0x7c, 0xa8, 0xcb, 0x90, 0x31, 0x31,
+	0x35, 0x3c, 0xe2, 0xfa, 0x82, 0xe6, 0x2f, 0xf0, 0xbe, 0x68, 0x93, 0x7a, 0x19, 0xd2, 0x16, 0x07,
+	0xd5, 0x89, 0x99, 0x1f, 0xe7, 0xfd, 0x29, 0xd1, 0xb0, 0x6d, 0xaa, 0x7b, 0x30, 0x63, 0xa1, 0x43,
+	0xec, 0xe9, 0xfb, 0x18, 0xeb, 0x1e, 0x62, 0x38, 0x3f, 0xe1, 0x8f, 0x58, 0x2f, 0x3e, 0xfd, 0x62,
+	0x49, 0xf9, 0xdb, 0x17, 0x4b, 0xd7, 0xea, 0x84, 0x1d, 0x34, 0x6b, 0x45, 0xc3, 0xb1, 0xa4, 0x5e,
+	0xe4, 0xcf, 0x75, 0x6a, 0x1e, 0x96, 0xd8, 0x89, 0x8b, 0x69, 0x71, 0x13, 0x1b, 0x5a, 0x96, 0xa3,
+	0x6c, 0x61, 0xac, 0x21, 0x86, 0x7d, 0x54, 0xd6, 0x8e, 0x7a, 0x31, 0x19, 0x2a, 0x8b, 0xa2, 0x1a,
+	0xb0, 0xe0, 0xe1, 0x06, 0x3a, 0x91, 0xb8, 0xf4, 0x00, 0x79, 0x12, 0x7d, 0x32, 0x11, 0xfa, 0xbc,
+	0x44, 0xdb, 0xc2, 0xb8, 0xea, 0x63, 0x71, 0x22, 0xdf, 0x83, 0x79, 0x8b, 0xd8, 0xba, 0xeb, 0x11,
+	0x03, 0xeb, 0x8c, 0x18, 0x87, 0x3a, 0x25, 0x1f, 0xe2, 0xfc, 0x54, 0x22, 0x0a, 0x73, 0x16, 0xb1,
+	0x2b, 0x3e, 0xd2, 0x1e, 0x31, 0x0e, 0xab, 0xe4, 0x43, 0x2e, 0x83, 0x0f, 0xff, 0xb0, 0x89, 0x6c,
+	0x46, 0xd8, 0x49, 0x84, 0x42, 0x2a, 0x99, 0x0c, 0x16, 0xb1, 0xef, 0x49, 0xb0, 0x90, 0xc8, 0x37,
+	0x61, 0x92, 0x32, 0xc4, 0x9a, 0x34, 0x9f, 0x5e, 0x56, 0xd6, 0x66, 0x6e, 0xae, 0x15, 0xfb, 0x3b,
+	0x59, 0x51, 0x18, 0x5c, 0x95, 0x8f, 0xd7, 0xe4, 0x3c, 0xf5, 0xbb, 0xa0, 0x5a, 0xe8, 0x58, 0xa7,
+	0x0d, 0xe2, 0xba, 0xa8, 0xce, 0xb5, 0x4c, 0x9c, 0x3c, 0x24, 0x54, 0x02, 0x3a, 0xae, 0x4a, 0x20,
+	0xcd, 0xc7, 0xb9, 0x75, 0xed, 0x67, 0x1f, 0x2f, 0x8d, 0xfd, 0xeb, 0xe3, 0xa5, 0xb1, 0x4f, 0x7e,
+	0x7f, 0x7d, 0x51, 0x7a, 0x5b, 0xdd, 0x39, 0x0a, 0x59, 0xda, 0x70, 0x6c, 0x86, 0x6d, 0xb6, 0xfa,
+	0x5b, 0x05, 0x16, 0xca, 0x92, 0xdf, 0xb2, 0x8d, 0x6a, 0x8d, 0xb3, 0x3b, 0xc3, 0x1d, 0xc8, 0x06,
+	0x1a, 0xd8, 0x3b, 0x71, 0x71, 0x7e, 0x7c, 0xb8, 0x82, 0xca, 0x91, 0xf1, 0x5a, 0xdb, 0xec, 0x5b,
+	0xa9, 0x40, 0x90, 0xd5, 0x7f, 0x66, 0x61, 0x65, 0x1d, 0x31, 0xe3, 0x20, 0x18, 0xbd, 0xe3, 0x98,
+	0x64, 0x9f, 0x18, 0xbe, 0xbc, 0xf6, 0x99, 0xb9, 0xfe, 0x89, 0x02, 0xab, 0xd4, 0x75, 0x98, 0x2e,
+	0x1d, 0xd9, 0xf5, 0xb7, 0x07, 0xbd, 0xc9, 0xf7, 0x07, 0x3d, 0xd8, 0x50, 0x69, 0x7e, 0x7c, 0x79,
+	0x7c, 0x2d, 0x73, 0xf3, 0x6b, 0x83, 0x84, 0x19, 0xb8, 0xc5, 0x68, 0x05, 0x3a, 0xa8, 0x9b, 0xaa,
+	0xbf, 0x56, 0x60, 0xcd, 0xc4, 0x1e, 0x39, 0x42, 0x3e, 0xfa, 0x10, 0x6e, 0x26, 0x38, 0x37, 0xdf,
+	0x18, 0xc4, 0xcd, 0x66, 0x88, 0xd5, 0x9f, 0xa7, 0x37, 0xcc, 0xe1, 0x83, 0xa8, 0xda, 0x84, 0xd7,
+	0xa3, 0x0a, 0x6a, 0xa0, 0xa6, 0x6d, 0x1c, 0x44, 0x98, 0xb9, 0xc8, 0x99, 0x79, 0x27, 0x9e, 0x6a,
+	0xee, 0xf0, 0xd9, 0x21, 0x07, 0xaf, 0xd1, 0x3e, 0x3d, 0x54, 0xfd, 0xb1, 0x02, 0x2b, 0x2e, 0xf6,
+	0x5c, 0xcc, 0x9a, 0xa8, 0xd1, 0x97, 0xf8, 0xe4, 0xf0, 0x75, 0xa9, 0x04, 0x20, 0x3d, 0x39, 0x28,
+	0xb8, 0x83, 0xba, 0xa9, 0xfa, 0x91, 0x02, 0xd7, 0xf0, 0xb1, 0x4b, 0xbc, 0x13, 0x7d, 0xbf, 0xc9,
+	0x9a, 0x1e, 0xa6, 0x7d, 0x79, 0x99, 0xe2, 0xbc, 0x7c, 0x7d, 0xb0, 0xc1, 0xfb, 0x48, 0x5b, 0x02,
+	0xa8, 0x27, 0x3f, 0xab, 0x78, 0xd8, 0x10, 0xaa, 0xfe, 0x4a, 0x81, 0xb7, 0x98, 0x87, 0x4c, 0x62,
+	0xd7, 0x75, 0x0f, 0x3f, 0x42, 0x9e, 0xa9, 0x1b, 0xc8, 0x72, 0x11, 0xa9, 0xdb, 0x9d, 0xb6, 0xc2,
+	0xf7, 0xbe, 0x21, 0xa6, 0xb2, 0x27, 0xa0, 0x34, 0x8e, 0xb4, 0x21, 0x81, 0x3a, 0x4c, 0xe5, 0x2a,
+	0x1b, 0x3e, 0x88, 0xeb, 0xaa, 0x46, 0x6c, 0xe4, 0x9d, 0xe8, 0x0e, 0xf7, 0xae, 0xfe, 0xba, 0x4a,
+	0x0f, 0xd7, 0xd5, 0x3a, 0x47, 0xda, 0x15, 0x40, 0xbd, 0x75, 0x55, 0x1b, 0x36, 0x84, 0xaa, 0xbf,
+	0x54, 0xe0, 0xcd, 0x0e, 0x9e, 0xfa, 0x38, 0x15, 0x70, 0x96, 0xd6, 0x4f, 0xc9, 0x52, 0x2f, 0xbf,
+	0x5a, 0x69, 0xe3, 0xab, 0xa7, 0x53, 0xfd, 0x08, 0x0a, 0x26, 0xb6, 0x1d, 0x4b, 0x37, 0xb1, 0x41,
+	0x2c, 0xd4, 0xa0, 0x5d, 0x0b, 0x97, 0xe1, 0x0b, 0xf7, 0xee, 0x20, 0x76, 0x04, 0xe8, 0xa6, 0x8f,
+	0xb3, 0x29, 0x61, 0x42, 0x1e, 0x2e, 0x9b, 0xd1, 0xe6, 0x8e, 0x85, 0x32, 0xe0, 0x15, 0x3f, 0xcc,
+	0x9b, 0x84, 0x1a, 0x4e, 0xd3, 0x66, 0x2d, 0xa2, 0x59, 0x4e, 0xb4, 0x34, 0x88, 0xe8, 0x16, 0xc6,
+	0x9b, 0x72, 0x5e, 0x48, 0x6c, 0x7e, 0xbf, 0xbb, 0x51, 0xfd, 0xa9, 0x02, 0xab, 0x72, 0xf9, 0xf7,
+	0x1d, 0xcf, 0xc0, 0xa6, 0x4e, 0x31, 0x63, 0x0d, 0x6c, 0xe1, 0x08, 0x45, 0x9a, 0x9f, 0xe6, 0x6a,
+	0xbf, 0x35, 0x3c, 0x8e, 0x6e, 0x71, 0x90, 0x6a, 0x88, 0x11, 0x52, 0x5f, 0xb2, 0x06, 0xf6, 0xd3,
+	0xd8, 0x41, 0xf1, 0x4f, 0x13, 0x90, 0xef, 0xb7, 0x55, 0x25, 0x0e, 0x30, 0x0b, 0x30, 0xe9, 0x67,
+	0x22, 0xd8, 0x93, 0x09, 0xa2, 0xfc, 0x52, 0xaf, 0x00, 0xf8, 0xc9, 0xb7, 0xce, 0xd7, 0x49, 0xa4,
+	0x86, 0x5a, 0xda, 0x6f, 0xe1, 0xeb, 0xa9, 0x2e, 0x41, 0xe6, 0x61, 0xd3, 0x61, 0x41, 0x3f, 0x4f,
+	0xf2, 0x34, 0xe0, 0x4d, 0x62, 0x40, 0x9f, 0x6c, 0xaa, 0x95, 0xaf, 0x8d, 0x8d, 0x28, 0x9b, 0x9a,
+	0x4a, 0x44, 0xa1, 0x67, 0x36, 0xd5, 0x9d, 0x22, 0xa7, 0x46, 0x92, 0x22, 0xa7, 0xcf, 0x9e, 0x22,
+	0xc7, 0x36, 0xa2, 0xdf, 0x4d, 0xc1, 0x95, 0x81, 0x21, 0xe7, 0xdc, 0x2d, 0xa9, 0xc3, 0x54, 0x26,
+	0xba, 0x4c, 0x65, 0x09, 0x32, 0xe2, 0x40, 0xa4, 0xfb, 0xf6, 0x15, 0xd8, 0x92, 0x68, 0x5a, 0x47,
+	0x14, 0xab, 0x2b, 0x90, 0x95, 0x03, 0xf8, 0x2c, 0x61, 0x44, 0x9a, 0x9c, 0x74, 0xcf, 0x6f, 0x52,
+	0x8b, 0x30, 0x2f, 0x87, 0x50, 0x03, 0x35, 0xb0, 0xbe, 0x8f, 0x0c, 0xe6, 0x78, 0xdc, 0x18, 0xa6,
+	0xb5, 0x4b, 0xa2, 0xab, 0xea, 0xf7, 0x6c, 0xf1, 0x0e, 0xb5, 0x1c, 0xd2, 0xf4, 0x15, 0xca, 0xd7,
+	0x75, 0xe6, 0xe6, 0x1b, 0x11, 0x2f, 0x17, 0xbd, 0xa1, 0xfa, 0x76, 0xf9, 0x27, 0x4f, 0x04, 0xc1,
+	0x09, 0xff, 0xab, 0x3f, 0x80, 0x1c, 0xb1, 0x09, 0x23, 0x22, 0x05, 0xa8, 0x13, 0x5b, 0xe6, 0xcb,
+	0xe9, 0x44, 0x46, 0xa8, 0x4a, 0xac, 0x1d, 0x0e, 0xc5, 0x33, 0x66, 0xf5, 0x00, 0xf2, 0x16, 0x22,
+	0xfe, 0xda, 0x21, 0xdb, 0xc0, 0xed, 0x54, 0x20, 0x11, 0x95, 0x85, 0x08, 0x5e, 0x94, 0x52, 0xb7,
+	0xb5, 0x67, 0x12, 0xe1, 0x0f, 0xb3, 0xf6, 0x6c, 0x32, 0xd4, 0xb6, 0x03, 0x61, 0x9f, 0xdd, 0x65,
+	0x7a, 0xe4, 0xbb, 0xcb, 0xcc, 0xb9, 0xed, 0x2e, 0xb1, 0x3d, 0xf6, 0xdf, 0x93, 0xb0, 0x32, 0x34,
+	0xd9, 0x38, 0x77, 0xaf, 0xbd, 0x0a, 0xd3, 0x81, 0x43, 0x9d, 0x58, 0x35, 0xa7, 0x21, 0xfd, 0x56,
+	0x3a, 0x62, 0x95, 0xb7, 0xa9, 0x6f, 0xc1, 0xac, 0x1c, 0xe4, 0x7a, 0xce, 0x11, 0x31, 0xb1, 0x27,
+	0xbd, 0x77, 0x46, 0x34, 0x57, 0x64, 0x6b, 0xa7, 0xbb, 0x4d, 0x26, 0x74, 0xb7, 0xd3, 0x7a, 0xf9,
+	0x0d, 0xc8, 0xf1, 0x7c, 0x95, 0x9f, 0xc5, 0x74, 0x46, 0x2c, 0x4c, 0x19, 0xb2, 0x5c, 0xee, 0xee,
+	0xe3, 0xda, 0x7c, 0xab, 0x6f, 0x2f, 0xe8, 0xf2, 0xa7, 0x44, 0xf2, 0x80, 0xd6, 0x94, 0xb4, 0x98,
+	0xd2, 0xea, 0x6b, 0x4d, 0xc9, 0xc1, 0x45, 0x64, 0x5a, 0xc4, 0x16, 0xfe, 0xa8, 0x89, 0x8f, 0xce,
+	0x6d, 0x2f, 0xd3, 0xb5, 0xed, 0x75, 0xfb, 0x5b, 0x76, 0x24, 0xfe, 0x36, 0x3d, 0x3a, 0x7f, 0x9b,
+	0x19, 0xb9, 0xbf, 0xcd, 0xbe, 0x78, 0x7f, 0xfb, 0x74, 0x0a, 0x56, 0x86, 0x1e, 0x84, 0x5e, 0x46,
+	0xc9, 0x53, 0xb8, 0xed, 0x02, 0x4c, 0x8a, 0x63, 0xa3, 0xf4, 0x22, 0xf9, 0xd5, 0x37, 0x7a, 0xc2,
+	0x0b, 0x89, 0x9e, 0x99, 0x11, 0x47, 0xcf, 0x97, 0xde, 0xfc, 0xff, 0xe0, 0xcd, 0x7f, 0xcf, 0xc2,
+	0xd5, 0x18, 0xc5, 0xa6, 0xd1, 0xd4, 0xd8, 0xfb, 0x19, 0x78, 0xb2, 0x4a, 0xfb, 0x69, 0x0d, 0x3c,
+	0x59, 0xe5, 0x3d, 0xbe, 0x81, 0x4f, 0x8e, 0xe4, 0x30, 0x34, 0x35, 0xd2, 0xfb, 0x82, 0xd4, 0xc8,
+	0xef, 0x0b, 0xd2, 0x23, 0xbf, 0x2f, 0x80, 0xf3, 0xbb, 0x2f, 0xf8, 0x3e, 0xa8, 0xef, 0x39, 0x4d,
+	0xaf, 0x71, 0xb2, 0x6d, 0x33, 0xec, 0x61, 0xca, 0xb4, 0xf6, 0xbc, 0xff, 0x54, 0xe6, 0xd9, 0x8d,
+	0xa4, 0xd6, 0x20, 0x27, 0x5a, 0xb7, 0x9a, 0x36, 0xaf, 0xcf, 0x21, 0x86, 0x37, 0x90, 0x9b, 0xcf,
+	0x26, 0xa2, 0xd0, 0x13, 0x2b, 0x72, 0xe7, 0x31, 0x9d, 0xf0, 0xce, 0x63, 0x27, 0xcc, 0x75, 0x79,
+	0xed, 0x8d, 0xf2, 0x9d, 0x30, 0x33, 0x18, 0x48, 0x84, 0x3a, 0xbe, 0x93, 0xd0, 0x20, 0x2b, 0x16,
+	0x5f, 0xea, 0x03, 0xb8, 0xe4, 0x5f, 0xa1, 0x38, 0x2e, 0xb6, 0x75, 0x22, 0xb5, 0x91, 0x9f, 0x4d,
+	0x24, 0xf1, 0xac, 0x85, 0x8e, 0x77, 0x5d, 0x6c, 0x07, 0x4a, 0x0d, 0xb0, 0x5d, 0x87, 0x12, 0x9e,
+	0xd3, 0x72, 0x83, 0x98, 0x4b, 0x8c, 0x5d, 0x91, 0x38, 0xdc, 0x18, 0x3e, 0x80, 0x39, 0x61, 0xcc,
+	0x35, 0x64, 0x9b, 0x72, 0x0f, 0xb9, 0x94, 0x08, 0x7a, 0x86, 0xe3, 0xac, 0x23, 0xdb, 0x14, 0x7b,
+	0xc7, 0x3d, 0xc8, 0xfa, 0x5c, 0x37, 0xf0, 0x11, 0xf6, 0x50, 0x1d, 0xe7, 0xd5, 0x44, 0xa8, 0x19,
+	0x0b, 0x1d, 0xdf, 0x91, 0x10, 0x7d, 0xee, 0xa9, 0xe6, 0x5f, 0xf0, 0x3d, 0xd5, 0x7f, 0x15, 0x28,
+	0x0c, 0x2e, 0xff, 0x8d, 0x26, 0xb0, 0x7c, 0x07, 0xe6, 0xda, 0xaa, 0x95, 0xc4, 0x48, 0x7a, 0x7d,
+	0x3b, 0x4b, 0x23, 0x2c, 0x13, 0x23, 0x7e, 0x60, 0xfd, 0xab, 0x02, 0x97, 0x07, 0x54, 0x78, 0x13,
+	0xcb, 0x5d, 0x81, 0x99, 0xf6, 0xd2, 0xb3, 0xbc, 0xdc, 0x7a, 0x7b, 0xf0, 0x75, 0x52, 0x84, 0x05,
+	0x6d, 0xba, 0xad, 0xb8, 0x1c, 0x5b, 0xa2, 0xa7, 0x29, 0xb8, 0x16, 0xaf, 0x84, 0xfe, 0xf2, 0x46,
+	0xfe, 0xe5, 0x8d, 0x7c, 0xcc, 0x08, 0xdb, 0xaf, 0x04, 0x91, 0x3e, 0x7d, 0x09, 0x02, 0xfa, 0x97,
+	0x20, 0x7a, 0xed, 0x07, 0x99, 0x73, 0xd9, 0x0f, 0x5a, 0xd5, 0x8d, 0x6c, 0xb4, 0xba, 0x71, 0xf6,
+	0xa0, 0x7b, 0xbf, 0x77, 0xd0, 0xfd, 0xf2, 0xc0, 0xbb, 0x52, 0x59, 0x4f, 0x1a, 0x10, 0x7c, 0x7b,
+	0xc7, 0x85, 0xd9, 0x17, 0x1c, 0x17, 0xfe, 0xa8, 0x40, 0xae, 0x17, 0xb3, 0xfe, 0x51, 0x58, 0xd6,
+	0xd3, 0xc4, 0xce, 0x21, 0xbf, 0xd4, 0x45, 0x48, 0x85, 0x25, 0x34, 0xb1, 0x6f, 0x84, 0xdf, 0xfd,
+	0x4e, 0xed, 0xe3, 0x31, 0x4f, 0xed, 0x13, 0xc9, 0x4e, 0xed, 0xab, 0x7f, 0x51, 0x20, 0xdb, 0xc6,
+	0x7b, 0x47, 0x05, 0x42, 0x19, 0x5a, 0x81, 0xb8, 0x10, 0xbb, 0x02, 0x31, 0x6a, 0x59, 0xfe, 0x7c,
+	0x01, 0xae, 0xf6, 0xbc, 0x47, 0x3e, 0xa7, 0xaa, 0xce, 0x03, 0x98, 0x0e, 0xaf, 0xb8, 0x89, 0xbd,
+	0xef, 0x70, 0x81, 0x32, 0x37, 0xbf, 0x7a, 0xea, 0x7b, 0xed, 0x6d, 0x7b, 0xdf, 0xd1, 0xb2, 0x46,
+	0xe4, 0x4b, 0xad, 0xc1, 0x2b, 0x21, 0xb6, 0xbc, 0x4e, 0x77, 0x1d, 0x27, 0x7c, 0x66, 0x51, 0x1c,
+	0x44, 0x23, 0x80, 0x15, 0x44, 0x2a, 0x8e, 0xd3, 0xd0, 0xe6, 0x8d, 0xae, 0xb6, 0xf8, 0x21, 0xf2,
+	0xd3, 0xf1, 0x3e, 0x7a, 0x3c, 0xa7, 0xf8, 0x38, 0x4a, 0x3d, 0x36, 0x61, 0xa9, 0xa7, 0x1e, 0x75,
+	0x64, 0x9a, 0x3c, 0x09, 0x4e, 0xaa, 0xd1, 0xd7, 0x7b, 0x68, 0xf4, 0x76, 0x80, 0xa9, 0x3e, 0x84,
+	0x2b, 0xbd, 0xc9, 0x8a, 0x1b, 0xf5, 0xe0, 0x81, 0xca, 0x69, 0x89, 0x2e, 0xf6, 0x20, 0x2a, 0x16,
+	0x21, 0xfe, 0x6a, 0xfe, 0x5c, 0x81, 0x4b, 0xc1, 0x74, 0x62, 0x33, 0x31, 0xdd, 0x2f, 0xea, 0x23,
+	0x43, 0x5c, 0xbc, 0x23, 0xd3, 0xf4, 0x30, 0xa5, 0x72, 0x15, 0x67, 0x64, 0xf3, 0x6d, 0xd1, 0xaa,
+	0xee, 0x00, 0xd8, 0xf8, 0x91, 0xee, 0xfa, 0x73, 0x69, 0xc2, 0x72, 0x57, 0xda, 0xc6, 0x8f, 0x38,
+	0x71, 0xba, 0xfa, 0x9b, 0x0b, 0xb0, 0xd6, 0xb6, 0x96, 0x15, 0xcc, 0xcf, 0x79, 0xa2, 0xfb, 0x9c,
+	0x0c, 0xec, 0x1d, 0x58, 0x70, 0x05, 0x2c, 0x5f, 0x85, 0x48, 0x74, 0x1d, 0xe7, 0xd1, 0x35, 0xe7,
+	0x06, 0x44, 0x9d, 0x46, 0x2b, 0xbc, 0xea, 0x90, 0x0b, 0x97, 0x8e, 0xd8, 0x2c, 0x5c, 0x3a, 0x61,
+	0x2f, 0xd7, 0x07, 0x2d, 0x5d, 0x97, 0x7e, 0x35, 0xd5, 0xeb, 0x6c, 0x3a, 0xc5, 0x13, 0x00, 0x05,
+	0xe6, 0x7b, 0xbc, 0x70, 0x48, 0xac, 0x8e, 0x6f, 0x43, 0x8a, 0x1a, 0x07, 0xd8, 0x6c, 0x36, 0x70,
+	0x7e, 0xfc, 0x54, 0x8f, 0x2b, 0xaa, 0x72, 0x9a, 0x16, 0x02, 0xc4, 0x16, 0xe2, 0x73, 0x05, 0x96,
+	0xf8, 0x8b, 0xb9, 0x0d, 0xc7, 0xb2, 0x9a, 0x36, 0x61, 0x27, 0xbe, 0xb6, 0xab, 0xbe, 0xe6, 0xcf,
+	0x2c, 0xd0, 0x7d, 0x48, 0x77, 0xbe, 0x8a, 0x7b, 0x57, 0x3e, 0x16, 0x2e, 0xb6, 0xbd, 0x0b, 0x6e,
+	0x31, 0xd5, 0x8f, 0x07, 0xad, 0x85, 0x14, 0x5b, 0xb4, 0xff, 0x28, 0x50, 0xbc, 0xcd, 0x1c, 0x8b,
+	0x18, 0x22, 0xe7, 0xd9, 0xf5, 0x4c, 0x9e, 0xd3, 0xee, 0x34, 0x1b, 0x8c, 0xb8, 0x0d, 0x82, 0xbd,
+	0x40, 0x6f, 0x67, 0x96, 0x14, 0xc3, 0x42, 0xf0, 0x7c, 0x05, 0x63, 0xdd, 0x0a, 0x09, 0x04, 0x62,
+	0x97, 0x62, 0x3c, 0x59, 0x89, 0x32, 0xa6, 0xe5, 0xac, 0xee, 0xc6, 0xf8, 0x92, 0x7f, 0xa2, 0xc0,
+	0xab, 0xd2, 0x7b, 0xcf, 0x4d, 0xc4, 0x5d, 0x48, 0x07, 0xc6, 0x15, 0x48, 0x75, 0x63, 0xb8, 0x54,
+	0x1d, 0x5c, 0x68, 0x2d, 0x8c, 0xd8, 0xc2, 0xfc, 0x41, 0x81, 0x65, 0x01, 0xb6, 0x89, 0x1b, 0x84,
+	0x32, 0x62, 0xd7, 0xcb, 0xc7, 0xd8, 0x72, 0xcf, 0xe5, 0x49, 0xe7, 0x15, 0x80, 0xf0, 0x0c, 0x28,
+	0xc4, 0x4a, 0x6b, 0xe9, 0xe0, 0x10, 0x48, 0xfd, 0x23, 0x22, 0xa1, 0x3a, 0xe6, 0xe4, 0x78, 0xbe,
+	0x93, 0xd2, 0x52, 0x84, 0x0a, 0xf2, 0x71, 0x05, 0xf8, 0xd2, 0x31, 0x64, 0xa3, 0x8f, 0x57, 0xd5,
+	0x9b, 0x90, 0x2b, 0x7f, 0xb0, 0xf1, 0xde, 0xed, 0xbb, 0xdf, 0x2a, 0xeb, 0xf7, 0xef, 0x56, 0x2b,
+	0xe5, 0x8d, 0xed, 0xad, 0xed, 0xf2, 0xe6, 0xdc, 0xd8, 0x62, 0xfe, 0xf1, 0x93, 0xe5, 0x9e, 0x7d,
+	0xaa, 0x0a, 0x13, 0xd5, 0xca, 0xee, 0xde, 0x9c, 0xb2, 0x98, 0x7a, 0xfc, 0x64, 0x99, 0xff, 0xf7,
+	0xa5, 0xdb, 0x2c, 0x6b, 0xdb, 0xef, 0xdf, 0xde, 0xdb, 0x7e, 0xbf, 0x5c, 0x9d, 0xbb, 0xb0, 0x38,
+	0xfb, 0xf8, 0xc9, 0x72, 0xb4, 0x69, 0xfd, 0xe0, 0xe9, 0xb3, 0x82, 0xf2, 0xd9, 0xb3, 0x82, 0xf2,
+	0x8f, 0x67, 0x05, 0xe5, 0x17, 0xcf, 0x0b, 0x63, 0x9f, 0x3d, 0x2f, 0x8c, 0x7d, 0xfe, 0xbc, 0x30,
+	0xf6, 0xe0, 0x6e, 0x24, 0x24, 0x6c, 0x07, 0x8b, 0x78, 0x07, 0xd5, 0x68, 0x29, 0x5c, 0xd2, 0xeb,
+	0x86, 0xe3, 0xe1, 0xe8, 0xe7, 0x01, 0x22, 0x76, 0xc9, 0x72, 0xf8, 0xea, 0xb5, 0xde, 0xdb, 0xf3,
+	0xf0, 0x51, 0x9b, 0xe4, 0xcf, 0xe7, 0xbf, 0xf2, 0xbf, 0x01, 0x00, 0xca, 0x0d, 0x6c, 0x84, 0x73,
+	0x30, 0x00, 0x00,
 }
 
 func (m *SpotMarketParamUpdateProposal) Marshal() (dAtA []byte, err error) {
@@ -1296,6 +1417,18 @@ func (m *SpotMarketParamUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSlippageRatio != nil {
+		{
+			size := m.MaxSlippageRatio.Size()
+			i -= size
+			if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Status != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.Status))
 		i--
@@ -2141,6 +2274,74 @@ func (m *DerivativeMarketParamUpdateProposal) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSlippageRatio != nil {
+		{
+			size := m.MaxSlippageRatio.Size()
+			i -= size
+			if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxLeverage != nil {
+		{
+			size := m.MaxLeverage.Size()
+			i -= size
+			if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PriceBandRatio != nil {
+		{
+			size := m.PriceBandRatio.Size()
+			i -= size
+			if _, err := m.PriceBandRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxPositionSize != nil {
+		{
+			size := m.MaxPositionSize.Size()
+			i -= size
+			if _, err := m.MaxPositionSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MaxOpenInterest != nil {
+		{
+			size := m.MaxOpenInterest.Size()
+			i -= size
+			if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.OracleParams != nil {
 		{
 			size, err := m.OracleParams.MarshalToSizedBuffer(dAtA[:i])
@@ -2417,6 +2618,18 @@ func (m *BinaryOptionsMarketParamUpdateProposal) MarshalToSizedBuffer(dAtA []byt
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSlippageRatio != nil {
+		{
+			size := m.MaxSlippageRatio.Size()
+			i -= size
+			if _, err := m.MaxSlippageRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.OracleParams != nil {
 		{
 			size, err := m.OracleParams.MarshalToSizedBuffer(dAtA[:i])
@@ -3028,6 +3241,113 @@ func (m *AtomicMarketOrderFeeMultiplierScheduleProposal) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
+func (m *TradingScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketDelistingExemptionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketDelistingExemptionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketDelistingExemptionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsExempt {
+		i--
+		if m.IsExempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MarketIds) > 0 {
+		for iNdEx := len(m.MarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MarketIds[iNdEx])
+			copy(dAtA[i:], m.MarketIds[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.MarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -3080,6 +3400,10 @@ func (m *SpotMarketParamUpdateProposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovProposal(uint64(m.Status))
 	}
+	if m.MaxSlippageRatio != nil {
+		l = m.MaxSlippageRatio.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -3444,6 +3768,26 @@ func (m *DerivativeMarketParamUpdateProposal) Size() (n int) {
 		l = m.OracleParams.Size()
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if m.MaxOpenInterest != nil {
+		l = m.MaxOpenInterest.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.MaxPositionSize != nil {
+		l = m.MaxPositionSize.Size()
+		n += 2 + l + sovProposal(uint64(l))
+	}
+	if m.PriceBandRatio != nil {
+		l = m.PriceBandRatio.Size()
+		n += 2 + l + sovProposal(uint64(l))
+	}
+	if m.MaxLeverage != nil {
+		l = m.MaxLeverage.Size()
+		n += 2 + l + sovProposal(uint64(l))
+	}
+	if m.MaxSlippageRatio != nil {
+		l = m.MaxSlippageRatio.Size()
+		n += 2 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -3554,6 +3898,10 @@ func (m *BinaryOptionsMarketParamUpdateProposal) Size() (n int) {
 		l = m.OracleParams.Size()
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if m.MaxSlippageRatio != nil {
+		l = m.MaxSlippageRatio.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -3771,6 +4119,55 @@ func (m *AtomicMarketOrderFeeMultiplierScheduleProposal) Size() (n int) {
 	return n
 }
 
+func (m *TradingScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketDelistingExemptionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.MarketIds) > 0 {
+		for _, s := range m.MarketIds {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if m.IsExempt {
+		n += 2
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4101,20 +4498,56 @@ func (m *SpotMarketParamUpdateProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProposal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
 			}
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSlippageRatio = &v
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -7076,6 +7509,186 @@ func (m *DerivativeMarketParamUpdateProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxOpenInterest = &v
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPositionSize = &v
+			if err := m.MaxPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBandRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.PriceBandRatio = &v
+			if err := m.PriceBandRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxLeverage = &v
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSlippageRatio = &v
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -7893,6 +8506,42 @@ func (m *BinaryOptionsMarketParamUpdateProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxSlippageRatio = &v
+			if err := m.MaxSlippageRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -9349,6 +9998,320 @@ func (m *AtomicMarketOrderFeeMultiplierScheduleProposal) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
+func (m *TradingScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, &MarketTradingSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketDelistingExemptionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketDelistingExemptionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketDelistingExemptionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketIds = append(m.MarketIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -87,10 +87,10 @@ func (o *SpotMarketOrder) FeeRecipient() common.Address {
 
 func (o *SpotOrder) CheckTickSize(minPriceTickSize, minQuantityTickSize sdk.Dec) error {
 	if BreachesMinimumTickSize(o.OrderInfo.Price, minPriceTickSize) {
-		return errors.Wrapf(ErrInvalidPrice, "price %s must be a multiple of the minimum price tick size %s", o.OrderInfo.Price.String(), minPriceTickSize.String())
+		return errors.Wrapf(ErrPriceTickSizeMisaligned, "price %s must be a multiple of the minimum price tick size %s", o.OrderInfo.Price.String(), minPriceTickSize.String())
 	}
 	if BreachesMinimumTickSize(o.OrderInfo.Quantity, minQuantityTickSize) {
-		return errors.Wrapf(ErrInvalidQuantity, "quantity %s must be a multiple of the minimum quantity tick size %s", o.OrderInfo.Quantity.String(), minQuantityTickSize.String())
+		return errors.Wrapf(ErrQuantityTickSizeMisaligned, "quantity %s must be a multiple of the minimum quantity tick size %s", o.OrderInfo.Quantity.String(), minQuantityTickSize.String())
 	}
 	return nil
 }
@@ -131,7 +131,7 @@ func (m *SpotLimitOrder) GetUnfilledNotional() sdk.Dec {
 	return m.Fillable.Mul(m.OrderInfo.Price)
 }
 func (m *SpotLimitOrder) GetUnfilledFeeAmount(fee sdk.Dec) sdk.Dec {
-	return m.GetUnfilledNotional().Mul(fee)
+	return CalculateFee(m.GetUnfilledNotional(), fee)
 }
 
 func (m *SpotOrder) GetBalanceHoldAndMarginDenom(market *SpotMarket) (sdk.Dec, string) {
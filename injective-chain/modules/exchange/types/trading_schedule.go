@@ -0,0 +1,56 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+)
+
+// SecondsPerDay is the exclusive upper bound of the seconds of a TradingWindow
+const SecondsPerDay = 24 * 60 * 60
+
+func (w TradingWindow) ValidateBasic() error {
+	if w.EndSecond > SecondsPerDay {
+		return ErrInvalidTradingSchedule.Wrapf("window end %d must not exceed %d", w.EndSecond, SecondsPerDay)
+	}
+
+	if w.StartSecond >= w.EndSecond {
+		return ErrInvalidTradingSchedule.Wrapf("window start %d must be lower than its end %d", w.StartSecond, w.EndSecond)
+	}
+
+	return nil
+}
+
+// Contains returns true if the second of the day falls within the window.
+func (w TradingWindow) Contains(secondOfDay uint32) bool {
+	return secondOfDay >= w.StartSecond && secondOfDay < w.EndSecond
+}
+
+func (s *MarketTradingSchedule) ValidateBasic() error {
+	if !IsHexHash(s.MarketId) {
+		return errors.Wrap(ErrMarketInvalid, s.MarketId)
+	}
+
+	for _, window := range s.Windows {
+		if err := window.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsOpen returns true if the block time falls within one of the windows of the schedule. The block time is converted
+// to UTC so that the result doesn't depend on the local timezone of the node.
+func (s *MarketTradingSchedule) IsOpen(blockTime time.Time) bool {
+	utcTime := blockTime.UTC()
+	secondOfDay := uint32(utcTime.Hour()*3600 + utcTime.Minute()*60 + utcTime.Second())
+
+	for _, window := range s.Windows {
+		if window.Contains(secondOfDay) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -126,28 +126,28 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-// MsgDeposit defines a SDK message for transferring coins from the sender's
-// bank balance into the subaccount's exchange deposits
-type MsgDeposit struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// (Optional) bytes32 subaccount ID to deposit funds into. If empty, the coin
-	// will be deposited to the sender's default subaccount address.
-	SubaccountId string     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+// MsgForceSettleMarket defines a governance message for settling a derivative
+// market at a governance approved price
+type MsgForceSettleMarket struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MarketId  string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// price at which all positions of the market are closed
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
 }
 
-func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
-func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
-func (*MsgDeposit) ProtoMessage()    {}
-func (*MsgDeposit) Descriptor() ([]byte, []int) {
+func (m *MsgForceSettleMarket) Reset()         { *m = MsgForceSettleMarket{} }
+func (m *MsgForceSettleMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgForceSettleMarket) ProtoMessage()    {}
+func (*MsgForceSettleMarket) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{2}
 }
-func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
+func (m *MsgForceSettleMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgForceSettleMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgForceSettleMarket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -157,34 +157,47 @@ func (m *MsgDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgDeposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeposit.Merge(m, src)
+func (m *MsgForceSettleMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceSettleMarket.Merge(m, src)
 }
-func (m *MsgDeposit) XXX_Size() int {
+func (m *MsgForceSettleMarket) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeposit.DiscardUnknown(m)
+func (m *MsgForceSettleMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceSettleMarket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeposit proto.InternalMessageInfo
+var xxx_messageInfo_MsgForceSettleMarket proto.InternalMessageInfo
 
-// MsgDepositResponse defines the Msg/Deposit response type.
-type MsgDepositResponse struct {
+func (m *MsgForceSettleMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
-func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDepositResponse) ProtoMessage()    {}
-func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
+func (m *MsgForceSettleMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+type MsgForceSettleMarketResponse struct {
+}
+
+func (m *MsgForceSettleMarketResponse) Reset()         { *m = MsgForceSettleMarketResponse{} }
+func (m *MsgForceSettleMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceSettleMarketResponse) ProtoMessage()    {}
+func (*MsgForceSettleMarketResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{3}
 }
-func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgForceSettleMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgForceSettleMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDepositResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgForceSettleMarketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -194,39 +207,38 @@ func (m *MsgDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgDepositResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDepositResponse.Merge(m, src)
+func (m *MsgForceSettleMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceSettleMarketResponse.Merge(m, src)
 }
-func (m *MsgDepositResponse) XXX_Size() int {
+func (m *MsgForceSettleMarketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDepositResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDepositResponse.DiscardUnknown(m)
+func (m *MsgForceSettleMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceSettleMarketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgForceSettleMarketResponse proto.InternalMessageInfo
 
-// MsgWithdraw defines a SDK message for withdrawing coins from a subaccount's
-// deposits to the user's bank balance
-type MsgWithdraw struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// bytes32 subaccount ID to withdraw funds from
-	SubaccountId string     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+// MsgCancelAllOrdersInMarket defines a governance message for cancelling the
+// resting limit and conditional orders of a market
+type MsgCancelAllOrdersInMarket struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MarketId  string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
-func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdraw) ProtoMessage()    {}
-func (*MsgWithdraw) Descriptor() ([]byte, []int) {
+func (m *MsgCancelAllOrdersInMarket) Reset()         { *m = MsgCancelAllOrdersInMarket{} }
+func (m *MsgCancelAllOrdersInMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrdersInMarket) ProtoMessage()    {}
+func (*MsgCancelAllOrdersInMarket) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{4}
 }
-func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAllOrdersInMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAllOrdersInMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAllOrdersInMarket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -236,34 +248,52 @@ func (m *MsgWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdraw.Merge(m, src)
+func (m *MsgCancelAllOrdersInMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrdersInMarket.Merge(m, src)
 }
-func (m *MsgWithdraw) XXX_Size() int {
+func (m *MsgCancelAllOrdersInMarket) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdraw.DiscardUnknown(m)
+func (m *MsgCancelAllOrdersInMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrdersInMarket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAllOrdersInMarket proto.InternalMessageInfo
 
-// MsgWithdraw defines the Msg/Withdraw response type.
-type MsgWithdrawResponse struct {
+func (m *MsgCancelAllOrdersInMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
-func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawResponse) ProtoMessage()    {}
-func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelAllOrdersInMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+type MsgCancelAllOrdersInMarketResponse struct {
+	// number of orders cancelled by the message
+	CancelledOrdersCount uint32 `protobuf:"varint,1,opt,name=cancelled_orders_count,json=cancelledOrdersCount,proto3" json:"cancelled_orders_count,omitempty"`
+	// true if the market still has orders to cancel because of the per message
+	// bound, the message must then be sent again
+	HasMoreOrders bool `protobuf:"varint,2,opt,name=has_more_orders,json=hasMoreOrders,proto3" json:"has_more_orders,omitempty"`
+}
+
+func (m *MsgCancelAllOrdersInMarketResponse) Reset()         { *m = MsgCancelAllOrdersInMarketResponse{} }
+func (m *MsgCancelAllOrdersInMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrdersInMarketResponse) ProtoMessage()    {}
+func (*MsgCancelAllOrdersInMarketResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{5}
 }
-func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAllOrdersInMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAllOrdersInMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAllOrdersInMarketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -273,37 +303,51 @@ func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawResponse.Merge(m, src)
+func (m *MsgCancelAllOrdersInMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrdersInMarketResponse.Merge(m, src)
 }
-func (m *MsgWithdrawResponse) XXX_Size() int {
+func (m *MsgCancelAllOrdersInMarketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawResponse.DiscardUnknown(m)
+func (m *MsgCancelAllOrdersInMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrdersInMarketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAllOrdersInMarketResponse proto.InternalMessageInfo
 
-// MsgCreateSpotLimitOrder defines a SDK message for creating a new spot limit
-// order.
-type MsgCreateSpotLimitOrder struct {
-	Sender string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Order  SpotOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+func (m *MsgCancelAllOrdersInMarketResponse) GetCancelledOrdersCount() uint32 {
+	if m != nil {
+		return m.CancelledOrdersCount
+	}
+	return 0
 }
 
-func (m *MsgCreateSpotLimitOrder) Reset()         { *m = MsgCreateSpotLimitOrder{} }
-func (m *MsgCreateSpotLimitOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSpotLimitOrder) ProtoMessage()    {}
-func (*MsgCreateSpotLimitOrder) Descriptor() ([]byte, []int) {
+func (m *MsgCancelAllOrdersInMarketResponse) GetHasMoreOrders() bool {
+	if m != nil {
+		return m.HasMoreOrders
+	}
+	return false
+}
+
+// MsgBatchCancelOrders defines the Msg/BatchCancelOrders request type.
+type MsgBatchCancelOrders struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// orders to cancel, in spot, derivative or binary options markets
+	Data []OrderData `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+}
+
+func (m *MsgBatchCancelOrders) Reset()         { *m = MsgBatchCancelOrders{} }
+func (m *MsgBatchCancelOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelOrders) ProtoMessage()    {}
+func (*MsgBatchCancelOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{6}
 }
-func (m *MsgCreateSpotLimitOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSpotLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSpotLimitOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -313,36 +357,39 @@ func (m *MsgCreateSpotLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSpotLimitOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSpotLimitOrder.Merge(m, src)
+func (m *MsgBatchCancelOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelOrders.Merge(m, src)
 }
-func (m *MsgCreateSpotLimitOrder) XXX_Size() int {
+func (m *MsgBatchCancelOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSpotLimitOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSpotLimitOrder.DiscardUnknown(m)
+func (m *MsgBatchCancelOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSpotLimitOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelOrders proto.InternalMessageInfo
 
-// MsgCreateSpotLimitOrderResponse defines the Msg/CreateSpotOrder response
+// MsgBatchCancelOrdersResponse defines the Msg/BatchCancelOrders response
 // type.
-type MsgCreateSpotLimitOrderResponse struct {
-	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+type MsgBatchCancelOrdersResponse struct {
+	// whether each order was cancelled, in the order of the request
+	Success []bool `protobuf:"varint,1,rep,packed,name=success,proto3" json:"success,omitempty"`
+	// ABCI error code of each failed cancellation, zero for cancelled orders
+	ErrorCodes []uint32 `protobuf:"varint,2,rep,packed,name=error_codes,json=errorCodes,proto3" json:"error_codes,omitempty"`
 }
 
-func (m *MsgCreateSpotLimitOrderResponse) Reset()         { *m = MsgCreateSpotLimitOrderResponse{} }
-func (m *MsgCreateSpotLimitOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSpotLimitOrderResponse) ProtoMessage()    {}
-func (*MsgCreateSpotLimitOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancelOrdersResponse) Reset()         { *m = MsgBatchCancelOrdersResponse{} }
+func (m *MsgBatchCancelOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchCancelOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{7}
 }
-func (m *MsgCreateSpotLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSpotLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSpotLimitOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -352,37 +399,42 @@ func (m *MsgCreateSpotLimitOrderResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSpotLimitOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSpotLimitOrderResponse.Merge(m, src)
+func (m *MsgBatchCancelOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelOrdersResponse.Merge(m, src)
 }
-func (m *MsgCreateSpotLimitOrderResponse) XXX_Size() int {
+func (m *MsgBatchCancelOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSpotLimitOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSpotLimitOrderResponse.DiscardUnknown(m)
+func (m *MsgBatchCancelOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSpotLimitOrderResponse proto.InternalMessageInfo
-
-// MsgBatchCreateSpotLimitOrders defines a SDK message for creating a new batch
-// of spot limit orders.
-type MsgBatchCreateSpotLimitOrders struct {
-	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Orders []SpotOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
-}
+var xxx_messageInfo_MsgBatchCancelOrdersResponse proto.InternalMessageInfo
 
-func (m *MsgBatchCreateSpotLimitOrders) Reset()         { *m = MsgBatchCreateSpotLimitOrders{} }
-func (m *MsgBatchCreateSpotLimitOrders) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCreateSpotLimitOrders) ProtoMessage()    {}
-func (*MsgBatchCreateSpotLimitOrders) Descriptor() ([]byte, []int) {
+// MsgReplaceOrder defines the Msg/ReplaceOrder request type.
+type MsgReplaceOrder struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the resting limit order to cancel
+	OldOrder OrderData `protobuf:"bytes,2,opt,name=old_order,json=oldOrder,proto3" json:"old_order"`
+	// the new limit order in a spot market, set when replacing a spot order
+	SpotOrder *SpotOrder `protobuf:"bytes,3,opt,name=spot_order,json=spotOrder,proto3" json:"spot_order,omitempty"`
+	// the new limit order in a derivative market, set when replacing a
+	// derivative order
+	DerivativeOrder *DerivativeOrder `protobuf:"bytes,4,opt,name=derivative_order,json=derivativeOrder,proto3" json:"derivative_order,omitempty"`
+}
+
+func (m *MsgReplaceOrder) Reset()         { *m = MsgReplaceOrder{} }
+func (m *MsgReplaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrder) ProtoMessage()    {}
+func (*MsgReplaceOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{8}
 }
-func (m *MsgBatchCreateSpotLimitOrders) XXX_Unmarshal(b []byte) error {
+func (m *MsgReplaceOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCreateSpotLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReplaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCreateSpotLimitOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReplaceOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -392,36 +444,35 @@ func (m *MsgBatchCreateSpotLimitOrders) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCreateSpotLimitOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCreateSpotLimitOrders.Merge(m, src)
+func (m *MsgReplaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrder.Merge(m, src)
 }
-func (m *MsgBatchCreateSpotLimitOrders) XXX_Size() int {
+func (m *MsgReplaceOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCreateSpotLimitOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCreateSpotLimitOrders.DiscardUnknown(m)
+func (m *MsgReplaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCreateSpotLimitOrders proto.InternalMessageInfo
+var xxx_messageInfo_MsgReplaceOrder proto.InternalMessageInfo
 
-// MsgBatchCreateSpotLimitOrdersResponse defines the
-// Msg/BatchCreateSpotLimitOrders response type.
-type MsgBatchCreateSpotLimitOrdersResponse struct {
-	OrderHashes []string `protobuf:"bytes,1,rep,name=order_hashes,json=orderHashes,proto3" json:"order_hashes,omitempty"`
+// MsgReplaceOrderResponse defines the Msg/ReplaceOrder response type.
+type MsgReplaceOrderResponse struct {
+	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
 }
 
-func (m *MsgBatchCreateSpotLimitOrdersResponse) Reset()         { *m = MsgBatchCreateSpotLimitOrdersResponse{} }
-func (m *MsgBatchCreateSpotLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCreateSpotLimitOrdersResponse) ProtoMessage()    {}
-func (*MsgBatchCreateSpotLimitOrdersResponse) Descriptor() ([]byte, []int) {
+func (m *MsgReplaceOrderResponse) Reset()         { *m = MsgReplaceOrderResponse{} }
+func (m *MsgReplaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrderResponse) ProtoMessage()    {}
+func (*MsgReplaceOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{9}
 }
-func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgReplaceOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReplaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReplaceOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -431,47 +482,45 @@ func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse.Merge(m, src)
+func (m *MsgReplaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrderResponse.Merge(m, src)
 }
-func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Size() int {
+func (m *MsgReplaceOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse.DiscardUnknown(m)
+func (m *MsgReplaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgReplaceOrderResponse proto.InternalMessageInfo
 
-// MsgInstantSpotMarketLaunch defines a SDK message for creating a new spot
-// market by paying listing fee without governance
-type MsgInstantSpotMarketLaunch struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Ticker for the spot market.
-	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	// type of coin to use as the base currency
-	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	// type of coin to use as the quote currency
-	QuoteDenom string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
-	// min_price_tick_size defines the minimum tick size of the order's price
-	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
-	// min_quantity_tick_size defines the minimum tick size of the order's
-	// quantity
-	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+func (m *MsgReplaceOrderResponse) GetOrderHash() string {
+	if m != nil {
+		return m.OrderHash
+	}
+	return ""
 }
 
-func (m *MsgInstantSpotMarketLaunch) Reset()         { *m = MsgInstantSpotMarketLaunch{} }
-func (m *MsgInstantSpotMarketLaunch) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantSpotMarketLaunch) ProtoMessage()    {}
-func (*MsgInstantSpotMarketLaunch) Descriptor() ([]byte, []int) {
+// MsgSetMarginMode defines the Msg/SetMarginMode request type. The margin mode
+// can only be switched while the subaccount has no open positions.
+type MsgSetMarginMode struct {
+	Sender       string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubaccountId string     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	MarginMode   MarginMode `protobuf:"varint,3,opt,name=margin_mode,json=marginMode,proto3,enum=injective.exchange.v1beta1.MarginMode" json:"margin_mode,omitempty"`
+}
+
+func (m *MsgSetMarginMode) Reset()         { *m = MsgSetMarginMode{} }
+func (m *MsgSetMarginMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginMode) ProtoMessage()    {}
+func (*MsgSetMarginMode) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{10}
 }
-func (m *MsgInstantSpotMarketLaunch) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetMarginMode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantSpotMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetMarginMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantSpotMarketLaunch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetMarginMode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -481,35 +530,34 @@ func (m *MsgInstantSpotMarketLaunch) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantSpotMarketLaunch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantSpotMarketLaunch.Merge(m, src)
+func (m *MsgSetMarginMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginMode.Merge(m, src)
 }
-func (m *MsgInstantSpotMarketLaunch) XXX_Size() int {
+func (m *MsgSetMarginMode) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantSpotMarketLaunch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantSpotMarketLaunch.DiscardUnknown(m)
+func (m *MsgSetMarginMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginMode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantSpotMarketLaunch proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetMarginMode proto.InternalMessageInfo
 
-// MsgInstantSpotMarketLaunchResponse defines the Msg/InstantSpotMarketLaunch
-// response type.
-type MsgInstantSpotMarketLaunchResponse struct {
+// MsgSetMarginModeResponse defines the Msg/SetMarginMode response type.
+type MsgSetMarginModeResponse struct {
 }
 
-func (m *MsgInstantSpotMarketLaunchResponse) Reset()         { *m = MsgInstantSpotMarketLaunchResponse{} }
-func (m *MsgInstantSpotMarketLaunchResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantSpotMarketLaunchResponse) ProtoMessage()    {}
-func (*MsgInstantSpotMarketLaunchResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetMarginModeResponse) Reset()         { *m = MsgSetMarginModeResponse{} }
+func (m *MsgSetMarginModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginModeResponse) ProtoMessage()    {}
+func (*MsgSetMarginModeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{11}
 }
-func (m *MsgInstantSpotMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetMarginModeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantSpotMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetMarginModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantSpotMarketLaunchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetMarginModeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -519,66 +567,42 @@ func (m *MsgInstantSpotMarketLaunchResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantSpotMarketLaunchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantSpotMarketLaunchResponse.Merge(m, src)
+func (m *MsgSetMarginModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginModeResponse.Merge(m, src)
 }
-func (m *MsgInstantSpotMarketLaunchResponse) XXX_Size() int {
+func (m *MsgSetMarginModeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantSpotMarketLaunchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantSpotMarketLaunchResponse.DiscardUnknown(m)
+func (m *MsgSetMarginModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginModeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantSpotMarketLaunchResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetMarginModeResponse proto.InternalMessageInfo
 
-// MsgInstantPerpetualMarketLaunch defines a SDK message for creating a new
-// perpetual futures market by paying listing fee without governance
-type MsgInstantPerpetualMarketLaunch struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Ticker for the derivative market.
-	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	// type of coin to use as the base currency
-	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
-	// Oracle base currency
-	OracleBase string `protobuf:"bytes,4,opt,name=oracle_base,json=oracleBase,proto3" json:"oracle_base,omitempty"`
-	// Oracle quote currency
-	OracleQuote string `protobuf:"bytes,5,opt,name=oracle_quote,json=oracleQuote,proto3" json:"oracle_quote,omitempty"`
-	// Scale factor for oracle prices.
-	OracleScaleFactor uint32 `protobuf:"varint,6,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
-	// Oracle type
-	OracleType types1.OracleType `protobuf:"varint,7,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
-	// maker_fee_rate defines the trade fee rate for makers on the perpetual
-	// market
-	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
-	// taker_fee_rate defines the trade fee rate for takers on the perpetual
-	// market
-	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
-	// initial_margin_ratio defines the initial margin ratio for the perpetual
-	// market
-	InitialMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=initial_margin_ratio,json=initialMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_margin_ratio"`
-	// maintenance_margin_ratio defines the maintenance margin ratio for the
-	// perpetual market
-	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
-	// min_price_tick_size defines the minimum tick size of the order's price and
-	// margin
-	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
-	// min_quantity_tick_size defines the minimum tick size of the order's
-	// quantity
-	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+// MsgTransferPosition defines the Msg/TransferPosition request type. The
+// quantity of the position is moved together with the same share of its
+// margin.
+type MsgTransferPosition struct {
+	Sender                  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MarketId                string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SourceSubaccountId      string `protobuf:"bytes,3,opt,name=source_subaccount_id,json=sourceSubaccountId,proto3" json:"source_subaccount_id,omitempty"`
+	DestinationSubaccountId string `protobuf:"bytes,4,opt,name=destination_subaccount_id,json=destinationSubaccountId,proto3" json:"destination_subaccount_id,omitempty"`
+	// quantity defines the quantity of the position to transfer
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
 }
 
-func (m *MsgInstantPerpetualMarketLaunch) Reset()         { *m = MsgInstantPerpetualMarketLaunch{} }
-func (m *MsgInstantPerpetualMarketLaunch) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantPerpetualMarketLaunch) ProtoMessage()    {}
-func (*MsgInstantPerpetualMarketLaunch) Descriptor() ([]byte, []int) {
+func (m *MsgTransferPosition) Reset()         { *m = MsgTransferPosition{} }
+func (m *MsgTransferPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPosition) ProtoMessage()    {}
+func (*MsgTransferPosition) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{12}
 }
-func (m *MsgInstantPerpetualMarketLaunch) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantPerpetualMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantPerpetualMarketLaunch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferPosition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -588,37 +612,34 @@ func (m *MsgInstantPerpetualMarketLaunch) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantPerpetualMarketLaunch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantPerpetualMarketLaunch.Merge(m, src)
+func (m *MsgTransferPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPosition.Merge(m, src)
 }
-func (m *MsgInstantPerpetualMarketLaunch) XXX_Size() int {
+func (m *MsgTransferPosition) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantPerpetualMarketLaunch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantPerpetualMarketLaunch.DiscardUnknown(m)
+func (m *MsgTransferPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPosition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantPerpetualMarketLaunch proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferPosition proto.InternalMessageInfo
 
-// MsgInstantPerpetualMarketLaunchResponse defines the
-// Msg/InstantPerpetualMarketLaunchResponse response type.
-type MsgInstantPerpetualMarketLaunchResponse struct {
+// MsgTransferPositionResponse defines the Msg/TransferPosition response type.
+type MsgTransferPositionResponse struct {
 }
 
-func (m *MsgInstantPerpetualMarketLaunchResponse) Reset() {
-	*m = MsgInstantPerpetualMarketLaunchResponse{}
-}
-func (m *MsgInstantPerpetualMarketLaunchResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantPerpetualMarketLaunchResponse) ProtoMessage()    {}
-func (*MsgInstantPerpetualMarketLaunchResponse) Descriptor() ([]byte, []int) {
+func (m *MsgTransferPositionResponse) Reset()         { *m = MsgTransferPositionResponse{} }
+func (m *MsgTransferPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPositionResponse) ProtoMessage()    {}
+func (*MsgTransferPositionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{13}
 }
-func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferPositionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -628,66 +649,40 @@ func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse.Merge(m, src)
+func (m *MsgTransferPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPositionResponse.Merge(m, src)
 }
-func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Size() int {
+func (m *MsgTransferPositionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse.DiscardUnknown(m)
+func (m *MsgTransferPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPositionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferPositionResponse proto.InternalMessageInfo
 
-// MsgInstantBinaryOptionsMarketLaunch defines a SDK message for creating a new
-// perpetual futures market by paying listing fee without governance
-type MsgInstantBinaryOptionsMarketLaunch struct {
+// MsgDeposit defines a SDK message for transferring coins from the sender's
+// bank balance into the subaccount's exchange deposits
+type MsgDeposit struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Ticker for the derivative contract.
-	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	// Oracle symbol
-	OracleSymbol string `protobuf:"bytes,3,opt,name=oracle_symbol,json=oracleSymbol,proto3" json:"oracle_symbol,omitempty"`
-	// Oracle Provider
-	OracleProvider string `protobuf:"bytes,4,opt,name=oracle_provider,json=oracleProvider,proto3" json:"oracle_provider,omitempty"`
-	// Oracle type
-	OracleType types1.OracleType `protobuf:"varint,5,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
-	// Scale factor for oracle prices.
-	OracleScaleFactor uint32 `protobuf:"varint,6,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
-	// maker_fee_rate defines the trade fee rate for makers on the perpetual
-	// market
-	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
-	// taker_fee_rate defines the trade fee rate for takers on the perpetual
-	// market
-	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
-	// expiration timestamp
-	ExpirationTimestamp int64 `protobuf:"varint,9,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
-	// expiration timestamp
-	SettlementTimestamp int64 `protobuf:"varint,10,opt,name=settlement_timestamp,json=settlementTimestamp,proto3" json:"settlement_timestamp,omitempty"`
-	// admin of the market
-	Admin string `protobuf:"bytes,11,opt,name=admin,proto3" json:"admin,omitempty"`
-	// Address of the quote currency denomination for the binary options contract
-	QuoteDenom string `protobuf:"bytes,12,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
-	// min_price_tick_size defines the minimum tick size that the price and margin
-	// required for orders in the market
-	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
-	// min_quantity_tick_size defines the minimum tick size of the quantity
-	// required for orders in the market
-	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+	// (Optional) bytes32 subaccount ID to deposit funds into. If empty, the coin
+	// will be deposited to the sender's default subaccount address.
+	SubaccountId string     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunch) Reset()         { *m = MsgInstantBinaryOptionsMarketLaunch{} }
-func (m *MsgInstantBinaryOptionsMarketLaunch) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantBinaryOptionsMarketLaunch) ProtoMessage()    {}
-func (*MsgInstantBinaryOptionsMarketLaunch) Descriptor() ([]byte, []int) {
+func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
+func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgDeposit) ProtoMessage()    {}
+func (*MsgDeposit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{14}
 }
-func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -697,39 +692,34 @@ func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch.Merge(m, src)
+func (m *MsgDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeposit.Merge(m, src)
 }
-func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Size() int {
+func (m *MsgDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch.DiscardUnknown(m)
+func (m *MsgDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeposit proto.InternalMessageInfo
 
-// MsgInstantBinaryOptionsMarketLaunchResponse defines the
-// Msg/InstantBinaryOptionsMarketLaunchResponse response type.
-type MsgInstantBinaryOptionsMarketLaunchResponse struct {
+// MsgDepositResponse defines the Msg/Deposit response type.
+type MsgDepositResponse struct {
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) Reset() {
-	*m = MsgInstantBinaryOptionsMarketLaunchResponse{}
-}
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgInstantBinaryOptionsMarketLaunchResponse) ProtoMessage() {}
-func (*MsgInstantBinaryOptionsMarketLaunchResponse) Descriptor() ([]byte, []int) {
+func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
+func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositResponse) ProtoMessage()    {}
+func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{15}
 }
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDepositResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -739,68 +729,39 @@ func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse.Merge(m, src)
+func (m *MsgDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositResponse.Merge(m, src)
 }
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Size() int {
+func (m *MsgDepositResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse.DiscardUnknown(m)
+func (m *MsgDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
-// MsgInstantExpiryFuturesMarketLaunch defines a SDK message for creating a new
-// expiry futures market by paying listing fee without governance
-type MsgInstantExpiryFuturesMarketLaunch struct {
+// MsgWithdraw defines a SDK message for withdrawing coins from a subaccount's
+// deposits to the user's bank balance
+type MsgWithdraw struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Ticker for the derivative market.
-	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	// type of coin to use as the quote currency
-	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
-	// Oracle base currency
-	OracleBase string `protobuf:"bytes,4,opt,name=oracle_base,json=oracleBase,proto3" json:"oracle_base,omitempty"`
-	// Oracle quote currency
-	OracleQuote string `protobuf:"bytes,5,opt,name=oracle_quote,json=oracleQuote,proto3" json:"oracle_quote,omitempty"`
-	// Oracle type
-	OracleType types1.OracleType `protobuf:"varint,6,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
-	// Scale factor for oracle prices.
-	OracleScaleFactor uint32 `protobuf:"varint,7,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
-	// Expiration time of the market
-	Expiry int64 `protobuf:"varint,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	// maker_fee_rate defines the trade fee rate for makers on the expiry futures
-	// market
-	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
-	// taker_fee_rate defines the trade fee rate for takers on the expiry futures
-	// market
-	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
-	// initial_margin_ratio defines the initial margin ratio for the derivative
-	// market
-	InitialMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=initial_margin_ratio,json=initialMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_margin_ratio"`
-	// maintenance_margin_ratio defines the maintenance margin ratio for the
-	// derivative market
-	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
-	// min_price_tick_size defines the minimum tick size of the order's price and
-	// margin
-	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
-	// min_quantity_tick_size defines the minimum tick size of the order's
-	// quantity
-	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+	// bytes32 subaccount ID to withdraw funds from
+	SubaccountId string     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunch) Reset()         { *m = MsgInstantExpiryFuturesMarketLaunch{} }
-func (m *MsgInstantExpiryFuturesMarketLaunch) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantExpiryFuturesMarketLaunch) ProtoMessage()    {}
-func (*MsgInstantExpiryFuturesMarketLaunch) Descriptor() ([]byte, []int) {
+func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
+func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdraw) ProtoMessage()    {}
+func (*MsgWithdraw) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{16}
 }
-func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdraw.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -810,39 +771,34 @@ func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch.Merge(m, src)
+func (m *MsgWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdraw.Merge(m, src)
 }
-func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Size() int {
+func (m *MsgWithdraw) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch.DiscardUnknown(m)
+func (m *MsgWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdraw.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdraw proto.InternalMessageInfo
 
-// MsgInstantExpiryFuturesMarketLaunchResponse defines the
-// Msg/InstantExpiryFuturesMarketLaunch response type.
-type MsgInstantExpiryFuturesMarketLaunchResponse struct {
+// MsgWithdraw defines the Msg/Withdraw response type.
+type MsgWithdrawResponse struct {
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) Reset() {
-	*m = MsgInstantExpiryFuturesMarketLaunchResponse{}
-}
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgInstantExpiryFuturesMarketLaunchResponse) ProtoMessage() {}
-func (*MsgInstantExpiryFuturesMarketLaunchResponse) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
+func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawResponse) ProtoMessage()    {}
+func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{17}
 }
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -852,37 +808,37 @@ func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse.Merge(m, src)
+func (m *MsgWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawResponse.Merge(m, src)
 }
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Size() int {
+func (m *MsgWithdrawResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse.DiscardUnknown(m)
+func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
-// MsgCreateSpotMarketOrder defines a SDK message for creating a new spot market
+// MsgCreateSpotLimitOrder defines a SDK message for creating a new spot limit
 // order.
-type MsgCreateSpotMarketOrder struct {
+type MsgCreateSpotLimitOrder struct {
 	Sender string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Order  SpotOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgCreateSpotMarketOrder) Reset()         { *m = MsgCreateSpotMarketOrder{} }
-func (m *MsgCreateSpotMarketOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSpotMarketOrder) ProtoMessage()    {}
-func (*MsgCreateSpotMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{18}
+func (m *MsgCreateSpotLimitOrder) Reset()         { *m = MsgCreateSpotLimitOrder{} }
+func (m *MsgCreateSpotLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSpotLimitOrder) ProtoMessage()    {}
+func (*MsgCreateSpotLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{18}
 }
-func (m *MsgCreateSpotMarketOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSpotLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSpotMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSpotLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSpotMarketOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSpotLimitOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -892,37 +848,36 @@ func (m *MsgCreateSpotMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSpotMarketOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSpotMarketOrder.Merge(m, src)
+func (m *MsgCreateSpotLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSpotLimitOrder.Merge(m, src)
 }
-func (m *MsgCreateSpotMarketOrder) XXX_Size() int {
+func (m *MsgCreateSpotLimitOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSpotMarketOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSpotMarketOrder.DiscardUnknown(m)
+func (m *MsgCreateSpotLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSpotLimitOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSpotMarketOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSpotLimitOrder proto.InternalMessageInfo
 
-// MsgCreateSpotMarketOrderResponse defines the Msg/CreateSpotMarketLimitOrder
-// response type.
-type MsgCreateSpotMarketOrderResponse struct {
-	OrderHash string                  `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
-	Results   *SpotMarketOrderResults `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+// MsgCreateSpotLimitOrderResponse defines the Msg/CreateSpotOrder response
+// type.
+type MsgCreateSpotLimitOrderResponse struct {
+	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
 }
 
-func (m *MsgCreateSpotMarketOrderResponse) Reset()         { *m = MsgCreateSpotMarketOrderResponse{} }
-func (m *MsgCreateSpotMarketOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSpotMarketOrderResponse) ProtoMessage()    {}
-func (*MsgCreateSpotMarketOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateSpotLimitOrderResponse) Reset()         { *m = MsgCreateSpotLimitOrderResponse{} }
+func (m *MsgCreateSpotLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSpotLimitOrderResponse) ProtoMessage()    {}
+func (*MsgCreateSpotLimitOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{19}
 }
-func (m *MsgCreateSpotMarketOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSpotLimitOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSpotMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSpotLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSpotMarketOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSpotLimitOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -932,36 +887,37 @@ func (m *MsgCreateSpotMarketOrderResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSpotMarketOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSpotMarketOrderResponse.Merge(m, src)
+func (m *MsgCreateSpotLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSpotLimitOrderResponse.Merge(m, src)
 }
-func (m *MsgCreateSpotMarketOrderResponse) XXX_Size() int {
+func (m *MsgCreateSpotLimitOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSpotMarketOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSpotMarketOrderResponse.DiscardUnknown(m)
+func (m *MsgCreateSpotLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSpotLimitOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSpotMarketOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSpotLimitOrderResponse proto.InternalMessageInfo
 
-type SpotMarketOrderResults struct {
-	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
-	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Fee      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+// MsgBatchCreateSpotLimitOrders defines a SDK message for creating a new batch
+// of spot limit orders.
+type MsgBatchCreateSpotLimitOrders struct {
+	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Orders []SpotOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
 }
 
-func (m *SpotMarketOrderResults) Reset()         { *m = SpotMarketOrderResults{} }
-func (m *SpotMarketOrderResults) String() string { return proto.CompactTextString(m) }
-func (*SpotMarketOrderResults) ProtoMessage()    {}
-func (*SpotMarketOrderResults) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCreateSpotLimitOrders) Reset()         { *m = MsgBatchCreateSpotLimitOrders{} }
+func (m *MsgBatchCreateSpotLimitOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateSpotLimitOrders) ProtoMessage()    {}
+func (*MsgBatchCreateSpotLimitOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{20}
 }
-func (m *SpotMarketOrderResults) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCreateSpotLimitOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpotMarketOrderResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCreateSpotLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpotMarketOrderResults.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCreateSpotLimitOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -971,36 +927,36 @@ func (m *SpotMarketOrderResults) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *SpotMarketOrderResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpotMarketOrderResults.Merge(m, src)
+func (m *MsgBatchCreateSpotLimitOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateSpotLimitOrders.Merge(m, src)
 }
-func (m *SpotMarketOrderResults) XXX_Size() int {
+func (m *MsgBatchCreateSpotLimitOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpotMarketOrderResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpotMarketOrderResults.DiscardUnknown(m)
+func (m *MsgBatchCreateSpotLimitOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateSpotLimitOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpotMarketOrderResults proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCreateSpotLimitOrders proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgCreateDerivativeLimitOrder
-type MsgCreateDerivativeLimitOrder struct {
-	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Order  DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+// MsgBatchCreateSpotLimitOrdersResponse defines the
+// Msg/BatchCreateSpotLimitOrders response type.
+type MsgBatchCreateSpotLimitOrdersResponse struct {
+	OrderHashes []string `protobuf:"bytes,1,rep,name=order_hashes,json=orderHashes,proto3" json:"order_hashes,omitempty"`
 }
 
-func (m *MsgCreateDerivativeLimitOrder) Reset()         { *m = MsgCreateDerivativeLimitOrder{} }
-func (m *MsgCreateDerivativeLimitOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateDerivativeLimitOrder) ProtoMessage()    {}
-func (*MsgCreateDerivativeLimitOrder) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCreateSpotLimitOrdersResponse) Reset()         { *m = MsgBatchCreateSpotLimitOrdersResponse{} }
+func (m *MsgBatchCreateSpotLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateSpotLimitOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchCreateSpotLimitOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{21}
 }
-func (m *MsgCreateDerivativeLimitOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateDerivativeLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateDerivativeLimitOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1010,36 +966,47 @@ func (m *MsgCreateDerivativeLimitOrder) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateDerivativeLimitOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateDerivativeLimitOrder.Merge(m, src)
+func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse.Merge(m, src)
 }
-func (m *MsgCreateDerivativeLimitOrder) XXX_Size() int {
+func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateDerivativeLimitOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateDerivativeLimitOrder.DiscardUnknown(m)
+func (m *MsgBatchCreateSpotLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateDerivativeLimitOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCreateSpotLimitOrdersResponse proto.InternalMessageInfo
 
-// MsgCreateDerivativeLimitOrderResponse defines the
-// Msg/CreateDerivativeMarketOrder response type.
-type MsgCreateDerivativeLimitOrderResponse struct {
-	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+// MsgInstantSpotMarketLaunch defines a SDK message for creating a new spot
+// market by paying listing fee without governance
+type MsgInstantSpotMarketLaunch struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Ticker for the spot market.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// type of coin to use as the base currency
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// type of coin to use as the quote currency
+	QuoteDenom string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// min_price_tick_size defines the minimum tick size of the order's price
+	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
+	// min_quantity_tick_size defines the minimum tick size of the order's
+	// quantity
+	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
 }
 
-func (m *MsgCreateDerivativeLimitOrderResponse) Reset()         { *m = MsgCreateDerivativeLimitOrderResponse{} }
-func (m *MsgCreateDerivativeLimitOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateDerivativeLimitOrderResponse) ProtoMessage()    {}
-func (*MsgCreateDerivativeLimitOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgInstantSpotMarketLaunch) Reset()         { *m = MsgInstantSpotMarketLaunch{} }
+func (m *MsgInstantSpotMarketLaunch) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantSpotMarketLaunch) ProtoMessage()    {}
+func (*MsgInstantSpotMarketLaunch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{22}
 }
-func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantSpotMarketLaunch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantSpotMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantSpotMarketLaunch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1049,36 +1016,35 @@ func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse.Merge(m, src)
+func (m *MsgInstantSpotMarketLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantSpotMarketLaunch.Merge(m, src)
 }
-func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Size() int {
+func (m *MsgInstantSpotMarketLaunch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateDerivativeLimitOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse.DiscardUnknown(m)
+func (m *MsgInstantSpotMarketLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantSpotMarketLaunch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantSpotMarketLaunch proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgCreateBinaryOptionsLimitOrder
-type MsgCreateBinaryOptionsLimitOrder struct {
-	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Order  DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+// MsgInstantSpotMarketLaunchResponse defines the Msg/InstantSpotMarketLaunch
+// response type.
+type MsgInstantSpotMarketLaunchResponse struct {
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrder) Reset()         { *m = MsgCreateBinaryOptionsLimitOrder{} }
-func (m *MsgCreateBinaryOptionsLimitOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateBinaryOptionsLimitOrder) ProtoMessage()    {}
-func (*MsgCreateBinaryOptionsLimitOrder) Descriptor() ([]byte, []int) {
+func (m *MsgInstantSpotMarketLaunchResponse) Reset()         { *m = MsgInstantSpotMarketLaunchResponse{} }
+func (m *MsgInstantSpotMarketLaunchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantSpotMarketLaunchResponse) ProtoMessage()    {}
+func (*MsgInstantSpotMarketLaunchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{23}
 }
-func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantSpotMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantSpotMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantSpotMarketLaunchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1088,38 +1054,66 @@ func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder.Merge(m, src)
+func (m *MsgInstantSpotMarketLaunchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantSpotMarketLaunchResponse.Merge(m, src)
 }
-func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Size() int {
+func (m *MsgInstantSpotMarketLaunchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateBinaryOptionsLimitOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder.DiscardUnknown(m)
+func (m *MsgInstantSpotMarketLaunchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantSpotMarketLaunchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantSpotMarketLaunchResponse proto.InternalMessageInfo
 
-// MsgCreateBinaryOptionsLimitOrderResponse defines the
-// Msg/CreateBinaryOptionsLimitOrder response type.
-type MsgCreateBinaryOptionsLimitOrderResponse struct {
-	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+// MsgInstantPerpetualMarketLaunch defines a SDK message for creating a new
+// perpetual futures market by paying listing fee without governance
+type MsgInstantPerpetualMarketLaunch struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Ticker for the derivative market.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// type of coin to use as the base currency
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// Oracle base currency
+	OracleBase string `protobuf:"bytes,4,opt,name=oracle_base,json=oracleBase,proto3" json:"oracle_base,omitempty"`
+	// Oracle quote currency
+	OracleQuote string `protobuf:"bytes,5,opt,name=oracle_quote,json=oracleQuote,proto3" json:"oracle_quote,omitempty"`
+	// Scale factor for oracle prices.
+	OracleScaleFactor uint32 `protobuf:"varint,6,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
+	// Oracle type
+	OracleType types1.OracleType `protobuf:"varint,7,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// maker_fee_rate defines the trade fee rate for makers on the perpetual
+	// market
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	// taker_fee_rate defines the trade fee rate for takers on the perpetual
+	// market
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	// initial_margin_ratio defines the initial margin ratio for the perpetual
+	// market
+	InitialMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=initial_margin_ratio,json=initialMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_margin_ratio"`
+	// maintenance_margin_ratio defines the maintenance margin ratio for the
+	// perpetual market
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	// min_price_tick_size defines the minimum tick size of the order's price and
+	// margin
+	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
+	// min_quantity_tick_size defines the minimum tick size of the order's
+	// quantity
+	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) Reset() {
-	*m = MsgCreateBinaryOptionsLimitOrderResponse{}
-}
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateBinaryOptionsLimitOrderResponse) ProtoMessage()    {}
-func (*MsgCreateBinaryOptionsLimitOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgInstantPerpetualMarketLaunch) Reset()         { *m = MsgInstantPerpetualMarketLaunch{} }
+func (m *MsgInstantPerpetualMarketLaunch) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantPerpetualMarketLaunch) ProtoMessage()    {}
+func (*MsgInstantPerpetualMarketLaunch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{24}
 }
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantPerpetualMarketLaunch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantPerpetualMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantPerpetualMarketLaunch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1129,36 +1123,37 @@ func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse.Merge(m, src)
+func (m *MsgInstantPerpetualMarketLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantPerpetualMarketLaunch.Merge(m, src)
 }
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Size() int {
+func (m *MsgInstantPerpetualMarketLaunch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse.DiscardUnknown(m)
+func (m *MsgInstantPerpetualMarketLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantPerpetualMarketLaunch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantPerpetualMarketLaunch proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgBatchCreateDerivativeLimitOrders
-type MsgBatchCreateDerivativeLimitOrders struct {
-	Sender string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Orders []DerivativeOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+// MsgInstantPerpetualMarketLaunchResponse defines the
+// Msg/InstantPerpetualMarketLaunchResponse response type.
+type MsgInstantPerpetualMarketLaunchResponse struct {
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrders) Reset()         { *m = MsgBatchCreateDerivativeLimitOrders{} }
-func (m *MsgBatchCreateDerivativeLimitOrders) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCreateDerivativeLimitOrders) ProtoMessage()    {}
-func (*MsgBatchCreateDerivativeLimitOrders) Descriptor() ([]byte, []int) {
+func (m *MsgInstantPerpetualMarketLaunchResponse) Reset() {
+	*m = MsgInstantPerpetualMarketLaunchResponse{}
+}
+func (m *MsgInstantPerpetualMarketLaunchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantPerpetualMarketLaunchResponse) ProtoMessage()    {}
+func (*MsgInstantPerpetualMarketLaunchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{25}
 }
-func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1168,40 +1163,66 @@ func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders.Merge(m, src)
+func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse.Merge(m, src)
 }
-func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Size() int {
+func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCreateDerivativeLimitOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders.DiscardUnknown(m)
+func (m *MsgInstantPerpetualMarketLaunchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantPerpetualMarketLaunchResponse proto.InternalMessageInfo
 
-// MsgBatchCreateDerivativeLimitOrdersResponse defines the
-// Msg/BatchCreateDerivativeLimitOrders response type.
-type MsgBatchCreateDerivativeLimitOrdersResponse struct {
-	OrderHashes []string `protobuf:"bytes,1,rep,name=order_hashes,json=orderHashes,proto3" json:"order_hashes,omitempty"`
+// MsgInstantBinaryOptionsMarketLaunch defines a SDK message for creating a new
+// perpetual futures market by paying listing fee without governance
+type MsgInstantBinaryOptionsMarketLaunch struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Ticker for the derivative contract.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Oracle symbol
+	OracleSymbol string `protobuf:"bytes,3,opt,name=oracle_symbol,json=oracleSymbol,proto3" json:"oracle_symbol,omitempty"`
+	// Oracle Provider
+	OracleProvider string `protobuf:"bytes,4,opt,name=oracle_provider,json=oracleProvider,proto3" json:"oracle_provider,omitempty"`
+	// Oracle type
+	OracleType types1.OracleType `protobuf:"varint,5,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// Scale factor for oracle prices.
+	OracleScaleFactor uint32 `protobuf:"varint,6,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
+	// maker_fee_rate defines the trade fee rate for makers on the perpetual
+	// market
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	// taker_fee_rate defines the trade fee rate for takers on the perpetual
+	// market
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	// expiration timestamp
+	ExpirationTimestamp int64 `protobuf:"varint,9,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	// expiration timestamp
+	SettlementTimestamp int64 `protobuf:"varint,10,opt,name=settlement_timestamp,json=settlementTimestamp,proto3" json:"settlement_timestamp,omitempty"`
+	// admin of the market
+	Admin string `protobuf:"bytes,11,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Address of the quote currency denomination for the binary options contract
+	QuoteDenom string `protobuf:"bytes,12,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// min_price_tick_size defines the minimum tick size that the price and margin
+	// required for orders in the market
+	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
+	// min_quantity_tick_size defines the minimum tick size of the quantity
+	// required for orders in the market
+	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) Reset() {
-	*m = MsgBatchCreateDerivativeLimitOrdersResponse{}
-}
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgBatchCreateDerivativeLimitOrdersResponse) ProtoMessage() {}
-func (*MsgBatchCreateDerivativeLimitOrdersResponse) Descriptor() ([]byte, []int) {
+func (m *MsgInstantBinaryOptionsMarketLaunch) Reset()         { *m = MsgInstantBinaryOptionsMarketLaunch{} }
+func (m *MsgInstantBinaryOptionsMarketLaunch) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantBinaryOptionsMarketLaunch) ProtoMessage()    {}
+func (*MsgInstantBinaryOptionsMarketLaunch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{26}
 }
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1211,39 +1232,39 @@ func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse.Merge(m, src)
+func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch.Merge(m, src)
 }
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Size() int {
+func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse.DiscardUnknown(m)
+func (m *MsgInstantBinaryOptionsMarketLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunch proto.InternalMessageInfo
 
-// MsgCancelSpotOrder defines the Msg/CancelSpotOrder response type.
-type MsgCancelSpotOrder struct {
-	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	OrderHash    string `protobuf:"bytes,4,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
-	Cid          string `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`
+// MsgInstantBinaryOptionsMarketLaunchResponse defines the
+// Msg/InstantBinaryOptionsMarketLaunchResponse response type.
+type MsgInstantBinaryOptionsMarketLaunchResponse struct {
 }
 
-func (m *MsgCancelSpotOrder) Reset()         { *m = MsgCancelSpotOrder{} }
-func (m *MsgCancelSpotOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelSpotOrder) ProtoMessage()    {}
-func (*MsgCancelSpotOrder) Descriptor() ([]byte, []int) {
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) Reset() {
+	*m = MsgInstantBinaryOptionsMarketLaunchResponse{}
+}
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgInstantBinaryOptionsMarketLaunchResponse) ProtoMessage() {}
+func (*MsgInstantBinaryOptionsMarketLaunchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{27}
 }
-func (m *MsgCancelSpotOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelSpotOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1253,34 +1274,68 @@ func (m *MsgCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelSpotOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelSpotOrder.Merge(m, src)
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse.Merge(m, src)
 }
-func (m *MsgCancelSpotOrder) XXX_Size() int {
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelSpotOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelSpotOrder.DiscardUnknown(m)
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelSpotOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantBinaryOptionsMarketLaunchResponse proto.InternalMessageInfo
 
-// MsgCancelSpotOrderResponse defines the Msg/CancelSpotOrder response type.
-type MsgCancelSpotOrderResponse struct {
+// MsgInstantExpiryFuturesMarketLaunch defines a SDK message for creating a new
+// expiry futures market by paying listing fee without governance
+type MsgInstantExpiryFuturesMarketLaunch struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Ticker for the derivative market.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// type of coin to use as the quote currency
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// Oracle base currency
+	OracleBase string `protobuf:"bytes,4,opt,name=oracle_base,json=oracleBase,proto3" json:"oracle_base,omitempty"`
+	// Oracle quote currency
+	OracleQuote string `protobuf:"bytes,5,opt,name=oracle_quote,json=oracleQuote,proto3" json:"oracle_quote,omitempty"`
+	// Oracle type
+	OracleType types1.OracleType `protobuf:"varint,6,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// Scale factor for oracle prices.
+	OracleScaleFactor uint32 `protobuf:"varint,7,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
+	// Expiration time of the market
+	Expiry int64 `protobuf:"varint,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// maker_fee_rate defines the trade fee rate for makers on the expiry futures
+	// market
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	// taker_fee_rate defines the trade fee rate for takers on the expiry futures
+	// market
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	// initial_margin_ratio defines the initial margin ratio for the derivative
+	// market
+	InitialMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=initial_margin_ratio,json=initialMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_margin_ratio"`
+	// maintenance_margin_ratio defines the maintenance margin ratio for the
+	// derivative market
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	// min_price_tick_size defines the minimum tick size of the order's price and
+	// margin
+	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
+	// min_quantity_tick_size defines the minimum tick size of the order's
+	// quantity
+	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
 }
 
-func (m *MsgCancelSpotOrderResponse) Reset()         { *m = MsgCancelSpotOrderResponse{} }
-func (m *MsgCancelSpotOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelSpotOrderResponse) ProtoMessage()    {}
-func (*MsgCancelSpotOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgInstantExpiryFuturesMarketLaunch) Reset()         { *m = MsgInstantExpiryFuturesMarketLaunch{} }
+func (m *MsgInstantExpiryFuturesMarketLaunch) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantExpiryFuturesMarketLaunch) ProtoMessage()    {}
+func (*MsgInstantExpiryFuturesMarketLaunch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{28}
 }
-func (m *MsgCancelSpotOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelSpotOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelSpotOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1290,36 +1345,39 @@ func (m *MsgCancelSpotOrderResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelSpotOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelSpotOrderResponse.Merge(m, src)
+func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch.Merge(m, src)
 }
-func (m *MsgCancelSpotOrderResponse) XXX_Size() int {
+func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelSpotOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelSpotOrderResponse.DiscardUnknown(m)
+func (m *MsgInstantExpiryFuturesMarketLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelSpotOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunch proto.InternalMessageInfo
 
-// MsgBatchCancelSpotOrders defines the Msg/BatchCancelSpotOrders response type.
-type MsgBatchCancelSpotOrders struct {
-	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Data   []OrderData `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+// MsgInstantExpiryFuturesMarketLaunchResponse defines the
+// Msg/InstantExpiryFuturesMarketLaunch response type.
+type MsgInstantExpiryFuturesMarketLaunchResponse struct {
 }
 
-func (m *MsgBatchCancelSpotOrders) Reset()         { *m = MsgBatchCancelSpotOrders{} }
-func (m *MsgBatchCancelSpotOrders) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCancelSpotOrders) ProtoMessage()    {}
-func (*MsgBatchCancelSpotOrders) Descriptor() ([]byte, []int) {
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) Reset() {
+	*m = MsgInstantExpiryFuturesMarketLaunchResponse{}
+}
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgInstantExpiryFuturesMarketLaunchResponse) ProtoMessage() {}
+func (*MsgInstantExpiryFuturesMarketLaunchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{29}
 }
-func (m *MsgBatchCancelSpotOrders) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCancelSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCancelSpotOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1329,36 +1387,37 @@ func (m *MsgBatchCancelSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCancelSpotOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCancelSpotOrders.Merge(m, src)
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse.Merge(m, src)
 }
-func (m *MsgBatchCancelSpotOrders) XXX_Size() int {
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCancelSpotOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCancelSpotOrders.DiscardUnknown(m)
-}
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse.DiscardUnknown(m)
+}
 
-var xxx_messageInfo_MsgBatchCancelSpotOrders proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantExpiryFuturesMarketLaunchResponse proto.InternalMessageInfo
 
-// MsgBatchCancelSpotOrdersResponse defines the Msg/BatchCancelSpotOrders
-// response type.
-type MsgBatchCancelSpotOrdersResponse struct {
-	Success []bool `protobuf:"varint,1,rep,packed,name=success,proto3" json:"success,omitempty"`
+// MsgCreateSpotMarketOrder defines a SDK message for creating a new spot market
+// order.
+type MsgCreateSpotMarketOrder struct {
+	Sender string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Order  SpotOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgBatchCancelSpotOrdersResponse) Reset()         { *m = MsgBatchCancelSpotOrdersResponse{} }
-func (m *MsgBatchCancelSpotOrdersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCancelSpotOrdersResponse) ProtoMessage()    {}
-func (*MsgBatchCancelSpotOrdersResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateSpotMarketOrder) Reset()         { *m = MsgCreateSpotMarketOrder{} }
+func (m *MsgCreateSpotMarketOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSpotMarketOrder) ProtoMessage()    {}
+func (*MsgCreateSpotMarketOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{30}
 }
-func (m *MsgBatchCancelSpotOrdersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSpotMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCancelSpotOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSpotMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCancelSpotOrdersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSpotMarketOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1368,37 +1427,37 @@ func (m *MsgBatchCancelSpotOrdersResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCancelSpotOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCancelSpotOrdersResponse.Merge(m, src)
+func (m *MsgCreateSpotMarketOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSpotMarketOrder.Merge(m, src)
 }
-func (m *MsgBatchCancelSpotOrdersResponse) XXX_Size() int {
+func (m *MsgCreateSpotMarketOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCancelSpotOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCancelSpotOrdersResponse.DiscardUnknown(m)
+func (m *MsgCreateSpotMarketOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSpotMarketOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCancelSpotOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSpotMarketOrder proto.InternalMessageInfo
 
-// MsgBatchCancelBinaryOptionsOrders defines the
-// Msg/BatchCancelBinaryOptionsOrders response type.
-type MsgBatchCancelBinaryOptionsOrders struct {
-	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Data   []OrderData `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+// MsgCreateSpotMarketOrderResponse defines the Msg/CreateSpotMarketLimitOrder
+// response type.
+type MsgCreateSpotMarketOrderResponse struct {
+	OrderHash string                  `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	Results   *SpotMarketOrderResults `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrders) Reset()         { *m = MsgBatchCancelBinaryOptionsOrders{} }
-func (m *MsgBatchCancelBinaryOptionsOrders) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCancelBinaryOptionsOrders) ProtoMessage()    {}
-func (*MsgBatchCancelBinaryOptionsOrders) Descriptor() ([]byte, []int) {
+func (m *MsgCreateSpotMarketOrderResponse) Reset()         { *m = MsgCreateSpotMarketOrderResponse{} }
+func (m *MsgCreateSpotMarketOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSpotMarketOrderResponse) ProtoMessage()    {}
+func (*MsgCreateSpotMarketOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{31}
 }
-func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSpotMarketOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSpotMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSpotMarketOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1408,40 +1467,36 @@ func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders.Merge(m, src)
+func (m *MsgCreateSpotMarketOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSpotMarketOrderResponse.Merge(m, src)
 }
-func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Size() int {
+func (m *MsgCreateSpotMarketOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCancelBinaryOptionsOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders.DiscardUnknown(m)
+func (m *MsgCreateSpotMarketOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSpotMarketOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSpotMarketOrderResponse proto.InternalMessageInfo
 
-// BatchCancelBinaryOptionsOrdersResponse defines the
-// Msg/BatchCancelBinaryOptionsOrders response type.
-type MsgBatchCancelBinaryOptionsOrdersResponse struct {
-	Success []bool `protobuf:"varint,1,rep,packed,name=success,proto3" json:"success,omitempty"`
+type SpotMarketOrderResults struct {
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Fee      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) Reset() {
-	*m = MsgBatchCancelBinaryOptionsOrdersResponse{}
-}
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgBatchCancelBinaryOptionsOrdersResponse) ProtoMessage() {}
-func (*MsgBatchCancelBinaryOptionsOrdersResponse) Descriptor() ([]byte, []int) {
+func (m *SpotMarketOrderResults) Reset()         { *m = SpotMarketOrderResults{} }
+func (m *SpotMarketOrderResults) String() string { return proto.CompactTextString(m) }
+func (*SpotMarketOrderResults) ProtoMessage()    {}
+func (*SpotMarketOrderResults) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{32}
 }
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Unmarshal(b []byte) error {
+func (m *SpotMarketOrderResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpotMarketOrderResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpotMarketOrderResults.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1451,47 +1506,36 @@ func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse.Merge(m, src)
+func (m *SpotMarketOrderResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotMarketOrderResults.Merge(m, src)
 }
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Size() int {
+func (m *SpotMarketOrderResults) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse.DiscardUnknown(m)
+func (m *SpotMarketOrderResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotMarketOrderResults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_SpotMarketOrderResults proto.InternalMessageInfo
 
-// MsgBatchUpdateOrders defines the Msg/BatchUpdateOrders response type.
-type MsgBatchUpdateOrders struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// subaccount_id only used for the spot_market_ids_to_cancel_all and
-	// derivative_market_ids_to_cancel_all.
-	SubaccountId                      string             `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	SpotMarketIdsToCancelAll          []string           `protobuf:"bytes,3,rep,name=spot_market_ids_to_cancel_all,json=spotMarketIdsToCancelAll,proto3" json:"spot_market_ids_to_cancel_all,omitempty"`
-	DerivativeMarketIdsToCancelAll    []string           `protobuf:"bytes,4,rep,name=derivative_market_ids_to_cancel_all,json=derivativeMarketIdsToCancelAll,proto3" json:"derivative_market_ids_to_cancel_all,omitempty"`
-	SpotOrdersToCancel                []*OrderData       `protobuf:"bytes,5,rep,name=spot_orders_to_cancel,json=spotOrdersToCancel,proto3" json:"spot_orders_to_cancel,omitempty"`
-	DerivativeOrdersToCancel          []*OrderData       `protobuf:"bytes,6,rep,name=derivative_orders_to_cancel,json=derivativeOrdersToCancel,proto3" json:"derivative_orders_to_cancel,omitempty"`
-	SpotOrdersToCreate                []*SpotOrder       `protobuf:"bytes,7,rep,name=spot_orders_to_create,json=spotOrdersToCreate,proto3" json:"spot_orders_to_create,omitempty"`
-	DerivativeOrdersToCreate          []*DerivativeOrder `protobuf:"bytes,8,rep,name=derivative_orders_to_create,json=derivativeOrdersToCreate,proto3" json:"derivative_orders_to_create,omitempty"`
-	BinaryOptionsOrdersToCancel       []*OrderData       `protobuf:"bytes,9,rep,name=binary_options_orders_to_cancel,json=binaryOptionsOrdersToCancel,proto3" json:"binary_options_orders_to_cancel,omitempty"`
-	BinaryOptionsMarketIdsToCancelAll []string           `protobuf:"bytes,10,rep,name=binary_options_market_ids_to_cancel_all,json=binaryOptionsMarketIdsToCancelAll,proto3" json:"binary_options_market_ids_to_cancel_all,omitempty"`
-	BinaryOptionsOrdersToCreate       []*DerivativeOrder `protobuf:"bytes,11,rep,name=binary_options_orders_to_create,json=binaryOptionsOrdersToCreate,proto3" json:"binary_options_orders_to_create,omitempty"`
+// A Cosmos-SDK MsgCreateDerivativeLimitOrder
+type MsgCreateDerivativeLimitOrder struct {
+	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Order  DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgBatchUpdateOrders) Reset()         { *m = MsgBatchUpdateOrders{} }
-func (m *MsgBatchUpdateOrders) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchUpdateOrders) ProtoMessage()    {}
-func (*MsgBatchUpdateOrders) Descriptor() ([]byte, []int) {
+func (m *MsgCreateDerivativeLimitOrder) Reset()         { *m = MsgCreateDerivativeLimitOrder{} }
+func (m *MsgCreateDerivativeLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDerivativeLimitOrder) ProtoMessage()    {}
+func (*MsgCreateDerivativeLimitOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{33}
 }
-func (m *MsgBatchUpdateOrders) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateDerivativeLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchUpdateOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateDerivativeLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchUpdateOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDerivativeLimitOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1501,40 +1545,36 @@ func (m *MsgBatchUpdateOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchUpdateOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchUpdateOrders.Merge(m, src)
+func (m *MsgCreateDerivativeLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDerivativeLimitOrder.Merge(m, src)
 }
-func (m *MsgBatchUpdateOrders) XXX_Size() int {
+func (m *MsgCreateDerivativeLimitOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchUpdateOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchUpdateOrders.DiscardUnknown(m)
+func (m *MsgCreateDerivativeLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDerivativeLimitOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchUpdateOrders proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateDerivativeLimitOrder proto.InternalMessageInfo
 
-// MsgBatchUpdateOrdersResponse defines the Msg/BatchUpdateOrders response type.
-type MsgBatchUpdateOrdersResponse struct {
-	SpotCancelSuccess          []bool   `protobuf:"varint,1,rep,packed,name=spot_cancel_success,json=spotCancelSuccess,proto3" json:"spot_cancel_success,omitempty"`
-	DerivativeCancelSuccess    []bool   `protobuf:"varint,2,rep,packed,name=derivative_cancel_success,json=derivativeCancelSuccess,proto3" json:"derivative_cancel_success,omitempty"`
-	SpotOrderHashes            []string `protobuf:"bytes,3,rep,name=spot_order_hashes,json=spotOrderHashes,proto3" json:"spot_order_hashes,omitempty"`
-	DerivativeOrderHashes      []string `protobuf:"bytes,4,rep,name=derivative_order_hashes,json=derivativeOrderHashes,proto3" json:"derivative_order_hashes,omitempty"`
-	BinaryOptionsCancelSuccess []bool   `protobuf:"varint,5,rep,packed,name=binary_options_cancel_success,json=binaryOptionsCancelSuccess,proto3" json:"binary_options_cancel_success,omitempty"`
-	BinaryOptionsOrderHashes   []string `protobuf:"bytes,6,rep,name=binary_options_order_hashes,json=binaryOptionsOrderHashes,proto3" json:"binary_options_order_hashes,omitempty"`
+// MsgCreateDerivativeLimitOrderResponse defines the
+// Msg/CreateDerivativeMarketOrder response type.
+type MsgCreateDerivativeLimitOrderResponse struct {
+	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
 }
 
-func (m *MsgBatchUpdateOrdersResponse) Reset()         { *m = MsgBatchUpdateOrdersResponse{} }
-func (m *MsgBatchUpdateOrdersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchUpdateOrdersResponse) ProtoMessage()    {}
-func (*MsgBatchUpdateOrdersResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateDerivativeLimitOrderResponse) Reset()         { *m = MsgCreateDerivativeLimitOrderResponse{} }
+func (m *MsgCreateDerivativeLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDerivativeLimitOrderResponse) ProtoMessage()    {}
+func (*MsgCreateDerivativeLimitOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{34}
 }
-func (m *MsgBatchUpdateOrdersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchUpdateOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchUpdateOrdersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1544,36 +1584,36 @@ func (m *MsgBatchUpdateOrdersResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchUpdateOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchUpdateOrdersResponse.Merge(m, src)
+func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse.Merge(m, src)
 }
-func (m *MsgBatchUpdateOrdersResponse) XXX_Size() int {
+func (m *MsgCreateDerivativeLimitOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchUpdateOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchUpdateOrdersResponse.DiscardUnknown(m)
+func (m *MsgCreateDerivativeLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchUpdateOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateDerivativeLimitOrderResponse proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgCreateDerivativeMarketOrder
-type MsgCreateDerivativeMarketOrder struct {
+// A Cosmos-SDK MsgCreateBinaryOptionsLimitOrder
+type MsgCreateBinaryOptionsLimitOrder struct {
 	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Order  DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgCreateDerivativeMarketOrder) Reset()         { *m = MsgCreateDerivativeMarketOrder{} }
-func (m *MsgCreateDerivativeMarketOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateDerivativeMarketOrder) ProtoMessage()    {}
-func (*MsgCreateDerivativeMarketOrder) Descriptor() ([]byte, []int) {
+func (m *MsgCreateBinaryOptionsLimitOrder) Reset()         { *m = MsgCreateBinaryOptionsLimitOrder{} }
+func (m *MsgCreateBinaryOptionsLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBinaryOptionsLimitOrder) ProtoMessage()    {}
+func (*MsgCreateBinaryOptionsLimitOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{35}
 }
-func (m *MsgCreateDerivativeMarketOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateDerivativeMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateDerivativeMarketOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1583,39 +1623,38 @@ func (m *MsgCreateDerivativeMarketOrder) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateDerivativeMarketOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateDerivativeMarketOrder.Merge(m, src)
+func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder.Merge(m, src)
 }
-func (m *MsgCreateDerivativeMarketOrder) XXX_Size() int {
+func (m *MsgCreateBinaryOptionsLimitOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateDerivativeMarketOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateDerivativeMarketOrder.DiscardUnknown(m)
+func (m *MsgCreateBinaryOptionsLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateDerivativeMarketOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateBinaryOptionsLimitOrder proto.InternalMessageInfo
 
-// MsgCreateDerivativeMarketOrderResponse defines the
-// Msg/CreateDerivativeMarketOrder response type.
-type MsgCreateDerivativeMarketOrderResponse struct {
-	OrderHash string                        `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
-	Results   *DerivativeMarketOrderResults `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+// MsgCreateBinaryOptionsLimitOrderResponse defines the
+// Msg/CreateBinaryOptionsLimitOrder response type.
+type MsgCreateBinaryOptionsLimitOrderResponse struct {
+	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
 }
 
-func (m *MsgCreateDerivativeMarketOrderResponse) Reset() {
-	*m = MsgCreateDerivativeMarketOrderResponse{}
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) Reset() {
+	*m = MsgCreateBinaryOptionsLimitOrderResponse{}
 }
-func (m *MsgCreateDerivativeMarketOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateDerivativeMarketOrderResponse) ProtoMessage()    {}
-func (*MsgCreateDerivativeMarketOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBinaryOptionsLimitOrderResponse) ProtoMessage()    {}
+func (*MsgCreateBinaryOptionsLimitOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{36}
 }
-func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1625,38 +1664,36 @@ func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse.Merge(m, src)
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse.Merge(m, src)
 }
-func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Size() int {
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateDerivativeMarketOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse.DiscardUnknown(m)
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateBinaryOptionsLimitOrderResponse proto.InternalMessageInfo
 
-type DerivativeMarketOrderResults struct {
-	Quantity      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
-	Price         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Fee           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
-	PositionDelta PositionDelta                          `protobuf:"bytes,4,opt,name=position_delta,json=positionDelta,proto3" json:"position_delta"`
-	Payout        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout"`
+// A Cosmos-SDK MsgBatchCreateDerivativeLimitOrders
+type MsgBatchCreateDerivativeLimitOrders struct {
+	Sender string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Orders []DerivativeOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
 }
 
-func (m *DerivativeMarketOrderResults) Reset()         { *m = DerivativeMarketOrderResults{} }
-func (m *DerivativeMarketOrderResults) String() string { return proto.CompactTextString(m) }
-func (*DerivativeMarketOrderResults) ProtoMessage()    {}
-func (*DerivativeMarketOrderResults) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCreateDerivativeLimitOrders) Reset()         { *m = MsgBatchCreateDerivativeLimitOrders{} }
+func (m *MsgBatchCreateDerivativeLimitOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateDerivativeLimitOrders) ProtoMessage()    {}
+func (*MsgBatchCreateDerivativeLimitOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{37}
 }
-func (m *DerivativeMarketOrderResults) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DerivativeMarketOrderResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DerivativeMarketOrderResults.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1666,36 +1703,40 @@ func (m *DerivativeMarketOrderResults) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *DerivativeMarketOrderResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DerivativeMarketOrderResults.Merge(m, src)
+func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders.Merge(m, src)
 }
-func (m *DerivativeMarketOrderResults) XXX_Size() int {
+func (m *MsgBatchCreateDerivativeLimitOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *DerivativeMarketOrderResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_DerivativeMarketOrderResults.DiscardUnknown(m)
+func (m *MsgBatchCreateDerivativeLimitOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DerivativeMarketOrderResults proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCreateDerivativeLimitOrders proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgCreateBinaryOptionsMarketOrder
-type MsgCreateBinaryOptionsMarketOrder struct {
-	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Order  DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+// MsgBatchCreateDerivativeLimitOrdersResponse defines the
+// Msg/BatchCreateDerivativeLimitOrders response type.
+type MsgBatchCreateDerivativeLimitOrdersResponse struct {
+	OrderHashes []string `protobuf:"bytes,1,rep,name=order_hashes,json=orderHashes,proto3" json:"order_hashes,omitempty"`
 }
 
-func (m *MsgCreateBinaryOptionsMarketOrder) Reset()         { *m = MsgCreateBinaryOptionsMarketOrder{} }
-func (m *MsgCreateBinaryOptionsMarketOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateBinaryOptionsMarketOrder) ProtoMessage()    {}
-func (*MsgCreateBinaryOptionsMarketOrder) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) Reset() {
+	*m = MsgBatchCreateDerivativeLimitOrdersResponse{}
+}
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgBatchCreateDerivativeLimitOrdersResponse) ProtoMessage() {}
+func (*MsgBatchCreateDerivativeLimitOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{38}
 }
-func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1705,41 +1746,39 @@ func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder.Merge(m, src)
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse.Merge(m, src)
 }
-func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Size() int {
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateBinaryOptionsMarketOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder.DiscardUnknown(m)
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCreateDerivativeLimitOrdersResponse proto.InternalMessageInfo
 
-// MsgCreateBinaryOptionsMarketOrderResponse defines the
-// Msg/CreateBinaryOptionsMarketOrder response type.
-type MsgCreateBinaryOptionsMarketOrderResponse struct {
-	OrderHash string                        `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
-	Results   *DerivativeMarketOrderResults `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+// MsgCancelSpotOrder defines the Msg/CancelSpotOrder response type.
+type MsgCancelSpotOrder struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	OrderHash    string `protobuf:"bytes,4,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	Cid          string `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`
 }
 
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) Reset() {
-	*m = MsgCreateBinaryOptionsMarketOrderResponse{}
-}
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgCreateBinaryOptionsMarketOrderResponse) ProtoMessage() {}
-func (*MsgCreateBinaryOptionsMarketOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelSpotOrder) Reset()         { *m = MsgCancelSpotOrder{} }
+func (m *MsgCancelSpotOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSpotOrder) ProtoMessage()    {}
+func (*MsgCancelSpotOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{39}
 }
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelSpotOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelSpotOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1749,40 +1788,34 @@ func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse.Merge(m, src)
+func (m *MsgCancelSpotOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSpotOrder.Merge(m, src)
 }
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Size() int {
+func (m *MsgCancelSpotOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse.DiscardUnknown(m)
+func (m *MsgCancelSpotOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSpotOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelSpotOrder proto.InternalMessageInfo
 
-// MsgCancelDerivativeOrder defines the Msg/CancelDerivativeOrder response type.
-type MsgCancelDerivativeOrder struct {
-	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	OrderHash    string `protobuf:"bytes,4,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
-	OrderMask    int32  `protobuf:"varint,5,opt,name=order_mask,json=orderMask,proto3" json:"order_mask,omitempty"`
-	Cid          string `protobuf:"bytes,6,opt,name=cid,proto3" json:"cid,omitempty"`
+// MsgCancelSpotOrderResponse defines the Msg/CancelSpotOrder response type.
+type MsgCancelSpotOrderResponse struct {
 }
 
-func (m *MsgCancelDerivativeOrder) Reset()         { *m = MsgCancelDerivativeOrder{} }
-func (m *MsgCancelDerivativeOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelDerivativeOrder) ProtoMessage()    {}
-func (*MsgCancelDerivativeOrder) Descriptor() ([]byte, []int) {
+func (m *MsgCancelSpotOrderResponse) Reset()         { *m = MsgCancelSpotOrderResponse{} }
+func (m *MsgCancelSpotOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSpotOrderResponse) ProtoMessage()    {}
+func (*MsgCancelSpotOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{40}
 }
-func (m *MsgCancelDerivativeOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelSpotOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelSpotOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelDerivativeOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelSpotOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1792,35 +1825,36 @@ func (m *MsgCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelDerivativeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelDerivativeOrder.Merge(m, src)
+func (m *MsgCancelSpotOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSpotOrderResponse.Merge(m, src)
 }
-func (m *MsgCancelDerivativeOrder) XXX_Size() int {
+func (m *MsgCancelSpotOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelDerivativeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelDerivativeOrder.DiscardUnknown(m)
+func (m *MsgCancelSpotOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSpotOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelDerivativeOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelSpotOrderResponse proto.InternalMessageInfo
 
-// MsgCancelDerivativeOrderResponse defines the
-// Msg/CancelDerivativeOrderResponse response type.
-type MsgCancelDerivativeOrderResponse struct {
+// MsgBatchCancelSpotOrders defines the Msg/BatchCancelSpotOrders response type.
+type MsgBatchCancelSpotOrders struct {
+	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Data   []OrderData `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 }
 
-func (m *MsgCancelDerivativeOrderResponse) Reset()         { *m = MsgCancelDerivativeOrderResponse{} }
-func (m *MsgCancelDerivativeOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelDerivativeOrderResponse) ProtoMessage()    {}
-func (*MsgCancelDerivativeOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancelSpotOrders) Reset()         { *m = MsgBatchCancelSpotOrders{} }
+func (m *MsgBatchCancelSpotOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelSpotOrders) ProtoMessage()    {}
+func (*MsgBatchCancelSpotOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{41}
 }
-func (m *MsgCancelDerivativeOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelSpotOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelDerivativeOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelDerivativeOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelSpotOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1830,41 +1864,36 @@ func (m *MsgCancelDerivativeOrderResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelDerivativeOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelDerivativeOrderResponse.Merge(m, src)
+func (m *MsgBatchCancelSpotOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelSpotOrders.Merge(m, src)
 }
-func (m *MsgCancelDerivativeOrderResponse) XXX_Size() int {
+func (m *MsgBatchCancelSpotOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelDerivativeOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelDerivativeOrderResponse.DiscardUnknown(m)
+func (m *MsgBatchCancelSpotOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelSpotOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelDerivativeOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelSpotOrders proto.InternalMessageInfo
 
-// MsgCancelBinaryOptionsOrder defines the Msg/CancelBinaryOptionsOrder response
-// type.
-type MsgCancelBinaryOptionsOrder struct {
-	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	OrderHash    string `protobuf:"bytes,4,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
-	OrderMask    int32  `protobuf:"varint,5,opt,name=order_mask,json=orderMask,proto3" json:"order_mask,omitempty"`
-	Cid          string `protobuf:"bytes,6,opt,name=cid,proto3" json:"cid,omitempty"`
+// MsgBatchCancelSpotOrdersResponse defines the Msg/BatchCancelSpotOrders
+// response type.
+type MsgBatchCancelSpotOrdersResponse struct {
+	Success []bool `protobuf:"varint,1,rep,packed,name=success,proto3" json:"success,omitempty"`
 }
 
-func (m *MsgCancelBinaryOptionsOrder) Reset()         { *m = MsgCancelBinaryOptionsOrder{} }
-func (m *MsgCancelBinaryOptionsOrder) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelBinaryOptionsOrder) ProtoMessage()    {}
-func (*MsgCancelBinaryOptionsOrder) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancelSpotOrdersResponse) Reset()         { *m = MsgBatchCancelSpotOrdersResponse{} }
+func (m *MsgBatchCancelSpotOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelSpotOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchCancelSpotOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{42}
 }
-func (m *MsgCancelBinaryOptionsOrder) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelSpotOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelBinaryOptionsOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelSpotOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelBinaryOptionsOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelSpotOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1874,35 +1903,37 @@ func (m *MsgCancelBinaryOptionsOrder) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelBinaryOptionsOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelBinaryOptionsOrder.Merge(m, src)
+func (m *MsgBatchCancelSpotOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelSpotOrdersResponse.Merge(m, src)
 }
-func (m *MsgCancelBinaryOptionsOrder) XXX_Size() int {
+func (m *MsgBatchCancelSpotOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelBinaryOptionsOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelBinaryOptionsOrder.DiscardUnknown(m)
+func (m *MsgBatchCancelSpotOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelSpotOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelBinaryOptionsOrder proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelSpotOrdersResponse proto.InternalMessageInfo
 
-// MsgCancelBinaryOptionsOrderResponse defines the
-// Msg/CancelBinaryOptionsOrderResponse response type.
-type MsgCancelBinaryOptionsOrderResponse struct {
+// MsgBatchCancelBinaryOptionsOrders defines the
+// Msg/BatchCancelBinaryOptionsOrders response type.
+type MsgBatchCancelBinaryOptionsOrders struct {
+	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Data   []OrderData `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 }
 
-func (m *MsgCancelBinaryOptionsOrderResponse) Reset()         { *m = MsgCancelBinaryOptionsOrderResponse{} }
-func (m *MsgCancelBinaryOptionsOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelBinaryOptionsOrderResponse) ProtoMessage()    {}
-func (*MsgCancelBinaryOptionsOrderResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancelBinaryOptionsOrders) Reset()         { *m = MsgBatchCancelBinaryOptionsOrders{} }
+func (m *MsgBatchCancelBinaryOptionsOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelBinaryOptionsOrders) ProtoMessage()    {}
+func (*MsgBatchCancelBinaryOptionsOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{43}
 }
-func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1912,38 +1943,40 @@ func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse.Merge(m, src)
+func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders.Merge(m, src)
 }
-func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Size() int {
+func (m *MsgBatchCancelBinaryOptionsOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelBinaryOptionsOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse.DiscardUnknown(m)
+func (m *MsgBatchCancelBinaryOptionsOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelBinaryOptionsOrders proto.InternalMessageInfo
 
-type OrderData struct {
-	MarketId     string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	OrderHash    string `protobuf:"bytes,3,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
-	OrderMask    int32  `protobuf:"varint,4,opt,name=order_mask,json=orderMask,proto3" json:"order_mask,omitempty"`
-	Cid          string `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`
+// BatchCancelBinaryOptionsOrdersResponse defines the
+// Msg/BatchCancelBinaryOptionsOrders response type.
+type MsgBatchCancelBinaryOptionsOrdersResponse struct {
+	Success []bool `protobuf:"varint,1,rep,packed,name=success,proto3" json:"success,omitempty"`
 }
 
-func (m *OrderData) Reset()         { *m = OrderData{} }
-func (m *OrderData) String() string { return proto.CompactTextString(m) }
-func (*OrderData) ProtoMessage()    {}
-func (*OrderData) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) Reset() {
+	*m = MsgBatchCancelBinaryOptionsOrdersResponse{}
+}
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgBatchCancelBinaryOptionsOrdersResponse) ProtoMessage() {}
+func (*MsgBatchCancelBinaryOptionsOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{44}
 }
-func (m *OrderData) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OrderData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OrderData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1953,72 +1986,47 @@ func (m *OrderData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *OrderData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderData.Merge(m, src)
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse.Merge(m, src)
 }
-func (m *OrderData) XXX_Size() int {
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OrderData) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderData.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OrderData proto.InternalMessageInfo
-
-func (m *OrderData) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
-	}
-	return ""
-}
-
-func (m *OrderData) GetSubaccountId() string {
-	if m != nil {
-		return m.SubaccountId
-	}
-	return ""
-}
-
-func (m *OrderData) GetOrderHash() string {
-	if m != nil {
-		return m.OrderHash
-	}
-	return ""
-}
-
-func (m *OrderData) GetOrderMask() int32 {
-	if m != nil {
-		return m.OrderMask
-	}
-	return 0
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *OrderData) GetCid() string {
-	if m != nil {
-		return m.Cid
-	}
-	return ""
-}
+var xxx_messageInfo_MsgBatchCancelBinaryOptionsOrdersResponse proto.InternalMessageInfo
 
-// MsgBatchCancelDerivativeOrders defines the Msg/CancelDerivativeOrders
-// response type.
-type MsgBatchCancelDerivativeOrders struct {
-	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Data   []OrderData `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+// MsgBatchUpdateOrders defines the Msg/BatchUpdateOrders response type.
+type MsgBatchUpdateOrders struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// subaccount_id only used for the spot_market_ids_to_cancel_all and
+	// derivative_market_ids_to_cancel_all.
+	SubaccountId                      string             `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	SpotMarketIdsToCancelAll          []string           `protobuf:"bytes,3,rep,name=spot_market_ids_to_cancel_all,json=spotMarketIdsToCancelAll,proto3" json:"spot_market_ids_to_cancel_all,omitempty"`
+	DerivativeMarketIdsToCancelAll    []string           `protobuf:"bytes,4,rep,name=derivative_market_ids_to_cancel_all,json=derivativeMarketIdsToCancelAll,proto3" json:"derivative_market_ids_to_cancel_all,omitempty"`
+	SpotOrdersToCancel                []*OrderData       `protobuf:"bytes,5,rep,name=spot_orders_to_cancel,json=spotOrdersToCancel,proto3" json:"spot_orders_to_cancel,omitempty"`
+	DerivativeOrdersToCancel          []*OrderData       `protobuf:"bytes,6,rep,name=derivative_orders_to_cancel,json=derivativeOrdersToCancel,proto3" json:"derivative_orders_to_cancel,omitempty"`
+	SpotOrdersToCreate                []*SpotOrder       `protobuf:"bytes,7,rep,name=spot_orders_to_create,json=spotOrdersToCreate,proto3" json:"spot_orders_to_create,omitempty"`
+	DerivativeOrdersToCreate          []*DerivativeOrder `protobuf:"bytes,8,rep,name=derivative_orders_to_create,json=derivativeOrdersToCreate,proto3" json:"derivative_orders_to_create,omitempty"`
+	BinaryOptionsOrdersToCancel       []*OrderData       `protobuf:"bytes,9,rep,name=binary_options_orders_to_cancel,json=binaryOptionsOrdersToCancel,proto3" json:"binary_options_orders_to_cancel,omitempty"`
+	BinaryOptionsMarketIdsToCancelAll []string           `protobuf:"bytes,10,rep,name=binary_options_market_ids_to_cancel_all,json=binaryOptionsMarketIdsToCancelAll,proto3" json:"binary_options_market_ids_to_cancel_all,omitempty"`
+	BinaryOptionsOrdersToCreate       []*DerivativeOrder `protobuf:"bytes,11,rep,name=binary_options_orders_to_create,json=binaryOptionsOrdersToCreate,proto3" json:"binary_options_orders_to_create,omitempty"`
 }
 
-func (m *MsgBatchCancelDerivativeOrders) Reset()         { *m = MsgBatchCancelDerivativeOrders{} }
-func (m *MsgBatchCancelDerivativeOrders) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCancelDerivativeOrders) ProtoMessage()    {}
-func (*MsgBatchCancelDerivativeOrders) Descriptor() ([]byte, []int) {
+func (m *MsgBatchUpdateOrders) Reset()         { *m = MsgBatchUpdateOrders{} }
+func (m *MsgBatchUpdateOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdateOrders) ProtoMessage()    {}
+func (*MsgBatchUpdateOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{45}
 }
-func (m *MsgBatchCancelDerivativeOrders) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchUpdateOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCancelDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchUpdateOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCancelDerivativeOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchUpdateOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2028,38 +2036,40 @@ func (m *MsgBatchCancelDerivativeOrders) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCancelDerivativeOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCancelDerivativeOrders.Merge(m, src)
+func (m *MsgBatchUpdateOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdateOrders.Merge(m, src)
 }
-func (m *MsgBatchCancelDerivativeOrders) XXX_Size() int {
+func (m *MsgBatchUpdateOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCancelDerivativeOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCancelDerivativeOrders.DiscardUnknown(m)
+func (m *MsgBatchUpdateOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdateOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCancelDerivativeOrders proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchUpdateOrders proto.InternalMessageInfo
 
-// MsgBatchCancelDerivativeOrdersResponse defines the
-// Msg/CancelDerivativeOrderResponse response type.
-type MsgBatchCancelDerivativeOrdersResponse struct {
-	Success []bool `protobuf:"varint,1,rep,packed,name=success,proto3" json:"success,omitempty"`
+// MsgBatchUpdateOrdersResponse defines the Msg/BatchUpdateOrders response type.
+type MsgBatchUpdateOrdersResponse struct {
+	SpotCancelSuccess          []bool   `protobuf:"varint,1,rep,packed,name=spot_cancel_success,json=spotCancelSuccess,proto3" json:"spot_cancel_success,omitempty"`
+	DerivativeCancelSuccess    []bool   `protobuf:"varint,2,rep,packed,name=derivative_cancel_success,json=derivativeCancelSuccess,proto3" json:"derivative_cancel_success,omitempty"`
+	SpotOrderHashes            []string `protobuf:"bytes,3,rep,name=spot_order_hashes,json=spotOrderHashes,proto3" json:"spot_order_hashes,omitempty"`
+	DerivativeOrderHashes      []string `protobuf:"bytes,4,rep,name=derivative_order_hashes,json=derivativeOrderHashes,proto3" json:"derivative_order_hashes,omitempty"`
+	BinaryOptionsCancelSuccess []bool   `protobuf:"varint,5,rep,packed,name=binary_options_cancel_success,json=binaryOptionsCancelSuccess,proto3" json:"binary_options_cancel_success,omitempty"`
+	BinaryOptionsOrderHashes   []string `protobuf:"bytes,6,rep,name=binary_options_order_hashes,json=binaryOptionsOrderHashes,proto3" json:"binary_options_order_hashes,omitempty"`
 }
 
-func (m *MsgBatchCancelDerivativeOrdersResponse) Reset() {
-	*m = MsgBatchCancelDerivativeOrdersResponse{}
-}
-func (m *MsgBatchCancelDerivativeOrdersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBatchCancelDerivativeOrdersResponse) ProtoMessage()    {}
-func (*MsgBatchCancelDerivativeOrdersResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBatchUpdateOrdersResponse) Reset()         { *m = MsgBatchUpdateOrdersResponse{} }
+func (m *MsgBatchUpdateOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdateOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchUpdateOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{46}
 }
-func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchUpdateOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchUpdateOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchUpdateOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2069,38 +2079,36 @@ func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse.Merge(m, src)
+func (m *MsgBatchUpdateOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdateOrdersResponse.Merge(m, src)
 }
-func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Size() int {
+func (m *MsgBatchUpdateOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse.DiscardUnknown(m)
+func (m *MsgBatchUpdateOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdateOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchUpdateOrdersResponse proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgSubaccountTransfer
-type MsgSubaccountTransfer struct {
-	Sender                  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	SourceSubaccountId      string     `protobuf:"bytes,2,opt,name=source_subaccount_id,json=sourceSubaccountId,proto3" json:"source_subaccount_id,omitempty"`
-	DestinationSubaccountId string     `protobuf:"bytes,3,opt,name=destination_subaccount_id,json=destinationSubaccountId,proto3" json:"destination_subaccount_id,omitempty"`
-	Amount                  types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+// A Cosmos-SDK MsgCreateDerivativeMarketOrder
+type MsgCreateDerivativeMarketOrder struct {
+	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Order  DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgSubaccountTransfer) Reset()         { *m = MsgSubaccountTransfer{} }
-func (m *MsgSubaccountTransfer) String() string { return proto.CompactTextString(m) }
-func (*MsgSubaccountTransfer) ProtoMessage()    {}
-func (*MsgSubaccountTransfer) Descriptor() ([]byte, []int) {
+func (m *MsgCreateDerivativeMarketOrder) Reset()         { *m = MsgCreateDerivativeMarketOrder{} }
+func (m *MsgCreateDerivativeMarketOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDerivativeMarketOrder) ProtoMessage()    {}
+func (*MsgCreateDerivativeMarketOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{47}
 }
-func (m *MsgSubaccountTransfer) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateDerivativeMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubaccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateDerivativeMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubaccountTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDerivativeMarketOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2110,63 +2118,39 @@ func (m *MsgSubaccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgSubaccountTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubaccountTransfer.Merge(m, src)
+func (m *MsgCreateDerivativeMarketOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDerivativeMarketOrder.Merge(m, src)
 }
-func (m *MsgSubaccountTransfer) XXX_Size() int {
+func (m *MsgCreateDerivativeMarketOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubaccountTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubaccountTransfer.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgSubaccountTransfer proto.InternalMessageInfo
-
-func (m *MsgSubaccountTransfer) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
-}
-
-func (m *MsgSubaccountTransfer) GetSourceSubaccountId() string {
-	if m != nil {
-		return m.SourceSubaccountId
-	}
-	return ""
+func (m *MsgCreateDerivativeMarketOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDerivativeMarketOrder.DiscardUnknown(m)
 }
 
-func (m *MsgSubaccountTransfer) GetDestinationSubaccountId() string {
-	if m != nil {
-		return m.DestinationSubaccountId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgCreateDerivativeMarketOrder proto.InternalMessageInfo
 
-func (m *MsgSubaccountTransfer) GetAmount() types.Coin {
-	if m != nil {
-		return m.Amount
-	}
-	return types.Coin{}
+// MsgCreateDerivativeMarketOrderResponse defines the
+// Msg/CreateDerivativeMarketOrder response type.
+type MsgCreateDerivativeMarketOrderResponse struct {
+	OrderHash string                        `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	Results   *DerivativeMarketOrderResults `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
 }
 
-// MsgSubaccountTransferResponse defines the Msg/SubaccountTransfer response
-// type.
-type MsgSubaccountTransferResponse struct {
+func (m *MsgCreateDerivativeMarketOrderResponse) Reset() {
+	*m = MsgCreateDerivativeMarketOrderResponse{}
 }
-
-func (m *MsgSubaccountTransferResponse) Reset()         { *m = MsgSubaccountTransferResponse{} }
-func (m *MsgSubaccountTransferResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubaccountTransferResponse) ProtoMessage()    {}
-func (*MsgSubaccountTransferResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateDerivativeMarketOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDerivativeMarketOrderResponse) ProtoMessage()    {}
+func (*MsgCreateDerivativeMarketOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{48}
 }
-func (m *MsgSubaccountTransferResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubaccountTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubaccountTransferResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2176,38 +2160,38 @@ func (m *MsgSubaccountTransferResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgSubaccountTransferResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubaccountTransferResponse.Merge(m, src)
+func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse.Merge(m, src)
 }
-func (m *MsgSubaccountTransferResponse) XXX_Size() int {
+func (m *MsgCreateDerivativeMarketOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubaccountTransferResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubaccountTransferResponse.DiscardUnknown(m)
+func (m *MsgCreateDerivativeMarketOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubaccountTransferResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateDerivativeMarketOrderResponse proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgExternalTransfer
-type MsgExternalTransfer struct {
-	Sender                  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	SourceSubaccountId      string     `protobuf:"bytes,2,opt,name=source_subaccount_id,json=sourceSubaccountId,proto3" json:"source_subaccount_id,omitempty"`
-	DestinationSubaccountId string     `protobuf:"bytes,3,opt,name=destination_subaccount_id,json=destinationSubaccountId,proto3" json:"destination_subaccount_id,omitempty"`
-	Amount                  types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+type DerivativeMarketOrderResults struct {
+	Quantity      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Price         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Fee           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+	PositionDelta PositionDelta                          `protobuf:"bytes,4,opt,name=position_delta,json=positionDelta,proto3" json:"position_delta"`
+	Payout        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout"`
 }
 
-func (m *MsgExternalTransfer) Reset()         { *m = MsgExternalTransfer{} }
-func (m *MsgExternalTransfer) String() string { return proto.CompactTextString(m) }
-func (*MsgExternalTransfer) ProtoMessage()    {}
-func (*MsgExternalTransfer) Descriptor() ([]byte, []int) {
+func (m *DerivativeMarketOrderResults) Reset()         { *m = DerivativeMarketOrderResults{} }
+func (m *DerivativeMarketOrderResults) String() string { return proto.CompactTextString(m) }
+func (*DerivativeMarketOrderResults) ProtoMessage()    {}
+func (*DerivativeMarketOrderResults) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{49}
 }
-func (m *MsgExternalTransfer) XXX_Unmarshal(b []byte) error {
+func (m *DerivativeMarketOrderResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExternalTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DerivativeMarketOrderResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExternalTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DerivativeMarketOrderResults.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2217,62 +2201,36 @@ func (m *MsgExternalTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgExternalTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExternalTransfer.Merge(m, src)
+func (m *DerivativeMarketOrderResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivativeMarketOrderResults.Merge(m, src)
 }
-func (m *MsgExternalTransfer) XXX_Size() int {
+func (m *DerivativeMarketOrderResults) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExternalTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExternalTransfer.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgExternalTransfer proto.InternalMessageInfo
-
-func (m *MsgExternalTransfer) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
-}
-
-func (m *MsgExternalTransfer) GetSourceSubaccountId() string {
-	if m != nil {
-		return m.SourceSubaccountId
-	}
-	return ""
-}
-
-func (m *MsgExternalTransfer) GetDestinationSubaccountId() string {
-	if m != nil {
-		return m.DestinationSubaccountId
-	}
-	return ""
+func (m *DerivativeMarketOrderResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivativeMarketOrderResults.DiscardUnknown(m)
 }
 
-func (m *MsgExternalTransfer) GetAmount() types.Coin {
-	if m != nil {
-		return m.Amount
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_DerivativeMarketOrderResults proto.InternalMessageInfo
 
-// MsgExternalTransferResponse defines the Msg/ExternalTransfer response type.
-type MsgExternalTransferResponse struct {
+// A Cosmos-SDK MsgCreateBinaryOptionsMarketOrder
+type MsgCreateBinaryOptionsMarketOrder struct {
+	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Order  DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgExternalTransferResponse) Reset()         { *m = MsgExternalTransferResponse{} }
-func (m *MsgExternalTransferResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgExternalTransferResponse) ProtoMessage()    {}
-func (*MsgExternalTransferResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateBinaryOptionsMarketOrder) Reset()         { *m = MsgCreateBinaryOptionsMarketOrder{} }
+func (m *MsgCreateBinaryOptionsMarketOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBinaryOptionsMarketOrder) ProtoMessage()    {}
+func (*MsgCreateBinaryOptionsMarketOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{50}
 }
-func (m *MsgExternalTransferResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExternalTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExternalTransferResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2282,39 +2240,41 @@ func (m *MsgExternalTransferResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgExternalTransferResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExternalTransferResponse.Merge(m, src)
+func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder.Merge(m, src)
 }
-func (m *MsgExternalTransferResponse) XXX_Size() int {
+func (m *MsgCreateBinaryOptionsMarketOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExternalTransferResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExternalTransferResponse.DiscardUnknown(m)
+func (m *MsgCreateBinaryOptionsMarketOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExternalTransferResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateBinaryOptionsMarketOrder proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgLiquidatePosition
-type MsgLiquidatePosition struct {
-	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	MarketId     string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// optional order to provide for liquidation
-	Order *DerivativeOrder `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+// MsgCreateBinaryOptionsMarketOrderResponse defines the
+// Msg/CreateBinaryOptionsMarketOrder response type.
+type MsgCreateBinaryOptionsMarketOrderResponse struct {
+	OrderHash string                        `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	Results   *DerivativeMarketOrderResults `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
 }
 
-func (m *MsgLiquidatePosition) Reset()         { *m = MsgLiquidatePosition{} }
-func (m *MsgLiquidatePosition) String() string { return proto.CompactTextString(m) }
-func (*MsgLiquidatePosition) ProtoMessage()    {}
-func (*MsgLiquidatePosition) Descriptor() ([]byte, []int) {
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) Reset() {
+	*m = MsgCreateBinaryOptionsMarketOrderResponse{}
+}
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCreateBinaryOptionsMarketOrderResponse) ProtoMessage() {}
+func (*MsgCreateBinaryOptionsMarketOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{51}
 }
-func (m *MsgLiquidatePosition) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgLiquidatePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgLiquidatePosition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2324,62 +2284,40 @@ func (m *MsgLiquidatePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgLiquidatePosition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgLiquidatePosition.Merge(m, src)
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse.Merge(m, src)
 }
-func (m *MsgLiquidatePosition) XXX_Size() int {
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgLiquidatePosition) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgLiquidatePosition.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgLiquidatePosition proto.InternalMessageInfo
-
-func (m *MsgLiquidatePosition) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
-}
-
-func (m *MsgLiquidatePosition) GetSubaccountId() string {
-	if m != nil {
-		return m.SubaccountId
-	}
-	return ""
-}
-
-func (m *MsgLiquidatePosition) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
-	}
-	return ""
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidatePosition) GetOrder() *DerivativeOrder {
-	if m != nil {
-		return m.Order
-	}
-	return nil
-}
+var xxx_messageInfo_MsgCreateBinaryOptionsMarketOrderResponse proto.InternalMessageInfo
 
-// MsgLiquidatePositionResponse defines the Msg/LiquidatePosition response type.
-type MsgLiquidatePositionResponse struct {
+// MsgCancelDerivativeOrder defines the Msg/CancelDerivativeOrder response type.
+type MsgCancelDerivativeOrder struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	OrderHash    string `protobuf:"bytes,4,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	OrderMask    int32  `protobuf:"varint,5,opt,name=order_mask,json=orderMask,proto3" json:"order_mask,omitempty"`
+	Cid          string `protobuf:"bytes,6,opt,name=cid,proto3" json:"cid,omitempty"`
 }
 
-func (m *MsgLiquidatePositionResponse) Reset()         { *m = MsgLiquidatePositionResponse{} }
-func (m *MsgLiquidatePositionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgLiquidatePositionResponse) ProtoMessage()    {}
-func (*MsgLiquidatePositionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelDerivativeOrder) Reset()         { *m = MsgCancelDerivativeOrder{} }
+func (m *MsgCancelDerivativeOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDerivativeOrder) ProtoMessage()    {}
+func (*MsgCancelDerivativeOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{52}
 }
-func (m *MsgLiquidatePositionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelDerivativeOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgLiquidatePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgLiquidatePositionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelDerivativeOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2389,37 +2327,35 @@ func (m *MsgLiquidatePositionResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgLiquidatePositionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgLiquidatePositionResponse.Merge(m, src)
+func (m *MsgCancelDerivativeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDerivativeOrder.Merge(m, src)
 }
-func (m *MsgLiquidatePositionResponse) XXX_Size() int {
+func (m *MsgCancelDerivativeOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgLiquidatePositionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgLiquidatePositionResponse.DiscardUnknown(m)
+func (m *MsgCancelDerivativeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDerivativeOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgLiquidatePositionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelDerivativeOrder proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgEmergencySettleMarket
-type MsgEmergencySettleMarket struct {
-	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	MarketId     string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+// MsgCancelDerivativeOrderResponse defines the
+// Msg/CancelDerivativeOrderResponse response type.
+type MsgCancelDerivativeOrderResponse struct {
 }
 
-func (m *MsgEmergencySettleMarket) Reset()         { *m = MsgEmergencySettleMarket{} }
-func (m *MsgEmergencySettleMarket) String() string { return proto.CompactTextString(m) }
-func (*MsgEmergencySettleMarket) ProtoMessage()    {}
-func (*MsgEmergencySettleMarket) Descriptor() ([]byte, []int) {
+func (m *MsgCancelDerivativeOrderResponse) Reset()         { *m = MsgCancelDerivativeOrderResponse{} }
+func (m *MsgCancelDerivativeOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDerivativeOrderResponse) ProtoMessage()    {}
+func (*MsgCancelDerivativeOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{53}
 }
-func (m *MsgEmergencySettleMarket) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelDerivativeOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEmergencySettleMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelDerivativeOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEmergencySettleMarket.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelDerivativeOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2429,56 +2365,79 @@ func (m *MsgEmergencySettleMarket) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgEmergencySettleMarket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEmergencySettleMarket.Merge(m, src)
+func (m *MsgCancelDerivativeOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDerivativeOrderResponse.Merge(m, src)
 }
-func (m *MsgEmergencySettleMarket) XXX_Size() int {
+func (m *MsgCancelDerivativeOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEmergencySettleMarket) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEmergencySettleMarket.DiscardUnknown(m)
+func (m *MsgCancelDerivativeOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDerivativeOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEmergencySettleMarket proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelDerivativeOrderResponse proto.InternalMessageInfo
 
-func (m *MsgEmergencySettleMarket) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
+// MsgCancelBinaryOptionsOrder defines the Msg/CancelBinaryOptionsOrder response
+// type.
+type MsgCancelBinaryOptionsOrder struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	OrderHash    string `protobuf:"bytes,4,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	OrderMask    int32  `protobuf:"varint,5,opt,name=order_mask,json=orderMask,proto3" json:"order_mask,omitempty"`
+	Cid          string `protobuf:"bytes,6,opt,name=cid,proto3" json:"cid,omitempty"`
 }
 
-func (m *MsgEmergencySettleMarket) GetSubaccountId() string {
-	if m != nil {
-		return m.SubaccountId
-	}
-	return ""
+func (m *MsgCancelBinaryOptionsOrder) Reset()         { *m = MsgCancelBinaryOptionsOrder{} }
+func (m *MsgCancelBinaryOptionsOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBinaryOptionsOrder) ProtoMessage()    {}
+func (*MsgCancelBinaryOptionsOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{54}
 }
-
-func (m *MsgEmergencySettleMarket) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
+func (m *MsgCancelBinaryOptionsOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBinaryOptionsOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBinaryOptionsOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgCancelBinaryOptionsOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBinaryOptionsOrder.Merge(m, src)
+}
+func (m *MsgCancelBinaryOptionsOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBinaryOptionsOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBinaryOptionsOrder.DiscardUnknown(m)
 }
 
-// MsgEmergencySettleMarketResponse defines the Msg/EmergencySettleMarket
-// response type.
-type MsgEmergencySettleMarketResponse struct {
+var xxx_messageInfo_MsgCancelBinaryOptionsOrder proto.InternalMessageInfo
+
+// MsgCancelBinaryOptionsOrderResponse defines the
+// Msg/CancelBinaryOptionsOrderResponse response type.
+type MsgCancelBinaryOptionsOrderResponse struct {
 }
 
-func (m *MsgEmergencySettleMarketResponse) Reset()         { *m = MsgEmergencySettleMarketResponse{} }
-func (m *MsgEmergencySettleMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgEmergencySettleMarketResponse) ProtoMessage()    {}
-func (*MsgEmergencySettleMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{54}
+func (m *MsgCancelBinaryOptionsOrderResponse) Reset()         { *m = MsgCancelBinaryOptionsOrderResponse{} }
+func (m *MsgCancelBinaryOptionsOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBinaryOptionsOrderResponse) ProtoMessage()    {}
+func (*MsgCancelBinaryOptionsOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{55}
 }
-func (m *MsgEmergencySettleMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEmergencySettleMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEmergencySettleMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2488,40 +2447,38 @@ func (m *MsgEmergencySettleMarketResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgEmergencySettleMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEmergencySettleMarketResponse.Merge(m, src)
+func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse.Merge(m, src)
 }
-func (m *MsgEmergencySettleMarketResponse) XXX_Size() int {
+func (m *MsgCancelBinaryOptionsOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEmergencySettleMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEmergencySettleMarketResponse.DiscardUnknown(m)
+func (m *MsgCancelBinaryOptionsOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEmergencySettleMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelBinaryOptionsOrderResponse proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgIncreasePositionMargin
-type MsgIncreasePositionMargin struct {
-	Sender                  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	SourceSubaccountId      string `protobuf:"bytes,2,opt,name=source_subaccount_id,json=sourceSubaccountId,proto3" json:"source_subaccount_id,omitempty"`
-	DestinationSubaccountId string `protobuf:"bytes,3,opt,name=destination_subaccount_id,json=destinationSubaccountId,proto3" json:"destination_subaccount_id,omitempty"`
-	MarketId                string `protobuf:"bytes,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// amount defines the amount of margin to add to the position
-	Amount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount"`
+type OrderData struct {
+	MarketId     string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	OrderHash    string `protobuf:"bytes,3,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	OrderMask    int32  `protobuf:"varint,4,opt,name=order_mask,json=orderMask,proto3" json:"order_mask,omitempty"`
+	Cid          string `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`
 }
 
-func (m *MsgIncreasePositionMargin) Reset()         { *m = MsgIncreasePositionMargin{} }
-func (m *MsgIncreasePositionMargin) String() string { return proto.CompactTextString(m) }
-func (*MsgIncreasePositionMargin) ProtoMessage()    {}
-func (*MsgIncreasePositionMargin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{55}
+func (m *OrderData) Reset()         { *m = OrderData{} }
+func (m *OrderData) String() string { return proto.CompactTextString(m) }
+func (*OrderData) ProtoMessage()    {}
+func (*OrderData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{56}
 }
-func (m *MsgIncreasePositionMargin) XXX_Unmarshal(b []byte) error {
+func (m *OrderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgIncreasePositionMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OrderData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgIncreasePositionMargin.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OrderData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2531,63 +2488,72 @@ func (m *MsgIncreasePositionMargin) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgIncreasePositionMargin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgIncreasePositionMargin.Merge(m, src)
+func (m *OrderData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderData.Merge(m, src)
 }
-func (m *MsgIncreasePositionMargin) XXX_Size() int {
+func (m *OrderData) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgIncreasePositionMargin) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgIncreasePositionMargin.DiscardUnknown(m)
+func (m *OrderData) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgIncreasePositionMargin proto.InternalMessageInfo
+var xxx_messageInfo_OrderData proto.InternalMessageInfo
 
-func (m *MsgIncreasePositionMargin) GetSender() string {
+func (m *OrderData) GetMarketId() string {
 	if m != nil {
-		return m.Sender
+		return m.MarketId
 	}
 	return ""
 }
 
-func (m *MsgIncreasePositionMargin) GetSourceSubaccountId() string {
+func (m *OrderData) GetSubaccountId() string {
 	if m != nil {
-		return m.SourceSubaccountId
+		return m.SubaccountId
 	}
 	return ""
 }
 
-func (m *MsgIncreasePositionMargin) GetDestinationSubaccountId() string {
+func (m *OrderData) GetOrderHash() string {
 	if m != nil {
-		return m.DestinationSubaccountId
+		return m.OrderHash
 	}
 	return ""
 }
 
-func (m *MsgIncreasePositionMargin) GetMarketId() string {
+func (m *OrderData) GetOrderMask() int32 {
 	if m != nil {
-		return m.MarketId
+		return m.OrderMask
+	}
+	return 0
+}
+
+func (m *OrderData) GetCid() string {
+	if m != nil {
+		return m.Cid
 	}
 	return ""
 }
 
-// MsgIncreasePositionMarginResponse defines the Msg/IncreasePositionMargin
+// MsgBatchCancelDerivativeOrders defines the Msg/CancelDerivativeOrders
 // response type.
-type MsgIncreasePositionMarginResponse struct {
+type MsgBatchCancelDerivativeOrders struct {
+	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Data   []OrderData `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 }
 
-func (m *MsgIncreasePositionMarginResponse) Reset()         { *m = MsgIncreasePositionMarginResponse{} }
-func (m *MsgIncreasePositionMarginResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgIncreasePositionMarginResponse) ProtoMessage()    {}
-func (*MsgIncreasePositionMarginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{56}
+func (m *MsgBatchCancelDerivativeOrders) Reset()         { *m = MsgBatchCancelDerivativeOrders{} }
+func (m *MsgBatchCancelDerivativeOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelDerivativeOrders) ProtoMessage()    {}
+func (*MsgBatchCancelDerivativeOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{57}
 }
-func (m *MsgIncreasePositionMarginResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelDerivativeOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgIncreasePositionMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgIncreasePositionMarginResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelDerivativeOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2597,42 +2563,38 @@ func (m *MsgIncreasePositionMarginResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgIncreasePositionMarginResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgIncreasePositionMarginResponse.Merge(m, src)
+func (m *MsgBatchCancelDerivativeOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelDerivativeOrders.Merge(m, src)
 }
-func (m *MsgIncreasePositionMarginResponse) XXX_Size() int {
+func (m *MsgBatchCancelDerivativeOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgIncreasePositionMarginResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgIncreasePositionMarginResponse.DiscardUnknown(m)
+func (m *MsgBatchCancelDerivativeOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelDerivativeOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgIncreasePositionMarginResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelDerivativeOrders proto.InternalMessageInfo
 
-// MsgPrivilegedExecuteContract defines the Msg/Exec message type
-type MsgPrivilegedExecuteContract struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// funds defines the user's bank coins used to fund the execution (e.g.
-	// 100inj).
-	Funds string `protobuf:"bytes,2,opt,name=funds,proto3" json:"funds,omitempty"`
-	// contract_address defines the contract address to execute
-	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// data defines the call data used when executing the contract
-	Data string `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+// MsgBatchCancelDerivativeOrdersResponse defines the
+// Msg/CancelDerivativeOrderResponse response type.
+type MsgBatchCancelDerivativeOrdersResponse struct {
+	Success []bool `protobuf:"varint,1,rep,packed,name=success,proto3" json:"success,omitempty"`
 }
 
-func (m *MsgPrivilegedExecuteContract) Reset()         { *m = MsgPrivilegedExecuteContract{} }
-func (m *MsgPrivilegedExecuteContract) String() string { return proto.CompactTextString(m) }
-func (*MsgPrivilegedExecuteContract) ProtoMessage()    {}
-func (*MsgPrivilegedExecuteContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{57}
+func (m *MsgBatchCancelDerivativeOrdersResponse) Reset() {
+	*m = MsgBatchCancelDerivativeOrdersResponse{}
 }
-func (m *MsgPrivilegedExecuteContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelDerivativeOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelDerivativeOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchCancelDerivativeOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{58}
+}
+func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgPrivilegedExecuteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgPrivilegedExecuteContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2642,35 +2604,38 @@ func (m *MsgPrivilegedExecuteContract) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgPrivilegedExecuteContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgPrivilegedExecuteContract.Merge(m, src)
+func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse.Merge(m, src)
 }
-func (m *MsgPrivilegedExecuteContract) XXX_Size() int {
+func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgPrivilegedExecuteContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgPrivilegedExecuteContract.DiscardUnknown(m)
+func (m *MsgBatchCancelDerivativeOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgPrivilegedExecuteContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelDerivativeOrdersResponse proto.InternalMessageInfo
 
-// MsgPrivilegedExecuteContractResponse defines the Msg/Exec response type.
-type MsgPrivilegedExecuteContractResponse struct {
-	FundsDiff github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=funds_diff,json=fundsDiff,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds_diff"`
+// A Cosmos-SDK MsgSubaccountTransfer
+type MsgSubaccountTransfer struct {
+	Sender                  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SourceSubaccountId      string     `protobuf:"bytes,2,opt,name=source_subaccount_id,json=sourceSubaccountId,proto3" json:"source_subaccount_id,omitempty"`
+	DestinationSubaccountId string     `protobuf:"bytes,3,opt,name=destination_subaccount_id,json=destinationSubaccountId,proto3" json:"destination_subaccount_id,omitempty"`
+	Amount                  types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgPrivilegedExecuteContractResponse) Reset()         { *m = MsgPrivilegedExecuteContractResponse{} }
-func (m *MsgPrivilegedExecuteContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgPrivilegedExecuteContractResponse) ProtoMessage()    {}
-func (*MsgPrivilegedExecuteContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{58}
+func (m *MsgSubaccountTransfer) Reset()         { *m = MsgSubaccountTransfer{} }
+func (m *MsgSubaccountTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgSubaccountTransfer) ProtoMessage()    {}
+func (*MsgSubaccountTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{59}
 }
-func (m *MsgPrivilegedExecuteContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubaccountTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgPrivilegedExecuteContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubaccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgPrivilegedExecuteContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubaccountTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2680,79 +2645,63 @@ func (m *MsgPrivilegedExecuteContractResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgPrivilegedExecuteContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgPrivilegedExecuteContractResponse.Merge(m, src)
+func (m *MsgSubaccountTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubaccountTransfer.Merge(m, src)
 }
-func (m *MsgPrivilegedExecuteContractResponse) XXX_Size() int {
+func (m *MsgSubaccountTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgPrivilegedExecuteContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgPrivilegedExecuteContractResponse.DiscardUnknown(m)
+func (m *MsgSubaccountTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubaccountTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgPrivilegedExecuteContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubaccountTransfer proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgRewardsOptOut
-type MsgRewardsOptOut struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+func (m *MsgSubaccountTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgRewardsOptOut) Reset()         { *m = MsgRewardsOptOut{} }
-func (m *MsgRewardsOptOut) String() string { return proto.CompactTextString(m) }
-func (*MsgRewardsOptOut) ProtoMessage()    {}
-func (*MsgRewardsOptOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{59}
-}
-func (m *MsgRewardsOptOut) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgRewardsOptOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgRewardsOptOut.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MsgSubaccountTransfer) GetSourceSubaccountId() string {
+	if m != nil {
+		return m.SourceSubaccountId
 	}
+	return ""
 }
-func (m *MsgRewardsOptOut) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRewardsOptOut.Merge(m, src)
-}
-func (m *MsgRewardsOptOut) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgRewardsOptOut) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRewardsOptOut.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgRewardsOptOut proto.InternalMessageInfo
 
-func (m *MsgRewardsOptOut) GetSender() string {
+func (m *MsgSubaccountTransfer) GetDestinationSubaccountId() string {
 	if m != nil {
-		return m.Sender
+		return m.DestinationSubaccountId
 	}
 	return ""
 }
 
-// MsgRewardsOptOutResponse defines the Msg/RewardsOptOut response type.
-type MsgRewardsOptOutResponse struct {
+func (m *MsgSubaccountTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgRewardsOptOutResponse) Reset()         { *m = MsgRewardsOptOutResponse{} }
-func (m *MsgRewardsOptOutResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRewardsOptOutResponse) ProtoMessage()    {}
-func (*MsgRewardsOptOutResponse) Descriptor() ([]byte, []int) {
+// MsgSubaccountTransferResponse defines the Msg/SubaccountTransfer response
+// type.
+type MsgSubaccountTransferResponse struct {
+}
+
+func (m *MsgSubaccountTransferResponse) Reset()         { *m = MsgSubaccountTransferResponse{} }
+func (m *MsgSubaccountTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubaccountTransferResponse) ProtoMessage()    {}
+func (*MsgSubaccountTransferResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{60}
 }
-func (m *MsgRewardsOptOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubaccountTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRewardsOptOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubaccountTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRewardsOptOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubaccountTransferResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2762,37 +2711,38 @@ func (m *MsgRewardsOptOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgRewardsOptOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRewardsOptOutResponse.Merge(m, src)
+func (m *MsgSubaccountTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubaccountTransferResponse.Merge(m, src)
 }
-func (m *MsgRewardsOptOutResponse) XXX_Size() int {
+func (m *MsgSubaccountTransferResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRewardsOptOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRewardsOptOutResponse.DiscardUnknown(m)
+func (m *MsgSubaccountTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubaccountTransferResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRewardsOptOutResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubaccountTransferResponse proto.InternalMessageInfo
 
-// A Cosmos-SDK MsgReclaimLockedFunds
-type MsgReclaimLockedFunds struct {
-	Sender              string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	LockedAccountPubKey []byte `protobuf:"bytes,2,opt,name=lockedAccountPubKey,proto3" json:"lockedAccountPubKey,omitempty"`
-	Signature           []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+// A Cosmos-SDK MsgExternalTransfer
+type MsgExternalTransfer struct {
+	Sender                  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SourceSubaccountId      string     `protobuf:"bytes,2,opt,name=source_subaccount_id,json=sourceSubaccountId,proto3" json:"source_subaccount_id,omitempty"`
+	DestinationSubaccountId string     `protobuf:"bytes,3,opt,name=destination_subaccount_id,json=destinationSubaccountId,proto3" json:"destination_subaccount_id,omitempty"`
+	Amount                  types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgReclaimLockedFunds) Reset()         { *m = MsgReclaimLockedFunds{} }
-func (m *MsgReclaimLockedFunds) String() string { return proto.CompactTextString(m) }
-func (*MsgReclaimLockedFunds) ProtoMessage()    {}
-func (*MsgReclaimLockedFunds) Descriptor() ([]byte, []int) {
+func (m *MsgExternalTransfer) Reset()         { *m = MsgExternalTransfer{} }
+func (m *MsgExternalTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgExternalTransfer) ProtoMessage()    {}
+func (*MsgExternalTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{61}
 }
-func (m *MsgReclaimLockedFunds) XXX_Unmarshal(b []byte) error {
+func (m *MsgExternalTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReclaimLockedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExternalTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReclaimLockedFunds.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExternalTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2802,56 +2752,62 @@ func (m *MsgReclaimLockedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgReclaimLockedFunds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReclaimLockedFunds.Merge(m, src)
+func (m *MsgExternalTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExternalTransfer.Merge(m, src)
 }
-func (m *MsgReclaimLockedFunds) XXX_Size() int {
+func (m *MsgExternalTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReclaimLockedFunds) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReclaimLockedFunds.DiscardUnknown(m)
+func (m *MsgExternalTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExternalTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReclaimLockedFunds proto.InternalMessageInfo
+var xxx_messageInfo_MsgExternalTransfer proto.InternalMessageInfo
 
-func (m *MsgReclaimLockedFunds) GetSender() string {
+func (m *MsgExternalTransfer) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgReclaimLockedFunds) GetLockedAccountPubKey() []byte {
+func (m *MsgExternalTransfer) GetSourceSubaccountId() string {
 	if m != nil {
-		return m.LockedAccountPubKey
+		return m.SourceSubaccountId
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgReclaimLockedFunds) GetSignature() []byte {
+func (m *MsgExternalTransfer) GetDestinationSubaccountId() string {
 	if m != nil {
-		return m.Signature
+		return m.DestinationSubaccountId
 	}
-	return nil
+	return ""
 }
 
-// MsgReclaimLockedFundsResponse defines the Msg/ReclaimLockedFunds response
-// type.
-type MsgReclaimLockedFundsResponse struct {
+func (m *MsgExternalTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgReclaimLockedFundsResponse) Reset()         { *m = MsgReclaimLockedFundsResponse{} }
-func (m *MsgReclaimLockedFundsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgReclaimLockedFundsResponse) ProtoMessage()    {}
-func (*MsgReclaimLockedFundsResponse) Descriptor() ([]byte, []int) {
+// MsgExternalTransferResponse defines the Msg/ExternalTransfer response type.
+type MsgExternalTransferResponse struct {
+}
+
+func (m *MsgExternalTransferResponse) Reset()         { *m = MsgExternalTransferResponse{} }
+func (m *MsgExternalTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExternalTransferResponse) ProtoMessage()    {}
+func (*MsgExternalTransferResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{62}
 }
-func (m *MsgReclaimLockedFundsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgExternalTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReclaimLockedFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExternalTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReclaimLockedFundsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExternalTransferResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2861,39 +2817,39 @@ func (m *MsgReclaimLockedFundsResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgReclaimLockedFundsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReclaimLockedFundsResponse.Merge(m, src)
+func (m *MsgExternalTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExternalTransferResponse.Merge(m, src)
 }
-func (m *MsgReclaimLockedFundsResponse) XXX_Size() int {
+func (m *MsgExternalTransferResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReclaimLockedFundsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReclaimLockedFundsResponse.DiscardUnknown(m)
+func (m *MsgExternalTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExternalTransferResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReclaimLockedFundsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgExternalTransferResponse proto.InternalMessageInfo
 
-// MsgSignData defines an arbitrary, general-purpose, off-chain message
-type MsgSignData struct {
-	// Signer is the sdk.AccAddress of the message signer
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=Signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer"`
-	// Data represents the raw bytes of the content that is signed (text, json,
-	// etc)
-	Data []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"data"`
+// A Cosmos-SDK MsgLiquidatePosition
+type MsgLiquidatePosition struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	MarketId     string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// optional order to provide for liquidation
+	Order *DerivativeOrder `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
 }
 
-func (m *MsgSignData) Reset()         { *m = MsgSignData{} }
-func (m *MsgSignData) String() string { return proto.CompactTextString(m) }
-func (*MsgSignData) ProtoMessage()    {}
-func (*MsgSignData) Descriptor() ([]byte, []int) {
+func (m *MsgLiquidatePosition) Reset()         { *m = MsgLiquidatePosition{} }
+func (m *MsgLiquidatePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidatePosition) ProtoMessage()    {}
+func (*MsgLiquidatePosition) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bd45b74cb6d81462, []int{63}
 }
-func (m *MsgSignData) XXX_Unmarshal(b []byte) error {
+func (m *MsgLiquidatePosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSignData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgLiquidatePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSignData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgLiquidatePosition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2903,50 +2859,62 @@ func (m *MsgSignData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgSignData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSignData.Merge(m, src)
+func (m *MsgLiquidatePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidatePosition.Merge(m, src)
 }
-func (m *MsgSignData) XXX_Size() int {
+func (m *MsgLiquidatePosition) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSignData) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSignData.DiscardUnknown(m)
+func (m *MsgLiquidatePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidatePosition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSignData proto.InternalMessageInfo
+var xxx_messageInfo_MsgLiquidatePosition proto.InternalMessageInfo
 
-func (m *MsgSignData) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgLiquidatePosition) GetSender() string {
 	if m != nil {
-		return m.Signer
+		return m.Sender
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgSignData) GetData() []byte {
+func (m *MsgLiquidatePosition) GetSubaccountId() string {
 	if m != nil {
-		return m.Data
+		return m.SubaccountId
 	}
-	return nil
+	return ""
 }
 
-// MsgSignDoc defines an arbitrary, general-purpose, off-chain message
-type MsgSignDoc struct {
-	SignType string      `protobuf:"bytes,1,opt,name=sign_type,json=signType,proto3" json:"type"`
-	Value    MsgSignData `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+func (m *MsgLiquidatePosition) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
 }
 
-func (m *MsgSignDoc) Reset()         { *m = MsgSignDoc{} }
-func (m *MsgSignDoc) String() string { return proto.CompactTextString(m) }
-func (*MsgSignDoc) ProtoMessage()    {}
-func (*MsgSignDoc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{64}
+func (m *MsgLiquidatePosition) GetOrder() *DerivativeOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
 }
-func (m *MsgSignDoc) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+// MsgLiquidatePositionResponse defines the Msg/LiquidatePosition response type.
+type MsgLiquidatePositionResponse struct {
 }
-func (m *MsgSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *MsgLiquidatePositionResponse) Reset()         { *m = MsgLiquidatePositionResponse{} }
+func (m *MsgLiquidatePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidatePositionResponse) ProtoMessage()    {}
+func (*MsgLiquidatePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{64}
+}
+func (m *MsgLiquidatePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidatePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSignDoc.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgLiquidatePositionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2956,59 +2924,96 @@ func (m *MsgSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgSignDoc) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSignDoc.Merge(m, src)
+func (m *MsgLiquidatePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidatePositionResponse.Merge(m, src)
 }
-func (m *MsgSignDoc) XXX_Size() int {
+func (m *MsgLiquidatePositionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSignDoc) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSignDoc.DiscardUnknown(m)
+func (m *MsgLiquidatePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidatePositionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSignDoc proto.InternalMessageInfo
+var xxx_messageInfo_MsgLiquidatePositionResponse proto.InternalMessageInfo
 
-func (m *MsgSignDoc) GetSignType() string {
+// A Cosmos-SDK MsgEmergencySettleMarket
+type MsgEmergencySettleMarket struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	MarketId     string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *MsgEmergencySettleMarket) Reset()         { *m = MsgEmergencySettleMarket{} }
+func (m *MsgEmergencySettleMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencySettleMarket) ProtoMessage()    {}
+func (*MsgEmergencySettleMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{65}
+}
+func (m *MsgEmergencySettleMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencySettleMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencySettleMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencySettleMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencySettleMarket.Merge(m, src)
+}
+func (m *MsgEmergencySettleMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencySettleMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencySettleMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencySettleMarket proto.InternalMessageInfo
+
+func (m *MsgEmergencySettleMarket) GetSender() string {
 	if m != nil {
-		return m.SignType
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgSignDoc) GetValue() MsgSignData {
+func (m *MsgEmergencySettleMarket) GetSubaccountId() string {
 	if m != nil {
-		return m.Value
+		return m.SubaccountId
 	}
-	return MsgSignData{}
+	return ""
 }
 
-// MsgAdminUpdateBinaryOptionsMarket is used by the market Admin to operate the
-// market
-type MsgAdminUpdateBinaryOptionsMarket struct {
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// new price at which market will be settled
-	SettlementPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price,omitempty"`
-	// expiration timestamp
-	ExpirationTimestamp int64 `protobuf:"varint,4,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
-	// expiration timestamp
-	SettlementTimestamp int64 `protobuf:"varint,5,opt,name=settlement_timestamp,json=settlementTimestamp,proto3" json:"settlement_timestamp,omitempty"`
-	// Status of the market
-	Status MarketStatus `protobuf:"varint,6,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+func (m *MsgEmergencySettleMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) Reset()         { *m = MsgAdminUpdateBinaryOptionsMarket{} }
-func (m *MsgAdminUpdateBinaryOptionsMarket) String() string { return proto.CompactTextString(m) }
-func (*MsgAdminUpdateBinaryOptionsMarket) ProtoMessage()    {}
-func (*MsgAdminUpdateBinaryOptionsMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{65}
+// MsgEmergencySettleMarketResponse defines the Msg/EmergencySettleMarket
+// response type.
+type MsgEmergencySettleMarketResponse struct {
 }
-func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgEmergencySettleMarketResponse) Reset()         { *m = MsgEmergencySettleMarketResponse{} }
+func (m *MsgEmergencySettleMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencySettleMarketResponse) ProtoMessage()    {}
+func (*MsgEmergencySettleMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{66}
+}
+func (m *MsgEmergencySettleMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEmergencySettleMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEmergencySettleMarketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3018,74 +3023,106 @@ func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket.Merge(m, src)
+func (m *MsgEmergencySettleMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencySettleMarketResponse.Merge(m, src)
 }
-func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Size() int {
+func (m *MsgEmergencySettleMarketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket.DiscardUnknown(m)
+func (m *MsgEmergencySettleMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencySettleMarketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket proto.InternalMessageInfo
+var xxx_messageInfo_MsgEmergencySettleMarketResponse proto.InternalMessageInfo
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) GetSender() string {
-	if m != nil {
-		return m.Sender
+// A Cosmos-SDK MsgIncreasePositionMargin
+type MsgIncreasePositionMargin struct {
+	Sender                  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SourceSubaccountId      string `protobuf:"bytes,2,opt,name=source_subaccount_id,json=sourceSubaccountId,proto3" json:"source_subaccount_id,omitempty"`
+	DestinationSubaccountId string `protobuf:"bytes,3,opt,name=destination_subaccount_id,json=destinationSubaccountId,proto3" json:"destination_subaccount_id,omitempty"`
+	MarketId                string `protobuf:"bytes,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount defines the amount of margin to add to the position
+	Amount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount"`
+}
+
+func (m *MsgIncreasePositionMargin) Reset()         { *m = MsgIncreasePositionMargin{} }
+func (m *MsgIncreasePositionMargin) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreasePositionMargin) ProtoMessage()    {}
+func (*MsgIncreasePositionMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{67}
+}
+func (m *MsgIncreasePositionMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreasePositionMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreasePositionMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgIncreasePositionMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreasePositionMargin.Merge(m, src)
+}
+func (m *MsgIncreasePositionMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreasePositionMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreasePositionMargin.DiscardUnknown(m)
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) GetMarketId() string {
+var xxx_messageInfo_MsgIncreasePositionMargin proto.InternalMessageInfo
+
+func (m *MsgIncreasePositionMargin) GetSender() string {
 	if m != nil {
-		return m.MarketId
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) GetExpirationTimestamp() int64 {
+func (m *MsgIncreasePositionMargin) GetSourceSubaccountId() string {
 	if m != nil {
-		return m.ExpirationTimestamp
+		return m.SourceSubaccountId
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) GetSettlementTimestamp() int64 {
+func (m *MsgIncreasePositionMargin) GetDestinationSubaccountId() string {
 	if m != nil {
-		return m.SettlementTimestamp
+		return m.DestinationSubaccountId
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) GetStatus() MarketStatus {
+func (m *MsgIncreasePositionMargin) GetMarketId() string {
 	if m != nil {
-		return m.Status
+		return m.MarketId
 	}
-	return MarketStatus_Unspecified
+	return ""
 }
 
-// MsgAdminUpdateBinaryOptionsMarketResponse is the response for
-// AdminUpdateBinaryOptionsMarket rpc method
-type MsgAdminUpdateBinaryOptionsMarketResponse struct {
+// MsgIncreasePositionMarginResponse defines the Msg/IncreasePositionMargin
+// response type.
+type MsgIncreasePositionMarginResponse struct {
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) Reset() {
-	*m = MsgAdminUpdateBinaryOptionsMarketResponse{}
-}
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgAdminUpdateBinaryOptionsMarketResponse) ProtoMessage() {}
-func (*MsgAdminUpdateBinaryOptionsMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd45b74cb6d81462, []int{66}
+func (m *MsgIncreasePositionMarginResponse) Reset()         { *m = MsgIncreasePositionMarginResponse{} }
+func (m *MsgIncreasePositionMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreasePositionMarginResponse) ProtoMessage()    {}
+func (*MsgIncreasePositionMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{68}
 }
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgIncreasePositionMarginResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgIncreasePositionMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgIncreasePositionMarginResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3095,2535 +3132,2329 @@ func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse.Merge(m, src)
+func (m *MsgIncreasePositionMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreasePositionMarginResponse.Merge(m, src)
 }
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Size() int {
+func (m *MsgIncreasePositionMarginResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse.DiscardUnknown(m)
+func (m *MsgIncreasePositionMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreasePositionMarginResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgIncreasePositionMarginResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "injective.exchange.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.exchange.v1beta1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgDeposit)(nil), "injective.exchange.v1beta1.MsgDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "injective.exchange.v1beta1.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "injective.exchange.v1beta1.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "injective.exchange.v1beta1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgCreateSpotLimitOrder)(nil), "injective.exchange.v1beta1.MsgCreateSpotLimitOrder")
-	proto.RegisterType((*MsgCreateSpotLimitOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateSpotLimitOrderResponse")
-	proto.RegisterType((*MsgBatchCreateSpotLimitOrders)(nil), "injective.exchange.v1beta1.MsgBatchCreateSpotLimitOrders")
-	proto.RegisterType((*MsgBatchCreateSpotLimitOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCreateSpotLimitOrdersResponse")
-	proto.RegisterType((*MsgInstantSpotMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantSpotMarketLaunch")
-	proto.RegisterType((*MsgInstantSpotMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantSpotMarketLaunchResponse")
-	proto.RegisterType((*MsgInstantPerpetualMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantPerpetualMarketLaunch")
-	proto.RegisterType((*MsgInstantPerpetualMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantPerpetualMarketLaunchResponse")
-	proto.RegisterType((*MsgInstantBinaryOptionsMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantBinaryOptionsMarketLaunch")
-	proto.RegisterType((*MsgInstantBinaryOptionsMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantBinaryOptionsMarketLaunchResponse")
-	proto.RegisterType((*MsgInstantExpiryFuturesMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantExpiryFuturesMarketLaunch")
-	proto.RegisterType((*MsgInstantExpiryFuturesMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantExpiryFuturesMarketLaunchResponse")
-	proto.RegisterType((*MsgCreateSpotMarketOrder)(nil), "injective.exchange.v1beta1.MsgCreateSpotMarketOrder")
-	proto.RegisterType((*MsgCreateSpotMarketOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateSpotMarketOrderResponse")
-	proto.RegisterType((*SpotMarketOrderResults)(nil), "injective.exchange.v1beta1.SpotMarketOrderResults")
-	proto.RegisterType((*MsgCreateDerivativeLimitOrder)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeLimitOrder")
-	proto.RegisterType((*MsgCreateDerivativeLimitOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeLimitOrderResponse")
-	proto.RegisterType((*MsgCreateBinaryOptionsLimitOrder)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsLimitOrder")
-	proto.RegisterType((*MsgCreateBinaryOptionsLimitOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsLimitOrderResponse")
-	proto.RegisterType((*MsgBatchCreateDerivativeLimitOrders)(nil), "injective.exchange.v1beta1.MsgBatchCreateDerivativeLimitOrders")
-	proto.RegisterType((*MsgBatchCreateDerivativeLimitOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCreateDerivativeLimitOrdersResponse")
-	proto.RegisterType((*MsgCancelSpotOrder)(nil), "injective.exchange.v1beta1.MsgCancelSpotOrder")
-	proto.RegisterType((*MsgCancelSpotOrderResponse)(nil), "injective.exchange.v1beta1.MsgCancelSpotOrderResponse")
-	proto.RegisterType((*MsgBatchCancelSpotOrders)(nil), "injective.exchange.v1beta1.MsgBatchCancelSpotOrders")
-	proto.RegisterType((*MsgBatchCancelSpotOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCancelSpotOrdersResponse")
-	proto.RegisterType((*MsgBatchCancelBinaryOptionsOrders)(nil), "injective.exchange.v1beta1.MsgBatchCancelBinaryOptionsOrders")
-	proto.RegisterType((*MsgBatchCancelBinaryOptionsOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCancelBinaryOptionsOrdersResponse")
-	proto.RegisterType((*MsgBatchUpdateOrders)(nil), "injective.exchange.v1beta1.MsgBatchUpdateOrders")
-	proto.RegisterType((*MsgBatchUpdateOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchUpdateOrdersResponse")
-	proto.RegisterType((*MsgCreateDerivativeMarketOrder)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeMarketOrder")
-	proto.RegisterType((*MsgCreateDerivativeMarketOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeMarketOrderResponse")
-	proto.RegisterType((*DerivativeMarketOrderResults)(nil), "injective.exchange.v1beta1.DerivativeMarketOrderResults")
-	proto.RegisterType((*MsgCreateBinaryOptionsMarketOrder)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsMarketOrder")
-	proto.RegisterType((*MsgCreateBinaryOptionsMarketOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsMarketOrderResponse")
-	proto.RegisterType((*MsgCancelDerivativeOrder)(nil), "injective.exchange.v1beta1.MsgCancelDerivativeOrder")
-	proto.RegisterType((*MsgCancelDerivativeOrderResponse)(nil), "injective.exchange.v1beta1.MsgCancelDerivativeOrderResponse")
-	proto.RegisterType((*MsgCancelBinaryOptionsOrder)(nil), "injective.exchange.v1beta1.MsgCancelBinaryOptionsOrder")
-	proto.RegisterType((*MsgCancelBinaryOptionsOrderResponse)(nil), "injective.exchange.v1beta1.MsgCancelBinaryOptionsOrderResponse")
-	proto.RegisterType((*OrderData)(nil), "injective.exchange.v1beta1.OrderData")
-	proto.RegisterType((*MsgBatchCancelDerivativeOrders)(nil), "injective.exchange.v1beta1.MsgBatchCancelDerivativeOrders")
-	proto.RegisterType((*MsgBatchCancelDerivativeOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCancelDerivativeOrdersResponse")
-	proto.RegisterType((*MsgSubaccountTransfer)(nil), "injective.exchange.v1beta1.MsgSubaccountTransfer")
-	proto.RegisterType((*MsgSubaccountTransferResponse)(nil), "injective.exchange.v1beta1.MsgSubaccountTransferResponse")
-	proto.RegisterType((*MsgExternalTransfer)(nil), "injective.exchange.v1beta1.MsgExternalTransfer")
-	proto.RegisterType((*MsgExternalTransferResponse)(nil), "injective.exchange.v1beta1.MsgExternalTransferResponse")
-	proto.RegisterType((*MsgLiquidatePosition)(nil), "injective.exchange.v1beta1.MsgLiquidatePosition")
-	proto.RegisterType((*MsgLiquidatePositionResponse)(nil), "injective.exchange.v1beta1.MsgLiquidatePositionResponse")
-	proto.RegisterType((*MsgEmergencySettleMarket)(nil), "injective.exchange.v1beta1.MsgEmergencySettleMarket")
-	proto.RegisterType((*MsgEmergencySettleMarketResponse)(nil), "injective.exchange.v1beta1.MsgEmergencySettleMarketResponse")
-	proto.RegisterType((*MsgIncreasePositionMargin)(nil), "injective.exchange.v1beta1.MsgIncreasePositionMargin")
-	proto.RegisterType((*MsgIncreasePositionMarginResponse)(nil), "injective.exchange.v1beta1.MsgIncreasePositionMarginResponse")
-	proto.RegisterType((*MsgPrivilegedExecuteContract)(nil), "injective.exchange.v1beta1.MsgPrivilegedExecuteContract")
-	proto.RegisterType((*MsgPrivilegedExecuteContractResponse)(nil), "injective.exchange.v1beta1.MsgPrivilegedExecuteContractResponse")
-	proto.RegisterType((*MsgRewardsOptOut)(nil), "injective.exchange.v1beta1.MsgRewardsOptOut")
-	proto.RegisterType((*MsgRewardsOptOutResponse)(nil), "injective.exchange.v1beta1.MsgRewardsOptOutResponse")
-	proto.RegisterType((*MsgReclaimLockedFunds)(nil), "injective.exchange.v1beta1.MsgReclaimLockedFunds")
-	proto.RegisterType((*MsgReclaimLockedFundsResponse)(nil), "injective.exchange.v1beta1.MsgReclaimLockedFundsResponse")
-	proto.RegisterType((*MsgSignData)(nil), "injective.exchange.v1beta1.MsgSignData")
-	proto.RegisterType((*MsgSignDoc)(nil), "injective.exchange.v1beta1.MsgSignDoc")
-	proto.RegisterType((*MsgAdminUpdateBinaryOptionsMarket)(nil), "injective.exchange.v1beta1.MsgAdminUpdateBinaryOptionsMarket")
-	proto.RegisterType((*MsgAdminUpdateBinaryOptionsMarketResponse)(nil), "injective.exchange.v1beta1.MsgAdminUpdateBinaryOptionsMarketResponse")
+// MsgPrivilegedExecuteContract defines the Msg/Exec message type
+type MsgPrivilegedExecuteContract struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// funds defines the user's bank coins used to fund the execution (e.g.
+	// 100inj).
+	Funds string `protobuf:"bytes,2,opt,name=funds,proto3" json:"funds,omitempty"`
+	// contract_address defines the contract address to execute
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// data defines the call data used when executing the contract
+	Data string `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("injective/exchange/v1beta1/tx.proto", fileDescriptor_bd45b74cb6d81462)
+func (m *MsgPrivilegedExecuteContract) Reset()         { *m = MsgPrivilegedExecuteContract{} }
+func (m *MsgPrivilegedExecuteContract) String() string { return proto.CompactTextString(m) }
+func (*MsgPrivilegedExecuteContract) ProtoMessage()    {}
+func (*MsgPrivilegedExecuteContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{69}
 }
-
-var fileDescriptor_bd45b74cb6d81462 = []byte{
-	// 3167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1c, 0xdf, 0x6f, 0x1c, 0x47,
-	0x39, 0xeb, 0x1f, 0x67, 0xdf, 0x67, 0x3b, 0x4e, 0xd6, 0x8e, 0x73, 0xb9, 0x24, 0x76, 0x6a, 0x37,
-	0x8d, 0xd3, 0x10, 0xbb, 0x49, 0x43, 0xda, 0xa6, 0x84, 0xc4, 0x3f, 0xe2, 0x92, 0x36, 0x26, 0xee,
-	0x9e, 0xf9, 0x55, 0x09, 0x8e, 0xf1, 0xee, 0xf8, 0xbc, 0xf5, 0xdd, 0xee, 0x65, 0x67, 0xce, 0x8d,
-	0x2b, 0x24, 0xa0, 0xe2, 0xa1, 0x94, 0x1f, 0xa2, 0x50, 0x54, 0x28, 0x14, 0x2a, 0x21, 0x81, 0x04,
-	0x08, 0xf5, 0x81, 0x47, 0x9e, 0x51, 0x1f, 0x2b, 0x24, 0xa4, 0x8a, 0x87, 0x00, 0x8d, 0x10, 0x55,
-	0xff, 0x00, 0x1e, 0xfa, 0x80, 0xd0, 0xce, 0xcc, 0xce, 0xed, 0xee, 0xed, 0xaf, 0x3b, 0xd7, 0x49,
-	0xc8, 0x53, 0x6e, 0x67, 0xbe, 0xef, 0x9b, 0xef, 0xf7, 0xcc, 0xf7, 0xcd, 0xc4, 0x30, 0x65, 0x5a,
-	0xcf, 0x63, 0x9d, 0x9a, 0x5b, 0x78, 0x16, 0xdf, 0xd4, 0x37, 0x90, 0x55, 0xc1, 0xb3, 0x5b, 0x67,
-	0xd6, 0x30, 0x45, 0x67, 0x66, 0xe9, 0xcd, 0x99, 0xba, 0x63, 0x53, 0x5b, 0x2d, 0x4a, 0xa0, 0x19,
-	0x0f, 0x68, 0x46, 0x00, 0x15, 0xc7, 0x75, 0x9b, 0xd4, 0x6c, 0x32, 0xbb, 0x86, 0x48, 0x13, 0x53,
-	0xb7, 0x4d, 0x8b, 0xe3, 0x16, 0x67, 0xc4, 0xbc, 0x61, 0x12, 0xea, 0x98, 0x6b, 0x0d, 0x6a, 0xda,
-	0x96, 0x84, 0xf3, 0x0f, 0x0a, 0xf8, 0x83, 0x02, 0xbe, 0x46, 0x2a, 0xb3, 0x5b, 0x67, 0xdc, 0x7f,
-	0xc4, 0xc4, 0x21, 0x3e, 0x51, 0x66, 0x5f, 0xb3, 0xfc, 0x43, 0x4c, 0x8d, 0x56, 0xec, 0x8a, 0xcd,
-	0xc7, 0xdd, 0x5f, 0x62, 0xf4, 0x64, 0x82, 0x68, 0x52, 0x0c, 0x0e, 0x7a, 0xbc, 0x09, 0x6a, 0x3b,
-	0x48, 0xaf, 0x36, 0x01, 0xf9, 0x27, 0x07, 0x9b, 0xfc, 0x99, 0x02, 0xc3, 0xcb, 0xa4, 0xf2, 0xb9,
-	0xba, 0x81, 0x28, 0x5e, 0x41, 0x0e, 0xaa, 0x11, 0xf5, 0x3c, 0xe4, 0x51, 0x83, 0x6e, 0xd8, 0x8e,
-	0x49, 0xb7, 0x0b, 0xca, 0x31, 0x65, 0x3a, 0x3f, 0x5f, 0xf8, 0xcb, 0x1f, 0x4f, 0x8f, 0x0a, 0x06,
-	0xe7, 0x0c, 0xc3, 0xc1, 0x84, 0x94, 0xa8, 0x63, 0x5a, 0x15, 0xad, 0x09, 0xaa, 0x5e, 0x86, 0x5c,
-	0x9d, 0x51, 0x28, 0x74, 0x1d, 0x53, 0xa6, 0x07, 0xce, 0x4e, 0xce, 0xc4, 0x2b, 0x79, 0x86, 0xaf,
-	0x35, 0xdf, 0xf3, 0xce, 0xad, 0x89, 0x3d, 0x9a, 0xc0, 0xbb, 0xb0, 0xf7, 0xa5, 0x7f, 0xbf, 0xfd,
-	0x70, 0x93, 0xe2, 0xe4, 0x21, 0x38, 0x18, 0x62, 0x4e, 0xc3, 0xa4, 0x6e, 0x5b, 0x04, 0x4f, 0xbe,
-	0xae, 0x00, 0x2c, 0x93, 0xca, 0x22, 0xae, 0xdb, 0xc4, 0xa4, 0xea, 0x18, 0xe4, 0x08, 0xb6, 0x0c,
-	0xec, 0x70, 0x86, 0x35, 0xf1, 0xa5, 0x4e, 0xc1, 0x10, 0x69, 0xac, 0x21, 0x5d, 0xb7, 0x1b, 0x16,
-	0x2d, 0x9b, 0x06, 0x63, 0x2d, 0xaf, 0x0d, 0x36, 0x07, 0xaf, 0x1a, 0xea, 0x63, 0x90, 0x43, 0x35,
-	0xf7, 0x77, 0xa1, 0x9b, 0x31, 0x7e, 0x48, 0x58, 0x78, 0xc6, 0xf5, 0x00, 0xc9, 0xf1, 0x82, 0x6d,
-	0x5a, 0x1e, 0xbf, 0x1c, 0xfc, 0xc2, 0xc8, 0xcb, 0x6f, 0x4d, 0xec, 0xf9, 0xe0, 0xad, 0x89, 0x3d,
-	0x2e, 0xdf, 0x62, 0xc9, 0xc9, 0x51, 0x50, 0x9b, 0x8c, 0x49, 0x7e, 0x7f, 0xa2, 0xc0, 0xc0, 0x32,
-	0xa9, 0x7c, 0xc1, 0xa4, 0x1b, 0x86, 0x83, 0x5e, 0xb8, 0x97, 0x18, 0x3e, 0x00, 0x23, 0x3e, 0xce,
-	0x24, 0xc7, 0xdf, 0x56, 0x98, 0xf6, 0x17, 0x1c, 0x8c, 0x28, 0x2e, 0xd5, 0x6d, 0x7a, 0xcd, 0xac,
-	0x99, 0xf4, 0xba, 0xe3, 0x72, 0x19, 0xc7, 0xfd, 0x1c, 0xf4, 0xda, 0x2e, 0x80, 0xf0, 0x80, 0xe3,
-	0x49, 0x1e, 0xe0, 0x92, 0x64, 0xd4, 0x04, 0x8f, 0x1c, 0x33, 0x9a, 0xc5, 0xa7, 0x61, 0x22, 0x86,
-	0x15, 0x8f, 0x5d, 0xf5, 0x28, 0x00, 0x23, 0x50, 0xde, 0x40, 0x64, 0x43, 0xb0, 0x95, 0x67, 0x23,
-	0x9f, 0x41, 0x64, 0xe3, 0x42, 0xbf, 0x47, 0x76, 0xf2, 0x55, 0x05, 0x8e, 0x2e, 0x93, 0xca, 0x3c,
-	0xa2, 0xfa, 0x46, 0x14, 0x45, 0x12, 0x2b, 0xdd, 0x02, 0xe4, 0x18, 0x41, 0xd7, 0xc1, 0xbb, 0xdb,
-	0x15, 0x4f, 0xa0, 0x46, 0xcb, 0xb7, 0x0a, 0xc7, 0x13, 0x59, 0x92, 0x52, 0x3e, 0x00, 0x83, 0x4d,
-	0x29, 0x31, 0x29, 0x28, 0xc7, 0xba, 0xa7, 0xf3, 0xda, 0x80, 0x94, 0x13, 0x13, 0x9f, 0xa4, 0xff,
-	0xea, 0x82, 0xe2, 0x32, 0xa9, 0x5c, 0xb5, 0x08, 0x45, 0x16, 0x75, 0x49, 0x2e, 0x23, 0x67, 0x13,
-	0xd3, 0x6b, 0xa8, 0x61, 0xe9, 0x1b, 0xb1, 0x62, 0x8e, 0x41, 0x8e, 0x9a, 0xfa, 0xa6, 0xb0, 0x62,
-	0x5e, 0x13, 0x5f, 0xae, 0x86, 0x5d, 0xff, 0x2a, 0x1b, 0xd8, 0xb2, 0x6b, 0xcc, 0xf3, 0xf2, 0x5a,
-	0xde, 0x1d, 0x59, 0x74, 0x07, 0xd4, 0x09, 0x18, 0xb8, 0xd1, 0xb0, 0xa9, 0x37, 0xdf, 0xc3, 0xe6,
-	0x81, 0x0d, 0x71, 0x80, 0x2f, 0xc3, 0x48, 0xcd, 0xb4, 0xca, 0x75, 0xc7, 0xd4, 0x71, 0xd9, 0xa5,
-	0x59, 0x26, 0xe6, 0x8b, 0xb8, 0xd0, 0xcb, 0x32, 0xcc, 0x8c, 0xab, 0xa4, 0xbf, 0xdd, 0x9a, 0x78,
-	0xa8, 0x62, 0xd2, 0x8d, 0xc6, 0xda, 0x8c, 0x6e, 0xd7, 0x44, 0x46, 0x14, 0xff, 0x9c, 0x26, 0xc6,
-	0xe6, 0x2c, 0xdd, 0xae, 0x63, 0x32, 0xb3, 0x88, 0x75, 0x6d, 0x5f, 0xcd, 0xb4, 0x56, 0x5c, 0x4a,
-	0xab, 0xa6, 0xbe, 0x59, 0x32, 0x5f, 0xc4, 0xaa, 0x0e, 0x63, 0x2e, 0xf9, 0x1b, 0x0d, 0x64, 0x51,
-	0x93, 0x6e, 0xfb, 0x56, 0xc8, 0x75, 0xb4, 0x82, 0xcb, 0xec, 0xb3, 0x82, 0x98, 0xb7, 0x48, 0xb4,
-	0xf5, 0x1e, 0x84, 0xc9, 0x78, 0x35, 0xcb, 0x78, 0xfa, 0x6f, 0x8e, 0x39, 0xb1, 0x00, 0x5b, 0xc1,
-	0x4e, 0x1d, 0xd3, 0x06, 0xaa, 0xee, 0xc8, 0x24, 0x21, 0x9d, 0x77, 0xb7, 0xe8, 0x7c, 0x02, 0x06,
-	0x78, 0xbe, 0x2f, 0xbb, 0x86, 0xf2, 0x8c, 0xc2, 0x87, 0xe6, 0x91, 0xe7, 0x50, 0x0c, 0x80, 0x61,
-	0x71, 0x6b, 0x68, 0x02, 0xe9, 0x59, 0x77, 0x48, 0x9d, 0x81, 0x11, 0x01, 0x42, 0x74, 0x54, 0xc5,
-	0xe5, 0x75, 0xa4, 0x53, 0xdb, 0x61, 0x5a, 0x1d, 0xd2, 0xf6, 0xf3, 0xa9, 0x92, 0x3b, 0xb3, 0xc4,
-	0x26, 0xd4, 0x2b, 0x72, 0x4d, 0x57, 0x99, 0x85, 0xbe, 0x63, 0xca, 0xf4, 0xde, 0xb3, 0x0f, 0xfa,
-	0x62, 0x45, 0xec, 0x40, 0x5e, 0xa4, 0x5c, 0x67, 0x9f, 0xab, 0xdb, 0x75, 0xec, 0x71, 0xe6, 0xfe,
-	0x56, 0x57, 0x61, 0x6f, 0x0d, 0x6d, 0x62, 0xa7, 0xbc, 0x8e, 0x71, 0xd9, 0x41, 0x14, 0x17, 0xfa,
-	0x3b, 0xb2, 0xe3, 0x20, 0xa3, 0xb2, 0x84, 0xb1, 0x86, 0x28, 0xa3, 0x4a, 0x83, 0x54, 0xf3, 0x9d,
-	0x51, 0xa5, 0x7e, 0xaa, 0x5f, 0x85, 0x51, 0xd3, 0x32, 0xa9, 0x89, 0xaa, 0xe5, 0x1a, 0x72, 0x2a,
-	0xa6, 0xe5, 0x92, 0x36, 0xed, 0x02, 0x74, 0x44, 0x5b, 0x15, 0xb4, 0x96, 0x19, 0x29, 0xcd, 0xa5,
-	0xa4, 0x6e, 0x40, 0xa1, 0x86, 0x4c, 0x8b, 0x62, 0x0b, 0x59, 0x3a, 0x0e, 0xae, 0x32, 0xd0, 0xd1,
-	0x2a, 0x63, 0x3e, 0x7a, 0xfe, 0x95, 0x62, 0xc2, 0x74, 0x70, 0xd7, 0xc3, 0x74, 0x68, 0x97, 0xc3,
-	0xf4, 0x24, 0x9c, 0x48, 0x89, 0x3f, 0x19, 0xab, 0x7f, 0xca, 0xc1, 0x54, 0x13, 0x76, 0xde, 0xb4,
-	0x90, 0xb3, 0x7d, 0xbd, 0xee, 0x9e, 0xe9, 0xc8, 0x8e, 0xe2, 0x75, 0x0a, 0x86, 0xbc, 0x50, 0xda,
-	0xae, 0xad, 0xd9, 0x55, 0x11, 0xb1, 0x22, 0x04, 0x4b, 0x6c, 0x4c, 0x3d, 0x01, 0xc3, 0x02, 0xa8,
-	0xee, 0xd8, 0x5b, 0xa6, 0x4b, 0x9d, 0xc7, 0xed, 0x5e, 0x3e, 0xbc, 0x22, 0x46, 0xc3, 0x81, 0xd6,
-	0xdb, 0x61, 0xa0, 0xb5, 0x1b, 0xdf, 0xad, 0x81, 0xd9, 0xb7, 0x2b, 0x81, 0xd9, 0xff, 0x31, 0x04,
-	0xe6, 0x19, 0x18, 0xc5, 0x37, 0xeb, 0x26, 0x8b, 0x13, 0xab, 0x4c, 0xcd, 0x1a, 0x26, 0x14, 0xd5,
-	0xea, 0x2c, 0xe8, 0xbb, 0xb5, 0x91, 0xe6, 0xdc, 0xaa, 0x37, 0xe5, 0xa2, 0x10, 0x4c, 0x69, 0x15,
-	0xd7, 0xb0, 0x45, 0x7d, 0x28, 0xc0, 0x51, 0x9a, 0x73, 0x4d, 0x94, 0x51, 0xe8, 0x45, 0x46, 0xcd,
-	0xb4, 0x78, 0x24, 0x6a, 0xfc, 0x23, 0x9c, 0x9c, 0x07, 0xb3, 0x6e, 0x88, 0x43, 0xbb, 0x1e, 0x69,
-	0x7b, 0x77, 0x39, 0xd2, 0x4e, 0xc3, 0xa9, 0x0c, 0xd1, 0x23, 0xa3, 0xed, 0x8d, 0x3e, 0x7f, 0xb4,
-	0x5d, 0x71, 0x6d, 0xb2, 0xbd, 0xd4, 0xa0, 0x0d, 0x07, 0x93, 0x7b, 0x7f, 0x77, 0x0c, 0x05, 0x61,
-	0xee, 0xe3, 0x0d, 0xc2, 0xbe, 0xb8, 0x20, 0x1c, 0x83, 0x1c, 0x73, 0xde, 0x6d, 0x16, 0x26, 0xdd,
-	0x9a, 0xf8, 0x8a, 0x08, 0xce, 0xfc, 0xae, 0x04, 0x27, 0xec, 0xe2, 0xae, 0x39, 0x70, 0x47, 0x76,
-	0xcd, 0xc1, 0x3b, 0xb1, 0x6b, 0xde, 0x67, 0xb1, 0x1c, 0x1b, 0x9b, 0x32, 0x96, 0x5f, 0x51, 0xa0,
-	0x10, 0x28, 0xd5, 0x38, 0xd4, 0xdd, 0x29, 0x1b, 0x7f, 0xa9, 0xc0, 0xb1, 0x38, 0x66, 0x32, 0x16,
-	0x8e, 0xaa, 0x06, 0x7d, 0x0e, 0x26, 0x8d, 0x2a, 0xf5, 0xda, 0x1a, 0x67, 0xd3, 0xb8, 0x0b, 0x2e,
-	0xe2, 0x62, 0x32, 0x56, 0x15, 0xcd, 0x23, 0xe4, 0x2b, 0xd1, 0xfe, 0xa3, 0xc0, 0x58, 0x34, 0x8e,
-	0xfa, 0x34, 0xf4, 0x7b, 0xe6, 0x16, 0x5d, 0x98, 0x76, 0x8d, 0x2c, 0xf1, 0xd5, 0x45, 0xe8, 0x65,
-	0x9e, 0xc9, 0x13, 0x64, 0xdb, 0x84, 0x38, 0xb2, 0x7a, 0x19, 0xba, 0xd7, 0x31, 0xe6, 0x79, 0xb4,
-	0x6d, 0x1a, 0x2e, 0x6a, 0x6b, 0x15, 0xce, 0x4d, 0xb3, 0x88, 0x1d, 0x73, 0x0b, 0xb9, 0x1a, 0xcd,
-	0xd0, 0x63, 0x78, 0x2a, 0xe8, 0x2c, 0xa7, 0x92, 0xcc, 0xd1, 0x24, 0x1c, 0xe1, 0x32, 0xc3, 0x2f,
-	0x87, 0xdc, 0x65, 0x85, 0x55, 0xe1, 0xf1, 0x2c, 0xb5, 0xdf, 0x6b, 0x78, 0xcd, 0xef, 0x80, 0x81,
-	0x8d, 0xf0, 0xae, 0x0a, 0x5a, 0x82, 0xe9, 0x34, 0xae, 0xda, 0x97, 0xf5, 0xa7, 0x0a, 0xdb, 0xc5,
-	0x7d, 0x4d, 0x8c, 0x28, 0x1d, 0xc6, 0x77, 0x57, 0xae, 0x86, 0xba, 0x2b, 0x1d, 0xc8, 0xeb, 0xf5,
-	0x58, 0x5a, 0x04, 0x7e, 0x8e, 0x25, 0xb1, 0x34, 0xd6, 0x3a, 0xeb, 0xb2, 0xbc, 0xad, 0xb0, 0x86,
-	0xdf, 0x82, 0xbb, 0x23, 0x54, 0x65, 0x76, 0x8a, 0x15, 0xf3, 0x30, 0xe4, 0x6b, 0x2c, 0xd8, 0x9b,
-	0xcd, 0xbd, 0x7e, 0x3e, 0x70, 0xd5, 0x68, 0xed, 0xfe, 0x75, 0x47, 0x74, 0xff, 0x82, 0x16, 0xe9,
-	0x09, 0x27, 0xac, 0x7d, 0xd0, 0xad, 0x9b, 0x86, 0x38, 0xaa, 0xb8, 0x3f, 0x5b, 0xd5, 0x71, 0x84,
-	0xf5, 0x85, 0x42, 0x1c, 0xcb, 0x14, 0xfe, 0x2d, 0x9e, 0xc2, 0xb9, 0xb6, 0x82, 0x30, 0xf1, 0xd6,
-	0xbb, 0x04, 0x3d, 0x06, 0xa2, 0x28, 0x4b, 0x67, 0x8c, 0x51, 0x5a, 0x44, 0x14, 0x09, 0xab, 0x31,
-	0xc4, 0x56, 0x26, 0x97, 0x58, 0xe8, 0x44, 0x72, 0x21, 0x0d, 0x55, 0x80, 0x3e, 0xd2, 0xd0, 0x75,
-	0x4c, 0xb8, 0x8d, 0xfa, 0x35, 0xef, 0xd3, 0x67, 0x9f, 0xef, 0x29, 0xf0, 0x40, 0x90, 0x50, 0xc0,
-	0xe5, 0xef, 0xb8, 0x5c, 0xd7, 0xe1, 0x64, 0x2a, 0x3b, 0x6d, 0x09, 0xf8, 0x6e, 0x1f, 0x8c, 0x7a,
-	0x14, 0x79, 0xaf, 0x3c, 0x45, 0xa6, 0x4c, 0x3d, 0xe6, 0x4b, 0x70, 0x94, 0xd4, 0x6d, 0x5a, 0x96,
-	0xce, 0x4a, 0xca, 0xd4, 0x2e, 0xeb, 0x8c, 0xe3, 0x32, 0xaa, 0xba, 0xa5, 0xab, 0x1b, 0x14, 0x05,
-	0x22, 0x77, 0xaf, 0xab, 0x06, 0x59, 0xb5, 0xb9, 0x48, 0x73, 0xd5, 0xaa, 0xfa, 0x0c, 0x4c, 0x19,
-	0x32, 0xca, 0xe2, 0xc9, 0xf4, 0x30, 0x32, 0xe3, 0x4d, 0xd0, 0x48, 0x62, 0x5f, 0x81, 0x03, 0x8c,
-	0x1b, 0x1e, 0xe0, 0x4d, 0x12, 0x85, 0xde, 0x76, 0xed, 0xa2, 0x68, 0x2a, 0x91, 0x8e, 0xe4, 0x2d,
-	0xa1, 0x3e, 0x0f, 0x87, 0x7d, 0xcc, 0xb6, 0xac, 0x92, 0x6b, 0x7f, 0x95, 0x82, 0x11, 0x4c, 0x51,
-	0xcd, 0xb5, 0x22, 0x64, 0x61, 0x39, 0xa9, 0xd0, 0xd7, 0x6e, 0x57, 0x39, 0x2c, 0x0b, 0x23, 0xa3,
-	0xd6, 0xe3, 0x64, 0xe1, 0xab, 0xf4, 0x77, 0x96, 0x5d, 0xa3, 0x25, 0xe2, 0x2b, 0xde, 0x80, 0x89,
-	0x35, 0xe6, 0xc4, 0x65, 0x9b, 0x7b, 0x71, 0xab, 0x06, 0xf3, 0xed, 0x6b, 0xf0, 0xf0, 0x5a, 0x6b,
-	0x60, 0x48, 0x25, 0x6a, 0x70, 0x22, 0xb4, 0x64, 0xac, 0x87, 0x01, 0xf3, 0xb0, 0x07, 0xd6, 0x5a,
-	0xeb, 0xd0, 0x90, 0x93, 0xbd, 0x90, 0x24, 0x06, 0x57, 0xde, 0x40, 0xa7, 0xca, 0x8b, 0x11, 0x86,
-	0x51, 0x6d, 0xcd, 0x11, 0x1f, 0x75, 0xc1, 0x91, 0xa8, 0x90, 0x96, 0x79, 0x61, 0x06, 0x46, 0x98,
-	0x0f, 0x09, 0x31, 0x83, 0x39, 0x62, 0xbf, 0x3b, 0x25, 0x72, 0x26, 0x9f, 0x50, 0x2f, 0xc0, 0x21,
-	0x9f, 0x4f, 0x84, 0xb0, 0xba, 0x18, 0xd6, 0xc1, 0x26, 0x40, 0x10, 0xf7, 0x61, 0xd8, 0xdf, 0xf4,
-	0x57, 0x6f, 0x4b, 0xe4, 0xd1, 0x3f, 0x2c, 0xdd, 0x8f, 0x6f, 0x8b, 0xea, 0x79, 0x38, 0x18, 0xf6,
-	0x3d, 0x0f, 0x83, 0x07, 0xfa, 0x81, 0x90, 0x13, 0x09, 0xbc, 0x39, 0x38, 0x1a, 0x52, 0x7d, 0x88,
-	0xc7, 0x5e, 0xc6, 0x63, 0x31, 0xa0, 0xc5, 0x20, 0x9b, 0x17, 0xe1, 0x70, 0x94, 0xf5, 0xbc, 0xe5,
-	0x73, 0x3c, 0x5d, 0xb5, 0x9a, 0xa1, 0x65, 0x43, 0xff, 0xa1, 0x02, 0xe3, 0x11, 0xe7, 0xc0, 0x2c,
-	0x85, 0xcc, 0xee, 0x1d, 0xd9, 0x7e, 0xa7, 0xc0, 0x43, 0xc9, 0x4c, 0x65, 0x2d, 0x68, 0xbe, 0x18,
-	0x2e, 0x68, 0x1e, 0xcf, 0xc6, 0x65, 0x3b, 0x65, 0xcd, 0xcf, 0xbb, 0xe1, 0x48, 0x12, 0xe6, 0xfd,
-	0x58, 0xdc, 0xa8, 0x9f, 0x87, 0xbd, 0xec, 0xce, 0xd7, 0xb4, 0xad, 0xb2, 0x81, 0xab, 0x14, 0xb1,
-	0xb3, 0xd9, 0xc0, 0xd9, 0x93, 0x89, 0xf7, 0xe0, 0x02, 0x63, 0xd1, 0x45, 0x10, 0x3e, 0x30, 0x54,
-	0xf7, 0x0f, 0xaa, 0x4b, 0x90, 0xab, 0xa3, 0x6d, 0xbb, 0x41, 0x3b, 0xbc, 0x2a, 0x13, 0xd8, 0x3e,
-	0xf3, 0xfc, 0x98, 0x1f, 0x89, 0x22, 0x0a, 0x80, 0xbb, 0xeb, 0xe4, 0x7f, 0x50, 0xd8, 0xd9, 0x28,
-	0x99, 0xaf, 0x7b, 0xc9, 0xcf, 0xff, 0x2a, 0xba, 0x1d, 0x2c, 0x11, 0x85, 0x64, 0xbd, 0x7b, 0x15,
-	0x80, 0x9c, 0xae, 0x21, 0xb2, 0xc9, 0x9c, 0xa6, 0x57, 0x4c, 0x2f, 0x23, 0xb2, 0xe9, 0x15, 0x08,
-	0xb9, 0x84, 0x02, 0x61, 0x92, 0x97, 0xad, 0x51, 0x62, 0xc9, 0x32, 0xe1, 0x3d, 0x05, 0x0e, 0x4b,
-	0xa0, 0xd6, 0x33, 0xec, 0xff, 0xb3, 0xf8, 0xc7, 0x59, 0x25, 0x1b, 0x27, 0x99, 0xd4, 0xc0, 0x9b,
-	0x0a, 0xe4, 0xe5, 0xa1, 0x25, 0x28, 0x97, 0x92, 0x26, 0x57, 0x57, 0xaa, 0x5c, 0xdd, 0xc9, 0x72,
-	0xf5, 0xc4, 0xc8, 0xd5, 0xac, 0xfb, 0x26, 0x5f, 0xe1, 0x1b, 0x99, 0xaf, 0xd4, 0x08, 0xd9, 0xf2,
-	0x4e, 0x96, 0x3d, 0xd7, 0xd8, 0xfe, 0x95, 0xc0, 0x4b, 0x5b, 0x35, 0xcf, 0x6d, 0x05, 0x0e, 0x2c,
-	0x93, 0x4a, 0x49, 0xaa, 0x6f, 0xd5, 0x41, 0x16, 0x59, 0x4f, 0x70, 0xbb, 0x47, 0x60, 0x94, 0xd8,
-	0x0d, 0x47, 0xc7, 0xe5, 0x28, 0x43, 0xa8, 0x7c, 0xae, 0xe4, 0x37, 0x07, 0x3b, 0x33, 0x11, 0x6a,
-	0x5a, 0xfc, 0xf2, 0x28, 0xca, 0x2f, 0x0f, 0xfa, 0x00, 0x4a, 0xd1, 0x2f, 0x74, 0x7a, 0xda, 0x7b,
-	0xa1, 0x33, 0xe0, 0xd7, 0xd9, 0x04, 0xeb, 0x91, 0xb5, 0x0a, 0x29, 0x3d, 0xf0, 0x9f, 0x0a, 0x7b,
-	0xbb, 0x73, 0xe5, 0x26, 0xc5, 0x8e, 0x85, 0xaa, 0xf7, 0xa5, 0x12, 0x8e, 0xb2, 0x34, 0x13, 0x16,
-	0x51, 0xaa, 0xe0, 0xcf, 0x0a, 0xab, 0x7e, 0xaf, 0x99, 0x37, 0x1a, 0x26, 0x7b, 0x27, 0x26, 0xf6,
-	0xce, 0x9d, 0x55, 0xbf, 0x81, 0x60, 0xee, 0x0e, 0x05, 0xb3, 0xdc, 0x00, 0x7b, 0x3a, 0xdb, 0x00,
-	0x15, 0x6f, 0x03, 0x0c, 0xc8, 0x39, 0xce, 0x8e, 0xfc, 0x2d, 0x72, 0x48, 0x41, 0xbf, 0xc9, 0xf7,
-	0x9a, 0x2b, 0x35, 0xec, 0x54, 0xb0, 0xa5, 0x6f, 0x97, 0xd8, 0x45, 0x24, 0xdf, 0xad, 0x76, 0x4f,
-	0xd8, 0x20, 0x8f, 0x7c, 0x5f, 0x88, 0x64, 0x41, 0xf2, 0xf9, 0xa3, 0x2e, 0x38, 0xc4, 0x6e, 0x0c,
-	0xdc, 0x92, 0x89, 0x48, 0x39, 0xf8, 0x65, 0xc9, 0x3d, 0xe2, 0x99, 0x01, 0x89, 0x7b, 0x42, 0xe6,
-	0x5d, 0x92, 0x6e, 0xdb, 0xe1, 0x79, 0x2b, 0xca, 0x8b, 0xa7, 0xd8, 0x89, 0x2b, 0x5a, 0x29, 0x52,
-	0x75, 0x6f, 0x29, 0xcc, 0x07, 0x56, 0x1c, 0x73, 0xcb, 0xac, 0xe2, 0x0a, 0x36, 0xae, 0xdc, 0xc4,
-	0x7a, 0x83, 0xe2, 0x05, 0xdb, 0xa2, 0x0e, 0xd2, 0xe3, 0xcd, 0x3c, 0x0a, 0xbd, 0xeb, 0x0d, 0xcb,
-	0x20, 0x42, 0x5d, 0xfc, 0x43, 0x3d, 0x09, 0xfb, 0x74, 0x81, 0x59, 0x46, 0xfc, 0xd5, 0xa6, 0x50,
-	0xcc, 0xb0, 0x37, 0x2e, 0x1e, 0x73, 0xaa, 0xaa, 0xc8, 0xf7, 0x5c, 0x17, 0x3c, 0x85, 0x47, 0x5e,
-	0xa9, 0xfc, 0x46, 0x81, 0x07, 0x93, 0x58, 0x94, 0x59, 0xfc, 0x79, 0x00, 0xc6, 0x45, 0xd9, 0x30,
-	0xd7, 0xd7, 0x59, 0x22, 0x4f, 0x4c, 0x00, 0x8f, 0xb8, 0x4a, 0xfe, 0xed, 0xdf, 0x27, 0xa6, 0x33,
-	0x28, 0xd9, 0x45, 0x20, 0x5a, 0x9e, 0x91, 0x5f, 0x34, 0xd7, 0xd7, 0xa3, 0x39, 0x7d, 0x18, 0xf6,
-	0x2d, 0x93, 0x8a, 0x86, 0x5f, 0x40, 0x8e, 0x41, 0xae, 0xd7, 0xe9, 0xf5, 0x46, 0xac, 0xfe, 0x26,
-	0x8b, 0x2c, 0xb4, 0x02, 0xb0, 0xd2, 0x28, 0xdf, 0xe5, 0x5b, 0x8d, 0x86, 0xf5, 0x2a, 0x32, 0x6b,
-	0xd7, 0x6c, 0x7d, 0x13, 0x1b, 0x4b, 0x4c, 0xbf, 0xf1, 0xbe, 0x3c, 0x52, 0x65, 0x60, 0x73, 0xdc,
-	0xe1, 0x56, 0x1a, 0x6b, 0xcf, 0xe0, 0x6d, 0x66, 0x9b, 0x41, 0x2d, 0x6a, 0x4a, 0x3d, 0x02, 0x79,
-	0x62, 0x56, 0x2c, 0x44, 0x1b, 0x0e, 0x2f, 0x41, 0x06, 0xb5, 0xe6, 0x40, 0xd4, 0x9e, 0xd0, 0xca,
-	0x8d, 0xe4, 0xf7, 0x1b, 0xfc, 0xa5, 0x69, 0xc9, 0xac, 0x58, 0xec, 0x5c, 0x52, 0x82, 0x9c, 0xfb,
-	0x5b, 0x70, 0x39, 0x38, 0xff, 0xe4, 0x87, 0xb7, 0x26, 0x72, 0x84, 0x8d, 0x7c, 0x74, 0x6b, 0xe2,
-	0x74, 0x06, 0x7d, 0xcf, 0xe9, 0xba, 0xf0, 0x13, 0x4d, 0x90, 0x52, 0x8f, 0x40, 0xcf, 0x22, 0x3f,
-	0x1f, 0xb8, 0x24, 0xfb, 0x3f, 0xbc, 0x35, 0xc1, 0x7c, 0x46, 0x63, 0xa3, 0x93, 0x37, 0xd9, 0xdb,
-	0x5c, 0xc6, 0x81, 0xad, 0xab, 0xc7, 0xb9, 0x70, 0xfc, 0x7e, 0x9c, 0x17, 0x7b, 0x0c, 0xc1, 0xfd,
-	0xd6, 0xfa, 0xdd, 0x29, 0x76, 0x03, 0xbe, 0x00, 0xbd, 0x5b, 0xa8, 0xda, 0xc0, 0xe2, 0xb4, 0x7e,
-	0x22, 0x29, 0xab, 0xfa, 0xe4, 0xf3, 0x4a, 0x0a, 0x86, 0x3b, 0xf9, 0x41, 0x17, 0x8b, 0xb3, 0x39,
-	0xa3, 0x66, 0x5a, 0xbc, 0x71, 0x12, 0x51, 0x46, 0x74, 0x76, 0x34, 0xfd, 0x12, 0xec, 0xf3, 0xbd,
-	0x0b, 0xe1, 0x15, 0x67, 0xb3, 0x5a, 0x54, 0xda, 0x48, 0x10, 0xc3, 0x4d, 0x3a, 0xec, 0x96, 0x37,
-	0xf6, 0x95, 0x4a, 0x4f, 0xfb, 0xaf, 0x54, 0x7a, 0xe3, 0x5f, 0xa9, 0x5c, 0x86, 0x1c, 0xa1, 0x88,
-	0x36, 0x88, 0x78, 0xa4, 0x30, 0x9d, 0xa8, 0x61, 0x26, 0x76, 0x89, 0xc1, 0x6b, 0x02, 0x2f, 0xe8,
-	0x88, 0xa7, 0x58, 0xad, 0x96, 0xac, 0x69, 0xcf, 0x29, 0xcf, 0xfe, 0x62, 0x0a, 0xba, 0x97, 0x49,
-	0x45, 0x45, 0xd0, 0xe7, 0x3d, 0xd9, 0x7e, 0x28, 0xc5, 0xc0, 0x02, 0xae, 0x38, 0x93, 0x0d, 0x4e,
-	0x26, 0x1e, 0x03, 0xfa, 0xe5, 0x2b, 0xeb, 0x34, 0x27, 0xf2, 0x00, 0x8b, 0xb3, 0x19, 0x01, 0xe5,
-	0x2a, 0xaf, 0x2a, 0x70, 0x30, 0xee, 0x61, 0xed, 0xf9, 0x14, 0x62, 0x31, 0x78, 0xc5, 0x4f, 0x77,
-	0x86, 0x27, 0x79, 0x72, 0xb7, 0x8f, 0xc4, 0xe7, 0xa5, 0x4f, 0x66, 0x5b, 0x20, 0x12, 0xb9, 0xb8,
-	0xb0, 0x03, 0x64, 0xc9, 0xe2, 0xef, 0x15, 0x38, 0x96, 0xfa, 0xce, 0xe7, 0x52, 0xb6, 0x95, 0x62,
-	0x09, 0x14, 0x9f, 0xda, 0x21, 0x01, 0xc9, 0xee, 0xcb, 0x0a, 0x8c, 0x46, 0x3e, 0x80, 0x7f, 0x34,
-	0x65, 0x85, 0x28, 0xa4, 0xe2, 0x93, 0x1d, 0x20, 0x49, 0x56, 0xde, 0x50, 0xa0, 0x98, 0xf0, 0x66,
-	0xfd, 0x89, 0x14, 0xda, 0xf1, 0xa8, 0xc5, 0xb9, 0x8e, 0x51, 0x25, 0x73, 0xdf, 0x51, 0xe0, 0x40,
-	0xf4, 0x93, 0x8f, 0x73, 0x99, 0x65, 0xf6, 0x61, 0x15, 0x3f, 0xd5, 0x09, 0x96, 0xe4, 0x66, 0x1b,
-	0x86, 0xc3, 0xb7, 0xb1, 0x69, 0x49, 0x24, 0x04, 0x5f, 0x3c, 0xdf, 0x1e, 0x7c, 0x40, 0x11, 0xd1,
-	0x17, 0xa7, 0xe7, 0x32, 0x69, 0x39, 0x84, 0x95, 0xaa, 0x88, 0xe4, 0xeb, 0xd1, 0xaf, 0xc3, 0xfe,
-	0xd6, 0x5b, 0xc1, 0x47, 0xb2, 0x90, 0xf4, 0x63, 0x14, 0x1f, 0x6f, 0x17, 0x43, 0x32, 0xf0, 0xba,
-	0x02, 0x87, 0xe2, 0x4f, 0xb3, 0x69, 0x74, 0x63, 0x31, 0x8b, 0x97, 0x3b, 0xc5, 0x0c, 0x84, 0x53,
-	0xc2, 0xe3, 0x93, 0x27, 0x32, 0x39, 0x60, 0x14, 0x6a, 0x6a, 0x38, 0x65, 0x78, 0x5f, 0xe2, 0x66,
-	0xc9, 0xd4, 0x77, 0x14, 0x97, 0xb2, 0x87, 0x6d, 0x24, 0x81, 0xd4, 0x2c, 0x99, 0xf9, 0xb9, 0xc4,
-	0x9b, 0x0a, 0x1c, 0x4e, 0xba, 0x2d, 0xb9, 0xd0, 0xa6, 0x46, 0xfc, 0x99, 0x60, 0xbe, 0x73, 0xdc,
-	0x60, 0x76, 0x8a, 0x6c, 0xd1, 0x9e, 0xcb, 0x14, 0xe6, 0x21, 0xac, 0xf4, 0xec, 0x94, 0xd4, 0x37,
-	0x65, 0xda, 0x4a, 0x6a, 0xc9, 0x5d, 0xc8, 0x1e, 0xf2, 0x61, 0xdc, 0x54, 0x6d, 0x65, 0x69, 0xbf,
-	0xf9, 0xb6, 0xe8, 0xf8, 0x87, 0xef, 0x19, 0xb7, 0xe8, 0x58, 0x02, 0x59, 0xb7, 0xe8, 0xd4, 0xc7,
-	0xc3, 0xea, 0xaf, 0x14, 0x38, 0x9a, 0xfc, 0xbe, 0x2a, 0xdb, 0x66, 0x12, 0x83, 0x5d, 0x5c, 0xdc,
-	0x09, 0xb6, 0xe4, 0xf2, 0xd7, 0x0a, 0x8c, 0xa7, 0x5c, 0xb7, 0x5c, 0x6c, 0x7f, 0x21, 0x7f, 0xa0,
-	0x5c, 0xd9, 0x11, 0xba, 0x64, 0xf4, 0x35, 0x05, 0x0a, 0xb1, 0x2d, 0xfd, 0xc7, 0x32, 0x39, 0x7e,
-	0x2b, 0x62, 0xf1, 0x52, 0x87, 0x88, 0x01, 0xfd, 0xa5, 0xbc, 0xe0, 0xb9, 0x98, 0xdd, 0xf7, 0x23,
-	0xd0, 0x53, 0xf5, 0x97, 0xf1, 0xc1, 0xce, 0x4b, 0x0a, 0xa8, 0x11, 0x5d, 0xe9, 0x33, 0x69, 0xd5,
-	0x6c, 0x0b, 0x4a, 0xf1, 0x89, 0xb6, 0x51, 0x24, 0x13, 0x5f, 0x83, 0x7d, 0x2d, 0x2d, 0xe1, 0xb4,
-	0x0a, 0x27, 0x8c, 0x50, 0x7c, 0xac, 0x4d, 0x04, 0xff, 0xa9, 0xa3, 0xb5, 0x1b, 0x9b, 0x76, 0xea,
-	0x68, 0xc1, 0x48, 0x3d, 0x75, 0xc4, 0x76, 0x4a, 0x59, 0xbe, 0x8f, 0x6e, 0x93, 0xa6, 0xe5, 0xfb,
-	0x48, 0xac, 0xd4, 0x7c, 0x9f, 0xd8, 0x0f, 0x55, 0xbf, 0xaf, 0xc0, 0x58, 0x4c, 0x33, 0xf4, 0x93,
-	0xa9, 0x49, 0x30, 0x0a, 0xad, 0x78, 0xb1, 0x23, 0x34, 0xc9, 0x10, 0x81, 0xa1, 0x60, 0x57, 0xec,
-	0x13, 0x29, 0xf4, 0x02, 0xd0, 0xc5, 0x73, 0xed, 0x40, 0x07, 0x02, 0x38, 0xa5, 0x2b, 0x93, 0x26,
-	0x56, 0x32, 0x7a, 0x6a, 0x00, 0x67, 0xeb, 0x54, 0xb0, 0x00, 0x8e, 0xe8, 0xf5, 0x9d, 0x49, 0x95,
-	0x3a, 0x8c, 0x92, 0x1a, 0xc0, 0xf1, 0x3d, 0x3c, 0xb5, 0x0e, 0x83, 0x81, 0xff, 0x92, 0x7f, 0x2a,
-	0x85, 0x94, 0x1f, 0xb8, 0xf8, 0x68, 0x1b, 0xc0, 0xde, 0x8a, 0xf3, 0x1b, 0xef, 0xbc, 0x3f, 0xae,
-	0xbc, 0xfb, 0xfe, 0xb8, 0xf2, 0x8f, 0xf7, 0xc7, 0x95, 0x1f, 0xdc, 0x1e, 0xdf, 0xf3, 0xee, 0xed,
-	0xf1, 0x3d, 0xef, 0xdd, 0x1e, 0xdf, 0xf3, 0xdc, 0x67, 0x7d, 0x5d, 0xad, 0xab, 0x1e, 0xe1, 0x6b,
-	0x68, 0x8d, 0xcc, 0xca, 0x65, 0x4e, 0xeb, 0xb6, 0x83, 0xfd, 0x9f, 0x1b, 0xc8, 0xb4, 0x66, 0x6b,
-	0xb6, 0xd1, 0xa8, 0x62, 0xd2, 0xfc, 0x53, 0x05, 0xac, 0x03, 0xb6, 0x96, 0x63, 0x7f, 0x79, 0xe0,
-	0xd1, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x70, 0x8b, 0x67, 0xfe, 0xa8, 0x41, 0x00, 0x00,
+func (m *MsgPrivilegedExecuteContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPrivilegedExecuteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPrivilegedExecuteContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPrivilegedExecuteContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPrivilegedExecuteContract.Merge(m, src)
+}
+func (m *MsgPrivilegedExecuteContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPrivilegedExecuteContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPrivilegedExecuteContract.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgPrivilegedExecuteContract proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Deposit defines a method for transferring coins from the sender's bank
-	// balance into the subaccount's exchange deposits
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	// Withdraw defines a method for withdrawing coins from a subaccount's
-	// deposits to the user's bank balance
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// InstantSpotMarketLaunch defines method for creating a spot market by paying
-	// listing fee without governance
-	InstantSpotMarketLaunch(ctx context.Context, in *MsgInstantSpotMarketLaunch, opts ...grpc.CallOption) (*MsgInstantSpotMarketLaunchResponse, error)
-	// InstantPerpetualMarketLaunch defines a method for creating a new perpetual
-	// futures market by paying listing fee without governance
-	InstantPerpetualMarketLaunch(ctx context.Context, in *MsgInstantPerpetualMarketLaunch, opts ...grpc.CallOption) (*MsgInstantPerpetualMarketLaunchResponse, error)
-	// InstantExpiryFuturesMarketLaunch defines a method for creating a new expiry
-	// futures market by paying listing fee without governance
-	InstantExpiryFuturesMarketLaunch(ctx context.Context, in *MsgInstantExpiryFuturesMarketLaunch, opts ...grpc.CallOption) (*MsgInstantExpiryFuturesMarketLaunchResponse, error)
-	// CreateSpotLimitOrder defines a method for creating a new spot limit order.
-	CreateSpotLimitOrder(ctx context.Context, in *MsgCreateSpotLimitOrder, opts ...grpc.CallOption) (*MsgCreateSpotLimitOrderResponse, error)
-	// BatchCreateSpotLimitOrder defines a method for creating a new batch of spot
-	// limit orders.
-	BatchCreateSpotLimitOrders(ctx context.Context, in *MsgBatchCreateSpotLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateSpotLimitOrdersResponse, error)
-	// CreateSpotMarketOrder defines a method for creating a new spot market
-	// order.
-	CreateSpotMarketOrder(ctx context.Context, in *MsgCreateSpotMarketOrder, opts ...grpc.CallOption) (*MsgCreateSpotMarketOrderResponse, error)
-	// MsgCancelSpotOrder defines a method for cancelling a spot order.
-	CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error)
-	// BatchCancelSpotOrders defines a method for cancelling a batch of spot
-	// orders in a given market.
-	BatchCancelSpotOrders(ctx context.Context, in *MsgBatchCancelSpotOrders, opts ...grpc.CallOption) (*MsgBatchCancelSpotOrdersResponse, error)
-	// BatchUpdateOrders defines a method for updating a batch of orders.
-	BatchUpdateOrders(ctx context.Context, in *MsgBatchUpdateOrders, opts ...grpc.CallOption) (*MsgBatchUpdateOrdersResponse, error)
-	// PrivilegedExecuteContract defines a method for executing a Cosmwasm
-	// contract from the exchange module with privileged capabilities.
-	PrivilegedExecuteContract(ctx context.Context, in *MsgPrivilegedExecuteContract, opts ...grpc.CallOption) (*MsgPrivilegedExecuteContractResponse, error)
-	// CreateDerivativeLimitOrder defines a method for creating a new derivative
-	// limit order.
-	CreateDerivativeLimitOrder(ctx context.Context, in *MsgCreateDerivativeLimitOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeLimitOrderResponse, error)
-	// BatchCreateDerivativeLimitOrders defines a method for creating a new batch
-	// of derivative limit orders.
-	BatchCreateDerivativeLimitOrders(ctx context.Context, in *MsgBatchCreateDerivativeLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateDerivativeLimitOrdersResponse, error)
-	// MsgCreateDerivativeLimitOrder defines a method for creating a new
-	// derivative market order.
-	CreateDerivativeMarketOrder(ctx context.Context, in *MsgCreateDerivativeMarketOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeMarketOrderResponse, error)
-	// MsgCancelDerivativeOrder defines a method for cancelling a derivative
-	// order.
-	CancelDerivativeOrder(ctx context.Context, in *MsgCancelDerivativeOrder, opts ...grpc.CallOption) (*MsgCancelDerivativeOrderResponse, error)
-	// MsgBatchCancelDerivativeOrders defines a method for cancelling a batch of
-	// derivative limit orders.
-	BatchCancelDerivativeOrders(ctx context.Context, in *MsgBatchCancelDerivativeOrders, opts ...grpc.CallOption) (*MsgBatchCancelDerivativeOrdersResponse, error)
-	// InstantBinaryOptionsMarketLaunch defines method for creating a binary
-	// options market by paying listing fee without governance
-	InstantBinaryOptionsMarketLaunch(ctx context.Context, in *MsgInstantBinaryOptionsMarketLaunch, opts ...grpc.CallOption) (*MsgInstantBinaryOptionsMarketLaunchResponse, error)
-	// CreateBinaryOptionsLimitOrder defines a method for creating a new binary
-	// options limit order.
-	CreateBinaryOptionsLimitOrder(ctx context.Context, in *MsgCreateBinaryOptionsLimitOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsLimitOrderResponse, error)
-	// CreateBinaryOptionsMarketOrder defines a method for creating a new binary
-	// options market order.
-	CreateBinaryOptionsMarketOrder(ctx context.Context, in *MsgCreateBinaryOptionsMarketOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsMarketOrderResponse, error)
-	// MsgCancelBinaryOptionsOrder defines a method for cancelling a binary
-	// options order.
-	CancelBinaryOptionsOrder(ctx context.Context, in *MsgCancelBinaryOptionsOrder, opts ...grpc.CallOption) (*MsgCancelBinaryOptionsOrderResponse, error)
-	// BatchCancelBinaryOptionsOrders defines a method for cancelling a batch of
-	// binary options limit orders.
-	BatchCancelBinaryOptionsOrders(ctx context.Context, in *MsgBatchCancelBinaryOptionsOrders, opts ...grpc.CallOption) (*MsgBatchCancelBinaryOptionsOrdersResponse, error)
-	// SubaccountTransfer defines a method for transfer between subaccounts
-	SubaccountTransfer(ctx context.Context, in *MsgSubaccountTransfer, opts ...grpc.CallOption) (*MsgSubaccountTransferResponse, error)
-	// ExternalTransfer defines a method for transfer between external accounts
-	ExternalTransfer(ctx context.Context, in *MsgExternalTransfer, opts ...grpc.CallOption) (*MsgExternalTransferResponse, error)
-	// LiquidatePosition defines a method for liquidating a position
-	LiquidatePosition(ctx context.Context, in *MsgLiquidatePosition, opts ...grpc.CallOption) (*MsgLiquidatePositionResponse, error)
-	// EmergencySettleMarket defines a method for emergency settling a market
-	EmergencySettleMarket(ctx context.Context, in *MsgEmergencySettleMarket, opts ...grpc.CallOption) (*MsgEmergencySettleMarketResponse, error)
-	// IncreasePositionMargin defines a method for increasing margin of a position
-	IncreasePositionMargin(ctx context.Context, in *MsgIncreasePositionMargin, opts ...grpc.CallOption) (*MsgIncreasePositionMarginResponse, error)
-	// RewardsOptOut defines a method for opting out of rewards
-	RewardsOptOut(ctx context.Context, in *MsgRewardsOptOut, opts ...grpc.CallOption) (*MsgRewardsOptOutResponse, error)
-	// AdminUpdateBinaryOptionsMarket defines method for updating a binary options
-	// market by admin
-	AdminUpdateBinaryOptionsMarket(ctx context.Context, in *MsgAdminUpdateBinaryOptionsMarket, opts ...grpc.CallOption) (*MsgAdminUpdateBinaryOptionsMarketResponse, error)
-	//
-	ReclaimLockedFunds(ctx context.Context, in *MsgReclaimLockedFunds, opts ...grpc.CallOption) (*MsgReclaimLockedFundsResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgPrivilegedExecuteContractResponse defines the Msg/Exec response type.
+type MsgPrivilegedExecuteContractResponse struct {
+	FundsDiff github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=funds_diff,json=fundsDiff,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds_diff"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgPrivilegedExecuteContractResponse) Reset()         { *m = MsgPrivilegedExecuteContractResponse{} }
+func (m *MsgPrivilegedExecuteContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPrivilegedExecuteContractResponse) ProtoMessage()    {}
+func (*MsgPrivilegedExecuteContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{70}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgPrivilegedExecuteContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPrivilegedExecuteContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPrivilegedExecuteContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPrivilegedExecuteContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPrivilegedExecuteContractResponse.Merge(m, src)
 }
-
-func (c *msgClient) InstantSpotMarketLaunch(ctx context.Context, in *MsgInstantSpotMarketLaunch, opts ...grpc.CallOption) (*MsgInstantSpotMarketLaunchResponse, error) {
-	out := new(MsgInstantSpotMarketLaunchResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantSpotMarketLaunch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPrivilegedExecuteContractResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) InstantPerpetualMarketLaunch(ctx context.Context, in *MsgInstantPerpetualMarketLaunch, opts ...grpc.CallOption) (*MsgInstantPerpetualMarketLaunchResponse, error) {
-	out := new(MsgInstantPerpetualMarketLaunchResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantPerpetualMarketLaunch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgPrivilegedExecuteContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPrivilegedExecuteContractResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) InstantExpiryFuturesMarketLaunch(ctx context.Context, in *MsgInstantExpiryFuturesMarketLaunch, opts ...grpc.CallOption) (*MsgInstantExpiryFuturesMarketLaunchResponse, error) {
-	out := new(MsgInstantExpiryFuturesMarketLaunchResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantExpiryFuturesMarketLaunch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgPrivilegedExecuteContractResponse proto.InternalMessageInfo
 
-func (c *msgClient) CreateSpotLimitOrder(ctx context.Context, in *MsgCreateSpotLimitOrder, opts ...grpc.CallOption) (*MsgCreateSpotLimitOrderResponse, error) {
-	out := new(MsgCreateSpotLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateSpotLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// A Cosmos-SDK MsgRewardsOptOut
+type MsgRewardsOptOut struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (c *msgClient) BatchCreateSpotLimitOrders(ctx context.Context, in *MsgBatchCreateSpotLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateSpotLimitOrdersResponse, error) {
-	out := new(MsgBatchCreateSpotLimitOrdersResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCreateSpotLimitOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOut) Reset()         { *m = MsgRewardsOptOut{} }
+func (m *MsgRewardsOptOut) String() string { return proto.CompactTextString(m) }
+func (*MsgRewardsOptOut) ProtoMessage()    {}
+func (*MsgRewardsOptOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{71}
 }
-
-func (c *msgClient) CreateSpotMarketOrder(ctx context.Context, in *MsgCreateSpotMarketOrder, opts ...grpc.CallOption) (*MsgCreateSpotMarketOrderResponse, error) {
-	out := new(MsgCreateSpotMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateSpotMarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error) {
-	out := new(MsgCancelSpotOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CancelSpotOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRewardsOptOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRewardsOptOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) BatchCancelSpotOrders(ctx context.Context, in *MsgBatchCancelSpotOrders, opts ...grpc.CallOption) (*MsgBatchCancelSpotOrdersResponse, error) {
-	out := new(MsgBatchCancelSpotOrdersResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCancelSpotOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRewardsOptOut.Merge(m, src)
 }
-
-func (c *msgClient) BatchUpdateOrders(ctx context.Context, in *MsgBatchUpdateOrders, opts ...grpc.CallOption) (*MsgBatchUpdateOrdersResponse, error) {
-	out := new(MsgBatchUpdateOrdersResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchUpdateOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOut) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) PrivilegedExecuteContract(ctx context.Context, in *MsgPrivilegedExecuteContract, opts ...grpc.CallOption) (*MsgPrivilegedExecuteContractResponse, error) {
-	out := new(MsgPrivilegedExecuteContractResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/PrivilegedExecuteContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRewardsOptOut.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateDerivativeLimitOrder(ctx context.Context, in *MsgCreateDerivativeLimitOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeLimitOrderResponse, error) {
-	out := new(MsgCreateDerivativeLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateDerivativeLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgRewardsOptOut proto.InternalMessageInfo
 
-func (c *msgClient) BatchCreateDerivativeLimitOrders(ctx context.Context, in *MsgBatchCreateDerivativeLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateDerivativeLimitOrdersResponse, error) {
-	out := new(MsgBatchCreateDerivativeLimitOrdersResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCreateDerivativeLimitOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRewardsOptOut) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateDerivativeMarketOrder(ctx context.Context, in *MsgCreateDerivativeMarketOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeMarketOrderResponse, error) {
-	out := new(MsgCreateDerivativeMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateDerivativeMarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRewardsOptOutResponse defines the Msg/RewardsOptOut response type.
+type MsgRewardsOptOutResponse struct {
 }
 
-func (c *msgClient) CancelDerivativeOrder(ctx context.Context, in *MsgCancelDerivativeOrder, opts ...grpc.CallOption) (*MsgCancelDerivativeOrderResponse, error) {
-	out := new(MsgCancelDerivativeOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CancelDerivativeOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOutResponse) Reset()         { *m = MsgRewardsOptOutResponse{} }
+func (m *MsgRewardsOptOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRewardsOptOutResponse) ProtoMessage()    {}
+func (*MsgRewardsOptOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{72}
 }
-
-func (c *msgClient) BatchCancelDerivativeOrders(ctx context.Context, in *MsgBatchCancelDerivativeOrders, opts ...grpc.CallOption) (*MsgBatchCancelDerivativeOrdersResponse, error) {
-	out := new(MsgBatchCancelDerivativeOrdersResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCancelDerivativeOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) InstantBinaryOptionsMarketLaunch(ctx context.Context, in *MsgInstantBinaryOptionsMarketLaunch, opts ...grpc.CallOption) (*MsgInstantBinaryOptionsMarketLaunchResponse, error) {
-	out := new(MsgInstantBinaryOptionsMarketLaunchResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantBinaryOptionsMarketLaunch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRewardsOptOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRewardsOptOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) CreateBinaryOptionsLimitOrder(ctx context.Context, in *MsgCreateBinaryOptionsLimitOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsLimitOrderResponse, error) {
-	out := new(MsgCreateBinaryOptionsLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRewardsOptOutResponse.Merge(m, src)
 }
-
-func (c *msgClient) CreateBinaryOptionsMarketOrder(ctx context.Context, in *MsgCreateBinaryOptionsMarketOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsMarketOrderResponse, error) {
-	out := new(MsgCreateBinaryOptionsMarketOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsMarketOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOutResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) CancelBinaryOptionsOrder(ctx context.Context, in *MsgCancelBinaryOptionsOrder, opts ...grpc.CallOption) (*MsgCancelBinaryOptionsOrderResponse, error) {
-	out := new(MsgCancelBinaryOptionsOrderResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CancelBinaryOptionsOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRewardsOptOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRewardsOptOutResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) BatchCancelBinaryOptionsOrders(ctx context.Context, in *MsgBatchCancelBinaryOptionsOrders, opts ...grpc.CallOption) (*MsgBatchCancelBinaryOptionsOrdersResponse, error) {
-	out := new(MsgBatchCancelBinaryOptionsOrdersResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCancelBinaryOptionsOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgRewardsOptOutResponse proto.InternalMessageInfo
 
-func (c *msgClient) SubaccountTransfer(ctx context.Context, in *MsgSubaccountTransfer, opts ...grpc.CallOption) (*MsgSubaccountTransferResponse, error) {
-	out := new(MsgSubaccountTransferResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/SubaccountTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// A Cosmos-SDK MsgReclaimLockedFunds
+type MsgReclaimLockedFunds struct {
+	Sender              string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	LockedAccountPubKey []byte `protobuf:"bytes,2,opt,name=lockedAccountPubKey,proto3" json:"lockedAccountPubKey,omitempty"`
+	Signature           []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (c *msgClient) ExternalTransfer(ctx context.Context, in *MsgExternalTransfer, opts ...grpc.CallOption) (*MsgExternalTransferResponse, error) {
-	out := new(MsgExternalTransferResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/ExternalTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReclaimLockedFunds) Reset()         { *m = MsgReclaimLockedFunds{} }
+func (m *MsgReclaimLockedFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimLockedFunds) ProtoMessage()    {}
+func (*MsgReclaimLockedFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{73}
 }
-
-func (c *msgClient) LiquidatePosition(ctx context.Context, in *MsgLiquidatePosition, opts ...grpc.CallOption) (*MsgLiquidatePositionResponse, error) {
-	out := new(MsgLiquidatePositionResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/LiquidatePosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimLockedFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimLockedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimLockedFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgReclaimLockedFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimLockedFunds.Merge(m, src)
+}
+func (m *MsgReclaimLockedFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimLockedFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimLockedFunds.DiscardUnknown(m)
 }
 
-func (c *msgClient) EmergencySettleMarket(ctx context.Context, in *MsgEmergencySettleMarket, opts ...grpc.CallOption) (*MsgEmergencySettleMarketResponse, error) {
-	out := new(MsgEmergencySettleMarketResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/EmergencySettleMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReclaimLockedFunds proto.InternalMessageInfo
+
+func (m *MsgReclaimLockedFunds) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) IncreasePositionMargin(ctx context.Context, in *MsgIncreasePositionMargin, opts ...grpc.CallOption) (*MsgIncreasePositionMarginResponse, error) {
-	out := new(MsgIncreasePositionMarginResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/IncreasePositionMargin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimLockedFunds) GetLockedAccountPubKey() []byte {
+	if m != nil {
+		return m.LockedAccountPubKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RewardsOptOut(ctx context.Context, in *MsgRewardsOptOut, opts ...grpc.CallOption) (*MsgRewardsOptOutResponse, error) {
-	out := new(MsgRewardsOptOutResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/RewardsOptOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimLockedFunds) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) AdminUpdateBinaryOptionsMarket(ctx context.Context, in *MsgAdminUpdateBinaryOptionsMarket, opts ...grpc.CallOption) (*MsgAdminUpdateBinaryOptionsMarketResponse, error) {
-	out := new(MsgAdminUpdateBinaryOptionsMarketResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/AdminUpdateBinaryOptionsMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgReclaimLockedFundsResponse defines the Msg/ReclaimLockedFunds response
+// type.
+type MsgReclaimLockedFundsResponse struct {
 }
 
-func (c *msgClient) ReclaimLockedFunds(ctx context.Context, in *MsgReclaimLockedFunds, opts ...grpc.CallOption) (*MsgReclaimLockedFundsResponse, error) {
-	out := new(MsgReclaimLockedFundsResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/ReclaimLockedFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReclaimLockedFundsResponse) Reset()         { *m = MsgReclaimLockedFundsResponse{} }
+func (m *MsgReclaimLockedFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimLockedFundsResponse) ProtoMessage()    {}
+func (*MsgReclaimLockedFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{74}
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimLockedFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimLockedFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimLockedFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Deposit defines a method for transferring coins from the sender's bank
-	// balance into the subaccount's exchange deposits
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	// Withdraw defines a method for withdrawing coins from a subaccount's
-	// deposits to the user's bank balance
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
-	// InstantSpotMarketLaunch defines method for creating a spot market by paying
-	// listing fee without governance
-	InstantSpotMarketLaunch(context.Context, *MsgInstantSpotMarketLaunch) (*MsgInstantSpotMarketLaunchResponse, error)
-	// InstantPerpetualMarketLaunch defines a method for creating a new perpetual
-	// futures market by paying listing fee without governance
-	InstantPerpetualMarketLaunch(context.Context, *MsgInstantPerpetualMarketLaunch) (*MsgInstantPerpetualMarketLaunchResponse, error)
-	// InstantExpiryFuturesMarketLaunch defines a method for creating a new expiry
-	// futures market by paying listing fee without governance
-	InstantExpiryFuturesMarketLaunch(context.Context, *MsgInstantExpiryFuturesMarketLaunch) (*MsgInstantExpiryFuturesMarketLaunchResponse, error)
-	// CreateSpotLimitOrder defines a method for creating a new spot limit order.
-	CreateSpotLimitOrder(context.Context, *MsgCreateSpotLimitOrder) (*MsgCreateSpotLimitOrderResponse, error)
-	// BatchCreateSpotLimitOrder defines a method for creating a new batch of spot
-	// limit orders.
-	BatchCreateSpotLimitOrders(context.Context, *MsgBatchCreateSpotLimitOrders) (*MsgBatchCreateSpotLimitOrdersResponse, error)
-	// CreateSpotMarketOrder defines a method for creating a new spot market
-	// order.
-	CreateSpotMarketOrder(context.Context, *MsgCreateSpotMarketOrder) (*MsgCreateSpotMarketOrderResponse, error)
-	// MsgCancelSpotOrder defines a method for cancelling a spot order.
-	CancelSpotOrder(context.Context, *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error)
-	// BatchCancelSpotOrders defines a method for cancelling a batch of spot
-	// orders in a given market.
-	BatchCancelSpotOrders(context.Context, *MsgBatchCancelSpotOrders) (*MsgBatchCancelSpotOrdersResponse, error)
-	// BatchUpdateOrders defines a method for updating a batch of orders.
-	BatchUpdateOrders(context.Context, *MsgBatchUpdateOrders) (*MsgBatchUpdateOrdersResponse, error)
-	// PrivilegedExecuteContract defines a method for executing a Cosmwasm
-	// contract from the exchange module with privileged capabilities.
-	PrivilegedExecuteContract(context.Context, *MsgPrivilegedExecuteContract) (*MsgPrivilegedExecuteContractResponse, error)
-	// CreateDerivativeLimitOrder defines a method for creating a new derivative
-	// limit order.
-	CreateDerivativeLimitOrder(context.Context, *MsgCreateDerivativeLimitOrder) (*MsgCreateDerivativeLimitOrderResponse, error)
-	// BatchCreateDerivativeLimitOrders defines a method for creating a new batch
-	// of derivative limit orders.
-	BatchCreateDerivativeLimitOrders(context.Context, *MsgBatchCreateDerivativeLimitOrders) (*MsgBatchCreateDerivativeLimitOrdersResponse, error)
-	// MsgCreateDerivativeLimitOrder defines a method for creating a new
-	// derivative market order.
-	CreateDerivativeMarketOrder(context.Context, *MsgCreateDerivativeMarketOrder) (*MsgCreateDerivativeMarketOrderResponse, error)
-	// MsgCancelDerivativeOrder defines a method for cancelling a derivative
-	// order.
-	CancelDerivativeOrder(context.Context, *MsgCancelDerivativeOrder) (*MsgCancelDerivativeOrderResponse, error)
-	// MsgBatchCancelDerivativeOrders defines a method for cancelling a batch of
-	// derivative limit orders.
-	BatchCancelDerivativeOrders(context.Context, *MsgBatchCancelDerivativeOrders) (*MsgBatchCancelDerivativeOrdersResponse, error)
-	// InstantBinaryOptionsMarketLaunch defines method for creating a binary
-	// options market by paying listing fee without governance
-	InstantBinaryOptionsMarketLaunch(context.Context, *MsgInstantBinaryOptionsMarketLaunch) (*MsgInstantBinaryOptionsMarketLaunchResponse, error)
-	// CreateBinaryOptionsLimitOrder defines a method for creating a new binary
-	// options limit order.
-	CreateBinaryOptionsLimitOrder(context.Context, *MsgCreateBinaryOptionsLimitOrder) (*MsgCreateBinaryOptionsLimitOrderResponse, error)
-	// CreateBinaryOptionsMarketOrder defines a method for creating a new binary
-	// options market order.
-	CreateBinaryOptionsMarketOrder(context.Context, *MsgCreateBinaryOptionsMarketOrder) (*MsgCreateBinaryOptionsMarketOrderResponse, error)
-	// MsgCancelBinaryOptionsOrder defines a method for cancelling a binary
-	// options order.
-	CancelBinaryOptionsOrder(context.Context, *MsgCancelBinaryOptionsOrder) (*MsgCancelBinaryOptionsOrderResponse, error)
-	// BatchCancelBinaryOptionsOrders defines a method for cancelling a batch of
-	// binary options limit orders.
-	BatchCancelBinaryOptionsOrders(context.Context, *MsgBatchCancelBinaryOptionsOrders) (*MsgBatchCancelBinaryOptionsOrdersResponse, error)
-	// SubaccountTransfer defines a method for transfer between subaccounts
-	SubaccountTransfer(context.Context, *MsgSubaccountTransfer) (*MsgSubaccountTransferResponse, error)
-	// ExternalTransfer defines a method for transfer between external accounts
-	ExternalTransfer(context.Context, *MsgExternalTransfer) (*MsgExternalTransferResponse, error)
-	// LiquidatePosition defines a method for liquidating a position
-	LiquidatePosition(context.Context, *MsgLiquidatePosition) (*MsgLiquidatePositionResponse, error)
-	// EmergencySettleMarket defines a method for emergency settling a market
-	EmergencySettleMarket(context.Context, *MsgEmergencySettleMarket) (*MsgEmergencySettleMarketResponse, error)
-	// IncreasePositionMargin defines a method for increasing margin of a position
-	IncreasePositionMargin(context.Context, *MsgIncreasePositionMargin) (*MsgIncreasePositionMarginResponse, error)
-	// RewardsOptOut defines a method for opting out of rewards
-	RewardsOptOut(context.Context, *MsgRewardsOptOut) (*MsgRewardsOptOutResponse, error)
-	// AdminUpdateBinaryOptionsMarket defines method for updating a binary options
-	// market by admin
-	AdminUpdateBinaryOptionsMarket(context.Context, *MsgAdminUpdateBinaryOptionsMarket) (*MsgAdminUpdateBinaryOptionsMarketResponse, error)
-	//
-	ReclaimLockedFunds(context.Context, *MsgReclaimLockedFunds) (*MsgReclaimLockedFundsResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgReclaimLockedFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimLockedFundsResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgReclaimLockedFundsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgReclaimLockedFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimLockedFundsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+
+var xxx_messageInfo_MsgReclaimLockedFundsResponse proto.InternalMessageInfo
+
+// MsgSignData defines an arbitrary, general-purpose, off-chain message
+type MsgSignData struct {
+	// Signer is the sdk.AccAddress of the message signer
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=Signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer"`
+	// Data represents the raw bytes of the content that is signed (text, json,
+	// etc)
+	Data []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"data"`
 }
-func (*UnimplementedMsgServer) InstantSpotMarketLaunch(ctx context.Context, req *MsgInstantSpotMarketLaunch) (*MsgInstantSpotMarketLaunchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantSpotMarketLaunch not implemented")
+
+func (m *MsgSignData) Reset()         { *m = MsgSignData{} }
+func (m *MsgSignData) String() string { return proto.CompactTextString(m) }
+func (*MsgSignData) ProtoMessage()    {}
+func (*MsgSignData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{75}
 }
-func (*UnimplementedMsgServer) InstantPerpetualMarketLaunch(ctx context.Context, req *MsgInstantPerpetualMarketLaunch) (*MsgInstantPerpetualMarketLaunchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantPerpetualMarketLaunch not implemented")
+func (m *MsgSignData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) InstantExpiryFuturesMarketLaunch(ctx context.Context, req *MsgInstantExpiryFuturesMarketLaunch) (*MsgInstantExpiryFuturesMarketLaunchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantExpiryFuturesMarketLaunch not implemented")
+func (m *MsgSignData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateSpotLimitOrder(ctx context.Context, req *MsgCreateSpotLimitOrder) (*MsgCreateSpotLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSpotLimitOrder not implemented")
+func (m *MsgSignData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignData.Merge(m, src)
 }
-func (*UnimplementedMsgServer) BatchCreateSpotLimitOrders(ctx context.Context, req *MsgBatchCreateSpotLimitOrders) (*MsgBatchCreateSpotLimitOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateSpotLimitOrders not implemented")
+func (m *MsgSignData) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreateSpotMarketOrder(ctx context.Context, req *MsgCreateSpotMarketOrder) (*MsgCreateSpotMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSpotMarketOrder not implemented")
+func (m *MsgSignData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignData.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CancelSpotOrder(ctx context.Context, req *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelSpotOrder not implemented")
+
+var xxx_messageInfo_MsgSignData proto.InternalMessageInfo
+
+func (m *MsgSignData) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) BatchCancelSpotOrders(ctx context.Context, req *MsgBatchCancelSpotOrders) (*MsgBatchCancelSpotOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelSpotOrders not implemented")
+
+func (m *MsgSignData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) BatchUpdateOrders(ctx context.Context, req *MsgBatchUpdateOrders) (*MsgBatchUpdateOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateOrders not implemented")
+
+// MsgSignDoc defines an arbitrary, general-purpose, off-chain message
+type MsgSignDoc struct {
+	SignType string      `protobuf:"bytes,1,opt,name=sign_type,json=signType,proto3" json:"type"`
+	Value    MsgSignData `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
 }
-func (*UnimplementedMsgServer) PrivilegedExecuteContract(ctx context.Context, req *MsgPrivilegedExecuteContract) (*MsgPrivilegedExecuteContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PrivilegedExecuteContract not implemented")
+
+func (m *MsgSignDoc) Reset()         { *m = MsgSignDoc{} }
+func (m *MsgSignDoc) String() string { return proto.CompactTextString(m) }
+func (*MsgSignDoc) ProtoMessage()    {}
+func (*MsgSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{76}
 }
-func (*UnimplementedMsgServer) CreateDerivativeLimitOrder(ctx context.Context, req *MsgCreateDerivativeLimitOrder) (*MsgCreateDerivativeLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDerivativeLimitOrder not implemented")
+func (m *MsgSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) BatchCreateDerivativeLimitOrders(ctx context.Context, req *MsgBatchCreateDerivativeLimitOrders) (*MsgBatchCreateDerivativeLimitOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateDerivativeLimitOrders not implemented")
+func (m *MsgSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateDerivativeMarketOrder(ctx context.Context, req *MsgCreateDerivativeMarketOrder) (*MsgCreateDerivativeMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDerivativeMarketOrder not implemented")
+func (m *MsgSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignDoc.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelDerivativeOrder(ctx context.Context, req *MsgCancelDerivativeOrder) (*MsgCancelDerivativeOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelDerivativeOrder not implemented")
+func (m *MsgSignDoc) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) BatchCancelDerivativeOrders(ctx context.Context, req *MsgBatchCancelDerivativeOrders) (*MsgBatchCancelDerivativeOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelDerivativeOrders not implemented")
+func (m *MsgSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignDoc.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) InstantBinaryOptionsMarketLaunch(ctx context.Context, req *MsgInstantBinaryOptionsMarketLaunch) (*MsgInstantBinaryOptionsMarketLaunchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantBinaryOptionsMarketLaunch not implemented")
+
+var xxx_messageInfo_MsgSignDoc proto.InternalMessageInfo
+
+func (m *MsgSignDoc) GetSignType() string {
+	if m != nil {
+		return m.SignType
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) CreateBinaryOptionsLimitOrder(ctx context.Context, req *MsgCreateBinaryOptionsLimitOrder) (*MsgCreateBinaryOptionsLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBinaryOptionsLimitOrder not implemented")
-}
-func (*UnimplementedMsgServer) CreateBinaryOptionsMarketOrder(ctx context.Context, req *MsgCreateBinaryOptionsMarketOrder) (*MsgCreateBinaryOptionsMarketOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBinaryOptionsMarketOrder not implemented")
-}
-func (*UnimplementedMsgServer) CancelBinaryOptionsOrder(ctx context.Context, req *MsgCancelBinaryOptionsOrder) (*MsgCancelBinaryOptionsOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelBinaryOptionsOrder not implemented")
-}
-func (*UnimplementedMsgServer) BatchCancelBinaryOptionsOrders(ctx context.Context, req *MsgBatchCancelBinaryOptionsOrders) (*MsgBatchCancelBinaryOptionsOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelBinaryOptionsOrders not implemented")
-}
-func (*UnimplementedMsgServer) SubaccountTransfer(ctx context.Context, req *MsgSubaccountTransfer) (*MsgSubaccountTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubaccountTransfer not implemented")
-}
-func (*UnimplementedMsgServer) ExternalTransfer(ctx context.Context, req *MsgExternalTransfer) (*MsgExternalTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExternalTransfer not implemented")
+
+func (m *MsgSignDoc) GetValue() MsgSignData {
+	if m != nil {
+		return m.Value
+	}
+	return MsgSignData{}
 }
-func (*UnimplementedMsgServer) LiquidatePosition(ctx context.Context, req *MsgLiquidatePosition) (*MsgLiquidatePositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidatePosition not implemented")
+
+// MsgAdminUpdateBinaryOptionsMarket is used by the market Admin to operate the
+// market
+type MsgAdminUpdateBinaryOptionsMarket struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// new price at which market will be settled
+	SettlementPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price,omitempty"`
+	// expiration timestamp
+	ExpirationTimestamp int64 `protobuf:"varint,4,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	// expiration timestamp
+	SettlementTimestamp int64 `protobuf:"varint,5,opt,name=settlement_timestamp,json=settlementTimestamp,proto3" json:"settlement_timestamp,omitempty"`
+	// Status of the market
+	Status MarketStatus `protobuf:"varint,6,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
 }
-func (*UnimplementedMsgServer) EmergencySettleMarket(ctx context.Context, req *MsgEmergencySettleMarket) (*MsgEmergencySettleMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EmergencySettleMarket not implemented")
+
+func (m *MsgAdminUpdateBinaryOptionsMarket) Reset()         { *m = MsgAdminUpdateBinaryOptionsMarket{} }
+func (m *MsgAdminUpdateBinaryOptionsMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgAdminUpdateBinaryOptionsMarket) ProtoMessage()    {}
+func (*MsgAdminUpdateBinaryOptionsMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{77}
 }
-func (*UnimplementedMsgServer) IncreasePositionMargin(ctx context.Context, req *MsgIncreasePositionMargin) (*MsgIncreasePositionMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncreasePositionMargin not implemented")
+func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RewardsOptOut(ctx context.Context, req *MsgRewardsOptOut) (*MsgRewardsOptOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsOptOut not implemented")
+func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AdminUpdateBinaryOptionsMarket(ctx context.Context, req *MsgAdminUpdateBinaryOptionsMarket) (*MsgAdminUpdateBinaryOptionsMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateBinaryOptionsMarket not implemented")
+func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ReclaimLockedFunds(ctx context.Context, req *MsgReclaimLockedFunds) (*MsgReclaimLockedFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReclaimLockedFunds not implemented")
+func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAdminUpdateBinaryOptionsMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarket proto.InternalMessageInfo
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+func (m *MsgAdminUpdateBinaryOptionsMarket) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+func (m *MsgAdminUpdateBinaryOptionsMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InstantSpotMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantSpotMarketLaunch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantSpotMarketLaunch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/InstantSpotMarketLaunch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantSpotMarketLaunch(ctx, req.(*MsgInstantSpotMarketLaunch))
+func (m *MsgAdminUpdateBinaryOptionsMarket) GetExpirationTimestamp() int64 {
+	if m != nil {
+		return m.ExpirationTimestamp
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_InstantPerpetualMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantPerpetualMarketLaunch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantPerpetualMarketLaunch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/InstantPerpetualMarketLaunch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantPerpetualMarketLaunch(ctx, req.(*MsgInstantPerpetualMarketLaunch))
+func (m *MsgAdminUpdateBinaryOptionsMarket) GetSettlementTimestamp() int64 {
+	if m != nil {
+		return m.SettlementTimestamp
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_InstantExpiryFuturesMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantExpiryFuturesMarketLaunch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantExpiryFuturesMarketLaunch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/InstantExpiryFuturesMarketLaunch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantExpiryFuturesMarketLaunch(ctx, req.(*MsgInstantExpiryFuturesMarketLaunch))
+func (m *MsgAdminUpdateBinaryOptionsMarket) GetStatus() MarketStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return MarketStatus_Unspecified
 }
 
-func _Msg_CreateSpotLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSpotLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSpotLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CreateSpotLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSpotLimitOrder(ctx, req.(*MsgCreateSpotLimitOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAdminUpdateBinaryOptionsMarketResponse is the response for
+// AdminUpdateBinaryOptionsMarket rpc method
+type MsgAdminUpdateBinaryOptionsMarketResponse struct {
 }
 
-func _Msg_BatchCreateSpotLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBatchCreateSpotLimitOrders)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BatchCreateSpotLimitOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCreateSpotLimitOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BatchCreateSpotLimitOrders(ctx, req.(*MsgBatchCreateSpotLimitOrders))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) Reset() {
+	*m = MsgAdminUpdateBinaryOptionsMarketResponse{}
 }
-
-func _Msg_CreateSpotMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSpotMarketOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSpotMarketOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CreateSpotMarketOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSpotMarketOrder(ctx, req.(*MsgCreateSpotMarketOrder))
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAdminUpdateBinaryOptionsMarketResponse) ProtoMessage() {}
+func (*MsgAdminUpdateBinaryOptionsMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd45b74cb6d81462, []int{78}
+}
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse.Merge(m, src)
+}
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse.DiscardUnknown(m)
 }
 
-func _Msg_CancelSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSpotOrder)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_MsgAdminUpdateBinaryOptionsMarketResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "injective.exchange.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.exchange.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgForceSettleMarket)(nil), "injective.exchange.v1beta1.MsgForceSettleMarket")
+	proto.RegisterType((*MsgForceSettleMarketResponse)(nil), "injective.exchange.v1beta1.MsgForceSettleMarketResponse")
+	proto.RegisterType((*MsgCancelAllOrdersInMarket)(nil), "injective.exchange.v1beta1.MsgCancelAllOrdersInMarket")
+	proto.RegisterType((*MsgCancelAllOrdersInMarketResponse)(nil), "injective.exchange.v1beta1.MsgCancelAllOrdersInMarketResponse")
+	proto.RegisterType((*MsgBatchCancelOrders)(nil), "injective.exchange.v1beta1.MsgBatchCancelOrders")
+	proto.RegisterType((*MsgBatchCancelOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCancelOrdersResponse")
+	proto.RegisterType((*MsgReplaceOrder)(nil), "injective.exchange.v1beta1.MsgReplaceOrder")
+	proto.RegisterType((*MsgReplaceOrderResponse)(nil), "injective.exchange.v1beta1.MsgReplaceOrderResponse")
+	proto.RegisterType((*MsgSetMarginMode)(nil), "injective.exchange.v1beta1.MsgSetMarginMode")
+	proto.RegisterType((*MsgSetMarginModeResponse)(nil), "injective.exchange.v1beta1.MsgSetMarginModeResponse")
+	proto.RegisterType((*MsgTransferPosition)(nil), "injective.exchange.v1beta1.MsgTransferPosition")
+	proto.RegisterType((*MsgTransferPositionResponse)(nil), "injective.exchange.v1beta1.MsgTransferPositionResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "injective.exchange.v1beta1.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "injective.exchange.v1beta1.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "injective.exchange.v1beta1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "injective.exchange.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgCreateSpotLimitOrder)(nil), "injective.exchange.v1beta1.MsgCreateSpotLimitOrder")
+	proto.RegisterType((*MsgCreateSpotLimitOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateSpotLimitOrderResponse")
+	proto.RegisterType((*MsgBatchCreateSpotLimitOrders)(nil), "injective.exchange.v1beta1.MsgBatchCreateSpotLimitOrders")
+	proto.RegisterType((*MsgBatchCreateSpotLimitOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCreateSpotLimitOrdersResponse")
+	proto.RegisterType((*MsgInstantSpotMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantSpotMarketLaunch")
+	proto.RegisterType((*MsgInstantSpotMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantSpotMarketLaunchResponse")
+	proto.RegisterType((*MsgInstantPerpetualMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantPerpetualMarketLaunch")
+	proto.RegisterType((*MsgInstantPerpetualMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantPerpetualMarketLaunchResponse")
+	proto.RegisterType((*MsgInstantBinaryOptionsMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantBinaryOptionsMarketLaunch")
+	proto.RegisterType((*MsgInstantBinaryOptionsMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantBinaryOptionsMarketLaunchResponse")
+	proto.RegisterType((*MsgInstantExpiryFuturesMarketLaunch)(nil), "injective.exchange.v1beta1.MsgInstantExpiryFuturesMarketLaunch")
+	proto.RegisterType((*MsgInstantExpiryFuturesMarketLaunchResponse)(nil), "injective.exchange.v1beta1.MsgInstantExpiryFuturesMarketLaunchResponse")
+	proto.RegisterType((*MsgCreateSpotMarketOrder)(nil), "injective.exchange.v1beta1.MsgCreateSpotMarketOrder")
+	proto.RegisterType((*MsgCreateSpotMarketOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateSpotMarketOrderResponse")
+	proto.RegisterType((*SpotMarketOrderResults)(nil), "injective.exchange.v1beta1.SpotMarketOrderResults")
+	proto.RegisterType((*MsgCreateDerivativeLimitOrder)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeLimitOrder")
+	proto.RegisterType((*MsgCreateDerivativeLimitOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeLimitOrderResponse")
+	proto.RegisterType((*MsgCreateBinaryOptionsLimitOrder)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsLimitOrder")
+	proto.RegisterType((*MsgCreateBinaryOptionsLimitOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsLimitOrderResponse")
+	proto.RegisterType((*MsgBatchCreateDerivativeLimitOrders)(nil), "injective.exchange.v1beta1.MsgBatchCreateDerivativeLimitOrders")
+	proto.RegisterType((*MsgBatchCreateDerivativeLimitOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCreateDerivativeLimitOrdersResponse")
+	proto.RegisterType((*MsgCancelSpotOrder)(nil), "injective.exchange.v1beta1.MsgCancelSpotOrder")
+	proto.RegisterType((*MsgCancelSpotOrderResponse)(nil), "injective.exchange.v1beta1.MsgCancelSpotOrderResponse")
+	proto.RegisterType((*MsgBatchCancelSpotOrders)(nil), "injective.exchange.v1beta1.MsgBatchCancelSpotOrders")
+	proto.RegisterType((*MsgBatchCancelSpotOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCancelSpotOrdersResponse")
+	proto.RegisterType((*MsgBatchCancelBinaryOptionsOrders)(nil), "injective.exchange.v1beta1.MsgBatchCancelBinaryOptionsOrders")
+	proto.RegisterType((*MsgBatchCancelBinaryOptionsOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCancelBinaryOptionsOrdersResponse")
+	proto.RegisterType((*MsgBatchUpdateOrders)(nil), "injective.exchange.v1beta1.MsgBatchUpdateOrders")
+	proto.RegisterType((*MsgBatchUpdateOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchUpdateOrdersResponse")
+	proto.RegisterType((*MsgCreateDerivativeMarketOrder)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeMarketOrder")
+	proto.RegisterType((*MsgCreateDerivativeMarketOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateDerivativeMarketOrderResponse")
+	proto.RegisterType((*DerivativeMarketOrderResults)(nil), "injective.exchange.v1beta1.DerivativeMarketOrderResults")
+	proto.RegisterType((*MsgCreateBinaryOptionsMarketOrder)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsMarketOrder")
+	proto.RegisterType((*MsgCreateBinaryOptionsMarketOrderResponse)(nil), "injective.exchange.v1beta1.MsgCreateBinaryOptionsMarketOrderResponse")
+	proto.RegisterType((*MsgCancelDerivativeOrder)(nil), "injective.exchange.v1beta1.MsgCancelDerivativeOrder")
+	proto.RegisterType((*MsgCancelDerivativeOrderResponse)(nil), "injective.exchange.v1beta1.MsgCancelDerivativeOrderResponse")
+	proto.RegisterType((*MsgCancelBinaryOptionsOrder)(nil), "injective.exchange.v1beta1.MsgCancelBinaryOptionsOrder")
+	proto.RegisterType((*MsgCancelBinaryOptionsOrderResponse)(nil), "injective.exchange.v1beta1.MsgCancelBinaryOptionsOrderResponse")
+	proto.RegisterType((*OrderData)(nil), "injective.exchange.v1beta1.OrderData")
+	proto.RegisterType((*MsgBatchCancelDerivativeOrders)(nil), "injective.exchange.v1beta1.MsgBatchCancelDerivativeOrders")
+	proto.RegisterType((*MsgBatchCancelDerivativeOrdersResponse)(nil), "injective.exchange.v1beta1.MsgBatchCancelDerivativeOrdersResponse")
+	proto.RegisterType((*MsgSubaccountTransfer)(nil), "injective.exchange.v1beta1.MsgSubaccountTransfer")
+	proto.RegisterType((*MsgSubaccountTransferResponse)(nil), "injective.exchange.v1beta1.MsgSubaccountTransferResponse")
+	proto.RegisterType((*MsgExternalTransfer)(nil), "injective.exchange.v1beta1.MsgExternalTransfer")
+	proto.RegisterType((*MsgExternalTransferResponse)(nil), "injective.exchange.v1beta1.MsgExternalTransferResponse")
+	proto.RegisterType((*MsgLiquidatePosition)(nil), "injective.exchange.v1beta1.MsgLiquidatePosition")
+	proto.RegisterType((*MsgLiquidatePositionResponse)(nil), "injective.exchange.v1beta1.MsgLiquidatePositionResponse")
+	proto.RegisterType((*MsgEmergencySettleMarket)(nil), "injective.exchange.v1beta1.MsgEmergencySettleMarket")
+	proto.RegisterType((*MsgEmergencySettleMarketResponse)(nil), "injective.exchange.v1beta1.MsgEmergencySettleMarketResponse")
+	proto.RegisterType((*MsgIncreasePositionMargin)(nil), "injective.exchange.v1beta1.MsgIncreasePositionMargin")
+	proto.RegisterType((*MsgIncreasePositionMarginResponse)(nil), "injective.exchange.v1beta1.MsgIncreasePositionMarginResponse")
+	proto.RegisterType((*MsgPrivilegedExecuteContract)(nil), "injective.exchange.v1beta1.MsgPrivilegedExecuteContract")
+	proto.RegisterType((*MsgPrivilegedExecuteContractResponse)(nil), "injective.exchange.v1beta1.MsgPrivilegedExecuteContractResponse")
+	proto.RegisterType((*MsgRewardsOptOut)(nil), "injective.exchange.v1beta1.MsgRewardsOptOut")
+	proto.RegisterType((*MsgRewardsOptOutResponse)(nil), "injective.exchange.v1beta1.MsgRewardsOptOutResponse")
+	proto.RegisterType((*MsgReclaimLockedFunds)(nil), "injective.exchange.v1beta1.MsgReclaimLockedFunds")
+	proto.RegisterType((*MsgReclaimLockedFundsResponse)(nil), "injective.exchange.v1beta1.MsgReclaimLockedFundsResponse")
+	proto.RegisterType((*MsgSignData)(nil), "injective.exchange.v1beta1.MsgSignData")
+	proto.RegisterType((*MsgSignDoc)(nil), "injective.exchange.v1beta1.MsgSignDoc")
+	proto.RegisterType((*MsgAdminUpdateBinaryOptionsMarket)(nil), "injective.exchange.v1beta1.MsgAdminUpdateBinaryOptionsMarket")
+	proto.RegisterType((*MsgAdminUpdateBinaryOptionsMarketResponse)(nil), "injective.exchange.v1beta1.MsgAdminUpdateBinaryOptionsMarketResponse")
+}
+
+func init() {
+	proto.RegisterFile("injective/exchange/v1beta1/tx.proto", fileDescriptor_bd45b74cb6d81462)
+}
+
+var fileDescriptor_bd45b74cb6d81462 = []byte{
+	// 3546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0xdd, 0x6f, 0x1c, 0xd7,
+	0x75, 0xd7, 0x70, 0xc9, 0x25, 0xf7, 0x90, 0x14, 0xa5, 0x21, 0x45, 0xad, 0x46, 0x12, 0x49, 0x91,
+	0xd6, 0x07, 0xad, 0x8a, 0xd4, 0x57, 0xf5, 0x41, 0x4b, 0x96, 0xf8, 0x29, 0xcb, 0x16, 0x2b, 0x7a,
+	0xa8, 0xba, 0xad, 0x81, 0x76, 0x3b, 0x9c, 0xb9, 0x5c, 0x8e, 0xb9, 0x3b, 0xb3, 0x9a, 0x3b, 0x2b,
+	0x8b, 0x46, 0x81, 0xba, 0x46, 0x1f, 0x5c, 0xf7, 0x03, 0x75, 0x6b, 0xc3, 0xb5, 0x5b, 0xa3, 0x46,
+	0x02, 0x24, 0xc8, 0x07, 0x02, 0x3f, 0xe4, 0x31, 0xcf, 0x81, 0x1f, 0x8d, 0x00, 0x09, 0x8c, 0x3c,
+	0x28, 0x89, 0x85, 0x20, 0x86, 0xff, 0x80, 0x3c, 0xf8, 0x21, 0x08, 0xe6, 0xde, 0x3b, 0x77, 0xbe,
+	0x77, 0x66, 0x87, 0xa6, 0xa4, 0xf8, 0x49, 0x9c, 0x7b, 0xcf, 0xef, 0xdc, 0x73, 0xce, 0x3d, 0xe7,
+	0xdc, 0xaf, 0xa3, 0x85, 0x09, 0xdd, 0x78, 0x05, 0xa9, 0xb6, 0x7e, 0x0f, 0x4d, 0xa3, 0xfb, 0xea,
+	0x86, 0x62, 0x54, 0xd1, 0xf4, 0xbd, 0x33, 0x6b, 0xc8, 0x56, 0xce, 0x4c, 0xdb, 0xf7, 0xa7, 0x1a,
+	0x96, 0x69, 0x9b, 0xa2, 0xc4, 0x89, 0xa6, 0x5c, 0xa2, 0x29, 0x46, 0x24, 0x8d, 0xa8, 0x26, 0xae,
+	0x9b, 0x78, 0x7a, 0x4d, 0xc1, 0x1e, 0x52, 0x35, 0x75, 0x83, 0x62, 0xa5, 0x29, 0xd6, 0xaf, 0xe9,
+	0xd8, 0xb6, 0xf4, 0xb5, 0xa6, 0xad, 0x9b, 0x06, 0xa7, 0xf3, 0x37, 0x32, 0xfa, 0xfd, 0x8c, 0xbe,
+	0x8e, 0xab, 0xd3, 0xf7, 0xce, 0x38, 0xff, 0xb0, 0x8e, 0x03, 0xb4, 0xa3, 0x42, 0xbe, 0xa6, 0xe9,
+	0x07, 0xeb, 0x1a, 0xaa, 0x9a, 0x55, 0x93, 0xb6, 0x3b, 0x7f, 0xb1, 0xd6, 0xc9, 0x16, 0xaa, 0x71,
+	0x35, 0x28, 0xe9, 0x51, 0x8f, 0xd4, 0xb4, 0x14, 0xb5, 0xe6, 0x11, 0xd2, 0x4f, 0x4a, 0x36, 0xfe,
+	0xbf, 0x02, 0x0c, 0x2c, 0xe3, 0xea, 0x5f, 0x36, 0x34, 0xc5, 0x46, 0x2b, 0x8a, 0xa5, 0xd4, 0xb1,
+	0x78, 0x01, 0x4a, 0x4a, 0xd3, 0xde, 0x30, 0x2d, 0xdd, 0xde, 0x2a, 0x0b, 0x63, 0xc2, 0x89, 0xd2,
+	0x5c, 0xf9, 0x67, 0x3f, 0x3e, 0x35, 0xc4, 0x04, 0x9c, 0xd5, 0x34, 0x0b, 0x61, 0xbc, 0x6a, 0x5b,
+	0xba, 0x51, 0x95, 0x3d, 0x52, 0xf1, 0x3a, 0x14, 0x1b, 0x84, 0x43, 0xb9, 0x63, 0x4c, 0x38, 0xd1,
+	0x7b, 0x76, 0x7c, 0x2a, 0xd9, 0xc8, 0x53, 0x74, 0xac, 0xb9, 0xce, 0x4f, 0x1e, 0x8c, 0xee, 0x92,
+	0x19, 0x6e, 0x66, 0xf7, 0x1b, 0xbf, 0xfb, 0xf8, 0x69, 0x8f, 0xe3, 0xf8, 0x01, 0xd8, 0x1f, 0x12,
+	0x4e, 0x46, 0xb8, 0x61, 0x1a, 0x18, 0x8d, 0xff, 0x42, 0x80, 0xa1, 0x65, 0x5c, 0x5d, 0x32, 0x2d,
+	0x15, 0xad, 0x22, 0xdb, 0xae, 0xa1, 0x65, 0xc5, 0xda, 0x44, 0x76, 0x6e, 0xe9, 0x0f, 0x42, 0xa9,
+	0x4e, 0x38, 0x54, 0x74, 0x8d, 0x28, 0x50, 0x92, 0x7b, 0x68, 0xc3, 0x4d, 0x4d, 0xfc, 0x1b, 0xd8,
+	0x83, 0xc9, 0x20, 0x75, 0x64, 0xd8, 0x95, 0x86, 0xa5, 0xab, 0xa8, 0x5c, 0x20, 0xbc, 0xa7, 0x1c,
+	0x05, 0x7e, 0xf9, 0x60, 0xf4, 0x58, 0x55, 0xb7, 0x37, 0x9a, 0x6b, 0x53, 0xaa, 0x59, 0x67, 0x33,
+	0xc9, 0xfe, 0x39, 0x85, 0xb5, 0xcd, 0x69, 0x7b, 0xab, 0x81, 0xf0, 0xd4, 0x02, 0x52, 0xe5, 0x01,
+	0x8f, 0xcf, 0x8a, 0xc3, 0x26, 0xa2, 0xf3, 0x08, 0x1c, 0x8a, 0xd3, 0x8b, 0x2b, 0xfe, 0x4f, 0x02,
+	0x48, 0xcb, 0xb8, 0x3a, 0xaf, 0x18, 0x2a, 0xaa, 0xcd, 0xd6, 0x6a, 0xb7, 0x2d, 0x0d, 0x59, 0xf8,
+	0xa6, 0xb1, 0x83, 0xea, 0x47, 0x64, 0x7c, 0x43, 0x80, 0xf1, 0x64, 0x19, 0x5c, 0x51, 0xc5, 0xf3,
+	0x30, 0xac, 0x12, 0x92, 0x1a, 0xd2, 0x2a, 0x26, 0xa1, 0xa9, 0xa8, 0x66, 0xd3, 0xb0, 0x89, 0x60,
+	0xfd, 0xf2, 0x10, 0xef, 0xa5, 0x0c, 0xe6, 0x9d, 0x3e, 0xf1, 0x18, 0x0c, 0x6c, 0x28, 0xb8, 0x52,
+	0x37, 0x2d, 0xc4, 0x40, 0x44, 0x9e, 0x1e, 0xb9, 0x7f, 0x43, 0xc1, 0xcb, 0xa6, 0x85, 0x28, 0xf1,
+	0xf8, 0xeb, 0xd4, 0x03, 0xe6, 0x14, 0x5b, 0xdd, 0xa0, 0x92, 0xd0, 0x0e, 0x71, 0x18, 0x8a, 0x18,
+	0x19, 0x1a, 0xb2, 0xa8, 0xfe, 0x32, 0xfb, 0x12, 0xaf, 0x41, 0xa7, 0xa6, 0xd8, 0x4a, 0xb9, 0x63,
+	0xac, 0x70, 0xa2, 0xf7, 0xec, 0xd1, 0x56, 0xde, 0x49, 0x38, 0x2d, 0x28, 0xb6, 0xc2, 0x1c, 0x94,
+	0x00, 0x67, 0x06, 0xde, 0xfc, 0x68, 0x74, 0x97, 0x63, 0x0a, 0xc6, 0x71, 0x5c, 0x85, 0x43, 0x71,
+	0x12, 0x70, 0x03, 0x94, 0xa1, 0x1b, 0x37, 0x55, 0x15, 0x61, 0x5c, 0x16, 0xc6, 0x0a, 0x27, 0x7a,
+	0x64, 0xf7, 0x53, 0x1c, 0x85, 0x5e, 0x64, 0x59, 0xa6, 0x55, 0x51, 0x4d, 0x0d, 0x61, 0x22, 0x52,
+	0xbf, 0x0c, 0xa4, 0x69, 0xde, 0x69, 0x99, 0xe9, 0x71, 0xc6, 0xfa, 0xe2, 0xa3, 0xd1, 0x5d, 0xe3,
+	0xdf, 0xea, 0x20, 0x21, 0x2a, 0xa3, 0x46, 0x4d, 0x51, 0xa9, 0xf2, 0x89, 0x2a, 0x3e, 0x07, 0x25,
+	0xb3, 0xc6, 0x6c, 0xcd, 0xa2, 0xb0, 0x2d, 0x3d, 0x7b, 0xcc, 0x1a, 0x9d, 0x0b, 0x71, 0x01, 0x00,
+	0x37, 0x4c, 0x9b, 0xb1, 0x2a, 0xa4, 0xb3, 0x5a, 0x6d, 0x98, 0x36, 0x81, 0xca, 0x25, 0xec, 0xfe,
+	0x29, 0xbe, 0x04, 0x7b, 0x34, 0x64, 0xe9, 0xf7, 0x14, 0x07, 0xc3, 0x78, 0x75, 0x12, 0x5e, 0x27,
+	0x5b, 0xf1, 0x5a, 0xe0, 0x18, 0xca, 0x71, 0x40, 0x0b, 0x36, 0x44, 0x67, 0xe2, 0x12, 0xec, 0x0f,
+	0xd9, 0x88, 0x4f, 0xc2, 0x61, 0x00, 0x32, 0x70, 0x65, 0x43, 0xc1, 0x1b, 0xcc, 0x5e, 0x25, 0xd2,
+	0xf2, 0x9c, 0x82, 0x37, 0xc6, 0xbf, 0x27, 0xc0, 0x9e, 0x65, 0x5c, 0x5d, 0x45, 0xf6, 0xb2, 0x62,
+	0x55, 0x75, 0x63, 0xd9, 0xd4, 0x50, 0xa2, 0x7d, 0x27, 0xa0, 0x1f, 0x37, 0xd7, 0x14, 0x95, 0x78,
+	0xb1, 0x17, 0x29, 0x7d, 0x5e, 0xe3, 0x4d, 0x4d, 0xbc, 0x01, 0xbd, 0x75, 0xc2, 0xaa, 0x52, 0x37,
+	0x35, 0x9a, 0x27, 0x76, 0x9f, 0x3d, 0xd6, 0x4a, 0x5f, 0x6f, 0x64, 0x19, 0xea, 0xfc, 0xef, 0xa8,
+	0x96, 0x12, 0x94, 0xc3, 0xa2, 0xf2, 0xbc, 0xf0, 0x6e, 0x07, 0x0c, 0x2e, 0xe3, 0xea, 0x1d, 0x4b,
+	0x31, 0xf0, 0x3a, 0xb2, 0x56, 0x4c, 0xac, 0x3b, 0x6b, 0x50, 0xa2, 0x2a, 0x2d, 0xf3, 0xdd, 0x69,
+	0x18, 0xc2, 0x66, 0xd3, 0x52, 0x51, 0x25, 0xa8, 0x2e, 0xc9, 0x79, 0xb2, 0x48, 0xfb, 0x56, 0xfd,
+	0x4a, 0xcf, 0xc0, 0x01, 0x0d, 0x61, 0x5b, 0x37, 0x14, 0x67, 0xd4, 0x10, 0xac, 0x93, 0xc0, 0xf6,
+	0xfb, 0x08, 0x02, 0xd8, 0xe7, 0xa1, 0xe7, 0x6e, 0x53, 0x31, 0x6c, 0x27, 0x65, 0x75, 0xe5, 0xca,
+	0xaa, 0x1c, 0x1f, 0xb5, 0xd9, 0x61, 0x38, 0x18, 0x63, 0x16, 0x6e, 0xb6, 0xf7, 0x04, 0x80, 0x65,
+	0x5c, 0x5d, 0x40, 0x0d, 0xa7, 0x67, 0x7b, 0x13, 0x7f, 0x11, 0x8a, 0x4a, 0xdd, 0xf9, 0x9b, 0xc5,
+	0xcb, 0x01, 0xb6, 0x53, 0x98, 0x72, 0x76, 0x12, 0x7c, 0xb2, 0xe7, 0x4d, 0xdd, 0x70, 0xd7, 0x3d,
+	0x4a, 0x3e, 0x33, 0xe8, 0x06, 0xbb, 0x5f, 0xf0, 0x21, 0x10, 0x3d, 0xc1, 0xb8, 0xbc, 0xff, 0x23,
+	0x40, 0xef, 0x32, 0xae, 0xfe, 0x95, 0x6e, 0x6f, 0x68, 0x96, 0xf2, 0xea, 0x93, 0x24, 0xf0, 0x3e,
+	0x18, 0xf4, 0x49, 0xc6, 0x25, 0xfe, 0x17, 0x81, 0xc4, 0xe6, 0xbc, 0x85, 0x14, 0x1b, 0x39, 0x59,
+	0xe2, 0x96, 0x5e, 0xd7, 0xed, 0xd6, 0x79, 0x6c, 0x16, 0xba, 0x32, 0xe7, 0x30, 0x9e, 0x78, 0x98,
+	0x8c, 0x14, 0x19, 0x2f, 0xe2, 0xf3, 0x30, 0x9a, 0x20, 0x4a, 0xc6, 0x74, 0xe1, 0xcb, 0xcb, 0x6f,
+	0x0b, 0x70, 0x98, 0x67, 0xff, 0x18, 0x8e, 0xc9, 0x0b, 0xd1, 0x3c, 0x14, 0xf9, 0xc2, 0x56, 0x68,
+	0x57, 0x3d, 0x06, 0x8d, 0xd7, 0xef, 0x0e, 0x1c, 0x6d, 0x29, 0x12, 0xd7, 0xf2, 0x08, 0xf4, 0x79,
+	0x5a, 0x22, 0xba, 0x3c, 0x95, 0xe4, 0x5e, 0xae, 0x67, 0x60, 0x05, 0xfa, 0x6d, 0x07, 0xd9, 0x72,
+	0xdc, 0x34, 0xb0, 0xad, 0x18, 0xb6, 0xc3, 0x92, 0x2e, 0xf4, 0xb7, 0x94, 0xa6, 0xa1, 0x6e, 0x24,
+	0xaa, 0x39, 0x0c, 0x45, 0x5b, 0x57, 0x37, 0xd9, 0x2c, 0x96, 0x64, 0xf6, 0xe5, 0x58, 0xd8, 0xf1,
+	0xaf, 0x8a, 0x86, 0x0c, 0xb3, 0xce, 0x52, 0x4a, 0xc9, 0x69, 0x59, 0x70, 0x1a, 0x9c, 0xa5, 0xf1,
+	0x6e, 0xd3, 0xb4, 0xdd, 0x7e, 0x9a, 0x3b, 0x80, 0x34, 0x51, 0x82, 0xbf, 0x85, 0xc1, 0xba, 0x6e,
+	0xd0, 0x5d, 0x58, 0xc5, 0xe1, 0x59, 0xc1, 0xfa, 0x6b, 0x28, 0x67, 0xe6, 0xd8, 0x53, 0xd7, 0x0d,
+	0xb2, 0x11, 0xbb, 0xa3, 0xab, 0x9b, 0xab, 0xfa, 0x6b, 0x48, 0x54, 0x61, 0xd8, 0x61, 0xef, 0x66,
+	0x14, 0xdf, 0x08, 0xc5, 0x5c, 0x23, 0x38, 0xc2, 0xbe, 0xc8, 0x98, 0xb9, 0x83, 0xc4, 0xcf, 0xde,
+	0x53, 0x30, 0x9e, 0x6c, 0x66, 0x1e, 0x4f, 0x7f, 0x28, 0xc2, 0xa8, 0x47, 0xb6, 0x82, 0xac, 0x06,
+	0xb2, 0x9b, 0x4a, 0x6d, 0x5b, 0x53, 0x12, 0xb2, 0x79, 0x21, 0x62, 0xf3, 0x51, 0xe8, 0xa5, 0xe7,
+	0x86, 0x8a, 0x33, 0x51, 0xee, 0xa4, 0xd0, 0xa6, 0x39, 0xc5, 0x75, 0x28, 0x42, 0x40, 0x50, 0x74,
+	0x36, 0x64, 0x06, 0x7a, 0xd1, 0x69, 0x12, 0xa7, 0x60, 0x90, 0x91, 0x60, 0x55, 0xa9, 0xa1, 0xca,
+	0xba, 0xa2, 0xda, 0xa6, 0x45, 0xac, 0xda, 0x2f, 0xef, 0xa5, 0x5d, 0xab, 0x4e, 0xcf, 0x12, 0xe9,
+	0x10, 0x17, 0xf9, 0x98, 0x8e, 0x31, 0xcb, 0xdd, 0x64, 0x1d, 0x7d, 0xca, 0x17, 0x2b, 0xb4, 0xd7,
+	0xb7, 0x99, 0x71, 0x3e, 0xef, 0x6c, 0x35, 0x90, 0x2b, 0x99, 0xf3, 0xb7, 0x78, 0x07, 0x76, 0xd7,
+	0x95, 0x4d, 0x64, 0x55, 0xd6, 0x11, 0xaa, 0x58, 0x8a, 0x8d, 0xca, 0x3d, 0xb9, 0xe6, 0xb1, 0x8f,
+	0x70, 0x59, 0x42, 0x48, 0x56, 0x6c, 0xc2, 0xd5, 0x0e, 0x72, 0x2d, 0xe5, 0xe3, 0x6a, 0xfb, 0xb9,
+	0xfe, 0x3d, 0x0c, 0xe9, 0x86, 0x6e, 0xeb, 0x4a, 0xad, 0xc2, 0xb6, 0x10, 0x96, 0xb3, 0x5c, 0x96,
+	0x21, 0x17, 0x6f, 0x91, 0xf1, 0xa2, 0xbb, 0x05, 0xd9, 0xe1, 0x24, 0x6e, 0x40, 0xb9, 0xae, 0xe8,
+	0x86, 0x8d, 0x0c, 0xc5, 0x50, 0x51, 0x70, 0x94, 0xde, 0x5c, 0xa3, 0x0c, 0xfb, 0xf8, 0xf9, 0x47,
+	0x4a, 0x08, 0xd3, 0xbe, 0x1d, 0x0f, 0xd3, 0xfe, 0x1d, 0x0e, 0xd3, 0x49, 0x38, 0x9e, 0x12, 0x7f,
+	0x3c, 0x56, 0x7f, 0x52, 0x84, 0x09, 0x8f, 0x76, 0x4e, 0x37, 0x14, 0x6b, 0xeb, 0x76, 0xc3, 0xd9,
+	0x80, 0xe0, 0x6d, 0xc5, 0xeb, 0x04, 0xf4, 0xbb, 0xa1, 0xb4, 0x55, 0x5f, 0x33, 0x6b, 0x2c, 0x62,
+	0x59, 0x08, 0xae, 0x92, 0x36, 0xf1, 0x38, 0x0c, 0x30, 0xa2, 0x86, 0x65, 0xde, 0xd3, 0xdd, 0xbd,
+	0x77, 0x49, 0xde, 0x4d, 0x9b, 0x57, 0x58, 0x6b, 0x38, 0xd0, 0xba, 0x72, 0x06, 0x5a, 0xbb, 0xf1,
+	0x1d, 0x0d, 0xcc, 0xee, 0x1d, 0x09, 0xcc, 0x9e, 0xaf, 0x21, 0x30, 0xcf, 0xc0, 0x10, 0xba, 0xdf,
+	0xd0, 0x2d, 0xba, 0xbb, 0xb5, 0xf5, 0x3a, 0xc2, 0xb6, 0x52, 0x6f, 0x90, 0xa0, 0x2f, 0xc8, 0x83,
+	0x5e, 0xdf, 0x1d, 0xb7, 0xcb, 0x81, 0xf8, 0xee, 0x0c, 0x3c, 0x08, 0x50, 0x88, 0xd7, 0xe7, 0x41,
+	0x86, 0xa0, 0x4b, 0xd1, 0xea, 0xba, 0x41, 0x23, 0x51, 0xa6, 0x1f, 0xe1, 0xe4, 0xdc, 0x97, 0x75,
+	0x41, 0xec, 0xdf, 0xf1, 0x48, 0xdb, 0xbd, 0xc3, 0x91, 0x76, 0x0a, 0x4e, 0x66, 0x88, 0x1e, 0x1e,
+	0x6d, 0x1f, 0x74, 0xfb, 0xa3, 0x6d, 0xd1, 0x99, 0x93, 0xad, 0xa5, 0xa6, 0xdd, 0xb4, 0x10, 0x7e,
+	0xf2, 0x57, 0xc7, 0x50, 0x10, 0x16, 0xbf, 0xde, 0x20, 0xec, 0x4e, 0x0a, 0xc2, 0x61, 0x28, 0x12,
+	0xe7, 0xdd, 0x22, 0x61, 0x52, 0x90, 0xd9, 0x57, 0x4c, 0x70, 0x96, 0x76, 0x24, 0x38, 0x61, 0x07,
+	0x57, 0xcd, 0xde, 0x47, 0xb2, 0x6a, 0xf6, 0x3d, 0x8a, 0x55, 0xf3, 0x1b, 0x16, 0xcb, 0x89, 0xb1,
+	0xc9, 0x63, 0xf9, 0x2d, 0x81, 0xdc, 0x75, 0x78, 0xa7, 0x18, 0x4a, 0xf5, 0x78, 0x8e, 0x8d, 0xff,
+	0x2f, 0xc0, 0x58, 0x92, 0x30, 0x19, 0x0f, 0x8e, 0xa2, 0x0c, 0xdd, 0x16, 0xc2, 0xcd, 0x9a, 0xed,
+	0x5e, 0x8f, 0x9f, 0x4d, 0x93, 0x2e, 0x38, 0x88, 0x83, 0x24, 0xa2, 0x0a, 0xb2, 0xcb, 0xc8, 0x77,
+	0x44, 0xfb, 0xbd, 0x00, 0xc3, 0xf1, 0x98, 0xc0, 0xed, 0x8a, 0xb0, 0xbd, 0xdb, 0x15, 0x71, 0x01,
+	0xba, 0xe8, 0xe5, 0x77, 0x47, 0x2e, 0x46, 0x14, 0x2c, 0x5e, 0x87, 0xc2, 0x3a, 0xca, 0x7b, 0x81,
+	0xee, 0x40, 0xa3, 0xa7, 0x70, 0x3a, 0x35, 0xde, 0xbd, 0x61, 0x86, 0x3b, 0x86, 0x1b, 0x41, 0x67,
+	0x69, 0xe7, 0x42, 0x32, 0xe8, 0x32, 0x91, 0x2b, 0xa7, 0x15, 0x38, 0xda, 0x52, 0xa4, 0xf6, 0xef,
+	0x1a, 0xde, 0xf1, 0x3b, 0x60, 0x60, 0x21, 0x7c, 0xac, 0x8a, 0xae, 0xc2, 0x89, 0x34, 0xa9, 0xda,
+	0xd7, 0xf5, 0x7d, 0x01, 0x26, 0x82, 0x97, 0x18, 0x71, 0x36, 0x4c, 0xbe, 0x5d, 0xb9, 0x19, 0xba,
+	0x5d, 0xc9, 0xa1, 0xaf, 0x7b, 0xc7, 0x12, 0x51, 0xf8, 0x65, 0x38, 0x99, 0x41, 0xb4, 0x7c, 0xb7,
+	0x2c, 0x1f, 0x0b, 0xe4, 0xc2, 0x8f, 0x3e, 0x24, 0xf0, 0xec, 0x94, 0xef, 0xfe, 0x36, 0x72, 0xfb,
+	0x57, 0x88, 0xb9, 0xfd, 0x0b, 0xce, 0x48, 0x67, 0x38, 0x61, 0xed, 0x81, 0x82, 0xaa, 0x6b, 0x6c,
+	0xab, 0xe2, 0xfc, 0x19, 0x35, 0xc7, 0x21, 0x90, 0xa2, 0x12, 0xf3, 0x14, 0xfe, 0xcf, 0x34, 0x85,
+	0xfb, 0x9e, 0x47, 0x38, 0xcd, 0xa3, 0x7c, 0xa4, 0x59, 0x82, 0xb1, 0x24, 0x29, 0xd2, 0x1f, 0x6a,
+	0x7c, 0xf3, 0xf3, 0xef, 0x02, 0x1c, 0x09, 0x32, 0x0a, 0xb8, 0xfc, 0x23, 0xd7, 0xeb, 0x36, 0x4c,
+	0xa6, 0x8a, 0xd3, 0x96, 0x82, 0x9f, 0x76, 0x7b, 0x0f, 0x6a, 0xf4, 0xcd, 0x35, 0x45, 0xa7, 0x4c,
+	0x77, 0xcc, 0xd7, 0xe0, 0x30, 0x79, 0x48, 0xe2, 0xce, 0x8a, 0x2b, 0xb6, 0x59, 0xa1, 0x2f, 0x7f,
+	0x15, 0xa5, 0xe6, 0x1c, 0x5d, 0x9d, 0xa0, 0x28, 0x63, 0xbe, 0x7a, 0xdd, 0xd4, 0xf0, 0x1d, 0x93,
+	0xbf, 0x2d, 0x8a, 0x2f, 0xc0, 0x84, 0xef, 0x0d, 0x29, 0x91, 0x4d, 0x27, 0x61, 0x33, 0xe2, 0x91,
+	0xc6, 0x32, 0xfb, 0x3b, 0xd8, 0xe7, 0x3d, 0x6b, 0xf9, 0x58, 0x94, 0xbb, 0xda, 0x9d, 0x17, 0x41,
+	0x16, 0xf9, 0x3b, 0x17, 0x1f, 0x42, 0x7c, 0x05, 0x0e, 0x86, 0x1f, 0xbc, 0xfc, 0xa3, 0x14, 0xdb,
+	0x1f, 0xa5, 0x1c, 0x7a, 0xfb, 0xf2, 0xc6, 0x8a, 0xd1, 0x85, 0xe4, 0xa4, 0x72, 0x77, 0xbb, 0xb7,
+	0xca, 0x61, 0x5d, 0x08, 0x1b, 0xb1, 0x91, 0xa4, 0x0b, 0x1d, 0xa5, 0x27, 0x5f, 0x76, 0x8d, 0xd7,
+	0x88, 0x8e, 0x78, 0x17, 0x46, 0xd7, 0x88, 0x13, 0x57, 0x4c, 0xea, 0xc5, 0x51, 0x0b, 0x96, 0xda,
+	0xb7, 0xe0, 0xc1, 0xb5, 0x68, 0x60, 0x70, 0x23, 0xca, 0x70, 0x3c, 0x34, 0x64, 0xa2, 0x87, 0x01,
+	0xf1, 0xb0, 0x23, 0x6b, 0xd1, 0x73, 0x68, 0xc8, 0xc9, 0x5e, 0x6d, 0xa5, 0x06, 0x35, 0x5e, 0x6f,
+	0x5e, 0xe3, 0x25, 0x28, 0x43, 0xb8, 0x46, 0x73, 0xc4, 0x57, 0x1d, 0x70, 0x28, 0x2e, 0xa4, 0x79,
+	0x5e, 0x98, 0x82, 0x41, 0xe2, 0x43, 0x4c, 0xcd, 0x60, 0x8e, 0xd8, 0xeb, 0x74, 0xb1, 0x9c, 0x49,
+	0x3b, 0xe8, 0x33, 0x1f, 0xf7, 0x89, 0x10, 0xaa, 0x83, 0xa0, 0xf6, 0x7b, 0x04, 0x41, 0xec, 0xd3,
+	0xb0, 0xd7, 0xf3, 0x57, 0x77, 0x49, 0xa4, 0xd1, 0x3f, 0xc0, 0xdd, 0x8f, 0x2e, 0x8b, 0xe2, 0x05,
+	0xd8, 0x1f, 0xf6, 0x3d, 0x17, 0x41, 0x03, 0x7d, 0x5f, 0xc8, 0x89, 0x18, 0x6e, 0x16, 0x0e, 0x87,
+	0x4c, 0x1f, 0x92, 0xb1, 0x8b, 0xc8, 0x28, 0x05, 0xac, 0x18, 0x14, 0xf3, 0x2a, 0x1c, 0x8c, 0x9b,
+	0x3d, 0x77, 0xf8, 0x22, 0x4d, 0x57, 0xd1, 0x69, 0x88, 0x2c, 0xe8, 0xff, 0x25, 0xc0, 0x48, 0xcc,
+	0x3e, 0x30, 0xcb, 0x41, 0x66, 0xe7, 0xb6, 0x6c, 0x3f, 0x10, 0xe0, 0x58, 0x6b, 0xa1, 0xb2, 0x1e,
+	0x68, 0xfe, 0x3a, 0x7c, 0xa0, 0xb9, 0x94, 0x4d, 0xca, 0x76, 0x8e, 0x35, 0xff, 0x57, 0x80, 0x43,
+	0xad, 0x90, 0xdf, 0xc4, 0xc3, 0x8d, 0xf8, 0x12, 0xec, 0x6e, 0xb0, 0x67, 0xea, 0x8a, 0x86, 0x6a,
+	0xb6, 0xc2, 0x4a, 0x26, 0x26, 0x5b, 0xd6, 0x53, 0x31, 0xc4, 0x82, 0x03, 0x60, 0x3e, 0xd0, 0xdf,
+	0xf0, 0x37, 0x8a, 0x4b, 0x4e, 0x7d, 0xd6, 0x96, 0xd9, 0xb4, 0x73, 0x3e, 0x95, 0x31, 0xb4, 0x6f,
+	0x7a, 0xde, 0xa5, 0x5b, 0xa2, 0x98, 0x03, 0xc0, 0xe3, 0x75, 0xf2, 0x1f, 0x09, 0x30, 0x99, 0x2a,
+	0xd7, 0x93, 0xe4, 0xe7, 0x3f, 0x67, 0xb7, 0x1d, 0x24, 0x11, 0x85, 0x74, 0x7d, 0x7c, 0x27, 0x00,
+	0xde, 0x5d, 0x57, 0xf0, 0x26, 0x71, 0x9a, 0x2e, 0xd6, 0xbd, 0xac, 0xe0, 0x4d, 0xf7, 0x80, 0x50,
+	0x6c, 0x71, 0x40, 0x18, 0x87, 0xb1, 0x24, 0xb5, 0xf8, 0x31, 0xe1, 0x33, 0x01, 0x0e, 0x72, 0xa2,
+	0xe8, 0x1e, 0xf6, 0x4f, 0x59, 0xfd, 0xa3, 0x30, 0xd1, 0x42, 0x33, 0x6e, 0x81, 0x0f, 0x05, 0x28,
+	0xf1, 0x4d, 0x4b, 0x50, 0x2f, 0x21, 0x4d, 0xaf, 0x8e, 0x54, 0xbd, 0x0a, 0xad, 0xf5, 0xea, 0x4c,
+	0xd0, 0xcb, 0x3b, 0xf7, 0x8d, 0xbf, 0x45, 0x17, 0x32, 0xdf, 0x51, 0x23, 0x34, 0x97, 0x8f, 0xf2,
+	0xd8, 0x73, 0x0b, 0x8e, 0xb5, 0x96, 0xa5, 0xad, 0x33, 0xcf, 0x43, 0x01, 0xf6, 0x39, 0x25, 0x55,
+	0xdc, 0x7c, 0x6e, 0xa1, 0x50, 0xa2, 0x46, 0x49, 0xa5, 0x51, 0x1d, 0xf9, 0x4a, 0xa3, 0x0a, 0xad,
+	0x4b, 0xa3, 0xbc, 0x0a, 0x9d, 0xce, 0xf6, 0x2a, 0x74, 0x7a, 0xfd, 0x36, 0x1b, 0x25, 0x77, 0x64,
+	0x51, 0x25, 0xb9, 0x07, 0xfe, 0x46, 0x20, 0xb5, 0x3b, 0x8b, 0xf7, 0x6d, 0x64, 0x19, 0x4a, 0xed,
+	0x1b, 0x69, 0x04, 0x5a, 0x08, 0x16, 0x56, 0x91, 0x9b, 0xe0, 0xa7, 0xb4, 0x9c, 0xf4, 0x96, 0x7e,
+	0xb7, 0xa9, 0x93, 0x7a, 0xe3, 0xb4, 0x02, 0xba, 0x4c, 0xa1, 0x18, 0x08, 0xe6, 0x42, 0x28, 0x98,
+	0xf9, 0x02, 0xd8, 0x99, 0x6f, 0x01, 0x14, 0xdc, 0x05, 0x30, 0xa0, 0x27, 0x2d, 0x20, 0x8e, 0xe8,
+	0xe1, 0x2f, 0x20, 0x76, 0xd6, 0x9a, 0xc5, 0x3a, 0xb2, 0xaa, 0xc8, 0x50, 0xb7, 0x02, 0xd5, 0xd3,
+	0x3b, 0xa6, 0xec, 0x4c, 0x6f, 0x74, 0x5d, 0x88, 0x15, 0x81, 0xcb, 0xf9, 0xdf, 0x1d, 0x70, 0x80,
+	0xbc, 0x18, 0xa8, 0x16, 0x52, 0x30, 0xd7, 0x83, 0x3e, 0x96, 0x3c, 0x21, 0x9e, 0x19, 0xd0, 0xb8,
+	0x33, 0x34, 0xbd, 0x4b, 0xdc, 0x6d, 0x73, 0xee, 0xb7, 0xe2, 0xbc, 0x78, 0x02, 0x8e, 0x24, 0x1a,
+	0x85, 0x9b, 0xee, 0x23, 0x81, 0xf8, 0xc0, 0x8a, 0xa5, 0xdf, 0xd3, 0x6b, 0xa8, 0x8a, 0xb4, 0xc5,
+	0xfb, 0x48, 0x6d, 0xda, 0x68, 0xde, 0x34, 0x6c, 0x4b, 0x51, 0x93, 0xa7, 0x79, 0x08, 0xba, 0xd6,
+	0x9b, 0x86, 0x86, 0x99, 0xb9, 0xe8, 0x87, 0x38, 0x09, 0x7b, 0x54, 0x86, 0xac, 0x28, 0xb4, 0x80,
+	0x9c, 0x19, 0x66, 0xc0, 0x6d, 0x67, 0x75, 0xe5, 0xa2, 0xc8, 0xf2, 0x3d, 0xb5, 0x05, 0x4d, 0xe1,
+	0xb1, 0x4f, 0x2a, 0xdf, 0x15, 0xe0, 0xa9, 0x56, 0x22, 0xf2, 0x2c, 0xfe, 0x0a, 0x00, 0x91, 0xa2,
+	0xa2, 0xe9, 0xeb, 0xeb, 0x24, 0x91, 0xb7, 0x4c, 0x00, 0xa7, 0x1d, 0x23, 0x7f, 0xff, 0x57, 0xa3,
+	0x27, 0x32, 0x18, 0xd9, 0x01, 0x60, 0xb9, 0x44, 0xd8, 0x2f, 0xe8, 0xeb, 0xeb, 0xf1, 0x92, 0x3e,
+	0x4d, 0xea, 0x83, 0x65, 0xf4, 0xaa, 0x62, 0x69, 0xf8, 0x76, 0xc3, 0xbe, 0xdd, 0x4c, 0xb4, 0x1f,
+	0x2b, 0xd0, 0x0d, 0xd0, 0xf2, 0x49, 0xf9, 0x37, 0xba, 0xd4, 0xc8, 0x48, 0xad, 0x29, 0x7a, 0xfd,
+	0x96, 0xa9, 0x6e, 0x22, 0x6d, 0x89, 0xd8, 0x37, 0xd9, 0x97, 0x07, 0x6b, 0x84, 0x6c, 0x96, 0x3a,
+	0xdc, 0x4a, 0x73, 0xed, 0x05, 0xb4, 0x45, 0xe6, 0xa6, 0x4f, 0x8e, 0xeb, 0x12, 0x0f, 0x41, 0x09,
+	0xeb, 0x55, 0x43, 0xb1, 0x9b, 0x16, 0x3d, 0x82, 0xf4, 0xc9, 0x5e, 0x43, 0xdc, 0x9a, 0x10, 0x95,
+	0x86, 0xcb, 0xfb, 0x3a, 0xad, 0x34, 0x5d, 0xd5, 0xab, 0x06, 0xd9, 0x97, 0xac, 0x42, 0xd1, 0xf9,
+	0x9b, 0x49, 0xd9, 0x37, 0xf7, 0xcc, 0x97, 0x0f, 0x46, 0x8b, 0x98, 0xb4, 0x7c, 0xf5, 0x60, 0xf4,
+	0x54, 0x06, 0x7b, 0xcf, 0xaa, 0x2a, 0xf3, 0x13, 0x99, 0xb1, 0x12, 0x0f, 0x41, 0xe7, 0x02, 0xdd,
+	0x1f, 0x38, 0x2c, 0x7b, 0xbe, 0x7c, 0x30, 0x4a, 0x7c, 0x46, 0x26, 0xad, 0xe3, 0xf7, 0x49, 0x6d,
+	0x2e, 0x91, 0xc0, 0x54, 0xc5, 0xa3, 0x54, 0x39, 0xfa, 0x3e, 0x4e, 0x0f, 0x7b, 0x04, 0xe0, 0x7c,
+	0xcb, 0x3d, 0x4e, 0x17, 0x79, 0x01, 0x9f, 0x87, 0xae, 0x7b, 0x4a, 0xad, 0x89, 0xd8, 0x6e, 0xfd,
+	0x78, 0xcb, 0xc2, 0x6b, 0x4f, 0x3f, 0xf7, 0x48, 0x41, 0xb0, 0xe3, 0x5f, 0x74, 0x90, 0x38, 0x9b,
+	0x75, 0x0a, 0x30, 0xe8, 0xc5, 0x49, 0xcc, 0x31, 0x22, 0xdf, 0xd6, 0xb4, 0xf5, 0xff, 0x25, 0x11,
+	0xb6, 0xf1, 0x7f, 0x49, 0x12, 0xab, 0x54, 0x3a, 0xdb, 0xaf, 0x52, 0xe9, 0x4a, 0xae, 0x52, 0xb9,
+	0x0e, 0x45, 0x6c, 0x2b, 0x76, 0x13, 0xb3, 0x22, 0x85, 0x13, 0x29, 0xa5, 0xed, 0x9b, 0xc8, 0x5e,
+	0x25, 0xf4, 0x32, 0xc3, 0x05, 0x1d, 0xf1, 0x24, 0x4c, 0xa6, 0x5a, 0xda, 0x75, 0xca, 0xb3, 0xef,
+	0x4f, 0x42, 0x61, 0x19, 0x57, 0x45, 0x05, 0xba, 0xdd, 0x92, 0xed, 0x63, 0x29, 0x13, 0xcc, 0xe8,
+	0xa4, 0xa9, 0x6c, 0x74, 0x3c, 0xf1, 0x68, 0xd0, 0xc3, 0xab, 0xac, 0xd3, 0x9c, 0xc8, 0x25, 0x94,
+	0xa6, 0x33, 0x12, 0xf2, 0x51, 0xde, 0x16, 0x60, 0x7f, 0x52, 0x61, 0xed, 0x85, 0x14, 0x66, 0x09,
+	0x38, 0xe9, 0xd9, 0x7c, 0x38, 0x2e, 0x93, 0xb3, 0x7c, 0xb4, 0x2c, 0x2f, 0x7d, 0x26, 0xdb, 0x00,
+	0xb1, 0x60, 0x69, 0x7e, 0x1b, 0x60, 0x2e, 0xe2, 0x0f, 0x05, 0x18, 0x4b, 0xad, 0xf3, 0xb9, 0x96,
+	0x6d, 0xa4, 0x44, 0x06, 0xd2, 0x8d, 0x6d, 0x32, 0xe0, 0xe2, 0xbe, 0x29, 0xc0, 0x50, 0x6c, 0x01,
+	0xfc, 0xb9, 0x94, 0x11, 0xe2, 0x40, 0xd2, 0x33, 0x39, 0x40, 0x5c, 0x94, 0x0f, 0x04, 0x90, 0x5a,
+	0xd4, 0xac, 0x5f, 0x4e, 0xe1, 0x9d, 0x0c, 0x95, 0x66, 0x73, 0x43, 0xb9, 0x70, 0xff, 0x2a, 0xc0,
+	0xbe, 0xf8, 0x92, 0x8f, 0xf3, 0x99, 0x75, 0xf6, 0xa1, 0xa4, 0x2b, 0x79, 0x50, 0x5c, 0x9a, 0x2d,
+	0x18, 0x08, 0xbf, 0xc6, 0xa6, 0x25, 0x91, 0x10, 0xbd, 0x74, 0xa1, 0x3d, 0xfa, 0x80, 0x21, 0xe2,
+	0x1f, 0x4e, 0xcf, 0x67, 0xb2, 0x72, 0x08, 0x25, 0x5d, 0xc9, 0x83, 0xe2, 0xd2, 0xfc, 0x23, 0xec,
+	0x8d, 0xbe, 0x0a, 0x9e, 0xce, 0xc2, 0xd2, 0x8f, 0x90, 0x2e, 0xb5, 0x8b, 0xe0, 0x02, 0xbc, 0x27,
+	0xc0, 0x81, 0xe4, 0xdd, 0x6c, 0x1a, 0xdf, 0x44, 0xa4, 0x74, 0x3d, 0x2f, 0x32, 0x10, 0x4e, 0x2d,
+	0x8a, 0x4f, 0x2e, 0x67, 0x72, 0xc0, 0x38, 0xa8, 0x34, 0x9b, 0x1b, 0x1a, 0xc8, 0x92, 0xa9, 0x75,
+	0x14, 0xd7, 0xb2, 0x87, 0x6d, 0x2c, 0x03, 0xe9, 0xc6, 0x36, 0x19, 0x70, 0x71, 0x3f, 0x14, 0xe0,
+	0x60, 0xab, 0xd7, 0x92, 0x99, 0x36, 0x2d, 0xe2, 0xcf, 0x04, 0x73, 0xf9, 0xb1, 0xc1, 0xec, 0x14,
+	0x7b, 0x45, 0x7b, 0x3e, 0x53, 0x98, 0x87, 0x50, 0xd2, 0x95, 0x3c, 0xa8, 0x80, 0xb5, 0x5a, 0x5d,
+	0xc9, 0xcd, 0x64, 0x0f, 0xf9, 0x30, 0x56, 0x9a, 0xcb, 0x8f, 0x8d, 0x5b, 0xa2, 0x93, 0x0b, 0xdf,
+	0x33, 0x2e, 0xd1, 0x89, 0x0c, 0xa4, 0x1b, 0xdb, 0x64, 0xc0, 0xc5, 0xfd, 0xb6, 0x00, 0x87, 0x5b,
+	0xd7, 0x57, 0x65, 0x5b, 0x4c, 0x12, 0xd0, 0xd2, 0xc2, 0x76, 0xd0, 0x5c, 0xca, 0xef, 0x08, 0x30,
+	0x92, 0xf2, 0xdc, 0x72, 0xb5, 0xfd, 0x81, 0xfc, 0x81, 0xb2, 0xb8, 0x2d, 0x38, 0x17, 0xf4, 0x1d,
+	0x01, 0xca, 0x89, 0x57, 0xfa, 0x17, 0x33, 0x39, 0x7e, 0x14, 0x28, 0x5d, 0xcb, 0x09, 0x0c, 0xd8,
+	0x2f, 0xa5, 0x82, 0xe7, 0x6a, 0x76, 0xdf, 0x8f, 0x81, 0x4b, 0x8b, 0xdb, 0x82, 0x73, 0x41, 0xdf,
+	0x10, 0x40, 0x8c, 0xb9, 0x95, 0x3e, 0x93, 0x76, 0x9a, 0x8d, 0x40, 0xa4, 0xcb, 0x6d, 0x43, 0xb8,
+	0x10, 0xff, 0x00, 0x7b, 0x22, 0x57, 0xc2, 0x69, 0x27, 0x9c, 0x30, 0x40, 0xba, 0xd8, 0x26, 0xc0,
+	0xbf, 0xeb, 0x88, 0xde, 0xc6, 0xa6, 0xed, 0x3a, 0x22, 0x08, 0xe9, 0x52, 0xbb, 0x88, 0x40, 0xbe,
+	0x8f, 0xbf, 0x26, 0x4d, 0xcb, 0xf7, 0xb1, 0x28, 0xe9, 0x4a, 0x1e, 0x14, 0x97, 0xe6, 0x3f, 0x04,
+	0x18, 0x4e, 0xb8, 0x0c, 0xfd, 0xf3, 0xd4, 0x24, 0x18, 0x07, 0x93, 0xae, 0xe6, 0x82, 0x71, 0x81,
+	0x30, 0xf4, 0x07, 0x6f, 0xc5, 0xfe, 0x2c, 0x85, 0x5f, 0x80, 0x5a, 0x3a, 0xdf, 0x0e, 0x75, 0x20,
+	0x80, 0x53, 0x6e, 0x65, 0xd2, 0xd4, 0x6a, 0x0d, 0x97, 0x16, 0xb7, 0x05, 0x0f, 0x04, 0x70, 0xcc,
+	0x5d, 0xdf, 0x99, 0x54, 0xad, 0xc3, 0x10, 0xe9, 0x72, 0xdb, 0x10, 0x2e, 0x44, 0x03, 0xfa, 0x02,
+	0x3f, 0xed, 0x72, 0x32, 0x85, 0x95, 0x9f, 0x58, 0x3a, 0xd7, 0x06, 0xb1, 0x3f, 0x68, 0xa3, 0xbf,
+	0xc9, 0x92, 0x16, 0xb4, 0x11, 0x84, 0x74, 0xa9, 0x5d, 0x44, 0xe0, 0x42, 0x25, 0xf1, 0xc7, 0x51,
+	0x32, 0x2d, 0x1f, 0x11, 0x9c, 0xf4, 0x6c, 0x3e, 0x5c, 0xe4, 0xfc, 0x14, 0xf8, 0x99, 0x92, 0xd3,
+	0xd9, 0x17, 0x8a, 0x76, 0xce, 0x4f, 0xb1, 0x3f, 0x44, 0xd2, 0x80, 0xbe, 0xc0, 0xef, 0x87, 0x9c,
+	0x4c, 0x75, 0x29, 0x8f, 0x58, 0x3a, 0xd7, 0x06, 0xb1, 0x3f, 0x39, 0x04, 0x7f, 0x52, 0x23, 0x2d,
+	0x39, 0x04, 0xa8, 0xa5, 0xf3, 0xed, 0x50, 0xfb, 0xd7, 0xab, 0xc8, 0xef, 0x5f, 0xa4, 0xad, 0x57,
+	0x61, 0x80, 0x74, 0xb1, 0x4d, 0x80, 0x3b, 0xfa, 0xdc, 0xc6, 0x27, 0x9f, 0x8f, 0x08, 0x9f, 0x7e,
+	0x3e, 0x22, 0xfc, 0xfa, 0xf3, 0x11, 0xe1, 0x3f, 0x1f, 0x8e, 0xec, 0xfa, 0xf4, 0xe1, 0xc8, 0xae,
+	0xcf, 0x1e, 0x8e, 0xec, 0x7a, 0xf9, 0x2f, 0x7c, 0x17, 0xba, 0x37, 0x5d, 0xe6, 0xb7, 0x94, 0x35,
+	0x3c, 0xcd, 0x87, 0x3a, 0xa5, 0x9a, 0x16, 0xf2, 0x7f, 0x6e, 0x28, 0xba, 0x31, 0x5d, 0x37, 0xb5,
+	0x66, 0x0d, 0x61, 0xef, 0xd7, 0x9e, 0xc8, 0xe5, 0xef, 0x5a, 0x91, 0xfc, 0x78, 0xd3, 0xb9, 0x3f,
+	0x0e, 0x00, 0x3f, 0x51, 0x45, 0x29, 0xeb, 0x4a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Deposit defines a method for transferring coins from the sender's bank
+	// balance into the subaccount's exchange deposits
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// Withdraw defines a method for withdrawing coins from a subaccount's
+	// deposits to the user's bank balance
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// InstantSpotMarketLaunch defines method for creating a spot market by paying
+	// listing fee without governance
+	InstantSpotMarketLaunch(ctx context.Context, in *MsgInstantSpotMarketLaunch, opts ...grpc.CallOption) (*MsgInstantSpotMarketLaunchResponse, error)
+	// InstantPerpetualMarketLaunch defines a method for creating a new perpetual
+	// futures market by paying listing fee without governance
+	InstantPerpetualMarketLaunch(ctx context.Context, in *MsgInstantPerpetualMarketLaunch, opts ...grpc.CallOption) (*MsgInstantPerpetualMarketLaunchResponse, error)
+	// InstantExpiryFuturesMarketLaunch defines a method for creating a new expiry
+	// futures market by paying listing fee without governance
+	InstantExpiryFuturesMarketLaunch(ctx context.Context, in *MsgInstantExpiryFuturesMarketLaunch, opts ...grpc.CallOption) (*MsgInstantExpiryFuturesMarketLaunchResponse, error)
+	// CreateSpotLimitOrder defines a method for creating a new spot limit order.
+	CreateSpotLimitOrder(ctx context.Context, in *MsgCreateSpotLimitOrder, opts ...grpc.CallOption) (*MsgCreateSpotLimitOrderResponse, error)
+	// BatchCreateSpotLimitOrder defines a method for creating a new batch of spot
+	// limit orders.
+	BatchCreateSpotLimitOrders(ctx context.Context, in *MsgBatchCreateSpotLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateSpotLimitOrdersResponse, error)
+	// CreateSpotMarketOrder defines a method for creating a new spot market
+	// order.
+	CreateSpotMarketOrder(ctx context.Context, in *MsgCreateSpotMarketOrder, opts ...grpc.CallOption) (*MsgCreateSpotMarketOrderResponse, error)
+	// MsgCancelSpotOrder defines a method for cancelling a spot order.
+	CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error)
+	// BatchCancelSpotOrders defines a method for cancelling a batch of spot
+	// orders in a given market.
+	BatchCancelSpotOrders(ctx context.Context, in *MsgBatchCancelSpotOrders, opts ...grpc.CallOption) (*MsgBatchCancelSpotOrdersResponse, error)
+	// BatchUpdateOrders defines a method for updating a batch of orders.
+	BatchUpdateOrders(ctx context.Context, in *MsgBatchUpdateOrders, opts ...grpc.CallOption) (*MsgBatchUpdateOrdersResponse, error)
+	// PrivilegedExecuteContract defines a method for executing a Cosmwasm
+	// contract from the exchange module with privileged capabilities.
+	PrivilegedExecuteContract(ctx context.Context, in *MsgPrivilegedExecuteContract, opts ...grpc.CallOption) (*MsgPrivilegedExecuteContractResponse, error)
+	// CreateDerivativeLimitOrder defines a method for creating a new derivative
+	// limit order.
+	CreateDerivativeLimitOrder(ctx context.Context, in *MsgCreateDerivativeLimitOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeLimitOrderResponse, error)
+	// BatchCreateDerivativeLimitOrders defines a method for creating a new batch
+	// of derivative limit orders.
+	BatchCreateDerivativeLimitOrders(ctx context.Context, in *MsgBatchCreateDerivativeLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateDerivativeLimitOrdersResponse, error)
+	// MsgCreateDerivativeLimitOrder defines a method for creating a new
+	// derivative market order.
+	CreateDerivativeMarketOrder(ctx context.Context, in *MsgCreateDerivativeMarketOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeMarketOrderResponse, error)
+	// MsgCancelDerivativeOrder defines a method for cancelling a derivative
+	// order.
+	CancelDerivativeOrder(ctx context.Context, in *MsgCancelDerivativeOrder, opts ...grpc.CallOption) (*MsgCancelDerivativeOrderResponse, error)
+	// MsgBatchCancelDerivativeOrders defines a method for cancelling a batch of
+	// derivative limit orders.
+	BatchCancelDerivativeOrders(ctx context.Context, in *MsgBatchCancelDerivativeOrders, opts ...grpc.CallOption) (*MsgBatchCancelDerivativeOrdersResponse, error)
+	// InstantBinaryOptionsMarketLaunch defines method for creating a binary
+	// options market by paying listing fee without governance
+	InstantBinaryOptionsMarketLaunch(ctx context.Context, in *MsgInstantBinaryOptionsMarketLaunch, opts ...grpc.CallOption) (*MsgInstantBinaryOptionsMarketLaunchResponse, error)
+	// CreateBinaryOptionsLimitOrder defines a method for creating a new binary
+	// options limit order.
+	CreateBinaryOptionsLimitOrder(ctx context.Context, in *MsgCreateBinaryOptionsLimitOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsLimitOrderResponse, error)
+	// CreateBinaryOptionsMarketOrder defines a method for creating a new binary
+	// options market order.
+	CreateBinaryOptionsMarketOrder(ctx context.Context, in *MsgCreateBinaryOptionsMarketOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsMarketOrderResponse, error)
+	// MsgCancelBinaryOptionsOrder defines a method for cancelling a binary
+	// options order.
+	CancelBinaryOptionsOrder(ctx context.Context, in *MsgCancelBinaryOptionsOrder, opts ...grpc.CallOption) (*MsgCancelBinaryOptionsOrderResponse, error)
+	// BatchCancelBinaryOptionsOrders defines a method for cancelling a batch of
+	// binary options limit orders.
+	BatchCancelBinaryOptionsOrders(ctx context.Context, in *MsgBatchCancelBinaryOptionsOrders, opts ...grpc.CallOption) (*MsgBatchCancelBinaryOptionsOrdersResponse, error)
+	// SubaccountTransfer defines a method for transfer between subaccounts
+	SubaccountTransfer(ctx context.Context, in *MsgSubaccountTransfer, opts ...grpc.CallOption) (*MsgSubaccountTransferResponse, error)
+	// ExternalTransfer defines a method for transfer between external accounts
+	ExternalTransfer(ctx context.Context, in *MsgExternalTransfer, opts ...grpc.CallOption) (*MsgExternalTransferResponse, error)
+	// LiquidatePosition defines a method for liquidating a position
+	LiquidatePosition(ctx context.Context, in *MsgLiquidatePosition, opts ...grpc.CallOption) (*MsgLiquidatePositionResponse, error)
+	// EmergencySettleMarket defines a method for emergency settling a market
+	EmergencySettleMarket(ctx context.Context, in *MsgEmergencySettleMarket, opts ...grpc.CallOption) (*MsgEmergencySettleMarketResponse, error)
+	// IncreasePositionMargin defines a method for increasing margin of a position
+	IncreasePositionMargin(ctx context.Context, in *MsgIncreasePositionMargin, opts ...grpc.CallOption) (*MsgIncreasePositionMarginResponse, error)
+	// RewardsOptOut defines a method for opting out of rewards
+	RewardsOptOut(ctx context.Context, in *MsgRewardsOptOut, opts ...grpc.CallOption) (*MsgRewardsOptOutResponse, error)
+	// AdminUpdateBinaryOptionsMarket defines method for updating a binary options
+	// market by admin
+	AdminUpdateBinaryOptionsMarket(ctx context.Context, in *MsgAdminUpdateBinaryOptionsMarket, opts ...grpc.CallOption) (*MsgAdminUpdateBinaryOptionsMarketResponse, error)
+	//
+	ReclaimLockedFunds(ctx context.Context, in *MsgReclaimLockedFunds, opts ...grpc.CallOption) (*MsgReclaimLockedFundsResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ForceSettleMarket defines a governance operation for settling a derivative
+	// market at a given price, closing all its positions and demolishing it
+	ForceSettleMarket(ctx context.Context, in *MsgForceSettleMarket, opts ...grpc.CallOption) (*MsgForceSettleMarketResponse, error)
+	// CancelAllOrdersInMarket defines a governance operation for cancelling the
+	// resting orders of a market, a bounded number of orders per message
+	CancelAllOrdersInMarket(ctx context.Context, in *MsgCancelAllOrdersInMarket, opts ...grpc.CallOption) (*MsgCancelAllOrdersInMarketResponse, error)
+	// BatchCancelOrders defines a method for cancelling a batch of orders in
+	// markets of any type, reporting the outcome of each cancellation
+	BatchCancelOrders(ctx context.Context, in *MsgBatchCancelOrders, opts ...grpc.CallOption) (*MsgBatchCancelOrdersResponse, error)
+	// ReplaceOrder defines a method for atomically cancelling a limit order and
+	// placing a new limit order in the same market and subaccount
+	ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error)
+	// SetMarginMode defines a method for switching a subaccount between isolated
+	// and cross margin
+	SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error)
+	// TransferPosition defines a method for moving a derivative position and
+	// its proportional margin between two subaccounts of the sender
+	TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/Deposit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelSpotOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CancelSpotOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSpotOrder(ctx, req.(*MsgCancelSpotOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BatchCancelSpotOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBatchCancelSpotOrders)
-	if err := dec(in); err != nil {
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BatchCancelSpotOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCancelSpotOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BatchCancelSpotOrders(ctx, req.(*MsgBatchCancelSpotOrders))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BatchUpdateOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBatchUpdateOrders)
-	if err := dec(in); err != nil {
+func (c *msgClient) InstantSpotMarketLaunch(ctx context.Context, in *MsgInstantSpotMarketLaunch, opts ...grpc.CallOption) (*MsgInstantSpotMarketLaunchResponse, error) {
+	out := new(MsgInstantSpotMarketLaunchResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantSpotMarketLaunch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BatchUpdateOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/BatchUpdateOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BatchUpdateOrders(ctx, req.(*MsgBatchUpdateOrders))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_PrivilegedExecuteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPrivilegedExecuteContract)
-	if err := dec(in); err != nil {
+func (c *msgClient) InstantPerpetualMarketLaunch(ctx context.Context, in *MsgInstantPerpetualMarketLaunch, opts ...grpc.CallOption) (*MsgInstantPerpetualMarketLaunchResponse, error) {
+	out := new(MsgInstantPerpetualMarketLaunchResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantPerpetualMarketLaunch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).PrivilegedExecuteContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/PrivilegedExecuteContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PrivilegedExecuteContract(ctx, req.(*MsgPrivilegedExecuteContract))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreateDerivativeLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDerivativeLimitOrder)
-	if err := dec(in); err != nil {
+func (c *msgClient) InstantExpiryFuturesMarketLaunch(ctx context.Context, in *MsgInstantExpiryFuturesMarketLaunch, opts ...grpc.CallOption) (*MsgInstantExpiryFuturesMarketLaunchResponse, error) {
+	out := new(MsgInstantExpiryFuturesMarketLaunchResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantExpiryFuturesMarketLaunch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDerivativeLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CreateDerivativeLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDerivativeLimitOrder(ctx, req.(*MsgCreateDerivativeLimitOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BatchCreateDerivativeLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBatchCreateDerivativeLimitOrders)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateSpotLimitOrder(ctx context.Context, in *MsgCreateSpotLimitOrder, opts ...grpc.CallOption) (*MsgCreateSpotLimitOrderResponse, error) {
+	out := new(MsgCreateSpotLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateSpotLimitOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BatchCreateDerivativeLimitOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCreateDerivativeLimitOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BatchCreateDerivativeLimitOrders(ctx, req.(*MsgBatchCreateDerivativeLimitOrders))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreateDerivativeMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDerivativeMarketOrder)
-	if err := dec(in); err != nil {
+func (c *msgClient) BatchCreateSpotLimitOrders(ctx context.Context, in *MsgBatchCreateSpotLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateSpotLimitOrdersResponse, error) {
+	out := new(MsgBatchCreateSpotLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCreateSpotLimitOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDerivativeMarketOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CreateDerivativeMarketOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDerivativeMarketOrder(ctx, req.(*MsgCreateDerivativeMarketOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CancelDerivativeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelDerivativeOrder)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateSpotMarketOrder(ctx context.Context, in *MsgCreateSpotMarketOrder, opts ...grpc.CallOption) (*MsgCreateSpotMarketOrderResponse, error) {
+	out := new(MsgCreateSpotMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateSpotMarketOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelDerivativeOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CancelDerivativeOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelDerivativeOrder(ctx, req.(*MsgCancelDerivativeOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BatchCancelDerivativeOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBatchCancelDerivativeOrders)
-	if err := dec(in); err != nil {
+func (c *msgClient) CancelSpotOrder(ctx context.Context, in *MsgCancelSpotOrder, opts ...grpc.CallOption) (*MsgCancelSpotOrderResponse, error) {
+	out := new(MsgCancelSpotOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CancelSpotOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BatchCancelDerivativeOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCancelDerivativeOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BatchCancelDerivativeOrders(ctx, req.(*MsgBatchCancelDerivativeOrders))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_InstantBinaryOptionsMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantBinaryOptionsMarketLaunch)
-	if err := dec(in); err != nil {
+func (c *msgClient) BatchCancelSpotOrders(ctx context.Context, in *MsgBatchCancelSpotOrders, opts ...grpc.CallOption) (*MsgBatchCancelSpotOrdersResponse, error) {
+	out := new(MsgBatchCancelSpotOrdersResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCancelSpotOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantBinaryOptionsMarketLaunch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/InstantBinaryOptionsMarketLaunch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantBinaryOptionsMarketLaunch(ctx, req.(*MsgInstantBinaryOptionsMarketLaunch))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreateBinaryOptionsLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBinaryOptionsLimitOrder)
-	if err := dec(in); err != nil {
+func (c *msgClient) BatchUpdateOrders(ctx context.Context, in *MsgBatchUpdateOrders, opts ...grpc.CallOption) (*MsgBatchUpdateOrdersResponse, error) {
+	out := new(MsgBatchUpdateOrdersResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchUpdateOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBinaryOptionsLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBinaryOptionsLimitOrder(ctx, req.(*MsgCreateBinaryOptionsLimitOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreateBinaryOptionsMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBinaryOptionsMarketOrder)
-	if err := dec(in); err != nil {
+func (c *msgClient) PrivilegedExecuteContract(ctx context.Context, in *MsgPrivilegedExecuteContract, opts ...grpc.CallOption) (*MsgPrivilegedExecuteContractResponse, error) {
+	out := new(MsgPrivilegedExecuteContractResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/PrivilegedExecuteContract", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBinaryOptionsMarketOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsMarketOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBinaryOptionsMarketOrder(ctx, req.(*MsgCreateBinaryOptionsMarketOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CancelBinaryOptionsOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelBinaryOptionsOrder)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateDerivativeLimitOrder(ctx context.Context, in *MsgCreateDerivativeLimitOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeLimitOrderResponse, error) {
+	out := new(MsgCreateDerivativeLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateDerivativeLimitOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelBinaryOptionsOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/CancelBinaryOptionsOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelBinaryOptionsOrder(ctx, req.(*MsgCancelBinaryOptionsOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BatchCancelBinaryOptionsOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBatchCancelBinaryOptionsOrders)
-	if err := dec(in); err != nil {
+func (c *msgClient) BatchCreateDerivativeLimitOrders(ctx context.Context, in *MsgBatchCreateDerivativeLimitOrders, opts ...grpc.CallOption) (*MsgBatchCreateDerivativeLimitOrdersResponse, error) {
+	out := new(MsgBatchCreateDerivativeLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCreateDerivativeLimitOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BatchCancelBinaryOptionsOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCancelBinaryOptionsOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BatchCancelBinaryOptionsOrders(ctx, req.(*MsgBatchCancelBinaryOptionsOrders))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubaccountTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubaccountTransfer)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateDerivativeMarketOrder(ctx context.Context, in *MsgCreateDerivativeMarketOrder, opts ...grpc.CallOption) (*MsgCreateDerivativeMarketOrderResponse, error) {
+	out := new(MsgCreateDerivativeMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateDerivativeMarketOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubaccountTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/SubaccountTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubaccountTransfer(ctx, req.(*MsgSubaccountTransfer))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ExternalTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExternalTransfer)
-	if err := dec(in); err != nil {
+func (c *msgClient) CancelDerivativeOrder(ctx context.Context, in *MsgCancelDerivativeOrder, opts ...grpc.CallOption) (*MsgCancelDerivativeOrderResponse, error) {
+	out := new(MsgCancelDerivativeOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CancelDerivativeOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExternalTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/ExternalTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExternalTransfer(ctx, req.(*MsgExternalTransfer))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_LiquidatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidatePosition)
-	if err := dec(in); err != nil {
+func (c *msgClient) BatchCancelDerivativeOrders(ctx context.Context, in *MsgBatchCancelDerivativeOrders, opts ...grpc.CallOption) (*MsgBatchCancelDerivativeOrdersResponse, error) {
+	out := new(MsgBatchCancelDerivativeOrdersResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCancelDerivativeOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidatePosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/LiquidatePosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidatePosition(ctx, req.(*MsgLiquidatePosition))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_EmergencySettleMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEmergencySettleMarket)
-	if err := dec(in); err != nil {
+func (c *msgClient) InstantBinaryOptionsMarketLaunch(ctx context.Context, in *MsgInstantBinaryOptionsMarketLaunch, opts ...grpc.CallOption) (*MsgInstantBinaryOptionsMarketLaunchResponse, error) {
+	out := new(MsgInstantBinaryOptionsMarketLaunchResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/InstantBinaryOptionsMarketLaunch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).EmergencySettleMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/EmergencySettleMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EmergencySettleMarket(ctx, req.(*MsgEmergencySettleMarket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_IncreasePositionMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgIncreasePositionMargin)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateBinaryOptionsLimitOrder(ctx context.Context, in *MsgCreateBinaryOptionsLimitOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsLimitOrderResponse, error) {
+	out := new(MsgCreateBinaryOptionsLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsLimitOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).IncreasePositionMargin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/IncreasePositionMargin",
+	return out, nil
+}
+
+func (c *msgClient) CreateBinaryOptionsMarketOrder(ctx context.Context, in *MsgCreateBinaryOptionsMarketOrder, opts ...grpc.CallOption) (*MsgCreateBinaryOptionsMarketOrderResponse, error) {
+	out := new(MsgCreateBinaryOptionsMarketOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsMarketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).IncreasePositionMargin(ctx, req.(*MsgIncreasePositionMargin))
+	return out, nil
+}
+
+func (c *msgClient) CancelBinaryOptionsOrder(ctx context.Context, in *MsgCancelBinaryOptionsOrder, opts ...grpc.CallOption) (*MsgCancelBinaryOptionsOrderResponse, error) {
+	out := new(MsgCancelBinaryOptionsOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CancelBinaryOptionsOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RewardsOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRewardsOptOut)
-	if err := dec(in); err != nil {
+func (c *msgClient) BatchCancelBinaryOptionsOrders(ctx context.Context, in *MsgBatchCancelBinaryOptionsOrders, opts ...grpc.CallOption) (*MsgBatchCancelBinaryOptionsOrdersResponse, error) {
+	out := new(MsgBatchCancelBinaryOptionsOrdersResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCancelBinaryOptionsOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RewardsOptOut(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) SubaccountTransfer(ctx context.Context, in *MsgSubaccountTransfer, opts ...grpc.CallOption) (*MsgSubaccountTransferResponse, error) {
+	out := new(MsgSubaccountTransferResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/SubaccountTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/RewardsOptOut",
+	return out, nil
+}
+
+func (c *msgClient) ExternalTransfer(ctx context.Context, in *MsgExternalTransfer, opts ...grpc.CallOption) (*MsgExternalTransferResponse, error) {
+	out := new(MsgExternalTransferResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/ExternalTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RewardsOptOut(ctx, req.(*MsgRewardsOptOut))
+	return out, nil
+}
+
+func (c *msgClient) LiquidatePosition(ctx context.Context, in *MsgLiquidatePosition, opts ...grpc.CallOption) (*MsgLiquidatePositionResponse, error) {
+	out := new(MsgLiquidatePositionResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/LiquidatePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AdminUpdateBinaryOptionsMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAdminUpdateBinaryOptionsMarket)
-	if err := dec(in); err != nil {
+func (c *msgClient) EmergencySettleMarket(ctx context.Context, in *MsgEmergencySettleMarket, opts ...grpc.CallOption) (*MsgEmergencySettleMarketResponse, error) {
+	out := new(MsgEmergencySettleMarketResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/EmergencySettleMarket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AdminUpdateBinaryOptionsMarket(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) IncreasePositionMargin(ctx context.Context, in *MsgIncreasePositionMargin, opts ...grpc.CallOption) (*MsgIncreasePositionMarginResponse, error) {
+	out := new(MsgIncreasePositionMarginResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/IncreasePositionMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/AdminUpdateBinaryOptionsMarket",
+	return out, nil
+}
+
+func (c *msgClient) RewardsOptOut(ctx context.Context, in *MsgRewardsOptOut, opts ...grpc.CallOption) (*MsgRewardsOptOutResponse, error) {
+	out := new(MsgRewardsOptOutResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/RewardsOptOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AdminUpdateBinaryOptionsMarket(ctx, req.(*MsgAdminUpdateBinaryOptionsMarket))
+	return out, nil
+}
+
+func (c *msgClient) AdminUpdateBinaryOptionsMarket(ctx context.Context, in *MsgAdminUpdateBinaryOptionsMarket, opts ...grpc.CallOption) (*MsgAdminUpdateBinaryOptionsMarketResponse, error) {
+	out := new(MsgAdminUpdateBinaryOptionsMarketResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/AdminUpdateBinaryOptionsMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ReclaimLockedFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReclaimLockedFunds)
-	if err := dec(in); err != nil {
+func (c *msgClient) ReclaimLockedFunds(ctx context.Context, in *MsgReclaimLockedFunds, opts ...grpc.CallOption) (*MsgReclaimLockedFundsResponse, error) {
+	out := new(MsgReclaimLockedFundsResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/ReclaimLockedFunds", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReclaimLockedFunds(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/ReclaimLockedFunds",
+	return out, nil
+}
+
+func (c *msgClient) ForceSettleMarket(ctx context.Context, in *MsgForceSettleMarket, opts ...grpc.CallOption) (*MsgForceSettleMarketResponse, error) {
+	out := new(MsgForceSettleMarketResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/ForceSettleMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReclaimLockedFunds(ctx, req.(*MsgReclaimLockedFunds))
+	return out, nil
+}
+
+func (c *msgClient) CancelAllOrdersInMarket(ctx context.Context, in *MsgCancelAllOrdersInMarket, opts ...grpc.CallOption) (*MsgCancelAllOrdersInMarketResponse, error) {
+	out := new(MsgCancelAllOrdersInMarketResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/CancelAllOrdersInMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
+func (c *msgClient) BatchCancelOrders(ctx context.Context, in *MsgBatchCancelOrders, opts ...grpc.CallOption) (*MsgBatchCancelOrdersResponse, error) {
+	out := new(MsgBatchCancelOrdersResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/BatchCancelOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error) {
+	out := new(MsgReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.exchange.v1beta1.Msg/UpdateParams",
+	return out, nil
+}
+
+func (c *msgClient) SetMarginMode(ctx context.Context, in *MsgSetMarginMode, opts ...grpc.CallOption) (*MsgSetMarginModeResponse, error) {
+	out := new(MsgSetMarginModeResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/SetMarginMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return out, nil
+}
+
+func (c *msgClient) TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error) {
+	out := new(MsgTransferPositionResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v1beta1.Msg/TransferPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "injective.exchange.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "InstantSpotMarketLaunch",
-			Handler:    _Msg_InstantSpotMarketLaunch_Handler,
-		},
-		{
-			MethodName: "InstantPerpetualMarketLaunch",
-			Handler:    _Msg_InstantPerpetualMarketLaunch_Handler,
-		},
-		{
-			MethodName: "InstantExpiryFuturesMarketLaunch",
-			Handler:    _Msg_InstantExpiryFuturesMarketLaunch_Handler,
-		},
-		{
-			MethodName: "CreateSpotLimitOrder",
-			Handler:    _Msg_CreateSpotLimitOrder_Handler,
-		},
-		{
-			MethodName: "BatchCreateSpotLimitOrders",
-			Handler:    _Msg_BatchCreateSpotLimitOrders_Handler,
-		},
-		{
-			MethodName: "CreateSpotMarketOrder",
-			Handler:    _Msg_CreateSpotMarketOrder_Handler,
-		},
-		{
-			MethodName: "CancelSpotOrder",
-			Handler:    _Msg_CancelSpotOrder_Handler,
-		},
-		{
-			MethodName: "BatchCancelSpotOrders",
-			Handler:    _Msg_BatchCancelSpotOrders_Handler,
-		},
-		{
-			MethodName: "BatchUpdateOrders",
-			Handler:    _Msg_BatchUpdateOrders_Handler,
-		},
-		{
-			MethodName: "PrivilegedExecuteContract",
-			Handler:    _Msg_PrivilegedExecuteContract_Handler,
-		},
-		{
-			MethodName: "CreateDerivativeLimitOrder",
-			Handler:    _Msg_CreateDerivativeLimitOrder_Handler,
-		},
-		{
-			MethodName: "BatchCreateDerivativeLimitOrders",
-			Handler:    _Msg_BatchCreateDerivativeLimitOrders_Handler,
-		},
-		{
-			MethodName: "CreateDerivativeMarketOrder",
-			Handler:    _Msg_CreateDerivativeMarketOrder_Handler,
-		},
-		{
-			MethodName: "CancelDerivativeOrder",
-			Handler:    _Msg_CancelDerivativeOrder_Handler,
-		},
-		{
-			MethodName: "BatchCancelDerivativeOrders",
-			Handler:    _Msg_BatchCancelDerivativeOrders_Handler,
-		},
-		{
-			MethodName: "InstantBinaryOptionsMarketLaunch",
-			Handler:    _Msg_InstantBinaryOptionsMarketLaunch_Handler,
-		},
-		{
-			MethodName: "CreateBinaryOptionsLimitOrder",
-			Handler:    _Msg_CreateBinaryOptionsLimitOrder_Handler,
-		},
-		{
-			MethodName: "CreateBinaryOptionsMarketOrder",
-			Handler:    _Msg_CreateBinaryOptionsMarketOrder_Handler,
-		},
-		{
-			MethodName: "CancelBinaryOptionsOrder",
-			Handler:    _Msg_CancelBinaryOptionsOrder_Handler,
-		},
-		{
-			MethodName: "BatchCancelBinaryOptionsOrders",
-			Handler:    _Msg_BatchCancelBinaryOptionsOrders_Handler,
-		},
-		{
-			MethodName: "SubaccountTransfer",
-			Handler:    _Msg_SubaccountTransfer_Handler,
-		},
-		{
-			MethodName: "ExternalTransfer",
-			Handler:    _Msg_ExternalTransfer_Handler,
-		},
-		{
-			MethodName: "LiquidatePosition",
-			Handler:    _Msg_LiquidatePosition_Handler,
-		},
-		{
-			MethodName: "EmergencySettleMarket",
-			Handler:    _Msg_EmergencySettleMarket_Handler,
-		},
-		{
-			MethodName: "IncreasePositionMargin",
-			Handler:    _Msg_IncreasePositionMargin_Handler,
-		},
-		{
-			MethodName: "RewardsOptOut",
-			Handler:    _Msg_RewardsOptOut_Handler,
-		},
-		{
-			MethodName: "AdminUpdateBinaryOptionsMarket",
-			Handler:    _Msg_AdminUpdateBinaryOptionsMarket_Handler,
-		},
-		{
-			MethodName: "ReclaimLockedFunds",
-			Handler:    _Msg_ReclaimLockedFunds_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "injective/exchange/v1beta1/tx.proto",
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Deposit defines a method for transferring coins from the sender's bank
+	// balance into the subaccount's exchange deposits
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// Withdraw defines a method for withdrawing coins from a subaccount's
+	// deposits to the user's bank balance
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// InstantSpotMarketLaunch defines method for creating a spot market by paying
+	// listing fee without governance
+	InstantSpotMarketLaunch(context.Context, *MsgInstantSpotMarketLaunch) (*MsgInstantSpotMarketLaunchResponse, error)
+	// InstantPerpetualMarketLaunch defines a method for creating a new perpetual
+	// futures market by paying listing fee without governance
+	InstantPerpetualMarketLaunch(context.Context, *MsgInstantPerpetualMarketLaunch) (*MsgInstantPerpetualMarketLaunchResponse, error)
+	// InstantExpiryFuturesMarketLaunch defines a method for creating a new expiry
+	// futures market by paying listing fee without governance
+	InstantExpiryFuturesMarketLaunch(context.Context, *MsgInstantExpiryFuturesMarketLaunch) (*MsgInstantExpiryFuturesMarketLaunchResponse, error)
+	// CreateSpotLimitOrder defines a method for creating a new spot limit order.
+	CreateSpotLimitOrder(context.Context, *MsgCreateSpotLimitOrder) (*MsgCreateSpotLimitOrderResponse, error)
+	// BatchCreateSpotLimitOrder defines a method for creating a new batch of spot
+	// limit orders.
+	BatchCreateSpotLimitOrders(context.Context, *MsgBatchCreateSpotLimitOrders) (*MsgBatchCreateSpotLimitOrdersResponse, error)
+	// CreateSpotMarketOrder defines a method for creating a new spot market
+	// order.
+	CreateSpotMarketOrder(context.Context, *MsgCreateSpotMarketOrder) (*MsgCreateSpotMarketOrderResponse, error)
+	// MsgCancelSpotOrder defines a method for cancelling a spot order.
+	CancelSpotOrder(context.Context, *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error)
+	// BatchCancelSpotOrders defines a method for cancelling a batch of spot
+	// orders in a given market.
+	BatchCancelSpotOrders(context.Context, *MsgBatchCancelSpotOrders) (*MsgBatchCancelSpotOrdersResponse, error)
+	// BatchUpdateOrders defines a method for updating a batch of orders.
+	BatchUpdateOrders(context.Context, *MsgBatchUpdateOrders) (*MsgBatchUpdateOrdersResponse, error)
+	// PrivilegedExecuteContract defines a method for executing a Cosmwasm
+	// contract from the exchange module with privileged capabilities.
+	PrivilegedExecuteContract(context.Context, *MsgPrivilegedExecuteContract) (*MsgPrivilegedExecuteContractResponse, error)
+	// CreateDerivativeLimitOrder defines a method for creating a new derivative
+	// limit order.
+	CreateDerivativeLimitOrder(context.Context, *MsgCreateDerivativeLimitOrder) (*MsgCreateDerivativeLimitOrderResponse, error)
+	// BatchCreateDerivativeLimitOrders defines a method for creating a new batch
+	// of derivative limit orders.
+	BatchCreateDerivativeLimitOrders(context.Context, *MsgBatchCreateDerivativeLimitOrders) (*MsgBatchCreateDerivativeLimitOrdersResponse, error)
+	// MsgCreateDerivativeLimitOrder defines a method for creating a new
+	// derivative market order.
+	CreateDerivativeMarketOrder(context.Context, *MsgCreateDerivativeMarketOrder) (*MsgCreateDerivativeMarketOrderResponse, error)
+	// MsgCancelDerivativeOrder defines a method for cancelling a derivative
+	// order.
+	CancelDerivativeOrder(context.Context, *MsgCancelDerivativeOrder) (*MsgCancelDerivativeOrderResponse, error)
+	// MsgBatchCancelDerivativeOrders defines a method for cancelling a batch of
+	// derivative limit orders.
+	BatchCancelDerivativeOrders(context.Context, *MsgBatchCancelDerivativeOrders) (*MsgBatchCancelDerivativeOrdersResponse, error)
+	// InstantBinaryOptionsMarketLaunch defines method for creating a binary
+	// options market by paying listing fee without governance
+	InstantBinaryOptionsMarketLaunch(context.Context, *MsgInstantBinaryOptionsMarketLaunch) (*MsgInstantBinaryOptionsMarketLaunchResponse, error)
+	// CreateBinaryOptionsLimitOrder defines a method for creating a new binary
+	// options limit order.
+	CreateBinaryOptionsLimitOrder(context.Context, *MsgCreateBinaryOptionsLimitOrder) (*MsgCreateBinaryOptionsLimitOrderResponse, error)
+	// CreateBinaryOptionsMarketOrder defines a method for creating a new binary
+	// options market order.
+	CreateBinaryOptionsMarketOrder(context.Context, *MsgCreateBinaryOptionsMarketOrder) (*MsgCreateBinaryOptionsMarketOrderResponse, error)
+	// MsgCancelBinaryOptionsOrder defines a method for cancelling a binary
+	// options order.
+	CancelBinaryOptionsOrder(context.Context, *MsgCancelBinaryOptionsOrder) (*MsgCancelBinaryOptionsOrderResponse, error)
+	// BatchCancelBinaryOptionsOrders defines a method for cancelling a batch of
+	// binary options limit orders.
+	BatchCancelBinaryOptionsOrders(context.Context, *MsgBatchCancelBinaryOptionsOrders) (*MsgBatchCancelBinaryOptionsOrdersResponse, error)
+	// SubaccountTransfer defines a method for transfer between subaccounts
+	SubaccountTransfer(context.Context, *MsgSubaccountTransfer) (*MsgSubaccountTransferResponse, error)
+	// ExternalTransfer defines a method for transfer between external accounts
+	ExternalTransfer(context.Context, *MsgExternalTransfer) (*MsgExternalTransferResponse, error)
+	// LiquidatePosition defines a method for liquidating a position
+	LiquidatePosition(context.Context, *MsgLiquidatePosition) (*MsgLiquidatePositionResponse, error)
+	// EmergencySettleMarket defines a method for emergency settling a market
+	EmergencySettleMarket(context.Context, *MsgEmergencySettleMarket) (*MsgEmergencySettleMarketResponse, error)
+	// IncreasePositionMargin defines a method for increasing margin of a position
+	IncreasePositionMargin(context.Context, *MsgIncreasePositionMargin) (*MsgIncreasePositionMarginResponse, error)
+	// RewardsOptOut defines a method for opting out of rewards
+	RewardsOptOut(context.Context, *MsgRewardsOptOut) (*MsgRewardsOptOutResponse, error)
+	// AdminUpdateBinaryOptionsMarket defines method for updating a binary options
+	// market by admin
+	AdminUpdateBinaryOptionsMarket(context.Context, *MsgAdminUpdateBinaryOptionsMarket) (*MsgAdminUpdateBinaryOptionsMarketResponse, error)
+	//
+	ReclaimLockedFunds(context.Context, *MsgReclaimLockedFunds) (*MsgReclaimLockedFundsResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ForceSettleMarket defines a governance operation for settling a derivative
+	// market at a given price, closing all its positions and demolishing it
+	ForceSettleMarket(context.Context, *MsgForceSettleMarket) (*MsgForceSettleMarketResponse, error)
+	// CancelAllOrdersInMarket defines a governance operation for cancelling the
+	// resting orders of a market, a bounded number of orders per message
+	CancelAllOrdersInMarket(context.Context, *MsgCancelAllOrdersInMarket) (*MsgCancelAllOrdersInMarketResponse, error)
+	// BatchCancelOrders defines a method for cancelling a batch of orders in
+	// markets of any type, reporting the outcome of each cancellation
+	BatchCancelOrders(context.Context, *MsgBatchCancelOrders) (*MsgBatchCancelOrdersResponse, error)
+	// ReplaceOrder defines a method for atomically cancelling a limit order and
+	// placing a new limit order in the same market and subaccount
+	ReplaceOrder(context.Context, *MsgReplaceOrder) (*MsgReplaceOrderResponse, error)
+	// SetMarginMode defines a method for switching a subaccount between isolated
+	// and cross margin
+	SetMarginMode(context.Context, *MsgSetMarginMode) (*MsgSetMarginModeResponse, error)
+	// TransferPosition defines a method for moving a derivative position and
+	// its proportional margin between two subaccounts of the sender
+	TransferPosition(context.Context, *MsgTransferPosition) (*MsgTransferPositionResponse, error)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) InstantSpotMarketLaunch(ctx context.Context, req *MsgInstantSpotMarketLaunch) (*MsgInstantSpotMarketLaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantSpotMarketLaunch not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) InstantPerpetualMarketLaunch(ctx context.Context, req *MsgInstantPerpetualMarketLaunch) (*MsgInstantPerpetualMarketLaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantPerpetualMarketLaunch not implemented")
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) InstantExpiryFuturesMarketLaunch(ctx context.Context, req *MsgInstantExpiryFuturesMarketLaunch) (*MsgInstantExpiryFuturesMarketLaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantExpiryFuturesMarketLaunch not implemented")
 }
-
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateSpotLimitOrder(ctx context.Context, req *MsgCreateSpotLimitOrder) (*MsgCreateSpotLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpotLimitOrder not implemented")
 }
-
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) BatchCreateSpotLimitOrders(ctx context.Context, req *MsgBatchCreateSpotLimitOrders) (*MsgBatchCreateSpotLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateSpotLimitOrders not implemented")
 }
-
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) CreateSpotMarketOrder(ctx context.Context, req *MsgCreateSpotMarketOrder) (*MsgCreateSpotMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpotMarketOrder not implemented")
 }
-
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CancelSpotOrder(ctx context.Context, req *MsgCancelSpotOrder) (*MsgCancelSpotOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSpotOrder not implemented")
 }
-
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) BatchCancelSpotOrders(ctx context.Context, req *MsgBatchCancelSpotOrders) (*MsgBatchCancelSpotOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelSpotOrders not implemented")
 }
-
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) BatchUpdateOrders(ctx context.Context, req *MsgBatchUpdateOrders) (*MsgBatchUpdateOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateOrders not implemented")
 }
-
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) PrivilegedExecuteContract(ctx context.Context, req *MsgPrivilegedExecuteContract) (*MsgPrivilegedExecuteContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrivilegedExecuteContract not implemented")
+}
+func (*UnimplementedMsgServer) CreateDerivativeLimitOrder(ctx context.Context, req *MsgCreateDerivativeLimitOrder) (*MsgCreateDerivativeLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDerivativeLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchCreateDerivativeLimitOrders(ctx context.Context, req *MsgBatchCreateDerivativeLimitOrders) (*MsgBatchCreateDerivativeLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateDerivativeLimitOrders not implemented")
+}
+func (*UnimplementedMsgServer) CreateDerivativeMarketOrder(ctx context.Context, req *MsgCreateDerivativeMarketOrder) (*MsgCreateDerivativeMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDerivativeMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelDerivativeOrder(ctx context.Context, req *MsgCancelDerivativeOrder) (*MsgCancelDerivativeOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDerivativeOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancelDerivativeOrders(ctx context.Context, req *MsgBatchCancelDerivativeOrders) (*MsgBatchCancelDerivativeOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelDerivativeOrders not implemented")
+}
+func (*UnimplementedMsgServer) InstantBinaryOptionsMarketLaunch(ctx context.Context, req *MsgInstantBinaryOptionsMarketLaunch) (*MsgInstantBinaryOptionsMarketLaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantBinaryOptionsMarketLaunch not implemented")
+}
+func (*UnimplementedMsgServer) CreateBinaryOptionsLimitOrder(ctx context.Context, req *MsgCreateBinaryOptionsLimitOrder) (*MsgCreateBinaryOptionsLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBinaryOptionsLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CreateBinaryOptionsMarketOrder(ctx context.Context, req *MsgCreateBinaryOptionsMarketOrder) (*MsgCreateBinaryOptionsMarketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBinaryOptionsMarketOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelBinaryOptionsOrder(ctx context.Context, req *MsgCancelBinaryOptionsOrder) (*MsgCancelBinaryOptionsOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBinaryOptionsOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancelBinaryOptionsOrders(ctx context.Context, req *MsgBatchCancelBinaryOptionsOrders) (*MsgBatchCancelBinaryOptionsOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelBinaryOptionsOrders not implemented")
+}
+func (*UnimplementedMsgServer) SubaccountTransfer(ctx context.Context, req *MsgSubaccountTransfer) (*MsgSubaccountTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ExternalTransfer(ctx context.Context, req *MsgExternalTransfer) (*MsgExternalTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalTransfer not implemented")
+}
+func (*UnimplementedMsgServer) LiquidatePosition(ctx context.Context, req *MsgLiquidatePosition) (*MsgLiquidatePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidatePosition not implemented")
+}
+func (*UnimplementedMsgServer) EmergencySettleMarket(ctx context.Context, req *MsgEmergencySettleMarket) (*MsgEmergencySettleMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencySettleMarket not implemented")
+}
+func (*UnimplementedMsgServer) IncreasePositionMargin(ctx context.Context, req *MsgIncreasePositionMargin) (*MsgIncreasePositionMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreasePositionMargin not implemented")
+}
+func (*UnimplementedMsgServer) RewardsOptOut(ctx context.Context, req *MsgRewardsOptOut) (*MsgRewardsOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsOptOut not implemented")
+}
+func (*UnimplementedMsgServer) AdminUpdateBinaryOptionsMarket(ctx context.Context, req *MsgAdminUpdateBinaryOptionsMarket) (*MsgAdminUpdateBinaryOptionsMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateBinaryOptionsMarket not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimLockedFunds(ctx context.Context, req *MsgReclaimLockedFunds) (*MsgReclaimLockedFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimLockedFunds not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ForceSettleMarket(ctx context.Context, req *MsgForceSettleMarket) (*MsgForceSettleMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceSettleMarket not implemented")
+}
+func (*UnimplementedMsgServer) CancelAllOrdersInMarket(ctx context.Context, req *MsgCancelAllOrdersInMarket) (*MsgCancelAllOrdersInMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrdersInMarket not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancelOrders(ctx context.Context, req *MsgBatchCancelOrders) (*MsgBatchCancelOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelOrders not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceOrder(ctx context.Context, req *MsgReplaceOrder) (*MsgReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) SetMarginMode(ctx context.Context, req *MsgSetMarginMode) (*MsgSetMarginModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginMode not implemented")
+}
+func (*UnimplementedMsgServer) TransferPosition(ctx context.Context, req *MsgTransferPosition) (*MsgTransferPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPosition not implemented")
 }
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/Deposit",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_InstantSpotMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantSpotMarketLaunch)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateSpotLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateSpotLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).InstantSpotMarketLaunch(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/InstantSpotMarketLaunch",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantSpotMarketLaunch(ctx, req.(*MsgInstantSpotMarketLaunch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_InstantPerpetualMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantPerpetualMarketLaunch)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateSpotLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).InstantPerpetualMarketLaunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/InstantPerpetualMarketLaunch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantPerpetualMarketLaunch(ctx, req.(*MsgInstantPerpetualMarketLaunch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0xa
+func _Msg_InstantExpiryFuturesMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantExpiryFuturesMarketLaunch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).InstantExpiryFuturesMarketLaunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/InstantExpiryFuturesMarketLaunch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantExpiryFuturesMarketLaunch(ctx, req.(*MsgInstantExpiryFuturesMarketLaunch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBatchCreateSpotLimitOrders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateSpotLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSpotLimitOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBatchCreateSpotLimitOrders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBatchCreateSpotLimitOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSpotLimitOrder(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CreateSpotLimitOrder",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSpotLimitOrder(ctx, req.(*MsgCreateSpotLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBatchCreateSpotLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BatchCreateSpotLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateSpotLimitOrders)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBatchCreateSpotLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBatchCreateSpotLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrderHashes) > 0 {
-		for iNdEx := len(m.OrderHashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OrderHashes[iNdEx])
-			copy(dAtA[i:], m.OrderHashes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHashes[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateSpotLimitOrders(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCreateSpotLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateSpotLimitOrders(ctx, req.(*MsgBatchCreateSpotLimitOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantSpotMarketLaunch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateSpotMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSpotMarketOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInstantSpotMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInstantSpotMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinQuantityTickSize.Size()
-		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSpotMarketOrder(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MinPriceTickSize.Size()
-		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CreateSpotMarketOrder",
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSpotMarketOrder(ctx, req.(*MsgCreateSpotMarketOrder))
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSpotOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSpotOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Ticker) > 0 {
-		i -= len(m.Ticker)
-		copy(dAtA[i:], m.Ticker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSpotOrder(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CancelSpotOrder",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSpotOrder(ctx, req.(*MsgCancelSpotOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantSpotMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BatchCancelSpotOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancelSpotOrders)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInstantSpotMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInstantSpotMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancelSpotOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCancelSpotOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancelSpotOrders(ctx, req.(*MsgBatchCancelSpotOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantPerpetualMarketLaunch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BatchUpdateOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchUpdateOrders)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BatchUpdateOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/BatchUpdateOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchUpdateOrders(ctx, req.(*MsgBatchUpdateOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantPerpetualMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_PrivilegedExecuteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPrivilegedExecuteContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PrivilegedExecuteContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/PrivilegedExecuteContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PrivilegedExecuteContract(ctx, req.(*MsgPrivilegedExecuteContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantPerpetualMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinQuantityTickSize.Size()
-		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MinPriceTickSize.Size()
-		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_CreateDerivativeLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDerivativeLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.MaintenanceMarginRatio.Size()
-		i -= size
-		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDerivativeLimitOrder(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.InitialMarginRatio.Size()
-		i -= size
-		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CreateDerivativeLimitOrder",
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.TakerFeeRate.Size()
-		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDerivativeLimitOrder(ctx, req.(*MsgCreateDerivativeLimitOrder))
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.MakerFeeRate.Size()
-		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchCreateDerivativeLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateDerivativeLimitOrders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.OracleType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateDerivativeLimitOrders(ctx, in)
 	}
-	if m.OracleScaleFactor != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OracleScaleFactor))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCreateDerivativeLimitOrders",
 	}
-	if len(m.OracleQuote) > 0 {
-		i -= len(m.OracleQuote)
-		copy(dAtA[i:], m.OracleQuote)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleQuote)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateDerivativeLimitOrders(ctx, req.(*MsgBatchCreateDerivativeLimitOrders))
 	}
-	if len(m.OracleBase) > 0 {
-		i -= len(m.OracleBase)
-		copy(dAtA[i:], m.OracleBase)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleBase)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDerivativeMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDerivativeMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDerivativeMarketOrder(ctx, in)
 	}
-	if len(m.Ticker) > 0 {
-		i -= len(m.Ticker)
-		copy(dAtA[i:], m.Ticker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CreateDerivativeMarketOrder",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDerivativeMarketOrder(ctx, req.(*MsgCreateDerivativeMarketOrder))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantPerpetualMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelDerivativeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDerivativeOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDerivativeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CancelDerivativeOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDerivativeOrder(ctx, req.(*MsgCancelDerivativeOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantPerpetualMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BatchCancelDerivativeOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancelDerivativeOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancelDerivativeOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCancelDerivativeOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancelDerivativeOrders(ctx, req.(*MsgBatchCancelDerivativeOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantPerpetualMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_InstantBinaryOptionsMarketLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantBinaryOptionsMarketLaunch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantBinaryOptionsMarketLaunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/InstantBinaryOptionsMarketLaunch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantBinaryOptionsMarketLaunch(ctx, req.(*MsgInstantBinaryOptionsMarketLaunch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateBinaryOptionsLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBinaryOptionsLimitOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBinaryOptionsLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBinaryOptionsLimitOrder(ctx, req.(*MsgCreateBinaryOptionsLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateBinaryOptionsMarketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBinaryOptionsMarketOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBinaryOptionsMarketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CreateBinaryOptionsMarketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBinaryOptionsMarketOrder(ctx, req.(*MsgCreateBinaryOptionsMarketOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinQuantityTickSize.Size()
-		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_CancelBinaryOptionsOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBinaryOptionsOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.MinPriceTickSize.Size()
-		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBinaryOptionsOrder(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x6a
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x62
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CancelBinaryOptionsOrder",
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x5a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBinaryOptionsOrder(ctx, req.(*MsgCancelBinaryOptionsOrder))
 	}
-	if m.SettlementTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementTimestamp))
-		i--
-		dAtA[i] = 0x50
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchCancelBinaryOptionsOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancelBinaryOptionsOrders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExpirationTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationTimestamp))
-		i--
-		dAtA[i] = 0x48
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancelBinaryOptionsOrders(ctx, in)
 	}
-	{
-		size := m.TakerFeeRate.Size()
-		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCancelBinaryOptionsOrders",
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MakerFeeRate.Size()
-		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancelBinaryOptionsOrders(ctx, req.(*MsgBatchCancelBinaryOptionsOrders))
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.OracleScaleFactor != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OracleScaleFactor))
-		i--
-		dAtA[i] = 0x30
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubaccountTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubaccountTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OracleType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(MsgServer).SubaccountTransfer(ctx, in)
 	}
-	if len(m.OracleProvider) > 0 {
-		i -= len(m.OracleProvider)
-		copy(dAtA[i:], m.OracleProvider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleProvider)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/SubaccountTransfer",
 	}
-	if len(m.OracleSymbol) > 0 {
-		i -= len(m.OracleSymbol)
-		copy(dAtA[i:], m.OracleSymbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleSymbol)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubaccountTransfer(ctx, req.(*MsgSubaccountTransfer))
 	}
-	if len(m.Ticker) > 0 {
-		i -= len(m.Ticker)
-		copy(dAtA[i:], m.Ticker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExternalTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExternalTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).ExternalTransfer(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/ExternalTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExternalTransfer(ctx, req.(*MsgExternalTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_LiquidatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidatePosition)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidatePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/LiquidatePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidatePosition(ctx, req.(*MsgLiquidatePosition))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_EmergencySettleMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencySettleMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencySettleMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/EmergencySettleMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencySettleMarket(ctx, req.(*MsgEmergencySettleMarket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_IncreasePositionMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreasePositionMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreasePositionMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/IncreasePositionMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreasePositionMargin(ctx, req.(*MsgIncreasePositionMargin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RewardsOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRewardsOptOut)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RewardsOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/RewardsOptOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RewardsOptOut(ctx, req.(*MsgRewardsOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AdminUpdateBinaryOptionsMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdminUpdateBinaryOptionsMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdminUpdateBinaryOptionsMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/AdminUpdateBinaryOptionsMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdminUpdateBinaryOptionsMarket(ctx, req.(*MsgAdminUpdateBinaryOptionsMarket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinQuantityTickSize.Size()
-		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.MinPriceTickSize.Size()
-		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MaintenanceMarginRatio.Size()
-		i -= size
-		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_ReclaimLockedFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimLockedFunds)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.InitialMarginRatio.Size()
-		i -= size
-		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimLockedFunds(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.TakerFeeRate.Size()
-		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/ReclaimLockedFunds",
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.MakerFeeRate.Size()
-		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimLockedFunds(ctx, req.(*MsgReclaimLockedFunds))
 	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Expiry != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Expiry))
-		i--
-		dAtA[i] = 0x40
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OracleScaleFactor != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OracleScaleFactor))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.OracleType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/UpdateParams",
 	}
-	if len(m.OracleQuote) > 0 {
-		i -= len(m.OracleQuote)
-		copy(dAtA[i:], m.OracleQuote)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleQuote)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if len(m.OracleBase) > 0 {
-		i -= len(m.OracleBase)
-		copy(dAtA[i:], m.OracleBase)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleBase)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceSettleMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceSettleMarket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).ForceSettleMarket(ctx, in)
 	}
-	if len(m.Ticker) > 0 {
-		i -= len(m.Ticker)
-		copy(dAtA[i:], m.Ticker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/ForceSettleMarket",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceSettleMarket(ctx, req.(*MsgForceSettleMarket))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelAllOrdersInMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAllOrdersInMarket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAllOrdersInMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/CancelAllOrdersInMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAllOrdersInMarket(ctx, req.(*MsgCancelAllOrdersInMarket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BatchCancelOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancelOrders)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateSpotMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancelOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/BatchCancelOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancelOrders(ctx, req.(*MsgBatchCancelOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrder(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrder(ctx, req.(*MsgReplaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotMarketOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetMarginMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarginMode)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarginMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/SetMarginMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarginMode(ctx, req.(*MsgSetMarginMode))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_TransferPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v1beta1.Msg/TransferPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPosition(ctx, req.(*MsgTransferPosition))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSpotMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Results != nil {
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.exchange.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Results.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "InstantSpotMarketLaunch",
+			Handler:    _Msg_InstantSpotMarketLaunch_Handler,
+		},
+		{
+			MethodName: "InstantPerpetualMarketLaunch",
+			Handler:    _Msg_InstantPerpetualMarketLaunch_Handler,
+		},
+		{
+			MethodName: "InstantExpiryFuturesMarketLaunch",
+			Handler:    _Msg_InstantExpiryFuturesMarketLaunch_Handler,
+		},
+		{
+			MethodName: "CreateSpotLimitOrder",
+			Handler:    _Msg_CreateSpotLimitOrder_Handler,
+		},
+		{
+			MethodName: "BatchCreateSpotLimitOrders",
+			Handler:    _Msg_BatchCreateSpotLimitOrders_Handler,
+		},
+		{
+			MethodName: "CreateSpotMarketOrder",
+			Handler:    _Msg_CreateSpotMarketOrder_Handler,
+		},
+		{
+			MethodName: "CancelSpotOrder",
+			Handler:    _Msg_CancelSpotOrder_Handler,
+		},
+		{
+			MethodName: "BatchCancelSpotOrders",
+			Handler:    _Msg_BatchCancelSpotOrders_Handler,
+		},
+		{
+			MethodName: "BatchUpdateOrders",
+			Handler:    _Msg_BatchUpdateOrders_Handler,
+		},
+		{
+			MethodName: "PrivilegedExecuteContract",
+			Handler:    _Msg_PrivilegedExecuteContract_Handler,
+		},
+		{
+			MethodName: "CreateDerivativeLimitOrder",
+			Handler:    _Msg_CreateDerivativeLimitOrder_Handler,
+		},
+		{
+			MethodName: "BatchCreateDerivativeLimitOrders",
+			Handler:    _Msg_BatchCreateDerivativeLimitOrders_Handler,
+		},
+		{
+			MethodName: "CreateDerivativeMarketOrder",
+			Handler:    _Msg_CreateDerivativeMarketOrder_Handler,
+		},
+		{
+			MethodName: "CancelDerivativeOrder",
+			Handler:    _Msg_CancelDerivativeOrder_Handler,
+		},
+		{
+			MethodName: "BatchCancelDerivativeOrders",
+			Handler:    _Msg_BatchCancelDerivativeOrders_Handler,
+		},
+		{
+			MethodName: "InstantBinaryOptionsMarketLaunch",
+			Handler:    _Msg_InstantBinaryOptionsMarketLaunch_Handler,
+		},
+		{
+			MethodName: "CreateBinaryOptionsLimitOrder",
+			Handler:    _Msg_CreateBinaryOptionsLimitOrder_Handler,
+		},
+		{
+			MethodName: "CreateBinaryOptionsMarketOrder",
+			Handler:    _Msg_CreateBinaryOptionsMarketOrder_Handler,
+		},
+		{
+			MethodName: "CancelBinaryOptionsOrder",
+			Handler:    _Msg_CancelBinaryOptionsOrder_Handler,
+		},
+		{
+			MethodName: "BatchCancelBinaryOptionsOrders",
+			Handler:    _Msg_BatchCancelBinaryOptionsOrders_Handler,
+		},
+		{
+			MethodName: "SubaccountTransfer",
+			Handler:    _Msg_SubaccountTransfer_Handler,
+		},
+		{
+			MethodName: "ExternalTransfer",
+			Handler:    _Msg_ExternalTransfer_Handler,
+		},
+		{
+			MethodName: "LiquidatePosition",
+			Handler:    _Msg_LiquidatePosition_Handler,
+		},
+		{
+			MethodName: "EmergencySettleMarket",
+			Handler:    _Msg_EmergencySettleMarket_Handler,
+		},
+		{
+			MethodName: "IncreasePositionMargin",
+			Handler:    _Msg_IncreasePositionMargin_Handler,
+		},
+		{
+			MethodName: "RewardsOptOut",
+			Handler:    _Msg_RewardsOptOut_Handler,
+		},
+		{
+			MethodName: "AdminUpdateBinaryOptionsMarket",
+			Handler:    _Msg_AdminUpdateBinaryOptionsMarket_Handler,
+		},
+		{
+			MethodName: "ReclaimLockedFunds",
+			Handler:    _Msg_ReclaimLockedFunds_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ForceSettleMarket",
+			Handler:    _Msg_ForceSettleMarket_Handler,
+		},
+		{
+			MethodName: "CancelAllOrdersInMarket",
+			Handler:    _Msg_CancelAllOrdersInMarket_Handler,
+		},
+		{
+			MethodName: "BatchCancelOrders",
+			Handler:    _Msg_BatchCancelOrders_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Msg_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "SetMarginMode",
+			Handler:    _Msg_SetMarginMode_Handler,
+		},
+		{
+			MethodName: "TransferPosition",
+			Handler:    _Msg_TransferPosition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/exchange/v1beta1/tx.proto",
 }
 
-func (m *SpotMarketOrderResults) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5633,50 +5464,37 @@ func (m *SpotMarketOrderResults) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpotMarketOrderResults) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotMarketOrderResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Price.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size := m.Quantity.Size()
-		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDerivativeLimitOrder) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5686,37 +5504,20 @@ func (m *MsgCreateDerivativeLimitOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDerivativeLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDerivativeLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDerivativeLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgForceSettleMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5726,27 +5527,44 @@ func (m *MsgCreateDerivativeLimitOrderResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDerivativeLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgForceSettleMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDerivativeLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgForceSettleMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrder) Marshal() (dAtA []byte, err error) {
+func (m *MsgForceSettleMarketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5756,37 +5574,20 @@ func (m *MsgCreateBinaryOptionsLimitOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgForceSettleMarketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgForceSettleMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelAllOrdersInMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5796,27 +5597,34 @@ func (m *MsgCreateBinaryOptionsLimitOrderResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelAllOrdersInMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelAllOrdersInMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrders) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelAllOrdersInMarketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5826,20 +5634,58 @@ func (m *MsgBatchCreateDerivativeLimitOrders) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrders) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelAllOrdersInMarketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelAllOrdersInMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+	if m.HasMoreOrders {
+		i--
+		if m.HasMoreOrders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CancelledOrdersCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CancelledOrdersCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancelOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5860,7 +5706,7 @@ func (m *MsgBatchCreateDerivativeLimitOrders) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCancelOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5870,29 +5716,51 @@ func (m *MsgBatchCreateDerivativeLimitOrdersResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrderHashes) > 0 {
-		for iNdEx := len(m.OrderHashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OrderHashes[iNdEx])
-			copy(dAtA[i:], m.OrderHashes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHashes[iNdEx])))
+	if len(m.ErrorCodes) > 0 {
+		dAtA3 := make([]byte, len(m.ErrorCodes)*10)
+		var j2 int
+		for _, num := range m.ErrorCodes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Success) > 0 {
+		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
 			i--
-			dAtA[i] = 0xa
+			if m.Success[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Success)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSpotOrder) Marshal() (dAtA []byte, err error) {
+func (m *MsgReplaceOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5902,44 +5770,50 @@ func (m *MsgCancelSpotOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+	if m.DerivativeOrder != nil {
+		{
+			size, err := m.DerivativeOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+	if m.SpotOrder != nil {
+		{
+			size, err := m.SpotOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.OldOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -5950,7 +5824,7 @@ func (m *MsgCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSpotOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgReplaceOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5960,20 +5834,27 @@ func (m *MsgCancelSpotOrderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReplaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelSpotOrders) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetMarginMode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5983,29 +5864,27 @@ func (m *MsgBatchCancelSpotOrders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCancelSpotOrders) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetMarginMode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCancelSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetMarginMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.MarginMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarginMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -6017,7 +5896,7 @@ func (m *MsgBatchCancelSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelSpotOrdersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetMarginModeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6027,33 +5906,20 @@ func (m *MsgBatchCancelSpotOrdersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCancelSpotOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetMarginModeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCancelSpotOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetMarginModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Success) > 0 {
-		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.Success[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Success)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrders) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferPosition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6063,29 +5929,46 @@ func (m *MsgBatchCancelBinaryOptionsOrders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrders) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferPosition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DestinationSubaccountId) > 0 {
+		i -= len(m.DestinationSubaccountId)
+		copy(dAtA[i:], m.DestinationSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationSubaccountId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceSubaccountId) > 0 {
+		i -= len(m.SourceSubaccountId)
+		copy(dAtA[i:], m.SourceSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubaccountId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -6097,7 +5980,7 @@ func (m *MsgBatchCancelBinaryOptionsOrders) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferPositionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6107,33 +5990,20 @@ func (m *MsgBatchCancelBinaryOptionsOrdersResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferPositionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Success) > 0 {
-		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.Success[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Success)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchUpdateOrders) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6143,127 +6013,26 @@ func (m *MsgBatchUpdateOrders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchUpdateOrders) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchUpdateOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BinaryOptionsOrdersToCreate) > 0 {
-		for iNdEx := len(m.BinaryOptionsOrdersToCreate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BinaryOptionsOrdersToCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if len(m.BinaryOptionsMarketIdsToCancelAll) > 0 {
-		for iNdEx := len(m.BinaryOptionsMarketIdsToCancelAll) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BinaryOptionsMarketIdsToCancelAll[iNdEx])
-			copy(dAtA[i:], m.BinaryOptionsMarketIdsToCancelAll[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryOptionsMarketIdsToCancelAll[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.BinaryOptionsOrdersToCancel) > 0 {
-		for iNdEx := len(m.BinaryOptionsOrdersToCancel) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BinaryOptionsOrdersToCancel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.DerivativeOrdersToCreate) > 0 {
-		for iNdEx := len(m.DerivativeOrdersToCreate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DerivativeOrdersToCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.SpotOrdersToCreate) > 0 {
-		for iNdEx := len(m.SpotOrdersToCreate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpotOrdersToCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.DerivativeOrdersToCancel) > 0 {
-		for iNdEx := len(m.DerivativeOrdersToCancel) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DerivativeOrdersToCancel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.SpotOrdersToCancel) > 0 {
-		for iNdEx := len(m.SpotOrdersToCancel) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpotOrdersToCancel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.DerivativeMarketIdsToCancelAll) > 0 {
-		for iNdEx := len(m.DerivativeMarketIdsToCancelAll) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DerivativeMarketIdsToCancelAll[iNdEx])
-			copy(dAtA[i:], m.DerivativeMarketIdsToCancelAll[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeMarketIdsToCancelAll[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.SpotMarketIdsToCancelAll) > 0 {
-		for iNdEx := len(m.SpotMarketIdsToCancelAll) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SpotMarketIdsToCancelAll[iNdEx])
-			copy(dAtA[i:], m.SpotMarketIdsToCancelAll[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SpotMarketIdsToCancelAll[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.SubaccountId) > 0 {
 		i -= len(m.SubaccountId)
 		copy(dAtA[i:], m.SubaccountId)
@@ -6281,7 +6050,7 @@ func (m *MsgBatchUpdateOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchUpdateOrdersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6291,86 +6060,67 @@ func (m *MsgBatchUpdateOrdersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchUpdateOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchUpdateOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BinaryOptionsOrderHashes) > 0 {
-		for iNdEx := len(m.BinaryOptionsOrderHashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BinaryOptionsOrderHashes[iNdEx])
-			copy(dAtA[i:], m.BinaryOptionsOrderHashes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryOptionsOrderHashes[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.BinaryOptionsCancelSuccess) > 0 {
-		for iNdEx := len(m.BinaryOptionsCancelSuccess) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.BinaryOptionsCancelSuccess[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryOptionsCancelSuccess)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DerivativeOrderHashes) > 0 {
-		for iNdEx := len(m.DerivativeOrderHashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DerivativeOrderHashes[iNdEx])
-			copy(dAtA[i:], m.DerivativeOrderHashes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeOrderHashes[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SpotOrderHashes) > 0 {
-		for iNdEx := len(m.SpotOrderHashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SpotOrderHashes[iNdEx])
-			copy(dAtA[i:], m.SpotOrderHashes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SpotOrderHashes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.DerivativeCancelSuccess) > 0 {
-		for iNdEx := len(m.DerivativeCancelSuccess) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.DerivativeCancelSuccess[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeCancelSuccess)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SpotCancelSuccess) > 0 {
-		for iNdEx := len(m.SpotCancelSuccess) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.SpotCancelSuccess[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpotCancelSuccess)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDerivativeMarketOrder) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6380,12 +6130,35 @@ func (m *MsgCreateDerivativeMarketOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDerivativeMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDerivativeMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSpotLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSpotLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSpotLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6410,7 +6183,7 @@ func (m *MsgCreateDerivativeMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDerivativeMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateSpotLimitOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6420,28 +6193,16 @@ func (m *MsgCreateDerivativeMarketOrderResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDerivativeMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDerivativeMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Results != nil {
-		{
-			size, err := m.Results.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.OrderHash) > 0 {
 		i -= len(m.OrderHash)
 		copy(dAtA[i:], m.OrderHash)
@@ -6452,7 +6213,7 @@ func (m *MsgCreateDerivativeMarketOrderResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *DerivativeMarketOrderResults) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCreateSpotLimitOrders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6462,99 +6223,30 @@ func (m *DerivativeMarketOrderResults) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DerivativeMarketOrderResults) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateSpotLimitOrders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DerivativeMarketOrderResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateSpotLimitOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Payout.Size()
-		i -= size
-		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.PositionDelta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Quantity.Size()
-		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCreateBinaryOptionsMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateBinaryOptionsMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateBinaryOptionsMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -6565,7 +6257,7 @@ func (m *MsgCreateBinaryOptionsMarketOrder) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCreateSpotLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6575,39 +6267,29 @@ func (m *MsgCreateBinaryOptionsMarketOrderResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateSpotLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateSpotLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Results != nil {
-		{
-			size, err := m.Results.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.OrderHashes) > 0 {
+		for iNdEx := len(m.OrderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OrderHashes[iNdEx])
+			copy(dAtA[i:], m.OrderHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantSpotMarketLaunch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6617,46 +6299,54 @@ func (m *MsgCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantSpotMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantSpotMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x32
+	{
+		size := m.MinQuantityTickSize.Size()
+		i -= size
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.OrderMask != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderMask))
-		i--
-		dAtA[i] = 0x28
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6670,7 +6360,7 @@ func (m *MsgCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelDerivativeOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantSpotMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6680,12 +6370,12 @@ func (m *MsgCancelDerivativeOrderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelDerivativeOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantSpotMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelDerivativeOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantSpotMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6693,7 +6383,7 @@ func (m *MsgCancelDerivativeOrderResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelBinaryOptionsOrder) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantPerpetualMarketLaunch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6703,60 +6393,125 @@ func (m *MsgCancelBinaryOptionsOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelBinaryOptionsOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantPerpetualMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelBinaryOptionsOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantPerpetualMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.OrderMask != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderMask))
-		i--
-		dAtA[i] = 0x28
+	{
+		size := m.MinQuantityTickSize.Size()
+		i -= size
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x1a
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.InitialMarginRatio.Size()
+		i -= size
+		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.OracleType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OracleScaleFactor != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScaleFactor))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OracleQuote) > 0 {
+		i -= len(m.OracleQuote)
+		copy(dAtA[i:], m.OracleQuote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleQuote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OracleBase) > 0 {
+		i -= len(m.OracleBase)
+		copy(dAtA[i:], m.OracleBase)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleBase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelBinaryOptionsOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantPerpetualMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6766,12 +6521,12 @@ func (m *MsgCancelBinaryOptionsOrderResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelBinaryOptionsOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantPerpetualMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelBinaryOptionsOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantPerpetualMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6779,7 +6534,7 @@ func (m *MsgCancelBinaryOptionsOrderResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderData) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantBinaryOptionsMarketLaunch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6789,85 +6544,110 @@ func (m *OrderData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderData) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantBinaryOptionsMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantBinaryOptionsMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x2a
+	{
+		size := m.MinQuantityTickSize.Size()
+		i -= size
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.OrderMask != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderMask))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x62
 	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x5a
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+	if m.SettlementTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementTimestamp))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x50
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationTimestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x48
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgBatchCancelDerivativeOrders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBatchCancelDerivativeOrders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBatchCancelDerivativeOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.OracleScaleFactor != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScaleFactor))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OracleProvider) > 0 {
+		i -= len(m.OracleProvider)
+		copy(dAtA[i:], m.OracleProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleProvider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OracleSymbol) > 0 {
+		i -= len(m.OracleSymbol)
+		copy(dAtA[i:], m.OracleSymbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleSymbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -6879,7 +6659,7 @@ func (m *MsgBatchCancelDerivativeOrders) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelDerivativeOrdersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6889,33 +6669,20 @@ func (m *MsgBatchCancelDerivativeOrdersResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCancelDerivativeOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCancelDerivativeOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Success) > 0 {
-		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.Success[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Success)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubaccountTransfer) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantExpiryFuturesMarketLaunch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6925,37 +6692,116 @@ func (m *MsgSubaccountTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubaccountTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantExpiryFuturesMarketLaunch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubaccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantExpiryFuturesMarketLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MinQuantityTickSize.Size()
+		i -= size
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.DestinationSubaccountId) > 0 {
-		i -= len(m.DestinationSubaccountId)
-		copy(dAtA[i:], m.DestinationSubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationSubaccountId)))
-		i--
+	dAtA[i] = 0x72
+	{
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.InitialMarginRatio.Size()
+		i -= size
+		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Expiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OracleScaleFactor != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScaleFactor))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OracleQuote) > 0 {
+		i -= len(m.OracleQuote)
+		copy(dAtA[i:], m.OracleQuote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleQuote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OracleBase) > 0 {
+		i -= len(m.OracleBase)
+		copy(dAtA[i:], m.OracleBase)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleBase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.SourceSubaccountId) > 0 {
-		i -= len(m.SourceSubaccountId)
-		copy(dAtA[i:], m.SourceSubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubaccountId)))
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6969,7 +6815,7 @@ func (m *MsgSubaccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubaccountTransferResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6979,12 +6825,12 @@ func (m *MsgSubaccountTransferResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubaccountTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubaccountTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6992,7 +6838,7 @@ func (m *MsgSubaccountTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExternalTransfer) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateSpotMarketOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7002,18 +6848,18 @@ func (m *MsgExternalTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExternalTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotMarketOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExternalTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -7021,21 +6867,7 @@ func (m *MsgExternalTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.DestinationSubaccountId) > 0 {
-		i -= len(m.DestinationSubaccountId)
-		copy(dAtA[i:], m.DestinationSubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationSubaccountId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceSubaccountId) > 0 {
-		i -= len(m.SourceSubaccountId)
-		copy(dAtA[i:], m.SourceSubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubaccountId)))
-		i--
-		dAtA[i] = 0x12
-	}
+	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -7046,30 +6878,7 @@ func (m *MsgExternalTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExternalTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgExternalTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgExternalTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgLiquidatePosition) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateSpotMarketOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7079,19 +6888,19 @@ func (m *MsgLiquidatePosition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidatePosition) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidatePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateSpotMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Order != nil {
+	if m.Results != nil {
 		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Results.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7099,33 +6908,19 @@ func (m *MsgLiquidatePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidatePositionResponse) Marshal() (dAtA []byte, err error) {
+func (m *SpotMarketOrderResults) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7135,20 +6930,50 @@ func (m *MsgLiquidatePositionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidatePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotMarketOrderResults) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidatePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotMarketOrderResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEmergencySettleMarket) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateDerivativeLimitOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7158,30 +6983,26 @@ func (m *MsgEmergencySettleMarket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEmergencySettleMarket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDerivativeLimitOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEmergencySettleMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDerivativeLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -7192,7 +7013,7 @@ func (m *MsgEmergencySettleMarket) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEmergencySettleMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateDerivativeLimitOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7202,20 +7023,27 @@ func (m *MsgEmergencySettleMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEmergencySettleMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDerivativeLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEmergencySettleMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDerivativeLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgIncreasePositionMargin) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBinaryOptionsLimitOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7225,47 +7053,26 @@ func (m *MsgIncreasePositionMargin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgIncreasePositionMargin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBinaryOptionsLimitOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIncreasePositionMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBinaryOptionsLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.DestinationSubaccountId) > 0 {
-		i -= len(m.DestinationSubaccountId)
-		copy(dAtA[i:], m.DestinationSubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationSubaccountId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceSubaccountId) > 0 {
-		i -= len(m.SourceSubaccountId)
-		copy(dAtA[i:], m.SourceSubaccountId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubaccountId)))
-		i--
-		dAtA[i] = 0x12
-	}
+	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -7276,7 +7083,7 @@ func (m *MsgIncreasePositionMargin) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgIncreasePositionMarginResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7286,20 +7093,27 @@ func (m *MsgIncreasePositionMarginResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgIncreasePositionMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIncreasePositionMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPrivilegedExecuteContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCreateDerivativeLimitOrders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7309,36 +7123,29 @@ func (m *MsgPrivilegedExecuteContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPrivilegedExecuteContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDerivativeLimitOrders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPrivilegedExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDerivativeLimitOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Funds) > 0 {
-		i -= len(m.Funds)
-		copy(dAtA[i:], m.Funds)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Funds)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -7350,7 +7157,7 @@ func (m *MsgPrivilegedExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPrivilegedExecuteContractResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7360,26 +7167,21 @@ func (m *MsgPrivilegedExecuteContractResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgPrivilegedExecuteContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPrivilegedExecuteContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FundsDiff) > 0 {
-		for iNdEx := len(m.FundsDiff) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FundsDiff[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
+	if len(m.OrderHashes) > 0 {
+		for iNdEx := len(m.OrderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OrderHashes[iNdEx])
+			copy(dAtA[i:], m.OrderHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHashes[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7387,7 +7189,7 @@ func (m *MsgPrivilegedExecuteContractResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRewardsOptOut) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSpotOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7397,16 +7199,44 @@ func (m *MsgRewardsOptOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRewardsOptOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRewardsOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -7417,7 +7247,7 @@ func (m *MsgRewardsOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRewardsOptOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSpotOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7427,12 +7257,12 @@ func (m *MsgRewardsOptOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRewardsOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRewardsOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSpotOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7440,7 +7270,7 @@ func (m *MsgRewardsOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgReclaimLockedFunds) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCancelSpotOrders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7450,29 +7280,29 @@ func (m *MsgReclaimLockedFunds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgReclaimLockedFunds) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelSpotOrders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReclaimLockedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.LockedAccountPubKey) > 0 {
-		i -= len(m.LockedAccountPubKey)
-		copy(dAtA[i:], m.LockedAccountPubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LockedAccountPubKey)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -7484,7 +7314,7 @@ func (m *MsgReclaimLockedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgReclaimLockedFundsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCancelSpotOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7494,20 +7324,33 @@ func (m *MsgReclaimLockedFundsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgReclaimLockedFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelSpotOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReclaimLockedFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelSpotOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Success) > 0 {
+		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Success[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Success)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSignData) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCancelBinaryOptionsOrders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7517,34 +7360,41 @@ func (m *MsgSignData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSignData) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelBinaryOptionsOrders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSignData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelBinaryOptionsOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSignDoc) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7554,37 +7404,33 @@ func (m *MsgSignDoc) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSignDoc) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Success) > 0 {
+		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Success[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.SignType) > 0 {
-		i -= len(m.SignType)
-		copy(dAtA[i:], m.SignType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SignType)))
-		i--
-		dAtA[i] = 0xa
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Success)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchUpdateOrders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7594,47 +7440,131 @@ func (m *MsgAdminUpdateBinaryOptionsMarket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchUpdateOrders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchUpdateOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
+	if len(m.BinaryOptionsOrdersToCreate) > 0 {
+		for iNdEx := len(m.BinaryOptionsOrdersToCreate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BinaryOptionsOrdersToCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	if m.SettlementTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementTimestamp))
-		i--
-		dAtA[i] = 0x28
+	if len(m.BinaryOptionsMarketIdsToCancelAll) > 0 {
+		for iNdEx := len(m.BinaryOptionsMarketIdsToCancelAll) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BinaryOptionsMarketIdsToCancelAll[iNdEx])
+			copy(dAtA[i:], m.BinaryOptionsMarketIdsToCancelAll[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryOptionsMarketIdsToCancelAll[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.ExpirationTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationTimestamp))
-		i--
-		dAtA[i] = 0x20
+	if len(m.BinaryOptionsOrdersToCancel) > 0 {
+		for iNdEx := len(m.BinaryOptionsOrdersToCancel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BinaryOptionsOrdersToCancel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.SettlementPrice != nil {
-		{
-			size := m.SettlementPrice.Size()
-			i -= size
-			if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.DerivativeOrdersToCreate) > 0 {
+		for iNdEx := len(m.DerivativeOrdersToCreate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeOrdersToCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x42
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+	if len(m.SpotOrdersToCreate) > 0 {
+		for iNdEx := len(m.SpotOrdersToCreate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotOrdersToCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DerivativeOrdersToCancel) > 0 {
+		for iNdEx := len(m.DerivativeOrdersToCancel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeOrdersToCancel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpotOrdersToCancel) > 0 {
+		for iNdEx := len(m.SpotOrdersToCancel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotOrdersToCancel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DerivativeMarketIdsToCancelAll) > 0 {
+		for iNdEx := len(m.DerivativeMarketIdsToCancelAll) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DerivativeMarketIdsToCancelAll[iNdEx])
+			copy(dAtA[i:], m.DerivativeMarketIdsToCancelAll[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeMarketIdsToCancelAll[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SpotMarketIdsToCancelAll) > 0 {
+		for iNdEx := len(m.SpotMarketIdsToCancelAll) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpotMarketIdsToCancelAll[iNdEx])
+			copy(dAtA[i:], m.SpotMarketIdsToCancelAll[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SpotMarketIdsToCancelAll[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7648,7 +7578,7 @@ func (m *MsgAdminUpdateBinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchUpdateOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7658,1152 +7588,1493 @@ func (m *MsgAdminUpdateBinaryOptionsMarketResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchUpdateOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchUpdateOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.BinaryOptionsOrderHashes) > 0 {
+		for iNdEx := len(m.BinaryOptionsOrderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BinaryOptionsOrderHashes[iNdEx])
+			copy(dAtA[i:], m.BinaryOptionsOrderHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryOptionsOrderHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BinaryOptionsCancelSuccess) > 0 {
+		for iNdEx := len(m.BinaryOptionsCancelSuccess) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.BinaryOptionsCancelSuccess[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryOptionsCancelSuccess)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DerivativeOrderHashes) > 0 {
+		for iNdEx := len(m.DerivativeOrderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DerivativeOrderHashes[iNdEx])
+			copy(dAtA[i:], m.DerivativeOrderHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeOrderHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.SpotOrderHashes) > 0 {
+		for iNdEx := len(m.SpotOrderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpotOrderHashes[iNdEx])
+			copy(dAtA[i:], m.SpotOrderHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SpotOrderHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DerivativeCancelSuccess) > 0 {
+		for iNdEx := len(m.DerivativeCancelSuccess) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.DerivativeCancelSuccess[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeCancelSuccess)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpotCancelSuccess) > 0 {
+		for iNdEx := len(m.SpotCancelSuccess) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.SpotCancelSuccess[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpotCancelSuccess)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateDerivativeMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateDerivativeMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDerivativeMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateDerivativeMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateDerivativeMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDerivativeMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Results != nil {
+		{
+			size, err := m.Results.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DerivativeMarketOrderResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateSpotLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *DerivativeMarketOrderResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateSpotLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DerivativeMarketOrderResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgBatchCreateSpotLimitOrders) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.PositionDelta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCreateSpotLimitOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateBinaryOptionsMarketOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBinaryOptionsMarketOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBinaryOptionsMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OrderHashes) > 0 {
-		for _, s := range m.OrderHashes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantSpotMarketLaunch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Results != nil {
+		{
+			size, err := m.Results.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinPriceTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinQuantityTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantSpotMarketLaunchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgInstantPerpetualMarketLaunch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.OrderMask != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderMask))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.OracleBase)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OracleQuote)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.OracleScaleFactor != 0 {
-		n += 1 + sovTx(uint64(m.OracleScaleFactor))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.OracleType != 0 {
-		n += 1 + sovTx(uint64(m.OracleType))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.InitialMarginRatio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinPriceTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinQuantityTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantPerpetualMarketLaunchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelDerivativeOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDerivativeOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDerivativeOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelBinaryOptionsOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBinaryOptionsOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBinaryOptionsOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OracleSymbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OracleProvider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.OracleType != 0 {
-		n += 1 + sovTx(uint64(m.OracleType))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.OracleScaleFactor != 0 {
-		n += 1 + sovTx(uint64(m.OracleScaleFactor))
+	if m.OrderMask != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderMask))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.ExpirationTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.ExpirationTimestamp))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.SettlementTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.SettlementTimestamp))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinPriceTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinQuantityTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantBinaryOptionsMarketLaunchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelBinaryOptionsOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OracleBase)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OracleQuote)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.OracleType != 0 {
-		n += 1 + sovTx(uint64(m.OracleType))
-	}
-	if m.OracleScaleFactor != 0 {
-		n += 1 + sovTx(uint64(m.OracleScaleFactor))
-	}
-	if m.Expiry != 0 {
-		n += 1 + sovTx(uint64(m.Expiry))
-	}
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.InitialMarginRatio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinPriceTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinQuantityTickSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgCancelBinaryOptionsOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInstantExpiryFuturesMarketLaunchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelBinaryOptionsOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateSpotMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *OrderData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateSpotMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OrderData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Results != nil {
-		l = m.Results.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.OrderMask != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderMask))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SpotMarketOrderResults) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchCancelDerivativeOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Quantity.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDerivativeLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgBatchCancelDerivativeOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDerivativeLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBatchCancelDerivativeOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgBatchCancelDerivativeOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateBinaryOptionsLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgBatchCancelDerivativeOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBatchCancelDerivativeOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Success) > 0 {
+		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Success[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Success)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCreateDerivativeLimitOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OrderHashes) > 0 {
-		for _, s := range m.OrderHashes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSubaccountTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubaccountTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubaccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.DestinationSubaccountId) > 0 {
+		i -= len(m.DestinationSubaccountId)
+		copy(dAtA[i:], m.DestinationSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationSubaccountId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SourceSubaccountId) > 0 {
+		i -= len(m.SourceSubaccountId)
+		copy(dAtA[i:], m.SourceSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSpotOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubaccountTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBatchCancelSpotOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgSubaccountTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBatchCancelSpotOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubaccountTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Success) > 0 {
-		n += 1 + sovTx(uint64(len(m.Success))) + len(m.Success)*1
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrders) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExternalTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExternalTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExternalTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if len(m.DestinationSubaccountId) > 0 {
+		i -= len(m.DestinationSubaccountId)
+		copy(dAtA[i:], m.DestinationSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationSubaccountId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceSubaccountId) > 0 {
+		i -= len(m.SourceSubaccountId)
+		copy(dAtA[i:], m.SourceSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelBinaryOptionsOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExternalTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExternalTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExternalTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Success) > 0 {
-		n += 1 + sovTx(uint64(len(m.Success))) + len(m.Success)*1
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchUpdateOrders) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLiquidatePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidatePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidatePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.SpotMarketIdsToCancelAll) > 0 {
-		for _, s := range m.SpotMarketIdsToCancelAll {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.DerivativeMarketIdsToCancelAll) > 0 {
-		for _, s := range m.DerivativeMarketIdsToCancelAll {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.SpotOrdersToCancel) > 0 {
-		for _, e := range m.SpotOrdersToCancel {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.DerivativeOrdersToCancel) > 0 {
-		for _, e := range m.DerivativeOrdersToCancel {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.SpotOrdersToCreate) > 0 {
-		for _, e := range m.SpotOrdersToCreate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.DerivativeOrdersToCreate) > 0 {
-		for _, e := range m.DerivativeOrdersToCreate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.BinaryOptionsOrdersToCancel) > 0 {
-		for _, e := range m.BinaryOptionsOrdersToCancel {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.BinaryOptionsMarketIdsToCancelAll) > 0 {
-		for _, s := range m.BinaryOptionsMarketIdsToCancelAll {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.BinaryOptionsOrdersToCreate) > 0 {
-		for _, e := range m.BinaryOptionsOrdersToCreate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchUpdateOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SpotCancelSuccess) > 0 {
-		n += 1 + sovTx(uint64(len(m.SpotCancelSuccess))) + len(m.SpotCancelSuccess)*1
-	}
-	if len(m.DerivativeCancelSuccess) > 0 {
-		n += 1 + sovTx(uint64(len(m.DerivativeCancelSuccess))) + len(m.DerivativeCancelSuccess)*1
-	}
-	if len(m.SpotOrderHashes) > 0 {
-		for _, s := range m.SpotOrderHashes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.DerivativeOrderHashes) > 0 {
-		for _, s := range m.DerivativeOrderHashes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.BinaryOptionsCancelSuccess) > 0 {
-		n += 1 + sovTx(uint64(len(m.BinaryOptionsCancelSuccess))) + len(m.BinaryOptionsCancelSuccess)*1
-	}
-	if len(m.BinaryOptionsOrderHashes) > 0 {
-		for _, s := range m.BinaryOptionsOrderHashes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgLiquidatePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDerivativeMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgLiquidatePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDerivativeMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgLiquidatePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Results != nil {
-		l = m.Results.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DerivativeMarketOrderResults) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEmergencySettleMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Quantity.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PositionDelta.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Payout.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateBinaryOptionsMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgEmergencySettleMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateBinaryOptionsMarketOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgEmergencySettleMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Results != nil {
-		l = m.Results.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelDerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEmergencySettleMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencySettleMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencySettleMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.OrderMask != 0 {
-		n += 1 + sovTx(uint64(m.OrderMask))
-	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelDerivativeOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIncreasePositionMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelBinaryOptionsOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgIncreasePositionMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreasePositionMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DestinationSubaccountId) > 0 {
+		i -= len(m.DestinationSubaccountId)
+		copy(dAtA[i:], m.DestinationSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationSubaccountId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.OrderMask != 0 {
-		n += 1 + sovTx(uint64(m.OrderMask))
+	if len(m.SourceSubaccountId) > 0 {
+		i -= len(m.SourceSubaccountId)
+		copy(dAtA[i:], m.SourceSubaccountId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceSubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelBinaryOptionsOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIncreasePositionMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreasePositionMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreasePositionMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OrderData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPrivilegedExecuteContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrivilegedExecuteContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrivilegedExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.OrderMask != 0 {
-		n += 1 + sovTx(uint64(m.OrderMask))
+	if len(m.Funds) > 0 {
+		i -= len(m.Funds)
+		copy(dAtA[i:], m.Funds)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funds)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelDerivativeOrders) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPrivilegedExecuteContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrivilegedExecuteContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrivilegedExecuteContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.FundsDiff) > 0 {
+		for iNdEx := len(m.FundsDiff) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundsDiff[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBatchCancelDerivativeOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Success) > 0 {
-		n += 1 + sovTx(uint64(len(m.Success))) + len(m.Success)*1
+func (m *MsgRewardsOptOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubaccountTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRewardsOptOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRewardsOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DestinationSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubaccountTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRewardsOptOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgExternalTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DestinationSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgRewardsOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExternalTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRewardsOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidatePosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReclaimLockedFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimLockedFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimLockedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.LockedAccountPubKey) > 0 {
+		i -= len(m.LockedAccountPubKey)
+		copy(dAtA[i:], m.LockedAccountPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LockedAccountPubKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidatePositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReclaimLockedFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimLockedFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimLockedFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEmergencySettleMarket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSignData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEmergencySettleMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgIncreasePositionMargin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DestinationSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.SignType) > 0 {
+		i -= len(m.SignType)
+		copy(dAtA[i:], m.SignType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SignType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgIncreasePositionMarginResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAdminUpdateBinaryOptionsMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPrivilegedExecuteContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAdminUpdateBinaryOptionsMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminUpdateBinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Funds)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SettlementTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementTimestamp))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x20
 	}
+	if m.SettlementPrice != nil {
+		{
+			size := m.SettlementPrice.Size()
+			i -= size
+			if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgPrivilegedExecuteContractResponse) Size() (n int) {
+func (m *MsgUpdateParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.FundsDiff) > 0 {
-		for _, e := range m.FundsDiff {
+	return n
+}
+
+func (m *MsgForceSettleMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgForceSettleMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAllOrdersInMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrdersInMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelledOrdersCount != 0 {
+		n += 1 + sovTx(uint64(m.CancelledOrdersCount))
+	}
+	if m.HasMoreOrders {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBatchCancelOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
@@ -8811,7 +9082,26 @@ func (m *MsgPrivilegedExecuteContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgRewardsOptOut) Size() (n int) {
+func (m *MsgBatchCancelOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Success) > 0 {
+		n += 1 + sovTx(uint64(len(m.Success))) + len(m.Success)*1
+	}
+	if len(m.ErrorCodes) > 0 {
+		l = 0
+		for _, e := range m.ErrorCodes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgReplaceOrder) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8821,19 +9111,33 @@ func (m *MsgRewardsOptOut) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.OldOrder.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SpotOrder != nil {
+		l = m.SpotOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DerivativeOrder != nil {
+		l = m.DerivativeOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgRewardsOptOutResponse) Size() (n int) {
+func (m *MsgReplaceOrderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgReclaimLockedFunds) Size() (n int) {
+func (m *MsgSetMarginMode) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8843,18 +9147,53 @@ func (m *MsgReclaimLockedFunds) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.LockedAccountPubKey)
+	l = len(m.SubaccountId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Signature)
+	if m.MarginMode != 0 {
+		n += 1 + sovTx(uint64(m.MarginMode))
+	}
+	return n
+}
+
+func (m *MsgSetMarginModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationSubaccountId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgReclaimLockedFundsResponse) Size() (n int) {
+func (m *MsgTransferPositionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8863,84 +9202,2571 @@ func (m *MsgReclaimLockedFundsResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSignData) Size() (n int) {
+func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Signer)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Data)
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSpotLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateSpotLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchCreateSpotLimitOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCreateSpotLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderHashes) > 0 {
+		for _, s := range m.OrderHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInstantSpotMarketLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantSpotMarketLaunchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstantPerpetualMarketLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleBase)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleQuote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovTx(uint64(m.OracleScaleFactor))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovTx(uint64(m.OracleType))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.InitialMarginRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantPerpetualMarketLaunchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstantBinaryOptionsMarketLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleSymbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovTx(uint64(m.OracleType))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovTx(uint64(m.OracleScaleFactor))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationTimestamp))
+	}
+	if m.SettlementTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.SettlementTimestamp))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantBinaryOptionsMarketLaunchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstantExpiryFuturesMarketLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleBase)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleQuote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovTx(uint64(m.OracleType))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovTx(uint64(m.OracleScaleFactor))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovTx(uint64(m.Expiry))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.InitialMarginRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantExpiryFuturesMarketLaunchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSpotMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateSpotMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Results != nil {
+		l = m.Results.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SpotMarketOrderResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateDerivativeLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateDerivativeLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBinaryOptionsLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateBinaryOptionsLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchCreateDerivativeLimitOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCreateDerivativeLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderHashes) > 0 {
+		for _, s := range m.OrderHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelSpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSpotOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchCancelSpotOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCancelSpotOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Success) > 0 {
+		n += 1 + sovTx(uint64(len(m.Success))) + len(m.Success)*1
+	}
+	return n
+}
+
+func (m *MsgBatchCancelBinaryOptionsOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCancelBinaryOptionsOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Success) > 0 {
+		n += 1 + sovTx(uint64(len(m.Success))) + len(m.Success)*1
+	}
+	return n
+}
+
+func (m *MsgBatchUpdateOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpotMarketIdsToCancelAll) > 0 {
+		for _, s := range m.SpotMarketIdsToCancelAll {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DerivativeMarketIdsToCancelAll) > 0 {
+		for _, s := range m.DerivativeMarketIdsToCancelAll {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SpotOrdersToCancel) > 0 {
+		for _, e := range m.SpotOrdersToCancel {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DerivativeOrdersToCancel) > 0 {
+		for _, e := range m.DerivativeOrdersToCancel {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SpotOrdersToCreate) > 0 {
+		for _, e := range m.SpotOrdersToCreate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DerivativeOrdersToCreate) > 0 {
+		for _, e := range m.DerivativeOrdersToCreate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BinaryOptionsOrdersToCancel) > 0 {
+		for _, e := range m.BinaryOptionsOrdersToCancel {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BinaryOptionsMarketIdsToCancelAll) > 0 {
+		for _, s := range m.BinaryOptionsMarketIdsToCancelAll {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BinaryOptionsOrdersToCreate) > 0 {
+		for _, e := range m.BinaryOptionsOrdersToCreate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchUpdateOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpotCancelSuccess) > 0 {
+		n += 1 + sovTx(uint64(len(m.SpotCancelSuccess))) + len(m.SpotCancelSuccess)*1
+	}
+	if len(m.DerivativeCancelSuccess) > 0 {
+		n += 1 + sovTx(uint64(len(m.DerivativeCancelSuccess))) + len(m.DerivativeCancelSuccess)*1
+	}
+	if len(m.SpotOrderHashes) > 0 {
+		for _, s := range m.SpotOrderHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DerivativeOrderHashes) > 0 {
+		for _, s := range m.DerivativeOrderHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BinaryOptionsCancelSuccess) > 0 {
+		n += 1 + sovTx(uint64(len(m.BinaryOptionsCancelSuccess))) + len(m.BinaryOptionsCancelSuccess)*1
+	}
+	if len(m.BinaryOptionsOrderHashes) > 0 {
+		for _, s := range m.BinaryOptionsOrderHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateDerivativeMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateDerivativeMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Results != nil {
+		l = m.Results.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DerivativeMarketOrderResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quantity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PositionDelta.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Payout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateBinaryOptionsMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateBinaryOptionsMarketOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Results != nil {
+		l = m.Results.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderMask != 0 {
+		n += 1 + sovTx(uint64(m.OrderMask))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDerivativeOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelBinaryOptionsOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderMask != 0 {
+		n += 1 + sovTx(uint64(m.OrderMask))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelBinaryOptionsOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OrderData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderMask != 0 {
+		n += 1 + sovTx(uint64(m.OrderMask))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchCancelDerivativeOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCancelDerivativeOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Success) > 0 {
+		n += 1 + sovTx(uint64(len(m.Success))) + len(m.Success)*1
+	}
+	return n
+}
+
+func (m *MsgSubaccountTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubaccountTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExternalTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExternalTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidatePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidatePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEmergencySettleMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEmergencySettleMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIncreasePositionMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgIncreasePositionMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPrivilegedExecuteContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Funds)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPrivilegedExecuteContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundsDiff) > 0 {
+		for _, e := range m.FundsDiff {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRewardsOptOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRewardsOptOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReclaimLockedFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LockedAccountPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReclaimLockedFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAdminUpdateBinaryOptionsMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementPrice != nil {
+		l = m.SettlementPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationTimestamp))
+	}
+	if m.SettlementTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.SettlementTimestamp))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgAdminUpdateBinaryOptionsMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceSettleMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceSettleMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceSettleMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceSettleMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceSettleMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceSettleMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllOrdersInMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersInMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersInMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllOrdersInMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersInMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllOrdersInMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrdersCount", wireType)
+			}
+			m.CancelledOrdersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledOrdersCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMoreOrders", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMoreOrders = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancelOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancelOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancelOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, OrderData{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancelOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancelOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancelOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Success = append(m.Success, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Success) == 0 {
+					m.Success = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Success = append(m.Success, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ErrorCodes = append(m.ErrorCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ErrorCodes) == 0 {
+					m.ErrorCodes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ErrorCodes = append(m.ErrorCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpotOrder == nil {
+				m.SpotOrder = &SpotOrder{}
+			}
+			if err := m.SpotOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DerivativeOrder == nil {
+				m.DerivativeOrder = &DerivativeOrder{}
+			}
+			if err := m.DerivativeOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMarginMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarginMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarginMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginMode", wireType)
+			}
+			m.MarginMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginMode |= MarginMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSignDoc) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SignType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgAdminUpdateBinaryOptionsMarket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SettlementPrice != nil {
-		l = m.SettlementPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExpirationTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.ExpirationTimestamp))
-	}
-	if m.SettlementTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.SettlementTimestamp))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+func (m *MsgSetMarginModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarginModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAdminUpdateBinaryOptionsMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8963,15 +11789,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8999,13 +11825,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9015,22 +11841,119 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceSubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationSubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationSubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9055,7 +11978,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9078,10 +12001,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
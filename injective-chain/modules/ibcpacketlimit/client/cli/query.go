@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/injective-core/cli"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+)
+
+// GetQueryCmd returns the parent command for all modules/ibcpacketlimit CLi query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := cli.ModuleRootCommand(types.ModuleName, true)
+
+	cmd.AddCommand(
+		GetIBCPacketLimitParamsCmd(),
+		GetPendingIBCPacketsCmd(),
+	)
+	return cmd
+}
+
+func GetIBCPacketLimitParamsCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"params",
+		"Gets ibcpacketlimit params info",
+		types.NewQueryClient,
+		&types.QueryIBCPacketLimitParamsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+}
+
+func GetPendingIBCPacketsCmd() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"pending-packets <channel_id>",
+		"Gets the packets of a channel sent or received and not acknowledged yet",
+		types.NewQueryClient,
+		&types.QueryPendingIBCPacketsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the packets of a channel sent and not acknowledged by the counterparty yet, followed by the packets received and not acknowledged yet, to troubleshoot stuck transfers."
+	return cmd
+}
@@ -0,0 +1,18 @@
+package ibcpacketlimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+}
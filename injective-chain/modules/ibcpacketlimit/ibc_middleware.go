@@ -0,0 +1,41 @@
+package ibcpacketlimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware rejects the inbound packets whose data exceeds the MaxPacketDataSize param with an error
+// acknowledgement, before the wrapped IBC module processes them. All the other callbacks are passed through.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given IBC module.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	maxPacketDataSize := im.keeper.GetParams(ctx).MaxPacketDataSize
+	if packetDataSize := uint64(len(packet.GetData())); maxPacketDataSize > 0 && packetDataSize > maxPacketDataSize {
+		return channeltypes.NewErrorAcknowledgement(
+			types.ErrPacketTooLarge.Wrapf("packet data is %d bytes, the maximum is %d bytes", packetDataSize, maxPacketDataSize),
+		)
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,81 @@
+package ibcpacketlimit_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+)
+
+// recordingIBCModule is an IBC module recording the packets it receives
+type recordingIBCModule struct {
+	porttypes.IBCModule
+
+	receivedPackets []channeltypes.Packet
+}
+
+func (m *recordingIBCModule) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.receivedPackets = append(m.receivedPackets, packet)
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func newPacket(data []byte) channeltypes.Packet {
+	return channeltypes.NewPacket(data, 1, ibctransfertypes.PortID, "channel-0", ibctransfertypes.PortID, "channel-1", clienttypes.ZeroHeight(), uint64(time.Now().Add(time.Hour).UnixNano()))
+}
+
+func TestIBCMiddlewareOnRecvPacket(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	})
+
+	require.Equal(t, types.DefaultParams(), app.IBCPacketLimitKeeper.GetParams(ctx))
+
+	transferModule := &recordingIBCModule{}
+	middleware := ibcpacketlimit.NewIBCMiddleware(transferModule, app.IBCPacketLimitKeeper)
+	relayer := sdk.AccAddress("relayer_____________")
+
+	t.Run("transfer packet is accepted", func(t *testing.T) {
+		packetData := ibctransfertypes.NewFungibleTokenPacketData("uatom", "1000000", "cosmos1sender", "inj1receiver", "memo")
+		packet := newPacket(packetData.GetBytes())
+
+		ack := middleware.OnRecvPacket(ctx, packet, relayer)
+
+		require.True(t, ack.Success())
+		require.Equal(t, []channeltypes.Packet{packet}, transferModule.receivedPackets)
+	})
+
+	t.Run("oversized packet is rejected", func(t *testing.T) {
+		transferModule.receivedPackets = nil
+		packet := newPacket(bytes.Repeat([]byte{'a'}, int(types.DefaultMaxPacketDataSize)+1))
+
+		ack := middleware.OnRecvPacket(ctx, packet, relayer)
+
+		require.False(t, ack.Success())
+		require.Empty(t, transferModule.receivedPackets, "oversized packet shouldn't be processed")
+	})
+
+	t.Run("no limit when the ceiling is zero", func(t *testing.T) {
+		transferModule.receivedPackets = nil
+		app.IBCPacketLimitKeeper.SetParams(ctx, types.NewParams(0))
+		packet := newPacket(bytes.Repeat([]byte{'a'}, int(types.DefaultMaxPacketDataSize)+1))
+
+		ack := middleware.OnRecvPacket(ctx, packet, relayer)
+
+		require.True(t, ack.Success())
+		require.Len(t, transferModule.receivedPackets, 1)
+	})
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+	"github.com/InjectiveLabs/metrics"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+func (k *Keeper) IBCPacketLimitParams(c context.Context, _ *types.QueryIBCPacketLimitParamsRequest) (*types.QueryIBCPacketLimitParamsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryIBCPacketLimitParamsResponse{
+		Params: k.GetParams(ctx),
+	}
+	return res, nil
+}
+
+func (k *Keeper) PendingIBCPackets(c context.Context, req *types.QueryPendingIBCPacketsRequest) (*types.QueryPendingIBCPacketsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packets, err := k.GetPendingIBCPackets(ctx, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageRes, err := paginatePendingPackets(packets, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	res := &types.QueryPendingIBCPacketsResponse{
+		Packets:    page,
+		Pagination: pageRes,
+	}
+	return res, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+	"github.com/InjectiveLabs/metrics"
+)
+
+// GetParams returns the total set of ibcpacketlimit parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.GetStore(ctx)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.Params{}
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+// SetParams set the params
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.GetStore(ctx)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
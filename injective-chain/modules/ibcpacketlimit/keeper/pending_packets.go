@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+)
+
+// GetPendingIBCPackets returns the packets of a channel sent and not acknowledged by the counterparty yet, which still
+// have a packet commitment, followed by the packets received and without an acknowledgement written yet, each ordered
+// by sequence.
+func (k *Keeper) GetPendingIBCPackets(ctx sdk.Context, channelID string) ([]types.PendingIBCPacket, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	portID, found := k.getChannelPortID(ctx, channelID)
+	if !found {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrChannelNotFound.Wrapf("channel %s", channelID)
+	}
+
+	packets := make([]types.PendingIBCPacket, 0)
+
+	for _, commitment := range k.channelKeeper.GetAllPacketCommitmentsAtChannel(ctx, portID, channelID) {
+		packets = append(packets, types.PendingIBCPacket{
+			PortId:    portID,
+			ChannelId: channelID,
+			Sequence:  commitment.Sequence,
+		})
+	}
+
+	receivedPackets := make([]types.PendingIBCPacket, 0)
+	for _, receipt := range k.channelKeeper.GetAllPacketReceipts(ctx) {
+		if receipt.PortId != portID || receipt.ChannelId != channelID {
+			continue
+		}
+
+		if k.channelKeeper.HasPacketAcknowledgement(ctx, portID, channelID, receipt.Sequence) {
+			continue
+		}
+
+		receivedPackets = append(receivedPackets, types.PendingIBCPacket{
+			PortId:    portID,
+			ChannelId: channelID,
+			Sequence:  receipt.Sequence,
+			Received:  true,
+		})
+	}
+
+	sortPendingPackets(packets)
+	sortPendingPackets(receivedPackets)
+
+	return append(packets, receivedPackets...), nil
+}
+
+// getChannelPortID returns the port of a channel, the channel identifiers being unique across ports.
+func (k *Keeper) getChannelPortID(ctx sdk.Context, channelID string) (string, bool) {
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.ChannelId == channelID {
+			return channel.PortId, true
+		}
+	}
+
+	return "", false
+}
+
+func sortPendingPackets(packets []types.PendingIBCPacket) {
+	sort.SliceStable(packets, func(i, j int) bool {
+		return packets[i].Sequence < packets[j].Sequence
+	})
+}
+
+// pendingPacketKey returns the pagination key of a pending packet, ordered like the pending packets.
+func pendingPacketKey(packet types.PendingIBCPacket) []byte {
+	direction := byte(0)
+	if packet.Received {
+		direction = 1
+	}
+
+	return append([]byte{direction}, sdk.Uint64ToBigEndian(packet.Sequence)...)
+}
+
+// paginatePendingPackets returns the page of the pending packets selected by the page request. The next key is the
+// key of the first packet of the next page.
+func paginatePendingPackets(packets []types.PendingIBCPacket, pageReq *query.PageRequest) ([]types.PendingIBCPacket, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, types.ErrInvalidPageRequest.Wrap("either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]types.PendingIBCPacket, len(packets))
+		for i, packet := range packets {
+			reversed[len(packets)-1-i] = packet
+		}
+		packets = reversed
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := uint64(0)
+	if len(pageReq.Key) > 0 {
+		start = uint64(sort.Search(len(packets), func(i int) bool {
+			cmp := bytes.Compare(pendingPacketKey(packets[i]), pageReq.Key)
+			if pageReq.Reverse {
+				return cmp <= 0
+			}
+			return cmp >= 0
+		}))
+	} else {
+		start = pageReq.Offset
+	}
+
+	total := uint64(len(packets))
+	if start > total {
+		start = total
+	}
+
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = pendingPacketKey(packets[end])
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return packets[start:end], pageRes, nil
+}
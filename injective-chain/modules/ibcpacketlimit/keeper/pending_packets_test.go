@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ibcpacketlimit/types"
+)
+
+func TestPendingIBCPackets(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	})
+
+	const channelID = "channel-0"
+	port := ibctransfertypes.PortID
+	channelKeeper := app.IBCKeeper.ChannelKeeper
+
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(port, "channel-7"), []string{"connection-0"}, ibctransfertypes.Version)
+	channelKeeper.SetChannel(ctx, port, channelID, channel)
+
+	// packets 1 and 2 were sent and not acknowledged by the counterparty
+	channelKeeper.SetPacketCommitment(ctx, port, channelID, 2, []byte("commitment"))
+	channelKeeper.SetPacketCommitment(ctx, port, channelID, 1, []byte("commitment"))
+	// packet 3 was received and is not acknowledged yet, packet 4 was received and acknowledged
+	channelKeeper.SetPacketReceipt(ctx, port, channelID, 3)
+	channelKeeper.SetPacketReceipt(ctx, port, channelID, 4)
+	channelKeeper.SetPacketAcknowledgement(ctx, port, channelID, 4, []byte("ack"))
+
+	queryPending := func(pageReq *query.PageRequest) (*types.QueryPendingIBCPacketsResponse, error) {
+		return app.IBCPacketLimitKeeper.PendingIBCPackets(sdk.WrapSDKContext(ctx), &types.QueryPendingIBCPacketsRequest{
+			ChannelId:  channelID,
+			Pagination: pageReq,
+		})
+	}
+
+	res, err := queryPending(&query.PageRequest{CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingIBCPacket{
+		{PortId: port, ChannelId: channelID, Sequence: 1},
+		{PortId: port, ChannelId: channelID, Sequence: 2},
+		{PortId: port, ChannelId: channelID, Sequence: 3, Received: true},
+	}, res.Packets)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = queryPending(&query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 2)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	res, err = queryPending(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingIBCPacket{{PortId: port, ChannelId: channelID, Sequence: 3, Received: true}}, res.Packets)
+	require.Empty(t, res.Pagination.NextKey)
+
+	_, err = app.IBCPacketLimitKeeper.PendingIBCPackets(sdk.WrapSDKContext(ctx), &types.QueryPendingIBCPacketsRequest{ChannelId: "channel-99"})
+	require.ErrorIs(t, err, types.ErrChannelNotFound)
+}
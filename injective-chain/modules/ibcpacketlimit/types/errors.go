@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrPacketTooLarge     = errors.Register(ModuleName, 1, "packet data too large")
+	ErrChannelNotFound    = errors.Register(ModuleName, 2, "channel not found")
+	ErrInvalidPageRequest = errors.Register(ModuleName, 3, "invalid page request")
+)
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetAllChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel
+	GetAllPacketCommitmentsAtChannel(ctx sdk.Context, portID, channelID string) []channeltypes.PacketState
+	GetAllPacketReceipts(ctx sdk.Context) []channeltypes.PacketState
+	HasPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) bool
+}
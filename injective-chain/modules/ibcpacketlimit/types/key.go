@@ -0,0 +1,12 @@
+package types
+
+const (
+	ModuleName = "ibcpacketlimit"
+	// StoreKey can't start with the store key of the IBC module, store keys can't be prefixes of each other
+	StoreKey = "packetlimit"
+)
+
+var (
+	// Keys for store prefixes
+	ParamsKey = []byte{0x01}
+)
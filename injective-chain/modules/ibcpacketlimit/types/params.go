@@ -0,0 +1,26 @@
+package types
+
+// IBC packet limit params default values
+var (
+	// DefaultMaxPacketDataSize represents 64 KiB, a transfer packet being a few hundred bytes plus its memo
+	DefaultMaxPacketDataSize uint64 = 64 * 1024
+)
+
+// NewParams creates a new Params instance
+func NewParams(maxPacketDataSize uint64) Params {
+	return Params{
+		MaxPacketDataSize: maxPacketDataSize,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxPacketDataSize: DefaultMaxPacketDataSize,
+	}
+}
+
+// Validate performs basic validation on ibcpacketlimit parameters.
+func (p Params) Validate() error {
+	return nil
+}
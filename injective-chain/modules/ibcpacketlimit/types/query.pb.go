@@ -0,0 +1,1373 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/ibcpacketlimit/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryIBCPacketLimitParamsRequest is the request type for the
+// Query/IBCPacketLimitParams RPC method.
+type QueryIBCPacketLimitParamsRequest struct {
+}
+
+func (m *QueryIBCPacketLimitParamsRequest) Reset()         { *m = QueryIBCPacketLimitParamsRequest{} }
+func (m *QueryIBCPacketLimitParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCPacketLimitParamsRequest) ProtoMessage()    {}
+func (*QueryIBCPacketLimitParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e34ad8b6cabd2d1e, []int{0}
+}
+func (m *QueryIBCPacketLimitParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCPacketLimitParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCPacketLimitParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCPacketLimitParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCPacketLimitParamsRequest.Merge(m, src)
+}
+func (m *QueryIBCPacketLimitParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCPacketLimitParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCPacketLimitParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCPacketLimitParamsRequest proto.InternalMessageInfo
+
+// QueryIBCPacketLimitParamsResponse is the response type for the
+// Query/IBCPacketLimitParams RPC method.
+type QueryIBCPacketLimitParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryIBCPacketLimitParamsResponse) Reset()         { *m = QueryIBCPacketLimitParamsResponse{} }
+func (m *QueryIBCPacketLimitParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCPacketLimitParamsResponse) ProtoMessage()    {}
+func (*QueryIBCPacketLimitParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e34ad8b6cabd2d1e, []int{1}
+}
+func (m *QueryIBCPacketLimitParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCPacketLimitParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCPacketLimitParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCPacketLimitParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCPacketLimitParamsResponse.Merge(m, src)
+}
+func (m *QueryIBCPacketLimitParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCPacketLimitParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCPacketLimitParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCPacketLimitParamsResponse proto.InternalMessageInfo
+
+func (m *QueryIBCPacketLimitParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryPendingIBCPacketsRequest is the request type for the
+// Query/PendingIBCPackets RPC method.
+type QueryPendingIBCPacketsRequest struct {
+	ChannelId  string             `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingIBCPacketsRequest) Reset()         { *m = QueryPendingIBCPacketsRequest{} }
+func (m *QueryPendingIBCPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingIBCPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingIBCPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e34ad8b6cabd2d1e, []int{2}
+}
+func (m *QueryPendingIBCPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingIBCPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingIBCPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingIBCPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingIBCPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingIBCPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingIBCPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingIBCPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingIBCPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingIBCPacketsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPendingIBCPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingIBCPacket defines a packet of a channel not acknowledged yet
+type PendingIBCPacket struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// received is true for a packet received and not acknowledged yet, and false
+	// for a packet sent and not acknowledged by the counterparty yet
+	Received bool `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *PendingIBCPacket) Reset()         { *m = PendingIBCPacket{} }
+func (m *PendingIBCPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingIBCPacket) ProtoMessage()    {}
+func (*PendingIBCPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e34ad8b6cabd2d1e, []int{3}
+}
+func (m *PendingIBCPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIBCPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIBCPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingIBCPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIBCPacket.Merge(m, src)
+}
+func (m *PendingIBCPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIBCPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIBCPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingIBCPacket proto.InternalMessageInfo
+
+func (m *PendingIBCPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingIBCPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingIBCPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingIBCPacket) GetReceived() bool {
+	if m != nil {
+		return m.Received
+	}
+	return false
+}
+
+// QueryPendingIBCPacketsResponse is the response type for the
+// Query/PendingIBCPackets RPC method.
+type QueryPendingIBCPacketsResponse struct {
+	// packets are the sent packets followed by the received packets, each
+	// ordered by sequence
+	Packets    []PendingIBCPacket  `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingIBCPacketsResponse) Reset()         { *m = QueryPendingIBCPacketsResponse{} }
+func (m *QueryPendingIBCPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingIBCPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingIBCPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e34ad8b6cabd2d1e, []int{4}
+}
+func (m *QueryPendingIBCPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingIBCPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingIBCPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingIBCPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingIBCPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingIBCPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingIBCPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingIBCPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingIBCPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingIBCPacketsResponse) GetPackets() []PendingIBCPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryPendingIBCPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryIBCPacketLimitParamsRequest)(nil), "injective.ibcpacketlimit.v1beta1.QueryIBCPacketLimitParamsRequest")
+	proto.RegisterType((*QueryIBCPacketLimitParamsResponse)(nil), "injective.ibcpacketlimit.v1beta1.QueryIBCPacketLimitParamsResponse")
+	proto.RegisterType((*QueryPendingIBCPacketsRequest)(nil), "injective.ibcpacketlimit.v1beta1.QueryPendingIBCPacketsRequest")
+	proto.RegisterType((*PendingIBCPacket)(nil), "injective.ibcpacketlimit.v1beta1.PendingIBCPacket")
+	proto.RegisterType((*QueryPendingIBCPacketsResponse)(nil), "injective.ibcpacketlimit.v1beta1.QueryPendingIBCPacketsResponse")
+}
+
+func init() {
+	proto.RegisterFile("injective/ibcpacketlimit/v1beta1/query.proto", fileDescriptor_e34ad8b6cabd2d1e)
+}
+
+var fileDescriptor_e34ad8b6cabd2d1e = []byte{
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6f, 0xd3, 0x3e,
+	0x18, 0xad, 0xdb, 0xfe, 0xba, 0xcd, 0xbb, 0xfc, 0xb0, 0x26, 0x51, 0x55, 0x2c, 0x94, 0x1c, 0x20,
+	0x9a, 0x20, 0x66, 0x45, 0x5c, 0x61, 0xea, 0xa4, 0xa2, 0x4a, 0x3b, 0x94, 0x88, 0x13, 0x97, 0xc9,
+	0x49, 0xac, 0xd4, 0xac, 0xb5, 0xb3, 0xd8, 0xad, 0x34, 0x21, 0x2e, 0x3b, 0x70, 0x46, 0xe2, 0xdf,
+	0x81, 0xfb, 0x8e, 0x43, 0x5c, 0x38, 0x4d, 0xa8, 0xe5, 0x0f, 0x41, 0x71, 0xdc, 0xa6, 0x84, 0x8d,
+	0x54, 0x70, 0xab, 0xfd, 0xbd, 0xef, 0xbd, 0xe7, 0xf7, 0x7d, 0x0d, 0x7c, 0xc8, 0xf8, 0x1b, 0x1a,
+	0x28, 0x36, 0xa5, 0x98, 0xf9, 0x41, 0x4c, 0x82, 0x13, 0xaa, 0x46, 0x6c, 0xcc, 0x14, 0x9e, 0xee,
+	0xfb, 0x54, 0x91, 0x7d, 0x7c, 0x3a, 0xa1, 0xc9, 0x99, 0x1b, 0x27, 0x42, 0x09, 0xd4, 0x5e, 0xa2,
+	0xdd, 0x5f, 0xd1, 0xae, 0x41, 0xb7, 0x76, 0x22, 0x11, 0x09, 0x0d, 0xc6, 0xe9, 0xaf, 0xac, 0xaf,
+	0x75, 0x27, 0x12, 0x22, 0x1a, 0x51, 0x4c, 0x62, 0x86, 0x09, 0xe7, 0x42, 0x11, 0xc5, 0x04, 0x97,
+	0xa6, 0xba, 0x17, 0x08, 0x39, 0x16, 0x12, 0xfb, 0x44, 0xd2, 0x4c, 0x6e, 0x29, 0x1e, 0x93, 0x88,
+	0x71, 0x0d, 0x36, 0xd8, 0xa7, 0xa5, 0x7e, 0x0b, 0xc6, 0x74, 0x9b, 0x6d, 0xc3, 0xf6, 0xcb, 0x94,
+	0xb8, 0xdf, 0x3d, 0x1c, 0xe8, 0xe2, 0x51, 0x5a, 0x1c, 0x90, 0x84, 0x8c, 0xa5, 0x47, 0x4f, 0x27,
+	0x54, 0x2a, 0xfb, 0x04, 0xde, 0xfb, 0x03, 0x46, 0xc6, 0x82, 0x4b, 0x8a, 0x7a, 0xb0, 0x11, 0xeb,
+	0x9b, 0x26, 0x68, 0x03, 0x67, 0xbb, 0xe3, 0xb8, 0x65, 0x91, 0xb8, 0x19, 0x43, 0xb7, 0x7e, 0x71,
+	0x75, 0xb7, 0xe2, 0x99, 0x6e, 0xfb, 0x3d, 0x80, 0xbb, 0x5a, 0x6d, 0x40, 0x79, 0xc8, 0x78, 0xb4,
+	0x14, 0x5d, 0xd8, 0x41, 0xbb, 0x10, 0x06, 0x43, 0xc2, 0x39, 0x1d, 0x1d, 0xb3, 0x50, 0xab, 0x6d,
+	0x79, 0x5b, 0xe6, 0xa6, 0x1f, 0xa2, 0x1e, 0x84, 0x79, 0x38, 0xcd, 0xaa, 0x36, 0x73, 0xdf, 0xcd,
+	0x92, 0x74, 0xd3, 0x24, 0xdd, 0x6c, 0x70, 0xb9, 0x8b, 0x88, 0x1a, 0x6a, 0x6f, 0xa5, 0xd3, 0x3e,
+	0x07, 0xf0, 0xff, 0xa2, 0x07, 0x74, 0x1b, 0x6e, 0xc4, 0x22, 0x51, 0xb9, 0x70, 0x23, 0x3d, 0xf6,
+	0xc3, 0x82, 0xa9, 0x6a, 0xd1, 0x54, 0x0b, 0x6e, 0xca, 0x54, 0x83, 0x07, 0xb4, 0x59, 0x6b, 0x03,
+	0xa7, 0xee, 0x2d, 0xcf, 0x69, 0x2d, 0xa1, 0x01, 0x65, 0x53, 0x1a, 0x36, 0xeb, 0x6d, 0xe0, 0x6c,
+	0x7a, 0xcb, 0xb3, 0xfd, 0x19, 0x40, 0xeb, 0xa6, 0x34, 0x4c, 0xf0, 0x1e, 0xdc, 0xc8, 0xc2, 0x4d,
+	0x93, 0xaf, 0x39, 0xdb, 0x9d, 0xce, 0x1a, 0xc9, 0x17, 0xd8, 0xcc, 0x0c, 0x16, 0x44, 0xe8, 0xc5,
+	0x35, 0x19, 0x3e, 0x28, 0xcd, 0x30, 0x33, 0xb4, 0x1a, 0x62, 0xe7, 0x53, 0x0d, 0xfe, 0xa7, 0xfd,
+	0xa3, 0x2f, 0x00, 0xee, 0x5c, 0xb7, 0x40, 0xa8, 0x5b, 0x6e, 0xb7, 0x6c, 0x43, 0x5b, 0x87, 0xff,
+	0xc4, 0x91, 0xf9, 0xb6, 0x1f, 0x9f, 0x7f, 0xfd, 0xf1, 0xb1, 0xba, 0x87, 0x1c, 0x5c, 0xfa, 0x57,
+	0xca, 0x76, 0x15, 0x5d, 0x01, 0x78, 0xeb, 0xb7, 0xc1, 0xa0, 0xe7, 0x6b, 0x9a, 0xb9, 0x69, 0xc1,
+	0x5b, 0x07, 0x7f, 0x4f, 0x60, 0x9e, 0xd2, 0xd3, 0x4f, 0x39, 0x40, 0xcf, 0xd6, 0x78, 0x4a, 0x46,
+	0x72, 0x6c, 0x46, 0x8f, 0xdf, 0xe6, 0x6b, 0xfc, 0xae, 0xcb, 0x2f, 0x66, 0x16, 0xb8, 0x9c, 0x59,
+	0xe0, 0xfb, 0xcc, 0x02, 0x1f, 0xe6, 0x56, 0xe5, 0x72, 0x6e, 0x55, 0xbe, 0xcd, 0xad, 0xca, 0xeb,
+	0x57, 0x11, 0x53, 0xc3, 0x89, 0xef, 0x06, 0x62, 0x8c, 0xfb, 0x0b, 0x8d, 0x23, 0xe2, 0xcb, 0x5c,
+	0xf1, 0x51, 0x20, 0x12, 0xba, 0x7a, 0x1c, 0x12, 0xc6, 0xf1, 0x58, 0x84, 0x93, 0x11, 0x95, 0x45,
+	0x3b, 0xea, 0x2c, 0xa6, 0xd2, 0x6f, 0xe8, 0x8f, 0xd2, 0x93, 0x9f, 0x03, 0x00, 0xb9, 0xb0, 0xdf,
+	0x5b, 0x7d, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Retrieves ibcpacketlimit params
+	IBCPacketLimitParams(ctx context.Context, in *QueryIBCPacketLimitParamsRequest, opts ...grpc.CallOption) (*QueryIBCPacketLimitParamsResponse, error)
+	// Retrieves the packets of a channel sent or received and not acknowledged
+	// yet
+	PendingIBCPackets(ctx context.Context, in *QueryPendingIBCPacketsRequest, opts ...grpc.CallOption) (*QueryPendingIBCPacketsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) IBCPacketLimitParams(ctx context.Context, in *QueryIBCPacketLimitParamsRequest, opts ...grpc.CallOption) (*QueryIBCPacketLimitParamsResponse, error) {
+	out := new(QueryIBCPacketLimitParamsResponse)
+	err := c.cc.Invoke(ctx, "/injective.ibcpacketlimit.v1beta1.Query/IBCPacketLimitParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingIBCPackets(ctx context.Context, in *QueryPendingIBCPacketsRequest, opts ...grpc.CallOption) (*QueryPendingIBCPacketsResponse, error) {
+	out := new(QueryPendingIBCPacketsResponse)
+	err := c.cc.Invoke(ctx, "/injective.ibcpacketlimit.v1beta1.Query/PendingIBCPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Retrieves ibcpacketlimit params
+	IBCPacketLimitParams(context.Context, *QueryIBCPacketLimitParamsRequest) (*QueryIBCPacketLimitParamsResponse, error)
+	// Retrieves the packets of a channel sent or received and not acknowledged
+	// yet
+	PendingIBCPackets(context.Context, *QueryPendingIBCPacketsRequest) (*QueryPendingIBCPacketsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) IBCPacketLimitParams(ctx context.Context, req *QueryIBCPacketLimitParamsRequest) (*QueryIBCPacketLimitParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCPacketLimitParams not implemented")
+}
+func (*UnimplementedQueryServer) PendingIBCPackets(ctx context.Context, req *QueryPendingIBCPacketsRequest) (*QueryPendingIBCPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingIBCPackets not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_IBCPacketLimitParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCPacketLimitParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCPacketLimitParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.ibcpacketlimit.v1beta1.Query/IBCPacketLimitParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCPacketLimitParams(ctx, req.(*QueryIBCPacketLimitParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingIBCPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingIBCPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingIBCPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.ibcpacketlimit.v1beta1.Query/PendingIBCPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingIBCPackets(ctx, req.(*QueryPendingIBCPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.ibcpacketlimit.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IBCPacketLimitParams",
+			Handler:    _Query_IBCPacketLimitParams_Handler,
+		},
+		{
+			MethodName: "PendingIBCPackets",
+			Handler:    _Query_PendingIBCPackets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/ibcpacketlimit/v1beta1/query.proto",
+}
+
+func (m *QueryIBCPacketLimitParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCPacketLimitParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCPacketLimitParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCPacketLimitParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCPacketLimitParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCPacketLimitParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingIBCPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingIBCPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingIBCPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingIBCPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingIBCPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingIBCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Received {
+		i--
+		if m.Received {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingIBCPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingIBCPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingIBCPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIBCPacketLimitParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCPacketLimitParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingIBCPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingIBCPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.Received {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPendingIBCPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIBCPacketLimitParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCPacketLimitParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCPacketLimitParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCPacketLimitParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCPacketLimitParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCPacketLimitParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingIBCPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingIBCPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingIBCPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingIBCPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingIBCPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingIBCPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Received = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingIBCPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingIBCPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingIBCPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, PendingIBCPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
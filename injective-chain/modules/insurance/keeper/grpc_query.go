@@ -33,10 +33,24 @@ func (k *Keeper) InsuranceFund(c context.Context, request *types.QueryInsuranceF
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	ctx := sdk.UnwrapSDKContext(c)
-	fund := k.GetInsuranceFund(ctx, common.HexToHash(request.MarketId))
+	marketID := common.HexToHash(request.MarketId)
+
+	fund := k.GetInsuranceFund(ctx, marketID)
+	if fund == nil {
+		return &types.QueryInsuranceFundResponse{SharePrice: sdk.ZeroDec()}, nil
+	}
+
+	redemptions, pageRes, err := k.GetInsuranceFundRedemptions(ctx, marketID, request.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
 
 	res := &types.QueryInsuranceFundResponse{
-		Fund: fund,
+		Fund:               fund,
+		SharePrice:         fund.SharePrice(),
+		PendingRedemptions: redemptions,
+		Pagination:         pageRes,
 	}
 
 	return res, nil
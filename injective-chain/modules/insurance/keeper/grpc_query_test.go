@@ -5,6 +5,7 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/ethereum/go-ethereum/common"
 	. "github.com/onsi/ginkgo"
@@ -122,4 +123,94 @@ var _ = Describe("Query GRPC Endpoints test", func() {
 			Expect(queryResponse.Amount).To(Equal([]sdk.Coin{sdk.NewInt64Coin(depositDenom, 2500)}))
 		})
 	})
+	Describe("Insurance fund", func() {
+		var (
+			otherMarketID common.Hash
+			claimTimes    []time.Time
+		)
+
+		requestRedemption := func(redeemer sdk.AccAddress, marketID common.Hash) {
+			fund := app.InsuranceKeeper.GetInsuranceFund(ctx, marketID)
+			shareCoin := app.BankKeeper.GetBalance(ctx, redeemer, fund.ShareDenom())
+			err = app.InsuranceKeeper.RequestInsuranceFundRedemption(ctx, redeemer, marketID, sdk.NewCoin(fund.ShareDenom(), shareCoin.Amount.Quo(sdk.NewInt(2))))
+			Expect(err).To(BeNil())
+			claimTimes = append(claimTimes, ctx.BlockTime().Add(fund.RedemptionNoticePeriodDuration))
+		}
+
+		BeforeEach(func() {
+			claimTimes = []time.Time{ctx.BlockTime().Add(time.Minute)}
+
+			// a redemption of another fund is not part of the schedule
+			otherTicker := "atom/usdt"
+			otherMarketID = exchangetypes.NewDerivativesMarketID(otherTicker, depositDenom, "atom", oracleQuote, oracleType, expiry)
+			deposit := sdk.NewInt64Coin(depositDenom, 1000)
+			err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(deposit))
+			Expect(err).To(BeNil())
+			err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.NewCoins(deposit))
+			Expect(err).To(BeNil())
+			err = app.InsuranceKeeper.CreateInsuranceFund(ctx, sender, deposit, otherTicker, depositDenom, "atom", oracleQuote, oracleType, expiry)
+			Expect(err).To(BeNil())
+			requestRedemption(sender, otherMarketID)
+			claimTimes = claimTimes[:1]
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+			requestRedemption(sender, marketID)
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+			requestRedemption(sender2, marketID)
+		})
+
+		It("returns the fund balance, shares and share price", func() {
+			res, err := app.InsuranceKeeper.InsuranceFund(sdk.WrapSDKContext(ctx), &types.QueryInsuranceFundRequest{
+				MarketId: marketID.Hex(),
+			})
+			Expect(err).To(BeNil())
+			Expect(res.Fund.Balance).To(Equal(sdk.NewInt(15000)))
+			Expect(res.Fund.TotalShare).To(Equal(types.InsuranceFundInitialSupply.MulRaw(3).QuoRaw(2)))
+			Expect(res.SharePrice.String()).To(Equal(sdk.NewDec(15000).QuoInt(res.Fund.TotalShare).String()))
+		})
+
+		It("returns the pending redemptions of the fund by unlock time", func() {
+			res, err := app.InsuranceKeeper.InsuranceFund(sdk.WrapSDKContext(ctx), &types.QueryInsuranceFundRequest{
+				MarketId: marketID.Hex(),
+			})
+			Expect(err).To(BeNil())
+			Expect(res.PendingRedemptions).To(HaveLen(3))
+			Expect(res.Pagination.Total).To(Equal(uint64(3)))
+
+			for i, redemption := range res.PendingRedemptions {
+				Expect(redemption.MarketId).To(Equal(marketID.Hex()))
+				Expect(redemption.ClaimableRedemptionTime.Equal(claimTimes[i])).To(BeTrue())
+			}
+			Expect(res.PendingRedemptions[0].Redeemer).To(Equal(sender2.String()))
+			Expect(res.PendingRedemptions[1].Redeemer).To(Equal(sender.String()))
+			Expect(res.PendingRedemptions[2].Redeemer).To(Equal(sender2.String()))
+		})
+
+		It("paginates the pending redemptions", func() {
+			res, err := app.InsuranceKeeper.InsuranceFund(sdk.WrapSDKContext(ctx), &types.QueryInsuranceFundRequest{
+				MarketId:   marketID.Hex(),
+				Pagination: &query.PageRequest{Limit: 2},
+			})
+			Expect(err).To(BeNil())
+			Expect(res.PendingRedemptions).To(HaveLen(2))
+			Expect(res.Pagination.NextKey).ToNot(BeEmpty())
+
+			nextRes, err := app.InsuranceKeeper.InsuranceFund(sdk.WrapSDKContext(ctx), &types.QueryInsuranceFundRequest{
+				MarketId:   marketID.Hex(),
+				Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+			})
+			Expect(err).To(BeNil())
+			Expect(nextRes.PendingRedemptions).To(HaveLen(1))
+			Expect(nextRes.PendingRedemptions[0].ClaimableRedemptionTime.Equal(claimTimes[2])).To(BeTrue())
+		})
+
+		It("returns an empty response for an unknown market", func() {
+			res, err := app.InsuranceKeeper.InsuranceFund(sdk.WrapSDKContext(ctx), &types.QueryInsuranceFundRequest{
+				MarketId: common.HexToHash("0x01").Hex(),
+			})
+			Expect(err).To(BeNil())
+			Expect(res.Fund).To(BeNil())
+			Expect(res.PendingRedemptions).To(BeEmpty())
+		})
+	})
 })
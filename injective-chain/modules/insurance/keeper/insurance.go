@@ -9,6 +9,7 @@ import (
 	db "github.com/cometbft/cometbft-db"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -130,6 +131,32 @@ func (k *Keeper) GetAllInsuranceFundRedemptions(ctx sdk.Context) []types.Redempt
 	return schedules
 }
 
+// GetInsuranceFundRedemptions returns a page of the pending redemptions of the insurance fund, ordered by claimable redemption time.
+func (k *Keeper) GetInsuranceFundRedemptions(ctx sdk.Context, marketID common.Hash, pageReq *query.PageRequest) ([]types.RedemptionSchedule, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	schedules := make([]types.RedemptionSchedule, 0)
+	redemptionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionSchedulePrefixKey)
+
+	pageRes, err := query.FilteredPaginate(redemptionStore, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+		schedule := k.unmarshalRedemptionSchedule(value)
+		if schedule.MarketId != marketID.Hex() {
+			return false, nil
+		}
+
+		if accumulate {
+			schedules = append(schedules, *schedule)
+		}
+		return true, nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
+	return schedules, pageRes, nil
+}
+
 // IterateInsuranceFunds iterates over InsuranceFunds calling process on each insurance fund.
 func (k *Keeper) IterateInsuranceFunds(ctx sdk.Context, process func(*types.InsuranceFund) (stop bool)) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
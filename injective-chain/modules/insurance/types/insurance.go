@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
@@ -43,6 +44,15 @@ func (fund InsuranceFund) ShareDenom() string {
 	return fund.InsurancePoolTokenDenom
 }
 
+// SharePrice returns the amount of deposit denom redeemable per share token, or zero if no shares exist.
+func (fund InsuranceFund) SharePrice() sdk.Dec {
+	if fund.TotalShare.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecFromInt(fund.Balance).QuoInt(fund.TotalShare)
+}
+
 func (fund *InsuranceFund) AddTotalShare(shares math.Int) {
 	fund.TotalShare = fund.TotalShare.Add(shares)
 }
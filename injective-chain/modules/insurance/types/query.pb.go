@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -119,6 +121,8 @@ func (m *QueryInsuranceParamsResponse) GetParams() Params {
 type QueryInsuranceFundRequest struct {
 	// Market ID for the market
 	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination of the pending redemptions of the fund
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryInsuranceFundRequest) Reset()         { *m = QueryInsuranceFundRequest{} }
@@ -161,10 +165,22 @@ func (m *QueryInsuranceFundRequest) GetMarketId() string {
 	return ""
 }
 
+func (m *QueryInsuranceFundRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryInsuranceFundResponse is the response type for the Query/InsuranceFund
 // RPC method.
 type QueryInsuranceFundResponse struct {
 	Fund *InsuranceFund `protobuf:"bytes,1,opt,name=fund,proto3" json:"fund,omitempty"`
+	// deposit denom amount redeemable per share token
+	SharePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=share_price,json=sharePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share_price"`
+	// pending redemptions of the fund, ordered by claimable redemption time
+	PendingRedemptions []RedemptionSchedule `protobuf:"bytes,3,rep,name=pending_redemptions,json=pendingRedemptions,proto3" json:"pending_redemptions"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryInsuranceFundResponse) Reset()         { *m = QueryInsuranceFundResponse{} }
@@ -207,6 +223,20 @@ func (m *QueryInsuranceFundResponse) GetFund() *InsuranceFund {
 	return nil
 }
 
+func (m *QueryInsuranceFundResponse) GetPendingRedemptions() []RedemptionSchedule {
+	if m != nil {
+		return m.PendingRedemptions
+	}
+	return nil
+}
+
+func (m *QueryInsuranceFundResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryInsuranceFundsRequest is the request type for the Query/InsuranceFunds
 // RPC method.
 type QueryInsuranceFundsRequest struct {
@@ -595,53 +625,62 @@ func init() {
 }
 
 var fileDescriptor_74cebfe4cd18bca2 = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x4b, 0x14, 0x41,
-	0x1c, 0xbf, 0x35, 0xb5, 0x9c, 0xa8, 0x60, 0x10, 0x3a, 0x57, 0x5b, 0x6d, 0x25, 0xca, 0xac, 0xdd,
-	0xbc, 0x2c, 0xcf, 0x7e, 0x28, 0x19, 0x19, 0x42, 0x91, 0x5d, 0x10, 0x61, 0xc2, 0x31, 0x77, 0x3b,
-	0xad, 0x53, 0xee, 0xcc, 0xb9, 0x33, 0x2b, 0x48, 0xf4, 0xd2, 0x5f, 0x10, 0x04, 0xfd, 0x23, 0x41,
-	0xd0, 0x63, 0x6f, 0xf6, 0x66, 0xf4, 0xd2, 0x53, 0x84, 0xf6, 0x87, 0xc4, 0xce, 0xce, 0xae, 0x77,
-	0x7a, 0x8e, 0xed, 0xd5, 0xdb, 0xed, 0xcc, 0x7c, 0x7e, 0x7d, 0x67, 0xbe, 0x5f, 0x0e, 0x9c, 0x27,
-	0xf4, 0x25, 0xae, 0x0b, 0xb2, 0x8e, 0x5d, 0x42, 0x79, 0x14, 0x22, 0x5a, 0xc7, 0xee, 0xfa, 0x44,
-	0x0d, 0x0b, 0x34, 0xe1, 0xae, 0x45, 0x38, 0xdc, 0x70, 0x1a, 0x21, 0x13, 0x0c, 0x0e, 0x66, 0x07,
-	0x9d, 0xec, 0xa0, 0xa3, 0x0e, 0x9a, 0x43, 0x3e, 0x63, 0xfe, 0x2a, 0x76, 0x51, 0x83, 0xb8, 0x88,
-	0x52, 0x26, 0x90, 0x20, 0x8c, 0xf2, 0x04, 0x6a, 0x8e, 0xeb, 0x34, 0x76, 0xc9, 0x92, 0xc3, 0xfd,
-	0x3e, 0xf3, 0x99, 0xfc, 0xe9, 0xc6, 0xbf, 0xd4, 0xaa, 0x55, 0x67, 0x3c, 0x60, 0xdc, 0xad, 0x21,
-	0xbe, 0x0b, 0xad, 0x33, 0x42, 0xd5, 0xfe, 0x98, 0x4e, 0xc2, 0xc7, 0x14, 0x73, 0xa2, 0xdc, 0xd8,
-	0x67, 0xc0, 0xe0, 0xe3, 0x38, 0xd7, 0x42, 0x7a, 0x6e, 0x11, 0x85, 0x28, 0xe0, 0x15, 0xbc, 0x16,
-	0x61, 0x2e, 0x6c, 0x04, 0x86, 0xda, 0x6f, 0xf3, 0x06, 0xa3, 0x1c, 0xc3, 0x3b, 0xa0, 0xb7, 0x21,
-	0x57, 0x8a, 0xc6, 0x88, 0x71, 0xe1, 0x78, 0x69, 0xd4, 0xd1, 0x14, 0xc6, 0x49, 0xc0, 0x73, 0xdd,
-	0x9b, 0x3f, 0x87, 0x0b, 0x15, 0x05, 0xb4, 0xcb, 0x60, 0xa0, 0x55, 0x62, 0x3e, 0xa2, 0x9e, 0xd2,
-	0x87, 0x83, 0xa0, 0x2f, 0x40, 0xe1, 0x2b, 0x2c, 0xaa, 0xc4, 0x93, 0x12, 0x7d, 0x95, 0x63, 0xc9,
-	0xc2, 0x82, 0x67, 0x2f, 0x03, 0xb3, 0x1d, 0x52, 0x59, 0x9b, 0x01, 0xdd, 0x2f, 0x22, 0xea, 0x29,
-	0x63, 0x17, 0xb5, 0xc6, 0x5a, 0x19, 0x24, 0xce, 0x1e, 0x6a, 0xc7, 0x9e, 0x15, 0x06, 0xef, 0xad,
-	0x9b, 0xda, 0x55, 0xe2, 0xf3, 0xa0, 0x27, 0x26, 0x89, 0xcb, 0x72, 0x24, 0x9f, 0xba, 0xaa, 0x4e,
-	0x02, 0xb7, 0x9f, 0x81, 0x11, 0x29, 0x73, 0x8f, 0x0b, 0x12, 0x20, 0x81, 0xbd, 0x0a, 0xf6, 0x70,
-	0xd0, 0x90, 0xef, 0x29, 0xad, 0x91, 0x09, 0xb2, 0x92, 0xec, 0x2d, 0x11, 0x2c, 0x82, 0xa3, 0xc8,
-	0xf3, 0x42, 0xcc, 0x79, 0xb1, 0x4b, 0x6e, 0xa5, 0x9f, 0xf6, 0x32, 0x38, 0xab, 0x61, 0x56, 0x31,
-	0xa6, 0x40, 0x2f, 0x0a, 0x58, 0x44, 0x85, 0xca, 0x31, 0xe0, 0x24, 0x2f, 0xcf, 0x89, 0x5f, 0x5e,
-	0xe6, 0xff, 0x2e, 0x23, 0x34, 0xbd, 0xd4, 0xe4, 0xb8, 0xfd, 0x14, 0x58, 0x92, 0x7d, 0x11, 0x53,
-	0x8f, 0x50, 0xff, 0xbf, 0xb9, 0x5e, 0x02, 0xc3, 0x07, 0xf2, 0xfe, 0xab, 0xe7, 0x01, 0x70, 0x5a,
-	0x72, 0x3f, 0x64, 0x5e, 0xb4, 0x8a, 0x9f, 0x08, 0x24, 0x70, 0x7a, 0xdb, 0xcf, 0x41, 0x71, 0xff,
-	0x96, 0xd2, 0x9b, 0x05, 0x3d, 0x3c, 0x5e, 0x50, 0x0f, 0x6d, 0x4c, 0x7b, 0xd5, 0xf7, 0x93, 0xe6,
-	0x4b, 0x18, 0x12, 0x5c, 0xe9, 0x43, 0x1f, 0xe8, 0x91, 0xec, 0xf0, 0xa3, 0x01, 0x4e, 0xed, 0xe9,
-	0x34, 0x58, 0xd6, 0xf2, 0x69, 0x7a, 0xd7, 0x9c, 0xee, 0x00, 0x99, 0x64, 0xb2, 0xc7, 0xdf, 0x7e,
-	0xff, 0xfd, 0xbe, 0xeb, 0x1c, 0x1c, 0x75, 0x75, 0x93, 0x24, 0x69, 0x60, 0xf8, 0xc5, 0x00, 0x27,
-	0x5a, 0x9e, 0x30, 0xbc, 0x9e, 0x43, 0xb9, 0xa9, 0xdb, 0xcd, 0xa9, 0xdc, 0x38, 0xe5, 0x77, 0x56,
-	0xfa, 0x9d, 0x86, 0x53, 0xee, 0x5f, 0x0d, 0xd7, 0x6a, 0xdc, 0x5c, 0xee, 0xeb, 0x6c, 0xb2, 0xbc,
-	0x81, 0x9f, 0x0d, 0x70, 0xb2, 0xb5, 0x95, 0x61, 0x5e, 0x33, 0x59, 0xdd, 0xcb, 0xf9, 0x81, 0x2a,
-	0xc6, 0xa4, 0x8c, 0xe1, 0xc0, 0x4b, 0x39, 0x62, 0x70, 0xf8, 0xcd, 0x00, 0xfd, 0xed, 0xba, 0x18,
-	0xde, 0x3e, 0xdc, 0x88, 0x66, 0xae, 0x98, 0x33, 0x9d, 0xc2, 0x55, 0x9a, 0x1b, 0x32, 0xcd, 0x24,
-	0x2c, 0x69, 0xd3, 0xe0, 0x94, 0xa2, 0x1a, 0x36, 0x59, 0xff, 0x6a, 0x00, 0xb8, 0xbf, 0xc7, 0xe1,
-	0xcd, 0xc3, 0x2d, 0x1d, 0x38, 0x71, 0xcc, 0x5b, 0x9d, 0x81, 0x55, 0x9a, 0xb2, 0x4c, 0x53, 0x82,
-	0x57, 0xf4, 0x2d, 0x91, 0x10, 0xb4, 0x64, 0xf9, 0x64, 0x80, 0xfe, 0xec, 0xc2, 0x9b, 0x26, 0x08,
-	0x9c, 0x3c, 0xdc, 0xd0, 0xfe, 0x59, 0x64, 0x5e, 0xcb, 0x89, 0x52, 0xfe, 0x27, 0xa4, 0xff, 0x71,
-	0x38, 0xa6, 0xf5, 0x1f, 0x48, 0x64, 0x55, 0x0e, 0xa6, 0x39, 0xb2, 0xb9, 0x6d, 0x19, 0x5b, 0xdb,
-	0x96, 0xf1, 0x6b, 0xdb, 0x32, 0xde, 0xed, 0x58, 0x85, 0xad, 0x1d, 0xab, 0xf0, 0x63, 0xc7, 0x2a,
-	0x2c, 0x3d, 0xf2, 0x89, 0x58, 0x89, 0x6a, 0x4e, 0x9d, 0x05, 0xee, 0x42, 0x4a, 0xf7, 0x00, 0xd5,
-	0xf8, 0x2e, 0xf9, 0xe5, 0x3a, 0x0b, 0x71, 0xf3, 0xe7, 0x0a, 0x22, 0x54, 0xf1, 0xf3, 0x26, 0x65,
-	0xb1, 0xd1, 0xc0, 0xbc, 0xd6, 0x2b, 0xff, 0x8d, 0x5c, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x23,
-	0x60, 0x60, 0x91, 0x81, 0x09, 0x00, 0x00,
+	// 868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xef, 0x6b, 0xe4, 0x44,
+	0x18, 0xde, 0xf4, 0x97, 0xee, 0x5b, 0x54, 0x18, 0x0b, 0x6e, 0xb3, 0x35, 0xad, 0x39, 0xbc, 0xbb,
+	0x5e, 0xbd, 0xc4, 0xae, 0xd5, 0xf6, 0xfc, 0x71, 0x87, 0x55, 0x7b, 0x14, 0x94, 0xab, 0x7b, 0x20,
+	0x72, 0x0a, 0xcb, 0x6c, 0x32, 0x97, 0x1d, 0xdb, 0xcc, 0xa4, 0x99, 0xa4, 0x50, 0x44, 0x10, 0xff,
+	0x02, 0x41, 0xf0, 0x1f, 0x11, 0x04, 0x3f, 0xfa, 0xad, 0x7e, 0xab, 0xf8, 0x45, 0xfc, 0x50, 0xa4,
+	0xf5, 0x0f, 0x91, 0xcc, 0x4c, 0xb2, 0x59, 0x77, 0x9b, 0x75, 0xdb, 0x4f, 0xbb, 0x99, 0x79, 0x9f,
+	0x67, 0x9e, 0xe7, 0x9d, 0xf7, 0x7d, 0x13, 0xb8, 0x45, 0xd9, 0x57, 0xc4, 0x4b, 0xe8, 0x11, 0x71,
+	0x29, 0x13, 0x69, 0x8c, 0x99, 0x47, 0xdc, 0xa3, 0xf5, 0x2e, 0x49, 0xf0, 0xba, 0x7b, 0x98, 0x92,
+	0xf8, 0xd8, 0x89, 0x62, 0x9e, 0x70, 0xd4, 0x2c, 0x02, 0x9d, 0x22, 0xd0, 0xd1, 0x81, 0xe6, 0x52,
+	0xc0, 0x79, 0x70, 0x40, 0x5c, 0x1c, 0x51, 0x17, 0x33, 0xc6, 0x13, 0x9c, 0x50, 0xce, 0x84, 0x82,
+	0x9a, 0x6b, 0x55, 0x67, 0xf4, 0xc9, 0x54, 0xf0, 0x42, 0xc0, 0x03, 0x2e, 0xff, 0xba, 0xd9, 0x3f,
+	0xbd, 0x6a, 0x79, 0x5c, 0x84, 0x5c, 0xb8, 0x5d, 0x2c, 0xfa, 0x50, 0x8f, 0x53, 0xa6, 0xf7, 0xef,
+	0x94, 0xf7, 0xa5, 0xec, 0x22, 0x2a, 0xc2, 0x01, 0x65, 0x52, 0x8f, 0x8e, 0x5d, 0xad, 0x92, 0x13,
+	0x10, 0x46, 0x04, 0xd5, 0xca, 0xed, 0x97, 0xa1, 0xf9, 0x69, 0x46, 0xb6, 0x9b, 0xc7, 0xed, 0xe1,
+	0x18, 0x87, 0xa2, 0x4d, 0x0e, 0x53, 0x22, 0x12, 0x1b, 0xc3, 0xd2, 0xe8, 0x6d, 0x11, 0x71, 0x26,
+	0x08, 0x7a, 0x1f, 0xe6, 0x22, 0xb9, 0xd2, 0x30, 0x56, 0x8c, 0xdb, 0xf3, 0xad, 0x1b, 0x4e, 0x45,
+	0x12, 0x1d, 0x05, 0xde, 0x9e, 0x39, 0x39, 0x5b, 0xae, 0xb5, 0x35, 0xd0, 0xfe, 0xd6, 0x80, 0xc5,
+	0xc1, 0x33, 0x76, 0x52, 0xe6, 0x6b, 0x01, 0xa8, 0x09, 0xf5, 0x10, 0xc7, 0xfb, 0x24, 0xe9, 0x50,
+	0x5f, 0x9e, 0x51, 0x6f, 0x3f, 0xab, 0x16, 0x76, 0x7d, 0xb4, 0x03, 0xd0, 0xf7, 0xde, 0x98, 0x92,
+	0x0a, 0x6e, 0x3a, 0x2a, 0x51, 0x4e, 0x96, 0x28, 0x47, 0xdd, 0x6f, 0xff, 0xfc, 0x80, 0x68, 0xe2,
+	0x76, 0x09, 0x69, 0x9f, 0x4d, 0x81, 0x39, 0x4a, 0x82, 0x36, 0x79, 0x1f, 0x66, 0x9e, 0xa6, 0xcc,
+	0xd7, 0x16, 0xef, 0x54, 0x5a, 0x1c, 0x64, 0x90, 0x38, 0xf4, 0x08, 0xe6, 0x45, 0x0f, 0xc7, 0xa4,
+	0x13, 0xc5, 0xd4, 0x23, 0x52, 0x67, 0x7d, 0xdb, 0xc9, 0x92, 0xf0, 0xd7, 0xd9, 0xf2, 0xcd, 0x80,
+	0x26, 0xbd, 0xb4, 0xeb, 0x78, 0x3c, 0x74, 0xf5, 0x15, 0xab, 0x9f, 0xbb, 0xc2, 0xdf, 0x77, 0x93,
+	0xe3, 0x88, 0x08, 0xe7, 0x43, 0xe2, 0xb5, 0x41, 0x52, 0xec, 0x65, 0x0c, 0xe8, 0x29, 0xbc, 0x18,
+	0x11, 0xe6, 0x53, 0x16, 0x74, 0x62, 0xe2, 0x93, 0x30, 0x92, 0xb5, 0xd8, 0x98, 0x5e, 0x99, 0xbe,
+	0x3d, 0xdf, 0x72, 0x2b, 0xf5, 0xb5, 0x8b, 0xf8, 0xc7, 0x5e, 0x8f, 0xf8, 0xe9, 0x01, 0xd1, 0xd7,
+	0x81, 0x34, 0x63, 0x3f, 0x40, 0xa0, 0x87, 0x03, 0xf9, 0x9d, 0x91, 0xf6, 0x6f, 0x8d, 0xcd, 0xaf,
+	0xca, 0xda, 0x40, 0x82, 0x97, 0x46, 0xe5, 0xb7, 0x28, 0x32, 0x02, 0xcd, 0x91, 0xbb, 0x3a, 0xfd,
+	0x3b, 0x30, 0x9b, 0xa5, 0x31, 0x2b, 0xb1, 0xe9, 0xc9, 0xf2, 0xaf, 0xad, 0x29, 0xb8, 0xfd, 0x39,
+	0xac, 0xc8, 0x63, 0x3e, 0x12, 0x09, 0x0d, 0x71, 0x42, 0xfc, 0x92, 0xd5, 0xbc, 0xdc, 0x4c, 0x28,
+	0xaa, 0x6b, 0xa8, 0xda, 0x1a, 0xf0, 0x0c, 0xf6, 0xfd, 0x98, 0x08, 0xa1, 0xae, 0xb0, 0x9d, 0x3f,
+	0xda, 0x5f, 0xc2, 0x2b, 0x15, 0xcc, 0xda, 0xc6, 0x26, 0xcc, 0xe1, 0x90, 0xa7, 0x2c, 0xd1, 0x3e,
+	0x16, 0x07, 0x12, 0x99, 0xeb, 0xff, 0x80, 0x53, 0x96, 0x37, 0x88, 0x0a, 0xb7, 0x3f, 0x03, 0x4b,
+	0xb2, 0xef, 0x0d, 0x5d, 0xd0, 0xf5, 0x54, 0x3f, 0x81, 0xe5, 0x4b, 0x79, 0xaf, 0xab, 0x79, 0x11,
+	0x5e, 0x92, 0xdc, 0x9f, 0xf0, 0xac, 0xc4, 0x1e, 0x27, 0x38, 0xc9, 0x1b, 0xcf, 0xfe, 0x02, 0x1a,
+	0xc3, 0x5b, 0xfa, 0xbc, 0x07, 0x30, 0x2b, 0xb2, 0x05, 0xdd, 0x6a, 0xab, 0x95, 0x57, 0xfd, 0x50,
+	0x0d, 0x32, 0xc5, 0xa0, 0x70, 0xad, 0x1f, 0xeb, 0x30, 0x2b, 0xd9, 0xd1, 0x4f, 0x06, 0xbc, 0xf0,
+	0x9f, 0xa9, 0x85, 0xb6, 0x2a, 0xf9, 0x2a, 0xe6, 0xa0, 0x79, 0xef, 0x0a, 0x48, 0xe5, 0xc9, 0x5e,
+	0xfb, 0xee, 0x8f, 0x7f, 0x7e, 0x98, 0x7a, 0x15, 0xdd, 0x70, 0xab, 0xa6, 0xb2, 0x1a, 0x86, 0xe8,
+	0x57, 0x03, 0x9e, 0x1b, 0x28, 0x61, 0xf4, 0xd6, 0x04, 0x27, 0x97, 0x06, 0xa7, 0xb9, 0x39, 0x31,
+	0x4e, 0xeb, 0x7d, 0x20, 0xf5, 0xde, 0x43, 0x9b, 0xee, 0xff, 0x7a, 0xa9, 0x75, 0xb2, 0xe6, 0x72,
+	0xbf, 0x2e, 0x86, 0xf4, 0x37, 0xe8, 0x17, 0x03, 0x9e, 0x1f, 0x6c, 0x65, 0x34, 0xa9, 0x98, 0x22,
+	0xef, 0x5b, 0x93, 0x03, 0xb5, 0x8d, 0x0d, 0x69, 0xc3, 0x41, 0xaf, 0x4d, 0x60, 0x43, 0xa0, 0xdf,
+	0x0d, 0x58, 0x18, 0xd5, 0xc5, 0xe8, 0xbd, 0xf1, 0x42, 0x2a, 0xe6, 0x8a, 0x79, 0xff, 0xaa, 0x70,
+	0xed, 0xe6, 0x6d, 0xe9, 0x66, 0x03, 0xb5, 0x2a, 0xdd, 0x90, 0x9c, 0xa2, 0xfc, 0x5a, 0x40, 0xbf,
+	0x19, 0x80, 0x86, 0x7b, 0x1c, 0xbd, 0x33, 0x5e, 0xd2, 0xa5, 0x13, 0xc7, 0x7c, 0xf7, 0x6a, 0x60,
+	0xed, 0x66, 0x4b, 0xba, 0x69, 0xa1, 0xd7, 0xab, 0x5b, 0x62, 0xf8, 0x15, 0x87, 0x7e, 0x36, 0x60,
+	0xa1, 0xb8, 0xf0, 0xd2, 0x04, 0x41, 0x1b, 0xe3, 0x05, 0x0d, 0xcf, 0x22, 0xf3, 0xcd, 0x09, 0x51,
+	0x5a, 0xff, 0xba, 0xd4, 0xbf, 0x86, 0x56, 0x2b, 0xf5, 0x87, 0x12, 0xd9, 0x91, 0x83, 0x69, 0x9b,
+	0x9e, 0x9c, 0x5b, 0xc6, 0xe9, 0xb9, 0x65, 0xfc, 0x7d, 0x6e, 0x19, 0xdf, 0x5f, 0x58, 0xb5, 0xd3,
+	0x0b, 0xab, 0xf6, 0xe7, 0x85, 0x55, 0x7b, 0xf2, 0xa8, 0xf4, 0x01, 0xb0, 0x9b, 0xd3, 0x7d, 0x8c,
+	0xbb, 0xa2, 0x4f, 0x7e, 0xd7, 0xe3, 0x31, 0x29, 0x3f, 0xf6, 0x30, 0x65, 0x9a, 0x5f, 0x94, 0x4e,
+	0x96, 0x5f, 0x0b, 0xdd, 0x39, 0xf9, 0x65, 0xf7, 0xc6, 0xbf, 0x03, 0x00, 0xb0, 0x56, 0x08, 0x2f,
+	0xf9, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -994,6 +1033,18 @@ func (m *QueryInsuranceFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
 		copy(dAtA[i:], m.MarketId)
@@ -1024,6 +1075,42 @@ func (m *QueryInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PendingRedemptions) > 0 {
+		for iNdEx := len(m.PendingRedemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRedemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.Fund != nil {
 		{
 			size, err := m.Fund.MarshalToSizedBuffer(dAtA[:i])
@@ -1346,6 +1433,10 @@ func (m *QueryInsuranceFundRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1359,6 +1450,18 @@ func (m *QueryInsuranceFundResponse) Size() (n int) {
 		l = m.Fund.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = m.SharePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PendingRedemptions) > 0 {
+		for _, e := range m.PendingRedemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1672,6 +1775,42 @@ func (m *QueryInsuranceFundRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1758,6 +1897,110 @@ func (m *QueryInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRedemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRedemptions = append(m.PendingRedemptions, RedemptionSchedule{})
+			if err := m.PendingRedemptions[len(m.PendingRedemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
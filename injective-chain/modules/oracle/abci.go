@@ -1,7 +1,6 @@
 package oracle
 
 import (
-	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/InjectiveLabs/metrics"
@@ -63,6 +62,12 @@ func (h *BlockHandler) RequestAllBandIBCRates(ctx sdk.Context) {
 	}
 }
 
-func EndBlocker(ctx sdk.Context, block abci.RequestEndBlock) {
+func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
+	metrics.ReportFuncCall(h.svcTags)
+	doneFn := metrics.ReportFuncTiming(h.svcTags)
+	defer doneFn()
 
+	// after the reports of the block, so a validator reporting at the deadline isn't counted as missing it
+	h.k.TrackMissedValidatorReports(ctx)
+	h.k.PenalizeMissedValidatorReports(ctx)
 }
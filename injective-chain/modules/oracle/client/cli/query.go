@@ -29,6 +29,10 @@ func GetQueryCmd() *cobra.Command {
 		GetProvidersInfo(),
 		GetProvidersPrices(),
 		GetPythPriceFeed(),
+		GetRelayerNonceCmd(),
+		GetOracleReporterStatsCmd(),
+		GetOraclePricesCmd(),
+		GetAggregatedPriceCmd(),
 	)
 	return cmd
 }
@@ -136,3 +140,51 @@ func GetPythPriceFeed() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRelayerNonceCmd queries the last nonce accepted from a relayer
+func GetRelayerNonceCmd() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"relayer-nonce [relayer]",
+		"Gets the last nonce accepted from a relayer",
+		types.NewQueryClient,
+		&types.QueryRelayerNonceRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+	cmd.Long = "Gets the last nonce accepted from a relayer. Relayers should use a greater nonce for their next price update."
+	return cmd
+}
+
+// GetOracleReporterStatsCmd queries the oracle report counts of the validators
+func GetOracleReporterStatsCmd() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"reporter-stats",
+		"Gets the on time, late and missed oracle report counts of the validators",
+		types.NewQueryClient,
+		&types.QueryOracleReporterStatsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+	cmd.Long = "Gets the on time, late and missed oracle report counts of the validators within the reporter stats window."
+	return cmd
+}
+
+// GetOraclePricesCmd queries the current oracle prices with their freshness
+func GetOraclePricesCmd() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"oracle-prices",
+		"Gets the current price of every oracle price feed with its freshness",
+		types.NewQueryClient,
+		&types.QueryOraclePricesRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+	cmd.Long = "Gets the current price of every oracle price feed with the block and time of its last update, and whether it is stale relative to the price staleness threshold."
+	return cmd
+}
+
+// GetAggregatedPriceCmd queries the price of a symbol aggregated across the oracle providers
+func GetAggregatedPriceCmd() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"aggregated-price [symbol]",
+		"Gets the price of a symbol aggregated across the oracle providers",
+		types.NewQueryClient,
+		&types.QueryAggregatedPriceRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+	cmd.Long = "Gets the price of a symbol aggregated across the oracle providers by the aggregation policy of the symbol, along with the provider prices it is aggregated from."
+	return cmd
+}
@@ -36,6 +36,7 @@ const (
 	flagFeeLimit                 = "fee-limit"
 	flagPacketTimeoutTimestamp   = "packet-timeout-timestamp"
 	flagLegacyOracleScriptIDs    = "legacy-oracle-script-ids"
+	flagRelayerNonce             = "relayer-nonce"
 )
 
 // NewTxCmd returns a root CLI command handler for certain modules/oracle transaction commands.
@@ -320,12 +321,18 @@ func NewRelayBandRatesTxCmd() *cobra.Command {
 				return err
 			}
 
+			nonce, err := cmd.Flags().GetUint64(flagRelayerNonce)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRelayBandRates{
 				Relayer:      from.String(),
 				Symbols:      symbols,
 				Rates:        rates,
 				ResolveTimes: resolveTimes,
 				RequestIDs:   requestIDs,
+				Nonce:        nonce,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -336,6 +343,7 @@ func NewRelayBandRatesTxCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagRelayerNonce, 0, "nonce of the update, greater than the last nonce accepted from the relayer (0 to skip the replay guard)")
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -361,12 +369,18 @@ func NewRelayPriceFeedPriceTxCmd() *cobra.Command {
 				return err
 			}
 
+			nonce, err := cmd.Flags().GetUint64(flagRelayerNonce)
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
 			msg := &types.MsgRelayPriceFeedPrice{
 				Sender: from.String(),
 				Base:   []string{args[0]}, // BTC
 				Quote:  []string{args[1]}, // USDT
 				Price:  []sdk.Dec{price},
+				Nonce:  nonce,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -377,6 +391,7 @@ func NewRelayPriceFeedPriceTxCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagRelayerNonce, 0, "nonce of the update, greater than the last nonce accepted from the relayer (0 to skip the replay guard)")
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -868,11 +883,17 @@ func NewRelayProviderPricesProposalTxCmd() *cobra.Command {
 				prices[i] = price
 			}
 
+			nonce, err := cmd.Flags().GetUint64(flagRelayerNonce)
+			if err != nil {
+				return err
+			}
+
 			content := &types.MsgRelayProviderPrices{
 				Sender:   from.String(),
 				Provider: provider,
 				Symbols:  symbols,
 				Prices:   prices,
+				Nonce:    nonce,
 			}
 
 			if err := content.ValidateBasic(); err != nil {
@@ -884,6 +905,7 @@ func NewRelayProviderPricesProposalTxCmd() *cobra.Command {
 	}
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
 
+	cmd.Flags().Uint64(flagRelayerNonce, 0, "nonce of the update, greater than the last nonce accepted from the relayer (0 to skip the replay guard)")
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -40,6 +40,11 @@ func (k BandMsgServer) RelayBandRates(goCtx context.Context, msg *types.MsgRelay
 		return nil, types.ErrRelayerNotAuthorized
 	}
 
+	if err := k.UseRelayerNonce(ctx, relayer, msg.Nonce); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	// loop SetBandPriceState for all symbols
 	for idx := range msg.Symbols {
 		symbol := msg.Symbols[idx]
@@ -79,5 +84,7 @@ func (k BandMsgServer) RelayBandRates(goCtx context.Context, msg *types.MsgRelay
 		})
 	}
 
+	k.RecordValidatorReport(ctx, relayer)
+
 	return &types.MsgRelayBandRatesResponse{}, nil
 }
@@ -100,6 +100,22 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, pythPriceState := range data.PythPriceStates {
 		k.SetPythPriceState(ctx, pythPriceState)
 	}
+
+	for _, relayerNonce := range data.RelayerNonces {
+		relayerAddr, err := sdk.AccAddressFromBech32(relayerNonce.Relayer)
+		if err != nil {
+			panic(err)
+		}
+		k.SetRelayerNonce(ctx, relayerAddr, relayerNonce.Nonce)
+	}
+
+	for _, record := range data.ReporterRecords {
+		k.SetReporterRecord(ctx, record)
+	}
+
+	for _, vote := range data.PriceMoveVotes {
+		k.SetPriceMoveVote(ctx, vote)
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -119,5 +135,8 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		HistoricalPriceRecords: k.GetAllHistoricalPriceRecords(ctx),
 		ProviderStates:         k.GetAllProviderStates(ctx),
 		PythPriceStates:        k.GetAllPythPriceStates(ctx),
+		RelayerNonces:          k.GetAllRelayerNonces(ctx),
+		ReporterRecords:        k.GetAllReporterRecords(ctx),
+		PriceMoveVotes:         k.GetAllPriceMoveVotes(ctx),
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/InjectiveLabs/metrics"
 
@@ -255,3 +256,66 @@ func (k *Keeper) PythPrice(c context.Context, req *types.QueryPythPriceRequest)
 
 	return &types.QueryPythPriceResponse{PriceState: priceState}, nil
 }
+
+// RelayerNonce returns the last nonce accepted from a relayer, so that relayers can resume from it after a restart.
+func (k *Keeper) RelayerNonce(c context.Context, req *types.QueryRelayerNonceRequest) (*types.QueryRelayerNonceResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	relayer, err := sdk.AccAddressFromBech32(req.Relayer)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(sdkerrors.ErrInvalidAddress, req.Relayer)
+	}
+
+	return &types.QueryRelayerNonceResponse{Nonce: k.GetRelayerNonce(ctx, relayer)}, nil
+}
+
+// OracleReporterStats returns the counts of on time, late and missed oracle reports of the validators within the
+// reporter stats window.
+func (k *Keeper) OracleReporterStats(c context.Context, req *types.QueryOracleReporterStatsRequest) (*types.QueryOracleReporterStatsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats, pageRes, err := k.GetOracleReporterStats(ctx, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryOracleReporterStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
+
+// OraclePrices returns the current price of every oracle price feed with the block and time of its last update, and
+// whether it is stale relative to the price staleness threshold.
+func (k *Keeper) OraclePrices(c context.Context, req *types.QueryOraclePricesRequest) (*types.QueryOraclePricesResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	prices, pageRes, err := paginateOraclePriceFeeds(k.GetAllOraclePriceFeeds(ctx), req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryOraclePricesResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
+// AggregatedPrice returns the price of a symbol aggregated across the oracle providers by the aggregation policy of
+// the symbol, along with the provider prices it is aggregated from.
+func (k *Keeper) AggregatedPrice(c context.Context, req *types.QueryAggregatedPriceRequest) (*types.QueryAggregatedPriceResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	price, providerPrices, err := k.GetAggregatedPrice(ctx, req.Symbol)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.QueryAggregatedPriceResponse{Price: price, ProviderPrices: providerPrices}, nil
+}
@@ -34,6 +34,11 @@ type Keeper struct {
 
 	ocrKeeper types.OcrKeeper
 
+	misbehaviorSlasher types.MisbehaviorSlasher
+	stakingKeeper      types.StakingKeeper
+	slashingKeeper     types.SlashingKeeper
+	oracleAdapters     []OracleAdapter
+
 	svcTags metrics.Tags
 
 	authority string
@@ -5,7 +5,7 @@ import (
 	"time"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle"
-	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/keeper"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/onsi/ginkgo"
@@ -17,10 +17,9 @@ func TestKeeper(t *testing.T) {
 	RunSpecs(t, "Keeper Suite")
 }
 
-func EndBlockerAndCommit(ctx sdk.Context, counter int) sdk.Context {
+func EndBlockerAndCommit(k keeper.Keeper, ctx sdk.Context, counter int) sdk.Context {
 	for i := 0; i < counter; i++ {
-		endBlockReq := abci.RequestEndBlock{Height: ctx.BlockHeight()}
-		oracle.EndBlocker(ctx, endBlockReq)
+		oracle.NewBlockHandler(k).EndBlocker(ctx)
 
 		// build new context with height and time
 		height := ctx.BlockHeight() + 1
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// SetMisbehaviorSlasher sets the slasher of the validators reported for oracle misbehavior.
+func (k *Keeper) SetMisbehaviorSlasher(slasher types.MisbehaviorSlasher) {
+	if k.misbehaviorSlasher != nil {
+		panic("cannot set misbehavior slasher twice")
+	}
+
+	k.misbehaviorSlasher = slasher
+}
+
+// IsOracleMisbehaviorSlashed returns true if the oracle misbehavior of the validator at the height was slashed.
+func (k *Keeper) IsOracleMisbehaviorSlashed(ctx sdk.Context, validator sdk.ValAddress, infractionHeight int64) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getStore(ctx).Has(types.GetOracleMisbehaviorKey(validator, infractionHeight))
+}
+
+// HandleOracleMisbehavior slashes a validator for an oracle misbehavior at the infraction height, by the governance set
+// oracle misbehavior slash fraction, and returns the fraction. The misbehavior of a validator at a height is only
+// slashed once, so the same evidence can't be applied twice.
+func (k *Keeper) HandleOracleMisbehavior(ctx sdk.Context, validator sdk.ValAddress, infractionHeight int64) (sdk.Dec, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if infractionHeight <= 0 || infractionHeight > ctx.BlockHeight() {
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, types.ErrInvalidOracleMisbehavior.Wrapf("infraction height %d must be positive and at most the current height", infractionHeight)
+	}
+
+	if k.misbehaviorSlasher == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, types.ErrInvalidOracleMisbehavior.Wrap("no misbehavior slasher set")
+	}
+
+	if k.IsOracleMisbehaviorSlashed(ctx, validator, infractionHeight) {
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, types.ErrOracleMisbehaviorSlashed.Wrapf("validator %s at height %d", validator.String(), infractionHeight)
+	}
+
+	slashFraction, err := k.misbehaviorSlasher.SlashOracleMisbehavior(ctx, validator, infractionHeight)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, err
+	}
+
+	k.getStore(ctx).Set(types.GetOracleMisbehaviorKey(validator, infractionHeight), []byte{})
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventOracleMisbehaviorSlashed{
+		Validator:        validator.String(),
+		InfractionHeight: infractionHeight,
+		SlashFraction:    slashFraction,
+	})
+
+	return slashFraction, nil
+}
@@ -36,7 +36,7 @@ var _ = Describe("MsgServer Tests", func() {
 		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "3", Time: time.Unix(1618997040, 0)})
 		msgServer = keeper.NewMsgServerImpl(app.OracleKeeper)
 		goCtx = sdk.WrapSDKContext(ctx)
-		ctx = EndBlockerAndCommit(ctx, 1)
+		ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 
 		// init priceFeedPairs
 		priceFeedPairs = []types.PriceFeedInfo{
@@ -52,7 +52,7 @@ var _ = Describe("MsgServer Tests", func() {
 		app.OracleKeeper.SetPriceFeedRelayer(ctx, priceFeedPairs[1].Base, priceFeedPairs[1].Quote, OracleAccAddrs[1])
 		app.OracleKeeper.SetPriceFeedRelayer(ctx, priceFeedPairs[2].Base, priceFeedPairs[2].Quote, OracleAccAddrs[2])
 
-		ctx = EndBlockerAndCommit(ctx, 5)
+		ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 5)
 		goCtx = sdk.WrapSDKContext(ctx)
 	})
 
@@ -75,7 +75,7 @@ var _ = Describe("MsgServer Tests", func() {
 				Expect(data.CumulativePrice).To(BeEquivalentTo(sdk.NewDec(0)))
 
 				// fast forward 5 blocks (5000ms)
-				ctx = EndBlockerAndCommit(ctx, 5)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 5)
 				goCtx = sdk.WrapSDKContext(ctx)
 			})
 
@@ -90,7 +90,7 @@ var _ = Describe("MsgServer Tests", func() {
 				Expect(data.CumulativePrice).To(BeEquivalentTo(sdk.NewDec(290000)))
 
 				// fast forward 8 blocks (8000ms)
-				ctx = EndBlockerAndCommit(ctx, 8)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 8)
 				goCtx = sdk.WrapSDKContext(ctx)
 			})
 
@@ -105,7 +105,7 @@ var _ = Describe("MsgServer Tests", func() {
 				Expect(data.CumulativePrice).To(BeEquivalentTo(sdk.NewDec(762960)))
 
 				// fast forward 4 blocks (4000ms)
-				ctx = EndBlockerAndCommit(ctx, 4)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 4)
 				goCtx = sdk.WrapSDKContext(ctx)
 			})
 
@@ -133,7 +133,7 @@ var _ = Describe("MsgServer Tests", func() {
 				}
 				_, err := msgServer.RelayPriceFeedPrice(goCtx, msg)
 				Expect(err).ToNot(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 		})
 
@@ -154,18 +154,18 @@ var _ = Describe("MsgServer Tests", func() {
 			It("Should pass", func() {
 				_, err := msgServer.RelayPriceFeedPrice(goCtx, msg1)
 				Expect(err).To(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 			It("Should pass", func() {
 				msg1.Sender = OracleAccAddrs[1].String()
 				_, err := msgServer.RelayPriceFeedPrice(goCtx, msg1)
 				Expect(err).To(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 			It("Should pass", func() {
 				_, err := msgServer.RelayPriceFeedPrice(goCtx, msg2)
 				Expect(err).To(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 		})
 
@@ -179,7 +179,7 @@ var _ = Describe("MsgServer Tests", func() {
 				}
 				_, err := msgServer.RelayPriceFeedPrice(goCtx, msg)
 				Expect(err).NotTo(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 		})
 
@@ -194,14 +194,14 @@ var _ = Describe("MsgServer Tests", func() {
 			It("Should pass", func() {
 				_, err := msgServer.RelayPriceFeedPrice(goCtx, msg)
 				Expect(err).To(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 
 			It("Should pass", func() {
 				msg.Sender = OracleAccAddrs[1].String()
 				_, err := msgServer.RelayPriceFeedPrice(goCtx, msg)
 				Expect(err).To(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 		})
 
@@ -249,7 +249,7 @@ var _ = Describe("MsgServer Tests", func() {
 				_, err := msgServer.RelayCoinbaseMessages(goCtx, msg)
 
 				Expect(err).To(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 		})
 
@@ -268,7 +268,7 @@ var _ = Describe("MsgServer Tests", func() {
 				_, err := msgServer.RelayCoinbaseMessages(goCtx, msg)
 
 				Expect(err).NotTo(BeNil())
-				ctx = EndBlockerAndCommit(ctx, 1)
+				ctx = EndBlockerAndCommit(app.OracleKeeper, ctx, 1)
 			})
 		})
 
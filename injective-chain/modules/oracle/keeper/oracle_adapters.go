@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// OracleAdapter is a source of oracle prices. Every price it returns is tagged with the provider it comes from, so
+// that the prices of a symbol can be aggregated across the providers.
+type OracleAdapter interface {
+	// Name returns the name the adapter is registered under.
+	Name() string
+	// GetProviderPrices returns the current prices of the symbol, at most one per provider.
+	GetProviderPrices(ctx sdk.Context, symbol string) []types.ProviderPrice
+}
+
+type AggregationKeeper interface {
+	RegisterOracleAdapter(adapter OracleAdapter)
+	GetProviderPrices(ctx sdk.Context, symbol string) []types.ProviderPrice
+	GetAggregatedPrice(ctx sdk.Context, symbol string) (sdk.Dec, []types.ProviderPrice, error)
+}
+
+const (
+	BandAdapterName     = "band"
+	BandIBCAdapterName  = "band_ibc"
+	CoinbaseAdapterName = "coinbase"
+	ProviderAdapterName = "provider"
+)
+
+// RegisterOracleAdapter registers an external oracle adapter, whose prices are aggregated along with the prices of the
+// built-in adapters. As the other keeper dependencies, it must be registered before the keeper is passed to the other
+// modules.
+func (k *Keeper) RegisterOracleAdapter(adapter OracleAdapter) {
+	for _, registered := range k.getOracleAdapters() {
+		if registered.Name() == adapter.Name() {
+			panic(types.ErrOracleAdapterExists.Wrap(adapter.Name()))
+		}
+	}
+
+	k.oracleAdapters = append(k.oracleAdapters, adapter)
+}
+
+// getOracleAdapters returns the built-in adapters followed by the registered ones.
+func (k *Keeper) getOracleAdapters() []OracleAdapter {
+	adapters := []OracleAdapter{
+		bandAdapter{k: k},
+		bandIBCAdapter{k: k},
+		coinbaseAdapter{k: k},
+		providerAdapter{k: k},
+	}
+
+	return append(adapters, k.oracleAdapters...)
+}
+
+// GetProviderPrices returns the current prices of the symbol across every oracle adapter, sorted by provider.
+func (k *Keeper) GetProviderPrices(ctx sdk.Context, symbol string) []types.ProviderPrice {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	prices := make([]types.ProviderPrice, 0)
+	for _, adapter := range k.getOracleAdapters() {
+		prices = append(prices, adapter.GetProviderPrices(ctx, symbol)...)
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Provider < prices[j].Provider
+	})
+
+	return prices
+}
+
+// GetAggregatedPrice aggregates the fresh prices of the symbol across the providers, by the aggregation policy of the
+// symbol. It returns the price along with the provider prices it is aggregated from.
+func (k *Keeper) GetAggregatedPrice(ctx sdk.Context, symbol string) (sdk.Dec, []types.ProviderPrice, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+
+	var aggregation *types.SymbolAggregation
+	for idx := range params.SymbolAggregations {
+		if params.SymbolAggregations[idx].Symbol == symbol {
+			aggregation = &params.SymbolAggregations[idx]
+			break
+		}
+	}
+
+	if aggregation == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, nil, types.ErrPriceAggregationNotFound.Wrapf("symbol %s", symbol)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	prices := make([]types.ProviderPrice, 0)
+	for _, price := range k.GetProviderPrices(ctx, symbol) {
+		if params.PriceStalenessThreshold > 0 && blockTime-price.Timestamp > params.PriceStalenessThreshold {
+			continue
+		}
+
+		prices = append(prices, price)
+	}
+
+	minProviders := int(aggregation.MinProviders)
+	if minProviders == 0 {
+		minProviders = 1
+	}
+
+	if len(prices) < minProviders {
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, nil, types.ErrInsufficientProviderPrices.Wrapf("symbol %s has %d fresh provider prices, %d required", symbol, len(prices), minProviders)
+	}
+
+	switch aggregation.Policy {
+	case types.AggregationPolicy_AggregationMedian:
+		return medianProviderPrice(prices), prices, nil
+	default:
+		metrics.ReportFuncError(k.svcTags)
+		return sdk.Dec{}, nil, types.ErrPriceAggregationNotFound.Wrapf("unsupported aggregation policy %s for symbol %s", aggregation.Policy.String(), symbol)
+	}
+}
+
+// medianProviderPrice returns the median of the provider prices, the mean of the two middle prices for an even count.
+func medianProviderPrice(prices []types.ProviderPrice) sdk.Dec {
+	sorted := make([]sdk.Dec, 0, len(prices))
+	for _, price := range prices {
+		sorted = append(sorted, price.Price)
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
+
+type bandAdapter struct {
+	k *Keeper
+}
+
+func (a bandAdapter) Name() string {
+	return BandAdapterName
+}
+
+func (a bandAdapter) GetProviderPrices(ctx sdk.Context, symbol string) []types.ProviderPrice {
+	priceState := a.k.GetBandPriceState(ctx, symbol)
+	if priceState == nil {
+		return nil
+	}
+
+	return []types.ProviderPrice{newProviderPrice(BandAdapterName, &priceState.PriceState)}
+}
+
+type bandIBCAdapter struct {
+	k *Keeper
+}
+
+func (a bandIBCAdapter) Name() string {
+	return BandIBCAdapterName
+}
+
+func (a bandIBCAdapter) GetProviderPrices(ctx sdk.Context, symbol string) []types.ProviderPrice {
+	priceState := a.k.GetBandIBCPriceState(ctx, symbol)
+	if priceState == nil {
+		return nil
+	}
+
+	return []types.ProviderPrice{newProviderPrice(BandIBCAdapterName, &priceState.PriceState)}
+}
+
+type coinbaseAdapter struct {
+	k *Keeper
+}
+
+func (a coinbaseAdapter) Name() string {
+	return CoinbaseAdapterName
+}
+
+func (a coinbaseAdapter) GetProviderPrices(ctx sdk.Context, symbol string) []types.ProviderPrice {
+	priceState := a.k.getLastCoinbasePriceState(ctx, symbol)
+	if priceState == nil {
+		return nil
+	}
+
+	return []types.ProviderPrice{newProviderPrice(CoinbaseAdapterName, &priceState.PriceState)}
+}
+
+// providerAdapter tags the price of each provider of the provider oracle with the provider name.
+type providerAdapter struct {
+	k *Keeper
+}
+
+func (a providerAdapter) Name() string {
+	return ProviderAdapterName
+}
+
+func (a providerAdapter) GetProviderPrices(ctx sdk.Context, symbol string) []types.ProviderPrice {
+	prices := make([]types.ProviderPrice, 0)
+	for _, info := range a.k.GetAllProviderInfos(ctx) {
+		priceState := a.k.GetProviderPriceState(ctx, info.Provider, symbol)
+		if priceState == nil || priceState.State == nil {
+			continue
+		}
+
+		prices = append(prices, newProviderPrice(fmt.Sprintf("%s/%s", ProviderAdapterName, info.Provider), priceState.State))
+	}
+
+	return prices
+}
+
+func newProviderPrice(provider string, priceState *types.PriceState) types.ProviderPrice {
+	return types.ProviderPrice{
+		Provider:  provider,
+		Price:     priceState.Price,
+		Timestamp: priceState.Timestamp,
+	}
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type testOracleAdapter struct {
+	name  string
+	price types.ProviderPrice
+}
+
+func (a testOracleAdapter) Name() string {
+	return a.name
+}
+
+func (a testOracleAdapter) GetProviderPrices(_ sdk.Context, _ string) []types.ProviderPrice {
+	return []types.ProviderPrice{a.price}
+}
+
+var _ = Describe("Oracle price aggregation", func() {
+	var (
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+
+		blockTime = time.Unix(1618997040, 0)
+		symbol    = "BTC"
+
+		relayers = map[string]string{
+			"providerA": "inj1rgmw7dlgwqpwwf3j8zy4qvg9zkvtgeuy568fff",
+			"providerB": "inj1l0zxkd8tkam0tvg68uqh7xvym79mtw8329vd43",
+			"providerC": "inj13tqdeq5hv9hjr9sz58a42xkww05q6pwf5reey9",
+		}
+	)
+
+	relayPrice := func(provider string, price sdk.Dec) {
+		_, err := msgServer.RelayProviderPrices(sdk.WrapSDKContext(ctx), &types.MsgRelayProviderPrices{
+			Sender:   relayers[provider],
+			Provider: provider,
+			Symbols:  []string{symbol},
+			Prices:   []sdk.Dec{price},
+		})
+		Expect(err).To(BeNil())
+	}
+
+	setAggregation := func(minProviders uint32) {
+		params := app.OracleKeeper.GetParams(ctx)
+		params.SymbolAggregations = []types.SymbolAggregation{{
+			Symbol:       symbol,
+			Policy:       types.AggregationPolicy_AggregationMedian,
+			MinProviders: minProviders,
+		}}
+		app.OracleKeeper.SetParams(ctx, params)
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "3", Time: blockTime})
+		msgServer = keeper.NewMsgServerImpl(app.OracleKeeper)
+
+		for provider, relayer := range relayers {
+			Expect(app.OracleKeeper.SetProviderInfo(ctx, &types.ProviderInfo{Provider: provider, Relayers: []string{relayer}})).To(BeNil())
+		}
+
+		relayPrice("providerA", sdk.NewDec(10))
+		relayPrice("providerB", sdk.NewDec(30))
+		relayPrice("providerC", sdk.NewDec(12))
+	})
+
+	It("tags the submitted prices with their provider", func() {
+		prices := app.OracleKeeper.GetProviderPrices(ctx, symbol)
+
+		Expect(prices).To(HaveLen(3))
+		Expect(prices[0].Provider).To(Equal("provider/providerA"))
+		Expect(prices[0].Price.String()).To(Equal(sdk.NewDec(10).String()))
+		Expect(prices[1].Provider).To(Equal("provider/providerB"))
+		Expect(prices[1].Price.String()).To(Equal(sdk.NewDec(30).String()))
+		Expect(prices[2].Provider).To(Equal("provider/providerC"))
+		Expect(prices[2].Price.String()).To(Equal(sdk.NewDec(12).String()))
+	})
+
+	It("aggregates the median of an odd number of prices", func() {
+		setAggregation(3)
+
+		price, prices, err := app.OracleKeeper.GetAggregatedPrice(ctx, symbol)
+		Expect(err).To(BeNil())
+		Expect(price.String()).To(Equal(sdk.NewDec(12).String()))
+		Expect(prices).To(HaveLen(3))
+	})
+
+	It("aggregates the median of an even number of prices across the adapters", func() {
+		setAggregation(4)
+		app.OracleKeeper.SetBandPriceState(ctx, symbol, &types.BandPriceState{
+			Symbol:     symbol,
+			Rate:       sdk.NewInt(14),
+			PriceState: *types.NewPriceState(sdk.NewDec(14), blockTime.Unix()),
+		})
+
+		res, err := app.OracleKeeper.AggregatedPrice(sdk.WrapSDKContext(ctx), &types.QueryAggregatedPriceRequest{Symbol: symbol})
+		Expect(err).To(BeNil())
+		Expect(res.Price.String()).To(Equal(sdk.NewDec(13).String()))
+		Expect(res.ProviderPrices[0].Provider).To(Equal(keeper.BandAdapterName))
+	})
+
+	It("ignores the stale prices", func() {
+		setAggregation(3)
+
+		params := app.OracleKeeper.GetParams(ctx)
+		params.PriceStalenessThreshold = 60
+		app.OracleKeeper.SetParams(ctx, params)
+
+		ctx = ctx.WithBlockTime(blockTime.Add(30 * time.Second))
+		relayPrice("providerB", sdk.NewDec(20))
+
+		ctx = ctx.WithBlockTime(blockTime.Add(90 * time.Second))
+		_, _, err := app.OracleKeeper.GetAggregatedPrice(ctx, symbol)
+		Expect(err).To(MatchError(types.ErrInsufficientProviderPrices))
+
+		setAggregation(1)
+		price, _, err := app.OracleKeeper.GetAggregatedPrice(ctx, symbol)
+		Expect(err).To(BeNil())
+		Expect(price.String()).To(Equal(sdk.NewDec(20).String()))
+	})
+
+	It("doesn't aggregate the symbols without an aggregation policy", func() {
+		_, _, err := app.OracleKeeper.GetAggregatedPrice(ctx, symbol)
+		Expect(err).To(MatchError(types.ErrPriceAggregationNotFound))
+	})
+
+	It("aggregates the prices of the registered adapters", func() {
+		setAggregation(4)
+
+		k := app.OracleKeeper
+		k.RegisterOracleAdapter(testOracleAdapter{
+			name:  "external",
+			price: types.ProviderPrice{Provider: "external", Price: sdk.NewDec(100), Timestamp: blockTime.Unix()},
+		})
+
+		price, _, err := k.GetAggregatedPrice(ctx, symbol)
+		Expect(err).To(BeNil())
+		Expect(price.String()).To(Equal(sdk.NewDec(21).String()))
+
+		Expect(func() {
+			k.RegisterOracleAdapter(testOracleAdapter{name: keeper.ProviderAdapterName})
+		}).To(Panic())
+	})
+})
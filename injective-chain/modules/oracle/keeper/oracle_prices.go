@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// GetPriceUpdateHeight returns the height of the last price update of a symbol of an oracle type, or zero if unknown.
+func (k *Keeper) GetPriceUpdateHeight(ctx sdk.Context, oracleType types.OracleType, symbol string) int64 {
+	bz := k.getStore(ctx).Get(types.GetPriceUpdateHeightKey(oracleType, symbol))
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+func (k *Keeper) setPriceUpdateHeight(ctx sdk.Context, oracleType types.OracleType, symbol string, height int64) {
+	k.getStore(ctx).Set(types.GetPriceUpdateHeightKey(oracleType, symbol), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetAllOraclePriceFeeds returns the current price of every oracle price feed with the block and time of its last
+// update, sorted by oracle type and symbol. A price is stale once the price staleness threshold passed since its
+// last update.
+func (k *Keeper) GetAllOraclePriceFeeds(ctx sdk.Context) []types.OraclePriceFeed {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	threshold := k.GetParams(ctx).PriceStalenessThreshold
+	blockTime := ctx.BlockTime().Unix()
+
+	feeds := make([]types.OraclePriceFeed, 0)
+	// the record symbol is the one the price updates are recorded under, which may differ from the displayed symbol
+	addFeed := func(oracleType types.OracleType, symbol, recordSymbol string, priceState *types.PriceState) {
+		if priceState == nil {
+			return
+		}
+
+		feeds = append(feeds, types.OraclePriceFeed{
+			OracleType:          oracleType,
+			Symbol:              symbol,
+			Price:               priceState.Price,
+			LastUpdateHeight:    k.GetPriceUpdateHeight(ctx, oracleType, recordSymbol),
+			LastUpdateTimestamp: priceState.Timestamp,
+			Stale:               threshold > 0 && blockTime-priceState.Timestamp > threshold,
+		})
+	}
+
+	for _, state := range k.GetAllBandPriceStates(ctx) {
+		addFeed(types.OracleType_Band, state.Symbol, state.Symbol, &state.PriceState)
+	}
+
+	for _, state := range k.GetAllBandIBCPriceStates(ctx) {
+		addFeed(types.OracleType_BandIBC, state.Symbol, state.Symbol, &state.PriceState)
+	}
+
+	for _, state := range k.GetAllPriceFeedStates(ctx) {
+		pair := fmt.Sprintf("%s/%s", state.Base, state.Quote)
+		addFeed(types.OracleType_PriceFeed, pair, pair, state.PriceState)
+	}
+
+	for _, state := range k.GetAllCoinbasePriceStates(ctx) {
+		addFeed(types.OracleType_Coinbase, state.Key, state.Key, &state.PriceState)
+	}
+
+	for _, state := range k.GetAllChainlinkPriceStates(ctx) {
+		addFeed(types.OracleType_Chainlink, state.FeedId, state.FeedId, &state.PriceState)
+	}
+
+	for _, state := range k.GetAllPythPriceStates(ctx) {
+		priceID := common.HexToHash(state.PriceId).Hex()
+		addFeed(types.OracleType_Pyth, priceID, priceID, &state.PriceState)
+	}
+
+	for _, state := range k.GetAllProviderStates(ctx) {
+		provider := state.ProviderInfo.Provider
+		for _, priceState := range state.ProviderPriceStates {
+			addFeed(
+				types.OracleType_Provider,
+				fmt.Sprintf("%s/%s", provider, priceState.Symbol),
+				fmt.Sprintf("%s/%s", types.GetDelimitedProvider(provider), priceState.Symbol),
+				priceState.State,
+			)
+		}
+	}
+
+	sort.Slice(feeds, func(i, j int) bool {
+		return bytes.Compare(oraclePriceFeedKey(feeds[i]), oraclePriceFeedKey(feeds[j])) < 0
+	})
+
+	return feeds
+}
+
+// oraclePriceFeedKey returns the pagination key of an oracle price feed.
+func oraclePriceFeedKey(feed types.OraclePriceFeed) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(feed.OracleType)), feed.Symbol...)
+}
+
+// paginateOraclePriceFeeds returns the page of the oracle price feeds selected by the page request. The next key is
+// the key of the first price feed of the next page.
+func paginateOraclePriceFeeds(feeds []types.OraclePriceFeed, pageReq *query.PageRequest) ([]types.OraclePriceFeed, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]types.OraclePriceFeed, len(feeds))
+		for i, feed := range feeds {
+			reversed[len(feeds)-1-i] = feed
+		}
+		feeds = reversed
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := uint64(0)
+	if len(pageReq.Key) > 0 {
+		start = uint64(sort.Search(len(feeds), func(i int) bool {
+			cmp := bytes.Compare(oraclePriceFeedKey(feeds[i]), pageReq.Key)
+			if pageReq.Reverse {
+				return cmp <= 0
+			}
+			return cmp >= 0
+		}))
+	} else {
+		start = pageReq.Offset
+	}
+
+	total := uint64(len(feeds))
+	if start > total {
+		start = total
+	}
+
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = oraclePriceFeedKey(feeds[end])
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return feeds[start:end], pageRes, nil
+}
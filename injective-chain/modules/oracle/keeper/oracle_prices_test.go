@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Oracle prices", func() {
+	var (
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		blockTime = time.Unix(1618997040, 0)
+	)
+
+	setPrice := func(height int64, base, quote string, price sdk.Dec, timestamp int64) {
+		app.OracleKeeper.SetPriceFeedInfo(ctx.WithBlockHeight(height), &types.PriceFeedInfo{Base: base, Quote: quote})
+		app.OracleKeeper.SetPriceFeedPriceState(ctx.WithBlockHeight(height), base, quote, types.NewPriceState(price, timestamp))
+	}
+
+	queryPrices := func(pageReq *query.PageRequest) *types.QueryOraclePricesResponse {
+		res, err := app.OracleKeeper.OraclePrices(sdk.WrapSDKContext(ctx), &types.QueryOraclePricesRequest{Pagination: pageReq})
+		Expect(err).To(BeNil())
+		return res
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 100, ChainID: "3", Time: blockTime})
+
+		params := app.OracleKeeper.GetParams(ctx)
+		params.PriceStalenessThreshold = 60
+		app.OracleKeeper.SetParams(ctx, params)
+
+		setPrice(40, "ATOM", "USDT", sdk.NewDec(8), blockTime.Unix()-600)
+		setPrice(99, "INJ", "USDT", sdk.NewDec(25), blockTime.Unix()-10)
+	})
+
+	It("flags the old prices stale", func() {
+		Expect(queryPrices(nil).Prices).To(Equal([]types.OraclePriceFeed{{
+			OracleType:          types.OracleType_PriceFeed,
+			Symbol:              "ATOM/USDT",
+			Price:               sdk.NewDec(8),
+			LastUpdateHeight:    40,
+			LastUpdateTimestamp: blockTime.Unix() - 600,
+			Stale:               true,
+		}, {
+			OracleType:          types.OracleType_PriceFeed,
+			Symbol:              "INJ/USDT",
+			Price:               sdk.NewDec(25),
+			LastUpdateHeight:    99,
+			LastUpdateTimestamp: blockTime.Unix() - 10,
+			Stale:               false,
+		}}))
+	})
+
+	It("doesn't flag the prices when the threshold is unset", func() {
+		app.OracleKeeper.SetParams(ctx, types.DefaultParams())
+
+		for _, price := range queryPrices(nil).Prices {
+			Expect(price.Stale).To(BeFalse())
+		}
+	})
+
+	It("paginates the prices", func() {
+		res := queryPrices(&query.PageRequest{Limit: 1, CountTotal: true})
+		Expect(res.Prices).To(HaveLen(1))
+		Expect(res.Prices[0].Symbol).To(Equal("ATOM/USDT"))
+		Expect(res.Pagination.Total).To(Equal(uint64(2)))
+
+		res = queryPrices(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 1})
+		Expect(res.Prices).To(HaveLen(1))
+		Expect(res.Prices[0].Symbol).To(Equal("INJ/USDT"))
+		Expect(res.Pagination.NextKey).To(BeEmpty())
+	})
+})
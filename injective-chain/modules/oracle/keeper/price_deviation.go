@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type PriceDeviationKeeper interface {
+	AcceptPriceSubmission(ctx sdk.Context, oracleType types.OracleType, symbol string, lastPrice *sdk.Dec, price sdk.Dec, reporter sdk.AccAddress, reporters []string) bool
+	GetPriceMoveVotes(ctx sdk.Context, oracleType types.OracleType, symbol string) []*types.PriceMoveVote
+	SetPriceMoveVote(ctx sdk.Context, vote *types.PriceMoveVote)
+	GetAllPriceMoveVotes(ctx sdk.Context) []*types.PriceMoveVote
+}
+
+const (
+	// minPriceMoveVotes is the minimum number of votes agreeing on a large price move, so that a single submission
+	// can't move the price of a symbol by more than its max price deviation. The only reporter of a symbol reaches it
+	// by confirming its vote in a later block.
+	minPriceMoveVotes = 2
+
+	// priceMoveVoteWindow is the number of seconds during which a price move vote counts towards a large price move,
+	// so that a move can't be accepted on the strength of votes for a move long past.
+	priceMoveVoteWindow int64 = 10 * 60
+)
+
+// getMaxPriceDeviation returns the max price deviation of the symbol, if it's guarded.
+func getMaxPriceDeviation(params types.Params, symbol string) (sdk.Dec, bool) {
+	for _, deviation := range params.SymbolPriceDeviations {
+		if deviation.Symbol == symbol {
+			return deviation.MaxPriceDeviation, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// AcceptPriceSubmission returns true if the price of the symbol of the oracle type submitted by the reporter can be
+// accepted. A price deviating from the last accepted price by more than the max price deviation of the symbol is
+// recorded as a vote for a price move, and only accepted once more than two thirds of the reporters of the symbol, and
+// at least two, voted within the last priceMoveVoteWindow seconds for prices within the max price deviation of it. With
+// a single reporter, its vote from an earlier block counts as the second vote. The votes of the symbol are cleared
+// whenever a price is accepted.
+func (k *Keeper) AcceptPriceSubmission(
+	ctx sdk.Context,
+	oracleType types.OracleType,
+	symbol string,
+	lastPrice *sdk.Dec,
+	price sdk.Dec,
+	reporter sdk.AccAddress,
+	reporters []string,
+) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	symbol = types.GetPriceDeviationSymbol(oracleType, symbol)
+
+	maxDeviation, ok := getMaxPriceDeviation(k.GetParams(ctx), symbol)
+	if !ok {
+		return true
+	}
+
+	if lastPrice == nil || !lastPrice.IsPositive() || price.Sub(*lastPrice).Abs().Quo(*lastPrice).LTE(maxDeviation) {
+		k.deletePriceMoveVotes(ctx, symbol)
+		return true
+	}
+
+	previousVote := k.getPriceMoveVote(ctx, symbol, reporter)
+	k.SetPriceMoveVote(ctx, &types.PriceMoveVote{
+		Symbol:      symbol,
+		Reporter:    reporter.String(),
+		Price:       price,
+		Timestamp:   ctx.BlockTime().Unix(),
+		BlockHeight: ctx.BlockHeight(),
+	})
+
+	isReporter := make(map[string]struct{}, len(reporters))
+	for _, r := range reporters {
+		isReporter[r] = struct{}{}
+	}
+
+	agreeing := 0
+	maxDistance := maxDeviation.Mul(price)
+	oldestVoteTimestamp := ctx.BlockTime().Unix() - priceMoveVoteWindow
+	for _, vote := range k.getPriceMoveVotes(ctx, types.GetPriceMoveVotePrefix(symbol)) {
+		if _, ok := isReporter[vote.Reporter]; !ok || vote.Timestamp < oldestVoteTimestamp {
+			continue
+		}
+
+		if vote.Price.Sub(price).Abs().LTE(maxDistance) {
+			agreeing++
+		}
+	}
+
+	// the only reporter confirms its move by voting for it again in a later block
+	if len(reporters) < minPriceMoveVotes && previousVote != nil && previousVote.BlockHeight < ctx.BlockHeight() {
+		if _, ok := isReporter[previousVote.Reporter]; ok && previousVote.Timestamp >= oldestVoteTimestamp && previousVote.Price.Sub(price).Abs().LTE(maxDistance) {
+			agreeing++
+		}
+	}
+
+	if agreeing >= minPriceMoveVotes && 3*agreeing > 2*len(reporters) {
+		k.deletePriceMoveVotes(ctx, symbol)
+		return true
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventPriceDeviationRejected{
+		Symbol:    symbol,
+		Reporter:  reporter.String(),
+		LastPrice: *lastPrice,
+		Price:     price,
+	})
+
+	return false
+}
+
+// GetPriceMoveVotes returns the price move votes of the symbol of the oracle type, by reporter address.
+func (k *Keeper) GetPriceMoveVotes(ctx sdk.Context, oracleType types.OracleType, symbol string) []*types.PriceMoveVote {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getPriceMoveVotes(ctx, types.GetPriceMoveVotePrefix(types.GetPriceDeviationSymbol(oracleType, symbol)))
+}
+
+// SetPriceMoveVote sets the price move vote of a reporter for a symbol.
+func (k *Keeper) SetPriceMoveVote(ctx sdk.Context, vote *types.PriceMoveVote) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	reporter, err := sdk.AccAddressFromBech32(vote.Reporter)
+	if err != nil {
+		panic(err)
+	}
+
+	k.getStore(ctx).Set(types.GetPriceMoveVoteKey(vote.Symbol, reporter), k.cdc.MustMarshal(vote))
+}
+
+// GetAllPriceMoveVotes returns the price move votes of every symbol.
+func (k *Keeper) GetAllPriceMoveVotes(ctx sdk.Context) []*types.PriceMoveVote {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getPriceMoveVotes(ctx, types.PriceMoveVotePrefix)
+}
+
+func (k *Keeper) getPriceMoveVote(ctx sdk.Context, symbol string, reporter sdk.AccAddress) *types.PriceMoveVote {
+	bz := k.getStore(ctx).Get(types.GetPriceMoveVoteKey(symbol, reporter))
+	if bz == nil {
+		return nil
+	}
+
+	var vote types.PriceMoveVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return &vote
+}
+
+func (k *Keeper) getPriceMoveVotes(ctx sdk.Context, keyPrefix []byte) []*types.PriceMoveVote {
+	votes := make([]*types.PriceMoveVote, 0)
+	voteStore := prefix.NewStore(k.getStore(ctx), keyPrefix)
+
+	iterator := voteStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.PriceMoveVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, &vote)
+	}
+
+	return votes
+}
+
+func (k *Keeper) deletePriceMoveVotes(ctx sdk.Context, symbol string) {
+	voteStore := prefix.NewStore(k.getStore(ctx), types.GetPriceMoveVotePrefix(symbol))
+
+	iterator := voteStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		voteStore.Delete(key)
+	}
+}
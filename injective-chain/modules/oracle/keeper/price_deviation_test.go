@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Oracle price deviation guard", func() {
+	var (
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+
+		relayers = []string{
+			"inj1rgmw7dlgwqpwwf3j8zy4qvg9zkvtgeuy568fff",
+			"inj1l0zxkd8tkam0tvg68uqh7xvym79mtw8329vd43",
+			"inj13tqdeq5hv9hjr9sz58a42xkww05q6pwf5reey9",
+		}
+	)
+
+	relayPrice := func(relayer string, price sdk.Dec) {
+		_, err := msgServer.RelayPriceFeedPrice(sdk.WrapSDKContext(ctx), &types.MsgRelayPriceFeedPrice{
+			Sender: relayer,
+			Base:   []string{"INJ"},
+			Quote:  []string{"USDT"},
+			Price:  []sdk.Dec{price},
+		})
+		Expect(err).To(BeNil())
+	}
+
+	lastPrice := func() string {
+		return app.OracleKeeper.GetPriceFeedPriceState(ctx, "INJ", "USDT").Price.String()
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "3", Time: time.Unix(1618997040, 0)})
+		msgServer = keeper.NewMsgServerImpl(app.OracleKeeper)
+
+		for _, relayer := range relayers {
+			app.OracleKeeper.SetPriceFeedRelayer(ctx, "INJ", "USDT", sdk.MustAccAddressFromBech32(relayer))
+		}
+
+		params := app.OracleKeeper.GetParams(ctx)
+		params.SymbolPriceDeviations = []types.SymbolPriceDeviation{{
+			Symbol:            types.GetPriceDeviationSymbol(types.OracleType_PriceFeed, "INJ/USDT"),
+			MaxPriceDeviation: sdk.NewDecWithPrec(1, 1),
+		}}
+		app.OracleKeeper.SetParams(ctx, params)
+
+		relayPrice(relayers[0], sdk.NewDec(10))
+	})
+
+	It("accepts an update within the max price deviation", func() {
+		relayPrice(relayers[1], sdk.MustNewDecFromStr("10.5"))
+		Expect(lastPrice()).To(Equal(sdk.MustNewDecFromStr("10.5").String()))
+	})
+
+	It("rejects a large update of a single reporter", func() {
+		relayPrice(relayers[1], sdk.NewDec(20))
+		Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+
+		// the same reporter can't move the price on its own
+		relayPrice(relayers[1], sdk.NewDec(20))
+		Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+		Expect(app.OracleKeeper.GetPriceMoveVotes(ctx, types.OracleType_PriceFeed, "INJ/USDT")).To(HaveLen(1))
+	})
+
+	It("accepts a large move once a supermajority of the reporters agree", func() {
+		relayPrice(relayers[0], sdk.NewDec(20))
+		relayPrice(relayers[1], sdk.MustNewDecFromStr("20.5"))
+		// two of three reporters isn't a supermajority
+		Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+
+		relayPrice(relayers[2], sdk.MustNewDecFromStr("19.8"))
+		Expect(lastPrice()).To(Equal(sdk.MustNewDecFromStr("19.8").String()))
+		Expect(app.OracleKeeper.GetPriceMoveVotes(ctx, types.OracleType_PriceFeed, "INJ/USDT")).To(BeEmpty())
+	})
+
+	It("doesn't count the votes older than the vote window", func() {
+		relayPrice(relayers[0], sdk.NewDec(20))
+		relayPrice(relayers[1], sdk.NewDec(20))
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		relayPrice(relayers[2], sdk.NewDec(20))
+		Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+	})
+
+	Context("with a single reporter", func() {
+		BeforeEach(func() {
+			app.OracleKeeper.DeletePriceFeedRelayer(ctx, "INJ", "USDT", sdk.MustAccAddressFromBech32(relayers[1]))
+			app.OracleKeeper.DeletePriceFeedRelayer(ctx, "INJ", "USDT", sdk.MustAccAddressFromBech32(relayers[2]))
+		})
+
+		nextBlock := func() {
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Second))
+		}
+
+		It("rejects a large move until it's confirmed in a later block", func() {
+			relayPrice(relayers[0], sdk.NewDec(20))
+			Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+
+			// confirming in the same block doesn't count
+			relayPrice(relayers[0], sdk.NewDec(20))
+			Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+
+			nextBlock()
+			relayPrice(relayers[0], sdk.MustNewDecFromStr("20.5"))
+			Expect(lastPrice()).To(Equal(sdk.MustNewDecFromStr("20.5").String()))
+			Expect(app.OracleKeeper.GetPriceMoveVotes(ctx, types.OracleType_PriceFeed, "INJ/USDT")).To(BeEmpty())
+		})
+
+		It("doesn't confirm a large move with a vote for a different move", func() {
+			relayPrice(relayers[0], sdk.NewDec(20))
+
+			nextBlock()
+			relayPrice(relayers[0], sdk.NewDec(5))
+			Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+		})
+
+		It("doesn't confirm a large move with a vote older than the vote window", func() {
+			relayPrice(relayers[0], sdk.NewDec(20))
+
+			nextBlock()
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+			relayPrice(relayers[0], sdk.NewDec(20))
+			Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+		})
+	})
+
+	It("doesn't guard the prices of another oracle type with the same symbol", func() {
+		providerLastPrice := sdk.NewDec(10)
+		accepted := app.OracleKeeper.AcceptPriceSubmission(ctx, types.OracleType_Provider, "INJ/USDT", &providerLastPrice, sdk.NewDec(20), sdk.MustAccAddressFromBech32(relayers[0]), relayers)
+		Expect(accepted).To(BeTrue())
+	})
+
+	It("doesn't count the votes for a different move", func() {
+		relayPrice(relayers[0], sdk.NewDec(20))
+		relayPrice(relayers[1], sdk.NewDec(5))
+		relayPrice(relayers[2], sdk.NewDec(20))
+
+		Expect(lastPrice()).To(Equal(sdk.NewDec(10).String()))
+	})
+})
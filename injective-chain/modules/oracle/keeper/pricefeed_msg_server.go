@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +35,11 @@ func (k PricefeedMsgServer) RelayPriceFeedPrice(goCtx context.Context, msg *type
 
 	relayer, _ := sdk.AccAddressFromBech32(msg.Sender)
 
+	if err := k.UseRelayerNonce(ctx, relayer, msg.Nonce); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	for idx := range msg.Price {
 		base, quote, price := msg.Base[idx], msg.Quote[idx], msg.Price[idx]
 		if !k.IsPriceFeedRelayer(ctx, base, quote, relayer) {
@@ -43,6 +49,18 @@ func (k PricefeedMsgServer) RelayPriceFeedPrice(goCtx context.Context, msg *type
 
 		k.SetPriceFeedInfo(ctx, &types.PriceFeedInfo{Base: base, Quote: quote})
 		priceState := k.GetPriceFeedPriceState(ctx, base, quote)
+
+		var lastPrice *sdk.Dec
+		if priceState != nil {
+			lastPrice = &priceState.Price
+		}
+
+		symbol := fmt.Sprintf("%s/%s", base, quote)
+		reporters := k.GetAllPriceFeedRelayers(ctx, types.GetBaseQuoteHash(base, quote))
+		if !k.AcceptPriceSubmission(ctx, types.OracleType_PriceFeed, symbol, lastPrice, price, relayer, reporters) {
+			continue
+		}
+
 		blockTime := ctx.BlockTime().Unix()
 		if priceState == nil {
 			priceState = types.NewPriceState(price, blockTime)
@@ -61,5 +79,7 @@ func (k PricefeedMsgServer) RelayPriceFeedPrice(goCtx context.Context, msg *type
 		})
 	}
 
+	k.RecordValidatorReport(ctx, relayer)
+
 	return &types.MsgRelayPriceFeedPriceResponse{}, nil
 }
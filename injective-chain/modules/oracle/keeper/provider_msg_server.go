@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
 	"github.com/InjectiveLabs/metrics"
@@ -12,13 +13,19 @@ import (
 
 type ProviderMsgServer struct {
 	ProviderKeeper
+	RelayerNonceKeeper
+	ReporterStatsKeeper
+	PriceDeviationKeeper
 	svcTags metrics.Tags
 }
 
 // NewProviderMsgServerImpl returns an implementation of the provider MsgServer interface for the provided Keeper for provider oracle functions.
 func NewProviderMsgServerImpl(keeper Keeper) ProviderMsgServer {
 	return ProviderMsgServer{
-		ProviderKeeper: &keeper,
+		ProviderKeeper:       &keeper,
+		RelayerNonceKeeper:   &keeper,
+		ReporterStatsKeeper:  &keeper,
+		PriceDeviationKeeper: &keeper,
 		svcTags: metrics.Tags{
 			"svc": "provider_msg_h",
 		},
@@ -36,12 +43,31 @@ func (k ProviderMsgServer) RelayProviderPrices(goCtx context.Context, msg *types
 		return nil, errors.Wrapf(types.ErrRelayerNotAuthorized, "relayer %s not an authorized provider for %s", relayer.String(), msg.Provider)
 	}
 
+	if err := k.UseRelayerNonce(ctx, relayer, msg.Nonce); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	var reporters []string
+	if info := k.GetProviderInfo(ctx, msg.Provider); info != nil {
+		reporters = info.Relayers
+	}
+
 	for idx := range msg.Prices {
 		price := msg.Prices[idx]
 		symbol := msg.Symbols[idx]
 
 		providerPriceState := k.GetProviderPriceState(ctx, msg.Provider, symbol)
 
+		var lastPrice *sdk.Dec
+		if providerPriceState != nil && providerPriceState.State != nil {
+			lastPrice = &providerPriceState.State.Price
+		}
+
+		if !k.AcceptPriceSubmission(ctx, types.OracleType_Provider, fmt.Sprintf("%s/%s", msg.Provider, symbol), lastPrice, price, relayer, reporters) {
+			continue
+		}
+
 		blockTime := ctx.BlockTime().Unix()
 		if providerPriceState == nil || providerPriceState.State == nil {
 			providerPriceState = types.NewProviderPriceState(symbol, price, blockTime)
@@ -60,5 +86,7 @@ func (k ProviderMsgServer) RelayProviderPrices(goCtx context.Context, msg *types
 		})
 	}
 
+	k.RecordValidatorReport(ctx, relayer)
+
 	return &types.MsgRelayProviderPricesResponse{}, nil
 }
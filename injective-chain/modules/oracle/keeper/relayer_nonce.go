@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type RelayerNonceKeeper interface {
+	GetRelayerNonce(ctx sdk.Context, relayer sdk.AccAddress) uint64
+	SetRelayerNonce(ctx sdk.Context, relayer sdk.AccAddress, nonce uint64)
+	UseRelayerNonce(ctx sdk.Context, relayer sdk.AccAddress, nonce uint64) error
+	GetAllRelayerNonces(ctx sdk.Context) []*types.RelayerNonce
+}
+
+// GetRelayerNonce returns the last nonce accepted from the relayer, or zero if none was accepted yet.
+func (k *Keeper) GetRelayerNonce(ctx sdk.Context, relayer sdk.AccAddress) uint64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetRelayerNonceKey(relayer))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetRelayerNonce sets the last nonce accepted from the relayer.
+func (k *Keeper) SetRelayerNonce(ctx sdk.Context, relayer sdk.AccAddress, nonce uint64) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.getStore(ctx).Set(types.GetRelayerNonceKey(relayer), sdk.Uint64ToBigEndian(nonce))
+}
+
+// UseRelayerNonce checks that the nonce of a price update is greater than the last nonce accepted from the relayer
+// and records it, so that stale or replayed updates are rejected. A zero nonce skips the check only for relayers which
+// never had a nonce accepted, once a relayer uses nonces every update must carry one.
+func (k *Keeper) UseRelayerNonce(ctx sdk.Context, relayer sdk.AccAddress, nonce uint64) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	lastNonce := k.GetRelayerNonce(ctx, relayer)
+	if nonce == 0 && lastNonce == 0 {
+		return nil
+	}
+
+	if nonce <= lastNonce {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInvalidRelayerNonce.Wrapf("nonce %d of relayer %s must be greater than %d", nonce, relayer.String(), lastNonce)
+	}
+
+	k.SetRelayerNonce(ctx, relayer, nonce)
+	return nil
+}
+
+// GetAllRelayerNonces returns the last nonce accepted from each relayer.
+func (k *Keeper) GetAllRelayerNonces(ctx sdk.Context) []*types.RelayerNonce {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	nonces := make([]*types.RelayerNonce, 0)
+	nonceStore := prefix.NewStore(k.getStore(ctx), types.RelayerNonceKey)
+
+	iterator := nonceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		nonces = append(nonces, &types.RelayerNonce{
+			Relayer: sdk.AccAddress(iterator.Key()).String(),
+			Nonce:   sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return nonces
+}
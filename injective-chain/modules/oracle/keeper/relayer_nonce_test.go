@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Relayer nonce", func() {
+	var (
+		app        *simapp.InjectiveApp
+		ctx        sdk.Context
+		msgServer  types.MsgServer
+		relayer, _ = sdk.AccAddressFromBech32("inj1rgmw7dlgwqpwwf3j8zy4qvg9zkvtgeuy568fff")
+	)
+
+	relayPrice := func(price int64, nonce uint64) error {
+		_, err := msgServer.RelayPriceFeedPrice(sdk.WrapSDKContext(ctx), &types.MsgRelayPriceFeedPrice{
+			Sender: relayer.String(),
+			Base:   []string{"INJ"},
+			Quote:  []string{"USDT"},
+			Price:  []sdk.Dec{sdk.NewDec(price)},
+			Nonce:  nonce,
+		})
+		return err
+	}
+
+	queryNonce := func() uint64 {
+		res, err := app.OracleKeeper.RelayerNonce(sdk.WrapSDKContext(ctx), &types.QueryRelayerNonceRequest{Relayer: relayer.String()})
+		Expect(err).To(BeNil())
+		return res.Nonce
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "3", Time: time.Unix(1618997040, 0)})
+		msgServer = keeper.NewMsgServerImpl(app.OracleKeeper)
+
+		app.OracleKeeper.SetPriceFeedRelayer(ctx, "INJ", "USDT", relayer)
+	})
+
+	It("accepts in-order updates", func() {
+		Expect(relayPrice(10, 1)).To(BeNil())
+		Expect(relayPrice(11, 2)).To(BeNil())
+		Expect(relayPrice(12, 5)).To(BeNil())
+
+		Expect(app.OracleKeeper.GetPriceFeedPrice(ctx, "INJ", "USDT").String()).To(Equal(sdk.NewDec(12).String()))
+	})
+
+	It("rejects replayed and stale nonces", func() {
+		Expect(relayPrice(10, 2)).To(BeNil())
+
+		Expect(relayPrice(11, 2)).To(MatchError(types.ErrInvalidRelayerNonce))
+		Expect(relayPrice(11, 1)).To(MatchError(types.ErrInvalidRelayerNonce))
+
+		Expect(app.OracleKeeper.GetPriceFeedPrice(ctx, "INJ", "USDT").String()).To(Equal(sdk.NewDec(10).String()))
+	})
+
+	It("skips the replay guard for relayers without nonces", func() {
+		Expect(relayPrice(10, 0)).To(BeNil())
+		Expect(relayPrice(11, 0)).To(BeNil())
+
+		Expect(queryNonce()).To(Equal(uint64(0)))
+		Expect(app.OracleKeeper.GetPriceFeedPrice(ctx, "INJ", "USDT").String()).To(Equal(sdk.NewDec(11).String()))
+	})
+
+	It("rejects updates without nonce once a nonce was accepted", func() {
+		Expect(relayPrice(10, 3)).To(BeNil())
+
+		// replaying the update, or sending a stale one, without its nonce
+		Expect(relayPrice(10, 0)).To(MatchError(types.ErrInvalidRelayerNonce))
+		Expect(relayPrice(9, 0)).To(MatchError(types.ErrInvalidRelayerNonce))
+
+		Expect(queryNonce()).To(Equal(uint64(3)))
+		Expect(app.OracleKeeper.GetPriceFeedPrice(ctx, "INJ", "USDT").String()).To(Equal(sdk.NewDec(10).String()))
+	})
+
+	It("returns the last accepted nonce", func() {
+		Expect(queryNonce()).To(Equal(uint64(0)))
+
+		Expect(relayPrice(10, 7)).To(BeNil())
+		Expect(queryNonce()).To(Equal(uint64(7)))
+
+		exported := app.OracleKeeper.ExportGenesis(ctx)
+		Expect(exported.RelayerNonces).To(Equal([]*types.RelayerNonce{{Relayer: relayer.String(), Nonce: 7}}))
+	})
+})
@@ -0,0 +1,342 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type ReporterStatsKeeper interface {
+	RecordValidatorReport(ctx sdk.Context, relayer sdk.AccAddress)
+	TrackMissedValidatorReports(ctx sdk.Context)
+	PenalizeMissedValidatorReports(ctx sdk.Context)
+	GetReporterRecord(ctx sdk.Context, validator sdk.ValAddress) *types.ReporterRecord
+	SetReporterRecord(ctx sdk.Context, record *types.ReporterRecord)
+	GetAllReporterRecords(ctx sdk.Context) []*types.ReporterRecord
+	GetOracleReporterStats(ctx sdk.Context, pageReq *query.PageRequest) ([]types.OracleReporterStats, *query.PageResponse, error)
+}
+
+// SetStakingKeeper sets the staking keeper used to track the oracle reports of the validators.
+func (k *Keeper) SetStakingKeeper(stakingKeeper types.StakingKeeper) {
+	if k.stakingKeeper != nil {
+		panic("cannot set staking keeper twice")
+	}
+
+	k.stakingKeeper = stakingKeeper
+}
+
+// SetSlashingKeeper sets the slashing keeper used to penalize the validators missing oracle reports.
+func (k *Keeper) SetSlashingKeeper(slashingKeeper types.SlashingKeeper) {
+	if k.slashingKeeper != nil {
+		panic("cannot set slashing keeper twice")
+	}
+
+	k.slashingKeeper = slashingKeeper
+}
+
+// isReporterStatsEnabled returns true if the oracle reports of the validators are tracked.
+func (k *Keeper) isReporterStatsEnabled(params types.Params) bool {
+	return k.stakingKeeper != nil && params.ReporterStatsWindow > 0 && params.ReporterInterval > 0
+}
+
+// GetReporterRecord returns the oracle report outcomes of the validator, or nil if its reports aren't tracked yet.
+func (k *Keeper) GetReporterRecord(ctx sdk.Context, validator sdk.ValAddress) *types.ReporterRecord {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetReporterRecordKey(validator))
+	if bz == nil {
+		return nil
+	}
+
+	var record types.ReporterRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+// SetReporterRecord sets the oracle report outcomes of a validator.
+func (k *Keeper) SetReporterRecord(ctx sdk.Context, record *types.ReporterRecord) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	validator, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	k.getStore(ctx).Set(types.GetReporterRecordKey(validator), k.cdc.MustMarshal(record))
+}
+
+func (k *Keeper) deleteReporterRecord(ctx sdk.Context, validator sdk.ValAddress) {
+	k.getStore(ctx).Delete(types.GetReporterRecordKey(validator))
+}
+
+// GetAllReporterRecords returns the oracle report outcomes of every tracked validator.
+func (k *Keeper) GetAllReporterRecords(ctx sdk.Context) []*types.ReporterRecord {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	records := make([]*types.ReporterRecord, 0)
+	recordStore := prefix.NewStore(k.getStore(ctx), types.ReporterRecordPrefix)
+
+	iterator := recordStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ReporterRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, &record)
+	}
+
+	return records
+}
+
+// RecordValidatorReport records an oracle report of the relayer, if the relayer is the operator of a bonded validator.
+// The report is on time if it comes within the reporter interval from the previous report of the validator, and late
+// otherwise. Several reports of a validator within a block count once.
+func (k *Keeper) RecordValidatorReport(ctx sdk.Context, relayer sdk.AccAddress) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	if !k.isReporterStatsEnabled(params) {
+		return
+	}
+
+	valAddr := sdk.ValAddress(relayer)
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil || !validator.IsBonded() {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	record := k.GetReporterRecord(ctx, valAddr)
+	if record == nil {
+		record = &types.ReporterRecord{
+			Validator:        valAddr.String(),
+			LastReportHeight: height,
+		}
+	}
+
+	if n := len(record.Outcomes); n > 0 && record.Outcomes[n-1].Height == height {
+		return
+	}
+
+	outcome := types.ReportOutcome_ReportOnTime
+	if height-record.LastReportHeight > params.ReporterInterval {
+		outcome = types.ReportOutcome_ReportLate
+	}
+
+	record.Outcomes = append(record.Outcomes, types.ReporterOutcome{Height: height, Outcome: outcome})
+	record.LastReportHeight = height
+	pruneReporterOutcomes(record, height, params.ReporterStatsWindow)
+
+	k.SetReporterRecord(ctx, record)
+}
+
+// TrackMissedValidatorReports records a missed oracle report for every bonded validator registered as a reporter which
+// didn't report within twice the reporter interval from its previous report, and starts tracking the newly registered
+// or bonded ones. A validator is a registered reporter if its operator account is a price feed, Band or provider
+// relayer, the accounts whose reports are recorded. It's run in the EndBlocker, after the reports of the block.
+func (k *Keeper) TrackMissedValidatorReports(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	if !k.isReporterStatsEnabled(params) {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	reporters := k.getRegisteredReporters(ctx)
+
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valAddr := validator.GetOperator()
+		record := k.GetReporterRecord(ctx, valAddr)
+
+		if _, ok := reporters[sdk.AccAddress(valAddr).String()]; !ok {
+			// the reports of a deregistered reporter are tracked afresh if it's registered again
+			if record != nil {
+				k.deleteReporterRecord(ctx, valAddr)
+			}
+			return false
+		}
+
+		if record == nil {
+			k.SetReporterRecord(ctx, &types.ReporterRecord{
+				Validator:        valAddr.String(),
+				LastReportHeight: height,
+			})
+			return false
+		}
+
+		if height-record.LastReportHeight < 2*params.ReporterInterval {
+			return false
+		}
+
+		record.Outcomes = append(record.Outcomes, types.ReporterOutcome{Height: height, Outcome: types.ReportOutcome_ReportMissed})
+		record.LastReportHeight = height
+		pruneReporterOutcomes(record, height, params.ReporterStatsWindow)
+
+		k.SetReporterRecord(ctx, record)
+		return false
+	})
+}
+
+// getRegisteredReporters returns the set of the price feed, Band and provider relayers, by bech32 address.
+func (k *Keeper) getRegisteredReporters(ctx sdk.Context) map[string]struct{} {
+	reporters := make(map[string]struct{})
+
+	// the relayer of each price feed pair is the value of its key
+	pricefeedRelayerIterator := prefix.NewStore(k.getStore(ctx), types.PricefeedRelayerKey).Iterator(nil, nil)
+	for ; pricefeedRelayerIterator.Valid(); pricefeedRelayerIterator.Next() {
+		reporters[sdk.AccAddress(pricefeedRelayerIterator.Value()).String()] = struct{}{}
+	}
+	pricefeedRelayerIterator.Close()
+
+	for _, relayer := range k.GetAllBandRelayers(ctx) {
+		reporters[relayer] = struct{}{}
+	}
+
+	providerIndexIterator := prefix.NewStore(k.getStore(ctx), types.ProviderIndexPrefix).Iterator(nil, nil)
+	for ; providerIndexIterator.Valid(); providerIndexIterator.Next() {
+		reporters[sdk.AccAddress(providerIndexIterator.Key()).String()] = struct{}{}
+	}
+	providerIndexIterator.Close()
+
+	return reporters
+}
+
+// PenalizeMissedValidatorReports jails the bonded validators with more missed oracle reports than the max missed
+// reports within the reporter stats window, and slashes them for an oracle misbehavior by the governance set oracle
+// misbehavior slash fraction. As for downtime, a jailed validator can't unjail before the downtime jail duration of the
+// slashing module, so a validator without signing info is not penalized and an error is logged. It's run in the
+// EndBlocker, after the missed reports of the block are tracked.
+func (k *Keeper) PenalizeMissedValidatorReports(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	params := k.GetParams(ctx)
+	if !k.isReporterStatsEnabled(params) || params.MaxMissedReports == 0 || k.slashingKeeper == nil || k.misbehaviorSlasher == nil {
+		return
+	}
+
+	type penalty struct {
+		validator stakingtypes.ValidatorI
+		missed    uint64
+	}
+
+	height := ctx.BlockHeight()
+	penalties := make([]penalty, 0)
+
+	// the validators are penalized once the iteration is over, since jailing them updates the power index
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if validator.IsJailed() {
+			return false
+		}
+
+		record := k.GetReporterRecord(ctx, validator.GetOperator())
+		if record == nil {
+			return false
+		}
+
+		if missed := getReporterStats(record, height, params.ReporterStatsWindow).Missed; missed > params.MaxMissedReports {
+			penalties = append(penalties, penalty{validator: validator, missed: missed})
+		}
+
+		return false
+	})
+
+	for _, p := range penalties {
+		consAddr, err := p.validator.GetConsAddr()
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			ctx.Logger().Error("failed to get the consensus address of the validator", "validator", p.validator.GetOperator().String(), "error", err)
+			continue
+		}
+
+		if _, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr); !found {
+			metrics.ReportFuncError(k.svcTags)
+			ctx.Logger().Error("cannot penalize the missed oracle reports of a validator without signing info", "validator", p.validator.GetOperator().String())
+			continue
+		}
+
+		// the infraction is attributed to the last block whose validator set the validator signed, as for downtime
+		infractionHeight := height - sdk.ValidatorUpdateDelay - 1
+		slashFraction, err := k.HandleOracleMisbehavior(ctx, p.validator.GetOperator(), infractionHeight)
+		if err != nil {
+			ctx.Logger().Error("failed to slash the missed oracle reports of the validator", "validator", p.validator.GetOperator().String(), "error", err)
+			continue
+		}
+
+		k.slashingKeeper.Jail(ctx, consAddr)
+		k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockHeader().Time.Add(k.slashingKeeper.DowntimeJailDuration(ctx)))
+
+		// the reports are tracked afresh once the validator is bonded again
+		k.deleteReporterRecord(ctx, p.validator.GetOperator())
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventMissedReportsPenalized{
+			Validator:     p.validator.GetOperator().String(),
+			MissedReports: p.missed,
+			SlashFraction: slashFraction,
+		})
+	}
+}
+
+// pruneReporterOutcomes removes the outcomes which are out of the window ending at the height.
+func pruneReporterOutcomes(record *types.ReporterRecord, height, window int64) {
+	first := 0
+	for first < len(record.Outcomes) && record.Outcomes[first].Height <= height-window {
+		first++
+	}
+
+	record.Outcomes = record.Outcomes[first:]
+}
+
+// getReporterStats counts the oracle report outcomes of the record within the window ending at the height.
+func getReporterStats(record *types.ReporterRecord, height, window int64) types.OracleReporterStats {
+	stats := types.OracleReporterStats{Validator: record.Validator}
+
+	for _, outcome := range record.Outcomes {
+		if outcome.Height <= height-window {
+			continue
+		}
+
+		switch outcome.Outcome {
+		case types.ReportOutcome_ReportOnTime:
+			stats.OnTime++
+		case types.ReportOutcome_ReportLate:
+			stats.Late++
+		case types.ReportOutcome_ReportMissed:
+			stats.Missed++
+		}
+	}
+
+	return stats
+}
+
+// GetOracleReporterStats returns a page of the oracle report counts of the tracked validators within the reporter
+// stats window, by validator address.
+func (k *Keeper) GetOracleReporterStats(ctx sdk.Context, pageReq *query.PageRequest) ([]types.OracleReporterStats, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	height := ctx.BlockHeight()
+	window := k.GetParams(ctx).ReporterStatsWindow
+	recordStore := prefix.NewStore(k.getStore(ctx), types.ReporterRecordPrefix)
+
+	stats := make([]types.OracleReporterStats, 0)
+	pageRes, err := query.Paginate(recordStore, pageReq, func(_, value []byte) error {
+		var record types.ReporterRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		stats = append(stats, getReporterStats(&record, height, window))
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
+	return stats, pageRes, nil
+}
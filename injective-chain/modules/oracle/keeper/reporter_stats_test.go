@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+var _ = Describe("Oracle reporter stats", func() {
+	var (
+		app       *simapp.InjectiveApp
+		ctx       sdk.Context
+		msgServer types.MsgServer
+		validator sdk.ValAddress
+	)
+
+	relayPrice := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		_, err := msgServer.RelayPriceFeedPrice(sdk.WrapSDKContext(ctx), &types.MsgRelayPriceFeedPrice{
+			Sender: sdk.AccAddress(validator).String(),
+			Base:   []string{"INJ"},
+			Quote:  []string{"USDT"},
+			Price:  []sdk.Dec{sdk.NewDec(10)},
+		})
+		Expect(err).To(BeNil())
+	}
+
+	// runs the EndBlocker of every block from the current height to the height
+	endBlocksUntil := func(height int64) {
+		for h := ctx.BlockHeight(); h <= height; h++ {
+			ctx = ctx.WithBlockHeight(h)
+			oracle.NewBlockHandler(app.OracleKeeper).EndBlocker(ctx)
+		}
+	}
+
+	queryStats := func() []types.OracleReporterStats {
+		res, err := app.OracleKeeper.OracleReporterStats(sdk.WrapSDKContext(ctx), &types.QueryOracleReporterStatsRequest{
+			Pagination: &query.PageRequest{Limit: 10},
+		})
+		Expect(err).To(BeNil())
+		return res.Stats
+	}
+
+	BeforeEach(func() {
+		app = simapp.Setup(false)
+		ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10, ChainID: "3", Time: time.Unix(1618997040, 0)})
+		msgServer = keeper.NewMsgServerImpl(app.OracleKeeper)
+
+		validator = app.StakingKeeper.GetBondedValidatorsByPower(ctx)[0].GetOperator()
+		app.OracleKeeper.SetPriceFeedRelayer(ctx, "INJ", "USDT", sdk.AccAddress(validator))
+
+		params := app.OracleKeeper.GetParams(ctx)
+		params.ReporterStatsWindow = 100
+		params.ReporterInterval = 5
+		app.OracleKeeper.SetParams(ctx, params)
+	})
+
+	It("counts the on time, late and missed reports", func() {
+		// starts tracking the validator at 10
+		endBlocksUntil(10)
+
+		relayPrice(12)
+		// only the first report of a block counts
+		relayPrice(12)
+		endBlocksUntil(18)
+
+		// 7 blocks after the previous report
+		relayPrice(19)
+		// missed once 10 blocks passed
+		endBlocksUntil(29)
+		relayPrice(30)
+
+		Expect(queryStats()).To(Equal([]types.OracleReporterStats{{
+			Validator: validator.String(),
+			OnTime:    2,
+			Late:      1,
+			Missed:    1,
+		}}))
+
+		record := app.OracleKeeper.GetReporterRecord(ctx, validator)
+		Expect(record.LastReportHeight).To(Equal(int64(30)))
+		Expect(record.Outcomes).To(Equal([]types.ReporterOutcome{
+			{Height: 12, Outcome: types.ReportOutcome_ReportOnTime},
+			{Height: 19, Outcome: types.ReportOutcome_ReportLate},
+			{Height: 29, Outcome: types.ReportOutcome_ReportMissed},
+			{Height: 30, Outcome: types.ReportOutcome_ReportOnTime},
+		}))
+	})
+
+	It("only counts the reports within the window", func() {
+		endBlocksUntil(10)
+		relayPrice(12)
+		relayPrice(19)
+
+		// the report at 12 is out of the window ending at 115
+		ctx = ctx.WithBlockHeight(115)
+		Expect(queryStats()).To(Equal([]types.OracleReporterStats{{
+			Validator: validator.String(),
+			Late:      1,
+		}}))
+	})
+
+	It("doesn't track the reports when disabled", func() {
+		app.OracleKeeper.SetParams(ctx, types.DefaultParams())
+
+		endBlocksUntil(10)
+		relayPrice(12)
+
+		Expect(queryStats()).To(BeEmpty())
+		Expect(app.OracleKeeper.GetReporterRecord(ctx, validator)).To(BeNil())
+	})
+
+	It("doesn't track the reports of relayers which aren't validators", func() {
+		relayer, _ := sdk.AccAddressFromBech32("inj1rgmw7dlgwqpwwf3j8zy4qvg9zkvtgeuy568fff")
+		app.OracleKeeper.SetPriceFeedRelayer(ctx, "INJ", "USDT", relayer)
+
+		_, err := msgServer.RelayPriceFeedPrice(sdk.WrapSDKContext(ctx), &types.MsgRelayPriceFeedPrice{
+			Sender: relayer.String(),
+			Base:   []string{"INJ"},
+			Quote:  []string{"USDT"},
+			Price:  []sdk.Dec{sdk.NewDec(10)},
+		})
+		Expect(err).To(BeNil())
+
+		Expect(app.OracleKeeper.GetReporterRecord(ctx, sdk.ValAddress(relayer))).To(BeNil())
+	})
+
+	It("doesn't track the validators which aren't registered reporters", func() {
+		endBlocksUntil(10)
+		Expect(app.OracleKeeper.GetReporterRecord(ctx, validator)).ToNot(BeNil())
+
+		// the record of a deregistered reporter is dropped
+		app.OracleKeeper.DeletePriceFeedRelayer(ctx, "INJ", "USDT", sdk.AccAddress(validator))
+		endBlocksUntil(30)
+
+		Expect(app.OracleKeeper.GetReporterRecord(ctx, validator)).To(BeNil())
+		Expect(queryStats()).To(BeEmpty())
+	})
+
+	It("doesn't penalize a validator without signing info", func() {
+		params := app.OracleKeeper.GetParams(ctx)
+		params.MaxMissedReports = 1
+		app.OracleKeeper.SetParams(ctx, params)
+
+		endBlocksUntil(30)
+
+		Expect(app.StakingKeeper.Validator(ctx, validator).IsJailed()).To(BeFalse())
+		Expect(app.OracleKeeper.GetReporterRecord(ctx, validator).Outcomes).To(HaveLen(2))
+	})
+
+	Context("when the max missed reports are set", func() {
+		BeforeEach(func() {
+			params := app.OracleKeeper.GetParams(ctx)
+			params.MaxMissedReports = 1
+			app.OracleKeeper.SetParams(ctx, params)
+
+			// the genesis validators of the test app are not bonded through the staking hooks, so they have no signing info
+			consAddr, err := app.StakingKeeper.Validator(ctx, validator).GetConsAddr()
+			Expect(err).To(BeNil())
+			app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+		})
+
+		jailedUntil := func() time.Time {
+			consAddr, err := app.StakingKeeper.Validator(ctx, validator).GetConsAddr()
+			Expect(err).To(BeNil())
+
+			info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			Expect(found).To(BeTrue())
+			return info.JailedUntil
+		}
+
+		It("jails the validator missing reports past the threshold", func() {
+			tokens := app.StakingKeeper.Validator(ctx, validator).GetTokens()
+
+			// missed at 20, within the threshold
+			endBlocksUntil(20)
+			Expect(app.StakingKeeper.Validator(ctx, validator).IsJailed()).To(BeFalse())
+
+			// missed again at 30, past the threshold
+			endBlocksUntil(30)
+			Expect(app.StakingKeeper.Validator(ctx, validator).IsJailed()).To(BeTrue())
+			Expect(app.StakingKeeper.Validator(ctx, validator).GetTokens()).To(Equal(tokens))
+			Expect(jailedUntil()).To(Equal(ctx.BlockTime().Add(app.SlashingKeeper.DowntimeJailDuration(ctx))))
+			Expect(app.OracleKeeper.GetReporterRecord(ctx, validator)).To(BeNil())
+		})
+
+		It("slashes the jailed validator by the oracle misbehavior slash fraction", func() {
+			app.GetSubspace(simapp.SlashingFractionsParamsSubspace).Set(ctx, simapp.KeyOracleMisbehaviorSlashFraction, sdk.NewDecWithPrec(1, 1))
+
+			tokens := app.StakingKeeper.Validator(ctx, validator).GetTokens()
+
+			endBlocksUntil(30)
+			Expect(app.StakingKeeper.Validator(ctx, validator).IsJailed()).To(BeTrue())
+			Expect(app.StakingKeeper.Validator(ctx, validator).GetTokens().LT(tokens)).To(BeTrue())
+
+			// the missed reports are slashed once as an oracle misbehavior at the infraction height
+			infractionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+			Expect(app.OracleKeeper.IsOracleMisbehaviorSlashed(ctx, validator, infractionHeight)).To(BeTrue())
+		})
+
+		It("doesn't jail the validator reporting in time", func() {
+			endBlocksUntil(10)
+			for h := int64(15); h <= 40; h += 5 {
+				relayPrice(h)
+				endBlocksUntil(h)
+			}
+
+			Expect(app.StakingKeeper.Validator(ctx, validator).IsJailed()).To(BeFalse())
+		})
+	})
+})
@@ -26,6 +26,7 @@ func (k *Keeper) AppendPriceRecord(ctx sdk.Context, oracleType types.OracleType,
 
 	k.setHistoricalPriceRecords(ctx, oracleType, symbol, existingOrEmptyRecord)
 	k.updateLastPriceTimestampMap(ctx, oracleType, symbol, priceRecord.Timestamp)
+	k.setPriceUpdateHeight(ctx, oracleType, symbol, ctx.BlockHeight())
 }
 
 func (k *Keeper) updateLastPriceTimestampMap(ctx sdk.Context, oracleType types.OracleType, symbol string, timestamp int64) {
@@ -44,4 +44,10 @@ var (
 	ErrInvalidPythExponent         = errors.Register(ModuleName, 37, "unauthorized Pyth price relay")
 	ErrInvalidPythPublishTime      = errors.Register(ModuleName, 38, "unauthorized Pyth price relay")
 	ErrEmptyPriceAttestations      = errors.Register(ModuleName, 39, "empty price attestations")
+	ErrInvalidRelayerNonce         = errors.Register(ModuleName, 40, "invalid relayer nonce")
+	ErrOracleMisbehaviorSlashed    = errors.Register(ModuleName, 41, "oracle misbehavior already slashed")
+	ErrInvalidOracleMisbehavior    = errors.Register(ModuleName, 42, "invalid oracle misbehavior")
+	ErrPriceAggregationNotFound    = errors.Register(ModuleName, 43, "price aggregation not found")
+	ErrInsufficientProviderPrices  = errors.Register(ModuleName, 44, "insufficient provider prices")
+	ErrOracleAdapterExists         = errors.Register(ModuleName, 45, "oracle adapter already registered")
 )
@@ -593,6 +593,166 @@ func (m *EventSetPythPrices) GetPrices() []*PythPriceState {
 	return nil
 }
 
+type EventOracleMisbehaviorSlashed struct {
+	Validator        string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	InfractionHeight int64                                  `protobuf:"varint,2,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	SlashFraction    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+}
+
+func (m *EventOracleMisbehaviorSlashed) Reset()         { *m = EventOracleMisbehaviorSlashed{} }
+func (m *EventOracleMisbehaviorSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventOracleMisbehaviorSlashed) ProtoMessage()    {}
+func (*EventOracleMisbehaviorSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c42b07097291dfa0, []int{10}
+}
+func (m *EventOracleMisbehaviorSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOracleMisbehaviorSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOracleMisbehaviorSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOracleMisbehaviorSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOracleMisbehaviorSlashed.Merge(m, src)
+}
+func (m *EventOracleMisbehaviorSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOracleMisbehaviorSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOracleMisbehaviorSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOracleMisbehaviorSlashed proto.InternalMessageInfo
+
+func (m *EventOracleMisbehaviorSlashed) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventOracleMisbehaviorSlashed) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+type EventMissedReportsPenalized struct {
+	Validator     string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	MissedReports uint64                                 `protobuf:"varint,2,opt,name=missed_reports,json=missedReports,proto3" json:"missed_reports,omitempty"`
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+}
+
+func (m *EventMissedReportsPenalized) Reset()         { *m = EventMissedReportsPenalized{} }
+func (m *EventMissedReportsPenalized) String() string { return proto.CompactTextString(m) }
+func (*EventMissedReportsPenalized) ProtoMessage()    {}
+func (*EventMissedReportsPenalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c42b07097291dfa0, []int{11}
+}
+func (m *EventMissedReportsPenalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMissedReportsPenalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMissedReportsPenalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMissedReportsPenalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMissedReportsPenalized.Merge(m, src)
+}
+func (m *EventMissedReportsPenalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMissedReportsPenalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMissedReportsPenalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMissedReportsPenalized proto.InternalMessageInfo
+
+func (m *EventMissedReportsPenalized) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventMissedReportsPenalized) GetMissedReports() uint64 {
+	if m != nil {
+		return m.MissedReports
+	}
+	return 0
+}
+
+type EventPriceDeviationRejected struct {
+	Symbol    string                                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Reporter  string                                 `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	LastPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *EventPriceDeviationRejected) Reset()         { *m = EventPriceDeviationRejected{} }
+func (m *EventPriceDeviationRejected) String() string { return proto.CompactTextString(m) }
+func (*EventPriceDeviationRejected) ProtoMessage()    {}
+func (*EventPriceDeviationRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c42b07097291dfa0, []int{12}
+}
+func (m *EventPriceDeviationRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceDeviationRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceDeviationRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceDeviationRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceDeviationRejected.Merge(m, src)
+}
+func (m *EventPriceDeviationRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceDeviationRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceDeviationRejected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceDeviationRejected proto.InternalMessageInfo
+
+func (m *EventPriceDeviationRejected) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *EventPriceDeviationRejected) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SetChainlinkPriceEvent)(nil), "injective.oracle.v1beta1.SetChainlinkPriceEvent")
 	proto.RegisterType((*SetBandPriceEvent)(nil), "injective.oracle.v1beta1.SetBandPriceEvent")
@@ -604,6 +764,9 @@ func init() {
 	proto.RegisterType((*SetProviderPriceEvent)(nil), "injective.oracle.v1beta1.SetProviderPriceEvent")
 	proto.RegisterType((*SetCoinbasePriceEvent)(nil), "injective.oracle.v1beta1.SetCoinbasePriceEvent")
 	proto.RegisterType((*EventSetPythPrices)(nil), "injective.oracle.v1beta1.EventSetPythPrices")
+	proto.RegisterType((*EventOracleMisbehaviorSlashed)(nil), "injective.oracle.v1beta1.EventOracleMisbehaviorSlashed")
+	proto.RegisterType((*EventMissedReportsPenalized)(nil), "injective.oracle.v1beta1.EventMissedReportsPenalized")
+	proto.RegisterType((*EventPriceDeviationRejected)(nil), "injective.oracle.v1beta1.EventPriceDeviationRejected")
 }
 
 func init() {
@@ -611,49 +774,59 @@ func init() {
 }
 
 var fileDescriptor_c42b07097291dfa0 = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbd, 0x6e, 0xdb, 0x48,
-	0x10, 0xd6, 0x4a, 0xb2, 0x6c, 0xad, 0xaf, 0x39, 0x42, 0xe7, 0x23, 0xec, 0x3b, 0x5a, 0x11, 0x90,
-	0x40, 0x8d, 0x49, 0x38, 0xe9, 0x52, 0x25, 0xf2, 0x0f, 0x20, 0xc0, 0x85, 0x41, 0x19, 0x29, 0xd2,
-	0x18, 0xd4, 0x72, 0x6c, 0x6f, 0x44, 0x72, 0xe5, 0xdd, 0xa5, 0x02, 0xbd, 0x45, 0x80, 0x14, 0xe9,
-	0xd3, 0xe6, 0x45, 0xdc, 0x04, 0x70, 0x19, 0xa4, 0x30, 0x02, 0xfb, 0x09, 0xf2, 0x06, 0xc1, 0xfe,
-	0x48, 0x62, 0x04, 0x2b, 0xb0, 0xa1, 0x8a, 0x9c, 0xd9, 0x99, 0x6f, 0xbe, 0x6f, 0x77, 0x66, 0xf0,
-	0x53, 0x9a, 0xbd, 0x03, 0x22, 0xe9, 0x08, 0x02, 0xc6, 0x23, 0x92, 0x40, 0x30, 0xda, 0xed, 0x83,
-	0x8c, 0x76, 0x03, 0x18, 0x41, 0x26, 0x85, 0x3f, 0xe4, 0x4c, 0x32, 0xc7, 0x9d, 0x86, 0xf9, 0x26,
-	0xcc, 0xb7, 0x61, 0x9b, 0x8d, 0x73, 0x76, 0xce, 0x74, 0x50, 0xa0, 0xfe, 0x4c, 0xfc, 0xa6, 0x47,
-	0x98, 0x48, 0x99, 0x08, 0xfa, 0x91, 0x98, 0x21, 0x12, 0x46, 0x33, 0x7b, 0xbe, 0xb8, 0xac, 0x85,
-	0xd7, 0x61, 0xad, 0x4f, 0x08, 0x6f, 0xf4, 0x40, 0xee, 0x5d, 0x44, 0x34, 0x4b, 0x68, 0x36, 0x38,
-	0xe6, 0x94, 0xc0, 0x81, 0x22, 0xe6, 0xfc, 0x8b, 0x57, 0xcf, 0x00, 0xe2, 0x53, 0x1a, 0xbb, 0xa8,
-	0x89, 0xda, 0xf5, 0xb0, 0xa6, 0xcc, 0x6e, 0xec, 0x1c, 0xe2, 0x5a, 0x94, 0x89, 0xf7, 0xc0, 0xdd,
-	0xb2, 0xf2, 0x77, 0xfc, 0xab, 0x9b, 0xed, 0xd2, 0xf7, 0x9b, 0xed, 0x67, 0xe7, 0x54, 0x5e, 0xe4,
-	0x7d, 0x9f, 0xb0, 0x34, 0xb0, 0xec, 0xcc, 0x67, 0x47, 0xc4, 0x83, 0x40, 0x8e, 0x87, 0x20, 0xfc,
-	0x7d, 0x20, 0xa1, 0xcd, 0x76, 0xfe, 0xc3, 0x75, 0x49, 0x53, 0x10, 0x32, 0x4a, 0x87, 0x6e, 0xa5,
-	0x89, 0xda, 0xd5, 0x70, 0xe6, 0x68, 0x7d, 0x45, 0xf8, 0xef, 0x1e, 0xc8, 0x4e, 0x94, 0xc5, 0x05,
-	0x52, 0x2e, 0x5e, 0xe5, 0x90, 0x44, 0x63, 0xe0, 0x96, 0xd4, 0xc4, 0x74, 0x36, 0x70, 0x4d, 0x8c,
-	0xd3, 0x3e, 0x4b, 0x0c, 0xab, 0xd0, 0x5a, 0xce, 0x3e, 0x5e, 0x19, 0xaa, 0x7c, 0x5d, 0xe1, 0xf1,
-	0x64, 0x4d, 0xb2, 0xf3, 0x04, 0xff, 0xc5, 0x41, 0xb0, 0x64, 0x04, 0xa7, 0x8a, 0xa2, 0x5b, 0xd5,
-	0x74, 0xd7, 0xad, 0xef, 0x84, 0xa6, 0xe0, 0xfc, 0x8f, 0x31, 0x87, 0xcb, 0x1c, 0x84, 0x54, 0x57,
-	0xb6, 0x62, 0xf4, 0x58, 0x4f, 0x37, 0x6e, 0xfd, 0x44, 0xb8, 0x61, 0xf5, 0x74, 0x3b, 0x7b, 0x0f,
-	0x92, 0xe4, 0xe2, 0x55, 0x23, 0x42, 0xb8, 0xe5, 0x66, 0x45, 0x9d, 0x58, 0x53, 0x3d, 0x81, 0xe6,
-	0x25, 0xdc, 0x8a, 0x3a, 0x78, 0xfc, 0x13, 0x98, 0xec, 0xe5, 0x65, 0x39, 0x5b, 0xb8, 0x4e, 0x12,
-	0x0a, 0x99, 0x3e, 0xad, 0x35, 0x51, 0xbb, 0x12, 0xae, 0x19, 0x47, 0x37, 0x6e, 0x9d, 0xe0, 0x0d,
-	0xad, 0xd1, 0x8a, 0x7e, 0x4d, 0x06, 0xbd, 0x9c, 0x10, 0x10, 0x42, 0xa1, 0x46, 0x64, 0x70, 0xca,
-	0x41, 0xe4, 0x89, 0xb4, 0xba, 0xeb, 0x11, 0x19, 0x84, 0xda, 0xf1, 0x3b, 0x6a, 0x79, 0x0e, 0xf5,
-	0x18, 0x37, 0xe6, 0x50, 0x0f, 0x38, 0x67, 0x5c, 0x25, 0x29, 0x4c, 0x50, 0x86, 0x85, 0x5c, 0x8b,
-	0x0a, 0x87, 0x8b, 0x11, 0x5f, 0xe2, 0xad, 0x22, 0x62, 0x08, 0x62, 0xc8, 0x32, 0xa1, 0xf5, 0xb3,
-	0x7c, 0x8e, 0x0d, 0x9a, 0xcb, 0xfd, 0x6c, 0x26, 0x48, 0x3f, 0xe8, 0x21, 0xc0, 0xc3, 0x9a, 0xd5,
-	0xc1, 0x55, 0x35, 0xb8, 0xb6, 0x55, 0xf5, 0xbf, 0xd3, 0xc0, 0x2b, 0x97, 0x39, 0x93, 0xb6, 0x51,
-	0x43, 0x63, 0xcc, 0xda, 0xb7, 0xba, 0x44, 0xfb, 0xb6, 0xbe, 0x20, 0xfc, 0x8f, 0x26, 0xc9, 0x46,
-	0x34, 0x06, 0x5e, 0xe0, 0xb8, 0x89, 0xd7, 0x86, 0xd6, 0x3b, 0xb9, 0xb3, 0x89, 0x5d, 0xe4, 0x5f,
-	0x5e, 0x34, 0x6c, 0x95, 0xfb, 0x87, 0x6d, 0x29, 0xb6, 0x1f, 0x0d, 0xdb, 0x3d, 0x46, 0x33, 0x75,
-	0x33, 0x05, 0xb6, 0xb3, 0xba, 0xe8, 0xfe, 0xba, 0xe5, 0x65, 0x86, 0xfc, 0xcf, 0x0b, 0xe9, 0x0d,
-	0x76, 0x34, 0x09, 0x75, 0x8f, 0x63, 0x79, 0x71, 0x6c, 0x26, 0xe8, 0xd5, 0x74, 0x12, 0x51, 0xb3,
-	0xd2, 0x5e, 0x7f, 0xde, 0xf6, 0x17, 0x2d, 0x72, 0x7f, 0x9a, 0xd5, 0x93, 0x91, 0x84, 0xc9, 0x0c,
-	0x76, 0xce, 0xae, 0x6e, 0x3d, 0x74, 0x7d, 0xeb, 0xa1, 0x1f, 0xb7, 0x1e, 0xfa, 0x70, 0xe7, 0x95,
-	0xae, 0xef, 0xbc, 0xd2, 0xb7, 0x3b, 0xaf, 0xf4, 0xf6, 0xa8, 0x40, 0xbf, 0x3b, 0x41, 0x3d, 0x8a,
-	0xfa, 0x22, 0x98, 0xd6, 0xd8, 0x21, 0x8c, 0x43, 0xd1, 0x54, 0x8b, 0x3c, 0x48, 0x59, 0x9c, 0x27,
-	0x20, 0x26, 0x9b, 0x5f, 0x0b, 0xed, 0xd7, 0xf4, 0xc6, 0x7f, 0xf1, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0x81, 0xc7, 0x44, 0x17, 0x91, 0x06, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0x71, 0x9a, 0x26, 0xb3, 0xec, 0x8a, 0xb5, 0x42, 0xb1, 0xb2, 0x6c, 0x36, 0x44,
+	0x5a, 0x14, 0x09, 0xad, 0xad, 0x85, 0x1b, 0x27, 0x48, 0xbb, 0x15, 0x91, 0xb6, 0x22, 0x72, 0x0a,
+	0x07, 0x2e, 0xd1, 0xc4, 0x7e, 0x4d, 0x86, 0xd8, 0x9e, 0x74, 0x66, 0x62, 0x14, 0x3e, 0x05, 0x12,
+	0x07, 0xee, 0x5c, 0xf9, 0x04, 0x1c, 0xb9, 0xf5, 0x82, 0xd4, 0x23, 0xe2, 0x50, 0xa1, 0x56, 0xe2,
+	0xce, 0x37, 0x40, 0x33, 0x1e, 0x27, 0x26, 0x6a, 0xa0, 0x6a, 0xe0, 0x14, 0xbf, 0x37, 0xef, 0xfd,
+	0xe7, 0xf7, 0x66, 0xde, 0x73, 0x8c, 0x9f, 0xd3, 0xe4, 0x2b, 0x08, 0x24, 0x4d, 0xc1, 0x63, 0x9c,
+	0x04, 0x11, 0x78, 0xe9, 0xcb, 0x31, 0x48, 0xf2, 0xd2, 0x83, 0x14, 0x12, 0x29, 0xdc, 0x39, 0x67,
+	0x92, 0xd9, 0xce, 0x2a, 0xcc, 0xcd, 0xc2, 0x5c, 0x13, 0xd6, 0x6c, 0x4c, 0xd8, 0x84, 0xe9, 0x20,
+	0x4f, 0x3d, 0x65, 0xf1, 0xcd, 0x56, 0xc0, 0x44, 0xcc, 0x84, 0x37, 0x26, 0x62, 0xad, 0x18, 0x30,
+	0x9a, 0x98, 0xf5, 0xed, 0xdb, 0x1a, 0x79, 0x1d, 0xd6, 0xf9, 0x1e, 0xe1, 0x83, 0x21, 0xc8, 0xc3,
+	0x29, 0xa1, 0x49, 0x44, 0x93, 0xd9, 0x80, 0xd3, 0x00, 0x5e, 0x29, 0x30, 0xfb, 0x6d, 0xbc, 0x7f,
+	0x06, 0x10, 0x8e, 0x68, 0xe8, 0xa0, 0x36, 0xea, 0xd6, 0xfd, 0xaa, 0x32, 0xfb, 0xa1, 0x7d, 0x8c,
+	0xab, 0x24, 0x11, 0x5f, 0x03, 0x77, 0xca, 0xca, 0xdf, 0x73, 0x2f, 0xae, 0x9e, 0x95, 0x7e, 0xbb,
+	0x7a, 0xf6, 0xde, 0x84, 0xca, 0xe9, 0x62, 0xec, 0x06, 0x2c, 0xf6, 0x0c, 0x5d, 0xf6, 0xf3, 0x42,
+	0x84, 0x33, 0x4f, 0x2e, 0xe7, 0x20, 0xdc, 0x23, 0x08, 0x7c, 0x93, 0x6d, 0xbf, 0x83, 0xeb, 0x92,
+	0xc6, 0x20, 0x24, 0x89, 0xe7, 0x8e, 0xd5, 0x46, 0xdd, 0x8a, 0xbf, 0x76, 0x74, 0x7e, 0x41, 0xf8,
+	0xf1, 0x10, 0x64, 0x8f, 0x24, 0x61, 0x01, 0xca, 0xc1, 0xfb, 0x1c, 0x22, 0xb2, 0x04, 0x6e, 0xa0,
+	0x72, 0xd3, 0x3e, 0xc0, 0x55, 0xb1, 0x8c, 0xc7, 0x2c, 0xca, 0xa8, 0x7c, 0x63, 0xd9, 0x47, 0x78,
+	0x6f, 0xae, 0xf2, 0x1d, 0xeb, 0x5e, 0xb0, 0x59, 0xb2, 0xfd, 0x2e, 0x7e, 0x83, 0x83, 0x60, 0x51,
+	0x0a, 0x23, 0x85, 0xe8, 0x54, 0x34, 0xee, 0x03, 0xe3, 0x3b, 0xa5, 0x31, 0xd8, 0x4f, 0x31, 0xe6,
+	0x70, 0xbe, 0x00, 0x21, 0xd5, 0x91, 0xed, 0x65, 0xf5, 0x18, 0x4f, 0x3f, 0xec, 0xfc, 0x89, 0x70,
+	0xc3, 0xd4, 0xd3, 0xef, 0x1d, 0xde, 0xa9, 0x24, 0x07, 0xef, 0x67, 0x45, 0x08, 0xa7, 0xdc, 0xb6,
+	0xd4, 0x8a, 0x31, 0xd5, 0x15, 0x68, 0x2e, 0xe1, 0x58, 0x6d, 0xeb, 0x1e, 0x55, 0x99, 0xec, 0xdd,
+	0xcb, 0xb2, 0x9f, 0xe0, 0x7a, 0x10, 0x51, 0x48, 0xf4, 0x6a, 0xb5, 0x8d, 0xba, 0x96, 0x5f, 0xcb,
+	0x1c, 0xfd, 0xb0, 0x73, 0x8a, 0x0f, 0x74, 0x8d, 0xa6, 0xe8, 0x4f, 0x82, 0xd9, 0x70, 0x11, 0x04,
+	0x20, 0x84, 0x52, 0x25, 0xc1, 0x6c, 0xc4, 0x41, 0x2c, 0x22, 0x69, 0xea, 0xae, 0x93, 0x60, 0xe6,
+	0x6b, 0xc7, 0xdf, 0x55, 0xcb, 0x1b, 0xaa, 0x03, 0xdc, 0xd8, 0x50, 0x7d, 0xc5, 0x39, 0xe3, 0x2a,
+	0x49, 0x69, 0x82, 0x32, 0x8c, 0x64, 0x8d, 0x14, 0x16, 0xb7, 0x2b, 0x7e, 0x84, 0x9f, 0x14, 0x15,
+	0x7d, 0x10, 0x73, 0x96, 0x08, 0x5d, 0x3f, 0x5b, 0x6c, 0xd0, 0xa0, 0x8d, 0xdc, 0x1f, 0xb2, 0x09,
+	0xd2, 0x17, 0x7a, 0x0c, 0x70, 0xb7, 0x66, 0xb5, 0x71, 0x45, 0x0d, 0xae, 0x69, 0x55, 0xfd, 0x6c,
+	0x37, 0xf0, 0xde, 0xf9, 0x82, 0x49, 0xd3, 0xa8, 0x7e, 0x66, 0xac, 0xdb, 0xb7, 0xb2, 0x43, 0xfb,
+	0x76, 0x7e, 0x44, 0xf8, 0x2d, 0x0d, 0xc9, 0x52, 0x1a, 0x02, 0x2f, 0x30, 0x36, 0x71, 0x6d, 0x6e,
+	0xbc, 0xf9, 0x99, 0xe5, 0x76, 0x91, 0xbf, 0xbc, 0x6d, 0xd8, 0xac, 0xdb, 0x87, 0x6d, 0x27, 0xda,
+	0xef, 0x32, 0xda, 0x43, 0x46, 0x13, 0x75, 0x32, 0x05, 0xda, 0xf5, 0xbe, 0xe8, 0xf6, 0x7d, 0xcb,
+	0xbb, 0x0c, 0xf9, 0x3f, 0xbf, 0x90, 0xbe, 0xc0, 0xb6, 0x86, 0x50, 0xe7, 0xb8, 0x94, 0xd3, 0x41,
+	0x36, 0x41, 0x1f, 0xaf, 0x26, 0x11, 0xb5, 0xad, 0xee, 0x83, 0x0f, 0xba, 0xee, 0xb6, 0x17, 0xb9,
+	0xbb, 0xca, 0x1a, 0x4a, 0x22, 0x21, 0x9f, 0xc1, 0xce, 0xcf, 0x08, 0x3f, 0xd5, 0xc2, 0x9f, 0xe9,
+	0xf0, 0x13, 0x2a, 0xc6, 0x30, 0x25, 0x29, 0x65, 0x7c, 0x18, 0x11, 0x31, 0x85, 0x50, 0x71, 0xa5,
+	0x24, 0xa2, 0x21, 0x91, 0xab, 0xc6, 0x5e, 0x3b, 0xec, 0xf7, 0xf1, 0x63, 0x9a, 0x9c, 0x71, 0x12,
+	0x48, 0xca, 0x92, 0xd1, 0x14, 0xe8, 0x64, 0x2a, 0x4d, 0x87, 0xbf, 0xb9, 0x5e, 0xf8, 0x54, 0xfb,
+	0xed, 0xcf, 0xf1, 0x23, 0xa1, 0x54, 0x47, 0xb9, 0xff, 0x9e, 0xaf, 0xc5, 0x87, 0x5a, 0xe5, 0xd8,
+	0x88, 0x74, 0x7e, 0x42, 0x66, 0x82, 0x4e, 0xa8, 0x10, 0x10, 0xfa, 0x30, 0x67, 0x5c, 0x8a, 0x01,
+	0x24, 0x24, 0xa2, 0xdf, 0xfc, 0x6b, 0x05, 0xcf, 0xf1, 0xa3, 0x58, 0xe7, 0x8d, 0x78, 0x96, 0xa8,
+	0xf1, 0x2b, 0xfe, 0xc3, 0xb8, 0xa8, 0xf6, 0x7f, 0xb1, 0xff, 0x91, 0xb3, 0xeb, 0xbb, 0x39, 0x82,
+	0x94, 0x12, 0xe5, 0xf7, 0x41, 0x5d, 0x23, 0x84, 0x5b, 0x7b, 0xae, 0x89, 0x6b, 0x19, 0xee, 0x6a,
+	0x3c, 0x56, 0xb6, 0x7d, 0x82, 0x71, 0x44, 0x84, 0x1c, 0xed, 0xf2, 0xcf, 0x53, 0x57, 0x0a, 0x9a,
+	0xe9, 0xbf, 0x19, 0xab, 0xde, 0xd9, 0xc5, 0x75, 0x0b, 0x5d, 0x5e, 0xb7, 0xd0, 0xef, 0xd7, 0x2d,
+	0xf4, 0xed, 0x4d, 0xab, 0x74, 0x79, 0xd3, 0x2a, 0xfd, 0x7a, 0xd3, 0x2a, 0x7d, 0xf9, 0xba, 0x20,
+	0xd4, 0xcf, 0xdb, 0xf7, 0x35, 0x19, 0x0b, 0x6f, 0xd5, 0xcc, 0x2f, 0x02, 0xc6, 0xa1, 0x68, 0xaa,
+	0x2f, 0x06, 0x2f, 0x66, 0xe1, 0x22, 0x02, 0x91, 0x7f, 0x62, 0xe8, 0x2d, 0xc7, 0x55, 0xfd, 0x69,
+	0xf1, 0xe1, 0x5f, 0x03, 0x00, 0x98, 0xdf, 0x87, 0x70, 0xfa, 0x08, 0x00, 0x00,
 }
 
 func (m *SetChainlinkPriceEvent) Marshal() (dAtA []byte, err error) {
@@ -1114,6 +1287,153 @@ func (m *EventSetPythPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOracleMisbehaviorSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOracleMisbehaviorSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOracleMisbehaviorSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.InfractionHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMissedReportsPenalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMissedReportsPenalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMissedReportsPenalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MissedReports != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedReports))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPriceDeviationRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceDeviationRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceDeviationRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1325,6 +1645,63 @@ func (m *EventSetPythPrices) Size() (n int) {
 	return n
 }
 
+func (m *EventOracleMisbehaviorSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovEvents(uint64(m.InfractionHeight))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventMissedReportsPenalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MissedReports != 0 {
+		n += 1 + sovEvents(uint64(m.MissedReports))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPriceDeviationRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.LastPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2709,6 +3086,458 @@ func (m *EventSetPythPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOracleMisbehaviorSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOracleMisbehaviorSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOracleMisbehaviorSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMissedReportsPenalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMissedReportsPenalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMissedReportsPenalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReports", wireType)
+			}
+			m.MissedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPriceDeviationRejected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceDeviationRejected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceDeviationRejected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
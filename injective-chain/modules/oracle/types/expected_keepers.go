@@ -1,8 +1,13 @@
 package types
 
 import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
@@ -42,3 +47,23 @@ type PortKeeper interface {
 type OcrKeeper interface {
 	GetTransmission(ctx sdk.Context, feedId string) *ocrtypes.Transmission
 }
+
+// MisbehaviorSlasher slashes the validators for oracle misbehavior, by the governance set slash fraction
+type MisbehaviorSlasher interface {
+	SlashOracleMisbehavior(ctx sdk.Context, validator sdk.ValAddress, infractionHeight int64) (sdk.Dec, error)
+}
+
+// StakingKeeper defines the expected staking keeper methods, to track the oracle reports of the validators
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+	PowerReduction(ctx sdk.Context) sdkmath.Int
+}
+
+// SlashingKeeper defines the expected slashing keeper methods, to penalize the validators missing oracle reports
+type SlashingKeeper interface {
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+	DowntimeJailDuration(ctx sdk.Context) time.Duration
+	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
+}
@@ -41,6 +41,9 @@ type GenesisState struct {
 	HistoricalPriceRecords []*PriceRecords        `protobuf:"bytes,13,rep,name=historical_price_records,json=historicalPriceRecords,proto3" json:"historical_price_records,omitempty"`
 	ProviderStates         []*ProviderState       `protobuf:"bytes,14,rep,name=provider_states,json=providerStates,proto3" json:"provider_states,omitempty"`
 	PythPriceStates        []*PythPriceState      `protobuf:"bytes,15,rep,name=pyth_price_states,json=pythPriceStates,proto3" json:"pyth_price_states,omitempty"`
+	RelayerNonces          []*RelayerNonce        `protobuf:"bytes,16,rep,name=relayer_nonces,json=relayerNonces,proto3" json:"relayer_nonces,omitempty"`
+	ReporterRecords        []*ReporterRecord      `protobuf:"bytes,17,rep,name=reporter_records,json=reporterRecords,proto3" json:"reporter_records,omitempty"`
+	PriceMoveVotes         []*PriceMoveVote       `protobuf:"bytes,18,rep,name=price_move_votes,json=priceMoveVotes,proto3" json:"price_move_votes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -181,6 +184,27 @@ func (m *GenesisState) GetPythPriceStates() []*PythPriceState {
 	return nil
 }
 
+func (m *GenesisState) GetRelayerNonces() []*RelayerNonce {
+	if m != nil {
+		return m.RelayerNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReporterRecords() []*ReporterRecord {
+	if m != nil {
+		return m.ReporterRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceMoveVotes() []*PriceMoveVote {
+	if m != nil {
+		return m.PriceMoveVotes
+	}
+	return nil
+}
+
 type CalldataRecord struct {
 	ClientId uint64 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
@@ -243,47 +267,52 @@ func init() {
 }
 
 var fileDescriptor_f7e14cf80151b4d2 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xe3, 0xb6, 0xbf, 0xfe, 0x9a, 0x6d, 0xda, 0xc0, 0xd2, 0x16, 0x13, 0x24, 0x63, 0x15,
-	0xd1, 0x5a, 0x82, 0xda, 0x6a, 0xb9, 0x20, 0x0e, 0x1c, 0x12, 0x09, 0x64, 0xa9, 0x12, 0x95, 0x0b,
-	0x17, 0x38, 0x98, 0xf5, 0x7a, 0x9b, 0x2c, 0x38, 0x5e, 0xb3, 0xbb, 0x89, 0x94, 0xb7, 0xe0, 0xb1,
-	0x7a, 0xec, 0x91, 0x13, 0xaa, 0x92, 0x17, 0x41, 0x5e, 0xff, 0x89, 0xdd, 0x2a, 0x89, 0xb8, 0x79,
-	0xc6, 0x33, 0x9f, 0xf9, 0xee, 0xcc, 0xec, 0x82, 0x23, 0x1a, 0x7f, 0x27, 0x58, 0xd2, 0x31, 0x71,
-	0x18, 0x47, 0x38, 0x22, 0xce, 0xf8, 0x34, 0x20, 0x12, 0x9d, 0x3a, 0x7d, 0x12, 0x13, 0x41, 0x85,
-	0x9d, 0x70, 0x26, 0x19, 0xd4, 0xcb, 0x38, 0x3b, 0x8b, 0xb3, 0xf3, 0xb8, 0xce, 0x8b, 0x85, 0x84,
-	0x3c, 0x50, 0x01, 0x3a, 0x7b, 0x7d, 0xd6, 0x67, 0xea, 0xd3, 0x49, 0xbf, 0x32, 0xef, 0xe1, 0x6d,
-	0x13, 0xb4, 0x3e, 0x64, 0x85, 0x2e, 0x25, 0x92, 0x04, 0xbe, 0x03, 0x9b, 0x09, 0xe2, 0x68, 0x28,
-	0x74, 0xcd, 0xd4, 0xac, 0xed, 0x33, 0xd3, 0x5e, 0x54, 0xd8, 0xbe, 0x50, 0x71, 0xdd, 0x8d, 0xeb,
-	0x3f, 0xcf, 0x1a, 0x5e, 0x9e, 0x05, 0x9f, 0x83, 0x9d, 0x00, 0xc5, 0xa1, 0xcf, 0x49, 0x84, 0x26,
-	0x84, 0x0b, 0x7d, 0xcd, 0x5c, 0xb7, 0x9a, 0x5e, 0x2b, 0x75, 0x7a, 0xb9, 0x0f, 0x7e, 0x02, 0x0f,
-	0x55, 0x50, 0xc2, 0x29, 0x26, 0xbe, 0x48, 0x0b, 0x0b, 0x7d, 0xdd, 0x5c, 0xb7, 0xb6, 0xcf, 0xac,
-	0xc5, 0xf5, 0xba, 0x28, 0x0e, 0x2f, 0xd2, 0x0c, 0xa5, 0xd4, 0x6b, 0x07, 0x35, 0x5b, 0x40, 0x1f,
-	0x3c, 0xce, 0x80, 0x57, 0x84, 0xdc, 0x61, 0x6f, 0xac, 0x62, 0x2b, 0xce, 0x7b, 0x42, 0xc2, 0x8c,
-	0xbd, 0x97, 0x14, 0x76, 0xb5, 0xc0, 0x37, 0xb0, 0x8f, 0x19, 0x8d, 0x03, 0x24, 0x48, 0x1d, 0xff,
-	0x9f, 0xc2, 0xbf, 0x5a, 0x8c, 0xef, 0xe5, 0x69, 0x15, 0xf9, 0x8f, 0xf0, 0x3d, 0x9f, 0x80, 0x5f,
-	0xc1, 0xbe, 0x6a, 0x0c, 0x0d, 0x70, 0xbd, 0xc2, 0xe6, 0x3f, 0x36, 0x07, 0xa6, 0x18, 0x37, 0xc0,
-	0x55, 0x78, 0x08, 0xf4, 0x12, 0x9e, 0x65, 0xfb, 0x9c, 0xfc, 0x1c, 0x11, 0x21, 0x85, 0xfe, 0xbf,
-	0xe2, 0xbf, 0x5c, 0xce, 0xff, 0xa8, 0x5c, 0x5e, 0x96, 0xe3, 0xed, 0xe7, 0x25, 0x6a, 0x5e, 0x01,
-	0x3f, 0x83, 0xf6, 0xfc, 0x08, 0xd9, 0x26, 0x6d, 0xa9, 0x4d, 0x3a, 0x5e, 0x0e, 0x77, 0xbb, 0xbd,
-	0xda, 0x42, 0xed, 0x14, 0x27, 0xc8, 0xf6, 0xea, 0x0d, 0x78, 0x52, 0x62, 0xa3, 0xf4, 0x38, 0xd2,
-	0xc7, 0x11, 0x25, 0xb1, 0xf4, 0x69, 0xa8, 0x37, 0x4d, 0xcd, 0xda, 0x28, 0x05, 0x9d, 0xab, 0xdf,
-	0x3d, 0xf5, 0xd7, 0x0d, 0xe1, 0x25, 0x78, 0x80, 0x51, 0x14, 0x85, 0x48, 0x22, 0x9f, 0x13, 0xcc,
-	0x78, 0x28, 0x74, 0xb0, 0xaa, 0x9d, 0xbd, 0x3c, 0xc3, 0x53, 0x09, 0x5e, 0x1b, 0xd7, 0x6c, 0x01,
-	0xdf, 0x82, 0xce, 0x5d, 0x39, 0x79, 0x2f, 0x53, 0x3d, 0xdb, 0x4a, 0xcf, 0x41, 0x4d, 0x4f, 0xde,
-	0x20, 0x37, 0x84, 0x18, 0x1c, 0xe0, 0x01, 0xa2, 0x71, 0x44, 0xe3, 0x1f, 0xf5, 0x29, 0xb7, 0x94,
-	0xac, 0x93, 0x25, 0xb2, 0x8a, 0xbc, 0xca, 0xa8, 0xf7, 0xf0, 0x7d, 0x67, 0xba, 0xab, 0xfa, 0x80,
-	0x0a, 0xc9, 0x38, 0xc5, 0x28, 0xca, 0xab, 0x14, 0xa7, 0xdf, 0x51, 0x65, 0x8e, 0x56, 0xdc, 0x86,
-	0xfc, 0xa8, 0xde, 0xc1, 0x9c, 0x53, 0xf5, 0xc3, 0x0b, 0xd0, 0x4e, 0x38, 0x1b, 0xd3, 0x90, 0xf0,
-	0x42, 0xff, 0xae, 0x02, 0x1f, 0x2f, 0x03, 0x67, 0x09, 0x99, 0xf2, 0xdd, 0xa4, 0x6a, 0xaa, 0x67,
-	0x21, 0x99, 0xc8, 0x41, 0xbd, 0x27, 0xed, 0x95, 0x57, 0x77, 0x22, 0x07, 0xd5, 0x67, 0x21, 0xa9,
-	0xd9, 0xe2, 0xd0, 0x05, 0xbb, 0xf5, 0x69, 0xc2, 0xa7, 0xa0, 0x39, 0xdf, 0x1d, 0x4d, 0xcd, 0x6a,
-	0x0b, 0x17, 0xeb, 0xd2, 0x01, 0x5b, 0xc5, 0xb0, 0xf5, 0x35, 0x53, 0xb3, 0x5a, 0x5e, 0x69, 0x77,
-	0xaf, 0xae, 0xa7, 0x86, 0x76, 0x33, 0x35, 0xb4, 0xdb, 0xa9, 0xa1, 0xfd, 0x9a, 0x19, 0x8d, 0x9b,
-	0x99, 0xd1, 0xf8, 0x3d, 0x33, 0x1a, 0x5f, 0xce, 0xfb, 0x54, 0x0e, 0x46, 0x81, 0x8d, 0xd9, 0xd0,
-	0x71, 0x0b, 0xa5, 0xe7, 0x28, 0x10, 0x4e, 0xa9, 0xfb, 0x04, 0x33, 0x4e, 0xaa, 0x66, 0x3a, 0x36,
-	0x67, 0xc8, 0xc2, 0x51, 0x44, 0x44, 0xf1, 0x74, 0xcb, 0x49, 0x42, 0x44, 0xb0, 0xa9, 0x1e, 0xe7,
-	0xd7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x53, 0x49, 0x4f, 0x18, 0x1d, 0x06, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x9b, 0xbe, 0xbe, 0x76, 0xf2, 0xd5, 0xce, 0x6b, 0xfb, 0xfc, 0xf2, 0xa4, 0x10, 0x15,
+	0x51, 0x22, 0x41, 0x13, 0xb5, 0x6c, 0x10, 0x0b, 0x16, 0x89, 0x04, 0x8a, 0xd4, 0x42, 0x70, 0x81,
+	0x05, 0x2c, 0xcc, 0x78, 0x7c, 0x9b, 0x0c, 0x38, 0x1e, 0x33, 0x33, 0x8d, 0x94, 0x7f, 0xc1, 0xcf,
+	0xea, 0xb2, 0x3b, 0x58, 0x21, 0xd4, 0xfe, 0x11, 0xe4, 0xf1, 0x38, 0xb5, 0x5b, 0x25, 0x11, 0x3b,
+	0xdf, 0xeb, 0x7b, 0xce, 0x3d, 0xf7, 0xfa, 0xcc, 0x18, 0xed, 0xb3, 0xf0, 0x33, 0x50, 0xc5, 0x26,
+	0xd0, 0xe1, 0x82, 0xd0, 0x00, 0x3a, 0x93, 0x43, 0x0f, 0x14, 0x39, 0xec, 0x0c, 0x21, 0x04, 0xc9,
+	0x64, 0x3b, 0x12, 0x5c, 0x71, 0x6c, 0xcf, 0xea, 0xda, 0x49, 0x5d, 0xdb, 0xd4, 0xd5, 0x1f, 0xcc,
+	0x65, 0x30, 0x85, 0x9a, 0xa0, 0xbe, 0x3d, 0xe4, 0x43, 0xae, 0x1f, 0x3b, 0xf1, 0x53, 0x92, 0xdd,
+	0xfb, 0x5e, 0x42, 0xe5, 0x97, 0x49, 0xa3, 0x53, 0x45, 0x14, 0xe0, 0xe7, 0x68, 0x2d, 0x22, 0x82,
+	0x8c, 0xa5, 0x6d, 0x35, 0xad, 0x56, 0xe9, 0xa8, 0xd9, 0x9e, 0xd7, 0xb8, 0x3d, 0xd0, 0x75, 0xdd,
+	0xd5, 0x8b, 0x9f, 0xf7, 0x0a, 0x8e, 0x41, 0xe1, 0xfb, 0xa8, 0xe2, 0x91, 0xd0, 0x77, 0x05, 0x04,
+	0x64, 0x0a, 0x42, 0xda, 0x2b, 0xcd, 0x62, 0x6b, 0xc3, 0x29, 0xc7, 0x49, 0xc7, 0xe4, 0xf0, 0x5b,
+	0xb4, 0xa5, 0x8b, 0x22, 0xc1, 0x28, 0xb8, 0x32, 0x6e, 0x2c, 0xed, 0x62, 0xb3, 0xd8, 0x2a, 0x1d,
+	0xb5, 0xe6, 0xf7, 0xeb, 0x92, 0xd0, 0x1f, 0xc4, 0x08, 0xad, 0xd4, 0xa9, 0x79, 0xb9, 0x58, 0x62,
+	0x17, 0xfd, 0x9b, 0x10, 0x9e, 0x01, 0xdc, 0xe2, 0x5e, 0x5d, 0xc6, 0xad, 0x79, 0x5e, 0x00, 0xf8,
+	0x09, 0xf7, 0x76, 0x94, 0xc6, 0xd9, 0x06, 0x9f, 0xd0, 0x0e, 0xe5, 0x2c, 0xf4, 0x88, 0x84, 0x3c,
+	0xfd, 0x5f, 0x9a, 0xfe, 0xf1, 0x7c, 0xfa, 0x9e, 0x81, 0x65, 0xe4, 0xff, 0x43, 0xef, 0xe4, 0x24,
+	0xfe, 0x88, 0x76, 0xf4, 0x62, 0x98, 0x47, 0xf3, 0x1d, 0xd6, 0xfe, 0x70, 0x39, 0x38, 0xa6, 0xe9,
+	0x7b, 0x34, 0x4b, 0xee, 0x23, 0x7b, 0x46, 0x9e, 0xa0, 0x5d, 0x01, 0x5f, 0xcf, 0x41, 0x2a, 0x69,
+	0xff, 0xad, 0xf9, 0x1f, 0x2d, 0xe6, 0x7f, 0xad, 0x53, 0x4e, 0x82, 0x71, 0x76, 0x4c, 0x8b, 0x5c,
+	0x56, 0xe2, 0x77, 0xa8, 0x76, 0x33, 0x42, 0xe2, 0xa4, 0x75, 0xed, 0xa4, 0x87, 0x8b, 0xc9, 0xfb,
+	0xdd, 0x5e, 0xce, 0x50, 0x95, 0x74, 0x82, 0xc4, 0x57, 0x4f, 0xd1, 0x7f, 0x33, 0xda, 0x20, 0x1e,
+	0x47, 0xb9, 0x34, 0x60, 0x10, 0x2a, 0x97, 0xf9, 0xf6, 0x46, 0xd3, 0x6a, 0xad, 0xce, 0x04, 0x1d,
+	0xeb, 0xd7, 0x3d, 0xfd, 0xb6, 0xef, 0xe3, 0x53, 0xb4, 0x49, 0x49, 0x10, 0xf8, 0x44, 0x11, 0x57,
+	0x00, 0xe5, 0xc2, 0x97, 0x36, 0x5a, 0xb6, 0xce, 0x9e, 0x41, 0x38, 0x1a, 0xe0, 0xd4, 0x68, 0x2e,
+	0x96, 0xf8, 0x19, 0xaa, 0xdf, 0x96, 0x63, 0x76, 0x19, 0xeb, 0x29, 0x69, 0x3d, 0xbb, 0x39, 0x3d,
+	0x66, 0x41, 0x7d, 0x1f, 0x53, 0xb4, 0x4b, 0x47, 0x84, 0x85, 0x01, 0x0b, 0xbf, 0xe4, 0xbf, 0x72,
+	0x59, 0xcb, 0x3a, 0x58, 0x20, 0x2b, 0xc5, 0x65, 0x3e, 0xf5, 0x36, 0xbd, 0x9b, 0x8c, 0xbd, 0x6a,
+	0x8f, 0x98, 0x54, 0x5c, 0x30, 0x4a, 0x02, 0xd3, 0x25, 0x9d, 0xbe, 0xa2, 0xdb, 0xec, 0x2f, 0x39,
+	0x0d, 0x66, 0x54, 0x67, 0xf7, 0x86, 0x27, 0x9b, 0xc7, 0x03, 0x54, 0x8b, 0x04, 0x9f, 0x30, 0x1f,
+	0x44, 0xaa, 0xbf, 0xda, 0x2c, 0x2e, 0xfe, 0xd0, 0x03, 0x03, 0x48, 0x94, 0x57, 0xa3, 0x6c, 0xa8,
+	0xaf, 0x85, 0x68, 0xaa, 0x46, 0xf9, 0x9d, 0xd4, 0x96, 0x1e, 0xdd, 0xa9, 0x1a, 0x65, 0xaf, 0x85,
+	0x28, 0x17, 0x4b, 0x7c, 0x82, 0xaa, 0xe6, 0x32, 0x72, 0x43, 0x1e, 0x52, 0x90, 0xf6, 0xe6, 0xb2,
+	0xf9, 0xcd, 0x45, 0xf5, 0x2a, 0x2e, 0x77, 0x2a, 0x22, 0x13, 0xc9, 0xd8, 0x4e, 0x02, 0x22, 0x2e,
+	0x14, 0x88, 0xd9, 0x42, 0xb7, 0x96, 0x69, 0x74, 0x0c, 0x22, 0xb5, 0x93, 0xc8, 0xc5, 0x12, 0xbf,
+	0x41, 0x9b, 0xc9, 0xd0, 0x63, 0x3e, 0x01, 0x77, 0xc2, 0xe3, 0xc1, 0xf1, 0xf2, 0x65, 0x32, 0x0a,
+	0x27, 0x7c, 0x02, 0xef, 0x79, 0xb2, 0xcc, 0x4c, 0x28, 0xf7, 0xfa, 0xa8, 0x9a, 0x37, 0x31, 0xfe,
+	0x1f, 0x6d, 0xdc, 0x1c, 0x19, 0x4b, 0x5b, 0x74, 0x9d, 0xa6, 0xa7, 0xa4, 0x8e, 0xd6, 0x53, 0x8f,
+	0xdb, 0x2b, 0x4d, 0xab, 0x55, 0x76, 0x66, 0x71, 0xf7, 0xec, 0xe2, 0xaa, 0x61, 0x5d, 0x5e, 0x35,
+	0xac, 0x5f, 0x57, 0x0d, 0xeb, 0xdb, 0x75, 0xa3, 0x70, 0x79, 0xdd, 0x28, 0xfc, 0xb8, 0x6e, 0x14,
+	0x3e, 0x1c, 0x0f, 0x99, 0x1a, 0x9d, 0x7b, 0x6d, 0xca, 0xc7, 0x9d, 0x7e, 0xaa, 0xf3, 0x98, 0x78,
+	0xb2, 0x33, 0x53, 0x7d, 0x40, 0xb9, 0x80, 0x6c, 0x18, 0xbb, 0xb5, 0x33, 0xe6, 0xfe, 0x79, 0x00,
+	0x32, 0xfd, 0x63, 0xa9, 0x69, 0x04, 0xd2, 0x5b, 0xd3, 0xff, 0xa4, 0x27, 0xbf, 0x07, 0x00, 0xfd,
+	0x36, 0xe8, 0xef, 0x14, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -306,6 +335,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceMoveVotes) > 0 {
+		for iNdEx := len(m.PriceMoveVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceMoveVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ReporterRecords) > 0 {
+		for iNdEx := len(m.ReporterRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReporterRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RelayerNonces) > 0 {
+		for iNdEx := len(m.RelayerNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.PythPriceStates) > 0 {
 		for iNdEx := len(m.PythPriceStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -616,6 +693,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RelayerNonces) > 0 {
+		for _, e := range m.RelayerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReporterRecords) > 0 {
+		for _, e := range m.ReporterRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceMoveVotes) > 0 {
+		for _, e := range m.PriceMoveVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1146,6 +1241,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerNonces = append(m.RelayerNonces, &RelayerNonce{})
+			if err := m.RelayerNonces[len(m.RelayerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterRecords = append(m.ReporterRecords, &ReporterRecord{})
+			if err := m.ReporterRecords[len(m.ReporterRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMoveVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceMoveVotes = append(m.PriceMoveVotes, &PriceMoveVote{})
+			if err := m.PriceMoveVotes[len(m.PriceMoveVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
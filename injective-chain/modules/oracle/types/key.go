@@ -58,8 +58,56 @@ var (
 
 	// PythPriceKey is the prefix for the priceID => PythPriceState store.
 	PythPriceKey = []byte{0x71}
+
+	// RelayerNonceKey is the prefix for the relayer => last accepted nonce store.
+	RelayerNonceKey = []byte{0x81}
+
+	// OracleMisbehaviorPrefix is the prefix for the validator + infraction height => slashed store.
+	OracleMisbehaviorPrefix = []byte{0x91}
+
+	// ReporterRecordPrefix is the prefix for the validator => oracle report outcomes store.
+	ReporterRecordPrefix = []byte{0xa1}
+
+	// PriceUpdateHeightPrefix is the prefix for the oracle type + symbol => last price update height store.
+	PriceUpdateHeightPrefix = []byte{0xb1}
+
+	// PriceMoveVotePrefix is the prefix for the symbol + reporter => price move vote store.
+	PriceMoveVotePrefix = []byte{0xc1}
 )
 
+// GetPriceDeviationSymbol returns the symbol of the max price deviation and the price move votes of a symbol of an
+// oracle type, e.g. PriceFeed_INJ/USDT, so that the base/quote pairs of the price feeds and the provider/symbol pairs
+// of the provider prices can't collide.
+func GetPriceDeviationSymbol(oracleType OracleType, symbol string) string {
+	return fmt.Sprintf("%s_%s", oracleType.String(), symbol)
+}
+
+// GetPriceMoveVotePrefix returns the prefix of the price move votes of a symbol.
+func GetPriceMoveVotePrefix(symbol string) []byte {
+	// the length prefix keeps a symbol from prefixing another one
+	return append(append(append([]byte{}, PriceMoveVotePrefix...), byte(len(symbol))), symbol...)
+}
+
+// GetPriceMoveVoteKey returns the key of the price move vote of a reporter for a symbol.
+func GetPriceMoveVoteKey(symbol string, reporter sdk.AccAddress) []byte {
+	return append(GetPriceMoveVotePrefix(symbol), reporter.Bytes()...)
+}
+
+// GetPriceUpdateHeightKey returns the key of the height of the last price update of a symbol of an oracle type.
+func GetPriceUpdateHeightKey(oracleType OracleType, symbol string) []byte {
+	return append(append([]byte{}, PriceUpdateHeightPrefix...), []byte(fmt.Sprintf("%s_%s", oracleType.String(), symbol))...)
+}
+
+// GetReporterRecordKey returns the key of the oracle report outcomes of a validator.
+func GetReporterRecordKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, ReporterRecordPrefix...), validator.Bytes()...)
+}
+
+// GetOracleMisbehaviorKey returns the key recording that the oracle misbehavior of a validator at a height was slashed.
+func GetOracleMisbehaviorKey(validator sdk.ValAddress, infractionHeight int64) []byte {
+	return append(append(append([]byte{}, OracleMisbehaviorPrefix...), validator.Bytes()...), sdk.Uint64ToBigEndian(uint64(infractionHeight))...)
+}
+
 func GetBandPriceStoreKey(symbol string) []byte {
 	return append(BandPriceKey, []byte(symbol)...)
 }
@@ -161,3 +209,7 @@ func GetProviderPriceKey(provider, symbol string) []byte {
 func GetPythPriceStoreKey(priceID common.Hash) []byte {
 	return append(PythPriceKey, priceID.Bytes()...)
 }
+
+func GetRelayerNonceKey(relayer sdk.AccAddress) []byte {
+	return append(RelayerNonceKey, relayer.Bytes()...)
+}
@@ -27,6 +27,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type AggregationPolicy int32
+
+const (
+	AggregationPolicy_AggregationUnspecified AggregationPolicy = 0
+	// the median of the fresh prices of the providers
+	AggregationPolicy_AggregationMedian AggregationPolicy = 1
+)
+
+var AggregationPolicy_name = map[int32]string{
+	0: "AggregationUnspecified",
+	1: "AggregationMedian",
+}
+
+var AggregationPolicy_value = map[string]int32{
+	"AggregationUnspecified": 0,
+	"AggregationMedian":      1,
+}
+
+func (x AggregationPolicy) String() string {
+	return proto.EnumName(AggregationPolicy_name, int32(x))
+}
+
+func (AggregationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{0}
+}
+
 type OracleType int32
 
 const (
@@ -79,11 +105,64 @@ func (x OracleType) String() string {
 }
 
 func (OracleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{0}
+	return fileDescriptor_1c8fbf1e7a765423, []int{1}
+}
+
+// ReportOutcome is the outcome of a validator oracle report.
+type ReportOutcome int32
+
+const (
+	ReportOutcome_ReportUnspecified ReportOutcome = 0
+	ReportOutcome_ReportOnTime      ReportOutcome = 1
+	ReportOutcome_ReportLate        ReportOutcome = 2
+	ReportOutcome_ReportMissed      ReportOutcome = 3
+)
+
+var ReportOutcome_name = map[int32]string{
+	0: "ReportUnspecified",
+	1: "ReportOnTime",
+	2: "ReportLate",
+	3: "ReportMissed",
+}
+
+var ReportOutcome_value = map[string]int32{
+	"ReportUnspecified": 0,
+	"ReportOnTime":      1,
+	"ReportLate":        2,
+	"ReportMissed":      3,
+}
+
+func (x ReportOutcome) String() string {
+	return proto.EnumName(ReportOutcome_name, int32(x))
+}
+
+func (ReportOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{2}
 }
 
 type Params struct {
 	PythContract string `protobuf:"bytes,1,opt,name=pyth_contract,json=pythContract,proto3" json:"pyth_contract,omitempty"`
+	// reporter_stats_window is the number of blocks over which the oracle
+	// reports of the validators are counted, zero disables the reporter stats
+	ReporterStatsWindow int64 `protobuf:"varint,2,opt,name=reporter_stats_window,json=reporterStatsWindow,proto3" json:"reporter_stats_window,omitempty"`
+	// reporter_interval is the number of blocks within which a validator is
+	// expected to report again after its previous report. A report within the
+	// interval is on time, a report within twice the interval is late, and a
+	// report is missed once twice the interval passed without one.
+	ReporterInterval int64 `protobuf:"varint,3,opt,name=reporter_interval,json=reporterInterval,proto3" json:"reporter_interval,omitempty"`
+	// max_missed_reports is the number of missed reports within the reporter
+	// stats window above which a bonded validator is jailed and slashed as for
+	// an oracle misbehavior, zero disables the penalty
+	MaxMissedReports uint64 `protobuf:"varint,4,opt,name=max_missed_reports,json=maxMissedReports,proto3" json:"max_missed_reports,omitempty"`
+	// price_staleness_threshold is the number of seconds after its last update
+	// after which an oracle price is flagged stale, zero never flags a price
+	PriceStalenessThreshold int64 `protobuf:"varint,5,opt,name=price_staleness_threshold,json=priceStalenessThreshold,proto3" json:"price_staleness_threshold,omitempty"`
+	// symbol_aggregations are the policies aggregating the prices of a symbol
+	// across the oracle providers
+	SymbolAggregations []SymbolAggregation `protobuf:"bytes,6,rep,name=symbol_aggregations,json=symbolAggregations,proto3" json:"symbol_aggregations"`
+	// symbol_price_deviations are the maximum deviations of the submitted
+	// prices of a symbol from its last accepted price
+	SymbolPriceDeviations []SymbolPriceDeviation `protobuf:"bytes,7,rep,name=symbol_price_deviations,json=symbolPriceDeviations,proto3" json:"symbol_price_deviations"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -126,6 +205,161 @@ func (m *Params) GetPythContract() string {
 	return ""
 }
 
+func (m *Params) GetReporterStatsWindow() int64 {
+	if m != nil {
+		return m.ReporterStatsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetReporterInterval() int64 {
+	if m != nil {
+		return m.ReporterInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedReports() uint64 {
+	if m != nil {
+		return m.MaxMissedReports
+	}
+	return 0
+}
+
+func (m *Params) GetPriceStalenessThreshold() int64 {
+	if m != nil {
+		return m.PriceStalenessThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetSymbolAggregations() []SymbolAggregation {
+	if m != nil {
+		return m.SymbolAggregations
+	}
+	return nil
+}
+
+func (m *Params) GetSymbolPriceDeviations() []SymbolPriceDeviation {
+	if m != nil {
+		return m.SymbolPriceDeviations
+	}
+	return nil
+}
+
+type SymbolPriceDeviation struct {
+	// symbol is the base/quote pair of the price feeds and the provider/symbol
+	// pair of the provider prices, prefixed with their oracle type, e.g.
+	// PriceFeed_INJ/USDT or Provider_provider/symbol
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// max_price_deviation is the maximum relative deviation of a submitted
+	// price from the last accepted price, a larger move is only accepted once a
+	// supermajority of the reporters agree on it
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+}
+
+func (m *SymbolPriceDeviation) Reset()         { *m = SymbolPriceDeviation{} }
+func (m *SymbolPriceDeviation) String() string { return proto.CompactTextString(m) }
+func (*SymbolPriceDeviation) ProtoMessage()    {}
+func (*SymbolPriceDeviation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{1}
+}
+func (m *SymbolPriceDeviation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbolPriceDeviation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbolPriceDeviation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbolPriceDeviation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolPriceDeviation.Merge(m, src)
+}
+func (m *SymbolPriceDeviation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbolPriceDeviation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbolPriceDeviation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbolPriceDeviation proto.InternalMessageInfo
+
+func (m *SymbolPriceDeviation) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+type SymbolAggregation struct {
+	Symbol string            `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Policy AggregationPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=injective.oracle.v1beta1.AggregationPolicy" json:"policy,omitempty"`
+	// min_providers is the number of fresh provider prices required to
+	// aggregate the price of the symbol
+	MinProviders uint32 `protobuf:"varint,3,opt,name=min_providers,json=minProviders,proto3" json:"min_providers,omitempty"`
+}
+
+func (m *SymbolAggregation) Reset()         { *m = SymbolAggregation{} }
+func (m *SymbolAggregation) String() string { return proto.CompactTextString(m) }
+func (*SymbolAggregation) ProtoMessage()    {}
+func (*SymbolAggregation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{2}
+}
+func (m *SymbolAggregation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbolAggregation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbolAggregation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbolAggregation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolAggregation.Merge(m, src)
+}
+func (m *SymbolAggregation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbolAggregation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbolAggregation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbolAggregation proto.InternalMessageInfo
+
+func (m *SymbolAggregation) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *SymbolAggregation) GetPolicy() AggregationPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return AggregationPolicy_AggregationUnspecified
+}
+
+func (m *SymbolAggregation) GetMinProviders() uint32 {
+	if m != nil {
+		return m.MinProviders
+	}
+	return 0
+}
+
 type OracleInfo struct {
 	Symbol     string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	OracleType OracleType `protobuf:"varint,2,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
@@ -135,7 +369,7 @@ func (m *OracleInfo) Reset()         { *m = OracleInfo{} }
 func (m *OracleInfo) String() string { return proto.CompactTextString(m) }
 func (*OracleInfo) ProtoMessage()    {}
 func (*OracleInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{1}
+	return fileDescriptor_1c8fbf1e7a765423, []int{3}
 }
 func (m *OracleInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +423,7 @@ func (m *ChainlinkPriceState) Reset()         { *m = ChainlinkPriceState{} }
 func (m *ChainlinkPriceState) String() string { return proto.CompactTextString(m) }
 func (*ChainlinkPriceState) ProtoMessage()    {}
 func (*ChainlinkPriceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{2}
+	return fileDescriptor_1c8fbf1e7a765423, []int{4}
 }
 func (m *ChainlinkPriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +485,7 @@ func (m *BandPriceState) Reset()         { *m = BandPriceState{} }
 func (m *BandPriceState) String() string { return proto.CompactTextString(m) }
 func (*BandPriceState) ProtoMessage()    {}
 func (*BandPriceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{3}
+	return fileDescriptor_1c8fbf1e7a765423, []int{5}
 }
 func (m *BandPriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +553,7 @@ func (m *PriceFeedState) Reset()         { *m = PriceFeedState{} }
 func (m *PriceFeedState) String() string { return proto.CompactTextString(m) }
 func (*PriceFeedState) ProtoMessage()    {}
 func (*PriceFeedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{4}
+	return fileDescriptor_1c8fbf1e7a765423, []int{6}
 }
 func (m *PriceFeedState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +619,7 @@ func (m *ProviderInfo) Reset()         { *m = ProviderInfo{} }
 func (m *ProviderInfo) String() string { return proto.CompactTextString(m) }
 func (*ProviderInfo) ProtoMessage()    {}
 func (*ProviderInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{5}
+	return fileDescriptor_1c8fbf1e7a765423, []int{7}
 }
 func (m *ProviderInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +671,7 @@ func (m *ProviderState) Reset()         { *m = ProviderState{} }
 func (m *ProviderState) String() string { return proto.CompactTextString(m) }
 func (*ProviderState) ProtoMessage()    {}
 func (*ProviderState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{6}
+	return fileDescriptor_1c8fbf1e7a765423, []int{8}
 }
 func (m *ProviderState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +723,7 @@ func (m *ProviderPriceState) Reset()         { *m = ProviderPriceState{} }
 func (m *ProviderPriceState) String() string { return proto.CompactTextString(m) }
 func (*ProviderPriceState) ProtoMessage()    {}
 func (*ProviderPriceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{7}
+	return fileDescriptor_1c8fbf1e7a765423, []int{9}
 }
 func (m *ProviderPriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,7 +775,7 @@ func (m *PriceFeedInfo) Reset()         { *m = PriceFeedInfo{} }
 func (m *PriceFeedInfo) String() string { return proto.CompactTextString(m) }
 func (*PriceFeedInfo) ProtoMessage()    {}
 func (*PriceFeedInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{8}
+	return fileDescriptor_1c8fbf1e7a765423, []int{10}
 }
 func (m *PriceFeedInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +826,7 @@ func (m *PriceFeedPrice) Reset()         { *m = PriceFeedPrice{} }
 func (m *PriceFeedPrice) String() string { return proto.CompactTextString(m) }
 func (*PriceFeedPrice) ProtoMessage()    {}
 func (*PriceFeedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{9}
+	return fileDescriptor_1c8fbf1e7a765423, []int{11}
 }
 func (m *PriceFeedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +872,7 @@ func (m *CoinbasePriceState) Reset()         { *m = CoinbasePriceState{} }
 func (m *CoinbasePriceState) String() string { return proto.CompactTextString(m) }
 func (*CoinbasePriceState) ProtoMessage()    {}
 func (*CoinbasePriceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{10}
+	return fileDescriptor_1c8fbf1e7a765423, []int{12}
 }
 func (m *CoinbasePriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +946,7 @@ func (m *PriceState) Reset()         { *m = PriceState{} }
 func (m *PriceState) String() string { return proto.CompactTextString(m) }
 func (*PriceState) ProtoMessage()    {}
 func (*PriceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{11}
+	return fileDescriptor_1c8fbf1e7a765423, []int{13}
 }
 func (m *PriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +995,7 @@ func (m *PythPriceState) Reset()         { *m = PythPriceState{} }
 func (m *PythPriceState) String() string { return proto.CompactTextString(m) }
 func (*PythPriceState) ProtoMessage()    {}
 func (*PythPriceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{12}
+	return fileDescriptor_1c8fbf1e7a765423, []int{14}
 }
 func (m *PythPriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,7 +1076,7 @@ func (m *BandOracleRequest) Reset()         { *m = BandOracleRequest{} }
 func (m *BandOracleRequest) String() string { return proto.CompactTextString(m) }
 func (*BandOracleRequest) ProtoMessage()    {}
 func (*BandOracleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{13}
+	return fileDescriptor_1c8fbf1e7a765423, []int{15}
 }
 func (m *BandOracleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,7 +1179,7 @@ type BandIBCParams struct {
 	IbcVersion string `protobuf:"bytes,4,opt,name=ibc_version,json=ibcVersion,proto3" json:"ibc_version,omitempty"`
 	// band IBC portID
 	IbcPortId string `protobuf:"bytes,5,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
-	// legacy oracle scheme ids
+	//  legacy oracle scheme ids
 	LegacyOracleIds []int64 `protobuf:"varint,6,rep,packed,name=legacy_oracle_ids,json=legacyOracleIds,proto3" json:"legacy_oracle_ids,omitempty"`
 }
 
@@ -953,7 +1187,7 @@ func (m *BandIBCParams) Reset()         { *m = BandIBCParams{} }
 func (m *BandIBCParams) String() string { return proto.CompactTextString(m) }
 func (*BandIBCParams) ProtoMessage()    {}
 func (*BandIBCParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{14}
+	return fileDescriptor_1c8fbf1e7a765423, []int{16}
 }
 func (m *BandIBCParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1268,7 @@ func (m *SymbolPriceTimestamp) Reset()         { *m = SymbolPriceTimestamp{} }
 func (m *SymbolPriceTimestamp) String() string { return proto.CompactTextString(m) }
 func (*SymbolPriceTimestamp) ProtoMessage()    {}
 func (*SymbolPriceTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{15}
+	return fileDescriptor_1c8fbf1e7a765423, []int{17}
 }
 func (m *SymbolPriceTimestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1092,7 +1326,7 @@ func (m *LastPriceTimestamps) Reset()         { *m = LastPriceTimestamps{} }
 func (m *LastPriceTimestamps) String() string { return proto.CompactTextString(m) }
 func (*LastPriceTimestamps) ProtoMessage()    {}
 func (*LastPriceTimestamps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{16}
+	return fileDescriptor_1c8fbf1e7a765423, []int{18}
 }
 func (m *LastPriceTimestamps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1372,7 @@ func (m *PriceRecords) Reset()         { *m = PriceRecords{} }
 func (m *PriceRecords) String() string { return proto.CompactTextString(m) }
 func (*PriceRecords) ProtoMessage()    {}
 func (*PriceRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{17}
+	return fileDescriptor_1c8fbf1e7a765423, []int{19}
 }
 func (m *PriceRecords) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,7 +1431,7 @@ func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
 func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
 func (*PriceRecord) ProtoMessage()    {}
 func (*PriceRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{18}
+	return fileDescriptor_1c8fbf1e7a765423, []int{20}
 }
 func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1499,7 @@ func (m *MetadataStatistics) Reset()         { *m = MetadataStatistics{} }
 func (m *MetadataStatistics) String() string { return proto.CompactTextString(m) }
 func (*MetadataStatistics) ProtoMessage()    {}
 func (*MetadataStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{19}
+	return fileDescriptor_1c8fbf1e7a765423, []int{21}
 }
 func (m *MetadataStatistics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1572,7 @@ func (m *PriceAttestation) Reset()         { *m = PriceAttestation{} }
 func (m *PriceAttestation) String() string { return proto.CompactTextString(m) }
 func (*PriceAttestation) ProtoMessage()    {}
 func (*PriceAttestation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{20}
+	return fileDescriptor_1c8fbf1e7a765423, []int{22}
 }
 func (m *PriceAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,686 +1657,859 @@ func (m *PriceAttestation) GetPublishTime() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
-	golang_proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
-	proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
-	golang_proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
-	proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
-	golang_proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
-	proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
-	golang_proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
-	proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
-	golang_proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
-	proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
-	golang_proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
-	proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
-	golang_proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
-	proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
-	golang_proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
-	proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
-	golang_proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
-	proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
-	golang_proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
-	proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
-	golang_proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
-	proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
-	golang_proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
-	proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
-	golang_proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
-	proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
-	golang_proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
-	proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
-	golang_proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
-	proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
-	golang_proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
-	proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
-	golang_proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
-	proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
-	golang_proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
-	proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
-	golang_proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
-	proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
-	golang_proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
-	proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
-	golang_proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
-	proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
-	golang_proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
+// RelayerNonce is the last nonce accepted from an oracle relayer.
+type RelayerNonce struct {
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+func (m *RelayerNonce) Reset()         { *m = RelayerNonce{} }
+func (m *RelayerNonce) String() string { return proto.CompactTextString(m) }
+func (*RelayerNonce) ProtoMessage()    {}
+func (*RelayerNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{23}
 }
-func init() {
-	golang_proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+func (m *RelayerNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerNonce.Merge(m, src)
+}
+func (m *RelayerNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerNonce.DiscardUnknown(m)
 }
 
-var fileDescriptor_1c8fbf1e7a765423 = []byte{
-	// 1620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x73, 0x1b, 0x4b,
-	0x11, 0xf7, 0xea, 0xff, 0xb6, 0x2c, 0x79, 0x33, 0xf6, 0x03, 0xc5, 0x80, 0x6c, 0x04, 0x79, 0xa8,
-	0x5e, 0xbd, 0x27, 0xbd, 0x38, 0x27, 0x52, 0x5c, 0x62, 0x3b, 0xa1, 0x54, 0x31, 0x85, 0x59, 0x3b,
-	0xa1, 0xe0, 0x22, 0x66, 0x77, 0x47, 0xd6, 0xc4, 0xfb, 0x2f, 0x3b, 0x2b, 0xc7, 0xca, 0x07, 0xc8,
-	0x15, 0xbe, 0x00, 0x05, 0xe7, 0x7c, 0x05, 0xaa, 0x28, 0x8a, 0x53, 0xaa, 0xb8, 0xe4, 0x48, 0x71,
-	0x08, 0x10, 0x5f, 0xf8, 0x06, 0x1c, 0xb8, 0x50, 0x3d, 0x33, 0x5a, 0xad, 0x6d, 0x9c, 0xf8, 0x4f,
-	0x38, 0x69, 0xa6, 0xa7, 0xfb, 0x37, 0xfd, 0x6f, 0xba, 0x7b, 0x05, 0x77, 0x78, 0xf8, 0x8c, 0xb9,
-	0x29, 0x3f, 0x62, 0xfd, 0x28, 0xa1, 0xae, 0xcf, 0xfa, 0x47, 0x77, 0x1d, 0x96, 0xd2, 0xbb, 0x7a,
-	0xdb, 0x8b, 0x93, 0x28, 0x8d, 0x48, 0x2b, 0x63, 0xeb, 0x69, 0xba, 0x66, 0x5b, 0x5d, 0x39, 0x88,
-	0x0e, 0x22, 0xc9, 0xd4, 0xc7, 0x95, 0xe2, 0x5f, 0x6d, 0xbb, 0x91, 0x08, 0x22, 0xd1, 0x77, 0xa8,
-	0x98, 0x23, 0xba, 0x11, 0x0f, 0xd5, 0x79, 0xe7, 0x1e, 0x54, 0x76, 0x69, 0x42, 0x03, 0x41, 0xbe,
-	0x07, 0x8d, 0x78, 0x9a, 0x8e, 0x87, 0x6e, 0x14, 0xa6, 0x09, 0x75, 0xd3, 0x96, 0xb1, 0x6e, 0x74,
-	0x4d, 0x7b, 0x11, 0x89, 0x5b, 0x9a, 0x76, 0xbf, 0xf4, 0xaf, 0xdf, 0xaf, 0x19, 0x9d, 0x43, 0x80,
-	0x9f, 0xca, 0xcb, 0x07, 0xe1, 0x28, 0x22, 0xdf, 0x80, 0x8a, 0x98, 0x06, 0x4e, 0xe4, 0x6b, 0x09,
-	0xbd, 0x23, 0x0f, 0xa1, 0xae, 0x54, 0x1c, 0xa6, 0xd3, 0x98, 0xb5, 0x0a, 0xeb, 0x46, 0xb7, 0xb9,
-	0xf1, 0xfd, 0xde, 0x45, 0x06, 0xf4, 0x14, 0xe4, 0xfe, 0x34, 0x66, 0x36, 0x44, 0xd9, 0xba, 0xf3,
-	0x4f, 0x03, 0x96, 0xb7, 0xc6, 0x94, 0x87, 0x3e, 0x0f, 0x0f, 0x77, 0x13, 0xee, 0xb2, 0xbd, 0x94,
-	0xa6, 0x8c, 0x7c, 0x13, 0xaa, 0x23, 0xc6, 0xbc, 0x21, 0xf7, 0x66, 0xf7, 0xe2, 0x76, 0xe0, 0x91,
-	0x47, 0x50, 0xa1, 0xa1, 0x78, 0xc1, 0x12, 0x79, 0xa5, 0xb9, 0xd9, 0x7b, 0xf3, 0x6e, 0x6d, 0xe1,
-	0x6f, 0xef, 0xd6, 0x3e, 0x3f, 0xe0, 0xe9, 0x78, 0xe2, 0xf4, 0xdc, 0x28, 0xe8, 0x6b, 0xaf, 0xa8,
-	0x9f, 0xaf, 0x84, 0x77, 0xd8, 0x47, 0x1d, 0x45, 0x6f, 0x9b, 0xb9, 0xb6, 0x96, 0x26, 0xdf, 0x06,
-	0x33, 0xe5, 0x01, 0x13, 0x29, 0x0d, 0xe2, 0x56, 0x71, 0xdd, 0xe8, 0x96, 0xec, 0x39, 0x81, 0x3c,
-	0x86, 0x7a, 0x8c, 0xca, 0x0c, 0x05, 0x6a, 0xd3, 0x2a, 0xad, 0x1b, 0xdd, 0xfa, 0x87, 0xac, 0x9b,
-	0x6b, 0xbe, 0x59, 0x42, 0x85, 0x6c, 0x88, 0x33, 0x4a, 0xe7, 0x3f, 0x06, 0x34, 0x37, 0x69, 0xe8,
-	0xe5, 0xcc, 0xbb, 0xc8, 0xab, 0x9b, 0x50, 0x4a, 0xf0, 0xc2, 0xab, 0xdb, 0x36, 0x08, 0x53, 0x5b,
-	0xca, 0x92, 0xef, 0xc2, 0x62, 0xc2, 0x44, 0xe4, 0x1f, 0xb1, 0x21, 0x1a, 0xa4, 0x8d, 0xab, 0x6b,
-	0xda, 0x3e, 0x0f, 0x18, 0xf9, 0x0e, 0x40, 0xc2, 0x9e, 0x4f, 0x98, 0x48, 0x87, 0x83, 0x6d, 0x69,
-	0x5d, 0xc9, 0x36, 0x35, 0x65, 0xb0, 0x7d, 0xd6, 0xfa, 0xf2, 0x8d, 0xac, 0xff, 0xad, 0x01, 0x4d,
-	0xc9, 0xf0, 0x88, 0x31, 0x4f, 0x59, 0x4f, 0xa0, 0x84, 0x19, 0xab, 0x6d, 0x97, 0x6b, 0xb2, 0x02,
-	0xe5, 0xe7, 0x93, 0x68, 0x66, 0xba, 0xad, 0x36, 0x98, 0x65, 0x79, 0x4d, 0x8a, 0x97, 0xd7, 0x24,
-	0xaf, 0x03, 0x59, 0x85, 0x5a, 0xc2, 0x7c, 0x3a, 0x65, 0x89, 0x68, 0x95, 0xd6, 0x8b, 0x5d, 0xd3,
-	0xce, 0xf6, 0x9d, 0x47, 0xb0, 0xb8, 0x9b, 0x44, 0x47, 0xdc, 0x63, 0x89, 0x4c, 0xf8, 0x55, 0xa8,
-	0xc5, 0x7a, 0xaf, 0x15, 0xcc, 0xf6, 0xa7, 0x70, 0x0a, 0x67, 0x70, 0xfe, 0x68, 0x40, 0x63, 0x06,
-	0xa4, 0x6e, 0x7d, 0x0c, 0x8d, 0x99, 0xe4, 0x90, 0x87, 0xa3, 0x48, 0xc2, 0xd5, 0x37, 0x3e, 0xff,
-	0x90, 0xfa, 0x73, 0x45, 0xec, 0xc5, 0x38, 0xaf, 0xd6, 0xaf, 0xe0, 0xb3, 0x0c, 0x2c, 0xe7, 0x12,
-	0xa5, 0x47, 0x7d, 0xe3, 0xcb, 0x8f, 0x83, 0xe6, 0x7c, 0xb3, 0x1c, 0x9f, 0xa3, 0x89, 0xce, 0x18,
-	0xc8, 0x79, 0xd6, 0x0b, 0x33, 0xf5, 0x3e, 0x94, 0x55, 0x4c, 0x0a, 0x57, 0x88, 0x89, 0x12, 0xe9,
-	0xfc, 0x10, 0x3d, 0xa5, 0x33, 0x42, 0x1a, 0x77, 0xe9, 0x84, 0xe8, 0x3c, 0xcd, 0x25, 0x93, 0x5c,
-	0x90, 0x6d, 0x28, 0x4b, 0x7f, 0x28, 0xe1, 0x2b, 0xd7, 0x03, 0x25, 0xdc, 0xf9, 0x83, 0x01, 0x64,
-	0x2b, 0xe2, 0x21, 0x5e, 0x9d, 0xb3, 0x9e, 0x40, 0xe9, 0x90, 0x87, 0xb3, 0x1a, 0x24, 0xd7, 0xa7,
-	0x2b, 0x47, 0xe1, 0x6c, 0xe5, 0xb0, 0xa0, 0x78, 0xc8, 0xa6, 0x32, 0x53, 0x4d, 0x1b, 0x97, 0x68,
-	0xc8, 0x11, 0xf5, 0x27, 0x4c, 0xbf, 0x33, 0xb5, 0xf9, 0xb4, 0x6f, 0xec, 0x2f, 0x06, 0x40, 0x4e,
-	0xeb, 0x4f, 0xe2, 0x12, 0xf2, 0x0b, 0xb0, 0xdc, 0x49, 0x30, 0xf1, 0x29, 0xaa, 0xa3, 0x72, 0xee,
-	0x9a, 0x35, 0x77, 0x69, 0x8e, 0xa3, 0x62, 0x76, 0xae, 0xf8, 0x16, 0x73, 0x2e, 0xec, 0xfc, 0xbb,
-	0x00, 0xcd, 0xdd, 0x69, 0x3a, 0xce, 0x59, 0x74, 0x1b, 0x1f, 0x25, 0x7a, 0x2b, 0xeb, 0x07, 0x55,
-	0xb9, 0x1f, 0x78, 0xe4, 0x31, 0x98, 0x2c, 0xa0, 0x37, 0xd2, 0xaf, 0xc6, 0x02, 0xaa, 0x14, 0x1b,
-	0x00, 0xae, 0xb1, 0x4b, 0x8e, 0x54, 0x08, 0xaf, 0x8c, 0x55, 0x65, 0x01, 0xdd, 0x8a, 0xc2, 0x11,
-	0x96, 0x72, 0x09, 0x53, 0xba, 0x16, 0x8c, 0x94, 0xc5, 0x52, 0x1e, 0x4f, 0x1c, 0x9f, 0x8b, 0xb1,
-	0x2a, 0xe5, 0x65, 0x55, 0xca, 0x35, 0x4d, 0x96, 0xf2, 0x33, 0x79, 0x54, 0xb9, 0x51, 0x1e, 0xbd,
-	0x2a, 0xc2, 0x2d, 0xec, 0x54, 0xaa, 0x59, 0xdb, 0xaa, 0x21, 0xe4, 0xbb, 0x85, 0x76, 0x7f, 0xae,
-	0x5b, 0x78, 0xa4, 0x0b, 0x96, 0x9e, 0x04, 0x84, 0x9b, 0xf0, 0x58, 0x32, 0x15, 0x64, 0x4c, 0x9b,
-	0x8a, 0xbe, 0x27, 0xc9, 0x03, 0x8f, 0xb4, 0xa0, 0xaa, 0xaa, 0x87, 0x68, 0x15, 0x65, 0xf5, 0x9c,
-	0x6d, 0xc9, 0xb7, 0xc0, 0xa4, 0xe2, 0x70, 0xe8, 0x46, 0x93, 0x30, 0xd5, 0xef, 0xa4, 0x46, 0xc5,
-	0xe1, 0x16, 0xee, 0xf1, 0x30, 0xe0, 0xa1, 0x3e, 0x54, 0x2e, 0xa8, 0x05, 0x3c, 0x54, 0x87, 0x63,
-	0x30, 0x47, 0x8c, 0x0d, 0x7d, 0x1e, 0xf0, 0xb4, 0x55, 0x91, 0xb5, 0xf0, 0x76, 0x4f, 0xb9, 0xb4,
-	0x87, 0x8f, 0x39, 0x33, 0x1c, 0x5f, 0xf7, 0xe6, 0xd7, 0x68, 0xf2, 0xeb, 0xbf, 0xaf, 0x75, 0x2f,
-	0x11, 0x06, 0x14, 0x10, 0x76, 0x6d, 0xc4, 0xd8, 0x0e, 0x82, 0x93, 0x35, 0xf4, 0x34, 0x8b, 0x69,
-	0xc2, 0x86, 0x07, 0x54, 0xb4, 0xaa, 0x52, 0x11, 0xd0, 0xa4, 0x1f, 0x53, 0x81, 0x0c, 0xec, 0x98,
-	0xb9, 0x93, 0x54, 0x31, 0xd4, 0x14, 0x83, 0x26, 0x21, 0x43, 0x17, 0x2c, 0x34, 0x44, 0x44, 0x93,
-	0xc4, 0x65, 0xda, 0x1e, 0x53, 0x72, 0x35, 0x03, 0x1e, 0xee, 0x49, 0xb2, 0xb4, 0xaa, 0xf3, 0xaa,
-	0x00, 0x0d, 0x0c, 0xc4, 0x60, 0x73, 0x4b, 0x0f, 0x70, 0x5d, 0xb0, 0x1c, 0x1a, 0x7a, 0x43, 0xee,
-	0xb8, 0x43, 0x16, 0x52, 0xc7, 0x67, 0x2a, 0x14, 0x35, 0xbb, 0x89, 0xf4, 0x81, 0xe3, 0x3e, 0x54,
-	0x54, 0xf2, 0x35, 0xac, 0x20, 0x53, 0x16, 0xb2, 0x30, 0x65, 0xc9, 0x11, 0xf5, 0x75, 0x4c, 0x08,
-	0x77, 0x5c, 0x1d, 0xd8, 0x81, 0x3e, 0x21, 0x5f, 0x02, 0x52, 0x33, 0xbd, 0xc6, 0x34, 0x0c, 0x99,
-	0xaf, 0x4b, 0x98, 0xc5, 0x1d, 0x57, 0x6b, 0xa6, 0xe8, 0x68, 0x26, 0x72, 0x1f, 0xb1, 0x44, 0xf0,
-	0x28, 0x54, 0xf9, 0x6d, 0x03, 0x77, 0xdc, 0xa7, 0x8a, 0x42, 0xda, 0x8a, 0x21, 0x8e, 0x12, 0x99,
-	0x0b, 0x65, 0xc9, 0x60, 0x72, 0xc7, 0xdd, 0x8d, 0x12, 0x4c, 0x83, 0x2f, 0xe0, 0x96, 0xcf, 0x0e,
-	0xa8, 0x3b, 0x1d, 0xea, 0xbc, 0xe1, 0x9e, 0x90, 0xa1, 0x2b, 0xda, 0x4b, 0xea, 0x40, 0xcf, 0x9f,
-	0x9e, 0xe8, 0xfc, 0xda, 0x80, 0x95, 0x3d, 0x99, 0x24, 0x32, 0x71, 0xf7, 0xb3, 0x3a, 0xfb, 0x23,
-	0xa8, 0x28, 0x69, 0xe9, 0x85, 0xcb, 0x8e, 0x9e, 0x5a, 0x06, 0x53, 0x4a, 0xa5, 0xde, 0x2c, 0x59,
-	0x4d, 0xbb, 0xa6, 0x08, 0x03, 0xef, 0x23, 0xd5, 0x69, 0x0a, 0xcb, 0x3b, 0x54, 0xa4, 0xa7, 0xd5,
-	0x11, 0xc4, 0x81, 0xcf, 0x7c, 0x2a, 0x52, 0xdd, 0x9b, 0x33, 0x76, 0xd1, 0x32, 0x64, 0x4e, 0xf6,
-	0x2e, 0x56, 0xef, 0x7f, 0x99, 0x67, 0x2f, 0xfb, 0xe7, 0xef, 0xe8, 0xfc, 0xd9, 0xc0, 0x59, 0x85,
-	0xbb, 0xcc, 0x66, 0x6e, 0x94, 0x78, 0xe2, 0xff, 0xe9, 0x84, 0x9f, 0xc3, 0x8a, 0x8f, 0x63, 0xc1,
-	0xcc, 0xa2, 0x44, 0x5d, 0x29, 0x1f, 0x6e, 0x7d, 0xe3, 0xce, 0x47, 0x0a, 0x8c, 0x52, 0xd0, 0x26,
-	0x0a, 0x22, 0xaf, 0x73, 0xe7, 0x39, 0xd4, 0x73, 0xfb, 0xd3, 0xce, 0x36, 0xce, 0x38, 0x7b, 0xde,
-	0xc9, 0x0a, 0x37, 0x69, 0xee, 0xaf, 0x4b, 0x40, 0x7e, 0xc2, 0x52, 0xea, 0xd1, 0x94, 0x62, 0xa1,
-	0xe3, 0x22, 0xe5, 0xae, 0x7c, 0xaf, 0x07, 0x49, 0x34, 0x89, 0xf5, 0x4b, 0xc4, 0xcb, 0x1b, 0x36,
-	0x48, 0x92, 0xaa, 0x2d, 0x3d, 0x58, 0xd6, 0x66, 0x0f, 0x05, 0x0d, 0x62, 0xac, 0x70, 0xfc, 0xa5,
-	0xd2, 0xa5, 0x61, 0xdf, 0xd2, 0x47, 0x7b, 0xf2, 0x64, 0x8f, 0xbf, 0x64, 0x58, 0xf2, 0x03, 0x46,
-	0xc3, 0x6b, 0x76, 0x0e, 0x29, 0x8b, 0x18, 0xe9, 0x0b, 0x1a, 0x5f, 0xb7, 0x6d, 0xa0, 0x2c, 0xf9,
-	0x01, 0x2c, 0x8d, 0x78, 0x22, 0xd2, 0x79, 0x1a, 0xca, 0x47, 0x58, 0xb4, 0x9b, 0x92, 0x3c, 0x7f,
-	0x44, 0x77, 0xa0, 0x29, 0x93, 0x76, 0xce, 0x57, 0x91, 0x7c, 0x0d, 0xa4, 0xee, 0xe7, 0xbe, 0x86,
-	0x64, 0x01, 0x56, 0x91, 0xa8, 0x5e, 0xaf, 0xc5, 0x06, 0x3c, 0x54, 0x2d, 0x16, 0xc1, 0xe8, 0xb1,
-	0x06, 0xab, 0x5d, 0x13, 0x8c, 0x1e, 0x2b, 0xb0, 0x9f, 0xc1, 0x62, 0xc0, 0x3c, 0x4e, 0x67, 0xca,
-	0x99, 0xd7, 0xc2, 0xab, 0x2b, 0x0c, 0x09, 0x89, 0x5f, 0xa4, 0x96, 0x5c, 0x3d, 0x48, 0x31, 0x77,
-	0x69, 0x8a, 0x25, 0xed, 0x03, 0xf3, 0xc7, 0x4a, 0x3e, 0x45, 0x8b, 0xb3, 0xe1, 0x89, 0xe8, 0xee,
-	0xaf, 0x3e, 0xbe, 0x54, 0x37, 0x27, 0x50, 0x62, 0xc7, 0x71, 0x24, 0x43, 0x5b, 0xb6, 0xe5, 0x1a,
-	0xdf, 0xe0, 0x7c, 0x7a, 0x51, 0x41, 0x9a, 0x4f, 0x23, 0xb7, 0x73, 0xd3, 0x48, 0x45, 0x02, 0x65,
-	0xd3, 0x85, 0x3e, 0x92, 0x78, 0x55, 0x89, 0x87, 0x47, 0x0f, 0x11, 0xf2, 0xec, 0xd0, 0x50, 0x93,
-	0xa8, 0xf9, 0xa1, 0xe1, 0x8b, 0xdf, 0x19, 0xb3, 0x6f, 0x7c, 0x2c, 0x08, 0x64, 0x09, 0xea, 0x4f,
-	0x42, 0x11, 0x33, 0x97, 0x8f, 0x38, 0xf3, 0xac, 0x05, 0x52, 0x83, 0x12, 0x76, 0x1f, 0xcb, 0x20,
-	0x0d, 0x30, 0xb3, 0x79, 0xdb, 0x2a, 0x90, 0x45, 0xa8, 0xcd, 0xa6, 0x64, 0xab, 0x88, 0x87, 0xd9,
-	0xb7, 0xbb, 0x55, 0x22, 0x26, 0x94, 0x6d, 0xfa, 0x32, 0x4a, 0xac, 0x32, 0xa9, 0x42, 0x71, 0x9b,
-	0x53, 0xab, 0x82, 0x48, 0x0f, 0x76, 0x07, 0xf7, 0xac, 0x2a, 0x92, 0x9e, 0x04, 0xd4, 0xaa, 0x21,
-	0x09, 0xa7, 0x3b, 0xcb, 0x24, 0x75, 0xa8, 0xea, 0x26, 0x67, 0x01, 0x42, 0xcf, 0x3e, 0x3f, 0xac,
-	0xfa, 0xe6, 0xb3, 0x37, 0xef, 0xdb, 0xc6, 0xdb, 0xf7, 0x6d, 0xe3, 0x1f, 0xef, 0xdb, 0xc6, 0x6f,
-	0x4e, 0xda, 0x0b, 0x7f, 0x3a, 0x69, 0x1b, 0x6f, 0x4f, 0xda, 0x0b, 0x7f, 0x3d, 0x69, 0x2f, 0xfc,
-	0x72, 0x27, 0x17, 0xd8, 0xc1, 0xac, 0x10, 0xed, 0x50, 0x47, 0xf4, 0xb3, 0xb2, 0xf4, 0x95, 0x1b,
-	0x25, 0x2c, 0xbf, 0x45, 0x45, 0xfb, 0x41, 0xe4, 0x4d, 0x7c, 0x26, 0x66, 0x7f, 0xc2, 0xc8, 0x14,
-	0x70, 0x2a, 0xf2, 0xcf, 0x92, 0x7b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xa3, 0x69, 0xd5,
-	0xa5, 0x11, 0x00, 0x00,
+var xxx_messageInfo_RelayerNonce proto.InternalMessageInfo
+
+func (m *RelayerNonce) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RelayerNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ReporterOutcome is the outcome of an oracle report of a validator at a
+// height.
+type ReporterOutcome struct {
+	Height  int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Outcome ReportOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=injective.oracle.v1beta1.ReportOutcome" json:"outcome,omitempty"`
+}
+
+func (m *ReporterOutcome) Reset()         { *m = ReporterOutcome{} }
+func (m *ReporterOutcome) String() string { return proto.CompactTextString(m) }
+func (*ReporterOutcome) ProtoMessage()    {}
+func (*ReporterOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{24}
+}
+func (m *ReporterOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReporterOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReporterOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PythContract != that1.PythContract {
-		return false
-	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ReporterOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterOutcome.Merge(m, src)
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReporterOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReporterOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterOutcome.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PythContract) > 0 {
-		i -= len(m.PythContract)
-		copy(dAtA[i:], m.PythContract)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PythContract)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ReporterOutcome proto.InternalMessageInfo
+
+func (m *ReporterOutcome) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *OracleInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReporterOutcome) GetOutcome() ReportOutcome {
+	if m != nil {
+		return m.Outcome
 	}
-	return dAtA[:n], nil
+	return ReportOutcome_ReportUnspecified
 }
 
-func (m *OracleInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReporterRecord holds the oracle report outcomes of a validator within the
+// reporter stats window.
+type ReporterRecord struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// last_report_height is the height of the last report of the validator, or
+	// of the last missed report, from which the next report is expected
+	LastReportHeight int64             `protobuf:"varint,2,opt,name=last_report_height,json=lastReportHeight,proto3" json:"last_report_height,omitempty"`
+	Outcomes         []ReporterOutcome `protobuf:"bytes,3,rep,name=outcomes,proto3" json:"outcomes"`
 }
 
-func (m *OracleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleType != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x10
+func (m *ReporterRecord) Reset()         { *m = ReporterRecord{} }
+func (m *ReporterRecord) String() string { return proto.CompactTextString(m) }
+func (*ReporterRecord) ProtoMessage()    {}
+func (*ReporterRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{25}
+}
+func (m *ReporterRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReporterRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReporterRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ReporterRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterRecord.Merge(m, src)
+}
+func (m *ReporterRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReporterRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterRecord proto.InternalMessageInfo
+
+func (m *ReporterRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ChainlinkPriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReporterRecord) GetLastReportHeight() int64 {
+	if m != nil {
+		return m.LastReportHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChainlinkPriceState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReporterRecord) GetOutcomes() []ReporterOutcome {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
 }
 
-func (m *ChainlinkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+// OracleReporterStats are the counts of oracle reports of a validator within
+// the reporter stats window.
+type OracleReporterStats struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	OnTime    uint64 `protobuf:"varint,2,opt,name=on_time,json=onTime,proto3" json:"on_time,omitempty"`
+	Late      uint64 `protobuf:"varint,3,opt,name=late,proto3" json:"late,omitempty"`
+	Missed    uint64 `protobuf:"varint,4,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *OracleReporterStats) Reset()         { *m = OracleReporterStats{} }
+func (m *OracleReporterStats) String() string { return proto.CompactTextString(m) }
+func (*OracleReporterStats) ProtoMessage()    {}
+func (*OracleReporterStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{26}
+}
+func (m *OracleReporterStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleReporterStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleReporterStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *OracleReporterStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleReporterStats.Merge(m, src)
+}
+func (m *OracleReporterStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleReporterStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleReporterStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleReporterStats proto.InternalMessageInfo
+
+func (m *OracleReporterStats) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	{
-		size := m.Answer.Size()
-		i -= size
-		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *OracleReporterStats) GetOnTime() uint64 {
+	if m != nil {
+		return m.OnTime
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *OracleReporterStats) GetLate() uint64 {
+	if m != nil {
+		return m.Late
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BandPriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OracleReporterStats) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BandPriceState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OraclePriceFeed struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// symbol is the base/quote pair of the price feeds, the provider/symbol pair
+	// of the provider prices and the price ID of the pyth prices
+	Symbol              string                                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	LastUpdateHeight    int64                                  `protobuf:"varint,4,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+	LastUpdateTimestamp int64                                  `protobuf:"varint,5,opt,name=last_update_timestamp,json=lastUpdateTimestamp,proto3" json:"last_update_timestamp,omitempty"`
+	Stale               bool                                   `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
-func (m *BandPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+func (m *OraclePriceFeed) Reset()         { *m = OraclePriceFeed{} }
+func (m *OraclePriceFeed) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceFeed) ProtoMessage()    {}
+func (*OraclePriceFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{27}
+}
+func (m *OraclePriceFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Request_ID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Request_ID))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *OraclePriceFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceFeed.Merge(m, src)
+}
+func (m *OraclePriceFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePriceFeed proto.InternalMessageInfo
+
+func (m *OraclePriceFeed) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
 	}
-	if m.ResolveTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
-		i--
-		dAtA[i] = 0x18
+	return OracleType_Unspecified
+}
+
+func (m *OraclePriceFeed) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *OraclePriceFeed) GetLastUpdateHeight() int64 {
+	if m != nil {
+		return m.LastUpdateHeight
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *OraclePriceFeed) GetLastUpdateTimestamp() int64 {
+	if m != nil {
+		return m.LastUpdateTimestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PriceFeedState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OraclePriceFeed) GetStale() bool {
+	if m != nil {
+		return m.Stale
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *PriceFeedState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ProviderPrice struct {
+	// provider is the adapter the price comes from, tagged with the name of the
+	// provider for the provider oracle
+	Provider  string                                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Timestamp int64                                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *PriceFeedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.PriceState != nil {
-		{
-			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ProviderPrice) Reset()         { *m = ProviderPrice{} }
+func (m *ProviderPrice) String() string { return proto.CompactTextString(m) }
+func (*ProviderPrice) ProtoMessage()    {}
+func (*ProviderPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{28}
 }
-
-func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *ProviderPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ProviderState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ProviderPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPrice.Merge(m, src)
 }
-
-func (m *ProviderState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPrice.DiscardUnknown(m)
 }
 
-func (m *ProviderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderPriceStates) > 0 {
-		for iNdEx := len(m.ProviderPriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProviderPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ProviderInfo != nil {
-		{
-			size, err := m.ProviderInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ProviderPrice proto.InternalMessageInfo
+
+func (m *ProviderPrice) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderPrice) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProviderPriceState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PriceMoveVote is a price submitted by a reporter which deviates from the last
+// accepted price of the symbol by more than the max price deviation
+type PriceMoveVote struct {
+	Symbol    string                                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Reporter  string                                 `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Timestamp int64                                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// block_height is the height of the block in which the vote was cast
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *ProviderPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
+func (m *PriceMoveVote) Reset()         { *m = PriceMoveVote{} }
+func (m *PriceMoveVote) String() string { return proto.CompactTextString(m) }
+func (*PriceMoveVote) ProtoMessage()    {}
+func (*PriceMoveVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{29}
+}
+func (m *PriceMoveVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMoveVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMoveVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PriceMoveVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMoveVote.Merge(m, src)
 }
-
-func (m *PriceFeedInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceMoveVote) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *PriceFeedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PriceMoveVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMoveVote.DiscardUnknown(m)
 }
 
-func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PriceMoveVote proto.InternalMessageInfo
+
+func (m *PriceMoveVote) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PriceFeedPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceMoveVote) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
 }
 
-func (m *PriceFeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+func (m *PriceMoveVote) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceMoveVote) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CoinbasePriceState) MarshalTo(dAtA []byte) (int, error) {
+func init() {
+	proto.RegisterEnum("injective.oracle.v1beta1.AggregationPolicy", AggregationPolicy_name, AggregationPolicy_value)
+	golang_proto.RegisterEnum("injective.oracle.v1beta1.AggregationPolicy", AggregationPolicy_name, AggregationPolicy_value)
+	proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
+	golang_proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
+	proto.RegisterEnum("injective.oracle.v1beta1.ReportOutcome", ReportOutcome_name, ReportOutcome_value)
+	golang_proto.RegisterEnum("injective.oracle.v1beta1.ReportOutcome", ReportOutcome_name, ReportOutcome_value)
+	proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
+	golang_proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
+	proto.RegisterType((*SymbolPriceDeviation)(nil), "injective.oracle.v1beta1.SymbolPriceDeviation")
+	golang_proto.RegisterType((*SymbolPriceDeviation)(nil), "injective.oracle.v1beta1.SymbolPriceDeviation")
+	proto.RegisterType((*SymbolAggregation)(nil), "injective.oracle.v1beta1.SymbolAggregation")
+	golang_proto.RegisterType((*SymbolAggregation)(nil), "injective.oracle.v1beta1.SymbolAggregation")
+	proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
+	golang_proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
+	proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
+	golang_proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
+	proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
+	golang_proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
+	proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
+	golang_proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
+	proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
+	golang_proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
+	proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
+	golang_proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
+	proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
+	golang_proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
+	proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
+	golang_proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
+	proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
+	golang_proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
+	proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
+	golang_proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
+	proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
+	golang_proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
+	proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
+	golang_proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
+	proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
+	golang_proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
+	proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
+	golang_proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
+	proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
+	golang_proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
+	proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
+	golang_proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
+	proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
+	golang_proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
+	proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
+	golang_proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
+	proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
+	golang_proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
+	proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
+	golang_proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
+	proto.RegisterType((*RelayerNonce)(nil), "injective.oracle.v1beta1.RelayerNonce")
+	golang_proto.RegisterType((*RelayerNonce)(nil), "injective.oracle.v1beta1.RelayerNonce")
+	proto.RegisterType((*ReporterOutcome)(nil), "injective.oracle.v1beta1.ReporterOutcome")
+	golang_proto.RegisterType((*ReporterOutcome)(nil), "injective.oracle.v1beta1.ReporterOutcome")
+	proto.RegisterType((*ReporterRecord)(nil), "injective.oracle.v1beta1.ReporterRecord")
+	golang_proto.RegisterType((*ReporterRecord)(nil), "injective.oracle.v1beta1.ReporterRecord")
+	proto.RegisterType((*OracleReporterStats)(nil), "injective.oracle.v1beta1.OracleReporterStats")
+	golang_proto.RegisterType((*OracleReporterStats)(nil), "injective.oracle.v1beta1.OracleReporterStats")
+	proto.RegisterType((*OraclePriceFeed)(nil), "injective.oracle.v1beta1.OraclePriceFeed")
+	golang_proto.RegisterType((*OraclePriceFeed)(nil), "injective.oracle.v1beta1.OraclePriceFeed")
+	proto.RegisterType((*ProviderPrice)(nil), "injective.oracle.v1beta1.ProviderPrice")
+	golang_proto.RegisterType((*ProviderPrice)(nil), "injective.oracle.v1beta1.ProviderPrice")
+	proto.RegisterType((*PriceMoveVote)(nil), "injective.oracle.v1beta1.PriceMoveVote")
+	golang_proto.RegisterType((*PriceMoveVote)(nil), "injective.oracle.v1beta1.PriceMoveVote")
+}
+
+func init() {
+	proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+}
+func init() {
+	golang_proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+}
+
+var fileDescriptor_1c8fbf1e7a765423 = []byte{
+	// 2195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x19, 0x4d, 0x73, 0x1c, 0x47,
+	0xd5, 0xb3, 0xbb, 0xda, 0x8f, 0xb7, 0xd2, 0x6a, 0xd4, 0x92, 0xe2, 0xb5, 0x01, 0xd9, 0x19, 0x48,
+	0x22, 0x9c, 0x64, 0x95, 0x28, 0x27, 0x5c, 0x14, 0x55, 0x96, 0x64, 0xc3, 0x96, 0x6d, 0x2c, 0x46,
+	0xb6, 0x53, 0xe4, 0xc0, 0xd0, 0x3b, 0xd3, 0xd2, 0x76, 0x34, 0x33, 0x3d, 0x9e, 0x9e, 0x95, 0x25,
+	0xff, 0x80, 0x5c, 0xc3, 0x81, 0x1b, 0x45, 0xc1, 0x95, 0x1c, 0xf8, 0x03, 0x54, 0x51, 0x14, 0xa7,
+	0x14, 0x5c, 0x7c, 0xa4, 0x38, 0x04, 0xb0, 0x2f, 0xfc, 0x03, 0x0e, 0x5c, 0xa8, 0xd7, 0xdd, 0x33,
+	0x3b, 0xbb, 0x8a, 0x24, 0x5b, 0x36, 0xa7, 0x9d, 0x7e, 0x5f, 0xfd, 0xbe, 0xfa, 0xf5, 0x7b, 0xbd,
+	0xf0, 0x16, 0x8f, 0x3f, 0x65, 0x7e, 0xc6, 0x0f, 0xd8, 0x9a, 0x48, 0xa9, 0x1f, 0xb2, 0xb5, 0x83,
+	0x0f, 0x07, 0x2c, 0xa3, 0x1f, 0x9a, 0x65, 0x2f, 0x49, 0x45, 0x26, 0x48, 0xb7, 0x20, 0xeb, 0x19,
+	0xb8, 0x21, 0xbb, 0xbc, 0xb4, 0x27, 0xf6, 0x84, 0x22, 0x5a, 0xc3, 0x2f, 0x4d, 0x7f, 0x79, 0xc5,
+	0x17, 0x32, 0x12, 0x72, 0x6d, 0x40, 0xe5, 0x58, 0xa2, 0x2f, 0x78, 0xac, 0xf1, 0xce, 0xd3, 0x2a,
+	0xd4, 0xb7, 0x69, 0x4a, 0x23, 0x49, 0xbe, 0x0d, 0x73, 0xc9, 0x51, 0x36, 0xf4, 0x7c, 0x11, 0x67,
+	0x29, 0xf5, 0xb3, 0xae, 0x75, 0xd5, 0x5a, 0x6d, 0xb9, 0xb3, 0x08, 0xdc, 0x34, 0x30, 0xb2, 0x0e,
+	0xcb, 0x29, 0x4b, 0x44, 0x9a, 0xb1, 0xd4, 0x93, 0x19, 0xcd, 0xa4, 0xf7, 0x98, 0xc7, 0x81, 0x78,
+	0xdc, 0xad, 0x5c, 0xb5, 0x56, 0xab, 0xee, 0x62, 0x8e, 0xdc, 0x41, 0xdc, 0xc7, 0x0a, 0x45, 0xde,
+	0x85, 0x85, 0x82, 0x87, 0xc7, 0x19, 0x4b, 0x0f, 0x68, 0xd8, 0xad, 0x2a, 0x7a, 0x3b, 0x47, 0xf4,
+	0x0d, 0x9c, 0xbc, 0x07, 0x24, 0xa2, 0x87, 0x5e, 0xc4, 0xa5, 0x64, 0x81, 0xa7, 0xd1, 0xb2, 0x5b,
+	0xbb, 0x6a, 0xad, 0xd6, 0x5c, 0x3b, 0xa2, 0x87, 0x77, 0x15, 0xc2, 0xd5, 0x70, 0x72, 0x1d, 0x2e,
+	0x25, 0x29, 0xf7, 0x19, 0xea, 0x12, 0xb2, 0x98, 0x49, 0xe9, 0x65, 0xc3, 0x94, 0xc9, 0xa1, 0x08,
+	0x83, 0xee, 0x8c, 0xda, 0xe2, 0xa2, 0x22, 0xd8, 0xc9, 0xf1, 0xf7, 0x73, 0x34, 0x19, 0xc0, 0xa2,
+	0x3c, 0x8a, 0x06, 0x22, 0xf4, 0xe8, 0xde, 0x5e, 0xca, 0xf6, 0x68, 0xc6, 0x45, 0x2c, 0xbb, 0xf5,
+	0xab, 0xd5, 0xd5, 0xf6, 0xfa, 0xbb, 0xbd, 0x93, 0x1c, 0xdd, 0xdb, 0x51, 0x4c, 0x37, 0xc6, 0x3c,
+	0x1b, 0xb5, 0x2f, 0xbf, 0xba, 0x72, 0xc1, 0x25, 0x72, 0x1a, 0x21, 0x49, 0x08, 0x17, 0xcd, 0x1e,
+	0x5a, 0xcd, 0x80, 0x1d, 0x70, 0xb3, 0x4f, 0x43, 0xed, 0xd3, 0x3b, 0x6b, 0x9f, 0x6d, 0xe4, 0xdb,
+	0xca, 0xd9, 0xcc, 0x56, 0xcb, 0xf2, 0x6b, 0x70, 0xf2, 0x7a, 0xed, 0xdf, 0xbf, 0xbd, 0x62, 0x39,
+	0xbf, 0xb4, 0x60, 0xe9, 0xeb, 0x78, 0xc9, 0x1b, 0x50, 0xd7, 0x7c, 0x26, 0xb2, 0x66, 0x45, 0x7e,
+	0x06, 0x8b, 0xe8, 0xf2, 0x29, 0x0d, 0x55, 0x44, 0x5b, 0x1b, 0x3d, 0xdc, 0xf0, 0xef, 0x5f, 0x5d,
+	0x79, 0x7b, 0x8f, 0x67, 0xc3, 0xd1, 0xa0, 0xe7, 0x8b, 0x68, 0xcd, 0xe4, 0x94, 0xfe, 0x79, 0x5f,
+	0x06, 0xfb, 0x6b, 0xd9, 0x51, 0xc2, 0x64, 0x6f, 0x8b, 0xf9, 0xee, 0x42, 0x44, 0x0f, 0x27, 0xf7,
+	0x35, 0x6a, 0xfd, 0xca, 0x82, 0x85, 0x63, 0xae, 0x3b, 0x51, 0xa7, 0x4d, 0xa8, 0x27, 0x22, 0xe4,
+	0xfe, 0x91, 0x52, 0xa3, 0x73, 0x5a, 0x3c, 0x4a, 0xe2, 0xb6, 0x15, 0x8b, 0x6b, 0x58, 0x31, 0xa3,
+	0x23, 0x1e, 0x7b, 0x49, 0x2a, 0x0e, 0x78, 0xc0, 0x52, 0xa9, 0x92, 0x6e, 0xce, 0x9d, 0x8d, 0x78,
+	0xbc, 0x9d, 0xc3, 0x8c, 0x76, 0xfb, 0x00, 0xf7, 0x94, 0xd8, 0x7e, 0xbc, 0x2b, 0x4e, 0xd4, 0xea,
+	0x26, 0xb4, 0xf5, 0xe6, 0x1e, 0x1a, 0x6c, 0x54, 0xfb, 0xce, 0xc9, 0xaa, 0x69, 0x91, 0xf7, 0x8f,
+	0x12, 0xe6, 0x82, 0x28, 0xbe, 0x9d, 0x7f, 0x59, 0xb0, 0xb8, 0x39, 0xa4, 0x3c, 0x0e, 0x79, 0xbc,
+	0xbf, 0x6d, 0xd2, 0x33, 0x63, 0xe4, 0x22, 0x34, 0x76, 0x19, 0x0b, 0x3c, 0x1e, 0xe4, 0xfb, 0xe2,
+	0xb2, 0x1f, 0x90, 0x5b, 0x50, 0xa7, 0xb1, 0x7c, 0xcc, 0xd2, 0x73, 0x06, 0xc5, 0x70, 0x93, 0x6f,
+	0x42, 0x2b, 0xe3, 0x11, 0x93, 0x19, 0x8d, 0x12, 0xe5, 0x8c, 0x9a, 0x3b, 0x06, 0x90, 0xdb, 0xd0,
+	0x2e, 0x0e, 0x53, 0xc6, 0xd4, 0x99, 0x6b, 0x9f, 0x66, 0xdd, 0x58, 0x73, 0x93, 0x96, 0x90, 0x14,
+	0x10, 0xe7, 0xbf, 0x16, 0x74, 0x36, 0x68, 0x1c, 0x94, 0xcc, 0x3b, 0xc9, 0xab, 0x1b, 0x50, 0x4b,
+	0x71, 0xc3, 0x97, 0xb7, 0xad, 0x1f, 0x67, 0xae, 0xe2, 0x25, 0x6f, 0xc2, 0x6c, 0xca, 0xa4, 0x08,
+	0x0f, 0x98, 0x87, 0x06, 0x19, 0xe3, 0xda, 0x06, 0x76, 0x9f, 0x47, 0x8c, 0x7c, 0x0b, 0x20, 0x65,
+	0x8f, 0x46, 0x4c, 0x66, 0x5e, 0x7f, 0xcb, 0x54, 0x94, 0x96, 0x81, 0xf4, 0xb7, 0xa6, 0xad, 0x9f,
+	0x79, 0x25, 0xeb, 0x7f, 0x6d, 0x41, 0x47, 0x11, 0xdc, 0x62, 0x2c, 0xd0, 0xd6, 0x13, 0xa8, 0x61,
+	0x11, 0x36, 0xb6, 0xab, 0x6f, 0xb2, 0x04, 0x33, 0x8f, 0x46, 0x22, 0x37, 0xdd, 0xd5, 0x0b, 0xcc,
+	0xb2, 0xb2, 0x26, 0xd5, 0x17, 0xd7, 0xa4, 0xac, 0x03, 0xb9, 0x0c, 0xcd, 0x94, 0x85, 0xf4, 0x08,
+	0x13, 0xbf, 0x76, 0xb5, 0xba, 0xda, 0x72, 0x8b, 0xb5, 0x73, 0x0b, 0x66, 0xf3, 0x13, 0xa0, 0x12,
+	0xfe, 0x32, 0x34, 0xf3, 0x53, 0x62, 0x14, 0x2c, 0xd6, 0x13, 0x72, 0x2a, 0x53, 0x72, 0xfe, 0x68,
+	0xc1, 0x5c, 0x2e, 0x48, 0xef, 0x7a, 0x1b, 0xe6, 0x72, 0x4e, 0x8f, 0xc7, 0xbb, 0x42, 0x89, 0x6b,
+	0xaf, 0xbf, 0x7d, 0x9a, 0xfa, 0x63, 0x45, 0xdc, 0xd9, 0xa4, 0xac, 0xd6, 0xcf, 0x61, 0xb9, 0x10,
+	0x56, 0x72, 0x89, 0xd6, 0xa3, 0xbd, 0xfe, 0xde, 0xd9, 0x42, 0x4b, 0xbe, 0x59, 0x4c, 0x8e, 0xc1,
+	0xa4, 0x33, 0x04, 0x72, 0x9c, 0xf4, 0xc4, 0x4c, 0xbd, 0x0e, 0x33, 0x3a, 0x26, 0x95, 0x97, 0x88,
+	0x89, 0x66, 0x71, 0xbe, 0x07, 0x73, 0x45, 0x46, 0x28, 0xe3, 0x5e, 0x38, 0x21, 0x9c, 0x87, 0xa5,
+	0x64, 0x52, 0x1f, 0x64, 0x0b, 0x66, 0x94, 0x3f, 0xba, 0xd6, 0x4b, 0x9f, 0x19, 0xac, 0x07, 0x9a,
+	0xd9, 0xf9, 0x83, 0x05, 0x64, 0x53, 0xf0, 0x18, 0xb7, 0x2e, 0x59, 0x4f, 0xa0, 0xb6, 0xcf, 0xe3,
+	0xbc, 0x06, 0xa9, 0xef, 0xc9, 0xca, 0x51, 0x99, 0xae, 0x1c, 0x36, 0x54, 0xf7, 0xd9, 0x91, 0xca,
+	0xd4, 0x96, 0x8b, 0x9f, 0x68, 0xc8, 0x01, 0x0d, 0x47, 0xcc, 0x9c, 0x33, 0xbd, 0x78, 0xbd, 0x67,
+	0xec, 0xaf, 0x16, 0x40, 0x49, 0xeb, 0xd7, 0xe2, 0x12, 0xf2, 0x53, 0xb0, 0xfd, 0x51, 0x34, 0x0a,
+	0x29, 0xaa, 0xa3, 0x73, 0xee, 0x9c, 0x35, 0x77, 0x7e, 0x2c, 0x47, 0xc7, 0xec, 0x58, 0xf1, 0xad,
+	0x96, 0x5c, 0xe8, 0xfc, 0xa7, 0x02, 0x9d, 0xed, 0xa3, 0x6c, 0x58, 0xb2, 0xe8, 0x12, 0x34, 0xb5,
+	0xb7, 0x8a, 0xfb, 0xa0, 0xa1, 0xd6, 0xfd, 0x80, 0xdc, 0x86, 0x16, 0x8b, 0xe8, 0x2b, 0xe9, 0xd7,
+	0x64, 0x11, 0xd5, 0x8a, 0xf5, 0x01, 0xbf, 0xb1, 0xef, 0xdb, 0xed, 0x56, 0xcf, 0x25, 0xab, 0xc1,
+	0x22, 0xba, 0x29, 0xe2, 0x5d, 0x2c, 0xe5, 0x4a, 0x4c, 0xed, 0x5c, 0x62, 0x14, 0x2f, 0x96, 0xf2,
+	0x64, 0x34, 0x08, 0xb9, 0x1c, 0xea, 0x52, 0x3e, 0xa3, 0x4b, 0xb9, 0x81, 0xa9, 0x52, 0x3e, 0x95,
+	0x47, 0xf5, 0x57, 0xca, 0xa3, 0xcf, 0xaa, 0xb0, 0x80, 0x37, 0x95, 0xbe, 0xac, 0x5d, 0x7d, 0x21,
+	0x94, 0x6f, 0x0b, 0xe3, 0xfe, 0xd2, 0x6d, 0x11, 0x90, 0x55, 0xb0, 0x4d, 0x27, 0x20, 0xfd, 0x94,
+	0x27, 0x8a, 0x48, 0xb7, 0xc0, 0x1d, 0x0d, 0xdf, 0x51, 0xe0, 0x7e, 0x40, 0xba, 0xd0, 0xd0, 0xd5,
+	0x03, 0xdb, 0x0f, 0xac, 0x9e, 0xf9, 0x92, 0x7c, 0x03, 0x5a, 0x54, 0xee, 0x7b, 0xbe, 0x18, 0xc5,
+	0x99, 0x39, 0x27, 0x4d, 0x2a, 0xf7, 0x37, 0x71, 0x8d, 0x48, 0xec, 0x5d, 0x34, 0x52, 0xbb, 0xa0,
+	0x19, 0xf1, 0x58, 0x23, 0x87, 0xd0, 0xda, 0x65, 0xcc, 0x0b, 0x79, 0xc4, 0x33, 0xd3, 0xb0, 0x5e,
+	0xea, 0x69, 0x97, 0xf6, 0xf0, 0x30, 0x17, 0x86, 0xe3, 0xe9, 0xde, 0xf8, 0x00, 0x4d, 0xfe, 0xe2,
+	0x1f, 0x57, 0x56, 0x5f, 0x20, 0x0c, 0xc8, 0x20, 0xdd, 0xe6, 0x2e, 0x63, 0x77, 0x50, 0x38, 0xb9,
+	0x82, 0x9e, 0x66, 0x09, 0x4d, 0x99, 0xb7, 0x47, 0xb1, 0x69, 0x45, 0x45, 0xc0, 0x80, 0x7e, 0x48,
+	0x25, 0x12, 0xb0, 0x43, 0xe6, 0x8f, 0x32, 0x4d, 0xd0, 0xd4, 0x04, 0x06, 0x84, 0x04, 0xab, 0x60,
+	0xa3, 0x21, 0x52, 0x8c, 0x52, 0x9f, 0x19, 0x7b, 0x5a, 0x8a, 0xaa, 0x13, 0xf1, 0x78, 0x47, 0x81,
+	0x95, 0x55, 0xce, 0x67, 0x15, 0x98, 0xc3, 0x40, 0xf4, 0x37, 0x36, 0xcd, 0x48, 0xb2, 0x0a, 0xf6,
+	0x80, 0xc6, 0x81, 0xc7, 0x07, 0xbe, 0xc7, 0x62, 0x3a, 0x08, 0x99, 0x0e, 0x45, 0xd3, 0xed, 0x20,
+	0xbc, 0x3f, 0xf0, 0x6f, 0x6a, 0x28, 0xf9, 0x00, 0x96, 0x90, 0xa8, 0x08, 0x59, 0x3e, 0x66, 0xe8,
+	0x98, 0x10, 0x3e, 0xf0, 0x4d, 0x60, 0xcb, 0x83, 0x06, 0x72, 0xe4, 0x7a, 0x0d, 0x69, 0x1c, 0xb3,
+	0xd0, 0x94, 0x30, 0x9b, 0x0f, 0x7c, 0xa3, 0x99, 0x86, 0xa3, 0x99, 0x48, 0x7d, 0xc0, 0x52, 0x89,
+	0xbd, 0xb1, 0xca, 0x6f, 0x17, 0xf8, 0xc0, 0x7f, 0xa8, 0x21, 0x64, 0x45, 0x13, 0xe0, 0x58, 0x82,
+	0xb9, 0x30, 0xa3, 0x08, 0x5a, 0x7c, 0xe0, 0x6f, 0x8b, 0x14, 0xd3, 0xe0, 0x1a, 0x2c, 0x84, 0x6c,
+	0x8f, 0xfa, 0x47, 0x9e, 0xc9, 0x1b, 0x1e, 0xe8, 0x59, 0xa3, 0xea, 0xce, 0x6b, 0x84, 0xe9, 0x3f,
+	0x03, 0xe9, 0x7c, 0x3e, 0xd9, 0xc1, 0xdf, 0x2f, 0xea, 0xec, 0xf7, 0xa1, 0xae, 0xb9, 0xbb, 0xd6,
+	0x4b, 0xb4, 0x9e, 0x86, 0x07, 0x53, 0xca, 0x0c, 0x23, 0x26, 0x59, 0x5b, 0x6e, 0x53, 0x03, 0xfa,
+	0xc1, 0x19, 0xd5, 0xe9, 0x08, 0x16, 0xef, 0x50, 0x99, 0x4d, 0xaa, 0x23, 0xc9, 0x00, 0x96, 0x43,
+	0x2a, 0x33, 0x73, 0x37, 0x17, 0xe4, 0xb2, 0x6b, 0xbd, 0xc4, 0x70, 0x53, 0xc8, 0x73, 0x17, 0xc3,
+	0xe3, 0x7b, 0x38, 0x7f, 0xb6, 0xb0, 0x57, 0xe1, 0x3e, 0x73, 0x99, 0x2f, 0xd2, 0x40, 0xfe, 0x3f,
+	0x9d, 0xf0, 0x31, 0x2c, 0x85, 0xd8, 0x16, 0xe4, 0x16, 0xa5, 0x7a, 0x4b, 0x75, 0x70, 0xdb, 0xeb,
+	0x6f, 0x9d, 0x51, 0x60, 0xb4, 0x82, 0x2e, 0xd1, 0x22, 0xca, 0x3a, 0x3b, 0x8f, 0xa0, 0x5d, 0x5a,
+	0x4f, 0x3a, 0xdb, 0x9a, 0x72, 0xf6, 0xf8, 0x26, 0xab, 0xbc, 0xca, 0xe5, 0xfe, 0x45, 0x0d, 0xc8,
+	0x5d, 0x96, 0xd1, 0x80, 0x66, 0x14, 0x0b, 0x1d, 0x97, 0x19, 0xf7, 0xd5, 0x79, 0xdd, 0x4b, 0xc5,
+	0x28, 0x31, 0x27, 0xd1, 0x52, 0x13, 0x11, 0x28, 0x90, 0xae, 0x2d, 0x3d, 0x58, 0x34, 0x66, 0x7b,
+	0x92, 0x46, 0x09, 0x56, 0x38, 0xfe, 0x44, 0xeb, 0x32, 0xe7, 0x2e, 0x18, 0xd4, 0x8e, 0xc2, 0xec,
+	0xf0, 0x27, 0x0c, 0x4b, 0x7e, 0xc4, 0x68, 0x7c, 0xce, 0x9b, 0x43, 0xf1, 0xa2, 0x8c, 0xec, 0x31,
+	0x4d, 0xce, 0x7b, 0x6d, 0x20, 0x2f, 0x79, 0x07, 0xe6, 0x77, 0x79, 0x2a, 0xb3, 0x71, 0x1a, 0x9a,
+	0x07, 0x80, 0x8e, 0x02, 0x8f, 0x0f, 0xd1, 0x5b, 0xd0, 0x09, 0xe9, 0x04, 0x5d, 0x5d, 0xd1, 0xcd,
+	0x85, 0xb4, 0x4c, 0x76, 0x5b, 0x17, 0x60, 0x1d, 0x89, 0xc6, 0xf9, 0xae, 0x58, 0x35, 0x68, 0xe2,
+	0x15, 0x8b, 0xc2, 0xf2, 0x11, 0xbb, 0xdb, 0x3c, 0xa7, 0x30, 0x33, 0x58, 0x93, 0x9f, 0xc0, 0x6c,
+	0xc4, 0x02, 0x4e, 0x73, 0xe5, 0x5a, 0xe7, 0x92, 0xd7, 0xd6, 0x32, 0x94, 0x48, 0x9c, 0x48, 0x6d,
+	0xf5, 0x75, 0x23, 0xc3, 0xdc, 0xd5, 0xb3, 0xf9, 0x29, 0xfd, 0xc7, 0x52, 0x39, 0x45, 0xab, 0x79,
+	0xf3, 0x44, 0xcc, 0xed, 0xaf, 0x87, 0x2f, 0xf5, 0x8d, 0x30, 0x76, 0x98, 0x08, 0x15, 0xda, 0x19,
+	0x57, 0x7d, 0xe3, 0x19, 0x1c, 0x77, 0x2f, 0x3a, 0x48, 0xe3, 0x6e, 0xe4, 0x52, 0xa9, 0x1b, 0xa9,
+	0x2b, 0x41, 0x45, 0x77, 0x61, 0x50, 0x4a, 0x5e, 0x43, 0xc9, 0x43, 0xd4, 0x4d, 0x14, 0x39, 0xdd,
+	0x34, 0x34, 0x95, 0xd4, 0x72, 0xd3, 0xe0, 0xfc, 0x00, 0x66, 0x5d, 0x3d, 0xb7, 0xfc, 0x58, 0xc4,
+	0x3e, 0xc3, 0x8b, 0xd9, 0xcc, 0x31, 0xb9, 0x75, 0x66, 0x89, 0xd6, 0xc5, 0x22, 0x36, 0xd6, 0xd5,
+	0x5c, 0xbd, 0x70, 0x42, 0x98, 0x77, 0xcd, 0x6b, 0xd5, 0xbd, 0x51, 0xe6, 0x8b, 0x48, 0xcd, 0x09,
+	0x43, 0xc6, 0xf7, 0x86, 0x99, 0x39, 0xc4, 0x66, 0x45, 0x6e, 0x40, 0x43, 0x68, 0x12, 0xf3, 0x46,
+	0xf0, 0xce, 0xc9, 0xa5, 0x43, 0xcb, 0x34, 0x12, 0xdd, 0x9c, 0xcf, 0xf9, 0x9d, 0x05, 0x9d, 0x7c,
+	0xbb, 0x71, 0xd5, 0x38, 0xa0, 0x21, 0x0f, 0x68, 0x26, 0x72, 0x95, 0xc7, 0x00, 0xbc, 0xcf, 0x54,
+	0x5a, 0xeb, 0x27, 0x33, 0xcf, 0xe8, 0xa5, 0xe3, 0x63, 0x23, 0x46, 0x4b, 0xfb, 0x91, 0xd6, 0xf0,
+	0x36, 0x34, 0xcd, 0x4e, 0x79, 0x75, 0xfb, 0xee, 0x59, 0x2a, 0x16, 0x66, 0x9b, 0x1e, 0xaa, 0x10,
+	0xe0, 0x1c, 0xc2, 0x62, 0xde, 0x3c, 0x95, 0x5e, 0xff, 0xce, 0xd0, 0xf7, 0x22, 0x34, 0x44, 0xac,
+	0x83, 0xa5, 0xdd, 0x5c, 0x17, 0xb1, 0x6a, 0xee, 0x08, 0xd4, 0xc2, 0x7c, 0xee, 0xad, 0xb9, 0xea,
+	0x1b, 0x1d, 0xad, 0x5f, 0x04, 0x4d, 0x9f, 0x64, 0x56, 0xce, 0xef, 0x2b, 0x30, 0xaf, 0xb7, 0x2e,
+	0x06, 0xa4, 0xe9, 0x47, 0x1a, 0xeb, 0x7c, 0x8f, 0x34, 0xa5, 0x19, 0xb0, 0x32, 0x31, 0x03, 0x16,
+	0xd5, 0xb9, 0xfa, 0x2a, 0x73, 0x46, 0x1e, 0xad, 0x51, 0x12, 0xd0, 0x8c, 0xe5, 0xd1, 0xaa, 0x8d,
+	0xa3, 0xf5, 0x40, 0x21, 0x4c, 0xb4, 0xd6, 0x61, 0xb9, 0x4c, 0x3d, 0x5d, 0xe1, 0x16, 0xc7, 0x0c,
+	0xe3, 0xfa, 0xb5, 0xa4, 0x66, 0xd5, 0x50, 0x77, 0xc7, 0x4d, 0x57, 0x2f, 0x9c, 0xcf, 0x4b, 0x03,
+	0xbb, 0x3e, 0x6f, 0xa7, 0x8d, 0xfe, 0xaf, 0xe5, 0x26, 0x3a, 0xa3, 0xb5, 0xf8, 0x8b, 0x65, 0x06,
+	0xe3, 0xbb, 0xe2, 0x80, 0x3d, 0x14, 0xa7, 0x4c, 0xdf, 0xea, 0x21, 0x42, 0x27, 0x58, 0x7e, 0x73,
+	0xe7, 0xeb, 0xd7, 0x14, 0x95, 0x09, 0x4d, 0x6b, 0xd3, 0xf7, 0xf2, 0x9b, 0x30, 0x3b, 0x08, 0x85,
+	0xbf, 0x9f, 0x47, 0x4b, 0x3b, 0xbf, 0xad, 0x60, 0x3a, 0x50, 0xd7, 0x6e, 0xc1, 0xc2, 0xb1, 0xe7,
+	0x48, 0x72, 0x19, 0xde, 0x28, 0x01, 0x1f, 0xc4, 0x32, 0x61, 0x3e, 0xdf, 0xe5, 0x2c, 0xb0, 0x2f,
+	0x90, 0xe5, 0x09, 0x86, 0xbb, 0xaa, 0x24, 0xdb, 0xd6, 0xb5, 0xdf, 0x58, 0xf9, 0x7b, 0xa4, 0xca,
+	0xc5, 0x79, 0x68, 0x4f, 0xb2, 0x35, 0xa1, 0x86, 0x9d, 0xb2, 0x6d, 0x91, 0x39, 0x68, 0x15, 0xa9,
+	0x6f, 0x57, 0xc8, 0x2c, 0x34, 0xf3, 0x89, 0xde, 0xae, 0x22, 0xb2, 0x78, 0x67, 0xb4, 0x6b, 0xa4,
+	0x05, 0x33, 0x2e, 0x7d, 0x22, 0x52, 0x7b, 0x86, 0x34, 0xa0, 0xba, 0xc5, 0xa9, 0x5d, 0x47, 0x49,
+	0x37, 0xb6, 0xfb, 0x1f, 0xd9, 0x0d, 0x04, 0x3d, 0x88, 0xa8, 0xdd, 0x44, 0x10, 0x4e, 0xa2, 0x76,
+	0x8b, 0xb4, 0xa1, 0x61, 0x1a, 0x72, 0x1b, 0x50, 0x74, 0x9e, 0x39, 0x76, 0xfb, 0xda, 0x27, 0x30,
+	0x37, 0x51, 0xb9, 0xd0, 0x12, 0x0d, 0x98, 0xd4, 0xd4, 0x86, 0x59, 0x0d, 0xbe, 0xa7, 0x4e, 0xb7,
+	0x6d, 0x91, 0x0e, 0x80, 0x86, 0xdc, 0xa1, 0x19, 0xb3, 0x2b, 0x63, 0x0a, 0xfd, 0xb4, 0x6f, 0x57,
+	0x37, 0x3e, 0xfd, 0xf2, 0xd9, 0x8a, 0xf5, 0xf4, 0xd9, 0x8a, 0xf5, 0xcf, 0x67, 0x2b, 0xd6, 0x2f,
+	0x9e, 0xaf, 0x5c, 0xf8, 0xd3, 0xf3, 0x15, 0xeb, 0xe9, 0xf3, 0x95, 0x0b, 0x7f, 0x7b, 0xbe, 0x72,
+	0xe1, 0x93, 0x3b, 0xa5, 0x98, 0xf6, 0xf3, 0x43, 0x7d, 0x87, 0x0e, 0xe4, 0x5a, 0x71, 0xc4, 0xdf,
+	0xf7, 0x45, 0xca, 0xca, 0x4b, 0x74, 0xc2, 0x5a, 0x24, 0x82, 0x51, 0xc8, 0x64, 0xfe, 0xff, 0x8a,
+	0x8a, 0xfe, 0xa0, 0xae, 0xfe, 0x07, 0xf9, 0xe8, 0x7f, 0x03, 0x00, 0xf9, 0x24, 0x1a, 0x60, 0x80,
+	0x19, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PythContract != that1.PythContract {
+		return false
+	}
+	if this.ReporterStatsWindow != that1.ReporterStatsWindow {
+		return false
+	}
+	if this.ReporterInterval != that1.ReporterInterval {
+		return false
+	}
+	if this.MaxMissedReports != that1.MaxMissedReports {
+		return false
+	}
+	if this.PriceStalenessThreshold != that1.PriceStalenessThreshold {
+		return false
+	}
+	if len(this.SymbolAggregations) != len(that1.SymbolAggregations) {
+		return false
+	}
+	for i := range this.SymbolAggregations {
+		if !this.SymbolAggregations[i].Equal(&that1.SymbolAggregations[i]) {
+			return false
+		}
+	}
+	if len(this.SymbolPriceDeviations) != len(that1.SymbolPriceDeviations) {
+		return false
+	}
+	for i := range this.SymbolPriceDeviations {
+		if !this.SymbolPriceDeviations[i].Equal(&that1.SymbolPriceDeviations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SymbolPriceDeviation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SymbolPriceDeviation)
+	if !ok {
+		that2, ok := that.(SymbolPriceDeviation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return false
+	}
+	return true
+}
+func (this *SymbolAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SymbolAggregation)
+	if !ok {
+		that2, ok := that.(SymbolAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Policy != that1.Policy {
+		return false
+	}
+	if this.MinProviders != that1.MinProviders {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinbasePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SymbolPriceDeviations) > 0 {
+		for iNdEx := len(m.SymbolPriceDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbolPriceDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Value != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Value))
+	if len(m.SymbolAggregations) > 0 {
+		for iNdEx := len(m.SymbolAggregations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbolAggregations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PriceStalenessThreshold != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PriceStalenessThreshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMissedReports != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxMissedReports))
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Key)))
+	if m.ReporterInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ReporterInterval))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+	if m.ReporterStatsWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ReporterStatsWindow))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Kind)))
+	if len(m.PythContract) > 0 {
+		i -= len(m.PythContract)
+		copy(dAtA[i:], m.PythContract)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PythContract)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceState) Marshal() (dAtA []byte, err error) {
+func (m *SymbolPriceDeviation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2112,45 +2519,37 @@ func (m *PriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *SymbolPriceDeviation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SymbolPriceDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
 	{
-		size := m.CumulativePrice.Size()
+		size := m.MaxPriceDeviation.Size()
 		i -= size
-		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
+func (m *SymbolAggregation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2160,72 +2559,37 @@ func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PythPriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *SymbolAggregation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PythPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SymbolAggregation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if m.MinProviders != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinProviders))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.PublishTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+	if m.Policy != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Policy))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x10
 	}
-	{
-		size := m.Conf.Size()
-		i -= size
-		if _, err := m.Conf.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.EmaConf.Size()
-		i -= size
-		if _, err := m.EmaConf.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.EmaPrice.Size()
-		i -= size
-		if _, err := m.EmaPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PriceId) > 0 {
-		i -= len(m.PriceId)
-		copy(dAtA[i:], m.PriceId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
+func (m *OracleInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2235,78 +2599,32 @@ func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BandOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BandOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinSourceCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinSourceCount))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.ExecuteGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.PrepareGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.MinCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.AskCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Symbols) > 0 {
-		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Symbols[iNdEx])
-			copy(dAtA[i:], m.Symbols[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbols[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.OracleScriptId != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptId))
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.RequestId != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestId))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
+func (m *ChainlinkPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2316,75 +2634,52 @@ func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BandIBCParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainlinkPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BandIBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainlinkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LegacyOracleIds) > 0 {
-		dAtA9 := make([]byte, len(m.LegacyOracleIds)*10)
-		var j8 int
-		for _, num1 := range m.LegacyOracleIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintOracle(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IbcPortId) > 0 {
-		i -= len(m.IbcPortId)
-		copy(dAtA[i:], m.IbcPortId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcPortId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.IbcVersion) > 0 {
-		i -= len(m.IbcVersion)
-		copy(dAtA[i:], m.IbcVersion)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcVersion)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IbcSourceChannel) > 0 {
-		i -= len(m.IbcSourceChannel)
-		copy(dAtA[i:], m.IbcSourceChannel)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcSourceChannel)))
-		i--
-		dAtA[i] = 0x1a
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.IbcRequestInterval != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.IbcRequestInterval))
+	i--
+	dAtA[i] = 0x22
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.BandIbcEnabled {
-		i--
-		if m.BandIbcEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.Answer.Size()
+		i -= size
+		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
+func (m *BandPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2394,37 +2689,57 @@ func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SymbolPriceTimestamp) MarshalTo(dAtA []byte) (int, error) {
+func (m *BandPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SymbolPriceTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BandPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Request_ID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Request_ID))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if len(m.SymbolId) > 0 {
-		i -= len(m.SymbolId)
-		copy(dAtA[i:], m.SymbolId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+	if m.ResolveTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.Oracle != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2434,57 +2749,117 @@ func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LastPriceTimestamps) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LastPriceTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LastPriceTimestamps) > 0 {
-		for iNdEx := len(m.LastPriceTimestamps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LastPriceTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.PriceState != nil {
+		{
+			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *PriceRecords) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LatestPriceRecords) > 0 {
-		for iNdEx := len(m.LatestPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderPriceStates) > 0 {
+		for iNdEx := len(m.ProviderPriceStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ProviderPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2492,25 +2867,25 @@ func (m *PriceRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintOracle(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SymbolId) > 0 {
-		i -= len(m.SymbolId)
-		copy(dAtA[i:], m.SymbolId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Oracle != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+	if m.ProviderInfo != nil {
+		{
+			size, err := m.ProviderInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2520,35 +2895,39 @@ func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2558,90 +2937,67 @@ func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetadataStatistics) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetadataStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MedianPrice.Size()
-		i -= size
-		if _, err := m.MedianPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.MaxPrice.Size()
-		i -= size
-		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinPrice.Size()
-		i -= size
-		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.LastTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LastTimestamp))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x12
 	}
-	if m.FirstTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.FirstTimestamp))
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xa
 	}
-	{
-		size := m.Twap.Size()
-		i -= size
-		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
+	return dAtA[:n], nil
+}
+
+func (m *PriceFeedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceFeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.Mean.Size()
+		size := m.Price.Size()
 		i -= size
-		if _, err := m.Mean.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.RecordsSampleSize != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RecordsSampleSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.GroupCount))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
+func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2651,537 +3007,2999 @@ func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+func (m *CoinbasePriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CoinbasePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PublishTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
-		i--
-		dAtA[i] = 0x40
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.EmaExpo != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaExpo))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.EmaConf != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaConf))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.EmaPrice != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaPrice))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Expo != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Expo))
+	i--
+	dAtA[i] = 0x2a
+	if m.Value != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Value))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.Conf != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Conf))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.Price != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Price))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.PriceId) > 0 {
-		i -= len(m.PriceId)
-		copy(dAtA[i:], m.PriceId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Kind)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PriceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PythContract)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	return n
+
+func (m *PriceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.OracleType != 0 {
-		n += 1 + sovOracle(uint64(m.OracleType))
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainlinkPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.Answer.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BandPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.ResolveTime != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveTime))
-	}
-	if m.Request_ID != 0 {
-		n += 1 + sovOracle(uint64(m.Request_ID))
-	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+func (m *PythPriceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceFeedState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PythPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.PublishTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PriceState != nil {
-		l = m.PriceState.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.Conf.Size()
+		i -= size
+		if _, err := m.Conf.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EmaConf.Size()
+		i -= size
+		if _, err := m.EmaConf.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EmaPrice.Size()
+		i -= size
+		if _, err := m.EmaPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PriceId) > 0 {
+		i -= len(m.PriceId)
+		copy(dAtA[i:], m.PriceId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProviderInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BandOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BandOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.MinSourceCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinSourceCount))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *ProviderState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.ProviderInfo != nil {
-		l = m.ProviderInfo.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if len(m.ProviderPriceStates) > 0 {
-		for _, e := range m.ProviderPriceStates {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbols) > 0 {
+		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Symbols[iNdEx])
+			copy(dAtA[i:], m.Symbols[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbols[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if m.OracleScriptId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProviderPriceState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BandIBCParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BandIBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.LegacyOracleIds) > 0 {
+		dAtA9 := make([]byte, len(m.LegacyOracleIds)*10)
+		var j8 int
+		for _, num1 := range m.LegacyOracleIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintOracle(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.IbcPortId) > 0 {
+		i -= len(m.IbcPortId)
+		copy(dAtA[i:], m.IbcPortId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcPortId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *PriceFeedInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.IbcVersion) > 0 {
+		i -= len(m.IbcVersion)
+		copy(dAtA[i:], m.IbcVersion)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcVersion)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.IbcSourceChannel) > 0 {
+		i -= len(m.IbcSourceChannel)
+		copy(dAtA[i:], m.IbcSourceChannel)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcSourceChannel)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.IbcRequestInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.IbcRequestInterval))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.BandIbcEnabled {
+		i--
+		if m.BandIbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceFeedPrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CoinbasePriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SymbolPriceTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbolPriceTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
 	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.SymbolId) > 0 {
+		i -= len(m.SymbolId)
+		copy(dAtA[i:], m.SymbolId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Value != 0 {
-		n += 1 + sovOracle(uint64(m.Value))
+	if m.Oracle != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LastPriceTimestamps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastPriceTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.CumulativePrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+	if len(m.LastPriceTimestamps) > 0 {
+		for iNdEx := len(m.LastPriceTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastPriceTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PythPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.EmaPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.EmaConf.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Conf.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.PublishTime != 0 {
-		n += 1 + sovOracle(uint64(m.PublishTime))
+func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BandOracleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovOracle(uint64(m.RequestId))
-	}
-	if m.OracleScriptId != 0 {
-		n += 1 + sovOracle(uint64(m.OracleScriptId))
-	}
-	if len(m.Symbols) > 0 {
-		for _, s := range m.Symbols {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
+	if len(m.LatestPriceRecords) > 0 {
+		for iNdEx := len(m.LatestPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.AskCount != 0 {
-		n += 1 + sovOracle(uint64(m.AskCount))
-	}
-	if m.MinCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinCount))
+	if len(m.SymbolId) > 0 {
+		i -= len(m.SymbolId)
+		copy(dAtA[i:], m.SymbolId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.Oracle != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.PrepareGas != 0 {
-		n += 1 + sovOracle(uint64(m.PrepareGas))
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ExecuteGas != 0 {
-		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.MinSourceCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinSourceCount))
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BandIBCParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BandIbcEnabled {
-		n += 2
+	{
+		size := m.MedianPrice.Size()
+		i -= size
+		if _, err := m.MedianPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.IbcRequestInterval != 0 {
-		n += 1 + sovOracle(uint64(m.IbcRequestInterval))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.IbcSourceChannel)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.IbcVersion)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if m.LastTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastTimestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.IbcPortId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.FirstTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.FirstTimestamp))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.LegacyOracleIds) > 0 {
-		l = 0
-		for _, e := range m.LegacyOracleIds {
-			l += sovOracle(uint64(e))
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovOracle(uint64(l)) + l
-	}
-	return n
-}
-
-func (m *SymbolPriceTimestamp) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovOracle(uint64(m.Oracle))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Mean.Size()
+		i -= size
+		if _, err := m.Mean.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.RecordsSampleSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RecordsSampleSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+	if m.GroupCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.GroupCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LastPriceTimestamps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LastPriceTimestamps) > 0 {
-		for _, e := range m.LastPriceTimestamps {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PriceRecords) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PublishTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EmaExpo != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaExpo))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EmaConf != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaConf))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EmaPrice != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaPrice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expo != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Expo))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Conf != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Conf))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Price != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PriceId) > 0 {
+		i -= len(m.PriceId)
+		copy(dAtA[i:], m.PriceId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReporterOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReporterOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReporterOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovOracle(uint64(m.Oracle))
+	if m.Outcome != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.LatestPriceRecords) > 0 {
-		for _, e := range m.LatestPriceRecords {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ReporterRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReporterRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReporterRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outcomes) > 0 {
+		for iNdEx := len(m.Outcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastReportHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastReportHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleReporterStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleReporterStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleReporterStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Missed != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Late != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Late))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OnTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OnTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OraclePriceFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePriceFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePriceFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastUpdateTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceMoveVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMoveVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMoveVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PythContract)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ReporterStatsWindow != 0 {
+		n += 1 + sovOracle(uint64(m.ReporterStatsWindow))
+	}
+	if m.ReporterInterval != 0 {
+		n += 1 + sovOracle(uint64(m.ReporterInterval))
+	}
+	if m.MaxMissedReports != 0 {
+		n += 1 + sovOracle(uint64(m.MaxMissedReports))
+	}
+	if m.PriceStalenessThreshold != 0 {
+		n += 1 + sovOracle(uint64(m.PriceStalenessThreshold))
+	}
+	if len(m.SymbolAggregations) > 0 {
+		for _, e := range m.SymbolAggregations {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.SymbolPriceDeviations) > 0 {
+		for _, e := range m.SymbolPriceDeviations {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SymbolPriceDeviation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *SymbolAggregation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovOracle(uint64(m.Policy))
+	}
+	if m.MinProviders != 0 {
+		n += 1 + sovOracle(uint64(m.MinProviders))
+	}
+	return n
+}
+
+func (m *OracleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	return n
+}
+
+func (m *ChainlinkPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Answer.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *BandPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveTime != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveTime))
+	}
+	if m.Request_ID != 0 {
+		n += 1 + sovOracle(uint64(m.Request_ID))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceFeedState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.PriceState != nil {
+		l = m.PriceState.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderInfo != nil {
+		l = m.ProviderInfo.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.ProviderPriceStates) > 0 {
+		for _, e := range m.ProviderPriceStates {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceFeedInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceFeedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *CoinbasePriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovOracle(uint64(m.Value))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *PythPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.EmaPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.EmaConf.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Conf.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.PublishTime != 0 {
+		n += 1 + sovOracle(uint64(m.PublishTime))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *BandOracleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovOracle(uint64(m.RequestId))
+	}
+	if m.OracleScriptId != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptId))
+	}
+	if len(m.Symbols) > 0 {
+		for _, s := range m.Symbols {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.MinSourceCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinSourceCount))
+	}
+	return n
+}
+
+func (m *BandIBCParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BandIbcEnabled {
+		n += 2
+	}
+	if m.IbcRequestInterval != 0 {
+		n += 1 + sovOracle(uint64(m.IbcRequestInterval))
+	}
+	l = len(m.IbcSourceChannel)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.IbcVersion)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.IbcPortId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.LegacyOracleIds) > 0 {
+		l = 0
+		for _, e := range m.LegacyOracleIds {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SymbolPriceTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovOracle(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *LastPriceTimestamps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LastPriceTimestamps) > 0 {
+		for _, e := range m.LastPriceTimestamps {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovOracle(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.LatestPriceRecords) > 0 {
+		for _, e := range m.LatestPriceRecords {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *MetadataStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupCount != 0 {
+		n += 1 + sovOracle(uint64(m.GroupCount))
+	}
+	if m.RecordsSampleSize != 0 {
+		n += 1 + sovOracle(uint64(m.RecordsSampleSize))
+	}
+	l = m.Mean.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.FirstTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.FirstTimestamp))
+	}
+	if m.LastTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.LastTimestamp))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MedianPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovOracle(uint64(m.Price))
+	}
+	if m.Conf != 0 {
+		n += 1 + sovOracle(uint64(m.Conf))
+	}
+	if m.Expo != 0 {
+		n += 1 + sovOracle(uint64(m.Expo))
+	}
+	if m.EmaPrice != 0 {
+		n += 1 + sovOracle(uint64(m.EmaPrice))
+	}
+	if m.EmaConf != 0 {
+		n += 1 + sovOracle(uint64(m.EmaConf))
+	}
+	if m.EmaExpo != 0 {
+		n += 1 + sovOracle(uint64(m.EmaExpo))
+	}
+	if m.PublishTime != 0 {
+		n += 1 + sovOracle(uint64(m.PublishTime))
+	}
+	return n
+}
+
+func (m *RelayerNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovOracle(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ReporterOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovOracle(uint64(m.Outcome))
+	}
+	return n
+}
+
+func (m *ReporterRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.LastReportHeight != 0 {
+		n += 1 + sovOracle(uint64(m.LastReportHeight))
+	}
+	if len(m.Outcomes) > 0 {
+		for _, e := range m.Outcomes {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleReporterStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OnTime != 0 {
+		n += 1 + sovOracle(uint64(m.OnTime))
+	}
+	if m.Late != 0 {
+		n += 1 + sovOracle(uint64(m.Late))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovOracle(uint64(m.Missed))
+	}
+	return n
+}
+
+func (m *OraclePriceFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdateHeight))
+	}
+	if m.LastUpdateTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdateTimestamp))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProviderPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *PriceMoveVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PythContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PythContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterStatsWindow", wireType)
+			}
+			m.ReporterStatsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterStatsWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterInterval", wireType)
+			}
+			m.ReporterInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedReports", wireType)
+			}
+			m.MaxMissedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStalenessThreshold", wireType)
+			}
+			m.PriceStalenessThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceStalenessThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolAggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolAggregations = append(m.SymbolAggregations, SymbolAggregation{})
+			if err := m.SymbolAggregations[len(m.SymbolAggregations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolPriceDeviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolPriceDeviations = append(m.SymbolPriceDeviations, SymbolPriceDeviation{})
+			if err := m.SymbolPriceDeviations[len(m.SymbolPriceDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbolPriceDeviation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbolPriceDeviation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbolPriceDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbolAggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbolAggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbolAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= AggregationPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProviders", wireType)
+			}
+			m.MinProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainlinkPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainlinkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BandPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request_ID", wireType)
+			}
+			m.Request_ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request_ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeedState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeedState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceState == nil {
+				m.PriceState = &PriceState{}
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderInfo == nil {
+				m.ProviderInfo = &ProviderInfo{}
+			}
+			if err := m.ProviderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPriceStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPriceStates = append(m.ProviderPriceStates, &ProviderPriceState{})
+			if err := m.ProviderPriceStates[len(m.ProviderPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *PriceRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func (m *MetadataStatistics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupCount != 0 {
-		n += 1 + sovOracle(uint64(m.GroupCount))
-	}
-	if m.RecordsSampleSize != 0 {
-		n += 1 + sovOracle(uint64(m.RecordsSampleSize))
-	}
-	l = m.Mean.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Twap.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.FirstTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.FirstTimestamp))
-	}
-	if m.LastTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.LastTimestamp))
-	}
-	l = m.MinPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.MaxPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.MedianPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
 
-func (m *PriceAttestation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 1 + sovOracle(uint64(m.Price))
-	}
-	if m.Conf != 0 {
-		n += 1 + sovOracle(uint64(m.Conf))
-	}
-	if m.Expo != 0 {
-		n += 1 + sovOracle(uint64(m.Expo))
-	}
-	if m.EmaPrice != 0 {
-		n += 1 + sovOracle(uint64(m.EmaPrice))
-	}
-	if m.EmaConf != 0 {
-		n += 1 + sovOracle(uint64(m.EmaConf))
-	}
-	if m.EmaExpo != 0 {
-		n += 1 + sovOracle(uint64(m.EmaExpo))
-	}
-	if m.PublishTime != 0 {
-		n += 1 + sovOracle(uint64(m.PublishTime))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3204,15 +6022,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderPriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PythContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3240,7 +6058,43 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PythContract = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &PriceState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3263,7 +6117,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleInfo) Unmarshal(dAtA []byte) error {
+func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3286,15 +6140,15 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceFeedInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceFeedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3322,13 +6176,13 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
-			m.OracleType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3338,11 +6192,108 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleType |= OracleType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeedPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3364,7 +6315,7 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
+func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3387,15 +6338,15 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainlinkPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoinbasePriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainlinkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoinbasePriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3423,11 +6374,30 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3455,15 +6425,13 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Timestamp = 0
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3473,12 +6441,12 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
@@ -3532,7 +6500,7 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BandPriceState) Unmarshal(dAtA []byte) error {
+func (m *PriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3555,15 +6523,15 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BandPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BandPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3591,11 +6559,13 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3623,53 +6593,15 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-			}
-			m.ResolveTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request_ID", wireType)
-			}
-			m.Request_ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Request_ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3679,25 +6611,11 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3719,7 +6637,7 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
+func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3742,15 +6660,15 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PythPriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PythPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3778,11 +6696,11 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.PriceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3810,47 +6728,13 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quote = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PriceState == nil {
-				m.PriceState = &PriceState{}
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmaPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3878,93 +6762,13 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.EmaConf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3992,63 +6796,15 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Conf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProviderState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderInfo", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
 			}
-			var msglen int
+			m.PublishTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4058,31 +6814,14 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PublishTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProviderInfo == nil {
-				m.ProviderInfo = &ProviderInfo{}
-			}
-			if err := m.ProviderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPriceStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4109,8 +6848,7 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderPriceStates = append(m.ProviderPriceStates, &ProviderPriceState{})
-			if err := m.ProviderPriceStates[len(m.ProviderPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4135,7 +6873,7 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
+func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4158,15 +6896,53 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: BandOracleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BandOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			}
+			m.OracleScriptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4194,11 +6970,49 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4225,68 +7039,16 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &PriceState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			var stringLen uint64
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4296,29 +7058,16 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Base = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			var stringLen uint64
+			m.ExecuteGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4328,79 +7077,16 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecuteGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Quote = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedPrice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSourceCount", wireType)
 			}
-			var stringLen uint64
+			m.MinSourceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4410,26 +7096,11 @@ func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinSourceCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4451,7 +7122,7 @@ func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
+func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4474,17 +7145,17 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoinbasePriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: BandIBCParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoinbasePriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BandIBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandIbcEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4494,29 +7165,17 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.BandIbcEnabled = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcRequestInterval", wireType)
 			}
-			m.Timestamp = 0
+			m.IbcRequestInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4526,14 +7185,14 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.IbcRequestInterval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSourceChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4561,13 +7220,13 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.IbcSourceChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcVersion", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4577,16 +7236,29 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4596,25 +7268,100 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcPortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LegacyOracleIds = append(m.LegacyOracleIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LegacyOracleIds) == 0 {
+					m.LegacyOracleIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LegacyOracleIds = append(m.LegacyOracleIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyOracleIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4636,7 +7383,7 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceState) Unmarshal(dAtA []byte) error {
+func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4659,17 +7406,17 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbolPriceTimestamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbolPriceTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
-			var stringLen uint64
+			m.Oracle = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4679,29 +7426,14 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Oracle |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4729,9 +7461,7 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymbolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -4773,7 +7503,7 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PythPriceState) Unmarshal(dAtA []byte) error {
+func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4796,17 +7526,17 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PythPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastPriceTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PythPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastPriceTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceTimestamps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4816,29 +7546,81 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceId = string(dAtA[iNdEx:postIndex])
+			m.LastPriceTimestamps = append(m.LastPriceTimestamps, &SymbolPriceTimestamp{})
+			if err := m.LastPriceTimestamps[len(m.LastPriceTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			m.Oracle = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4848,29 +7630,14 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Oracle |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EmaPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4898,15 +7665,13 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmaConf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymbolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestPriceRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4916,31 +7681,81 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Conf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestPriceRecords = append(m.LatestPriceRecords, &PriceRecord{})
+			if err := m.LatestPriceRecords[len(m.LatestPriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.PublishTime = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4950,16 +7765,16 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PublishTime |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4969,22 +7784,23 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5009,7 +7825,7 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
+func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5032,17 +7848,17 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BandOracleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataStatistics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BandOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupCount", wireType)
 			}
-			m.RequestId = 0
+			m.GroupCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5052,16 +7868,35 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				m.GroupCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsSampleSize", wireType)
+			}
+			m.RecordsSampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsSampleSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
-			m.OracleScriptId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5071,14 +7906,29 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5106,13 +7956,15 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstTimestamp", wireType)
 			}
-			m.AskCount = 0
+			m.FirstTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5122,16 +7974,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				m.FirstTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
 			}
-			m.MinCount = 0
+			m.LastTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5141,16 +7993,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.LastTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5160,31 +8012,31 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
-			m.PrepareGas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5194,35 +8046,31 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.ExecuteGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSourceCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
 			}
-			m.MinSourceCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5232,11 +8080,26 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSourceCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MedianPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5258,7 +8121,7 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
+func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5281,17 +8144,17 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BandIBCParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BandIBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BandIbcEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5301,17 +8164,29 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BandIbcEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcRequestInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.IbcRequestInterval = 0
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5321,16 +8196,16 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IbcRequestInterval |= int64(b&0x7F) << shift
+				m.Price |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSourceChannel", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
 			}
-			var stringLen uint64
+			m.Conf = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5340,29 +8215,16 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Conf |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcSourceChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expo", wireType)
 			}
-			var stringLen uint64
+			m.Expo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5372,29 +8234,16 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Expo |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcPortId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
 			}
-			var stringLen uint64
+			m.EmaPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5403,100 +8252,68 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcPortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LegacyOracleIds = append(m.LegacyOracleIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				iNdEx++
+				m.EmaPrice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthOracle
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
+			}
+			m.EmaConf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthOracle
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if postIndex > l {
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmaConf |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaExpo", wireType)
+			}
+			m.EmaExpo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmaExpo |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.LegacyOracleIds) == 0 {
-					m.LegacyOracleIds = make([]int64, 0, elementCount)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+			}
+			m.PublishTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LegacyOracleIds = append(m.LegacyOracleIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LegacyOracleIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5519,7 +8336,7 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
+func (m *RelayerNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5542,34 +8359,15 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SymbolPriceTimestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SymbolPriceTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
-			}
-			m.Oracle = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Oracle |= OracleType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5597,13 +8395,13 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolId = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Timestamp = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5613,7 +8411,7 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5639,7 +8437,7 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
+func (m *ReporterOutcome) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5662,17 +8460,17 @@ func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastPriceTimestamps: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReporterOutcome: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastPriceTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReporterOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceTimestamps", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5682,26 +8480,30 @@ func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			m.LastPriceTimestamps = append(m.LastPriceTimestamps, &SymbolPriceTimestamp{})
-			if err := m.LastPriceTimestamps[len(m.LastPriceTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= ReportOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5723,7 +8525,7 @@ func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceRecords) Unmarshal(dAtA []byte) error {
+func (m *ReporterRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5746,34 +8548,15 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReporterRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReporterRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
-			}
-			m.Oracle = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Oracle |= OracleType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5801,11 +8584,30 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolId = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReportHeight", wireType)
+			}
+			m.LastReportHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReportHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestPriceRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcomes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5832,8 +8634,8 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestPriceRecords = append(m.LatestPriceRecords, &PriceRecord{})
-			if err := m.LatestPriceRecords[len(m.LatestPriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outcomes = append(m.Outcomes, ReporterOutcome{})
+			if err := m.Outcomes[len(m.Outcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5858,7 +8660,7 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceRecord) Unmarshal(dAtA []byte) error {
+func (m *OracleReporterStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5881,17 +8683,17 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleReporterStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleReporterStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5901,16 +8703,29 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnTime", wireType)
 			}
-			var stringLen uint64
+			m.OnTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5920,26 +8735,49 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OnTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Late", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.Late = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Late |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5961,7 +8799,7 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
+func (m *OraclePriceFeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5984,17 +8822,17 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataStatistics: wiretype end group for non-group")
+			return fmt.Errorf("proto: OraclePriceFeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OraclePriceFeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
 			}
-			m.GroupCount = 0
+			m.OracleType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6004,33 +8842,14 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupCount |= uint32(b&0x7F) << shift
+				m.OracleType |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsSampleSize", wireType)
-			}
-			m.RecordsSampleSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RecordsSampleSize |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6058,13 +8877,11 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Mean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6092,15 +8909,15 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
 			}
-			m.FirstTimestamp = 0
+			m.LastUpdateHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6110,16 +8927,16 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FirstTimestamp |= int64(b&0x7F) << shift
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTimestamp", wireType)
 			}
-			m.LastTimestamp = 0
+			m.LastUpdateTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6129,16 +8946,16 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastTimestamp |= int64(b&0x7F) << shift
+				m.LastUpdateTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6148,29 +8965,65 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6198,13 +9051,11 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6232,10 +9083,29 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MedianPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6257,7 +9127,7 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
+func (m *PriceMoveVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6280,15 +9150,15 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceAttestation: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceMoveVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceMoveVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6316,13 +9186,13 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceId = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			m.Price = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6332,35 +9202,29 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.Conf = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Conf |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expo", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Expo = 0
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6370,54 +9234,31 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Expo |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.EmaPrice = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EmaPrice |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EmaConf = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EmaConf |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaExpo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.EmaExpo = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6427,16 +9268,16 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EmaExpo |= int32(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.PublishTime = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6446,7 +9287,7 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PublishTime |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -23,6 +24,10 @@ const (
 
 	MaxPythExponent = 10
 	MinPythExponent = -12
+
+	// MaxSymbolLength is the maximum length of a symbol with a max price deviation, as the symbol is length prefixed
+	// in the price move vote keys
+	MaxSymbolLength = 255
 )
 
 // Parameter keys
@@ -66,6 +71,30 @@ func DefaultBandIBCParams() BandIBCParams {
 
 // Validate performs basic validation on auction parameters.
 func (p Params) Validate() error {
+	if p.ReporterStatsWindow < 0 {
+		return fmt.Errorf("reporter_stats_window must not be negative: %d", p.ReporterStatsWindow)
+	}
+
+	if p.ReporterInterval < 0 {
+		return fmt.Errorf("reporter_interval must not be negative: %d", p.ReporterInterval)
+	}
+
+	if p.ReporterStatsWindow > 0 && p.ReporterInterval == 0 {
+		return fmt.Errorf("reporter_interval must be positive when the reporter stats are enabled")
+	}
+
+	if p.PriceStalenessThreshold < 0 {
+		return fmt.Errorf("price_staleness_threshold must not be negative: %d", p.PriceStalenessThreshold)
+	}
+
+	if err := validateSymbolAggregations(p.SymbolAggregations); err != nil {
+		return fmt.Errorf("symbol_aggregations is incorrect: %w", err)
+	}
+
+	if err := validateSymbolPriceDeviations(p.SymbolPriceDeviations); err != nil {
+		return fmt.Errorf("symbol_price_deviations is incorrect: %w", err)
+	}
+
 	return nil
 }
 
@@ -101,3 +130,48 @@ func validatePythContract(i interface{}) error {
 
 	return nil
 }
+
+func validateSymbolAggregations(aggregations []SymbolAggregation) error {
+	seen := make(map[string]struct{}, len(aggregations))
+	for _, aggregation := range aggregations {
+		if aggregation.Symbol == "" {
+			return fmt.Errorf("empty symbol")
+		}
+
+		if _, ok := seen[aggregation.Symbol]; ok {
+			return fmt.Errorf("duplicate symbol: %s", aggregation.Symbol)
+		}
+		seen[aggregation.Symbol] = struct{}{}
+
+		if aggregation.Policy != AggregationPolicy_AggregationMedian {
+			return fmt.Errorf("unsupported aggregation policy %s for symbol %s", aggregation.Policy.String(), aggregation.Symbol)
+		}
+	}
+
+	return nil
+}
+
+func validateSymbolPriceDeviations(deviations []SymbolPriceDeviation) error {
+	seen := make(map[string]struct{}, len(deviations))
+	for _, deviation := range deviations {
+		if deviation.Symbol == "" || len(deviation.Symbol) > MaxSymbolLength {
+			return fmt.Errorf("invalid symbol: %s", deviation.Symbol)
+		}
+
+		if !strings.HasPrefix(deviation.Symbol, GetPriceDeviationSymbol(OracleType_PriceFeed, "")) &&
+			!strings.HasPrefix(deviation.Symbol, GetPriceDeviationSymbol(OracleType_Provider, "")) {
+			return fmt.Errorf("symbol %s must be prefixed with the PriceFeed or Provider oracle type", deviation.Symbol)
+		}
+
+		if _, ok := seen[deviation.Symbol]; ok {
+			return fmt.Errorf("duplicate symbol: %s", deviation.Symbol)
+		}
+		seen[deviation.Symbol] = struct{}{}
+
+		if deviation.MaxPriceDeviation.IsNil() || !deviation.MaxPriceDeviation.IsPositive() {
+			return fmt.Errorf("max_price_deviation of symbol %s must be positive: %v", deviation.Symbol, deviation.MaxPriceDeviation)
+		}
+	}
+
+	return nil
+}
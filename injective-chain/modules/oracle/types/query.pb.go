@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -1503,6 +1504,393 @@ func (m *QueryOraclePriceResponse) GetPricePairState() *PricePairState {
 	return nil
 }
 
+// QueryRelayerNonceRequest is the request type for the Query/RelayerNonce RPC
+// method.
+type QueryRelayerNonceRequest struct {
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *QueryRelayerNonceRequest) Reset()         { *m = QueryRelayerNonceRequest{} }
+func (m *QueryRelayerNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerNonceRequest) ProtoMessage()    {}
+func (*QueryRelayerNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{32}
+}
+func (m *QueryRelayerNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerNonceRequest.Merge(m, src)
+}
+func (m *QueryRelayerNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerNonceRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerNonceRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+// QueryRelayerNonceResponse is the response type for the Query/RelayerNonce RPC
+// method.
+type QueryRelayerNonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryRelayerNonceResponse) Reset()         { *m = QueryRelayerNonceResponse{} }
+func (m *QueryRelayerNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerNonceResponse) ProtoMessage()    {}
+func (*QueryRelayerNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{33}
+}
+func (m *QueryRelayerNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerNonceResponse.Merge(m, src)
+}
+func (m *QueryRelayerNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerNonceResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// QueryOracleReporterStatsRequest is the request type for the
+// Query/OracleReporterStats RPC method.
+type QueryOracleReporterStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOracleReporterStatsRequest) Reset()         { *m = QueryOracleReporterStatsRequest{} }
+func (m *QueryOracleReporterStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleReporterStatsRequest) ProtoMessage()    {}
+func (*QueryOracleReporterStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{34}
+}
+func (m *QueryOracleReporterStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleReporterStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleReporterStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleReporterStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleReporterStatsRequest.Merge(m, src)
+}
+func (m *QueryOracleReporterStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleReporterStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleReporterStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleReporterStatsRequest proto.InternalMessageInfo
+
+func (m *QueryOracleReporterStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOracleReporterStatsResponse is the response type for the
+// Query/OracleReporterStats RPC method.
+type QueryOracleReporterStatsResponse struct {
+	Stats      []OracleReporterStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOracleReporterStatsResponse) Reset()         { *m = QueryOracleReporterStatsResponse{} }
+func (m *QueryOracleReporterStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleReporterStatsResponse) ProtoMessage()    {}
+func (*QueryOracleReporterStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{35}
+}
+func (m *QueryOracleReporterStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleReporterStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleReporterStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleReporterStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleReporterStatsResponse.Merge(m, src)
+}
+func (m *QueryOracleReporterStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleReporterStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleReporterStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleReporterStatsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleReporterStatsResponse) GetStats() []OracleReporterStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryOracleReporterStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOraclePricesRequest is the request type for the Query/OraclePrices RPC
+// method.
+type QueryOraclePricesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOraclePricesRequest) Reset()         { *m = QueryOraclePricesRequest{} }
+func (m *QueryOraclePricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOraclePricesRequest) ProtoMessage()    {}
+func (*QueryOraclePricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{36}
+}
+func (m *QueryOraclePricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOraclePricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOraclePricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOraclePricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOraclePricesRequest.Merge(m, src)
+}
+func (m *QueryOraclePricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOraclePricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOraclePricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOraclePricesRequest proto.InternalMessageInfo
+
+func (m *QueryOraclePricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOraclePricesResponse is the response type for the Query/OraclePrices
+// RPC method.
+type QueryOraclePricesResponse struct {
+	Prices     []OraclePriceFeed   `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOraclePricesResponse) Reset()         { *m = QueryOraclePricesResponse{} }
+func (m *QueryOraclePricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOraclePricesResponse) ProtoMessage()    {}
+func (*QueryOraclePricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{37}
+}
+func (m *QueryOraclePricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOraclePricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOraclePricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOraclePricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOraclePricesResponse.Merge(m, src)
+}
+func (m *QueryOraclePricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOraclePricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOraclePricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOraclePricesResponse proto.InternalMessageInfo
+
+func (m *QueryOraclePricesResponse) GetPrices() []OraclePriceFeed {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *QueryOraclePricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAggregatedPriceRequest is the request type for the Query/AggregatedPrice
+// RPC method.
+type QueryAggregatedPriceRequest struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *QueryAggregatedPriceRequest) Reset()         { *m = QueryAggregatedPriceRequest{} }
+func (m *QueryAggregatedPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatedPriceRequest) ProtoMessage()    {}
+func (*QueryAggregatedPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{38}
+}
+func (m *QueryAggregatedPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatedPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatedPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatedPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatedPriceRequest.Merge(m, src)
+}
+func (m *QueryAggregatedPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatedPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatedPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatedPriceRequest proto.InternalMessageInfo
+
+func (m *QueryAggregatedPriceRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// QueryAggregatedPriceResponse is the response type for the
+// Query/AggregatedPrice RPC method.
+type QueryAggregatedPriceResponse struct {
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// provider_prices are the fresh prices of the providers the price is
+	// aggregated from
+	ProviderPrices []ProviderPrice `protobuf:"bytes,2,rep,name=provider_prices,json=providerPrices,proto3" json:"provider_prices"`
+}
+
+func (m *QueryAggregatedPriceResponse) Reset()         { *m = QueryAggregatedPriceResponse{} }
+func (m *QueryAggregatedPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatedPriceResponse) ProtoMessage()    {}
+func (*QueryAggregatedPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{39}
+}
+func (m *QueryAggregatedPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatedPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatedPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatedPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatedPriceResponse.Merge(m, src)
+}
+func (m *QueryAggregatedPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatedPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatedPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatedPriceResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatedPriceResponse) GetProviderPrices() []ProviderPrice {
+	if m != nil {
+		return m.ProviderPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPythPriceRequest)(nil), "injective.oracle.v1beta1.QueryPythPriceRequest")
 	proto.RegisterType((*QueryPythPriceResponse)(nil), "injective.oracle.v1beta1.QueryPythPriceResponse")
@@ -1536,6 +1924,14 @@ func init() {
 	proto.RegisterType((*QueryOraclePriceRequest)(nil), "injective.oracle.v1beta1.QueryOraclePriceRequest")
 	proto.RegisterType((*PricePairState)(nil), "injective.oracle.v1beta1.PricePairState")
 	proto.RegisterType((*QueryOraclePriceResponse)(nil), "injective.oracle.v1beta1.QueryOraclePriceResponse")
+	proto.RegisterType((*QueryRelayerNonceRequest)(nil), "injective.oracle.v1beta1.QueryRelayerNonceRequest")
+	proto.RegisterType((*QueryRelayerNonceResponse)(nil), "injective.oracle.v1beta1.QueryRelayerNonceResponse")
+	proto.RegisterType((*QueryOracleReporterStatsRequest)(nil), "injective.oracle.v1beta1.QueryOracleReporterStatsRequest")
+	proto.RegisterType((*QueryOracleReporterStatsResponse)(nil), "injective.oracle.v1beta1.QueryOracleReporterStatsResponse")
+	proto.RegisterType((*QueryOraclePricesRequest)(nil), "injective.oracle.v1beta1.QueryOraclePricesRequest")
+	proto.RegisterType((*QueryOraclePricesResponse)(nil), "injective.oracle.v1beta1.QueryOraclePricesResponse")
+	proto.RegisterType((*QueryAggregatedPriceRequest)(nil), "injective.oracle.v1beta1.QueryAggregatedPriceRequest")
+	proto.RegisterType((*QueryAggregatedPriceResponse)(nil), "injective.oracle.v1beta1.QueryAggregatedPriceResponse")
 }
 
 func init() {
@@ -1543,111 +1939,133 @@ func init() {
 }
 
 var fileDescriptor_52f5d6f9962923ad = []byte{
-	// 1657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdf, 0x6f, 0xd3, 0xd6,
-	0x17, 0xaf, 0xfb, 0x8b, 0xe6, 0x04, 0xda, 0x72, 0x1b, 0x4a, 0x31, 0x90, 0x06, 0x43, 0x7f, 0xf0,
-	0x85, 0xc6, 0x34, 0x7c, 0x37, 0x18, 0x63, 0x48, 0xb4, 0xc0, 0x56, 0x46, 0xd5, 0xce, 0xb0, 0x1f,
-	0xda, 0x4b, 0x74, 0xe3, 0xdc, 0x26, 0x1e, 0x89, 0xaf, 0xb1, 0x9d, 0x42, 0x84, 0xd0, 0xa4, 0x3d,
-	0x4e, 0x93, 0x36, 0x69, 0x4f, 0x93, 0xb6, 0xf7, 0x69, 0x6f, 0x7b, 0xd8, 0xc3, 0x5e, 0x27, 0x4d,
-	0x62, 0x6f, 0x4c, 0xd3, 0xa4, 0x89, 0x07, 0x34, 0xc1, 0xfe, 0x90, 0xc9, 0xf7, 0x5e, 0xbb, 0x76,
-	0x63, 0xc7, 0x49, 0xa4, 0x3d, 0x35, 0xbe, 0x3e, 0xe7, 0x73, 0x3e, 0xe7, 0xf8, 0x9c, 0x63, 0x7f,
-	0x54, 0x38, 0x63, 0x98, 0x9f, 0x10, 0xdd, 0x35, 0x76, 0x89, 0x4a, 0x6d, 0xac, 0x37, 0x88, 0xba,
-	0xbb, 0x5a, 0x21, 0x2e, 0x5e, 0x55, 0x1f, 0xb4, 0x88, 0xdd, 0x2e, 0x5a, 0x36, 0x75, 0x29, 0x9a,
-	0x0b, 0xac, 0x8a, 0xdc, 0xaa, 0x28, 0xac, 0xe4, 0x13, 0x35, 0x4a, 0x6b, 0x0d, 0xa2, 0x62, 0xcb,
-	0x50, 0xb1, 0x69, 0x52, 0x17, 0xbb, 0x06, 0x35, 0x1d, 0xee, 0x27, 0x2f, 0x24, 0xa2, 0x0b, 0x18,
-	0x6e, 0xb6, 0x98, 0x68, 0x56, 0x23, 0x26, 0x71, 0x0c, 0x1f, 0x2e, 0x57, 0xa3, 0x35, 0xca, 0x7e,
-	0xaa, 0xde, 0x2f, 0x7e, 0xaa, 0x94, 0xe0, 0xc8, 0x7b, 0x1e, 0xd7, 0xed, 0xb6, 0x5b, 0xdf, 0xb6,
-	0x0d, 0x9d, 0x68, 0xe4, 0x41, 0x8b, 0x38, 0x2e, 0x3a, 0x06, 0x13, 0x96, 0x77, 0x5d, 0x36, 0xaa,
-	0x73, 0x52, 0x41, 0x5a, 0xce, 0x68, 0x07, 0xd8, 0xf5, 0x46, 0x55, 0xd1, 0x61, 0x76, 0xbf, 0x8f,
-	0x63, 0x51, 0xd3, 0x21, 0x68, 0x03, 0xb2, 0xdc, 0xc9, 0x71, 0xb1, 0x4b, 0x98, 0x5f, 0xb6, 0xb4,
-	0x5c, 0x4c, 0x2a, 0x40, 0x31, 0x40, 0xb8, 0xeb, 0xd9, 0x6b, 0x60, 0x05, 0xbf, 0x95, 0x1c, 0x20,
-	0x1e, 0x04, 0xdb, 0xb8, 0xe9, 0x08, 0x56, 0xca, 0xfb, 0x30, 0x13, 0x39, 0x15, 0x71, 0xaf, 0xc1,
-	0xb8, 0xc5, 0x4e, 0x44, 0xc8, 0x42, 0x97, 0x90, 0xcc, 0x6e, 0x6d, 0xf4, 0xe9, 0x8b, 0xf9, 0x21,
-	0x4d, 0x78, 0x29, 0x32, 0xcc, 0x31, 0xd8, 0x35, 0x6c, 0x56, 0x35, 0xd2, 0xc0, 0x6d, 0x62, 0x07,
-	0x21, 0x2f, 0xc1, 0xb1, 0x98, 0x7b, 0x22, 0xb0, 0x0c, 0x13, 0xb6, 0x38, 0x9b, 0x93, 0x0a, 0x23,
-	0xcb, 0x19, 0x2d, 0xb8, 0x56, 0x4e, 0xc2, 0xf1, 0xc0, 0x71, 0x2f, 0xc9, 0x00, 0xf7, 0x3e, 0x9c,
-	0x88, 0xbf, 0x2d, 0xa0, 0xdf, 0x85, 0x83, 0xa1, 0x5a, 0x72, 0xf8, 0xae, 0xc5, 0x8c, 0x02, 0x69,
-	0xd9, 0xbd, 0x62, 0x3a, 0x4a, 0x01, 0xf2, 0x41, 0xb0, 0x8d, 0xb5, 0xf5, 0x18, 0x3a, 0x26, 0xcc,
-	0x27, 0x5a, 0xfc, 0x17, 0x8c, 0x14, 0x28, 0xf0, 0x27, 0xe9, 0x9d, 0xdd, 0x22, 0x24, 0xae, 0x44,
-	0x16, 0x9c, 0xea, 0x62, 0x33, 0x28, 0xab, 0x00, 0x2d, 0x86, 0xd5, 0x29, 0x51, 0x85, 0x75, 0x6a,
-	0x98, 0x15, 0xec, 0x90, 0x18, 0x52, 0x8e, 0x20, 0x1e, 0x6b, 0x22, 0x38, 0x6d, 0xc5, 0x72, 0x3a,
-	0x9f, 0xcc, 0xa9, 0x13, 0x2c, 0xca, 0xcb, 0xef, 0xa5, 0xe8, 0xc0, 0x74, 0xf4, 0x52, 0xc7, 0xed,
-	0x81, 0x6b, 0x14, 0x1d, 0xcc, 0x08, 0x97, 0x7b, 0xa2, 0x97, 0xb6, 0x6d, 0xba, 0x6b, 0x54, 0x89,
-	0x1d, 0xb2, 0x13, 0xbb, 0x43, 0xf6, 0x76, 0x07, 0xbf, 0x29, 0x76, 0x47, 0x70, 0x8d, 0x66, 0x61,
-	0xdc, 0x69, 0x37, 0x2b, 0xb4, 0x31, 0x37, 0xcc, 0xee, 0x88, 0x2b, 0xa5, 0x2e, 0x2a, 0x1f, 0x87,
-	0x2a, 0xb2, 0xb8, 0x19, 0xb7, 0x5d, 0xce, 0xa4, 0x3c, 0xe8, 0xce, 0xcd, 0x72, 0x0c, 0x8e, 0xb2,
-	0x48, 0x9b, 0xb4, 0xda, 0x6a, 0x44, 0x88, 0x2b, 0x1f, 0x89, 0x3d, 0x10, 0xb9, 0x25, 0xa2, 0x5f,
-	0x85, 0xb1, 0x70, 0xdc, 0xc5, 0xe4, 0xb8, 0x6f, 0xf3, 0xbd, 0xcb, 0xdd, 0xb9, 0x93, 0xf2, 0x29,
-	0x28, 0x0c, 0xf9, 0x1d, 0xc3, 0x71, 0xa9, 0x6d, 0xe8, 0xb8, 0x21, 0x36, 0xa7, 0x4e, 0xed, 0xaa,
-	0xff, 0x1c, 0xd1, 0x55, 0x18, 0xe7, 0x58, 0x2c, 0xc8, 0x64, 0xb7, 0xe4, 0xb6, 0xd8, 0xe5, 0xbd,
-	0xb6, 0x45, 0x34, 0xe1, 0x83, 0x8e, 0x43, 0x86, 0x17, 0xd3, 0xdb, 0xd9, 0xbc, 0xba, 0x13, 0xfc,
-	0x60, 0xa3, 0xaa, 0xd8, 0x70, 0xba, 0x2b, 0x81, 0xa0, 0x53, 0x0e, 0xf1, 0x1a, 0xdb, 0xfc, 0x86,
-	0x68, 0x95, 0xc5, 0x94, 0x2a, 0xfb, 0x30, 0xbc, 0xcd, 0xc4, 0x95, 0xf2, 0xb9, 0x04, 0x39, 0xce,
-	0x93, 0x47, 0x6d, 0x6f, 0x59, 0xec, 0x05, 0x87, 0x8e, 0xc2, 0x81, 0x26, 0x7e, 0x54, 0xc6, 0x35,
-	0x9e, 0xe8, 0xa8, 0x36, 0xde, 0xc4, 0x8f, 0xae, 0xd7, 0x08, 0x2a, 0xc2, 0x8c, 0x61, 0xea, 0x8d,
-	0x56, 0x95, 0x94, 0x6d, 0xfc, 0xb0, 0x5c, 0xe7, 0x6e, 0x2c, 0x99, 0x09, 0xed, 0xb0, 0xb8, 0xa5,
-	0xe1, 0x87, 0x02, 0x0f, 0x9d, 0x85, 0x69, 0xdf, 0xbe, 0x49, 0x5c, 0x5c, 0xc5, 0x2e, 0x9e, 0x1b,
-	0x61, 0xc6, 0x53, 0xe2, 0x7c, 0x53, 0x1c, 0x2b, 0x5f, 0x0c, 0x8b, 0x21, 0xe1, 0x8c, 0x3e, 0xa0,
-	0x0d, 0xec, 0x1a, 0x0d, 0xc3, 0x6d, 0xfb, 0xc5, 0xbf, 0x0e, 0x19, 0x6f, 0x04, 0xcb, 0x86, 0xb9,
-	0x43, 0xd3, 0x9b, 0x8b, 0xa3, 0x6c, 0x98, 0x3b, 0x54, 0x9b, 0xf0, 0xdc, 0xbc, 0x5f, 0x68, 0x1d,
-	0xe0, 0x41, 0x8b, 0xba, 0x02, 0x63, 0xb8, 0x0f, 0x8c, 0x0c, 0xf3, 0x63, 0x20, 0x55, 0x98, 0xe5,
-	0x76, 0x7e, 0xfa, 0x65, 0xca, 0xcb, 0xc6, 0x32, 0xcb, 0x96, 0x8a, 0x69, 0x80, 0xd1, 0x62, 0x6b,
-	0x39, 0x1a, 0x73, 0xea, 0x95, 0xe3, 0x64, 0x42, 0x39, 0x44, 0x2b, 0xdc, 0x06, 0xd8, 0x0d, 0x4e,
-	0xf9, 0x1c, 0xaf, 0xfd, 0xef, 0xf9, 0x8b, 0xf9, 0xc5, 0x9a, 0xe1, 0xd6, 0x5b, 0x95, 0xa2, 0x4e,
-	0x9b, 0xaa, 0x4e, 0x9d, 0x26, 0x75, 0xc4, 0x9f, 0x15, 0xa7, 0x7a, 0x5f, 0x75, 0xdb, 0x16, 0x71,
-	0x8a, 0x37, 0x88, 0xae, 0x85, 0xbc, 0xd1, 0x87, 0x30, 0xed, 0x27, 0x13, 0x3c, 0x27, 0x5e, 0x9e,
-	0x2e, 0x4b, 0xd1, 0x7f, 0x74, 0xde, 0x20, 0x19, 0x8e, 0x6b, 0xe8, 0x8e, 0x36, 0x25, 0x50, 0xfc,
-	0x5b, 0xe8, 0x16, 0x64, 0xc3, 0x8d, 0x32, 0xc2, 0xba, 0x75, 0xa1, 0xa7, 0x6e, 0xd5, 0xc0, 0x0e,
-	0x1a, 0x29, 0x58, 0xfc, 0xbc, 0x1a, 0xfe, 0x12, 0x72, 0xd8, 0xb3, 0x11, 0xcb, 0xa1, 0x2e, 0x16,
-	0x7f, 0xac, 0x89, 0xa8, 0xd9, 0x0d, 0xc8, 0xf8, 0x9b, 0xae, 0xa7, 0xd1, 0xe1, 0xa6, 0xbc, 0x03,
-	0x02, 0x47, 0xe5, 0x5a, 0x6c, 0x24, 0x46, 0xdd, 0xe9, 0x61, 0xc7, 0x2a, 0xb6, 0x78, 0x6f, 0xc6,
-	0xfb, 0x0b, 0xaa, 0x9b, 0xde, 0xa4, 0xf3, 0x3b, 0x77, 0xc5, 0x5e, 0xf3, 0xe8, 0x2e, 0xa5, 0xd3,
-	0xe5, 0x8b, 0x2d, 0xea, 0xed, 0xcd, 0xfa, 0xd1, 0x48, 0xd0, 0xd0, 0xb7, 0xe4, 0x4d, 0xc8, 0x8a,
-	0x8e, 0xf6, 0xba, 0xa3, 0xaf, 0xdd, 0x06, 0x34, 0xf8, 0x8d, 0x10, 0x8c, 0x7a, 0x93, 0x26, 0x56,
-	0x1b, 0xfb, 0x8d, 0x72, 0x30, 0xc6, 0x26, 0x87, 0xcd, 0x46, 0x46, 0xe3, 0x17, 0xca, 0x37, 0xa3,
-	0x30, 0xc9, 0x18, 0x6c, 0x63, 0x83, 0xf3, 0x43, 0x9b, 0x00, 0x16, 0x36, 0xec, 0x32, 0x5b, 0x50,
-	0xa2, 0x9b, 0x8b, 0xde, 0x47, 0x60, 0x1f, 0x1d, 0x9d, 0xf1, 0x10, 0x18, 0xae, 0x07, 0xc7, 0x96,
-	0x05, 0x87, 0x1b, 0x1e, 0x0c, 0x2e, 0x78, 0xe3, 0xa3, 0x2d, 0xc8, 0xf2, 0xc5, 0xc1, 0xf1, 0x46,
-	0x06, 0xc2, 0xe3, 0xbb, 0x87, 0x03, 0x56, 0xe0, 0x08, 0xe3, 0xa7, 0xb7, 0x9a, 0x2d, 0x6f, 0x0a,
-	0x77, 0x7d, 0xe8, 0xd1, 0x81, 0xa0, 0x67, 0x3c, 0xb0, 0xf5, 0x00, 0x8b, 0xc7, 0xa8, 0xc2, 0x2c,
-	0x27, 0xdd, 0x11, 0x64, 0x6c, 0xa0, 0x20, 0x39, 0x86, 0xb6, 0x3f, 0xca, 0x02, 0x4c, 0xb2, 0x4c,
-	0x5c, 0xa3, 0x49, 0x1c, 0x17, 0x37, 0xad, 0xb9, 0xf1, 0x82, 0xb4, 0x3c, 0xa2, 0x1d, 0xf2, 0x4e,
-	0xef, 0xf9, 0x87, 0x68, 0x09, 0xa6, 0x38, 0x99, 0x3d, 0xbb, 0x03, 0xcc, 0x6e, 0x92, 0x1d, 0x07,
-	0x86, 0x8a, 0x29, 0xde, 0xf1, 0x91, 0x3e, 0x15, 0x33, 0xa1, 0xc1, 0x34, 0x7f, 0xfb, 0xb1, 0x56,
-	0xe9, 0x55, 0xc4, 0x44, 0x1a, 0x4d, 0x9b, 0xb4, 0x22, 0xd7, 0xa5, 0xe7, 0x47, 0x60, 0x8c, 0x05,
-	0x44, 0x5f, 0x4a, 0x30, 0xce, 0xe5, 0x07, 0xea, 0xb2, 0xf5, 0x3a, 0x55, 0x8f, 0xbc, 0xd2, 0xa3,
-	0x35, 0xcf, 0x42, 0x59, 0xfe, 0xec, 0x8f, 0x7f, 0xbe, 0x1e, 0x56, 0x50, 0x41, 0x4d, 0x94, 0x86,
-	0x5c, 0xf7, 0xa0, 0xef, 0x25, 0x38, 0x18, 0xd6, 0x35, 0xa8, 0x94, 0x12, 0x29, 0x46, 0x20, 0xc9,
-	0x17, 0xfb, 0xf2, 0x11, 0x1c, 0x55, 0xc6, 0xf1, 0x2c, 0x5a, 0x4a, 0xe6, 0x58, 0xc1, 0x66, 0xb5,
-	0xec, 0xab, 0x29, 0xf4, 0x93, 0x04, 0x53, 0xfb, 0xa4, 0x12, 0x7a, 0xad, 0x87, 0xc8, 0x9d, 0x5f,
-	0xcb, 0xf2, 0xeb, 0xfd, 0xba, 0x09, 0xce, 0x17, 0x19, 0xe7, 0x15, 0x74, 0x2e, 0x85, 0x73, 0xf8,
-	0x53, 0x1b, 0xfd, 0x22, 0x01, 0xea, 0xd4, 0x54, 0xe8, 0x72, 0x0f, 0x1c, 0x62, 0x85, 0x9a, 0xfc,
-	0xc6, 0x00, 0x9e, 0x22, 0x81, 0x4b, 0x2c, 0x81, 0x55, 0xa4, 0xa6, 0x24, 0x60, 0x54, 0xf4, 0x68,
-	0x12, 0xbf, 0x49, 0x90, 0x8b, 0x13, 0x61, 0xe8, 0x4a, 0x5a, 0x67, 0x26, 0xab, 0x3b, 0xf9, 0xcd,
-	0x81, 0x7c, 0x45, 0x2a, 0x97, 0x59, 0x2a, 0x25, 0x74, 0xa1, 0x4b, 0x8f, 0x7b, 0x6e, 0x3b, 0x84,
-	0xec, 0x7b, 0x20, 0xbf, 0x4a, 0x30, 0x13, 0xa3, 0xdd, 0x50, 0x5a, 0x5d, 0x93, 0x25, 0xa1, 0x7c,
-	0x65, 0x10, 0xd7, 0xde, 0x9f, 0x89, 0x2e, 0xdc, 0xa3, 0x79, 0x78, 0x03, 0xb1, 0x4f, 0xef, 0xa5,
-	0x0e, 0x44, 0xbc, 0x7c, 0x4c, 0x1d, 0x88, 0x04, 0x59, 0xd9, 0xcb, 0x40, 0x58, 0x6d, 0xb7, 0x1e,
-	0xe5, 0xfd, 0xa7, 0x04, 0xa8, 0x53, 0xe4, 0xa5, 0x0e, 0x44, 0xa2, 0xda, 0x4c, 0x1d, 0x88, 0x64,
-	0x45, 0xa9, 0xdc, 0x66, 0x09, 0xdc, 0x40, 0x6b, 0xdd, 0xba, 0x88, 0x7b, 0x87, 0x93, 0x50, 0x1f,
-	0xfb, 0xa7, 0x4f, 0xd4, 0xc7, 0x5c, 0x61, 0x3d, 0x41, 0x3f, 0x48, 0x70, 0x98, 0xbf, 0x53, 0x42,
-	0xea, 0x11, 0xad, 0xa6, 0x90, 0xeb, 0x14, 0xa1, 0x72, 0xa9, 0x1f, 0x17, 0x91, 0x48, 0x91, 0x25,
-	0xb2, 0x8c, 0x16, 0x93, 0x13, 0x69, 0x32, 0x37, 0x9e, 0x00, 0xfa, 0x5d, 0x82, 0xd9, 0x78, 0x25,
-	0x88, 0xae, 0xa6, 0x84, 0xef, 0xaa, 0x60, 0xe5, 0xb7, 0x06, 0xf4, 0x16, 0x79, 0x5c, 0x61, 0x79,
-	0xfc, 0x1f, 0x95, 0x92, 0xf3, 0xa8, 0x07, 0x08, 0xe5, 0x88, 0x52, 0x45, 0x3f, 0x4a, 0x30, 0xbd,
-	0x5f, 0xcc, 0xa0, 0xb4, 0xd6, 0x4e, 0x10, 0x83, 0xf2, 0xa5, 0xbe, 0xfd, 0x44, 0x06, 0xe7, 0x59,
-	0x06, 0x8b, 0xe8, 0x4c, 0x72, 0x06, 0x21, 0x5d, 0xf4, 0xb3, 0x04, 0x33, 0x31, 0x7a, 0x22, 0x75,
-	0x19, 0x25, 0xcb, 0x94, 0xd4, 0x65, 0xd4, 0x45, 0xbe, 0x28, 0xe7, 0x18, 0xf9, 0x05, 0x74, 0x3a,
-	0x7d, 0x1e, 0xd8, 0x9b, 0x2d, 0x17, 0xa7, 0x30, 0x50, 0x7f, 0x0c, 0x22, 0xb2, 0x26, 0xf5, 0xa5,
-	0xd0, 0x4d, 0xd2, 0x28, 0xab, 0x8c, 0xfe, 0x39, 0x74, 0xb6, 0xd7, 0x71, 0x76, 0xd0, 0x77, 0x12,
-	0x64, 0x43, 0x5f, 0x82, 0xa9, 0xf3, 0xda, 0xa9, 0x6e, 0x52, 0xe7, 0x35, 0xe6, 0x43, 0x53, 0x59,
-	0x62, 0x4c, 0x4f, 0xa1, 0xf9, 0x94, 0xd7, 0x17, 0xfa, 0x56, 0x82, 0x4c, 0xb0, 0x7e, 0x91, 0xda,
-	0xeb, 0xa2, 0xf6, 0xb9, 0x5d, 0xe8, 0xdd, 0xa1, 0xf7, 0xfe, 0xdd, 0xdb, 0xe9, 0x6b, 0x3b, 0x4f,
-	0x5f, 0xe6, 0xa5, 0x67, 0x2f, 0xf3, 0xd2, 0xdf, 0x2f, 0xf3, 0xd2, 0x57, 0xaf, 0xf2, 0x43, 0xcf,
-	0x5e, 0xe5, 0x87, 0xfe, 0x7a, 0x95, 0x1f, 0xfa, 0xf8, 0x4e, 0xe8, 0xa3, 0x7f, 0xc3, 0x47, 0xba,
-	0x83, 0x2b, 0xce, 0x1e, 0xee, 0x8a, 0x4e, 0x6d, 0x12, 0xbe, 0xac, 0x63, 0xc3, 0x14, 0x7b, 0xca,
-	0xf1, 0x83, 0x32, 0x79, 0x50, 0x19, 0x67, 0xff, 0xad, 0xb8, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0xe8, 0xd9, 0x82, 0x72, 0x19, 0x00, 0x00,
+	// 2003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x6f, 0xdb, 0xd6,
+	0x15, 0x0f, 0xfd, 0x15, 0xeb, 0x38, 0xb5, 0xd3, 0x6b, 0xd5, 0x91, 0x99, 0xd4, 0x56, 0xd8, 0xc4,
+	0x76, 0x92, 0x5a, 0x8c, 0xe5, 0x26, 0x69, 0x9c, 0xac, 0x40, 0x9c, 0x34, 0x99, 0xbb, 0x66, 0xc9,
+	0xd8, 0xac, 0x1b, 0xf6, 0x22, 0x50, 0x12, 0x2d, 0x71, 0x95, 0x48, 0x86, 0xa4, 0xdc, 0x0a, 0x41,
+	0x30, 0x60, 0x8f, 0xc3, 0x80, 0x0d, 0xd8, 0xd3, 0x80, 0xed, 0x7d, 0x18, 0xb0, 0x87, 0x0d, 0xd8,
+	0x80, 0x61, 0x1f, 0x0f, 0x03, 0x06, 0x74, 0x6f, 0x1d, 0x86, 0x01, 0xc3, 0x1e, 0x8a, 0x21, 0xd9,
+	0x1f, 0x32, 0xf0, 0x9e, 0xc3, 0x2b, 0xd2, 0x22, 0x45, 0x4a, 0x6b, 0x9f, 0xac, 0x7b, 0x79, 0x3e,
+	0x7e, 0xe7, 0xdc, 0x73, 0xce, 0xbd, 0xe7, 0x18, 0x2e, 0x98, 0xd6, 0x77, 0x8d, 0x86, 0x6f, 0x1e,
+	0x19, 0xaa, 0xed, 0xea, 0x8d, 0x8e, 0xa1, 0x1e, 0xed, 0xd4, 0x0d, 0x5f, 0xdf, 0x51, 0x9f, 0xf6,
+	0x0c, 0xb7, 0x5f, 0x71, 0x5c, 0xdb, 0xb7, 0x59, 0x49, 0x50, 0x55, 0x90, 0xaa, 0x42, 0x54, 0xf2,
+	0xb9, 0x96, 0x6d, 0xb7, 0x3a, 0x86, 0xaa, 0x3b, 0xa6, 0xaa, 0x5b, 0x96, 0xed, 0xeb, 0xbe, 0x69,
+	0x5b, 0x1e, 0xf2, 0xc9, 0x17, 0x53, 0xa5, 0x93, 0x18, 0x24, 0xdb, 0x48, 0x25, 0x6b, 0x19, 0x96,
+	0xe1, 0x99, 0xa1, 0xb8, 0x62, 0xcb, 0x6e, 0xd9, 0xfc, 0xa7, 0x1a, 0xfc, 0xa2, 0xdd, 0xcb, 0x0d,
+	0xdb, 0xeb, 0xda, 0x9e, 0x5a, 0xd7, 0x3d, 0x03, 0x51, 0x0b, 0x76, 0x47, 0x6f, 0x99, 0x16, 0x47,
+	0x84, 0xb4, 0x4a, 0x15, 0x5e, 0xfb, 0x46, 0x40, 0xf1, 0xb8, 0xef, 0xb7, 0x1f, 0xbb, 0x66, 0xc3,
+	0xd0, 0x8c, 0xa7, 0x3d, 0xc3, 0xf3, 0xd9, 0x2a, 0xcc, 0x3b, 0xc1, 0xba, 0x66, 0x36, 0x4b, 0x52,
+	0x59, 0xda, 0x2a, 0x68, 0x27, 0xf9, 0xfa, 0xa0, 0xa9, 0x34, 0x60, 0xe5, 0x38, 0x8f, 0xe7, 0xd8,
+	0x96, 0x67, 0xb0, 0x03, 0x58, 0x40, 0x26, 0xcf, 0xd7, 0x7d, 0x83, 0xf3, 0x2d, 0x54, 0xb7, 0x2a,
+	0x69, 0xce, 0xaa, 0x08, 0x09, 0x1f, 0x04, 0xf4, 0x1a, 0x38, 0xe2, 0xb7, 0x52, 0x04, 0x86, 0x4a,
+	0x74, 0x57, 0xef, 0x7a, 0x84, 0x4a, 0xf9, 0x26, 0x2c, 0xc7, 0x76, 0x49, 0xef, 0x3b, 0x30, 0xe7,
+	0xf0, 0x1d, 0x52, 0x59, 0x1e, 0xa1, 0x92, 0xd3, 0xed, 0xcf, 0x7c, 0xfa, 0xf9, 0xfa, 0x09, 0x8d,
+	0xb8, 0x14, 0x19, 0x4a, 0x5c, 0xec, 0xbe, 0x6e, 0x35, 0x35, 0xa3, 0xa3, 0xf7, 0x0d, 0x57, 0xa8,
+	0xbc, 0x01, 0xab, 0x09, 0xdf, 0x48, 0xb1, 0x0c, 0xf3, 0x2e, 0xed, 0x95, 0xa4, 0xf2, 0xf4, 0x56,
+	0x41, 0x13, 0x6b, 0xe5, 0x75, 0x38, 0x2b, 0x18, 0x07, 0x46, 0x0a, 0xb9, 0x1f, 0xc1, 0xb9, 0xe4,
+	0xcf, 0x24, 0xfa, 0x6b, 0x70, 0x2a, 0xe2, 0x4b, 0x14, 0x3f, 0xd2, 0x99, 0x71, 0x41, 0xda, 0xc2,
+	0xc0, 0x99, 0x9e, 0x52, 0x86, 0x35, 0xa1, 0xec, 0x60, 0xff, 0x6e, 0x02, 0x1c, 0x0b, 0xd6, 0x53,
+	0x29, 0xbe, 0x0c, 0x44, 0x0a, 0x94, 0xf1, 0x24, 0x83, 0xbd, 0xfb, 0x86, 0x91, 0xe4, 0x22, 0x07,
+	0xce, 0x8f, 0xa0, 0x99, 0x14, 0x95, 0x90, 0x96, 0x80, 0xea, 0x3c, 0x79, 0xe1, 0xae, 0x6d, 0x5a,
+	0x41, 0xfa, 0x24, 0x80, 0xf2, 0xa0, 0x9c, 0x4e, 0x42, 0x98, 0x1e, 0x25, 0x62, 0x7a, 0x33, 0x1d,
+	0xd3, 0xb0, 0xb0, 0x38, 0xae, 0x30, 0x96, 0xe2, 0x09, 0x33, 0x14, 0x4b, 0x43, 0x9f, 0x27, 0xf6,
+	0x51, 0x3c, 0x31, 0x63, 0x58, 0x9e, 0x50, 0x2c, 0x3d, 0x76, 0xed, 0x23, 0xb3, 0x69, 0xb8, 0x11,
+	0x3a, 0xaa, 0x1d, 0x72, 0x50, 0x3b, 0xf0, 0x23, 0xd5, 0x0e, 0xb1, 0x66, 0x2b, 0x30, 0xe7, 0xf5,
+	0xbb, 0x75, 0xbb, 0x53, 0x9a, 0xe2, 0x5f, 0x68, 0xa5, 0xb4, 0x61, 0x3d, 0x55, 0x2a, 0x59, 0xf1,
+	0x6e, 0x52, 0x75, 0xb9, 0x90, 0x71, 0xd0, 0xc3, 0x95, 0x65, 0x15, 0xce, 0x70, 0x4d, 0x0f, 0xed,
+	0x66, 0xaf, 0x13, 0x03, 0xae, 0x7c, 0x1b, 0x4a, 0xc3, 0x9f, 0x48, 0xfb, 0x6d, 0x98, 0x8d, 0xea,
+	0xdd, 0x48, 0xd7, 0xfb, 0x00, 0x6b, 0x34, 0xb2, 0x23, 0x93, 0xf2, 0x3d, 0x50, 0xb8, 0xe4, 0xaf,
+	0x9a, 0x9e, 0x6f, 0xbb, 0x66, 0x43, 0xef, 0x50, 0xe5, 0x6c, 0xd8, 0x6e, 0x33, 0x3c, 0x47, 0x76,
+	0x1b, 0xe6, 0x50, 0x16, 0x57, 0xb2, 0x38, 0xca, 0xb8, 0x47, 0x7c, 0xf9, 0xa4, 0xef, 0x18, 0x1a,
+	0xf1, 0xb0, 0xb3, 0x50, 0x40, 0x67, 0x06, 0x35, 0x1b, 0xbd, 0x3b, 0x8f, 0x1b, 0x07, 0x4d, 0xc5,
+	0x85, 0x37, 0x46, 0x02, 0x10, 0x91, 0xf2, 0x0a, 0xfa, 0xd8, 0xc5, 0x0f, 0x14, 0x2a, 0x1b, 0x19,
+	0x5e, 0x0e, 0xc5, 0x9c, 0x72, 0x22, 0x2b, 0xe5, 0x07, 0x12, 0x14, 0x11, 0x27, 0x6a, 0xed, 0x3f,
+	0x72, 0xf8, 0x65, 0xc8, 0xce, 0xc0, 0xc9, 0xae, 0xfe, 0x49, 0x4d, 0x6f, 0xa1, 0xa1, 0x33, 0xda,
+	0x5c, 0x57, 0xff, 0xe4, 0x4e, 0xcb, 0x60, 0x15, 0x58, 0x36, 0xad, 0x46, 0xa7, 0xd7, 0x34, 0x6a,
+	0xae, 0xfe, 0x71, 0xad, 0x8d, 0x6c, 0xdc, 0x98, 0x79, 0xed, 0x55, 0xfa, 0xa4, 0xe9, 0x1f, 0x93,
+	0x3c, 0x76, 0x09, 0x4e, 0x87, 0xf4, 0x5d, 0xc3, 0xd7, 0x9b, 0xba, 0xaf, 0x97, 0xa6, 0x39, 0xf1,
+	0x12, 0xed, 0x3f, 0xa4, 0x6d, 0xe5, 0x87, 0x53, 0x94, 0x24, 0x88, 0xe8, 0x43, 0xbb, 0xa3, 0xfb,
+	0x66, 0xc7, 0xf4, 0xfb, 0xa1, 0xf3, 0xef, 0x40, 0x21, 0x48, 0xc1, 0x9a, 0x69, 0x1d, 0xda, 0xd9,
+	0xc1, 0x85, 0x52, 0x0e, 0xac, 0x43, 0x5b, 0x9b, 0x0f, 0xd8, 0x82, 0x5f, 0xec, 0x2e, 0xc0, 0xd3,
+	0x9e, 0xed, 0x93, 0x8c, 0xa9, 0x31, 0x64, 0x14, 0x38, 0x1f, 0x17, 0xd2, 0x84, 0x15, 0xa4, 0x0b,
+	0xcd, 0xaf, 0xd9, 0xe8, 0x36, 0x6e, 0xd9, 0x42, 0xb5, 0x92, 0x25, 0x30, 0xee, 0x6c, 0xad, 0x68,
+	0x27, 0xec, 0x06, 0xee, 0x78, 0x3d, 0xc5, 0x1d, 0x14, 0x0a, 0xef, 0x01, 0x1c, 0x89, 0x5d, 0xcc,
+	0xe3, 0xfd, 0xcb, 0xff, 0xfe, 0x7c, 0x7d, 0xa3, 0x65, 0xfa, 0xed, 0x5e, 0xbd, 0xd2, 0xb0, 0xbb,
+	0x2a, 0xbd, 0x34, 0xf0, 0xcf, 0xb6, 0xd7, 0xfc, 0x48, 0xf5, 0xfb, 0x8e, 0xe1, 0x55, 0xee, 0x19,
+	0x0d, 0x2d, 0xc2, 0xcd, 0xbe, 0x05, 0xa7, 0x43, 0x63, 0xc4, 0x39, 0xa1, 0x7b, 0x46, 0x14, 0xc5,
+	0xf0, 0xe8, 0x82, 0x44, 0x32, 0x3d, 0xdf, 0x6c, 0x78, 0xda, 0x12, 0x49, 0x09, 0x3f, 0xb1, 0xfb,
+	0xb0, 0x10, 0x0d, 0x94, 0x69, 0x1e, 0xad, 0x17, 0x73, 0x45, 0xab, 0x06, 0xae, 0x08, 0x24, 0x51,
+	0xf8, 0xd1, 0x1b, 0x61, 0x11, 0xf2, 0xf8, 0xd9, 0x50, 0x71, 0x68, 0x43, 0x39, 0x9d, 0x84, 0x7c,
+	0x76, 0x0f, 0x0a, 0x61, 0xa5, 0xcb, 0x95, 0x3a, 0x48, 0x8a, 0x11, 0x20, 0x18, 0x95, 0x77, 0x12,
+	0x35, 0x71, 0xe8, 0x5e, 0x8e, 0x1a, 0xab, 0xb8, 0x70, 0x7e, 0x04, 0x3f, 0x41, 0x7d, 0x18, 0x64,
+	0x3a, 0x7e, 0xf9, 0x80, 0xea, 0x5a, 0x00, 0x77, 0x33, 0x1b, 0x2e, 0x16, 0xb6, 0x38, 0x77, 0x90,
+	0xeb, 0x67, 0x62, 0x4a, 0x23, 0x6f, 0xc9, 0x77, 0x61, 0x81, 0x22, 0x3a, 0x88, 0x8e, 0xb1, 0x6a,
+	0x1b, 0xd8, 0xe2, 0x37, 0x63, 0x30, 0x13, 0x64, 0x1a, 0x95, 0x36, 0xfe, 0x9b, 0x15, 0x61, 0x96,
+	0x67, 0x0e, 0xcf, 0x8d, 0x82, 0x86, 0x0b, 0xe5, 0xa7, 0x33, 0xb0, 0xc8, 0x11, 0x3c, 0xd6, 0x4d,
+	0xc4, 0xc7, 0x1e, 0x02, 0x38, 0xba, 0xe9, 0xd6, 0x78, 0x81, 0xa2, 0x68, 0xae, 0x04, 0x8f, 0xc0,
+	0x31, 0x22, 0xba, 0x10, 0x48, 0xe0, 0x72, 0x03, 0x71, 0xbc, 0x58, 0xa0, 0xb8, 0xa9, 0xc9, 0xc4,
+	0x89, 0x1b, 0x9f, 0x3d, 0x82, 0x05, 0x2c, 0x1c, 0x28, 0x6f, 0x7a, 0x22, 0x79, 0x58, 0x7b, 0x50,
+	0x60, 0x1d, 0x5e, 0xe3, 0xf8, 0x1a, 0xbd, 0x6e, 0x2f, 0xc8, 0xc2, 0xa3, 0x50, 0xf4, 0xcc, 0x44,
+	0xa2, 0x97, 0x03, 0x61, 0x77, 0x85, 0x2c, 0xd4, 0xd1, 0x84, 0x15, 0x04, 0x3d, 0xa4, 0x64, 0x76,
+	0x22, 0x25, 0x45, 0x2e, 0xed, 0xb8, 0x96, 0x8b, 0xb0, 0xc8, 0x2d, 0xf1, 0xcd, 0xae, 0xe1, 0xf9,
+	0x7a, 0xd7, 0x29, 0xcd, 0x95, 0xa5, 0xad, 0x69, 0xed, 0x95, 0x60, 0xf7, 0x49, 0xb8, 0xc9, 0x36,
+	0x61, 0x09, 0xc1, 0x0c, 0xe8, 0x4e, 0x72, 0xba, 0x45, 0xbe, 0x2d, 0x08, 0x15, 0x8b, 0xee, 0xf8,
+	0x58, 0x9c, 0x52, 0x4e, 0x68, 0x70, 0x1a, 0x6f, 0x3f, 0x1e, 0x2a, 0x79, 0x9b, 0x98, 0x58, 0xa0,
+	0x69, 0x8b, 0x4e, 0x6c, 0xad, 0xbc, 0x45, 0xfa, 0xa8, 0x77, 0xf8, 0xba, 0x6d, 0x0d, 0x12, 0xa3,
+	0x04, 0x27, 0xa9, 0x5d, 0x08, 0x7b, 0x2c, 0x5a, 0x2a, 0x3b, 0xb0, 0x9a, 0xc0, 0x45, 0x30, 0x8b,
+	0x30, 0x6b, 0x05, 0x1b, 0x74, 0x79, 0xe2, 0x42, 0x31, 0x63, 0x25, 0x4c, 0x33, 0x1c, 0xdb, 0xf5,
+	0x31, 0x3b, 0x45, 0xd1, 0xb8, 0x0f, 0x30, 0xe8, 0x00, 0xc5, 0x43, 0x06, 0x0f, 0xa3, 0x12, 0xf8,
+	0xb3, 0x82, 0x4d, 0xae, 0x30, 0x4d, 0x6f, 0x85, 0x58, 0xb5, 0x08, 0xa7, 0xf2, 0x3b, 0x09, 0xca,
+	0xe9, 0xba, 0x44, 0x33, 0xc8, 0xdf, 0x3e, 0x61, 0x1d, 0xdc, 0xce, 0xca, 0xf7, 0x98, 0x14, 0x6a,
+	0xd0, 0x50, 0x02, 0x7b, 0x10, 0xc3, 0x8d, 0x17, 0xc7, 0x66, 0x26, 0x6e, 0xc4, 0x11, 0x03, 0x5e,
+	0x1f, 0x3e, 0xfc, 0x2f, 0xdc, 0x39, 0xbf, 0x92, 0x60, 0x35, 0x41, 0x09, 0x79, 0xe5, 0x01, 0xcc,
+	0xf1, 0x00, 0x09, 0xdd, 0x72, 0x29, 0xcb, 0x2d, 0xa2, 0x5d, 0x11, 0x3d, 0x2b, 0x67, 0xff, 0xe2,
+	0x7c, 0x72, 0x8d, 0x7a, 0x8b, 0x3b, 0xad, 0x96, 0x6b, 0xb4, 0x74, 0x9f, 0xda, 0xac, 0xd0, 0x2d,
+	0x83, 0x07, 0xbb, 0x14, 0x7b, 0xb0, 0xff, 0x51, 0x82, 0x73, 0xc9, 0x7c, 0xe2, 0x2e, 0x9c, 0xfd,
+	0x7f, 0x8a, 0x2d, 0x32, 0xb3, 0x0f, 0x61, 0x29, 0xbc, 0x68, 0x6a, 0xe4, 0xb8, 0xa9, 0xbc, 0x17,
+	0x15, 0xc7, 0x43, 0x6e, 0x5b, 0x74, 0xa2, 0x9b, 0x5e, 0xf5, 0x0f, 0x67, 0x61, 0x96, 0xc3, 0x67,
+	0x3f, 0x92, 0x60, 0x0e, 0xa7, 0x02, 0x6c, 0xc4, 0x63, 0x64, 0x78, 0x18, 0x21, 0x6f, 0xe7, 0xa4,
+	0x46, 0x7f, 0x28, 0x5b, 0xdf, 0xff, 0xc7, 0x7f, 0x7f, 0x32, 0xa5, 0xb0, 0xb2, 0x9a, 0x3a, 0xdd,
+	0xc1, 0x71, 0x04, 0xfb, 0x85, 0x04, 0xa7, 0xa2, 0xe3, 0x06, 0x56, 0xcd, 0xd0, 0x94, 0x30, 0xb7,
+	0x90, 0x77, 0xc7, 0xe2, 0x21, 0x8c, 0x2a, 0xc7, 0x78, 0x89, 0x6d, 0xa6, 0x63, 0xac, 0xeb, 0x56,
+	0xb3, 0x16, 0x0e, 0x39, 0xd8, 0x6f, 0x25, 0x58, 0x3a, 0x36, 0xc1, 0x60, 0xd7, 0x72, 0x68, 0x1e,
+	0x6e, 0x62, 0xe5, 0xeb, 0xe3, 0xb2, 0x11, 0xe6, 0x5d, 0x8e, 0x79, 0x9b, 0x5d, 0xc9, 0xc0, 0x1c,
+	0xed, 0x80, 0xd9, 0x5f, 0x24, 0x60, 0xc3, 0xa3, 0x0e, 0xf6, 0x76, 0x0e, 0x0c, 0x89, 0xf3, 0x13,
+	0xf9, 0xe6, 0x04, 0x9c, 0x64, 0xc0, 0x0d, 0x6e, 0xc0, 0x0e, 0x53, 0x33, 0x0c, 0x30, 0xeb, 0x8d,
+	0xb8, 0x11, 0x7f, 0x93, 0xa0, 0x98, 0x34, 0x1b, 0x61, 0x7b, 0x59, 0x91, 0x99, 0x3e, 0x74, 0x91,
+	0x6f, 0x4d, 0xc4, 0x4b, 0xa6, 0xbc, 0xcd, 0x4d, 0xa9, 0xb2, 0xab, 0x23, 0x62, 0x3c, 0x60, 0x3b,
+	0x34, 0x8c, 0x63, 0x07, 0xf2, 0x57, 0x09, 0x96, 0x13, 0x46, 0x2a, 0x2c, 0xcb, 0xaf, 0xe9, 0x93,
+	0x1a, 0x79, 0x6f, 0x12, 0xd6, 0xfc, 0x67, 0xd2, 0x20, 0xf6, 0xb8, 0x1d, 0x41, 0x42, 0x1c, 0x1b,
+	0xc3, 0x64, 0x26, 0x44, 0xf2, 0x54, 0x47, 0xbe, 0x3e, 0x2e, 0x5b, 0xfe, 0x84, 0x70, 0xfa, 0x7e,
+	0x3b, 0x8e, 0xfb, 0x9f, 0x12, 0xb0, 0xe1, 0xd9, 0x4b, 0x66, 0x42, 0xa4, 0x0e, 0x81, 0xe4, 0x9b,
+	0x13, 0x70, 0x92, 0x01, 0xef, 0x71, 0x03, 0xee, 0xb1, 0xfd, 0x51, 0x51, 0x14, 0xbd, 0x13, 0xd0,
+	0x08, 0xf5, 0x59, 0xb8, 0xfb, 0x5c, 0x7d, 0x86, 0xb7, 0xd4, 0x73, 0xf6, 0x4b, 0x09, 0x5e, 0xc5,
+	0x8b, 0x34, 0x32, 0xd4, 0x61, 0x3b, 0x19, 0xe0, 0x86, 0x67, 0x43, 0x72, 0x75, 0x1c, 0x16, 0x32,
+	0xa4, 0xc2, 0x0d, 0xd9, 0x62, 0x1b, 0xe9, 0x86, 0x74, 0x39, 0x1b, 0x1a, 0xc0, 0xfe, 0x2e, 0xc1,
+	0x4a, 0xf2, 0x80, 0x86, 0xdd, 0xce, 0x50, 0x3f, 0x72, 0xb0, 0x24, 0x7f, 0x65, 0x42, 0x6e, 0xb2,
+	0x63, 0x8f, 0xdb, 0xf1, 0x16, 0xab, 0xa6, 0xdb, 0xd1, 0x16, 0x12, 0x6a, 0xb1, 0x01, 0x12, 0xfb,
+	0xb5, 0x04, 0xa7, 0x8f, 0xcf, 0x18, 0x58, 0x56, 0x68, 0xa7, 0xcc, 0x68, 0xe4, 0x1b, 0x63, 0xf3,
+	0x91, 0x05, 0x6f, 0x72, 0x0b, 0x36, 0xd8, 0x85, 0x74, 0x0b, 0x22, 0xe3, 0x8a, 0xdf, 0x4b, 0xb0,
+	0x9c, 0xd0, 0xe6, 0x67, 0x16, 0xa3, 0xf4, 0xe9, 0x81, 0xbc, 0x37, 0x09, 0x2b, 0x81, 0xbf, 0xc2,
+	0xc1, 0x5f, 0x64, 0x6f, 0x64, 0xe7, 0x03, 0xbf, 0xd9, 0x8a, 0x49, 0x8d, 0x3f, 0x1b, 0x0f, 0x41,
+	0xec, 0x6d, 0x2c, 0xdf, 0x9a, 0x88, 0x97, 0xe0, 0xef, 0x70, 0xf8, 0x57, 0xd8, 0xa5, 0xbc, 0xe9,
+	0xec, 0xb1, 0x9f, 0x4b, 0xb0, 0x10, 0x79, 0xfe, 0x66, 0xe6, 0xeb, 0xf0, 0xd0, 0x41, 0xae, 0x8e,
+	0xc3, 0x42, 0x48, 0x37, 0x39, 0xd2, 0xf3, 0x6c, 0x3d, 0xe3, 0xfa, 0x62, 0x3f, 0x93, 0xa0, 0x20,
+	0xca, 0x2f, 0x53, 0xf3, 0x16, 0xea, 0x10, 0xdb, 0xd5, 0xfc, 0x0c, 0xf9, 0xe3, 0x77, 0x50, 0xd3,
+	0xd9, 0x6f, 0x24, 0x38, 0x15, 0xed, 0x1c, 0x33, 0x1f, 0x90, 0x09, 0xcd, 0xa9, 0xbc, 0x3b, 0x16,
+	0x0f, 0xe1, 0xbc, 0xc9, 0x71, 0xee, 0xb2, 0x9d, 0x74, 0x9c, 0xf4, 0x76, 0xac, 0xf1, 0xae, 0x55,
+	0x7d, 0x46, 0xcb, 0xe7, 0xec, 0xcf, 0x22, 0xe9, 0x62, 0x9d, 0x60, 0xce, 0xa4, 0x4b, 0xea, 0x77,
+	0xe5, 0xbd, 0x49, 0x58, 0xc9, 0x92, 0xab, 0xdc, 0x92, 0xcb, 0x6c, 0x6b, 0x94, 0x25, 0xc8, 0x58,
+	0xc3, 0x2e, 0x35, 0x78, 0xb6, 0x47, 0x7b, 0x3e, 0x36, 0x46, 0x08, 0xe6, 0x7e, 0xb6, 0x27, 0x35,
+	0x95, 0x79, 0x9e, 0xed, 0xb8, 0x0c, 0xf3, 0xeb, 0x4f, 0x12, 0x2c, 0x1d, 0xeb, 0xdb, 0x32, 0x5f,
+	0x29, 0xc9, 0xfd, 0xa1, 0x7c, 0x7d, 0x5c, 0x36, 0xc2, 0x7c, 0x8b, 0x63, 0xbe, 0xc6, 0x76, 0xd3,
+	0x31, 0xeb, 0x82, 0x15, 0x71, 0x8b, 0x5b, 0x7d, 0xff, 0xf0, 0xd3, 0x17, 0x6b, 0xd2, 0x67, 0x2f,
+	0xd6, 0xa4, 0xff, 0xbc, 0x58, 0x93, 0x7e, 0xfc, 0x72, 0xed, 0xc4, 0x67, 0x2f, 0xd7, 0x4e, 0xfc,
+	0xeb, 0xe5, 0xda, 0x89, 0xef, 0xbc, 0x1f, 0x69, 0x2f, 0x0f, 0x42, 0xc1, 0xef, 0xeb, 0x75, 0x6f,
+	0xa0, 0x66, 0xbb, 0x61, 0xbb, 0x46, 0x74, 0xd9, 0xd6, 0x4d, 0x8b, 0x2e, 0x62, 0x2f, 0xc4, 0xc0,
+	0x1b, 0xd1, 0xfa, 0x1c, 0xff, 0x2f, 0xf9, 0xee, 0xff, 0x06, 0x00, 0x85, 0x88, 0x44, 0x06, 0x16,
+	0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1688,6 +2106,15 @@ type QueryClient interface {
 	OracleProviderPrices(ctx context.Context, in *QueryOracleProviderPricesRequest, opts ...grpc.CallOption) (*QueryOracleProviderPricesResponse, error)
 	OraclePrice(ctx context.Context, in *QueryOraclePriceRequest, opts ...grpc.CallOption) (*QueryOraclePriceResponse, error)
 	PythPrice(ctx context.Context, in *QueryPythPriceRequest, opts ...grpc.CallOption) (*QueryPythPriceResponse, error)
+	// Retrieves the last nonce accepted from a relayer
+	RelayerNonce(ctx context.Context, in *QueryRelayerNonceRequest, opts ...grpc.CallOption) (*QueryRelayerNonceResponse, error)
+	// Retrieves the oracle report counts of the validators within the reporter
+	// stats window
+	OracleReporterStats(ctx context.Context, in *QueryOracleReporterStatsRequest, opts ...grpc.CallOption) (*QueryOracleReporterStatsResponse, error)
+	// Retrieves the current price of every oracle price feed with its freshness
+	OraclePrices(ctx context.Context, in *QueryOraclePricesRequest, opts ...grpc.CallOption) (*QueryOraclePricesResponse, error)
+	// Retrieves the price of a symbol aggregated across the oracle providers
+	AggregatedPrice(ctx context.Context, in *QueryAggregatedPriceRequest, opts ...grpc.CallOption) (*QueryAggregatedPriceResponse, error)
 }
 
 type queryClient struct {
@@ -1833,6 +2260,42 @@ func (c *queryClient) PythPrice(ctx context.Context, in *QueryPythPriceRequest,
 	return out, nil
 }
 
+func (c *queryClient) RelayerNonce(ctx context.Context, in *QueryRelayerNonceRequest, opts ...grpc.CallOption) (*QueryRelayerNonceResponse, error) {
+	out := new(QueryRelayerNonceResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/RelayerNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleReporterStats(ctx context.Context, in *QueryOracleReporterStatsRequest, opts ...grpc.CallOption) (*QueryOracleReporterStatsResponse, error) {
+	out := new(QueryOracleReporterStatsResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleReporterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OraclePrices(ctx context.Context, in *QueryOraclePricesRequest, opts ...grpc.CallOption) (*QueryOraclePricesResponse, error) {
+	out := new(QueryOraclePricesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OraclePrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatedPrice(ctx context.Context, in *QueryAggregatedPriceRequest, opts ...grpc.CallOption) (*QueryAggregatedPriceResponse, error) {
+	out := new(QueryAggregatedPriceResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/AggregatedPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Retrieves oracle params
@@ -1861,6 +2324,15 @@ type QueryServer interface {
 	OracleProviderPrices(context.Context, *QueryOracleProviderPricesRequest) (*QueryOracleProviderPricesResponse, error)
 	OraclePrice(context.Context, *QueryOraclePriceRequest) (*QueryOraclePriceResponse, error)
 	PythPrice(context.Context, *QueryPythPriceRequest) (*QueryPythPriceResponse, error)
+	// Retrieves the last nonce accepted from a relayer
+	RelayerNonce(context.Context, *QueryRelayerNonceRequest) (*QueryRelayerNonceResponse, error)
+	// Retrieves the oracle report counts of the validators within the reporter
+	// stats window
+	OracleReporterStats(context.Context, *QueryOracleReporterStatsRequest) (*QueryOracleReporterStatsResponse, error)
+	// Retrieves the current price of every oracle price feed with its freshness
+	OraclePrices(context.Context, *QueryOraclePricesRequest) (*QueryOraclePricesResponse, error)
+	// Retrieves the price of a symbol aggregated across the oracle providers
+	AggregatedPrice(context.Context, *QueryAggregatedPriceRequest) (*QueryAggregatedPriceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1912,6 +2384,18 @@ func (*UnimplementedQueryServer) OraclePrice(ctx context.Context, req *QueryOrac
 func (*UnimplementedQueryServer) PythPrice(ctx context.Context, req *QueryPythPriceRequest) (*QueryPythPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PythPrice not implemented")
 }
+func (*UnimplementedQueryServer) RelayerNonce(ctx context.Context, req *QueryRelayerNonceRequest) (*QueryRelayerNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerNonce not implemented")
+}
+func (*UnimplementedQueryServer) OracleReporterStats(ctx context.Context, req *QueryOracleReporterStatsRequest) (*QueryOracleReporterStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleReporterStats not implemented")
+}
+func (*UnimplementedQueryServer) OraclePrices(ctx context.Context, req *QueryOraclePricesRequest) (*QueryOraclePricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OraclePrices not implemented")
+}
+func (*UnimplementedQueryServer) AggregatedPrice(ctx context.Context, req *QueryAggregatedPriceRequest) (*QueryAggregatedPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedPrice not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2187,10 +2671,82 @@ func _Query_PythPrice_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "injective.oracle.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _Query_RelayerNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/RelayerNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerNonce(ctx, req.(*QueryRelayerNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleReporterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleReporterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleReporterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OracleReporterStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleReporterStats(ctx, req.(*QueryOracleReporterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OraclePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOraclePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OraclePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OraclePrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OraclePrices(ctx, req.(*QueryOraclePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatedPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatedPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatedPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/AggregatedPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatedPrice(ctx, req.(*QueryAggregatedPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.oracle.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2251,6 +2807,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PythPrice",
 			Handler:    _Query_PythPrice_Handler,
 		},
+		{
+			MethodName: "RelayerNonce",
+			Handler:    _Query_RelayerNonce_Handler,
+		},
+		{
+			MethodName: "OracleReporterStats",
+			Handler:    _Query_OracleReporterStats_Handler,
+		},
+		{
+			MethodName: "OraclePrices",
+			Handler:    _Query_OraclePrices_Handler,
+		},
+		{
+			MethodName: "AggregatedPrice",
+			Handler:    _Query_AggregatedPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/oracle/v1beta1/query.proto",
@@ -3389,225 +3961,334 @@ func (m *QueryOraclePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRelayerNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPythPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryRelayerNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPythPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRelayerNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PriceState != nil {
-		l = m.PriceState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRelayerNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBandRelayersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRelayerNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBandRelayersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryOracleReporterStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBandPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryOracleReporterStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBandPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOracleReporterStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBandIBCPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOracleReporterStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBandIBCPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOracleReporterStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleReporterStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPriceFeedPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOraclePricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPriceFeedPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOraclePricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclePricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCoinbasePriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOraclePricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCoinbasePriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOraclePricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclePricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPythPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregatedPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatedPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatedPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPythPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregatedPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatedPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatedPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProviderPrices) > 0 {
+		for iNdEx := len(m.ProviderPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProviderPriceStateRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPythPriceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Symbol)
+	l = len(m.PriceId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryProviderPriceStateResponse) Size() (n int) {
+func (m *QueryPythPriceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3620,7 +4301,7 @@ func (m *QueryProviderPriceStateResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryModuleStateRequest) Size() (n int) {
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3629,105 +4310,82 @@ func (m *QueryModuleStateRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryModuleStateResponse) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryHistoricalPriceRecordsRequest) Size() (n int) {
+func (m *QueryBandRelayersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovQuery(uint64(m.Oracle))
-	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryHistoricalPriceRecordsResponse) Size() (n int) {
+func (m *QueryBandRelayersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PriceRecords) > 0 {
-		for _, e := range m.PriceRecords {
-			l = e.Size()
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *OracleHistoryOptions) Size() (n int) {
+func (m *QueryBandPriceStatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MaxAge != 0 {
-		n += 1 + sovQuery(uint64(m.MaxAge))
-	}
-	if m.IncludeRawHistory {
-		n += 2
-	}
-	if m.IncludeMetadata {
-		n += 2
-	}
 	return n
 }
 
-func (m *QueryOracleVolatilityRequest) Size() (n int) {
+func (m *QueryBandPriceStatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BaseInfo != nil {
-		l = m.BaseInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.QuoteInfo != nil {
-		l = m.QuoteInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.OracleHistoryOptions != nil {
-		l = m.OracleHistoryOptions.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryOracleVolatilityResponse) Size() (n int) {
+func (m *QueryBandIBCPriceStatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Volatility != nil {
-		l = m.Volatility.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.HistoryMetadata != nil {
-		l = m.HistoryMetadata.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBandIBCPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.RawHistory) > 0 {
-		for _, e := range m.RawHistory {
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3735,7 +4393,7 @@ func (m *QueryOracleVolatilityResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryOracleProvidersInfoRequest) Size() (n int) {
+func (m *QueryPriceFeedPriceStatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3744,14 +4402,14 @@ func (m *QueryOracleProvidersInfoRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryOracleProvidersInfoResponse) Size() (n int) {
+func (m *QueryPriceFeedPriceStatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3759,27 +4417,23 @@ func (m *QueryOracleProvidersInfoResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryOracleProviderPricesRequest) Size() (n int) {
+func (m *QueryCoinbasePriceStatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryOracleProviderPricesResponse) Size() (n int) {
+func (m *QueryCoinbasePriceStatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ProviderState) > 0 {
-		for _, e := range m.ProviderState {
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3787,17 +4441,238 @@ func (m *QueryOracleProviderPricesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryOraclePriceRequest) Size() (n int) {
+func (m *QueryPythPriceStatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.OracleType != 0 {
-		n += 1 + sovQuery(uint64(m.OracleType))
+	return n
+}
+
+func (m *QueryPythPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Base)
-	if l > 0 {
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPriceStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPriceStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceState != nil {
+		l = m.PriceState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalPriceRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovQuery(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalPriceRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceRecords) > 0 {
+		for _, e := range m.PriceRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleHistoryOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAge != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAge))
+	}
+	if m.IncludeRawHistory {
+		n += 2
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOracleVolatilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		l = m.BaseInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QuoteInfo != nil {
+		l = m.QuoteInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OracleHistoryOptions != nil {
+		l = m.OracleHistoryOptions.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleVolatilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Volatility != nil {
+		l = m.Volatility.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HistoryMetadata != nil {
+		l = m.HistoryMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RawHistory) > 0 {
+		for _, e := range m.RawHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleProvidersInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOracleProvidersInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleProviderPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleProviderPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderState) > 0 {
+		for _, e := range m.ProviderState {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOraclePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovQuery(uint64(m.OracleType))
+	}
+	l = len(m.Base)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.Quote)
@@ -3829,29 +4704,631 @@ func (m *PricePairState) Size() (n int) {
 	if m.QuoteTimestamp != 0 {
 		n += 1 + sovQuery(uint64(m.QuoteTimestamp))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryOraclePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PricePairState != nil {
+		l = m.PricePairState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryOracleReporterStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleReporterStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOraclePricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOraclePricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatedPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatedPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProviderPrices) > 0 {
+		for _, e := range m.ProviderPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPythPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPythPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPythPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPythPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceState == nil {
+				m.PriceState = &PythPriceState{}
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBandRelayersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBandRelayersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBandRelayersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBandRelayersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBandRelayersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBandRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBandPriceStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBandPriceStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBandPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryOraclePriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PricePairState != nil {
-		l = m.PricePairState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBandPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3874,17 +5351,17 @@ func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPythPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBandPriceStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPythPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBandPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3894,23 +5371,25 @@ func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceId = string(dAtA[iNdEx:postIndex])
+			m.PriceStates = append(m.PriceStates, &BandPriceState{})
+			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3933,7 +5412,7 @@ func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBandIBCPriceStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3956,15 +5435,65 @@ func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPythPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBandIBCPriceStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPythPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBandIBCPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBandIBCPriceStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBandIBCPriceStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBandIBCPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3991,10 +5520,8 @@ func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PriceState == nil {
-				m.PriceState = &PythPriceState{}
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceStates = append(m.PriceStates, &BandPriceState{})
+			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4019,7 +5546,7 @@ func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceFeedPriceStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4042,10 +5569,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceFeedPriceStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceFeedPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4069,7 +5596,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceFeedPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4092,15 +5619,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceFeedPriceStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceFeedPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4127,7 +5654,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceStates = append(m.PriceStates, &PriceFeedState{})
+			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4152,7 +5680,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBandRelayersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCoinbasePriceStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4175,10 +5703,10 @@ func (m *QueryBandRelayersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBandRelayersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoinbasePriceStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBandRelayersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoinbasePriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4202,7 +5730,7 @@ func (m *QueryBandRelayersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBandRelayersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCoinbasePriceStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4225,17 +5753,17 @@ func (m *QueryBandRelayersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBandRelayersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoinbasePriceStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBandRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoinbasePriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4245,23 +5773,25 @@ func (m *QueryBandRelayersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.PriceStates = append(m.PriceStates, &CoinbasePriceState{})
+			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4284,7 +5814,7 @@ func (m *QueryBandRelayersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBandPriceStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPythPriceStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4307,10 +5837,10 @@ func (m *QueryBandPriceStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBandPriceStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPythPriceStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBandPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPythPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4334,7 +5864,7 @@ func (m *QueryBandPriceStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBandPriceStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPythPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4357,10 +5887,10 @@ func (m *QueryBandPriceStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBandPriceStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPythPriceStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBandPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPythPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4392,7 +5922,7 @@ func (m *QueryBandPriceStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceStates = append(m.PriceStates, &BandPriceState{})
+			m.PriceStates = append(m.PriceStates, &PythPriceState{})
 			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4418,7 +5948,7 @@ func (m *QueryBandPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBandIBCPriceStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPriceStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4437,16 +5967,80 @@ func (m *QueryBandIBCPriceStatesRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBandIBCPriceStatesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBandIBCPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderPriceStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderPriceStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4468,7 +6062,7 @@ func (m *QueryBandIBCPriceStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBandIBCPriceStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPriceStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4491,15 +6085,15 @@ func (m *QueryBandIBCPriceStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBandIBCPriceStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPriceStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBandIBCPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPriceStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4526,8 +6120,10 @@ func (m *QueryBandIBCPriceStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceStates = append(m.PriceStates, &BandPriceState{})
-			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PriceState == nil {
+				m.PriceState = &PriceState{}
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4552,7 +6148,7 @@ func (m *QueryBandIBCPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceFeedPriceStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4575,10 +6171,10 @@ func (m *QueryPriceFeedPriceStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceFeedPriceStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceFeedPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4602,7 +6198,7 @@ func (m *QueryPriceFeedPriceStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceFeedPriceStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4625,15 +6221,15 @@ func (m *QueryPriceFeedPriceStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceFeedPriceStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceFeedPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4660,8 +6256,10 @@ func (m *QueryPriceFeedPriceStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceStates = append(m.PriceStates, &PriceFeedState{})
-			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &GenesisState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4686,7 +6284,7 @@ func (m *QueryPriceFeedPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoinbasePriceStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricalPriceRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4709,12 +6307,63 @@ func (m *QueryCoinbasePriceStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoinbasePriceStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricalPriceRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoinbasePriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricalPriceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			m.Oracle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Oracle |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4736,7 +6385,7 @@ func (m *QueryCoinbasePriceStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoinbasePriceStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricalPriceRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4759,15 +6408,15 @@ func (m *QueryCoinbasePriceStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoinbasePriceStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricalPriceRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoinbasePriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricalPriceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4794,8 +6443,8 @@ func (m *QueryCoinbasePriceStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceStates = append(m.PriceStates, &CoinbasePriceState{})
-			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceRecords = append(m.PriceRecords, &PriceRecords{})
+			if err := m.PriceRecords[len(m.PriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4820,7 +6469,7 @@ func (m *QueryCoinbasePriceStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPythPriceStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *OracleHistoryOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4843,12 +6492,71 @@ func (m *QueryPythPriceStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPythPriceStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleHistoryOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPythPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleHistoryOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRawHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRawHistory = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4870,7 +6578,7 @@ func (m *QueryPythPriceStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPythPriceStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleVolatilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4893,15 +6601,87 @@ func (m *QueryPythPriceStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPythPriceStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleVolatilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPythPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleVolatilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseInfo == nil {
+				m.BaseInfo = &OracleInfo{}
+			}
+			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuoteInfo == nil {
+				m.QuoteInfo = &OracleInfo{}
+			}
+			if err := m.QuoteInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleHistoryOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4928,8 +6708,10 @@ func (m *QueryPythPriceStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceStates = append(m.PriceStates, &PythPriceState{})
-			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OracleHistoryOptions == nil {
+				m.OracleHistoryOptions = &OracleHistoryOptions{}
+			}
+			if err := m.OracleHistoryOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4954,7 +6736,7 @@ func (m *QueryPythPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderPriceStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleVolatilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4977,15 +6759,15 @@ func (m *QueryProviderPriceStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderPriceStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleVolatilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderPriceStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleVolatilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5013,13 +6795,17 @@ func (m *QueryProviderPriceStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Volatility = &v
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5029,77 +6815,31 @@ func (m *QueryProviderPriceStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProviderPriceStateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.HistoryMetadata == nil {
+				m.HistoryMetadata = &MetadataStatistics{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.HistoryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderPriceStateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderPriceStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5126,10 +6866,8 @@ func (m *QueryProviderPriceStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PriceState == nil {
-				m.PriceState = &PriceState{}
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RawHistory = append(m.RawHistory, &PriceRecord{})
+			if err := m.RawHistory[len(m.RawHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5154,7 +6892,7 @@ func (m *QueryProviderPriceStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleProvidersInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5177,10 +6915,10 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleProvidersInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleProvidersInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5204,7 +6942,7 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleProvidersInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5227,15 +6965,15 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleProvidersInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleProvidersInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5262,10 +7000,8 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &GenesisState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Providers = append(m.Providers, &ProviderInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5290,7 +7026,7 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHistoricalPriceRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleProviderPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5313,34 +7049,15 @@ func (m *QueryHistoricalPriceRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHistoricalPriceRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleProviderPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHistoricalPriceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleProviderPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
-			}
-			m.Oracle = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Oracle |= OracleType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5368,7 +7085,7 @@ func (m *QueryHistoricalPriceRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolId = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5391,7 +7108,7 @@ func (m *QueryHistoricalPriceRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHistoricalPriceRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleProviderPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5414,15 +7131,15 @@ func (m *QueryHistoricalPriceRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHistoricalPriceRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleProviderPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHistoricalPriceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleProviderPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5449,8 +7166,8 @@ func (m *QueryHistoricalPriceRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceRecords = append(m.PriceRecords, &PriceRecords{})
-			if err := m.PriceRecords[len(m.PriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderState = append(m.ProviderState, &ProviderState{})
+			if err := m.ProviderState[len(m.ProviderState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5475,7 +7192,7 @@ func (m *QueryHistoricalPriceRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleHistoryOptions) Unmarshal(dAtA []byte) error {
+func (m *QueryOraclePriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5498,17 +7215,17 @@ func (m *OracleHistoryOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleHistoryOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOraclePriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleHistoryOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOraclePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
 			}
-			m.MaxAge = 0
+			m.OracleType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5518,16 +7235,16 @@ func (m *OracleHistoryOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAge |= uint64(b&0x7F) << shift
+				m.OracleType |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRawHistory", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5537,17 +7254,29 @@ func (m *OracleHistoryOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRawHistory = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5557,12 +7286,24 @@ func (m *OracleHistoryOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeMetadata = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5584,7 +7325,7 @@ func (m *OracleHistoryOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleVolatilityRequest) Unmarshal(dAtA []byte) error {
+func (m *PricePairState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5607,17 +7348,17 @@ func (m *QueryOracleVolatilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleVolatilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricePairState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleVolatilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricePairState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5627,33 +7368,31 @@ func (m *QueryOracleVolatilityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseInfo == nil {
-				m.BaseInfo = &OracleInfo{}
-			}
-			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PairPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5663,33 +7402,31 @@ func (m *QueryOracleVolatilityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QuoteInfo == nil {
-				m.QuoteInfo = &OracleInfo{}
-			}
-			if err := m.QuoteInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleHistoryOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5699,81 +7436,29 @@ func (m *QueryOracleVolatilityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OracleHistoryOptions == nil {
-				m.OracleHistoryOptions = &OracleHistoryOptions{}
-			}
-			if err := m.OracleHistoryOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuotePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOracleVolatilityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleVolatilityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleVolatilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5801,17 +7486,15 @@ func (m *QueryOracleVolatilityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.Volatility = &v
-			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseCumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCumulativePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5821,33 +7504,31 @@ func (m *QueryOracleVolatilityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HistoryMetadata == nil {
-				m.HistoryMetadata = &MetadataStatistics{}
-			}
-			if err := m.HistoryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteCumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawHistory", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseTimestamp", wireType)
 			}
-			var msglen int
+			m.BaseTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5857,26 +7538,30 @@ func (m *QueryOracleVolatilityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BaseTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteTimestamp", wireType)
 			}
-			m.RawHistory = append(m.RawHistory, &PriceRecord{})
-			if err := m.RawHistory[len(m.RawHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.QuoteTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5898,7 +7583,7 @@ func (m *QueryOracleVolatilityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleProvidersInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOraclePriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5921,12 +7606,48 @@ func (m *QueryOracleProvidersInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProvidersInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOraclePriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProvidersInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOraclePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePairState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PricePairState == nil {
+				m.PricePairState = &PricePairState{}
+			}
+			if err := m.PricePairState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5948,7 +7669,7 @@ func (m *QueryOracleProvidersInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleProvidersInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,17 +7692,17 @@ func (m *QueryOracleProvidersInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProvidersInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProvidersInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5991,25 +7712,23 @@ func (m *QueryOracleProvidersInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, &ProviderInfo{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6032,7 +7751,7 @@ func (m *QueryOracleProvidersInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleProviderPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6055,17 +7774,17 @@ func (m *QueryOracleProviderPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProviderPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProviderPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6075,24 +7794,11 @@ func (m *QueryOracleProviderPricesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6114,7 +7820,7 @@ func (m *QueryOracleProviderPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleProviderPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleReporterStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6137,15 +7843,15 @@ func (m *QueryOracleProviderPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProviderPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleReporterStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProviderPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleReporterStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6172,8 +7878,10 @@ func (m *QueryOracleProviderPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderState = append(m.ProviderState, &ProviderState{})
-			if err := m.ProviderState[len(m.ProviderState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6198,7 +7906,7 @@ func (m *QueryOracleProviderPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOraclePriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleReporterStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6221,36 +7929,17 @@ func (m *QueryOraclePriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclePriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleReporterStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleReporterStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
-			}
-			m.OracleType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OracleType |= OracleType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6260,29 +7949,31 @@ func (m *QueryOraclePriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.Stats = append(m.Stats, OracleReporterStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6292,23 +7983,27 @@ func (m *QueryOraclePriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quote = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6331,7 +8026,7 @@ func (m *QueryOraclePriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PricePairState) Unmarshal(dAtA []byte) error {
+func (m *QueryOraclePricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6354,17 +8049,17 @@ func (m *PricePairState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PricePairState: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOraclePricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PricePairState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOraclePricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6374,65 +8069,83 @@ func (m *PricePairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PairPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOraclePricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOraclePricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOraclePricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuotePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6442,31 +8155,31 @@ func (m *PricePairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuotePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, OraclePriceFeed{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseCumulativePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6476,29 +8189,81 @@ func (m *PricePairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseCumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatedPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatedPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatedPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCumulativePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6526,48 +8291,8 @@ func (m *PricePairState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteCumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseTimestamp", wireType)
-			}
-			m.BaseTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BaseTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteTimestamp", wireType)
-			}
-			m.QuoteTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QuoteTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6589,7 +8314,7 @@ func (m *PricePairState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOraclePriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatedPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6612,15 +8337,49 @@ func (m *QueryOraclePriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclePriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatedPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PricePairState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6647,10 +8406,8 @@ func (m *QueryOraclePriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PricePairState == nil {
-				m.PricePairState = &PricePairState{}
-			}
-			if err := m.PricePairState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderPrices = append(m.ProviderPrices, ProviderPrice{})
+			if err := m.ProviderPrices[len(m.ProviderPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -38,6 +38,10 @@ type MsgRelayProviderPrices struct {
 	Provider string                                   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
 	Symbols  []string                                 `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`
 	Prices   []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,rep,name=prices,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"prices"`
+	// nonce is the relayer nonce of the update, which must be greater than the
+	// last nonce accepted from the sender. A zero nonce skips the replay guard
+	// until a nonce was accepted from the sender.
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *MsgRelayProviderPrices) Reset()         { *m = MsgRelayProviderPrices{} }
@@ -117,6 +121,10 @@ type MsgRelayPriceFeedPrice struct {
 	Quote  []string `protobuf:"bytes,3,rep,name=quote,proto3" json:"quote,omitempty"`
 	// price defines the price of the oracle base and quote
 	Price []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,rep,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// nonce is the relayer nonce of the update, which must be greater than the
+	// last nonce accepted from the sender. A zero nonce skips the replay guard
+	// until a nonce was accepted from the sender.
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *MsgRelayPriceFeedPrice) Reset()         { *m = MsgRelayPriceFeedPrice{} }
@@ -194,6 +202,10 @@ type MsgRelayBandRates struct {
 	Rates        []uint64 `protobuf:"varint,3,rep,packed,name=rates,proto3" json:"rates,omitempty"`
 	ResolveTimes []uint64 `protobuf:"varint,4,rep,packed,name=resolve_times,json=resolveTimes,proto3" json:"resolve_times,omitempty"`
 	RequestIDs   []uint64 `protobuf:"varint,5,rep,packed,name=requestIDs,proto3" json:"requestIDs,omitempty"`
+	// nonce is the relayer nonce of the update, which must be greater than the
+	// last nonce accepted from the relayer. A zero nonce skips the replay guard
+	// until a nonce was accepted from the relayer.
+	Nonce uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *MsgRelayBandRates) Reset()         { *m = MsgRelayBandRates{} }
@@ -264,6 +276,13 @@ func (m *MsgRelayBandRates) GetRequestIDs() []uint64 {
 	return nil
 }
 
+func (m *MsgRelayBandRates) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 type MsgRelayBandRatesResponse struct {
 }
 
@@ -642,61 +661,62 @@ func init() {
 func init() { proto.RegisterFile("injective/oracle/v1beta1/tx.proto", fileDescriptor_5fdf1c490eba4310) }
 
 var fileDescriptor_5fdf1c490eba4310 = []byte{
-	// 854 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x77, 0x93, 0x40, 0xa6, 0xa1, 0x55, 0xa7, 0xa1, 0xf5, 0x1a, 0x70, 0x42, 0x10, 0xa8,
-	0x14, 0xd6, 0x26, 0x29, 0x42, 0x28, 0x07, 0xa4, 0xa6, 0xab, 0x4a, 0x2b, 0x35, 0xd2, 0xca, 0xc0,
-	0x01, 0x2e, 0xd1, 0xc4, 0x1e, 0x1c, 0x43, 0xec, 0x71, 0x67, 0x26, 0x11, 0x39, 0x02, 0x07, 0x38,
-	0x72, 0x45, 0xe2, 0xd0, 0x8f, 0xd0, 0x03, 0x7c, 0x02, 0x2e, 0x3d, 0x56, 0x9c, 0x10, 0x87, 0x0a,
-	0xed, 0x1e, 0xe0, 0x03, 0xf0, 0x01, 0x90, 0xc7, 0xe3, 0x59, 0xe7, 0x8f, 0xf3, 0x87, 0x93, 0xfd,
-	0xe6, 0xfd, 0xde, 0x9b, 0xdf, 0xef, 0xcd, 0xbc, 0x67, 0x83, 0xd7, 0x83, 0xe8, 0x4b, 0xec, 0xf2,
-	0x60, 0x86, 0x6d, 0x42, 0x91, 0x3b, 0xc1, 0xf6, 0xac, 0x33, 0xc2, 0x1c, 0x75, 0x6c, 0xfe, 0xb5,
-	0x15, 0x53, 0xc2, 0x09, 0xd4, 0x15, 0xc4, 0x4a, 0x21, 0x96, 0x84, 0x18, 0x0d, 0x9f, 0xf8, 0x44,
-	0x80, 0xec, 0xe4, 0x2d, 0xc5, 0x1b, 0x6f, 0x16, 0xa6, 0x94, 0xe1, 0x29, 0xec, 0x96, 0x4b, 0x58,
-	0x48, 0x98, 0x1d, 0x32, 0xdf, 0x9e, 0x75, 0x92, 0x87, 0x74, 0x1c, 0xa5, 0x8e, 0x61, 0x9a, 0x38,
-	0x35, 0x52, 0x57, 0xfb, 0x37, 0x0d, 0xdc, 0x1c, 0x30, 0xdf, 0xc1, 0x13, 0x34, 0x3f, 0xa3, 0x64,
-	0x16, 0x78, 0x98, 0x9e, 0xd1, 0xc0, 0xc5, 0x0c, 0xde, 0x04, 0x55, 0x86, 0x23, 0x0f, 0x53, 0x5d,
-	0x6b, 0x69, 0xb7, 0x6b, 0x8e, 0xb4, 0xa0, 0x01, 0x5e, 0x8c, 0x25, 0x52, 0x3f, 0x10, 0x1e, 0x65,
-	0x43, 0x1d, 0xbc, 0xc0, 0xe6, 0xe1, 0x88, 0x4c, 0x98, 0x7e, 0xd8, 0x3a, 0xbc, 0x5d, 0x73, 0x32,
-	0x13, 0x3e, 0x00, 0xd5, 0x58, 0xe4, 0xd5, 0xcb, 0x89, 0xa3, 0x6f, 0x3d, 0x7d, 0xde, 0x2c, 0xfd,
-	0xf9, 0xbc, 0xf9, 0x96, 0x1f, 0xf0, 0xf1, 0x74, 0x64, 0xb9, 0x24, 0x94, 0xcc, 0xe4, 0xe3, 0x98,
-	0x79, 0x5f, 0xd9, 0x7c, 0x1e, 0x63, 0x66, 0x9d, 0x60, 0xd7, 0x91, 0xd1, 0xbd, 0x1b, 0x3f, 0x3c,
-	0x6e, 0x96, 0xfe, 0x79, 0xdc, 0x2c, 0x7d, 0xfb, 0xf7, 0x93, 0x3b, 0x92, 0x52, 0xbb, 0x05, 0xcc,
-	0xf5, 0x22, 0x1c, 0xcc, 0x62, 0x12, 0x31, 0xdc, 0xfe, 0x75, 0x41, 0x67, 0xe0, 0xe2, 0x07, 0x18,
-	0x7b, 0xe2, 0xa5, 0x50, 0x27, 0x04, 0xe5, 0x11, 0x62, 0x58, 0x3f, 0x10, 0x42, 0xc4, 0x3b, 0x6c,
-	0x80, 0xca, 0xa3, 0x29, 0xe1, 0x58, 0xaa, 0x4b, 0x0d, 0x78, 0x02, 0x2a, 0x82, 0xdd, 0xff, 0x94,
-	0x96, 0x06, 0xef, 0xa0, 0x2c, 0x4f, 0x5b, 0x29, 0x7b, 0xa2, 0x81, 0xeb, 0x19, 0xa4, 0x8f, 0x22,
-	0xcf, 0x41, 0x1c, 0xb3, 0xe4, 0x20, 0x68, 0xb2, 0xa2, 0x54, 0x65, 0x66, 0xfe, 0x88, 0x0e, 0x16,
-	0x8f, 0xa8, 0x01, 0x2a, 0x34, 0x09, 0x16, 0xe2, 0xca, 0x4e, 0x6a, 0xc0, 0x37, 0xc0, 0x4b, 0x14,
-	0x33, 0x32, 0x99, 0xe1, 0x21, 0x0f, 0x42, 0x79, 0x7e, 0x65, 0xa7, 0x2e, 0x17, 0x3f, 0x49, 0xd6,
-	0xa0, 0x09, 0x00, 0xc5, 0x8f, 0xa6, 0x98, 0xf1, 0xd3, 0x13, 0xa6, 0x57, 0x04, 0x22, 0xb7, 0xd2,
-	0xab, 0x27, 0x9a, 0x32, 0x0a, 0xed, 0x57, 0xc0, 0xd1, 0x0a, 0x63, 0xa5, 0xe7, 0x3b, 0x0d, 0xe8,
-	0x99, 0xf7, 0x3e, 0x09, 0xa2, 0xa4, 0xee, 0x03, 0xcc, 0x18, 0xf2, 0x37, 0xdf, 0xc9, 0x50, 0x62,
-	0x84, 0xaa, 0xba, 0xa3, 0xec, 0x84, 0x1b, 0x0b, 0xfc, 0x08, 0xf1, 0x29, 0x95, 0xda, 0xea, 0x4e,
-	0x6e, 0x65, 0x7d, 0xdd, 0xdb, 0xa0, 0x55, 0x44, 0x42, 0x31, 0xf5, 0xe4, 0x95, 0x12, 0x2a, 0x13,
-	0x21, 0xa7, 0xfd, 0xfb, 0x69, 0xf5, 0x8b, 0x68, 0xbe, 0xa6, 0xca, 0x34, 0x0c, 0x3c, 0xd1, 0x3c,
-	0x65, 0xa7, 0x96, 0x95, 0xc9, 0xdb, 0x7c, 0x03, 0x56, 0x76, 0x51, 0x3c, 0x7e, 0xd6, 0x00, 0x54,
-	0x97, 0x64, 0xce, 0xc7, 0x5b, 0xfa, 0xf7, 0x33, 0x00, 0xc5, 0x85, 0x1b, 0x22, 0xce, 0x31, 0xe3,
-	0x88, 0x07, 0x24, 0x4a, 0xab, 0x76, 0xa5, 0x7b, 0xc7, 0x2a, 0x1a, 0x4d, 0x96, 0xc8, 0x7a, 0xef,
-	0x32, 0xc4, 0xb9, 0x1e, 0x2f, 0xad, 0x14, 0x94, 0xf2, 0x55, 0x60, 0xac, 0xb2, 0x53, 0xe4, 0x7f,
-	0xd2, 0xc0, 0xb5, 0x01, 0xf3, 0x3f, 0x8d, 0x3d, 0xc4, 0xf1, 0x19, 0xa2, 0x28, 0x64, 0xf0, 0x03,
-	0x50, 0x43, 0x53, 0x3e, 0x26, 0x34, 0xe0, 0xf3, 0x94, 0x7c, 0x5f, 0xff, 0xfd, 0x97, 0xe3, 0x86,
-	0x9c, 0x5c, 0xf7, 0x3c, 0x8f, 0x62, 0xc6, 0x3e, 0xe6, 0x34, 0x88, 0x7c, 0xe7, 0x12, 0x0a, 0x3f,
-	0x02, 0xd5, 0x58, 0x64, 0x10, 0xa5, 0xbd, 0xd2, 0x6d, 0x6d, 0x50, 0x23, 0x70, 0xfd, 0x72, 0xd2,
-	0xaa, 0x8e, 0x8c, 0xea, 0x5d, 0x4d, 0x68, 0x5f, 0xe6, 0x6b, 0x1f, 0x81, 0x5b, 0x4b, 0xd4, 0x32,
-	0xda, 0xdd, 0x7f, 0xab, 0xe0, 0x70, 0xc0, 0x7c, 0xf8, 0x8d, 0x06, 0x6e, 0xac, 0x1b, 0x9e, 0xef,
-	0x15, 0x6f, 0xbd, 0x7e, 0x52, 0x19, 0x1f, 0xee, 0x1b, 0x91, 0x71, 0xc9, 0x73, 0x58, 0x18, 0x6c,
-	0x3b, 0x71, 0xc8, 0x47, 0xec, 0xc6, 0x61, 0xdd, 0x14, 0x82, 0x14, 0x5c, 0x5d, 0x9a, 0x40, 0xef,
-	0x6c, 0xcf, 0xa5, 0xc0, 0xc6, 0xdd, 0x3d, 0xc0, 0x4b, 0xba, 0x57, 0xbb, 0x6f, 0x9b, 0xee, 0x95,
-	0x88, 0xad, 0xba, 0x0b, 0x7b, 0x0f, 0x7e, 0xaf, 0x81, 0x97, 0xd7, 0x8f, 0xaa, 0xee, 0x76, 0x49,
-	0xcb, 0x31, 0x46, 0x6f, 0xff, 0x18, 0xc5, 0x64, 0x0a, 0xae, 0x2d, 0x4f, 0x80, 0x77, 0x77, 0x38,
-	0x4e, 0x85, 0x36, 0xde, 0xdf, 0x07, 0xad, 0xb6, 0x9d, 0x80, 0xfa, 0x42, 0xef, 0xbe, 0xbd, 0x31,
-	0x4b, 0x1e, 0x6a, 0x74, 0x76, 0x86, 0x66, 0xbb, 0xf5, 0xbf, 0x78, 0x7a, 0x6e, 0x6a, 0xcf, 0xce,
-	0x4d, 0xed, 0xaf, 0x73, 0x53, 0xfb, 0xf1, 0xc2, 0x2c, 0x3d, 0xbb, 0x30, 0x4b, 0x7f, 0x5c, 0x98,
-	0xa5, 0xcf, 0x1f, 0xe6, 0x3e, 0xb6, 0xa7, 0x59, 0xda, 0x87, 0x68, 0xc4, 0x6c, 0xb5, 0xc9, 0xb1,
-	0x4b, 0x28, 0xce, 0x9b, 0x63, 0x14, 0x44, 0x76, 0x48, 0xbc, 0xe9, 0x04, 0xb3, 0xec, 0xcf, 0x4a,
-	0x7c, 0x96, 0x47, 0x55, 0xf1, 0x77, 0x74, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x8a,
-	0x17, 0xab, 0xcd, 0x09, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0xdb, 0x24, 0x90, 0xd9, 0xb0, 0xab, 0xf5, 0x86, 0x5d, 0xd7, 0x80, 0x13, 0x82, 0x40,
+	0x65, 0xa1, 0x36, 0x49, 0x11, 0xaa, 0x7a, 0x40, 0x6a, 0x5a, 0x55, 0xaa, 0xd4, 0x48, 0x95, 0x81,
+	0x03, 0x5c, 0xa2, 0x89, 0x3d, 0x38, 0x86, 0xd8, 0xe3, 0xce, 0x4c, 0x22, 0x72, 0x04, 0x0e, 0x70,
+	0xe4, 0x8a, 0xc4, 0xa1, 0x3f, 0x81, 0x03, 0xbf, 0x01, 0x55, 0xe2, 0x52, 0x71, 0x02, 0x0e, 0x15,
+	0x6a, 0x0f, 0xf0, 0x03, 0xf8, 0x01, 0xc8, 0xe3, 0xf1, 0xc4, 0xf9, 0x6e, 0x7a, 0xb2, 0xdf, 0x99,
+	0xe7, 0x79, 0xe7, 0x79, 0xe6, 0x9d, 0x79, 0x6d, 0xf0, 0xba, 0x1f, 0x7e, 0x81, 0x1c, 0xe6, 0x0f,
+	0x91, 0x85, 0x09, 0x74, 0xfa, 0xc8, 0x1a, 0x36, 0xba, 0x88, 0xc1, 0x86, 0xc5, 0xbe, 0x32, 0x23,
+	0x82, 0x19, 0x56, 0x35, 0x09, 0x31, 0x13, 0x88, 0x29, 0x20, 0x7a, 0xc5, 0xc3, 0x1e, 0xe6, 0x20,
+	0x2b, 0x7e, 0x4b, 0xf0, 0xfa, 0x9b, 0x0b, 0x53, 0x0a, 0x7a, 0x02, 0x7b, 0xe6, 0x60, 0x1a, 0x60,
+	0x6a, 0x05, 0xd4, 0xb3, 0x86, 0x8d, 0xf8, 0x21, 0x26, 0xb6, 0x92, 0x89, 0x4e, 0x92, 0x38, 0x09,
+	0x92, 0xa9, 0xfa, 0x9f, 0x0a, 0x78, 0xda, 0xa6, 0x9e, 0x8d, 0xfa, 0x70, 0x74, 0x46, 0xf0, 0xd0,
+	0x77, 0x11, 0x39, 0x23, 0xbe, 0x83, 0xa8, 0xfa, 0x14, 0x14, 0x29, 0x0a, 0x5d, 0x44, 0x34, 0xa5,
+	0xa6, 0x6c, 0x97, 0x6c, 0x11, 0xa9, 0x3a, 0x78, 0x31, 0x12, 0x48, 0x6d, 0x83, 0xcf, 0xc8, 0x58,
+	0xd5, 0xc0, 0x0b, 0x74, 0x14, 0x74, 0x71, 0x9f, 0x6a, 0x9b, 0xb5, 0xcd, 0xed, 0x92, 0x9d, 0x86,
+	0xea, 0x31, 0x28, 0x46, 0x3c, 0xaf, 0x96, 0x8f, 0x27, 0x5a, 0xe6, 0xe5, 0x75, 0x35, 0xf7, 0xd7,
+	0x75, 0xf5, 0x2d, 0xcf, 0x67, 0xbd, 0x41, 0xd7, 0x74, 0x70, 0x20, 0x94, 0x89, 0xc7, 0x0e, 0x75,
+	0xbf, 0xb4, 0xd8, 0x28, 0x42, 0xd4, 0x3c, 0x42, 0x8e, 0x2d, 0xd8, 0x6a, 0x05, 0x14, 0x42, 0x1c,
+	0x3a, 0x48, 0x2b, 0xd4, 0x94, 0xed, 0xbc, 0x9d, 0x04, 0xfb, 0x4f, 0xbe, 0xbf, 0xa8, 0xe6, 0xfe,
+	0xbd, 0xa8, 0xe6, 0xbe, 0xf9, 0xe7, 0xe7, 0xe7, 0x42, 0x68, 0xbd, 0x06, 0x8c, 0xf9, 0xd6, 0x6c,
+	0x44, 0x23, 0x1c, 0x52, 0x54, 0xff, 0x6d, 0xc2, 0xbd, 0xef, 0xa0, 0x63, 0x84, 0x5c, 0xfe, 0xb2,
+	0xd0, 0xbd, 0x0a, 0xf2, 0x5d, 0x48, 0x91, 0xb6, 0xc1, 0xed, 0xf1, 0xf7, 0x58, 0xd3, 0xf9, 0x00,
+	0x33, 0x24, 0x3c, 0x27, 0x81, 0x7a, 0x04, 0x0a, 0x5c, 0xf3, 0x3d, 0x0d, 0x27, 0xe4, 0x7b, 0xfb,
+	0xcd, 0x9a, 0x91, 0x7e, 0x7f, 0x55, 0xc0, 0xe3, 0x14, 0xd2, 0x82, 0xa1, 0x6b, 0x43, 0x86, 0x68,
+	0x5c, 0x34, 0x12, 0x8f, 0x48, 0xaf, 0x69, 0x98, 0x2d, 0xe7, 0xc6, 0x64, 0x39, 0x2b, 0xa0, 0x40,
+	0x62, 0x32, 0xb7, 0x9c, 0xb7, 0x93, 0x40, 0x7d, 0x03, 0xbc, 0x44, 0x10, 0xc5, 0xfd, 0x21, 0xea,
+	0x30, 0x3f, 0x10, 0xb5, 0xce, 0xdb, 0x65, 0x31, 0xf8, 0x71, 0x3c, 0xa6, 0x1a, 0x00, 0x10, 0x74,
+	0x3e, 0x40, 0x94, 0x9d, 0x1c, 0x51, 0xad, 0xc0, 0x11, 0x99, 0x91, 0xb1, 0xe3, 0x62, 0xd6, 0x71,
+	0x39, 0x76, 0x9a, 0x0a, 0xab, 0xbf, 0x02, 0xb6, 0x66, 0x7c, 0x48, 0x97, 0xdf, 0x2a, 0x40, 0x4b,
+	0x67, 0x0f, 0xb1, 0x1f, 0xc6, 0x35, 0x6a, 0x23, 0x4a, 0xa1, 0xb7, 0xfc, 0x54, 0x07, 0x02, 0xc3,
+	0xbd, 0x96, 0x6d, 0x19, 0xc7, 0x8a, 0xa9, 0xef, 0x85, 0x90, 0x0d, 0x88, 0x70, 0x5c, 0xb6, 0x33,
+	0x23, 0xf3, 0xab, 0x51, 0x07, 0xb5, 0x45, 0x22, 0xa4, 0x52, 0x57, 0x1c, 0x3f, 0xee, 0x3d, 0x36,
+	0x72, 0xd2, 0x3a, 0x4c, 0x6a, 0xb2, 0x48, 0xe6, 0x6b, 0x72, 0xf3, 0x3a, 0xbe, 0xcb, 0xaf, 0x5f,
+	0xde, 0x2e, 0xa5, 0x9b, 0xe7, 0x2e, 0x3f, 0x17, 0x33, 0xab, 0x48, 0x1d, 0x3f, 0x29, 0x40, 0x95,
+	0x47, 0x67, 0xc4, 0x7a, 0x2b, 0x3a, 0xc0, 0xa7, 0x40, 0xe5, 0x87, 0xb3, 0x03, 0x19, 0x43, 0x94,
+	0x41, 0xe6, 0xe3, 0x30, 0xd9, 0xb5, 0x07, 0xcd, 0xe7, 0xe6, 0xa2, 0xe6, 0x66, 0xf2, 0xac, 0x07,
+	0x63, 0x8a, 0xfd, 0x38, 0x9a, 0x1a, 0x59, 0xb0, 0x95, 0xaf, 0x02, 0x7d, 0x56, 0x9d, 0x14, 0xff,
+	0xa3, 0x02, 0x1e, 0xb5, 0xa9, 0xf7, 0x49, 0xe4, 0x42, 0x86, 0xce, 0x20, 0x81, 0x01, 0x55, 0x3f,
+	0x00, 0x25, 0x38, 0x60, 0x3d, 0x4c, 0x7c, 0x36, 0x4a, 0xc4, 0xb7, 0xb4, 0xdf, 0x7f, 0xd9, 0xa9,
+	0x88, 0xde, 0x77, 0xe0, 0xba, 0x04, 0x51, 0xfa, 0x11, 0x23, 0x7e, 0xe8, 0xd9, 0x63, 0xa8, 0xfa,
+	0x21, 0x28, 0x46, 0x3c, 0x03, 0xdf, 0xda, 0x07, 0xcd, 0xda, 0x12, 0x37, 0x1c, 0xd7, 0xca, 0xc7,
+	0xd7, 0xda, 0x16, 0xac, 0xfd, 0x87, 0xb1, 0xec, 0x71, 0xbe, 0xfa, 0x16, 0x78, 0x36, 0x25, 0x2d,
+	0x95, 0xdd, 0xfc, 0xaf, 0x08, 0x36, 0xdb, 0xd4, 0x53, 0xbf, 0x56, 0xc0, 0x93, 0x79, 0xed, 0xf7,
+	0xbd, 0xc5, 0x4b, 0xcf, 0xef, 0x6a, 0xfa, 0xde, 0xba, 0x8c, 0x54, 0x4b, 0x56, 0xc3, 0x44, 0x13,
+	0xbc, 0x93, 0x86, 0x2c, 0x43, 0xdf, 0x5b, 0x97, 0x21, 0x35, 0x10, 0xf0, 0x70, 0xaa, 0x2f, 0xbd,
+	0xb3, 0x3a, 0x97, 0x04, 0xeb, 0xbb, 0x6b, 0x80, 0xa7, 0x7c, 0xcf, 0xde, 0xbe, 0x55, 0xbe, 0x67,
+	0x18, 0xfa, 0xde, 0xba, 0x0c, 0xa9, 0xe1, 0x3b, 0x05, 0xbc, 0x3c, 0xbf, 0x55, 0x35, 0x57, 0x5b,
+	0x9a, 0xe6, 0xe8, 0xfb, 0xeb, 0x73, 0xa4, 0x92, 0x01, 0x78, 0x34, 0xdd, 0x01, 0xde, 0xbd, 0x43,
+	0x39, 0x25, 0x5a, 0x7f, 0x7f, 0x1d, 0xb4, 0x5c, 0xb6, 0x0f, 0xca, 0x13, 0x77, 0xf7, 0xed, 0xa5,
+	0x59, 0xb2, 0x50, 0xbd, 0x71, 0x67, 0x68, 0xba, 0x5a, 0xeb, 0xf3, 0xcb, 0x1b, 0x43, 0xb9, 0xba,
+	0x31, 0x94, 0xbf, 0x6f, 0x0c, 0xe5, 0x87, 0x5b, 0x23, 0x77, 0x75, 0x6b, 0xe4, 0xfe, 0xb8, 0x35,
+	0x72, 0x9f, 0x9d, 0x66, 0x3e, 0xcc, 0x27, 0x69, 0xda, 0x53, 0xd8, 0xa5, 0x96, 0x5c, 0x64, 0xc7,
+	0xc1, 0x04, 0x65, 0xc3, 0x1e, 0xf4, 0x43, 0x2b, 0xc0, 0xee, 0xa0, 0x8f, 0x68, 0xfa, 0x6f, 0xc6,
+	0x3f, 0xe1, 0xdd, 0x22, 0xff, 0xbf, 0xda, 0xfd, 0x7f, 0x00, 0xe7, 0x9e, 0xaf, 0xbf, 0x0f, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1035,6 +1055,11 @@ func (m *MsgRelayProviderPrices) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Prices) > 0 {
 		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1118,6 +1143,11 @@ func (m *MsgRelayPriceFeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Price) > 0 {
 		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1203,6 +1233,11 @@ func (m *MsgRelayBandRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.RequestIDs) > 0 {
 		dAtA2 := make([]byte, len(m.RequestIDs)*10)
 		var j1 int
@@ -1595,6 +1630,9 @@ func (m *MsgRelayProviderPrices) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -1635,6 +1673,9 @@ func (m *MsgRelayPriceFeedPrice) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -1684,6 +1725,9 @@ func (m *MsgRelayBandRates) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -1974,6 +2018,25 @@ func (m *MsgRelayProviderPrices) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2206,6 +2269,25 @@ func (m *MsgRelayPriceFeedPrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2598,6 +2680,25 @@ func (m *MsgRelayBandRates) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDs", wireType)
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
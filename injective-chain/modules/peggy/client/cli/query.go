@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetOutgoingBatch(),
 		// CmdGetAllOutgoingTXBatchRequest(),
 		// CmdGetOutgoingTXBatchByNonceRequest(),
 		// CmdGetAllAttestationsRequest(),
@@ -204,3 +205,33 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetOutgoingBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-batch [nonce]",
+		Short: "Get the outgoing TX batch with a particular nonce, its total fees and the validators which confirmed it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingBatchRequest{
+				BatchNonce: nonce,
+			}
+
+			res, err := queryClient.OutgoingBatch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
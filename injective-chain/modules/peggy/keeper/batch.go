@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -19,7 +20,10 @@ const OutgoingTxBatchSize = 100
 //   - find bridged denominator for given voucher type
 //   - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //     have a higher total fees. If not exit without creating a batch
-//   - select available transactions from the outgoing transaction pool sorted by fee desc
+//   - determine if the total fees of the batch would meet the min batch fee of the denom. If not exit without
+//     creating a batch, the transactions stay in the pool until more fees are accumulated
+//   - select available transactions from the outgoing transaction pool sorted by fee desc, then by id asc, so that
+//     every node builds the same batch and the transactions of the batch are in that order
 //   - persist an outgoing batch object with an incrementing ID = nonce
 //   - emit an event
 func (k *Keeper) BuildOutgoingTXBatch(ctx sdk.Context, contractAddress common.Address, maxElements int) (*types.OutgoingTxBatch, error) {
@@ -50,6 +54,14 @@ func (k *Keeper) BuildOutgoingTXBatch(ctx sdk.Context, contractAddress common.Ad
 		}
 	}
 
+	_, denom := k.ERC20ToDenomLookup(ctx, contractAddress)
+	if minBatchFee := k.GetParams(ctx).MinBatchFee.AmountOf(denom); minBatchFee.IsPositive() {
+		if batchFees := k.getUnbatchedTXFees(ctx, contractAddress, maxElements); batchFees.LT(minBatchFee) {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, errors.Wrapf(types.ErrBatchFeesBelowMinimum, "batch fees %s%s are below the minimum %s%s", batchFees, denom, minBatchFee, denom)
+		}
+	}
+
 	selectedTx, err := k.pickUnbatchedTX(ctx, contractAddress, maxElements)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
@@ -197,6 +209,25 @@ func (k *Keeper) pickUnbatchedTX(ctx sdk.Context, contractAddress common.Address
 	return selectedTx, nil
 }
 
+// getUnbatchedTXFees returns the total fees of the txs pickUnbatchedTX would pick, without picking them
+func (k *Keeper) getUnbatchedTXFees(ctx sdk.Context, contractAddress common.Address, maxElements int) sdkmath.Int {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	fees := sdk.ZeroInt()
+	count := 0
+	k.IterateOutgoingPoolByFee(ctx, contractAddress, func(_ uint64, tx *types.OutgoingTransferTx) bool {
+		if tx == nil || tx.Erc20Fee == nil {
+			return true
+		}
+
+		fees = fees.Add(tx.Erc20Fee.Amount)
+		count++
+		return count == maxElements
+	})
+
+	return fees
+}
+
 // GetOutgoingTXBatch loads a batch object. Returns nil when not exists.
 func (k *Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract common.Address, nonce uint64) *types.OutgoingTxBatch {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
@@ -218,6 +249,24 @@ func (k *Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract common.Addres
 	return &b
 }
 
+// GetOutgoingTXBatchByNonce loads the batch with the given nonce, whatever its token. Returns nil when not exists.
+func (k *Keeper) GetOutgoingTXBatchByNonce(ctx sdk.Context, nonce uint64) *types.OutgoingTxBatch {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	// batch nonces are unique across the tokens
+	var batch *types.OutgoingTxBatch
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, b *types.OutgoingTxBatch) bool {
+		if b.BatchNonce != nonce {
+			return false
+		}
+
+		batch = k.GetOutgoingTXBatch(ctx, common.HexToAddress(b.TokenContract), nonce)
+		return true
+	})
+
+	return batch
+}
+
 // CancelOutgoingTXBatch releases all TX in the batch and deletes the batch
 func (k *Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract common.Address, nonce uint64) error {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
@@ -229,7 +278,7 @@ func (k *Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract common.Add
 
 	for _, tx := range batch.Transactions {
 		tx.Erc20Fee.Contract = tokenContract.Hex()
-		k.prependToUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, tx.Id)
+		k.addToUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, tx.Id)
 	}
 
 	// Delete batch since it is finished
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
@@ -437,6 +438,185 @@ func TestPoolTxRefund(t *testing.T) {
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
 
+func TestBatchComposition(t *testing.T) {
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("inj1f2kdg34689x93cvw2y59z7y46dvz2fk8g3cggx")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).PeggyCoin(),
+		)
+	)
+
+	batchTxIDs := func(batch *types.OutgoingTxBatch) []uint64 {
+		ids := make([]uint64, 0, len(batch.Transactions))
+		for _, tx := range batch.Transactions {
+			ids = append(ids, tx.Id)
+		}
+		return ids
+	}
+
+	// builds a batch of 3, cancels it and builds a batch of 4 out of the same pool
+	buildBatches := func() (canceled, rebuilt []uint64) {
+		input := testpeggy.CreateTestEnv(t)
+		ctx := input.Context
+
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+		input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+		input.PeggyKeeper.SetLastOutgoingPoolID(ctx, uint64(0))
+		input.PeggyKeeper.SetLastOutgoingBatchID(ctx, uint64(0))
+
+		for i, v := range []uint64{2, 3, 2, 1, 3, 2} {
+			amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).PeggyCoin()
+			fee := types.NewERC20Token(v, myTokenContractAddr).PeggyCoin()
+			_, err := input.PeggyKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+			require.NoError(t, err)
+		}
+
+		firstBatch, err := input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 3)
+		require.NoError(t, err)
+		require.NoError(t, input.PeggyKeeper.CancelOutgoingTXBatch(ctx, myTokenContractAddr, firstBatch.BatchNonce))
+
+		secondBatch, err := input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 4)
+		require.NoError(t, err)
+
+		// the stored batch keeps the order
+		gotSecondBatch := input.PeggyKeeper.GetOutgoingTXBatch(ctx, myTokenContractAddr, secondBatch.BatchNonce)
+		require.Equal(t, batchTxIDs(secondBatch), batchTxIDs(gotSecondBatch))
+
+		return batchTxIDs(firstBatch), batchTxIDs(secondBatch)
+	}
+
+	canceled, rebuilt := buildBatches()
+	// by fee desc, then by id asc
+	assert.Equal(t, []uint64{2, 5, 1}, canceled)
+	// the txs of the canceled batch return to the pool in the same order
+	assert.Equal(t, []uint64{2, 5, 1, 3}, rebuilt)
+
+	for i := 0; i < 3; i++ {
+		c, r := buildBatches()
+		assert.Equal(t, canceled, c)
+		assert.Equal(t, rebuilt, r)
+	}
+}
+
+func TestOutgoingBatchQuery(t *testing.T) {
+	input := testpeggy.CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("inj1f2kdg34689x93cvw2y59z7y46dvz2fk8g3cggx")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).PeggyCoin(),
+		)
+	)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	input.PeggyKeeper.SetLastOutgoingPoolID(ctx, uint64(0))
+	input.PeggyKeeper.SetLastOutgoingBatchID(ctx, uint64(0))
+
+	for i, v := range []uint64{2, 3, 2, 1} {
+		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).PeggyCoin()
+		fee := types.NewERC20Token(v, myTokenContractAddr).PeggyCoin()
+		_, err := input.PeggyKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+		require.NoError(t, err)
+	}
+
+	batch, err := input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 3)
+	require.NoError(t, err)
+
+	// the first three validators confirm the batch, the first one isn't an orchestrator anymore
+	for i := range testpeggy.ValAddrs[:4] {
+		if i > 0 {
+			input.PeggyKeeper.SetOrchestratorValidator(ctx, testpeggy.ValAddrs[i], testpeggy.AccAddrs[i])
+		}
+		if i < 3 {
+			input.PeggyKeeper.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+				Nonce:         batch.BatchNonce,
+				TokenContract: myTokenContractAddr.Hex(),
+				EthSigner:     testpeggy.EthAddrs[i].String(),
+				Orchestrator:  testpeggy.AccAddrs[i].String(),
+			})
+		}
+	}
+
+	res, err := input.PeggyKeeper.OutgoingBatch(sdk.WrapSDKContext(ctx), &types.QueryOutgoingBatchRequest{BatchNonce: batch.BatchNonce})
+	require.NoError(t, err)
+
+	expValidators := []string{testpeggy.ValAddrs[1].String(), testpeggy.ValAddrs[2].String()}
+	sort.Strings(expValidators)
+
+	assert.Equal(t, batch.Transactions, res.Batch.Transactions)
+	assert.Equal(t, batch.BatchNonce, res.Batch.BatchNonce)
+	assert.Equal(t, sdk.NewInt(7), res.TotalFees)
+	assert.Equal(t, expValidators, res.ConfirmedValidators)
+
+	// an unknown nonce isn't an error
+	res, err = input.PeggyKeeper.OutgoingBatch(sdk.WrapSDKContext(ctx), &types.QueryOutgoingBatchRequest{BatchNonce: batch.BatchNonce + 1})
+	require.NoError(t, err)
+	assert.Nil(t, res.Batch)
+	assert.True(t, res.TotalFees.IsZero())
+	assert.Empty(t, res.ConfirmedValidators)
+}
+
+func TestMinBatchFee(t *testing.T) {
+	input := testpeggy.CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("inj1f2kdg34689x93cvw2y59z7y46dvz2fk8g3cggx")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).PeggyCoin(),
+		)
+		myDenom = types.NewERC20Token(1, myTokenContractAddr).PeggyCoin().Denom
+	)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	input.PeggyKeeper.SetLastOutgoingPoolID(ctx, uint64(0))
+	input.PeggyKeeper.SetLastOutgoingBatchID(ctx, uint64(0))
+
+	params := input.PeggyKeeper.GetParams(ctx)
+	params.MinBatchFee = sdk.NewCoins(sdk.NewInt64Coin(myDenom, 10))
+	input.PeggyKeeper.SetParams(ctx, params)
+
+	withdraw := func(fees ...uint64) {
+		for i, v := range fees {
+			amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).PeggyCoin()
+			fee := types.NewERC20Token(v, myTokenContractAddr).PeggyCoin()
+			_, err := input.PeggyKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+			require.NoError(t, err)
+		}
+	}
+
+	// the fees of the batch are 8, below the minimum
+	withdraw(2, 3, 2, 1)
+	_, err := input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 100)
+	require.ErrorIs(t, err, types.ErrBatchFeesBelowMinimum)
+	require.Len(t, input.PeggyKeeper.GetPoolTransactions(ctx), 4)
+	require.Nil(t, input.PeggyKeeper.GetLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+
+	// the batch size counts, the fees of the best 2 txs are 5
+	withdraw(2)
+	_, err = input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
+	require.ErrorIs(t, err, types.ErrBatchFeesBelowMinimum)
+
+	// the fees of the batch are 10, meeting the minimum
+	batch, err := input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 100)
+	require.NoError(t, err)
+	assert.Len(t, batch.Transactions, 5)
+	assert.Empty(t, input.PeggyKeeper.GetPoolTransactions(ctx))
+}
+
 // TestManyBatches handles test cases around batch execution, specifically executing multiple batches
 // out of sequential order, which is exactly what happens on the
 func TestManyBatches(t *testing.T) {
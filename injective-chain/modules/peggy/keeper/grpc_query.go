@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/InjectiveLabs/metrics"
 
@@ -217,6 +218,47 @@ func (k *Keeper) BatchConfirms(c context.Context, req *types.QueryBatchConfirmsR
 	return &types.QueryBatchConfirmsResponse{Confirms: confirms}, nil
 }
 
+// OutgoingBatch returns the batch with the given nonce, its total fees and the validators which confirmed it
+func (k *Keeper) OutgoingBatch(c context.Context, req *types.QueryOutgoingBatchRequest) (*types.QueryOutgoingBatchResponse, error) {
+	metrics.ReportFuncCall(k.grpcTags)
+	doneFn := metrics.ReportFuncTiming(k.grpcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	batch := k.GetOutgoingTXBatchByNonce(ctx, req.BatchNonce)
+	if batch == nil {
+		return &types.QueryOutgoingBatchResponse{TotalFees: sdk.ZeroInt(), ConfirmedValidators: []string{}}, nil
+	}
+
+	totalFees := sdk.ZeroInt()
+	for _, tx := range batch.Transactions {
+		totalFees = totalFees.Add(tx.Erc20Fee.Amount)
+	}
+
+	confirmedValidators := make([]string, 0)
+	k.IterateBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, common.HexToAddress(batch.TokenContract),
+		func(_ []byte, confirm *types.MsgConfirmBatch) (stop bool) {
+			orchestrator, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
+			if err != nil {
+				return false
+			}
+
+			// the orchestrator may have been unset since it confirmed the batch
+			if validator, found := k.GetOrchestratorValidator(ctx, orchestrator); found {
+				confirmedValidators = append(confirmedValidators, validator.String())
+			}
+			return false
+		})
+	sort.Strings(confirmedValidators)
+
+	return &types.QueryOutgoingBatchResponse{
+		Batch:               batch,
+		TotalFees:           totalFees,
+		ConfirmedValidators: confirmedValidators,
+	}, nil
+}
+
 // LastEventByAddr returns the last event for the given validator address, this allows eth oracles to figure out where they left off
 func (k *Keeper) LastEventByAddr(c context.Context, req *types.QueryLastEventByAddrRequest) (*types.QueryLastEventByAddrResponse, error) {
 	metrics.ReportFuncCall(k.grpcTags)
@@ -281,6 +323,53 @@ func (k *Keeper) ERC20ToDenom(c context.Context, req *types.QueryERC20ToDenomReq
 	return &ret, nil
 }
 
+// DenomOrigin resolves a denom to its source: the IBC path and base denom of an IBC voucher, the Ethereum token
+// contract of a peggy voucher, or native for any other denom
+func (k *Keeper) DenomOrigin(c context.Context, req *types.QueryDenomOriginRequest) (*types.QueryDenomOriginResponse, error) {
+	metrics.ReportFuncCall(k.grpcTags)
+	doneFn := metrics.ReportFuncTiming(k.grpcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		metrics.ReportFuncError(k.grpcTags)
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if strings.HasPrefix(req.Denom, ibctransfertypes.DenomPrefix+"/") {
+		hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(req.Denom, ibctransfertypes.DenomPrefix+"/"))
+		if err != nil {
+			metrics.ReportFuncError(k.grpcTags)
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		denomTrace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+		if !found {
+			metrics.ReportFuncError(k.grpcTags)
+			return nil, errors.Wrapf(sdkerrors.ErrNotFound, "denom trace of %s not found", req.Denom)
+		}
+
+		return &types.QueryDenomOriginResponse{
+			Origin:       types.DENOM_ORIGIN_TYPE_IBC,
+			IbcPath:      denomTrace.Path,
+			IbcBaseDenom: denomTrace.BaseDenom,
+		}, nil
+	}
+
+	if peggyDenom, err := types.NewPeggyDenomFromString(req.Denom); err == nil {
+		tokenContract, _ := peggyDenom.TokenContract()
+		return &types.QueryDenomOriginResponse{
+			Origin: types.DENOM_ORIGIN_TYPE_PEGGY,
+			Erc20:  tokenContract.Hex(),
+		}, nil
+	}
+
+	return &types.QueryDenomOriginResponse{
+		Origin: types.DENOM_ORIGIN_TYPE_NATIVE,
+	}, nil
+}
+
 func (k *Keeper) GetDelegateKeyByValidator(c context.Context, req *types.QueryDelegateKeysByValidatorAddress) (*types.QueryDelegateKeysByValidatorAddressResponse, error) {
 	metrics.ReportFuncCall(k.grpcTags)
 	doneFn := metrics.ReportFuncTiming(k.grpcTags)
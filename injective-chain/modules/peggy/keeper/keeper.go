@@ -32,6 +32,7 @@ type Keeper struct {
 	DistKeeper        types.DistributionKeeper
 	SlashingKeeper    types.SlashingKeeper
 	exchangeMsgServer exchangetypes.MsgServer
+	transferKeeper    types.TransferKeeper
 
 	AttestationHandler interface {
 		Handle(sdk.Context, types.EthereumClaim) error
@@ -44,6 +45,11 @@ type Keeper struct {
 	authority string
 }
 
+// SetTransferKeeper sets the IBC transfer keeper, which is created after the peggy keeper.
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
@@ -24,6 +24,7 @@ import (
 	tmtypes "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
 func TestPrefixRange(t *testing.T) {
@@ -349,3 +350,61 @@ func TestPeggyBankEvents(t *testing.T) {
 		"attribute[0] key is not expected",
 	)
 }
+
+func TestDenomOrigin(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 16, 12, 0, 0, 0, time.UTC),
+	})
+
+	denomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-1/uatom")
+	app.TransferKeeper.SetDenomTrace(ctx, denomTrace)
+
+	tokenContract := common.HexToAddress(testpeggy.TokenContractAddrs[0])
+
+	specs := map[string]struct {
+		denom  string
+		expRes *types.QueryDenomOriginResponse
+		expErr bool
+	}{
+		"ibc denom": {
+			denom: denomTrace.IBCDenom(),
+			expRes: &types.QueryDenomOriginResponse{
+				Origin:       types.DENOM_ORIGIN_TYPE_IBC,
+				IbcPath:      "transfer/channel-1",
+				IbcBaseDenom: "uatom",
+			},
+		},
+		"ibc denom without trace": {
+			denom:  ibctransfertypes.ParseDenomTrace("transfer/channel-2/uatom").IBCDenom(),
+			expErr: true,
+		},
+		"peggy denom": {
+			denom: types.PeggyDenomString(tokenContract),
+			expRes: &types.QueryDenomOriginResponse{
+				Origin: types.DENOM_ORIGIN_TYPE_PEGGY,
+				Erc20:  tokenContract.Hex(),
+			},
+		},
+		"native denom": {
+			denom: "inj",
+			expRes: &types.QueryDenomOriginResponse{
+				Origin: types.DENOM_ORIGIN_TYPE_NATIVE,
+			},
+		},
+	}
+
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			res, err := app.PeggyKeeper.DenomOrigin(sdk.WrapSDKContext(ctx), &types.QueryDenomOriginRequest{Denom: spec.denom})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expRes, res)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 )
 
 // AddToOutgoingPool
+// - checks the sender is below the max number of pending withdrawals
 // - checks a counterpart denominator exists for the given voucher type
 // - burns the voucher for transfer amount and fees
 // - persists an OutgoingTx
@@ -23,6 +24,13 @@ import (
 func (k *Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver common.Address, amount, fee sdk.Coin) (uint64, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
+	if maxPending := k.GetParams(ctx).MaxPendingWithdrawalsPerAccount; maxPending > 0 {
+		if pending := k.GetPendingWithdrawalCount(ctx, sender); pending >= maxPending {
+			metrics.ReportFuncError(k.svcTags)
+			return 0, errors.Wrapf(types.ErrMaxPendingWithdrawals, "%s has %d pending withdrawals, the max is %d", sender.String(), pending, maxPending)
+		}
+	}
+
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
 
@@ -75,7 +83,7 @@ func (k *Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, count
 	}
 
 	// add a second index with the fee
-	k.appendToUnbatchedTXIndex(ctx, tokenContract, erc20Fee, nextID)
+	k.addToUnbatchedTXIndex(ctx, tokenContract, erc20Fee, nextID)
 
 	// todo: add second index for sender so that we can easily query: give pending Tx by sender
 	// todo: what about a second index for receiver?
@@ -179,8 +187,9 @@ func (k *Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, s
 	return nil
 }
 
-// appendToUnbatchedTXIndex add at the end when tx with same fee exists
-func (k *Keeper) appendToUnbatchedTXIndex(ctx sdk.Context, tokenContract common.Address, fee *types.ERC20Token, txID uint64) {
+// addToUnbatchedTXIndex adds the tx to the ids with the same fee, which are kept in ascending order so that
+// the txs with the same fee are batched by id (see IterateOutgoingPoolByFee)
+func (k *Keeper) addToUnbatchedTXIndex(ctx sdk.Context, tokenContract common.Address, fee *types.ERC20Token, txID uint64) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	store := ctx.KVStore(k.storeKey)
@@ -190,24 +199,28 @@ func (k *Keeper) appendToUnbatchedTXIndex(ctx sdk.Context, tokenContract common.
 		bz := store.Get(idxKey)
 		k.cdc.MustUnmarshal(bz, &idSet)
 	}
-	idSet.Ids = append(idSet.Ids, txID)
+
+	ids := sortedIDs(idSet.Ids)
+	i := sort.Search(len(ids), func(i int) bool { return ids[i] >= txID })
+	ids = append(ids, 0)
+	copy(ids[i+1:], ids[i:])
+	ids[i] = txID
+
+	idSet.Ids = ids
 	store.Set(idxKey, k.cdc.MustMarshal(&idSet))
 }
 
-// appendToUnbatchedTXIndex add at the top when tx with same fee exists
-func (k *Keeper) prependToUnbatchedTXIndex(ctx sdk.Context, tokenContract common.Address, fee *types.ERC20Token, txID uint64) {
-	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
-
-	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetFeeSecondIndexKey(tokenContract, fee)
-	var idSet types.IDSet
-	if store.Has(idxKey) {
-		bz := store.Get(idxKey)
-		k.cdc.MustUnmarshal(bz, &idSet)
+// sortedIDs returns the ids in ascending order. The ids of the index entries written before they were kept in order
+// may be in any order.
+func sortedIDs(ids []uint64) []uint64 {
+	if sort.SliceIsSorted(ids, func(i, j int) bool { return ids[i] < ids[j] }) {
+		return ids
 	}
 
-	idSet.Ids = append([]uint64{txID}, idSet.Ids...)
-	store.Set(idxKey, k.cdc.MustMarshal(&idSet))
+	sorted := make([]uint64, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
 }
 
 // removeFromUnbatchedTXIndex removes the tx from the index and also removes it from the iterator
@@ -254,7 +267,19 @@ func (k *Keeper) setPoolEntry(ctx sdk.Context, outgoingTransferTx *types.Outgoin
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetOutgoingTxPoolKey(outgoingTransferTx.Id), bz)
+	key := types.GetOutgoingTxPoolKey(outgoingTransferTx.Id)
+
+	// entries are set again when a batch is stored, only count them once
+	if !store.Has(key) {
+		sender, err := sdk.AccAddressFromBech32(outgoingTransferTx.Sender)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return err
+		}
+		k.setPendingWithdrawalCount(ctx, sender, k.GetPendingWithdrawalCount(ctx, sender)+1)
+	}
+
+	store.Set(key, bz)
 
 	return nil
 }
@@ -287,10 +312,77 @@ func (k *Keeper) getPoolEntry(ctx sdk.Context, id uint64) (*types.OutgoingTransf
 func (k *Keeper) removePoolEntry(ctx sdk.Context, id uint64) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
+	tx, err := k.getPoolEntry(ctx, id)
+	if err != nil {
+		return
+	}
+
+	if sender, err := sdk.AccAddressFromBech32(tx.Sender); err == nil {
+		if pending := k.GetPendingWithdrawalCount(ctx, sender); pending > 0 {
+			k.setPendingWithdrawalCount(ctx, sender, pending-1)
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOutgoingTxPoolKey(id))
 }
 
+// GetPendingWithdrawalCount returns the number of withdrawals of the account in the tx pool, batched or not
+func (k *Keeper) GetPendingWithdrawalCount(ctx sdk.Context, account sdk.AccAddress) uint64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingWithdrawalCountKey(account))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k *Keeper) setPendingWithdrawalCount(ctx sdk.Context, account sdk.AccAddress, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPendingWithdrawalCountKey(account)
+
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(count))
+}
+
+// InitializePendingWithdrawalCounts counts the withdrawals of every account in the tx pool, batched or not.
+// Only needed for the state which predates the counts, they are kept up to date by the pool afterwards.
+func (k *Keeper) InitializePendingWithdrawalCounts(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := ctx.KVStore(k.storeKey)
+	counts := make(map[string]uint64)
+
+	iter := prefix.NewStore(store, types.OutgoingTXPoolKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var tx types.OutgoingTransferTx
+		k.cdc.MustUnmarshal(iter.Value(), &tx)
+		counts[tx.Sender]++
+	}
+
+	senders := make([]string, 0, len(counts))
+	for sender := range counts {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	for _, sender := range senders {
+		account, err := sdk.AccAddressFromBech32(sender)
+		if err != nil {
+			continue
+		}
+		k.setPendingWithdrawalCount(ctx, account, counts[sender])
+	}
+}
+
 // GetPoolTransactions, grabs all transactions from the tx pool, useful for queries or genesis save/load
 // this does not include all transactions in batches, because it iterates using the second index key
 func (k *Keeper) GetPoolTransactions(ctx sdk.Context) []*types.OutgoingTransferTx {
@@ -307,7 +399,7 @@ func (k *Keeper) GetPoolTransactions(ctx sdk.Context) []*types.OutgoingTransferT
 	for ; iter.Valid(); iter.Next() {
 		var ids types.IDSet
 		k.cdc.MustUnmarshal(iter.Value(), &ids)
-		for _, id := range ids.Ids {
+		for _, id := range sortedIDs(ids.Ids) {
 			tx, err := k.getPoolEntry(ctx, id)
 			if err != nil {
 				metrics.ReportFuncError(k.svcTags)
@@ -320,7 +412,8 @@ func (k *Keeper) GetPoolTransactions(ctx sdk.Context) []*types.OutgoingTransferT
 	return ret
 }
 
-// IterateOutgoingPoolByFee itetates over the outgoing pool which is sorted by fee
+// IterateOutgoingPoolByFee iterates over the unbatched txs of the token in the order they are batched: by fee
+// descending, then by id ascending, so the oldest tx goes first among the txs with the same fee
 func (k *Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, tokenContract common.Address, cb func(uint64, *types.OutgoingTransferTx) bool) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -332,7 +425,7 @@ func (k *Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, tokenContract common.
 		var ids types.IDSet
 		k.cdc.MustUnmarshal(iter.Value(), &ids)
 		// cb returns true to stop early
-		for _, id := range ids.Ids {
+		for _, id := range sortedIDs(ids.Ids) {
 			tx, err := k.getPoolEntry(ctx, id)
 			if err != nil {
 				metrics.ReportFuncError(k.svcTags)
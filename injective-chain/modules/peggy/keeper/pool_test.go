@@ -142,6 +142,57 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 
 }
 
+func TestMaxPendingWithdrawalsPerAccount(t *testing.T) {
+	input := testpeggy.CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("inj1f2kdg34689x93cvw2y59z7y46dvz2fk8g3cggx")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	// mint some voucher first
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).PeggyCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	// set senders balance
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	input.PeggyKeeper.SetLastOutgoingPoolID(ctx, uint64(0))
+	input.PeggyKeeper.SetLastOutgoingBatchID(ctx, uint64(0))
+
+	params := input.PeggyKeeper.GetParams(ctx)
+	params.MaxPendingWithdrawalsPerAccount = 2
+	input.PeggyKeeper.SetParams(ctx, params)
+
+	withdraw := func() error {
+		amount := types.NewERC20Token(100, myTokenContractAddr).PeggyCoin()
+		fee := types.NewERC20Token(2, myTokenContractAddr).PeggyCoin()
+		_, err := input.PeggyKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+		return err
+	}
+
+	require.NoError(t, withdraw())
+	require.NoError(t, withdraw())
+	assert.Equal(t, uint64(2), input.PeggyKeeper.GetPendingWithdrawalCount(ctx, mySender))
+
+	// at the cap
+	balance := input.BankKeeper.GetAllBalances(ctx, mySender)
+	require.ErrorIs(t, withdraw(), types.ErrMaxPendingWithdrawals)
+	assert.Equal(t, balance, input.BankKeeper.GetAllBalances(ctx, mySender))
+
+	// batched withdrawals are still pending
+	batch, err := input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), input.PeggyKeeper.GetPendingWithdrawalCount(ctx, mySender))
+	require.ErrorIs(t, withdraw(), types.ErrMaxPendingWithdrawals)
+
+	// the executed batch frees a slot
+	input.PeggyKeeper.OutgoingTxBatchExecuted(ctx, myTokenContractAddr, batch.BatchNonce)
+	assert.Equal(t, uint64(1), input.PeggyKeeper.GetPendingWithdrawalCount(ctx, mySender))
+	require.NoError(t, withdraw())
+	assert.Equal(t, uint64(2), input.PeggyKeeper.GetPendingWithdrawalCount(ctx, mySender))
+}
+
 func TestLastOutgoingBatchID(t *testing.T) {
 	input := testpeggy.CreateTestEnv(t)
 	ctx := input.Context
@@ -20,4 +20,6 @@ var (
 	ErrInvalidEthSender        = errors.Register(ModuleName, 13, "invalid ethereum sender on claim")
 	ErrInvalidEthDestination   = errors.Register(ModuleName, 14, "invalid ethereum destination")
 	ErrNoLastClaimForValidator = errors.Register(ModuleName, 15, "missing previous claim for validator")
+	ErrMaxPendingWithdrawals   = errors.Register(ModuleName, 16, "account exceeds the max number of pending withdrawals")
+	ErrBatchFeesBelowMinimum   = errors.Register(ModuleName, 17, "batch fees are below the minimum")
 )
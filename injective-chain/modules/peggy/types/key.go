@@ -113,8 +113,18 @@ var (
 	PastEthSignatureCheckpointKey = []byte{0x1b}
 
 	EthereumBlacklistKey = []byte{0x1c}
+
+	// PendingWithdrawalCountKey indexes the number of withdrawals of an account in the outgoing pool
+	PendingWithdrawalCountKey = []byte{0x1d}
 )
 
+// GetPendingWithdrawalCountKey returns the following key format
+// prefix     cosmos-account
+// [0x1d][inj1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetPendingWithdrawalCountKey(account sdk.AccAddress) []byte {
+	return append(PendingWithdrawalCountKey, account.Bytes()...)
+}
+
 func GetEthereumBlacklistStoreKey(addr common.Address) []byte {
 	return append(EthereumBlacklistKey, addr.Bytes()...)
 }
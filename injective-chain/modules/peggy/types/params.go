@@ -33,6 +33,8 @@ func DefaultParams() *Params {
 		CosmosCoinDenom:               "inj",
 		UnbondSlashingValsetsWindow:   10000,
 		ClaimSlashingEnabled:          false,
+		// no limit on the pending withdrawals by default
+		MaxPendingWithdrawalsPerAccount: 0,
 	}
 }
 
@@ -98,6 +100,12 @@ func (p Params) ValidateBasic() error {
 	if err := validateClaimSlashingEnabled(p.ClaimSlashingEnabled); err != nil {
 		return errors.Wrap(err, "claim slashing enabled")
 	}
+	if err := validateMaxPendingWithdrawalsPerAccount(p.MaxPendingWithdrawalsPerAccount); err != nil {
+		return errors.Wrap(err, "max pending withdrawals per account")
+	}
+	if err := validateMinBatchFee(p.MinBatchFee); err != nil {
+		return errors.Wrap(err, "min batch fee")
+	}
 
 	return nil
 }
@@ -290,6 +298,21 @@ func validateSlashFractionBadEthSignature(i interface{}) error {
 	return nil
 }
 
+func validateMaxPendingWithdrawalsPerAccount(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinBatchFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
 func validateValsetReward(i interface{}) error {
 	return nil
 }
@@ -47,6 +47,14 @@ type Params struct {
 	ClaimSlashingEnabled          bool                                   `protobuf:"varint,19,opt,name=claim_slashing_enabled,json=claimSlashingEnabled,proto3" json:"claim_slashing_enabled,omitempty"`
 	BridgeContractStartHeight     uint64                                 `protobuf:"varint,20,opt,name=bridge_contract_start_height,json=bridgeContractStartHeight,proto3" json:"bridge_contract_start_height,omitempty"`
 	ValsetReward                  types.Coin                             `protobuf:"bytes,21,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	// max_pending_withdrawals_per_account is the maximum number of withdrawals
+	// an account can have in the outgoing pool, batched or not, until they are
+	// executed on Ethereum or cancelled. Zero means no limit.
+	MaxPendingWithdrawalsPerAccount uint64 `protobuf:"varint,22,opt,name=max_pending_withdrawals_per_account,json=maxPendingWithdrawalsPerAccount,proto3" json:"max_pending_withdrawals_per_account,omitempty"`
+	// min_batch_fee is the minimum total fee of a batch of the denom, a batch
+	// with lower fees isn't created and its transactions stay in the pool.
+	// Denoms without a minimum have no minimum.
+	MinBatchFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,23,rep,name=min_batch_fee,json=minBatchFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_batch_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -194,6 +202,20 @@ func (m *Params) GetValsetReward() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetMaxPendingWithdrawalsPerAccount() uint64 {
+	if m != nil {
+		return m.MaxPendingWithdrawalsPerAccount
+	}
+	return 0
+}
+
+func (m *Params) GetMinBatchFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinBatchFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.peggy.v1.Params")
 }
@@ -201,55 +223,60 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/params.proto", fileDescriptor_f21ffdf8d29783da) }
 
 var fileDescriptor_f21ffdf8d29783da = []byte{
-	// 759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0x3b, 0x45,
-	0x18, 0xc7, 0xbb, 0x5a, 0xf9, 0xc1, 0x00, 0x02, 0x43, 0x2b, 0xc3, 0x1f, 0x97, 0xc6, 0x03, 0x69,
-	0x8c, 0xec, 0x52, 0x34, 0x1e, 0x34, 0xc6, 0xd8, 0x52, 0x03, 0xd1, 0x83, 0x29, 0x46, 0x13, 0x2f,
-	0xe3, 0xec, 0xce, 0xb0, 0x3b, 0xd2, 0xdd, 0x69, 0x76, 0xa6, 0x6d, 0xb8, 0xf9, 0x12, 0x7c, 0x59,
-	0x1c, 0x39, 0x1a, 0x63, 0x88, 0x81, 0x37, 0xe1, 0xd1, 0xcc, 0x33, 0xbb, 0x6d, 0xa9, 0x9e, 0x88,
-	0xa7, 0x76, 0xf7, 0xf3, 0xfd, 0x3e, 0xdf, 0xc9, 0xf3, 0x4c, 0x9f, 0xa2, 0x63, 0x99, 0xff, 0x22,
-	0x62, 0x23, 0x27, 0x22, 0x1c, 0x89, 0x24, 0xb9, 0x0b, 0x27, 0x9d, 0x70, 0xc4, 0x0a, 0x96, 0xe9,
-	0x60, 0x54, 0x28, 0xa3, 0x30, 0x9e, 0x09, 0x02, 0x10, 0x04, 0x93, 0xce, 0x41, 0x23, 0x51, 0x89,
-	0x02, 0x1c, 0xda, 0x6f, 0x4e, 0x79, 0xe0, 0xc7, 0x4a, 0x67, 0x4a, 0x87, 0x11, 0xd3, 0x22, 0x9c,
-	0x74, 0x22, 0x61, 0x58, 0x27, 0x8c, 0x95, 0xcc, 0x1d, 0xff, 0xe0, 0x6f, 0x84, 0x56, 0xbe, 0x83,
-	0xd2, 0x78, 0x1f, 0xad, 0x42, 0x31, 0x2a, 0x39, 0xf1, 0x5a, 0x5e, 0x7b, 0x6d, 0xf0, 0x06, 0x9e,
-	0xaf, 0x38, 0x3e, 0x43, 0x8d, 0x58, 0xe5, 0xa6, 0x60, 0xb1, 0xa1, 0x5a, 0x8d, 0x8b, 0x58, 0xd0,
-	0x94, 0xe9, 0x94, 0xbc, 0x05, 0x32, 0x5c, 0xb1, 0x6b, 0x40, 0x97, 0x4c, 0xa7, 0xf8, 0x53, 0xb4,
-	0x17, 0x15, 0x92, 0x27, 0x82, 0x0a, 0x93, 0x8a, 0x42, 0x8c, 0x33, 0xca, 0x38, 0x2f, 0x84, 0xd6,
-	0xe4, 0x6d, 0x30, 0x35, 0x1d, 0xee, 0x97, 0xf4, 0x2b, 0x07, 0xf1, 0x09, 0xda, 0x2a, 0x7d, 0x71,
-	0xca, 0x64, 0x6e, 0xcf, 0x52, 0x6f, 0x79, 0xed, 0xfa, 0x60, 0xd3, 0xbd, 0xee, 0xd9, 0xb7, 0x57,
-	0x1c, 0x9f, 0xa3, 0xa6, 0x96, 0x49, 0x2e, 0x38, 0x9d, 0xb0, 0xa1, 0x16, 0x46, 0xd3, 0xa9, 0xcc,
-	0xb9, 0x9a, 0x92, 0x77, 0x40, 0xbd, 0xeb, 0xe0, 0x0f, 0x8e, 0xfd, 0x08, 0x68, 0xc1, 0x13, 0x31,
-	0x13, 0xa7, 0x62, 0xe6, 0x59, 0x59, 0xf4, 0x74, 0x1d, 0x2b, 0x3d, 0x67, 0xa8, 0x51, 0x7a, 0xe2,
-	0x21, 0x93, 0xd9, 0xcc, 0xf2, 0x06, 0x2c, 0xd8, 0xb1, 0x1e, 0xa0, 0xb9, 0xc3, 0xb0, 0x22, 0x11,
-	0xc6, 0xa5, 0x50, 0x23, 0x33, 0xa1, 0xc6, 0x86, 0xac, 0x3a, 0x87, 0x63, 0x10, 0xf2, 0xbd, 0x23,
-	0xf8, 0x23, 0x84, 0xd9, 0x44, 0x14, 0x2c, 0x11, 0x34, 0x1a, 0xaa, 0xf8, 0x16, 0x2c, 0x64, 0x0d,
-	0xf4, 0xdb, 0x25, 0xe9, 0x5a, 0x60, 0x0d, 0xf8, 0x0b, 0x74, 0x58, 0xa9, 0x67, 0xad, 0x5d, 0xb0,
-	0x21, 0xb0, 0x91, 0x52, 0x52, 0xb5, 0x77, 0x6e, 0x8f, 0x50, 0x53, 0x0f, 0x99, 0x4e, 0xe9, 0x8d,
-	0x9d, 0x98, 0x54, 0x79, 0xd9, 0x40, 0xb2, 0xde, 0xf2, 0xda, 0x1b, 0xdd, 0xe0, 0xfe, 0xf1, 0xb8,
-	0xf6, 0xc7, 0xe3, 0xf1, 0x49, 0x22, 0x4d, 0x3a, 0x8e, 0x82, 0x58, 0x65, 0x61, 0x79, 0x85, 0xdc,
-	0xc7, 0xa9, 0xe6, 0xb7, 0xa1, 0xb9, 0x1b, 0x09, 0x1d, 0x5c, 0x88, 0x78, 0xb0, 0x0b, 0xc5, 0xbe,
-	0x2e, 0x6b, 0xb9, 0x7e, 0xe3, 0x9f, 0x51, 0x63, 0x29, 0x03, 0x5a, 0x41, 0x36, 0x5e, 0x15, 0x81,
-	0x5f, 0x44, 0x40, 0xe7, 0xfe, 0x23, 0x01, 0xc6, 0x43, 0x36, 0xff, 0x87, 0x04, 0x98, 0x26, 0x9e,
-	0xa2, 0xd6, 0x72, 0x82, 0xca, 0x6f, 0x86, 0x32, 0x36, 0x32, 0x4f, 0xca, 0xb4, 0x77, 0x5f, 0x95,
-	0xf6, 0xfe, 0xcb, 0xb4, 0x79, 0x55, 0x17, 0xdc, 0x43, 0xfe, 0x38, 0x8f, 0x54, 0xce, 0x29, 0xe8,
-	0x6c, 0xda, 0xd2, 0x15, 0xdf, 0x82, 0x11, 0x1f, 0x3a, 0xd5, 0x75, 0x29, 0x7a, 0x79, 0xd5, 0x27,
-	0xff, 0x3a, 0x7d, 0xc4, 0xb8, 0xbd, 0x2f, 0xd4, 0xde, 0x58, 0x66, 0xc6, 0x85, 0x20, 0xdb, 0xaf,
-	0x3a, 0xfd, 0xd1, 0xd2, 0x34, 0x78, 0xdf, 0xa4, 0xd7, 0x55, 0x4d, 0xfc, 0x21, 0xda, 0x71, 0x2e,
-	0x6a, 0x77, 0x0c, 0xe5, 0x22, 0x57, 0x19, 0xd9, 0x81, 0x1f, 0xfc, 0x96, 0x03, 0x3d, 0x25, 0xf3,
-	0x0b, 0xfb, 0x1a, 0x7f, 0x8e, 0x0e, 0x16, 0xb5, 0xa2, 0x88, 0xcf, 0xcf, 0x68, 0xb5, 0x4a, 0x08,
-	0x06, 0xd3, 0xde, 0xdc, 0xd4, 0xb7, 0xbc, 0x57, 0x62, 0xfc, 0x09, 0x7a, 0x0f, 0x66, 0x30, 0x6f,
-	0x92, 0xc8, 0x59, 0x34, 0x14, 0x9c, 0xec, 0xb6, 0xbc, 0xf6, 0xea, 0xa0, 0x01, 0xb4, 0x6a, 0x4e,
-	0xdf, 0x31, 0xfc, 0x25, 0x3a, 0xaa, 0xb6, 0xcb, 0x6c, 0x9d, 0x19, 0x56, 0x18, 0x9a, 0x0a, 0x99,
-	0xa4, 0x86, 0x34, 0xa0, 0xb3, 0xfb, 0xe5, 0xaa, 0xa9, 0xb6, 0x9a, 0x55, 0x5c, 0x82, 0x00, 0x5f,
-	0xa0, 0x4d, 0x37, 0x0c, 0x5a, 0x88, 0x29, 0x2b, 0x38, 0x69, 0xb6, 0xbc, 0xf6, 0xfa, 0xf9, 0x7e,
-	0xe0, 0xce, 0x19, 0xd8, 0x35, 0x1b, 0x94, 0x6b, 0x36, 0xb0, 0xa7, 0xee, 0xd6, 0x6d, 0x7f, 0x07,
-	0x1b, 0xce, 0x35, 0x00, 0xd3, 0x67, 0xf5, 0x5f, 0xff, 0x6c, 0xd5, 0xba, 0xe2, 0xfe, 0xc9, 0xf7,
-	0x1e, 0x9e, 0x7c, 0xef, 0xaf, 0x27, 0xdf, 0xfb, 0xed, 0xd9, 0xaf, 0x3d, 0x3c, 0xfb, 0xb5, 0xdf,
-	0x9f, 0xfd, 0xda, 0x4f, 0xdf, 0x2c, 0xcc, 0xe2, 0xaa, 0xda, 0xf4, 0xdf, 0xb2, 0x48, 0x87, 0xb3,
-	0xbd, 0x7f, 0x1a, 0xab, 0x42, 0x2c, 0x3e, 0xda, 0xbd, 0x18, 0x66, 0x8a, 0x8f, 0x87, 0x42, 0x97,
-	0xff, 0x1a, 0x30, 0xb4, 0x68, 0x05, 0x16, 0xfd, 0xc7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8a,
-	0xea, 0x23, 0xd2, 0x55, 0x06, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xd1, 0x6e, 0x1b, 0x45,
+	0x14, 0xf5, 0xd2, 0x90, 0xa6, 0x93, 0x84, 0xb4, 0x13, 0xa7, 0x99, 0xa4, 0xc5, 0xb6, 0x40, 0xaa,
+	0x2c, 0x44, 0x77, 0x93, 0x80, 0x78, 0x00, 0x21, 0x54, 0x3b, 0xa9, 0x1a, 0xd1, 0x87, 0xc8, 0x41,
+	0x54, 0xe2, 0x65, 0x98, 0x9d, 0xb9, 0xd9, 0x1d, 0xea, 0xdd, 0xb1, 0x66, 0xc6, 0x76, 0xfb, 0xc6,
+	0x27, 0xf0, 0x1d, 0xfc, 0x00, 0xbf, 0xd0, 0xc7, 0x3e, 0x22, 0x84, 0x02, 0x4a, 0x7e, 0x04, 0xcd,
+	0xcc, 0xae, 0xed, 0x04, 0x84, 0x50, 0xc4, 0x93, 0xbd, 0x73, 0xce, 0xb9, 0xe7, 0xea, 0xde, 0x3b,
+	0x77, 0x50, 0x5b, 0x96, 0x3f, 0x00, 0xb7, 0x72, 0x02, 0xc9, 0x08, 0xb2, 0xec, 0x75, 0x32, 0xd9,
+	0x4f, 0x46, 0x4c, 0xb3, 0xc2, 0xc4, 0x23, 0xad, 0xac, 0xc2, 0x78, 0x46, 0x88, 0x3d, 0x21, 0x9e,
+	0xec, 0xef, 0x36, 0x33, 0x95, 0x29, 0x0f, 0x27, 0xee, 0x5f, 0x60, 0xee, 0xb6, 0xb8, 0x32, 0x85,
+	0x32, 0x49, 0xca, 0x0c, 0x24, 0x93, 0xfd, 0x14, 0x2c, 0xdb, 0x4f, 0xb8, 0x92, 0x65, 0xc0, 0x3f,
+	0xf8, 0x65, 0x0d, 0x2d, 0x9f, 0xf8, 0xd0, 0x78, 0x07, 0xad, 0xf8, 0x60, 0x54, 0x0a, 0x12, 0x75,
+	0xa2, 0xee, 0x9d, 0xc1, 0x6d, 0xff, 0x7d, 0x2c, 0xf0, 0x1e, 0x6a, 0x72, 0x55, 0x5a, 0xcd, 0xb8,
+	0xa5, 0x46, 0x8d, 0x35, 0x07, 0x9a, 0x33, 0x93, 0x93, 0x77, 0x3c, 0x0d, 0xd7, 0xd8, 0xa9, 0x87,
+	0x9e, 0x31, 0x93, 0xe3, 0xcf, 0xd0, 0x76, 0xaa, 0xa5, 0xc8, 0x80, 0x82, 0xcd, 0x41, 0xc3, 0xb8,
+	0xa0, 0x4c, 0x08, 0x0d, 0xc6, 0x90, 0x5b, 0x5e, 0xb4, 0x15, 0xe0, 0xa3, 0x0a, 0x7d, 0x12, 0x40,
+	0xfc, 0x08, 0x6d, 0x54, 0x3a, 0x9e, 0x33, 0x59, 0xba, 0x5c, 0x96, 0x3a, 0x51, 0x77, 0x69, 0xb0,
+	0x1e, 0x8e, 0xfb, 0xee, 0xf4, 0x58, 0xe0, 0x03, 0xb4, 0x65, 0x64, 0x56, 0x82, 0xa0, 0x13, 0x36,
+	0x34, 0x60, 0x0d, 0x9d, 0xca, 0x52, 0xa8, 0x29, 0x79, 0xd7, 0xb3, 0x37, 0x03, 0xf8, 0x6d, 0xc0,
+	0x5e, 0x78, 0x68, 0x41, 0x93, 0x32, 0xcb, 0x73, 0x98, 0x69, 0x96, 0x17, 0x35, 0xbd, 0x80, 0x55,
+	0x9a, 0x3d, 0xd4, 0xac, 0x34, 0x7c, 0xc8, 0x64, 0x31, 0x93, 0xdc, 0xf6, 0x12, 0x1c, 0xb0, 0xbe,
+	0x87, 0xe6, 0x0a, 0xcb, 0x74, 0x06, 0x36, 0xb8, 0x50, 0x2b, 0x0b, 0x50, 0x63, 0x4b, 0x56, 0x82,
+	0x22, 0x60, 0xde, 0xe4, 0x9b, 0x80, 0xe0, 0x8f, 0x11, 0x66, 0x13, 0xd0, 0x2c, 0x03, 0x9a, 0x0e,
+	0x15, 0x7f, 0xe9, 0x25, 0xe4, 0x8e, 0xe7, 0xdf, 0xad, 0x90, 0x9e, 0x03, 0x9c, 0x00, 0x7f, 0x89,
+	0x1e, 0xd4, 0xec, 0x59, 0x69, 0x17, 0x64, 0xc8, 0xcb, 0x48, 0x45, 0xa9, 0xcb, 0x3b, 0x97, 0xa7,
+	0x68, 0xcb, 0x0c, 0x99, 0xc9, 0xe9, 0x99, 0xeb, 0x98, 0x54, 0x65, 0x55, 0x40, 0xb2, 0xda, 0x89,
+	0xba, 0x6b, 0xbd, 0xf8, 0xcd, 0x79, 0xbb, 0xf1, 0xdb, 0x79, 0xfb, 0x51, 0x26, 0x6d, 0x3e, 0x4e,
+	0x63, 0xae, 0x8a, 0xa4, 0x1a, 0xa1, 0xf0, 0xf3, 0xd8, 0x88, 0x97, 0x89, 0x7d, 0x3d, 0x02, 0x13,
+	0x1f, 0x02, 0x1f, 0x6c, 0xfa, 0x60, 0x4f, 0xab, 0x58, 0xa1, 0xde, 0xf8, 0x7b, 0xd4, 0xbc, 0xe6,
+	0xe1, 0x4b, 0x41, 0xd6, 0x6e, 0x64, 0x81, 0xaf, 0x58, 0xf8, 0xca, 0xfd, 0x83, 0x83, 0x6f, 0x0f,
+	0x59, 0xff, 0x1f, 0x1c, 0x7c, 0x37, 0xf1, 0x14, 0x75, 0xae, 0x3b, 0xa8, 0xf2, 0x6c, 0x28, 0xb9,
+	0x95, 0x65, 0x56, 0xb9, 0xbd, 0x77, 0x23, 0xb7, 0xf7, 0xaf, 0xba, 0xcd, 0xa3, 0x06, 0xe3, 0x3e,
+	0x6a, 0x8d, 0xcb, 0x54, 0x95, 0x82, 0x7a, 0x9e, 0x73, 0xbb, 0x36, 0xe2, 0x1b, 0xbe, 0xc5, 0x0f,
+	0x02, 0xeb, 0xb4, 0x22, 0x5d, 0x1d, 0xf5, 0xc9, 0xdf, 0xb2, 0x4f, 0x99, 0x70, 0xf3, 0x42, 0xdd,
+	0xc4, 0x32, 0x3b, 0xd6, 0x40, 0xee, 0xde, 0x28, 0xfb, 0x87, 0xd7, 0xba, 0x21, 0x8e, 0x6c, 0x7e,
+	0x5a, 0xc7, 0xc4, 0x1f, 0xa1, 0x7b, 0x41, 0x45, 0xdd, 0x8e, 0xa1, 0x02, 0x4a, 0x55, 0x90, 0x7b,
+	0xfe, 0xc2, 0x6f, 0x04, 0xa0, 0xaf, 0x64, 0x79, 0xe8, 0x8e, 0xf1, 0x17, 0x68, 0x77, 0x91, 0x0b,
+	0x9a, 0x1f, 0xec, 0xd1, 0x7a, 0x95, 0x10, 0xec, 0x45, 0xdb, 0x73, 0xd1, 0x91, 0xc3, 0xfb, 0x15,
+	0x8c, 0x3f, 0x45, 0xf7, 0x7d, 0x0f, 0xe6, 0x45, 0x82, 0x92, 0xa5, 0x43, 0x10, 0x64, 0xb3, 0x13,
+	0x75, 0x57, 0x06, 0x4d, 0x8f, 0xd6, 0xc5, 0x39, 0x0a, 0x18, 0xfe, 0x0a, 0x3d, 0xac, 0xb7, 0xcb,
+	0x6c, 0x9d, 0x59, 0xa6, 0x2d, 0xcd, 0x41, 0x66, 0xb9, 0x25, 0x4d, 0x5f, 0xd9, 0x9d, 0x6a, 0xd5,
+	0xd4, 0x5b, 0xcd, 0x31, 0x9e, 0x79, 0x02, 0x3e, 0x44, 0xeb, 0xa1, 0x19, 0x54, 0xc3, 0x94, 0x69,
+	0x41, 0xb6, 0x3a, 0x51, 0x77, 0xf5, 0x60, 0x27, 0x0e, 0x79, 0xc6, 0x6e, 0xcd, 0xc6, 0xd5, 0x9a,
+	0x8d, 0x5d, 0xd6, 0xbd, 0x25, 0x57, 0xdf, 0xc1, 0x5a, 0x50, 0x0d, 0xbc, 0x08, 0x3f, 0x47, 0x1f,
+	0x16, 0xec, 0x15, 0x1d, 0x41, 0x29, 0x5c, 0xe6, 0x53, 0x69, 0x73, 0xa1, 0xd9, 0x94, 0x0d, 0x0d,
+	0x1d, 0x81, 0xa6, 0x8c, 0x73, 0x35, 0x2e, 0x2d, 0xb9, 0xef, 0xb3, 0x69, 0x17, 0xec, 0xd5, 0x49,
+	0x60, 0xbe, 0x98, 0x13, 0x4f, 0x40, 0x3f, 0x09, 0x34, 0xac, 0xd0, 0x7a, 0x21, 0xab, 0x2b, 0x46,
+	0xcf, 0x00, 0xc8, 0x76, 0xe7, 0xd6, 0xbf, 0xe7, 0xb4, 0xe7, 0x72, 0xfa, 0xf9, 0x8f, 0x76, 0xf7,
+	0x3f, 0xf4, 0xdc, 0x09, 0xcc, 0x60, 0xb5, 0x90, 0xe1, 0xe6, 0x3d, 0x05, 0xf8, 0x7c, 0xe9, 0xc7,
+	0xdf, 0x3b, 0x8d, 0x1e, 0xbc, 0xb9, 0x68, 0x45, 0x6f, 0x2f, 0x5a, 0xd1, 0x9f, 0x17, 0xad, 0xe8,
+	0xa7, 0xcb, 0x56, 0xe3, 0xed, 0x65, 0xab, 0xf1, 0xeb, 0x65, 0xab, 0xf1, 0xdd, 0xd7, 0x0b, 0x61,
+	0x8f, 0xeb, 0x87, 0xea, 0x39, 0x4b, 0x4d, 0x32, 0x7b, 0xb6, 0x1e, 0x73, 0xa5, 0x61, 0xf1, 0xd3,
+	0xad, 0xf5, 0xa4, 0x50, 0x62, 0x3c, 0x04, 0x53, 0x3d, 0x7a, 0xde, 0x3f, 0x5d, 0xf6, 0xef, 0xd4,
+	0x27, 0x7f, 0x0d, 0x00, 0xbb, 0x79, 0xbe, 0x46, 0x14, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -272,6 +299,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinBatchFee) > 0 {
+		for iNdEx := len(m.MinBatchFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBatchFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.MaxPendingWithdrawalsPerAccount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPendingWithdrawalsPerAccount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	{
 		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -516,6 +566,15 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ValsetReward.Size()
 	n += 2 + l + sovParams(uint64(l))
+	if m.MaxPendingWithdrawalsPerAccount != 0 {
+		n += 2 + sovParams(uint64(m.MaxPendingWithdrawalsPerAccount))
+	}
+	if len(m.MinBatchFee) > 0 {
+		for _, e := range m.MinBatchFee {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1103,6 +1162,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingWithdrawalsPerAccount", wireType)
+			}
+			m.MaxPendingWithdrawalsPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingWithdrawalsPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBatchFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBatchFee = append(m.MinBatchFee, types.Coin{})
+			if err := m.MinBatchFee[len(m.MinBatchFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
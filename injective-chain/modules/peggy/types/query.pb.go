@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,6 +30,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type DenomOriginType int32
+
+const (
+	// the denom is native to the chain
+	DENOM_ORIGIN_TYPE_NATIVE DenomOriginType = 0
+	// the denom is an IBC voucher
+	DENOM_ORIGIN_TYPE_IBC DenomOriginType = 1
+	// the denom is a peggy voucher of an Ethereum token
+	DENOM_ORIGIN_TYPE_PEGGY DenomOriginType = 2
+)
+
+var DenomOriginType_name = map[int32]string{
+	0: "DENOM_ORIGIN_TYPE_NATIVE",
+	1: "DENOM_ORIGIN_TYPE_IBC",
+	2: "DENOM_ORIGIN_TYPE_PEGGY",
+}
+
+var DenomOriginType_value = map[string]int32{
+	"DENOM_ORIGIN_TYPE_NATIVE": 0,
+	"DENOM_ORIGIN_TYPE_IBC":    1,
+	"DENOM_ORIGIN_TYPE_PEGGY":  2,
+}
+
+func (x DenomOriginType) String() string {
+	return proto.EnumName(DenomOriginType_name, int32(x))
+}
+
+func (DenomOriginType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_702b8e5c1503495b, []int{0}
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -1085,6 +1117,106 @@ func (m *QueryBatchConfirmsResponse) GetConfirms() []*MsgConfirmBatch {
 	return nil
 }
 
+type QueryOutgoingBatchRequest struct {
+	BatchNonce uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+}
+
+func (m *QueryOutgoingBatchRequest) Reset()         { *m = QueryOutgoingBatchRequest{} }
+func (m *QueryOutgoingBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutgoingBatchRequest) ProtoMessage()    {}
+func (*QueryOutgoingBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702b8e5c1503495b, []int{24}
+}
+func (m *QueryOutgoingBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutgoingBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutgoingBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutgoingBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutgoingBatchRequest.Merge(m, src)
+}
+func (m *QueryOutgoingBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutgoingBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutgoingBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutgoingBatchRequest proto.InternalMessageInfo
+
+func (m *QueryOutgoingBatchRequest) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+type QueryOutgoingBatchResponse struct {
+	// batch is empty when there is no outgoing batch with the nonce
+	Batch     *OutgoingTxBatch                       `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	TotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees"`
+	// confirmed_validators are the validators whose orchestrator confirmed the
+	// batch, sorted by address
+	ConfirmedValidators []string `protobuf:"bytes,3,rep,name=confirmed_validators,json=confirmedValidators,proto3" json:"confirmed_validators,omitempty"`
+}
+
+func (m *QueryOutgoingBatchResponse) Reset()         { *m = QueryOutgoingBatchResponse{} }
+func (m *QueryOutgoingBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutgoingBatchResponse) ProtoMessage()    {}
+func (*QueryOutgoingBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702b8e5c1503495b, []int{25}
+}
+func (m *QueryOutgoingBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutgoingBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutgoingBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutgoingBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutgoingBatchResponse.Merge(m, src)
+}
+func (m *QueryOutgoingBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutgoingBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutgoingBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutgoingBatchResponse proto.InternalMessageInfo
+
+func (m *QueryOutgoingBatchResponse) GetBatch() *OutgoingTxBatch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *QueryOutgoingBatchResponse) GetConfirmedValidators() []string {
+	if m != nil {
+		return m.ConfirmedValidators
+	}
+	return nil
+}
+
 type QueryLastEventByAddrRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -1093,7 +1225,7 @@ func (m *QueryLastEventByAddrRequest) Reset()         { *m = QueryLastEventByAdd
 func (m *QueryLastEventByAddrRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastEventByAddrRequest) ProtoMessage()    {}
 func (*QueryLastEventByAddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{24}
+	return fileDescriptor_702b8e5c1503495b, []int{26}
 }
 func (m *QueryLastEventByAddrRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1269,7 @@ func (m *QueryLastEventByAddrResponse) Reset()         { *m = QueryLastEventByAd
 func (m *QueryLastEventByAddrResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastEventByAddrResponse) ProtoMessage()    {}
 func (*QueryLastEventByAddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{25}
+	return fileDescriptor_702b8e5c1503495b, []int{27}
 }
 func (m *QueryLastEventByAddrResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1313,7 @@ func (m *QueryERC20ToDenomRequest) Reset()         { *m = QueryERC20ToDenomReque
 func (m *QueryERC20ToDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryERC20ToDenomRequest) ProtoMessage()    {}
 func (*QueryERC20ToDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{26}
+	return fileDescriptor_702b8e5c1503495b, []int{28}
 }
 func (m *QueryERC20ToDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1358,7 @@ func (m *QueryERC20ToDenomResponse) Reset()         { *m = QueryERC20ToDenomResp
 func (m *QueryERC20ToDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryERC20ToDenomResponse) ProtoMessage()    {}
 func (*QueryERC20ToDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{27}
+	return fileDescriptor_702b8e5c1503495b, []int{29}
 }
 func (m *QueryERC20ToDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1277,7 +1409,7 @@ func (m *QueryDenomToERC20Request) Reset()         { *m = QueryDenomToERC20Reque
 func (m *QueryDenomToERC20Request) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomToERC20Request) ProtoMessage()    {}
 func (*QueryDenomToERC20Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{28}
+	return fileDescriptor_702b8e5c1503495b, []int{30}
 }
 func (m *QueryDenomToERC20Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1322,7 +1454,7 @@ func (m *QueryDenomToERC20Response) Reset()         { *m = QueryDenomToERC20Resp
 func (m *QueryDenomToERC20Response) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomToERC20Response) ProtoMessage()    {}
 func (*QueryDenomToERC20Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{29}
+	return fileDescriptor_702b8e5c1503495b, []int{31}
 }
 func (m *QueryDenomToERC20Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,6 +1497,121 @@ func (m *QueryDenomToERC20Response) GetCosmosOriginated() bool {
 	return false
 }
 
+type QueryDenomOriginRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomOriginRequest) Reset()         { *m = QueryDenomOriginRequest{} }
+func (m *QueryDenomOriginRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomOriginRequest) ProtoMessage()    {}
+func (*QueryDenomOriginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702b8e5c1503495b, []int{32}
+}
+func (m *QueryDenomOriginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomOriginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomOriginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomOriginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomOriginRequest.Merge(m, src)
+}
+func (m *QueryDenomOriginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomOriginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomOriginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomOriginRequest proto.InternalMessageInfo
+
+func (m *QueryDenomOriginRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryDenomOriginResponse struct {
+	Origin DenomOriginType `protobuf:"varint,1,opt,name=origin,proto3,enum=injective.peggy.v1.DenomOriginType" json:"origin,omitempty"`
+	// the IBC transfer path of an IBC voucher, e.g. transfer/channel-0
+	IbcPath string `protobuf:"bytes,2,opt,name=ibc_path,json=ibcPath,proto3" json:"ibc_path,omitempty"`
+	// the denom of an IBC voucher on its source chain
+	IbcBaseDenom string `protobuf:"bytes,3,opt,name=ibc_base_denom,json=ibcBaseDenom,proto3" json:"ibc_base_denom,omitempty"`
+	// the Ethereum token contract of a peggy voucher
+	Erc20 string `protobuf:"bytes,4,opt,name=erc20,proto3" json:"erc20,omitempty"`
+}
+
+func (m *QueryDenomOriginResponse) Reset()         { *m = QueryDenomOriginResponse{} }
+func (m *QueryDenomOriginResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomOriginResponse) ProtoMessage()    {}
+func (*QueryDenomOriginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702b8e5c1503495b, []int{33}
+}
+func (m *QueryDenomOriginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomOriginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomOriginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomOriginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomOriginResponse.Merge(m, src)
+}
+func (m *QueryDenomOriginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomOriginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomOriginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomOriginResponse proto.InternalMessageInfo
+
+func (m *QueryDenomOriginResponse) GetOrigin() DenomOriginType {
+	if m != nil {
+		return m.Origin
+	}
+	return DENOM_ORIGIN_TYPE_NATIVE
+}
+
+func (m *QueryDenomOriginResponse) GetIbcPath() string {
+	if m != nil {
+		return m.IbcPath
+	}
+	return ""
+}
+
+func (m *QueryDenomOriginResponse) GetIbcBaseDenom() string {
+	if m != nil {
+		return m.IbcBaseDenom
+	}
+	return ""
+}
+
+func (m *QueryDenomOriginResponse) GetErc20() string {
+	if m != nil {
+		return m.Erc20
+	}
+	return ""
+}
+
 type QueryDelegateKeysByValidatorAddress struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
@@ -1373,7 +1620,7 @@ func (m *QueryDelegateKeysByValidatorAddress) Reset()         { *m = QueryDelega
 func (m *QueryDelegateKeysByValidatorAddress) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegateKeysByValidatorAddress) ProtoMessage()    {}
 func (*QueryDelegateKeysByValidatorAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{30}
+	return fileDescriptor_702b8e5c1503495b, []int{34}
 }
 func (m *QueryDelegateKeysByValidatorAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1422,7 +1669,7 @@ func (m *QueryDelegateKeysByValidatorAddressResponse) String() string {
 }
 func (*QueryDelegateKeysByValidatorAddressResponse) ProtoMessage() {}
 func (*QueryDelegateKeysByValidatorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{31}
+	return fileDescriptor_702b8e5c1503495b, []int{35}
 }
 func (m *QueryDelegateKeysByValidatorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1473,7 +1720,7 @@ func (m *QueryDelegateKeysByEthAddress) Reset()         { *m = QueryDelegateKeys
 func (m *QueryDelegateKeysByEthAddress) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegateKeysByEthAddress) ProtoMessage()    {}
 func (*QueryDelegateKeysByEthAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{32}
+	return fileDescriptor_702b8e5c1503495b, []int{36}
 }
 func (m *QueryDelegateKeysByEthAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1518,7 +1765,7 @@ func (m *QueryDelegateKeysByEthAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegateKeysByEthAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegateKeysByEthAddressResponse) ProtoMessage()    {}
 func (*QueryDelegateKeysByEthAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{33}
+	return fileDescriptor_702b8e5c1503495b, []int{37}
 }
 func (m *QueryDelegateKeysByEthAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1571,7 +1818,7 @@ func (m *QueryDelegateKeysByOrchestratorAddress) Reset() {
 func (m *QueryDelegateKeysByOrchestratorAddress) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegateKeysByOrchestratorAddress) ProtoMessage()    {}
 func (*QueryDelegateKeysByOrchestratorAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{34}
+	return fileDescriptor_702b8e5c1503495b, []int{38}
 }
 func (m *QueryDelegateKeysByOrchestratorAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1620,7 +1867,7 @@ func (m *QueryDelegateKeysByOrchestratorAddressResponse) String() string {
 }
 func (*QueryDelegateKeysByOrchestratorAddressResponse) ProtoMessage() {}
 func (*QueryDelegateKeysByOrchestratorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{35}
+	return fileDescriptor_702b8e5c1503495b, []int{39}
 }
 func (m *QueryDelegateKeysByOrchestratorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,7 +1918,7 @@ func (m *QueryPendingSendToEth) Reset()         { *m = QueryPendingSendToEth{} }
 func (m *QueryPendingSendToEth) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingSendToEth) ProtoMessage()    {}
 func (*QueryPendingSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{36}
+	return fileDescriptor_702b8e5c1503495b, []int{40}
 }
 func (m *QueryPendingSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1716,7 +1963,7 @@ func (m *QueryPendingSendToEthResponse) Reset()         { *m = QueryPendingSendT
 func (m *QueryPendingSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingSendToEthResponse) ProtoMessage()    {}
 func (*QueryPendingSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{37}
+	return fileDescriptor_702b8e5c1503495b, []int{41}
 }
 func (m *QueryPendingSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1768,7 +2015,7 @@ func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest
 func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateRequest) ProtoMessage()    {}
 func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{38}
+	return fileDescriptor_702b8e5c1503495b, []int{42}
 }
 func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,7 +2054,7 @@ func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateRespon
 func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateResponse) ProtoMessage()    {}
 func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{39}
+	return fileDescriptor_702b8e5c1503495b, []int{43}
 }
 func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1850,7 +2097,7 @@ func (m *MissingNoncesRequest) Reset()         { *m = MissingNoncesRequest{} }
 func (m *MissingNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*MissingNoncesRequest) ProtoMessage()    {}
 func (*MissingNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{40}
+	return fileDescriptor_702b8e5c1503495b, []int{44}
 }
 func (m *MissingNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2134,7 @@ func (m *MissingNoncesResponse) Reset()         { *m = MissingNoncesResponse{} }
 func (m *MissingNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*MissingNoncesResponse) ProtoMessage()    {}
 func (*MissingNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_702b8e5c1503495b, []int{41}
+	return fileDescriptor_702b8e5c1503495b, []int{45}
 }
 func (m *MissingNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1924,6 +2171,7 @@ func (m *MissingNoncesResponse) GetOperatorAddresses() []string {
 }
 
 func init() {
+	proto.RegisterEnum("injective.peggy.v1.DenomOriginType", DenomOriginType_name, DenomOriginType_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "injective.peggy.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.peggy.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryCurrentValsetRequest)(nil), "injective.peggy.v1.QueryCurrentValsetRequest")
@@ -1948,12 +2196,16 @@ func init() {
 	proto.RegisterType((*QueryBatchRequestByNonceResponse)(nil), "injective.peggy.v1.QueryBatchRequestByNonceResponse")
 	proto.RegisterType((*QueryBatchConfirmsRequest)(nil), "injective.peggy.v1.QueryBatchConfirmsRequest")
 	proto.RegisterType((*QueryBatchConfirmsResponse)(nil), "injective.peggy.v1.QueryBatchConfirmsResponse")
+	proto.RegisterType((*QueryOutgoingBatchRequest)(nil), "injective.peggy.v1.QueryOutgoingBatchRequest")
+	proto.RegisterType((*QueryOutgoingBatchResponse)(nil), "injective.peggy.v1.QueryOutgoingBatchResponse")
 	proto.RegisterType((*QueryLastEventByAddrRequest)(nil), "injective.peggy.v1.QueryLastEventByAddrRequest")
 	proto.RegisterType((*QueryLastEventByAddrResponse)(nil), "injective.peggy.v1.QueryLastEventByAddrResponse")
 	proto.RegisterType((*QueryERC20ToDenomRequest)(nil), "injective.peggy.v1.QueryERC20ToDenomRequest")
 	proto.RegisterType((*QueryERC20ToDenomResponse)(nil), "injective.peggy.v1.QueryERC20ToDenomResponse")
 	proto.RegisterType((*QueryDenomToERC20Request)(nil), "injective.peggy.v1.QueryDenomToERC20Request")
 	proto.RegisterType((*QueryDenomToERC20Response)(nil), "injective.peggy.v1.QueryDenomToERC20Response")
+	proto.RegisterType((*QueryDenomOriginRequest)(nil), "injective.peggy.v1.QueryDenomOriginRequest")
+	proto.RegisterType((*QueryDenomOriginResponse)(nil), "injective.peggy.v1.QueryDenomOriginResponse")
 	proto.RegisterType((*QueryDelegateKeysByValidatorAddress)(nil), "injective.peggy.v1.QueryDelegateKeysByValidatorAddress")
 	proto.RegisterType((*QueryDelegateKeysByValidatorAddressResponse)(nil), "injective.peggy.v1.QueryDelegateKeysByValidatorAddressResponse")
 	proto.RegisterType((*QueryDelegateKeysByEthAddress)(nil), "injective.peggy.v1.QueryDelegateKeysByEthAddress")
@@ -1971,118 +2223,136 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/query.proto", fileDescriptor_702b8e5c1503495b) }
 
 var fileDescriptor_702b8e5c1503495b = []byte{
-	// 1767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6f, 0xdb, 0x46,
-	0x16, 0xc7, 0x4d, 0x6f, 0x6c, 0xc7, 0x2f, 0x9b, 0xc4, 0x1e, 0xcb, 0x89, 0x4c, 0xdb, 0xb2, 0xc2,
-	0xfc, 0x58, 0x3b, 0xb1, 0x45, 0x5b, 0xf9, 0xb5, 0xd9, 0x45, 0x9c, 0xc4, 0x5e, 0x27, 0x08, 0x92,
-	0xac, 0xbd, 0x8a, 0xb1, 0x59, 0x6c, 0xd3, 0x12, 0x94, 0x34, 0xa6, 0xd9, 0xca, 0x1c, 0x85, 0xa4,
-	0x8d, 0x08, 0x41, 0x0a, 0xb4, 0x97, 0x16, 0x28, 0x0a, 0x04, 0xe8, 0xa9, 0x3d, 0xa4, 0x3d, 0xf7,
-	0xd6, 0x7b, 0x0f, 0x3d, 0x15, 0xe9, 0x2d, 0x68, 0x51, 0xa0, 0xa7, 0xa2, 0x48, 0xfa, 0x87, 0x14,
-	0x9c, 0x19, 0x52, 0x22, 0x39, 0xa4, 0x28, 0xa7, 0xa7, 0x98, 0x33, 0xef, 0xc7, 0xe7, 0x0d, 0x87,
-	0x6f, 0xe6, 0x1b, 0x41, 0xc1, 0xb4, 0xde, 0xc5, 0x35, 0xd7, 0xdc, 0xc3, 0x6a, 0x13, 0x1b, 0x46,
-	0x4b, 0xdd, 0x5b, 0x52, 0x1f, 0xed, 0x62, 0xbb, 0x55, 0x6a, 0xda, 0xc4, 0x25, 0x08, 0x05, 0xf3,
-	0x25, 0x3a, 0x5f, 0xda, 0x5b, 0x92, 0x8b, 0x02, 0x1f, 0x03, 0x5b, 0xd8, 0x31, 0x1d, 0xe6, 0x25,
-	0xcf, 0x08, 0x2c, 0x9a, 0xba, 0xad, 0xef, 0xf8, 0x06, 0xa2, 0xb4, 0x6e, 0xab, 0x89, 0xfd, 0xf9,
-	0x69, 0xc1, 0xfc, 0x8e, 0x63, 0xa4, 0x4d, 0x37, 0x09, 0x69, 0xa4, 0x44, 0xaf, 0xea, 0x6e, 0x6d,
-	0x9b, 0xcf, 0x4f, 0x19, 0x84, 0x18, 0x0d, 0xac, 0xea, 0x4d, 0x53, 0xd5, 0x2d, 0x8b, 0xb8, 0xba,
-	0x6b, 0x12, 0xcb, 0x0f, 0x9e, 0x33, 0x88, 0x41, 0xe8, 0x9f, 0xaa, 0xf7, 0x17, 0x1b, 0x55, 0x72,
-	0x80, 0xfe, 0xe3, 0xad, 0xcb, 0x06, 0x2d, 0xa3, 0x82, 0x1f, 0xed, 0x62, 0xc7, 0x55, 0xd6, 0x61,
-	0x2c, 0x34, 0xea, 0x34, 0x89, 0xe5, 0x60, 0xf4, 0x77, 0x18, 0x64, 0xe5, 0xe6, 0xa5, 0xa2, 0x34,
-	0x7b, 0xa8, 0x2c, 0x97, 0xe2, 0xcb, 0x58, 0x62, 0x3e, 0x2b, 0x07, 0x5e, 0xfc, 0x3a, 0xd3, 0x57,
-	0xe1, 0xf6, 0xca, 0x24, 0x4c, 0xd0, 0x80, 0xab, 0xbb, 0xb6, 0x8d, 0x2d, 0xf7, 0xbf, 0x7a, 0xc3,
-	0xc1, 0xae, 0x9f, 0x6d, 0x03, 0x64, 0xd1, 0x24, 0x4f, 0x5a, 0x86, 0xc1, 0x3d, 0x3a, 0x92, 0x96,
-	0x94, 0xfb, 0x70, 0x4b, 0x65, 0x89, 0xa7, 0x0b, 0xe5, 0xe1, 0xff, 0xa0, 0x1c, 0x0c, 0x58, 0xc4,
-	0xaa, 0x61, 0x1a, 0xef, 0x40, 0x85, 0x3d, 0x04, 0x10, 0x11, 0x97, 0x37, 0x80, 0xb8, 0x13, 0x82,
-	0x58, 0x25, 0xd6, 0x96, 0x69, 0xef, 0xa4, 0x42, 0xa0, 0x3c, 0x0c, 0xe9, 0xf5, 0xba, 0x8d, 0x1d,
-	0x27, 0xdf, 0x5f, 0x94, 0x66, 0x87, 0x2b, 0xfe, 0xa3, 0xf2, 0x30, 0x84, 0x17, 0x04, 0xe3, 0x78,
-	0xcb, 0x30, 0x54, 0x63, 0x43, 0x9c, 0xef, 0x94, 0x88, 0xef, 0x9e, 0x63, 0x84, 0xdd, 0x7d, 0x27,
-	0xe5, 0x0a, 0x9c, 0x88, 0x47, 0x77, 0x56, 0x5a, 0xff, 0xf6, 0xa8, 0xd2, 0xd7, 0x6d, 0x0b, 0x94,
-	0x34, 0x57, 0x0e, 0x78, 0x1d, 0x0e, 0xf2, 0x5c, 0xde, 0xde, 0xf9, 0x4b, 0x66, 0xc2, 0xc0, 0x4b,
-	0x29, 0x42, 0x81, 0xe6, 0xb9, 0xab, 0x3b, 0xe1, 0xed, 0x13, 0x6c, 0xda, 0x07, 0x30, 0x93, 0x68,
-	0xc1, 0x31, 0x2e, 0xc0, 0x10, 0x7b, 0x39, 0x3e, 0x45, 0xda, 0x7b, 0xf4, 0x4d, 0x95, 0x9b, 0x70,
-	0x36, 0x08, 0xbc, 0x81, 0xad, 0xba, 0x69, 0x19, 0xa1, 0xf8, 0x2b, 0xad, 0x1b, 0xf5, 0xba, 0xed,
-	0x2f, 0x53, 0xc7, 0x3b, 0x94, 0xc2, 0xef, 0xb0, 0x06, 0xe7, 0x32, 0xc5, 0x79, 0x23, 0xd8, 0x63,
-	0x90, 0xa3, 0x49, 0x56, 0xbc, 0xc6, 0x70, 0x13, 0xfb, 0x6f, 0x4f, 0xd9, 0x84, 0xf1, 0xc8, 0x38,
-	0x4f, 0xf3, 0x4f, 0x18, 0xae, 0xf2, 0x31, 0x3f, 0xd1, 0xb4, 0x28, 0x91, 0xef, 0xe8, 0x54, 0xda,
-	0xf6, 0xca, 0x1a, 0xcc, 0x45, 0x4b, 0xa2, 0x76, 0x3d, 0xae, 0x8c, 0x11, 0x5f, 0x61, 0x51, 0x18,
-	0x4e, 0x7c, 0x05, 0x06, 0x28, 0x01, 0xdf, 0xeb, 0x27, 0x45, 0xb4, 0xeb, 0xbb, 0xae, 0x41, 0x4c,
-	0xcb, 0xd8, 0x7c, 0xcc, 0x02, 0x31, 0x0f, 0x65, 0x06, 0xa6, 0x69, 0xa2, 0xc8, 0x34, 0x0e, 0x36,
-	0x91, 0xc6, 0xb7, 0x99, 0xc0, 0x80, 0x67, 0xbf, 0x0a, 0x43, 0x55, 0x36, 0xc4, 0x57, 0x2b, 0x53,
-	0x7e, 0xdf, 0x47, 0xa9, 0xf2, 0x5d, 0x1a, 0xae, 0xaf, 0xfb, 0x87, 0x86, 0xe6, 0x60, 0xa4, 0x46,
-	0x2c, 0xd7, 0xd6, 0x6b, 0xae, 0x16, 0x6e, 0x12, 0x47, 0xfd, 0xf1, 0x1b, 0x7c, 0x39, 0xdf, 0x86,
-	0x62, 0x72, 0x8e, 0x37, 0x5f, 0xc4, 0x87, 0xbc, 0xb1, 0xd1, 0x41, 0xff, 0x8b, 0xff, 0x13, 0xe1,
-	0x65, 0x51, 0x74, 0x8e, 0x7d, 0x2d, 0xd6, 0x48, 0x4e, 0x26, 0x34, 0x12, 0xee, 0xca, 0xc8, 0xdb,
-	0x7d, 0xe4, 0x32, 0x4c, 0x06, 0x5b, 0x6d, 0x6d, 0x0f, 0x5b, 0x99, 0xf7, 0x68, 0x03, 0xa6, 0xc4,
-	0x8e, 0x9c, 0xec, 0x2e, 0x8c, 0x34, 0x74, 0xc7, 0xd5, 0x6a, 0x0d, 0xdd, 0xdc, 0xd1, 0xb0, 0x67,
-	0xc1, 0xd7, 0x56, 0x11, 0x11, 0x7a, 0x61, 0x56, 0x3d, 0x53, 0x1a, 0xab, 0x72, 0xa4, 0x11, 0x7a,
-	0x56, 0x16, 0x21, 0x4f, 0xb3, 0xad, 0x55, 0x56, 0xcb, 0x8b, 0x9b, 0xe4, 0x5f, 0xd8, 0x22, 0x9d,
-	0x67, 0x07, 0xb6, 0x6b, 0xe5, 0x45, 0x4e, 0xc8, 0x1e, 0x94, 0x77, 0xf8, 0x5b, 0x09, 0x7b, 0x70,
-	0xb8, 0x1c, 0x0c, 0xd4, 0xbd, 0x01, 0xdf, 0x85, 0x3e, 0xa0, 0x73, 0x30, 0x5a, 0x23, 0xce, 0x0e,
-	0x71, 0x34, 0x62, 0x9b, 0x86, 0x69, 0xe9, 0x2e, 0xae, 0xd3, 0xd7, 0x72, 0xb0, 0x32, 0xc2, 0x26,
-	0xd6, 0x83, 0xf1, 0x80, 0x88, 0x06, 0xde, 0x24, 0x34, 0x4d, 0x07, 0x51, 0x3c, 0x7c, 0x40, 0x14,
-	0xf6, 0x68, 0x13, 0xc5, 0x8b, 0xe8, 0x8d, 0xa8, 0x02, 0x27, 0x79, 0xfc, 0x06, 0x36, 0x74, 0x17,
-	0xdf, 0xc1, 0x2d, 0x67, 0xc5, 0x3b, 0x88, 0xcc, 0xba, 0xee, 0x12, 0x9b, 0x6f, 0x28, 0x2f, 0xe6,
-	0x9e, 0x3f, 0xa6, 0x85, 0x5f, 0xee, 0xc8, 0x5e, 0xc4, 0x58, 0xf9, 0x40, 0xe2, 0x4d, 0x3a, 0x3d,
-	0x68, 0x50, 0xc6, 0x0c, 0x1c, 0xc2, 0xee, 0x76, 0x24, 0x2c, 0x60, 0x77, 0xdb, 0xcf, 0xbe, 0x04,
-	0x39, 0x62, 0x7b, 0x5f, 0xbe, 0x6b, 0x87, 0x00, 0xd8, 0xee, 0x1f, 0xeb, 0x9c, 0xf3, 0x19, 0xae,
-	0xf3, 0x26, 0x15, 0x46, 0x58, 0x6b, 0xc7, 0xec, 0x96, 0x54, 0xf9, 0x48, 0x82, 0xd3, 0xa9, 0x21,
-	0x02, 0xfe, 0x5e, 0x16, 0x67, 0x3f, 0xb5, 0xbc, 0x05, 0x67, 0x04, 0x20, 0xeb, 0x71, 0xcb, 0xc4,
-	0xe0, 0x52, 0x72, 0xf0, 0xf7, 0xa1, 0x94, 0x2d, 0xf8, 0xfe, 0xca, 0x8d, 0x2c, 0x73, 0x7f, 0x6c,
-	0x99, 0x97, 0xf9, 0x99, 0xca, 0x8f, 0xac, 0xfb, 0xd8, 0xaa, 0x6f, 0x92, 0x35, 0x77, 0x1b, 0x9d,
-	0x86, 0x23, 0x0e, 0xb6, 0xea, 0x38, 0x9a, 0xe3, 0x30, 0x1b, 0xf5, 0xfd, 0x7f, 0x94, 0xf8, 0x9b,
-	0x8e, 0x06, 0x08, 0x78, 0xff, 0x07, 0x39, 0xd7, 0xd6, 0x2d, 0x67, 0x0b, 0xdb, 0x8e, 0x66, 0x5a,
-	0x5a, 0xf8, 0xe4, 0x39, 0x93, 0xda, 0xb4, 0xb9, 0xdf, 0xe6, 0xe3, 0x0a, 0x0a, 0x62, 0xdc, 0xb6,
-	0xf8, 0x71, 0x86, 0x1e, 0xc0, 0xd8, 0xae, 0xc5, 0xc2, 0xd5, 0xb5, 0x60, 0x3e, 0xdf, 0xdf, 0x5b,
-	0xe0, 0x20, 0x84, 0x3f, 0xe8, 0x28, 0x13, 0x70, 0x9c, 0xd6, 0x74, 0x8f, 0xd4, 0x77, 0x1b, 0xf8,
-	0xbe, 0xab, 0xbb, 0xc1, 0x1d, 0xa4, 0xc2, 0x5b, 0x48, 0x68, 0x8a, 0x57, 0x7a, 0x09, 0x06, 0x1c,
-	0x6f, 0x80, 0xf7, 0xcc, 0xa2, 0x88, 0xe0, 0x16, 0x53, 0x63, 0xcc, 0x91, 0x99, 0x7b, 0xf7, 0x9d,
-	0x7b, 0xa6, 0xe3, 0x98, 0x96, 0x41, 0xcf, 0xb7, 0xe0, 0x20, 0xbf, 0x09, 0xe3, 0x91, 0x71, 0x9e,
-	0x68, 0x01, 0x10, 0x69, 0xe2, 0xd0, 0x1e, 0xe3, 0x0b, 0x3a, 0x5c, 0x19, 0xf5, 0x67, 0x6e, 0xf8,
-	0x13, 0xe5, 0xef, 0x65, 0x18, 0xa0, 0xd0, 0xc8, 0x81, 0x41, 0xa6, 0x6d, 0x90, 0x70, 0x79, 0xe2,
-	0x32, 0x4a, 0xfe, 0x5b, 0x57, 0x3b, 0xc6, 0xa4, 0xe4, 0x3f, 0xfc, 0xe9, 0xf7, 0xcf, 0xfa, 0x11,
-	0x1a, 0x89, 0xea, 0x4a, 0xf4, 0x4c, 0x82, 0xc3, 0x21, 0x5d, 0x84, 0x16, 0x12, 0x83, 0x8a, 0xc4,
-	0x95, 0x5c, 0xca, 0x6a, 0xce, 0x51, 0x8a, 0x14, 0x45, 0x46, 0xf9, 0x36, 0x0a, 0xbb, 0x5a, 0xaa,
-	0x35, 0x66, 0x8f, 0x3e, 0x96, 0xe0, 0x70, 0x28, 0x47, 0x0a, 0x92, 0x48, 0x80, 0xa5, 0x20, 0x09,
-	0xc5, 0x97, 0x68, 0x75, 0x18, 0x12, 0x5d, 0x9d, 0x90, 0x60, 0xe8, 0x8a, 0x12, 0x96, 0x61, 0x5d,
-	0x51, 0x22, 0x42, 0x2b, 0x6d, 0x75, 0x38, 0xc0, 0xd7, 0x12, 0x8c, 0x0b, 0xb5, 0x10, 0xba, 0x98,
-	0x2d, 0x57, 0x44, 0x76, 0xc9, 0x97, 0x7a, 0x75, 0xe3, 0xa8, 0x0a, 0x45, 0x9d, 0x42, 0x72, 0x1b,
-	0xd5, 0xbf, 0x04, 0xa9, 0x4f, 0xe8, 0xad, 0xec, 0x29, 0xfa, 0x4a, 0x02, 0x14, 0x97, 0x4b, 0xa8,
-	0x9c, 0x98, 0x32, 0x51, 0x7d, 0xc9, 0xe7, 0x7b, 0xf2, 0xe1, 0x8c, 0x27, 0x28, 0xe3, 0x24, 0x9a,
-	0x88, 0x2d, 0xa7, 0xed, 0xb3, 0x7c, 0x27, 0x41, 0x21, 0x5d, 0x30, 0xa1, 0xe5, 0xd4, 0xd4, 0x5d,
-	0x15, 0x9b, 0x7c, 0x6d, 0xdf, 0xfe, 0xbc, 0x8c, 0x69, 0x5a, 0xc6, 0x71, 0x34, 0x1e, 0x2b, 0xc3,
-	0xbb, 0xd5, 0xa1, 0xe7, 0x12, 0x1c, 0x8d, 0xdc, 0x1a, 0x91, 0x9a, 0x9a, 0x33, 0x7e, 0x31, 0x95,
-	0x17, 0xb3, 0x3b, 0x70, 0xaa, 0x59, 0x4a, 0xa5, 0xa0, 0x62, 0x9b, 0x8a, 0xd8, 0x7a, 0xad, 0x81,
-	0x55, 0x7a, 0x39, 0x55, 0x9f, 0xf0, 0xee, 0xf7, 0x14, 0x7d, 0x21, 0xc1, 0xd8, 0x2d, 0xec, 0xc6,
-	0x8e, 0xb1, 0xb9, 0xe4, 0xfe, 0x15, 0x31, 0x95, 0x97, 0x32, 0x9b, 0x06, 0x7c, 0xa7, 0x29, 0xdf,
-	0x0c, 0x9a, 0xee, 0x68, 0x7a, 0xcc, 0x56, 0xf3, 0x8e, 0x49, 0xcd, 0x25, 0x1a, 0x76, 0xb7, 0xd1,
-	0x53, 0x18, 0x0e, 0xa4, 0x27, 0x9a, 0x4d, 0x4c, 0x13, 0xd1, 0xbb, 0xf2, 0x5c, 0x06, 0x4b, 0x0e,
-	0x32, 0x49, 0x41, 0xc6, 0xd1, 0x58, 0xe4, 0xbf, 0xd5, 0xb6, 0xbc, 0x8c, 0xcf, 0x25, 0x18, 0x8d,
-	0x89, 0x41, 0x94, 0x5c, 0x6e, 0x92, 0xb2, 0x94, 0xcb, 0xbd, 0xb8, 0x24, 0x7f, 0xc3, 0x94, 0x4c,
-	0x25, 0xdc, 0xc5, 0x7d, 0x8c, 0xbe, 0x95, 0x60, 0x3a, 0x55, 0x37, 0xa3, 0xab, 0x59, 0xf6, 0x77,
-	0xa2, 0x6c, 0x97, 0x97, 0xf7, 0xeb, 0xce, 0x8b, 0x98, 0xa2, 0x45, 0x1c, 0x43, 0xb9, 0x68, 0x11,
-	0xf4, 0xe3, 0xf8, 0x5c, 0x82, 0x31, 0x81, 0x4e, 0x45, 0xe7, 0xd3, 0xdf, 0x9f, 0x50, 0x39, 0xcb,
-	0x17, 0x7a, 0x73, 0xe2, 0x80, 0xc7, 0x29, 0xe0, 0x28, 0x3a, 0x1a, 0x01, 0xa4, 0xc7, 0x4b, 0x48,
-	0x86, 0xa6, 0x1c, 0x2f, 0x22, 0x31, 0x9c, 0x72, 0xbc, 0x08, 0xd5, 0xad, 0xe8, 0x78, 0x61, 0x4b,
-	0xe5, 0x77, 0x6e, 0xf4, 0xa5, 0x04, 0x7f, 0xed, 0x54, 0x78, 0x68, 0x3e, 0x31, 0x85, 0x40, 0x3a,
-	0xca, 0x0b, 0x19, 0xad, 0x39, 0x4f, 0x99, 0xf2, 0xcc, 0xa3, 0xb3, 0x9d, 0x67, 0x48, 0x44, 0x9e,
-	0xa9, 0x54, 0xb9, 0x79, 0x5f, 0x2b, 0x13, 0x95, 0x1e, 0x61, 0xa7, 0xe2, 0x4b, 0x21, 0x14, 0x48,
-	0xc9, 0x14, 0x42, 0x91, 0x8c, 0xcc, 0x46, 0x48, 0xc1, 0x68, 0x3f, 0xa1, 0x22, 0xf3, 0x07, 0x09,
-	0x26, 0x6e, 0x61, 0xb7, 0x43, 0x35, 0x74, 0x08, 0x3c, 0x74, 0x39, 0x05, 0x20, 0x4d, 0x12, 0xa6,
-	0x1c, 0x23, 0xd9, 0xb4, 0xa4, 0xa8, 0x16, 0xfa, 0x9b, 0x85, 0x56, 0xe7, 0xfe, 0xda, 0x7b, 0xb8,
-	0xe5, 0x68, 0xd5, 0x96, 0x16, 0x48, 0x15, 0xf4, 0x0d, 0x6b, 0xdd, 0xa1, 0x5a, 0xbc, 0xd6, 0xbd,
-	0x94, 0x11, 0xa6, 0x2d, 0x09, 0xe5, 0x2b, 0x3d, 0xbb, 0x04, 0xe4, 0xf3, 0x94, 0xfc, 0x0c, 0x3a,
-	0xd5, 0x95, 0xdc, 0xeb, 0xe8, 0x3f, 0x4b, 0x30, 0x15, 0x65, 0xee, 0x14, 0x6d, 0xe8, 0x1f, 0x19,
-	0x49, 0x04, 0x4a, 0x4f, 0x5e, 0xd9, 0xbf, 0x6f, 0x50, 0xce, 0x45, 0x5a, 0x8e, 0x8a, 0x16, 0xba,
-	0x96, 0xd3, 0xa9, 0x4a, 0xd1, 0xa7, 0x12, 0x8c, 0x6c, 0x78, 0x0e, 0x1d, 0xfa, 0x06, 0x9d, 0x4b,
-	0xe4, 0x89, 0x0b, 0x24, 0x79, 0x3e, 0x9b, 0x31, 0xc7, 0x2c, 0x50, 0xcc, 0x3c, 0x3a, 0xd6, 0xf1,
-	0x63, 0x12, 0x35, 0xd3, 0xa8, 0x34, 0x42, 0x9f, 0x48, 0x80, 0xb8, 0x06, 0xf2, 0xb0, 0x08, 0x13,
-	0x42, 0xe2, 0x33, 0x54, 0xa4, 0xa1, 0xc4, 0x67, 0xa8, 0x50, 0x55, 0x89, 0x3a, 0xd7, 0x0e, 0x33,
-	0xd4, 0xe8, 0x5d, 0xd3, 0x59, 0xc1, 0x2f, 0x5e, 0x15, 0xa4, 0x97, 0xaf, 0x0a, 0xd2, 0x6f, 0xaf,
-	0x0a, 0xd2, 0xb3, 0xd7, 0x85, 0xbe, 0x97, 0xaf, 0x0b, 0x7d, 0xbf, 0xbc, 0x2e, 0xf4, 0xfd, 0xff,
-	0x8e, 0x61, 0xba, 0xdb, 0xbb, 0xd5, 0x52, 0x8d, 0xec, 0xa8, 0xb7, 0xfd, 0x84, 0x77, 0xf5, 0xaa,
-	0xa3, 0x06, 0xe9, 0x17, 0x6a, 0xc4, 0xc6, 0x9d, 0x8f, 0xdb, 0xba, 0x69, 0xf1, 0x6a, 0x1d, 0x9e,
-	0x92, 0xfe, 0xd0, 0x56, 0x1d, 0xa4, 0xbf, 0x6b, 0x9d, 0xff, 0x23, 0x00, 0x00, 0xff, 0xff, 0x32,
-	0x01, 0x57, 0x21, 0x02, 0x1c, 0x00, 0x00,
+	// 2056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xc0, 0xdd, 0x49, 0xec, 0xc4, 0x2f, 0x9b, 0x64, 0x52, 0x1e, 0x27, 0xe3, 0xb6, 0x3d, 0x9e,
+	0xed, 0x24, 0xc6, 0x4e, 0xec, 0xe9, 0xd8, 0xd9, 0x0f, 0x02, 0x6c, 0xb2, 0x19, 0xef, 0xc4, 0xb2,
+	0x12, 0xc7, 0x66, 0x76, 0x94, 0x65, 0x61, 0xa1, 0xd5, 0x33, 0x53, 0xe9, 0x69, 0x76, 0xa6, 0x7b,
+	0xb6, 0xab, 0x6c, 0x65, 0x14, 0x05, 0x09, 0x2e, 0x44, 0x42, 0x48, 0x2b, 0x71, 0x82, 0x03, 0x20,
+	0x71, 0xe3, 0x80, 0xc4, 0x9d, 0x03, 0xc7, 0xe5, 0xb6, 0x02, 0x21, 0x01, 0x87, 0x15, 0x4a, 0xf8,
+	0x0b, 0xf8, 0x0b, 0x50, 0x57, 0x55, 0xf7, 0xf4, 0xb7, 0x7b, 0x1c, 0x4e, 0x76, 0x57, 0xbd, 0x8f,
+	0xdf, 0x7b, 0x5d, 0x5d, 0x55, 0xef, 0x0d, 0x94, 0x4d, 0xeb, 0x87, 0xb8, 0x4d, 0xcd, 0x43, 0xac,
+	0x0e, 0xb0, 0x61, 0x0c, 0xd5, 0xc3, 0x0d, 0xf5, 0xb3, 0x03, 0xec, 0x0c, 0xab, 0x03, 0xc7, 0xa6,
+	0x36, 0x42, 0xfe, 0x7c, 0x95, 0xcd, 0x57, 0x0f, 0x37, 0xe4, 0x4a, 0x82, 0x8e, 0x81, 0x2d, 0x4c,
+	0x4c, 0xc2, 0xb5, 0xe4, 0xa5, 0x04, 0x89, 0x81, 0xee, 0xe8, 0x7d, 0x4f, 0x20, 0xc9, 0x2d, 0x1d,
+	0x0e, 0xb0, 0x37, 0xbf, 0x98, 0x30, 0xdf, 0x27, 0x46, 0xd6, 0xf4, 0xc0, 0xb6, 0x7b, 0x19, 0xd6,
+	0x5b, 0x3a, 0x6d, 0x77, 0xc5, 0xfc, 0x82, 0x61, 0xdb, 0x46, 0x0f, 0xab, 0xfa, 0xc0, 0x54, 0x75,
+	0xcb, 0xb2, 0xa9, 0x4e, 0x4d, 0xdb, 0xf2, 0x8c, 0x17, 0x0d, 0xdb, 0xb0, 0xd9, 0xbf, 0xaa, 0xfb,
+	0x1f, 0x1f, 0x55, 0x8a, 0x80, 0xbe, 0xed, 0xe6, 0x65, 0x9f, 0x85, 0xd1, 0xc0, 0x9f, 0x1d, 0x60,
+	0x42, 0x95, 0x3d, 0x98, 0x09, 0x8d, 0x92, 0x81, 0x6d, 0x11, 0x8c, 0xbe, 0x0e, 0x53, 0x3c, 0xdc,
+	0x92, 0x54, 0x91, 0x56, 0xce, 0x6e, 0xca, 0xd5, 0x78, 0x1a, 0xab, 0x5c, 0xa7, 0x76, 0xea, 0x8b,
+	0xaf, 0x96, 0x26, 0x1a, 0x42, 0x5e, 0x99, 0x87, 0x39, 0x66, 0x70, 0xeb, 0xc0, 0x71, 0xb0, 0x45,
+	0x1f, 0xeb, 0x3d, 0x82, 0xa9, 0xe7, 0x6d, 0x1f, 0xe4, 0xa4, 0x49, 0xe1, 0x74, 0x13, 0xa6, 0x0e,
+	0xd9, 0x48, 0x96, 0x53, 0xa1, 0x23, 0x24, 0x95, 0x0d, 0xe1, 0x2e, 0xe4, 0x47, 0xfc, 0x41, 0x45,
+	0x98, 0xb4, 0x6c, 0xab, 0x8d, 0x99, 0xbd, 0x53, 0x0d, 0xfe, 0xe0, 0x43, 0x44, 0x54, 0x5e, 0x03,
+	0xe2, 0x41, 0x08, 0x62, 0xcb, 0xb6, 0x9e, 0x98, 0x4e, 0x3f, 0x13, 0x02, 0x95, 0xe0, 0xb4, 0xde,
+	0xe9, 0x38, 0x98, 0x90, 0xd2, 0x89, 0x8a, 0xb4, 0x32, 0xdd, 0xf0, 0x1e, 0x95, 0x4f, 0x40, 0x4e,
+	0x32, 0x26, 0xf0, 0xee, 0xc0, 0xe9, 0x36, 0x1f, 0x12, 0x7c, 0x57, 0x93, 0xf8, 0x76, 0x89, 0x11,
+	0x56, 0xf7, 0x94, 0x94, 0xdb, 0xf0, 0x66, 0xdc, 0x3a, 0xa9, 0x0d, 0x1f, 0xb9, 0x54, 0xd9, 0x79,
+	0x7b, 0x02, 0x4a, 0x96, 0xaa, 0x00, 0x7c, 0x1f, 0xce, 0x08, 0x5f, 0xee, 0xda, 0x39, 0x99, 0x9b,
+	0xd0, 0xd7, 0x52, 0x2a, 0x50, 0x66, 0x7e, 0x1e, 0xea, 0x24, 0xbc, 0x7c, 0xfc, 0x45, 0xfb, 0x11,
+	0x2c, 0xa5, 0x4a, 0x08, 0x8c, 0xb7, 0xe0, 0x34, 0x7f, 0x39, 0x1e, 0x45, 0xd6, 0x7b, 0xf4, 0x44,
+	0x95, 0xfb, 0x70, 0xdd, 0x37, 0xbc, 0x8f, 0xad, 0x8e, 0x69, 0x19, 0x21, 0xfb, 0xb5, 0xe1, 0xbd,
+	0x4e, 0xc7, 0xf1, 0xd2, 0x14, 0x78, 0x87, 0x52, 0xf8, 0x1d, 0xb6, 0xe1, 0x46, 0x2e, 0x3b, 0xaf,
+	0x05, 0x7b, 0x09, 0x8a, 0xcc, 0x49, 0xcd, 0xdd, 0x18, 0xee, 0x63, 0xef, 0xed, 0x29, 0x4d, 0x98,
+	0x8d, 0x8c, 0x0b, 0x37, 0xdf, 0x84, 0xe9, 0x96, 0x18, 0xf3, 0x1c, 0x2d, 0x26, 0x39, 0xf2, 0x14,
+	0x49, 0x63, 0x24, 0xaf, 0xd4, 0x61, 0x35, 0x1a, 0x12, 0x93, 0x1b, 0x33, 0x33, 0x06, 0x5c, 0xcf,
+	0x63, 0x46, 0x10, 0xdf, 0x86, 0x49, 0x46, 0x20, 0xd6, 0xfa, 0x95, 0x24, 0xda, 0xbd, 0x03, 0x6a,
+	0xd8, 0xa6, 0x65, 0x34, 0x9f, 0x72, 0x43, 0x5c, 0x43, 0x59, 0x82, 0x45, 0xe6, 0x28, 0x32, 0x8d,
+	0xfd, 0x45, 0xa4, 0x41, 0x39, 0x4d, 0x40, 0x78, 0x7f, 0x0f, 0x4e, 0xb7, 0xf8, 0x90, 0xc8, 0x56,
+	0x2e, 0xff, 0x9e, 0x8e, 0xd2, 0x12, 0xab, 0x34, 0x1c, 0xdf, 0xd1, 0x1f, 0x1a, 0x5a, 0x85, 0x42,
+	0xdb, 0xb6, 0xa8, 0xa3, 0xb7, 0xa9, 0x16, 0xde, 0x24, 0x2e, 0x78, 0xe3, 0xf7, 0x44, 0x3a, 0xbf,
+	0x0f, 0x95, 0x74, 0x1f, 0xaf, 0x9f, 0xc4, 0x4f, 0xc4, 0xc6, 0xc6, 0x06, 0xbd, 0x2f, 0xfe, 0xff,
+	0x08, 0x2f, 0x27, 0x59, 0x17, 0xd8, 0x77, 0x63, 0x1b, 0xc9, 0x95, 0x94, 0x8d, 0x44, 0xa8, 0x72,
+	0xf2, 0xd1, 0x3e, 0xf2, 0x2d, 0x01, 0xef, 0xc5, 0x16, 0xcc, 0x11, 0x5a, 0x82, 0xb3, 0x2c, 0x44,
+	0x2d, 0x18, 0x02, 0xb0, 0x21, 0x96, 0x3d, 0xe5, 0x9f, 0x12, 0xc8, 0x49, 0xea, 0xaf, 0x9d, 0x54,
+	0xb4, 0x0b, 0x40, 0x6d, 0xaa, 0xf7, 0xb4, 0x27, 0x18, 0x8b, 0xdc, 0xd4, 0xaa, 0xee, 0x19, 0xfa,
+	0xaf, 0xaf, 0x96, 0x96, 0x0d, 0x93, 0x76, 0x0f, 0x5a, 0xd5, 0xb6, 0xdd, 0x57, 0xdb, 0x36, 0xe9,
+	0xdb, 0x44, 0xfc, 0x59, 0x27, 0x9d, 0x4f, 0xc5, 0x05, 0x63, 0xc7, 0xa2, 0x8d, 0x69, 0x66, 0xc1,
+	0xfd, 0x30, 0xd1, 0x06, 0x14, 0x45, 0xc8, 0xb8, 0xa3, 0x1d, 0xea, 0x3d, 0xb3, 0xa3, 0x53, 0xdb,
+	0x21, 0xa5, 0x93, 0x95, 0x93, 0x2b, 0xd3, 0x8d, 0x19, 0x7f, 0xee, 0xb1, 0x3f, 0xa5, 0xbc, 0x0b,
+	0xf3, 0xfe, 0x47, 0x58, 0x3f, 0xc4, 0x56, 0xee, 0xaf, 0xb7, 0x07, 0x0b, 0xc9, 0x8a, 0x22, 0x2b,
+	0x0f, 0xa1, 0xd0, 0xd3, 0x09, 0xd5, 0xda, 0x3d, 0xdd, 0xec, 0x6b, 0xd8, 0x95, 0x10, 0x09, 0x52,
+	0x92, 0x12, 0xe4, 0x9a, 0xd9, 0x72, 0x45, 0x99, 0xad, 0xc6, 0xf9, 0x5e, 0xe8, 0x59, 0xb9, 0x09,
+	0x25, 0xe6, 0xad, 0xde, 0xd8, 0xda, 0xbc, 0xd9, 0xb4, 0x3f, 0xc0, 0x96, 0x1d, 0x3c, 0x55, 0xb1,
+	0xd3, 0xde, 0xbc, 0x29, 0x08, 0xf9, 0x83, 0xf2, 0x03, 0x98, 0x4b, 0xd0, 0x10, 0x70, 0x45, 0x98,
+	0xec, 0xb8, 0x03, 0x9e, 0x0a, 0x7b, 0x40, 0x37, 0xe0, 0x22, 0xcf, 0xb0, 0x66, 0x3b, 0xa6, 0x61,
+	0x5a, 0x3a, 0xc5, 0x1d, 0xf6, 0x52, 0xce, 0x34, 0x0a, 0x7c, 0x62, 0xcf, 0x1f, 0xf7, 0x89, 0x98,
+	0xe1, 0xa6, 0xcd, 0xdc, 0x04, 0x88, 0xe2, 0xe6, 0x7d, 0xa2, 0xb0, 0xc6, 0x88, 0x28, 0x1e, 0xc4,
+	0x78, 0x44, 0x2a, 0x5c, 0x1e, 0xd9, 0xe7, 0xe3, 0xd9, 0x40, 0x7f, 0x90, 0xa0, 0x14, 0xd7, 0xf0,
+	0x4f, 0x88, 0x29, 0xee, 0x93, 0xe9, 0x9c, 0x4f, 0x5e, 0xd6, 0x01, 0xc5, 0xe6, 0x70, 0x80, 0x1b,
+	0x42, 0x05, 0xcd, 0xc1, 0x19, 0xb3, 0xd5, 0xd6, 0x06, 0x3a, 0xed, 0x7a, 0x77, 0x1a, 0xb3, 0xd5,
+	0xde, 0xd7, 0x69, 0x17, 0x5d, 0x85, 0xf3, 0xee, 0x54, 0x4b, 0x27, 0x58, 0xe3, 0x4c, 0x27, 0x99,
+	0xc0, 0x1b, 0x66, 0xab, 0x5d, 0xd3, 0x09, 0x66, 0x26, 0x47, 0xe9, 0x38, 0x15, 0x7c, 0xa7, 0x0d,
+	0xb8, 0x22, 0x78, 0x7b, 0xd8, 0xd0, 0x29, 0x7e, 0x80, 0x87, 0xa4, 0x36, 0xf4, 0x57, 0xb3, 0xd8,
+	0x4c, 0xdc, 0xac, 0xf9, 0x8b, 0x5f, 0x0b, 0x2f, 0xdf, 0xc2, 0x61, 0x44, 0x58, 0xf9, 0xb1, 0x04,
+	0x37, 0x72, 0x18, 0xf5, 0xf3, 0xb2, 0x04, 0x67, 0x31, 0xed, 0x46, 0xcc, 0x02, 0xa6, 0x5d, 0xcf,
+	0xfb, 0x06, 0x14, 0x6d, 0xc7, 0xdd, 0xf5, 0xa9, 0x13, 0x02, 0xe0, 0x79, 0x98, 0x09, 0xce, 0x79,
+	0x0c, 0xef, 0xc3, 0x62, 0x02, 0x42, 0x7d, 0x64, 0xf3, 0x28, 0xa7, 0xca, 0x4f, 0x25, 0xb8, 0x96,
+	0x69, 0xc2, 0xe7, 0x1f, 0x27, 0x39, 0xc7, 0x89, 0xe5, 0x7b, 0xb0, 0x9c, 0x00, 0xb2, 0x17, 0x97,
+	0x4c, 0x35, 0x2e, 0xa5, 0x1b, 0xff, 0x11, 0x54, 0xf3, 0x19, 0x3f, 0x5e, 0xb8, 0x91, 0x34, 0x9f,
+	0x88, 0xa5, 0xf9, 0x8e, 0xb8, 0x4f, 0x89, 0xeb, 0xca, 0x87, 0xd8, 0xea, 0x34, 0xed, 0x3a, 0xed,
+	0xa2, 0x6b, 0x70, 0x9e, 0x60, 0xab, 0x83, 0xa3, 0x3e, 0xce, 0xf1, 0x51, 0x4f, 0xff, 0xaf, 0x12,
+	0x2c, 0x26, 0x1a, 0xf0, 0x79, 0xbf, 0x03, 0x45, 0xea, 0xe8, 0x16, 0x79, 0x82, 0x1d, 0xa2, 0x99,
+	0x96, 0x16, 0xbe, 0x75, 0x2c, 0x67, 0x9e, 0x2d, 0x42, 0xaf, 0xf9, 0xb4, 0x81, 0x7c, 0x1b, 0x3b,
+	0x96, 0xb8, 0xca, 0xa0, 0x8f, 0x60, 0xe6, 0xc0, 0xe2, 0xe6, 0x3a, 0x9a, 0x3f, 0x5f, 0x3a, 0x31,
+	0x9e, 0x61, 0xdf, 0x84, 0x37, 0x48, 0x94, 0x39, 0xb1, 0xef, 0xec, 0xda, 0x9d, 0x83, 0x1e, 0xfe,
+	0x90, 0xea, 0xd4, 0xbf, 0x7f, 0x36, 0xa0, 0x14, 0x9f, 0x12, 0x91, 0xbe, 0x03, 0x93, 0xc4, 0x1d,
+	0x10, 0xa7, 0x42, 0x25, 0x89, 0x60, 0x9b, 0x57, 0xe2, 0x5c, 0x91, 0x8b, 0xbb, 0x77, 0xdd, 0x5d,
+	0x93, 0x10, 0xd3, 0x32, 0xd8, 0xe9, 0xec, 0x5f, 0xe2, 0xee, 0xc3, 0x6c, 0x64, 0x5c, 0x38, 0x5a,
+	0x07, 0x64, 0x0f, 0x70, 0x68, 0x8d, 0x89, 0x84, 0x4e, 0x37, 0x2e, 0x7a, 0x33, 0xf7, 0xbc, 0x89,
+	0xeb, 0x7d, 0xb8, 0x10, 0xd9, 0xd6, 0xd0, 0x02, 0x94, 0x3e, 0xa8, 0x3f, 0xda, 0xdb, 0xd5, 0xf6,
+	0x1a, 0x3b, 0xdb, 0x3b, 0x8f, 0xb4, 0xe6, 0xc7, 0xfb, 0x75, 0xed, 0xd1, 0xbd, 0xe6, 0xce, 0xe3,
+	0x7a, 0x61, 0x02, 0xcd, 0xc1, 0x6c, 0x7c, 0x76, 0xa7, 0xb6, 0x55, 0x90, 0xd0, 0x3c, 0x5c, 0x8e,
+	0x4f, 0xed, 0xd7, 0xb7, 0xb7, 0x3f, 0x2e, 0x9c, 0x90, 0x4f, 0xbd, 0xf8, 0x5d, 0x79, 0x62, 0xf3,
+	0xbf, 0x0b, 0x30, 0xc9, 0x72, 0x84, 0x08, 0x4c, 0xf1, 0x32, 0x1a, 0x25, 0xbe, 0x8d, 0x78, 0xc5,
+	0x2e, 0x7f, 0xed, 0x48, 0x39, 0x9e, 0x02, 0xa5, 0xf4, 0x93, 0xbf, 0xfd, 0xe7, 0x17, 0x27, 0x10,
+	0x2a, 0x44, 0x5b, 0x18, 0xe8, 0x73, 0x09, 0xce, 0x85, 0x4a, 0x70, 0xb4, 0x9e, 0x6a, 0x34, 0xa9,
+	0x8e, 0x97, 0xab, 0x79, 0xc5, 0x05, 0x4a, 0x85, 0xa1, 0xc8, 0xa8, 0x34, 0x42, 0xe1, 0x55, 0x8c,
+	0xda, 0xe6, 0xf2, 0xe8, 0x85, 0x04, 0xe7, 0x42, 0x3e, 0x32, 0x90, 0x92, 0x6a, 0x7d, 0xb9, 0x9a,
+	0x57, 0x3c, 0x3d, 0x3b, 0x1c, 0x89, 0x65, 0x27, 0x54, 0x9b, 0x1e, 0x89, 0x12, 0xae, 0xf8, 0xe5,
+	0x6a, 0x5e, 0xf1, 0xa3, 0xb3, 0x23, 0x00, 0x7e, 0x2f, 0xc1, 0x6c, 0x62, 0xd9, 0x8d, 0xde, 0xce,
+	0xe7, 0x2b, 0x52, 0xe1, 0xcb, 0xef, 0x8c, 0xab, 0x26, 0x50, 0x15, 0x86, 0xba, 0x80, 0xe4, 0x11,
+	0xaa, 0x60, 0x24, 0xea, 0x33, 0x76, 0x95, 0x7e, 0x8e, 0x7e, 0x2b, 0x01, 0x8a, 0x57, 0xe6, 0x68,
+	0x33, 0xd5, 0x65, 0x6a, 0xa1, 0x2f, 0xdf, 0x1a, 0x4b, 0x47, 0x30, 0xbe, 0xc9, 0x18, 0xe7, 0xd1,
+	0x5c, 0x2c, 0x9d, 0x8e, 0xc7, 0xf2, 0x67, 0x09, 0xca, 0xd9, 0xb5, 0x39, 0xba, 0x93, 0xe9, 0xfa,
+	0xc8, 0xe6, 0x80, 0x7c, 0xf7, 0xd8, 0xfa, 0x22, 0x8c, 0x45, 0x16, 0xc6, 0x65, 0x34, 0x1b, 0x0b,
+	0xc3, 0xbd, 0x26, 0xa3, 0x5f, 0x4b, 0x70, 0x21, 0x72, 0x0d, 0x47, 0x6a, 0xa6, 0xcf, 0xf8, 0x4d,
+	0x5f, 0xbe, 0x99, 0x5f, 0x41, 0x50, 0xad, 0x30, 0x2a, 0x05, 0x55, 0x46, 0x54, 0xb6, 0xa3, 0xb7,
+	0x7b, 0x58, 0x65, 0xb7, 0x7d, 0xf5, 0x99, 0xd8, 0x6c, 0x9f, 0xa3, 0x5f, 0x49, 0x30, 0xb3, 0x8d,
+	0x69, 0xec, 0xd4, 0x5c, 0x4d, 0xdf, 0xbf, 0x22, 0xa2, 0xf2, 0x46, 0x6e, 0x51, 0x9f, 0xef, 0x1a,
+	0xe3, 0x5b, 0x42, 0x8b, 0x81, 0x4d, 0x8f, 0xcb, 0x6a, 0xee, 0xa9, 0xac, 0x51, 0x5b, 0xc3, 0xb4,
+	0x8b, 0x9e, 0xc3, 0xb4, 0xdf, 0xe5, 0x40, 0x2b, 0xa9, 0x6e, 0x22, 0xad, 0x15, 0x79, 0x35, 0x87,
+	0xa4, 0x00, 0x99, 0x67, 0x20, 0xb3, 0x68, 0x26, 0xd2, 0xc1, 0x75, 0x8b, 0x3e, 0xf7, 0xe5, 0x5d,
+	0x8c, 0xf5, 0x1d, 0x50, 0x7a, 0xb8, 0x69, 0x4d, 0x0c, 0x79, 0x73, 0x1c, 0x95, 0xf4, 0x6f, 0x98,
+	0x91, 0xa9, 0xb6, 0x50, 0xa1, 0x4f, 0xd1, 0x9f, 0x24, 0x58, 0xcc, 0x6c, 0xd1, 0xa0, 0xf7, 0xf2,
+	0xac, 0xef, 0xd4, 0x0e, 0x91, 0x7c, 0xe7, 0xb8, 0xea, 0x22, 0x88, 0x05, 0x16, 0xc4, 0x25, 0x54,
+	0x8c, 0x06, 0xc1, 0x3e, 0x8e, 0x5f, 0x4a, 0x30, 0x93, 0xd0, 0x12, 0x41, 0xb7, 0xb2, 0xdf, 0x5f,
+	0x62, 0x93, 0x46, 0x7e, 0x6b, 0x3c, 0x25, 0x01, 0x78, 0x99, 0x01, 0x5e, 0x44, 0x17, 0x22, 0x80,
+	0xec, 0x78, 0x09, 0x75, 0x3c, 0x32, 0x8e, 0x97, 0xa4, 0xbe, 0x8b, 0x5c, 0xcd, 0x2b, 0x9e, 0x7e,
+	0xbc, 0xf0, 0x54, 0x79, 0x3b, 0xb7, 0xbb, 0x1c, 0xcf, 0x85, 0xda, 0x1c, 0x19, 0x48, 0x49, 0xdd,
+	0x14, 0xb9, 0x9a, 0x57, 0x5c, 0x20, 0x55, 0x19, 0xd2, 0x0a, 0x5a, 0x0e, 0xec, 0x22, 0x42, 0x90,
+	0x5f, 0x7e, 0xd5, 0x67, 0x81, 0xee, 0xcc, 0x73, 0xf4, 0x1b, 0x09, 0xde, 0x08, 0xd6, 0xf4, 0x68,
+	0x2d, 0xd5, 0x61, 0x42, 0xb3, 0x40, 0x5e, 0xcf, 0x29, 0x2d, 0xe8, 0x36, 0x19, 0xdd, 0x1a, 0xba,
+	0x1e, 0x3c, 0xe4, 0x22, 0x05, 0xb9, 0xca, 0x8a, 0x53, 0x77, 0x3b, 0xe1, 0x6d, 0x04, 0x97, 0x30,
+	0x58, 0xe3, 0x67, 0x10, 0x26, 0x34, 0x0f, 0xe4, 0xf5, 0x9c, 0xd2, 0xe3, 0x10, 0x32, 0x30, 0x97,
+	0x90, 0xb7, 0x15, 0x5e, 0x48, 0x70, 0x36, 0x70, 0xc7, 0x45, 0x37, 0xb2, 0x5d, 0x86, 0x7a, 0x09,
+	0xf2, 0x5a, 0x3e, 0x61, 0x81, 0x57, 0x66, 0x78, 0x25, 0x74, 0x69, 0x84, 0xc7, 0x61, 0x44, 0xa7,
+	0xe0, 0x2f, 0x12, 0xcc, 0x6d, 0x63, 0x1a, 0x28, 0xe8, 0x02, 0xb5, 0x37, 0x7a, 0x37, 0xc3, 0x57,
+	0x56, 0xb5, 0x2e, 0xdf, 0x3d, 0xa6, 0x62, 0x56, 0x5a, 0xd9, 0x4f, 0x89, 0x5a, 0x47, 0xe8, 0x6b,
+	0x9f, 0xe2, 0x21, 0xd1, 0x5a, 0xc3, 0x51, 0x9f, 0x0d, 0xfd, 0x91, 0x1f, 0x73, 0xa1, 0x58, 0xdc,
+	0x63, 0x6e, 0x23, 0x27, 0xcc, 0xa8, 0x5a, 0x97, 0x6f, 0x8f, 0xad, 0xe2, 0x93, 0xaf, 0x31, 0xf2,
+	0x65, 0x74, 0xf5, 0x48, 0x72, 0xf7, 0xf4, 0xfb, 0xbb, 0x04, 0x0b, 0x51, 0xe6, 0x60, 0x3d, 0x8d,
+	0xbe, 0x91, 0x93, 0x24, 0xa1, 0x08, 0x97, 0x6b, 0xc7, 0xd7, 0xf5, 0xc3, 0x79, 0x9b, 0x85, 0xa3,
+	0xa2, 0xf5, 0x23, 0xc3, 0x09, 0x36, 0x0c, 0xd0, 0xcf, 0x25, 0x28, 0xec, 0xbb, 0x0a, 0x81, 0xd2,
+	0x33, 0x63, 0x9d, 0xc7, 0x6b, 0x57, 0x79, 0x2d, 0x9f, 0x70, 0xfa, 0x3a, 0xef, 0x33, 0x31, 0x8d,
+	0x55, 0xad, 0xe8, 0x67, 0x12, 0x20, 0x51, 0x9e, 0xba, 0x58, 0x36, 0xaf, 0x51, 0x93, 0xef, 0x1b,
+	0x49, 0xe5, 0xad, 0xbc, 0x9a, 0x43, 0x32, 0x7d, 0x97, 0xef, 0x73, 0x41, 0xbe, 0x89, 0x92, 0x1a,
+	0xfe, 0xe2, 0x65, 0x59, 0xfa, 0xf2, 0x65, 0x59, 0xfa, 0xf7, 0xcb, 0xb2, 0xf4, 0xf9, 0xab, 0xf2,
+	0xc4, 0x97, 0xaf, 0xca, 0x13, 0xff, 0x78, 0x55, 0x9e, 0xf8, 0xee, 0x83, 0x40, 0xd7, 0x79, 0xc7,
+	0x73, 0xf8, 0x50, 0x6f, 0x11, 0xd5, 0x77, 0xbf, 0xde, 0xb6, 0x1d, 0x1c, 0x7c, 0xec, 0xea, 0xa6,
+	0x25, 0xa2, 0x25, 0xc2, 0x25, 0x6b, 0x4f, 0xb7, 0xa6, 0xd8, 0xcf, 0xcd, 0xb7, 0xfe, 0x37, 0x00,
+	0x4c, 0x7a, 0xba, 0xd5, 0x99, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2115,8 +2385,12 @@ type QueryClient interface {
 	LastPendingBatchRequestByAddr(ctx context.Context, in *QueryLastPendingBatchRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingBatchRequestByAddrResponse, error)
 	BatchRequestByNonce(ctx context.Context, in *QueryBatchRequestByNonceRequest, opts ...grpc.CallOption) (*QueryBatchRequestByNonceResponse, error)
 	BatchConfirms(ctx context.Context, in *QueryBatchConfirmsRequest, opts ...grpc.CallOption) (*QueryBatchConfirmsResponse, error)
+	OutgoingBatch(ctx context.Context, in *QueryOutgoingBatchRequest, opts ...grpc.CallOption) (*QueryOutgoingBatchResponse, error)
 	ERC20ToDenom(ctx context.Context, in *QueryERC20ToDenomRequest, opts ...grpc.CallOption) (*QueryERC20ToDenomResponse, error)
 	DenomToERC20(ctx context.Context, in *QueryDenomToERC20Request, opts ...grpc.CallOption) (*QueryDenomToERC20Response, error)
+	// Resolves a denom to its source: the IBC path and base denom of an IBC
+	// voucher or the Ethereum token contract of a peggy voucher
+	DenomOrigin(ctx context.Context, in *QueryDenomOriginRequest, opts ...grpc.CallOption) (*QueryDenomOriginResponse, error)
 	GetDelegateKeyByValidator(ctx context.Context, in *QueryDelegateKeysByValidatorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByValidatorAddressResponse, error)
 	GetDelegateKeyByEth(ctx context.Context, in *QueryDelegateKeysByEthAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByEthAddressResponse, error)
 	GetDelegateKeyByOrchestrator(ctx context.Context, in *QueryDelegateKeysByOrchestratorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByOrchestratorAddressResponse, error)
@@ -2259,6 +2533,15 @@ func (c *queryClient) BatchConfirms(ctx context.Context, in *QueryBatchConfirmsR
 	return out, nil
 }
 
+func (c *queryClient) OutgoingBatch(ctx context.Context, in *QueryOutgoingBatchRequest, opts ...grpc.CallOption) (*QueryOutgoingBatchResponse, error) {
+	out := new(QueryOutgoingBatchResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Query/OutgoingBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ERC20ToDenom(ctx context.Context, in *QueryERC20ToDenomRequest, opts ...grpc.CallOption) (*QueryERC20ToDenomResponse, error) {
 	out := new(QueryERC20ToDenomResponse)
 	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Query/ERC20ToDenom", in, out, opts...)
@@ -2277,6 +2560,15 @@ func (c *queryClient) DenomToERC20(ctx context.Context, in *QueryDenomToERC20Req
 	return out, nil
 }
 
+func (c *queryClient) DenomOrigin(ctx context.Context, in *QueryDenomOriginRequest, opts ...grpc.CallOption) (*QueryDenomOriginResponse, error) {
+	out := new(QueryDenomOriginResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Query/DenomOrigin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetDelegateKeyByValidator(ctx context.Context, in *QueryDelegateKeysByValidatorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByValidatorAddressResponse, error) {
 	out := new(QueryDelegateKeysByValidatorAddressResponse)
 	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Query/GetDelegateKeyByValidator", in, out, opts...)
@@ -2342,8 +2634,12 @@ type QueryServer interface {
 	LastPendingBatchRequestByAddr(context.Context, *QueryLastPendingBatchRequestByAddrRequest) (*QueryLastPendingBatchRequestByAddrResponse, error)
 	BatchRequestByNonce(context.Context, *QueryBatchRequestByNonceRequest) (*QueryBatchRequestByNonceResponse, error)
 	BatchConfirms(context.Context, *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error)
+	OutgoingBatch(context.Context, *QueryOutgoingBatchRequest) (*QueryOutgoingBatchResponse, error)
 	ERC20ToDenom(context.Context, *QueryERC20ToDenomRequest) (*QueryERC20ToDenomResponse, error)
 	DenomToERC20(context.Context, *QueryDenomToERC20Request) (*QueryDenomToERC20Response, error)
+	// Resolves a denom to its source: the IBC path and base denom of an IBC
+	// voucher or the Ethereum token contract of a peggy voucher
+	DenomOrigin(context.Context, *QueryDenomOriginRequest) (*QueryDenomOriginResponse, error)
 	GetDelegateKeyByValidator(context.Context, *QueryDelegateKeysByValidatorAddress) (*QueryDelegateKeysByValidatorAddressResponse, error)
 	GetDelegateKeyByEth(context.Context, *QueryDelegateKeysByEthAddress) (*QueryDelegateKeysByEthAddressResponse, error)
 	GetDelegateKeyByOrchestrator(context.Context, *QueryDelegateKeysByOrchestratorAddress) (*QueryDelegateKeysByOrchestratorAddressResponse, error)
@@ -2398,12 +2694,18 @@ func (*UnimplementedQueryServer) BatchRequestByNonce(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) BatchConfirms(ctx context.Context, req *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchConfirms not implemented")
 }
+func (*UnimplementedQueryServer) OutgoingBatch(ctx context.Context, req *QueryOutgoingBatchRequest) (*QueryOutgoingBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutgoingBatch not implemented")
+}
 func (*UnimplementedQueryServer) ERC20ToDenom(ctx context.Context, req *QueryERC20ToDenomRequest) (*QueryERC20ToDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ERC20ToDenom not implemented")
 }
 func (*UnimplementedQueryServer) DenomToERC20(ctx context.Context, req *QueryDenomToERC20Request) (*QueryDenomToERC20Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20 not implemented")
 }
+func (*UnimplementedQueryServer) DenomOrigin(ctx context.Context, req *QueryDenomOriginRequest) (*QueryDenomOriginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomOrigin not implemented")
+}
 func (*UnimplementedQueryServer) GetDelegateKeyByValidator(ctx context.Context, req *QueryDelegateKeysByValidatorAddress) (*QueryDelegateKeysByValidatorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateKeyByValidator not implemented")
 }
@@ -2676,6 +2978,24 @@ func _Query_BatchConfirms_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OutgoingBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutgoingBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutgoingBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Query/OutgoingBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutgoingBatch(ctx, req.(*QueryOutgoingBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ERC20ToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryERC20ToDenomRequest)
 	if err := dec(in); err != nil {
@@ -2712,6 +3032,24 @@ func _Query_DenomToERC20_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomOrigin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomOriginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomOrigin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Query/DenomOrigin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomOrigin(ctx, req.(*QueryDenomOriginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetDelegateKeyByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegateKeysByValidatorAddress)
 	if err := dec(in); err != nil {
@@ -2862,6 +3200,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BatchConfirms",
 			Handler:    _Query_BatchConfirms_Handler,
 		},
+		{
+			MethodName: "OutgoingBatch",
+			Handler:    _Query_OutgoingBatch_Handler,
+		},
 		{
 			MethodName: "ERC20ToDenom",
 			Handler:    _Query_ERC20ToDenom_Handler,
@@ -2870,6 +3212,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomToERC20",
 			Handler:    _Query_DenomToERC20_Handler,
 		},
+		{
+			MethodName: "DenomOrigin",
+			Handler:    _Query_DenomOrigin_Handler,
+		},
 		{
 			MethodName: "GetDelegateKeyByValidator",
 			Handler:    _Query_GetDelegateKeyByValidator_Handler,
@@ -3661,7 +4007,7 @@ func (m *QueryBatchConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastEventByAddrRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOutgoingBatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3671,27 +4017,25 @@ func (m *QueryLastEventByAddrRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastEventByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOutgoingBatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastEventByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOutgoingBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastEventByAddrResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOutgoingBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3701,19 +4045,38 @@ func (m *QueryLastEventByAddrResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastEventByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOutgoingBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastEventByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOutgoingBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastClaimEvent != nil {
+	if len(m.ConfirmedValidators) > 0 {
+		for iNdEx := len(m.ConfirmedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConfirmedValidators[iNdEx])
+			copy(dAtA[i:], m.ConfirmedValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConfirmedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Batch != nil {
 		{
-			size, err := m.LastClaimEvent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3726,7 +4089,7 @@ func (m *QueryLastEventByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLastEventByAddrRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3736,21 +4099,86 @@ func (m *QueryERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLastEventByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLastEventByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
-		i--
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastEventByAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastEventByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastEventByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastClaimEvent != nil {
+		{
+			size, err := m.LastClaimEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -3866,6 +4294,85 @@ func (m *QueryDenomToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomOriginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomOriginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomOriginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomOriginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomOriginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomOriginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IbcBaseDenom) > 0 {
+		i -= len(m.IbcBaseDenom)
+		copy(dAtA[i:], m.IbcBaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcBaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IbcPath) > 0 {
+		i -= len(m.IbcPath)
+		copy(dAtA[i:], m.IbcPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Origin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryDelegateKeysByValidatorAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4581,6 +5088,39 @@ func (m *QueryBatchConfirmsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOutgoingBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *QueryOutgoingBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConfirmedValidators) > 0 {
+		for _, s := range m.ConfirmedValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryLastEventByAddrRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4665,6 +5205,43 @@ func (m *QueryDenomToERC20Response) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomOriginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomOriginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Origin != 0 {
+		n += 1 + sovQuery(uint64(m.Origin))
+	}
+	l = len(m.IbcPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IbcBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryDelegateKeysByValidatorAddress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6713,7 +7290,7 @@ func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastEventByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutgoingBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6736,17 +7313,17 @@ func (m *QueryLastEventByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastEventByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutgoingBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastEventByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutgoingBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			var stringLen uint64
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6756,24 +7333,11 @@ func (m *QueryLastEventByAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6795,7 +7359,7 @@ func (m *QueryLastEventByAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastEventByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOutgoingBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6818,15 +7382,15 @@ func (m *QueryLastEventByAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastEventByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutgoingBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastEventByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutgoingBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6853,30 +7417,264 @@ func (m *QueryLastEventByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastClaimEvent == nil {
-				m.LastClaimEvent = &LastClaimEvent{}
+			if m.Batch == nil {
+				m.Batch = &OutgoingTxBatch{}
 			}
-			if err := m.LastClaimEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmedValidators = append(m.ConfirmedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastEventByAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastEventByAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastEventByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastEventByAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastEventByAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastEventByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastClaimEvent == nil {
+				m.LastClaimEvent = &LastClaimEvent{}
+			}
+			if err := m.LastClaimEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -7249,6 +8047,253 @@ func (m *QueryDenomToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomOriginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomOriginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomOriginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomOriginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomOriginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomOriginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= DenomOriginType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcBaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcBaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryDelegateKeysByValidatorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
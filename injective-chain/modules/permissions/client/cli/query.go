@@ -18,6 +18,7 @@ func GetQueryCmd() *cobra.Command {
 		GetNamespaceRoleAddresses(),
 		GetNamespaceAddressRoles(),
 		GetVouchersForAddress(),
+		GetDenomSendRestrictions(),
 	)
 
 	return cmd
@@ -70,3 +71,11 @@ func GetVouchersForAddress() *cobra.Command {
 		&types.QueryVouchersForAddressRequest{}, nil, nil,
 	)
 }
+
+func GetDenomSendRestrictions() *cobra.Command {
+	return cli.QueryCmd("denom-send-restrictions",
+		"Returns the denoms whose sends are disabled along with their allowed senders",
+		types.NewQueryClient,
+		&types.QueryDenomSendRestrictionsRequest{}, nil, nil,
+	)
+}
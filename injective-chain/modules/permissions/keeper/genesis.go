@@ -17,6 +17,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, restriction := range genState.DenomSendRestrictions {
+		if err := k.SetDenomSendRestriction(ctx, restriction); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the permissions module's exported genesis.
@@ -34,5 +40,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		gs.Namespaces = append(gs.Namespaces, *ns)
 	}
 
+	restrictions, err := k.GetAllDenomSendRestrictions(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.DenomSendRestrictions = restrictions
+
 	return gs
 }
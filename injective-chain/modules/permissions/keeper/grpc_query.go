@@ -106,3 +106,14 @@ func (q queryServer) VouchersForAddress(c context.Context, req *types.QueryVouch
 
 	return resp, nil
 }
+
+func (q queryServer) DenomSendRestrictions(c context.Context, _ *types.QueryDenomSendRestrictionsRequest) (*types.QueryDenomSendRestrictionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	restrictions, err := q.GetAllDenomSendRestrictions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomSendRestrictionsResponse{Restrictions: restrictions}, nil
+}
@@ -6,13 +6,14 @@ import (
 )
 
 var (
-	paramsKey       = []byte{0x01}
-	namespacesKey   = []byte{0x02} // denom => Namespace
-	rolesKey        = []byte{0x03} // denom + role_id => Role
-	addressRolesKey = []byte{0x04} // denom + address => []role_id
-	roleNames       = []byte{0x05} // denom + role_name => role_id
-	vouchersKey     = []byte{0x06} // toAddr + fromAddr => Coins
-	delim           = []byte("|")
+	paramsKey                = []byte{0x01}
+	namespacesKey            = []byte{0x02} // denom => Namespace
+	rolesKey                 = []byte{0x03} // denom + role_id => Role
+	addressRolesKey          = []byte{0x04} // denom + address => []role_id
+	roleNames                = []byte{0x05} // denom + role_name => role_id
+	vouchersKey              = []byte{0x06} // toAddr + fromAddr => Coins
+	denomSendRestrictionsKey = []byte{0x07} // denom => DenomSendRestriction
+	delim                    = []byte("|")
 )
 
 // getNamespacesStore returns the store prefix where all the namespaces reside
@@ -50,3 +51,9 @@ func (k Keeper) getVouchersStore(ctx sdk.Context, toAddress string) sdk.KVStore
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, append(vouchersKey, toAddress...))
 }
+
+// getDenomSendRestrictionsStore returns the store prefix where all the denom send restrictions reside
+func (k Keeper) getDenomSendRestrictionsStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, denomSendRestrictionsKey)
+}
@@ -353,3 +353,30 @@ func (k msgServer) ClaimVoucher(c context.Context, msg *types.MsgClaimVoucher) (
 
 	return &types.MsgClaimVoucherResponse{}, nil
 }
+
+func (k msgServer) UpdateDenomSendRestriction(c context.Context, msg *types.MsgUpdateDenomSendRestriction) (*types.MsgUpdateDenomSendRestrictionResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !msg.SendsDisabled {
+		k.deleteDenomSendRestriction(ctx, msg.Denom)
+		return &types.MsgUpdateDenomSendRestrictionResponse{}, nil
+	}
+
+	restriction := types.DenomSendRestriction{
+		Denom:          msg.Denom,
+		AllowedSenders: msg.AllowedSenders,
+	}
+	if err := k.SetDenomSendRestriction(ctx, restriction); err != nil {
+		return nil, errors.Wrap(err, "can't store denom send restriction")
+	}
+
+	return &types.MsgUpdateDenomSendRestrictionResponse{}, nil
+}
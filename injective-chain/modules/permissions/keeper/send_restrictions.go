@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// DenomSendRestrictionFn rejects transfers of a restricted denom unless the sender is one of its allowed senders.
+// It is layered on top of the bank send enabled params, which only apply to MsgSend and MsgMultiSend, and also
+// covers the transfers performed by other modules.
+func (k Keeper) DenomSendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amounts sdk.Coins) (sdk.AccAddress, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, amount := range amounts {
+		restriction, err := k.GetDenomSendRestriction(sdkCtx, amount.Denom)
+		if err != nil {
+			return toAddr, err
+		}
+
+		if restriction != nil && !restriction.IsAllowedSender(fromAddr.String()) {
+			return toAddr, errors.Wrapf(types.ErrDenomSendDisabled, "sends of %s are disabled for %s", amount.Denom, fromAddr.String())
+		}
+	}
+
+	return toAddr, nil
+}
+
+// GetDenomSendRestriction returns the send restriction of the denom or nil if its sends are not restricted.
+func (k Keeper) GetDenomSendRestriction(ctx sdk.Context, denom string) (*types.DenomSendRestriction, error) {
+	store := k.getDenomSendRestrictionsStore(ctx)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return nil, nil
+	}
+
+	var restriction types.DenomSendRestriction
+	if err := proto.Unmarshal(bz, &restriction); err != nil {
+		return nil, err
+	}
+
+	return &restriction, nil
+}
+
+func (k Keeper) SetDenomSendRestriction(ctx sdk.Context, restriction types.DenomSendRestriction) error {
+	store := k.getDenomSendRestrictionsStore(ctx)
+	bz, err := proto.Marshal(&restriction)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(restriction.Denom), bz)
+	return nil
+}
+
+func (k Keeper) deleteDenomSendRestriction(ctx sdk.Context, denom string) {
+	store := k.getDenomSendRestrictionsStore(ctx)
+	store.Delete([]byte(denom))
+}
+
+// GetAllDenomSendRestrictions returns the send restrictions of all the denoms, sorted by denom.
+func (k Keeper) GetAllDenomSendRestrictions(ctx sdk.Context) ([]types.DenomSendRestriction, error) {
+	store := k.getDenomSendRestrictionsStore(ctx)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	restrictions := make([]types.DenomSendRestriction, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var restriction types.DenomSendRestriction
+		if err := proto.Unmarshal(iterator.Value(), &restriction); err != nil {
+			return nil, err
+		}
+		restrictions = append(restrictions, restriction)
+	}
+
+	return restrictions, nil
+}
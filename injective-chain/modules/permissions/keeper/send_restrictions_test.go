@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/keeper"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+	tftypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/tokenfactory/types"
+)
+
+func TestDenomSendRestriction(t *testing.T) {
+	s, k := setupTestSuite(t, 2)
+
+	user := s.TestAccs[0]
+	recipient := s.TestAccs[1]
+	denom := "usdt"
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	exchangeModuleAddr := authtypes.NewModuleAddress(exchangetypes.ModuleName)
+	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	require.NoError(t, banktestutil.FundModuleAccount(s.App.BankKeeper, s.Ctx, exchangetypes.ModuleName, amount))
+
+	disableMsg := &types.MsgUpdateDenomSendRestriction{
+		Authority:      govModuleAddr,
+		Denom:          denom,
+		SendsDisabled:  true,
+		AllowedSenders: []string{exchangeModuleAddr.String()},
+	}
+
+	// only governance can update the restriction
+	wrongAuthorityMsg := *disableMsg
+	wrongAuthorityMsg.Authority = user.String()
+	_, err := s.MsgServer.UpdateDenomSendRestriction(s.Ctx, &wrongAuthorityMsg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = s.MsgServer.UpdateDenomSendRestriction(s.Ctx, disableMsg)
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(k).DenomSendRestrictions(s.Ctx, &types.QueryDenomSendRestrictionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomSendRestriction{{Denom: denom, AllowedSenders: []string{exchangeModuleAddr.String()}}}, res.Restrictions)
+
+	// users can't send the denom
+	err = s.App.BankKeeper.SendCoins(s.Ctx, user, recipient, amount)
+	require.ErrorIs(t, err, types.ErrDenomSendDisabled)
+
+	err = s.App.BankKeeper.SendCoinsFromAccountToModule(s.Ctx, user, exchangetypes.ModuleName, amount)
+	require.ErrorIs(t, err, types.ErrDenomSendDisabled)
+
+	// other denoms are not affected
+	otherAmount := sdk.NewCoins(sdk.NewInt64Coin(tftypes.DefaultParams().DenomCreationFee[0].Denom, 1))
+	require.NoError(t, s.App.BankKeeper.SendCoins(s.Ctx, user, recipient, otherAmount))
+
+	// the exempt module can still move the denom
+	require.NoError(t, s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, exchangetypes.ModuleName, recipient, amount))
+
+	// the restriction is part of the genesis
+	genesis := k.ExportGenesis(s.Ctx)
+	require.Equal(t, res.Restrictions, genesis.DenomSendRestrictions)
+	require.NoError(t, genesis.Validate())
+
+	// lifting the restriction enables the sends again
+	_, err = s.MsgServer.UpdateDenomSendRestriction(s.Ctx, &types.MsgUpdateDenomSendRestriction{
+		Authority: govModuleAddr,
+		Denom:     denom,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.App.BankKeeper.SendCoins(s.Ctx, user, recipient, amount))
+}
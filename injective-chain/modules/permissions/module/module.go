@@ -120,6 +120,7 @@ func NewAppModule(
 ) AppModule {
 	// set global bank transfer hook
 	bankKeeper.AppendSendRestriction(k.SendRestrictionFn)
+	bankKeeper.AppendSendRestriction(k.DenomSendRestrictionFn)
 
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(),
@@ -18,11 +18,13 @@ const ModuleName = "permissions"
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, fmt.Sprintf("injective/%s/update-params", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateDenomSendRestriction{}, fmt.Sprintf("injective/%s/update-denom-send-restriction", ModuleName), nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateDenomSendRestriction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -14,6 +14,8 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params:     DefaultParams(),
 		Namespaces: []Namespace{},
+
+		DenomSendRestrictions: []DenomSendRestriction{},
 	}
 }
 
@@ -34,5 +36,17 @@ func (gs GenesisState) Validate() error {
 		seenDenoms[ns.GetDenom()] = struct{}{}
 	}
 
+	seenRestrictedDenoms := map[string]struct{}{}
+
+	for _, restriction := range gs.GetDenomSendRestrictions() {
+		if err := restriction.Validate(); err != nil {
+			return errors.Wrap(ErrInvalidGenesis, err.Error())
+		}
+		if _, ok := seenRestrictedDenoms[restriction.Denom]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "duplicate denom send restriction: %s", restriction.Denom)
+		}
+		seenRestrictedDenoms[restriction.Denom] = struct{}{}
+	}
+
 	return nil
 }
@@ -26,8 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the permissions module's genesis state.
 type GenesisState struct {
 	// params defines the parameters of the module.
-	Params     Params      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Namespaces []Namespace `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Namespaces            []Namespace            `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces"`
+	DenomSendRestrictions []DenomSendRestriction `protobuf:"bytes,3,rep,name=denom_send_restrictions,json=denomSendRestrictions,proto3" json:"denom_send_restrictions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +78,13 @@ func (m *GenesisState) GetNamespaces() []Namespace {
 	return nil
 }
 
+func (m *GenesisState) GetDenomSendRestrictions() []DenomSendRestriction {
+	if m != nil {
+		return m.DenomSendRestrictions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.permissions.v1beta1.GenesisState")
 }
@@ -86,24 +94,27 @@ func init() {
 }
 
 var fileDescriptor_5ff1982ce1793022 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x48, 0x2d, 0xca, 0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf,
-	0x2b, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x85, 0x2b, 0xd6, 0x43, 0x52, 0xac, 0x07,
-	0x55, 0x2c, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0x69,
-	0xe1, 0xb7, 0xa1, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0x81, 0x94, 0x3e, 0x01, 0xb5, 0x48, 0x96,
-	0x82, 0x35, 0x28, 0x2d, 0x66, 0xe4, 0xe2, 0x71, 0x87, 0xb8, 0x31, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x99, 0x8b, 0x0d, 0x62, 0xa2, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xaa, 0x1e, 0x5e,
-	0x37, 0xeb, 0x05, 0x80, 0x15, 0x3b, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0xd5, 0x2a, 0xe4,
-	0xc7, 0xc5, 0x95, 0x97, 0x98, 0x9b, 0x5a, 0x5c, 0x90, 0x98, 0x9c, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0,
-	0xac, 0xc1, 0x6d, 0xa4, 0x41, 0xc0, 0x20, 0x3f, 0x98, 0x06, 0xa8, 0x59, 0x48, 0x26, 0x38, 0x65,
-	0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x54, 0x60, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x27, 0xcc, 0x7c, 0x9f, 0xc4, 0xa4, 0x62, 0x44, 0x48,
-	0xe8, 0x26, 0xe7, 0x17, 0xa5, 0x22, 0x73, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x73, 0xf3, 0x53, 0x4a,
-	0x73, 0x52, 0x8b, 0x51, 0x82, 0xa9, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x32, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x0c, 0xe9, 0xc0, 0xda, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0x02, 0x41,
+	0x18, 0xc7, 0x77, 0x35, 0x3c, 0x8c, 0x9d, 0x96, 0x22, 0x11, 0x9a, 0x24, 0x08, 0xa4, 0x68, 0x06,
+	0xf5, 0x0d, 0x2c, 0x88, 0x20, 0xa4, 0xf4, 0xd6, 0x45, 0x66, 0x77, 0x3f, 0xd6, 0xa9, 0x66, 0x66,
+	0x9b, 0x6f, 0x14, 0x7a, 0x8b, 0x4e, 0x3d, 0x93, 0x47, 0x8f, 0x9d, 0x22, 0xf4, 0x45, 0xc2, 0x75,
+	0xcd, 0x0d, 0x42, 0x6f, 0x33, 0xcc, 0xff, 0xf7, 0xfb, 0x7f, 0xcc, 0x47, 0x2e, 0xa4, 0x7e, 0x82,
+	0xc8, 0xc9, 0x09, 0xf0, 0x14, 0xac, 0x92, 0x88, 0xd2, 0x68, 0xe4, 0x93, 0x56, 0x08, 0x4e, 0xb4,
+	0x78, 0x02, 0x1a, 0x50, 0x22, 0x4b, 0xad, 0x71, 0x26, 0x38, 0xfe, 0x0d, 0xb3, 0x42, 0x98, 0xe5,
+	0xe1, 0xfa, 0x41, 0x62, 0x12, 0x93, 0x25, 0xf9, 0xf2, 0xb4, 0x82, 0xea, 0xe7, 0xdb, 0x1b, 0x52,
+	0x61, 0x85, 0xca, 0x0b, 0xea, 0x7c, 0x47, 0xb6, 0x50, 0x9a, 0x01, 0xa7, 0x1f, 0x25, 0xb2, 0x7f,
+	0xb3, 0x9a, 0x71, 0xe0, 0x84, 0x83, 0xe0, 0x8a, 0x54, 0x56, 0xc6, 0x9a, 0xdf, 0xf0, 0x9b, 0xd5,
+	0xf6, 0x19, 0xdb, 0x3a, 0x33, 0xbb, 0xcf, 0xc2, 0xdd, 0xbd, 0xe9, 0xd7, 0x89, 0xd7, 0xcf, 0xd1,
+	0xa0, 0x47, 0x88, 0x16, 0x0a, 0x30, 0x15, 0x11, 0x60, 0xad, 0xd4, 0x28, 0x37, 0xab, 0xed, 0xe6,
+	0x0e, 0x51, 0x6f, 0x0d, 0xe4, 0xae, 0x82, 0x21, 0x78, 0x25, 0x47, 0x31, 0x68, 0xa3, 0x86, 0x08,
+	0x3a, 0x1e, 0x5a, 0x40, 0x67, 0x65, 0xe4, 0x96, 0x78, 0xad, 0x9c, 0xc9, 0x3b, 0x3b, 0xe4, 0xd7,
+	0x4b, 0x7a, 0x00, 0x3a, 0xee, 0x6f, 0xd8, 0xbc, 0xe7, 0x30, 0xfe, 0xe7, 0x0d, 0xbb, 0xcf, 0xd3,
+	0x39, 0xf5, 0x67, 0x73, 0xea, 0x7f, 0xcf, 0xa9, 0xff, 0xbe, 0xa0, 0xde, 0x6c, 0x41, 0xbd, 0xcf,
+	0x05, 0xf5, 0x1e, 0x1f, 0x12, 0xe9, 0x46, 0xe3, 0x90, 0x45, 0x46, 0xf1, 0xdb, 0x75, 0xeb, 0x9d,
+	0x08, 0x71, 0xf3, 0xf9, 0x97, 0x91, 0xb1, 0x50, 0xbc, 0x8e, 0x84, 0xd4, 0x5c, 0x99, 0x78, 0xfc,
+	0x02, 0xf8, 0x67, 0x33, 0xee, 0x2d, 0x05, 0x0c, 0x2b, 0xd9, 0x32, 0x3a, 0x3f, 0x03, 0x00, 0x0b,
+	0xfa, 0x6f, 0x84, 0x4d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +137,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomSendRestrictions) > 0 {
+		for iNdEx := len(m.DenomSendRestrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomSendRestrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Namespaces) > 0 {
 		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +203,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomSendRestrictions) > 0 {
+		for _, e := range m.DenomSendRestrictions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +314,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomSendRestrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomSendRestrictions = append(m.DenomSendRestrictions, DenomSendRestriction{})
+			if err := m.DenomSendRestrictions[len(m.DenomSendRestrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
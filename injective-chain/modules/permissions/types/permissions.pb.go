@@ -396,6 +396,61 @@ func (m *AddressVoucher) GetVoucher() *Voucher {
 	return nil
 }
 
+// DenomSendRestriction disables the sends of a denom for every sender except
+// the allowed senders, on top of the bank send enabled params
+type DenomSendRestriction struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// addresses, typically module accounts, still allowed to send the denom
+	AllowedSenders []string `protobuf:"bytes,2,rep,name=allowed_senders,json=allowedSenders,proto3" json:"allowed_senders,omitempty"`
+}
+
+func (m *DenomSendRestriction) Reset()         { *m = DenomSendRestriction{} }
+func (m *DenomSendRestriction) String() string { return proto.CompactTextString(m) }
+func (*DenomSendRestriction) ProtoMessage()    {}
+func (*DenomSendRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{6}
+}
+func (m *DenomSendRestriction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSendRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSendRestriction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSendRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSendRestriction.Merge(m, src)
+}
+func (m *DenomSendRestriction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSendRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSendRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSendRestriction proto.InternalMessageInfo
+
+func (m *DenomSendRestriction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomSendRestriction) GetAllowedSenders() []string {
+	if m != nil {
+		return m.AllowedSenders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("injective.permissions.v1beta1.Action", Action_name, Action_value)
 	proto.RegisterType((*Namespace)(nil), "injective.permissions.v1beta1.Namespace")
@@ -404,6 +459,7 @@ func init() {
 	proto.RegisterType((*RoleIDs)(nil), "injective.permissions.v1beta1.RoleIDs")
 	proto.RegisterType((*Voucher)(nil), "injective.permissions.v1beta1.Voucher")
 	proto.RegisterType((*AddressVoucher)(nil), "injective.permissions.v1beta1.AddressVoucher")
+	proto.RegisterType((*DenomSendRestriction)(nil), "injective.permissions.v1beta1.DenomSendRestriction")
 }
 
 func init() {
@@ -411,43 +467,45 @@ func init() {
 }
 
 var fileDescriptor_6d25f3ecf3806c6c = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4d, 0x8f, 0xd2, 0x4e,
-	0x18, 0xa7, 0xbc, 0x15, 0xa6, 0xf0, 0x5f, 0x32, 0xd9, 0x43, 0x77, 0xff, 0xb1, 0x8b, 0xd5, 0x18,
-	0xa2, 0xd9, 0xd6, 0xc5, 0x9b, 0x31, 0xc6, 0x85, 0xc5, 0xd8, 0x44, 0x09, 0x56, 0x77, 0x0f, 0x5e,
-	0x48, 0x5f, 0x26, 0x30, 0x42, 0x3b, 0xa4, 0x4f, 0xc1, 0xf8, 0x2d, 0xfc, 0x1c, 0x7e, 0x92, 0xf5,
-	0xb6, 0x47, 0x4f, 0x6a, 0xe0, 0x8b, 0x98, 0x99, 0x29, 0x50, 0x0f, 0xba, 0x27, 0xfa, 0xfc, 0x5e,
-	0x9e, 0xe1, 0xf9, 0xcd, 0x33, 0xc8, 0xa6, 0xf1, 0x47, 0x12, 0xa4, 0x74, 0x45, 0xec, 0x05, 0x49,
-	0x22, 0x0a, 0x40, 0x59, 0x0c, 0xf6, 0xea, 0xcc, 0x27, 0xa9, 0x77, 0x96, 0xc7, 0xac, 0x45, 0xc2,
-	0x52, 0x86, 0xef, 0xec, 0x0c, 0x56, 0x9e, 0xcc, 0x0c, 0xc7, 0x87, 0x13, 0x36, 0x61, 0x42, 0x69,
-	0xf3, 0x2f, 0x69, 0x3a, 0x36, 0x02, 0x06, 0x11, 0x03, 0xdb, 0xf7, 0x80, 0xec, 0x7a, 0x07, 0x8c,
-	0xc6, 0x92, 0x37, 0xbf, 0x15, 0x51, 0x7d, 0xe8, 0x45, 0x04, 0x16, 0x5e, 0x40, 0xf0, 0x21, 0xaa,
-	0x84, 0x24, 0x66, 0x91, 0xae, 0xb4, 0x95, 0x4e, 0xdd, 0x95, 0x05, 0xfe, 0x1f, 0xd5, 0x3f, 0x79,
-	0x10, 0x8d, 0xa7, 0x8c, 0xcd, 0xf4, 0xa2, 0x60, 0x6a, 0x1c, 0x78, 0xc5, 0xd8, 0x0c, 0xdf, 0x45,
-	0x8d, 0x88, 0xc6, 0x29, 0x8c, 0x17, 0xde, 0x12, 0x48, 0xa8, 0x97, 0xda, 0x4a, 0xa7, 0xe6, 0x6a,
-	0x02, 0x1b, 0x09, 0x88, 0x4b, 0x80, 0xc4, 0xe1, 0x4e, 0x52, 0x96, 0x12, 0x81, 0xed, 0x25, 0xfe,
-	0x32, 0x89, 0x77, 0x92, 0x8a, 0x94, 0x08, 0x2c, 0x93, 0x0c, 0x51, 0x2b, 0x61, 0x73, 0x32, 0xce,
-	0xcd, 0xae, 0x57, 0xdb, 0xa5, 0x8e, 0xd6, 0xbd, 0x67, 0xfd, 0x33, 0x19, 0xcb, 0x65, 0x73, 0xe2,
-	0x1e, 0x70, 0xf3, 0x68, 0xcf, 0xe2, 0x11, 0x6a, 0x7a, 0x61, 0x98, 0x10, 0x80, 0x31, 0xa7, 0x40,
-	0x57, 0x45, 0xb3, 0x47, 0xb7, 0x34, 0x3b, 0x97, 0x1e, 0xde, 0x13, 0xdc, 0x86, 0x97, 0xab, 0xcc,
-	0xe7, 0xa8, 0x91, 0x67, 0xb1, 0x8e, 0xd4, 0x8c, 0xcf, 0xf2, 0xdc, 0x96, 0x3c, 0x67, 0x79, 0x66,
-	0xb1, 0x5d, 0xe2, 0x39, 0x8b, 0xc2, 0x7c, 0x86, 0xca, 0xdc, 0x88, 0x31, 0x2a, 0x73, 0x20, 0x33,
-	0x89, 0x6f, 0xdc, 0x46, 0x5a, 0x7e, 0x70, 0x7e, 0x0b, 0x4d, 0x37, 0x0f, 0x99, 0xf7, 0x91, 0xca,
-	0xdd, 0xce, 0x05, 0xe0, 0x23, 0x54, 0x13, 0x51, 0xd1, 0x90, 0x9f, 0x5c, 0xea, 0x34, 0x5d, 0x95,
-	0xd7, 0x4e, 0x08, 0xe6, 0x1c, 0xa9, 0x57, 0x6c, 0x19, 0x4c, 0x49, 0x82, 0x3d, 0x54, 0xe1, 0x8b,
-	0x20, 0x25, 0x5a, 0xf7, 0xc8, 0x92, 0xab, 0x62, 0xf1, 0x55, 0xd9, 0x8d, 0xdb, 0x67, 0x34, 0xee,
-	0x3d, 0xbe, 0xfe, 0x71, 0x52, 0xf8, 0xfa, 0xf3, 0xa4, 0x33, 0xa1, 0xe9, 0x74, 0xe9, 0x5b, 0x01,
-	0x8b, 0xec, 0x6c, 0xaf, 0xe4, 0xcf, 0x29, 0x84, 0x33, 0x3b, 0xfd, 0xbc, 0x20, 0x20, 0x0c, 0xe0,
-	0xca, 0xce, 0xe6, 0x1c, 0xfd, 0x97, 0x25, 0xb2, 0x3d, 0xf4, 0xef, 0x99, 0xbc, 0x40, 0xea, 0x4a,
-	0x8a, 0xc4, 0x74, 0x5a, 0xf7, 0xc1, 0x2d, 0x37, 0x91, 0xb5, 0x74, 0xb7, 0xb6, 0x87, 0x4f, 0x51,
-	0xf5, 0x3c, 0x48, 0x29, 0x8b, 0xf1, 0x01, 0xd2, 0x2e, 0x87, 0xef, 0x46, 0x83, 0xbe, 0xf3, 0xd2,
-	0x19, 0x5c, 0xb4, 0x0a, 0xb8, 0x86, 0xca, 0x6f, 0x9c, 0xe1, 0xfb, 0x96, 0x82, 0x35, 0xa4, 0xba,
-	0x83, 0xfe, 0xc0, 0xb9, 0x1a, 0xb4, 0x8a, 0x1c, 0xee, 0x5d, 0xba, 0xc3, 0x56, 0xb9, 0x37, 0xbb,
-	0x5e, 0x1b, 0xca, 0xcd, 0xda, 0x50, 0x7e, 0xad, 0x0d, 0xe5, 0xcb, 0xc6, 0x28, 0xdc, 0x6c, 0x8c,
-	0xc2, 0xf7, 0x8d, 0x51, 0xf8, 0xf0, 0x36, 0x37, 0xb4, 0xb3, 0xfd, 0x43, 0xaf, 0x3d, 0x1f, 0xf6,
-	0x0f, 0xf8, 0x34, 0x60, 0x09, 0xc9, 0x97, 0x53, 0x8f, 0xc6, 0x76, 0xc4, 0xc2, 0xe5, 0x9c, 0xc0,
-	0x1f, 0xaf, 0x5b, 0x64, 0xe4, 0x57, 0xc5, 0xdb, 0x7b, 0xf2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf3,
-	0xf2, 0xce, 0xa8, 0x03, 0x04, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x93, 0xb4, 0x4e, 0xaf, 0xfb, 0x88, 0x46, 0x5d, 0xb8, 0x45, 0xb8, 0xc1, 0x20, 0x88,
+	0x40, 0xb5, 0x69, 0xd9, 0x21, 0x84, 0xe8, 0x23, 0x08, 0x4b, 0x10, 0x85, 0x29, 0xed, 0x82, 0x4d,
+	0x34, 0xb6, 0x47, 0xcd, 0x10, 0xdb, 0x13, 0x79, 0x9c, 0x56, 0xfc, 0x05, 0xdf, 0xc1, 0x97, 0x94,
+	0x5d, 0x97, 0xac, 0x00, 0xb5, 0x3f, 0x82, 0x66, 0xc6, 0x49, 0xcd, 0xa2, 0x74, 0x15, 0xdf, 0x73,
+	0xcf, 0xb9, 0xa3, 0x7b, 0xe6, 0x64, 0xc0, 0x67, 0xd9, 0x17, 0x1a, 0x15, 0xec, 0x8c, 0xfa, 0x13,
+	0x9a, 0xa7, 0x4c, 0x08, 0xc6, 0x33, 0xe1, 0x9f, 0xed, 0x84, 0xb4, 0x20, 0x3b, 0x55, 0xcc, 0x9b,
+	0xe4, 0xbc, 0xe0, 0xe8, 0xfe, 0x5c, 0xe0, 0x55, 0x9b, 0xa5, 0x60, 0x73, 0xfd, 0x94, 0x9f, 0x72,
+	0xc5, 0xf4, 0xe5, 0x97, 0x16, 0x6d, 0x3a, 0x11, 0x17, 0x29, 0x17, 0x7e, 0x48, 0x04, 0x9d, 0xcf,
+	0x8e, 0x38, 0xcb, 0x74, 0xdf, 0xfd, 0x51, 0x87, 0xa5, 0x3e, 0x49, 0xa9, 0x98, 0x90, 0x88, 0xa2,
+	0x75, 0x58, 0x88, 0x69, 0xc6, 0x53, 0xdb, 0xe8, 0x18, 0xdd, 0x25, 0xac, 0x0b, 0x74, 0x0f, 0x96,
+	0xce, 0x89, 0x48, 0x87, 0x23, 0xce, 0xc7, 0x76, 0x5d, 0x75, 0x5a, 0x12, 0x78, 0xc7, 0xf9, 0x18,
+	0x3d, 0x80, 0xe5, 0x94, 0x65, 0x85, 0x18, 0x4e, 0xc8, 0x54, 0xd0, 0xd8, 0x6e, 0x74, 0x8c, 0x6e,
+	0x0b, 0x5b, 0x0a, 0x1b, 0x28, 0x48, 0x52, 0x04, 0xcd, 0xe2, 0x39, 0xa5, 0xa9, 0x29, 0x0a, 0xbb,
+	0xa1, 0x84, 0xd3, 0x3c, 0x9b, 0x53, 0x16, 0x34, 0x45, 0x61, 0x25, 0xa5, 0x0f, 0xed, 0x9c, 0x27,
+	0x74, 0x58, 0xd9, 0xdd, 0x5e, 0xec, 0x34, 0xba, 0xd6, 0xee, 0x43, 0xef, 0xbf, 0xce, 0x78, 0x98,
+	0x27, 0x14, 0xaf, 0x49, 0xf1, 0xe0, 0xa6, 0x8b, 0x06, 0xb0, 0x42, 0xe2, 0x38, 0xa7, 0x42, 0x0c,
+	0x65, 0x4b, 0xd8, 0xa6, 0x1a, 0xf6, 0xec, 0x8e, 0x61, 0x7b, 0x5a, 0x23, 0x67, 0x0a, 0xbc, 0x4c,
+	0x2a, 0x95, 0xfb, 0x1a, 0x96, 0xab, 0x5d, 0x64, 0x83, 0x59, 0xf6, 0x4b, 0x3f, 0x67, 0xa5, 0xf4,
+	0x59, 0x9f, 0x59, 0xef, 0x34, 0xa4, 0xcf, 0xaa, 0x70, 0x5f, 0x41, 0x53, 0x0a, 0x11, 0x82, 0xa6,
+	0x04, 0x4a, 0x91, 0xfa, 0x46, 0x1d, 0xb0, 0xaa, 0x8b, 0xcb, 0x5b, 0x58, 0xc1, 0x55, 0xc8, 0x7d,
+	0x04, 0xa6, 0x54, 0x07, 0x87, 0x02, 0x6d, 0x40, 0x4b, 0x59, 0xc5, 0x62, 0x79, 0x72, 0xa3, 0xbb,
+	0x82, 0x4d, 0x59, 0x07, 0xb1, 0x70, 0x13, 0x30, 0x4f, 0xf8, 0x34, 0x1a, 0xd1, 0x1c, 0x11, 0x58,
+	0x90, 0x41, 0xd0, 0x14, 0x6b, 0x77, 0xc3, 0xd3, 0x51, 0xf1, 0x64, 0x54, 0xe6, 0xeb, 0x1e, 0x70,
+	0x96, 0xed, 0x3f, 0xbf, 0xf8, 0xb5, 0x55, 0xfb, 0xfe, 0x7b, 0xab, 0x7b, 0xca, 0x8a, 0xd1, 0x34,
+	0xf4, 0x22, 0x9e, 0xfa, 0x65, 0xae, 0xf4, 0xcf, 0xb6, 0x88, 0xc7, 0x7e, 0xf1, 0x75, 0x42, 0x85,
+	0x12, 0x08, 0xac, 0x27, 0xbb, 0x09, 0xac, 0x96, 0x8e, 0xcc, 0x0e, 0xbd, 0xdd, 0x93, 0x37, 0x60,
+	0x9e, 0x69, 0x92, 0xda, 0xce, 0xda, 0x7d, 0x7c, 0xc7, 0x4d, 0x94, 0x23, 0xf1, 0x4c, 0xe6, 0x1e,
+	0xc3, 0xfa, 0xa1, 0x0c, 0xec, 0x11, 0xcd, 0x62, 0x4c, 0x45, 0x91, 0xb3, 0xa8, 0x60, 0x3c, 0xbb,
+	0x25, 0xd5, 0x4f, 0x60, 0x8d, 0x24, 0x09, 0x3f, 0xa7, 0xf1, 0x50, 0x26, 0x91, 0xe6, 0xb3, 0xdb,
+	0x58, 0x2d, 0xe1, 0x23, 0x8d, 0x3e, 0x7d, 0x09, 0x8b, 0x7b, 0x7a, 0xd0, 0x1a, 0x58, 0xc7, 0xfd,
+	0xa3, 0x41, 0xef, 0x20, 0x78, 0x1b, 0xf4, 0x0e, 0xdb, 0x35, 0xd4, 0x82, 0xe6, 0x87, 0xa0, 0xff,
+	0xa9, 0x6d, 0x20, 0x0b, 0x4c, 0xdc, 0x3b, 0xe8, 0x05, 0x27, 0xbd, 0x76, 0x5d, 0xc2, 0xfb, 0xc7,
+	0xb8, 0xdf, 0x6e, 0xee, 0x8f, 0x2f, 0xae, 0x1c, 0xe3, 0xf2, 0xca, 0x31, 0xfe, 0x5c, 0x39, 0xc6,
+	0xb7, 0x6b, 0xa7, 0x76, 0x79, 0xed, 0xd4, 0x7e, 0x5e, 0x3b, 0xb5, 0xcf, 0x1f, 0x2b, 0x5e, 0x06,
+	0xb3, 0x3d, 0xdf, 0x93, 0x50, 0xdc, 0xbc, 0x0b, 0xdb, 0x11, 0xcf, 0x69, 0xb5, 0x1c, 0x11, 0x96,
+	0xf9, 0x29, 0x8f, 0xa7, 0x09, 0x15, 0xff, 0x3c, 0x1a, 0xca, 0xfa, 0x70, 0x51, 0xfd, 0xa5, 0x5f,
+	0xfc, 0x1d, 0x00, 0xa7, 0x1f, 0xc6, 0x65, 0x5a, 0x04, 0x00, 0x00,
 }
 
 func (m *Namespace) Marshal() (dAtA []byte, err error) {
@@ -739,6 +797,45 @@ func (m *AddressVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomSendRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSendRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSendRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSenders) > 0 {
+		for iNdEx := len(m.AllowedSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSenders[iNdEx])
+			copy(dAtA[i:], m.AllowedSenders[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.AllowedSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPermissions(v)
 	base := offset
@@ -871,6 +968,25 @@ func (m *AddressVoucher) Size() (n int) {
 	return n
 }
 
+func (m *DenomSendRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.AllowedSenders) > 0 {
+		for _, s := range m.AllowedSenders {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPermissions(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1662,6 +1778,120 @@ func (m *AddressVoucher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomSendRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSendRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSendRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSenders = append(m.AllowedSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPermissions(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
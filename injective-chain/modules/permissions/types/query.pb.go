@@ -581,6 +581,86 @@ func (m *QueryVouchersForAddressResponse) GetVouchers() []*AddressVoucher {
 	return nil
 }
 
+type QueryDenomSendRestrictionsRequest struct {
+}
+
+func (m *QueryDenomSendRestrictionsRequest) Reset()         { *m = QueryDenomSendRestrictionsRequest{} }
+func (m *QueryDenomSendRestrictionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSendRestrictionsRequest) ProtoMessage()    {}
+func (*QueryDenomSendRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0ae50f1018498b3, []int{12}
+}
+func (m *QueryDenomSendRestrictionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSendRestrictionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSendRestrictionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSendRestrictionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSendRestrictionsRequest.Merge(m, src)
+}
+func (m *QueryDenomSendRestrictionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSendRestrictionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSendRestrictionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSendRestrictionsRequest proto.InternalMessageInfo
+
+type QueryDenomSendRestrictionsResponse struct {
+	Restrictions []DenomSendRestriction `protobuf:"bytes,1,rep,name=restrictions,proto3" json:"restrictions"`
+}
+
+func (m *QueryDenomSendRestrictionsResponse) Reset()         { *m = QueryDenomSendRestrictionsResponse{} }
+func (m *QueryDenomSendRestrictionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSendRestrictionsResponse) ProtoMessage()    {}
+func (*QueryDenomSendRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0ae50f1018498b3, []int{13}
+}
+func (m *QueryDenomSendRestrictionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSendRestrictionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSendRestrictionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSendRestrictionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSendRestrictionsResponse.Merge(m, src)
+}
+func (m *QueryDenomSendRestrictionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSendRestrictionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSendRestrictionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSendRestrictionsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomSendRestrictionsResponse) GetRestrictions() []DenomSendRestriction {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "injective.permissions.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.permissions.v1beta1.QueryParamsResponse")
@@ -594,6 +674,8 @@ func init() {
 	proto.RegisterType((*QueryAddressRolesResponse)(nil), "injective.permissions.v1beta1.QueryAddressRolesResponse")
 	proto.RegisterType((*QueryVouchersForAddressRequest)(nil), "injective.permissions.v1beta1.QueryVouchersForAddressRequest")
 	proto.RegisterType((*QueryVouchersForAddressResponse)(nil), "injective.permissions.v1beta1.QueryVouchersForAddressResponse")
+	proto.RegisterType((*QueryDenomSendRestrictionsRequest)(nil), "injective.permissions.v1beta1.QueryDenomSendRestrictionsRequest")
+	proto.RegisterType((*QueryDenomSendRestrictionsResponse)(nil), "injective.permissions.v1beta1.QueryDenomSendRestrictionsResponse")
 }
 
 func init() {
@@ -601,55 +683,60 @@ func init() {
 }
 
 var fileDescriptor_e0ae50f1018498b3 = []byte{
-	// 758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xd3, 0x4a,
-	0x10, 0xc7, 0xb3, 0x7d, 0x6d, 0x5f, 0x33, 0x6d, 0xf5, 0x9e, 0xf6, 0xe5, 0x90, 0xe7, 0xb6, 0x69,
-	0x65, 0x54, 0x11, 0x40, 0x89, 0x49, 0xaa, 0x8a, 0xfe, 0x02, 0x89, 0x80, 0x0a, 0x45, 0x08, 0x51,
-	0x1f, 0x90, 0xe8, 0x25, 0xda, 0x24, 0x5b, 0xd7, 0x60, 0x7b, 0x5d, 0xaf, 0x53, 0x29, 0x57, 0x6e,
-	0xdc, 0x90, 0xb8, 0xf3, 0x8f, 0xf4, 0xca, 0xa1, 0xc7, 0x4a, 0x5c, 0x38, 0x21, 0xd4, 0xf2, 0x87,
-	0x20, 0xaf, 0xd7, 0x8e, 0xd3, 0x34, 0x71, 0x1a, 0x6e, 0xd9, 0xdd, 0xf9, 0xce, 0x7c, 0xbe, 0x93,
-	0xcc, 0x28, 0x70, 0xc7, 0x74, 0xde, 0xd1, 0xa6, 0x6f, 0x9e, 0x50, 0xcd, 0xa5, 0x9e, 0x6d, 0x72,
-	0x6e, 0x32, 0x87, 0x6b, 0x27, 0x95, 0x06, 0xf5, 0x49, 0x45, 0x3b, 0x6e, 0x53, 0xaf, 0x53, 0x76,
-	0x3d, 0xe6, 0x33, 0xbc, 0x14, 0x87, 0x96, 0x13, 0xa1, 0x65, 0x19, 0xaa, 0xe4, 0x0c, 0x66, 0x30,
-	0x11, 0xa9, 0x05, 0x9f, 0x42, 0x91, 0xb2, 0x68, 0x30, 0x66, 0x58, 0x54, 0x23, 0xae, 0xa9, 0x11,
-	0xc7, 0x61, 0x3e, 0xf1, 0x85, 0x2a, 0x7c, 0xbd, 0xdb, 0x64, 0xdc, 0x66, 0x5c, 0x6b, 0x10, 0x4e,
-	0xc3, 0x5a, 0x71, 0x65, 0x97, 0x18, 0xa6, 0x23, 0x82, 0xa3, 0xd8, 0xe1, 0xa4, 0x2e, 0xf1, 0x88,
-	0x1d, 0xe5, 0xbd, 0x37, 0x3c, 0xd6, 0xa0, 0x0e, 0xe5, 0x66, 0x14, 0xac, 0xa5, 0x24, 0x4e, 0x78,
-	0x15, 0x02, 0x35, 0x07, 0x78, 0x3f, 0x60, 0x7d, 0x2d, 0x4a, 0xea, 0xf4, 0xb8, 0x4d, 0xb9, 0xaf,
-	0x1e, 0xc0, 0x7f, 0x3d, 0xb7, 0xdc, 0x65, 0x0e, 0xa7, 0xf8, 0x09, 0x4c, 0x87, 0x68, 0x79, 0xb4,
-	0x82, 0x8a, 0xb3, 0xd5, 0xd5, 0xf2, 0xd0, 0x36, 0x96, 0x43, 0x79, 0x6d, 0xf2, 0xec, 0xc7, 0x72,
-	0x46, 0x97, 0x52, 0x75, 0x01, 0xfe, 0x17, 0xb9, 0x1f, 0x5b, 0xd6, 0x2b, 0x62, 0x53, 0xee, 0x92,
-	0x26, 0x8d, 0x0b, 0x1f, 0x82, 0x72, 0xdd, 0xa3, 0xac, 0xff, 0x1c, 0xc0, 0x89, 0x6f, 0xf3, 0x68,
-	0xe5, 0xaf, 0xe2, 0x6c, 0xb5, 0x98, 0xc2, 0x10, 0xa7, 0xd1, 0x13, 0x5a, 0xf5, 0x2d, 0x2c, 0x8a,
-	0x3a, 0xf1, 0x6b, 0xad, 0xf3, 0x94, 0x3a, 0xcc, 0x96, 0x1c, 0x38, 0x07, 0x53, 0xad, 0xe0, 0x2c,
-	0x8c, 0x66, 0xf5, 0xf0, 0x80, 0x6f, 0xc1, 0xbc, 0xe9, 0x34, 0xad, 0x76, 0x8b, 0xd6, 0x3d, 0x66,
-	0x51, 0x9e, 0x9f, 0x58, 0x41, 0xc5, 0x19, 0x7d, 0x4e, 0x5e, 0xea, 0xc1, 0x9d, 0x6a, 0xc0, 0xd2,
-	0x80, 0xd4, 0xd2, 0xc5, 0x2e, 0x64, 0x63, 0x12, 0xd9, 0xc8, 0xd1, 0x4d, 0x74, 0xa5, 0xea, 0x33,
-	0x58, 0x08, 0x7b, 0xd5, 0x6a, 0x79, 0x94, 0x73, 0xca, 0x6b, 0x9d, 0x80, 0x60, 0xb8, 0x05, 0x0c,
-	0x93, 0x01, 0xba, 0x20, 0xcf, 0xea, 0xe2, 0xb3, 0xba, 0x23, 0x9b, 0xd1, 0x97, 0x48, 0x02, 0x2f,
-	0x42, 0x96, 0x44, 0x4f, 0xa2, 0xeb, 0x59, 0xbd, 0x7b, 0xa1, 0xbe, 0x80, 0x7c, 0x52, 0x2d, 0x9a,
-	0x30, 0x9c, 0x21, 0x0f, 0x7f, 0x4b, 0xb9, 0xc4, 0x88, 0x8e, 0x6a, 0x25, 0xfa, 0x6d, 0xf4, 0xe4,
-	0x92, 0x18, 0x39, 0x98, 0x0a, 0xbb, 0x1e, 0x22, 0x84, 0x07, 0x75, 0x0b, 0x0a, 0x42, 0xf2, 0x86,
-	0xb5, 0x9b, 0x47, 0xd4, 0xe3, 0xbb, 0xcc, 0x8b, 0xd4, 0x12, 0x22, 0x51, 0x0e, 0xf5, 0x96, 0xb3,
-	0x60, 0x79, 0xa0, 0x56, 0x16, 0xdd, 0x83, 0x99, 0x13, 0xf9, 0x2a, 0x7f, 0x70, 0xa5, 0x94, 0xef,
-	0x4a, 0x66, 0x90, 0x39, 0xf5, 0x58, 0x5e, 0xfd, 0x98, 0x85, 0x29, 0x51, 0x0e, 0x7f, 0x41, 0x30,
-	0x1d, 0xce, 0x06, 0xae, 0xa4, 0x64, 0xeb, 0x1f, 0x4e, 0xa5, 0x7a, 0x13, 0x49, 0x68, 0x43, 0x2d,
-	0x7d, 0xf8, 0xf6, 0xeb, 0xf3, 0xc4, 0x6d, 0xbc, 0xaa, 0x8d, 0xb2, 0x79, 0xf0, 0x29, 0x82, 0xf9,
-	0x9e, 0x11, 0xc4, 0x1b, 0xa3, 0x14, 0xbd, 0x6e, 0xa4, 0x95, 0xcd, 0x31, 0x94, 0x92, 0x7a, 0x5d,
-	0x50, 0x6b, 0xb8, 0x94, 0x42, 0x4d, 0x2c, 0xab, 0xde, 0x1d, 0x6e, 0x7c, 0x86, 0xe0, 0xdf, 0xab,
-	0xd3, 0x87, 0xb7, 0x47, 0xc1, 0x18, 0xb0, 0x0e, 0x94, 0x9d, 0xf1, 0xc4, 0xd2, 0xc6, 0xa6, 0xb0,
-	0xb1, 0x86, 0x2b, 0x29, 0x36, 0x62, 0x0b, 0xf5, 0x46, 0xa7, 0x1e, 0x8e, 0xca, 0x29, 0x82, 0xb9,
-	0xe4, 0x30, 0xe0, 0x07, 0x23, 0x75, 0xb3, 0x7f, 0x14, 0x95, 0x8d, 0x9b, 0x0b, 0x25, 0xfe, 0x86,
-	0xc0, 0xaf, 0xe2, 0xfb, 0x69, 0xdf, 0x42, 0xb4, 0x12, 0x02, 0xfc, 0x60, 0x38, 0xf1, 0x57, 0x04,
-	0xff, 0x5c, 0x59, 0x2a, 0x78, 0xeb, 0x06, 0x1c, 0x57, 0x56, 0x9a, 0xb2, 0x3d, 0x96, 0xf6, 0x8f,
-	0x6d, 0x9c, 0x23, 0xc0, 0xfd, 0x2b, 0x02, 0x3f, 0x1c, 0x85, 0x66, 0xe0, 0x5a, 0x52, 0x1e, 0x8d,
-	0x2b, 0x97, 0x7e, 0xb6, 0x85, 0x9f, 0x75, 0xbc, 0x96, 0xe2, 0x27, 0xda, 0x3f, 0xf5, 0x43, 0xe6,
-	0xd5, 0xa5, 0xb9, 0xda, 0xfb, 0xb3, 0x8b, 0x02, 0x3a, 0xbf, 0x28, 0xa0, 0x9f, 0x17, 0x05, 0xf4,
-	0xe9, 0xb2, 0x90, 0x39, 0xbf, 0x2c, 0x64, 0xbe, 0x5f, 0x16, 0x32, 0x07, 0xfb, 0x86, 0xe9, 0x1f,
-	0xb5, 0x1b, 0xe5, 0x26, 0xb3, 0xb5, 0xbd, 0x28, 0xf1, 0x4b, 0xd2, 0xe0, 0xdd, 0x32, 0xa5, 0x26,
-	0xf3, 0x68, 0xf2, 0x78, 0x44, 0x4c, 0x47, 0xb3, 0x59, 0xab, 0x6d, 0x51, 0xde, 0xc3, 0xe0, 0x77,
-	0x5c, 0xca, 0x1b, 0xd3, 0xe2, 0xaf, 0xc6, 0xda, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xec,
-	0x3f, 0x09, 0xa0, 0x09, 0x00, 0x00,
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4f, 0x4f, 0xe3, 0x46,
+	0x18, 0xc6, 0x33, 0x14, 0x28, 0x79, 0x01, 0xb5, 0x9a, 0xa6, 0x52, 0x6a, 0x42, 0xa0, 0x46, 0xa8,
+	0x69, 0xab, 0xc4, 0x4d, 0x22, 0xda, 0xf0, 0xa7, 0xa8, 0xa4, 0x15, 0x2d, 0x55, 0x55, 0x15, 0x57,
+	0xaa, 0x54, 0xa4, 0xca, 0x72, 0x9c, 0xc1, 0xb8, 0x75, 0x3c, 0xc6, 0xe3, 0x20, 0xe5, 0xda, 0x7e,
+	0x81, 0x4a, 0x7b, 0xdf, 0x2f, 0xc2, 0x75, 0x0f, 0x1c, 0x91, 0xf6, 0xb2, 0x87, 0xd5, 0x6a, 0x05,
+	0x7b, 0xd8, 0x8f, 0xb1, 0xf2, 0x78, 0xec, 0x38, 0x90, 0xc4, 0x21, 0xdc, 0x98, 0x99, 0xf7, 0x79,
+	0xdf, 0xdf, 0x33, 0xf1, 0x3c, 0x02, 0x3e, 0xb7, 0x9c, 0xbf, 0x89, 0xe1, 0x5b, 0x17, 0x44, 0x71,
+	0x89, 0xd7, 0xb1, 0x18, 0xb3, 0xa8, 0xc3, 0x94, 0x8b, 0x6a, 0x8b, 0xf8, 0x7a, 0x55, 0x39, 0xef,
+	0x12, 0xaf, 0x57, 0x71, 0x3d, 0xea, 0x53, 0xbc, 0x1a, 0x97, 0x56, 0x12, 0xa5, 0x15, 0x51, 0x2a,
+	0xe5, 0x4c, 0x6a, 0x52, 0x5e, 0xa9, 0x04, 0x7f, 0x85, 0x22, 0xa9, 0x60, 0x52, 0x6a, 0xda, 0x44,
+	0xd1, 0x5d, 0x4b, 0xd1, 0x1d, 0x87, 0xfa, 0xba, 0xcf, 0x55, 0xe1, 0xe9, 0x17, 0x06, 0x65, 0x1d,
+	0xca, 0x94, 0x96, 0xce, 0x48, 0x38, 0x2b, 0x9e, 0xec, 0xea, 0xa6, 0xe5, 0xf0, 0xe2, 0xa8, 0x76,
+	0x3c, 0xa9, 0xab, 0x7b, 0x7a, 0x27, 0xea, 0xfb, 0xe5, 0xf8, 0x5a, 0x93, 0x38, 0x84, 0x59, 0x51,
+	0xb1, 0x92, 0xd2, 0x38, 0xe1, 0x95, 0x0b, 0xe4, 0x1c, 0xe0, 0xe3, 0x80, 0xf5, 0x37, 0x3e, 0x52,
+	0x25, 0xe7, 0x5d, 0xc2, 0x7c, 0xf9, 0x04, 0x3e, 0x1a, 0xd8, 0x65, 0x2e, 0x75, 0x18, 0xc1, 0xdf,
+	0xc3, 0x7c, 0x88, 0x96, 0x47, 0xeb, 0xa8, 0xb4, 0x58, 0xdb, 0xac, 0x8c, 0xbd, 0xc6, 0x4a, 0x28,
+	0x6f, 0xce, 0x5e, 0xbd, 0x5a, 0xcb, 0xa8, 0x42, 0x2a, 0xaf, 0xc0, 0x27, 0xbc, 0xf7, 0x81, 0x6d,
+	0xff, 0xaa, 0x77, 0x08, 0x73, 0x75, 0x83, 0xc4, 0x83, 0x4f, 0x41, 0x1a, 0x76, 0x28, 0xe6, 0xff,
+	0x04, 0xe0, 0xc4, 0xbb, 0x79, 0xb4, 0xfe, 0x5e, 0x69, 0xb1, 0x56, 0x4a, 0x61, 0x88, 0xdb, 0xa8,
+	0x09, 0xad, 0xfc, 0x27, 0x14, 0xf8, 0x9c, 0xf8, 0xb4, 0xd9, 0xfb, 0x81, 0x38, 0xb4, 0x23, 0x38,
+	0x70, 0x0e, 0xe6, 0xda, 0xc1, 0x9a, 0x1b, 0xcd, 0xaa, 0xe1, 0x02, 0x6f, 0xc0, 0xb2, 0xe5, 0x18,
+	0x76, 0xb7, 0x4d, 0x34, 0x8f, 0xda, 0x84, 0xe5, 0x67, 0xd6, 0x51, 0x69, 0x41, 0x5d, 0x12, 0x9b,
+	0x6a, 0xb0, 0x27, 0x9b, 0xb0, 0x3a, 0xa2, 0xb5, 0x70, 0x71, 0x08, 0xd9, 0x98, 0x44, 0x5c, 0xe4,
+	0xe4, 0x26, 0xfa, 0x52, 0xf9, 0x47, 0x58, 0x09, 0xef, 0xaa, 0xdd, 0xf6, 0x08, 0x63, 0x84, 0x35,
+	0x7b, 0x01, 0xc1, 0x78, 0x0b, 0x18, 0x66, 0x03, 0x74, 0x4e, 0x9e, 0x55, 0xf9, 0xdf, 0xf2, 0x1e,
+	0x14, 0x86, 0x37, 0x12, 0xc0, 0x05, 0xc8, 0xea, 0xd1, 0x11, 0xbf, 0xf5, 0xac, 0xda, 0xdf, 0x90,
+	0x7f, 0x86, 0x7c, 0x52, 0x1d, 0x28, 0xd9, 0x78, 0x86, 0x3c, 0xbc, 0x2f, 0xe4, 0x02, 0x23, 0x5a,
+	0xca, 0xd5, 0xe8, 0xdb, 0x18, 0xe8, 0x25, 0x30, 0x72, 0x30, 0x17, 0xde, 0x7a, 0x88, 0x10, 0x2e,
+	0xe4, 0x1d, 0x28, 0x72, 0xc9, 0x1f, 0xb4, 0x6b, 0x9c, 0x11, 0x8f, 0x1d, 0x52, 0x2f, 0x52, 0x0b,
+	0x88, 0xc4, 0x38, 0x34, 0x38, 0xce, 0x86, 0xb5, 0x91, 0x5a, 0x31, 0xf4, 0x08, 0x16, 0x2e, 0xc4,
+	0xa9, 0xf8, 0xe0, 0xca, 0x29, 0xbf, 0x95, 0xe8, 0x20, 0x7a, 0xaa, 0xb1, 0x5c, 0xde, 0x80, 0x4f,
+	0xf9, 0x34, 0xfe, 0x35, 0xfc, 0x4e, 0x9c, 0xb6, 0x4a, 0x98, 0xef, 0x59, 0x06, 0x0f, 0x91, 0xe8,
+	0x01, 0xfc, 0x87, 0x40, 0x1e, 0x57, 0x25, 0xb0, 0xfe, 0x82, 0x25, 0x2f, 0xb1, 0x2f, 0xd0, 0xea,
+	0x29, 0x68, 0xc3, 0x7a, 0x8a, 0xd7, 0x39, 0xd0, 0xae, 0xf6, 0x16, 0x60, 0x8e, 0x53, 0xe0, 0xa7,
+	0x08, 0xe6, 0xc3, 0x67, 0x8c, 0xab, 0x29, 0xdd, 0xef, 0xe7, 0x88, 0x54, 0x7b, 0x88, 0x24, 0xb4,
+	0x26, 0x97, 0xff, 0x7d, 0xfe, 0xe6, 0xc9, 0xcc, 0x67, 0x78, 0x53, 0x99, 0x24, 0x24, 0xf1, 0x25,
+	0x82, 0xe5, 0x81, 0xb4, 0xc0, 0x8d, 0x49, 0x86, 0x0e, 0x4b, 0x1f, 0x69, 0x7b, 0x0a, 0xa5, 0xa0,
+	0xde, 0xe2, 0xd4, 0x0a, 0x2e, 0xa7, 0x50, 0xeb, 0xb6, 0xad, 0xf5, 0x73, 0x08, 0x5f, 0x21, 0xf8,
+	0xf0, 0x6e, 0x50, 0xe0, 0xdd, 0x49, 0x30, 0x46, 0x24, 0x97, 0xb4, 0x37, 0x9d, 0x58, 0xd8, 0xd8,
+	0xe6, 0x36, 0xea, 0xb8, 0x9a, 0x62, 0x23, 0xb6, 0xa0, 0xb5, 0x7a, 0x5a, 0xf8, 0xaa, 0x2f, 0x11,
+	0x2c, 0x25, 0xdf, 0x2d, 0xfe, 0x66, 0xa2, 0xdb, 0xbc, 0x9f, 0x1a, 0x52, 0xe3, 0xe1, 0x42, 0x81,
+	0xdf, 0xe0, 0xf8, 0x35, 0xfc, 0x55, 0xda, 0xaf, 0x10, 0xa5, 0x57, 0x80, 0x1f, 0xe4, 0x08, 0x7e,
+	0x86, 0xe0, 0x83, 0x3b, 0xf9, 0x87, 0x77, 0x1e, 0xc0, 0x71, 0x27, 0x7d, 0xa5, 0xdd, 0xa9, 0xb4,
+	0x8f, 0xb6, 0x71, 0x8d, 0x00, 0xdf, 0x4f, 0x33, 0xfc, 0xed, 0x24, 0x34, 0x23, 0x13, 0x54, 0xda,
+	0x9f, 0x56, 0x2e, 0xfc, 0xec, 0x72, 0x3f, 0x5b, 0xb8, 0x9e, 0xe2, 0x27, 0x8a, 0x4a, 0xed, 0x94,
+	0x7a, 0x9a, 0x30, 0x87, 0x5f, 0x22, 0xf8, 0x78, 0x68, 0x18, 0xe2, 0xef, 0x26, 0xc1, 0x1a, 0x97,
+	0xb6, 0xd2, 0xc1, 0x23, 0x3a, 0x08, 0x6f, 0xfb, 0xdc, 0x5b, 0x03, 0x7f, 0x9d, 0xe2, 0x8d, 0x3f,
+	0x12, 0x8d, 0x11, 0xa7, 0xad, 0x25, 0xa3, 0xb6, 0xf9, 0xcf, 0xd5, 0x4d, 0x11, 0x5d, 0xdf, 0x14,
+	0xd1, 0xeb, 0x9b, 0x22, 0xfa, 0xff, 0xb6, 0x98, 0xb9, 0xbe, 0x2d, 0x66, 0x5e, 0xdc, 0x16, 0x33,
+	0x27, 0xc7, 0xa6, 0xe5, 0x9f, 0x75, 0x5b, 0x15, 0x83, 0x76, 0x94, 0xa3, 0xa8, 0xf7, 0x2f, 0x7a,
+	0x8b, 0xf5, 0x27, 0x95, 0x0d, 0xea, 0x91, 0xe4, 0xf2, 0x4c, 0xb7, 0x1c, 0xa5, 0x43, 0xdb, 0x5d,
+	0x9b, 0xb0, 0x01, 0x0c, 0xbf, 0xe7, 0x12, 0xd6, 0x9a, 0xe7, 0xff, 0xf4, 0xd5, 0xdf, 0x0d, 0x00,
+	0x43, 0x7b, 0xe8, 0x91, 0x2a, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -683,6 +770,9 @@ type QueryClient interface {
 	// vouchers that are held by permissions module for this address, keyed by the
 	// originator address
 	VouchersForAddress(ctx context.Context, in *QueryVouchersForAddressRequest, opts ...grpc.CallOption) (*QueryVouchersForAddressResponse, error)
+	// DenomSendRestrictions defines a gRPC query method that returns the denoms
+	// whose sends are disabled along with their allowed senders
+	DenomSendRestrictions(ctx context.Context, in *QueryDenomSendRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomSendRestrictionsResponse, error)
 }
 
 type queryClient struct {
@@ -747,6 +837,15 @@ func (c *queryClient) VouchersForAddress(ctx context.Context, in *QueryVouchersF
 	return out, nil
 }
 
+func (c *queryClient) DenomSendRestrictions(ctx context.Context, in *QueryDenomSendRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomSendRestrictionsResponse, error) {
+	out := new(QueryDenomSendRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Query/DenomSendRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the permissions module's
@@ -768,6 +867,9 @@ type QueryServer interface {
 	// vouchers that are held by permissions module for this address, keyed by the
 	// originator address
 	VouchersForAddress(context.Context, *QueryVouchersForAddressRequest) (*QueryVouchersForAddressResponse, error)
+	// DenomSendRestrictions defines a gRPC query method that returns the denoms
+	// whose sends are disabled along with their allowed senders
+	DenomSendRestrictions(context.Context, *QueryDenomSendRestrictionsRequest) (*QueryDenomSendRestrictionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -792,6 +894,9 @@ func (*UnimplementedQueryServer) AddressesByRole(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) VouchersForAddress(ctx context.Context, req *QueryVouchersForAddressRequest) (*QueryVouchersForAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VouchersForAddress not implemented")
 }
+func (*UnimplementedQueryServer) DenomSendRestrictions(ctx context.Context, req *QueryDenomSendRestrictionsRequest) (*QueryDenomSendRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSendRestrictions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -905,6 +1010,24 @@ func _Query_VouchersForAddress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomSendRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSendRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSendRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Query/DenomSendRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSendRestrictions(ctx, req.(*QueryDenomSendRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.permissions.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -933,6 +1056,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VouchersForAddress",
 			Handler:    _Query_VouchersForAddress_Handler,
 		},
+		{
+			MethodName: "DenomSendRestrictions",
+			Handler:    _Query_DenomSendRestrictions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/permissions/v1beta1/query.proto",
@@ -1334,6 +1461,66 @@ func (m *QueryVouchersForAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomSendRestrictionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSendRestrictionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSendRestrictionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSendRestrictionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSendRestrictionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSendRestrictionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Restrictions) > 0 {
+		for iNdEx := len(m.Restrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1510,6 +1697,30 @@ func (m *QueryVouchersForAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomSendRestrictionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomSendRestrictionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Restrictions) > 0 {
+		for _, e := range m.Restrictions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2529,6 +2740,140 @@ func (m *QueryVouchersForAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomSendRestrictionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSendRestrictionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSendRestrictionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSendRestrictionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSendRestrictionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSendRestrictionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restrictions = append(m.Restrictions, DenomSendRestriction{})
+			if err := m.Restrictions[len(m.Restrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
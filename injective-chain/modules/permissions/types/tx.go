@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -128,3 +129,31 @@ func (m MsgClaimVoucher) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{addr}
 }
+
+var _ sdk.Msg = &MsgUpdateDenomSendRestriction{}
+
+func (m MsgUpdateDenomSendRestriction) Route() string { return routerKey }
+
+func (m MsgUpdateDenomSendRestriction) Type() string { return "update_denom_send_restriction" }
+
+func (m MsgUpdateDenomSendRestriction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if !m.SendsDisabled && len(m.AllowedSenders) > 0 {
+		return errors.Wrap(ErrInvalidSendRestriction, "allowed senders can only be set when the sends are disabled")
+	}
+
+	restriction := DenomSendRestriction{Denom: m.Denom, AllowedSenders: m.AllowedSenders}
+	return restriction.Validate()
+}
+
+func (m *MsgUpdateDenomSendRestriction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgUpdateDenomSendRestriction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
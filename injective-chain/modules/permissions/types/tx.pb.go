@@ -884,6 +884,113 @@ func (m *MsgClaimVoucherResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimVoucherResponse proto.InternalMessageInfo
 
+type MsgUpdateDenomSendRestriction struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// disables the sends of the denom for everyone except the allowed senders,
+	// false removes the restriction
+	SendsDisabled  bool     `protobuf:"varint,3,opt,name=sends_disabled,json=sendsDisabled,proto3" json:"sends_disabled,omitempty"`
+	AllowedSenders []string `protobuf:"bytes,4,rep,name=allowed_senders,json=allowedSenders,proto3" json:"allowed_senders,omitempty"`
+}
+
+func (m *MsgUpdateDenomSendRestriction) Reset()         { *m = MsgUpdateDenomSendRestriction{} }
+func (m *MsgUpdateDenomSendRestriction) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomSendRestriction) ProtoMessage()    {}
+func (*MsgUpdateDenomSendRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{14}
+}
+func (m *MsgUpdateDenomSendRestriction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomSendRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomSendRestriction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomSendRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomSendRestriction.Merge(m, src)
+}
+func (m *MsgUpdateDenomSendRestriction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomSendRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomSendRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomSendRestriction proto.InternalMessageInfo
+
+func (m *MsgUpdateDenomSendRestriction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateDenomSendRestriction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgUpdateDenomSendRestriction) GetSendsDisabled() bool {
+	if m != nil {
+		return m.SendsDisabled
+	}
+	return false
+}
+
+func (m *MsgUpdateDenomSendRestriction) GetAllowedSenders() []string {
+	if m != nil {
+		return m.AllowedSenders
+	}
+	return nil
+}
+
+type MsgUpdateDenomSendRestrictionResponse struct {
+}
+
+func (m *MsgUpdateDenomSendRestrictionResponse) Reset()         { *m = MsgUpdateDenomSendRestrictionResponse{} }
+func (m *MsgUpdateDenomSendRestrictionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomSendRestrictionResponse) ProtoMessage()    {}
+func (*MsgUpdateDenomSendRestrictionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{15}
+}
+func (m *MsgUpdateDenomSendRestrictionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomSendRestrictionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomSendRestrictionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomSendRestrictionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomSendRestrictionResponse.Merge(m, src)
+}
+func (m *MsgUpdateDenomSendRestrictionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomSendRestrictionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomSendRestrictionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomSendRestrictionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "injective.permissions.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.permissions.v1beta1.MsgUpdateParamsResponse")
@@ -903,6 +1010,8 @@ func init() {
 	proto.RegisterType((*MsgRevokeNamespaceRolesResponse)(nil), "injective.permissions.v1beta1.MsgRevokeNamespaceRolesResponse")
 	proto.RegisterType((*MsgClaimVoucher)(nil), "injective.permissions.v1beta1.MsgClaimVoucher")
 	proto.RegisterType((*MsgClaimVoucherResponse)(nil), "injective.permissions.v1beta1.MsgClaimVoucherResponse")
+	proto.RegisterType((*MsgUpdateDenomSendRestriction)(nil), "injective.permissions.v1beta1.MsgUpdateDenomSendRestriction")
+	proto.RegisterType((*MsgUpdateDenomSendRestrictionResponse)(nil), "injective.permissions.v1beta1.MsgUpdateDenomSendRestrictionResponse")
 }
 
 func init() {
@@ -910,64 +1019,70 @@ func init() {
 }
 
 var fileDescriptor_ab9bfdcab1d9b6fa = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcb, 0x6e, 0xf3, 0x44,
-	0x14, 0xc7, 0xe3, 0x2f, 0x6d, 0x68, 0x26, 0x6d, 0x43, 0xad, 0x48, 0x4d, 0x0d, 0xb8, 0x25, 0x08,
-	0x08, 0x45, 0x8d, 0x49, 0x91, 0x2a, 0xd1, 0x05, 0x82, 0xa4, 0x0b, 0x90, 0x9a, 0x2a, 0xb8, 0x50,
-	0x24, 0x24, 0x64, 0x8d, 0x93, 0xc1, 0x31, 0x89, 0x3d, 0x96, 0xc7, 0x4e, 0xe8, 0x0a, 0x89, 0x07,
-	0x40, 0xac, 0x2b, 0xc4, 0x33, 0xb0, 0xe0, 0x21, 0xba, 0x2c, 0xac, 0x58, 0x55, 0xa8, 0x5d, 0xb0,
-	0xe7, 0x09, 0xd0, 0xd8, 0x13, 0xdf, 0xe2, 0x26, 0x4e, 0xab, 0x7e, 0xab, 0x64, 0xe6, 0x5c, 0xfe,
-	0xbf, 0x73, 0xec, 0x39, 0x63, 0xf0, 0x8e, 0x6e, 0x7e, 0x8f, 0x7a, 0x8e, 0x3e, 0x46, 0x92, 0x85,
-	0x6c, 0x43, 0x27, 0x44, 0xc7, 0x26, 0x91, 0xc6, 0x4d, 0x15, 0x39, 0xb0, 0x29, 0x39, 0x3f, 0x34,
-	0x2c, 0x1b, 0x3b, 0x98, 0x7f, 0x23, 0xf0, 0x6b, 0x44, 0xfc, 0x1a, 0xcc, 0x4f, 0xa8, 0x68, 0x58,
-	0xc3, 0x9e, 0xa7, 0x44, 0xff, 0xf9, 0x41, 0x82, 0xd8, 0xc3, 0xc4, 0xc0, 0x44, 0x52, 0x21, 0x41,
-	0x41, 0xca, 0x1e, 0xd6, 0xcd, 0x19, 0xbb, 0x39, 0x0c, 0xec, 0x74, 0xc1, 0xec, 0xdb, 0xcc, 0x6e,
-	0x10, 0x4d, 0x1a, 0x37, 0xe9, 0x0f, 0x33, 0xec, 0xf8, 0x06, 0xc5, 0x57, 0xf4, 0x17, 0xcc, 0xb4,
-	0x3f, 0xbf, 0x20, 0x0b, 0xda, 0xd0, 0x98, 0xfa, 0x4a, 0x0b, 0x7c, 0x23, 0x85, 0x7a, 0x01, 0xb5,
-	0xdf, 0x38, 0x50, 0xee, 0x10, 0xed, 0x2b, 0xab, 0x0f, 0x1d, 0xd4, 0xf5, 0x52, 0xf1, 0x47, 0xa0,
-	0x08, 0x5d, 0x67, 0x80, 0x6d, 0xdd, 0xb9, 0xac, 0x72, 0x7b, 0x5c, 0xbd, 0xd8, 0xaa, 0xfe, 0xf5,
-	0xc7, 0x41, 0x85, 0x51, 0x7d, 0xda, 0xef, 0xdb, 0x88, 0x90, 0x73, 0xc7, 0xd6, 0x4d, 0x4d, 0x0e,
-	0x5d, 0xf9, 0x36, 0x28, 0xf8, 0x30, 0xd5, 0x17, 0x7b, 0x5c, 0xbd, 0x74, 0xf8, 0x76, 0x63, 0x6e,
-	0x8b, 0x1b, 0xbe, 0x5c, 0x6b, 0xe5, 0xfa, 0x76, 0x37, 0x27, 0xb3, 0xd0, 0xe3, 0xcd, 0x9f, 0xfe,
-	0xfd, 0x7d, 0x3f, 0x4c, 0x5a, 0xdb, 0x01, 0xdb, 0x09, 0x3e, 0x19, 0x11, 0x0b, 0x9b, 0x04, 0xd5,
-	0xae, 0x38, 0xc0, 0x77, 0x88, 0xd6, 0xb6, 0x11, 0x74, 0xd0, 0x19, 0x34, 0x10, 0xb1, 0x60, 0x0f,
-	0xf1, 0xef, 0x81, 0x02, 0x41, 0x66, 0x1f, 0xd9, 0x8c, 0x7d, 0xeb, 0xbf, 0xdb, 0xdd, 0x8d, 0x4b,
-	0x68, 0x8c, 0x8e, 0x6b, 0xfe, 0x7e, 0x4d, 0x66, 0x0e, 0xfc, 0x29, 0x28, 0x9a, 0xd3, 0x38, 0x06,
-	0x5d, 0x5f, 0x00, 0x1d, 0xe8, 0x30, 0xee, 0x30, 0xc1, 0x71, 0x89, 0xa2, 0xb3, 0xd4, 0xb5, 0xd7,
-	0x81, 0x30, 0xcb, 0x16, 0xa0, 0xbb, 0x1e, 0xf9, 0x09, 0x1a, 0xa1, 0x47, 0x92, 0xbf, 0x0b, 0xca,
-	0x81, 0xb0, 0xd2, 0x47, 0x26, 0x36, 0x3c, 0xfe, 0xa2, 0xbc, 0x19, 0x6c, 0x9f, 0xd0, 0xdd, 0x34,
-	0xa8, 0x84, 0x6c, 0x00, 0xf5, 0xe7, 0xaa, 0x47, 0xe5, 0xf7, 0xfa, 0x59, 0xa9, 0xf8, 0x6f, 0x41,
-	0x71, 0x02, 0x89, 0xa1, 0x0c, 0x30, 0x1e, 0x56, 0xf3, 0x5e, 0xe3, 0x3f, 0x59, 0xd0, 0xf8, 0x59,
-	0x32, 0xba, 0x75, 0x8e, 0x9c, 0xaf, 0x21, 0x31, 0x3e, 0xc3, 0x78, 0x28, 0xaf, 0x4d, 0xd8, 0x3f,
-	0xfe, 0x3b, 0xb0, 0x6e, 0xe8, 0xa6, 0x43, 0x14, 0x0b, 0xba, 0x04, 0xf5, 0xab, 0x2b, 0x9e, 0x42,
-	0xfb, 0xb1, 0x0a, 0x1d, 0x9a, 0xab, 0xeb, 0xa5, 0x92, 0x4b, 0x46, 0xb8, 0xa0, 0x3a, 0xb4, 0xf2,
-	0x40, 0x67, 0xf5, 0x69, 0x3a, 0xe7, 0x34, 0xd7, 0x54, 0x87, 0x84, 0x0b, 0xaa, 0xa3, 0xba, 0xb6,
-	0x19, 0xe8, 0x14, 0x9e, 0xa6, 0xd3, 0xa2, 0xb9, 0xa6, 0x3a, 0x6a, 0xb8, 0x10, 0x0e, 0xc0, 0x66,
-	0xbc, 0xa7, 0xfc, 0x6b, 0xa0, 0x68, 0xa2, 0x89, 0x32, 0x86, 0x23, 0x17, 0xf9, 0xcf, 0x5f, 0x5e,
-	0x33, 0xd1, 0xe4, 0x82, 0xae, 0x85, 0x0f, 0xc0, 0xd6, 0x4c, 0x83, 0x66, 0x23, 0xd6, 0xd2, 0x22,
-	0x22, 0xa5, 0x66, 0x8c, 0x88, 0x40, 0xcf, 0x8d, 0x48, 0x7b, 0xe3, 0x13, 0x6d, 0x08, 0xde, 0xf8,
-	0x5f, 0x5f, 0x44, 0xa6, 0x4b, 0x68, 0xc6, 0x23, 0x44, 0x9e, 0xe5, 0xb5, 0x3f, 0x03, 0xaf, 0xda,
-	0x78, 0x84, 0x94, 0xc8, 0xd3, 0xaa, 0xe6, 0xf7, 0xf2, 0xf5, 0xd2, 0xe1, 0x5b, 0x0b, 0x9e, 0x25,
-	0x65, 0x92, 0xcb, 0x34, 0xb8, 0x1b, 0x5a, 0xf9, 0x2e, 0xd8, 0x80, 0xfe, 0x34, 0x56, 0xa8, 0x89,
-	0x54, 0x57, 0xbc, 0x64, 0xef, 0x2f, 0x48, 0xc6, 0x26, 0xb8, 0x57, 0xa7, 0xbc, 0x0e, 0x23, 0xab,
-	0x78, 0xf3, 0xde, 0x04, 0xbb, 0x0f, 0x74, 0x27, 0xe8, 0xe0, 0x2d, 0xe7, 0x75, 0x50, 0x46, 0x63,
-	0x3c, 0x7c, 0x19, 0x1d, 0x54, 0xc1, 0x76, 0xac, 0x62, 0xc5, 0xc1, 0x8a, 0xed, 0x89, 0xb3, 0x46,
-	0x2e, 0x55, 0x7b, 0x25, 0x5a, 0xfb, 0x97, 0xd8, 0xaf, 0x22, 0xad, 0x07, 0x69, 0xf5, 0x05, 0x3d,
-	0xd0, 0xbd, 0x2b, 0xb4, 0x3d, 0x82, 0xba, 0x71, 0x81, 0xdd, 0xde, 0x00, 0xd9, 0xcb, 0x94, 0x2e,
-	0x02, 0x80, 0x6d, 0x5d, 0xd3, 0x4d, 0xe8, 0x60, 0x9b, 0x55, 0x1d, 0xd9, 0x89, 0xd3, 0xf8, 0xb7,
-	0x61, 0x54, 0x6a, 0x4a, 0x71, 0x78, 0xf5, 0x0a, 0xc8, 0x77, 0x88, 0xc6, 0x8f, 0xc1, 0x7a, 0xec,
-	0x36, 0x6f, 0x64, 0x9d, 0x12, 0xbe, 0xbf, 0x70, 0xb4, 0x9c, 0xff, 0x54, 0x9f, 0xff, 0x11, 0x94,
-	0x93, 0x37, 0x71, 0x73, 0x71, 0xaa, 0x44, 0x88, 0xf0, 0xd1, 0xd2, 0x21, 0x51, 0x80, 0xe4, 0x85,
-	0x9a, 0x01, 0x20, 0x11, 0x92, 0x05, 0xe0, 0x81, 0xfb, 0x93, 0x02, 0x24, 0xef, 0xce, 0xe6, 0xd2,
-	0x23, 0x3a, 0x0b, 0xc0, 0x03, 0xe3, 0x8c, 0xff, 0x99, 0x03, 0x95, 0xd4, 0x59, 0x76, 0xb4, 0x7c,
-	0x4e, 0x1a, 0x27, 0x7c, 0xfc, 0xb8, 0xb8, 0x18, 0x50, 0xea, 0x68, 0xc8, 0x00, 0x94, 0x16, 0x97,
-	0x05, 0x68, 0xde, 0x51, 0xa5, 0x87, 0x23, 0x76, 0x4e, 0x33, 0x1c, 0x8e, 0xa8, 0x7f, 0x96, 0xc3,
-	0x91, 0x76, 0x38, 0x5b, 0xc3, 0xeb, 0x3b, 0x91, 0xbb, 0xb9, 0x13, 0xb9, 0x7f, 0xee, 0x44, 0xee,
-	0x97, 0x7b, 0x31, 0x77, 0x73, 0x2f, 0xe6, 0xfe, 0xbe, 0x17, 0x73, 0xdf, 0x7c, 0xa1, 0xe9, 0xce,
-	0xc0, 0x55, 0x1b, 0x3d, 0x6c, 0x48, 0x9f, 0x4f, 0x73, 0x9f, 0x42, 0x95, 0x84, 0x9f, 0xf2, 0x07,
-	0x3d, 0x6c, 0xa3, 0xe8, 0x72, 0x00, 0x75, 0x53, 0x32, 0x70, 0xdf, 0x1d, 0x21, 0x12, 0xfb, 0xce,
-	0x77, 0x2e, 0x2d, 0x44, 0xd4, 0x82, 0xf7, 0x69, 0xff, 0xe1, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xab, 0xb4, 0xa8, 0xfb, 0x0a, 0x0d, 0x00, 0x00,
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x37, 0x6d, 0x95, 0x4c, 0xdb, 0x94, 0xb5, 0x22, 0x35, 0x6b, 0xd8, 0xb4, 0x04, 0x95,
+	0x2d, 0x8b, 0x1a, 0x93, 0x22, 0x55, 0x6a, 0x85, 0x56, 0xd0, 0xf6, 0x00, 0xd2, 0x76, 0x55, 0x1c,
+	0x58, 0x24, 0x24, 0x64, 0x8d, 0xe3, 0xc1, 0x31, 0xb1, 0x3d, 0x91, 0x67, 0x92, 0xd0, 0x13, 0x12,
+	0x1f, 0x00, 0x71, 0x46, 0x08, 0xf1, 0x11, 0x38, 0x70, 0xe5, 0xbe, 0x17, 0xa4, 0x85, 0x13, 0xa7,
+	0x0a, 0xb5, 0x07, 0xee, 0x7c, 0x02, 0x34, 0x7f, 0x32, 0x71, 0x12, 0x93, 0xc4, 0xa9, 0x76, 0x4f,
+	0xed, 0xfb, 0xf3, 0x7b, 0xbf, 0xdf, 0x7b, 0xe3, 0xbc, 0xb1, 0xc1, 0x9b, 0x7e, 0xf4, 0x15, 0x6a,
+	0x52, 0xbf, 0x87, 0xcc, 0x0e, 0x8a, 0x43, 0x9f, 0x10, 0x1f, 0x47, 0xc4, 0xec, 0xd5, 0x1d, 0x44,
+	0x61, 0xdd, 0xa4, 0x5f, 0xd7, 0x3a, 0x31, 0xa6, 0x58, 0xbf, 0xaf, 0xf2, 0x6a, 0x89, 0xbc, 0x9a,
+	0xcc, 0x33, 0x4a, 0x1e, 0xf6, 0x30, 0xcf, 0x34, 0xd9, 0x7f, 0x02, 0x64, 0x54, 0x9a, 0x98, 0x84,
+	0x98, 0x98, 0x0e, 0x24, 0x48, 0x95, 0x6c, 0x62, 0x3f, 0x9a, 0x88, 0x47, 0x6d, 0x15, 0x67, 0x86,
+	0x8c, 0x6f, 0xc9, 0x78, 0x48, 0x3c, 0xb3, 0x57, 0x67, 0x7f, 0x64, 0xe0, 0x9e, 0x08, 0xd8, 0x82,
+	0x51, 0x18, 0x32, 0xf4, 0x70, 0x7a, 0x43, 0x1d, 0x18, 0xc3, 0x70, 0x90, 0x6b, 0xce, 0xc8, 0x4d,
+	0x34, 0xca, 0x01, 0xd5, 0x9f, 0x34, 0xb0, 0x79, 0x4e, 0xbc, 0x4f, 0x3b, 0x2e, 0xa4, 0xe8, 0x82,
+	0x97, 0xd2, 0x0f, 0x41, 0x01, 0x76, 0x69, 0x0b, 0xc7, 0x3e, 0xbd, 0x2c, 0x6b, 0x3b, 0xda, 0x5e,
+	0xe1, 0xa4, 0xfc, 0xe7, 0xaf, 0xfb, 0x25, 0xa9, 0xea, 0x03, 0xd7, 0x8d, 0x11, 0x21, 0x0d, 0x1a,
+	0xfb, 0x91, 0x67, 0x0d, 0x53, 0xf5, 0x53, 0xb0, 0x2a, 0xc4, 0x94, 0xef, 0xec, 0x68, 0x7b, 0x6b,
+	0x07, 0xbb, 0xb5, 0xa9, 0x23, 0xae, 0x09, 0xba, 0x93, 0xe5, 0x67, 0x57, 0xdb, 0x4b, 0x96, 0x84,
+	0x1e, 0x17, 0xbf, 0xfd, 0xe7, 0x97, 0x87, 0xc3, 0xa2, 0xd5, 0x7b, 0x60, 0x6b, 0x4c, 0x9f, 0x85,
+	0x48, 0x07, 0x47, 0x04, 0x55, 0x7f, 0xd0, 0x80, 0x7e, 0x4e, 0xbc, 0xd3, 0x18, 0x41, 0x8a, 0x9e,
+	0xc0, 0x10, 0x91, 0x0e, 0x6c, 0x22, 0xfd, 0x2d, 0xb0, 0x4a, 0x50, 0xe4, 0xa2, 0x58, 0x6a, 0xbf,
+	0xfb, 0xef, 0xd5, 0xf6, 0xc6, 0x25, 0x0c, 0x83, 0xe3, 0xaa, 0xf0, 0x57, 0x2d, 0x99, 0xa0, 0x3f,
+	0x06, 0x85, 0x68, 0x80, 0x93, 0xa2, 0xf7, 0x66, 0x88, 0x56, 0x3c, 0x52, 0xf7, 0xb0, 0xc0, 0xf1,
+	0x1a, 0x93, 0x2e, 0x4b, 0x57, 0x5f, 0x03, 0xc6, 0xa4, 0x36, 0x25, 0xbd, 0xcb, 0x95, 0x9f, 0xa1,
+	0x00, 0x2d, 0xa8, 0xfc, 0x01, 0xd8, 0x54, 0xc4, 0xb6, 0x8b, 0x22, 0x1c, 0x72, 0xfd, 0x05, 0xab,
+	0xa8, 0xdc, 0x67, 0xcc, 0x9b, 0x26, 0x6a, 0x8c, 0x56, 0x89, 0xfa, 0x63, 0x05, 0xe8, 0x6a, 0xd6,
+	0x2f, 0x54, 0x95, 0xfe, 0x05, 0x28, 0xf4, 0x21, 0x09, 0xed, 0x16, 0xc6, 0xed, 0x72, 0x8e, 0x0f,
+	0xfe, 0xfd, 0x19, 0x83, 0x9f, 0x54, 0xc6, 0x5c, 0x0d, 0x44, 0x3f, 0x83, 0x24, 0xfc, 0x10, 0xe3,
+	0xb6, 0x95, 0xef, 0xcb, 0xff, 0xf4, 0x2f, 0xc1, 0x7a, 0xe8, 0x47, 0x94, 0xd8, 0x1d, 0xd8, 0x25,
+	0xc8, 0x2d, 0x2f, 0x73, 0x86, 0xd3, 0x45, 0x19, 0xce, 0x59, 0xad, 0x0b, 0x5e, 0xca, 0x5a, 0x0b,
+	0x87, 0x06, 0xe3, 0x61, 0x9d, 0x2b, 0x9e, 0x95, 0xdb, 0xf1, 0x34, 0x58, 0xad, 0x01, 0x0f, 0x19,
+	0x1a, 0x8c, 0xc7, 0xe9, 0xc6, 0x91, 0xe2, 0x59, 0xbd, 0x1d, 0xcf, 0x09, 0xab, 0x35, 0xe0, 0x71,
+	0x86, 0x86, 0xb1, 0x0f, 0x8a, 0xa3, 0x33, 0xd5, 0x5f, 0x05, 0x85, 0x08, 0xf5, 0xed, 0x1e, 0x0c,
+	0xba, 0x48, 0x9c, 0xbf, 0x95, 0x8f, 0x50, 0xff, 0x29, 0xb3, 0x8d, 0x77, 0xc0, 0xdd, 0x89, 0x01,
+	0x4d, 0x22, 0xf2, 0x69, 0x88, 0x44, 0xab, 0x73, 0x22, 0x12, 0xa2, 0xa7, 0x22, 0xd2, 0x9e, 0xf8,
+	0xb1, 0x31, 0xa8, 0x27, 0xfe, 0xc7, 0x3b, 0x60, 0x2b, 0x25, 0x8c, 0x03, 0x44, 0x5e, 0xc8, 0x63,
+	0xff, 0x04, 0xbc, 0x12, 0xe3, 0x00, 0xd9, 0x89, 0xd3, 0x2a, 0xe7, 0x76, 0x72, 0x7b, 0x6b, 0x07,
+	0x6f, 0xcc, 0x38, 0x4b, 0xa6, 0xc9, 0xda, 0x64, 0xe0, 0x8b, 0x61, 0x54, 0xbf, 0x00, 0x1b, 0x50,
+	0x6c, 0x63, 0x9b, 0x85, 0x48, 0x79, 0x99, 0x17, 0x7b, 0x7b, 0x46, 0x31, 0xb9, 0xc1, 0x79, 0x9f,
+	0xd6, 0x3a, 0x4c, 0x58, 0xa3, 0xc3, 0x7b, 0x1d, 0x6c, 0xff, 0xcf, 0x74, 0xd4, 0x04, 0xaf, 0x34,
+	0x3e, 0x41, 0x0b, 0xf5, 0x70, 0xfb, 0x65, 0x4c, 0xd0, 0x01, 0x5b, 0x23, 0x1d, 0xdb, 0x14, 0xdb,
+	0x31, 0x27, 0x2f, 0xe7, 0xb2, 0xf7, 0x5e, 0x4a, 0xf6, 0xfe, 0x09, 0x16, 0x5d, 0xa4, 0xcd, 0x20,
+	0xad, 0x3f, 0x35, 0x03, 0x9f, 0x5f, 0xa1, 0xa7, 0x01, 0xf4, 0xc3, 0xa7, 0xb8, 0xdb, 0x6c, 0xa1,
+	0x38, 0x4b, 0xeb, 0x15, 0x00, 0x70, 0xec, 0x7b, 0x7e, 0x04, 0x29, 0x8e, 0x65, 0xd7, 0x09, 0xcf,
+	0xa8, 0x1a, 0x71, 0x1b, 0x26, 0xa9, 0x94, 0x8a, 0xdf, 0x35, 0x70, 0x5f, 0x9d, 0x16, 0x1f, 0x16,
+	0xfb, 0x8d, 0x59, 0x88, 0xd0, 0xd8, 0x6f, 0x52, 0x1f, 0x47, 0x0b, 0xdf, 0xeb, 0x25, 0xb0, 0x92,
+	0x3c, 0x12, 0x61, 0xe8, 0xbb, 0xa0, 0x28, 0x76, 0x9f, 0xeb, 0x13, 0xe8, 0x04, 0xc8, 0xe5, 0x7b,
+	0x3c, 0x6f, 0x6d, 0x70, 0xef, 0x99, 0x74, 0xb2, 0x93, 0x85, 0x41, 0x80, 0xfb, 0xc8, 0xb5, 0x45,
+	0x0f, 0xe2, 0x21, 0x2d, 0x58, 0x45, 0xe9, 0x6e, 0x08, 0xef, 0xc4, 0xc5, 0xff, 0x00, 0xec, 0x4e,
+	0x6d, 0x67, 0xd0, 0xf8, 0xc1, 0x6f, 0x79, 0x90, 0x3b, 0x27, 0x9e, 0xde, 0x03, 0xeb, 0x23, 0xaf,
+	0x31, 0xb5, 0x79, 0xd7, 0xa3, 0xc8, 0x37, 0x0e, 0xb3, 0xe5, 0x0f, 0xf8, 0xf5, 0x6f, 0xc0, 0xe6,
+	0xf8, 0x2b, 0x48, 0x7d, 0x76, 0xa9, 0x31, 0x88, 0x71, 0x94, 0x19, 0x92, 0x14, 0x30, 0xfe, 0x26,
+	0x31, 0x87, 0x80, 0x31, 0x88, 0x71, 0x94, 0x19, 0x92, 0x14, 0x30, 0xfe, 0xd2, 0x50, 0xcf, 0x7c,
+	0x37, 0x19, 0x47, 0x99, 0x21, 0x4a, 0xc0, 0x77, 0x1a, 0x28, 0xa5, 0x2e, 0xf1, 0xc3, 0xec, 0x35,
+	0x19, 0xce, 0x78, 0xb4, 0x18, 0x6e, 0x44, 0x50, 0xea, 0x4e, 0x9c, 0x43, 0x50, 0x1a, 0xce, 0x78,
+	0xb4, 0x18, 0x4e, 0x09, 0xea, 0x81, 0xf5, 0x91, 0x05, 0x35, 0xc7, 0x8f, 0x23, 0x99, 0x6f, 0x1c,
+	0x66, 0xcb, 0x57, 0xbc, 0x3f, 0x6b, 0xc0, 0x98, 0xb2, 0x92, 0xde, 0x9b, 0x77, 0xce, 0x69, 0x68,
+	0xe3, 0xec, 0x36, 0xe8, 0x81, 0xc4, 0x93, 0xf6, 0xb3, 0xeb, 0x8a, 0xf6, 0xfc, 0xba, 0xa2, 0xfd,
+	0x7d, 0x5d, 0xd1, 0xbe, 0xbf, 0xa9, 0x2c, 0x3d, 0xbf, 0xa9, 0x2c, 0xfd, 0x75, 0x53, 0x59, 0xfa,
+	0xfc, 0x63, 0xcf, 0xa7, 0xad, 0xae, 0x53, 0x6b, 0xe2, 0xd0, 0xfc, 0x68, 0xc0, 0xf4, 0x18, 0x3a,
+	0x64, 0xf8, 0x99, 0xb5, 0xdf, 0xc4, 0x31, 0x4a, 0x9a, 0x2d, 0xe8, 0x47, 0x66, 0x88, 0xdd, 0x6e,
+	0x80, 0xc8, 0xc8, 0x37, 0x18, 0xbd, 0xec, 0x20, 0xe2, 0xac, 0xf2, 0xcf, 0xae, 0x77, 0xff, 0x1b,
+	0x00, 0x17, 0x1a, 0x6d, 0x03, 0xa6, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -989,6 +1104,7 @@ type MsgClient interface {
 	UpdateNamespaceRoles(ctx context.Context, in *MsgUpdateNamespaceRoles, opts ...grpc.CallOption) (*MsgUpdateNamespaceRolesResponse, error)
 	RevokeNamespaceRoles(ctx context.Context, in *MsgRevokeNamespaceRoles, opts ...grpc.CallOption) (*MsgRevokeNamespaceRolesResponse, error)
 	ClaimVoucher(ctx context.Context, in *MsgClaimVoucher, opts ...grpc.CallOption) (*MsgClaimVoucherResponse, error)
+	UpdateDenomSendRestriction(ctx context.Context, in *MsgUpdateDenomSendRestriction, opts ...grpc.CallOption) (*MsgUpdateDenomSendRestrictionResponse, error)
 }
 
 type msgClient struct {
@@ -1062,6 +1178,15 @@ func (c *msgClient) ClaimVoucher(ctx context.Context, in *MsgClaimVoucher, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateDenomSendRestriction(ctx context.Context, in *MsgUpdateDenomSendRestriction, opts ...grpc.CallOption) (*MsgUpdateDenomSendRestrictionResponse, error) {
+	out := new(MsgUpdateDenomSendRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateDenomSendRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -1071,6 +1196,7 @@ type MsgServer interface {
 	UpdateNamespaceRoles(context.Context, *MsgUpdateNamespaceRoles) (*MsgUpdateNamespaceRolesResponse, error)
 	RevokeNamespaceRoles(context.Context, *MsgRevokeNamespaceRoles) (*MsgRevokeNamespaceRolesResponse, error)
 	ClaimVoucher(context.Context, *MsgClaimVoucher) (*MsgClaimVoucherResponse, error)
+	UpdateDenomSendRestriction(context.Context, *MsgUpdateDenomSendRestriction) (*MsgUpdateDenomSendRestrictionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1098,6 +1224,9 @@ func (*UnimplementedMsgServer) RevokeNamespaceRoles(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) ClaimVoucher(ctx context.Context, req *MsgClaimVoucher) (*MsgClaimVoucherResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimVoucher not implemented")
 }
+func (*UnimplementedMsgServer) UpdateDenomSendRestriction(ctx context.Context, req *MsgUpdateDenomSendRestriction) (*MsgUpdateDenomSendRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomSendRestriction not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1229,6 +1358,24 @@ func _Msg_ClaimVoucher_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDenomSendRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomSendRestriction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomSendRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateDenomSendRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomSendRestriction(ctx, req.(*MsgUpdateDenomSendRestriction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.permissions.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1261,6 +1408,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimVoucher",
 			Handler:    _Msg_ClaimVoucher_Handler,
 		},
+		{
+			MethodName: "UpdateDenomSendRestriction",
+			Handler:    _Msg_UpdateDenomSendRestriction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/permissions/v1beta1/tx.proto",
@@ -1911,6 +2062,85 @@ func (m *MsgClaimVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateDenomSendRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomSendRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomSendRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSenders) > 0 {
+		for iNdEx := len(m.AllowedSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSenders[iNdEx])
+			copy(dAtA[i:], m.AllowedSenders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SendsDisabled {
+		i--
+		if m.SendsDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomSendRestrictionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomSendRestrictionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomSendRestrictionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2183,6 +2413,41 @@ func (m *MsgClaimVoucherResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateDenomSendRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SendsDisabled {
+		n += 2
+	}
+	if len(m.AllowedSenders) > 0 {
+		for _, s := range m.AllowedSenders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateDenomSendRestrictionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3877,6 +4142,222 @@ func (m *MsgClaimVoucherResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateDenomSendRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomSendRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomSendRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendsDisabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSenders = append(m.AllowedSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomSendRestrictionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomSendRestrictionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomSendRestrictionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
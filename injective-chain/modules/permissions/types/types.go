@@ -73,3 +73,32 @@ func (n *Namespace) Validate() error {
 
 	return nil
 }
+
+func (r *DenomSendRestriction) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errors.Wrap(ErrInvalidSendRestriction, err.Error())
+	}
+
+	seenSenders := make(map[string]struct{}, len(r.AllowedSenders))
+	for _, sender := range r.AllowedSenders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return errors.Wrapf(ErrInvalidSendRestriction, "invalid allowed sender %s: %s", sender, err.Error())
+		}
+		if _, ok := seenSenders[sender]; ok {
+			return errors.Wrapf(ErrInvalidSendRestriction, "duplicate allowed sender %s", sender)
+		}
+		seenSenders[sender] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsAllowedSender returns true if the address may send the restricted denom
+func (r *DenomSendRestriction) IsAllowedSender(addr string) bool {
+	for _, sender := range r.AllowedSenders {
+		if sender == addr {
+			return true
+		}
+	}
+	return false
+}
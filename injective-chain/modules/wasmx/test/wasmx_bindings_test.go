@@ -10,7 +10,6 @@ import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/wasmbinding"
 	"github.com/InjectiveLabs/injective-core/injective-chain/wasmbinding/bindings"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -55,12 +54,11 @@ var _ = Describe("Wasmx ", func() {
 		config := te.TestPlayerConfig{NumAccounts: 2, NumSpotMarkets: 1, InitContractRegistry: true}
 		player = te.InitTest(config, nil)
 		app := player.App
-		bk := app.BankKeeper.(bankkeeper.BaseKeeper)
-		queryPlugin = *wasmbinding.NewQueryPlugin(&app.AuthzKeeper, &app.ExchangeKeeper, &app.OracleKeeper, &bk, &app.TokenFactoryKeeper, &app.WasmxKeeper, &app.FeeGrantKeeper)
+		queryPlugin = *wasmbinding.NewQueryPlugin(&app.AuthzKeeper, &app.ExchangeKeeper, &app.OracleKeeper, app.BankKeeper, &app.TokenFactoryKeeper, &app.WasmxKeeper, &app.FeeGrantKeeper)
 		mock = MockMessenger{shouldBeCalled: true}
 
 		decorator := wasmbinding.CustomMessageDecorator(
-			app.MsgServiceRouter(), app.BankKeeper.(bankkeeper.BaseKeeper), &app.ExchangeKeeper, &app.TokenFactoryKeeper)
+			app.MsgServiceRouter(), app.BankKeeper, &app.ExchangeKeeper, &app.TokenFactoryKeeper)
 
 		messenger = decorator(mock)
 	})
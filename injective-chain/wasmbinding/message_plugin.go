@@ -22,7 +22,7 @@ import (
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
 func CustomMessageDecorator(
 	router wasmkeeper.MessageRouter,
-	bankKeeper bankkeeper.BaseKeeper,
+	bankKeeper bankkeeper.Keeper,
 	exchangeKeeper *exchangekeeper.Keeper,
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
 ) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
@@ -30,7 +30,7 @@ func CustomMessageDecorator(
 		return &CustomMessenger{
 			router:             router,
 			wrapped:            old,
-			bankKeeper:         &bankKeeper,
+			bankKeeper:         bankKeeper,
 			exchangeKeeper:     exchangeKeeper,
 			tokenFactoryKeeper: tokenFactoryKeeper,
 		}
@@ -40,7 +40,7 @@ func CustomMessageDecorator(
 type CustomMessenger struct {
 	router             wasmkeeper.MessageRouter
 	wrapped            wasmkeeper.Messenger
-	bankKeeper         *bankkeeper.BaseKeeper
+	bankKeeper         bankkeeper.Keeper
 	exchangeKeeper     *exchangekeeper.Keeper
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper
 }
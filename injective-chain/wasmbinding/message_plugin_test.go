@@ -8,7 +8,6 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/ethereum/go-ethereum/common"
 	. "github.com/onsi/ginkgo"
@@ -71,7 +70,7 @@ var _ = Describe("Message plugin tests", func() {
 		JustBeforeEach(func() {
 			mock = MockMessanger{shouldBeCalled: true}
 			decorator := wasmbinding.CustomMessageDecorator(
-				app.MsgServiceRouter(), app.BankKeeper.(bankkeeper.BaseKeeper), &app.ExchangeKeeper, &app.TokenFactoryKeeper)
+				app.MsgServiceRouter(), app.BankKeeper, &app.ExchangeKeeper, &app.TokenFactoryKeeper)
 
 			messanger = decorator(mock)
 		})
@@ -89,7 +88,7 @@ var _ = Describe("Message plugin tests", func() {
 		JustBeforeEach(func() {
 			mock = MockMessanger{shouldBeCalled: false}
 			decorator := wasmbinding.CustomMessageDecorator(
-				app.MsgServiceRouter(), app.BankKeeper.(bankkeeper.BaseKeeper), &app.ExchangeKeeper, &app.TokenFactoryKeeper)
+				app.MsgServiceRouter(), app.BankKeeper, &app.ExchangeKeeper, &app.TokenFactoryKeeper)
 
 			messanger = decorator(mock)
 		})
@@ -31,7 +31,7 @@ import (
 
 type QueryPlugin struct {
 	authzKeeper        *authzkeeper.Keeper
-	bankKeeper         *bankkeeper.BaseKeeper
+	bankKeeper         bankkeeper.Keeper
 	exchangeKeeper     *exchangekeeper.Keeper
 	feegrantKeeper     *feegrantkeeper.Keeper
 	oracleKeeper       *oraclekeeper.Keeper
@@ -44,7 +44,7 @@ func NewQueryPlugin(
 	ak *authzkeeper.Keeper,
 	ek *exchangekeeper.Keeper,
 	ok *oraclekeeper.Keeper,
-	bk *bankkeeper.BaseKeeper,
+	bk bankkeeper.Keeper,
 	tfk *tokenfactorykeeper.Keeper,
 	wk *wasmxkeeper.Keeper,
 	fgk *feegrantkeeper.Keeper,
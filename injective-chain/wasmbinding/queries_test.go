@@ -16,8 +16,6 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-
 	simapp "github.com/InjectiveLabs/injective-core/injective-chain/app"
 	exchangekeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/testexchange"
@@ -57,8 +55,7 @@ var _ = Describe("Queries tests", func() {
 			Height: 1234567,
 			Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
 		})
-		bk := app.BankKeeper.(bankkeeper.BaseKeeper)
-		queryPlugin = *wasmbinding.NewQueryPlugin(&app.AuthzKeeper, &app.ExchangeKeeper, &app.OracleKeeper, &bk, &app.TokenFactoryKeeper, &app.WasmxKeeper, &app.FeeGrantKeeper)
+		queryPlugin = *wasmbinding.NewQueryPlugin(&app.AuthzKeeper, &app.ExchangeKeeper, &app.OracleKeeper, app.BankKeeper, &app.TokenFactoryKeeper, &app.WasmxKeeper, &app.FeeGrantKeeper)
 	})
 
 	Context("staking queries", func() {
@@ -15,7 +15,7 @@ import (
 
 func RegisterCustomPlugins(
 	authzKeeper *authzkeeper.Keeper,
-	bankBaseKeeper bankkeeper.BaseKeeper,
+	bankKeeper bankkeeper.Keeper,
 	exchangeKeeper *exchangekeeper.Keeper,
 	feegrantKeeper *feegrantkeeper.Keeper,
 	oracleKeeper *oraclekeeper.Keeper,
@@ -23,14 +23,14 @@ func RegisterCustomPlugins(
 	wasmxKeeper *wasmxkeeper.Keeper,
 	router wasmkeeper.MessageRouter,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(authzKeeper, exchangeKeeper, oracleKeeper, &bankBaseKeeper, tokenFactoryKeeper, wasmxKeeper, feegrantKeeper)
+	wasmQueryPlugin := NewQueryPlugin(authzKeeper, exchangeKeeper, oracleKeeper, bankKeeper, tokenFactoryKeeper, wasmxKeeper, feegrantKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(router, bankBaseKeeper, exchangeKeeper, tokenFactoryKeeper),
+		CustomMessageDecorator(router, bankKeeper, exchangeKeeper, tokenFactoryKeeper),
 	)
 
 	return []wasmkeeper.Option{